@@ -0,0 +1,89 @@
+package main
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+
+	"github.com/workflow-engine/workflow-engine/internal/auth"
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data"
+	"github.com/workflow-engine/workflow-engine/internal/temporal"
+	"github.com/workflow-engine/workflow-engine/pkg/config"
+)
+
+// app HTTP服务运行所需的顶层组件
+type app struct {
+	server *http.Server
+	data   *data.Data
+	users  *biz.UserUseCase
+	logger *zap.Logger
+}
+
+// newApp 创建服务应用
+func newApp(server *http.Server, data *data.Data, users *biz.UserUseCase, logger *zap.Logger) *app {
+	return &app{
+		server: server,
+		data:   data,
+		users:  users,
+		logger: logger,
+	}
+}
+
+// newJWTConfig 根据认证配置创建JWT配置
+func newJWTConfig(cfg *config.Config) *auth.JWTConfig {
+	jwtConfig := &auth.JWTConfig{
+		SecretKey:      cfg.Auth.Secret,
+		Issuer:         "workflow-engine",
+		ExpirationTime: cfg.Auth.Expires,
+		RefreshTime:    cfg.Auth.RefreshExpires,
+		Algorithm:      cfg.Auth.Algorithm,
+		// 吊销记录保存在 Redis 中，在服务重启后保留并在所有副本之间共享
+		EnableBlacklist: true,
+		// 刷新令牌在数据库中按会话记录，轮换与重用检测见 biz.AuthUseCase
+		EnableRefresh: true,
+	}
+	for _, key := range cfg.Auth.Keys {
+		jwtConfig.Keys = append(jwtConfig.Keys, auth.KeyConfig{
+			ID:             key.ID,
+			Algorithm:      key.Algorithm,
+			PrivateKeyFile: key.PrivateKeyFile,
+			PublicKeyFile:  key.PublicKeyFile,
+			NotBefore:      key.NotBefore,
+			NotAfter:       key.NotAfter,
+		})
+	}
+	for _, jwks := range cfg.Auth.JWKS {
+		jwtConfig.JWKS = append(jwtConfig.JWKS, auth.JWKSConfig{
+			Issuer:          jwks.Issuer,
+			Audience:        jwks.Audience,
+			File:            jwks.File,
+			URL:             jwks.URL,
+			RefreshInterval: jwks.RefreshInterval,
+			Roles:           jwks.Roles,
+			Permissions:     jwks.Permissions,
+		})
+	}
+	return jwtConfig
+}
+
+// newPasswordHasher 根据认证配置创建密码哈希器
+func newPasswordHasher(cfg *config.Config) (*auth.PasswordHasher, error) {
+	return auth.NewPasswordHasher(auth.PasswordConfig{Algorithm: cfg.Auth.PasswordHash})
+}
+
+// newRevocationStore 创建基于 Redis 的令牌吊销存储
+func newRevocationStore(d *data.Data) auth.RevocationStore {
+	return auth.NewRedisRevocationStore(d.Redis)
+}
+
+// newWorkflowEngine 创建Temporal客户端，清理函数关闭客户端连接
+func newWorkflowEngine(cfg *config.Config, logger *zap.Logger) (*temporal.Client, func(), error) {
+	client, err := temporal.NewClient(cfg.Temporal)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logger.Info("Temporal客户端连接成功", zap.String("host_port", cfg.Temporal.HostPort))
+	return client, client.Close, nil
+}
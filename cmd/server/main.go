@@ -4,6 +4,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"net/http"
 	"os"
@@ -11,12 +12,23 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
 
-	"github.com/workflow-engine/workflow-engine/internal/server"
+	"github.com/workflow-engine/workflow-engine/internal/auth"
+	"github.com/workflow-engine/workflow-engine/pkg/config"
+)
+
+var (
+	// confPath 配置文件路径
+	confPath = flag.String("conf", "configs/config.yaml", "配置文件路径")
+	// migrate 启动前是否执行数据库迁移
+	migrate = flag.Bool("migrate", false, "启动前执行数据库迁移")
 )
 
 func main() {
+	flag.Parse()
+
 	// 初始化日志
 	logger, err := zap.NewProduction()
 	if err != nil {
@@ -26,17 +38,39 @@ func main() {
 
 	logger.Info("工作流引擎服务启动中...")
 
-	// 创建HTTP路由器
-	router := server.NewRouter(logger)
+	// 加载配置
+	cfg, err := config.Load(*confPath)
+	if err != nil {
+		logger.Fatal("加载配置失败", zap.Error(err), zap.String("path", *confPath))
+	}
 
-	// 创建HTTP服务器
-	srv := &http.Server{
-		Addr:         ":8080",
-		Handler:      router,
-		ReadTimeout:  30 * time.Second,
-		WriteTimeout: 30 * time.Second,
-		IdleTimeout:  60 * time.Second,
+	// 构建依赖图
+	gin.SetMode(gin.ReleaseMode)
+	application, cleanup, err := wireApp(cfg, logger)
+	if err != nil {
+		logger.Fatal("初始化服务失败", zap.Error(err))
 	}
+	defer cleanup()
+
+	// 执行数据库迁移
+	if *migrate {
+		if err := application.data.Migrate(context.Background()); err != nil {
+			logger.Error("执行数据库迁移失败", zap.Error(err))
+			return
+		}
+	}
+
+	// 创建初始管理员账号，由服务自身以系统身份执行
+	if admin := cfg.Auth.Admin; admin.Username != "" {
+		if admin.Password == "" {
+			logger.Warn("未配置初始管理员密码，跳过创建管理员账号", zap.String("username", admin.Username))
+		} else if err := application.users.EnsureAdmin(auth.NewSystemContext(context.Background()), admin.Username, admin.Password); err != nil {
+			logger.Error("创建初始管理员账号失败", zap.Error(err))
+			return
+		}
+	}
+
+	srv := application.server
 
 	// 启动服务器
 	go func() {
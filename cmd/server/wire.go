@@ -0,0 +1,40 @@
+//go:build wireinject
+// +build wireinject
+
+package main
+
+import (
+	"github.com/google/wire"
+	"go.uber.org/zap"
+
+	"github.com/workflow-engine/workflow-engine/internal/auth"
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data"
+	"github.com/workflow-engine/workflow-engine/internal/data/repository"
+	"github.com/workflow-engine/workflow-engine/internal/middleware"
+	"github.com/workflow-engine/workflow-engine/internal/server"
+	"github.com/workflow-engine/workflow-engine/internal/service"
+	"github.com/workflow-engine/workflow-engine/internal/temporal"
+	"github.com/workflow-engine/workflow-engine/pkg/config"
+)
+
+// wireApp 根据配置构建完整的服务依赖图
+func wireApp(cfg *config.Config, logger *zap.Logger) (*app, func(), error) {
+	panic(wire.Build(
+		data.ProviderSet,
+		repository.ProviderSet,
+		biz.ProviderSet,
+		service.ServiceSet,
+		server.ProviderSet,
+		newJWTConfig,
+		newRevocationStore,
+		newPasswordHasher,
+		auth.NewJWTManager,
+		middleware.NewAuthMiddleware,
+		newWorkflowEngine,
+		wire.Bind(new(biz.WorkflowEngine), new(*temporal.Client)),
+		wire.Bind(new(biz.TokenManager), new(*auth.JWTManager)),
+		wire.Bind(new(server.HealthChecker), new(*data.Data)),
+		newApp,
+	))
+}
@@ -0,0 +1,87 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package main
+
+import (
+	"github.com/workflow-engine/workflow-engine/internal/auth"
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data"
+	"github.com/workflow-engine/workflow-engine/internal/data/repository"
+	"github.com/workflow-engine/workflow-engine/internal/middleware"
+	"github.com/workflow-engine/workflow-engine/internal/server"
+	"github.com/workflow-engine/workflow-engine/internal/service"
+	"github.com/workflow-engine/workflow-engine/pkg/config"
+	"go.uber.org/zap"
+)
+
+// Injectors from wire.go:
+
+// wireApp 根据配置构建完整的服务依赖图
+func wireApp(cfg *config.Config, logger *zap.Logger) (*app, func(), error) {
+	dataData, cleanup, err := data.NewData(cfg, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	client := dataData.DB
+	processDefinitionRepo := repository.NewProcessDefinitionRepo(client, logger)
+	redisClient := dataData.Redis
+	cacheRepo := repository.NewCacheRepo(redisClient, logger)
+	authorizationRepo := repository.NewAuthorizationRepo(client, logger)
+	authorizationUseCase := biz.NewAuthorizationUseCase(authorizationRepo, processDefinitionRepo, logger)
+	processDefinitionUseCase := biz.NewProcessDefinitionUseCase(processDefinitionRepo, cacheRepo, authorizationUseCase, logger)
+	processDefinitionService := service.NewProcessDefinitionService(processDefinitionUseCase, logger)
+	processInstanceRepo := repository.NewProcessInstanceRepo(client, logger)
+	taskInstanceRepo := repository.NewTaskInstanceRepo(client, logger)
+	processVariableRepo := repository.NewProcessVariableRepo(client, logger)
+	transactionRepo := repository.NewTransactionRepo(client, logger)
+	temporalClient, cleanup2, err := newWorkflowEngine(cfg, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	processInstanceUseCase := biz.NewProcessInstanceUseCase(processInstanceRepo, processDefinitionRepo, taskInstanceRepo, processVariableRepo, transactionRepo, cacheRepo, temporalClient, authorizationUseCase, logger)
+	processInstanceService := service.NewProcessInstanceService(processInstanceUseCase, logger)
+	processEventRepo := repository.NewProcessEventRepo(client, logger)
+	taskCommentRepo := repository.NewTaskCommentRepo(client, logger)
+	taskAttachmentRepo := repository.NewTaskAttachmentRepo(client, cfg, logger)
+	taskInstanceUseCase := biz.NewTaskInstanceUseCase(taskInstanceRepo, processInstanceRepo, processVariableRepo, processEventRepo, taskCommentRepo, taskAttachmentRepo, transactionRepo, cacheRepo, temporalClient, authorizationUseCase, logger)
+	taskInstanceService := service.NewTaskInstanceService(taskInstanceUseCase, logger)
+	historicProcessInstanceRepo := repository.NewHistoricProcessInstanceRepo(client, logger)
+	historicDataUseCase := biz.NewHistoricDataUseCase(historicProcessInstanceRepo, cacheRepo, authorizationUseCase, logger)
+	historicDataService := service.NewHistoricDataService(historicDataUseCase, logger)
+	userRepo := repository.NewUserRepo(client, logger)
+	roleRepo := repository.NewRoleRepo(client, logger)
+	refreshTokenRepo := repository.NewRefreshTokenRepo(client, logger)
+	jwtConfig := newJWTConfig(cfg)
+	revocationStore := newRevocationStore(dataData)
+	jwtManager, err := auth.NewJWTManager(jwtConfig, revocationStore, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	passwordHasher, err := newPasswordHasher(cfg)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	authUseCase := biz.NewAuthUseCase(userRepo, roleRepo, refreshTokenRepo, transactionRepo, jwtManager, passwordHasher, logger)
+	authService := service.NewAuthService(authUseCase, logger)
+	groupRepo := repository.NewGroupRepo(client, logger)
+	userUseCase := biz.NewUserUseCase(userRepo, roleRepo, groupRepo, authUseCase, passwordHasher, logger)
+	userService := service.NewUserService(userUseCase, logger)
+	authorizationService := service.NewAuthorizationService(authorizationUseCase, logger)
+	authMiddleware := middleware.NewAuthMiddleware(jwtManager, logger)
+	router := server.NewRouter(processDefinitionService, processInstanceService, taskInstanceService, historicDataService, authService, userService, authorizationService, authMiddleware, dataData, logger)
+	httpServer := server.NewHTTPServer(cfg, router)
+	mainApp := newApp(httpServer, dataData, userUseCase, logger)
+	return mainApp, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
@@ -7,6 +7,11 @@ import (
 	"os/signal"
 	"syscall"
 
+	"go.uber.org/zap"
+
+	"github.com/workflow-engine/workflow-engine/internal/data"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/data/repository"
 	"github.com/workflow-engine/workflow-engine/internal/temporal"
 	"github.com/workflow-engine/workflow-engine/pkg/config"
 )
@@ -20,6 +25,30 @@ func main() {
 		log.Fatalf("加载配置失败: %v", err)
 	}
 
+	logger, err := zap.NewProduction()
+	if err != nil {
+		log.Fatalf("创建日志器失败: %v", err)
+	}
+	defer logger.Sync()
+
+	// 创建数据库连接，流程活动需要读取流程定义、记录流程事件、创建与升级用户任务并记录流程实例的最终状态
+	db, dbCleanup, err := data.NewDB(cfg.Data.Database, logger)
+	if err != nil {
+		log.Fatalf("创建数据库连接失败: %v", err)
+	}
+	defer dbCleanup()
+
+	// 用户任务升级修改任务后需要清除服务端缓存的任务详情，Redis 不可用时只记录警告
+	var taskCache temporal.TaskCache
+	if rdb, redisCleanup, err := data.NewRedis(cfg.Data.Redis, logger); err != nil {
+		logger.Warn("创建Redis连接失败，升级规则修改任务后不清除任务缓存", zap.Error(err))
+	} else {
+		defer redisCleanup()
+		taskCache = repository.NewCacheRepo(rdb, logger)
+	}
+
+	activities := newProcessActivities(db, taskCache, logger)
+
 	// 创建Temporal客户端
 	temporalClient, err := temporal.NewClient(cfg.Temporal)
 	if err != nil {
@@ -36,7 +65,7 @@ func main() {
 
 	// 在单独的goroutine中启动Worker
 	go func() {
-		if err := temporalClient.StartWorker(ctx); err != nil {
+		if err := temporalClient.StartWorker(ctx, activities); err != nil {
 			log.Fatalf("启动Temporal Worker失败: %v", err)
 		}
 	}()
@@ -56,3 +85,18 @@ func main() {
 
 	log.Println("Temporal Worker 已关闭")
 }
+
+// newProcessActivities 使用数据层仓储创建流程活动集合
+func newProcessActivities(db *ent.Client, cache temporal.TaskCache, logger *zap.Logger) *temporal.ProcessActivities {
+	return temporal.NewProcessActivities(
+		repository.NewProcessDefinitionRepo(db, logger),
+		repository.NewProcessInstanceRepo(db, logger),
+		repository.NewHistoricProcessInstanceRepo(db, logger),
+		repository.NewTransactionRepo(db, logger),
+		repository.NewProcessEventRepo(db, logger),
+		repository.NewTaskInstanceRepo(db, logger),
+		cache,
+		temporal.NewServiceRegistry(),
+		logger,
+	)
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	"go.uber.org/zap"
+
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/enttest"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/historicprocessinstance"
+	"github.com/workflow-engine/workflow-engine/internal/temporal"
+)
+
+// TestProcessWorkflowRecordsFinalState 测试流程工作流结束后流程实例与历史流程实例的数据库记录
+func TestProcessWorkflowRecordsFinalState(t *testing.T) {
+	ctx := context.Background()
+	db := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	t.Cleanup(func() { db.Close() })
+	activities := newProcessActivities(db, nil, zap.NewNop())
+
+	// run 创建流程定义与流程实例并在测试环境中执行流程工作流
+	run := func(t *testing.T, key, resource string) (*ent.ProcessInstance, *temporal.ProcessWorkflowResult) {
+		pd, err := db.ProcessDefinition.Create().
+			SetName(key).SetKey(key).SetVersion(1).SetResource(resource).
+			Save(ctx)
+		require.NoError(t, err, "创建流程定义不应该返回错误")
+		pi, err := db.ProcessInstance.Create().
+			SetBusinessKey(key + "-001").
+			SetProcessDefinitionID(pd.ID).
+			SetProcessDefinitionKey(key).
+			SetProcessDefinitionVersion(1).
+			SetStartUserID("alice").
+			SetStartTime(time.Now().Add(-time.Minute)).
+			Save(ctx)
+		require.NoError(t, err, "创建流程实例不应该返回错误")
+
+		var suite testsuite.WorkflowTestSuite
+		env := suite.NewTestWorkflowEnvironment()
+		env.RegisterActivity(activities)
+		env.RegisterActivity(temporal.SendNotificationActivity)
+		env.ExecuteWorkflow(temporal.ProcessWorkflow, temporal.ProcessWorkflowInput{
+			ProcessInstanceID:   pi.ID,
+			ProcessDefinitionID: pd.ID,
+			Initiator:           "alice",
+		})
+		require.True(t, env.IsWorkflowCompleted(), "工作流应该已结束")
+		var result temporal.ProcessWorkflowResult
+		require.NoError(t, env.GetWorkflowResult(&result), "获取工作流结果不应该返回错误")
+
+		pi, err = db.ProcessInstance.Get(ctx, pi.ID)
+		require.NoError(t, err, "获取流程实例不应该返回错误")
+		return pi, &result
+	}
+	// historic 获取流程实例对应的历史流程实例
+	historic := func(t *testing.T, id int64) *ent.HistoricProcessInstance {
+		hpi, err := db.HistoricProcessInstance.Query().
+			Where(historicprocessinstance.ProcessInstanceID(strconv.FormatInt(id, 10))).
+			Only(ctx)
+		require.NoError(t, err, "应该写入一条历史流程实例")
+		return hpi
+	}
+
+	t.Run("流程完成后记录结束时间与历史", func(t *testing.T) {
+		pi, result := run(t, "direct", `{"id":"direct","name":"direct","elements":[`+
+			`{"id":"start","type":"start_event","next":"end"},`+
+			`{"id":"end","type":"end_event"}]}`)
+		require.Equal(t, temporal.ProcessStatusCompleted, result.Status, "流程应该正常完成")
+
+		require.NotNil(t, pi.EndTime, "流程实例应该记录结束时间")
+		assert.Positive(t, pi.Duration, "流程实例应该记录持续时间")
+		assert.Empty(t, pi.DeleteReason, "正常完成不应该记录结束原因")
+
+		hpi := historic(t, pi.ID)
+		assert.Equal(t, "COMPLETED", hpi.State, "历史状态应该为已完成")
+		assert.Equal(t, "alice", hpi.StartUserID, "历史应该保留发起人")
+		require.NotNil(t, hpi.EndTime, "历史应该记录结束时间")
+		assert.True(t, pi.EndTime.Equal(*hpi.EndTime), "历史与流程实例的结束时间应该一致")
+	})
+
+	t.Run("流程失败后记录失败原因", func(t *testing.T) {
+		pi, result := run(t, "broken", `{"id":"broken","name":"broken","elements":[`+
+			`{"id":"start","type":"start_event","next":"call"},`+
+			`{"id":"call","type":"service_task","next":"end","config":{"service_name":"missing"}},`+
+			`{"id":"end","type":"end_event"}]}`)
+		require.Equal(t, temporal.ProcessStatusFailed, result.Status, "流程应该以失败状态结束")
+
+		require.NotNil(t, pi.EndTime, "失败的流程实例应该记录结束时间")
+		assert.Contains(t, pi.DeleteReason, "missing", "应该记录失败原因")
+		assert.Equal(t, "INTERNALLY_TERMINATED", historic(t, pi.ID).State, "历史状态应该为内部终止")
+	})
+
+	t.Run("已结束的流程实例不重复记录", func(t *testing.T) {
+		pi, _ := run(t, "again", `{"id":"again","name":"again","elements":[`+
+			`{"id":"start","type":"start_event","next":"end"},`+
+			`{"id":"end","type":"end_event"}]}`)
+
+		err := activities.UpdateStatusActivity(ctx, temporal.UpdateStatusInput{
+			ProcessInstanceID: pi.ID,
+			Status:            temporal.ProcessStatusFailed,
+			Reason:            "重试",
+		})
+		require.NoError(t, err, "活动重试不应该返回错误")
+
+		again, err := db.ProcessInstance.Get(ctx, pi.ID)
+		require.NoError(t, err, "获取流程实例不应该返回错误")
+		assert.Empty(t, again.DeleteReason, "已结束的流程实例不应该被修改")
+		assert.Equal(t, "COMPLETED", historic(t, pi.ID).State, "不应该写入第二条历史")
+	})
+}
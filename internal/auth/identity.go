@@ -0,0 +1,84 @@
+package auth
+
+import "context"
+
+// SystemUserID 系统身份的用户标识，流程引擎内部触发的操作使用系统身份；没有调用方身份时同样记为该标识
+const SystemUserID = "system"
+
+// Identity 调用方身份
+// 与传输层无关，由接口层在认证通过后放入 context.Context，业务层从上下文读取
+type Identity struct {
+	UserID      string   `json:"user_id"`
+	Username    string   `json:"username"`
+	Roles       []string `json:"roles"`
+	Groups      []string `json:"groups"`
+	Permissions []string `json:"permissions"`
+	TenantID    string   `json:"tenant_id"`
+}
+
+// identityKey 上下文中存放调用方身份的键
+type identityKey struct{}
+
+// NewIdentity 根据令牌声明创建调用方身份
+func NewIdentity(claims *UserClaims) *Identity {
+	return &Identity{
+		UserID:      claims.Principal(),
+		Username:    claims.Username,
+		Roles:       claims.Roles,
+		Groups:      claims.Groups,
+		Permissions: claims.Permissions,
+		TenantID:    claims.TenantID,
+	}
+}
+
+// SystemIdentity 返回流程引擎内部调用使用的系统身份
+func SystemIdentity() *Identity {
+	return &Identity{UserID: SystemUserID, Username: SystemUserID}
+}
+
+// IsSystem 检查是否为系统身份
+// 根据令牌创建的身份用户ID为数字或 iss|sub，不会与系统身份混淆
+func (i *Identity) IsSystem() bool {
+	return i.UserID == SystemUserID
+}
+
+// Principal 返回作为办理人、发起人等记录的用户标识
+// 取用户名，未设置时取用户ID
+func (i *Identity) Principal() string {
+	if i.Username != "" {
+		return i.Username
+	}
+	return i.UserID
+}
+
+// CandidateGroups 返回匹配任务候选组时使用的用户组，包含角色与用户组
+func (i *Identity) CandidateGroups() []string {
+	groups := make([]string, 0, len(i.Roles)+len(i.Groups))
+	groups = append(groups, i.Roles...)
+	groups = append(groups, i.Groups...)
+	return groups
+}
+
+// NewContext 返回携带调用方身份的上下文
+func NewContext(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// NewSystemContext 返回携带系统身份的上下文，用于流程引擎内部触发、不受资源授权限制的操作
+func NewSystemContext(ctx context.Context) context.Context {
+	return NewContext(ctx, SystemIdentity())
+}
+
+// FromContext 从上下文读取调用方身份
+func FromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(*Identity)
+	return identity, ok && identity != nil
+}
+
+// CurrentUserID 返回上下文中调用方的用户标识，没有调用方身份时返回 SystemUserID
+func CurrentUserID(ctx context.Context) string {
+	if identity, ok := FromContext(ctx); ok {
+		return identity.Principal()
+	}
+	return SystemUserID
+}
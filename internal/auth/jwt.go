@@ -3,9 +3,12 @@
 package auth
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -15,13 +18,15 @@ import (
 
 // JWTConfig JWT配置
 type JWTConfig struct {
-	SecretKey       string        `yaml:"secret_key"`       // 密钥
+	SecretKey       string        `yaml:"secret_key"`       // HS256 共享密钥
 	Issuer          string        `yaml:"issuer"`           // 签发者
 	ExpirationTime  time.Duration `yaml:"expiration_time"`  // 访问令牌过期时间
 	RefreshTime     time.Duration `yaml:"refresh_time"`     // 刷新令牌过期时间
-	Algorithm       string        `yaml:"algorithm"`        // 签名算法
+	Algorithm       string        `yaml:"algorithm"`        // 签名算法，配置 Keys 时作为未指定算法的密钥的默认算法
 	EnableRefresh   bool          `yaml:"enable_refresh"`   // 启用刷新令牌
-	EnableBlacklist bool          `yaml:"enable_blacklist"` // 启用黑名单
+	EnableBlacklist bool          `yaml:"enable_blacklist"` // 启用令牌吊销
+	Keys            []KeyConfig   `yaml:"keys"`             // 非对称签名密钥，配置后使用其中生效的密钥签名
+	JWKS            []JWKSConfig  `yaml:"jwks"`             // 信任的外部身份提供方，其令牌只用于验证
 }
 
 // UserClaims 用户声明
@@ -30,10 +35,41 @@ type UserClaims struct {
 	Username    string   `json:"username"`
 	Email       string   `json:"email"`
 	Roles       []string `json:"roles"`
+	Groups      []string `json:"groups,omitempty"` // 用户组，由身份提供方签发时携带
 	Permissions []string `json:"permissions"`
+	TenantID    string   `json:"tenant_id,omitempty"`  // 租户，由身份提供方签发时携带
+	SessionID   string   `json:"sid,omitempty"`        // 登录会话ID，同一会话轮换出的令牌属于同一令牌族
+	TokenType   string   `json:"token_type,omitempty"` // 令牌类型: access, refresh；外部身份提供方签发的令牌为空，按访问令牌处理
 	jwt.RegisteredClaims
 }
 
+// Principal 返回令牌所属用户的标识，用于按用户吊销令牌
+// 本地用户为用户ID，外部身份提供方的用户没有本地用户ID，为 iss|sub
+func (c *UserClaims) Principal() string {
+	if c.UserID == 0 {
+		return c.Username
+	}
+	return strconv.FormatInt(c.UserID, 10)
+}
+
+// 令牌类型
+const (
+	TokenTypeAccess  = "access"  // 访问令牌
+	TokenTypeRefresh = "refresh" // 刷新令牌
+)
+
+// TokenRequest 签发令牌对所需的用户信息
+type TokenRequest struct {
+	UserID      int64
+	Username    string
+	Email       string
+	Roles       []string
+	Groups      []string
+	Permissions []string
+	TenantID    string
+	SessionID   string // 登录会话ID，为空时刷新令牌不归属任何令牌族
+}
+
 // TokenPair 令牌对
 type TokenPair struct {
 	AccessToken  string    `json:"access_token"`
@@ -41,25 +77,49 @@ type TokenPair struct {
 	TokenType    string    `json:"token_type"`
 	ExpiresIn    int64     `json:"expires_in"`
 	ExpiresAt    time.Time `json:"expires_at"`
+
+	AccessTokenID    string    `json:"-"` // 访问令牌 jti
+	RefreshTokenID   string    `json:"-"` // 刷新令牌 jti，未启用刷新令牌时为空
+	RefreshExpiresAt time.Time `json:"-"` // 刷新令牌过期时间
 }
 
 // JWTManager JWT管理器
 type JWTManager struct {
-	config    *JWTConfig
-	logger    *zap.Logger
-	blacklist map[string]time.Time // 简单的内存黑名单
+	config      *JWTConfig
+	logger      *zap.Logger
+	keys        *Keyring        // 非对称密钥环
+	revocations RevocationStore // 令牌吊销存储
 }
 
 // NewJWTManager 创建JWT管理器
-func NewJWTManager(config *JWTConfig, logger *zap.Logger) *JWTManager {
-	if config.SecretKey == "" {
-		// 生成随机密钥
-		config.SecretKey = generateRandomSecret()
-		logger.Warn("使用随机生成的JWT密钥，生产环境建议配置固定密钥")
+// 配置了非对称密钥时用当前生效的密钥签名，否则用共享密钥按 HS256 签名；两者都未配置时返回错误，
+// 避免各副本各自生成随机密钥导致令牌互不认可。
+// revocations 为空时使用进程内的吊销存储，多副本部署时应传入共享的存储
+func NewJWTManager(config *JWTConfig, revocations RevocationStore, logger *zap.Logger) (*JWTManager, error) {
+	if config.Algorithm == "" && len(config.Keys) == 0 {
+		config.Algorithm = AlgorithmHS256
+	}
+	if len(config.Keys) == 0 && config.Algorithm != AlgorithmHS256 {
+		return nil, fmt.Errorf("签名算法 %s 需要配置签名密钥", config.Algorithm)
+	}
+	if config.SecretKey == "" && len(config.Keys) == 0 && len(config.JWKS) == 0 {
+		return nil, fmt.Errorf("未配置JWT签名密钥")
 	}
 
-	if config.Algorithm == "" {
-		config.Algorithm = "HS256"
+	keys := make([]*Key, 0, len(config.Keys))
+	for _, cfg := range config.Keys {
+		if cfg.Algorithm == "" && config.Algorithm != AlgorithmHS256 {
+			cfg.Algorithm = config.Algorithm
+		}
+		key, err := LoadKey(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("加载JWT签名密钥失败: %w", err)
+		}
+		keys = append(keys, key)
+	}
+	keyring, err := NewKeyring(keys, config.JWKS)
+	if err != nil {
+		return nil, fmt.Errorf("创建JWT密钥环失败: %w", err)
 	}
 
 	if config.ExpirationTime == 0 {
@@ -70,83 +130,110 @@ func NewJWTManager(config *JWTConfig, logger *zap.Logger) *JWTManager {
 		config.RefreshTime = 7 * 24 * time.Hour
 	}
 
-	return &JWTManager{
-		config:    config,
-		logger:    logger,
-		blacklist: make(map[string]time.Time),
+	if revocations == nil {
+		revocations = NewMemoryRevocationStore()
 	}
+
+	return &JWTManager{
+		config:      config,
+		logger:      logger,
+		keys:        keyring,
+		revocations: revocations,
+	}, nil
 }
 
 // GenerateTokenPair 生成令牌对
 func (j *JWTManager) GenerateTokenPair(userID int64, username, email string, roles, permissions []string) (*TokenPair, error) {
-	now := time.Now()
-
-	// 生成访问令牌
-	accessClaims := &UserClaims{
+	return j.IssueTokenPair(&TokenRequest{
 		UserID:      userID,
 		Username:    username,
 		Email:       email,
 		Roles:       roles,
 		Permissions: permissions,
+	})
+}
+
+// IssueTokenPair 按用户信息签发令牌对，两个令牌都携带会话ID
+func (j *JWTManager) IssueTokenPair(req *TokenRequest) (*TokenPair, error) {
+	now := time.Now()
+
+	// 生成访问令牌
+	accessClaims := &UserClaims{
+		UserID:      req.UserID,
+		Username:    req.Username,
+		Email:       req.Email,
+		Roles:       req.Roles,
+		Groups:      req.Groups,
+		Permissions: req.Permissions,
+		TenantID:    req.TenantID,
+		SessionID:   req.SessionID,
+		TokenType:   TokenTypeAccess,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        generateTokenID(),
 			Issuer:    j.config.Issuer,
-			Subject:   fmt.Sprintf("%d", userID),
+			Subject:   fmt.Sprintf("%d", req.UserID),
 			IssuedAt:  jwt.NewNumericDate(now),
 			ExpiresAt: jwt.NewNumericDate(now.Add(j.config.ExpirationTime)),
 			NotBefore: jwt.NewNumericDate(now),
 		},
 	}
 
-	accessToken := jwt.NewWithClaims(jwt.GetSigningMethod(j.config.Algorithm), accessClaims)
-	accessTokenString, err := accessToken.SignedString([]byte(j.config.SecretKey))
+	accessTokenString, err := j.sign(accessClaims)
 	if err != nil {
 		j.logger.Error("生成访问令牌失败",
-			zap.Int64("user_id", userID),
-			zap.String("username", username),
+			zap.Int64("user_id", req.UserID),
+			zap.String("username", req.Username),
 			zap.Error(err),
 		)
 		return nil, fmt.Errorf("生成访问令牌失败: %w", err)
 	}
 
 	tokenPair := &TokenPair{
-		AccessToken: accessTokenString,
-		TokenType:   "Bearer",
-		ExpiresIn:   int64(j.config.ExpirationTime.Seconds()),
-		ExpiresAt:   now.Add(j.config.ExpirationTime),
+		AccessToken:   accessTokenString,
+		TokenType:     "Bearer",
+		ExpiresIn:     int64(j.config.ExpirationTime.Seconds()),
+		ExpiresAt:     now.Add(j.config.ExpirationTime),
+		AccessTokenID: accessClaims.ID,
 	}
 
 	// 生成刷新令牌（如果启用）
 	if j.config.EnableRefresh {
 		refreshClaims := &UserClaims{
-			UserID:   userID,
-			Username: username,
-			Email:    email,
+			UserID:    req.UserID,
+			Username:  req.Username,
+			Email:     req.Email,
+			TenantID:  req.TenantID,
+			SessionID: req.SessionID,
+			TokenType: TokenTypeRefresh,
 			RegisteredClaims: jwt.RegisteredClaims{
+				ID:        generateTokenID(),
 				Issuer:    j.config.Issuer,
-				Subject:   fmt.Sprintf("%d", userID),
+				Subject:   fmt.Sprintf("%d", req.UserID),
 				IssuedAt:  jwt.NewNumericDate(now),
 				ExpiresAt: jwt.NewNumericDate(now.Add(j.config.RefreshTime)),
 				NotBefore: jwt.NewNumericDate(now),
 			},
 		}
 
-		refreshToken := jwt.NewWithClaims(jwt.GetSigningMethod(j.config.Algorithm), refreshClaims)
-		refreshTokenString, err := refreshToken.SignedString([]byte(j.config.SecretKey))
+		refreshTokenString, err := j.sign(refreshClaims)
 		if err != nil {
 			j.logger.Error("生成刷新令牌失败",
-				zap.Int64("user_id", userID),
-				zap.String("username", username),
+				zap.Int64("user_id", req.UserID),
+				zap.String("username", req.Username),
 				zap.Error(err),
 			)
 			return nil, fmt.Errorf("生成刷新令牌失败: %w", err)
 		}
 
 		tokenPair.RefreshToken = refreshTokenString
+		tokenPair.RefreshTokenID = refreshClaims.ID
+		tokenPair.RefreshExpiresAt = now.Add(j.config.RefreshTime)
 	}
 
 	j.logger.Info("令牌生成成功",
-		zap.Int64("user_id", userID),
-		zap.String("username", username),
+		zap.Int64("user_id", req.UserID),
+		zap.String("username", req.Username),
+		zap.String("session_id", req.SessionID),
 		zap.Time("expires_at", tokenPair.ExpiresAt),
 		zap.Bool("has_refresh_token", tokenPair.RefreshToken != ""),
 	)
@@ -155,20 +242,11 @@ func (j *JWTManager) GenerateTokenPair(userID int64, username, email string, rol
 }
 
 // ValidateToken 验证令牌
-func (j *JWTManager) ValidateToken(tokenString string) (*UserClaims, error) {
-	// 检查黑名单
-	if j.config.EnableBlacklist && j.isTokenBlacklisted(tokenString) {
-		return nil, fmt.Errorf("令牌已被吊销")
-	}
-
-	// 解析令牌
-	token, err := jwt.ParseWithClaims(tokenString, &UserClaims{}, func(token *jwt.Token) (interface{}, error) {
-		// 验证签名算法
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("意外的签名算法: %v", token.Header["alg"])
-		}
-		return []byte(j.config.SecretKey), nil
-	})
+// 启用令牌吊销时，按 jti 吊销的令牌与签发时间不晚于用户吊销时间点的令牌均验证失败
+func (j *JWTManager) ValidateToken(ctx context.Context, tokenString string) (*UserClaims, error) {
+	// 解析令牌，记录验证签名的密钥所属的外部身份提供方
+	var provider *JWKSConfig
+	token, err := jwt.ParseWithClaims(tokenString, &UserClaims{}, j.keyFunc(ctx, &provider))
 
 	if err != nil {
 		j.logger.Warn("令牌验证失败",
@@ -183,6 +261,13 @@ func (j *JWTManager) ValidateToken(tokenString string) (*UserClaims, error) {
 		return nil, fmt.Errorf("令牌声明无效")
 	}
 
+	if provider != nil {
+		if err := mapExternalClaims(claims, provider); err != nil {
+			j.logger.Warn("外部令牌声明无效", zap.String("issuer", claims.Issuer), zap.Error(err))
+			return nil, err
+		}
+	}
+
 	// 验证令牌是否过期
 	if claims.ExpiresAt != nil && claims.ExpiresAt.Time.Before(time.Now()) {
 		j.logger.Warn("令牌已过期",
@@ -192,6 +277,13 @@ func (j *JWTManager) ValidateToken(tokenString string) (*UserClaims, error) {
 		return nil, fmt.Errorf("令牌已过期")
 	}
 
+	// 检查吊销记录
+	if j.config.EnableBlacklist {
+		if err := j.checkRevoked(ctx, claims); err != nil {
+			return nil, err
+		}
+	}
+
 	j.logger.Debug("令牌验证成功",
 		zap.Int64("user_id", claims.UserID),
 		zap.String("username", claims.Username),
@@ -201,39 +293,77 @@ func (j *JWTManager) ValidateToken(tokenString string) (*UserClaims, error) {
 	return claims, nil
 }
 
+// ValidateAccessToken 验证访问令牌，刷新令牌不能用于访问接口
+func (j *JWTManager) ValidateAccessToken(ctx context.Context, tokenString string) (*UserClaims, error) {
+	claims, err := j.ValidateToken(ctx, tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.TokenType != "" && claims.TokenType != TokenTypeAccess {
+		j.logger.Warn("令牌类型不是访问令牌",
+			zap.Int64("user_id", claims.UserID),
+			zap.String("token_type", claims.TokenType),
+		)
+		return nil, fmt.Errorf("令牌类型无效")
+	}
+	return claims, nil
+}
+
+// ValidateRefreshToken 验证刷新令牌，访问令牌不能用于刷新
+func (j *JWTManager) ValidateRefreshToken(ctx context.Context, tokenString string) (*UserClaims, error) {
+	claims, err := j.ValidateToken(ctx, tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.TokenType != TokenTypeRefresh {
+		j.logger.Warn("令牌类型不是刷新令牌",
+			zap.Int64("user_id", claims.UserID),
+			zap.String("token_type", claims.TokenType),
+		)
+		return nil, fmt.Errorf("令牌类型无效")
+	}
+	return claims, nil
+}
+
 // RefreshToken 刷新令牌
-func (j *JWTManager) RefreshToken(refreshTokenString string) (*TokenPair, error) {
+func (j *JWTManager) RefreshToken(ctx context.Context, refreshTokenString string) (*TokenPair, error) {
 	if !j.config.EnableRefresh {
 		return nil, fmt.Errorf("刷新令牌功能未启用")
 	}
 
 	// 验证刷新令牌
-	claims, err := j.ValidateToken(refreshTokenString)
+	claims, err := j.ValidateRefreshToken(ctx, refreshTokenString)
 	if err != nil {
 		return nil, fmt.Errorf("刷新令牌验证失败: %w", err)
 	}
 
-	// 将旧的刷新令牌加入黑名单
+	// 吊销旧的刷新令牌，保证每个刷新令牌只能使用一次
 	if j.config.EnableBlacklist {
-		j.blacklistToken(refreshTokenString, claims.ExpiresAt.Time)
+		if err := j.revoke(ctx, claims); err != nil {
+			return nil, err
+		}
 	}
 
-	// 生成新的令牌对
-	return j.GenerateTokenPair(claims.UserID, claims.Username, claims.Email, claims.Roles, claims.Permissions)
+	// 生成新的令牌对，沿用原令牌的会话
+	return j.IssueTokenPair(&TokenRequest{
+		UserID:    claims.UserID,
+		Username:  claims.Username,
+		Email:     claims.Email,
+		TenantID:  claims.TenantID,
+		SessionID: claims.SessionID,
+	})
 }
 
 // RevokeToken 吊销令牌
-func (j *JWTManager) RevokeToken(tokenString string) error {
+// 吊销记录按令牌的 jti 保存，保留到令牌原本的过期时间
+func (j *JWTManager) RevokeToken(ctx context.Context, tokenString string) error {
 	if !j.config.EnableBlacklist {
-		j.logger.Warn("令牌黑名单功能未启用，无法吊销令牌")
-		return fmt.Errorf("令牌黑名单功能未启用")
+		j.logger.Warn("令牌吊销功能未启用，无法吊销令牌")
+		return fmt.Errorf("令牌吊销功能未启用")
 	}
 
-	// 解析令牌获取过期时间
-	token, err := jwt.ParseWithClaims(tokenString, &UserClaims{}, func(token *jwt.Token) (interface{}, error) {
-		return []byte(j.config.SecretKey), nil
-	})
-
+	// 解析令牌获取 jti 与过期时间，已吊销或已过期的令牌同样可以再次吊销
+	token, err := jwt.ParseWithClaims(tokenString, &UserClaims{}, j.keyFunc(ctx, nil), jwt.WithoutClaimsValidation())
 	if err != nil {
 		return fmt.Errorf("解析令牌失败: %w", err)
 	}
@@ -243,28 +373,235 @@ func (j *JWTManager) RevokeToken(tokenString string) error {
 		return fmt.Errorf("令牌声明无效")
 	}
 
-	// 加入黑名单
-	j.blacklistToken(tokenString, claims.ExpiresAt.Time)
+	if err := j.revoke(ctx, claims); err != nil {
+		return err
+	}
 
 	j.logger.Info("令牌已吊销",
 		zap.Int64("user_id", claims.UserID),
 		zap.String("username", claims.Username),
+		zap.String("jti", claims.ID),
 	)
 
 	return nil
 }
 
+// RevokeUserTokens 吊销用户在指定时间及之前签发的全部令牌，用于强制下线
+// 令牌签发时间精确到秒，与吊销时间点同一秒内签发的令牌同样失效
+func (j *JWTManager) RevokeUserTokens(ctx context.Context, userID int64, before time.Time) error {
+	return j.RevokePrincipalTokens(ctx, strconv.FormatInt(userID, 10), before)
+}
+
+// RevokePrincipalTokens 按用户标识吊销指定时间及之前签发的全部令牌
+// 用户标识与 UserClaims.Principal 一致，外部身份提供方的用户为 iss|sub
+func (j *JWTManager) RevokePrincipalTokens(ctx context.Context, principal string, before time.Time) error {
+	if !j.config.EnableBlacklist {
+		j.logger.Warn("令牌吊销功能未启用，无法吊销用户令牌")
+		return fmt.Errorf("令牌吊销功能未启用")
+	}
+
+	// 吊销记录需要保留到该时间点前签发的令牌全部过期
+	ttl := j.config.ExpirationTime
+	if j.config.EnableRefresh && j.config.RefreshTime > ttl {
+		ttl = j.config.RefreshTime
+	}
+	ttl -= time.Since(before)
+	if ttl <= 0 {
+		return nil
+	}
+
+	if err := j.revocations.RevokeUserTokens(ctx, principal, before, ttl); err != nil {
+		j.logger.Error("吊销用户令牌失败", zap.String("principal", principal), zap.Error(err))
+		return fmt.Errorf("吊销用户令牌失败: %w", err)
+	}
+
+	j.logger.Info("用户令牌已吊销",
+		zap.String("principal", principal),
+		zap.Time("before", before),
+	)
+
+	return nil
+}
+
+// RevocationEnabled 返回是否启用了令牌吊销
+// 未启用时吊销令牌会返回错误，令牌只能等待自然过期
+func (j *JWTManager) RevocationEnabled() bool {
+	return j.config.EnableBlacklist
+}
+
+// RevokeTokenID 按 jti 吊销令牌，吊销记录保留到令牌原本的过期时间
+// 用于吊销只记录了 jti 的令牌，例如令牌族中签发过的访问令牌
+func (j *JWTManager) RevokeTokenID(ctx context.Context, jti string, expiresAt time.Time) error {
+	if !j.config.EnableBlacklist {
+		j.logger.Warn("令牌吊销功能未启用，无法吊销令牌")
+		return fmt.Errorf("令牌吊销功能未启用")
+	}
+	if jti == "" {
+		return fmt.Errorf("令牌缺少 jti，无法吊销")
+	}
+
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+	if err := j.revocations.RevokeToken(ctx, jti, ttl); err != nil {
+		j.logger.Error("吊销令牌失败", zap.String("jti", jti), zap.Error(err))
+		return fmt.Errorf("吊销令牌失败: %w", err)
+	}
+	return nil
+}
+
+// revoke 按 jti 吊销令牌，吊销记录保留到令牌过期
+func (j *JWTManager) revoke(ctx context.Context, claims *UserClaims) error {
+	if claims.ID == "" {
+		return fmt.Errorf("令牌缺少 jti，无法吊销")
+	}
+	if claims.ExpiresAt == nil {
+		return fmt.Errorf("令牌缺少过期时间，无法吊销")
+	}
+	return j.RevokeTokenID(ctx, claims.ID, claims.ExpiresAt.Time)
+}
+
+// checkRevoked 检查令牌是否已按 jti 或按用户吊销
+// 吊销存储不可用时拒绝令牌，避免已吊销的令牌在故障期间重新生效
+func (j *JWTManager) checkRevoked(ctx context.Context, claims *UserClaims) error {
+	if claims.ID != "" {
+		revoked, err := j.revocations.IsTokenRevoked(ctx, claims.ID)
+		if err != nil {
+			j.logger.Error("查询令牌吊销记录失败", zap.String("jti", claims.ID), zap.Error(err))
+			return fmt.Errorf("查询令牌吊销记录失败: %w", err)
+		}
+		if revoked {
+			return fmt.Errorf("令牌已被吊销")
+		}
+	}
+
+	before, err := j.revocations.UserTokensRevokedBefore(ctx, claims.Principal())
+	if err != nil {
+		j.logger.Error("查询用户令牌吊销记录失败", zap.String("principal", claims.Principal()), zap.Error(err))
+		return fmt.Errorf("查询用户令牌吊销记录失败: %w", err)
+	}
+	if !before.IsZero() && (claims.IssuedAt == nil || !claims.IssuedAt.Time.After(before.Truncate(time.Second))) {
+		return fmt.Errorf("令牌已被吊销")
+	}
+	return nil
+}
+
+// JWKS 返回本地签名密钥的公钥集合，供 /.well-known/jwks.json 发布
+func (j *JWTManager) JWKS() *JWKSet {
+	return j.keys.JWKS()
+}
+
+// sign 签名令牌
+// 配置了非对称密钥时使用当前生效的密钥并在令牌头写入 kid，否则使用共享密钥
+func (j *JWTManager) sign(claims *UserClaims) (string, error) {
+	if len(j.config.Keys) == 0 {
+		if j.config.SecretKey == "" {
+			return "", fmt.Errorf("未配置JWT签名密钥")
+		}
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(j.config.SecretKey))
+	}
+
+	key, err := j.keys.SigningKey()
+	if err != nil {
+		return "", err
+	}
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(key.Algorithm), claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.Private)
+}
+
+// keyFunc 返回验证令牌签名的密钥
+// HS256 令牌使用共享密钥；非对称算法的令牌按 kid 查找密钥，令牌算法必须与密钥一致，
+// 来自外部身份提供方的密钥还要求令牌的签发者一致、受众包含配置的受众。
+// provider 非空时写入密钥所属的外部身份提供方
+func (j *JWTManager) keyFunc(ctx context.Context, provider **JWKSConfig) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		alg := token.Method.Alg()
+		if alg == AlgorithmHS256 {
+			if j.config.SecretKey == "" {
+				return nil, fmt.Errorf("意外的签名算法: %s", alg)
+			}
+			return []byte(j.config.SecretKey), nil
+		}
+
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" {
+			return nil, fmt.Errorf("令牌缺少 kid")
+		}
+		key, err := j.keys.VerificationKey(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		if key.Algorithm != alg {
+			return nil, fmt.Errorf("意外的签名算法: %s", alg)
+		}
+		if key.Provider != nil {
+			claims, ok := token.Claims.(*UserClaims)
+			if !ok || claims.Issuer != key.Provider.Issuer {
+				return nil, fmt.Errorf("令牌签发者不受信任")
+			}
+			if !slices.Contains(claims.Audience, key.Provider.Audience) {
+				return nil, fmt.Errorf("令牌受众不匹配")
+			}
+			if provider != nil {
+				*provider = key.Provider
+			}
+		}
+		return key.Public, nil
+	}
+}
+
+// mapExternalClaims 将外部身份提供方签发的令牌声明映射为本地声明
+// 用户名固定为 iss|sub，不与本地用户或其他身份提供方的用户混淆；令牌中的用户ID与会话被忽略，
+// 角色按配置映射为本地角色，权限只保留配置中允许的部分
+func mapExternalClaims(claims *UserClaims, provider *JWKSConfig) error {
+	if claims.Subject == "" {
+		return fmt.Errorf("令牌缺少 sub")
+	}
+	if claims.TokenType != "" && claims.TokenType != TokenTypeAccess {
+		return fmt.Errorf("令牌类型无效")
+	}
+
+	claims.UserID = 0
+	claims.Username = claims.Issuer + "|" + claims.Subject
+	claims.SessionID = ""
+
+	roles := make([]string, 0, len(claims.Roles))
+	for _, role := range claims.Roles {
+		if local, ok := provider.Roles[role]; ok && !slices.Contains(roles, local) {
+			roles = append(roles, local)
+		}
+	}
+	claims.Roles = roles
+
+	permissions := make([]string, 0, len(claims.Permissions))
+	for _, permission := range claims.Permissions {
+		if slices.Contains(provider.Permissions, permission) {
+			permissions = append(permissions, permission)
+		}
+	}
+	claims.Permissions = permissions
+	return nil
+}
+
 // HasPermission 检查权限
 func (j *JWTManager) HasPermission(claims *UserClaims, requiredPermission string) bool {
+	return MatchPermission(claims.Roles, claims.Permissions, requiredPermission)
+}
+
+// MatchPermission 检查角色与权限是否包含所需权限
+// 管理员角色拥有全部权限，权限支持 * 与前缀通配；令牌声明与业务层的调用方身份使用同一规则
+func MatchPermission(roles []string, permissions []string, requiredPermission string) bool {
 	// 检查角色权限
-	for _, role := range claims.Roles {
+	for _, role := range roles {
 		if role == "admin" || role == "super_admin" {
 			return true // 管理员拥有所有权限
 		}
 	}
 
 	// 检查具体权限
-	for _, permission := range claims.Permissions {
+	for _, permission := range permissions {
 		if permission == requiredPermission || permission == "*" {
 			return true
 		}
@@ -305,56 +642,11 @@ func (j *JWTManager) ExtractTokenFromHeader(authHeader string) (string, error) {
 	return parts[1], nil
 }
 
-// blacklistToken 将令牌加入黑名单
-func (j *JWTManager) blacklistToken(tokenString string, expiresAt time.Time) {
-	j.blacklist[tokenString] = expiresAt
-
-	// 清理过期的黑名单条目
-	j.cleanupBlacklist()
-}
-
-// isTokenBlacklisted 检查令牌是否在黑名单中
-func (j *JWTManager) isTokenBlacklisted(tokenString string) bool {
-	expiresAt, exists := j.blacklist[tokenString]
-	if !exists {
-		return false
-	}
-
-	// 如果令牌已过期，从黑名单中移除
-	if time.Now().After(expiresAt) {
-		delete(j.blacklist, tokenString)
-		return false
-	}
-
-	return true
-}
-
-// cleanupBlacklist 清理过期的黑名单条目
-func (j *JWTManager) cleanupBlacklist() {
-	now := time.Now()
-	for token, expiresAt := range j.blacklist {
-		if now.After(expiresAt) {
-			delete(j.blacklist, token)
-		}
-	}
-}
-
-// GetBlacklistStats 获取黑名单统计信息
-func (j *JWTManager) GetBlacklistStats() map[string]interface{} {
-	j.cleanupBlacklist()
-
-	return map[string]interface{}{
-		"total_blacklisted": len(j.blacklist),
-		"enabled":           j.config.EnableBlacklist,
-	}
-}
-
-// generateRandomSecret 生成随机密钥
-func generateRandomSecret() string {
-	bytes := make([]byte, 32)
+// generateTokenID 生成令牌的 jti
+func generateTokenID() string {
+	bytes := make([]byte, 16)
 	if _, err := rand.Read(bytes); err != nil {
-		// 如果随机数生成失败，使用固定的默认值
-		return "workflow-engine-default-secret-key-please-change-in-production"
+		return fmt.Sprintf("%d", time.Now().UnixNano())
 	}
 	return hex.EncodeToString(bytes)
 }
@@ -363,7 +655,7 @@ func generateRandomSecret() string {
 var (
 	// DefaultJWTConfig 默认JWT配置
 	DefaultJWTConfig = &JWTConfig{
-		SecretKey:       "", // 将自动生成
+		SecretKey:       "", // 必须配置共享密钥或签名密钥
 		Issuer:          "workflow-engine",
 		ExpirationTime:  24 * time.Hour,
 		RefreshTime:     7 * 24 * time.Hour,
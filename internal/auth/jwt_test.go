@@ -0,0 +1,178 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// failingRevocationStore 总是返回错误的吊销存储
+type failingRevocationStore struct {
+	*MemoryRevocationStore
+}
+
+func (failingRevocationStore) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	return false, errors.New("redis: connection refused")
+}
+
+func newTestJWTManager(store RevocationStore) *JWTManager {
+	manager, err := NewJWTManager(&JWTConfig{
+		SecretKey:       "test-secret",
+		Issuer:          "workflow-engine-test",
+		EnableRefresh:   true,
+		EnableBlacklist: true,
+	}, store, zap.NewNop())
+	if err != nil {
+		panic(err)
+	}
+	return manager
+}
+
+// TestJWTManager_RevokeToken 测试按 jti 吊销令牌
+func TestJWTManager_RevokeToken(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryRevocationStore()
+	manager := newTestJWTManager(store)
+
+	pair, err := manager.GenerateTokenPair(1, "alice", "alice@example.com", []string{"user"}, nil)
+	require.NoError(t, err, "生成令牌失败")
+	other, err := manager.GenerateTokenPair(1, "alice", "alice@example.com", []string{"user"}, nil)
+	require.NoError(t, err, "生成令牌失败")
+
+	claims, err := manager.ValidateToken(ctx, pair.AccessToken)
+	require.NoError(t, err, "未吊销的令牌应该验证通过")
+	assert.NotEmpty(t, claims.ID, "令牌应该携带 jti")
+
+	require.NoError(t, manager.RevokeToken(ctx, pair.AccessToken), "吊销令牌失败")
+	_, err = manager.ValidateToken(ctx, pair.AccessToken)
+	assert.Error(t, err, "已吊销的令牌应该验证失败")
+	_, err = manager.ValidateToken(ctx, other.AccessToken)
+	assert.NoError(t, err, "吊销一个令牌不应该影响同一用户的其他令牌")
+
+	require.NoError(t, manager.RevokeToken(ctx, pair.AccessToken), "重复吊销不应该返回错误")
+	assert.Equal(t, 1, store.Len(), "吊销记录应该按 jti 去重")
+}
+
+// TestJWTManager_RefreshTokenIsSingleUse 测试刷新令牌只能使用一次
+func TestJWTManager_RefreshTokenIsSingleUse(t *testing.T) {
+	ctx := context.Background()
+	manager := newTestJWTManager(nil)
+
+	pair, err := manager.GenerateTokenPair(1, "alice", "alice@example.com", []string{"user"}, nil)
+	require.NoError(t, err, "生成令牌失败")
+
+	refreshed, err := manager.RefreshToken(ctx, pair.RefreshToken)
+	require.NoError(t, err, "刷新令牌失败")
+	assert.NotEmpty(t, refreshed.AccessToken, "应该返回新的访问令牌")
+
+	_, err = manager.RefreshToken(ctx, pair.RefreshToken)
+	assert.Error(t, err, "已使用的刷新令牌不能再次刷新")
+}
+
+// TestJWTManager_TokenTypes 测试访问令牌与刷新令牌不能互换使用
+func TestJWTManager_TokenTypes(t *testing.T) {
+	ctx := context.Background()
+	manager := newTestJWTManager(nil)
+	pair, err := manager.IssueTokenPair(&TokenRequest{UserID: 1, Username: "alice", SessionID: "family-1"})
+	require.NoError(t, err, "签发令牌不应该返回错误")
+
+	claims, err := manager.ValidateAccessToken(ctx, pair.AccessToken)
+	require.NoError(t, err, "访问令牌应该有效")
+	assert.Equal(t, "family-1", claims.SessionID, "访问令牌应该携带会话ID")
+	assert.Equal(t, pair.AccessTokenID, claims.ID, "应该返回访问令牌的 jti")
+
+	claims, err = manager.ValidateRefreshToken(ctx, pair.RefreshToken)
+	require.NoError(t, err, "刷新令牌应该有效")
+	assert.Equal(t, pair.RefreshTokenID, claims.ID, "应该返回刷新令牌的 jti")
+
+	_, err = manager.ValidateAccessToken(ctx, pair.RefreshToken)
+	assert.Error(t, err, "刷新令牌不能用于访问接口")
+	_, err = manager.ValidateRefreshToken(ctx, pair.AccessToken)
+	assert.Error(t, err, "访问令牌不能用于刷新")
+}
+
+// TestJWTManager_RevokeUserTokens 测试强制下线吊销用户之前签发的令牌
+func TestJWTManager_RevokeUserTokens(t *testing.T) {
+	ctx := context.Background()
+	manager := newTestJWTManager(nil)
+
+	alice, err := manager.GenerateTokenPair(1, "alice", "", nil, nil)
+	require.NoError(t, err, "生成令牌失败")
+	bob, err := manager.GenerateTokenPair(2, "bob", "", nil, nil)
+	require.NoError(t, err, "生成令牌失败")
+
+	require.NoError(t, manager.RevokeUserTokens(ctx, 1, time.Now()), "吊销用户令牌失败")
+
+	_, err = manager.ValidateToken(ctx, alice.AccessToken)
+	assert.Error(t, err, "强制下线前签发的访问令牌应该失效")
+	_, err = manager.RefreshToken(ctx, alice.RefreshToken)
+	assert.Error(t, err, "强制下线前签发的刷新令牌应该失效")
+	_, err = manager.ValidateToken(ctx, bob.AccessToken)
+	assert.NoError(t, err, "其他用户的令牌不受影响")
+
+	// 吊销时间点之后签发的令牌有效；令牌签发时间精确到秒，等到下一秒再签发
+	require.NoError(t, manager.RevokeUserTokens(ctx, 1, time.Now().Add(-2*time.Second)), "较早的时间点不应该覆盖已有记录")
+	time.Sleep(time.Until(time.Now().Truncate(time.Second).Add(time.Second)))
+	fresh, err := manager.GenerateTokenPair(1, "alice", "", nil, nil)
+	require.NoError(t, err, "生成令牌失败")
+	_, err = manager.ValidateToken(ctx, fresh.AccessToken)
+	assert.NoError(t, err, "强制下线后重新签发的令牌应该有效")
+	_, err = manager.ValidateToken(ctx, alice.AccessToken)
+	assert.Error(t, err, "较早的时间点不应该让已吊销的令牌重新生效")
+}
+
+// TestJWTManager_RevocationStoreUnavailable 测试吊销存储不可用时拒绝令牌
+func TestJWTManager_RevocationStoreUnavailable(t *testing.T) {
+	manager := newTestJWTManager(failingRevocationStore{NewMemoryRevocationStore()})
+
+	pair, err := manager.GenerateTokenPair(1, "alice", "", nil, nil)
+	require.NoError(t, err, "生成令牌失败")
+
+	_, err = manager.ValidateToken(context.Background(), pair.AccessToken)
+	assert.Error(t, err, "无法确认吊销状态时应该拒绝令牌")
+}
+
+// TestMemoryRevocationStore 测试进程内吊销存储的过期与并发访问
+func TestMemoryRevocationStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryRevocationStore()
+	now := time.Now()
+	store.now = func() time.Time { return now }
+
+	require.NoError(t, store.RevokeToken(ctx, "jti-1", time.Minute))
+	require.NoError(t, store.RevokeUserTokens(ctx, "1", now, time.Hour))
+
+	revoked, err := store.IsTokenRevoked(ctx, "jti-1")
+	require.NoError(t, err)
+	assert.True(t, revoked, "有效期内的令牌应该处于吊销状态")
+
+	now = now.Add(2 * time.Minute)
+	revoked, err = store.IsTokenRevoked(ctx, "jti-1")
+	require.NoError(t, err)
+	assert.False(t, revoked, "令牌过期后吊销记录应该失效")
+	before, err := store.UserTokensRevokedBefore(ctx, "1")
+	require.NoError(t, err)
+	assert.False(t, before.IsZero(), "用户吊销记录应该在保留期内有效")
+	assert.Equal(t, 1, store.Len(), "过期的吊销记录应该被清理")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			jti := fmt.Sprintf("jti-%d", i)
+			assert.NoError(t, store.RevokeToken(ctx, jti, time.Minute))
+			_, err := store.IsTokenRevoked(ctx, jti)
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, 51, store.Len(), "并发吊销的记录应该全部保存")
+}
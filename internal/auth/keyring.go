@@ -0,0 +1,496 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// 签名算法
+const (
+	AlgorithmHS256 = "HS256" // HMAC-SHA256，共享密钥
+	AlgorithmRS256 = "RS256" // RSA PKCS#1 v1.5 + SHA256
+	AlgorithmES256 = "ES256" // ECDSA P-256 + SHA256
+	AlgorithmEdDSA = "EdDSA" // Ed25519
+)
+
+// 外部 JWKS 的默认刷新间隔与未知 kid 触发刷新的最小间隔
+const (
+	defaultJWKSRefreshInterval = time.Hour
+	minJWKSRefreshInterval     = time.Minute
+	jwksFetchTimeout           = 10 * time.Second
+)
+
+// ErrKeyNotFound 没有与令牌 kid 匹配的有效密钥
+var ErrKeyNotFound = errors.New("未找到令牌签名密钥")
+
+// KeyConfig 签名密钥配置
+// 轮换密钥时先加入 not_before 在未来的新密钥，使其提前出现在 JWKS 中；
+// 新密钥生效后开始用于签名，旧密钥继续验证已签发的令牌直到 not_after
+type KeyConfig struct {
+	ID             string    `yaml:"id"`               // 密钥ID，写入令牌头的 kid
+	Algorithm      string    `yaml:"algorithm"`        // 签名算法，为空时按密钥类型推断
+	PrivateKeyFile string    `yaml:"private_key_file"` // PEM 私钥文件，未配置时密钥只用于验证
+	PublicKeyFile  string    `yaml:"public_key_file"`  // PEM 公钥文件，配置私钥时可省略
+	NotBefore      time.Time `yaml:"not_before"`       // 开始用于签名的时间，零值表示立即生效
+	NotAfter       time.Time `yaml:"not_after"`        // 停止验证的时间，零值表示不过期
+}
+
+// JWKSConfig 外部身份提供方的 JWKS 配置，文件与地址二选一
+// 外部令牌的角色与权限只保留配置中允许的部分，未配置时外部用户没有任何角色与权限
+type JWKSConfig struct {
+	Issuer          string            `yaml:"issuer"`           // 签发者，令牌的 iss 必须一致
+	Audience        string            `yaml:"audience"`         // 受众，令牌的 aud 必须包含该值
+	File            string            `yaml:"file"`             // JWKS 文件路径
+	URL             string            `yaml:"url"`              // JWKS 地址
+	RefreshInterval time.Duration     `yaml:"refresh_interval"` // 从地址刷新的间隔，默认 1 小时
+	Roles           map[string]string `yaml:"roles"`            // 外部角色到本地角色的映射，未列出的角色被忽略
+	Permissions     []string          `yaml:"permissions"`      // 接受的外部权限，未列出的权限被忽略
+}
+
+// Key 令牌签名或验证密钥
+type Key struct {
+	ID        string
+	Algorithm string
+	Private   crypto.Signer    // 私钥，只用于验证的密钥为空
+	Public    crypto.PublicKey // 公钥
+	Provider  *JWKSConfig      // 密钥所属的外部身份提供方，本地密钥为空
+	NotBefore time.Time
+	NotAfter  time.Time
+}
+
+// LoadKey 从 PEM 文件加载密钥
+func LoadKey(cfg KeyConfig) (*Key, error) {
+	if cfg.ID == "" {
+		return nil, fmt.Errorf("密钥ID不能为空")
+	}
+	if cfg.PrivateKeyFile == "" && cfg.PublicKeyFile == "" {
+		return nil, fmt.Errorf("密钥 %s 未配置私钥或公钥文件", cfg.ID)
+	}
+	if !cfg.NotAfter.IsZero() && !cfg.NotAfter.After(cfg.NotBefore) {
+		return nil, fmt.Errorf("密钥 %s 的 not_after 必须晚于 not_before", cfg.ID)
+	}
+
+	key := &Key{ID: cfg.ID, NotBefore: cfg.NotBefore, NotAfter: cfg.NotAfter}
+	if cfg.PrivateKeyFile != "" {
+		data, err := os.ReadFile(cfg.PrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("读取密钥 %s 的私钥文件失败: %w", cfg.ID, err)
+		}
+		private, err := parsePrivateKeyPEM(data)
+		if err != nil {
+			return nil, fmt.Errorf("解析密钥 %s 的私钥失败: %w", cfg.ID, err)
+		}
+		key.Private = private
+		key.Public = private.Public()
+	} else {
+		data, err := os.ReadFile(cfg.PublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("读取密钥 %s 的公钥文件失败: %w", cfg.ID, err)
+		}
+		public, err := parsePublicKeyPEM(data)
+		if err != nil {
+			return nil, fmt.Errorf("解析密钥 %s 的公钥失败: %w", cfg.ID, err)
+		}
+		key.Public = public
+	}
+
+	algorithm, err := keyAlgorithm(key.Public, cfg.Algorithm)
+	if err != nil {
+		return nil, fmt.Errorf("密钥 %s: %w", cfg.ID, err)
+	}
+	key.Algorithm = algorithm
+	return key, nil
+}
+
+// canSign 密钥在指定时间能否用于签名
+func (k *Key) canSign(now time.Time) bool {
+	return k.Private != nil && !now.Before(k.NotBefore) && k.canVerify(now)
+}
+
+// canVerify 密钥在指定时间能否用于验证
+func (k *Key) canVerify(now time.Time) bool {
+	return k.NotAfter.IsZero() || now.Before(k.NotAfter)
+}
+
+// Keyring 非对称签名密钥环
+// 本地密钥用于签名与验证，外部 JWKS 中的密钥只用于验证
+type Keyring struct {
+	keys    []*Key
+	sources []*jwksSource
+	now     func() time.Time
+}
+
+// NewKeyring 根据密钥与外部 JWKS 配置创建密钥环，JWKS 文件在创建时加载
+func NewKeyring(keys []*Key, jwks []JWKSConfig) (*Keyring, error) {
+	ids := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if ids[key.ID] {
+			return nil, fmt.Errorf("密钥ID重复: %s", key.ID)
+		}
+		ids[key.ID] = true
+	}
+
+	k := &Keyring{keys: keys, now: time.Now}
+	for _, cfg := range jwks {
+		source, err := newJWKSSource(cfg)
+		if err != nil {
+			return nil, err
+		}
+		k.sources = append(k.sources, source)
+	}
+	return k, nil
+}
+
+// SigningKey 返回当前用于签名的密钥，即已生效的密钥中 not_before 最晚的一个
+func (k *Keyring) SigningKey() (*Key, error) {
+	now := k.now()
+	var current *Key
+	for _, key := range k.keys {
+		if key.canSign(now) && (current == nil || key.NotBefore.After(current.NotBefore)) {
+			current = key
+		}
+	}
+	if current == nil {
+		return nil, fmt.Errorf("没有可用于签名的密钥")
+	}
+	return current, nil
+}
+
+// VerificationKey 按 kid 查找验证密钥，本地密钥优先，其次是外部 JWKS
+func (k *Keyring) VerificationKey(ctx context.Context, kid string) (*Key, error) {
+	now := k.now()
+	for _, key := range k.keys {
+		if key.ID == kid {
+			if !key.canVerify(now) {
+				return nil, fmt.Errorf("密钥 %s 已过期", kid)
+			}
+			return key, nil
+		}
+	}
+	for _, source := range k.sources {
+		key, err := source.lookup(ctx, kid)
+		if err == nil {
+			return key, nil
+		}
+		if !errors.Is(err, ErrKeyNotFound) {
+			return nil, err
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, kid)
+}
+
+// JWKS 返回本地密钥中尚未过期的公钥，包括尚未开始签名的新密钥
+func (k *Keyring) JWKS() *JWKSet {
+	now := k.now()
+	set := &JWKSet{Keys: []JWK{}}
+	for _, key := range k.keys {
+		if !key.canVerify(now) {
+			continue
+		}
+		jwk, err := newJWK(key)
+		if err != nil {
+			continue
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set
+}
+
+// JWKSet JSON Web Key Set
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWK JSON Web Key 公钥
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid,omitempty"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	N   string `json:"n,omitempty"`   // RSA 模数
+	E   string `json:"e,omitempty"`   // RSA 指数
+	Crv string `json:"crv,omitempty"` // 曲线
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// newJWK 将密钥的公钥编码为 JWK
+func newJWK(key *Key) (JWK, error) {
+	jwk := JWK{Kid: key.ID, Use: "sig", Alg: key.Algorithm}
+	encode := base64.RawURLEncoding.EncodeToString
+	switch pub := key.Public.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = encode(pub.N.Bytes())
+		jwk.E = encode(big.NewInt(int64(pub.E)).Bytes())
+	case *ecdsa.PublicKey:
+		if pub.Curve != elliptic.P256() {
+			return JWK{}, fmt.Errorf("不支持的椭圆曲线: %s", pub.Curve.Params().Name)
+		}
+		jwk.Kty = "EC"
+		jwk.Crv = "P-256"
+		jwk.X = encode(pub.X.FillBytes(make([]byte, 32)))
+		jwk.Y = encode(pub.Y.FillBytes(make([]byte, 32)))
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = encode(pub)
+	default:
+		return JWK{}, fmt.Errorf("不支持的公钥类型: %T", key.Public)
+	}
+	return jwk, nil
+}
+
+// key 将 JWK 解码为验证密钥
+func (j JWK) key(provider *JWKSConfig) (*Key, error) {
+	decode := base64.RawURLEncoding.DecodeString
+	var public crypto.PublicKey
+	switch j.Kty {
+	case "RSA":
+		n, err := decode(j.N)
+		if err != nil {
+			return nil, fmt.Errorf("RSA 模数格式错误: %w", err)
+		}
+		e, err := decode(j.E)
+		if err != nil {
+			return nil, fmt.Errorf("RSA 指数格式错误: %w", err)
+		}
+		public = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	case "EC":
+		if j.Crv != "P-256" {
+			return nil, fmt.Errorf("不支持的椭圆曲线: %s", j.Crv)
+		}
+		x, err := decode(j.X)
+		if err != nil {
+			return nil, fmt.Errorf("EC 坐标格式错误: %w", err)
+		}
+		y, err := decode(j.Y)
+		if err != nil {
+			return nil, fmt.Errorf("EC 坐标格式错误: %w", err)
+		}
+		pub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !pub.Curve.IsOnCurve(pub.X, pub.Y) {
+			return nil, fmt.Errorf("EC 公钥不在曲线上")
+		}
+		public = pub
+	case "OKP":
+		if j.Crv != "Ed25519" {
+			return nil, fmt.Errorf("不支持的曲线: %s", j.Crv)
+		}
+		x, err := decode(j.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("Ed25519 公钥格式错误")
+		}
+		public = ed25519.PublicKey(x)
+	default:
+		return nil, fmt.Errorf("不支持的密钥类型: %s", j.Kty)
+	}
+
+	algorithm, err := keyAlgorithm(public, j.Alg)
+	if err != nil {
+		return nil, err
+	}
+	return &Key{ID: j.Kid, Algorithm: algorithm, Public: public, Provider: provider}, nil
+}
+
+// jwksSource 外部身份提供方的 JWKS
+// 从文件加载的密钥在创建时读取一次；从地址加载的密钥按刷新间隔更新，
+// 遇到未知 kid 时提前刷新，但两次刷新至少间隔 minJWKSRefreshInterval
+type jwksSource struct {
+	cfg    JWKSConfig
+	client *http.Client
+	now    func() time.Time
+
+	mu        sync.Mutex
+	keys      map[string]*Key
+	fetchedAt time.Time
+}
+
+// newJWKSSource 创建外部 JWKS
+func newJWKSSource(cfg JWKSConfig) (*jwksSource, error) {
+	if (cfg.File == "") == (cfg.URL == "") {
+		return nil, fmt.Errorf("外部 JWKS 必须配置文件或地址其中之一")
+	}
+	if cfg.Issuer == "" || cfg.Audience == "" {
+		return nil, fmt.Errorf("外部 JWKS 必须配置签发者与受众")
+	}
+	if cfg.RefreshInterval <= 0 {
+		cfg.RefreshInterval = defaultJWKSRefreshInterval
+	}
+
+	s := &jwksSource{
+		cfg:    cfg,
+		client: &http.Client{Timeout: jwksFetchTimeout},
+		now:    time.Now,
+		keys:   make(map[string]*Key),
+	}
+	if cfg.File != "" {
+		data, err := os.ReadFile(cfg.File)
+		if err != nil {
+			return nil, fmt.Errorf("读取 JWKS 文件失败: %w", err)
+		}
+		keys, err := parseJWKS(data, &s.cfg)
+		if err != nil {
+			return nil, fmt.Errorf("解析 JWKS 文件 %s 失败: %w", cfg.File, err)
+		}
+		s.keys = keys
+	}
+	return s, nil
+}
+
+// lookup 按 kid 查找密钥，必要时从地址刷新
+func (s *jwksSource) lookup(ctx context.Context, kid string) (*Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.keys[kid]
+	if s.cfg.URL != "" {
+		since := s.now().Sub(s.fetchedAt)
+		if s.fetchedAt.IsZero() || since >= s.cfg.RefreshInterval || (!ok && since >= minJWKSRefreshInterval) {
+			if err := s.refresh(ctx); err != nil && len(s.keys) == 0 {
+				return nil, err
+			}
+			key, ok = s.keys[kid]
+		}
+	}
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return key, nil
+}
+
+// refresh 从地址重新加载 JWKS，失败时保留已有密钥，调用方需持有锁
+func (s *jwksSource) refresh(ctx context.Context) error {
+	s.fetchedAt = s.now()
+
+	ctx, cancel := context.WithTimeout(ctx, jwksFetchTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.cfg.URL, nil)
+	if err != nil {
+		return fmt.Errorf("创建 JWKS 请求失败: %w", err)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("获取 JWKS 失败: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("获取 JWKS 失败: HTTP %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("读取 JWKS 失败: %w", err)
+	}
+	keys, err := parseJWKS(data, &s.cfg)
+	if err != nil {
+		return fmt.Errorf("解析 JWKS 失败: %w", err)
+	}
+	s.keys = keys
+	return nil
+}
+
+// parseJWKS 解析 JWKS 文档，跳过非签名用途与不支持的密钥
+func parseJWKS(data []byte, provider *JWKSConfig) (map[string]*Key, error) {
+	var set JWKSet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]*Key, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Kid == "" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+		key, err := jwk.key(provider)
+		if err != nil {
+			continue
+		}
+		keys[key.ID] = key
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("JWKS 中没有可用的签名密钥")
+	}
+	return keys, nil
+}
+
+// keyAlgorithm 校验公钥与签名算法是否匹配，算法为空时按公钥类型推断
+func keyAlgorithm(public crypto.PublicKey, algorithm string) (string, error) {
+	var expected string
+	switch pub := public.(type) {
+	case *rsa.PublicKey:
+		if pub.N.BitLen() < 2048 {
+			return "", fmt.Errorf("RSA 密钥长度不能小于 2048 位")
+		}
+		expected = AlgorithmRS256
+	case *ecdsa.PublicKey:
+		if pub.Curve != elliptic.P256() {
+			return "", fmt.Errorf("ES256 只支持 P-256 曲线")
+		}
+		expected = AlgorithmES256
+	case ed25519.PublicKey:
+		expected = AlgorithmEdDSA
+	default:
+		return "", fmt.Errorf("不支持的密钥类型: %T", public)
+	}
+	if algorithm != "" && algorithm != expected {
+		return "", fmt.Errorf("签名算法 %s 与密钥类型不匹配，应为 %s", algorithm, expected)
+	}
+	return expected, nil
+}
+
+// parsePrivateKeyPEM 解析 PKCS#8、PKCS#1 或 SEC 1 格式的 PEM 私钥
+func parsePrivateKeyPEM(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("不是有效的 PEM 数据")
+	}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("不支持的私钥类型: %T", key)
+	}
+	return signer, nil
+}
+
+// parsePublicKeyPEM 解析 PKIX 格式的 PEM 公钥或证书
+func parsePublicKeyPEM(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("不是有效的 PEM 数据")
+	}
+	switch block.Type {
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return cert.PublicKey, nil
+	case "RSA PUBLIC KEY":
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
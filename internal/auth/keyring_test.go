@@ -0,0 +1,322 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// writePrivateKey 将私钥以 PKCS#8 PEM 写入临时文件并返回路径
+func writePrivateKey(t *testing.T, key crypto.Signer) string {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err, "编码私钥失败")
+	path := filepath.Join(t.TempDir(), "key.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+	return path
+}
+
+func newRSAKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err, "生成 RSA 密钥失败")
+	return key
+}
+
+func newKeyManager(t *testing.T, config *JWTConfig) *JWTManager {
+	t.Helper()
+	config.Issuer = "workflow-engine-test"
+	manager, err := NewJWTManager(config, nil, zap.NewNop())
+	require.NoError(t, err, "创建JWT管理器失败")
+	return manager
+}
+
+// TestJWTManager_AsymmetricAlgorithms 测试各非对称算法签名、验证与 JWKS 发布
+func TestJWTManager_AsymmetricAlgorithms(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	cases := []struct {
+		algorithm string
+		key       crypto.Signer
+		kty       string
+	}{
+		{AlgorithmRS256, newRSAKey(t), "RSA"},
+		{AlgorithmES256, ecKey, "EC"},
+		{AlgorithmEdDSA, edKey, "OKP"},
+	}
+	for _, c := range cases {
+		t.Run(c.algorithm, func(t *testing.T) {
+			manager := newKeyManager(t, &JWTConfig{
+				Keys: []KeyConfig{{ID: "k1", PrivateKeyFile: writePrivateKey(t, c.key)}},
+			})
+
+			pair, err := manager.GenerateTokenPair(1, "alice", "", nil, nil)
+			require.NoError(t, err, "签发令牌失败")
+			token, _, err := jwt.NewParser().ParseUnverified(pair.AccessToken, &UserClaims{})
+			require.NoError(t, err)
+			assert.Equal(t, c.algorithm, token.Method.Alg(), "签名算法应该按密钥类型推断")
+			assert.Equal(t, "k1", token.Header["kid"], "令牌头应该携带 kid")
+
+			claims, err := manager.ValidateToken(context.Background(), pair.AccessToken)
+			require.NoError(t, err, "验证令牌失败")
+			assert.Equal(t, "alice", claims.Username)
+
+			jwks := manager.JWKS()
+			require.Len(t, jwks.Keys, 1, "JWKS 应该发布签名公钥")
+			assert.Equal(t, c.kty, jwks.Keys[0].Kty)
+			assert.Equal(t, c.algorithm, jwks.Keys[0].Alg)
+
+			// 发布的 JWK 应该能还原出同一个公钥
+			key, err := jwks.Keys[0].key(nil)
+			require.NoError(t, err, "解析 JWK 失败")
+			assert.True(t, c.key.Public().(interface{ Equal(crypto.PublicKey) bool }).Equal(key.Public), "JWK 公钥应该与私钥匹配")
+		})
+	}
+}
+
+// TestJWTManager_KeyRotation 测试密钥轮换期间新旧密钥并存
+func TestJWTManager_KeyRotation(t *testing.T) {
+	now := time.Now()
+	manager := newKeyManager(t, &JWTConfig{
+		Keys: []KeyConfig{
+			{ID: "old", PrivateKeyFile: writePrivateKey(t, newRSAKey(t)), NotAfter: now.Add(2 * time.Hour)},
+			{ID: "new", PrivateKeyFile: writePrivateKey(t, newRSAKey(t)), NotBefore: now.Add(time.Hour)},
+		},
+	})
+	ctx := context.Background()
+	kid := func(tokenString string) interface{} {
+		token, _, err := jwt.NewParser().ParseUnverified(tokenString, &UserClaims{})
+		require.NoError(t, err)
+		return token.Header["kid"]
+	}
+
+	// 新密钥生效前仍由旧密钥签名，但新密钥已提前发布
+	oldPair, err := manager.GenerateTokenPair(1, "alice", "", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "old", kid(oldPair.AccessToken), "新密钥生效前应该使用旧密钥签名")
+	assert.Len(t, manager.JWKS().Keys, 2, "新密钥应该提前出现在 JWKS 中")
+
+	// 新密钥生效后用新密钥签名，旧密钥签发的令牌仍然有效
+	manager.keys.now = func() time.Time { return now.Add(90 * time.Minute) }
+	newPair, err := manager.GenerateTokenPair(1, "alice", "", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "new", kid(newPair.AccessToken), "新密钥生效后应该使用新密钥签名")
+	_, err = manager.ValidateToken(ctx, oldPair.AccessToken)
+	assert.NoError(t, err, "重叠期内旧密钥签发的令牌应该有效")
+
+	// 旧密钥过期后不再验证，也不再发布
+	manager.keys.now = func() time.Time { return now.Add(3 * time.Hour) }
+	_, err = manager.ValidateToken(ctx, oldPair.AccessToken)
+	assert.Error(t, err, "旧密钥过期后其签发的令牌应该失效")
+	_, err = manager.ValidateToken(ctx, newPair.AccessToken)
+	assert.NoError(t, err)
+	jwks := manager.JWKS()
+	require.Len(t, jwks.Keys, 1)
+	assert.Equal(t, "new", jwks.Keys[0].Kid, "过期的密钥不应该继续发布")
+}
+
+// TestJWTManager_RejectsAlgorithmConfusion 测试配置非对称密钥后拒绝未配置共享密钥的 HS256 令牌
+func TestJWTManager_RejectsAlgorithmConfusion(t *testing.T) {
+	manager := newKeyManager(t, &JWTConfig{
+		Keys: []KeyConfig{{ID: "k1", PrivateKeyFile: writePrivateKey(t, newRSAKey(t))}},
+	})
+
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &UserClaims{Username: "mallory"}).SignedString([]byte(""))
+	require.NoError(t, err)
+	_, err = manager.ValidateToken(context.Background(), forged)
+	assert.Error(t, err, "没有共享密钥时不应该接受 HS256 令牌")
+
+	_, err = NewJWTManager(&JWTConfig{}, nil, zap.NewNop())
+	assert.Error(t, err, "未配置任何密钥时应该返回错误而不是生成随机密钥")
+	_, err = NewJWTManager(&JWTConfig{Algorithm: AlgorithmRS256, SecretKey: "secret"}, nil, zap.NewNop())
+	assert.Error(t, err, "非对称算法必须配置签名密钥")
+	_, err = NewJWTManager(&JWTConfig{Keys: []KeyConfig{{ID: "k1", Algorithm: AlgorithmES256, PrivateKeyFile: writePrivateKey(t, newRSAKey(t))}}}, nil, zap.NewNop())
+	assert.Error(t, err, "算法与密钥类型不匹配时应该返回错误")
+}
+
+// TestJWTManager_ExternalIdP 测试验证外部身份提供方签发的令牌
+func TestJWTManager_ExternalIdP(t *testing.T) {
+	idpKey := newRSAKey(t)
+	jwk, err := newJWK(&Key{ID: "idp-1", Algorithm: AlgorithmRS256, Public: idpKey.Public()})
+	require.NoError(t, err)
+	document, err := json.Marshal(JWKSet{Keys: []JWK{jwk}})
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, document, 0o600))
+
+	provider := JWKSConfig{
+		Issuer:      "https://idp.example.com",
+		Audience:    "workflow-engine",
+		File:        path,
+		Roles:       map[string]string{"staff": "user"},
+		Permissions: []string{"task:complete"},
+	}
+	sign := func(kid string, claims *UserClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = kid
+		signed, err := token.SignedString(idpKey)
+		require.NoError(t, err)
+		return signed
+	}
+	issue := func(kid, issuer string) string {
+		return sign(kid, &UserClaims{
+			Roles: []string{"staff"},
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    issuer,
+				Subject:   "alice@example.com",
+				Audience:  jwt.ClaimStrings{"workflow-engine"},
+				IssuedAt:  jwt.NewNumericDate(time.Now()),
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+		})
+	}
+
+	t.Run("JWKS 文件", func(t *testing.T) {
+		manager := newKeyManager(t, &JWTConfig{SecretKey: "local-secret", JWKS: []JWKSConfig{provider}})
+
+		claims, err := manager.ValidateToken(context.Background(), issue("idp-1", "https://idp.example.com"))
+		require.NoError(t, err, "应该接受受信任身份提供方的令牌")
+		assert.Equal(t, "https://idp.example.com|alice@example.com", claims.Username, "外部用户名应该为 iss|sub")
+		assert.Equal(t, []string{"user"}, claims.Roles, "外部角色应该映射为本地角色")
+
+		_, err = manager.ValidateToken(context.Background(), issue("idp-1", "https://evil.example.com"))
+		assert.Error(t, err, "签发者不一致的令牌应该被拒绝")
+		assert.Empty(t, manager.JWKS().Keys, "外部身份提供方的公钥不应该由本服务发布")
+	})
+
+	t.Run("必须配置签发者与受众", func(t *testing.T) {
+		withoutAudience := provider
+		withoutAudience.Audience = ""
+		_, err := NewJWTManager(&JWTConfig{JWKS: []JWKSConfig{withoutAudience}}, nil, zap.NewNop())
+		assert.Error(t, err, "未配置受众时应该拒绝启动")
+
+		withoutIssuer := provider
+		withoutIssuer.Issuer = ""
+		_, err = NewJWTManager(&JWTConfig{JWKS: []JWKSConfig{withoutIssuer}}, nil, zap.NewNop())
+		assert.Error(t, err, "未配置签发者时应该拒绝启动")
+	})
+
+	t.Run("拒绝签发给其他受众的令牌", func(t *testing.T) {
+		manager := newKeyManager(t, &JWTConfig{JWKS: []JWKSConfig{provider}})
+
+		for name, audience := range map[string]jwt.ClaimStrings{"其他客户端": {"other-client"}, "缺少受众": nil} {
+			_, err := manager.ValidateToken(context.Background(), sign("idp-1", &UserClaims{
+				RegisteredClaims: jwt.RegisteredClaims{
+					Issuer:    "https://idp.example.com",
+					Subject:   "alice@example.com",
+					Audience:  audience,
+					ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+				},
+			}))
+			assert.Error(t, err, "%s的令牌应该被拒绝", name)
+		}
+	})
+
+	t.Run("外部声明不能冒充本地用户或提升权限", func(t *testing.T) {
+		manager := newKeyManager(t, &JWTConfig{JWKS: []JWKSConfig{provider}})
+
+		claims, err := manager.ValidateToken(context.Background(), sign("idp-1", &UserClaims{
+			UserID:      1,
+			Username:    "admin",
+			Roles:       []string{"admin", "staff"},
+			Permissions: []string{"*", "task:complete"},
+			SessionID:   "session-1",
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    "https://idp.example.com",
+				Subject:   "mallory",
+				Audience:  jwt.ClaimStrings{"workflow-engine"},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+		}))
+		require.NoError(t, err, "验证外部令牌失败")
+		assert.Equal(t, int64(0), claims.UserID, "外部令牌不能指定本地用户ID")
+		assert.Equal(t, "https://idp.example.com|mallory", claims.Username, "外部令牌不能指定本地用户名")
+		assert.Empty(t, claims.SessionID, "外部令牌不属于本地会话")
+		assert.Equal(t, []string{"user"}, claims.Roles, "未映射的角色应该被忽略")
+		assert.Equal(t, []string{"task:complete"}, claims.Permissions, "未允许的权限应该被忽略")
+		assert.False(t, manager.HasPermission(claims, "process:start"), "外部令牌不能通过 admin 角色获得全部权限")
+
+		identity := NewIdentity(claims)
+		assert.Equal(t, "https://idp.example.com|mallory", identity.UserID, "外部用户的身份标识应该为 iss|sub")
+
+		_, err = manager.ValidateRefreshToken(context.Background(), sign("idp-1", &UserClaims{
+			TokenType: TokenTypeRefresh,
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    "https://idp.example.com",
+				Subject:   "mallory",
+				Audience:  jwt.ClaimStrings{"workflow-engine"},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+		}))
+		assert.Error(t, err, "外部令牌不能作为刷新令牌使用")
+	})
+
+	t.Run("按外部用户吊销令牌", func(t *testing.T) {
+		ctx := context.Background()
+		manager := newKeyManager(t, &JWTConfig{JWKS: []JWKSConfig{provider}, EnableBlacklist: true})
+		bob := sign("idp-1", &UserClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    "https://idp.example.com",
+				Subject:   "bob@example.com",
+				Audience:  jwt.ClaimStrings{"workflow-engine"},
+				IssuedAt:  jwt.NewNumericDate(time.Now()),
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+		})
+		alice := issue("idp-1", "https://idp.example.com")
+
+		require.NoError(t, manager.RevokePrincipalTokens(ctx, "https://idp.example.com|alice@example.com", time.Now()), "吊销外部用户令牌失败")
+
+		_, err := manager.ValidateToken(ctx, alice)
+		assert.Error(t, err, "被吊销的外部用户的令牌应该失效")
+		_, err = manager.ValidateToken(ctx, bob)
+		assert.NoError(t, err, "同一身份提供方的其他用户不受影响")
+	})
+
+	t.Run("JWKS 地址", func(t *testing.T) {
+		var fetches atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fetches.Add(1)
+			_, _ = w.Write(document)
+		}))
+		defer server.Close()
+
+		remote := provider
+		remote.File = ""
+		remote.URL = server.URL
+		manager := newKeyManager(t, &JWTConfig{JWKS: []JWKSConfig{remote}})
+		for i := 0; i < 3; i++ {
+			_, err := manager.ValidateToken(context.Background(), issue("idp-1", "https://idp.example.com"))
+			require.NoError(t, err, "应该接受从地址加载的密钥签发的令牌")
+		}
+		assert.Equal(t, int32(1), fetches.Load(), "刷新间隔内应该复用已加载的密钥")
+
+		_, err := manager.ValidateToken(context.Background(), issue("idp-2", "https://idp.example.com"))
+		assert.ErrorIs(t, err, ErrKeyNotFound, "未知的 kid 应该被拒绝")
+		assert.Equal(t, int32(1), fetches.Load(), "未知 kid 触发的刷新需要满足最小间隔")
+
+		_, err = manager.GenerateTokenPair(1, "alice", "", nil, nil)
+		assert.Error(t, err, "只信任外部身份提供方时不能签发令牌")
+	})
+}
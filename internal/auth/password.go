@@ -0,0 +1,159 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 密码哈希算法
+const (
+	PasswordHashArgon2id = "argon2id"
+	PasswordHashBcrypt   = "bcrypt"
+)
+
+// ErrPasswordMismatch 密码与哈希不匹配
+var ErrPasswordMismatch = errors.New("密码不正确")
+
+// PasswordConfig 密码哈希配置，未设置的参数使用 OWASP 推荐的默认值
+type PasswordConfig struct {
+	Algorithm string // 新密码使用的算法: argon2id, bcrypt
+	Memory    uint32 // argon2id 内存开销，单位 KiB
+	Time      uint32 // argon2id 迭代次数
+	Threads   uint8  // argon2id 并行度
+	Cost      int    // bcrypt 代价因子
+}
+
+// PasswordHasher 密码哈希器
+// 新密码按配置的算法生成 PHC 格式的哈希；校验时按哈希前缀识别算法，两种算法的已有哈希都可以校验，
+// 参数或算法与当前配置不一致的哈希在登录成功后由调用方按 NeedsRehash 重新计算
+type PasswordHasher struct {
+	config PasswordConfig
+}
+
+// NewPasswordHasher 创建密码哈希器
+func NewPasswordHasher(config PasswordConfig) (*PasswordHasher, error) {
+	if config.Algorithm == "" {
+		config.Algorithm = PasswordHashArgon2id
+	}
+	switch config.Algorithm {
+	case PasswordHashArgon2id:
+		if config.Memory == 0 {
+			config.Memory = 19 * 1024
+		}
+		if config.Time == 0 {
+			config.Time = 2
+		}
+		if config.Threads == 0 {
+			config.Threads = 1
+		}
+	case PasswordHashBcrypt:
+		if config.Cost == 0 {
+			config.Cost = bcrypt.DefaultCost
+		}
+		if config.Cost < bcrypt.MinCost || config.Cost > bcrypt.MaxCost {
+			return nil, fmt.Errorf("bcrypt 代价因子必须在 %d 到 %d 之间", bcrypt.MinCost, bcrypt.MaxCost)
+		}
+	default:
+		return nil, fmt.Errorf("不支持的密码哈希算法: %s", config.Algorithm)
+	}
+	return &PasswordHasher{config: config}, nil
+}
+
+// Hash 计算密码哈希
+func (h *PasswordHasher) Hash(password string) (string, error) {
+	if h.config.Algorithm == PasswordHashBcrypt {
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), h.config.Cost)
+		if err != nil {
+			return "", fmt.Errorf("计算密码哈希失败: %w", err)
+		}
+		return string(hash), nil
+	}
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("生成密码盐失败: %w", err)
+	}
+	key := argon2.IDKey([]byte(password), salt, h.config.Time, h.config.Memory, h.config.Threads, 32)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, h.config.Memory, h.config.Time, h.config.Threads,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// Verify 校验密码，不匹配时返回 ErrPasswordMismatch
+func (h *PasswordHasher) Verify(hash, password string) error {
+	if isBcryptHash(hash) {
+		if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)); err != nil {
+			if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+				return ErrPasswordMismatch
+			}
+			return fmt.Errorf("校验密码失败: %w", err)
+		}
+		return nil
+	}
+
+	params, salt, key, err := decodeArgon2id(hash)
+	if err != nil {
+		return err
+	}
+	actual := argon2.IDKey([]byte(password), salt, params.Time, params.Memory, params.Threads, uint32(len(key)))
+	if subtle.ConstantTimeCompare(actual, key) != 1 {
+		return ErrPasswordMismatch
+	}
+	return nil
+}
+
+// NeedsRehash 判断哈希的算法或参数是否与当前配置不一致
+func (h *PasswordHasher) NeedsRehash(hash string) bool {
+	if isBcryptHash(hash) {
+		if h.config.Algorithm != PasswordHashBcrypt {
+			return true
+		}
+		cost, err := bcrypt.Cost([]byte(hash))
+		return err != nil || cost != h.config.Cost
+	}
+
+	if h.config.Algorithm != PasswordHashArgon2id {
+		return true
+	}
+	params, _, _, err := decodeArgon2id(hash)
+	return err != nil || params.Memory != h.config.Memory || params.Time != h.config.Time || params.Threads != h.config.Threads
+}
+
+// isBcryptHash 判断是否为 bcrypt 哈希
+func isBcryptHash(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$")
+}
+
+// decodeArgon2id 解析 PHC 格式的 argon2id 哈希
+func decodeArgon2id(hash string) (PasswordConfig, []byte, []byte, error) {
+	var params PasswordConfig
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != PasswordHashArgon2id {
+		return params, nil, nil, fmt.Errorf("无法识别的密码哈希格式")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, fmt.Errorf("不支持的 argon2 版本: %s", parts[2])
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Time, &params.Threads); err != nil {
+		return params, nil, nil, fmt.Errorf("无效的 argon2 参数: %w", err)
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, fmt.Errorf("无效的密码盐: %w", err)
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, fmt.Errorf("无效的密码哈希")
+	}
+	params.Algorithm = PasswordHashArgon2id
+	return params, salt, key, nil
+}
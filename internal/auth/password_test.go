@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// TestPasswordHasher 测试密码哈希与校验
+func TestPasswordHasher(t *testing.T) {
+	argon, err := NewPasswordHasher(PasswordConfig{Memory: 1024, Time: 1, Threads: 1})
+	require.NoError(t, err, "创建 argon2id 哈希器不应该返回错误")
+	bcryptHasher, err := NewPasswordHasher(PasswordConfig{Algorithm: PasswordHashBcrypt, Cost: bcrypt.MinCost})
+	require.NoError(t, err, "创建 bcrypt 哈希器不应该返回错误")
+
+	t.Run("argon2id 使用 PHC 格式并加盐", func(t *testing.T) {
+		hash, err := argon.Hash("correct-horse")
+		require.NoError(t, err, "计算哈希不应该返回错误")
+		assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"), "哈希应该记录算法与参数: %s", hash)
+
+		again, err := argon.Hash("correct-horse")
+		require.NoError(t, err)
+		assert.NotEqual(t, hash, again, "相同密码的哈希应该使用不同的盐")
+
+		assert.NoError(t, argon.Verify(hash, "correct-horse"), "正确的密码应该校验通过")
+		assert.ErrorIs(t, argon.Verify(hash, "wrong-horse"), ErrPasswordMismatch, "错误的密码应该返回不匹配")
+		assert.False(t, argon.NeedsRehash(hash), "参数一致的哈希不需要重新计算")
+	})
+
+	t.Run("两种算法的哈希都可以校验", func(t *testing.T) {
+		hash, err := bcryptHasher.Hash("correct-horse")
+		require.NoError(t, err)
+
+		assert.NoError(t, argon.Verify(hash, "correct-horse"), "argon2id 哈希器应该能校验 bcrypt 哈希")
+		assert.ErrorIs(t, argon.Verify(hash, "wrong-horse"), ErrPasswordMismatch, "错误的密码应该返回不匹配")
+		assert.True(t, argon.NeedsRehash(hash), "算法不一致的哈希需要重新计算")
+		assert.False(t, bcryptHasher.NeedsRehash(hash), "参数一致的哈希不需要重新计算")
+	})
+
+	t.Run("参数变化后需要重新计算", func(t *testing.T) {
+		hash, err := argon.Hash("correct-horse")
+		require.NoError(t, err)
+		stronger, err := NewPasswordHasher(PasswordConfig{Memory: 2048, Time: 1, Threads: 1})
+		require.NoError(t, err)
+
+		assert.True(t, stronger.NeedsRehash(hash), "内存参数变化后需要重新计算")
+		assert.NoError(t, stronger.Verify(hash, "correct-horse"), "按哈希中记录的参数校验")
+	})
+
+	t.Run("无法识别的哈希", func(t *testing.T) {
+		err := argon.Verify("plaintext", "plaintext")
+		assert.Error(t, err, "无法识别的哈希应该返回错误")
+		assert.NotErrorIs(t, err, ErrPasswordMismatch, "格式错误不应该当作密码不匹配")
+	})
+
+	t.Run("不支持的配置", func(t *testing.T) {
+		_, err := NewPasswordHasher(PasswordConfig{Algorithm: "md5"})
+		assert.Error(t, err, "不支持的算法应该返回错误")
+		_, err = NewPasswordHasher(PasswordConfig{Algorithm: PasswordHashBcrypt, Cost: 99})
+		assert.Error(t, err, "超出范围的代价因子应该返回错误")
+	})
+}
@@ -0,0 +1,196 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RevocationStore 令牌吊销存储
+// 单个令牌按 jti 吊销，条目在令牌剩余有效期结束后过期；
+// 按用户吊销记录一个时间点，该用户在此之前签发的令牌全部失效，用于强制下线
+type RevocationStore interface {
+	// RevokeToken 吊销指定 jti 的令牌，ttl 为令牌剩余有效期
+	RevokeToken(ctx context.Context, jti string, ttl time.Duration) error
+	// IsTokenRevoked 判断指定 jti 的令牌是否已被吊销
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
+	// RevokeUserTokens 吊销用户在 before 及之前签发的令牌，ttl 为令牌的最长有效期
+	RevokeUserTokens(ctx context.Context, userID string, before time.Time, ttl time.Duration) error
+	// UserTokensRevokedBefore 返回用户令牌的吊销时间点，没有吊销记录时返回零值
+	UserTokensRevokedBefore(ctx context.Context, userID string) (time.Time, error)
+}
+
+// revocationEntry 内存吊销条目
+type revocationEntry struct {
+	before    time.Time // 按用户吊销的时间点，按令牌吊销时为零值
+	expiresAt time.Time
+}
+
+// MemoryRevocationStore 进程内的令牌吊销存储
+// 重启后丢失且不在副本之间共享，适用于单实例部署与测试
+type MemoryRevocationStore struct {
+	mu     sync.Mutex
+	tokens map[string]revocationEntry
+	users  map[string]revocationEntry
+	now    func() time.Time
+}
+
+// NewMemoryRevocationStore 创建进程内的令牌吊销存储
+func NewMemoryRevocationStore() *MemoryRevocationStore {
+	return &MemoryRevocationStore{
+		tokens: make(map[string]revocationEntry),
+		users:  make(map[string]revocationEntry),
+		now:    time.Now,
+	}
+}
+
+// RevokeToken 吊销指定 jti 的令牌
+func (s *MemoryRevocationStore) RevokeToken(ctx context.Context, jti string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.cleanup(now)
+	s.tokens[jti] = revocationEntry{expiresAt: now.Add(ttl)}
+	return nil
+}
+
+// IsTokenRevoked 判断指定 jti 的令牌是否已被吊销
+func (s *MemoryRevocationStore) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.tokens[jti]
+	return ok && s.now().Before(entry.expiresAt), nil
+}
+
+// RevokeUserTokens 吊销用户在 before 及之前签发的令牌，已有更晚的吊销时间点时保留较晚者
+func (s *MemoryRevocationStore) RevokeUserTokens(ctx context.Context, userID string, before time.Time, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.cleanup(now)
+	if entry, ok := s.users[userID]; ok && entry.before.After(before) {
+		before = entry.before
+	}
+	s.users[userID] = revocationEntry{before: before, expiresAt: now.Add(ttl)}
+	return nil
+}
+
+// UserTokensRevokedBefore 返回用户令牌的吊销时间点
+func (s *MemoryRevocationStore) UserTokensRevokedBefore(ctx context.Context, userID string) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.users[userID]
+	if !ok || !s.now().Before(entry.expiresAt) {
+		return time.Time{}, nil
+	}
+	return entry.before, nil
+}
+
+// Len 返回未过期的吊销条目数
+func (s *MemoryRevocationStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cleanup(s.now())
+	return len(s.tokens) + len(s.users)
+}
+
+// cleanup 清理过期的吊销条目，调用方需持有锁
+func (s *MemoryRevocationStore) cleanup(now time.Time) {
+	for jti, entry := range s.tokens {
+		if !now.Before(entry.expiresAt) {
+			delete(s.tokens, jti)
+		}
+	}
+	for userID, entry := range s.users {
+		if !now.Before(entry.expiresAt) {
+			delete(s.users, userID)
+		}
+	}
+}
+
+// 令牌吊销在 Redis 中的键前缀
+const (
+	revokedTokenKeyPrefix = "auth:revoked:jti:"  // 按 jti 吊销的令牌
+	revokedUserKeyPrefix  = "auth:revoked:user:" // 按用户吊销的时间点，值为 Unix 纳秒
+)
+
+// revokeUserScript 仅在新的时间点晚于已有时间点时写入，并刷新过期时间
+var revokeUserScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if current and tonumber(current) > tonumber(ARGV[1]) then
+	redis.call('PEXPIRE', KEYS[1], ARGV[2])
+	return 0
+end
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+return 1
+`)
+
+// RedisRevocationStore 基于 Redis 的令牌吊销存储
+// 条目的过期时间由 Redis 管理，重启后保留并在所有副本之间共享
+type RedisRevocationStore struct {
+	client *redis.Client
+}
+
+// NewRedisRevocationStore 创建基于 Redis 的令牌吊销存储
+func NewRedisRevocationStore(client *redis.Client) *RedisRevocationStore {
+	return &RedisRevocationStore{client: client}
+}
+
+// RevokeToken 吊销指定 jti 的令牌
+func (s *RedisRevocationStore) RevokeToken(ctx context.Context, jti string, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+	if err := s.client.Set(ctx, revokedTokenKeyPrefix+jti, 1, ttl).Err(); err != nil {
+		return fmt.Errorf("写入令牌吊销记录失败: %w", err)
+	}
+	return nil
+}
+
+// IsTokenRevoked 判断指定 jti 的令牌是否已被吊销
+func (s *RedisRevocationStore) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	n, err := s.client.Exists(ctx, revokedTokenKeyPrefix+jti).Result()
+	if err != nil {
+		return false, fmt.Errorf("查询令牌吊销记录失败: %w", err)
+	}
+	return n > 0, nil
+}
+
+// RevokeUserTokens 吊销用户在 before 及之前签发的令牌，已有更晚的吊销时间点时保留较晚者
+func (s *RedisRevocationStore) RevokeUserTokens(ctx context.Context, userID string, before time.Time, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+	err := revokeUserScript.Run(ctx, s.client, []string{revokedUserKeyPrefix + userID},
+		before.UnixNano(), ttl.Milliseconds()).Err()
+	if err != nil {
+		return fmt.Errorf("写入用户令牌吊销记录失败: %w", err)
+	}
+	return nil
+}
+
+// UserTokensRevokedBefore 返回用户令牌的吊销时间点
+func (s *RedisRevocationStore) UserTokensRevokedBefore(ctx context.Context, userID string) (time.Time, error) {
+	value, err := s.client.Get(ctx, revokedUserKeyPrefix+userID).Result()
+	if errors.Is(err, redis.Nil) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("查询用户令牌吊销记录失败: %w", err)
+	}
+	nanos, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("用户令牌吊销记录格式错误: %w", err)
+	}
+	return time.Unix(0, nanos), nil
+}
@@ -0,0 +1,388 @@
+// Package biz 提供业务逻辑层功能
+// 包含登录、刷新令牌、登出与修改密码的业务逻辑
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/workflow-engine/workflow-engine/internal/auth"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+
+	"go.uber.org/zap"
+)
+
+// 认证业务错误
+var (
+	ErrInvalidCredentials  = errors.New("用户名或密码错误")
+	ErrUserDisabled        = errors.New("用户已停用")
+	ErrWeakPassword        = errors.New("密码不符合要求")
+	ErrInvalidRefreshToken = errors.New("刷新令牌无效或已过期")
+	ErrRefreshTokenReused  = errors.New("刷新令牌已被使用，会话已吊销")
+)
+
+// 密码长度限制，上限与 bcrypt 能处理的最大字节数一致
+const (
+	minPasswordLength = 8
+	maxPasswordLength = 72
+)
+
+// TokenManager 令牌签发与吊销接口，由 auth.JWTManager 实现
+type TokenManager interface {
+	// 按用户信息签发令牌对
+	IssueTokenPair(req *auth.TokenRequest) (*auth.TokenPair, error)
+	// 验证刷新令牌的签名、有效期与类型
+	ValidateRefreshToken(ctx context.Context, tokenString string) (*auth.UserClaims, error)
+	// 是否启用了令牌吊销，未启用时访问令牌只能等待自然过期
+	RevocationEnabled() bool
+	// 按 jti 吊销令牌
+	RevokeTokenID(ctx context.Context, jti string, expiresAt time.Time) error
+	// 吊销用户在指定时间及之前签发的全部令牌
+	RevokeUserTokens(ctx context.Context, userID int64, before time.Time) error
+}
+
+// AuthUseCase 认证用例
+// 每次登录开启一个会话，会话ID即令牌族ID。刷新令牌只能使用一次，使用后轮换出同一令牌族的新令牌对；
+// 已使用的刷新令牌再次出现时视为泄露，吊销整个令牌族
+type AuthUseCase struct {
+	userRepo  UserRepo
+	roleRepo  RoleRepo
+	tokenRepo RefreshTokenRepo
+	txRepo    TransactionRepo
+	tokens    TokenManager
+	passwords *auth.PasswordHasher
+	logger    *zap.Logger
+
+	// 用户不存在时同样校验一次密码，避免通过响应时间探测用户名
+	dummyHash string
+}
+
+// NewAuthUseCase 创建认证用例实例
+func NewAuthUseCase(
+	userRepo UserRepo,
+	roleRepo RoleRepo,
+	tokenRepo RefreshTokenRepo,
+	txRepo TransactionRepo,
+	tokens TokenManager,
+	passwords *auth.PasswordHasher,
+	logger *zap.Logger,
+) *AuthUseCase {
+	dummyHash, err := passwords.Hash(newSessionID())
+	if err != nil {
+		logger.Warn("生成占位密码哈希失败", zap.Error(err))
+	}
+	return &AuthUseCase{
+		userRepo:  userRepo,
+		roleRepo:  roleRepo,
+		tokenRepo: tokenRepo,
+		txRepo:    txRepo,
+		tokens:    tokens,
+		passwords: passwords,
+		logger:    logger,
+		dummyHash: dummyHash,
+	}
+}
+
+// Login 校验用户名和密码，开启新会话并签发令牌对
+func (uc *AuthUseCase) Login(ctx context.Context, req *LoginRequest) (*auth.TokenPair, error) {
+	u, err := uc.userRepo.GetByUsername(ctx, req.Username)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			_ = uc.passwords.Verify(uc.dummyHash, req.Password)
+			uc.logger.Warn("登录失败: 用户不存在", zap.String("username", req.Username))
+			return nil, ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("查询用户失败: %w", err)
+	}
+
+	if err := uc.passwords.Verify(u.PasswordHash, req.Password); err != nil {
+		if errors.Is(err, auth.ErrPasswordMismatch) {
+			uc.logger.Warn("登录失败: 密码错误", zap.String("username", req.Username))
+			return nil, ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("校验密码失败: %w", err)
+	}
+	if u.Status != UserStatusActive {
+		uc.logger.Warn("登录失败: 用户已停用", zap.String("username", req.Username))
+		return nil, ErrUserDisabled
+	}
+
+	// 哈希算法或参数调整后，在用户下次登录时升级已保存的哈希
+	if uc.passwords.NeedsRehash(u.PasswordHash) {
+		if hash, err := uc.passwords.Hash(req.Password); err != nil {
+			uc.logger.Warn("重新计算密码哈希失败", zap.Int64("user_id", u.ID), zap.Error(err))
+		} else if err := uc.userRepo.RehashPassword(ctx, u.ID, hash); err != nil {
+			uc.logger.Warn("保存重新计算的密码哈希失败", zap.Int64("user_id", u.ID), zap.Error(err))
+		}
+	}
+
+	pair, err := uc.issue(ctx, u, newSessionID(), "")
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.userRepo.RecordLogin(ctx, u.ID, time.Now()); err != nil {
+		uc.logger.Warn("记录登录时间失败", zap.Int64("user_id", u.ID), zap.Error(err))
+	}
+
+	uc.logger.Info("用户登录成功", zap.Int64("user_id", u.ID), zap.String("username", u.Username))
+	return pair, nil
+}
+
+// Refresh 使用刷新令牌换取同一会话的新令牌对，旧刷新令牌随即失效
+// 刷新时重新读取用户的角色与权限；已使用过的刷新令牌再次使用时吊销整个会话
+func (uc *AuthUseCase) Refresh(ctx context.Context, refreshToken string) (*auth.TokenPair, error) {
+	claims, err := uc.tokens.ValidateRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRefreshToken, err)
+	}
+
+	record, err := uc.tokenRepo.GetByTokenID(ctx, claims.ID)
+	if err != nil {
+		return nil, err
+	}
+	if record.RevokedAt != nil {
+		uc.logger.Warn("刷新令牌所属会话已吊销", zap.Int64("user_id", record.UserID), zap.String("family_id", record.FamilyID))
+		return nil, fmt.Errorf("%w: 会话已吊销", ErrInvalidRefreshToken)
+	}
+
+	var pair *auth.TokenPair
+	err = uc.txRepo.Transaction(ctx, func(ctx context.Context) error {
+		consumed, err := uc.tokenRepo.MarkUsed(ctx, record.TokenID, time.Now())
+		if err != nil {
+			return err
+		}
+		if !consumed {
+			return ErrRefreshTokenReused
+		}
+
+		u, err := uc.userRepo.GetByID(ctx, record.UserID)
+		if err != nil {
+			return err
+		}
+		if u.Status != UserStatusActive {
+			return ErrUserDisabled
+		}
+
+		pair, err = uc.issue(ctx, u, record.FamilyID, record.TokenID)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, ErrRefreshTokenReused) {
+			uc.logger.Warn("检测到刷新令牌重用，吊销整个会话",
+				zap.Int64("user_id", record.UserID),
+				zap.String("family_id", record.FamilyID),
+				zap.String("token_id", record.TokenID))
+			if err := uc.revokeFamily(ctx, record.FamilyID); err != nil {
+				return nil, err
+			}
+			return nil, ErrRefreshTokenReused
+		}
+		if errors.Is(err, ErrUserDisabled) || errors.Is(err, ErrUserNotFound) {
+			return nil, err
+		}
+		uc.logger.Error("刷新令牌失败", zap.Int64("user_id", record.UserID), zap.Error(err))
+		return nil, fmt.Errorf("刷新令牌失败: %w", err)
+	}
+
+	uc.logger.Info("令牌刷新成功", zap.Int64("user_id", record.UserID), zap.String("family_id", record.FamilyID))
+	return pair, nil
+}
+
+// Logout 吊销当前访问令牌及其所属会话的全部令牌
+// 未启用令牌吊销时只吊销会话的刷新令牌，访问令牌在过期前仍然有效
+func (uc *AuthUseCase) Logout(ctx context.Context, claims *auth.UserClaims) error {
+	if claims.ExpiresAt != nil && uc.tokens.RevocationEnabled() {
+		if err := uc.tokens.RevokeTokenID(ctx, claims.ID, claims.ExpiresAt.Time); err != nil {
+			return err
+		}
+	}
+	if claims.SessionID != "" {
+		if err := uc.revokeFamily(ctx, claims.SessionID); err != nil {
+			return err
+		}
+	}
+
+	uc.logger.Info("用户已登出", zap.Int64("user_id", claims.UserID), zap.String("family_id", claims.SessionID))
+	return nil
+}
+
+// ChangePassword 修改当前用户的密码
+// 修改后吊销该用户的全部会话，并为当前调用方开启新会话返回新的令牌对
+func (uc *AuthUseCase) ChangePassword(ctx context.Context, req *ChangePasswordRequest) (*auth.TokenPair, error) {
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: 缺少调用方身份", ErrUserNotFound)
+	}
+	userID, err := strconv.ParseInt(identity.UserID, 10, 64)
+	if err != nil || userID <= 0 {
+		return nil, fmt.Errorf("%w: 外部身份提供方的用户不能在此修改密码", ErrUserNotFound)
+	}
+
+	u, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.passwords.Verify(u.PasswordHash, req.OldPassword); err != nil {
+		if errors.Is(err, auth.ErrPasswordMismatch) {
+			return nil, fmt.Errorf("%w: 原密码不正确", ErrInvalidCredentials)
+		}
+		return nil, fmt.Errorf("校验密码失败: %w", err)
+	}
+	if req.NewPassword == req.OldPassword {
+		return nil, fmt.Errorf("%w: 新密码不能与原密码相同", ErrWeakPassword)
+	}
+	if err := validatePassword(req.NewPassword, u.Username); err != nil {
+		return nil, err
+	}
+
+	hash, err := uc.passwords.Hash(req.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+	// 密码与刷新令牌在同一事务中更新，访问令牌的吊销记录不在数据库中，提交后再吊销；
+	// 随后为调用方签发的新令牌与吊销时间点在同一秒内，因此只按会话记录吊销，不按用户吊销
+	var records []*ent.RefreshToken
+	err = uc.txRepo.Transaction(ctx, func(ctx context.Context) error {
+		if err := uc.userRepo.UpdatePassword(ctx, u.ID, hash); err != nil {
+			return err
+		}
+		revoked, err := uc.tokenRepo.RevokeByUser(ctx, u.ID, time.Now())
+		records = revoked
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.revokeAccessTokens(ctx, records); err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("用户修改密码成功", zap.Int64("user_id", u.ID))
+	return uc.issue(ctx, u, newSessionID(), "")
+}
+
+// RevokeUserSessions 吊销用户的全部会话，并吊销该用户此前签发的全部令牌，用于停用用户、重置密码等强制下线场景
+// 按用户吊销同样覆盖没有刷新令牌记录的访问令牌
+func (uc *AuthUseCase) RevokeUserSessions(ctx context.Context, userID int64) error {
+	now := time.Now()
+	records, err := uc.tokenRepo.RevokeByUser(ctx, userID, now)
+	if err != nil {
+		return err
+	}
+	if err := uc.revokeAccessTokens(ctx, records); err != nil {
+		return err
+	}
+	if uc.tokens.RevocationEnabled() {
+		if err := uc.tokens.RevokeUserTokens(ctx, userID, now); err != nil {
+			return err
+		}
+	}
+	uc.logger.Info("用户会话已全部吊销", zap.Int64("user_id", userID))
+	return nil
+}
+
+// revokeFamily 吊销令牌族中的刷新令牌与尚未过期的访问令牌
+func (uc *AuthUseCase) revokeFamily(ctx context.Context, familyID string) error {
+	records, err := uc.tokenRepo.RevokeFamily(ctx, familyID, time.Now())
+	if err != nil {
+		return err
+	}
+	return uc.revokeAccessTokens(ctx, records)
+}
+
+// revokeAccessTokens 吊销刷新令牌记录中同时签发的访问令牌，未启用令牌吊销时不做处理
+func (uc *AuthUseCase) revokeAccessTokens(ctx context.Context, records []*ent.RefreshToken) error {
+	if !uc.tokens.RevocationEnabled() {
+		return nil
+	}
+	for _, record := range records {
+		if record.AccessTokenID == "" {
+			continue
+		}
+		if err := uc.tokens.RevokeTokenID(ctx, record.AccessTokenID, record.AccessExpiresAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// issue 为用户签发令牌对并记录刷新令牌，令牌携带用户当前的角色、用户组与角色授予的权限
+func (uc *AuthUseCase) issue(ctx context.Context, u *ent.User, familyID, parentTokenID string) (*auth.TokenPair, error) {
+	roles, err := uc.roleRepo.ListByNames(ctx, u.Roles)
+	if err != nil {
+		return nil, err
+	}
+
+	pair, err := uc.tokens.IssueTokenPair(&auth.TokenRequest{
+		UserID:      u.ID,
+		Username:    u.Username,
+		Email:       u.Email,
+		Roles:       u.Roles,
+		Groups:      u.Groups,
+		Permissions: rolePermissions(roles),
+		TenantID:    u.TenantID,
+		SessionID:   familyID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if pair.RefreshTokenID != "" {
+		if _, err := uc.tokenRepo.Create(ctx, &ent.RefreshToken{
+			TokenID:         pair.RefreshTokenID,
+			FamilyID:        familyID,
+			UserID:          u.ID,
+			ParentTokenID:   parentTokenID,
+			AccessTokenID:   pair.AccessTokenID,
+			AccessExpiresAt: pair.ExpiresAt,
+			ExpiresAt:       pair.RefreshExpiresAt,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return pair, nil
+}
+
+// rolePermissions 合并角色授予的权限，去除重复项
+func rolePermissions(roles []*ent.Role) []string {
+	seen := make(map[string]bool)
+	var permissions []string
+	for _, role := range roles {
+		for _, permission := range role.Permissions {
+			if !seen[permission] {
+				seen[permission] = true
+				permissions = append(permissions, permission)
+			}
+		}
+	}
+	return permissions
+}
+
+// validatePassword 检查密码长度，且不能与用户名相同
+func validatePassword(password, username string) error {
+	if utf8.RuneCountInString(password) < minPasswordLength {
+		return fmt.Errorf("%w: 密码至少需要 %d 个字符", ErrWeakPassword, minPasswordLength)
+	}
+	if len(password) > maxPasswordLength {
+		return fmt.Errorf("%w: 密码不能超过 %d 个字节", ErrWeakPassword, maxPasswordLength)
+	}
+	if password == username {
+		return fmt.Errorf("%w: 密码不能与用户名相同", ErrWeakPassword)
+	}
+	return nil
+}
+
+// newSessionID 生成会话ID
+func newSessionID() string {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+	return hex.EncodeToString(bytes)
+}
@@ -0,0 +1,510 @@
+// Package biz 提供业务逻辑层功能的测试
+// 包含登录、刷新令牌轮换与重用检测、修改密码的单元测试
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/workflow-engine/workflow-engine/internal/auth"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+)
+
+// MockUserRepo 模拟用户仓储
+type MockUserRepo struct {
+	mock.Mock
+}
+
+func (m *MockUserRepo) Create(ctx context.Context, u *ent.User) (*ent.User, error) {
+	args := m.Called(ctx, u)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.User), args.Error(1)
+}
+
+func (m *MockUserRepo) GetByID(ctx context.Context, id int64) (*ent.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.User), args.Error(1)
+}
+
+func (m *MockUserRepo) GetByUsername(ctx context.Context, username string) (*ent.User, error) {
+	args := m.Called(ctx, username)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.User), args.Error(1)
+}
+
+func (m *MockUserRepo) Update(ctx context.Context, u *ent.User) (*ent.User, error) {
+	args := m.Called(ctx, u)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.User), args.Error(1)
+}
+
+func (m *MockUserRepo) UpdatePassword(ctx context.Context, id int64, passwordHash string) error {
+	args := m.Called(ctx, id, passwordHash)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) RehashPassword(ctx context.Context, id int64, passwordHash string) error {
+	args := m.Called(ctx, id, passwordHash)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) RecordLogin(ctx context.Context, id int64, at time.Time) error {
+	args := m.Called(ctx, id, at)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) List(ctx context.Context, filter *UserFilter, opts *QueryOptions) ([]*ent.User, *PaginationResult, error) {
+	args := m.Called(ctx, filter, opts)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).([]*ent.User), args.Get(1).(*PaginationResult), args.Error(2)
+}
+
+// MockRoleRepo 模拟角色仓储
+type MockRoleRepo struct {
+	mock.Mock
+}
+
+func (m *MockRoleRepo) Create(ctx context.Context, r *ent.Role) (*ent.Role, error) {
+	args := m.Called(ctx, r)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.Role), args.Error(1)
+}
+
+func (m *MockRoleRepo) GetByName(ctx context.Context, name string) (*ent.Role, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.Role), args.Error(1)
+}
+
+func (m *MockRoleRepo) Update(ctx context.Context, r *ent.Role) (*ent.Role, error) {
+	args := m.Called(ctx, r)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.Role), args.Error(1)
+}
+
+func (m *MockRoleRepo) List(ctx context.Context) ([]*ent.Role, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.Role), args.Error(1)
+}
+
+func (m *MockRoleRepo) ListByNames(ctx context.Context, names []string) ([]*ent.Role, error) {
+	args := m.Called(ctx, names)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.Role), args.Error(1)
+}
+
+// MockRefreshTokenRepo 模拟刷新令牌仓储
+type MockRefreshTokenRepo struct {
+	mock.Mock
+}
+
+func (m *MockRefreshTokenRepo) Create(ctx context.Context, rt *ent.RefreshToken) (*ent.RefreshToken, error) {
+	args := m.Called(ctx, rt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.RefreshToken), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepo) GetByTokenID(ctx context.Context, tokenID string) (*ent.RefreshToken, error) {
+	args := m.Called(ctx, tokenID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.RefreshToken), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepo) MarkUsed(ctx context.Context, tokenID string, at time.Time) (bool, error) {
+	args := m.Called(ctx, tokenID, at)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepo) RevokeFamily(ctx context.Context, familyID string, at time.Time) ([]*ent.RefreshToken, error) {
+	args := m.Called(ctx, familyID, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.RefreshToken), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepo) RevokeByUser(ctx context.Context, userID int64, at time.Time) ([]*ent.RefreshToken, error) {
+	args := m.Called(ctx, userID, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.RefreshToken), args.Error(1)
+}
+
+func (m *MockRefreshTokenRepo) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	args := m.Called(ctx, before)
+	return args.Int(0), args.Error(1)
+}
+
+// authMocks 认证用例测试所需的模拟依赖
+type authMocks struct {
+	userRepo  *MockUserRepo
+	roleRepo  *MockRoleRepo
+	tokenRepo *MockRefreshTokenRepo
+	tx        *MockTransactionRepo
+	tokens    *auth.JWTManager
+	passwords *auth.PasswordHasher
+}
+
+// newAuthUseCaseWithMocks 创建使用模拟仓储的认证用例，令牌使用启用吊销的真实 JWT 管理器签发
+func newAuthUseCaseWithMocks(t *testing.T) (*AuthUseCase, *authMocks) {
+	t.Helper()
+	return newAuthUseCaseWithRevocation(t, true)
+}
+
+// newAuthUseCaseWithRevocation 创建使用模拟仓储的认证用例，可以指定是否启用令牌吊销
+func newAuthUseCaseWithRevocation(t *testing.T, enableBlacklist bool) (*AuthUseCase, *authMocks) {
+	t.Helper()
+	tokens, err := auth.NewJWTManager(&auth.JWTConfig{
+		SecretKey:       "test-secret",
+		Issuer:          "workflow-engine",
+		EnableRefresh:   true,
+		EnableBlacklist: enableBlacklist,
+	}, nil, zap.NewNop())
+	require.NoError(t, err, "创建JWT管理器不应该返回错误")
+	passwords, err := auth.NewPasswordHasher(auth.PasswordConfig{Algorithm: auth.PasswordHashBcrypt, Cost: bcrypt.MinCost})
+	require.NoError(t, err, "创建密码哈希器不应该返回错误")
+
+	m := &authMocks{
+		userRepo:  &MockUserRepo{},
+		roleRepo:  &MockRoleRepo{},
+		tokenRepo: &MockRefreshTokenRepo{},
+		tx:        &MockTransactionRepo{},
+		tokens:    tokens,
+		passwords: passwords,
+	}
+	uc := NewAuthUseCase(m.userRepo, m.roleRepo, m.tokenRepo, m.tx, tokens, passwords, zap.NewNop())
+	return uc, m
+}
+
+// newTestUser 创建使用给定密码的测试用户
+func newTestUser(t *testing.T, passwords *auth.PasswordHasher, password string) *ent.User {
+	t.Helper()
+	hash, err := passwords.Hash(password)
+	require.NoError(t, err, "计算密码哈希不应该返回错误")
+	return &ent.User{
+		ID:           7,
+		Username:     "alice",
+		PasswordHash: hash,
+		Status:       UserStatusActive,
+		Roles:        []string{"reviewer"},
+		TenantID:     "default",
+	}
+}
+
+// TestAuthUseCase_Login 测试登录
+func TestAuthUseCase_Login(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("登录成功签发令牌并记录刷新令牌", func(t *testing.T) {
+		uc, m := newAuthUseCaseWithMocks(t)
+		u := newTestUser(t, m.passwords, "correct-horse")
+		m.userRepo.On("GetByUsername", ctx, "alice").Return(u, nil)
+		m.userRepo.On("RecordLogin", ctx, u.ID, mock.Anything).Return(nil)
+		m.roleRepo.On("ListByNames", ctx, []string{"reviewer"}).Return([]*ent.Role{
+			{Name: "reviewer", Permissions: []string{"task:complete", "task:claim"}},
+		}, nil)
+		m.tokenRepo.On("Create", ctx, mock.Anything).Return(&ent.RefreshToken{}, nil)
+
+		pair, err := uc.Login(ctx, &LoginRequest{Username: "alice", Password: "correct-horse"})
+
+		require.NoError(t, err, "登录不应该返回错误")
+		claims, err := m.tokens.ValidateAccessToken(ctx, pair.AccessToken)
+		require.NoError(t, err, "访问令牌应该有效")
+		assert.Equal(t, u.ID, claims.UserID, "令牌应该携带用户ID")
+		assert.ElementsMatch(t, []string{"task:complete", "task:claim"}, claims.Permissions, "令牌应该携带角色授予的权限")
+		assert.NotEmpty(t, claims.SessionID, "令牌应该携带会话ID")
+
+		record := m.tokenRepo.Calls[0].Arguments.Get(1).(*ent.RefreshToken)
+		assert.Equal(t, pair.RefreshTokenID, record.TokenID, "应该记录刷新令牌的 jti")
+		assert.Equal(t, claims.SessionID, record.FamilyID, "令牌族应该与会话ID一致")
+		assert.Equal(t, claims.ID, record.AccessTokenID, "应该记录同时签发的访问令牌")
+	})
+
+	t.Run("用户不存在与密码错误返回相同错误", func(t *testing.T) {
+		uc, m := newAuthUseCaseWithMocks(t)
+		u := newTestUser(t, m.passwords, "correct-horse")
+		m.userRepo.On("GetByUsername", ctx, "alice").Return(u, nil)
+		m.userRepo.On("GetByUsername", ctx, "nobody").Return(nil, ErrUserNotFound)
+
+		_, err := uc.Login(ctx, &LoginRequest{Username: "alice", Password: "wrong-horse"})
+		assert.ErrorIs(t, err, ErrInvalidCredentials, "密码错误应该返回凭据无效")
+
+		_, err = uc.Login(ctx, &LoginRequest{Username: "nobody", Password: "wrong-horse"})
+		assert.ErrorIs(t, err, ErrInvalidCredentials, "用户不存在应该返回凭据无效")
+		m.tokenRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("停用的用户不能登录", func(t *testing.T) {
+		uc, m := newAuthUseCaseWithMocks(t)
+		u := newTestUser(t, m.passwords, "correct-horse")
+		u.Status = UserStatusDisabled
+		m.userRepo.On("GetByUsername", ctx, "alice").Return(u, nil)
+
+		_, err := uc.Login(ctx, &LoginRequest{Username: "alice", Password: "correct-horse"})
+
+		assert.ErrorIs(t, err, ErrUserDisabled, "应该返回用户已停用")
+	})
+
+	t.Run("哈希参数变化后登录时重新计算哈希", func(t *testing.T) {
+		uc, m := newAuthUseCaseWithMocks(t)
+		legacy, err := auth.NewPasswordHasher(auth.PasswordConfig{Algorithm: auth.PasswordHashBcrypt, Cost: bcrypt.MinCost + 1})
+		require.NoError(t, err)
+		u := newTestUser(t, legacy, "correct-horse")
+		m.userRepo.On("GetByUsername", ctx, "alice").Return(u, nil)
+		m.userRepo.On("RehashPassword", ctx, u.ID, mock.Anything).Return(nil)
+		m.userRepo.On("RecordLogin", ctx, u.ID, mock.Anything).Return(nil)
+		m.roleRepo.On("ListByNames", ctx, u.Roles).Return([]*ent.Role{}, nil)
+		m.tokenRepo.On("Create", ctx, mock.Anything).Return(&ent.RefreshToken{}, nil)
+
+		_, err = uc.Login(ctx, &LoginRequest{Username: "alice", Password: "correct-horse"})
+
+		require.NoError(t, err, "登录不应该返回错误")
+		rehashed := m.userRepo.Calls[1].Arguments.String(2)
+		assert.False(t, m.passwords.NeedsRehash(rehashed), "新哈希应该使用当前参数")
+		assert.NoError(t, m.passwords.Verify(rehashed, "correct-horse"), "新哈希应该能校验原密码")
+	})
+}
+
+// TestAuthUseCase_Refresh 测试刷新令牌轮换与重用检测
+func TestAuthUseCase_Refresh(t *testing.T) {
+	ctx := context.Background()
+
+	// issueRefreshToken 签发一个刷新令牌，返回令牌与对应的记录
+	issueRefreshToken := func(t *testing.T, m *authMocks) (*auth.TokenPair, *ent.RefreshToken) {
+		pair, err := m.tokens.IssueTokenPair(&auth.TokenRequest{UserID: 7, Username: "alice", SessionID: "family-1"})
+		require.NoError(t, err, "签发令牌不应该返回错误")
+		return pair, &ent.RefreshToken{
+			TokenID:         pair.RefreshTokenID,
+			FamilyID:        "family-1",
+			UserID:          7,
+			AccessTokenID:   pair.AccessTokenID,
+			AccessExpiresAt: pair.ExpiresAt,
+			ExpiresAt:       pair.RefreshExpiresAt,
+		}
+	}
+
+	t.Run("刷新后签发同一令牌族的新令牌对", func(t *testing.T) {
+		uc, m := newAuthUseCaseWithMocks(t)
+		pair, record := issueRefreshToken(t, m)
+		u := newTestUser(t, m.passwords, "correct-horse")
+		m.tokenRepo.On("GetByTokenID", ctx, record.TokenID).Return(record, nil)
+		m.tokenRepo.On("MarkUsed", ctx, record.TokenID, mock.Anything).Return(true, nil)
+		m.tokenRepo.On("Create", ctx, mock.Anything).Return(&ent.RefreshToken{}, nil)
+		m.userRepo.On("GetByID", ctx, int64(7)).Return(u, nil)
+		m.roleRepo.On("ListByNames", ctx, u.Roles).Return([]*ent.Role{}, nil)
+
+		next, err := uc.Refresh(ctx, pair.RefreshToken)
+
+		require.NoError(t, err, "刷新不应该返回错误")
+		assert.NotEqual(t, pair.RefreshTokenID, next.RefreshTokenID, "应该签发新的刷新令牌")
+		created := m.tokenRepo.Calls[2].Arguments.Get(1).(*ent.RefreshToken)
+		assert.Equal(t, "family-1", created.FamilyID, "新令牌应该属于同一令牌族")
+		assert.Equal(t, record.TokenID, created.ParentTokenID, "新令牌应该记录上一个令牌")
+	})
+
+	t.Run("重用已使用的刷新令牌吊销整个令牌族", func(t *testing.T) {
+		uc, m := newAuthUseCaseWithMocks(t)
+		pair, record := issueRefreshToken(t, m)
+		sibling, _ := issueRefreshToken(t, m)
+		m.tokenRepo.On("GetByTokenID", ctx, record.TokenID).Return(record, nil)
+		m.tokenRepo.On("MarkUsed", ctx, record.TokenID, mock.Anything).Return(false, nil)
+		m.tokenRepo.On("RevokeFamily", ctx, "family-1", mock.Anything).Return([]*ent.RefreshToken{
+			{AccessTokenID: sibling.AccessTokenID, AccessExpiresAt: sibling.ExpiresAt},
+		}, nil)
+
+		_, err := uc.Refresh(ctx, pair.RefreshToken)
+
+		assert.ErrorIs(t, err, ErrRefreshTokenReused, "应该返回刷新令牌重用")
+		m.tokenRepo.AssertCalled(t, "RevokeFamily", ctx, "family-1", mock.Anything)
+		_, err = m.tokens.ValidateAccessToken(ctx, sibling.AccessToken)
+		assert.Error(t, err, "令牌族中的访问令牌应该被吊销")
+	})
+
+	t.Run("已吊销的令牌族不能刷新", func(t *testing.T) {
+		uc, m := newAuthUseCaseWithMocks(t)
+		pair, record := issueRefreshToken(t, m)
+		revokedAt := time.Now()
+		record.RevokedAt = &revokedAt
+		m.tokenRepo.On("GetByTokenID", ctx, record.TokenID).Return(record, nil)
+
+		_, err := uc.Refresh(ctx, pair.RefreshToken)
+
+		assert.ErrorIs(t, err, ErrInvalidRefreshToken, "应该返回刷新令牌无效")
+		m.tokenRepo.AssertNotCalled(t, "MarkUsed", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("访问令牌不能用于刷新", func(t *testing.T) {
+		uc, m := newAuthUseCaseWithMocks(t)
+		pair, _ := issueRefreshToken(t, m)
+
+		_, err := uc.Refresh(ctx, pair.AccessToken)
+
+		assert.ErrorIs(t, err, ErrInvalidRefreshToken, "应该返回刷新令牌无效")
+	})
+}
+
+// TestAuthUseCase_Logout 测试登出
+func TestAuthUseCase_Logout(t *testing.T) {
+	ctx := context.Background()
+
+	for name, enableBlacklist := range map[string]bool{
+		"启用令牌吊销时吊销访问令牌与会话": true,
+		"未启用令牌吊销时只吊销会话":    false,
+	} {
+		t.Run(name, func(t *testing.T) {
+			uc, m := newAuthUseCaseWithRevocation(t, enableBlacklist)
+			pair, err := m.tokens.IssueTokenPair(&auth.TokenRequest{UserID: 7, Username: "alice", SessionID: "family-1"})
+			require.NoError(t, err, "签发令牌不应该返回错误")
+			claims, err := m.tokens.ValidateAccessToken(ctx, pair.AccessToken)
+			require.NoError(t, err, "访问令牌应该有效")
+			m.tokenRepo.On("RevokeFamily", ctx, "family-1", mock.Anything).Return([]*ent.RefreshToken{
+				{AccessTokenID: pair.AccessTokenID, AccessExpiresAt: pair.ExpiresAt},
+			}, nil)
+
+			require.NoError(t, uc.Logout(ctx, claims), "登出不应该返回错误")
+
+			m.tokenRepo.AssertCalled(t, "RevokeFamily", ctx, "family-1", mock.Anything)
+			_, err = m.tokens.ValidateAccessToken(ctx, pair.AccessToken)
+			if enableBlacklist {
+				assert.Error(t, err, "启用吊销时访问令牌应该立即失效")
+			} else {
+				assert.NoError(t, err, "未启用吊销时访问令牌在过期前仍然有效")
+			}
+		})
+	}
+}
+
+// TestAuthUseCase_RevokeUserSessions 测试强制下线吊销用户的全部会话与令牌
+func TestAuthUseCase_RevokeUserSessions(t *testing.T) {
+	ctx := context.Background()
+	uc, m := newAuthUseCaseWithMocks(t)
+	// 没有刷新令牌记录的访问令牌只能按用户吊销
+	untracked, err := m.tokens.IssueTokenPair(&auth.TokenRequest{UserID: 7, Username: "alice"})
+	require.NoError(t, err, "签发令牌不应该返回错误")
+	other, err := m.tokens.IssueTokenPair(&auth.TokenRequest{UserID: 8, Username: "bob"})
+	require.NoError(t, err, "签发令牌不应该返回错误")
+	m.tokenRepo.On("RevokeByUser", ctx, int64(7), mock.Anything).Return([]*ent.RefreshToken{}, nil)
+
+	require.NoError(t, uc.RevokeUserSessions(ctx, 7), "吊销用户会话不应该返回错误")
+
+	_, err = m.tokens.ValidateAccessToken(ctx, untracked.AccessToken)
+	assert.Error(t, err, "此前签发的访问令牌应该被吊销")
+	_, err = m.tokens.ValidateAccessToken(ctx, other.AccessToken)
+	assert.NoError(t, err, "其他用户的访问令牌不受影响")
+}
+
+// TestAuthUseCase_ChangePassword 测试修改密码
+func TestAuthUseCase_ChangePassword(t *testing.T) {
+	ctx := auth.NewContext(context.Background(), &auth.Identity{UserID: "7", Username: "alice"})
+
+	t.Run("修改密码后吊销全部会话并开启新会话", func(t *testing.T) {
+		uc, m := newAuthUseCaseWithMocks(t)
+		u := newTestUser(t, m.passwords, "correct-horse")
+		m.userRepo.On("GetByID", ctx, u.ID).Return(u, nil)
+		m.userRepo.On("UpdatePassword", ctx, u.ID, mock.Anything).Return(nil)
+		m.tokenRepo.On("RevokeByUser", ctx, u.ID, mock.Anything).Return([]*ent.RefreshToken{}, nil)
+		m.tokenRepo.On("Create", ctx, mock.Anything).Return(&ent.RefreshToken{}, nil)
+		m.roleRepo.On("ListByNames", ctx, u.Roles).Return([]*ent.Role{}, nil)
+
+		pair, err := uc.ChangePassword(ctx, &ChangePasswordRequest{OldPassword: "correct-horse", NewPassword: "battery-staple"})
+
+		require.NoError(t, err, "修改密码不应该返回错误")
+		assert.Equal(t, 1, m.tx.committed, "密码与会话吊销应该在同一事务中提交")
+		_, err = m.tokens.ValidateAccessToken(ctx, pair.AccessToken)
+		assert.NoError(t, err, "新的访问令牌不应该被吊销")
+		hash := m.userRepo.Calls[1].Arguments.String(2)
+		assert.NoError(t, m.passwords.Verify(hash, "battery-staple"), "应该保存新密码的哈希")
+		m.tokenRepo.AssertCalled(t, "RevokeByUser", ctx, u.ID, mock.Anything)
+	})
+
+	t.Run("吊销会话失败时回滚密码修改", func(t *testing.T) {
+		uc, m := newAuthUseCaseWithMocks(t)
+		u := newTestUser(t, m.passwords, "correct-horse")
+		previous, err := m.tokens.IssueTokenPair(&auth.TokenRequest{UserID: 7, Username: "alice", SessionID: "family-1"})
+		require.NoError(t, err, "签发令牌不应该返回错误")
+		m.userRepo.On("GetByID", ctx, u.ID).Return(u, nil)
+		m.userRepo.On("UpdatePassword", ctx, u.ID, mock.Anything).Return(nil)
+		m.tokenRepo.On("RevokeByUser", ctx, u.ID, mock.Anything).Return(nil, errors.New("数据库不可用"))
+
+		_, err = uc.ChangePassword(ctx, &ChangePasswordRequest{OldPassword: "correct-horse", NewPassword: "battery-staple"})
+
+		require.Error(t, err, "吊销会话失败时应该返回错误")
+		assert.Equal(t, 1, m.tx.rolledBack, "密码修改应该随事务回滚")
+		_, err = m.tokens.ValidateAccessToken(ctx, previous.AccessToken)
+		assert.NoError(t, err, "事务回滚时不应该吊销访问令牌")
+	})
+
+	t.Run("未启用令牌吊销时仍然吊销会话", func(t *testing.T) {
+		uc, m := newAuthUseCaseWithRevocation(t, false)
+		u := newTestUser(t, m.passwords, "correct-horse")
+		m.userRepo.On("GetByID", ctx, u.ID).Return(u, nil)
+		m.userRepo.On("UpdatePassword", ctx, u.ID, mock.Anything).Return(nil)
+		m.tokenRepo.On("RevokeByUser", ctx, u.ID, mock.Anything).Return([]*ent.RefreshToken{
+			{AccessTokenID: "previous", AccessExpiresAt: time.Now().Add(time.Hour)},
+		}, nil)
+		m.tokenRepo.On("Create", ctx, mock.Anything).Return(&ent.RefreshToken{}, nil)
+		m.roleRepo.On("ListByNames", ctx, u.Roles).Return([]*ent.Role{}, nil)
+
+		_, err := uc.ChangePassword(ctx, &ChangePasswordRequest{OldPassword: "correct-horse", NewPassword: "battery-staple"})
+
+		require.NoError(t, err, "未启用令牌吊销时修改密码不应该返回错误")
+		m.tokenRepo.AssertCalled(t, "RevokeByUser", ctx, u.ID, mock.Anything)
+	})
+
+	t.Run("原密码错误", func(t *testing.T) {
+		uc, m := newAuthUseCaseWithMocks(t)
+		u := newTestUser(t, m.passwords, "correct-horse")
+		m.userRepo.On("GetByID", ctx, u.ID).Return(u, nil)
+
+		_, err := uc.ChangePassword(ctx, &ChangePasswordRequest{OldPassword: "wrong-horse", NewPassword: "battery-staple"})
+
+		assert.ErrorIs(t, err, ErrInvalidCredentials, "应该返回凭据无效")
+		m.userRepo.AssertNotCalled(t, "UpdatePassword", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("新密码不符合要求", func(t *testing.T) {
+		uc, m := newAuthUseCaseWithMocks(t)
+		u := newTestUser(t, m.passwords, "correct-horse")
+		m.userRepo.On("GetByID", ctx, u.ID).Return(u, nil)
+
+		for _, password := range []string{"short", "correct-horse", "alice"} {
+			_, err := uc.ChangePassword(ctx, &ChangePasswordRequest{OldPassword: "correct-horse", NewPassword: password})
+			assert.ErrorIs(t, err, ErrWeakPassword, "密码 %q 应该被拒绝", password)
+		}
+	})
+}
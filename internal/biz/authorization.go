@@ -0,0 +1,322 @@
+// Package biz 提供业务逻辑层功能
+// 包含流程定义、流程实例与任务的资源级授权逻辑
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/workflow-engine/workflow-engine/internal/auth"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+
+	"go.uber.org/zap"
+)
+
+// 流程资源操作
+const (
+	ActionStart     = "start"     // 启动流程实例
+	ActionRead      = "read"      // 查看流程定义、流程实例、任务与历史
+	ActionUpdate    = "update"    // 修改流程实例变量
+	ActionSuspend   = "suspend"   // 挂起与激活流程定义、流程实例
+	ActionTerminate = "terminate" // 终止与删除流程实例
+	ActionMigrate   = "migrate"   // 迁移流程实例到其他流程定义版本
+	ActionAll       = "*"         // 全部操作，包括创建、修改、删除与部署流程定义
+)
+
+// 被授权主体类型
+const (
+	PrincipalUser  = "user"
+	PrincipalGroup = "group"
+)
+
+// 资源授权业务错误
+var (
+	ErrAccessDenied          = errors.New("没有操作该流程资源的权限")
+	ErrInvalidAuthorization  = errors.New("无效的授权")
+	ErrAuthorizationNotFound = errors.New("授权不存在")
+)
+
+// ResourceScope 授权范围，空字段表示不限
+type ResourceScope struct {
+	ProcessDefinitionKey string `json:"process_definition_key,omitempty"` // 流程定义Key
+	Category             string `json:"category,omitempty"`               // 流程定义分类
+	TenantID             string `json:"tenant_id,omitempty"`              // 租户ID
+}
+
+// unrestricted 检查授权范围是否不限任何流程资源
+func (s ResourceScope) unrestricted() bool {
+	return s.ProcessDefinitionKey == "" && s.Category == "" && s.TenantID == ""
+}
+
+// covers 检查授权范围是否包含流程资源
+func (s ResourceScope) covers(res *ProcessResource) bool {
+	return (s.ProcessDefinitionKey == "" || s.ProcessDefinitionKey == res.ProcessDefinitionKey) &&
+		(s.Category == "" || s.Category == res.Category) &&
+		(s.TenantID == "" || s.TenantID == res.TenantID)
+}
+
+// AccessFilter 列表查询的授权过滤条件
+// 记录属于任一授权范围，或调用方直接参与（发起人、办理人、所有人、候选人）时可见；没有授权范围时只能看到直接参与的记录
+type AccessFilter struct {
+	Scopes []ResourceScope `json:"scopes"` // 授权范围
+	User   string          `json:"user"`   // 调用方的用户标识，与发起人、办理人等记录的用户标识一致
+	Groups []string        `json:"groups"` // 调用方的用户组，用于匹配任务候选组
+}
+
+// ProcessResource 待鉴权的流程资源
+type ProcessResource struct {
+	ProcessDefinitionID  int64    // 流程定义ID，分类为空时用于查询分类
+	ProcessDefinitionKey string   // 流程定义Key
+	Category             string   // 流程定义分类
+	TenantID             string   // 租户ID
+	Involved             []string // 直接参与资源的用户，这些用户无需授权即可查看资源
+}
+
+// AuthorizationUseCase 资源授权用例
+// 按流程定义Key、分类与租户向用户或用户组授予流程操作权限，并在业务用例中校验
+// 没有调用方身份时拒绝访问；系统身份（流程引擎内部调用）、管理员角色或拥有 * 权限的调用方不受限制；
+// 角色权限 process:<操作> 或 process:* 视为不限范围的授权
+type AuthorizationUseCase struct {
+	repo           AuthorizationRepo
+	processDefRepo ProcessDefinitionRepo
+	logger         *zap.Logger
+}
+
+// NewAuthorizationUseCase 创建资源授权用例实例
+func NewAuthorizationUseCase(
+	repo AuthorizationRepo,
+	processDefRepo ProcessDefinitionRepo,
+	logger *zap.Logger,
+) *AuthorizationUseCase {
+	return &AuthorizationUseCase{
+		repo:           repo,
+		processDefRepo: processDefRepo,
+		logger:         logger,
+	}
+}
+
+// Authorize 校验调用方能否对流程资源执行操作
+func (uc *AuthorizationUseCase) Authorize(ctx context.Context, action string, res *ProcessResource) error {
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		uc.logger.Warn("缺少调用方身份，拒绝访问流程资源",
+			zap.String("action", action),
+			zap.String("process_definition_key", res.ProcessDefinitionKey))
+		return fmt.Errorf("%w: 缺少调用方身份", ErrAccessDenied)
+	}
+	if unrestrictedIdentity(identity, action) {
+		return nil
+	}
+	if action == ActionRead {
+		for _, user := range res.Involved {
+			if user != "" && user == identity.Principal() {
+				return nil
+			}
+		}
+	}
+
+	scopes, err := uc.grantedScopes(ctx, identity, action)
+	if err != nil {
+		return err
+	}
+	categoryResolved := res.Category != ""
+	for _, scope := range scopes {
+		if scope.Category != "" && !categoryResolved {
+			if err := uc.resolveCategory(ctx, res); err != nil {
+				return err
+			}
+			categoryResolved = true
+		}
+		if scope.covers(res) {
+			return nil
+		}
+	}
+
+	uc.logger.Info("流程资源访问被拒绝",
+		zap.String("user", identity.Principal()),
+		zap.String("action", action),
+		zap.String("process_definition_key", res.ProcessDefinitionKey),
+		zap.String("tenant_id", res.TenantID))
+	return fmt.Errorf("%w: %s %s", ErrAccessDenied, action, res.ProcessDefinitionKey)
+}
+
+// AccessFilter 返回调用方查询列表时的授权过滤条件，不受限制时返回 nil，没有调用方身份时拒绝访问
+func (uc *AuthorizationUseCase) AccessFilter(ctx context.Context, action string) (*AccessFilter, error) {
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		uc.logger.Warn("缺少调用方身份，拒绝查询流程资源", zap.String("action", action))
+		return nil, fmt.Errorf("%w: 缺少调用方身份", ErrAccessDenied)
+	}
+	if unrestrictedIdentity(identity, action) {
+		return nil, nil
+	}
+
+	scopes, err := uc.grantedScopes(ctx, identity, action)
+	if err != nil {
+		return nil, err
+	}
+	for _, scope := range scopes {
+		if scope.unrestricted() {
+			return nil, nil
+		}
+	}
+	return &AccessFilter{
+		Scopes: scopes,
+		User:   identity.Principal(),
+		Groups: identity.CandidateGroups(),
+	}, nil
+}
+
+// CreateGrant 创建授权
+func (uc *AuthorizationUseCase) CreateGrant(ctx context.Context, req *CreateAuthorizationRequest) (*AuthorizationResponse, error) {
+	if req.PrincipalType != PrincipalUser && req.PrincipalType != PrincipalGroup {
+		return nil, fmt.Errorf("%w: 主体类型必须是 user 或 group", ErrInvalidAuthorization)
+	}
+	if req.PrincipalID == "" {
+		return nil, fmt.Errorf("%w: 被授权主体不能为空", ErrInvalidAuthorization)
+	}
+	if len(req.Actions) == 0 {
+		return nil, fmt.Errorf("%w: 授予的操作不能为空", ErrInvalidAuthorization)
+	}
+	for _, action := range req.Actions {
+		if !validAction(action) {
+			return nil, fmt.Errorf("%w: 不支持的操作 %s", ErrInvalidAuthorization, action)
+		}
+	}
+
+	grant, err := uc.repo.Create(ctx, &ent.Authorization{
+		PrincipalType:        req.PrincipalType,
+		PrincipalID:          req.PrincipalID,
+		ProcessDefinitionKey: req.ProcessDefinitionKey,
+		Category:             req.Category,
+		TenantID:             req.TenantID,
+		Actions:              req.Actions,
+		CreatedBy:            auth.CurrentUserID(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("授权创建成功",
+		zap.Int64("id", grant.ID),
+		zap.String("principal_type", grant.PrincipalType),
+		zap.String("principal_id", grant.PrincipalID),
+		zap.Strings("actions", grant.Actions))
+	return toAuthorizationResponse(grant), nil
+}
+
+// ListGrants 查询授权
+func (uc *AuthorizationUseCase) ListGrants(ctx context.Context, req *ListAuthorizationsRequest) ([]*AuthorizationResponse, error) {
+	grants, err := uc.repo.List(ctx, &AuthorizationFilter{
+		PrincipalType:        req.PrincipalType,
+		PrincipalID:          req.PrincipalID,
+		ProcessDefinitionKey: req.ProcessDefinitionKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*AuthorizationResponse, 0, len(grants))
+	for _, grant := range grants {
+		items = append(items, toAuthorizationResponse(grant))
+	}
+	return items, nil
+}
+
+// DeleteGrant 删除授权，立即生效
+func (uc *AuthorizationUseCase) DeleteGrant(ctx context.Context, id string) error {
+	grantID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrAuthorizationNotFound, id)
+	}
+	if err := uc.repo.Delete(ctx, grantID); err != nil {
+		return err
+	}
+	uc.logger.Info("授权删除成功", zap.Int64("id", grantID))
+	return nil
+}
+
+// grantedScopes 返回授予调用方本人或其用户组、且允许该操作的授权范围
+func (uc *AuthorizationUseCase) grantedScopes(ctx context.Context, identity *auth.Identity, action string) ([]ResourceScope, error) {
+	users := []string{identity.UserID}
+	if identity.Username != "" && identity.Username != identity.UserID {
+		users = append(users, identity.Username)
+	}
+	grants, err := uc.repo.ListByPrincipals(ctx, users, identity.CandidateGroups())
+	if err != nil {
+		return nil, fmt.Errorf("查询授权失败: %w", err)
+	}
+
+	scopes := make([]ResourceScope, 0, len(grants))
+	for _, grant := range grants {
+		if !grantAllows(grant.Actions, action) {
+			continue
+		}
+		scopes = append(scopes, ResourceScope{
+			ProcessDefinitionKey: grant.ProcessDefinitionKey,
+			Category:             grant.Category,
+			TenantID:             grant.TenantID,
+		})
+	}
+	return scopes, nil
+}
+
+// resolveCategory 按流程定义补全资源的分类
+func (uc *AuthorizationUseCase) resolveCategory(ctx context.Context, res *ProcessResource) error {
+	var pd *ent.ProcessDefinition
+	var err error
+	switch {
+	case res.ProcessDefinitionID != 0:
+		pd, err = uc.processDefRepo.GetByID(ctx, strconv.FormatInt(res.ProcessDefinitionID, 10))
+	case res.ProcessDefinitionKey != "":
+		pd, err = uc.processDefRepo.GetLatestByKey(ctx, res.ProcessDefinitionKey)
+	default:
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("获取流程定义分类失败: %w", err)
+	}
+	res.Category = pd.Category
+	return nil
+}
+
+// unrestrictedIdentity 检查调用方是否不受资源授权限制
+// 系统身份、管理员角色、* 权限，以及 process:<操作>、process:* 角色权限不限资源范围
+func unrestrictedIdentity(identity *auth.Identity, action string) bool {
+	return identity.IsSystem() || auth.MatchPermission(identity.Roles, identity.Permissions, "process:"+action)
+}
+
+// grantAllows 检查授予的操作是否包含该操作
+func grantAllows(actions []string, action string) bool {
+	for _, a := range actions {
+		if a == action || a == ActionAll {
+			return true
+		}
+	}
+	return false
+}
+
+// validAction 检查是否为支持的操作
+func validAction(action string) bool {
+	switch action {
+	case ActionStart, ActionRead, ActionUpdate, ActionSuspend, ActionTerminate, ActionMigrate, ActionAll:
+		return true
+	}
+	return false
+}
+
+// toAuthorizationResponse 转换授权响应
+func toAuthorizationResponse(grant *ent.Authorization) *AuthorizationResponse {
+	return &AuthorizationResponse{
+		ID:                   strconv.FormatInt(grant.ID, 10),
+		PrincipalType:        grant.PrincipalType,
+		PrincipalID:          grant.PrincipalID,
+		ProcessDefinitionKey: grant.ProcessDefinitionKey,
+		Category:             grant.Category,
+		TenantID:             grant.TenantID,
+		Actions:              grant.Actions,
+		CreatedBy:            grant.CreatedBy,
+		CreatedAt:            grant.CreatedAt,
+	}
+}
@@ -0,0 +1,200 @@
+// Package biz 提供业务逻辑层功能的测试
+// 包含流程资源鉴权、列表授权过滤与授权管理的单元测试
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/workflow-engine/workflow-engine/internal/auth"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+)
+
+// systemContext 返回携带系统身份的上下文，不受资源授权限制，用于不关注鉴权的用例测试
+func systemContext() context.Context {
+	return auth.NewSystemContext(context.Background())
+}
+
+// MockAuthorizationRepo 模拟资源授权仓储
+type MockAuthorizationRepo struct {
+	mock.Mock
+}
+
+func (m *MockAuthorizationRepo) Create(ctx context.Context, a *ent.Authorization) (*ent.Authorization, error) {
+	args := m.Called(ctx, a)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.Authorization), args.Error(1)
+}
+
+func (m *MockAuthorizationRepo) Delete(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockAuthorizationRepo) List(ctx context.Context, filter *AuthorizationFilter) ([]*ent.Authorization, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.Authorization), args.Error(1)
+}
+
+func (m *MockAuthorizationRepo) ListByPrincipals(ctx context.Context, users []string, groups []string) ([]*ent.Authorization, error) {
+	args := m.Called(ctx, users, groups)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.Authorization), args.Error(1)
+}
+
+// TestAuthorizationUseCase_Authorize 测试流程资源鉴权
+func TestAuthorizationUseCase_Authorize(t *testing.T) {
+	leave := &ProcessResource{ProcessDefinitionID: 1, ProcessDefinitionKey: "leave", TenantID: "t1"}
+	alice := &auth.Identity{UserID: "7", Username: "alice", Roles: []string{"employee"}}
+
+	t.Run("系统身份、管理员与角色权限不受限制", func(t *testing.T) {
+		uc := NewAuthorizationUseCase(nil, nil, zap.NewNop())
+
+		assert.NoError(t, uc.Authorize(auth.NewSystemContext(context.Background()), ActionTerminate, leave), "系统身份不应该受限制")
+		admin := auth.NewContext(context.Background(), &auth.Identity{UserID: "1", Roles: []string{AdminRole}})
+		assert.NoError(t, uc.Authorize(admin, ActionTerminate, leave), "管理员不应该受限制")
+		starter := auth.NewContext(context.Background(), &auth.Identity{UserID: "2", Permissions: []string{"process:start"}})
+		assert.NoError(t, uc.Authorize(starter, ActionStart, leave), "拥有 process:start 权限时可以启动任意流程")
+	})
+
+	t.Run("没有调用方身份时拒绝访问", func(t *testing.T) {
+		repo := &MockAuthorizationRepo{}
+		uc := NewAuthorizationUseCase(repo, nil, zap.NewNop())
+
+		assert.ErrorIs(t, uc.Authorize(context.Background(), ActionRead, leave), ErrAccessDenied, "没有调用方身份时应该拒绝访问")
+		repo.AssertNotCalled(t, "ListByPrincipals", mock.Anything, mock.Anything, mock.Anything)
+
+		ctx := auth.NewContext(context.Background(), &auth.Identity{UserID: "3", Username: auth.SystemUserID})
+		repo.On("ListByPrincipals", ctx, []string{"3", auth.SystemUserID}, []string{}).Return([]*ent.Authorization{}, nil)
+		assert.ErrorIs(t, uc.Authorize(ctx, ActionRead, leave), ErrAccessDenied, "用户名为 system 的普通用户不是系统身份")
+	})
+
+	t.Run("发起人无需授权即可查看", func(t *testing.T) {
+		repo := &MockAuthorizationRepo{}
+		uc := NewAuthorizationUseCase(repo, nil, zap.NewNop())
+		ctx := auth.NewContext(context.Background(), alice)
+		repo.On("ListByPrincipals", ctx, []string{"7", "alice"}, []string{"employee"}).Return([]*ent.Authorization{}, nil)
+
+		res := &ProcessResource{ProcessDefinitionKey: "leave", Involved: []string{"alice"}}
+		assert.NoError(t, uc.Authorize(ctx, ActionRead, res), "发起人应该可以查看自己的流程实例")
+		assert.ErrorIs(t, uc.Authorize(ctx, ActionTerminate, res), ErrAccessDenied, "发起人没有授权时不能终止流程实例")
+	})
+
+	t.Run("按用户与用户组授权", func(t *testing.T) {
+		repo := &MockAuthorizationRepo{}
+		uc := NewAuthorizationUseCase(repo, nil, zap.NewNop())
+		ctx := auth.NewContext(context.Background(), alice)
+		repo.On("ListByPrincipals", ctx, []string{"7", "alice"}, []string{"employee"}).Return([]*ent.Authorization{
+			{PrincipalType: PrincipalUser, PrincipalID: "alice", ProcessDefinitionKey: "leave", Actions: []string{ActionStart, ActionRead}},
+			{PrincipalType: PrincipalGroup, PrincipalID: "employee", TenantID: "t2", Actions: []string{ActionAll}},
+		}, nil)
+
+		assert.NoError(t, uc.Authorize(ctx, ActionStart, leave), "用户授权应该允许启动")
+		assert.ErrorIs(t, uc.Authorize(ctx, ActionSuspend, leave), ErrAccessDenied, "未授予的操作应该被拒绝")
+		assert.NoError(t, uc.Authorize(ctx, ActionSuspend, &ProcessResource{ProcessDefinitionKey: "expense", TenantID: "t2"}), "用户组授权应该覆盖租户下的全部流程")
+		assert.ErrorIs(t, uc.Authorize(ctx, ActionSuspend, &ProcessResource{ProcessDefinitionKey: "expense", TenantID: "t1"}), ErrAccessDenied, "其他租户的流程应该被拒绝")
+	})
+
+	t.Run("按分类授权时查询流程定义分类", func(t *testing.T) {
+		repo := &MockAuthorizationRepo{}
+		defRepo := &MockProcessDefinitionRepo{}
+		uc := NewAuthorizationUseCase(repo, defRepo, zap.NewNop())
+		ctx := auth.NewContext(context.Background(), alice)
+		repo.On("ListByPrincipals", ctx, []string{"7", "alice"}, []string{"employee"}).Return([]*ent.Authorization{
+			{PrincipalType: PrincipalGroup, PrincipalID: "employee", Category: "hr", Actions: []string{ActionRead}},
+		}, nil)
+		defRepo.On("GetByID", ctx, "1").Return(&ent.ProcessDefinition{ID: 1, Key: "leave", Category: "hr"}, nil)
+		defRepo.On("GetLatestByKey", ctx, "expense").Return(&ent.ProcessDefinition{ID: 2, Key: "expense", Category: "finance"}, nil)
+
+		assert.NoError(t, uc.Authorize(ctx, ActionRead, &ProcessResource{ProcessDefinitionID: 1, ProcessDefinitionKey: "leave"}), "分类匹配时应该允许查看")
+		assert.ErrorIs(t, uc.Authorize(ctx, ActionRead, &ProcessResource{ProcessDefinitionKey: "expense"}), ErrAccessDenied, "分类不匹配时应该被拒绝")
+		defRepo.AssertExpectations(t)
+	})
+}
+
+// TestAuthorizationUseCase_AccessFilter 测试列表查询的授权过滤条件
+func TestAuthorizationUseCase_AccessFilter(t *testing.T) {
+	bob := &auth.Identity{UserID: "8", Username: "bob", Groups: []string{"finance"}}
+
+	t.Run("不受限制时不过滤", func(t *testing.T) {
+		repo := &MockAuthorizationRepo{}
+		uc := NewAuthorizationUseCase(repo, nil, zap.NewNop())
+
+		filter, err := uc.AccessFilter(auth.NewSystemContext(context.Background()), ActionRead)
+		require.NoError(t, err, "系统身份不应该返回错误")
+		assert.Nil(t, filter, "系统身份不应该过滤")
+		_, err = uc.AccessFilter(context.Background(), ActionRead)
+		assert.ErrorIs(t, err, ErrAccessDenied, "没有调用方身份时应该拒绝查询")
+
+		ctx := auth.NewContext(context.Background(), bob)
+		repo.On("ListByPrincipals", ctx, []string{"8", "bob"}, []string{"finance"}).Return([]*ent.Authorization{
+			{PrincipalType: PrincipalUser, PrincipalID: "bob", Actions: []string{ActionRead}},
+		}, nil)
+		filter, err = uc.AccessFilter(ctx, ActionRead)
+		require.NoError(t, err, "查询授权过滤条件不应该返回错误")
+		assert.Nil(t, filter, "不限范围的授权不应该过滤")
+	})
+
+	t.Run("按授予该操作的授权范围过滤", func(t *testing.T) {
+		repo := &MockAuthorizationRepo{}
+		uc := NewAuthorizationUseCase(repo, nil, zap.NewNop())
+		ctx := auth.NewContext(context.Background(), bob)
+		repo.On("ListByPrincipals", ctx, []string{"8", "bob"}, []string{"finance"}).Return([]*ent.Authorization{
+			{PrincipalType: PrincipalGroup, PrincipalID: "finance", Category: "finance", Actions: []string{ActionRead}},
+			{PrincipalType: PrincipalUser, PrincipalID: "bob", Actions: []string{ActionStart}},
+		}, nil)
+
+		filter, err := uc.AccessFilter(ctx, ActionRead)
+		require.NoError(t, err, "查询授权过滤条件不应该返回错误")
+		require.NotNil(t, filter, "有范围限制的授权应该过滤")
+		assert.Equal(t, []ResourceScope{{Category: "finance"}}, filter.Scopes, "应该只包含授予查看操作的范围")
+		assert.Equal(t, "bob", filter.User, "应该携带调用方用户标识")
+		assert.Equal(t, []string{"finance"}, filter.Groups, "应该携带调用方用户组")
+	})
+}
+
+// TestAuthorizationUseCase_CreateGrant 测试创建授权
+func TestAuthorizationUseCase_CreateGrant(t *testing.T) {
+	t.Run("校验授权参数", func(t *testing.T) {
+		repo := &MockAuthorizationRepo{}
+		uc := NewAuthorizationUseCase(repo, nil, zap.NewNop())
+		ctx := context.Background()
+
+		_, err := uc.CreateGrant(ctx, &CreateAuthorizationRequest{PrincipalType: "role", PrincipalID: "alice", Actions: []string{ActionRead}})
+		assert.ErrorIs(t, err, ErrInvalidAuthorization, "不支持的主体类型应该被拒绝")
+		_, err = uc.CreateGrant(ctx, &CreateAuthorizationRequest{PrincipalType: PrincipalUser, PrincipalID: "alice", Actions: []string{"delete"}})
+		assert.ErrorIs(t, err, ErrInvalidAuthorization, "不支持的操作应该被拒绝")
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("记录授权人", func(t *testing.T) {
+		repo := &MockAuthorizationRepo{}
+		uc := NewAuthorizationUseCase(repo, nil, zap.NewNop())
+		ctx := auth.NewContext(context.Background(), &auth.Identity{UserID: "1", Username: "admin", Roles: []string{AdminRole}})
+		repo.On("Create", ctx, mock.Anything).Return(&ent.Authorization{
+			ID: 3, PrincipalType: PrincipalGroup, PrincipalID: "finance", Category: "finance", Actions: []string{ActionRead}, CreatedBy: "admin",
+		}, nil)
+
+		resp, err := uc.CreateGrant(ctx, &CreateAuthorizationRequest{
+			PrincipalType: PrincipalGroup, PrincipalID: "finance", Category: "finance", Actions: []string{ActionRead},
+		})
+		require.NoError(t, err, "创建授权不应该返回错误")
+		assert.Equal(t, "3", resp.ID, "授权ID应该匹配")
+
+		grant := repo.Calls[0].Arguments.Get(1).(*ent.Authorization)
+		assert.Equal(t, "admin", grant.CreatedBy, "应该记录授权人")
+		assert.Equal(t, "finance", grant.Category, "授权范围应该匹配")
+	})
+}
@@ -3,6 +3,7 @@
 package biz
 
 import (
+	"io"
 	"time"
 )
 
@@ -14,7 +15,7 @@ type CreateProcessDefinitionRequest struct {
 	Name        string `json:"name" validate:"required"`     // 流程名称
 	Description string `json:"description"`                  // 流程描述
 	Category    string `json:"category"`                     // 流程分类
-	Resource    string `json:"resource" validate:"required"` // 流程资源(JSON格式)
+	Resource    string `json:"resource" validate:"required"` // 流程资源(JSON或BPMN 2.0 XML格式)
 	TenantID    string `json:"tenant_id"`                    // 租户ID
 	Version     int32  `json:"-"`                            // 版本号(内部使用)
 }
@@ -24,44 +25,45 @@ type UpdateProcessDefinitionRequest struct {
 	Name        string `json:"name"`        // 流程名称
 	Description string `json:"description"` // 流程描述
 	Category    string `json:"category"`    // 流程分类
-	Resource    string `json:"resource"`    // 流程资源(JSON格式)
+	Resource    string `json:"resource"`    // 流程资源(JSON或BPMN 2.0 XML格式)
 }
 
 // ProcessDefinitionResponse 流程定义响应
 type ProcessDefinitionResponse struct {
-	ID          string    `json:"id"`          // 流程定义ID
-	Key         string    `json:"key"`         // 流程唯一标识
-	Name        string    `json:"name"`        // 流程名称
-	Description string    `json:"description"` // 流程描述
-	Category    string    `json:"category"`    // 流程分类
-	Version     int32     `json:"version"`     // 版本号
-	Resource    string    `json:"resource"`    // 流程资源
-	Suspended   bool      `json:"suspended"`   // 是否挂起
-	TenantID    string    `json:"tenant_id"`   // 租户ID
-	DeployTime  time.Time `json:"deploy_time"` // 部署时间
-	CreatedAt   time.Time `json:"created_at"`  // 创建时间
-	UpdatedAt   time.Time `json:"updated_at"`  // 更新时间
+	ID          string                 `json:"id"`                     // 流程定义ID
+	Key         string                 `json:"key"`                    // 流程唯一标识
+	Name        string                 `json:"name"`                   // 流程名称
+	Description string                 `json:"description"`            // 流程描述
+	Category    string                 `json:"category"`               // 流程分类
+	Version     int32                  `json:"version"`                // 版本号
+	Resource    string                 `json:"resource"`               // 流程资源
+	DiagramData map[string]interface{} `json:"diagram_data,omitempty"` // 流程图布局
+	Suspended   bool                   `json:"suspended"`              // 是否挂起
+	TenantID    string                 `json:"tenant_id"`              // 租户ID
+	DeployTime  time.Time              `json:"deploy_time"`            // 部署时间
+	CreatedAt   time.Time              `json:"created_at"`             // 创建时间
+	UpdatedAt   time.Time              `json:"updated_at"`             // 更新时间
 }
 
 // ListProcessDefinitionsRequest 查询流程定义列表请求
 type ListProcessDefinitionsRequest struct {
 	// 分页参数
-	Page     int `json:"page" validate:"min=1"`      // 页码，从1开始
-	PageSize int `json:"page_size" validate:"min=1"` // 每页大小
+	Page     int `json:"page" form:"page" validate:"min=1"`           // 页码，从1开始
+	PageSize int `json:"page_size" form:"page_size" validate:"min=1"` // 每页大小
 
 	// 排序参数
-	OrderBy string `json:"order_by"` // 排序字段：name, created_at, version
-	Order   string `json:"order"`    // 排序方向：asc, desc
+	OrderBy string `json:"order_by" form:"order_by"` // 排序字段：name, created_at, version
+	Order   string `json:"order" form:"order"`       // 排序方向：asc, desc
 
 	// 搜索参数
-	Search string `json:"search"` // 搜索关键词
+	Search string `json:"search" form:"search"` // 搜索关键词
 
 	// 过滤参数
-	Name        string     `json:"name"`         // 按名称过滤
-	Category    string     `json:"category"`     // 按分类过滤
-	Status      string     `json:"status"`       // 按状态过滤：active, suspended
-	CreatedFrom *time.Time `json:"created_from"` // 创建时间起始
-	CreatedTo   *time.Time `json:"created_to"`   // 创建时间结束
+	Name        string     `json:"name" form:"name"`                 // 按名称过滤
+	Category    string     `json:"category" form:"category"`         // 按分类过滤
+	Status      string     `json:"status" form:"status"`             // 按状态过滤：active, suspended
+	CreatedFrom *time.Time `json:"created_from" form:"created_from"` // 创建时间起始
+	CreatedTo   *time.Time `json:"created_to" form:"created_to"`     // 创建时间结束
 }
 
 // ListProcessDefinitionsResponse 查询流程定义列表响应
@@ -83,6 +85,11 @@ type StartProcessInstanceRequest struct {
 	TenantID             string                 `json:"tenant_id"`              // 租户ID
 }
 
+// MigrateProcessInstanceRequest 迁移流程实例请求
+type MigrateProcessInstanceRequest struct {
+	ProcessDefinitionID string `json:"process_definition_id" validate:"required"` // 目标流程定义ID，须为同一流程的其他版本
+}
+
 // ProcessInstanceResponse 流程实例响应
 type ProcessInstanceResponse struct {
 	ID                  string                 `json:"id"`                    // 流程实例ID
@@ -101,6 +108,8 @@ type ProcessInstanceResponse struct {
 	IsSuspended         bool                   `json:"is_suspended"`          // 是否挂起
 	TenantID            string                 `json:"tenant_id"`             // 租户ID
 	Variables           map[string]interface{} `json:"variables"`             // 流程变量
+	WorkflowID          string                 `json:"workflow_id"`           // 工作流ID
+	WorkflowRunID       string                 `json:"workflow_run_id"`       // 工作流运行ID
 	CreatedAt           time.Time              `json:"created_at"`            // 创建时间
 	UpdatedAt           time.Time              `json:"updated_at"`            // 更新时间
 }
@@ -108,25 +117,25 @@ type ProcessInstanceResponse struct {
 // ListProcessInstancesRequest 查询流程实例列表请求
 type ListProcessInstancesRequest struct {
 	// 分页参数
-	Page     int `json:"page" validate:"min=1"`      // 页码
-	PageSize int `json:"page_size" validate:"min=1"` // 每页大小
+	Page     int `json:"page" form:"page" validate:"min=1"`           // 页码
+	PageSize int `json:"page_size" form:"page_size" validate:"min=1"` // 每页大小
 
 	// 排序参数
-	OrderBy string `json:"order_by"` // 排序字段
-	Order   string `json:"order"`    // 排序方向
+	OrderBy string `json:"order_by" form:"order_by"` // 排序字段
+	Order   string `json:"order" form:"order"`       // 排序方向
 
 	// 搜索参数
-	Search string `json:"search"` // 搜索关键词
+	Search string `json:"search" form:"search"` // 搜索关键词
 
 	// 过滤参数
-	ProcessDefinitionID string     `json:"process_definition_id"` // 按流程定义ID过滤
-	BusinessKey         string     `json:"business_key"`          // 按业务键过滤
-	StartUserID         string     `json:"start_user_id"`         // 按启动用户过滤
-	IsActive            *bool      `json:"is_active"`             // 按激活状态过滤
-	IsEnded             *bool      `json:"is_ended"`              // 按结束状态过滤
-	IsSuspended         *bool      `json:"is_suspended"`          // 按挂起状态过滤
-	StartedFrom         *time.Time `json:"started_from"`          // 开始时间起始
-	StartedTo           *time.Time `json:"started_to"`            // 开始时间结束
+	ProcessDefinitionID string     `json:"process_definition_id" form:"process_definition_id"` // 按流程定义ID过滤
+	BusinessKey         string     `json:"business_key" form:"business_key"`                   // 按业务键过滤
+	StartUserID         string     `json:"start_user_id" form:"start_user_id"`                 // 按启动用户过滤
+	IsActive            *bool      `json:"is_active" form:"is_active"`                         // 按激活状态过滤
+	IsEnded             *bool      `json:"is_ended" form:"is_ended"`                           // 按结束状态过滤
+	IsSuspended         *bool      `json:"is_suspended" form:"is_suspended"`                   // 按挂起状态过滤
+	StartedFrom         *time.Time `json:"started_from" form:"started_from"`                   // 开始时间起始
+	StartedTo           *time.Time `json:"started_to" form:"started_to"`                       // 开始时间结束
 }
 
 // ListProcessInstancesResponse 查询流程实例列表响应
@@ -139,26 +148,35 @@ type ListProcessInstancesResponse struct {
 
 // TaskInstanceResponse 任务实例响应
 type TaskInstanceResponse struct {
-	ID                  string                 `json:"id"`                    // 任务ID
-	ProcessInstanceID   string                 `json:"process_instance_id"`   // 流程实例ID
-	ProcessDefinitionID string                 `json:"process_definition_id"` // 流程定义ID
-	Name                string                 `json:"name"`                  // 任务名称
-	Description         string                 `json:"description"`           // 任务描述
-	TaskDefinitionKey   string                 `json:"task_definition_key"`   // 任务定义键
-	Priority            int32                  `json:"priority"`              // 优先级
-	CreateTime          time.Time              `json:"create_time"`           // 创建时间
-	ClaimTime           *time.Time             `json:"claim_time"`            // 认领时间
-	DueDate             *time.Time             `json:"due_date"`              // 到期时间
-	Category            string                 `json:"category"`              // 任务分类
-	Owner               string                 `json:"owner"`                 // 拥有者
-	Assignee            string                 `json:"assignee"`              // 委派人
-	Delegation          string                 `json:"delegation"`            // 委派状态
-	FormKey             string                 `json:"form_key"`              // 表单键
-	IsSuspended         bool                   `json:"is_suspended"`          // 是否挂起
-	TenantID            string                 `json:"tenant_id"`             // 租户ID
-	Variables           map[string]interface{} `json:"variables"`             // 任务变量
-	CreatedAt           time.Time              `json:"created_at"`            // 创建时间
-	UpdatedAt           time.Time              `json:"updated_at"`            // 更新时间
+	ID                  string                  `json:"id"`                    // 任务ID
+	ProcessInstanceID   string                  `json:"process_instance_id"`   // 流程实例ID
+	ProcessDefinitionID string                  `json:"process_definition_id"` // 流程定义ID
+	Name                string                  `json:"name"`                  // 任务名称
+	Description         string                  `json:"description"`           // 任务描述
+	TaskDefinitionKey   string                  `json:"task_definition_key"`   // 任务定义键
+	Priority            int32                   `json:"priority"`              // 优先级
+	Status              string                  `json:"status"`                // 任务状态
+	CreateTime          time.Time               `json:"create_time"`           // 创建时间
+	ClaimTime           *time.Time              `json:"claim_time"`            // 认领时间
+	EndTime             *time.Time              `json:"end_time"`              // 结束时间
+	Duration            int64                   `json:"duration"`              // 持续时间(毫秒)
+	DeleteReason        string                  `json:"delete_reason"`         // 取消原因
+	DueDate             *time.Time              `json:"due_date"`              // 到期时间
+	Category            string                  `json:"category"`              // 任务分类
+	Owner               string                  `json:"owner"`                 // 拥有者
+	Assignee            string                  `json:"assignee"`              // 委派人
+	CandidateUsers      []string                `json:"candidate_users"`       // 候选用户
+	CandidateGroups     []string                `json:"candidate_groups"`      // 候选组
+	Delegation          string                  `json:"delegation"`            // 委派状态
+	FormKey             string                  `json:"form_key"`              // 表单键
+	IsSuspended         bool                    `json:"is_suspended"`          // 是否挂起
+	TenantID            string                  `json:"tenant_id"`             // 租户ID
+	Variables           map[string]interface{}  `json:"variables"`             // 任务变量
+	ParentTaskID        string                  `json:"parent_task_id"`        // 父任务ID
+	WaitForSubtasks     bool                    `json:"wait_for_subtasks"`     // 子任务全部结束前不能完成
+	Subtasks            []*TaskInstanceResponse `json:"subtasks,omitempty"`    // 子任务树
+	CreatedAt           time.Time               `json:"created_at"`            // 创建时间
+	UpdatedAt           time.Time               `json:"updated_at"`            // 更新时间
 }
 
 // CompleteTaskRequest 完成任务请求
@@ -169,7 +187,8 @@ type CompleteTaskRequest struct {
 
 // ClaimTaskRequest 认领任务请求
 type ClaimTaskRequest struct {
-	AssigneeID string `json:"assignee_id" validate:"required"` // 认领人ID
+	AssigneeID      string   `json:"assignee_id" validate:"required"` // 认领人ID
+	CandidateGroups []string `json:"-"`                               // 认领人所属用户组，由接口层根据认证信息填充
 }
 
 // DelegateTaskRequest 委派任务请求
@@ -178,27 +197,42 @@ type DelegateTaskRequest struct {
 	Comment    string `json:"comment"`                         // 委派备注
 }
 
+// ResolveTaskRequest 交还委派任务请求
+type ResolveTaskRequest struct {
+	Variables map[string]interface{} `json:"variables"` // 被委派人填写的任务变量
+	Comment   string                 `json:"comment"`   // 处理说明
+}
+
 // ListTaskInstancesRequest 查询任务实例列表请求
 type ListTaskInstancesRequest struct {
 	// 分页参数
-	Page     int `json:"page" validate:"min=1"`      // 页码
-	PageSize int `json:"page_size" validate:"min=1"` // 每页大小
+	Page     int `json:"page" form:"page" validate:"min=1"`           // 页码
+	PageSize int `json:"page_size" form:"page_size" validate:"min=1"` // 每页大小
 
 	// 排序参数
-	OrderBy string `json:"order_by"` // 排序字段
-	Order   string `json:"order"`    // 排序方向
+	OrderBy string `json:"order_by" form:"order_by"` // 排序字段
+	Order   string `json:"order" form:"order"`       // 排序方向
 
 	// 搜索参数
-	Search string `json:"search"` // 搜索关键词
+	Search string `json:"search" form:"search"` // 搜索关键词
 
 	// 过滤参数
-	ProcessInstanceID string     `json:"process_instance_id"` // 按流程实例ID过滤
-	AssigneeID        string     `json:"assignee_id"`         // 按执行人过滤
-	Owner             string     `json:"owner"`               // 按拥有者过滤
-	Category          string     `json:"category"`            // 按分类过滤
-	IsSuspended       *bool      `json:"is_suspended"`        // 按挂起状态过滤
-	CreatedFrom       *time.Time `json:"created_from"`        // 创建时间起始
-	CreatedTo         *time.Time `json:"created_to"`          // 创建时间结束
+	ProcessInstanceID string     `json:"process_instance_id" form:"process_instance_id"` // 按流程实例ID过滤
+	AssigneeID        string     `json:"assignee_id" form:"assignee_id"`                 // 按执行人过滤
+	Status            string     `json:"status" form:"status"`                           // 按任务状态过滤
+	Owner             string     `json:"owner" form:"owner"`                             // 按拥有者过滤
+	Category          string     `json:"category" form:"category"`                       // 按分类过滤
+	IsSuspended       *bool      `json:"is_suspended" form:"is_suspended"`               // 按挂起状态过滤
+	CreatedFrom       *time.Time `json:"created_from" form:"created_from"`               // 创建时间起始
+	CreatedTo         *time.Time `json:"created_to" form:"created_to"`                   // 创建时间结束
+	ParentTaskID      string     `json:"parent_task_id" form:"parent_task_id"`           // 按父任务过滤，只查询其直接子任务
+
+	// 为 true 时只返回顶层任务，并在每个任务下返回其子任务树
+	WithSubtasks bool `json:"with_subtasks" form:"with_subtasks"`
+
+	// 调用方身份，由接口层根据认证信息填充，用于查询可认领的任务
+	CandidateUser   string   `json:"-" form:"-"` // 候选用户
+	CandidateGroups []string `json:"-" form:"-"` // 候选组
 }
 
 // ListTaskInstancesResponse 查询任务实例列表响应
@@ -207,6 +241,315 @@ type ListTaskInstancesResponse struct {
 	Pagination *PaginationResult       `json:"pagination"` // 分页信息
 }
 
+// TaskEventResponse 任务办理记录响应
+type TaskEventResponse struct {
+	ID        string                 `json:"id"`         // 事件ID
+	TaskID    string                 `json:"task_id"`    // 任务ID
+	EventType string                 `json:"event_type"` // 事件类型
+	UserID    string                 `json:"user_id"`    // 操作人
+	Timestamp time.Time              `json:"timestamp"`  // 发生时间
+	Data      map[string]interface{} `json:"data"`       // 事件数据：办理人、拥有者与状态的变化
+}
+
+// ListTaskEventsRequest 查询任务办理记录请求
+type ListTaskEventsRequest struct {
+	Page     int    `json:"page" form:"page"`           // 页码
+	PageSize int    `json:"page_size" form:"page_size"` // 每页大小
+	Order    string `json:"order" form:"order"`         // 排序方向，默认按发生顺序
+}
+
+// ListTaskEventsResponse 查询任务办理记录响应
+type ListTaskEventsResponse struct {
+	Items      []*TaskEventResponse `json:"items"`      // 办理记录列表
+	Pagination *PaginationResult    `json:"pagination"` // 分页信息
+}
+
+// UpdateTaskRequest 更新任务请求，只更新请求中设置的字段
+type UpdateTaskRequest struct {
+	Name        *string    `json:"name"`        // 任务名称
+	Description *string    `json:"description"` // 任务描述
+	Priority    *int32     `json:"priority"`    // 优先级
+	DueDate     *time.Time `json:"due_date"`    // 到期时间
+	Category    *string    `json:"category"`    // 任务分类
+
+	WaitForSubtasks *bool `json:"wait_for_subtasks"` // 子任务全部结束前不能完成任务
+}
+
+// CreateSubtaskRequest 创建子任务请求
+type CreateSubtaskRequest struct {
+	Name        string     `json:"name" validate:"required"` // 子任务名称
+	Description string     `json:"description"`              // 子任务描述
+	Assignee    string     `json:"assignee"`                 // 办理人，为空时由创建人办理
+	Priority    int32      `json:"priority"`                 // 优先级，为空时沿用父任务
+	DueDate     *time.Time `json:"due_date"`                 // 到期时间
+	BlockParent bool       `json:"block_parent"`             // 为 true 时父任务需等待子任务全部结束才能完成
+}
+
+// AddTaskCommentRequest 添加任务评论请求
+type AddTaskCommentRequest struct {
+	Message string `json:"message" validate:"required"` // 评论内容
+}
+
+// TaskCommentResponse 任务评论响应
+type TaskCommentResponse struct {
+	ID                string    `json:"id"`                  // 评论ID
+	TaskID            string    `json:"task_id"`             // 任务ID
+	ProcessInstanceID string    `json:"process_instance_id"` // 流程实例ID
+	UserID            string    `json:"user_id"`             // 评论人
+	Type              string    `json:"type"`                // 评论类型
+	Message           string    `json:"message"`             // 评论内容
+	CreatedAt         time.Time `json:"created_at"`          // 评论时间
+}
+
+// ListTaskCommentsRequest 查询任务评论请求
+type ListTaskCommentsRequest struct {
+	Page     int    `json:"page" form:"page"`           // 页码
+	PageSize int    `json:"page_size" form:"page_size"` // 每页大小
+	Order    string `json:"order" form:"order"`         // 排序方向，默认最新的评论在前
+}
+
+// ListTaskCommentsResponse 查询任务评论响应
+type ListTaskCommentsResponse struct {
+	Items      []*TaskCommentResponse `json:"items"`      // 评论列表
+	Pagination *PaginationResult      `json:"pagination"` // 分页信息
+}
+
+// AddTaskAttachmentRequest 上传任务附件请求
+type AddTaskAttachmentRequest struct {
+	Name        string    `json:"name" validate:"required"` // 文件名
+	Description string    `json:"description"`              // 附件说明
+	ContentType string    `json:"content_type"`             // 文件类型
+	Content     io.Reader `json:"-"`                        // 文件内容，由仓储按大小限制读取
+}
+
+// TaskAttachmentResponse 任务附件响应，不包含文件内容
+type TaskAttachmentResponse struct {
+	ID                string    `json:"id"`                  // 附件ID
+	TaskID            string    `json:"task_id"`             // 任务ID
+	ProcessInstanceID string    `json:"process_instance_id"` // 流程实例ID
+	UserID            string    `json:"user_id"`             // 上传人
+	Name              string    `json:"name"`                // 文件名
+	Description       string    `json:"description"`         // 附件说明
+	ContentType       string    `json:"content_type"`        // 文件类型
+	Size              int64     `json:"size"`                // 文件大小(字节)
+	CreatedAt         time.Time `json:"created_at"`          // 上传时间
+}
+
+// TaskAttachmentContent 任务附件及其文件内容，用于下载附件
+type TaskAttachmentContent struct {
+	Attachment *TaskAttachmentResponse // 附件信息
+	Content    []byte                  // 文件内容
+}
+
+// ListTaskAttachmentsRequest 查询任务附件请求
+type ListTaskAttachmentsRequest struct {
+	Page     int `json:"page" form:"page"`           // 页码
+	PageSize int `json:"page_size" form:"page_size"` // 每页大小
+}
+
+// ListTaskAttachmentsResponse 查询任务附件响应
+type ListTaskAttachmentsResponse struct {
+	Items      []*TaskAttachmentResponse `json:"items"`      // 附件列表
+	Pagination *PaginationResult         `json:"pagination"` // 分页信息
+}
+
+// 批量任务操作
+const (
+	BulkTaskClaim       = "claim"        // 认领
+	BulkTaskUnclaim     = "unclaim"      // 取消认领，任务退回待认领
+	BulkTaskReassign    = "reassign"     // 转交给其他办理人
+	BulkTaskSetPriority = "set_priority" // 修改优先级
+	BulkTaskSetDueDate  = "set_due_date" // 修改到期时间
+	BulkTaskComplete    = "complete"     // 完成
+)
+
+// BulkTaskRequest 批量任务操作请求
+// 按任务ID列表或过滤条件选择任务，两者同时设置时使用任务ID列表
+type BulkTaskRequest struct {
+	Action  string          `json:"action" validate:"required"` // 操作类型
+	TaskIDs []string        `json:"task_ids"`                   // 任务ID列表
+	Filter  *BulkTaskFilter `json:"filter"`                     // 任务过滤条件
+
+	AssigneeID      string                 `json:"assignee_id"` // 认领人或转交的办理人，认领时为空表示当前用户
+	CandidateGroups []string               `json:"-"`           // 认领人所属用户组，由接口层根据认证信息填充
+	Priority        *int32                 `json:"priority"`    // 优先级
+	DueDate         *time.Time             `json:"due_date"`    // 到期时间
+	Variables       map[string]interface{} `json:"variables"`   // 完成任务时提交的变量
+	Comment         string                 `json:"comment"`     // 完成任务时的备注
+}
+
+// BulkTaskFilter 批量任务操作的任务过滤条件，只选择未结束的任务
+type BulkTaskFilter struct {
+	ProcessInstanceID string     `json:"process_instance_id"` // 按流程实例ID过滤
+	AssigneeID        string     `json:"assignee_id"`         // 按执行人过滤
+	Status            string     `json:"status"`              // 按任务状态过滤，为空时为全部未结束的状态
+	CandidateUser     string     `json:"candidate_user"`      // 按候选用户过滤
+	CandidateGroups   []string   `json:"candidate_groups"`    // 按候选组过滤，与候选用户满足其一即可
+	CreatedFrom       *time.Time `json:"created_from"`        // 创建时间起始
+	CreatedTo         *time.Time `json:"created_to"`          // 创建时间结束
+}
+
+// BulkTaskResponse 批量任务操作响应
+type BulkTaskResponse struct {
+	Action    string                `json:"action"`    // 操作类型
+	Total     int                   `json:"total"`     // 处理的任务数
+	Succeeded int                   `json:"succeeded"` // 成功数
+	Failed    int                   `json:"failed"`    // 失败数
+	Items     []*BulkTaskItemResult `json:"items"`     // 每个任务的处理结果，顺序与选择任务的顺序一致
+}
+
+// BulkTaskItemResult 批量任务操作中单个任务的处理结果
+type BulkTaskItemResult struct {
+	TaskID  string `json:"task_id"`           // 任务ID
+	Success bool   `json:"success"`           // 是否成功
+	Code    int    `json:"code,omitempty"`    // 失败时的错误码，由服务层根据 Err 填充
+	Message string `json:"message,omitempty"` // 失败原因
+	Err     error  `json:"-"`                 // 失败时的错误
+}
+
+// 认证与用户相关的请求响应结构
+
+// LoginRequest 登录请求
+type LoginRequest struct {
+	Username string `json:"username" validate:"required"` // 用户名
+	Password string `json:"password" validate:"required"` // 密码
+}
+
+// RefreshTokenRequest 刷新令牌请求
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"` // 刷新令牌
+}
+
+// ChangePasswordRequest 修改密码请求
+type ChangePasswordRequest struct {
+	OldPassword string `json:"old_password" validate:"required"` // 原密码
+	NewPassword string `json:"new_password" validate:"required"` // 新密码
+}
+
+// CreateUserRequest 创建用户请求
+type CreateUserRequest struct {
+	Username    string   `json:"username" validate:"required"` // 用户名
+	Password    string   `json:"password" validate:"required"` // 初始密码
+	Email       string   `json:"email"`                        // 邮箱
+	DisplayName string   `json:"display_name"`                 // 显示名称
+	Roles       []string `json:"roles"`                        // 角色名称列表
+	Groups      []string `json:"groups"`                       // 用户组名称列表
+	TenantID    string   `json:"tenant_id"`                    // 租户ID
+}
+
+// UpdateUserRequest 更新用户请求，只更新请求中设置的字段
+type UpdateUserRequest struct {
+	Email       *string   `json:"email"`        // 邮箱
+	DisplayName *string   `json:"display_name"` // 显示名称
+	Roles       *[]string `json:"roles"`        // 角色名称列表
+	Groups      *[]string `json:"groups"`       // 用户组名称列表
+	Status      *string   `json:"status"`       // 账号状态: active, disabled
+	Password    *string   `json:"password"`     // 重置密码
+}
+
+// UserResponse 用户响应，不包含密码哈希
+type UserResponse struct {
+	ID                string     `json:"id"`                      // 用户ID
+	Username          string     `json:"username"`                // 用户名
+	Email             string     `json:"email"`                   // 邮箱
+	DisplayName       string     `json:"display_name"`            // 显示名称
+	Status            string     `json:"status"`                  // 账号状态
+	Roles             []string   `json:"roles"`                   // 角色名称列表
+	Groups            []string   `json:"groups"`                  // 用户组名称列表
+	TenantID          string     `json:"tenant_id"`               // 租户ID
+	PasswordChangedAt time.Time  `json:"password_changed_at"`     // 密码修改时间
+	LastLoginAt       *time.Time `json:"last_login_at,omitempty"` // 最后登录时间
+	CreatedAt         time.Time  `json:"created_at"`              // 创建时间
+	UpdatedAt         time.Time  `json:"updated_at"`              // 更新时间
+}
+
+// ListUsersRequest 查询用户列表请求
+type ListUsersRequest struct {
+	Page     int    `json:"page" form:"page"`           // 页码
+	PageSize int    `json:"page_size" form:"page_size"` // 每页大小
+	OrderBy  string `json:"order_by" form:"order_by"`   // 排序字段：username, created_at
+	Order    string `json:"order" form:"order"`         // 排序方向
+	Search   string `json:"search" form:"search"`       // 按用户名、邮箱或显示名称搜索
+	Status   string `json:"status" form:"status"`       // 按账号状态过滤
+	Role     string `json:"role" form:"role"`           // 按角色过滤
+	Group    string `json:"group" form:"group"`         // 按用户组过滤
+}
+
+// ListUsersResponse 查询用户列表响应
+type ListUsersResponse struct {
+	Items      []*UserResponse   `json:"items"`      // 用户列表
+	Pagination *PaginationResult `json:"pagination"` // 分页信息
+}
+
+// CreateRoleRequest 创建角色请求
+type CreateRoleRequest struct {
+	Name        string   `json:"name" validate:"required"` // 角色名称
+	Description string   `json:"description"`              // 角色描述
+	Permissions []string `json:"permissions"`              // 权限列表
+}
+
+// UpdateRoleRequest 更新角色请求，只更新请求中设置的字段
+type UpdateRoleRequest struct {
+	Description *string   `json:"description"` // 角色描述
+	Permissions *[]string `json:"permissions"` // 权限列表
+}
+
+// RoleResponse 角色响应
+type RoleResponse struct {
+	ID          string    `json:"id"`          // 角色ID
+	Name        string    `json:"name"`        // 角色名称
+	Description string    `json:"description"` // 角色描述
+	Permissions []string  `json:"permissions"` // 权限列表
+	CreatedAt   time.Time `json:"created_at"`  // 创建时间
+	UpdatedAt   time.Time `json:"updated_at"`  // 更新时间
+}
+
+// CreateGroupRequest 创建用户组请求
+type CreateGroupRequest struct {
+	Name        string `json:"name" validate:"required"` // 用户组名称
+	Description string `json:"description"`              // 用户组描述
+}
+
+// GroupResponse 用户组响应
+type GroupResponse struct {
+	ID          string    `json:"id"`          // 用户组ID
+	Name        string    `json:"name"`        // 用户组名称
+	Description string    `json:"description"` // 用户组描述
+	CreatedAt   time.Time `json:"created_at"`  // 创建时间
+}
+
+// 资源授权相关的请求响应结构
+
+// CreateAuthorizationRequest 创建授权请求，范围字段为空表示不限
+type CreateAuthorizationRequest struct {
+	PrincipalType        string   `json:"principal_type" validate:"required"` // 被授权主体类型: user, group
+	PrincipalID          string   `json:"principal_id" validate:"required"`   // 用户ID、用户名或用户组名称
+	ProcessDefinitionKey string   `json:"process_definition_key"`             // 流程定义Key
+	Category             string   `json:"category"`                           // 流程定义分类
+	TenantID             string   `json:"tenant_id"`                          // 租户ID
+	Actions              []string `json:"actions" validate:"required"`        // 授予的操作
+}
+
+// ListAuthorizationsRequest 查询授权列表请求
+type ListAuthorizationsRequest struct {
+	PrincipalType        string `json:"principal_type" form:"principal_type"`                 // 按主体类型过滤
+	PrincipalID          string `json:"principal_id" form:"principal_id"`                     // 按主体过滤
+	ProcessDefinitionKey string `json:"process_definition_key" form:"process_definition_key"` // 按流程定义Key过滤
+}
+
+// AuthorizationResponse 授权响应
+type AuthorizationResponse struct {
+	ID                   string    `json:"id"`                     // 授权ID
+	PrincipalType        string    `json:"principal_type"`         // 被授权主体类型
+	PrincipalID          string    `json:"principal_id"`           // 被授权主体
+	ProcessDefinitionKey string    `json:"process_definition_key"` // 流程定义Key，为空表示不限
+	Category             string    `json:"category"`               // 流程定义分类，为空表示不限
+	TenantID             string    `json:"tenant_id"`              // 租户ID，为空表示不限
+	Actions              []string  `json:"actions"`                // 授予的操作
+	CreatedBy            string    `json:"created_by"`             // 授权人
+	CreatedAt            time.Time `json:"created_at"`             // 创建时间
+}
+
 // 历史数据相关的请求响应结构
 
 // HistoricProcessInstanceResponse 历史流程实例响应
@@ -234,24 +577,24 @@ type HistoricProcessInstanceResponse struct {
 // ListHistoricProcessInstancesRequest 查询历史流程实例列表请求
 type ListHistoricProcessInstancesRequest struct {
 	// 分页参数
-	Page     int `json:"page" validate:"min=1"`      // 页码
-	PageSize int `json:"page_size" validate:"min=1"` // 每页大小
+	Page     int `json:"page" form:"page" validate:"min=1"`           // 页码
+	PageSize int `json:"page_size" form:"page_size" validate:"min=1"` // 每页大小
 
 	// 排序参数
-	OrderBy        string `json:"order_by"`        // 排序字段
-	OrderDirection string `json:"order_direction"` // 排序方向
+	OrderBy        string `json:"order_by" form:"order_by"`               // 排序字段
+	OrderDirection string `json:"order_direction" form:"order_direction"` // 排序方向
 
 	// 过滤参数
-	ProcessDefinitionID  string     `json:"process_definition_id"`  // 按流程定义ID过滤
-	ProcessDefinitionKey string     `json:"process_definition_key"` // 按流程定义键过滤
-	BusinessKey          string     `json:"business_key"`           // 按业务键过滤
-	StartUserID          string     `json:"start_user_id"`          // 按启动用户过滤
-	State                string     `json:"state"`                  // 按状态过滤
-	StartTimeAfter       *time.Time `json:"start_time_after"`       // 开始时间之后
-	StartTimeBefore      *time.Time `json:"start_time_before"`      // 开始时间之前
-	EndTimeAfter         *time.Time `json:"end_time_after"`         // 结束时间之后
-	EndTimeBefore        *time.Time `json:"end_time_before"`        // 结束时间之前
-	TenantID             string     `json:"tenant_id"`              // 租户ID
+	ProcessDefinitionID  string     `json:"process_definition_id" form:"process_definition_id"`   // 按流程定义ID过滤
+	ProcessDefinitionKey string     `json:"process_definition_key" form:"process_definition_key"` // 按流程定义键过滤
+	BusinessKey          string     `json:"business_key" form:"business_key"`                     // 按业务键过滤
+	StartUserID          string     `json:"start_user_id" form:"start_user_id"`                   // 按启动用户过滤
+	State                string     `json:"state" form:"state"`                                   // 按状态过滤
+	StartTimeAfter       *time.Time `json:"start_time_after" form:"start_time_after"`             // 开始时间之后
+	StartTimeBefore      *time.Time `json:"start_time_before" form:"start_time_before"`           // 开始时间之前
+	EndTimeAfter         *time.Time `json:"end_time_after" form:"end_time_after"`                 // 结束时间之后
+	EndTimeBefore        *time.Time `json:"end_time_before" form:"end_time_before"`               // 结束时间之前
+	TenantID             string     `json:"tenant_id" form:"tenant_id"`                           // 租户ID
 }
 
 // ListHistoricProcessInstancesResponse 查询历史流程实例列表响应
@@ -264,27 +607,28 @@ type ListHistoricProcessInstancesResponse struct {
 
 // HistoricProcessInstanceFilter 历史流程实例过滤条件
 type HistoricProcessInstanceFilter struct {
-	ProcessDefinitionID  string     `json:"process_definition_id,omitempty"`  // 按流程定义ID过滤
-	ProcessDefinitionKey string     `json:"process_definition_key,omitempty"` // 按流程定义键过滤
-	BusinessKey          string     `json:"business_key,omitempty"`           // 按业务键过滤
-	StartUserID          string     `json:"start_user_id,omitempty"`          // 按启动用户过滤
-	State                string     `json:"state,omitempty"`                  // 按状态过滤
-	StartTimeAfter       *time.Time `json:"start_time_after,omitempty"`       // 开始时间之后
-	StartTimeBefore      *time.Time `json:"start_time_before,omitempty"`      // 开始时间之前
-	EndTimeAfter         *time.Time `json:"end_time_after,omitempty"`         // 结束时间之后
-	EndTimeBefore        *time.Time `json:"end_time_before,omitempty"`        // 结束时间之前
-	TenantID             string     `json:"tenant_id,omitempty"`              // 租户ID
-	Page                 int        `json:"page"`                             // 页码
-	PageSize             int        `json:"page_size"`                        // 每页大小
-	OrderBy              string     `json:"order_by"`                         // 排序字段
-	OrderDirection       string     `json:"order_direction"`                  // 排序方向
+	ProcessDefinitionID  string        `json:"process_definition_id,omitempty"`  // 按流程定义ID过滤
+	ProcessDefinitionKey string        `json:"process_definition_key,omitempty"` // 按流程定义键过滤
+	BusinessKey          string        `json:"business_key,omitempty"`           // 按业务键过滤
+	StartUserID          string        `json:"start_user_id,omitempty"`          // 按启动用户过滤
+	State                string        `json:"state,omitempty"`                  // 按状态过滤
+	StartTimeAfter       *time.Time    `json:"start_time_after,omitempty"`       // 开始时间之后
+	StartTimeBefore      *time.Time    `json:"start_time_before,omitempty"`      // 开始时间之前
+	EndTimeAfter         *time.Time    `json:"end_time_after,omitempty"`         // 结束时间之后
+	EndTimeBefore        *time.Time    `json:"end_time_before,omitempty"`        // 结束时间之前
+	TenantID             string        `json:"tenant_id,omitempty"`              // 租户ID
+	Access               *AccessFilter `json:"-"`                                // 授权过滤条件，为空表示不限
+	Page                 int           `json:"page"`                             // 页码
+	PageSize             int           `json:"page_size"`                        // 每页大小
+	OrderBy              string        `json:"order_by"`                         // 排序字段
+	OrderDirection       string        `json:"order_direction"`                  // 排序方向
 }
 
 // ProcessStatisticsRequest 流程统计请求
 type ProcessStatisticsRequest struct {
-	ProcessDefinitionKey string    `json:"process_definition_key"` // 流程定义键
-	StartTime            time.Time `json:"start_time"`             // 统计开始时间
-	EndTime              time.Time `json:"end_time"`               // 统计结束时间
+	ProcessDefinitionKey string    `json:"process_definition_key" form:"process_definition_key"` // 流程定义键
+	StartTime            time.Time `json:"start_time" form:"start_time"`                         // 统计开始时间
+	EndTime              time.Time `json:"end_time" form:"end_time"`                             // 统计结束时间
 }
 
 // ProcessStatisticsResponse 流程统计响应
@@ -305,10 +649,10 @@ type ProcessStatisticsResponse struct {
 
 // ProcessTrendRequest 流程趋势请求
 type ProcessTrendRequest struct {
-	ProcessDefinitionKey string    `json:"process_definition_key"` // 流程定义键
-	StartTime            time.Time `json:"start_time"`             // 开始时间
-	EndTime              time.Time `json:"end_time"`               // 结束时间
-	Granularity          string    `json:"granularity"`            // 时间粒度: hour, day, week, month
+	ProcessDefinitionKey string    `json:"process_definition_key" form:"process_definition_key"` // 流程定义键
+	StartTime            time.Time `json:"start_time" form:"start_time"`                         // 开始时间
+	EndTime              time.Time `json:"end_time" form:"end_time"`                             // 结束时间
+	Granularity          string    `json:"granularity" form:"granularity"`                       // 时间粒度: hour, day, week, month
 }
 
 // ProcessTrendResponse 流程趋势响应
@@ -330,8 +674,8 @@ type ProcessTrendData struct {
 
 // BatchDeleteHistoricProcessInstancesRequest 批量删除历史流程实例请求
 type BatchDeleteHistoricProcessInstancesRequest struct {
-	ProcessDefinitionKey string    `json:"process_definition_key"` // 流程定义键
-	EndTimeBefore        time.Time `json:"end_time_before"`        // 结束时间之前
+	ProcessDefinitionKey string    `json:"process_definition_key" form:"process_definition_key"` // 流程定义键
+	EndTimeBefore        time.Time `json:"end_time_before" form:"end_time_before"`               // 结束时间之前
 }
 
 // BatchDeleteHistoricProcessInstancesResponse 批量删除历史流程实例响应
@@ -403,16 +747,51 @@ const (
 const (
 	TaskStatusCreated   = "created"   // 已创建
 	TaskStatusClaimed   = "claimed"   // 已认领
+	TaskStatusDelegated = "delegated" // 委派中
+	TaskStatusResolved  = "resolved"  // 被委派人已处理，交还拥有者
 	TaskStatusCompleted = "completed" // 已完成
 	TaskStatusCancelled = "cancelled" // 已取消
 	TaskStatusSuspended = "suspended" // 挂起
 )
 
+// 用户账号状态
+const (
+	UserStatusActive   = "active"   // 正常
+	UserStatusDisabled = "disabled" // 已停用，不能登录，已签发的令牌全部吊销
+)
+
+// 任务身份关联类型
+const (
+	IdentityLinkCandidate   = "candidate"   // 候选人，可认领任务
+	IdentityLinkParticipant = "participant" // 参与者，认领或被委派过任务
+)
+
 // 任务委派状态
 const (
-	TaskDelegationPending   = "pending"   // 待处理
-	TaskDelegationResolved  = "resolved"  // 已解决
-	TaskDelegationCancelled = "cancelled" // 已取消
+	TaskDelegationPending  = "PENDING"  // 委派中，等待被委派人处理
+	TaskDelegationResolved = "RESOLVED" // 被委派人已处理，交还拥有者
+)
+
+// 任务办理事件类型，写入 ProcessEvent 表作为任务的办理记录
+const (
+	TaskEventClaimed   = "TASK_CLAIMED"   // 认领
+	TaskEventDelegated = "TASK_DELEGATED" // 委派
+	TaskEventResolved  = "TASK_RESOLVED"  // 被委派人交还
+	TaskEventCompleted = "TASK_COMPLETED" // 完成
+
+	TaskEventUpdated         = "TASK_UPDATED"          // 修改任务信息
+	TaskEventAttachmentAdded = "TASK_ATTACHMENT_ADDED" // 上传附件
+	TaskEventSubtaskCreated  = "TASK_SUBTASK_CREATED"  // 创建子任务
+	TaskEventUnclaimed       = "TASK_UNCLAIMED"        // 取消认领
+	TaskEventReassigned      = "TASK_REASSIGNED"       // 转交
+)
+
+// 任务评论类型，办理任务时填写的备注按操作记为对应类型的评论
+const (
+	TaskCommentTypeComment  = "comment"  // 普通评论
+	TaskCommentTypeComplete = "complete" // 完成任务时的备注
+	TaskCommentTypeDelegate = "delegate" // 委派任务时的备注
+	TaskCommentTypeResolve  = "resolve"  // 交还任务时的处理说明
 )
 
 // 响应码定义
@@ -17,6 +17,7 @@ import (
 type HistoricDataUseCase struct {
 	historicRepo HistoricProcessInstanceRepo
 	cache        CacheRepo
+	authz        *AuthorizationUseCase
 	logger       *zap.Logger
 }
 
@@ -24,11 +25,13 @@ type HistoricDataUseCase struct {
 func NewHistoricDataUseCase(
 	historicRepo HistoricProcessInstanceRepo,
 	cache CacheRepo,
+	authz *AuthorizationUseCase,
 	logger *zap.Logger,
 ) *HistoricDataUseCase {
 	return &HistoricDataUseCase{
 		historicRepo: historicRepo,
 		cache:        cache,
+		authz:        authz,
 		logger:       logger,
 	}
 }
@@ -44,6 +47,9 @@ func (uc *HistoricDataUseCase) GetHistoricProcessInstance(ctx context.Context, i
 		var response HistoricProcessInstanceResponse
 		if err := json.Unmarshal([]byte(cached), &response); err == nil {
 			uc.logger.Debug("从缓存获取历史流程实例成功")
+			if err := uc.authz.Authorize(ctx, ActionRead, historicResource(&response)); err != nil {
+				return nil, err
+			}
 			return &response, nil
 		}
 	}
@@ -83,16 +89,24 @@ func (uc *HistoricDataUseCase) GetHistoricProcessInstance(ctx context.Context, i
 	if data, err := json.Marshal(response); err == nil {
 		uc.cache.Set(ctx, cacheKey, string(data), 2*time.Hour)
 	}
+	if err := uc.authz.Authorize(ctx, ActionRead, historicResource(response)); err != nil {
+		return nil, err
+	}
 
 	uc.logger.Info("获取历史流程实例成功", zap.Int64("instanceID", instanceID))
 	return response, nil
 }
 
 // ListHistoricProcessInstances 查询历史流程实例列表
-// 支持多种过滤条件和分页查询
+// 支持多种过滤条件和分页查询，只返回调用方有权查看或由调用方发起的流程实例
 func (uc *HistoricDataUseCase) ListHistoricProcessInstances(ctx context.Context, req *ListHistoricProcessInstancesRequest) (*ListHistoricProcessInstancesResponse, error) {
 	uc.logger.Debug("查询历史流程实例列表", zap.Any("request", req))
 
+	access, err := uc.authz.AccessFilter(ctx, ActionRead)
+	if err != nil {
+		return nil, err
+	}
+
 	// 参数验证
 	if req.Page <= 0 {
 		req.Page = 1
@@ -113,6 +127,7 @@ func (uc *HistoricDataUseCase) ListHistoricProcessInstances(ctx context.Context,
 		EndTimeAfter:         req.EndTimeAfter,
 		EndTimeBefore:        req.EndTimeBefore,
 		TenantID:             req.TenantID,
+		Access:               access,
 		Page:                 req.Page,
 		PageSize:             req.PageSize,
 		OrderBy:              req.OrderBy,
@@ -158,10 +173,14 @@ func (uc *HistoricDataUseCase) ListHistoricProcessInstances(ctx context.Context,
 }
 
 // GetProcessStatistics 获取流程统计信息
-// 提供流程执行的统计分析数据
+// 提供流程执行的统计分析数据，统计跨租户汇总，需要不限租户的查看权限
 func (uc *HistoricDataUseCase) GetProcessStatistics(ctx context.Context, req *ProcessStatisticsRequest) (*ProcessStatisticsResponse, error) {
 	uc.logger.Debug("获取流程统计信息", zap.String("processDefinitionKey", req.ProcessDefinitionKey))
 
+	if err := uc.authz.Authorize(ctx, ActionRead, &ProcessResource{ProcessDefinitionKey: req.ProcessDefinitionKey}); err != nil {
+		return nil, err
+	}
+
 	// 参数验证
 	if req.StartTime.IsZero() {
 		req.StartTime = time.Now().AddDate(0, -1, 0) // 默认最近一个月
@@ -216,10 +235,14 @@ func (uc *HistoricDataUseCase) GetProcessStatistics(ctx context.Context, req *Pr
 }
 
 // GetProcessTrend 获取流程趋势分析
-// 按时间维度分析流程执行趋势
+// 按时间维度分析流程执行趋势，与统计信息一样需要不限租户的查看权限
 func (uc *HistoricDataUseCase) GetProcessTrend(ctx context.Context, req *ProcessTrendRequest) (*ProcessTrendResponse, error) {
 	uc.logger.Debug("获取流程趋势分析", zap.String("processDefinitionKey", req.ProcessDefinitionKey))
 
+	if err := uc.authz.Authorize(ctx, ActionRead, &ProcessResource{ProcessDefinitionKey: req.ProcessDefinitionKey}); err != nil {
+		return nil, err
+	}
+
 	// 参数验证
 	if req.StartTime.IsZero() {
 		req.StartTime = time.Now().AddDate(0, -1, 0) // 默认最近一个月
@@ -271,11 +294,18 @@ func (uc *HistoricDataUseCase) DeleteHistoricProcessInstance(ctx context.Context
 	uc.logger.Info("删除历史流程实例", zap.Int64("instanceID", instanceID))
 
 	// 检查实例是否存在
-	_, err := uc.historicRepo.GetHistoricProcessInstance(ctx, instanceID)
+	instance, err := uc.historicRepo.GetHistoricProcessInstance(ctx, instanceID)
 	if err != nil {
 		uc.logger.Error("历史流程实例不存在", zap.Error(err))
 		return fmt.Errorf("历史流程实例不存在: %w", err)
 	}
+	if err := uc.authz.Authorize(ctx, ActionTerminate, &ProcessResource{
+		ProcessDefinitionID:  instance.ProcessDefinitionID,
+		ProcessDefinitionKey: instance.ProcessDefinitionKey,
+		TenantID:             instance.TenantID,
+	}); err != nil {
+		return err
+	}
 
 	// 删除历史流程实例
 	if err := uc.historicRepo.DeleteHistoricProcessInstance(ctx, instanceID); err != nil {
@@ -300,6 +330,9 @@ func (uc *HistoricDataUseCase) BatchDeleteHistoricProcessInstances(ctx context.C
 	if req.EndTimeBefore.IsZero() {
 		return nil, fmt.Errorf("必须指定结束时间条件")
 	}
+	if err := uc.authz.Authorize(ctx, ActionTerminate, &ProcessResource{ProcessDefinitionKey: req.ProcessDefinitionKey}); err != nil {
+		return nil, err
+	}
 
 	// 执行批量删除
 	deletedCount, err := uc.historicRepo.BatchDeleteHistoricProcessInstances(ctx, req.ProcessDefinitionKey, req.EndTimeBefore)
@@ -316,6 +349,17 @@ func (uc *HistoricDataUseCase) BatchDeleteHistoricProcessInstances(ctx context.C
 	return response, nil
 }
 
+// historicResource 返回历史流程实例的鉴权资源，发起人无需授权即可查看
+func historicResource(resp *HistoricProcessInstanceResponse) *ProcessResource {
+	definitionID, _ := strconv.ParseInt(resp.ProcessDefinitionID, 10, 64)
+	return &ProcessResource{
+		ProcessDefinitionID:  definitionID,
+		ProcessDefinitionKey: resp.ProcessDefinitionKey,
+		TenantID:             resp.TenantID,
+		Involved:             []string{resp.StartUserID},
+	}
+}
+
 // calculateDuration 计算流程执行时长
 func (uc *HistoricDataUseCase) calculateDuration(startTime time.Time, endTime *time.Time) *int64 {
 	if endTime == nil {
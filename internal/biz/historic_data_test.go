@@ -74,9 +74,9 @@ func TestHistoricDataUseCase_GetHistoricProcessInstance(t *testing.T) {
 	mockCache := new(MockCacheRepo)
 	logger := zap.NewNop()
 
-	useCase := NewHistoricDataUseCase(mockRepo, mockCache, logger)
+	useCase := NewHistoricDataUseCase(mockRepo, mockCache, NewAuthorizationUseCase(nil, nil, logger), logger)
 
-	ctx := context.Background()
+	ctx := systemContext()
 	instanceID := int64(1)
 
 	// 准备测试数据
@@ -132,9 +132,9 @@ func TestHistoricDataUseCase_GetProcessStatistics(t *testing.T) {
 	mockCache := new(MockCacheRepo)
 	logger := zap.NewNop()
 
-	useCase := NewHistoricDataUseCase(mockRepo, mockCache, logger)
+	useCase := NewHistoricDataUseCase(mockRepo, mockCache, NewAuthorizationUseCase(nil, nil, logger), logger)
 
-	ctx := context.Background()
+	ctx := systemContext()
 	req := &ProcessStatisticsRequest{
 		ProcessDefinitionKey: "test-process",
 		StartTime:            time.Now().AddDate(0, -1, 0),
@@ -186,9 +186,9 @@ func TestHistoricDataUseCase_DeleteHistoricProcessInstance(t *testing.T) {
 	mockCache := new(MockCacheRepo)
 	logger := zap.NewNop()
 
-	useCase := NewHistoricDataUseCase(mockRepo, mockCache, logger)
+	useCase := NewHistoricDataUseCase(mockRepo, mockCache, NewAuthorizationUseCase(nil, nil, logger), logger)
 
-	ctx := context.Background()
+	ctx := systemContext()
 	instanceID := int64(1)
 
 	t.Run("成功删除历史流程实例", func(t *testing.T) {
@@ -5,11 +5,13 @@ package biz
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
 
 	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/model"
 
 	"go.uber.org/zap"
 )
@@ -18,6 +20,7 @@ import (
 type ProcessDefinitionUseCase struct {
 	repo   ProcessDefinitionRepo
 	cache  CacheRepo
+	authz  *AuthorizationUseCase
 	logger *zap.Logger
 }
 
@@ -25,16 +28,19 @@ type ProcessDefinitionUseCase struct {
 func NewProcessDefinitionUseCase(
 	repo ProcessDefinitionRepo,
 	cache CacheRepo,
+	authz *AuthorizationUseCase,
 	logger *zap.Logger,
 ) *ProcessDefinitionUseCase {
 	return &ProcessDefinitionUseCase{
 		repo:   repo,
 		cache:  cache,
+		authz:  authz,
 		logger: logger,
 	}
 }
 
 // CreateProcessDefinition 创建流程定义
+// 调用方需要拥有新流程定义范围内的全部操作授权；Key 已存在时创建新版本，还需要拥有最新版本范围内的全部操作授权
 func (uc *ProcessDefinitionUseCase) CreateProcessDefinition(ctx context.Context, req *CreateProcessDefinitionRequest) (*ProcessDefinitionResponse, error) {
 	uc.logger.Info("开始创建流程定义",
 		zap.String("name", req.Name),
@@ -46,9 +52,20 @@ func (uc *ProcessDefinitionUseCase) CreateProcessDefinition(ctx context.Context,
 		return nil, fmt.Errorf("参数验证失败: %w", err)
 	}
 
+	if err := uc.authz.Authorize(ctx, ActionAll, &ProcessResource{
+		ProcessDefinitionKey: req.Key,
+		Category:             req.Category,
+		TenantID:             req.TenantID,
+	}); err != nil {
+		return nil, err
+	}
+
 	// 检查流程键是否已存在
 	existing, err := uc.repo.GetLatestByKey(ctx, req.Key)
 	if err == nil && existing != nil {
+		if err := uc.authz.Authorize(ctx, ActionAll, definitionResource(existing)); err != nil {
+			return nil, err
+		}
 		// 如果存在，创建新版本
 		req.Version = existing.Version + 1
 		uc.logger.Info("流程定义已存在，创建新版本",
@@ -60,8 +77,9 @@ func (uc *ProcessDefinitionUseCase) CreateProcessDefinition(ctx context.Context,
 		uc.logger.Info("创建流程定义第一个版本", zap.String("key", req.Key))
 	}
 
-	// 验证流程定义内容
-	if err := uc.validateProcessDefinition(req.Resource); err != nil {
+	// 验证流程定义内容，BPMN文件转换为JSON资源
+	resource, diagramData, err := uc.prepareResource(req.Resource)
+	if err != nil {
 		uc.logger.Error("流程定义内容验证失败", zap.Error(err))
 		return nil, fmt.Errorf("流程定义内容验证失败: %w", err)
 	}
@@ -73,7 +91,8 @@ func (uc *ProcessDefinitionUseCase) CreateProcessDefinition(ctx context.Context,
 		Description: req.Description,
 		Category:    req.Category,
 		Version:     req.Version,
-		Resource:    req.Resource,
+		Resource:    resource,
+		DiagramData: diagramData,
 		Suspended:   false, // 新创建的流程定义默认为激活状态
 		TenantID:    req.TenantID,
 	}
@@ -109,6 +128,9 @@ func (uc *ProcessDefinitionUseCase) GetProcessDefinition(ctx context.Context, id
 		var pd ent.ProcessDefinition
 		if err := json.Unmarshal([]byte(cached), &pd); err == nil {
 			uc.logger.Debug("从缓存获取流程定义成功", zap.String("id", id))
+			if err := uc.authz.Authorize(ctx, ActionRead, definitionResource(&pd)); err != nil {
+				return nil, err
+			}
 			return uc.toProcessDefinitionResponse(&pd), nil
 		}
 	}
@@ -125,6 +147,9 @@ func (uc *ProcessDefinitionUseCase) GetProcessDefinition(ctx context.Context, id
 		uc.logger.Warn("缓存流程定义失败", zap.Error(err))
 	}
 
+	if err := uc.authz.Authorize(ctx, ActionRead, definitionResource(pd)); err != nil {
+		return nil, err
+	}
 	return uc.toProcessDefinitionResponse(pd), nil
 }
 
@@ -137,11 +162,15 @@ func (uc *ProcessDefinitionUseCase) GetLatestProcessDefinition(ctx context.Conte
 		uc.logger.Error("获取最新版本流程定义失败", zap.String("key", key), zap.Error(err))
 		return nil, fmt.Errorf("获取最新版本流程定义失败: %w", err)
 	}
+	if err := uc.authz.Authorize(ctx, ActionRead, definitionResource(pd)); err != nil {
+		return nil, err
+	}
 
 	return uc.toProcessDefinitionResponse(pd), nil
 }
 
 // UpdateProcessDefinition 更新流程定义
+// 调用方需要拥有流程定义范围内的全部操作授权，修改分类时还需要拥有新分类范围内的全部操作授权
 func (uc *ProcessDefinitionUseCase) UpdateProcessDefinition(ctx context.Context, id string, req *UpdateProcessDefinitionRequest) (*ProcessDefinitionResponse, error) {
 	uc.logger.Info("更新流程定义", zap.String("id", id))
 
@@ -151,6 +180,9 @@ func (uc *ProcessDefinitionUseCase) UpdateProcessDefinition(ctx context.Context,
 		uc.logger.Error("获取待更新的流程定义失败", zap.String("id", id), zap.Error(err))
 		return nil, fmt.Errorf("获取待更新的流程定义失败: %w", err)
 	}
+	if err := uc.authz.Authorize(ctx, ActionAll, definitionResource(existing)); err != nil {
+		return nil, err
+	}
 
 	// 更新字段
 	if req.Name != "" {
@@ -159,16 +191,23 @@ func (uc *ProcessDefinitionUseCase) UpdateProcessDefinition(ctx context.Context,
 	if req.Description != "" {
 		existing.Description = req.Description
 	}
-	if req.Category != "" {
+	if req.Category != "" && req.Category != existing.Category {
 		existing.Category = req.Category
+		if err := uc.authz.Authorize(ctx, ActionAll, definitionResource(existing)); err != nil {
+			return nil, err
+		}
 	}
 	if req.Resource != "" {
-		// 验证新的流程定义内容
-		if err := uc.validateProcessDefinition(req.Resource); err != nil {
+		// 验证新的流程定义内容，BPMN文件携带的流程图布局覆盖原有布局
+		resource, diagramData, err := uc.prepareResource(req.Resource)
+		if err != nil {
 			uc.logger.Error("流程定义内容验证失败", zap.Error(err))
 			return nil, fmt.Errorf("流程定义内容验证失败: %w", err)
 		}
-		existing.Resource = req.Resource
+		existing.Resource = resource
+		if diagramData != nil {
+			existing.DiagramData = diagramData
+		}
 	}
 
 	// 保存更新
@@ -188,10 +227,19 @@ func (uc *ProcessDefinitionUseCase) UpdateProcessDefinition(ctx context.Context,
 	return uc.toProcessDefinitionResponse(result), nil
 }
 
-// DeleteProcessDefinition 删除流程定义
+// DeleteProcessDefinition 删除流程定义，调用方需要拥有流程定义范围内的全部操作授权
 func (uc *ProcessDefinitionUseCase) DeleteProcessDefinition(ctx context.Context, id string) error {
 	uc.logger.Info("删除流程定义", zap.String("id", id))
 
+	pd, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("获取流程定义失败", zap.String("id", id), zap.Error(err))
+		return fmt.Errorf("获取流程定义失败: %w", err)
+	}
+	if err := uc.authz.Authorize(ctx, ActionAll, definitionResource(pd)); err != nil {
+		return err
+	}
+
 	// 检查是否有正在运行的流程实例
 	// TODO: 实现流程实例检查逻辑
 
@@ -211,10 +259,15 @@ func (uc *ProcessDefinitionUseCase) DeleteProcessDefinition(ctx context.Context,
 	return nil
 }
 
-// ListProcessDefinitions 分页查询流程定义
+// ListProcessDefinitions 分页查询流程定义，只返回调用方有权查看的流程定义
 func (uc *ProcessDefinitionUseCase) ListProcessDefinitions(ctx context.Context, req *ListProcessDefinitionsRequest) (*ListProcessDefinitionsResponse, error) {
 	uc.logger.Debug("分页查询流程定义", zap.Any("request", req))
 
+	access, err := uc.authz.AccessFilter(ctx, ActionRead)
+	if err != nil {
+		return nil, err
+	}
+
 	// 构建过滤条件
 	filter := &ProcessDefinitionFilter{
 		Name:        req.Name,
@@ -222,6 +275,7 @@ func (uc *ProcessDefinitionUseCase) ListProcessDefinitions(ctx context.Context,
 		Status:      req.Status,
 		CreatedFrom: req.CreatedFrom,
 		CreatedTo:   req.CreatedTo,
+		Access:      access,
 	}
 
 	// 构建查询选项
@@ -252,10 +306,19 @@ func (uc *ProcessDefinitionUseCase) ListProcessDefinitions(ctx context.Context,
 	}, nil
 }
 
-// DeployProcessDefinition 部署流程定义
+// DeployProcessDefinition 部署流程定义，调用方需要拥有流程定义范围内的全部操作授权
 func (uc *ProcessDefinitionUseCase) DeployProcessDefinition(ctx context.Context, id string) error {
 	uc.logger.Info("部署流程定义", zap.String("id", id))
 
+	pd, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("获取流程定义失败", zap.String("id", id), zap.Error(err))
+		return fmt.Errorf("获取流程定义失败: %w", err)
+	}
+	if err := uc.authz.Authorize(ctx, ActionAll, definitionResource(pd)); err != nil {
+		return err
+	}
+
 	// 部署流程定义
 	if err := uc.repo.Deploy(ctx, id); err != nil {
 		uc.logger.Error("部署流程定义失败", zap.String("id", id), zap.Error(err))
@@ -276,6 +339,15 @@ func (uc *ProcessDefinitionUseCase) DeployProcessDefinition(ctx context.Context,
 func (uc *ProcessDefinitionUseCase) SuspendProcessDefinition(ctx context.Context, id string) error {
 	uc.logger.Info("挂起流程定义", zap.String("id", id))
 
+	pd, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("获取流程定义失败", zap.String("id", id), zap.Error(err))
+		return fmt.Errorf("获取流程定义失败: %w", err)
+	}
+	if err := uc.authz.Authorize(ctx, ActionSuspend, definitionResource(pd)); err != nil {
+		return err
+	}
+
 	// 挂起流程定义
 	if err := uc.repo.Suspend(ctx, id); err != nil {
 		uc.logger.Error("挂起流程定义失败", zap.String("id", id), zap.Error(err))
@@ -307,25 +379,84 @@ func (uc *ProcessDefinitionUseCase) validateCreateRequest(req *CreateProcessDefi
 }
 
 // validateProcessDefinition 验证流程定义内容
+// 解析为流程模型并进行结构校验，失败时返回的错误包装了 model.ValidationErrors
 func (uc *ProcessDefinitionUseCase) validateProcessDefinition(resource string) error {
-	// 简单的JSON格式验证
-	var definition map[string]interface{}
-	if err := json.Unmarshal([]byte(resource), &definition); err != nil {
-		return fmt.Errorf("流程定义不是有效的JSON格式: %w", err)
+	if _, err := model.Parse([]byte(resource)); err != nil {
+		var verrs model.ValidationErrors
+		if errors.As(err, &verrs) {
+			uc.logger.Debug("流程定义结构校验未通过", zap.Int("error_count", len(verrs)))
+		}
+		return err
 	}
+	return nil
+}
 
-	// 检查必要字段
-	if _, ok := definition["id"]; !ok {
-		return fmt.Errorf("流程定义缺少id字段")
+// prepareResource 校验流程定义资源并返回需要保存的JSON资源与流程图布局
+// BPMN 2.0 XML 转换为等价的JSON资源，其中的图形信息作为流程图布局保存
+func (uc *ProcessDefinitionUseCase) prepareResource(resource string) (string, map[string]interface{}, error) {
+	if !model.IsBPMN([]byte(resource)) {
+		return resource, nil, uc.validateProcessDefinition(resource)
 	}
-	if _, ok := definition["name"]; !ok {
-		return fmt.Errorf("流程定义缺少name字段")
+
+	doc, err := model.ParseBPMN([]byte(resource))
+	if err != nil {
+		var verrs model.ValidationErrors
+		if errors.As(err, &verrs) {
+			uc.logger.Debug("BPMN文件校验未通过", zap.Int("error_count", len(verrs)))
+		}
+		return "", nil, err
 	}
-	if _, ok := definition["elements"]; !ok {
-		return fmt.Errorf("流程定义缺少elements字段")
+	diagramData, err := doc.Diagram.Map()
+	if err != nil {
+		return "", nil, err
 	}
+	uc.logger.Info("BPMN文件已转换为流程定义资源", zap.String("process_id", doc.Definition.ID))
+	return string(doc.Resource), diagramData, nil
+}
 
-	return nil
+// ExportProcessDefinitionBPMN 将流程定义导出为BPMN 2.0 XML
+// 节点坐标取自流程定义的 diagram_data，缺失时自动布局
+func (uc *ProcessDefinitionUseCase) ExportProcessDefinitionBPMN(ctx context.Context, id string) ([]byte, error) {
+	uc.logger.Debug("导出流程定义BPMN", zap.String("id", id))
+
+	pd, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("获取流程定义失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("获取流程定义失败: %w", err)
+	}
+	if err := uc.authz.Authorize(ctx, ActionRead, definitionResource(pd)); err != nil {
+		return nil, err
+	}
+
+	def, err := model.Parse([]byte(pd.Resource))
+	if err != nil {
+		uc.logger.Error("解析流程定义失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("解析流程定义失败: %w", err)
+	}
+
+	diagram, err := model.DiagramFromMap(pd.DiagramData)
+	if err != nil {
+		// 布局数据无法识别时按自动布局导出，不影响流程语义
+		uc.logger.Warn("流程图布局无效，使用自动布局", zap.String("id", id), zap.Error(err))
+		diagram = nil
+	}
+
+	data, err := model.ExportBPMN(def, diagram)
+	if err != nil {
+		uc.logger.Error("导出BPMN失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("导出BPMN失败: %w", err)
+	}
+	return data, nil
+}
+
+// definitionResource 返回流程定义的鉴权资源
+func definitionResource(pd *ent.ProcessDefinition) *ProcessResource {
+	return &ProcessResource{
+		ProcessDefinitionID:  pd.ID,
+		ProcessDefinitionKey: pd.Key,
+		Category:             pd.Category,
+		TenantID:             pd.TenantID,
+	}
 }
 
 // cacheProcessDefinition 缓存流程定义
@@ -350,6 +481,7 @@ func (uc *ProcessDefinitionUseCase) toProcessDefinitionResponse(pd *ent.ProcessD
 		Category:    pd.Category,
 		Version:     pd.Version,
 		Resource:    pd.Resource,
+		DiagramData: pd.DiagramData,
 		Suspended:   pd.Suspended,
 		TenantID:    pd.TenantID,
 		DeployTime:  pd.DeployTime,
@@ -11,7 +11,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/workflow-engine/workflow-engine/internal/auth"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/model"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest/observer"
@@ -143,6 +146,11 @@ func (m *MockCacheRepo) HGetAll(ctx context.Context, key string) (map[string]str
 	return args.Get(0).(map[string]string), args.Error(1)
 }
 
+// testProcessResource 结构合法的最小流程定义资源
+const testProcessResource = `{"id":"test-process","name":"测试流程","elements":[` +
+	`{"id":"start","type":"startEvent","next":["end"]},` +
+	`{"id":"end","type":"endEvent"}]}`
+
 // 创建测试用的流程定义
 func createTestProcessDefinition() *ent.ProcessDefinition {
 	now := time.Now()
@@ -153,7 +161,7 @@ func createTestProcessDefinition() *ent.ProcessDefinition {
 		Description: "这是一个测试流程",
 		Category:    "test",
 		Version:     1,
-		Resource:    `{"id":"test-process","name":"测试流程","elements":[{"id":"start","type":"startEvent"}]}`,
+		Resource:    testProcessResource,
 		Suspended:   false,
 		TenantID:    "default",
 		DeployTime:  now,
@@ -177,14 +185,14 @@ func TestProcessDefinitionUseCase_CreateProcessDefinition(t *testing.T) {
 		// 准备测试数据
 		mockRepo := new(MockProcessDefinitionRepo)
 		mockCache := new(MockCacheRepo)
-		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, logger)
+		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, NewAuthorizationUseCase(nil, nil, logger), logger)
 
 		req := &CreateProcessDefinitionRequest{
 			Key:         "test-process",
 			Name:        "测试流程",
 			Description: "这是一个测试流程",
 			Category:    "test",
-			Resource:    `{"id":"test-process","name":"测试流程","elements":[{"id":"start","type":"startEvent"}]}`,
+			Resource:    testProcessResource,
 			TenantID:    "default",
 		}
 
@@ -198,7 +206,7 @@ func TestProcessDefinitionUseCase_CreateProcessDefinition(t *testing.T) {
 		mockCache.On("Set", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), time.Hour).Return(nil)
 
 		// 执行测试
-		result, err := uc.CreateProcessDefinition(context.Background(), req)
+		result, err := uc.CreateProcessDefinition(systemContext(), req)
 
 		// 验证结果
 		assert.NoError(t, err, "创建流程定义不应该返回错误")
@@ -216,14 +224,14 @@ func TestProcessDefinitionUseCase_CreateProcessDefinition(t *testing.T) {
 		// 准备测试数据
 		mockRepo := new(MockProcessDefinitionRepo)
 		mockCache := new(MockCacheRepo)
-		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, logger)
+		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, NewAuthorizationUseCase(nil, nil, logger), logger)
 
 		req := &CreateProcessDefinitionRequest{
 			Key:         "test-process",
 			Name:        "测试流程",
 			Description: "这是一个测试流程",
 			Category:    "test",
-			Resource:    `{"id":"test-process","name":"测试流程","elements":[{"id":"start","type":"startEvent"}]}`,
+			Resource:    testProcessResource,
 			TenantID:    "default",
 		}
 
@@ -240,7 +248,7 @@ func TestProcessDefinitionUseCase_CreateProcessDefinition(t *testing.T) {
 		mockCache.On("Set", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string"), time.Hour).Return(nil)
 
 		// 执行测试
-		result, err := uc.CreateProcessDefinition(context.Background(), req)
+		result, err := uc.CreateProcessDefinition(systemContext(), req)
 
 		// 验证结果
 		assert.NoError(t, err, "创建流程定义不应该返回错误")
@@ -256,7 +264,7 @@ func TestProcessDefinitionUseCase_CreateProcessDefinition(t *testing.T) {
 		// 准备测试数据
 		mockRepo := new(MockProcessDefinitionRepo)
 		mockCache := new(MockCacheRepo)
-		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, logger)
+		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, NewAuthorizationUseCase(nil, nil, logger), logger)
 
 		req := &CreateProcessDefinitionRequest{
 			// 缺少必要字段
@@ -264,7 +272,7 @@ func TestProcessDefinitionUseCase_CreateProcessDefinition(t *testing.T) {
 		}
 
 		// 执行测试
-		result, err := uc.CreateProcessDefinition(context.Background(), req)
+		result, err := uc.CreateProcessDefinition(systemContext(), req)
 
 		// 验证结果
 		assert.Error(t, err, "应该返回参数验证错误")
@@ -276,7 +284,7 @@ func TestProcessDefinitionUseCase_CreateProcessDefinition(t *testing.T) {
 		// 准备测试数据
 		mockRepo := new(MockProcessDefinitionRepo)
 		mockCache := new(MockCacheRepo)
-		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, logger)
+		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, NewAuthorizationUseCase(nil, nil, logger), logger)
 
 		req := &CreateProcessDefinitionRequest{
 			Key:         "test-process",
@@ -291,7 +299,7 @@ func TestProcessDefinitionUseCase_CreateProcessDefinition(t *testing.T) {
 		mockRepo.On("GetLatestByKey", mock.Anything, "test-process").Return(nil, errors.New("not found"))
 
 		// 执行测试
-		result, err := uc.CreateProcessDefinition(context.Background(), req)
+		result, err := uc.CreateProcessDefinition(systemContext(), req)
 
 		// 验证结果
 		assert.Error(t, err, "应该返回流程定义验证错误")
@@ -301,6 +309,40 @@ func TestProcessDefinitionUseCase_CreateProcessDefinition(t *testing.T) {
 		// 验证mock调用
 		mockRepo.AssertExpectations(t)
 	})
+
+	t.Run("流程图结构错误以列表返回", func(t *testing.T) {
+		// 准备测试数据
+		mockRepo := new(MockProcessDefinitionRepo)
+		mockCache := new(MockCacheRepo)
+		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, NewAuthorizationUseCase(nil, nil, logger), logger)
+
+		req := &CreateProcessDefinitionRequest{
+			Key:  "test-process",
+			Name: "测试流程",
+			Resource: `{"id":"test-process","name":"测试流程","elements":[` +
+				`{"id":"start","type":"start_event","next":["missing"]},` +
+				`{"id":"orphan","type":"user_task","next":"end"},` +
+				`{"id":"end","type":"end_event"}]}`,
+		}
+
+		// 设置mock期望
+		mockRepo.On("GetLatestByKey", mock.Anything, "test-process").Return(nil, errors.New("not found"))
+
+		// 执行测试
+		result, err := uc.CreateProcessDefinition(systemContext(), req)
+
+		// 验证结果
+		assert.Nil(t, result, "结果应该为空")
+		var verrs model.ValidationErrors
+		require.True(t, errors.As(err, &verrs), "错误应该包含结构化的校验错误列表")
+		paths := make([]string, len(verrs))
+		for i, e := range verrs {
+			paths[i] = e.Path
+		}
+		assert.Contains(t, paths, "$.elements[0].next[0]", "应该报告悬空的next目标")
+		assert.Contains(t, paths, "$.elements[1]", "应该报告不可达节点")
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
 }
 
 // TestProcessDefinitionUseCase_GetProcessDefinition 测试获取流程定义功能
@@ -311,7 +353,7 @@ func TestProcessDefinitionUseCase_GetProcessDefinition(t *testing.T) {
 		// 准备测试数据
 		mockRepo := new(MockProcessDefinitionRepo)
 		mockCache := new(MockCacheRepo)
-		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, logger)
+		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, NewAuthorizationUseCase(nil, nil, logger), logger)
 
 		expectedPD := createTestProcessDefinition()
 		id := strconv.FormatInt(expectedPD.ID, 10)
@@ -322,7 +364,7 @@ func TestProcessDefinitionUseCase_GetProcessDefinition(t *testing.T) {
 		mockCache.On("Set", mock.Anything, "process_definition:1", mock.AnythingOfType("string"), time.Hour).Return(nil)
 
 		// 执行测试
-		result, err := uc.GetProcessDefinition(context.Background(), id)
+		result, err := uc.GetProcessDefinition(systemContext(), id)
 
 		// 验证结果
 		assert.NoError(t, err, "获取流程定义不应该返回错误")
@@ -339,7 +381,7 @@ func TestProcessDefinitionUseCase_GetProcessDefinition(t *testing.T) {
 		// 准备测试数据
 		mockRepo := new(MockProcessDefinitionRepo)
 		mockCache := new(MockCacheRepo)
-		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, logger)
+		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, NewAuthorizationUseCase(nil, nil, logger), logger)
 
 		id := "999"
 
@@ -348,7 +390,7 @@ func TestProcessDefinitionUseCase_GetProcessDefinition(t *testing.T) {
 		mockRepo.On("GetByID", mock.Anything, id).Return(nil, errors.New("not found"))
 
 		// 执行测试
-		result, err := uc.GetProcessDefinition(context.Background(), id)
+		result, err := uc.GetProcessDefinition(systemContext(), id)
 
 		// 验证结果
 		assert.Error(t, err, "应该返回未找到错误")
@@ -369,7 +411,7 @@ func TestProcessDefinitionUseCase_UpdateProcessDefinition(t *testing.T) {
 		// 准备测试数据
 		mockRepo := new(MockProcessDefinitionRepo)
 		mockCache := new(MockCacheRepo)
-		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, logger)
+		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, NewAuthorizationUseCase(nil, nil, logger), logger)
 
 		existingPD := createTestProcessDefinition()
 		id := strconv.FormatInt(existingPD.ID, 10)
@@ -391,7 +433,7 @@ func TestProcessDefinitionUseCase_UpdateProcessDefinition(t *testing.T) {
 		mockCache.On("Delete", mock.Anything, "process_definition:1").Return(nil)
 
 		// 执行测试
-		result, err := uc.UpdateProcessDefinition(context.Background(), id, req)
+		result, err := uc.UpdateProcessDefinition(systemContext(), id, req)
 
 		// 验证结果
 		assert.NoError(t, err, "更新流程定义不应该返回错误")
@@ -408,7 +450,7 @@ func TestProcessDefinitionUseCase_UpdateProcessDefinition(t *testing.T) {
 		// 准备测试数据
 		mockRepo := new(MockProcessDefinitionRepo)
 		mockCache := new(MockCacheRepo)
-		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, logger)
+		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, NewAuthorizationUseCase(nil, nil, logger), logger)
 
 		id := "999"
 		req := &UpdateProcessDefinitionRequest{
@@ -419,7 +461,7 @@ func TestProcessDefinitionUseCase_UpdateProcessDefinition(t *testing.T) {
 		mockRepo.On("GetByID", mock.Anything, id).Return(nil, errors.New("not found"))
 
 		// 执行测试
-		result, err := uc.UpdateProcessDefinition(context.Background(), id, req)
+		result, err := uc.UpdateProcessDefinition(systemContext(), id, req)
 
 		// 验证结果
 		assert.Error(t, err, "应该返回未找到错误")
@@ -439,16 +481,17 @@ func TestProcessDefinitionUseCase_DeleteProcessDefinition(t *testing.T) {
 		// 准备测试数据
 		mockRepo := new(MockProcessDefinitionRepo)
 		mockCache := new(MockCacheRepo)
-		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, logger)
+		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, NewAuthorizationUseCase(nil, nil, logger), logger)
 
 		id := "1"
 
 		// 设置mock期望
+		mockRepo.On("GetByID", mock.Anything, id).Return(createTestProcessDefinition(), nil)
 		mockRepo.On("Delete", mock.Anything, id).Return(nil)
 		mockCache.On("Delete", mock.Anything, "process_definition:1").Return(nil)
 
 		// 执行测试
-		err := uc.DeleteProcessDefinition(context.Background(), id)
+		err := uc.DeleteProcessDefinition(systemContext(), id)
 
 		// 验证结果
 		assert.NoError(t, err, "删除流程定义不应该返回错误")
@@ -462,15 +505,16 @@ func TestProcessDefinitionUseCase_DeleteProcessDefinition(t *testing.T) {
 		// 准备测试数据
 		mockRepo := new(MockProcessDefinitionRepo)
 		mockCache := new(MockCacheRepo)
-		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, logger)
+		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, NewAuthorizationUseCase(nil, nil, logger), logger)
 
 		id := "1"
 
 		// 设置mock期望
+		mockRepo.On("GetByID", mock.Anything, id).Return(createTestProcessDefinition(), nil)
 		mockRepo.On("Delete", mock.Anything, id).Return(errors.New("delete failed"))
 
 		// 执行测试
-		err := uc.DeleteProcessDefinition(context.Background(), id)
+		err := uc.DeleteProcessDefinition(systemContext(), id)
 
 		// 验证结果
 		assert.Error(t, err, "应该返回删除失败错误")
@@ -489,16 +533,17 @@ func TestProcessDefinitionUseCase_DeployProcessDefinition(t *testing.T) {
 		// 准备测试数据
 		mockRepo := new(MockProcessDefinitionRepo)
 		mockCache := new(MockCacheRepo)
-		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, logger)
+		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, NewAuthorizationUseCase(nil, nil, logger), logger)
 
 		id := "1"
 
 		// 设置mock期望
+		mockRepo.On("GetByID", mock.Anything, id).Return(createTestProcessDefinition(), nil)
 		mockRepo.On("Deploy", mock.Anything, id).Return(nil)
 		mockCache.On("Delete", mock.Anything, "process_definition:1").Return(nil)
 
 		// 执行测试
-		err := uc.DeployProcessDefinition(context.Background(), id)
+		err := uc.DeployProcessDefinition(systemContext(), id)
 
 		// 验证结果
 		assert.NoError(t, err, "部署流程定义不应该返回错误")
@@ -517,16 +562,17 @@ func TestProcessDefinitionUseCase_SuspendProcessDefinition(t *testing.T) {
 		// 准备测试数据
 		mockRepo := new(MockProcessDefinitionRepo)
 		mockCache := new(MockCacheRepo)
-		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, logger)
+		uc := NewProcessDefinitionUseCase(mockRepo, mockCache, NewAuthorizationUseCase(nil, nil, logger), logger)
 
 		id := "1"
 
 		// 设置mock期望
+		mockRepo.On("GetByID", mock.Anything, id).Return(&ent.ProcessDefinition{ID: 1, Key: "test-process"}, nil)
 		mockRepo.On("Suspend", mock.Anything, id).Return(nil)
 		mockCache.On("Delete", mock.Anything, "process_definition:1").Return(nil)
 
 		// 执行测试
-		err := uc.SuspendProcessDefinition(context.Background(), id)
+		err := uc.SuspendProcessDefinition(systemContext(), id)
 
 		// 验证结果
 		assert.NoError(t, err, "挂起流程定义不应该返回错误")
@@ -536,3 +582,90 @@ func TestProcessDefinitionUseCase_SuspendProcessDefinition(t *testing.T) {
 		mockCache.AssertExpectations(t)
 	})
 }
+
+// TestProcessDefinitionUseCase_ManageAccess 测试创建、更新、删除与部署流程定义的资源授权
+func TestProcessDefinitionUseCase_ManageAccess(t *testing.T) {
+	logger, _ := createTestLogger()
+	ctx := auth.NewContext(context.Background(), &auth.Identity{UserID: "2", Username: "alice"})
+
+	// newUseCase 创建流程定义用例，调用方拥有 test 分类的全部操作授权与 payroll 流程的查看、启动授权
+	newUseCase := func() (*ProcessDefinitionUseCase, *MockProcessDefinitionRepo, *MockCacheRepo) {
+		mockRepo := new(MockProcessDefinitionRepo)
+		mockCache := new(MockCacheRepo)
+		authzRepo := new(MockAuthorizationRepo)
+		authzRepo.On("ListByPrincipals", mock.Anything, []string{"2", "alice"}, mock.Anything).Return([]*ent.Authorization{
+			{PrincipalType: PrincipalUser, PrincipalID: "alice", Category: "test", Actions: []string{ActionAll}},
+			{PrincipalType: PrincipalUser, PrincipalID: "alice", ProcessDefinitionKey: "payroll", Actions: []string{ActionRead, ActionStart}},
+		}, nil)
+		return NewProcessDefinitionUseCase(mockRepo, mockCache, NewAuthorizationUseCase(authzRepo, mockRepo, logger), logger), mockRepo, mockCache
+	}
+	// hrDefinition 返回授权范围外的流程定义
+	hrDefinition := func() *ent.ProcessDefinition {
+		pd := createTestProcessDefinition()
+		pd.Key, pd.Category = "payroll", "hr"
+		return pd
+	}
+
+	t.Run("在授权分类内创建流程定义", func(t *testing.T) {
+		uc, mockRepo, mockCache := newUseCase()
+
+		mockRepo.On("GetLatestByKey", mock.Anything, "test-process").Return(nil, errors.New("not found"))
+		mockRepo.On("Create", mock.Anything, mock.Anything).Return(createTestProcessDefinition(), nil)
+		mockCache.On("Set", mock.Anything, mock.Anything, mock.Anything, time.Hour).Return(nil)
+
+		_, err := uc.CreateProcessDefinition(ctx, &CreateProcessDefinitionRequest{
+			Key: "test-process", Name: "测试流程", Category: "test", Resource: testProcessResource,
+		})
+
+		assert.NoError(t, err, "拥有分类全部操作授权时应该可以创建流程定义")
+	})
+
+	t.Run("只有查看与启动授权时不能创建", func(t *testing.T) {
+		uc, mockRepo, _ := newUseCase()
+
+		_, err := uc.CreateProcessDefinition(ctx, &CreateProcessDefinitionRequest{
+			Key: "payroll", Name: "薪资流程", Category: "hr", Resource: testProcessResource,
+		})
+
+		assert.ErrorIs(t, err, ErrAccessDenied, "没有全部操作授权时不能创建流程定义")
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("不能为授权范围外的流程定义创建新版本", func(t *testing.T) {
+		uc, mockRepo, _ := newUseCase()
+
+		mockRepo.On("GetLatestByKey", mock.Anything, "payroll").Return(hrDefinition(), nil)
+
+		_, err := uc.CreateProcessDefinition(ctx, &CreateProcessDefinitionRequest{
+			Key: "payroll", Name: "薪资流程", Category: "test", Resource: testProcessResource,
+		})
+
+		assert.ErrorIs(t, err, ErrAccessDenied, "改用授权分类不能接管其他分类的流程定义")
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("不能将流程定义移到授权范围外的分类", func(t *testing.T) {
+		uc, mockRepo, _ := newUseCase()
+
+		mockRepo.On("GetByID", mock.Anything, "1").Return(createTestProcessDefinition(), nil)
+
+		_, err := uc.UpdateProcessDefinition(ctx, "1", &UpdateProcessDefinitionRequest{Category: "hr"})
+
+		assert.ErrorIs(t, err, ErrAccessDenied, "新分类不在授权范围内时不能修改")
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("不能更新、删除或部署授权范围外的流程定义", func(t *testing.T) {
+		uc, mockRepo, _ := newUseCase()
+
+		mockRepo.On("GetByID", mock.Anything, "1").Return(hrDefinition(), nil)
+
+		_, err := uc.UpdateProcessDefinition(ctx, "1", &UpdateProcessDefinitionRequest{Name: "薪资审批"})
+		assert.ErrorIs(t, err, ErrAccessDenied, "不能更新授权范围外的流程定义")
+		assert.ErrorIs(t, uc.DeleteProcessDefinition(ctx, "1"), ErrAccessDenied, "不能删除授权范围外的流程定义")
+		assert.ErrorIs(t, uc.DeployProcessDefinition(ctx, "1"), ErrAccessDenied, "不能部署授权范围外的流程定义")
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "Deploy", mock.Anything, mock.Anything)
+	})
+}
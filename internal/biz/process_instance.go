@@ -5,22 +5,38 @@ package biz
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
 
+	"github.com/workflow-engine/workflow-engine/internal/auth"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/model"
 	"github.com/workflow-engine/workflow-engine/internal/temporal"
 	"go.uber.org/zap"
 )
 
+// ErrInvalidMigration 流程实例不能迁移到目标流程定义版本
+var ErrInvalidMigration = errors.New("流程实例无法迁移到目标版本")
+
+// WorkflowEngine 流程执行引擎接口，由 temporal.Client 实现
+type WorkflowEngine interface {
+	StartProcessWorkflow(ctx context.Context, input temporal.ProcessWorkflowInput) (string, error)
+	SignalWorkflow(ctx context.Context, workflowID string, runID string, signalName string, arg interface{}) error
+	TerminateWorkflow(ctx context.Context, workflowID string, runID string, reason string) error
+}
+
 // ProcessInstanceUseCase 流程实例用例，包含流程实例相关的业务逻辑
 type ProcessInstanceUseCase struct {
 	processInstanceRepo ProcessInstanceRepo
 	processDefRepo      ProcessDefinitionRepo
+	taskInstanceRepo    TaskInstanceRepo
 	variableRepo        ProcessVariableRepo
+	txRepo              TransactionRepo
 	cache               CacheRepo
-	temporalClient      *temporal.Client
+	temporalClient      WorkflowEngine
+	authz               *AuthorizationUseCase
 	logger              *zap.Logger
 }
 
@@ -28,22 +44,29 @@ type ProcessInstanceUseCase struct {
 func NewProcessInstanceUseCase(
 	processInstanceRepo ProcessInstanceRepo,
 	processDefRepo ProcessDefinitionRepo,
+	taskInstanceRepo TaskInstanceRepo,
 	variableRepo ProcessVariableRepo,
+	txRepo TransactionRepo,
 	cache CacheRepo,
-	temporalClient *temporal.Client,
+	temporalClient WorkflowEngine,
+	authz *AuthorizationUseCase,
 	logger *zap.Logger,
 ) *ProcessInstanceUseCase {
 	return &ProcessInstanceUseCase{
 		processInstanceRepo: processInstanceRepo,
 		processDefRepo:      processDefRepo,
+		taskInstanceRepo:    taskInstanceRepo,
 		variableRepo:        variableRepo,
+		txRepo:              txRepo,
 		cache:               cache,
 		temporalClient:      temporalClient,
+		authz:               authz,
 		logger:              logger,
 	}
 }
 
 // StartProcessInstance 启动流程实例
+// 未指定租户时流程实例沿用流程定义的租户
 func (uc *ProcessInstanceUseCase) StartProcessInstance(ctx context.Context, req *StartProcessInstanceRequest) (*ProcessInstanceResponse, error) {
 	uc.logger.Info("开始启动流程实例",
 		zap.String("process_definition_id", req.ProcessDefinitionID),
@@ -78,6 +101,19 @@ func (uc *ProcessInstanceUseCase) StartProcessInstance(ctx context.Context, req
 		return nil, fmt.Errorf("流程定义已被挂起，无法启动实例")
 	}
 
+	tenantID := req.TenantID
+	if tenantID == "" {
+		tenantID = processDef.TenantID
+	}
+	if err := uc.authz.Authorize(ctx, ActionStart, &ProcessResource{
+		ProcessDefinitionID:  processDef.ID,
+		ProcessDefinitionKey: processDef.Key,
+		Category:             processDef.Category,
+		TenantID:             tenantID,
+	}); err != nil {
+		return nil, err
+	}
+
 	// 构建流程实例
 	instance := &ent.ProcessInstance{
 		ProcessDefinitionID:      processDef.ID,
@@ -90,26 +126,33 @@ func (uc *ProcessInstanceUseCase) StartProcessInstance(ctx context.Context, req
 		Name:                     req.Name,
 		Description:              req.Description,
 		Suspended:                false,
-		TenantID:                 req.TenantID,
+		TenantID:                 tenantID,
 	}
 
-	// 保存流程实例
-	result, err := uc.processInstanceRepo.Create(ctx, instance)
+	// 在同一事务中保存流程实例与流程变量，任一失败则整体回滚
+	var result *ent.ProcessInstance
+	err = uc.txRepo.Transaction(ctx, func(ctx context.Context) error {
+		created, err := uc.processInstanceRepo.Create(ctx, instance)
+		if err != nil {
+			return fmt.Errorf("保存流程实例失败: %w", err)
+		}
+		if err := uc.saveProcessVariables(ctx, created.ID, req.Variables); err != nil {
+			return fmt.Errorf("保存流程变量失败: %w", err)
+		}
+		result = created
+		return nil
+	})
 	if err != nil {
 		uc.logger.Error("保存流程实例失败", zap.Error(err))
-		return nil, fmt.Errorf("保存流程实例失败: %w", err)
+		return nil, err
 	}
 
-	// 保存流程变量
-	if req.Variables != nil && len(req.Variables) > 0 {
-		if err := uc.saveProcessVariables(ctx, result.ID, req.Variables); err != nil {
-			uc.logger.Warn("保存流程变量失败", zap.Error(err))
-			// 变量保存失败不影响主要流程
-		}
+	// 启动工作流执行，失败时回滚已写入的实例与变量
+	result, err = uc.startWorkflow(ctx, result, req.Variables)
+	if err != nil {
+		return nil, err
 	}
 
-	// TODO: 集成Temporal，启动工作流执行
-
 	// 缓存流程实例
 	if err := uc.cacheProcessInstance(ctx, result); err != nil {
 		uc.logger.Warn("缓存流程实例失败", zap.Error(err))
@@ -132,6 +175,9 @@ func (uc *ProcessInstanceUseCase) GetProcessInstance(ctx context.Context, id str
 		var instance ent.ProcessInstance
 		if err := json.Unmarshal([]byte(cached), &instance); err == nil {
 			uc.logger.Debug("从缓存获取流程实例成功", zap.String("id", id))
+			if err := uc.authz.Authorize(ctx, ActionRead, instanceResource(&instance)); err != nil {
+				return nil, err
+			}
 			variables, _ := uc.getProcessVariables(ctx, instance.ID)
 			return uc.toProcessInstanceResponse(&instance, variables), nil
 		}
@@ -143,6 +189,9 @@ func (uc *ProcessInstanceUseCase) GetProcessInstance(ctx context.Context, id str
 		uc.logger.Error("获取流程实例失败", zap.String("id", id), zap.Error(err))
 		return nil, fmt.Errorf("获取流程实例失败: %w", err)
 	}
+	if err := uc.authz.Authorize(ctx, ActionRead, instanceResource(instance)); err != nil {
+		return nil, err
+	}
 
 	// 获取流程变量
 	variables, err := uc.getProcessVariables(ctx, instance.ID)
@@ -159,15 +208,21 @@ func (uc *ProcessInstanceUseCase) GetProcessInstance(ctx context.Context, id str
 	return uc.toProcessInstanceResponse(instance, variables), nil
 }
 
-// ListProcessInstances 分页查询流程实例
+// ListProcessInstances 分页查询流程实例，只返回调用方有权查看或由调用方发起的流程实例
 func (uc *ProcessInstanceUseCase) ListProcessInstances(ctx context.Context, req *ListProcessInstancesRequest) (*ListProcessInstancesResponse, error) {
 	uc.logger.Debug("分页查询流程实例", zap.Any("request", req))
 
+	access, err := uc.authz.AccessFilter(ctx, ActionRead)
+	if err != nil {
+		return nil, err
+	}
+
 	// 构建过滤条件
 	filter := &ProcessInstanceFilter{
 		ProcessDefinitionID: req.ProcessDefinitionID,
 		StartedFrom:         req.StartedFrom,
 		StartedTo:           req.StartedTo,
+		Access:              access,
 	}
 
 	// 构建查询选项
@@ -210,6 +265,9 @@ func (uc *ProcessInstanceUseCase) SuspendProcessInstance(ctx context.Context, id
 		uc.logger.Error("获取流程实例失败", zap.String("id", id), zap.Error(err))
 		return fmt.Errorf("获取流程实例失败: %w", err)
 	}
+	if err := uc.authz.Authorize(ctx, ActionSuspend, instanceResource(instance)); err != nil {
+		return err
+	}
 
 	// 检查实例状态
 	if instance.EndTime != nil {
@@ -221,13 +279,18 @@ func (uc *ProcessInstanceUseCase) SuspendProcessInstance(ctx context.Context, id
 
 	// 更新实例状态
 	instance.Suspended = true
-	_, err = uc.processInstanceRepo.Update(ctx, instance)
+	instance, err = uc.processInstanceRepo.Update(ctx, instance)
 	if err != nil {
 		uc.logger.Error("挂起流程实例失败", zap.String("id", id), zap.Error(err))
 		return fmt.Errorf("挂起流程实例失败: %w", err)
 	}
 
-	// TODO: 集成Temporal，暂停工作流执行
+	// 通知工作流暂停执行，失败时恢复数据库状态
+	if err := uc.signalWorkflow(ctx, instance, temporal.SignalSuspend, nil); err != nil {
+		instance.Suspended = false
+		uc.rollbackInstance(ctx, instance)
+		return fmt.Errorf("暂停工作流执行失败: %w", err)
+	}
 
 	// 清除缓存
 	cacheKey := fmt.Sprintf("process_instance:%s", id)
@@ -249,6 +312,9 @@ func (uc *ProcessInstanceUseCase) ActivateProcessInstance(ctx context.Context, i
 		uc.logger.Error("获取流程实例失败", zap.String("id", id), zap.Error(err))
 		return fmt.Errorf("获取流程实例失败: %w", err)
 	}
+	if err := uc.authz.Authorize(ctx, ActionSuspend, instanceResource(instance)); err != nil {
+		return err
+	}
 
 	// 检查实例状态
 	if instance.EndTime != nil {
@@ -260,13 +326,18 @@ func (uc *ProcessInstanceUseCase) ActivateProcessInstance(ctx context.Context, i
 
 	// 更新实例状态
 	instance.Suspended = false
-	_, err = uc.processInstanceRepo.Update(ctx, instance)
+	instance, err = uc.processInstanceRepo.Update(ctx, instance)
 	if err != nil {
 		uc.logger.Error("激活流程实例失败", zap.String("id", id), zap.Error(err))
 		return fmt.Errorf("激活流程实例失败: %w", err)
 	}
 
-	// TODO: 集成Temporal，恢复工作流执行
+	// 通知工作流恢复执行，失败时恢复数据库状态
+	if err := uc.signalWorkflow(ctx, instance, temporal.SignalResume, nil); err != nil {
+		instance.Suspended = true
+		uc.rollbackInstance(ctx, instance)
+		return fmt.Errorf("恢复工作流执行失败: %w", err)
+	}
 
 	// 清除缓存
 	cacheKey := fmt.Sprintf("process_instance:%s", id)
@@ -279,6 +350,7 @@ func (uc *ProcessInstanceUseCase) ActivateProcessInstance(ctx context.Context, i
 }
 
 // TerminateProcessInstance 终止流程实例
+// 工作流终止后，流程实例下未结束的任务以终止原因取消
 func (uc *ProcessInstanceUseCase) TerminateProcessInstance(ctx context.Context, id, reason string) error {
 	uc.logger.Info("终止流程实例", zap.String("id", id), zap.String("reason", reason))
 
@@ -288,6 +360,9 @@ func (uc *ProcessInstanceUseCase) TerminateProcessInstance(ctx context.Context,
 		uc.logger.Error("获取流程实例失败", zap.String("id", id), zap.Error(err))
 		return fmt.Errorf("获取流程实例失败: %w", err)
 	}
+	if err := uc.authz.Authorize(ctx, ActionTerminate, instanceResource(instance)); err != nil {
+		return err
+	}
 
 	// 检查实例状态
 	if instance.EndTime != nil {
@@ -303,13 +378,29 @@ func (uc *ProcessInstanceUseCase) TerminateProcessInstance(ctx context.Context,
 		instance.Duration = duration
 	}
 
-	_, err = uc.processInstanceRepo.Update(ctx, instance)
+	instance, err = uc.processInstanceRepo.Update(ctx, instance)
 	if err != nil {
 		uc.logger.Error("终止流程实例失败", zap.String("id", id), zap.Error(err))
 		return fmt.Errorf("终止流程实例失败: %w", err)
 	}
 
-	// TODO: 集成Temporal，终止工作流执行
+	// 终止工作流执行，工作流已结束时视为成功；其他失败恢复数据库状态
+	workflowID, runID := workflowRef(instance)
+	if err := uc.temporalClient.TerminateWorkflow(ctx, workflowID, runID, reason); err != nil && !temporal.IsWorkflowNotFound(err) {
+		uc.logger.Error("终止工作流失败", zap.String("workflow_id", workflowID), zap.Error(err))
+		instance.EndTime = nil
+		instance.DeleteReason = ""
+		instance.Duration = 0
+		uc.rollbackInstance(ctx, instance)
+		return fmt.Errorf("终止工作流执行失败: %w", err)
+	}
+
+	// 取消未结束的任务，工作流已终止，失败时只记录日志
+	if cancelled, err := uc.taskInstanceRepo.CancelByProcessInstance(ctx, instance.ID, reason); err != nil {
+		uc.logger.Error("取消流程实例的任务失败", zap.String("id", id), zap.Error(err))
+	} else if cancelled > 0 {
+		uc.logger.Info("已取消流程实例的任务", zap.String("id", id), zap.Int("count", cancelled))
+	}
 
 	// 清除缓存
 	cacheKey := fmt.Sprintf("process_instance:%s", id)
@@ -321,10 +412,87 @@ func (uc *ProcessInstanceUseCase) TerminateProcessInstance(ctx context.Context,
 	return nil
 }
 
+// MigrateProcessInstance 将运行中的流程实例迁移到同一流程的其他版本
+// 调用方需要对流程实例和目标版本都拥有 migrate 授权；未结束的任务一并迁移，目标版本必须包含这些任务所在的节点。
+// 工作流收到迁移信号后替换流程图，正在等待的节点结束后按新版本继续；通知工作流失败时数据库中的迁移一并回滚
+func (uc *ProcessInstanceUseCase) MigrateProcessInstance(ctx context.Context, id string, req *MigrateProcessInstanceRequest) error {
+	uc.logger.Info("迁移流程实例", zap.String("id", id), zap.String("process_definition_id", req.ProcessDefinitionID))
+
+	instance, err := uc.processInstanceRepo.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("获取流程实例失败", zap.String("id", id), zap.Error(err))
+		return fmt.Errorf("获取流程实例失败: %w", err)
+	}
+	if err := uc.authz.Authorize(ctx, ActionMigrate, instanceResource(instance)); err != nil {
+		return err
+	}
+	if instance.EndTime != nil {
+		return fmt.Errorf("%w: 流程实例已结束", ErrInvalidMigration)
+	}
+
+	target, err := uc.processDefRepo.GetByID(ctx, req.ProcessDefinitionID)
+	if err != nil {
+		return fmt.Errorf("获取目标流程定义失败: %w", err)
+	}
+	switch {
+	case target.Key != instance.ProcessDefinitionKey:
+		return fmt.Errorf("%w: 只能迁移到同一流程的其他版本", ErrInvalidMigration)
+	case target.ID == instance.ProcessDefinitionID:
+		return fmt.Errorf("%w: 流程实例已是该版本", ErrInvalidMigration)
+	case target.Suspended:
+		return fmt.Errorf("%w: 目标流程定义已被挂起", ErrInvalidMigration)
+	}
+	if err := uc.authz.Authorize(ctx, ActionMigrate, &ProcessResource{
+		ProcessDefinitionID:  target.ID,
+		ProcessDefinitionKey: target.Key,
+		Category:             target.Category,
+		TenantID:             instance.TenantID,
+	}); err != nil {
+		return err
+	}
+	def, err := model.Parse([]byte(target.Resource))
+	if err != nil {
+		return fmt.Errorf("解析目标流程定义失败: %w", err)
+	}
+
+	err = uc.txRepo.Transaction(ctx, func(ctx context.Context) error {
+		tasks, err := uc.taskInstanceRepo.MigrateByProcessInstance(ctx, instance.ID, target.ID)
+		if err != nil {
+			return err
+		}
+		for _, task := range tasks {
+			if _, ok := def.Node(task.TaskDefinitionKey); !ok {
+				return fmt.Errorf("%w: 目标版本缺少任务 %d 所在的节点 %s", ErrInvalidMigration, task.ID, task.TaskDefinitionKey)
+			}
+		}
+		if err := uc.processInstanceRepo.Migrate(ctx, id, target); err != nil {
+			return err
+		}
+		return uc.signalWorkflow(ctx, instance, temporal.SignalMigrate, temporal.MigrateSignal{ProcessDefinitionID: target.ID})
+	})
+	if err != nil {
+		uc.logger.Error("迁移流程实例失败", zap.String("id", id), zap.Error(err))
+		return fmt.Errorf("迁移流程实例失败: %w", err)
+	}
+
+	// 清除缓存
+	cacheKey := fmt.Sprintf("process_instance:%s", id)
+	if err := uc.cache.Delete(ctx, cacheKey); err != nil {
+		uc.logger.Warn("清除流程实例缓存失败", zap.Error(err))
+	}
+
+	uc.logger.Info("流程实例迁移成功", zap.String("id", id), zap.Int64("process_definition_id", target.ID))
+	return nil
+}
+
 // DeleteProcessInstance 删除流程实例
 func (uc *ProcessInstanceUseCase) DeleteProcessInstance(ctx context.Context, id, reason string) error {
 	uc.logger.Info("删除流程实例", zap.String("id", id), zap.String("reason", reason))
 
+	if err := uc.authorizeInstance(ctx, ActionTerminate, id); err != nil {
+		return err
+	}
+
 	// 先终止流程实例（如果还在运行）
 	if err := uc.TerminateProcessInstance(ctx, id, reason); err != nil {
 		// 如果终止失败，检查是否是因为实例已经结束
@@ -350,6 +518,90 @@ func (uc *ProcessInstanceUseCase) DeleteProcessInstance(ctx context.Context, id,
 	return nil
 }
 
+// startWorkflow 启动流程工作流并将运行ID写回流程实例
+// 任一步骤失败都会回滚：已启动的工作流被终止，已写入的实例与变量被删除
+func (uc *ProcessInstanceUseCase) startWorkflow(ctx context.Context, instance *ent.ProcessInstance, variables map[string]interface{}) (*ent.ProcessInstance, error) {
+	workflowID := temporal.ProcessWorkflowID(instance.ID)
+	runID, err := uc.temporalClient.StartProcessWorkflow(ctx, temporal.ProcessWorkflowInput{
+		ProcessInstanceID:   instance.ID,
+		ProcessDefinitionID: instance.ProcessDefinitionID,
+		BusinessKey:         instance.BusinessKey,
+		Variables:           variables,
+		Initiator:           instance.StartUserID,
+		TenantID:            instance.TenantID,
+	})
+	if err != nil {
+		uc.logger.Error("启动工作流失败", zap.String("workflow_id", workflowID), zap.Error(err))
+		uc.discardInstance(ctx, instance.ID)
+		return nil, fmt.Errorf("启动工作流失败: %w", err)
+	}
+
+	instance.WorkflowID = workflowID
+	instance.WorkflowRunID = runID
+	updated, err := uc.processInstanceRepo.Update(ctx, instance)
+	if err != nil {
+		uc.logger.Error("保存工作流运行ID失败", zap.String("workflow_id", workflowID), zap.Error(err))
+		if termErr := uc.temporalClient.TerminateWorkflow(ctx, workflowID, runID, "保存流程实例失败，回滚启动"); termErr != nil {
+			uc.logger.Error("回滚时终止工作流失败", zap.String("workflow_id", workflowID), zap.Error(termErr))
+		}
+		uc.discardInstance(ctx, instance.ID)
+		return nil, fmt.Errorf("保存工作流运行ID失败: %w", err)
+	}
+
+	uc.logger.Info("工作流启动成功",
+		zap.String("workflow_id", workflowID),
+		zap.String("run_id", runID))
+	return updated, nil
+}
+
+// discardInstance 删除启动失败的流程实例及其变量
+func (uc *ProcessInstanceUseCase) discardInstance(ctx context.Context, instanceID int64) {
+	id := strconv.FormatInt(instanceID, 10)
+	err := uc.txRepo.Transaction(ctx, func(ctx context.Context) error {
+		if err := uc.variableRepo.DeleteByProcessInstanceID(ctx, id); err != nil {
+			return fmt.Errorf("回滚流程变量失败: %w", err)
+		}
+		if err := uc.processInstanceRepo.Delete(ctx, id); err != nil {
+			return fmt.Errorf("回滚流程实例失败: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		uc.logger.Error("回滚启动失败的流程实例失败", zap.String("instance_id", id), zap.Error(err))
+	}
+}
+
+// signalWorkflow 向流程实例对应的工作流发送信号
+func (uc *ProcessInstanceUseCase) signalWorkflow(ctx context.Context, instance *ent.ProcessInstance, signalName string, arg interface{}) error {
+	workflowID, runID := workflowRef(instance)
+	if err := uc.temporalClient.SignalWorkflow(ctx, workflowID, runID, signalName, arg); err != nil {
+		uc.logger.Error("发送工作流信号失败",
+			zap.String("workflow_id", workflowID),
+			zap.String("signal", signalName),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// rollbackInstance 工作流操作失败后恢复流程实例的数据库状态
+func (uc *ProcessInstanceUseCase) rollbackInstance(ctx context.Context, instance *ent.ProcessInstance) {
+	if _, err := uc.processInstanceRepo.Update(ctx, instance); err != nil {
+		uc.logger.Error("回滚流程实例状态失败，数据库与工作流状态可能不一致",
+			zap.Int64("instance_id", instance.ID),
+			zap.Error(err))
+	}
+}
+
+// workflowRef 返回流程实例对应的工作流ID与运行ID
+// 历史数据未记录工作流ID时按实例ID推导
+func workflowRef(instance *ent.ProcessInstance) (string, string) {
+	if instance.WorkflowID != "" {
+		return instance.WorkflowID, instance.WorkflowRunID
+	}
+	return temporal.ProcessWorkflowID(instance.ID), instance.WorkflowRunID
+}
+
 // validateStartRequest 验证启动请求参数
 func (uc *ProcessInstanceUseCase) validateStartRequest(req *StartProcessInstanceRequest) error {
 	if req.ProcessDefinitionID == "" && req.ProcessDefinitionKey == "" {
@@ -362,6 +614,7 @@ func (uc *ProcessInstanceUseCase) validateStartRequest(req *StartProcessInstance
 }
 
 // saveProcessVariables 保存流程变量
+// 任一变量序列化或写入失败即返回错误，调用方应在事务中调用以保证变量整体写入
 func (uc *ProcessInstanceUseCase) saveProcessVariables(ctx context.Context, instanceID int64, variables map[string]interface{}) error {
 	for name, value := range variables {
 		variable := &ent.ProcessVariable{
@@ -392,19 +645,13 @@ func (uc *ProcessInstanceUseCase) saveProcessVariables(ctx context.Context, inst
 			// 复杂类型序列化为JSON存储在TextValue中
 			valueBytes, err := json.Marshal(value)
 			if err != nil {
-				uc.logger.Warn("序列化流程变量失败",
-					zap.String("name", name),
-					zap.Any("value", value),
-					zap.Error(err))
-				continue
+				return fmt.Errorf("序列化流程变量 %s 失败: %w", name, err)
 			}
 			variable.TextValue = string(valueBytes)
 		}
 
 		if _, err := uc.variableRepo.Create(ctx, variable); err != nil {
-			uc.logger.Warn("保存流程变量失败",
-				zap.String("name", name),
-				zap.Error(err))
+			return fmt.Errorf("保存流程变量 %s 失败: %w", name, err)
 		}
 	}
 	return nil
@@ -416,16 +663,25 @@ func (uc *ProcessInstanceUseCase) GetProcessVariables(ctx context.Context, insta
 	if err != nil {
 		return nil, fmt.Errorf("无效的流程实例ID: %s", instanceID)
 	}
+	if err := uc.authorizeInstance(ctx, ActionRead, instanceID); err != nil {
+		return nil, err
+	}
 	return uc.getProcessVariables(ctx, id)
 }
 
 // SetProcessVariables 批量设置流程变量 (公共方法)
+// 变量会影响后续网关与表达式的计算，调用方需要拥有流程实例的 update 授权；发起人也不例外
 func (uc *ProcessInstanceUseCase) SetProcessVariables(ctx context.Context, instanceID string, variables map[string]interface{}) error {
 	id, err := strconv.ParseInt(instanceID, 10, 64)
 	if err != nil {
 		return fmt.Errorf("无效的流程实例ID: %s", instanceID)
 	}
-	return uc.saveProcessVariables(ctx, id, variables)
+	if err := uc.authorizeInstance(ctx, ActionUpdate, instanceID); err != nil {
+		return err
+	}
+	return uc.txRepo.Transaction(ctx, func(ctx context.Context) error {
+		return uc.saveProcessVariables(ctx, id, variables)
+	})
 }
 
 // GetProcessVariable 获取单个流程变量 (公共方法)
@@ -460,37 +716,44 @@ func (uc *ProcessInstanceUseCase) getProcessVariables(ctx context.Context, insta
 
 	result := make(map[string]interface{})
 	for _, variable := range variables {
-		var value interface{}
-
-		switch variable.Type {
-		case "string":
-			value = variable.TextValue
-		case "integer":
-			value = variable.LongValue
-		case "double":
-			value = variable.DoubleValue
-		case "boolean":
-			value = variable.TextValue == "true"
-		case "json":
-			if variable.TextValue != "" {
-				if err := json.Unmarshal([]byte(variable.TextValue), &value); err != nil {
-					uc.logger.Warn("反序列化流程变量失败",
-						zap.String("name", variable.Name),
-						zap.String("value", variable.TextValue),
-						zap.Error(err))
-					continue
-				}
-			}
-		default:
-			value = variable.TextValue
+		value, err := variableValue(variable)
+		if err != nil {
+			uc.logger.Warn("反序列化流程变量失败",
+				zap.String("name", variable.Name),
+				zap.String("value", variable.TextValue),
+				zap.Error(err))
+			continue
 		}
-
 		result[variable.Name] = value
 	}
 
 	return result, nil
 }
 
+// variableValue 按变量类型读取变量取值
+func variableValue(variable *ent.ProcessVariable) (interface{}, error) {
+	switch variable.Type {
+	case "string":
+		return variable.TextValue, nil
+	case "integer":
+		return variable.LongValue, nil
+	case "double":
+		return variable.DoubleValue, nil
+	case "boolean":
+		return variable.TextValue == "true", nil
+	case "json":
+		var value interface{}
+		if variable.TextValue != "" {
+			if err := json.Unmarshal([]byte(variable.TextValue), &value); err != nil {
+				return nil, err
+			}
+		}
+		return value, nil
+	default:
+		return variable.TextValue, nil
+	}
+}
+
 // getVariableType 获取变量类型
 func (uc *ProcessInstanceUseCase) getVariableType(value interface{}) string {
 	switch value.(type) {
@@ -509,11 +772,29 @@ func (uc *ProcessInstanceUseCase) getVariableType(value interface{}) string {
 	}
 }
 
-// getCurrentUserID 获取当前用户ID (从上下文中获取)
+// authorizeInstance 加载流程实例并校验调用方能否执行操作
+func (uc *ProcessInstanceUseCase) authorizeInstance(ctx context.Context, action, id string) error {
+	instance, err := uc.processInstanceRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("获取流程实例失败: %w", err)
+	}
+	return uc.authz.Authorize(ctx, action, instanceResource(instance))
+}
+
+// instanceResource 返回流程实例的鉴权资源，发起人无需授权即可查看
+func instanceResource(instance *ent.ProcessInstance) *ProcessResource {
+	return &ProcessResource{
+		ProcessDefinitionID:  instance.ProcessDefinitionID,
+		ProcessDefinitionKey: instance.ProcessDefinitionKey,
+		TenantID:             instance.TenantID,
+		Involved:             []string{instance.StartUserID},
+	}
+}
+
+// getCurrentUserID 获取当前用户ID
+// 取接口层放入上下文的调用方身份，引擎内部触发、没有调用方身份时为 auth.SystemUserID
 func (uc *ProcessInstanceUseCase) getCurrentUserID(ctx context.Context) string {
-	// TODO: 从JWT token或上下文中获取当前用户ID
-	// 这里先返回一个默认值
-	return "system"
+	return auth.CurrentUserID(ctx)
 }
 
 // cacheProcessInstance 缓存流程实例
@@ -558,6 +839,8 @@ func (uc *ProcessInstanceUseCase) toProcessInstanceResponse(instance *ent.Proces
 		IsSuspended:         isSuspended,
 		TenantID:            instance.TenantID,
 		Variables:           variables,
+		WorkflowID:          instance.WorkflowID,
+		WorkflowRunID:       instance.WorkflowRunID,
 		CreatedAt:           instance.CreatedAt,
 		UpdatedAt:           instance.UpdatedAt,
 	}
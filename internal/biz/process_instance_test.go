@@ -0,0 +1,588 @@
+// Package biz 提供业务逻辑层功能的测试
+// 包含流程实例管理业务逻辑的单元测试
+package biz
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/workflow-engine/workflow-engine/internal/auth"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/temporal"
+)
+
+// MockProcessInstanceRepo 模拟流程实例仓储
+type MockProcessInstanceRepo struct {
+	mock.Mock
+}
+
+func (m *MockProcessInstanceRepo) Create(ctx context.Context, pi *ent.ProcessInstance) (*ent.ProcessInstance, error) {
+	args := m.Called(ctx, pi)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.ProcessInstance), args.Error(1)
+}
+
+func (m *MockProcessInstanceRepo) GetByID(ctx context.Context, id string) (*ent.ProcessInstance, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.ProcessInstance), args.Error(1)
+}
+
+func (m *MockProcessInstanceRepo) Update(ctx context.Context, pi *ent.ProcessInstance) (*ent.ProcessInstance, error) {
+	args := m.Called(ctx, pi)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.ProcessInstance), args.Error(1)
+}
+
+func (m *MockProcessInstanceRepo) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockProcessInstanceRepo) List(ctx context.Context, filter *ProcessInstanceFilter, opts *QueryOptions) ([]*ent.ProcessInstance, *PaginationResult, error) {
+	args := m.Called(ctx, filter, opts)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).([]*ent.ProcessInstance), args.Get(1).(*PaginationResult), args.Error(2)
+}
+
+func (m *MockProcessInstanceRepo) Count(ctx context.Context, filter *ProcessInstanceFilter) (int, error) {
+	args := m.Called(ctx, filter)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockProcessInstanceRepo) ListByProcessDefinitionID(ctx context.Context, processDefinitionID string, opts *QueryOptions) ([]*ent.ProcessInstance, *PaginationResult, error) {
+	args := m.Called(ctx, processDefinitionID, opts)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).([]*ent.ProcessInstance), args.Get(1).(*PaginationResult), args.Error(2)
+}
+
+func (m *MockProcessInstanceRepo) Suspend(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockProcessInstanceRepo) Activate(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockProcessInstanceRepo) Terminate(ctx context.Context, id string, reason string) error {
+	args := m.Called(ctx, id, reason)
+	return args.Error(0)
+}
+
+func (m *MockProcessInstanceRepo) Migrate(ctx context.Context, id string, pd *ent.ProcessDefinition) error {
+	args := m.Called(ctx, id, pd)
+	return args.Error(0)
+}
+
+// MockProcessVariableRepo 模拟流程变量仓储
+type MockProcessVariableRepo struct {
+	mock.Mock
+}
+
+func (m *MockProcessVariableRepo) Create(ctx context.Context, pv *ent.ProcessVariable) (*ent.ProcessVariable, error) {
+	args := m.Called(ctx, pv)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.ProcessVariable), args.Error(1)
+}
+
+func (m *MockProcessVariableRepo) GetByID(ctx context.Context, id string) (*ent.ProcessVariable, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.ProcessVariable), args.Error(1)
+}
+
+func (m *MockProcessVariableRepo) Update(ctx context.Context, pv *ent.ProcessVariable) (*ent.ProcessVariable, error) {
+	args := m.Called(ctx, pv)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.ProcessVariable), args.Error(1)
+}
+
+func (m *MockProcessVariableRepo) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockProcessVariableRepo) GetByProcessInstanceIDAndName(ctx context.Context, processInstanceID, name string) (*ent.ProcessVariable, error) {
+	args := m.Called(ctx, processInstanceID, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.ProcessVariable), args.Error(1)
+}
+
+func (m *MockProcessVariableRepo) ListByProcessInstanceID(ctx context.Context, processInstanceID string) ([]*ent.ProcessVariable, error) {
+	args := m.Called(ctx, processInstanceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.ProcessVariable), args.Error(1)
+}
+
+func (m *MockProcessVariableRepo) ListByTaskID(ctx context.Context, taskID int64) ([]*ent.ProcessVariable, error) {
+	args := m.Called(ctx, taskID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.ProcessVariable), args.Error(1)
+}
+
+func (m *MockProcessVariableRepo) SetVariables(ctx context.Context, processInstanceID string, variables map[string]interface{}) error {
+	args := m.Called(ctx, processInstanceID, variables)
+	return args.Error(0)
+}
+
+func (m *MockProcessVariableRepo) DeleteByProcessInstanceID(ctx context.Context, processInstanceID string) error {
+	args := m.Called(ctx, processInstanceID)
+	return args.Error(0)
+}
+
+// MockWorkflowEngine 模拟流程执行引擎
+type MockWorkflowEngine struct {
+	mock.Mock
+}
+
+func (m *MockWorkflowEngine) StartProcessWorkflow(ctx context.Context, input temporal.ProcessWorkflowInput) (string, error) {
+	args := m.Called(ctx, input)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockWorkflowEngine) SignalWorkflow(ctx context.Context, workflowID string, runID string, signalName string, arg interface{}) error {
+	args := m.Called(ctx, workflowID, runID, signalName, arg)
+	return args.Error(0)
+}
+
+func (m *MockWorkflowEngine) TerminateWorkflow(ctx context.Context, workflowID string, runID string, reason string) error {
+	args := m.Called(ctx, workflowID, runID, reason)
+	return args.Error(0)
+}
+
+// MockTransactionRepo 模拟事务仓储，直接执行事务函数并记录提交与回滚次数
+type MockTransactionRepo struct {
+	committed  int
+	rolledBack int
+}
+
+func (m *MockTransactionRepo) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(ctx); err != nil {
+		m.rolledBack++
+		return err
+	}
+	m.committed++
+	return nil
+}
+
+func (m *MockTransactionRepo) Begin(ctx context.Context) (context.Context, error) {
+	return ctx, nil
+}
+
+func (m *MockTransactionRepo) Commit(ctx context.Context) error {
+	m.committed++
+	return nil
+}
+
+func (m *MockTransactionRepo) Rollback(ctx context.Context) error {
+	m.rolledBack++
+	return nil
+}
+
+// processInstanceMocks 流程实例用例测试所需的模拟依赖
+type processInstanceMocks struct {
+	instanceRepo *MockProcessInstanceRepo
+	defRepo      *MockProcessDefinitionRepo
+	taskRepo     *MockTaskInstanceRepo
+	variableRepo *MockProcessVariableRepo
+	tx           *MockTransactionRepo
+	cache        *MockCacheRepo
+	engine       *MockWorkflowEngine
+	authzRepo    *MockAuthorizationRepo
+}
+
+// newProcessInstanceUseCaseWithMocks 创建带模拟依赖的流程实例用例
+func newProcessInstanceUseCaseWithMocks() (*ProcessInstanceUseCase, *processInstanceMocks) {
+	logger, _ := createTestLogger()
+	m := &processInstanceMocks{
+		instanceRepo: new(MockProcessInstanceRepo),
+		defRepo:      new(MockProcessDefinitionRepo),
+		taskRepo:     new(MockTaskInstanceRepo),
+		variableRepo: new(MockProcessVariableRepo),
+		tx:           new(MockTransactionRepo),
+		cache:        new(MockCacheRepo),
+		engine:       new(MockWorkflowEngine),
+		authzRepo:    new(MockAuthorizationRepo),
+	}
+	uc := NewProcessInstanceUseCase(m.instanceRepo, m.defRepo, m.taskRepo, m.variableRepo, m.tx, m.cache, m.engine, NewAuthorizationUseCase(m.authzRepo, nil, logger), logger)
+	return uc, m
+}
+
+// createTestProcessInstance 创建测试用的流程实例
+func createTestProcessInstance() *ent.ProcessInstance {
+	now := time.Now()
+	return &ent.ProcessInstance{
+		ID:                   42,
+		ProcessDefinitionID:  1,
+		ProcessDefinitionKey: "test-process",
+		StartUserID:          "system",
+		StartTime:            now,
+		WorkflowID:           "process-instance-42",
+		WorkflowRunID:        "run-1",
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}
+}
+
+// TestProcessInstanceUseCase_StartProcessInstance 测试启动流程实例
+func TestProcessInstanceUseCase_StartProcessInstance(t *testing.T) {
+	t.Run("启动工作流并记录运行ID", func(t *testing.T) {
+		uc, m := newProcessInstanceUseCaseWithMocks()
+		created := createTestProcessInstance()
+		created.WorkflowID, created.WorkflowRunID = "", ""
+		created.TenantID = "tenant-a"
+
+		m.defRepo.On("GetByID", mock.Anything, "1").Return(createTestProcessDefinition(), nil)
+		m.instanceRepo.On("Create", mock.Anything, mock.Anything).Return(created, nil)
+		m.engine.On("StartProcessWorkflow", mock.Anything, mock.MatchedBy(func(in temporal.ProcessWorkflowInput) bool {
+			return in.ProcessInstanceID == 42 && in.ProcessDefinitionID == 1 && in.TenantID == "tenant-a"
+		})).Return("run-1", nil)
+		m.instanceRepo.On("Update", mock.Anything, mock.MatchedBy(func(pi *ent.ProcessInstance) bool {
+			return pi.WorkflowID == "process-instance-42" && pi.WorkflowRunID == "run-1"
+		})).Return(createTestProcessInstance(), nil)
+		m.cache.On("Set", mock.Anything, "process_instance:42", mock.Anything, 30*time.Minute).Return(nil)
+
+		result, err := uc.StartProcessInstance(systemContext(), &StartProcessInstanceRequest{ProcessDefinitionID: "1"})
+
+		require.NoError(t, err, "启动流程实例不应该返回错误")
+		assert.Equal(t, "process-instance-42", result.WorkflowID, "工作流ID应该由实例ID推导")
+		assert.Equal(t, "run-1", result.WorkflowRunID, "运行ID应该写回流程实例")
+		m.instanceRepo.AssertExpectations(t)
+		m.engine.AssertExpectations(t)
+	})
+
+	t.Run("发起人取自上下文中的调用方身份", func(t *testing.T) {
+		uc, m := newProcessInstanceUseCaseWithMocks()
+		ctx := auth.NewContext(systemContext(), &auth.Identity{UserID: "7", Username: "alice", Permissions: []string{"process:*"}})
+
+		m.defRepo.On("GetByID", mock.Anything, "1").Return(createTestProcessDefinition(), nil)
+		m.instanceRepo.On("Create", mock.Anything, mock.MatchedBy(func(pi *ent.ProcessInstance) bool {
+			return pi.StartUserID == "alice"
+		})).Return(createTestProcessInstance(), nil)
+		m.engine.On("StartProcessWorkflow", mock.Anything, mock.Anything).Return("run-1", nil)
+		m.instanceRepo.On("Update", mock.Anything, mock.Anything).Return(createTestProcessInstance(), nil)
+		m.cache.On("Set", mock.Anything, "process_instance:42", mock.Anything, 30*time.Minute).Return(nil)
+
+		_, err := uc.StartProcessInstance(ctx, &StartProcessInstanceRequest{ProcessDefinitionID: "1"})
+
+		require.NoError(t, err, "启动流程实例不应该返回错误")
+		m.instanceRepo.AssertExpectations(t)
+	})
+
+	t.Run("工作流启动失败时回滚流程实例", func(t *testing.T) {
+		uc, m := newProcessInstanceUseCaseWithMocks()
+
+		m.defRepo.On("GetByID", mock.Anything, "1").Return(createTestProcessDefinition(), nil)
+		m.instanceRepo.On("Create", mock.Anything, mock.Anything).Return(createTestProcessInstance(), nil)
+		m.engine.On("StartProcessWorkflow", mock.Anything, mock.Anything).Return("", errors.New("temporal unavailable"))
+		m.variableRepo.On("DeleteByProcessInstanceID", mock.Anything, "42").Return(nil)
+		m.instanceRepo.On("Delete", mock.Anything, "42").Return(nil)
+
+		result, err := uc.StartProcessInstance(systemContext(), &StartProcessInstanceRequest{ProcessDefinitionID: "1"})
+
+		assert.Error(t, err, "工作流启动失败应该返回错误")
+		assert.Nil(t, result, "结果应该为空")
+		assert.Contains(t, err.Error(), "启动工作流失败", "错误信息应该包含失败原因")
+		m.instanceRepo.AssertExpectations(t)
+		m.variableRepo.AssertExpectations(t)
+	})
+
+	t.Run("保存运行ID失败时终止工作流并回滚", func(t *testing.T) {
+		uc, m := newProcessInstanceUseCaseWithMocks()
+
+		m.defRepo.On("GetByID", mock.Anything, "1").Return(createTestProcessDefinition(), nil)
+		m.instanceRepo.On("Create", mock.Anything, mock.Anything).Return(createTestProcessInstance(), nil)
+		m.engine.On("StartProcessWorkflow", mock.Anything, mock.Anything).Return("run-1", nil)
+		m.instanceRepo.On("Update", mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+		m.engine.On("TerminateWorkflow", mock.Anything, "process-instance-42", "run-1", mock.Anything).Return(nil)
+		m.variableRepo.On("DeleteByProcessInstanceID", mock.Anything, "42").Return(nil)
+		m.instanceRepo.On("Delete", mock.Anything, "42").Return(nil)
+
+		_, err := uc.StartProcessInstance(systemContext(), &StartProcessInstanceRequest{ProcessDefinitionID: "1"})
+
+		assert.Error(t, err, "保存运行ID失败应该返回错误")
+		m.engine.AssertExpectations(t)
+		m.instanceRepo.AssertExpectations(t)
+	})
+
+	t.Run("保存流程变量失败时回滚事务且不启动工作流", func(t *testing.T) {
+		uc, m := newProcessInstanceUseCaseWithMocks()
+
+		m.defRepo.On("GetByID", mock.Anything, "1").Return(createTestProcessDefinition(), nil)
+		m.instanceRepo.On("Create", mock.Anything, mock.Anything).Return(createTestProcessInstance(), nil)
+		m.variableRepo.On("Create", mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		result, err := uc.StartProcessInstance(systemContext(), &StartProcessInstanceRequest{
+			ProcessDefinitionID: "1",
+			Variables:           map[string]interface{}{"amount": 100},
+		})
+
+		assert.Error(t, err, "保存流程变量失败应该返回错误")
+		assert.Nil(t, result, "结果应该为空")
+		assert.Contains(t, err.Error(), "保存流程变量失败", "错误信息应该包含失败原因")
+		assert.Equal(t, 1, m.tx.rolledBack, "事务应该回滚")
+		assert.Equal(t, 0, m.tx.committed, "事务不应该提交")
+		m.engine.AssertNotCalled(t, "StartProcessWorkflow", mock.Anything, mock.Anything)
+	})
+}
+
+// TestProcessInstanceUseCase_SuspendActivate 测试挂起与激活流程实例
+func TestProcessInstanceUseCase_SuspendActivate(t *testing.T) {
+	t.Run("挂起时发送暂停信号", func(t *testing.T) {
+		uc, m := newProcessInstanceUseCaseWithMocks()
+		suspended := createTestProcessInstance()
+		suspended.Suspended = true
+
+		m.instanceRepo.On("GetByID", mock.Anything, "42").Return(createTestProcessInstance(), nil)
+		m.instanceRepo.On("Update", mock.Anything, mock.Anything).Return(suspended, nil)
+		m.engine.On("SignalWorkflow", mock.Anything, "process-instance-42", "run-1", temporal.SignalSuspend, nil).Return(nil)
+		m.cache.On("Delete", mock.Anything, "process_instance:42").Return(nil)
+
+		err := uc.SuspendProcessInstance(systemContext(), "42")
+
+		assert.NoError(t, err, "挂起流程实例不应该返回错误")
+		m.engine.AssertExpectations(t)
+	})
+
+	t.Run("信号发送失败时恢复数据库状态", func(t *testing.T) {
+		uc, m := newProcessInstanceUseCaseWithMocks()
+		suspended := createTestProcessInstance()
+		suspended.Suspended = true
+
+		m.instanceRepo.On("GetByID", mock.Anything, "42").Return(createTestProcessInstance(), nil)
+		m.instanceRepo.On("Update", mock.Anything, mock.MatchedBy(func(pi *ent.ProcessInstance) bool {
+			return pi.Suspended
+		})).Return(suspended, nil).Once()
+		m.engine.On("SignalWorkflow", mock.Anything, "process-instance-42", "run-1", temporal.SignalSuspend, nil).
+			Return(errors.New("temporal unavailable"))
+		m.instanceRepo.On("Update", mock.Anything, mock.MatchedBy(func(pi *ent.ProcessInstance) bool {
+			return !pi.Suspended
+		})).Return(createTestProcessInstance(), nil).Once()
+
+		err := uc.SuspendProcessInstance(systemContext(), "42")
+
+		assert.Error(t, err, "信号发送失败应该返回错误")
+		m.instanceRepo.AssertExpectations(t)
+		m.cache.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("激活时发送恢复信号", func(t *testing.T) {
+		uc, m := newProcessInstanceUseCaseWithMocks()
+		suspended := createTestProcessInstance()
+		suspended.Suspended = true
+
+		m.instanceRepo.On("GetByID", mock.Anything, "42").Return(suspended, nil)
+		m.instanceRepo.On("Update", mock.Anything, mock.Anything).Return(createTestProcessInstance(), nil)
+		m.engine.On("SignalWorkflow", mock.Anything, "process-instance-42", "run-1", temporal.SignalResume, nil).Return(nil)
+		m.cache.On("Delete", mock.Anything, "process_instance:42").Return(nil)
+
+		err := uc.ActivateProcessInstance(systemContext(), "42")
+
+		assert.NoError(t, err, "激活流程实例不应该返回错误")
+		m.engine.AssertExpectations(t)
+	})
+}
+
+// TestProcessInstanceUseCase_TerminateProcessInstance 测试终止流程实例
+func TestProcessInstanceUseCase_TerminateProcessInstance(t *testing.T) {
+	t.Run("终止工作流并取消未结束的任务", func(t *testing.T) {
+		uc, m := newProcessInstanceUseCaseWithMocks()
+
+		m.instanceRepo.On("GetByID", mock.Anything, "42").Return(createTestProcessInstance(), nil)
+		m.instanceRepo.On("Update", mock.Anything, mock.MatchedBy(func(pi *ent.ProcessInstance) bool {
+			return pi.EndTime != nil && pi.DeleteReason == "用户取消"
+		})).Return(createTestProcessInstance(), nil)
+		m.engine.On("TerminateWorkflow", mock.Anything, "process-instance-42", "run-1", "用户取消").Return(nil)
+		m.taskRepo.On("CancelByProcessInstance", mock.Anything, int64(42), "用户取消").Return(2, nil)
+		m.cache.On("Delete", mock.Anything, "process_instance:42").Return(nil)
+
+		err := uc.TerminateProcessInstance(systemContext(), "42", "用户取消")
+
+		assert.NoError(t, err, "终止流程实例不应该返回错误")
+		m.engine.AssertExpectations(t)
+		m.taskRepo.AssertExpectations(t)
+	})
+
+	t.Run("终止失败时恢复数据库状态", func(t *testing.T) {
+		uc, m := newProcessInstanceUseCaseWithMocks()
+
+		m.instanceRepo.On("GetByID", mock.Anything, "42").Return(createTestProcessInstance(), nil)
+		m.instanceRepo.On("Update", mock.Anything, mock.MatchedBy(func(pi *ent.ProcessInstance) bool {
+			return pi.EndTime != nil
+		})).Return(createTestProcessInstance(), nil).Once()
+		m.engine.On("TerminateWorkflow", mock.Anything, "process-instance-42", "run-1", "用户取消").
+			Return(errors.New("temporal unavailable"))
+		m.instanceRepo.On("Update", mock.Anything, mock.MatchedBy(func(pi *ent.ProcessInstance) bool {
+			return pi.EndTime == nil && pi.DeleteReason == ""
+		})).Return(createTestProcessInstance(), nil).Once()
+
+		err := uc.TerminateProcessInstance(systemContext(), "42", "用户取消")
+
+		assert.Error(t, err, "终止工作流失败应该返回错误")
+		m.instanceRepo.AssertExpectations(t)
+		m.taskRepo.AssertNotCalled(t, "CancelByProcessInstance", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+// TestProcessInstanceUseCase_SetProcessVariables 测试设置流程变量
+func TestProcessInstanceUseCase_SetProcessVariables(t *testing.T) {
+	alice := &auth.Identity{UserID: "7", Username: "alice", Roles: []string{"employee"}}
+	instance := createTestProcessInstance()
+	instance.StartUserID = "alice"
+
+	t.Run("拥有 update 授权时保存变量", func(t *testing.T) {
+		uc, m := newProcessInstanceUseCaseWithMocks()
+		ctx := auth.NewContext(context.Background(), alice)
+
+		m.instanceRepo.On("GetByID", mock.Anything, "42").Return(instance, nil)
+		m.authzRepo.On("ListByPrincipals", mock.Anything, []string{"7", "alice"}, []string{"employee"}).Return([]*ent.Authorization{
+			{PrincipalType: PrincipalGroup, PrincipalID: "employee", ProcessDefinitionKey: "test-process", Actions: []string{ActionUpdate}},
+		}, nil)
+		m.variableRepo.On("Create", mock.Anything, mock.MatchedBy(func(pv *ent.ProcessVariable) bool {
+			return pv.ProcessInstanceID == 42 && pv.Name == "amount"
+		})).Return(&ent.ProcessVariable{}, nil)
+
+		err := uc.SetProcessVariables(ctx, "42", map[string]interface{}{"amount": int64(500)})
+
+		require.NoError(t, err, "拥有 update 授权时设置变量不应该返回错误")
+		assert.Equal(t, 1, m.tx.committed, "变量应该在事务中保存")
+		m.variableRepo.AssertExpectations(t)
+	})
+
+	t.Run("只能查看流程实例时不能修改变量", func(t *testing.T) {
+		uc, m := newProcessInstanceUseCaseWithMocks()
+		ctx := auth.NewContext(context.Background(), alice)
+
+		m.instanceRepo.On("GetByID", mock.Anything, "42").Return(instance, nil)
+		m.authzRepo.On("ListByPrincipals", mock.Anything, []string{"7", "alice"}, []string{"employee"}).Return([]*ent.Authorization{
+			{PrincipalType: PrincipalGroup, PrincipalID: "employee", ProcessDefinitionKey: "test-process", Actions: []string{ActionRead}},
+		}, nil)
+
+		err := uc.SetProcessVariables(ctx, "42", map[string]interface{}{"amount": int64(500)})
+
+		assert.ErrorIs(t, err, ErrAccessDenied, "发起人和有 read 授权的用户都不能修改流程变量")
+		assert.Equal(t, 0, m.tx.committed, "拒绝访问时不应该开启事务")
+		m.variableRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		m.variableRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+// TestProcessInstanceUseCase_MigrateProcessInstance 测试迁移流程实例到其他版本
+func TestProcessInstanceUseCase_MigrateProcessInstance(t *testing.T) {
+	alice := &auth.Identity{UserID: "7", Username: "alice", Roles: []string{"employee"}}
+	instance := createTestProcessInstance()
+	instance.StartUserID = "alice"
+	target := createTestProcessDefinition()
+	target.ID = 2
+	target.Version = 2
+	target.Resource = `{"id":"test-process","name":"测试流程","elements":[` +
+		`{"id":"start","type":"startEvent","next":["approve"]},` +
+		`{"id":"approve","type":"userTask","next":["end"]},` +
+		`{"id":"end","type":"endEvent"}]}`
+
+	t.Run("拥有 migrate 授权时迁移实例和任务并通知工作流", func(t *testing.T) {
+		uc, m := newProcessInstanceUseCaseWithMocks()
+		ctx := auth.NewContext(context.Background(), alice)
+
+		m.instanceRepo.On("GetByID", mock.Anything, "42").Return(instance, nil)
+		m.authzRepo.On("ListByPrincipals", mock.Anything, []string{"7", "alice"}, []string{"employee"}).Return([]*ent.Authorization{
+			{PrincipalType: PrincipalGroup, PrincipalID: "employee", ProcessDefinitionKey: "test-process", Actions: []string{ActionMigrate}},
+		}, nil)
+		m.defRepo.On("GetByID", mock.Anything, "2").Return(target, nil)
+		m.taskRepo.On("MigrateByProcessInstance", mock.Anything, int64(42), int64(2)).Return([]*ent.TaskInstance{
+			{ID: 5, TaskDefinitionKey: "approve"},
+		}, nil)
+		m.instanceRepo.On("Migrate", mock.Anything, "42", target).Return(nil)
+		m.engine.On("SignalWorkflow", mock.Anything, "process-instance-42", "run-1", temporal.SignalMigrate,
+			temporal.MigrateSignal{ProcessDefinitionID: 2}).Return(nil)
+		m.cache.On("Delete", mock.Anything, "process_instance:42").Return(nil)
+
+		err := uc.MigrateProcessInstance(ctx, "42", &MigrateProcessInstanceRequest{ProcessDefinitionID: "2"})
+
+		require.NoError(t, err, "拥有 migrate 授权时迁移不应该返回错误")
+		assert.Equal(t, 1, m.tx.committed, "迁移应该在事务中完成")
+		m.instanceRepo.AssertExpectations(t)
+		m.taskRepo.AssertExpectations(t)
+		m.engine.AssertExpectations(t)
+	})
+
+	t.Run("只能查看流程实例时不能迁移", func(t *testing.T) {
+		uc, m := newProcessInstanceUseCaseWithMocks()
+		ctx := auth.NewContext(context.Background(), alice)
+
+		m.instanceRepo.On("GetByID", mock.Anything, "42").Return(instance, nil)
+		m.authzRepo.On("ListByPrincipals", mock.Anything, []string{"7", "alice"}, []string{"employee"}).Return([]*ent.Authorization{
+			{PrincipalType: PrincipalGroup, PrincipalID: "employee", ProcessDefinitionKey: "test-process", Actions: []string{ActionRead, ActionUpdate}},
+		}, nil)
+
+		err := uc.MigrateProcessInstance(ctx, "42", &MigrateProcessInstanceRequest{ProcessDefinitionID: "2"})
+
+		assert.ErrorIs(t, err, ErrAccessDenied, "没有 migrate 授权时应该拒绝迁移")
+		assert.Equal(t, 0, m.tx.committed, "拒绝访问时不应该开启事务")
+		m.instanceRepo.AssertNotCalled(t, "Migrate", mock.Anything, mock.Anything, mock.Anything)
+		m.engine.AssertNotCalled(t, "SignalWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("目标版本缺少任务所在节点时回滚", func(t *testing.T) {
+		uc, m := newProcessInstanceUseCaseWithMocks()
+		ctx := systemContext()
+
+		m.instanceRepo.On("GetByID", mock.Anything, "42").Return(instance, nil)
+		m.defRepo.On("GetByID", mock.Anything, "2").Return(target, nil)
+		m.taskRepo.On("MigrateByProcessInstance", mock.Anything, int64(42), int64(2)).Return([]*ent.TaskInstance{
+			{ID: 5, TaskDefinitionKey: "review"},
+		}, nil)
+
+		err := uc.MigrateProcessInstance(ctx, "42", &MigrateProcessInstanceRequest{ProcessDefinitionID: "2"})
+
+		assert.ErrorIs(t, err, ErrInvalidMigration, "目标版本缺少节点时应该拒绝迁移")
+		assert.Equal(t, 1, m.tx.rolledBack, "迁移失败时应该回滚事务")
+		m.instanceRepo.AssertNotCalled(t, "Migrate", mock.Anything, mock.Anything, mock.Anything)
+		m.engine.AssertNotCalled(t, "SignalWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("不能迁移到其他流程", func(t *testing.T) {
+		uc, m := newProcessInstanceUseCaseWithMocks()
+		ctx := systemContext()
+		other := createTestProcessDefinition()
+		other.ID = 3
+		other.Key = "other-process"
+
+		m.instanceRepo.On("GetByID", mock.Anything, "42").Return(instance, nil)
+		m.defRepo.On("GetByID", mock.Anything, "3").Return(other, nil)
+
+		err := uc.MigrateProcessInstance(ctx, "42", &MigrateProcessInstanceRequest{ProcessDefinitionID: "3"})
+
+		assert.ErrorIs(t, err, ErrInvalidMigration, "只能迁移到同一流程的其他版本")
+		assert.Equal(t, 0, m.tx.committed, "校验失败时不应该开启事务")
+	})
+}
@@ -4,6 +4,7 @@ package biz
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/workflow-engine/workflow-engine/internal/data/ent"
@@ -31,30 +32,38 @@ type PaginationResult struct {
 
 // ProcessDefinitionFilter 流程定义过滤条件
 type ProcessDefinitionFilter struct {
-	Name        string     `json:"name,omitempty"`         // 按名称过滤
-	Category    string     `json:"category,omitempty"`     // 按分类过滤
-	Version     int        `json:"version,omitempty"`      // 按版本过滤
-	Status      string     `json:"status,omitempty"`       // 按状态过滤
-	CreatedFrom *time.Time `json:"created_from,omitempty"` // 创建时间起始
-	CreatedTo   *time.Time `json:"created_to,omitempty"`   // 创建时间结束
+	Name        string        `json:"name,omitempty"`         // 按名称过滤
+	Category    string        `json:"category,omitempty"`     // 按分类过滤
+	Version     int           `json:"version,omitempty"`      // 按版本过滤
+	Status      string        `json:"status,omitempty"`       // 按状态过滤
+	CreatedFrom *time.Time    `json:"created_from,omitempty"` // 创建时间起始
+	CreatedTo   *time.Time    `json:"created_to,omitempty"`   // 创建时间结束
+	Access      *AccessFilter `json:"-"`                      // 授权过滤条件，为空表示不限
 }
 
 // ProcessInstanceFilter 流程实例过滤条件
 type ProcessInstanceFilter struct {
-	ProcessDefinitionID string     `json:"process_definition_id,omitempty"` // 按流程定义ID过滤
-	Status              string     `json:"status,omitempty"`                // 按状态过滤
-	CreatedBy           string     `json:"created_by,omitempty"`            // 按创建者过滤
-	StartedFrom         *time.Time `json:"started_from,omitempty"`          // 开始时间起始
-	StartedTo           *time.Time `json:"started_to,omitempty"`            // 开始时间结束
+	ProcessDefinitionID string        `json:"process_definition_id,omitempty"` // 按流程定义ID过滤
+	Status              string        `json:"status,omitempty"`                // 按状态过滤
+	CreatedBy           string        `json:"created_by,omitempty"`            // 按创建者过滤
+	StartedFrom         *time.Time    `json:"started_from,omitempty"`          // 开始时间起始
+	StartedTo           *time.Time    `json:"started_to,omitempty"`            // 开始时间结束
+	Access              *AccessFilter `json:"-"`                               // 授权过滤条件，为空表示不限
 }
 
 // TaskInstanceFilter 任务实例过滤条件
 type TaskInstanceFilter struct {
-	ProcessInstanceID string     `json:"process_instance_id,omitempty"` // 按流程实例ID过滤
-	AssigneeID        string     `json:"assignee_id,omitempty"`         // 按执行人过滤
-	Status            string     `json:"status,omitempty"`              // 按状态过滤
-	CreatedFrom       *time.Time `json:"created_from,omitempty"`        // 创建时间起始
-	CreatedTo         *time.Time `json:"created_to,omitempty"`          // 创建时间结束
+	ProcessInstanceID string        `json:"process_instance_id,omitempty"` // 按流程实例ID过滤
+	AssigneeID        string        `json:"assignee_id,omitempty"`         // 按执行人过滤
+	Status            string        `json:"status,omitempty"`              // 按状态过滤
+	States            []string      `json:"states,omitempty"`              // 按任务状态过滤，满足其一即可
+	CandidateUser     string        `json:"candidate_user,omitempty"`      // 按候选用户过滤
+	CandidateGroups   []string      `json:"candidate_groups,omitempty"`    // 按候选组过滤，与候选用户满足其一即可
+	ParentTaskID      string        `json:"parent_task_id,omitempty"`      // 按父任务过滤，只查询其直接子任务
+	RootOnly          bool          `json:"root_only,omitempty"`           // 只查询顶层任务，未按父任务过滤时生效
+	CreatedFrom       *time.Time    `json:"created_from,omitempty"`        // 创建时间起始
+	CreatedTo         *time.Time    `json:"created_to,omitempty"`          // 创建时间结束
+	Access            *AccessFilter `json:"-"`                             // 授权过滤条件，为空表示不限
 }
 
 // ProcessDefinitionRepo 流程定义仓储接口
@@ -103,6 +112,8 @@ type ProcessInstanceRepo interface {
 	Activate(ctx context.Context, id string) error
 	// 终止流程实例
 	Terminate(ctx context.Context, id string, reason string) error
+	// 将未结束的流程实例迁移到指定的流程定义版本
+	Migrate(ctx context.Context, id string, pd *ent.ProcessDefinition) error
 }
 
 // TaskInstanceRepo 任务实例仓储接口
@@ -111,8 +122,12 @@ type TaskInstanceRepo interface {
 	Create(ctx context.Context, ti *ent.TaskInstance) (*ent.TaskInstance, error)
 	// 根据ID获取任务实例
 	GetByID(ctx context.Context, id string) (*ent.TaskInstance, error)
+	// 按创建顺序查询流程执行在指定节点上的全部任务，包括已结束的任务
+	ListByExecution(ctx context.Context, processInstanceID int64, executionID string, taskDefinitionKey string) ([]*ent.TaskInstance, error)
 	// 更新任务实例
 	Update(ctx context.Context, ti *ent.TaskInstance) (*ent.TaskInstance, error)
+	// 修改未结束任务的基本信息，只写入请求中设置的字段；任务已结束时返回任务状态错误
+	UpdateInfo(ctx context.Context, id string, req *UpdateTaskRequest) (*ent.TaskInstance, error)
 	// 删除任务实例
 	Delete(ctx context.Context, id string) error
 	// 分页查询任务实例
@@ -125,10 +140,52 @@ type TaskInstanceRepo interface {
 	ListByAssignee(ctx context.Context, assigneeID string, opts *QueryOptions) ([]*ent.TaskInstance, *PaginationResult, error)
 	// 认领任务
 	Claim(ctx context.Context, id string, assigneeID string) error
+	// 取消认领，任务退回待认领；只有指定用户认领的任务能被取消认领
+	Unclaim(ctx context.Context, id string, assigneeID string) error
 	// 完成任务
 	Complete(ctx context.Context, id string, variables map[string]interface{}) error
 	// 委派任务
 	Delegate(ctx context.Context, id string, delegateID string) error
+	// 被委派人交还任务，任务回到拥有者手中
+	Resolve(ctx context.Context, id string) error
+	// 转交任务给新的办理人（为空时退回待认领）并追加候选组
+	Reassign(ctx context.Context, id string, assigneeID string, groupIDs []string) error
+	// 提高未结束任务的优先级，返回是否有变更
+	RaisePriority(ctx context.Context, id string, priority int32) (bool, error)
+	// 查询多个父任务下的直接子任务
+	ListSubtasks(ctx context.Context, parentTaskIDs []string) ([]*ent.TaskInstance, error)
+	// 取消未结束的任务并记录取消原因
+	Cancel(ctx context.Context, id string, reason string) error
+	// 取消流程实例下未结束的任务，返回取消的任务数
+	CancelByProcessInstance(ctx context.Context, processInstanceID int64, reason string) (int, error)
+	// 将流程实例下未结束的任务迁移到指定的流程定义版本，返回迁移的任务
+	MigrateByProcessInstance(ctx context.Context, processInstanceID int64, processDefinitionID int64) ([]*ent.TaskInstance, error)
+	// 添加任务候选用户与候选组，已存在的关联不会重复添加
+	AddCandidates(ctx context.Context, taskID int64, userIDs []string, groupIDs []string) error
+	// 添加任务参与者，已存在的关联不会重复添加
+	AddParticipant(ctx context.Context, taskID int64, userID string) error
+	// 查询任务的身份关联
+	ListIdentityLinks(ctx context.Context, taskID int64) ([]*ent.TaskIdentityLink, error)
+}
+
+// TaskCommentRepo 任务评论仓储接口
+type TaskCommentRepo interface {
+	// 创建任务评论
+	Create(ctx context.Context, tc *ent.TaskComment) (*ent.TaskComment, error)
+	// 根据任务ID分页查询评论
+	ListByTaskID(ctx context.Context, taskID int64, opts *QueryOptions) ([]*ent.TaskComment, *PaginationResult, error)
+}
+
+// TaskAttachmentRepo 任务附件仓储接口
+type TaskAttachmentRepo interface {
+	// 创建任务附件，文件内容按配置的存储方式保存，超过大小限制时返回 ErrTaskAttachmentTooLarge
+	Create(ctx context.Context, ta *ent.TaskAttachment, content io.Reader) (*ent.TaskAttachment, error)
+	// 根据ID获取附件元数据，不包含文件内容
+	GetByID(ctx context.Context, id string) (*ent.TaskAttachment, error)
+	// 读取附件的文件内容
+	GetContent(ctx context.Context, ta *ent.TaskAttachment) ([]byte, error)
+	// 根据任务ID分页查询附件元数据
+	ListByTaskID(ctx context.Context, taskID int64, opts *QueryOptions) ([]*ent.TaskAttachment, *PaginationResult, error)
 }
 
 // HistoricProcessInstanceRepo 历史流程实例仓储接口
@@ -169,6 +226,8 @@ type ProcessVariableRepo interface {
 	GetByProcessInstanceIDAndName(ctx context.Context, processInstanceID, name string) (*ent.ProcessVariable, error)
 	// 根据流程实例ID获取所有变量
 	ListByProcessInstanceID(ctx context.Context, processInstanceID string) ([]*ent.ProcessVariable, error)
+	// 根据任务ID获取任务局部变量
+	ListByTaskID(ctx context.Context, taskID int64) ([]*ent.ProcessVariable, error)
 	// 批量设置流程变量
 	SetVariables(ctx context.Context, processInstanceID string, variables map[string]interface{}) error
 	// 删除流程实例的所有变量
@@ -187,12 +246,100 @@ type ProcessEventRepo interface {
 	ListByProcessInstanceID(ctx context.Context, processInstanceID string) ([]*ent.ProcessEvent, error)
 	// 根据事件类型查询事件
 	ListByEventType(ctx context.Context, eventType string, opts *QueryOptions) ([]*ent.ProcessEvent, *PaginationResult, error)
+	// 根据任务ID分页查询事件
+	ListByTaskID(ctx context.Context, taskID int64, opts *QueryOptions) ([]*ent.ProcessEvent, *PaginationResult, error)
 	// 删除流程事件
 	Delete(ctx context.Context, id string) error
 	// 删除流程实例的所有事件
 	DeleteByProcessInstanceID(ctx context.Context, processInstanceID string) error
 }
 
+// UserFilter 用户过滤条件
+type UserFilter struct {
+	Status string `json:"status,omitempty"` // 按账号状态过滤
+	Role   string `json:"role,omitempty"`   // 按角色过滤
+	Group  string `json:"group,omitempty"`  // 按用户组过滤
+}
+
+// UserRepo 用户仓储接口
+type UserRepo interface {
+	// 创建用户
+	Create(ctx context.Context, u *ent.User) (*ent.User, error)
+	// 根据ID获取用户
+	GetByID(ctx context.Context, id int64) (*ent.User, error)
+	// 根据用户名获取用户
+	GetByUsername(ctx context.Context, username string) (*ent.User, error)
+	// 更新用户资料、角色、用户组与状态，不修改密码
+	Update(ctx context.Context, u *ent.User) (*ent.User, error)
+	// 更新密码哈希并记录修改时间
+	UpdatePassword(ctx context.Context, id int64, passwordHash string) error
+	// 按当前哈希参数重新计算的密码哈希，不改变密码修改时间
+	RehashPassword(ctx context.Context, id int64, passwordHash string) error
+	// 记录最后登录时间
+	RecordLogin(ctx context.Context, id int64, at time.Time) error
+	// 分页查询用户，按用户名或邮箱搜索
+	List(ctx context.Context, filter *UserFilter, opts *QueryOptions) ([]*ent.User, *PaginationResult, error)
+}
+
+// RoleRepo 角色仓储接口
+type RoleRepo interface {
+	// 创建角色
+	Create(ctx context.Context, r *ent.Role) (*ent.Role, error)
+	// 根据名称获取角色
+	GetByName(ctx context.Context, name string) (*ent.Role, error)
+	// 更新角色描述与权限
+	Update(ctx context.Context, r *ent.Role) (*ent.Role, error)
+	// 查询全部角色
+	List(ctx context.Context) ([]*ent.Role, error)
+	// 按名称批量查询角色，不存在的名称被忽略
+	ListByNames(ctx context.Context, names []string) ([]*ent.Role, error)
+}
+
+// GroupRepo 用户组仓储接口
+type GroupRepo interface {
+	// 创建用户组
+	Create(ctx context.Context, g *ent.Group) (*ent.Group, error)
+	// 查询全部用户组
+	List(ctx context.Context) ([]*ent.Group, error)
+	// 按名称批量查询用户组，不存在的名称被忽略
+	ListByNames(ctx context.Context, names []string) ([]*ent.Group, error)
+}
+
+// RefreshTokenRepo 刷新令牌仓储接口
+type RefreshTokenRepo interface {
+	// 记录签发的刷新令牌
+	Create(ctx context.Context, rt *ent.RefreshToken) (*ent.RefreshToken, error)
+	// 根据 jti 获取刷新令牌记录
+	GetByTokenID(ctx context.Context, tokenID string) (*ent.RefreshToken, error)
+	// 将未使用且未吊销的刷新令牌标记为已使用，返回是否由本次调用完成标记
+	MarkUsed(ctx context.Context, tokenID string, at time.Time) (bool, error)
+	// 吊销令牌族中的全部刷新令牌，返回访问令牌尚未过期的记录
+	RevokeFamily(ctx context.Context, familyID string, at time.Time) ([]*ent.RefreshToken, error)
+	// 吊销用户的全部刷新令牌，返回访问令牌尚未过期的记录
+	RevokeByUser(ctx context.Context, userID int64, at time.Time) ([]*ent.RefreshToken, error)
+	// 删除刷新令牌在指定时间前已过期的记录
+	DeleteExpired(ctx context.Context, before time.Time) (int, error)
+}
+
+// AuthorizationFilter 授权过滤条件
+type AuthorizationFilter struct {
+	PrincipalType        string `json:"principal_type,omitempty"`         // 按主体类型过滤
+	PrincipalID          string `json:"principal_id,omitempty"`           // 按主体过滤
+	ProcessDefinitionKey string `json:"process_definition_key,omitempty"` // 按流程定义Key过滤
+}
+
+// AuthorizationRepo 资源授权仓储接口
+type AuthorizationRepo interface {
+	// 创建授权
+	Create(ctx context.Context, a *ent.Authorization) (*ent.Authorization, error)
+	// 删除授权
+	Delete(ctx context.Context, id int64) error
+	// 按过滤条件查询授权
+	List(ctx context.Context, filter *AuthorizationFilter) ([]*ent.Authorization, error)
+	// 查询授予给任一用户标识或任一用户组的授权
+	ListByPrincipals(ctx context.Context, users []string, groups []string) ([]*ent.Authorization, error)
+}
+
 // CacheRepo 缓存仓储接口
 type CacheRepo interface {
 	// 设置缓存
@@ -0,0 +1,285 @@
+// Package biz 提供业务逻辑层功能
+// 包含批量认领、取消认领、转交、修改优先级与到期时间以及完成任务的业务逻辑
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// maxBulkTasks 单次批量操作最多处理的任务数
+	maxBulkTasks = 1000
+	// bulkTaskBatchSize 批量操作每个事务处理的任务数
+	bulkTaskBatchSize = 50
+)
+
+// BulkTasks 批量操作任务
+// 任务按 bulkTaskBatchSize 分批处理，每批在一个事务中进行；每个任务单独检查状态与权限，
+// 任务业务错误只记为该任务失败，其他错误使本批事务回滚、本批任务全部记为失败，不影响其他批次。
+// 完成任务需要通知工作流，通知无法随事务回滚，因此每个任务在各自的事务中完成
+func (uc *TaskInstanceUseCase) BulkTasks(ctx context.Context, req *BulkTaskRequest) (*BulkTaskResponse, error) {
+	uc.logger.Info("批量操作任务", zap.String("action", req.Action))
+
+	switch req.Action {
+	case BulkTaskClaim, BulkTaskUnclaim, BulkTaskReassign, BulkTaskSetPriority, BulkTaskSetDueDate, BulkTaskComplete:
+	default:
+		return nil, fmt.Errorf("不支持的批量操作: %s", req.Action)
+	}
+
+	// 为他人认领或转交给他人时整个请求需要 task:assign 权限，逐个任务检查前先行拒绝
+	if (req.Action == BulkTaskClaim || req.Action == BulkTaskReassign) && req.AssigneeID != "" {
+		if err := checkAssignFor(ctx, req.AssigneeID); err != nil {
+			return nil, err
+		}
+	}
+
+	ids, err := uc.bulkTaskIDs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	currentUserID := uc.getCurrentUserID(ctx)
+	resp := &BulkTaskResponse{
+		Action: req.Action,
+		Total:  len(ids),
+		Items:  make([]*BulkTaskItemResult, 0, len(ids)),
+	}
+	for start := 0; start < len(ids); start += bulkTaskBatchSize {
+		batch := ids[start:min(start+bulkTaskBatchSize, len(ids))]
+		if req.Action == BulkTaskComplete {
+			resp.Items = append(resp.Items, uc.bulkComplete(ctx, batch, req)...)
+		} else {
+			resp.Items = append(resp.Items, uc.bulkBatch(ctx, batch, req, currentUserID)...)
+		}
+	}
+
+	for _, item := range resp.Items {
+		if item.Success {
+			resp.Succeeded++
+		} else {
+			resp.Failed++
+		}
+	}
+
+	uc.logger.Info("批量操作任务完成",
+		zap.String("action", req.Action),
+		zap.Int("total", resp.Total),
+		zap.Int("succeeded", resp.Succeeded),
+		zap.Int("failed", resp.Failed))
+	return resp, nil
+}
+
+// bulkTaskIDs 返回批量操作的任务ID，去除重复的ID
+// 未指定任务ID时按过滤条件查询未结束的任务，超过 maxBulkTasks 时返回 ErrBulkTaskLimitExceeded
+func (uc *TaskInstanceUseCase) bulkTaskIDs(ctx context.Context, req *BulkTaskRequest) ([]string, error) {
+	if len(req.TaskIDs) > 0 {
+		seen := make(map[string]bool, len(req.TaskIDs))
+		ids := make([]string, 0, len(req.TaskIDs))
+		for _, id := range req.TaskIDs {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) > maxBulkTasks {
+			return nil, fmt.Errorf("%w: 最多 %d 个任务", ErrBulkTaskLimitExceeded, maxBulkTasks)
+		}
+		return ids, nil
+	}
+	if req.Filter == nil {
+		return []string{}, nil
+	}
+
+	access, err := uc.authz.AccessFilter(ctx, ActionRead)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &TaskInstanceFilter{
+		ProcessInstanceID: req.Filter.ProcessInstanceID,
+		AssigneeID:        req.Filter.AssigneeID,
+		States:            OpenTaskStatuses,
+		CandidateUser:     req.Filter.CandidateUser,
+		CandidateGroups:   req.Filter.CandidateGroups,
+		CreatedFrom:       req.Filter.CreatedFrom,
+		CreatedTo:         req.Filter.CreatedTo,
+		Access:            access,
+	}
+	if req.Filter.Status != "" {
+		filter.States = []string{req.Filter.Status}
+	}
+
+	tasks, pagination, err := uc.taskInstanceRepo.List(ctx, filter, &QueryOptions{Page: 1, PageSize: maxBulkTasks})
+	if err != nil {
+		uc.logger.Error("查询批量操作的任务失败", zap.Error(err))
+		return nil, fmt.Errorf("查询批量操作的任务失败: %w", err)
+	}
+	if pagination != nil && pagination.Total > maxBulkTasks {
+		return nil, fmt.Errorf("%w: 过滤条件匹配 %d 个任务，最多 %d 个", ErrBulkTaskLimitExceeded, pagination.Total, maxBulkTasks)
+	}
+
+	ids := make([]string, len(tasks))
+	for i, task := range tasks {
+		ids[i] = strconv.FormatInt(task.ID, 10)
+	}
+	return ids, nil
+}
+
+// bulkBatch 在一个事务中处理一批任务
+func (uc *TaskInstanceUseCase) bulkBatch(ctx context.Context, ids []string, req *BulkTaskRequest, userID string) []*BulkTaskItemResult {
+	items := make([]*BulkTaskItemResult, len(ids))
+	err := uc.txRepo.Transaction(ctx, func(ctx context.Context) error {
+		for i, id := range ids {
+			err := uc.bulkApply(ctx, id, req, userID)
+			if err != nil && !isTaskError(err) {
+				return fmt.Errorf("处理任务 %s 失败: %w", id, err)
+			}
+			items[i] = bulkTaskItem(id, err)
+		}
+		return nil
+	})
+	if err != nil {
+		uc.logger.Error("批量操作任务失败，本批事务已回滚",
+			zap.String("action", req.Action),
+			zap.Strings("ids", ids),
+			zap.Error(err))
+		for i, id := range ids {
+			items[i] = bulkTaskItem(id, fmt.Errorf("同批次的任务处理失败，本批操作已回滚: %w", err))
+		}
+		return items
+	}
+
+	// 清除缓存
+	for _, item := range items {
+		if !item.Success {
+			continue
+		}
+		cacheKey := fmt.Sprintf("task_instance:%s", item.TaskID)
+		if err := uc.cache.Delete(ctx, cacheKey); err != nil {
+			uc.logger.Warn("清除任务实例缓存失败", zap.Error(err))
+		}
+	}
+	return items
+}
+
+// bulkApply 检查并对单个任务执行批量操作，需要在事务中调用
+// 调用方需要能查看任务；任务不满足操作条件时返回任务业务错误，不写入任何数据
+func (uc *TaskInstanceUseCase) bulkApply(ctx context.Context, id string, req *BulkTaskRequest, userID string) error {
+	if _, err := strconv.ParseInt(id, 10, 64); err != nil {
+		return fmt.Errorf("%w: 无效的任务实例ID %s", ErrTaskNotFound, id)
+	}
+	task, err := uc.taskInstanceRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := uc.authorizeTask(ctx, task); err != nil {
+		return err
+	}
+
+	switch req.Action {
+	case BulkTaskClaim:
+		claimReq := &ClaimTaskRequest{AssigneeID: req.AssigneeID, CandidateGroups: req.CandidateGroups}
+		if claimReq.AssigneeID == "" {
+			claimReq.AssigneeID = userID
+		}
+		if err := uc.checkClaim(ctx, task, claimReq); err != nil {
+			return err
+		}
+		return uc.claim(ctx, task, claimReq.AssigneeID)
+	case BulkTaskUnclaim:
+		return uc.unclaim(ctx, task, userID)
+	case BulkTaskReassign:
+		return uc.reassign(ctx, task, req.AssigneeID, userID)
+	case BulkTaskSetPriority, BulkTaskSetDueDate:
+		if err := checkTaskUpdatable(task, userID); err != nil {
+			return err
+		}
+		update := &UpdateTaskRequest{}
+		if req.Action == BulkTaskSetPriority {
+			update.Priority = req.Priority
+		} else {
+			update.DueDate = req.DueDate
+		}
+		changes := applyTaskUpdate(task, update)
+		if len(changes) == 0 {
+			return nil
+		}
+		_, err := uc.updateTask(ctx, task, update, changes, userID)
+		return err
+	}
+	return fmt.Errorf("不支持的批量操作: %s", req.Action)
+}
+
+// unclaim 取消认领任务，任务退回待认领；只有任务的认领人或拥有者才能取消认领
+func (uc *TaskInstanceUseCase) unclaim(ctx context.Context, task *ent.TaskInstance, userID string) error {
+	if err := CheckTaskTransition(task.Status, TaskStatusCreated); err != nil {
+		return err
+	}
+	if task.Assignee != userID && task.Owner != userID {
+		return fmt.Errorf("%w: 只有任务的认领人或拥有者才能取消认领", ErrTaskNotAssignee)
+	}
+
+	if err := uc.taskInstanceRepo.Unclaim(ctx, strconv.FormatInt(task.ID, 10), task.Assignee); err != nil {
+		return err
+	}
+	return uc.recordTaskEvent(ctx, task, TaskEventUnclaimed, userID, map[string]interface{}{
+		"from_status": taskStatus(task),
+		"to_status":   TaskStatusCreated,
+		"assignee":    task.Assignee,
+	})
+}
+
+// reassign 将任务转交给新的办理人，任务的拥有者与委派状态随之清除
+// 能分派任务的调用方可以转交任何未结束的任务，例如在人员离职时转交其办理的任务；
+// 其他调用方只能转交给自己，且已有办理人的任务只能由办理人或拥有者转交
+func (uc *TaskInstanceUseCase) reassign(ctx context.Context, task *ent.TaskInstance, assigneeID string, userID string) error {
+	var err error
+	if canAssignTasks(ctx) {
+		err = checkTaskOpen(task)
+	} else {
+		err = checkTaskUpdatable(task, userID)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := uc.taskInstanceRepo.Reassign(ctx, strconv.FormatInt(task.ID, 10), assigneeID, nil); err != nil {
+		return err
+	}
+	return uc.recordTaskEvent(ctx, task, TaskEventReassigned, userID, map[string]interface{}{
+		"from_status":   taskStatus(task),
+		"to_status":     TaskStatusClaimed,
+		"from_assignee": task.Assignee,
+		"assignee":      assigneeID,
+	})
+}
+
+// bulkComplete 逐个完成一批任务，每个任务在各自的事务中完成并通知工作流
+func (uc *TaskInstanceUseCase) bulkComplete(ctx context.Context, ids []string, req *BulkTaskRequest) []*BulkTaskItemResult {
+	items := make([]*BulkTaskItemResult, len(ids))
+	for i, id := range ids {
+		if _, err := strconv.ParseInt(id, 10, 64); err != nil {
+			items[i] = bulkTaskItem(id, fmt.Errorf("%w: 无效的任务实例ID %s", ErrTaskNotFound, id))
+			continue
+		}
+		items[i] = bulkTaskItem(id, uc.CompleteTask(ctx, id, &CompleteTaskRequest{
+			Variables: req.Variables,
+			Comment:   req.Comment,
+		}))
+	}
+	return items
+}
+
+// bulkTaskItem 根据单个任务的处理错误生成处理结果
+func bulkTaskItem(id string, err error) *BulkTaskItemResult {
+	if err != nil {
+		return &BulkTaskItemResult{TaskID: id, Message: err.Error(), Err: err}
+	}
+	return &BulkTaskItemResult{TaskID: id, Success: true}
+}
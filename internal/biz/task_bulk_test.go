@@ -0,0 +1,198 @@
+// Package biz 提供业务逻辑层功能的测试
+// 包含批量任务操作的单元测试
+package biz
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/workflow-engine/workflow-engine/internal/auth"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+)
+
+// TestTaskInstanceUseCase_BulkTasks 测试批量任务操作
+func TestTaskInstanceUseCase_BulkTasks(t *testing.T) {
+	t.Run("管理员转交他人办理的任务并分别返回结果", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+		ctx := auth.NewContext(context.Background(), &auth.Identity{UserID: "1", Username: "ops", Roles: []string{AdminRole}})
+		completed := createTestTaskInstance(TaskStatusCompleted, "leaver")
+		completed.ID = 9
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusClaimed, "leaver"), nil)
+		m.taskRepo.On("GetByID", mock.Anything, "8").Return(createTestSubtask(8, "", "leaver"), nil)
+		m.taskRepo.On("GetByID", mock.Anything, "9").Return(completed, nil)
+		m.taskRepo.On("Reassign", mock.Anything, mock.Anything, "alice", []string(nil)).Return(nil)
+		m.eventRepo.On("Create", mock.Anything, mock.MatchedBy(func(pe *ent.ProcessEvent) bool {
+			return pe.EventType == TaskEventReassigned && pe.UserID == "ops" && pe.EventData["assignee"] == "alice"
+		})).Return(&ent.ProcessEvent{}, nil)
+		m.cache.On("Delete", mock.Anything, mock.Anything).Return(nil)
+
+		result, err := uc.BulkTasks(ctx, &BulkTaskRequest{
+			Action:     BulkTaskReassign,
+			TaskIDs:    []string{"7", "8", "7", "9", "abc"},
+			AssigneeID: "alice",
+		})
+
+		require.NoError(t, err, "批量转交不应该返回错误")
+		assert.Equal(t, 4, result.Total, "重复的任务ID应该只处理一次")
+		assert.Equal(t, 2, result.Succeeded, "离职人员办理的任务应该转交成功")
+		assert.Equal(t, 2, result.Failed, "已完成与不存在的任务应该失败")
+		assert.True(t, result.Items[0].Success, "任务7应该转交成功")
+		assert.True(t, result.Items[1].Success, "任务8应该转交成功")
+		assert.ErrorIs(t, result.Items[2].Err, ErrTaskCompleted, "已完成的任务不能转交")
+		assert.ErrorIs(t, result.Items[3].Err, ErrTaskNotFound, "无效的任务ID应该视为任务不存在")
+		assert.Equal(t, 1, m.tx.committed, "同一批任务应该在一个事务中提交")
+		m.taskRepo.AssertNotCalled(t, "Reassign", mock.Anything, "9", mock.Anything, mock.Anything)
+	})
+
+	t.Run("普通用户不能把任务转交给他人", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+		ctx := auth.NewContext(context.Background(), &auth.Identity{UserID: "5", Username: "bob"})
+
+		_, err := uc.BulkTasks(ctx, &BulkTaskRequest{
+			Action:     BulkTaskReassign,
+			TaskIDs:    []string{"7"},
+			AssigneeID: "alice",
+		})
+
+		assert.ErrorIs(t, err, ErrAccessDenied, "没有 task:assign 权限时应该拒绝整个请求")
+		m.taskRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("普通用户不能接手他人办理的任务", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+		ctx := auth.NewContext(context.Background(), &auth.Identity{UserID: "5", Username: "bob"})
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusClaimed, "leaver"), nil)
+		m.authzRepo.On("ListByPrincipals", mock.Anything, mock.Anything, mock.Anything).Return([]*ent.Authorization{
+			{PrincipalType: PrincipalUser, PrincipalID: "bob", Actions: []string{ActionRead}},
+		}, nil)
+
+		result, err := uc.BulkTasks(ctx, &BulkTaskRequest{
+			Action:     BulkTaskReassign,
+			TaskIDs:    []string{"7"},
+			AssigneeID: "bob",
+		})
+
+		require.NoError(t, err, "单个任务失败不应该使整个请求失败")
+		assert.ErrorIs(t, result.Items[0].Err, ErrTaskNotAssignee, "没有 task:assign 权限时只能转交自己办理的任务")
+		m.taskRepo.AssertNotCalled(t, "Reassign", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("普通用户不能为他人批量认领", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+		ctx := auth.NewContext(context.Background(), &auth.Identity{UserID: "5", Username: "bob"})
+
+		_, err := uc.BulkTasks(ctx, &BulkTaskRequest{
+			Action:     BulkTaskClaim,
+			TaskIDs:    []string{"7"},
+			AssigneeID: "alice",
+		})
+
+		assert.ErrorIs(t, err, ErrAccessDenied, "没有 task:assign 权限时应该拒绝整个请求")
+		m.taskRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("无权查看的任务只记为该任务失败", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusCreated, ""), nil)
+		ctx := outsiderContext(m)
+
+		result, err := uc.BulkTasks(ctx, &BulkTaskRequest{
+			Action:     BulkTaskReassign,
+			TaskIDs:    []string{"7"},
+			AssigneeID: "mallory",
+		})
+
+		require.NoError(t, err, "无权访问单个任务不应该使整个请求失败")
+		assert.ErrorIs(t, result.Items[0].Err, ErrAccessDenied, "不能转交无权查看的任务")
+		assert.Equal(t, 1, m.tx.committed, "无权访问不应该回滚本批事务")
+		m.taskRepo.AssertNotCalled(t, "Reassign", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("非业务错误回滚本批事务", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+		second := createTestSubtask(8, "", "system")
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusClaimed, "system"), nil)
+		m.taskRepo.On("GetByID", mock.Anything, "8").Return(second, nil)
+		m.taskRepo.On("Unclaim", mock.Anything, "7", "system").Return(nil)
+		m.taskRepo.On("Unclaim", mock.Anything, "8", "system").Return(errors.New("数据库连接已断开"))
+		m.eventRepo.On("Create", mock.Anything, mock.Anything).Return(&ent.ProcessEvent{}, nil)
+
+		result, err := uc.BulkTasks(systemContext(), &BulkTaskRequest{
+			Action:  BulkTaskUnclaim,
+			TaskIDs: []string{"7", "8"},
+		})
+
+		require.NoError(t, err, "单批失败不应该使整个请求失败")
+		assert.Equal(t, 2, result.Failed, "回滚的批次中所有任务都应该记为失败")
+		assert.Equal(t, 1, m.tx.rolledBack, "本批事务应该回滚")
+		m.cache.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("按批次大小分多个事务处理", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+		ids := make([]string, bulkTaskBatchSize+10)
+		for i := range ids {
+			ids[i] = strconv.Itoa(i + 1)
+		}
+
+		m.taskRepo.On("GetByID", mock.Anything, mock.Anything).Return(createTestTaskInstance(TaskStatusClaimed, "system"), nil)
+		m.taskRepo.On("Unclaim", mock.Anything, mock.Anything, "system").Return(nil)
+		m.eventRepo.On("Create", mock.Anything, mock.Anything).Return(&ent.ProcessEvent{}, nil)
+		m.cache.On("Delete", mock.Anything, mock.Anything).Return(nil)
+
+		result, err := uc.BulkTasks(systemContext(), &BulkTaskRequest{
+			Action:  BulkTaskUnclaim,
+			TaskIDs: ids,
+		})
+
+		require.NoError(t, err, "批量取消认领不应该返回错误")
+		assert.Equal(t, len(ids), result.Succeeded, "所有任务都应该取消认领成功")
+		assert.Equal(t, 2, m.tx.committed, "超过批次大小的任务应该分两个事务提交")
+	})
+
+	t.Run("按过滤条件选择的任务超过上限", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+
+		m.taskRepo.On("List", mock.Anything, mock.MatchedBy(func(filter *TaskInstanceFilter) bool {
+			return filter.AssigneeID == "bob" && len(filter.States) == len(OpenTaskStatuses)
+		}), mock.Anything).Return([]*ent.TaskInstance{}, &PaginationResult{Total: maxBulkTasks + 1}, nil)
+
+		_, err := uc.BulkTasks(systemContext(), &BulkTaskRequest{
+			Action:     BulkTaskReassign,
+			Filter:     &BulkTaskFilter{AssigneeID: "bob"},
+			AssigneeID: "alice",
+		})
+
+		assert.ErrorIs(t, err, ErrBulkTaskLimitExceeded, "超过上限时应该拒绝整个请求")
+		m.taskRepo.AssertNotCalled(t, "Reassign", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("批量完成时每个任务使用各自的事务", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+
+		m.taskRepo.On("GetByID", mock.Anything, "8").Return(createTestSubtask(8, "7", "system"), nil)
+		m.taskRepo.On("GetByID", mock.Anything, "9").Return(createTestSubtask(9, "7", "bob"), nil)
+		m.taskRepo.On("Complete", mock.Anything, "8", map[string]interface{}(nil)).Return(nil)
+		m.eventRepo.On("Create", mock.Anything, mock.Anything).Return(&ent.ProcessEvent{}, nil)
+		m.cache.On("Delete", mock.Anything, "task_instance:8").Return(nil)
+
+		result, err := uc.BulkTasks(systemContext(), &BulkTaskRequest{
+			Action:  BulkTaskComplete,
+			TaskIDs: []string{"8", "9"},
+		})
+
+		require.NoError(t, err, "批量完成不应该返回错误")
+		assert.Equal(t, 1, result.Succeeded, "认领人自己的任务应该完成")
+		assert.ErrorIs(t, result.Items[1].Err, ErrTaskNotAssignee, "不能完成他人认领的任务")
+		assert.Equal(t, 1, m.tx.committed, "只有完成成功的任务提交事务")
+	})
+}
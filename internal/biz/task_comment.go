@@ -0,0 +1,353 @@
+// Package biz 提供业务逻辑层功能
+// 包含任务评论、附件与任务信息修改的业务逻辑
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+
+	"go.uber.org/zap"
+)
+
+// UpdateTask 修改任务的名称、描述、优先级、到期时间、分类以及是否等待子任务
+// 调用方需要能查看任务，未认领的任务因此只能由候选人或已授权的用户修改；
+// 已结束的任务不能修改，已有办理人的任务只能由办理人或拥有者修改。修改前后的值记入任务办理记录
+func (uc *TaskInstanceUseCase) UpdateTask(ctx context.Context, id string, req *UpdateTaskRequest) (*TaskInstanceResponse, error) {
+	uc.logger.Info("修改任务", zap.String("id", id))
+
+	task, err := uc.taskInstanceRepo.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("获取任务实例失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("获取任务实例失败: %w", err)
+	}
+	if err := uc.authorizeTask(ctx, task); err != nil {
+		return nil, err
+	}
+
+	currentUserID := uc.getCurrentUserID(ctx)
+	if err := checkTaskUpdatable(task, currentUserID); err != nil {
+		return nil, err
+	}
+
+	changes := applyTaskUpdate(task, req)
+	if len(changes) == 0 {
+		return uc.withIdentityLinks(ctx, uc.toTaskInstanceResponse(task, nil)), nil
+	}
+
+	var updated *ent.TaskInstance
+	err = uc.txRepo.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		updated, err = uc.updateTask(ctx, task, req, changes, currentUserID)
+		return err
+	})
+	if err != nil {
+		uc.logger.Error("修改任务失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("修改任务失败: %w", err)
+	}
+
+	// 清除缓存
+	cacheKey := fmt.Sprintf("task_instance:%s", id)
+	if err := uc.cache.Delete(ctx, cacheKey); err != nil {
+		uc.logger.Warn("清除任务实例缓存失败", zap.Error(err))
+	}
+
+	uc.logger.Info("任务修改成功", zap.String("id", id))
+	return uc.withIdentityLinks(ctx, uc.toTaskInstanceResponse(updated, nil)), nil
+}
+
+// checkTaskUpdatable 检查用户能否修改任务：已结束的任务不能修改，已有办理人的任务只能由办理人或拥有者修改
+func checkTaskUpdatable(task *ent.TaskInstance, userID string) error {
+	if err := checkTaskOpen(task); err != nil {
+		return err
+	}
+	if task.Assignee != "" && task.Assignee != userID && task.Owner != userID {
+		return fmt.Errorf("%w: 只有任务的办理人或拥有者才能修改任务", ErrTaskNotAssignee)
+	}
+	return nil
+}
+
+// checkTaskOpen 检查任务是否未结束
+func checkTaskOpen(task *ent.TaskInstance) error {
+	switch taskStatus(task) {
+	case TaskStatusCompleted:
+		return ErrTaskCompleted
+	case TaskStatusCancelled:
+		return ErrTaskCancelled
+	}
+	return nil
+}
+
+// updateTask 只保存请求中设置的字段并记录修改前后的值，需要在事务中调用；
+// 任务在读取后被其他请求完成或取消时返回任务状态错误
+func (uc *TaskInstanceUseCase) updateTask(ctx context.Context, task *ent.TaskInstance, req *UpdateTaskRequest, changes map[string]interface{}, userID string) (*ent.TaskInstance, error) {
+	updated, err := uc.taskInstanceRepo.UpdateInfo(ctx, strconv.FormatInt(task.ID, 10), req)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.recordTaskEvent(ctx, task, TaskEventUpdated, userID, map[string]interface{}{
+		"changes": changes,
+	}); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// applyTaskUpdate 将请求中设置的字段写入任务，返回发生变化的字段及其修改前后的值
+func applyTaskUpdate(task *ent.TaskInstance, req *UpdateTaskRequest) map[string]interface{} {
+	changes := make(map[string]interface{})
+	change := func(field string, from, to interface{}) {
+		changes[field] = map[string]interface{}{"from": from, "to": to}
+	}
+
+	if req.Name != nil && *req.Name != task.Name {
+		change("name", task.Name, *req.Name)
+		task.Name = *req.Name
+	}
+	if req.Description != nil && *req.Description != task.Description {
+		change("description", task.Description, *req.Description)
+		task.Description = *req.Description
+	}
+	if req.Priority != nil && *req.Priority != task.Priority {
+		change("priority", task.Priority, *req.Priority)
+		task.Priority = *req.Priority
+	}
+	if req.DueDate != nil && (task.DueDate == nil || !req.DueDate.Equal(*task.DueDate)) {
+		var from interface{}
+		if task.DueDate != nil {
+			from = *task.DueDate
+		}
+		change("due_date", from, *req.DueDate)
+		dueDate := *req.DueDate
+		task.DueDate = &dueDate
+	}
+	if req.Category != nil && *req.Category != task.Category {
+		change("category", task.Category, *req.Category)
+		task.Category = *req.Category
+	}
+	if req.WaitForSubtasks != nil && *req.WaitForSubtasks != task.WaitForSubtasks {
+		change("wait_for_subtasks", task.WaitForSubtasks, *req.WaitForSubtasks)
+		task.WaitForSubtasks = *req.WaitForSubtasks
+	}
+	return changes
+}
+
+// AddComment 为任务添加评论，调用方需要能查看任务，已结束的任务也可以添加评论
+func (uc *TaskInstanceUseCase) AddComment(ctx context.Context, id string, req *AddTaskCommentRequest) (*TaskCommentResponse, error) {
+	uc.logger.Info("添加任务评论", zap.String("id", id))
+
+	task, err := uc.taskInstanceRepo.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("获取任务实例失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("获取任务实例失败: %w", err)
+	}
+	if err := uc.authorizeTask(ctx, task); err != nil {
+		return nil, err
+	}
+
+	comment, err := uc.commentRepo.Create(ctx, &ent.TaskComment{
+		TaskID:            task.ID,
+		ProcessInstanceID: task.ProcessInstanceID,
+		UserID:            uc.getCurrentUserID(ctx),
+		Type:              TaskCommentTypeComment,
+		Message:           req.Message,
+	})
+	if err != nil {
+		uc.logger.Error("添加任务评论失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("添加任务评论失败: %w", err)
+	}
+
+	return toTaskCommentResponse(comment), nil
+}
+
+// ListComments 分页查询任务评论，包含办理任务时填写的备注
+func (uc *TaskInstanceUseCase) ListComments(ctx context.Context, id string, req *ListTaskCommentsRequest) (*ListTaskCommentsResponse, error) {
+	uc.logger.Debug("查询任务评论", zap.String("id", id))
+
+	task, err := uc.taskInstanceRepo.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("获取任务实例失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("获取任务实例失败: %w", err)
+	}
+	if err := uc.authorizeTask(ctx, task); err != nil {
+		return nil, err
+	}
+
+	comments, pagination, err := uc.commentRepo.ListByTaskID(ctx, task.ID, &QueryOptions{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Order:    req.Order,
+	})
+	if err != nil {
+		uc.logger.Error("查询任务评论失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("查询任务评论失败: %w", err)
+	}
+
+	items := make([]*TaskCommentResponse, len(comments))
+	for i, comment := range comments {
+		items[i] = toTaskCommentResponse(comment)
+	}
+
+	return &ListTaskCommentsResponse{
+		Items:      items,
+		Pagination: pagination,
+	}, nil
+}
+
+// AddAttachment 为任务上传附件，上传记录写入任务办理记录
+func (uc *TaskInstanceUseCase) AddAttachment(ctx context.Context, id string, req *AddTaskAttachmentRequest) (*TaskAttachmentResponse, error) {
+	uc.logger.Info("上传任务附件", zap.String("id", id), zap.String("name", req.Name))
+
+	task, err := uc.taskInstanceRepo.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("获取任务实例失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("获取任务实例失败: %w", err)
+	}
+	if err := uc.authorizeTask(ctx, task); err != nil {
+		return nil, err
+	}
+
+	currentUserID := uc.getCurrentUserID(ctx)
+	var attachment *ent.TaskAttachment
+	err = uc.txRepo.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		attachment, err = uc.attachmentRepo.Create(ctx, &ent.TaskAttachment{
+			TaskID:            task.ID,
+			ProcessInstanceID: task.ProcessInstanceID,
+			UserID:            currentUserID,
+			Name:              req.Name,
+			Description:       req.Description,
+			ContentType:       req.ContentType,
+		}, req.Content)
+		if err != nil {
+			return err
+		}
+		return uc.recordTaskEvent(ctx, task, TaskEventAttachmentAdded, currentUserID, map[string]interface{}{
+			"attachment_id": strconv.FormatInt(attachment.ID, 10),
+			"name":          attachment.Name,
+			"size":          attachment.Size,
+		})
+	})
+	if err != nil {
+		uc.logger.Error("上传任务附件失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("上传任务附件失败: %w", err)
+	}
+
+	uc.logger.Info("任务附件上传成功", zap.String("id", id), zap.Int64("attachment_id", attachment.ID))
+	return toTaskAttachmentResponse(attachment), nil
+}
+
+// ListAttachments 分页查询任务附件
+func (uc *TaskInstanceUseCase) ListAttachments(ctx context.Context, id string, req *ListTaskAttachmentsRequest) (*ListTaskAttachmentsResponse, error) {
+	uc.logger.Debug("查询任务附件", zap.String("id", id))
+
+	task, err := uc.taskInstanceRepo.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("获取任务实例失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("获取任务实例失败: %w", err)
+	}
+	if err := uc.authorizeTask(ctx, task); err != nil {
+		return nil, err
+	}
+
+	attachments, pagination, err := uc.attachmentRepo.ListByTaskID(ctx, task.ID, &QueryOptions{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		uc.logger.Error("查询任务附件失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("查询任务附件失败: %w", err)
+	}
+
+	items := make([]*TaskAttachmentResponse, len(attachments))
+	for i, attachment := range attachments {
+		items[i] = toTaskAttachmentResponse(attachment)
+	}
+
+	return &ListTaskAttachmentsResponse{
+		Items:      items,
+		Pagination: pagination,
+	}, nil
+}
+
+// GetAttachmentContent 获取任务附件及其文件内容，附件不属于该任务时视为不存在
+func (uc *TaskInstanceUseCase) GetAttachmentContent(ctx context.Context, id string, attachmentID string) (*TaskAttachmentContent, error) {
+	uc.logger.Debug("下载任务附件", zap.String("id", id), zap.String("attachment_id", attachmentID))
+
+	task, err := uc.taskInstanceRepo.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("获取任务实例失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("获取任务实例失败: %w", err)
+	}
+	if err := uc.authorizeTask(ctx, task); err != nil {
+		return nil, err
+	}
+
+	attachment, err := uc.attachmentRepo.GetByID(ctx, attachmentID)
+	if err != nil {
+		return nil, fmt.Errorf("获取任务附件失败: %w", err)
+	}
+	if attachment.TaskID != task.ID {
+		return nil, fmt.Errorf("%w: %s", ErrTaskAttachmentNotFound, attachmentID)
+	}
+
+	content, err := uc.attachmentRepo.GetContent(ctx, attachment)
+	if err != nil {
+		uc.logger.Error("读取任务附件内容失败", zap.String("attachment_id", attachmentID), zap.Error(err))
+		return nil, fmt.Errorf("读取任务附件内容失败: %w", err)
+	}
+
+	return &TaskAttachmentContent{
+		Attachment: toTaskAttachmentResponse(attachment),
+		Content:    content,
+	}, nil
+}
+
+// addTaskComment 将办理任务时填写的备注保存为任务评论，备注为空时不保存
+// 调用方应在变更任务的事务中调用
+func (uc *TaskInstanceUseCase) addTaskComment(ctx context.Context, task *ent.TaskInstance, commentType string, userID string, message string) error {
+	if message == "" {
+		return nil
+	}
+	_, err := uc.commentRepo.Create(ctx, &ent.TaskComment{
+		TaskID:            task.ID,
+		ProcessInstanceID: task.ProcessInstanceID,
+		UserID:            userID,
+		Type:              commentType,
+		Message:           message,
+	})
+	if err != nil {
+		return fmt.Errorf("保存任务备注失败: %w", err)
+	}
+	return nil
+}
+
+// toTaskCommentResponse 转换为任务评论响应
+func toTaskCommentResponse(comment *ent.TaskComment) *TaskCommentResponse {
+	return &TaskCommentResponse{
+		ID:                strconv.FormatInt(comment.ID, 10),
+		TaskID:            strconv.FormatInt(comment.TaskID, 10),
+		ProcessInstanceID: strconv.FormatInt(comment.ProcessInstanceID, 10),
+		UserID:            comment.UserID,
+		Type:              comment.Type,
+		Message:           comment.Message,
+		CreatedAt:         comment.CreatedAt,
+	}
+}
+
+// toTaskAttachmentResponse 转换为任务附件响应
+func toTaskAttachmentResponse(attachment *ent.TaskAttachment) *TaskAttachmentResponse {
+	return &TaskAttachmentResponse{
+		ID:                strconv.FormatInt(attachment.ID, 10),
+		TaskID:            strconv.FormatInt(attachment.TaskID, 10),
+		ProcessInstanceID: strconv.FormatInt(attachment.ProcessInstanceID, 10),
+		UserID:            attachment.UserID,
+		Name:              attachment.Name,
+		Description:       attachment.Description,
+		ContentType:       attachment.ContentType,
+		Size:              attachment.Size,
+		CreatedAt:         attachment.CreatedAt,
+	}
+}
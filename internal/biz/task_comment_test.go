@@ -0,0 +1,207 @@
+// Package biz 提供业务逻辑层功能的测试
+// 包含任务评论、附件与任务信息修改的单元测试
+package biz
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+)
+
+// MockTaskCommentRepo 模拟任务评论仓储
+type MockTaskCommentRepo struct {
+	mock.Mock
+}
+
+func (m *MockTaskCommentRepo) Create(ctx context.Context, tc *ent.TaskComment) (*ent.TaskComment, error) {
+	args := m.Called(ctx, tc)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.TaskComment), args.Error(1)
+}
+
+func (m *MockTaskCommentRepo) ListByTaskID(ctx context.Context, taskID int64, opts *QueryOptions) ([]*ent.TaskComment, *PaginationResult, error) {
+	args := m.Called(ctx, taskID, opts)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).([]*ent.TaskComment), args.Get(1).(*PaginationResult), args.Error(2)
+}
+
+// MockTaskAttachmentRepo 模拟任务附件仓储
+type MockTaskAttachmentRepo struct {
+	mock.Mock
+}
+
+func (m *MockTaskAttachmentRepo) Create(ctx context.Context, ta *ent.TaskAttachment, content io.Reader) (*ent.TaskAttachment, error) {
+	args := m.Called(ctx, ta, content)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.TaskAttachment), args.Error(1)
+}
+
+func (m *MockTaskAttachmentRepo) GetByID(ctx context.Context, id string) (*ent.TaskAttachment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.TaskAttachment), args.Error(1)
+}
+
+func (m *MockTaskAttachmentRepo) GetContent(ctx context.Context, ta *ent.TaskAttachment) ([]byte, error) {
+	args := m.Called(ctx, ta)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *MockTaskAttachmentRepo) ListByTaskID(ctx context.Context, taskID int64, opts *QueryOptions) ([]*ent.TaskAttachment, *PaginationResult, error) {
+	args := m.Called(ctx, taskID, opts)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).([]*ent.TaskAttachment), args.Get(1).(*PaginationResult), args.Error(2)
+}
+
+// TestTaskInstanceUseCase_UpdateTask 测试修改任务信息
+func TestTaskInstanceUseCase_UpdateTask(t *testing.T) {
+	t.Run("办理人修改任务并记录修改前后的值", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+		name := "财务审批"
+		priority := int32(80)
+		dueDate := time.Date(2026, 11, 1, 18, 0, 0, 0, time.UTC)
+
+		updated := createTestTaskInstance(TaskStatusClaimed, "system")
+		updated.Name, updated.Priority, updated.DueDate = name, priority, &dueDate
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusClaimed, "system"), nil)
+		m.taskRepo.On("UpdateInfo", mock.Anything, "7", mock.MatchedBy(func(req *UpdateTaskRequest) bool {
+			return *req.Name == name && *req.Priority == priority && req.DueDate.Equal(dueDate) &&
+				req.Description == nil && req.Category == nil && req.WaitForSubtasks == nil
+		})).Return(updated, nil)
+		m.eventRepo.On("Create", mock.Anything, mock.MatchedBy(func(pe *ent.ProcessEvent) bool {
+			changes, ok := pe.EventData["changes"].(map[string]interface{})
+			return pe.EventType == TaskEventUpdated && ok && len(changes) == 3 &&
+				changes["name"].(map[string]interface{})["from"] == "经理审批"
+		})).Return(&ent.ProcessEvent{}, nil)
+		m.taskRepo.On("ListIdentityLinks", mock.Anything, int64(7)).Return([]*ent.TaskIdentityLink{}, nil)
+		m.cache.On("Delete", mock.Anything, "task_instance:7").Return(nil)
+
+		result, err := uc.UpdateTask(systemContext(), "7", &UpdateTaskRequest{Name: &name, Priority: &priority, DueDate: &dueDate})
+
+		require.NoError(t, err, "修改任务不应该返回错误")
+		assert.Equal(t, name, result.Name, "任务名称应该更新")
+		assert.Equal(t, 1, m.tx.committed, "修改任务与办理记录应该在同一事务中提交")
+		m.eventRepo.AssertExpectations(t)
+	})
+
+	t.Run("没有变化时不写入", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+		name := "经理审批"
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusClaimed, "system"), nil)
+		m.taskRepo.On("ListIdentityLinks", mock.Anything, int64(7)).Return([]*ent.TaskIdentityLink{}, nil)
+
+		_, err := uc.UpdateTask(systemContext(), "7", &UpdateTaskRequest{Name: &name})
+
+		require.NoError(t, err, "没有变化的修改不应该返回错误")
+		m.taskRepo.AssertNotCalled(t, "UpdateInfo", mock.Anything, mock.Anything, mock.Anything)
+		m.eventRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("非办理人不能修改", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+		name := "财务审批"
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusClaimed, "bob"), nil)
+
+		_, err := uc.UpdateTask(systemContext(), "7", &UpdateTaskRequest{Name: &name})
+
+		assert.ErrorIs(t, err, ErrTaskNotAssignee, "非办理人不能修改任务")
+		m.taskRepo.AssertNotCalled(t, "UpdateInfo", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("已完成的任务不能修改", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+		name := "财务审批"
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusCompleted, "system"), nil)
+
+		_, err := uc.UpdateTask(systemContext(), "7", &UpdateTaskRequest{Name: &name})
+
+		assert.ErrorIs(t, err, ErrTaskCompleted, "已完成的任务不能修改")
+	})
+
+	t.Run("读取后被完成的任务不能修改", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+		name := "财务审批"
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusClaimed, "system"), nil)
+		m.taskRepo.On("UpdateInfo", mock.Anything, "7", mock.Anything).Return(nil, fmt.Errorf("%w: 7", ErrTaskCompleted))
+
+		_, err := uc.UpdateTask(systemContext(), "7", &UpdateTaskRequest{Name: &name})
+
+		assert.ErrorIs(t, err, ErrTaskCompleted, "并发完成的任务不能再修改")
+		assert.Equal(t, 1, m.tx.rolledBack, "修改失败时事务应该回滚")
+		m.eventRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+// TestTaskInstanceUseCase_Attachments 测试任务附件
+func TestTaskInstanceUseCase_Attachments(t *testing.T) {
+	t.Run("上传附件并记录办理记录", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+		content := strings.NewReader("invoice")
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusClaimed, "system"), nil)
+		m.attachRepo.On("Create", mock.Anything, mock.MatchedBy(func(ta *ent.TaskAttachment) bool {
+			return ta.TaskID == 7 && ta.ProcessInstanceID == 42 && ta.UserID == "system" && ta.Name == "invoice.txt"
+		}), content).Return(&ent.TaskAttachment{ID: 3, TaskID: 7, ProcessInstanceID: 42, Name: "invoice.txt", Size: 7}, nil)
+		m.eventRepo.On("Create", mock.Anything, mock.MatchedBy(func(pe *ent.ProcessEvent) bool {
+			return pe.EventType == TaskEventAttachmentAdded && pe.EventData["attachment_id"] == "3"
+		})).Return(&ent.ProcessEvent{}, nil)
+
+		result, err := uc.AddAttachment(systemContext(), "7", &AddTaskAttachmentRequest{Name: "invoice.txt", Content: content})
+
+		require.NoError(t, err, "上传附件不应该返回错误")
+		assert.Equal(t, "3", result.ID, "应该返回附件ID")
+		assert.Equal(t, int64(7), result.Size, "应该返回附件大小")
+		assert.Equal(t, 1, m.tx.committed, "附件与办理记录应该在同一事务中提交")
+	})
+
+	t.Run("附件超过大小限制时事务回滚", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusClaimed, "system"), nil)
+		m.attachRepo.On("Create", mock.Anything, mock.Anything, mock.Anything).Return(nil, ErrTaskAttachmentTooLarge)
+
+		_, err := uc.AddAttachment(systemContext(), "7", &AddTaskAttachmentRequest{Name: "big.bin"})
+
+		assert.ErrorIs(t, err, ErrTaskAttachmentTooLarge, "超过大小限制的错误应该透传")
+		assert.Equal(t, 1, m.tx.rolledBack, "事务应该回滚")
+		m.eventRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("下载其他任务的附件视为不存在", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusClaimed, "system"), nil)
+		m.attachRepo.On("GetByID", mock.Anything, "3").Return(&ent.TaskAttachment{ID: 3, TaskID: 8}, nil)
+
+		_, err := uc.GetAttachmentContent(systemContext(), "7", "3")
+
+		assert.ErrorIs(t, err, ErrTaskAttachmentNotFound, "不属于该任务的附件应该视为不存在")
+		m.attachRepo.AssertNotCalled(t, "GetContent", mock.Anything, mock.Anything)
+	})
+}
@@ -5,21 +5,34 @@ package biz
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
 
+	"github.com/workflow-engine/workflow-engine/internal/auth"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/model"
+	"github.com/workflow-engine/workflow-engine/internal/temporal"
 
 	"go.uber.org/zap"
 )
 
+// taskAssignPermission 为其他用户认领或转交任务所需的权限
+const taskAssignPermission = "task:assign"
+
 // TaskInstanceUseCase 任务实例用例，包含任务实例相关的业务逻辑
 type TaskInstanceUseCase struct {
 	taskInstanceRepo    TaskInstanceRepo
 	processInstanceRepo ProcessInstanceRepo
 	variableRepo        ProcessVariableRepo
+	eventRepo           ProcessEventRepo
+	commentRepo         TaskCommentRepo
+	attachmentRepo      TaskAttachmentRepo
+	txRepo              TransactionRepo
 	cache               CacheRepo
+	temporalClient      WorkflowEngine
+	authz               *AuthorizationUseCase
 	logger              *zap.Logger
 }
 
@@ -28,19 +41,32 @@ func NewTaskInstanceUseCase(
 	taskInstanceRepo TaskInstanceRepo,
 	processInstanceRepo ProcessInstanceRepo,
 	variableRepo ProcessVariableRepo,
+	eventRepo ProcessEventRepo,
+	commentRepo TaskCommentRepo,
+	attachmentRepo TaskAttachmentRepo,
+	txRepo TransactionRepo,
 	cache CacheRepo,
+	temporalClient WorkflowEngine,
+	authz *AuthorizationUseCase,
 	logger *zap.Logger,
 ) *TaskInstanceUseCase {
 	return &TaskInstanceUseCase{
 		taskInstanceRepo:    taskInstanceRepo,
 		processInstanceRepo: processInstanceRepo,
 		variableRepo:        variableRepo,
+		eventRepo:           eventRepo,
+		commentRepo:         commentRepo,
+		attachmentRepo:      attachmentRepo,
+		txRepo:              txRepo,
 		cache:               cache,
+		temporalClient:      temporalClient,
+		authz:               authz,
 		logger:              logger,
 	}
 }
 
 // GetTaskInstance 根据ID获取任务实例
+// 调用方需要有查看所属流程的权限，或是任务的办理人、所有人、候选人
 func (uc *TaskInstanceUseCase) GetTaskInstance(ctx context.Context, id string) (*TaskInstanceResponse, error) {
 	uc.logger.Debug("获取任务实例", zap.String("id", id))
 
@@ -50,8 +76,13 @@ func (uc *TaskInstanceUseCase) GetTaskInstance(ctx context.Context, id string) (
 		var task ent.TaskInstance
 		if err := json.Unmarshal([]byte(cached), &task); err == nil {
 			uc.logger.Debug("从缓存获取任务实例成功", zap.String("id", id))
+			if err := uc.authorizeTask(ctx, &task); err != nil {
+				return nil, err
+			}
 			variables, _ := uc.getTaskVariables(ctx, task.ID)
-			return uc.toTaskInstanceResponse(&task, variables), nil
+			resp := uc.withIdentityLinks(ctx, uc.toTaskInstanceResponse(&task, variables))
+			uc.withSubtasks(ctx, []*TaskInstanceResponse{resp})
+			return resp, nil
 		}
 	}
 
@@ -61,6 +92,9 @@ func (uc *TaskInstanceUseCase) GetTaskInstance(ctx context.Context, id string) (
 		uc.logger.Error("获取任务实例失败", zap.String("id", id), zap.Error(err))
 		return nil, fmt.Errorf("获取任务实例失败: %w", err)
 	}
+	if err := uc.authorizeTask(ctx, task); err != nil {
+		return nil, err
+	}
 
 	// 获取任务变量
 	variables, err := uc.getTaskVariables(ctx, task.ID)
@@ -74,21 +108,48 @@ func (uc *TaskInstanceUseCase) GetTaskInstance(ctx context.Context, id string) (
 		uc.logger.Warn("缓存任务实例失败", zap.Error(err))
 	}
 
-	return uc.toTaskInstanceResponse(task, variables), nil
+	resp := uc.withIdentityLinks(ctx, uc.toTaskInstanceResponse(task, variables))
+	uc.withSubtasks(ctx, []*TaskInstanceResponse{resp})
+	return resp, nil
 }
 
 // ListTaskInstances 分页查询任务实例
+// 要求返回子任务树时只分页查询顶层任务（按父任务过滤时为该父任务的直接子任务），再逐层填充子任务
+// 只返回调用方有权查看所属流程、或调用方参与办理的任务
 func (uc *TaskInstanceUseCase) ListTaskInstances(ctx context.Context, req *ListTaskInstancesRequest) (*ListTaskInstancesResponse, error) {
 	uc.logger.Debug("分页查询任务实例", zap.Any("request", req))
 
+	access, err := uc.authz.AccessFilter(ctx, ActionRead)
+	if err != nil {
+		return nil, err
+	}
+
 	// 构建过滤条件
 	filter := &TaskInstanceFilter{
 		ProcessInstanceID: req.ProcessInstanceID,
 		AssigneeID:        req.AssigneeID,
+		ParentTaskID:      req.ParentTaskID,
+		RootOnly:          req.WithSubtasks,
 		CreatedFrom:       req.CreatedFrom,
 		CreatedTo:         req.CreatedTo,
+		Access:            access,
 	}
+	if req.Status != "" {
+		filter.States = []string{req.Status}
+	}
+
+	result, err := uc.listTaskInstances(ctx, filter, req)
+	if err != nil {
+		return nil, err
+	}
+	if req.WithSubtasks {
+		uc.withSubtasks(ctx, result.Items)
+	}
+	return result, nil
+}
 
+// listTaskInstances 按过滤条件与请求中的分页排序参数查询任务实例
+func (uc *TaskInstanceUseCase) listTaskInstances(ctx context.Context, filter *TaskInstanceFilter, req *ListTaskInstancesRequest) (*ListTaskInstancesResponse, error) {
 	// 构建查询选项
 	opts := &QueryOptions{
 		Page:     req.Page,
@@ -110,7 +171,7 @@ func (uc *TaskInstanceUseCase) ListTaskInstances(ctx context.Context, req *ListT
 	for i, task := range tasks {
 		// 获取任务变量
 		variables, _ := uc.getTaskVariables(ctx, task.ID)
-		items[i] = uc.toTaskInstanceResponse(task, variables)
+		items[i] = uc.withIdentityLinks(ctx, uc.toTaskInstanceResponse(task, variables))
 	}
 
 	return &ListTaskInstancesResponse{
@@ -120,6 +181,8 @@ func (uc *TaskInstanceUseCase) ListTaskInstances(ctx context.Context, req *ListT
 }
 
 // ClaimTask 认领任务
+// 调用方需要能查看任务，为他人认领还需要 task:assign 权限；认领人必须是任务的候选用户，或属于任务的候选组；
+// 认领成功后认领人记为任务参与者
 func (uc *TaskInstanceUseCase) ClaimTask(ctx context.Context, id string, req *ClaimTaskRequest) error {
 	uc.logger.Info("认领任务", zap.String("id", id), zap.String("assignee_id", req.AssigneeID))
 
@@ -129,17 +192,19 @@ func (uc *TaskInstanceUseCase) ClaimTask(ctx context.Context, id string, req *Cl
 		uc.logger.Error("获取任务实例失败", zap.String("id", id), zap.Error(err))
 		return fmt.Errorf("获取任务实例失败: %w", err)
 	}
-
-	// 检查任务状态
-	if uc.isTaskCompleted(task) {
-		return fmt.Errorf("任务已完成，无法认领")
+	if err := uc.authorizeTask(ctx, task); err != nil {
+		return err
 	}
-	if task.Assignee != "" && task.Assignee != req.AssigneeID {
-		return fmt.Errorf("任务已被其他用户认领")
+
+	if err := uc.checkClaim(ctx, task, req); err != nil {
+		return err
 	}
 
-	// 认领任务
-	if err := uc.taskInstanceRepo.Claim(ctx, id, req.AssigneeID); err != nil {
+	// 认领任务并记录参与者与办理记录
+	err = uc.txRepo.Transaction(ctx, func(ctx context.Context) error {
+		return uc.claim(ctx, task, req.AssigneeID)
+	})
+	if err != nil {
 		uc.logger.Error("认领任务失败", zap.String("id", id), zap.Error(err))
 		return fmt.Errorf("认领任务失败: %w", err)
 	}
@@ -154,7 +219,56 @@ func (uc *TaskInstanceUseCase) ClaimTask(ctx context.Context, id string, req *Cl
 	return nil
 }
 
+// checkClaim 检查任务能否由认领人认领
+func (uc *TaskInstanceUseCase) checkClaim(ctx context.Context, task *ent.TaskInstance, req *ClaimTaskRequest) error {
+	id := strconv.FormatInt(task.ID, 10)
+
+	if err := checkAssignFor(ctx, req.AssigneeID); err != nil {
+		return err
+	}
+
+	// 检查任务状态
+	if err := CheckTaskTransition(task.Status, TaskStatusClaimed); err != nil {
+		return err
+	}
+	if task.Assignee != "" && task.Assignee != req.AssigneeID {
+		return fmt.Errorf("%w: %s", ErrTaskAlreadyClaimed, id)
+	}
+
+	// 检查认领人是否为任务候选人，已认领任务的认领人可以重复认领
+	if task.Assignee != req.AssigneeID {
+		candidate, err := uc.isCandidate(ctx, task.ID, req.AssigneeID, req.CandidateGroups)
+		if err != nil {
+			uc.logger.Error("查询任务候选人失败", zap.String("id", id), zap.Error(err))
+			return fmt.Errorf("查询任务候选人失败: %w", err)
+		}
+		if !candidate {
+			return fmt.Errorf("%w: %s", ErrTaskNotCandidate, req.AssigneeID)
+		}
+	}
+	return nil
+}
+
+// claim 认领任务并记录参与者与办理记录，需要在事务中调用
+func (uc *TaskInstanceUseCase) claim(ctx context.Context, task *ent.TaskInstance, assigneeID string) error {
+	if err := uc.taskInstanceRepo.Claim(ctx, strconv.FormatInt(task.ID, 10), assigneeID); err != nil {
+		return err
+	}
+	if err := uc.taskInstanceRepo.AddParticipant(ctx, task.ID, assigneeID); err != nil {
+		return err
+	}
+	return uc.recordTaskEvent(ctx, task, TaskEventClaimed, assigneeID, map[string]interface{}{
+		"from_status": taskStatus(task),
+		"to_status":   TaskStatusClaimed,
+		"assignee":    assigneeID,
+	})
+}
+
 // CompleteTask 完成任务
+// 任务从运行时表移除与通知所属工作流在同一事务中进行：通知失败时任务保持未完成，可以重试；
+// 任务只能被移除一次，且工作流只接受当前等待的任务ID，重试的完成请求不会让流程推进两次。
+// 被委派人交还的任务只能由拥有者完成，被委派人填写的变量与拥有者提交的变量合并后输出，同名变量以拥有者提交的为准。
+// 子任务不属于流程模型，完成时不通知工作流，提交的变量只保存为子任务的局部变量
 func (uc *TaskInstanceUseCase) CompleteTask(ctx context.Context, id string, req *CompleteTaskRequest) error {
 	uc.logger.Info("完成任务", zap.String("id", id))
 
@@ -164,35 +278,93 @@ func (uc *TaskInstanceUseCase) CompleteTask(ctx context.Context, id string, req
 		uc.logger.Error("获取任务实例失败", zap.String("id", id), zap.Error(err))
 		return fmt.Errorf("获取任务实例失败: %w", err)
 	}
+	if err := uc.authorizeTask(ctx, task); err != nil {
+		return err
+	}
 
 	// 检查任务状态
-	if uc.isTaskCompleted(task) {
-		return fmt.Errorf("任务已完成")
+	if err := CheckTaskTransition(task.Status, TaskStatusCompleted); err != nil {
+		return err
 	}
 
 	// 检查任务是否已被认领
 	currentUserID := uc.getCurrentUserID(ctx)
+	if task.Delegation == TaskDelegationResolved && task.Owner != currentUserID {
+		return fmt.Errorf("%w: 委派任务交还后只有任务拥有者才能完成任务", ErrTaskNotAssignee)
+	}
 	if task.Assignee == "" {
-		return fmt.Errorf("任务未被认领，请先认领任务")
+		return fmt.Errorf("%w: 请先认领任务", ErrTaskNotAssigned)
 	}
 	if task.Assignee != currentUserID {
-		return fmt.Errorf("只有任务认领人才能完成任务")
+		return fmt.Errorf("%w: 只有任务认领人才能完成任务", ErrTaskNotAssignee)
 	}
 
-	// 保存任务变量
-	if req.Variables != nil && len(req.Variables) > 0 {
-		if err := uc.saveTaskVariables(ctx, task.ID, req.Variables); err != nil {
-			uc.logger.Warn("保存任务变量失败", zap.Error(err))
+	// 等待子任务的任务需要子任务全部结束后才能完成
+	if task.WaitForSubtasks {
+		open, err := uc.taskInstanceRepo.Count(ctx, &TaskInstanceFilter{
+			ParentTaskID: strconv.FormatInt(task.ID, 10),
+			States:       OpenTaskStatuses,
+		})
+		if err != nil {
+			uc.logger.Error("查询未结束的子任务失败", zap.String("id", id), zap.Error(err))
+			return fmt.Errorf("查询未结束的子任务失败: %w", err)
+		}
+		if open > 0 {
+			return fmt.Errorf("%w: 还有 %d 个子任务未结束", ErrTaskHasOpenSubtasks, open)
 		}
 	}
 
-	// 完成任务
-	if err := uc.taskInstanceRepo.Complete(ctx, id, req.Variables); err != nil {
-		uc.logger.Error("完成任务失败", zap.String("id", id), zap.Error(err))
-		return fmt.Errorf("完成任务失败: %w", err)
+	// 合并被委派人交还任务时填写的变量
+	variables := req.Variables
+	if task.Delegation == TaskDelegationResolved {
+		if variables, err = uc.resolvedTaskVariables(ctx, task, req.Variables); err != nil {
+			uc.logger.Error("获取任务变量失败", zap.String("id", id), zap.Error(err))
+			return fmt.Errorf("获取任务变量失败: %w", err)
+		}
 	}
 
-	// TODO: 集成Temporal，推进工作流执行
+	// 获取任务所属的流程实例，用于定位工作流；子任务不通知工作流，也不向流程实例输出变量
+	var instance *ent.ProcessInstance
+	if task.ParentTaskID == "" {
+		instance, err = uc.processInstanceRepo.GetByID(ctx, strconv.FormatInt(task.ProcessInstanceID, 10))
+		if err != nil {
+			uc.logger.Error("获取任务所属流程实例失败", zap.String("id", id), zap.Error(err))
+			return fmt.Errorf("获取任务所属流程实例失败: %w", err)
+		}
+	} else {
+		variables = nil
+	}
+
+	// 在同一事务中保存任务变量、完成任务并通知工作流，任一失败则整体回滚
+	err = uc.txRepo.Transaction(ctx, func(ctx context.Context) error {
+		if err := uc.saveTaskVariables(ctx, task, req.Variables); err != nil {
+			return fmt.Errorf("保存任务变量失败: %w", err)
+		}
+		if err := uc.taskInstanceRepo.Complete(ctx, id, variables); err != nil {
+			return fmt.Errorf("完成任务失败: %w", err)
+		}
+		if err := uc.recordTaskEvent(ctx, task, TaskEventCompleted, currentUserID, map[string]interface{}{
+			"from_status": taskStatus(task),
+			"to_status":   TaskStatusCompleted,
+			"comment":     req.Comment,
+		}); err != nil {
+			return err
+		}
+		if err := uc.addTaskComment(ctx, task, TaskCommentTypeComplete, currentUserID, req.Comment); err != nil {
+			return err
+		}
+		if instance == nil {
+			return nil
+		}
+		if err := uc.signalTaskCompleted(ctx, instance, task, variables, currentUserID); err != nil {
+			return fmt.Errorf("通知流程引擎失败: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		uc.logger.Error("完成任务失败", zap.String("id", id), zap.Error(err))
+		return err
+	}
 
 	// 清除缓存
 	cacheKey := fmt.Sprintf("task_instance:%s", id)
@@ -205,6 +377,7 @@ func (uc *TaskInstanceUseCase) CompleteTask(ctx context.Context, id string, req
 }
 
 // DelegateTask 委派任务
+// 被委派人记为任务参与者；委派期间任务的拥有者不变，被委派人处理后通过 ResolveTask 交还
 func (uc *TaskInstanceUseCase) DelegateTask(ctx context.Context, id string, req *DelegateTaskRequest) error {
 	uc.logger.Info("委派任务", zap.String("id", id), zap.String("delegate_id", req.DelegateID))
 
@@ -214,20 +387,47 @@ func (uc *TaskInstanceUseCase) DelegateTask(ctx context.Context, id string, req
 		uc.logger.Error("获取任务实例失败", zap.String("id", id), zap.Error(err))
 		return fmt.Errorf("获取任务实例失败: %w", err)
 	}
+	if err := uc.authorizeTask(ctx, task); err != nil {
+		return err
+	}
 
 	// 检查任务状态
-	if uc.isTaskCompleted(task) {
-		return fmt.Errorf("任务已完成，无法委派")
+	if err := CheckTaskTransition(task.Status, TaskStatusDelegated); err != nil {
+		return err
 	}
 
 	// 检查委派权限
 	currentUserID := uc.getCurrentUserID(ctx)
 	if task.Assignee != currentUserID && task.Owner != currentUserID {
-		return fmt.Errorf("只有任务的认领人或拥有者才能委派任务")
+		return fmt.Errorf("%w: 只有任务的认领人或拥有者才能委派任务", ErrTaskNotAssignee)
 	}
 
-	// 委派任务
-	if err := uc.taskInstanceRepo.Delegate(ctx, id, req.DelegateID); err != nil {
+	owner := task.Owner
+	if owner == "" {
+		owner = task.Assignee
+	}
+
+	// 委派任务并记录参与者与办理记录
+	err = uc.txRepo.Transaction(ctx, func(ctx context.Context) error {
+		if err := uc.taskInstanceRepo.Delegate(ctx, id, req.DelegateID); err != nil {
+			return err
+		}
+		if err := uc.taskInstanceRepo.AddParticipant(ctx, task.ID, req.DelegateID); err != nil {
+			return err
+		}
+		if err := uc.recordTaskEvent(ctx, task, TaskEventDelegated, currentUserID, map[string]interface{}{
+			"from_status": taskStatus(task),
+			"to_status":   TaskStatusDelegated,
+			"owner":       owner,
+			"from":        task.Assignee,
+			"to":          req.DelegateID,
+			"comment":     req.Comment,
+		}); err != nil {
+			return err
+		}
+		return uc.addTaskComment(ctx, task, TaskCommentTypeDelegate, currentUserID, req.Comment)
+	})
+	if err != nil {
 		uc.logger.Error("委派任务失败", zap.String("id", id), zap.Error(err))
 		return fmt.Errorf("委派任务失败: %w", err)
 	}
@@ -242,36 +442,324 @@ func (uc *TaskInstanceUseCase) DelegateTask(ctx context.Context, id string, req
 	return nil
 }
 
-// GetMyTasks 获取当前用户的任务列表
+// ResolveTask 被委派人交还任务
+// 任务回到拥有者手中，被委派人填写的变量保存为任务局部变量，由拥有者完成任务时一并输出
+func (uc *TaskInstanceUseCase) ResolveTask(ctx context.Context, id string, req *ResolveTaskRequest) error {
+	uc.logger.Info("交还委派任务", zap.String("id", id))
+
+	// 获取任务实例
+	task, err := uc.taskInstanceRepo.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("获取任务实例失败", zap.String("id", id), zap.Error(err))
+		return fmt.Errorf("获取任务实例失败: %w", err)
+	}
+	if err := uc.authorizeTask(ctx, task); err != nil {
+		return err
+	}
+
+	// 检查任务状态
+	if err := CheckTaskTransition(task.Status, TaskStatusResolved); err != nil {
+		return err
+	}
+
+	// 只有被委派人才能交还任务
+	currentUserID := uc.getCurrentUserID(ctx)
+	if task.Assignee != currentUserID {
+		return fmt.Errorf("%w: 只有被委派人才能交还任务", ErrTaskNotAssignee)
+	}
+
+	// 保存被委派人的变量、交还任务并记录办理记录
+	err = uc.txRepo.Transaction(ctx, func(ctx context.Context) error {
+		if err := uc.saveTaskVariables(ctx, task, req.Variables); err != nil {
+			return fmt.Errorf("保存任务变量失败: %w", err)
+		}
+		if err := uc.taskInstanceRepo.Resolve(ctx, id); err != nil {
+			return err
+		}
+		if err := uc.recordTaskEvent(ctx, task, TaskEventResolved, currentUserID, map[string]interface{}{
+			"from_status": taskStatus(task),
+			"to_status":   TaskStatusResolved,
+			"owner":       task.Owner,
+			"from":        task.Assignee,
+			"to":          task.Owner,
+			"comment":     req.Comment,
+		}); err != nil {
+			return err
+		}
+		return uc.addTaskComment(ctx, task, TaskCommentTypeResolve, currentUserID, req.Comment)
+	})
+	if err != nil {
+		uc.logger.Error("交还委派任务失败", zap.String("id", id), zap.Error(err))
+		return fmt.Errorf("交还委派任务失败: %w", err)
+	}
+
+	// 清除缓存
+	cacheKey := fmt.Sprintf("task_instance:%s", id)
+	if err := uc.cache.Delete(ctx, cacheKey); err != nil {
+		uc.logger.Warn("清除任务实例缓存失败", zap.Error(err))
+	}
+
+	uc.logger.Info("委派任务交还成功", zap.String("id", id), zap.String("owner", task.Owner))
+	return nil
+}
+
+// ListTaskEvents 分页查询任务的办理记录
+func (uc *TaskInstanceUseCase) ListTaskEvents(ctx context.Context, id string, req *ListTaskEventsRequest) (*ListTaskEventsResponse, error) {
+	uc.logger.Debug("查询任务办理记录", zap.String("id", id))
+
+	task, err := uc.taskInstanceRepo.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("获取任务实例失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("获取任务实例失败: %w", err)
+	}
+	if err := uc.authorizeTask(ctx, task); err != nil {
+		return nil, err
+	}
+
+	events, pagination, err := uc.eventRepo.ListByTaskID(ctx, task.ID, &QueryOptions{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Order:    req.Order,
+	})
+	if err != nil {
+		uc.logger.Error("查询任务办理记录失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("查询任务办理记录失败: %w", err)
+	}
+
+	items := make([]*TaskEventResponse, len(events))
+	for i, event := range events {
+		items[i] = &TaskEventResponse{
+			ID:        strconv.FormatInt(event.ID, 10),
+			TaskID:    strconv.FormatInt(event.TaskID, 10),
+			EventType: event.EventType,
+			UserID:    event.UserID,
+			Timestamp: event.Timestamp,
+			Data:      event.EventData,
+		}
+	}
+
+	return &ListTaskEventsResponse{
+		Items:      items,
+		Pagination: pagination,
+	}, nil
+}
+
+// recordTaskEvent 记录任务办理事件，调用方应在变更任务的事务中调用
+func (uc *TaskInstanceUseCase) recordTaskEvent(ctx context.Context, task *ent.TaskInstance, eventType string, userID string, data map[string]interface{}) error {
+	_, err := uc.eventRepo.Create(ctx, &ent.ProcessEvent{
+		EventType:            eventType,
+		EventName:            task.Name,
+		ExecutionID:          task.ExecutionID,
+		ProcessInstanceID:    task.ProcessInstanceID,
+		ProcessDefinitionID:  task.ProcessDefinitionID,
+		ProcessDefinitionKey: task.ProcessDefinitionKey,
+		TaskID:               task.ID,
+		ActivityID:           task.TaskDefinitionKey,
+		ActivityName:         task.Name,
+		ActivityType:         string(model.NodeTypeUserTask),
+		UserID:               userID,
+		TenantID:             task.TenantID,
+		EventData:            data,
+	})
+	if err != nil {
+		return fmt.Errorf("记录任务办理记录失败: %w", err)
+	}
+	return nil
+}
+
+// taskStatus 返回任务当前状态，未设置时视为已创建
+func taskStatus(task *ent.TaskInstance) string {
+	if task.Status == "" {
+		return TaskStatusCreated
+	}
+	return task.Status
+}
+
+// resolvedTaskVariables 合并被委派人填写的任务变量与拥有者提交的变量，同名变量以拥有者提交的为准
+func (uc *TaskInstanceUseCase) resolvedTaskVariables(ctx context.Context, task *ent.TaskInstance, variables map[string]interface{}) (map[string]interface{}, error) {
+	merged, err := uc.getTaskVariables(ctx, task.ID)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range variables {
+		merged[name] = value
+	}
+	return merged, nil
+}
+
+// signalTaskCompleted 向任务所属的工作流发送用户任务完成信号，携带任务输出变量
+func (uc *TaskInstanceUseCase) signalTaskCompleted(ctx context.Context, instance *ent.ProcessInstance, task *ent.TaskInstance, variables map[string]interface{}, completedBy string) error {
+	workflowID, runID := workflowRef(instance)
+	err := uc.temporalClient.SignalWorkflow(ctx, workflowID, runID, temporal.SignalUserTaskCompleted, temporal.UserTaskCompletedSignal{
+		NodeID:      task.TaskDefinitionKey,
+		TaskID:      task.ID,
+		Variables:   variables,
+		CompletedBy: completedBy,
+	})
+	if err != nil {
+		uc.logger.Error("发送用户任务完成信号失败",
+			zap.Int64("task_id", task.ID),
+			zap.String("workflow_id", workflowID),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// GetMyTasks 获取当前用户未结束的任务列表
 func (uc *TaskInstanceUseCase) GetMyTasks(ctx context.Context, req *ListTaskInstancesRequest) (*ListTaskInstancesResponse, error) {
 	uc.logger.Debug("获取当前用户的任务列表")
 
 	// 获取当前用户ID
 	currentUserID := uc.getCurrentUserID(ctx)
 
-	// 设置按执行人过滤
-	req.AssigneeID = currentUserID
+	// 按执行人过滤未结束的任务
+	filter := &TaskInstanceFilter{
+		ProcessInstanceID: req.ProcessInstanceID,
+		AssigneeID:        currentUserID,
+		States:            OpenTaskStatuses,
+		CreatedFrom:       req.CreatedFrom,
+		CreatedTo:         req.CreatedTo,
+	}
+	if req.Status != "" {
+		filter.States = []string{req.Status}
+	}
 
-	return uc.ListTaskInstances(ctx, req)
+	return uc.listTaskInstances(ctx, filter, req)
 }
 
 // GetAvailableTasks 获取可认领的任务列表
+// 只返回未分配、且调用方是候选用户或所属用户组是候选组的任务
 func (uc *TaskInstanceUseCase) GetAvailableTasks(ctx context.Context, req *ListTaskInstancesRequest) (*ListTaskInstancesResponse, error) {
-	uc.logger.Debug("获取可认领的任务列表")
+	uc.logger.Debug("获取可认领的任务列表",
+		zap.String("candidate_user", req.CandidateUser),
+		zap.Strings("candidate_groups", req.CandidateGroups))
+
+	// 调用方身份未知时没有可认领的任务
+	if req.CandidateUser == "" && len(req.CandidateGroups) == 0 {
+		return &ListTaskInstancesResponse{
+			Items:      []*TaskInstanceResponse{},
+			Pagination: &PaginationResult{Page: req.Page, PageSize: req.PageSize},
+		}, nil
+	}
 
-	// 清除执行人过滤条件，获取未分配的任务
-	req.AssigneeID = ""
+	filter := &TaskInstanceFilter{
+		ProcessInstanceID: req.ProcessInstanceID,
+		Status:            "unassigned",
+		States:            OpenTaskStatuses,
+		CandidateUser:     req.CandidateUser,
+		CandidateGroups:   req.CandidateGroups,
+		CreatedFrom:       req.CreatedFrom,
+		CreatedTo:         req.CreatedTo,
+	}
+
+	return uc.listTaskInstances(ctx, filter, req)
+}
+
+// isCandidate 检查用户是否为任务的候选用户，或所属用户组之一是任务的候选组
+func (uc *TaskInstanceUseCase) isCandidate(ctx context.Context, taskID int64, userID string, groups []string) (bool, error) {
+	links, err := uc.taskInstanceRepo.ListIdentityLinks(ctx, taskID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, link := range links {
+		if link.Type != IdentityLinkCandidate {
+			continue
+		}
+		if link.UserID != "" && link.UserID == userID {
+			return true, nil
+		}
+		for _, group := range groups {
+			if link.GroupID != "" && link.GroupID == group {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// canAssignTasks 检查调用方能否分派任务：系统身份、管理员角色或拥有 task:assign 权限
+func canAssignTasks(ctx context.Context) bool {
+	identity, ok := auth.FromContext(ctx)
+	return ok && (identity.IsSystem() || auth.MatchPermission(identity.Roles, identity.Permissions, taskAssignPermission))
+}
+
+// checkAssignFor 检查调用方能否将任务交给指定用户办理
+// 交给自己不需要额外权限，交给他人需要能分派任务
+func checkAssignFor(ctx context.Context, assigneeID string) error {
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return fmt.Errorf("%w: 缺少调用方身份", ErrAccessDenied)
+	}
+	if identity.Principal() == assigneeID || canAssignTasks(ctx) {
+		return nil
+	}
+	return fmt.Errorf("%w: 没有将任务交给其他用户办理的权限", ErrAccessDenied)
+}
+
+// authorizeTask 校验调用方能否查看任务
+// 有查看所属流程权限的调用方，以及任务的办理人、所有人、候选人可以查看任务
+func (uc *TaskInstanceUseCase) authorizeTask(ctx context.Context, task *ent.TaskInstance) error {
+	err := uc.authz.Authorize(ctx, ActionRead, &ProcessResource{
+		ProcessDefinitionID:  task.ProcessDefinitionID,
+		ProcessDefinitionKey: task.ProcessDefinitionKey,
+		TenantID:             task.TenantID,
+		Involved:             []string{task.Assignee, task.Owner},
+	})
+	if !errors.Is(err, ErrAccessDenied) {
+		return err
+	}
 
-	return uc.ListTaskInstances(ctx, req)
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return err
+	}
+	candidate, cerr := uc.isCandidate(ctx, task.ID, identity.Principal(), identity.CandidateGroups())
+	if cerr != nil {
+		return cerr
+	}
+	if candidate {
+		return nil
+	}
+	return err
+}
+
+// withIdentityLinks 填充任务响应中的候选用户与候选组
+func (uc *TaskInstanceUseCase) withIdentityLinks(ctx context.Context, resp *TaskInstanceResponse) *TaskInstanceResponse {
+	taskID, err := strconv.ParseInt(resp.ID, 10, 64)
+	if err != nil {
+		return resp
+	}
+	links, err := uc.taskInstanceRepo.ListIdentityLinks(ctx, taskID)
+	if err != nil {
+		uc.logger.Warn("获取任务身份关联失败", zap.String("id", resp.ID), zap.Error(err))
+		return resp
+	}
+
+	for _, link := range links {
+		if link.Type != IdentityLinkCandidate {
+			continue
+		}
+		if link.UserID != "" {
+			resp.CandidateUsers = append(resp.CandidateUsers, link.UserID)
+		}
+		if link.GroupID != "" {
+			resp.CandidateGroups = append(resp.CandidateGroups, link.GroupID)
+		}
+	}
+	return resp
 }
 
 // saveTaskVariables 保存任务变量
-func (uc *TaskInstanceUseCase) saveTaskVariables(ctx context.Context, taskID int64, variables map[string]interface{}) error {
+// 任一变量序列化或写入失败即返回错误，调用方应在事务中调用以保证变量整体写入
+func (uc *TaskInstanceUseCase) saveTaskVariables(ctx context.Context, task *ent.TaskInstance, variables map[string]interface{}) error {
 	for name, value := range variables {
 		variable := &ent.ProcessVariable{
-			TaskID: taskID,
-			Name:   name,
-			Type:   uc.getVariableType(value),
+			TaskID:            task.ID,
+			ProcessInstanceID: task.ProcessInstanceID,
+			Name:              name,
+			Type:              uc.getVariableType(value),
 		}
 
 		// 根据变量类型设置相应的值字段
@@ -296,29 +784,38 @@ func (uc *TaskInstanceUseCase) saveTaskVariables(ctx context.Context, taskID int
 			// 复杂类型序列化为JSON存储在TextValue中
 			valueBytes, err := json.Marshal(value)
 			if err != nil {
-				uc.logger.Warn("序列化任务变量失败",
-					zap.String("name", name),
-					zap.Any("value", value),
-					zap.Error(err))
-				continue
+				return fmt.Errorf("序列化任务变量 %s 失败: %w", name, err)
 			}
 			variable.TextValue = string(valueBytes)
 		}
 
 		if _, err := uc.variableRepo.Create(ctx, variable); err != nil {
-			uc.logger.Warn("保存任务变量失败",
-				zap.String("name", name),
-				zap.Error(err))
+			return fmt.Errorf("保存任务变量 %s 失败: %w", name, err)
 		}
 	}
 	return nil
 }
 
-// getTaskVariables 获取任务变量
+// getTaskVariables 获取任务局部变量，同名变量以最后写入的为准
 func (uc *TaskInstanceUseCase) getTaskVariables(ctx context.Context, taskID int64) (map[string]interface{}, error) {
-	// TODO: 实现获取任务变量的逻辑
-	// 这里需要在ProcessVariableRepo中添加根据任务ID查询变量的方法
-	return make(map[string]interface{}), nil
+	variables, err := uc.variableRepo.ListByTaskID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]interface{}, len(variables))
+	for _, variable := range variables {
+		value, err := variableValue(variable)
+		if err != nil {
+			uc.logger.Warn("反序列化任务变量失败",
+				zap.String("name", variable.Name),
+				zap.String("value", variable.TextValue),
+				zap.Error(err))
+			continue
+		}
+		result[variable.Name] = value
+	}
+	return result, nil
 }
 
 // getVariableType 获取变量类型
@@ -339,18 +836,10 @@ func (uc *TaskInstanceUseCase) getVariableType(value interface{}) string {
 	}
 }
 
-// getCurrentUserID 获取当前用户ID (从上下文中获取)
+// getCurrentUserID 获取当前用户ID
+// 取接口层放入上下文的调用方身份，引擎内部触发、没有调用方身份时为 auth.SystemUserID
 func (uc *TaskInstanceUseCase) getCurrentUserID(ctx context.Context) string {
-	// TODO: 从JWT token或上下文中获取当前用户ID
-	// 这里先返回一个默认值
-	return "system"
-}
-
-// isTaskCompleted 检查任务是否已完成
-func (uc *TaskInstanceUseCase) isTaskCompleted(task *ent.TaskInstance) bool {
-	// TODO: 根据实际的TaskInstance结构检查任务状态
-	// 这里需要根据Ent schema的实际字段来实现
-	return false // 临时实现
+	return auth.CurrentUserID(ctx)
 }
 
 // cacheTaskInstance 缓存任务实例
@@ -367,8 +856,6 @@ func (uc *TaskInstanceUseCase) cacheTaskInstance(ctx context.Context, task *ent.
 
 // toTaskInstanceResponse 转换为响应格式
 func (uc *TaskInstanceUseCase) toTaskInstanceResponse(task *ent.TaskInstance, variables map[string]interface{}) *TaskInstanceResponse {
-	// TODO: 根据实际的TaskInstance结构实现转换
-	// 这里需要根据Ent schema的实际字段来实现
 	return &TaskInstanceResponse{
 		ID:                  strconv.FormatInt(task.ID, 10),
 		ProcessInstanceID:   strconv.FormatInt(task.ProcessInstanceID, 10),
@@ -377,8 +864,12 @@ func (uc *TaskInstanceUseCase) toTaskInstanceResponse(task *ent.TaskInstance, va
 		Description:         task.Description,
 		TaskDefinitionKey:   task.TaskDefinitionKey,
 		Priority:            task.Priority,
+		Status:              task.Status,
 		CreateTime:          task.CreateTime,
-		ClaimTime:           nil, // TODO: 实现认领时间字段
+		ClaimTime:           task.ClaimTime,
+		EndTime:             task.EndTime,
+		Duration:            task.Duration,
+		DeleteReason:        task.DeleteReason,
 		DueDate:             task.DueDate,
 		Category:            task.Category,
 		Owner:               task.Owner,
@@ -388,6 +879,8 @@ func (uc *TaskInstanceUseCase) toTaskInstanceResponse(task *ent.TaskInstance, va
 		IsSuspended:         task.Suspended,
 		TenantID:            task.TenantID,
 		Variables:           variables,
+		ParentTaskID:        task.ParentTaskID,
+		WaitForSubtasks:     task.WaitForSubtasks,
 		CreatedAt:           task.CreatedAt,
 		UpdatedAt:           task.UpdatedAt,
 	}
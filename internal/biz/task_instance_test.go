@@ -0,0 +1,719 @@
+// Package biz 提供业务逻辑层功能的测试
+// 包含任务实例状态流转业务逻辑的单元测试
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/workflow-engine/workflow-engine/internal/auth"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/temporal"
+)
+
+// MockTaskInstanceRepo 模拟任务实例仓储
+type MockTaskInstanceRepo struct {
+	mock.Mock
+}
+
+func (m *MockTaskInstanceRepo) Create(ctx context.Context, ti *ent.TaskInstance) (*ent.TaskInstance, error) {
+	args := m.Called(ctx, ti)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.TaskInstance), args.Error(1)
+}
+
+func (m *MockTaskInstanceRepo) GetByID(ctx context.Context, id string) (*ent.TaskInstance, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.TaskInstance), args.Error(1)
+}
+
+func (m *MockTaskInstanceRepo) ListByExecution(ctx context.Context, processInstanceID int64, executionID string, taskDefinitionKey string) ([]*ent.TaskInstance, error) {
+	args := m.Called(ctx, processInstanceID, executionID, taskDefinitionKey)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.TaskInstance), args.Error(1)
+}
+
+func (m *MockTaskInstanceRepo) Update(ctx context.Context, ti *ent.TaskInstance) (*ent.TaskInstance, error) {
+	args := m.Called(ctx, ti)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.TaskInstance), args.Error(1)
+}
+
+func (m *MockTaskInstanceRepo) UpdateInfo(ctx context.Context, id string, req *UpdateTaskRequest) (*ent.TaskInstance, error) {
+	args := m.Called(ctx, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.TaskInstance), args.Error(1)
+}
+
+func (m *MockTaskInstanceRepo) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockTaskInstanceRepo) List(ctx context.Context, filter *TaskInstanceFilter, opts *QueryOptions) ([]*ent.TaskInstance, *PaginationResult, error) {
+	args := m.Called(ctx, filter, opts)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).([]*ent.TaskInstance), args.Get(1).(*PaginationResult), args.Error(2)
+}
+
+func (m *MockTaskInstanceRepo) Count(ctx context.Context, filter *TaskInstanceFilter) (int, error) {
+	args := m.Called(ctx, filter)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockTaskInstanceRepo) ListByProcessInstanceID(ctx context.Context, processInstanceID string, opts *QueryOptions) ([]*ent.TaskInstance, *PaginationResult, error) {
+	args := m.Called(ctx, processInstanceID, opts)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).([]*ent.TaskInstance), args.Get(1).(*PaginationResult), args.Error(2)
+}
+
+func (m *MockTaskInstanceRepo) ListByAssignee(ctx context.Context, assigneeID string, opts *QueryOptions) ([]*ent.TaskInstance, *PaginationResult, error) {
+	args := m.Called(ctx, assigneeID, opts)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).([]*ent.TaskInstance), args.Get(1).(*PaginationResult), args.Error(2)
+}
+
+func (m *MockTaskInstanceRepo) Claim(ctx context.Context, id string, assigneeID string) error {
+	args := m.Called(ctx, id, assigneeID)
+	return args.Error(0)
+}
+
+func (m *MockTaskInstanceRepo) Unclaim(ctx context.Context, id string, assigneeID string) error {
+	args := m.Called(ctx, id, assigneeID)
+	return args.Error(0)
+}
+
+func (m *MockTaskInstanceRepo) Complete(ctx context.Context, id string, variables map[string]interface{}) error {
+	args := m.Called(ctx, id, variables)
+	return args.Error(0)
+}
+
+func (m *MockTaskInstanceRepo) Delegate(ctx context.Context, id string, delegateID string) error {
+	args := m.Called(ctx, id, delegateID)
+	return args.Error(0)
+}
+
+func (m *MockTaskInstanceRepo) Resolve(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockTaskInstanceRepo) Reassign(ctx context.Context, id string, assigneeID string, groupIDs []string) error {
+	args := m.Called(ctx, id, assigneeID, groupIDs)
+	return args.Error(0)
+}
+
+func (m *MockTaskInstanceRepo) RaisePriority(ctx context.Context, id string, priority int32) (bool, error) {
+	args := m.Called(ctx, id, priority)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockTaskInstanceRepo) ListSubtasks(ctx context.Context, parentTaskIDs []string) ([]*ent.TaskInstance, error) {
+	args := m.Called(ctx, parentTaskIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.TaskInstance), args.Error(1)
+}
+
+func (m *MockTaskInstanceRepo) Cancel(ctx context.Context, id string, reason string) error {
+	args := m.Called(ctx, id, reason)
+	return args.Error(0)
+}
+
+func (m *MockTaskInstanceRepo) CancelByProcessInstance(ctx context.Context, processInstanceID int64, reason string) (int, error) {
+	args := m.Called(ctx, processInstanceID, reason)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockTaskInstanceRepo) MigrateByProcessInstance(ctx context.Context, processInstanceID, processDefinitionID int64) ([]*ent.TaskInstance, error) {
+	args := m.Called(ctx, processInstanceID, processDefinitionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.TaskInstance), args.Error(1)
+}
+
+func (m *MockTaskInstanceRepo) AddCandidates(ctx context.Context, taskID int64, userIDs []string, groupIDs []string) error {
+	args := m.Called(ctx, taskID, userIDs, groupIDs)
+	return args.Error(0)
+}
+
+func (m *MockTaskInstanceRepo) AddParticipant(ctx context.Context, taskID int64, userID string) error {
+	args := m.Called(ctx, taskID, userID)
+	return args.Error(0)
+}
+
+func (m *MockTaskInstanceRepo) ListIdentityLinks(ctx context.Context, taskID int64) ([]*ent.TaskIdentityLink, error) {
+	args := m.Called(ctx, taskID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.TaskIdentityLink), args.Error(1)
+}
+
+// MockProcessEventRepo 模拟流程事件仓储
+type MockProcessEventRepo struct {
+	mock.Mock
+}
+
+func (m *MockProcessEventRepo) Create(ctx context.Context, pe *ent.ProcessEvent) (*ent.ProcessEvent, error) {
+	args := m.Called(ctx, pe)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.ProcessEvent), args.Error(1)
+}
+
+func (m *MockProcessEventRepo) GetByID(ctx context.Context, id string) (*ent.ProcessEvent, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ent.ProcessEvent), args.Error(1)
+}
+
+func (m *MockProcessEventRepo) List(ctx context.Context, processInstanceID string, opts *QueryOptions) ([]*ent.ProcessEvent, *PaginationResult, error) {
+	args := m.Called(ctx, processInstanceID, opts)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).([]*ent.ProcessEvent), args.Get(1).(*PaginationResult), args.Error(2)
+}
+
+func (m *MockProcessEventRepo) ListByProcessInstanceID(ctx context.Context, processInstanceID string) ([]*ent.ProcessEvent, error) {
+	args := m.Called(ctx, processInstanceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ent.ProcessEvent), args.Error(1)
+}
+
+func (m *MockProcessEventRepo) ListByEventType(ctx context.Context, eventType string, opts *QueryOptions) ([]*ent.ProcessEvent, *PaginationResult, error) {
+	args := m.Called(ctx, eventType, opts)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).([]*ent.ProcessEvent), args.Get(1).(*PaginationResult), args.Error(2)
+}
+
+func (m *MockProcessEventRepo) ListByTaskID(ctx context.Context, taskID int64, opts *QueryOptions) ([]*ent.ProcessEvent, *PaginationResult, error) {
+	args := m.Called(ctx, taskID, opts)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).([]*ent.ProcessEvent), args.Get(1).(*PaginationResult), args.Error(2)
+}
+
+func (m *MockProcessEventRepo) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockProcessEventRepo) DeleteByProcessInstanceID(ctx context.Context, processInstanceID string) error {
+	args := m.Called(ctx, processInstanceID)
+	return args.Error(0)
+}
+
+// taskInstanceMocks 任务实例用例测试所需的模拟依赖
+type taskInstanceMocks struct {
+	taskRepo     *MockTaskInstanceRepo
+	instanceRepo *MockProcessInstanceRepo
+	variableRepo *MockProcessVariableRepo
+	eventRepo    *MockProcessEventRepo
+	commentRepo  *MockTaskCommentRepo
+	attachRepo   *MockTaskAttachmentRepo
+	tx           *MockTransactionRepo
+	cache        *MockCacheRepo
+	engine       *MockWorkflowEngine
+	authzRepo    *MockAuthorizationRepo
+}
+
+// newTaskInstanceUseCaseWithMocks 创建带模拟依赖的任务实例用例
+func newTaskInstanceUseCaseWithMocks() (*TaskInstanceUseCase, *taskInstanceMocks) {
+	logger, _ := createTestLogger()
+	m := &taskInstanceMocks{
+		taskRepo:     new(MockTaskInstanceRepo),
+		instanceRepo: new(MockProcessInstanceRepo),
+		variableRepo: new(MockProcessVariableRepo),
+		eventRepo:    new(MockProcessEventRepo),
+		commentRepo:  new(MockTaskCommentRepo),
+		attachRepo:   new(MockTaskAttachmentRepo),
+		tx:           new(MockTransactionRepo),
+		cache:        new(MockCacheRepo),
+		engine:       new(MockWorkflowEngine),
+		authzRepo:    new(MockAuthorizationRepo),
+	}
+	uc := NewTaskInstanceUseCase(m.taskRepo, m.instanceRepo, m.variableRepo, m.eventRepo, m.commentRepo, m.attachRepo, m.tx, m.cache, m.engine, NewAuthorizationUseCase(m.authzRepo, nil, logger), logger)
+	return uc, m
+}
+
+// createTestTaskInstance 创建测试用的任务实例
+func createTestTaskInstance(status, assignee string) *ent.TaskInstance {
+	now := time.Now()
+	return &ent.TaskInstance{
+		ID:                   7,
+		Name:                 "经理审批",
+		TaskDefinitionKey:    "approve",
+		Assignee:             assignee,
+		Status:               status,
+		ProcessInstanceID:    42,
+		ProcessDefinitionKey: "test-process",
+		CreateTime:           now,
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}
+}
+
+// outsiderContext 返回既不参与任务、不是候选人、也没有任何授权的调用方上下文
+func outsiderContext(m *taskInstanceMocks) context.Context {
+	m.authzRepo.On("ListByPrincipals", mock.Anything, mock.Anything, mock.Anything).Return([]*ent.Authorization{}, nil)
+	m.taskRepo.On("ListIdentityLinks", mock.Anything, int64(7)).Return([]*ent.TaskIdentityLink{
+		{TaskID: 7, Type: IdentityLinkCandidate, GroupID: "finance"},
+	}, nil)
+	return auth.NewContext(context.Background(), &auth.Identity{UserID: "9", Username: "mallory"})
+}
+
+// expectTaskEvent 期望记录一条任务办理事件
+func expectTaskEvent(m *taskInstanceMocks, eventType string) {
+	m.eventRepo.On("Create", mock.Anything, mock.MatchedBy(func(pe *ent.ProcessEvent) bool {
+		return pe.EventType == eventType && pe.TaskID == 7 && pe.ProcessInstanceID == 42
+	})).Return(&ent.ProcessEvent{}, nil)
+}
+
+// TestCheckTaskTransition 测试任务状态流转规则
+func TestCheckTaskTransition(t *testing.T) {
+	allowed := []struct{ from, to string }{
+		{"", TaskStatusClaimed},
+		{TaskStatusCreated, TaskStatusCompleted},
+		{TaskStatusClaimed, TaskStatusClaimed},
+		{TaskStatusClaimed, TaskStatusDelegated},
+		{TaskStatusDelegated, TaskStatusResolved},
+		{TaskStatusResolved, TaskStatusCompleted},
+		{TaskStatusDelegated, TaskStatusCancelled},
+	}
+	for _, c := range allowed {
+		assert.NoError(t, CheckTaskTransition(c.from, c.to), "%s -> %s 应该允许", c.from, c.to)
+	}
+
+	assert.ErrorIs(t, CheckTaskTransition(TaskStatusCompleted, TaskStatusClaimed), ErrTaskCompleted, "已完成的任务不能认领")
+	assert.ErrorIs(t, CheckTaskTransition(TaskStatusCancelled, TaskStatusCompleted), ErrTaskCancelled, "已取消的任务不能完成")
+	assert.ErrorIs(t, CheckTaskTransition(TaskStatusDelegated, TaskStatusCompleted), ErrInvalidTaskTransition, "委派中的任务不能直接完成")
+	assert.ErrorIs(t, CheckTaskTransition(TaskStatusClaimed, TaskStatusResolved), ErrInvalidTaskTransition, "未委派的任务不能交还")
+
+	assert.ElementsMatch(t, []string{TaskStatusCreated, TaskStatusClaimed, TaskStatusResolved}, TaskStatusesBefore(TaskStatusCompleted), "可完成的状态应该匹配")
+	assert.True(t, IsTaskStatus(TaskStatusCancelled), "已取消是有效的任务状态")
+	assert.False(t, IsTaskStatus(TaskStatusSuspended), "挂起不是任务状态")
+}
+
+// TestTaskInstanceUseCase_ClaimTask 测试认领任务
+func TestTaskInstanceUseCase_ClaimTask(t *testing.T) {
+	t.Run("候选人认领任务并记为参与者", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusCreated, ""), nil)
+		m.taskRepo.On("ListIdentityLinks", mock.Anything, int64(7)).Return([]*ent.TaskIdentityLink{
+			{TaskID: 7, Type: IdentityLinkCandidate, GroupID: "finance"},
+		}, nil)
+		m.taskRepo.On("Claim", mock.Anything, "7", "alice").Return(nil)
+		m.taskRepo.On("AddParticipant", mock.Anything, int64(7), "alice").Return(nil)
+		expectTaskEvent(m, TaskEventClaimed)
+		m.cache.On("Delete", mock.Anything, "task_instance:7").Return(nil)
+
+		err := uc.ClaimTask(systemContext(), "7", &ClaimTaskRequest{AssigneeID: "alice", CandidateGroups: []string{"finance"}})
+
+		require.NoError(t, err, "候选组成员认领任务不应该返回错误")
+		m.taskRepo.AssertExpectations(t)
+		m.eventRepo.AssertExpectations(t)
+		assert.Equal(t, 1, m.tx.committed, "认领、记录参与者与办理记录应该在同一事务中提交")
+	})
+
+	t.Run("非候选人不能认领", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusCreated, ""), nil)
+		m.taskRepo.On("ListIdentityLinks", mock.Anything, int64(7)).Return([]*ent.TaskIdentityLink{
+			{TaskID: 7, Type: IdentityLinkCandidate, UserID: "bob"},
+			{TaskID: 7, Type: IdentityLinkParticipant, UserID: "alice"},
+		}, nil)
+
+		err := uc.ClaimTask(systemContext(), "7", &ClaimTaskRequest{AssigneeID: "alice"})
+
+		assert.ErrorIs(t, err, ErrTaskNotCandidate, "参与者不是候选人，不能认领")
+		m.taskRepo.AssertNotCalled(t, "Claim", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("为他人认领需要 task:assign 权限", func(t *testing.T) {
+		cases := map[string]struct {
+			permissions []string
+			expect      error
+		}{
+			"普通用户不能为他人认领":               {nil, ErrAccessDenied},
+			"拥有 task:assign 权限时可以为他人认领": {[]string{"task:assign"}, nil},
+		}
+		for name, c := range cases {
+			t.Run(name, func(t *testing.T) {
+				uc, m := newTaskInstanceUseCaseWithMocks()
+				ctx := auth.NewContext(context.Background(), &auth.Identity{UserID: "5", Username: "bob", Permissions: c.permissions})
+
+				m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusCreated, ""), nil)
+				m.authzRepo.On("ListByPrincipals", mock.Anything, mock.Anything, mock.Anything).Return([]*ent.Authorization{}, nil)
+				m.taskRepo.On("ListIdentityLinks", mock.Anything, int64(7)).Return([]*ent.TaskIdentityLink{
+					{TaskID: 7, Type: IdentityLinkCandidate, UserID: "bob"},
+					{TaskID: 7, Type: IdentityLinkCandidate, UserID: "alice"},
+				}, nil)
+				m.taskRepo.On("Claim", mock.Anything, "7", "alice").Return(nil)
+				m.taskRepo.On("AddParticipant", mock.Anything, int64(7), "alice").Return(nil)
+				expectTaskEvent(m, TaskEventClaimed)
+				m.cache.On("Delete", mock.Anything, "task_instance:7").Return(nil)
+
+				err := uc.ClaimTask(ctx, "7", &ClaimTaskRequest{AssigneeID: "alice"})
+
+				if c.expect == nil {
+					require.NoError(t, err, "拥有 task:assign 权限时为他人认领不应该返回错误")
+					m.taskRepo.AssertCalled(t, "Claim", mock.Anything, "7", "alice")
+					return
+				}
+				assert.ErrorIs(t, err, c.expect, "没有 task:assign 权限时不能为他人认领")
+				m.taskRepo.AssertNotCalled(t, "Claim", mock.Anything, mock.Anything, mock.Anything)
+			})
+		}
+	})
+
+	t.Run("已被他人认领", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusClaimed, "bob"), nil)
+
+		err := uc.ClaimTask(systemContext(), "7", &ClaimTaskRequest{AssigneeID: "alice"})
+
+		assert.ErrorIs(t, err, ErrTaskAlreadyClaimed, "已被他人认领的任务不能再认领")
+	})
+
+	t.Run("已完成的任务不能认领", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusCompleted, "alice"), nil)
+
+		err := uc.ClaimTask(systemContext(), "7", &ClaimTaskRequest{AssigneeID: "alice"})
+
+		assert.ErrorIs(t, err, ErrTaskCompleted, "已完成的任务不能认领")
+	})
+}
+
+// TestTaskInstanceUseCase_CompleteTask 测试完成任务
+func TestTaskInstanceUseCase_CompleteTask(t *testing.T) {
+	t.Run("办理人完成任务并通知工作流", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+		variables := map[string]interface{}{"approved": true}
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusClaimed, "system"), nil)
+		m.instanceRepo.On("GetByID", mock.Anything, "42").Return(createTestProcessInstance(), nil)
+		m.variableRepo.On("Create", mock.Anything, mock.Anything).Return(&ent.ProcessVariable{}, nil)
+		m.taskRepo.On("Complete", mock.Anything, "7", variables).Return(nil)
+		expectTaskEvent(m, TaskEventCompleted)
+		m.engine.On("SignalWorkflow", mock.Anything, "process-instance-42", "run-1", temporal.SignalUserTaskCompleted,
+			mock.MatchedBy(func(signal temporal.UserTaskCompletedSignal) bool {
+				return signal.TaskID == 7 && signal.NodeID == "approve"
+			})).Return(nil)
+		m.cache.On("Delete", mock.Anything, "task_instance:7").Return(nil)
+
+		m.commentRepo.On("Create", mock.Anything, mock.MatchedBy(func(tc *ent.TaskComment) bool {
+			return tc.TaskID == 7 && tc.Type == TaskCommentTypeComplete && tc.UserID == "system" && tc.Message == "同意"
+		})).Return(&ent.TaskComment{}, nil)
+
+		err := uc.CompleteTask(systemContext(), "7", &CompleteTaskRequest{Variables: variables, Comment: "同意"})
+
+		require.NoError(t, err, "完成任务不应该返回错误")
+		m.taskRepo.AssertExpectations(t)
+		m.eventRepo.AssertExpectations(t)
+		m.commentRepo.AssertExpectations(t)
+		m.engine.AssertExpectations(t)
+	})
+
+	cases := []struct {
+		name   string
+		task   *ent.TaskInstance
+		expect error
+	}{
+		{"未认领的任务不能完成", createTestTaskInstance(TaskStatusCreated, ""), ErrTaskNotAssigned},
+		{"非办理人不能完成", createTestTaskInstance(TaskStatusClaimed, "bob"), ErrTaskNotAssignee},
+		{"委派中的任务不能直接完成", createTestTaskInstance(TaskStatusDelegated, "system"), ErrInvalidTaskTransition},
+		{"已完成的任务不能重复完成", createTestTaskInstance(TaskStatusCompleted, "system"), ErrTaskCompleted},
+		{"已取消的任务不能完成", createTestTaskInstance(TaskStatusCancelled, "system"), ErrTaskCancelled},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			uc, m := newTaskInstanceUseCaseWithMocks()
+
+			m.taskRepo.On("GetByID", mock.Anything, "7").Return(c.task, nil)
+
+			err := uc.CompleteTask(systemContext(), "7", &CompleteTaskRequest{})
+
+			assert.ErrorIs(t, err, c.expect, "应该返回对应的任务错误")
+			m.taskRepo.AssertNotCalled(t, "Complete", mock.Anything, mock.Anything, mock.Anything)
+			m.engine.AssertNotCalled(t, "SignalWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("按上下文中的调用方检查办理人", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+		ctx := auth.NewContext(systemContext(), &auth.Identity{UserID: "7", Username: "alice"})
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusClaimed, "system"), nil)
+		m.authzRepo.On("ListByPrincipals", mock.Anything, []string{"7", "alice"}, mock.Anything).Return([]*ent.Authorization{
+			{PrincipalType: PrincipalUser, PrincipalID: "alice", Actions: []string{ActionRead}},
+		}, nil)
+
+		err := uc.CompleteTask(ctx, "7", &CompleteTaskRequest{})
+
+		assert.ErrorIs(t, err, ErrTaskNotAssignee, "认证用户不能以 system 身份完成任务")
+		m.taskRepo.AssertNotCalled(t, "Complete", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("交还后只有拥有者能完成", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+		task := createTestTaskInstance(TaskStatusResolved, "system")
+		task.Owner = "manager"
+		task.Delegation = TaskDelegationResolved
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(task, nil)
+
+		err := uc.CompleteTask(systemContext(), "7", &CompleteTaskRequest{})
+
+		assert.ErrorIs(t, err, ErrTaskNotAssignee, "拥有者以外的用户不能完成交还的任务")
+		m.taskRepo.AssertNotCalled(t, "Complete", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("拥有者完成交还的任务时合并被委派人的变量", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+		task := createTestTaskInstance(TaskStatusResolved, "system")
+		task.Owner = "system"
+		task.Delegation = TaskDelegationResolved
+		expected := map[string]interface{}{"checked": true, "amount": int64(200)}
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(task, nil)
+		m.instanceRepo.On("GetByID", mock.Anything, "42").Return(createTestProcessInstance(), nil)
+		m.variableRepo.On("ListByTaskID", mock.Anything, int64(7)).Return([]*ent.ProcessVariable{
+			{TaskID: 7, Name: "checked", Type: "boolean", TextValue: "true"},
+			{TaskID: 7, Name: "amount", Type: "integer", LongValue: 100},
+		}, nil)
+		m.variableRepo.On("Create", mock.Anything, mock.Anything).Return(&ent.ProcessVariable{}, nil)
+		m.taskRepo.On("Complete", mock.Anything, "7", expected).Return(nil)
+		expectTaskEvent(m, TaskEventCompleted)
+		m.engine.On("SignalWorkflow", mock.Anything, "process-instance-42", "run-1", temporal.SignalUserTaskCompleted,
+			mock.MatchedBy(func(signal temporal.UserTaskCompletedSignal) bool {
+				return signal.Variables["checked"] == true && signal.Variables["amount"] == int64(200)
+			})).Return(nil)
+		m.cache.On("Delete", mock.Anything, "task_instance:7").Return(nil)
+
+		err := uc.CompleteTask(systemContext(), "7", &CompleteTaskRequest{Variables: map[string]interface{}{"amount": int64(200)}})
+
+		require.NoError(t, err, "拥有者完成交还的任务不应该返回错误")
+		m.taskRepo.AssertExpectations(t)
+		m.engine.AssertExpectations(t)
+	})
+
+	t.Run("并发完成时返回仓储的状态错误", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusClaimed, "system"), nil)
+		m.instanceRepo.On("GetByID", mock.Anything, "42").Return(createTestProcessInstance(), nil)
+		m.taskRepo.On("Complete", mock.Anything, "7", mock.Anything).Return(ErrTaskCompleted)
+
+		err := uc.CompleteTask(systemContext(), "7", &CompleteTaskRequest{})
+
+		assert.ErrorIs(t, err, ErrTaskCompleted, "仓储的状态错误应该透传")
+		assert.Equal(t, 1, m.tx.rolledBack, "事务应该回滚")
+		m.engine.AssertNotCalled(t, "SignalWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+// TestTaskInstanceUseCase_DelegateTask 测试委派任务
+func TestTaskInstanceUseCase_DelegateTask(t *testing.T) {
+	t.Run("办理人委派任务并记录参与者", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusClaimed, "system"), nil)
+		m.taskRepo.On("Delegate", mock.Anything, "7", "deputy").Return(nil)
+		m.taskRepo.On("AddParticipant", mock.Anything, int64(7), "deputy").Return(nil)
+		m.eventRepo.On("Create", mock.Anything, mock.MatchedBy(func(pe *ent.ProcessEvent) bool {
+			return pe.EventType == TaskEventDelegated && pe.UserID == "system" &&
+				pe.EventData["owner"] == "system" && pe.EventData["to"] == "deputy"
+		})).Return(&ent.ProcessEvent{}, nil)
+		m.commentRepo.On("Create", mock.Anything, mock.MatchedBy(func(tc *ent.TaskComment) bool {
+			return tc.TaskID == 7 && tc.ProcessInstanceID == 42 && tc.Type == TaskCommentTypeDelegate && tc.Message == "请核对金额"
+		})).Return(&ent.TaskComment{}, nil)
+		m.cache.On("Delete", mock.Anything, "task_instance:7").Return(nil)
+
+		err := uc.DelegateTask(systemContext(), "7", &DelegateTaskRequest{DelegateID: "deputy", Comment: "请核对金额"})
+
+		require.NoError(t, err, "委派任务不应该返回错误")
+		m.taskRepo.AssertExpectations(t)
+		m.eventRepo.AssertExpectations(t)
+		m.commentRepo.AssertExpectations(t)
+	})
+
+	t.Run("委派中的任务不能再次委派", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusDelegated, "system"), nil)
+
+		err := uc.DelegateTask(systemContext(), "7", &DelegateTaskRequest{DelegateID: "deputy"})
+
+		assert.ErrorIs(t, err, ErrInvalidTaskTransition, "委派中的任务不能再次委派")
+		m.taskRepo.AssertNotCalled(t, "Delegate", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+// TestTaskInstanceUseCase_ResolveTask 测试交还委派任务
+func TestTaskInstanceUseCase_ResolveTask(t *testing.T) {
+	delegatedTask := func(assignee string) *ent.TaskInstance {
+		task := createTestTaskInstance(TaskStatusDelegated, assignee)
+		task.Owner = "manager"
+		task.Delegation = TaskDelegationPending
+		return task
+	}
+
+	t.Run("被委派人交还任务并保存变量", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(delegatedTask("system"), nil)
+		m.variableRepo.On("Create", mock.Anything, mock.MatchedBy(func(pv *ent.ProcessVariable) bool {
+			return pv.TaskID == 7 && pv.Name == "checked"
+		})).Return(&ent.ProcessVariable{}, nil)
+		m.taskRepo.On("Resolve", mock.Anything, "7").Return(nil)
+		m.eventRepo.On("Create", mock.Anything, mock.MatchedBy(func(pe *ent.ProcessEvent) bool {
+			return pe.EventType == TaskEventResolved && pe.UserID == "system" &&
+				pe.EventData["from"] == "system" && pe.EventData["to"] == "manager"
+		})).Return(&ent.ProcessEvent{}, nil)
+		m.cache.On("Delete", mock.Anything, "task_instance:7").Return(nil)
+
+		err := uc.ResolveTask(systemContext(), "7", &ResolveTaskRequest{Variables: map[string]interface{}{"checked": true}})
+
+		require.NoError(t, err, "交还委派任务不应该返回错误")
+		m.taskRepo.AssertExpectations(t)
+		m.variableRepo.AssertExpectations(t)
+		m.eventRepo.AssertExpectations(t)
+		assert.Equal(t, 1, m.tx.committed, "保存变量、交还任务与办理记录应该在同一事务中提交")
+	})
+
+	t.Run("非被委派人不能交还", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(delegatedTask("deputy"), nil)
+
+		err := uc.ResolveTask(systemContext(), "7", &ResolveTaskRequest{})
+
+		assert.ErrorIs(t, err, ErrTaskNotAssignee, "只有被委派人才能交还任务")
+		m.taskRepo.AssertNotCalled(t, "Resolve", mock.Anything, mock.Anything)
+	})
+
+	t.Run("未委派的任务不能交还", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusClaimed, "system"), nil)
+
+		err := uc.ResolveTask(systemContext(), "7", &ResolveTaskRequest{})
+
+		assert.ErrorIs(t, err, ErrInvalidTaskTransition, "未委派的任务不能交还")
+		m.taskRepo.AssertNotCalled(t, "Resolve", mock.Anything, mock.Anything)
+	})
+
+	t.Run("仓储交还失败时事务回滚", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(delegatedTask("system"), nil)
+		m.taskRepo.On("Resolve", mock.Anything, "7").Return(ErrTaskCancelled)
+
+		err := uc.ResolveTask(systemContext(), "7", &ResolveTaskRequest{})
+
+		assert.ErrorIs(t, err, ErrTaskCancelled, "仓储的状态错误应该透传")
+		assert.Equal(t, 1, m.tx.rolledBack, "事务应该回滚")
+		m.eventRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+// TestTaskInstanceUseCase_AccessDenied 测试无权查看任务的调用方不能读取、修改或办理任务
+func TestTaskInstanceUseCase_AccessDenied(t *testing.T) {
+	name := "财务审批"
+	cases := map[string]func(uc *TaskInstanceUseCase, ctx context.Context) error{
+		"修改任务": func(uc *TaskInstanceUseCase, ctx context.Context) error {
+			_, err := uc.UpdateTask(ctx, "7", &UpdateTaskRequest{Name: &name})
+			return err
+		},
+		"添加评论": func(uc *TaskInstanceUseCase, ctx context.Context) error {
+			_, err := uc.AddComment(ctx, "7", &AddTaskCommentRequest{Message: "请补充发票"})
+			return err
+		},
+		"查询评论": func(uc *TaskInstanceUseCase, ctx context.Context) error {
+			_, err := uc.ListComments(ctx, "7", &ListTaskCommentsRequest{})
+			return err
+		},
+		"上传附件": func(uc *TaskInstanceUseCase, ctx context.Context) error {
+			_, err := uc.AddAttachment(ctx, "7", &AddTaskAttachmentRequest{Name: "invoice.txt"})
+			return err
+		},
+		"查询附件": func(uc *TaskInstanceUseCase, ctx context.Context) error {
+			_, err := uc.ListAttachments(ctx, "7", &ListTaskAttachmentsRequest{})
+			return err
+		},
+		"下载附件": func(uc *TaskInstanceUseCase, ctx context.Context) error {
+			_, err := uc.GetAttachmentContent(ctx, "7", "3")
+			return err
+		},
+		"查询办理记录": func(uc *TaskInstanceUseCase, ctx context.Context) error {
+			_, err := uc.ListTaskEvents(ctx, "7", &ListTaskEventsRequest{})
+			return err
+		},
+		"认领任务": func(uc *TaskInstanceUseCase, ctx context.Context) error {
+			return uc.ClaimTask(ctx, "7", &ClaimTaskRequest{AssigneeID: "mallory"})
+		},
+		"完成任务": func(uc *TaskInstanceUseCase, ctx context.Context) error {
+			return uc.CompleteTask(ctx, "7", &CompleteTaskRequest{})
+		},
+		"委派任务": func(uc *TaskInstanceUseCase, ctx context.Context) error {
+			return uc.DelegateTask(ctx, "7", &DelegateTaskRequest{DelegateID: "mallory"})
+		},
+		"交还任务": func(uc *TaskInstanceUseCase, ctx context.Context) error {
+			return uc.ResolveTask(ctx, "7", &ResolveTaskRequest{})
+		},
+	}
+	for name, call := range cases {
+		t.Run(name, func(t *testing.T) {
+			uc, m := newTaskInstanceUseCaseWithMocks()
+
+			m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusCreated, ""), nil)
+
+			err := call(uc, outsiderContext(m))
+
+			assert.ErrorIs(t, err, ErrAccessDenied, "无权查看任务的调用方应该被拒绝")
+			m.commentRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+			m.attachRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+			m.eventRepo.AssertNotCalled(t, "ListByTaskID", mock.Anything, mock.Anything, mock.Anything)
+			assert.Zero(t, m.tx.committed+m.tx.rolledBack, "不应该开启事务")
+			m.taskRepo.AssertNotCalled(t, "Claim", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// Package biz 提供业务逻辑层功能
+// 包含任务状态流转规则与任务业务错误
+package biz
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// 任务业务错误，服务层据此返回对应的错误码
+var (
+	ErrTaskNotFound          = errors.New("任务实例不存在")
+	ErrTaskAlreadyClaimed    = errors.New("任务已被其他用户认领")
+	ErrTaskNotAssigned       = errors.New("任务未被认领")
+	ErrTaskNotCandidate      = errors.New("用户不是任务的候选人")
+	ErrTaskNotAssignee       = errors.New("用户不是任务的办理人")
+	ErrTaskCompleted         = errors.New("任务已完成")
+	ErrTaskCancelled         = errors.New("任务已取消")
+	ErrInvalidTaskTransition = errors.New("任务状态不允许该操作")
+	ErrTaskHasOpenSubtasks   = errors.New("任务存在未结束的子任务")
+
+	ErrTaskAttachmentNotFound = errors.New("任务附件不存在")
+	ErrTaskAttachmentTooLarge = errors.New("任务附件超过大小限制")
+
+	ErrBulkTaskLimitExceeded = errors.New("批量操作的任务数超过上限")
+)
+
+// taskTransitions 任务状态流转规则：当前状态 -> 允许变更的目标状态
+// 已认领的任务可以被同一用户重复认领，取消认领后退回已创建；委派中的任务需要由被委派人处理后交还，不能直接完成
+var taskTransitions = map[string][]string{
+	TaskStatusCreated:   {TaskStatusClaimed, TaskStatusDelegated, TaskStatusCompleted, TaskStatusCancelled},
+	TaskStatusClaimed:   {TaskStatusCreated, TaskStatusClaimed, TaskStatusDelegated, TaskStatusCompleted, TaskStatusCancelled},
+	TaskStatusDelegated: {TaskStatusResolved, TaskStatusCancelled},
+	TaskStatusResolved:  {TaskStatusDelegated, TaskStatusCompleted, TaskStatusCancelled},
+}
+
+// OpenTaskStatuses 未结束的任务状态
+var OpenTaskStatuses = []string{TaskStatusCreated, TaskStatusClaimed, TaskStatusDelegated, TaskStatusResolved}
+
+// IsTaskStatus 判断是否为有效的任务状态
+func IsTaskStatus(status string) bool {
+	return slices.Contains(OpenTaskStatuses, status) || status == TaskStatusCompleted || status == TaskStatusCancelled
+}
+
+// CheckTaskTransition 检查任务能否从当前状态变更为目标状态
+// 不允许时返回对应的任务业务错误；当前状态为空视为已创建
+func CheckTaskTransition(from, to string) error {
+	if from == "" {
+		from = TaskStatusCreated
+	}
+	switch from {
+	case TaskStatusCompleted:
+		return ErrTaskCompleted
+	case TaskStatusCancelled:
+		return ErrTaskCancelled
+	}
+	if !slices.Contains(taskTransitions[from], to) {
+		return fmt.Errorf("%w: 任务状态为 %s，不能变更为 %s", ErrInvalidTaskTransition, from, to)
+	}
+	return nil
+}
+
+// TaskStatusesBefore 返回允许变更为目标状态的任务状态，供仓储按状态条件更新任务
+func TaskStatusesBefore(to string) []string {
+	var statuses []string
+	for _, from := range OpenTaskStatuses {
+		if slices.Contains(taskTransitions[from], to) {
+			statuses = append(statuses, from)
+		}
+	}
+	return statuses
+}
+
+// taskErrors 任务业务错误与无权访问任务的错误，批量操作中单个任务返回这些错误时只记为该任务失败
+var taskErrors = []error{
+	ErrTaskNotFound, ErrTaskAlreadyClaimed, ErrTaskNotAssigned, ErrTaskNotCandidate, ErrTaskNotAssignee,
+	ErrTaskCompleted, ErrTaskCancelled, ErrInvalidTaskTransition, ErrTaskHasOpenSubtasks, ErrAccessDenied,
+}
+
+// isTaskError 判断是否为任务业务错误
+func isTaskError(err error) bool {
+	for _, target := range taskErrors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
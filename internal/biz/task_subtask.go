@@ -0,0 +1,165 @@
+// Package biz 提供业务逻辑层功能
+// 包含子任务的创建与子任务树查询
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+
+	"go.uber.org/zap"
+)
+
+// maxSubtaskDepth 查询子任务树的最大层数
+const maxSubtaskDepth = 10
+
+// CreateSubtask 在任务下创建子任务
+// 只有任务的拥有者（未委派时为办理人）才能创建子任务。子任务与父任务属于同一流程实例，不属于流程模型，
+// 子任务创建后即由办理人认领，未指定办理人时由创建人自己办理；要求阻塞父任务时，父任务需等待子任务全部结束才能完成
+func (uc *TaskInstanceUseCase) CreateSubtask(ctx context.Context, parentID string, req *CreateSubtaskRequest) (*TaskInstanceResponse, error) {
+	uc.logger.Info("创建子任务", zap.String("parent_id", parentID), zap.String("name", req.Name))
+
+	parent, err := uc.taskInstanceRepo.GetByID(ctx, parentID)
+	if err != nil {
+		uc.logger.Error("获取任务实例失败", zap.String("id", parentID), zap.Error(err))
+		return nil, fmt.Errorf("获取任务实例失败: %w", err)
+	}
+
+	// 检查父任务状态
+	switch taskStatus(parent) {
+	case TaskStatusCompleted:
+		return nil, ErrTaskCompleted
+	case TaskStatusCancelled:
+		return nil, ErrTaskCancelled
+	}
+
+	// 检查创建权限
+	currentUserID := uc.getCurrentUserID(ctx)
+	owner := parent.Owner
+	if owner == "" {
+		owner = parent.Assignee
+	}
+	if owner != currentUserID {
+		return nil, fmt.Errorf("%w: 只有任务的拥有者才能创建子任务", ErrTaskNotAssignee)
+	}
+
+	assignee := req.Assignee
+	if assignee == "" {
+		assignee = currentUserID
+	}
+
+	now := time.Now()
+	subtask := &ent.TaskInstance{
+		Name:                 req.Name,
+		Description:          req.Description,
+		TaskDefinitionKey:    parent.TaskDefinitionKey,
+		Owner:                currentUserID,
+		Assignee:             assignee,
+		Status:               TaskStatusClaimed,
+		ClaimTime:            &now,
+		Priority:             req.Priority,
+		DueDate:              req.DueDate,
+		Category:             parent.Category,
+		ParentTaskID:         strconv.FormatInt(parent.ID, 10),
+		ProcessInstanceID:    parent.ProcessInstanceID,
+		ProcessDefinitionID:  parent.ProcessDefinitionID,
+		ProcessDefinitionKey: parent.ProcessDefinitionKey,
+		TenantID:             parent.TenantID,
+		CreateTime:           now,
+	}
+	if subtask.Priority == 0 {
+		subtask.Priority = parent.Priority
+	}
+
+	var created *ent.TaskInstance
+	err = uc.txRepo.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		if created, err = uc.taskInstanceRepo.Create(ctx, subtask); err != nil {
+			return err
+		}
+		if err := uc.taskInstanceRepo.AddParticipant(ctx, created.ID, assignee); err != nil {
+			return err
+		}
+		if req.BlockParent && !parent.WaitForSubtasks {
+			block := true
+			if _, err := uc.taskInstanceRepo.UpdateInfo(ctx, strconv.FormatInt(parent.ID, 10), &UpdateTaskRequest{WaitForSubtasks: &block}); err != nil {
+				return err
+			}
+			parent.WaitForSubtasks = true
+		}
+		return uc.recordTaskEvent(ctx, parent, TaskEventSubtaskCreated, currentUserID, map[string]interface{}{
+			"subtask_id":   strconv.FormatInt(created.ID, 10),
+			"name":         created.Name,
+			"assignee":     created.Assignee,
+			"block_parent": parent.WaitForSubtasks,
+		})
+	})
+	if err != nil {
+		uc.logger.Error("创建子任务失败", zap.String("parent_id", parentID), zap.Error(err))
+		return nil, fmt.Errorf("创建子任务失败: %w", err)
+	}
+
+	// 清除父任务缓存
+	cacheKey := fmt.Sprintf("task_instance:%s", parentID)
+	if err := uc.cache.Delete(ctx, cacheKey); err != nil {
+		uc.logger.Warn("清除任务实例缓存失败", zap.Error(err))
+	}
+
+	uc.logger.Info("子任务创建成功", zap.String("parent_id", parentID), zap.Int64("id", created.ID))
+	return uc.withIdentityLinks(ctx, uc.toTaskInstanceResponse(created, nil)), nil
+}
+
+// ListSubtasks 查询任务的子任务树
+func (uc *TaskInstanceUseCase) ListSubtasks(ctx context.Context, id string) ([]*TaskInstanceResponse, error) {
+	uc.logger.Debug("查询子任务", zap.String("id", id))
+
+	task, err := uc.taskInstanceRepo.GetByID(ctx, id)
+	if err != nil {
+		uc.logger.Error("获取任务实例失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("获取任务实例失败: %w", err)
+	}
+	if err := uc.authorizeTask(ctx, task); err != nil {
+		return nil, err
+	}
+
+	resp := uc.toTaskInstanceResponse(task, nil)
+	uc.withSubtasks(ctx, []*TaskInstanceResponse{resp})
+	if resp.Subtasks == nil {
+		return []*TaskInstanceResponse{}, nil
+	}
+	return resp.Subtasks, nil
+}
+
+// withSubtasks 逐层查询并填充任务的子任务树，最多 maxSubtaskDepth 层
+// 子任务只包含任务本身的信息，不包含变量与候选人；查询失败时保留已填充的部分
+func (uc *TaskInstanceUseCase) withSubtasks(ctx context.Context, tasks []*TaskInstanceResponse) {
+	level := tasks
+	for depth := 0; depth < maxSubtaskDepth && len(level) > 0; depth++ {
+		parents := make(map[string]*TaskInstanceResponse, len(level))
+		ids := make([]string, len(level))
+		for i, task := range level {
+			parents[task.ID] = task
+			ids[i] = task.ID
+		}
+
+		children, err := uc.taskInstanceRepo.ListSubtasks(ctx, ids)
+		if err != nil {
+			uc.logger.Warn("查询子任务失败", zap.Strings("parent_ids", ids), zap.Error(err))
+			return
+		}
+
+		level = nil
+		for _, child := range children {
+			parent, ok := parents[child.ParentTaskID]
+			if !ok {
+				continue
+			}
+			resp := uc.toTaskInstanceResponse(child, nil)
+			parent.Subtasks = append(parent.Subtasks, resp)
+			level = append(level, resp)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Package biz 提供业务逻辑层功能的测试
+// 包含子任务创建、完成与子任务树查询的单元测试
+package biz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+)
+
+// createTestSubtask 创建测试用的子任务
+func createTestSubtask(id int64, parentID string, assignee string) *ent.TaskInstance {
+	task := createTestTaskInstance(TaskStatusClaimed, assignee)
+	task.ID = id
+	task.Name = "核对发票"
+	task.ParentTaskID = parentID
+	return task
+}
+
+// TestTaskInstanceUseCase_CreateSubtask 测试创建子任务
+func TestTaskInstanceUseCase_CreateSubtask(t *testing.T) {
+	t.Run("拥有者创建子任务并阻塞父任务", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+		parent := createTestTaskInstance(TaskStatusClaimed, "system")
+		parent.Priority = 50
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(parent, nil)
+		m.taskRepo.On("Create", mock.Anything, mock.MatchedBy(func(ti *ent.TaskInstance) bool {
+			return ti.ParentTaskID == "7" && ti.ProcessInstanceID == 42 && ti.Owner == "system" &&
+				ti.Assignee == "clerk" && ti.Status == TaskStatusClaimed && ti.Priority == 50
+		})).Return(createTestSubtask(8, "7", "clerk"), nil)
+		m.taskRepo.On("AddParticipant", mock.Anything, int64(8), "clerk").Return(nil)
+		m.taskRepo.On("UpdateInfo", mock.Anything, "7", mock.MatchedBy(func(req *UpdateTaskRequest) bool {
+			return req.WaitForSubtasks != nil && *req.WaitForSubtasks && req.Name == nil && req.Priority == nil
+		})).Return(&ent.TaskInstance{}, nil)
+		m.eventRepo.On("Create", mock.Anything, mock.MatchedBy(func(pe *ent.ProcessEvent) bool {
+			return pe.EventType == TaskEventSubtaskCreated && pe.TaskID == 7 && pe.EventData["subtask_id"] == "8"
+		})).Return(&ent.ProcessEvent{}, nil)
+		m.taskRepo.On("ListIdentityLinks", mock.Anything, int64(8)).Return([]*ent.TaskIdentityLink{}, nil)
+		m.cache.On("Delete", mock.Anything, "task_instance:7").Return(nil)
+
+		result, err := uc.CreateSubtask(systemContext(), "7", &CreateSubtaskRequest{
+			Name: "核对发票", Assignee: "clerk", Priority: 0, BlockParent: true,
+		})
+
+		require.NoError(t, err, "创建子任务不应该返回错误")
+		assert.Equal(t, "7", result.ParentTaskID, "子任务应该关联父任务")
+		assert.Equal(t, 1, m.tx.committed, "子任务与父任务的变更应该在同一事务中提交")
+		m.taskRepo.AssertExpectations(t)
+		m.eventRepo.AssertExpectations(t)
+	})
+
+	t.Run("非拥有者不能创建子任务", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusClaimed, "bob"), nil)
+
+		_, err := uc.CreateSubtask(systemContext(), "7", &CreateSubtaskRequest{Name: "核对发票"})
+
+		assert.ErrorIs(t, err, ErrTaskNotAssignee, "非拥有者不能创建子任务")
+		m.taskRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("已完成的任务不能创建子任务", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusCompleted, "system"), nil)
+
+		_, err := uc.CreateSubtask(systemContext(), "7", &CreateSubtaskRequest{Name: "核对发票"})
+
+		assert.ErrorIs(t, err, ErrTaskCompleted, "已完成的任务不能创建子任务")
+	})
+}
+
+// TestTaskInstanceUseCase_CompleteWithSubtasks 测试完成有子任务的任务与子任务
+func TestTaskInstanceUseCase_CompleteWithSubtasks(t *testing.T) {
+	t.Run("等待子任务的父任务在子任务未结束时不能完成", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+		task := createTestTaskInstance(TaskStatusClaimed, "system")
+		task.WaitForSubtasks = true
+
+		m.taskRepo.On("GetByID", mock.Anything, "7").Return(task, nil)
+		m.taskRepo.On("Count", mock.Anything, mock.MatchedBy(func(filter *TaskInstanceFilter) bool {
+			return filter.ParentTaskID == "7" && len(filter.States) == len(OpenTaskStatuses)
+		})).Return(2, nil)
+
+		err := uc.CompleteTask(systemContext(), "7", &CompleteTaskRequest{})
+
+		assert.ErrorIs(t, err, ErrTaskHasOpenSubtasks, "子任务未结束时应该拒绝完成")
+		m.taskRepo.AssertNotCalled(t, "Complete", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("完成子任务不通知工作流", func(t *testing.T) {
+		uc, m := newTaskInstanceUseCaseWithMocks()
+		variables := map[string]interface{}{"checked": true}
+
+		m.taskRepo.On("GetByID", mock.Anything, "8").Return(createTestSubtask(8, "7", "system"), nil)
+		m.variableRepo.On("Create", mock.Anything, mock.MatchedBy(func(pv *ent.ProcessVariable) bool {
+			return pv.TaskID == 8 && pv.Name == "checked"
+		})).Return(&ent.ProcessVariable{}, nil)
+		m.taskRepo.On("Complete", mock.Anything, "8", map[string]interface{}(nil)).Return(nil)
+		m.eventRepo.On("Create", mock.Anything, mock.Anything).Return(&ent.ProcessEvent{}, nil)
+		m.cache.On("Delete", mock.Anything, "task_instance:8").Return(nil)
+
+		err := uc.CompleteTask(systemContext(), "8", &CompleteTaskRequest{Variables: variables})
+
+		require.NoError(t, err, "完成子任务不应该返回错误")
+		m.taskRepo.AssertExpectations(t)
+		m.variableRepo.AssertExpectations(t)
+		m.instanceRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+		m.engine.AssertNotCalled(t, "SignalWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+// TestTaskInstanceUseCase_ListSubtasks 测试查询子任务树
+func TestTaskInstanceUseCase_ListSubtasks(t *testing.T) {
+	uc, m := newTaskInstanceUseCaseWithMocks()
+
+	m.taskRepo.On("GetByID", mock.Anything, "7").Return(createTestTaskInstance(TaskStatusClaimed, "system"), nil)
+	m.taskRepo.On("ListSubtasks", mock.Anything, []string{"7"}).Return([]*ent.TaskInstance{
+		createTestSubtask(8, "7", "system"),
+		createTestSubtask(9, "7", "clerk"),
+	}, nil)
+	m.taskRepo.On("ListSubtasks", mock.Anything, []string{"8", "9"}).Return([]*ent.TaskInstance{
+		createTestSubtask(10, "9", "clerk"),
+	}, nil)
+	m.taskRepo.On("ListSubtasks", mock.Anything, []string{"10"}).Return([]*ent.TaskInstance{}, nil)
+
+	result, err := uc.ListSubtasks(systemContext(), "7")
+
+	require.NoError(t, err, "查询子任务不应该返回错误")
+	require.Len(t, result, 2, "应该返回两个直接子任务")
+	assert.Empty(t, result[0].Subtasks, "子任务8没有下级任务")
+	require.Len(t, result[1].Subtasks, 1, "子任务9应该有一个下级任务")
+	assert.Equal(t, "10", result[1].Subtasks[0].ID, "下级任务应该匹配")
+}
@@ -0,0 +1,389 @@
+// Package biz 提供业务逻辑层功能
+// 包含用户、角色与用户组管理的业务逻辑
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/workflow-engine/workflow-engine/internal/auth"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+
+	"go.uber.org/zap"
+)
+
+// 用户管理业务错误
+var (
+	ErrUserNotFound  = errors.New("用户不存在")
+	ErrUserExists    = errors.New("用户名已存在")
+	ErrRoleNotFound  = errors.New("角色不存在")
+	ErrRoleExists    = errors.New("角色已存在")
+	ErrGroupNotFound = errors.New("用户组不存在")
+	ErrGroupExists   = errors.New("用户组已存在")
+)
+
+// AdminRole 管理员角色，拥有全部权限
+const AdminRole = "admin"
+
+// UserUseCase 用户管理用例
+type UserUseCase struct {
+	userRepo  UserRepo
+	roleRepo  RoleRepo
+	groupRepo GroupRepo
+	sessions  *AuthUseCase
+	passwords *auth.PasswordHasher
+	logger    *zap.Logger
+}
+
+// NewUserUseCase 创建用户管理用例实例
+func NewUserUseCase(
+	userRepo UserRepo,
+	roleRepo RoleRepo,
+	groupRepo GroupRepo,
+	sessions *AuthUseCase,
+	passwords *auth.PasswordHasher,
+	logger *zap.Logger,
+) *UserUseCase {
+	return &UserUseCase{
+		userRepo:  userRepo,
+		roleRepo:  roleRepo,
+		groupRepo: groupRepo,
+		sessions:  sessions,
+		passwords: passwords,
+		logger:    logger,
+	}
+}
+
+// CreateUser 创建用户，角色与用户组必须已存在
+func (uc *UserUseCase) CreateUser(ctx context.Context, req *CreateUserRequest) (*UserResponse, error) {
+	uc.logger.Info("创建用户", zap.String("username", req.Username))
+
+	if err := validatePassword(req.Password, req.Username); err != nil {
+		return nil, err
+	}
+	if err := uc.checkMembership(ctx, req.Roles, req.Groups); err != nil {
+		return nil, err
+	}
+
+	hash, err := uc.passwords.Hash(req.Password)
+	if err != nil {
+		return nil, err
+	}
+	u, err := uc.userRepo.Create(ctx, &ent.User{
+		Username:     req.Username,
+		Email:        req.Email,
+		DisplayName:  req.DisplayName,
+		PasswordHash: hash,
+		Status:       UserStatusActive,
+		Roles:        req.Roles,
+		Groups:       req.Groups,
+		TenantID:     req.TenantID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.Info("用户创建成功", zap.Int64("id", u.ID), zap.String("username", u.Username))
+	return toUserResponse(u), nil
+}
+
+// GetUser 获取用户
+func (uc *UserUseCase) GetUser(ctx context.Context, id string) (*UserResponse, error) {
+	userID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUserNotFound, id)
+	}
+	u, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toUserResponse(u), nil
+}
+
+// ListUsers 分页查询用户
+func (uc *UserUseCase) ListUsers(ctx context.Context, req *ListUsersRequest) (*ListUsersResponse, error) {
+	users, page, err := uc.userRepo.List(ctx, &UserFilter{
+		Status: req.Status,
+		Role:   req.Role,
+		Group:  req.Group,
+	}, &QueryOptions{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		OrderBy:  req.OrderBy,
+		Order:    req.Order,
+		Search:   req.Search,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*UserResponse, 0, len(users))
+	for _, u := range users {
+		items = append(items, toUserResponse(u))
+	}
+	return &ListUsersResponse{Items: items, Pagination: page}, nil
+}
+
+// UpdateUser 更新用户资料、角色、用户组、状态或重置密码
+// 停用用户或重置密码时吊销该用户的全部会话；角色与用户组的变更在下次刷新令牌时生效
+func (uc *UserUseCase) UpdateUser(ctx context.Context, id string, req *UpdateUserRequest) (*UserResponse, error) {
+	uc.logger.Info("更新用户", zap.String("id", id))
+
+	userID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUserNotFound, id)
+	}
+	u, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var roles, groups []string
+	if req.Roles != nil {
+		roles = *req.Roles
+	}
+	if req.Groups != nil {
+		groups = *req.Groups
+	}
+	if err := uc.checkMembership(ctx, roles, groups); err != nil {
+		return nil, err
+	}
+	if req.Password != nil {
+		if err := validatePassword(*req.Password, u.Username); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Email != nil {
+		u.Email = *req.Email
+	}
+	if req.DisplayName != nil {
+		u.DisplayName = *req.DisplayName
+	}
+	if req.Roles != nil {
+		u.Roles = roles
+	}
+	if req.Groups != nil {
+		u.Groups = groups
+	}
+	disabled := false
+	if req.Status != nil {
+		disabled = u.Status == UserStatusActive && *req.Status == UserStatusDisabled
+		u.Status = *req.Status
+	}
+
+	updated, err := uc.userRepo.Update(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	if req.Password != nil {
+		hash, err := uc.passwords.Hash(*req.Password)
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.userRepo.UpdatePassword(ctx, u.ID, hash); err != nil {
+			return nil, err
+		}
+	}
+	if disabled || req.Password != nil {
+		if err := uc.sessions.RevokeUserSessions(ctx, u.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	uc.logger.Info("用户更新成功", zap.Int64("id", u.ID), zap.Bool("disabled", disabled), zap.Bool("password_reset", req.Password != nil))
+	return toUserResponse(updated), nil
+}
+
+// CreateRole 创建角色
+func (uc *UserUseCase) CreateRole(ctx context.Context, req *CreateRoleRequest) (*RoleResponse, error) {
+	role, err := uc.roleRepo.Create(ctx, &ent.Role{
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: req.Permissions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toRoleResponse(role), nil
+}
+
+// UpdateRole 更新角色描述与权限，权限变更在用户下次刷新令牌时生效
+func (uc *UserUseCase) UpdateRole(ctx context.Context, name string, req *UpdateRoleRequest) (*RoleResponse, error) {
+	role, err := uc.roleRepo.GetByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if req.Description != nil {
+		role.Description = *req.Description
+	}
+	if req.Permissions != nil {
+		role.Permissions = *req.Permissions
+	}
+
+	updated, err := uc.roleRepo.Update(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+	return toRoleResponse(updated), nil
+}
+
+// ListRoles 查询全部角色
+func (uc *UserUseCase) ListRoles(ctx context.Context) ([]*RoleResponse, error) {
+	roles, err := uc.roleRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*RoleResponse, 0, len(roles))
+	for _, role := range roles {
+		items = append(items, toRoleResponse(role))
+	}
+	return items, nil
+}
+
+// CreateGroup 创建用户组
+func (uc *UserUseCase) CreateGroup(ctx context.Context, req *CreateGroupRequest) (*GroupResponse, error) {
+	group, err := uc.groupRepo.Create(ctx, &ent.Group{
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toGroupResponse(group), nil
+}
+
+// ListGroups 查询全部用户组
+func (uc *UserUseCase) ListGroups(ctx context.Context) ([]*GroupResponse, error) {
+	groups, err := uc.groupRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*GroupResponse, 0, len(groups))
+	for _, group := range groups {
+		items = append(items, toGroupResponse(group))
+	}
+	return items, nil
+}
+
+// EnsureAdmin 确保管理员角色与初始管理员账号存在，用于首次部署
+// 账号已存在时不做任何修改，不会覆盖已修改过的密码
+func (uc *UserUseCase) EnsureAdmin(ctx context.Context, username, password string) error {
+	if _, err := uc.roleRepo.GetByName(ctx, AdminRole); err != nil {
+		if !errors.Is(err, ErrRoleNotFound) {
+			return err
+		}
+		if _, err := uc.roleRepo.Create(ctx, &ent.Role{
+			Name:        AdminRole,
+			Description: "管理员",
+			Permissions: []string{"*"},
+		}); err != nil && !errors.Is(err, ErrRoleExists) {
+			return err
+		}
+	}
+
+	if _, err := uc.userRepo.GetByUsername(ctx, username); err == nil {
+		return nil
+	} else if !errors.Is(err, ErrUserNotFound) {
+		return err
+	}
+
+	if _, err := uc.CreateUser(ctx, &CreateUserRequest{
+		Username: username,
+		Password: password,
+		Roles:    []string{AdminRole},
+	}); err != nil && !errors.Is(err, ErrUserExists) {
+		return err
+	}
+	uc.logger.Info("已创建初始管理员账号", zap.String("username", username))
+	return nil
+}
+
+// checkMembership 检查角色与用户组是否都已存在
+func (uc *UserUseCase) checkMembership(ctx context.Context, roles, groups []string) error {
+	if len(roles) > 0 {
+		found, err := uc.roleRepo.ListByNames(ctx, roles)
+		if err != nil {
+			return err
+		}
+		names := make([]string, 0, len(found))
+		for _, role := range found {
+			names = append(names, role.Name)
+		}
+		if missing := missingNames(roles, names); len(missing) > 0 {
+			return fmt.Errorf("%w: %s", ErrRoleNotFound, strings.Join(missing, ", "))
+		}
+	}
+	if len(groups) > 0 {
+		found, err := uc.groupRepo.ListByNames(ctx, groups)
+		if err != nil {
+			return err
+		}
+		names := make([]string, 0, len(found))
+		for _, group := range found {
+			names = append(names, group.Name)
+		}
+		if missing := missingNames(groups, names); len(missing) > 0 {
+			return fmt.Errorf("%w: %s", ErrGroupNotFound, strings.Join(missing, ", "))
+		}
+	}
+	return nil
+}
+
+// missingNames 返回 wanted 中不在 found 里的名称
+func missingNames(wanted, found []string) []string {
+	exists := make(map[string]bool, len(found))
+	for _, name := range found {
+		exists[name] = true
+	}
+	var missing []string
+	for _, name := range wanted {
+		if !exists[name] {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// toUserResponse 转换用户响应
+func toUserResponse(u *ent.User) *UserResponse {
+	return &UserResponse{
+		ID:                strconv.FormatInt(u.ID, 10),
+		Username:          u.Username,
+		Email:             u.Email,
+		DisplayName:       u.DisplayName,
+		Status:            u.Status,
+		Roles:             u.Roles,
+		Groups:            u.Groups,
+		TenantID:          u.TenantID,
+		PasswordChangedAt: u.PasswordChangedAt,
+		LastLoginAt:       u.LastLoginAt,
+		CreatedAt:         u.CreatedAt,
+		UpdatedAt:         u.UpdatedAt,
+	}
+}
+
+// toRoleResponse 转换角色响应
+func toRoleResponse(role *ent.Role) *RoleResponse {
+	return &RoleResponse{
+		ID:          strconv.FormatInt(role.ID, 10),
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+}
+
+// toGroupResponse 转换用户组响应
+func toGroupResponse(group *ent.Group) *GroupResponse {
+	return &GroupResponse{
+		ID:          strconv.FormatInt(group.ID, 10),
+		Name:        group.Name,
+		Description: group.Description,
+		CreatedAt:   group.CreatedAt,
+	}
+}
@@ -11,11 +11,19 @@ import (
 	"github.com/workflow-engine/workflow-engine/pkg/config"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/google/wire"
 	_ "github.com/lib/pq" // PostgreSQL 驱动
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 )
 
+// ProviderSet 数据访问层的依赖注入提供器集合
+// 除 Data 本身外，还向仓储层提供其中的数据库与 Redis 客户端
+var ProviderSet = wire.NewSet(
+	NewData,
+	wire.FieldsOf(new(*Data), "DB", "Redis"),
+)
+
 // Data 数据访问层结构体，包含所有数据连接和客户端
 type Data struct {
 	// 数据库客户端
@@ -164,8 +172,10 @@ func (d *Data) Migrate(ctx context.Context) error {
 
 // HealthCheck 检查数据连接健康状态
 func (d *Data) HealthCheck(ctx context.Context) error {
-	// 检查数据库连接 - 尝试计数查询，如果失败则忽略（表可能尚未创建）
-	_, _ = d.DB.ProcessDefinition.Query().Count(ctx)
+	// 检查数据库连接，表尚未创建时同样视为不健康，服务此时无法处理请求
+	if _, err := d.DB.ProcessDefinition.Query().Exist(ctx); err != nil {
+		return fmt.Errorf("数据库健康检查失败: %w", err)
+	}
 
 	// 检查 Redis 连接
 	if err := d.Redis.Ping(ctx).Err(); err != nil {
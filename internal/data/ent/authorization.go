@@ -0,0 +1,189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/authorization"
+)
+
+// Authorization is the model entity for the Authorization schema.
+type Authorization struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 授权ID
+	ID int64 `json:"id,omitempty"`
+	// 被授权主体类型：user, group
+	PrincipalType string `json:"principal_type,omitempty"`
+	// 被授权主体：用户ID、用户名或用户组名称
+	PrincipalID string `json:"principal_id,omitempty"`
+	// 流程定义Key，为空表示不限
+	ProcessDefinitionKey string `json:"process_definition_key,omitempty"`
+	// 流程定义分类，为空表示不限
+	Category string `json:"category,omitempty"`
+	// 租户ID，为空表示不限
+	TenantID string `json:"tenant_id,omitempty"`
+	// 授予的操作：start, read, update, suspend, terminate, migrate，* 表示全部
+	Actions []string `json:"actions,omitempty"`
+	// 授权人
+	CreatedBy string `json:"created_by,omitempty"`
+	// 创建时间
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Authorization) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case authorization.FieldActions:
+			values[i] = new([]byte)
+		case authorization.FieldID:
+			values[i] = new(sql.NullInt64)
+		case authorization.FieldPrincipalType, authorization.FieldPrincipalID, authorization.FieldProcessDefinitionKey, authorization.FieldCategory, authorization.FieldTenantID, authorization.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case authorization.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Authorization fields.
+func (a *Authorization) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case authorization.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			a.ID = int64(value.Int64)
+		case authorization.FieldPrincipalType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field principal_type", values[i])
+			} else if value.Valid {
+				a.PrincipalType = value.String
+			}
+		case authorization.FieldPrincipalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field principal_id", values[i])
+			} else if value.Valid {
+				a.PrincipalID = value.String
+			}
+		case authorization.FieldProcessDefinitionKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field process_definition_key", values[i])
+			} else if value.Valid {
+				a.ProcessDefinitionKey = value.String
+			}
+		case authorization.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				a.Category = value.String
+			}
+		case authorization.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				a.TenantID = value.String
+			}
+		case authorization.FieldActions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field actions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.Actions); err != nil {
+					return fmt.Errorf("unmarshal field actions: %w", err)
+				}
+			}
+		case authorization.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				a.CreatedBy = value.String
+			}
+		case authorization.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				a.CreatedAt = value.Time
+			}
+		default:
+			a.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Authorization.
+// This includes values selected through modifiers, order, etc.
+func (a *Authorization) Value(name string) (ent.Value, error) {
+	return a.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Authorization.
+// Note that you need to call Authorization.Unwrap() before calling this method if this Authorization
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (a *Authorization) Update() *AuthorizationUpdateOne {
+	return NewAuthorizationClient(a.config).UpdateOne(a)
+}
+
+// Unwrap unwraps the Authorization entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (a *Authorization) Unwrap() *Authorization {
+	_tx, ok := a.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Authorization is not a transactional entity")
+	}
+	a.config.driver = _tx.drv
+	return a
+}
+
+// String implements the fmt.Stringer.
+func (a *Authorization) String() string {
+	var builder strings.Builder
+	builder.WriteString("Authorization(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", a.ID))
+	builder.WriteString("principal_type=")
+	builder.WriteString(a.PrincipalType)
+	builder.WriteString(", ")
+	builder.WriteString("principal_id=")
+	builder.WriteString(a.PrincipalID)
+	builder.WriteString(", ")
+	builder.WriteString("process_definition_key=")
+	builder.WriteString(a.ProcessDefinitionKey)
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(a.Category)
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(a.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("actions=")
+	builder.WriteString(fmt.Sprintf("%v", a.Actions))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(a.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(a.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Authorizations is a parsable slice of Authorization.
+type Authorizations []*Authorization
@@ -0,0 +1,123 @@
+// Code generated by ent, DO NOT EDIT.
+
+package authorization
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the authorization type in the database.
+	Label = "authorization"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPrincipalType holds the string denoting the principal_type field in the database.
+	FieldPrincipalType = "principal_type"
+	// FieldPrincipalID holds the string denoting the principal_id field in the database.
+	FieldPrincipalID = "principal_id"
+	// FieldProcessDefinitionKey holds the string denoting the process_definition_key field in the database.
+	FieldProcessDefinitionKey = "process_definition_key"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldActions holds the string denoting the actions field in the database.
+	FieldActions = "actions"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the authorization in the database.
+	Table = "authorizations"
+)
+
+// Columns holds all SQL columns for authorization fields.
+var Columns = []string{
+	FieldID,
+	FieldPrincipalType,
+	FieldPrincipalID,
+	FieldProcessDefinitionKey,
+	FieldCategory,
+	FieldTenantID,
+	FieldActions,
+	FieldCreatedBy,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PrincipalTypeValidator is a validator for the "principal_type" field. It is called by the builders before save.
+	PrincipalTypeValidator func(string) error
+	// PrincipalIDValidator is a validator for the "principal_id" field. It is called by the builders before save.
+	PrincipalIDValidator func(string) error
+	// DefaultProcessDefinitionKey holds the default value on creation for the "process_definition_key" field.
+	DefaultProcessDefinitionKey string
+	// ProcessDefinitionKeyValidator is a validator for the "process_definition_key" field. It is called by the builders before save.
+	ProcessDefinitionKeyValidator func(string) error
+	// DefaultCategory holds the default value on creation for the "category" field.
+	DefaultCategory string
+	// CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	CategoryValidator func(string) error
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID string
+	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	TenantIDValidator func(string) error
+	// CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	CreatedByValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Authorization queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPrincipalType orders the results by the principal_type field.
+func ByPrincipalType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrincipalType, opts...).ToFunc()
+}
+
+// ByPrincipalID orders the results by the principal_id field.
+func ByPrincipalID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrincipalID, opts...).ToFunc()
+}
+
+// ByProcessDefinitionKey orders the results by the process_definition_key field.
+func ByProcessDefinitionKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProcessDefinitionKey, opts...).ToFunc()
+}
+
+// ByCategory orders the results by the category field.
+func ByCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategory, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
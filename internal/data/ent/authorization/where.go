@@ -0,0 +1,545 @@
+// Code generated by ent, DO NOT EDIT.
+
+package authorization
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.Authorization {
+	return predicate.Authorization(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.Authorization {
+	return predicate.Authorization(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.Authorization {
+	return predicate.Authorization(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.Authorization {
+	return predicate.Authorization(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.Authorization {
+	return predicate.Authorization(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.Authorization {
+	return predicate.Authorization(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.Authorization {
+	return predicate.Authorization(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.Authorization {
+	return predicate.Authorization(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.Authorization {
+	return predicate.Authorization(sql.FieldLTE(FieldID, id))
+}
+
+// PrincipalType applies equality check predicate on the "principal_type" field. It's identical to PrincipalTypeEQ.
+func PrincipalType(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldEQ(FieldPrincipalType, v))
+}
+
+// PrincipalID applies equality check predicate on the "principal_id" field. It's identical to PrincipalIDEQ.
+func PrincipalID(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldEQ(FieldPrincipalID, v))
+}
+
+// ProcessDefinitionKey applies equality check predicate on the "process_definition_key" field. It's identical to ProcessDefinitionKeyEQ.
+func ProcessDefinitionKey(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldEQ(FieldProcessDefinitionKey, v))
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldEQ(FieldCategory, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldEQ(FieldTenantID, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Authorization {
+	return predicate.Authorization(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PrincipalTypeEQ applies the EQ predicate on the "principal_type" field.
+func PrincipalTypeEQ(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldEQ(FieldPrincipalType, v))
+}
+
+// PrincipalTypeNEQ applies the NEQ predicate on the "principal_type" field.
+func PrincipalTypeNEQ(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldNEQ(FieldPrincipalType, v))
+}
+
+// PrincipalTypeIn applies the In predicate on the "principal_type" field.
+func PrincipalTypeIn(vs ...string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldIn(FieldPrincipalType, vs...))
+}
+
+// PrincipalTypeNotIn applies the NotIn predicate on the "principal_type" field.
+func PrincipalTypeNotIn(vs ...string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldNotIn(FieldPrincipalType, vs...))
+}
+
+// PrincipalTypeGT applies the GT predicate on the "principal_type" field.
+func PrincipalTypeGT(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldGT(FieldPrincipalType, v))
+}
+
+// PrincipalTypeGTE applies the GTE predicate on the "principal_type" field.
+func PrincipalTypeGTE(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldGTE(FieldPrincipalType, v))
+}
+
+// PrincipalTypeLT applies the LT predicate on the "principal_type" field.
+func PrincipalTypeLT(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldLT(FieldPrincipalType, v))
+}
+
+// PrincipalTypeLTE applies the LTE predicate on the "principal_type" field.
+func PrincipalTypeLTE(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldLTE(FieldPrincipalType, v))
+}
+
+// PrincipalTypeContains applies the Contains predicate on the "principal_type" field.
+func PrincipalTypeContains(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldContains(FieldPrincipalType, v))
+}
+
+// PrincipalTypeHasPrefix applies the HasPrefix predicate on the "principal_type" field.
+func PrincipalTypeHasPrefix(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldHasPrefix(FieldPrincipalType, v))
+}
+
+// PrincipalTypeHasSuffix applies the HasSuffix predicate on the "principal_type" field.
+func PrincipalTypeHasSuffix(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldHasSuffix(FieldPrincipalType, v))
+}
+
+// PrincipalTypeEqualFold applies the EqualFold predicate on the "principal_type" field.
+func PrincipalTypeEqualFold(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldEqualFold(FieldPrincipalType, v))
+}
+
+// PrincipalTypeContainsFold applies the ContainsFold predicate on the "principal_type" field.
+func PrincipalTypeContainsFold(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldContainsFold(FieldPrincipalType, v))
+}
+
+// PrincipalIDEQ applies the EQ predicate on the "principal_id" field.
+func PrincipalIDEQ(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldEQ(FieldPrincipalID, v))
+}
+
+// PrincipalIDNEQ applies the NEQ predicate on the "principal_id" field.
+func PrincipalIDNEQ(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldNEQ(FieldPrincipalID, v))
+}
+
+// PrincipalIDIn applies the In predicate on the "principal_id" field.
+func PrincipalIDIn(vs ...string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldIn(FieldPrincipalID, vs...))
+}
+
+// PrincipalIDNotIn applies the NotIn predicate on the "principal_id" field.
+func PrincipalIDNotIn(vs ...string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldNotIn(FieldPrincipalID, vs...))
+}
+
+// PrincipalIDGT applies the GT predicate on the "principal_id" field.
+func PrincipalIDGT(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldGT(FieldPrincipalID, v))
+}
+
+// PrincipalIDGTE applies the GTE predicate on the "principal_id" field.
+func PrincipalIDGTE(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldGTE(FieldPrincipalID, v))
+}
+
+// PrincipalIDLT applies the LT predicate on the "principal_id" field.
+func PrincipalIDLT(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldLT(FieldPrincipalID, v))
+}
+
+// PrincipalIDLTE applies the LTE predicate on the "principal_id" field.
+func PrincipalIDLTE(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldLTE(FieldPrincipalID, v))
+}
+
+// PrincipalIDContains applies the Contains predicate on the "principal_id" field.
+func PrincipalIDContains(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldContains(FieldPrincipalID, v))
+}
+
+// PrincipalIDHasPrefix applies the HasPrefix predicate on the "principal_id" field.
+func PrincipalIDHasPrefix(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldHasPrefix(FieldPrincipalID, v))
+}
+
+// PrincipalIDHasSuffix applies the HasSuffix predicate on the "principal_id" field.
+func PrincipalIDHasSuffix(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldHasSuffix(FieldPrincipalID, v))
+}
+
+// PrincipalIDEqualFold applies the EqualFold predicate on the "principal_id" field.
+func PrincipalIDEqualFold(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldEqualFold(FieldPrincipalID, v))
+}
+
+// PrincipalIDContainsFold applies the ContainsFold predicate on the "principal_id" field.
+func PrincipalIDContainsFold(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldContainsFold(FieldPrincipalID, v))
+}
+
+// ProcessDefinitionKeyEQ applies the EQ predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyEQ(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldEQ(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyNEQ applies the NEQ predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyNEQ(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldNEQ(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyIn applies the In predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyIn(vs ...string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldIn(FieldProcessDefinitionKey, vs...))
+}
+
+// ProcessDefinitionKeyNotIn applies the NotIn predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyNotIn(vs ...string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldNotIn(FieldProcessDefinitionKey, vs...))
+}
+
+// ProcessDefinitionKeyGT applies the GT predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyGT(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldGT(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyGTE applies the GTE predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyGTE(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldGTE(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyLT applies the LT predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyLT(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldLT(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyLTE applies the LTE predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyLTE(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldLTE(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyContains applies the Contains predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyContains(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldContains(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyHasPrefix applies the HasPrefix predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyHasPrefix(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldHasPrefix(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyHasSuffix applies the HasSuffix predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyHasSuffix(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldHasSuffix(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyEqualFold applies the EqualFold predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyEqualFold(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldEqualFold(FieldProcessDefinitionKey, v))
+}
+
+// ProcessDefinitionKeyContainsFold applies the ContainsFold predicate on the "process_definition_key" field.
+func ProcessDefinitionKeyContainsFold(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldContainsFold(FieldProcessDefinitionKey, v))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldGT(FieldCategory, v))
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldGTE(FieldCategory, v))
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldLT(FieldCategory, v))
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldLTE(FieldCategory, v))
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldContains(FieldCategory, v))
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldHasPrefix(FieldCategory, v))
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldHasSuffix(FieldCategory, v))
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldEqualFold(FieldCategory, v))
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldContainsFold(FieldCategory, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.Authorization {
+	return predicate.Authorization(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.Authorization {
+	return predicate.Authorization(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.Authorization {
+	return predicate.Authorization(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Authorization {
+	return predicate.Authorization(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Authorization {
+	return predicate.Authorization(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Authorization {
+	return predicate.Authorization(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Authorization {
+	return predicate.Authorization(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Authorization {
+	return predicate.Authorization(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Authorization {
+	return predicate.Authorization(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Authorization {
+	return predicate.Authorization(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Authorization {
+	return predicate.Authorization(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Authorization) predicate.Authorization {
+	return predicate.Authorization(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Authorization) predicate.Authorization {
+	return predicate.Authorization(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Authorization) predicate.Authorization {
+	return predicate.Authorization(sql.NotPredicates(p))
+}
@@ -0,0 +1,376 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/authorization"
+)
+
+// AuthorizationCreate is the builder for creating a Authorization entity.
+type AuthorizationCreate struct {
+	config
+	mutation *AuthorizationMutation
+	hooks    []Hook
+}
+
+// SetPrincipalType sets the "principal_type" field.
+func (ac *AuthorizationCreate) SetPrincipalType(s string) *AuthorizationCreate {
+	ac.mutation.SetPrincipalType(s)
+	return ac
+}
+
+// SetPrincipalID sets the "principal_id" field.
+func (ac *AuthorizationCreate) SetPrincipalID(s string) *AuthorizationCreate {
+	ac.mutation.SetPrincipalID(s)
+	return ac
+}
+
+// SetProcessDefinitionKey sets the "process_definition_key" field.
+func (ac *AuthorizationCreate) SetProcessDefinitionKey(s string) *AuthorizationCreate {
+	ac.mutation.SetProcessDefinitionKey(s)
+	return ac
+}
+
+// SetNillableProcessDefinitionKey sets the "process_definition_key" field if the given value is not nil.
+func (ac *AuthorizationCreate) SetNillableProcessDefinitionKey(s *string) *AuthorizationCreate {
+	if s != nil {
+		ac.SetProcessDefinitionKey(*s)
+	}
+	return ac
+}
+
+// SetCategory sets the "category" field.
+func (ac *AuthorizationCreate) SetCategory(s string) *AuthorizationCreate {
+	ac.mutation.SetCategory(s)
+	return ac
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (ac *AuthorizationCreate) SetNillableCategory(s *string) *AuthorizationCreate {
+	if s != nil {
+		ac.SetCategory(*s)
+	}
+	return ac
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (ac *AuthorizationCreate) SetTenantID(s string) *AuthorizationCreate {
+	ac.mutation.SetTenantID(s)
+	return ac
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (ac *AuthorizationCreate) SetNillableTenantID(s *string) *AuthorizationCreate {
+	if s != nil {
+		ac.SetTenantID(*s)
+	}
+	return ac
+}
+
+// SetActions sets the "actions" field.
+func (ac *AuthorizationCreate) SetActions(s []string) *AuthorizationCreate {
+	ac.mutation.SetActions(s)
+	return ac
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ac *AuthorizationCreate) SetCreatedBy(s string) *AuthorizationCreate {
+	ac.mutation.SetCreatedBy(s)
+	return ac
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (ac *AuthorizationCreate) SetNillableCreatedBy(s *string) *AuthorizationCreate {
+	if s != nil {
+		ac.SetCreatedBy(*s)
+	}
+	return ac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ac *AuthorizationCreate) SetCreatedAt(t time.Time) *AuthorizationCreate {
+	ac.mutation.SetCreatedAt(t)
+	return ac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ac *AuthorizationCreate) SetNillableCreatedAt(t *time.Time) *AuthorizationCreate {
+	if t != nil {
+		ac.SetCreatedAt(*t)
+	}
+	return ac
+}
+
+// SetID sets the "id" field.
+func (ac *AuthorizationCreate) SetID(i int64) *AuthorizationCreate {
+	ac.mutation.SetID(i)
+	return ac
+}
+
+// Mutation returns the AuthorizationMutation object of the builder.
+func (ac *AuthorizationCreate) Mutation() *AuthorizationMutation {
+	return ac.mutation
+}
+
+// Save creates the Authorization in the database.
+func (ac *AuthorizationCreate) Save(ctx context.Context) (*Authorization, error) {
+	ac.defaults()
+	return withHooks(ctx, ac.sqlSave, ac.mutation, ac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ac *AuthorizationCreate) SaveX(ctx context.Context) *Authorization {
+	v, err := ac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ac *AuthorizationCreate) Exec(ctx context.Context) error {
+	_, err := ac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ac *AuthorizationCreate) ExecX(ctx context.Context) {
+	if err := ac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ac *AuthorizationCreate) defaults() {
+	if _, ok := ac.mutation.ProcessDefinitionKey(); !ok {
+		v := authorization.DefaultProcessDefinitionKey
+		ac.mutation.SetProcessDefinitionKey(v)
+	}
+	if _, ok := ac.mutation.Category(); !ok {
+		v := authorization.DefaultCategory
+		ac.mutation.SetCategory(v)
+	}
+	if _, ok := ac.mutation.TenantID(); !ok {
+		v := authorization.DefaultTenantID
+		ac.mutation.SetTenantID(v)
+	}
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		v := authorization.DefaultCreatedAt()
+		ac.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ac *AuthorizationCreate) check() error {
+	if _, ok := ac.mutation.PrincipalType(); !ok {
+		return &ValidationError{Name: "principal_type", err: errors.New(`ent: missing required field "Authorization.principal_type"`)}
+	}
+	if v, ok := ac.mutation.PrincipalType(); ok {
+		if err := authorization.PrincipalTypeValidator(v); err != nil {
+			return &ValidationError{Name: "principal_type", err: fmt.Errorf(`ent: validator failed for field "Authorization.principal_type": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.PrincipalID(); !ok {
+		return &ValidationError{Name: "principal_id", err: errors.New(`ent: missing required field "Authorization.principal_id"`)}
+	}
+	if v, ok := ac.mutation.PrincipalID(); ok {
+		if err := authorization.PrincipalIDValidator(v); err != nil {
+			return &ValidationError{Name: "principal_id", err: fmt.Errorf(`ent: validator failed for field "Authorization.principal_id": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.ProcessDefinitionKey(); !ok {
+		return &ValidationError{Name: "process_definition_key", err: errors.New(`ent: missing required field "Authorization.process_definition_key"`)}
+	}
+	if v, ok := ac.mutation.ProcessDefinitionKey(); ok {
+		if err := authorization.ProcessDefinitionKeyValidator(v); err != nil {
+			return &ValidationError{Name: "process_definition_key", err: fmt.Errorf(`ent: validator failed for field "Authorization.process_definition_key": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required field "Authorization.category"`)}
+	}
+	if v, ok := ac.mutation.Category(); ok {
+		if err := authorization.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Authorization.category": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Authorization.tenant_id"`)}
+	}
+	if v, ok := ac.mutation.TenantID(); ok {
+		if err := authorization.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "Authorization.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.Actions(); !ok {
+		return &ValidationError{Name: "actions", err: errors.New(`ent: missing required field "Authorization.actions"`)}
+	}
+	if v, ok := ac.mutation.CreatedBy(); ok {
+		if err := authorization.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Authorization.created_by": %w`, err)}
+		}
+	}
+	if _, ok := ac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Authorization.created_at"`)}
+	}
+	return nil
+}
+
+func (ac *AuthorizationCreate) sqlSave(ctx context.Context) (*Authorization, error) {
+	if err := ac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	ac.mutation.id = &_node.ID
+	ac.mutation.done = true
+	return _node, nil
+}
+
+func (ac *AuthorizationCreate) createSpec() (*Authorization, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Authorization{config: ac.config}
+		_spec = sqlgraph.NewCreateSpec(authorization.Table, sqlgraph.NewFieldSpec(authorization.FieldID, field.TypeInt64))
+	)
+	if id, ok := ac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ac.mutation.PrincipalType(); ok {
+		_spec.SetField(authorization.FieldPrincipalType, field.TypeString, value)
+		_node.PrincipalType = value
+	}
+	if value, ok := ac.mutation.PrincipalID(); ok {
+		_spec.SetField(authorization.FieldPrincipalID, field.TypeString, value)
+		_node.PrincipalID = value
+	}
+	if value, ok := ac.mutation.ProcessDefinitionKey(); ok {
+		_spec.SetField(authorization.FieldProcessDefinitionKey, field.TypeString, value)
+		_node.ProcessDefinitionKey = value
+	}
+	if value, ok := ac.mutation.Category(); ok {
+		_spec.SetField(authorization.FieldCategory, field.TypeString, value)
+		_node.Category = value
+	}
+	if value, ok := ac.mutation.TenantID(); ok {
+		_spec.SetField(authorization.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := ac.mutation.Actions(); ok {
+		_spec.SetField(authorization.FieldActions, field.TypeJSON, value)
+		_node.Actions = value
+	}
+	if value, ok := ac.mutation.CreatedBy(); ok {
+		_spec.SetField(authorization.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := ac.mutation.CreatedAt(); ok {
+		_spec.SetField(authorization.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AuthorizationCreateBulk is the builder for creating many Authorization entities in bulk.
+type AuthorizationCreateBulk struct {
+	config
+	err      error
+	builders []*AuthorizationCreate
+}
+
+// Save creates the Authorization entities in the database.
+func (acb *AuthorizationCreateBulk) Save(ctx context.Context) ([]*Authorization, error) {
+	if acb.err != nil {
+		return nil, acb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(acb.builders))
+	nodes := make([]*Authorization, len(acb.builders))
+	mutators := make([]Mutator, len(acb.builders))
+	for i := range acb.builders {
+		func(i int, root context.Context) {
+			builder := acb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuthorizationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, acb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, acb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, acb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acb *AuthorizationCreateBulk) SaveX(ctx context.Context) []*Authorization {
+	v, err := acb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acb *AuthorizationCreateBulk) Exec(ctx context.Context) error {
+	_, err := acb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acb *AuthorizationCreateBulk) ExecX(ctx context.Context) {
+	if err := acb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/authorization"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/predicate"
+)
+
+// AuthorizationDelete is the builder for deleting a Authorization entity.
+type AuthorizationDelete struct {
+	config
+	hooks    []Hook
+	mutation *AuthorizationMutation
+}
+
+// Where appends a list predicates to the AuthorizationDelete builder.
+func (ad *AuthorizationDelete) Where(ps ...predicate.Authorization) *AuthorizationDelete {
+	ad.mutation.Where(ps...)
+	return ad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ad *AuthorizationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ad.sqlExec, ad.mutation, ad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ad *AuthorizationDelete) ExecX(ctx context.Context) int {
+	n, err := ad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ad *AuthorizationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(authorization.Table, sqlgraph.NewFieldSpec(authorization.FieldID, field.TypeInt64))
+	if ps := ad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ad.mutation.done = true
+	return affected, err
+}
+
+// AuthorizationDeleteOne is the builder for deleting a single Authorization entity.
+type AuthorizationDeleteOne struct {
+	ad *AuthorizationDelete
+}
+
+// Where appends a list predicates to the AuthorizationDelete builder.
+func (ado *AuthorizationDeleteOne) Where(ps ...predicate.Authorization) *AuthorizationDeleteOne {
+	ado.ad.mutation.Where(ps...)
+	return ado
+}
+
+// Exec executes the deletion query.
+func (ado *AuthorizationDeleteOne) Exec(ctx context.Context) error {
+	n, err := ado.ad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{authorization.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ado *AuthorizationDeleteOne) ExecX(ctx context.Context) {
+	if err := ado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,222 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/authorization"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/predicate"
+)
+
+// AuthorizationUpdate is the builder for updating Authorization entities.
+type AuthorizationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuthorizationMutation
+}
+
+// Where appends a list predicates to the AuthorizationUpdate builder.
+func (au *AuthorizationUpdate) Where(ps ...predicate.Authorization) *AuthorizationUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetActions sets the "actions" field.
+func (au *AuthorizationUpdate) SetActions(s []string) *AuthorizationUpdate {
+	au.mutation.SetActions(s)
+	return au
+}
+
+// AppendActions appends s to the "actions" field.
+func (au *AuthorizationUpdate) AppendActions(s []string) *AuthorizationUpdate {
+	au.mutation.AppendActions(s)
+	return au
+}
+
+// Mutation returns the AuthorizationMutation object of the builder.
+func (au *AuthorizationUpdate) Mutation() *AuthorizationMutation {
+	return au.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AuthorizationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AuthorizationUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AuthorizationUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AuthorizationUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (au *AuthorizationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(authorization.Table, authorization.Columns, sqlgraph.NewFieldSpec(authorization.FieldID, field.TypeInt64))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.Actions(); ok {
+		_spec.SetField(authorization.FieldActions, field.TypeJSON, value)
+	}
+	if value, ok := au.mutation.AppendedActions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, authorization.FieldActions, value)
+		})
+	}
+	if au.mutation.CreatedByCleared() {
+		_spec.ClearField(authorization.FieldCreatedBy, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{authorization.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AuthorizationUpdateOne is the builder for updating a single Authorization entity.
+type AuthorizationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuthorizationMutation
+}
+
+// SetActions sets the "actions" field.
+func (auo *AuthorizationUpdateOne) SetActions(s []string) *AuthorizationUpdateOne {
+	auo.mutation.SetActions(s)
+	return auo
+}
+
+// AppendActions appends s to the "actions" field.
+func (auo *AuthorizationUpdateOne) AppendActions(s []string) *AuthorizationUpdateOne {
+	auo.mutation.AppendActions(s)
+	return auo
+}
+
+// Mutation returns the AuthorizationMutation object of the builder.
+func (auo *AuthorizationUpdateOne) Mutation() *AuthorizationMutation {
+	return auo.mutation
+}
+
+// Where appends a list predicates to the AuthorizationUpdate builder.
+func (auo *AuthorizationUpdateOne) Where(ps ...predicate.Authorization) *AuthorizationUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AuthorizationUpdateOne) Select(field string, fields ...string) *AuthorizationUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Authorization entity.
+func (auo *AuthorizationUpdateOne) Save(ctx context.Context) (*Authorization, error) {
+	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AuthorizationUpdateOne) SaveX(ctx context.Context) *Authorization {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AuthorizationUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AuthorizationUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (auo *AuthorizationUpdateOne) sqlSave(ctx context.Context) (_node *Authorization, err error) {
+	_spec := sqlgraph.NewUpdateSpec(authorization.Table, authorization.Columns, sqlgraph.NewFieldSpec(authorization.FieldID, field.TypeInt64))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Authorization.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, authorization.FieldID)
+		for _, f := range fields {
+			if !authorization.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != authorization.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.Actions(); ok {
+		_spec.SetField(authorization.FieldActions, field.TypeJSON, value)
+	}
+	if value, ok := auo.mutation.AppendedActions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, authorization.FieldActions, value)
+		})
+	}
+	if auo.mutation.CreatedByCleared() {
+		_spec.ClearField(authorization.FieldCreatedBy, field.TypeString)
+	}
+	_node = &Authorization{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{authorization.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
@@ -14,12 +14,20 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/authorization"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/group"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/historicprocessinstance"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/processdefinition"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/processevent"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/processinstance"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/processvariable"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/refreshtoken"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/role"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskattachment"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskcomment"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskidentitylink"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskinstance"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/user"
 )
 
 // Client is the client that holds all ent builders.
@@ -27,6 +35,10 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Authorization is the client for interacting with the Authorization builders.
+	Authorization *AuthorizationClient
+	// Group is the client for interacting with the Group builders.
+	Group *GroupClient
 	// HistoricProcessInstance is the client for interacting with the HistoricProcessInstance builders.
 	HistoricProcessInstance *HistoricProcessInstanceClient
 	// ProcessDefinition is the client for interacting with the ProcessDefinition builders.
@@ -37,8 +49,20 @@ type Client struct {
 	ProcessInstance *ProcessInstanceClient
 	// ProcessVariable is the client for interacting with the ProcessVariable builders.
 	ProcessVariable *ProcessVariableClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
+	// Role is the client for interacting with the Role builders.
+	Role *RoleClient
+	// TaskAttachment is the client for interacting with the TaskAttachment builders.
+	TaskAttachment *TaskAttachmentClient
+	// TaskComment is the client for interacting with the TaskComment builders.
+	TaskComment *TaskCommentClient
+	// TaskIdentityLink is the client for interacting with the TaskIdentityLink builders.
+	TaskIdentityLink *TaskIdentityLinkClient
 	// TaskInstance is the client for interacting with the TaskInstance builders.
 	TaskInstance *TaskInstanceClient
+	// User is the client for interacting with the User builders.
+	User *UserClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -50,12 +74,20 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Authorization = NewAuthorizationClient(c.config)
+	c.Group = NewGroupClient(c.config)
 	c.HistoricProcessInstance = NewHistoricProcessInstanceClient(c.config)
 	c.ProcessDefinition = NewProcessDefinitionClient(c.config)
 	c.ProcessEvent = NewProcessEventClient(c.config)
 	c.ProcessInstance = NewProcessInstanceClient(c.config)
 	c.ProcessVariable = NewProcessVariableClient(c.config)
+	c.RefreshToken = NewRefreshTokenClient(c.config)
+	c.Role = NewRoleClient(c.config)
+	c.TaskAttachment = NewTaskAttachmentClient(c.config)
+	c.TaskComment = NewTaskCommentClient(c.config)
+	c.TaskIdentityLink = NewTaskIdentityLinkClient(c.config)
 	c.TaskInstance = NewTaskInstanceClient(c.config)
+	c.User = NewUserClient(c.config)
 }
 
 type (
@@ -148,12 +180,20 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                     ctx,
 		config:                  cfg,
+		Authorization:           NewAuthorizationClient(cfg),
+		Group:                   NewGroupClient(cfg),
 		HistoricProcessInstance: NewHistoricProcessInstanceClient(cfg),
 		ProcessDefinition:       NewProcessDefinitionClient(cfg),
 		ProcessEvent:            NewProcessEventClient(cfg),
 		ProcessInstance:         NewProcessInstanceClient(cfg),
 		ProcessVariable:         NewProcessVariableClient(cfg),
+		RefreshToken:            NewRefreshTokenClient(cfg),
+		Role:                    NewRoleClient(cfg),
+		TaskAttachment:          NewTaskAttachmentClient(cfg),
+		TaskComment:             NewTaskCommentClient(cfg),
+		TaskIdentityLink:        NewTaskIdentityLinkClient(cfg),
 		TaskInstance:            NewTaskInstanceClient(cfg),
+		User:                    NewUserClient(cfg),
 	}, nil
 }
 
@@ -173,19 +213,27 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                     ctx,
 		config:                  cfg,
+		Authorization:           NewAuthorizationClient(cfg),
+		Group:                   NewGroupClient(cfg),
 		HistoricProcessInstance: NewHistoricProcessInstanceClient(cfg),
 		ProcessDefinition:       NewProcessDefinitionClient(cfg),
 		ProcessEvent:            NewProcessEventClient(cfg),
 		ProcessInstance:         NewProcessInstanceClient(cfg),
 		ProcessVariable:         NewProcessVariableClient(cfg),
+		RefreshToken:            NewRefreshTokenClient(cfg),
+		Role:                    NewRoleClient(cfg),
+		TaskAttachment:          NewTaskAttachmentClient(cfg),
+		TaskComment:             NewTaskCommentClient(cfg),
+		TaskIdentityLink:        NewTaskIdentityLinkClient(cfg),
 		TaskInstance:            NewTaskInstanceClient(cfg),
+		User:                    NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		HistoricProcessInstance.
+//		Authorization.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -208,8 +256,9 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.HistoricProcessInstance, c.ProcessDefinition, c.ProcessEvent,
-		c.ProcessInstance, c.ProcessVariable, c.TaskInstance,
+		c.Authorization, c.Group, c.HistoricProcessInstance, c.ProcessDefinition,
+		c.ProcessEvent, c.ProcessInstance, c.ProcessVariable, c.RefreshToken, c.Role,
+		c.TaskAttachment, c.TaskComment, c.TaskIdentityLink, c.TaskInstance, c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -219,8 +268,9 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.HistoricProcessInstance, c.ProcessDefinition, c.ProcessEvent,
-		c.ProcessInstance, c.ProcessVariable, c.TaskInstance,
+		c.Authorization, c.Group, c.HistoricProcessInstance, c.ProcessDefinition,
+		c.ProcessEvent, c.ProcessInstance, c.ProcessVariable, c.RefreshToken, c.Role,
+		c.TaskAttachment, c.TaskComment, c.TaskIdentityLink, c.TaskInstance, c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -229,6 +279,10 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AuthorizationMutation:
+		return c.Authorization.mutate(ctx, m)
+	case *GroupMutation:
+		return c.Group.mutate(ctx, m)
 	case *HistoricProcessInstanceMutation:
 		return c.HistoricProcessInstance.mutate(ctx, m)
 	case *ProcessDefinitionMutation:
@@ -239,13 +293,291 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.ProcessInstance.mutate(ctx, m)
 	case *ProcessVariableMutation:
 		return c.ProcessVariable.mutate(ctx, m)
+	case *RefreshTokenMutation:
+		return c.RefreshToken.mutate(ctx, m)
+	case *RoleMutation:
+		return c.Role.mutate(ctx, m)
+	case *TaskAttachmentMutation:
+		return c.TaskAttachment.mutate(ctx, m)
+	case *TaskCommentMutation:
+		return c.TaskComment.mutate(ctx, m)
+	case *TaskIdentityLinkMutation:
+		return c.TaskIdentityLink.mutate(ctx, m)
 	case *TaskInstanceMutation:
 		return c.TaskInstance.mutate(ctx, m)
+	case *UserMutation:
+		return c.User.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// AuthorizationClient is a client for the Authorization schema.
+type AuthorizationClient struct {
+	config
+}
+
+// NewAuthorizationClient returns a client for the Authorization from the given config.
+func NewAuthorizationClient(c config) *AuthorizationClient {
+	return &AuthorizationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `authorization.Hooks(f(g(h())))`.
+func (c *AuthorizationClient) Use(hooks ...Hook) {
+	c.hooks.Authorization = append(c.hooks.Authorization, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `authorization.Intercept(f(g(h())))`.
+func (c *AuthorizationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Authorization = append(c.inters.Authorization, interceptors...)
+}
+
+// Create returns a builder for creating a Authorization entity.
+func (c *AuthorizationClient) Create() *AuthorizationCreate {
+	mutation := newAuthorizationMutation(c.config, OpCreate)
+	return &AuthorizationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Authorization entities.
+func (c *AuthorizationClient) CreateBulk(builders ...*AuthorizationCreate) *AuthorizationCreateBulk {
+	return &AuthorizationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuthorizationClient) MapCreateBulk(slice any, setFunc func(*AuthorizationCreate, int)) *AuthorizationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuthorizationCreateBulk{err: fmt.Errorf("calling to AuthorizationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuthorizationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuthorizationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Authorization.
+func (c *AuthorizationClient) Update() *AuthorizationUpdate {
+	mutation := newAuthorizationMutation(c.config, OpUpdate)
+	return &AuthorizationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuthorizationClient) UpdateOne(a *Authorization) *AuthorizationUpdateOne {
+	mutation := newAuthorizationMutation(c.config, OpUpdateOne, withAuthorization(a))
+	return &AuthorizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuthorizationClient) UpdateOneID(id int64) *AuthorizationUpdateOne {
+	mutation := newAuthorizationMutation(c.config, OpUpdateOne, withAuthorizationID(id))
+	return &AuthorizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Authorization.
+func (c *AuthorizationClient) Delete() *AuthorizationDelete {
+	mutation := newAuthorizationMutation(c.config, OpDelete)
+	return &AuthorizationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuthorizationClient) DeleteOne(a *Authorization) *AuthorizationDeleteOne {
+	return c.DeleteOneID(a.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuthorizationClient) DeleteOneID(id int64) *AuthorizationDeleteOne {
+	builder := c.Delete().Where(authorization.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuthorizationDeleteOne{builder}
+}
+
+// Query returns a query builder for Authorization.
+func (c *AuthorizationClient) Query() *AuthorizationQuery {
+	return &AuthorizationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuthorization},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Authorization entity by its id.
+func (c *AuthorizationClient) Get(ctx context.Context, id int64) (*Authorization, error) {
+	return c.Query().Where(authorization.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuthorizationClient) GetX(ctx context.Context, id int64) *Authorization {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuthorizationClient) Hooks() []Hook {
+	return c.hooks.Authorization
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuthorizationClient) Interceptors() []Interceptor {
+	return c.inters.Authorization
+}
+
+func (c *AuthorizationClient) mutate(ctx context.Context, m *AuthorizationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuthorizationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuthorizationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuthorizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuthorizationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Authorization mutation op: %q", m.Op())
+	}
+}
+
+// GroupClient is a client for the Group schema.
+type GroupClient struct {
+	config
+}
+
+// NewGroupClient returns a client for the Group from the given config.
+func NewGroupClient(c config) *GroupClient {
+	return &GroupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `group.Hooks(f(g(h())))`.
+func (c *GroupClient) Use(hooks ...Hook) {
+	c.hooks.Group = append(c.hooks.Group, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `group.Intercept(f(g(h())))`.
+func (c *GroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Group = append(c.inters.Group, interceptors...)
+}
+
+// Create returns a builder for creating a Group entity.
+func (c *GroupClient) Create() *GroupCreate {
+	mutation := newGroupMutation(c.config, OpCreate)
+	return &GroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Group entities.
+func (c *GroupClient) CreateBulk(builders ...*GroupCreate) *GroupCreateBulk {
+	return &GroupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupClient) MapCreateBulk(slice any, setFunc func(*GroupCreate, int)) *GroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupCreateBulk{err: fmt.Errorf("calling to GroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Group.
+func (c *GroupClient) Update() *GroupUpdate {
+	mutation := newGroupMutation(c.config, OpUpdate)
+	return &GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GroupClient) UpdateOne(gr *Group) *GroupUpdateOne {
+	mutation := newGroupMutation(c.config, OpUpdateOne, withGroup(gr))
+	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GroupClient) UpdateOneID(id int64) *GroupUpdateOne {
+	mutation := newGroupMutation(c.config, OpUpdateOne, withGroupID(id))
+	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Group.
+func (c *GroupClient) Delete() *GroupDelete {
+	mutation := newGroupMutation(c.config, OpDelete)
+	return &GroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GroupClient) DeleteOne(gr *Group) *GroupDeleteOne {
+	return c.DeleteOneID(gr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GroupClient) DeleteOneID(id int64) *GroupDeleteOne {
+	builder := c.Delete().Where(group.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GroupDeleteOne{builder}
+}
+
+// Query returns a query builder for Group.
+func (c *GroupClient) Query() *GroupQuery {
+	return &GroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Group entity by its id.
+func (c *GroupClient) Get(ctx context.Context, id int64) (*Group, error) {
+	return c.Query().Where(group.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GroupClient) GetX(ctx context.Context, id int64) *Group {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GroupClient) Hooks() []Hook {
+	return c.hooks.Group
+}
+
+// Interceptors returns the client interceptors.
+func (c *GroupClient) Interceptors() []Interceptor {
+	return c.inters.Group
+}
+
+func (c *GroupClient) mutate(ctx context.Context, m *GroupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Group mutation op: %q", m.Op())
+	}
+}
+
 // HistoricProcessInstanceClient is a client for the HistoricProcessInstance schema.
 type HistoricProcessInstanceClient struct {
 	config
@@ -911,107 +1243,107 @@ func (c *ProcessVariableClient) mutate(ctx context.Context, m *ProcessVariableMu
 	}
 }
 
-// TaskInstanceClient is a client for the TaskInstance schema.
-type TaskInstanceClient struct {
+// RefreshTokenClient is a client for the RefreshToken schema.
+type RefreshTokenClient struct {
 	config
 }
 
-// NewTaskInstanceClient returns a client for the TaskInstance from the given config.
-func NewTaskInstanceClient(c config) *TaskInstanceClient {
-	return &TaskInstanceClient{config: c}
+// NewRefreshTokenClient returns a client for the RefreshToken from the given config.
+func NewRefreshTokenClient(c config) *RefreshTokenClient {
+	return &RefreshTokenClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `taskinstance.Hooks(f(g(h())))`.
-func (c *TaskInstanceClient) Use(hooks ...Hook) {
-	c.hooks.TaskInstance = append(c.hooks.TaskInstance, hooks...)
+// A call to `Use(f, g, h)` equals to `refreshtoken.Hooks(f(g(h())))`.
+func (c *RefreshTokenClient) Use(hooks ...Hook) {
+	c.hooks.RefreshToken = append(c.hooks.RefreshToken, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `taskinstance.Intercept(f(g(h())))`.
-func (c *TaskInstanceClient) Intercept(interceptors ...Interceptor) {
-	c.inters.TaskInstance = append(c.inters.TaskInstance, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `refreshtoken.Intercept(f(g(h())))`.
+func (c *RefreshTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RefreshToken = append(c.inters.RefreshToken, interceptors...)
 }
 
-// Create returns a builder for creating a TaskInstance entity.
-func (c *TaskInstanceClient) Create() *TaskInstanceCreate {
-	mutation := newTaskInstanceMutation(c.config, OpCreate)
-	return &TaskInstanceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a RefreshToken entity.
+func (c *RefreshTokenClient) Create() *RefreshTokenCreate {
+	mutation := newRefreshTokenMutation(c.config, OpCreate)
+	return &RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of TaskInstance entities.
-func (c *TaskInstanceClient) CreateBulk(builders ...*TaskInstanceCreate) *TaskInstanceCreateBulk {
-	return &TaskInstanceCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of RefreshToken entities.
+func (c *RefreshTokenClient) CreateBulk(builders ...*RefreshTokenCreate) *RefreshTokenCreateBulk {
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TaskInstanceClient) MapCreateBulk(slice any, setFunc func(*TaskInstanceCreate, int)) *TaskInstanceCreateBulk {
+func (c *RefreshTokenClient) MapCreateBulk(slice any, setFunc func(*RefreshTokenCreate, int)) *RefreshTokenCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TaskInstanceCreateBulk{err: fmt.Errorf("calling to TaskInstanceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &RefreshTokenCreateBulk{err: fmt.Errorf("calling to RefreshTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TaskInstanceCreate, rv.Len())
+	builders := make([]*RefreshTokenCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TaskInstanceCreateBulk{config: c.config, builders: builders}
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for TaskInstance.
-func (c *TaskInstanceClient) Update() *TaskInstanceUpdate {
-	mutation := newTaskInstanceMutation(c.config, OpUpdate)
-	return &TaskInstanceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for RefreshToken.
+func (c *RefreshTokenClient) Update() *RefreshTokenUpdate {
+	mutation := newRefreshTokenMutation(c.config, OpUpdate)
+	return &RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TaskInstanceClient) UpdateOne(ti *TaskInstance) *TaskInstanceUpdateOne {
-	mutation := newTaskInstanceMutation(c.config, OpUpdateOne, withTaskInstance(ti))
-	return &TaskInstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RefreshTokenClient) UpdateOne(rt *RefreshToken) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshToken(rt))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TaskInstanceClient) UpdateOneID(id int64) *TaskInstanceUpdateOne {
-	mutation := newTaskInstanceMutation(c.config, OpUpdateOne, withTaskInstanceID(id))
-	return &TaskInstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RefreshTokenClient) UpdateOneID(id int64) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshTokenID(id))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for TaskInstance.
-func (c *TaskInstanceClient) Delete() *TaskInstanceDelete {
-	mutation := newTaskInstanceMutation(c.config, OpDelete)
-	return &TaskInstanceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for RefreshToken.
+func (c *RefreshTokenClient) Delete() *RefreshTokenDelete {
+	mutation := newRefreshTokenMutation(c.config, OpDelete)
+	return &RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TaskInstanceClient) DeleteOne(ti *TaskInstance) *TaskInstanceDeleteOne {
-	return c.DeleteOneID(ti.ID)
+func (c *RefreshTokenClient) DeleteOne(rt *RefreshToken) *RefreshTokenDeleteOne {
+	return c.DeleteOneID(rt.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TaskInstanceClient) DeleteOneID(id int64) *TaskInstanceDeleteOne {
-	builder := c.Delete().Where(taskinstance.ID(id))
+func (c *RefreshTokenClient) DeleteOneID(id int64) *RefreshTokenDeleteOne {
+	builder := c.Delete().Where(refreshtoken.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TaskInstanceDeleteOne{builder}
+	return &RefreshTokenDeleteOne{builder}
 }
 
-// Query returns a query builder for TaskInstance.
-func (c *TaskInstanceClient) Query() *TaskInstanceQuery {
-	return &TaskInstanceQuery{
+// Query returns a query builder for RefreshToken.
+func (c *RefreshTokenClient) Query() *RefreshTokenQuery {
+	return &RefreshTokenQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTaskInstance},
+		ctx:    &QueryContext{Type: TypeRefreshToken},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a TaskInstance entity by its id.
-func (c *TaskInstanceClient) Get(ctx context.Context, id int64) (*TaskInstance, error) {
-	return c.Query().Where(taskinstance.ID(id)).Only(ctx)
+// Get returns a RefreshToken entity by its id.
+func (c *RefreshTokenClient) Get(ctx context.Context, id int64) (*RefreshToken, error) {
+	return c.Query().Where(refreshtoken.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TaskInstanceClient) GetX(ctx context.Context, id int64) *TaskInstance {
+func (c *RefreshTokenClient) GetX(ctx context.Context, id int64) *RefreshToken {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1020,38 +1352,838 @@ func (c *TaskInstanceClient) GetX(ctx context.Context, id int64) *TaskInstance {
 }
 
 // Hooks returns the client hooks.
-func (c *TaskInstanceClient) Hooks() []Hook {
-	return c.hooks.TaskInstance
+func (c *RefreshTokenClient) Hooks() []Hook {
+	return c.hooks.RefreshToken
 }
 
 // Interceptors returns the client interceptors.
-func (c *TaskInstanceClient) Interceptors() []Interceptor {
-	return c.inters.TaskInstance
+func (c *RefreshTokenClient) Interceptors() []Interceptor {
+	return c.inters.RefreshToken
 }
 
-func (c *TaskInstanceClient) mutate(ctx context.Context, m *TaskInstanceMutation) (Value, error) {
+func (c *RefreshTokenClient) mutate(ctx context.Context, m *RefreshTokenMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TaskInstanceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TaskInstanceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TaskInstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TaskInstanceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown TaskInstance mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown RefreshToken mutation op: %q", m.Op())
+	}
+}
+
+// RoleClient is a client for the Role schema.
+type RoleClient struct {
+	config
+}
+
+// NewRoleClient returns a client for the Role from the given config.
+func NewRoleClient(c config) *RoleClient {
+	return &RoleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `role.Hooks(f(g(h())))`.
+func (c *RoleClient) Use(hooks ...Hook) {
+	c.hooks.Role = append(c.hooks.Role, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `role.Intercept(f(g(h())))`.
+func (c *RoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Role = append(c.inters.Role, interceptors...)
+}
+
+// Create returns a builder for creating a Role entity.
+func (c *RoleClient) Create() *RoleCreate {
+	mutation := newRoleMutation(c.config, OpCreate)
+	return &RoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Role entities.
+func (c *RoleClient) CreateBulk(builders ...*RoleCreate) *RoleCreateBulk {
+	return &RoleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoleClient) MapCreateBulk(slice any, setFunc func(*RoleCreate, int)) *RoleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoleCreateBulk{err: fmt.Errorf("calling to RoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Role.
+func (c *RoleClient) Update() *RoleUpdate {
+	mutation := newRoleMutation(c.config, OpUpdate)
+	return &RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoleClient) UpdateOne(r *Role) *RoleUpdateOne {
+	mutation := newRoleMutation(c.config, OpUpdateOne, withRole(r))
+	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoleClient) UpdateOneID(id int64) *RoleUpdateOne {
+	mutation := newRoleMutation(c.config, OpUpdateOne, withRoleID(id))
+	return &RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Role.
+func (c *RoleClient) Delete() *RoleDelete {
+	mutation := newRoleMutation(c.config, OpDelete)
+	return &RoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoleClient) DeleteOne(r *Role) *RoleDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoleClient) DeleteOneID(id int64) *RoleDeleteOne {
+	builder := c.Delete().Where(role.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoleDeleteOne{builder}
+}
+
+// Query returns a query builder for Role.
+func (c *RoleClient) Query() *RoleQuery {
+	return &RoleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRole},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Role entity by its id.
+func (c *RoleClient) Get(ctx context.Context, id int64) (*Role, error) {
+	return c.Query().Where(role.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoleClient) GetX(ctx context.Context, id int64) *Role {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RoleClient) Hooks() []Hook {
+	return c.hooks.Role
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoleClient) Interceptors() []Interceptor {
+	return c.inters.Role
+}
+
+func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Role mutation op: %q", m.Op())
+	}
+}
+
+// TaskAttachmentClient is a client for the TaskAttachment schema.
+type TaskAttachmentClient struct {
+	config
+}
+
+// NewTaskAttachmentClient returns a client for the TaskAttachment from the given config.
+func NewTaskAttachmentClient(c config) *TaskAttachmentClient {
+	return &TaskAttachmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskattachment.Hooks(f(g(h())))`.
+func (c *TaskAttachmentClient) Use(hooks ...Hook) {
+	c.hooks.TaskAttachment = append(c.hooks.TaskAttachment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskattachment.Intercept(f(g(h())))`.
+func (c *TaskAttachmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskAttachment = append(c.inters.TaskAttachment, interceptors...)
+}
+
+// Create returns a builder for creating a TaskAttachment entity.
+func (c *TaskAttachmentClient) Create() *TaskAttachmentCreate {
+	mutation := newTaskAttachmentMutation(c.config, OpCreate)
+	return &TaskAttachmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskAttachment entities.
+func (c *TaskAttachmentClient) CreateBulk(builders ...*TaskAttachmentCreate) *TaskAttachmentCreateBulk {
+	return &TaskAttachmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskAttachmentClient) MapCreateBulk(slice any, setFunc func(*TaskAttachmentCreate, int)) *TaskAttachmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskAttachmentCreateBulk{err: fmt.Errorf("calling to TaskAttachmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskAttachmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskAttachmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskAttachment.
+func (c *TaskAttachmentClient) Update() *TaskAttachmentUpdate {
+	mutation := newTaskAttachmentMutation(c.config, OpUpdate)
+	return &TaskAttachmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskAttachmentClient) UpdateOne(ta *TaskAttachment) *TaskAttachmentUpdateOne {
+	mutation := newTaskAttachmentMutation(c.config, OpUpdateOne, withTaskAttachment(ta))
+	return &TaskAttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskAttachmentClient) UpdateOneID(id int64) *TaskAttachmentUpdateOne {
+	mutation := newTaskAttachmentMutation(c.config, OpUpdateOne, withTaskAttachmentID(id))
+	return &TaskAttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskAttachment.
+func (c *TaskAttachmentClient) Delete() *TaskAttachmentDelete {
+	mutation := newTaskAttachmentMutation(c.config, OpDelete)
+	return &TaskAttachmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskAttachmentClient) DeleteOne(ta *TaskAttachment) *TaskAttachmentDeleteOne {
+	return c.DeleteOneID(ta.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskAttachmentClient) DeleteOneID(id int64) *TaskAttachmentDeleteOne {
+	builder := c.Delete().Where(taskattachment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskAttachmentDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskAttachment.
+func (c *TaskAttachmentClient) Query() *TaskAttachmentQuery {
+	return &TaskAttachmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskAttachment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskAttachment entity by its id.
+func (c *TaskAttachmentClient) Get(ctx context.Context, id int64) (*TaskAttachment, error) {
+	return c.Query().Where(taskattachment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskAttachmentClient) GetX(ctx context.Context, id int64) *TaskAttachment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TaskAttachmentClient) Hooks() []Hook {
+	return c.hooks.TaskAttachment
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskAttachmentClient) Interceptors() []Interceptor {
+	return c.inters.TaskAttachment
+}
+
+func (c *TaskAttachmentClient) mutate(ctx context.Context, m *TaskAttachmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskAttachmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskAttachmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskAttachmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskAttachmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskAttachment mutation op: %q", m.Op())
+	}
+}
+
+// TaskCommentClient is a client for the TaskComment schema.
+type TaskCommentClient struct {
+	config
+}
+
+// NewTaskCommentClient returns a client for the TaskComment from the given config.
+func NewTaskCommentClient(c config) *TaskCommentClient {
+	return &TaskCommentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskcomment.Hooks(f(g(h())))`.
+func (c *TaskCommentClient) Use(hooks ...Hook) {
+	c.hooks.TaskComment = append(c.hooks.TaskComment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskcomment.Intercept(f(g(h())))`.
+func (c *TaskCommentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskComment = append(c.inters.TaskComment, interceptors...)
+}
+
+// Create returns a builder for creating a TaskComment entity.
+func (c *TaskCommentClient) Create() *TaskCommentCreate {
+	mutation := newTaskCommentMutation(c.config, OpCreate)
+	return &TaskCommentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskComment entities.
+func (c *TaskCommentClient) CreateBulk(builders ...*TaskCommentCreate) *TaskCommentCreateBulk {
+	return &TaskCommentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskCommentClient) MapCreateBulk(slice any, setFunc func(*TaskCommentCreate, int)) *TaskCommentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskCommentCreateBulk{err: fmt.Errorf("calling to TaskCommentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskCommentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskCommentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskComment.
+func (c *TaskCommentClient) Update() *TaskCommentUpdate {
+	mutation := newTaskCommentMutation(c.config, OpUpdate)
+	return &TaskCommentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskCommentClient) UpdateOne(tc *TaskComment) *TaskCommentUpdateOne {
+	mutation := newTaskCommentMutation(c.config, OpUpdateOne, withTaskComment(tc))
+	return &TaskCommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskCommentClient) UpdateOneID(id int64) *TaskCommentUpdateOne {
+	mutation := newTaskCommentMutation(c.config, OpUpdateOne, withTaskCommentID(id))
+	return &TaskCommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskComment.
+func (c *TaskCommentClient) Delete() *TaskCommentDelete {
+	mutation := newTaskCommentMutation(c.config, OpDelete)
+	return &TaskCommentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskCommentClient) DeleteOne(tc *TaskComment) *TaskCommentDeleteOne {
+	return c.DeleteOneID(tc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskCommentClient) DeleteOneID(id int64) *TaskCommentDeleteOne {
+	builder := c.Delete().Where(taskcomment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskCommentDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskComment.
+func (c *TaskCommentClient) Query() *TaskCommentQuery {
+	return &TaskCommentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskComment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskComment entity by its id.
+func (c *TaskCommentClient) Get(ctx context.Context, id int64) (*TaskComment, error) {
+	return c.Query().Where(taskcomment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskCommentClient) GetX(ctx context.Context, id int64) *TaskComment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TaskCommentClient) Hooks() []Hook {
+	return c.hooks.TaskComment
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskCommentClient) Interceptors() []Interceptor {
+	return c.inters.TaskComment
+}
+
+func (c *TaskCommentClient) mutate(ctx context.Context, m *TaskCommentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskCommentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskCommentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskCommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskCommentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskComment mutation op: %q", m.Op())
+	}
+}
+
+// TaskIdentityLinkClient is a client for the TaskIdentityLink schema.
+type TaskIdentityLinkClient struct {
+	config
+}
+
+// NewTaskIdentityLinkClient returns a client for the TaskIdentityLink from the given config.
+func NewTaskIdentityLinkClient(c config) *TaskIdentityLinkClient {
+	return &TaskIdentityLinkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskidentitylink.Hooks(f(g(h())))`.
+func (c *TaskIdentityLinkClient) Use(hooks ...Hook) {
+	c.hooks.TaskIdentityLink = append(c.hooks.TaskIdentityLink, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskidentitylink.Intercept(f(g(h())))`.
+func (c *TaskIdentityLinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskIdentityLink = append(c.inters.TaskIdentityLink, interceptors...)
+}
+
+// Create returns a builder for creating a TaskIdentityLink entity.
+func (c *TaskIdentityLinkClient) Create() *TaskIdentityLinkCreate {
+	mutation := newTaskIdentityLinkMutation(c.config, OpCreate)
+	return &TaskIdentityLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskIdentityLink entities.
+func (c *TaskIdentityLinkClient) CreateBulk(builders ...*TaskIdentityLinkCreate) *TaskIdentityLinkCreateBulk {
+	return &TaskIdentityLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskIdentityLinkClient) MapCreateBulk(slice any, setFunc func(*TaskIdentityLinkCreate, int)) *TaskIdentityLinkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskIdentityLinkCreateBulk{err: fmt.Errorf("calling to TaskIdentityLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskIdentityLinkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskIdentityLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskIdentityLink.
+func (c *TaskIdentityLinkClient) Update() *TaskIdentityLinkUpdate {
+	mutation := newTaskIdentityLinkMutation(c.config, OpUpdate)
+	return &TaskIdentityLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskIdentityLinkClient) UpdateOne(til *TaskIdentityLink) *TaskIdentityLinkUpdateOne {
+	mutation := newTaskIdentityLinkMutation(c.config, OpUpdateOne, withTaskIdentityLink(til))
+	return &TaskIdentityLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskIdentityLinkClient) UpdateOneID(id int64) *TaskIdentityLinkUpdateOne {
+	mutation := newTaskIdentityLinkMutation(c.config, OpUpdateOne, withTaskIdentityLinkID(id))
+	return &TaskIdentityLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskIdentityLink.
+func (c *TaskIdentityLinkClient) Delete() *TaskIdentityLinkDelete {
+	mutation := newTaskIdentityLinkMutation(c.config, OpDelete)
+	return &TaskIdentityLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskIdentityLinkClient) DeleteOne(til *TaskIdentityLink) *TaskIdentityLinkDeleteOne {
+	return c.DeleteOneID(til.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskIdentityLinkClient) DeleteOneID(id int64) *TaskIdentityLinkDeleteOne {
+	builder := c.Delete().Where(taskidentitylink.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskIdentityLinkDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskIdentityLink.
+func (c *TaskIdentityLinkClient) Query() *TaskIdentityLinkQuery {
+	return &TaskIdentityLinkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskIdentityLink},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskIdentityLink entity by its id.
+func (c *TaskIdentityLinkClient) Get(ctx context.Context, id int64) (*TaskIdentityLink, error) {
+	return c.Query().Where(taskidentitylink.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskIdentityLinkClient) GetX(ctx context.Context, id int64) *TaskIdentityLink {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TaskIdentityLinkClient) Hooks() []Hook {
+	return c.hooks.TaskIdentityLink
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskIdentityLinkClient) Interceptors() []Interceptor {
+	return c.inters.TaskIdentityLink
+}
+
+func (c *TaskIdentityLinkClient) mutate(ctx context.Context, m *TaskIdentityLinkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskIdentityLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskIdentityLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskIdentityLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskIdentityLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskIdentityLink mutation op: %q", m.Op())
+	}
+}
+
+// TaskInstanceClient is a client for the TaskInstance schema.
+type TaskInstanceClient struct {
+	config
+}
+
+// NewTaskInstanceClient returns a client for the TaskInstance from the given config.
+func NewTaskInstanceClient(c config) *TaskInstanceClient {
+	return &TaskInstanceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskinstance.Hooks(f(g(h())))`.
+func (c *TaskInstanceClient) Use(hooks ...Hook) {
+	c.hooks.TaskInstance = append(c.hooks.TaskInstance, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskinstance.Intercept(f(g(h())))`.
+func (c *TaskInstanceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskInstance = append(c.inters.TaskInstance, interceptors...)
+}
+
+// Create returns a builder for creating a TaskInstance entity.
+func (c *TaskInstanceClient) Create() *TaskInstanceCreate {
+	mutation := newTaskInstanceMutation(c.config, OpCreate)
+	return &TaskInstanceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskInstance entities.
+func (c *TaskInstanceClient) CreateBulk(builders ...*TaskInstanceCreate) *TaskInstanceCreateBulk {
+	return &TaskInstanceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskInstanceClient) MapCreateBulk(slice any, setFunc func(*TaskInstanceCreate, int)) *TaskInstanceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskInstanceCreateBulk{err: fmt.Errorf("calling to TaskInstanceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskInstanceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskInstanceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskInstance.
+func (c *TaskInstanceClient) Update() *TaskInstanceUpdate {
+	mutation := newTaskInstanceMutation(c.config, OpUpdate)
+	return &TaskInstanceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskInstanceClient) UpdateOne(ti *TaskInstance) *TaskInstanceUpdateOne {
+	mutation := newTaskInstanceMutation(c.config, OpUpdateOne, withTaskInstance(ti))
+	return &TaskInstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskInstanceClient) UpdateOneID(id int64) *TaskInstanceUpdateOne {
+	mutation := newTaskInstanceMutation(c.config, OpUpdateOne, withTaskInstanceID(id))
+	return &TaskInstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskInstance.
+func (c *TaskInstanceClient) Delete() *TaskInstanceDelete {
+	mutation := newTaskInstanceMutation(c.config, OpDelete)
+	return &TaskInstanceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskInstanceClient) DeleteOne(ti *TaskInstance) *TaskInstanceDeleteOne {
+	return c.DeleteOneID(ti.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskInstanceClient) DeleteOneID(id int64) *TaskInstanceDeleteOne {
+	builder := c.Delete().Where(taskinstance.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskInstanceDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskInstance.
+func (c *TaskInstanceClient) Query() *TaskInstanceQuery {
+	return &TaskInstanceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskInstance},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskInstance entity by its id.
+func (c *TaskInstanceClient) Get(ctx context.Context, id int64) (*TaskInstance, error) {
+	return c.Query().Where(taskinstance.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskInstanceClient) GetX(ctx context.Context, id int64) *TaskInstance {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TaskInstanceClient) Hooks() []Hook {
+	return c.hooks.TaskInstance
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskInstanceClient) Interceptors() []Interceptor {
+	return c.inters.TaskInstance
+}
+
+func (c *TaskInstanceClient) mutate(ctx context.Context, m *TaskInstanceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskInstanceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskInstanceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskInstanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskInstanceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskInstance mutation op: %q", m.Op())
+	}
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserClient) UpdateOne(u *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(u))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserClient) UpdateOneID(id int64) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserClient) DeleteOne(u *User) *UserDeleteOne {
+	return c.DeleteOneID(u.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserClient) DeleteOneID(id int64) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDeleteOne{builder}
+}
+
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id int64) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id int64) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserClient) Hooks() []Hook {
+	return c.hooks.User
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
+func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		HistoricProcessInstance, ProcessDefinition, ProcessEvent, ProcessInstance,
-		ProcessVariable, TaskInstance []ent.Hook
+		Authorization, Group, HistoricProcessInstance, ProcessDefinition, ProcessEvent,
+		ProcessInstance, ProcessVariable, RefreshToken, Role, TaskAttachment,
+		TaskComment, TaskIdentityLink, TaskInstance, User []ent.Hook
 	}
 	inters struct {
-		HistoricProcessInstance, ProcessDefinition, ProcessEvent, ProcessInstance,
-		ProcessVariable, TaskInstance []ent.Interceptor
+		Authorization, Group, HistoricProcessInstance, ProcessDefinition, ProcessEvent,
+		ProcessInstance, ProcessVariable, RefreshToken, Role, TaskAttachment,
+		TaskComment, TaskIdentityLink, TaskInstance, User []ent.Interceptor
 	}
 )
@@ -12,12 +12,20 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/authorization"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/group"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/historicprocessinstance"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/processdefinition"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/processevent"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/processinstance"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/processvariable"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/refreshtoken"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/role"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskattachment"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskcomment"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskidentitylink"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskinstance"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/user"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -78,12 +86,20 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			authorization.Table:           authorization.ValidColumn,
+			group.Table:                   group.ValidColumn,
 			historicprocessinstance.Table: historicprocessinstance.ValidColumn,
 			processdefinition.Table:       processdefinition.ValidColumn,
 			processevent.Table:            processevent.ValidColumn,
 			processinstance.Table:         processinstance.ValidColumn,
 			processvariable.Table:         processvariable.ValidColumn,
+			refreshtoken.Table:            refreshtoken.ValidColumn,
+			role.Table:                    role.ValidColumn,
+			taskattachment.Table:          taskattachment.ValidColumn,
+			taskcomment.Table:             taskcomment.ValidColumn,
+			taskidentitylink.Table:        taskidentitylink.ValidColumn,
 			taskinstance.Table:            taskinstance.ValidColumn,
+			user.Table:                    user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -8,6 +8,52 @@ import (
 )
 
 var (
+	// AuthorizationsColumns holds the columns for the "authorizations" table.
+	AuthorizationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "principal_type", Type: field.TypeString, Size: 20},
+		{Name: "principal_id", Type: field.TypeString, Size: 100},
+		{Name: "process_definition_key", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "category", Type: field.TypeString, Size: 100, Default: ""},
+		{Name: "tenant_id", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "actions", Type: field.TypeJSON},
+		{Name: "created_by", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuthorizationsTable holds the schema information for the "authorizations" table.
+	AuthorizationsTable = &schema.Table{
+		Name:       "authorizations",
+		Columns:    AuthorizationsColumns,
+		PrimaryKey: []*schema.Column{AuthorizationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "authorization_principal_type_principal_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuthorizationsColumns[1], AuthorizationsColumns[2]},
+			},
+		},
+	}
+	// GroupsColumns holds the columns for the "groups" table.
+	GroupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// GroupsTable holds the schema information for the "groups" table.
+	GroupsTable = &schema.Table{
+		Name:       "groups",
+		Columns:    GroupsColumns,
+		PrimaryKey: []*schema.Column{GroupsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "group_name",
+				Unique:  true,
+				Columns: []*schema.Column{GroupsColumns[1]},
+			},
+		},
+	}
 	// HistoricProcessInstancesColumns holds the columns for the "historic_process_instances" table.
 	HistoricProcessInstancesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -317,6 +363,8 @@ var (
 		{Name: "callback_type", Type: field.TypeString, Nullable: true, Size: 100},
 		{Name: "reference_id", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "reference_type", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "workflow_id", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "workflow_run_id", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -386,6 +434,11 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{ProcessInstancesColumns[20], ProcessInstancesColumns[21]},
 			},
+			{
+				Name:    "processinstance_workflow_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProcessInstancesColumns[22]},
+			},
 		},
 	}
 	// ProcessVariablesColumns holds the columns for the "process_variables" table.
@@ -486,6 +539,164 @@ var (
 			},
 		},
 	}
+	// RefreshTokensColumns holds the columns for the "refresh_tokens" table.
+	RefreshTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "token_id", Type: field.TypeString, Size: 64},
+		{Name: "family_id", Type: field.TypeString, Size: 64},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "parent_token_id", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "access_token_id", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "access_expires_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// RefreshTokensTable holds the schema information for the "refresh_tokens" table.
+	RefreshTokensTable = &schema.Table{
+		Name:       "refresh_tokens",
+		Columns:    RefreshTokensColumns,
+		PrimaryKey: []*schema.Column{RefreshTokensColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "refreshtoken_token_id",
+				Unique:  true,
+				Columns: []*schema.Column{RefreshTokensColumns[1]},
+			},
+			{
+				Name:    "refreshtoken_family_id",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[2]},
+			},
+			{
+				Name:    "refreshtoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[3]},
+			},
+		},
+	}
+	// RolesColumns holds the columns for the "roles" table.
+	RolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "permissions", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// RolesTable holds the schema information for the "roles" table.
+	RolesTable = &schema.Table{
+		Name:       "roles",
+		Columns:    RolesColumns,
+		PrimaryKey: []*schema.Column{RolesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "role_name",
+				Unique:  true,
+				Columns: []*schema.Column{RolesColumns[1]},
+			},
+		},
+	}
+	// TaskAttachmentsColumns holds the columns for the "task_attachments" table.
+	TaskAttachmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "task_id", Type: field.TypeInt64},
+		{Name: "process_instance_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "content_type", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "size", Type: field.TypeInt64, Default: 0},
+		{Name: "storage", Type: field.TypeString, Size: 20, Default: "db"},
+		{Name: "location", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "content", Type: field.TypeBytes, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TaskAttachmentsTable holds the schema information for the "task_attachments" table.
+	TaskAttachmentsTable = &schema.Table{
+		Name:       "task_attachments",
+		Columns:    TaskAttachmentsColumns,
+		PrimaryKey: []*schema.Column{TaskAttachmentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taskattachment_task_id",
+				Unique:  false,
+				Columns: []*schema.Column{TaskAttachmentsColumns[1]},
+			},
+			{
+				Name:    "taskattachment_process_instance_id",
+				Unique:  false,
+				Columns: []*schema.Column{TaskAttachmentsColumns[2]},
+			},
+		},
+	}
+	// TaskCommentsColumns holds the columns for the "task_comments" table.
+	TaskCommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "task_id", Type: field.TypeInt64},
+		{Name: "process_instance_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "type", Type: field.TypeString, Size: 50, Default: "comment"},
+		{Name: "message", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TaskCommentsTable holds the schema information for the "task_comments" table.
+	TaskCommentsTable = &schema.Table{
+		Name:       "task_comments",
+		Columns:    TaskCommentsColumns,
+		PrimaryKey: []*schema.Column{TaskCommentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taskcomment_task_id",
+				Unique:  false,
+				Columns: []*schema.Column{TaskCommentsColumns[1]},
+			},
+			{
+				Name:    "taskcomment_process_instance_id",
+				Unique:  false,
+				Columns: []*schema.Column{TaskCommentsColumns[2]},
+			},
+		},
+	}
+	// TaskIdentityLinksColumns holds the columns for the "task_identity_links" table.
+	TaskIdentityLinksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "task_id", Type: field.TypeInt64},
+		{Name: "process_instance_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "type", Type: field.TypeString, Size: 50},
+		{Name: "user_id", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "group_id", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TaskIdentityLinksTable holds the schema information for the "task_identity_links" table.
+	TaskIdentityLinksTable = &schema.Table{
+		Name:       "task_identity_links",
+		Columns:    TaskIdentityLinksColumns,
+		PrimaryKey: []*schema.Column{TaskIdentityLinksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taskidentitylink_task_id",
+				Unique:  false,
+				Columns: []*schema.Column{TaskIdentityLinksColumns[1]},
+			},
+			{
+				Name:    "taskidentitylink_process_instance_id",
+				Unique:  false,
+				Columns: []*schema.Column{TaskIdentityLinksColumns[2]},
+			},
+			{
+				Name:    "taskidentitylink_type_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{TaskIdentityLinksColumns[3], TaskIdentityLinksColumns[4]},
+			},
+			{
+				Name:    "taskidentitylink_type_group_id",
+				Unique:  false,
+				Columns: []*schema.Column{TaskIdentityLinksColumns[3], TaskIdentityLinksColumns[5]},
+			},
+		},
+	}
 	// TaskInstancesColumns holds the columns for the "task_instances" table.
 	TaskInstancesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -495,13 +706,19 @@ var (
 		{Name: "assignee", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "owner", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "delegation", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "status", Type: field.TypeString, Size: 50, Default: "created"},
 		{Name: "priority", Type: field.TypeInt32, Default: 50},
 		{Name: "create_time", Type: field.TypeTime},
+		{Name: "claim_time", Type: field.TypeTime, Nullable: true},
+		{Name: "end_time", Type: field.TypeTime, Nullable: true},
+		{Name: "duration", Type: field.TypeInt64, Nullable: true},
+		{Name: "delete_reason", Type: field.TypeString, Nullable: true, Size: 500},
 		{Name: "due_date", Type: field.TypeTime, Nullable: true},
 		{Name: "follow_up_date", Type: field.TypeTime, Nullable: true},
 		{Name: "form_key", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "category", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "parent_task_id", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "wait_for_subtasks", Type: field.TypeBool, Default: false},
 		{Name: "execution_id", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "process_instance_id", Type: field.TypeInt64},
 		{Name: "process_definition_id", Type: field.TypeInt64},
@@ -533,17 +750,17 @@ var (
 			{
 				Name:    "taskinstance_process_instance_id",
 				Unique:  false,
-				Columns: []*schema.Column{TaskInstancesColumns[15]},
+				Columns: []*schema.Column{TaskInstancesColumns[21]},
 			},
 			{
 				Name:    "taskinstance_process_definition_id",
 				Unique:  false,
-				Columns: []*schema.Column{TaskInstancesColumns[16]},
+				Columns: []*schema.Column{TaskInstancesColumns[22]},
 			},
 			{
 				Name:    "taskinstance_process_definition_key",
 				Unique:  false,
-				Columns: []*schema.Column{TaskInstancesColumns[17]},
+				Columns: []*schema.Column{TaskInstancesColumns[23]},
 			},
 			{
 				Name:    "taskinstance_task_definition_key",
@@ -553,68 +770,110 @@ var (
 			{
 				Name:    "taskinstance_create_time",
 				Unique:  false,
-				Columns: []*schema.Column{TaskInstancesColumns[8]},
+				Columns: []*schema.Column{TaskInstancesColumns[9]},
 			},
 			{
 				Name:    "taskinstance_due_date",
 				Unique:  false,
-				Columns: []*schema.Column{TaskInstancesColumns[9]},
+				Columns: []*schema.Column{TaskInstancesColumns[14]},
 			},
 			{
 				Name:    "taskinstance_priority",
 				Unique:  false,
-				Columns: []*schema.Column{TaskInstancesColumns[7]},
+				Columns: []*schema.Column{TaskInstancesColumns[8]},
 			},
 			{
 				Name:    "taskinstance_suspended",
 				Unique:  false,
-				Columns: []*schema.Column{TaskInstancesColumns[21]},
+				Columns: []*schema.Column{TaskInstancesColumns[27]},
 			},
 			{
 				Name:    "taskinstance_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{TaskInstancesColumns[22]},
+				Columns: []*schema.Column{TaskInstancesColumns[28]},
 			},
 			{
 				Name:    "taskinstance_delegation",
 				Unique:  false,
 				Columns: []*schema.Column{TaskInstancesColumns[6]},
 			},
+			{
+				Name:    "taskinstance_status",
+				Unique:  false,
+				Columns: []*schema.Column{TaskInstancesColumns[7]},
+			},
 			{
 				Name:    "taskinstance_parent_task_id",
 				Unique:  false,
-				Columns: []*schema.Column{TaskInstancesColumns[13]},
+				Columns: []*schema.Column{TaskInstancesColumns[18]},
 			},
 			{
 				Name:    "taskinstance_execution_id",
 				Unique:  false,
-				Columns: []*schema.Column{TaskInstancesColumns[14]},
+				Columns: []*schema.Column{TaskInstancesColumns[20]},
 			},
 			{
 				Name:    "taskinstance_category",
 				Unique:  false,
-				Columns: []*schema.Column{TaskInstancesColumns[12]},
+				Columns: []*schema.Column{TaskInstancesColumns[17]},
 			},
 			{
 				Name:    "taskinstance_assignee_process_instance_id",
 				Unique:  false,
-				Columns: []*schema.Column{TaskInstancesColumns[4], TaskInstancesColumns[15]},
+				Columns: []*schema.Column{TaskInstancesColumns[4], TaskInstancesColumns[21]},
 			},
 			{
 				Name:    "taskinstance_tenant_id_assignee",
 				Unique:  false,
-				Columns: []*schema.Column{TaskInstancesColumns[22], TaskInstancesColumns[4]},
+				Columns: []*schema.Column{TaskInstancesColumns[28], TaskInstancesColumns[4]},
+			},
+		},
+	}
+	// UsersColumns holds the columns for the "users" table.
+	UsersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "username", Type: field.TypeString, Size: 255},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "display_name", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "password_hash", Type: field.TypeString, Size: 255},
+		{Name: "status", Type: field.TypeString, Size: 20, Default: "active"},
+		{Name: "roles", Type: field.TypeJSON, Nullable: true},
+		{Name: "groups", Type: field.TypeJSON, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeString, Size: 100, Default: "default"},
+		{Name: "password_changed_at", Type: field.TypeTime},
+		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// UsersTable holds the schema information for the "users" table.
+	UsersTable = &schema.Table{
+		Name:       "users",
+		Columns:    UsersColumns,
+		PrimaryKey: []*schema.Column{UsersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "user_username",
+				Unique:  true,
+				Columns: []*schema.Column{UsersColumns[1]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuthorizationsTable,
+		GroupsTable,
 		HistoricProcessInstancesTable,
 		ProcessDefinitionsTable,
 		ProcessEventsTable,
 		ProcessInstancesTable,
 		ProcessVariablesTable,
+		RefreshTokensTable,
+		RolesTable,
+		TaskAttachmentsTable,
+		TaskCommentsTable,
+		TaskIdentityLinksTable,
 		TaskInstancesTable,
+		UsersTable,
 	}
 )
 
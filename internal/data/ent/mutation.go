@@ -11,13 +11,21 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/authorization"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/group"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/historicprocessinstance"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/predicate"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/processdefinition"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/processevent"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/processinstance"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/processvariable"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/refreshtoken"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/role"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskattachment"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskcomment"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskidentitylink"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskinstance"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/user"
 )
 
 const (
@@ -29,63 +37,54 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
+	TypeAuthorization           = "Authorization"
+	TypeGroup                   = "Group"
 	TypeHistoricProcessInstance = "HistoricProcessInstance"
 	TypeProcessDefinition       = "ProcessDefinition"
 	TypeProcessEvent            = "ProcessEvent"
 	TypeProcessInstance         = "ProcessInstance"
 	TypeProcessVariable         = "ProcessVariable"
+	TypeRefreshToken            = "RefreshToken"
+	TypeRole                    = "Role"
+	TypeTaskAttachment          = "TaskAttachment"
+	TypeTaskComment             = "TaskComment"
+	TypeTaskIdentityLink        = "TaskIdentityLink"
 	TypeTaskInstance            = "TaskInstance"
+	TypeUser                    = "User"
 )
 
-// HistoricProcessInstanceMutation represents an operation that mutates the HistoricProcessInstance nodes in the graph.
-type HistoricProcessInstanceMutation struct {
+// AuthorizationMutation represents an operation that mutates the Authorization nodes in the graph.
+type AuthorizationMutation struct {
 	config
-	op                            Op
-	typ                           string
-	id                            *int64
-	process_instance_id           *string
-	business_key                  *string
-	process_definition_id         *int64
-	addprocess_definition_id      *int64
-	process_definition_key        *string
-	process_definition_name       *string
-	process_definition_version    *int32
-	addprocess_definition_version *int32
-	deployment_id                 *string
-	start_user_id                 *string
-	start_time                    *time.Time
-	end_time                      *time.Time
-	duration                      *int64
-	addduration                   *int64
-	start_activity_id             *string
-	end_activity_id               *string
-	super_process_instance_id     *string
-	root_process_instance_id      *string
-	super_case_instance_id        *string
-	case_instance_id              *string
-	delete_reason                 *string
-	tenant_id                     *string
-	state                         *string
-	removal_time                  *string
-	created_at                    *time.Time
-	updated_at                    *time.Time
-	clearedFields                 map[string]struct{}
-	done                          bool
-	oldValue                      func(context.Context) (*HistoricProcessInstance, error)
-	predicates                    []predicate.HistoricProcessInstance
-}
-
-var _ ent.Mutation = (*HistoricProcessInstanceMutation)(nil)
-
-// historicprocessinstanceOption allows management of the mutation configuration using functional options.
-type historicprocessinstanceOption func(*HistoricProcessInstanceMutation)
-
-// newHistoricProcessInstanceMutation creates new mutation for the HistoricProcessInstance entity.
-func newHistoricProcessInstanceMutation(c config, op Op, opts ...historicprocessinstanceOption) *HistoricProcessInstanceMutation {
-	m := &HistoricProcessInstanceMutation{
+	op                     Op
+	typ                    string
+	id                     *int64
+	principal_type         *string
+	principal_id           *string
+	process_definition_key *string
+	category               *string
+	tenant_id              *string
+	actions                *[]string
+	appendactions          []string
+	created_by             *string
+	created_at             *time.Time
+	clearedFields          map[string]struct{}
+	done                   bool
+	oldValue               func(context.Context) (*Authorization, error)
+	predicates             []predicate.Authorization
+}
+
+var _ ent.Mutation = (*AuthorizationMutation)(nil)
+
+// authorizationOption allows management of the mutation configuration using functional options.
+type authorizationOption func(*AuthorizationMutation)
+
+// newAuthorizationMutation creates new mutation for the Authorization entity.
+func newAuthorizationMutation(c config, op Op, opts ...authorizationOption) *AuthorizationMutation {
+	m := &AuthorizationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeHistoricProcessInstance,
+		typ:           TypeAuthorization,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -94,20 +93,20 @@ func newHistoricProcessInstanceMutation(c config, op Op, opts ...historicprocess
 	return m
 }
 
-// withHistoricProcessInstanceID sets the ID field of the mutation.
-func withHistoricProcessInstanceID(id int64) historicprocessinstanceOption {
-	return func(m *HistoricProcessInstanceMutation) {
+// withAuthorizationID sets the ID field of the mutation.
+func withAuthorizationID(id int64) authorizationOption {
+	return func(m *AuthorizationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *HistoricProcessInstance
+			value *Authorization
 		)
-		m.oldValue = func(ctx context.Context) (*HistoricProcessInstance, error) {
+		m.oldValue = func(ctx context.Context) (*Authorization, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().HistoricProcessInstance.Get(ctx, id)
+					value, err = m.Client().Authorization.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -116,10 +115,10 @@ func withHistoricProcessInstanceID(id int64) historicprocessinstanceOption {
 	}
 }
 
-// withHistoricProcessInstance sets the old HistoricProcessInstance of the mutation.
-func withHistoricProcessInstance(node *HistoricProcessInstance) historicprocessinstanceOption {
-	return func(m *HistoricProcessInstanceMutation) {
-		m.oldValue = func(context.Context) (*HistoricProcessInstance, error) {
+// withAuthorization sets the old Authorization of the mutation.
+func withAuthorization(node *Authorization) authorizationOption {
+	return func(m *AuthorizationMutation) {
+		m.oldValue = func(context.Context) (*Authorization, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -128,7 +127,7 @@ func withHistoricProcessInstance(node *HistoricProcessInstance) historicprocessi
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m HistoricProcessInstanceMutation) Client() *Client {
+func (m AuthorizationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -136,7 +135,7 @@ func (m HistoricProcessInstanceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m HistoricProcessInstanceMutation) Tx() (*Tx, error) {
+func (m AuthorizationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -146,14 +145,14 @@ func (m HistoricProcessInstanceMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of HistoricProcessInstance entities.
-func (m *HistoricProcessInstanceMutation) SetID(id int64) {
+// operation is only accepted on creation of Authorization entities.
+func (m *AuthorizationMutation) SetID(id int64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *HistoricProcessInstanceMutation) ID() (id int64, exists bool) {
+func (m *AuthorizationMutation) ID() (id int64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -164,7 +163,7 @@ func (m *HistoricProcessInstanceMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *HistoricProcessInstanceMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *AuthorizationMutation) IDs(ctx context.Context) ([]int64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -173,2524 +172,2480 @@ func (m *HistoricProcessInstanceMutation) IDs(ctx context.Context) ([]int64, err
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().HistoricProcessInstance.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Authorization.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetProcessInstanceID sets the "process_instance_id" field.
-func (m *HistoricProcessInstanceMutation) SetProcessInstanceID(s string) {
-	m.process_instance_id = &s
+// SetPrincipalType sets the "principal_type" field.
+func (m *AuthorizationMutation) SetPrincipalType(s string) {
+	m.principal_type = &s
 }
 
-// ProcessInstanceID returns the value of the "process_instance_id" field in the mutation.
-func (m *HistoricProcessInstanceMutation) ProcessInstanceID() (r string, exists bool) {
-	v := m.process_instance_id
+// PrincipalType returns the value of the "principal_type" field in the mutation.
+func (m *AuthorizationMutation) PrincipalType() (r string, exists bool) {
+	v := m.principal_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessInstanceID returns the old "process_instance_id" field's value of the HistoricProcessInstance entity.
-// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldPrincipalType returns the old "principal_type" field's value of the Authorization entity.
+// If the Authorization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldProcessInstanceID(ctx context.Context) (v string, err error) {
+func (m *AuthorizationMutation) OldPrincipalType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessInstanceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrincipalType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessInstanceID requires an ID field in the mutation")
+		return v, errors.New("OldPrincipalType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessInstanceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPrincipalType: %w", err)
 	}
-	return oldValue.ProcessInstanceID, nil
+	return oldValue.PrincipalType, nil
 }
 
-// ResetProcessInstanceID resets all changes to the "process_instance_id" field.
-func (m *HistoricProcessInstanceMutation) ResetProcessInstanceID() {
-	m.process_instance_id = nil
+// ResetPrincipalType resets all changes to the "principal_type" field.
+func (m *AuthorizationMutation) ResetPrincipalType() {
+	m.principal_type = nil
 }
 
-// SetBusinessKey sets the "business_key" field.
-func (m *HistoricProcessInstanceMutation) SetBusinessKey(s string) {
-	m.business_key = &s
+// SetPrincipalID sets the "principal_id" field.
+func (m *AuthorizationMutation) SetPrincipalID(s string) {
+	m.principal_id = &s
 }
 
-// BusinessKey returns the value of the "business_key" field in the mutation.
-func (m *HistoricProcessInstanceMutation) BusinessKey() (r string, exists bool) {
-	v := m.business_key
+// PrincipalID returns the value of the "principal_id" field in the mutation.
+func (m *AuthorizationMutation) PrincipalID() (r string, exists bool) {
+	v := m.principal_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBusinessKey returns the old "business_key" field's value of the HistoricProcessInstance entity.
-// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldPrincipalID returns the old "principal_id" field's value of the Authorization entity.
+// If the Authorization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldBusinessKey(ctx context.Context) (v string, err error) {
+func (m *AuthorizationMutation) OldPrincipalID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBusinessKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrincipalID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBusinessKey requires an ID field in the mutation")
+		return v, errors.New("OldPrincipalID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBusinessKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldPrincipalID: %w", err)
 	}
-	return oldValue.BusinessKey, nil
-}
-
-// ClearBusinessKey clears the value of the "business_key" field.
-func (m *HistoricProcessInstanceMutation) ClearBusinessKey() {
-	m.business_key = nil
-	m.clearedFields[historicprocessinstance.FieldBusinessKey] = struct{}{}
-}
-
-// BusinessKeyCleared returns if the "business_key" field was cleared in this mutation.
-func (m *HistoricProcessInstanceMutation) BusinessKeyCleared() bool {
-	_, ok := m.clearedFields[historicprocessinstance.FieldBusinessKey]
-	return ok
+	return oldValue.PrincipalID, nil
 }
 
-// ResetBusinessKey resets all changes to the "business_key" field.
-func (m *HistoricProcessInstanceMutation) ResetBusinessKey() {
-	m.business_key = nil
-	delete(m.clearedFields, historicprocessinstance.FieldBusinessKey)
+// ResetPrincipalID resets all changes to the "principal_id" field.
+func (m *AuthorizationMutation) ResetPrincipalID() {
+	m.principal_id = nil
 }
 
-// SetProcessDefinitionID sets the "process_definition_id" field.
-func (m *HistoricProcessInstanceMutation) SetProcessDefinitionID(i int64) {
-	m.process_definition_id = &i
-	m.addprocess_definition_id = nil
+// SetProcessDefinitionKey sets the "process_definition_key" field.
+func (m *AuthorizationMutation) SetProcessDefinitionKey(s string) {
+	m.process_definition_key = &s
 }
 
-// ProcessDefinitionID returns the value of the "process_definition_id" field in the mutation.
-func (m *HistoricProcessInstanceMutation) ProcessDefinitionID() (r int64, exists bool) {
-	v := m.process_definition_id
+// ProcessDefinitionKey returns the value of the "process_definition_key" field in the mutation.
+func (m *AuthorizationMutation) ProcessDefinitionKey() (r string, exists bool) {
+	v := m.process_definition_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessDefinitionID returns the old "process_definition_id" field's value of the HistoricProcessInstance entity.
-// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldProcessDefinitionKey returns the old "process_definition_key" field's value of the Authorization entity.
+// If the Authorization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldProcessDefinitionID(ctx context.Context) (v int64, err error) {
+func (m *AuthorizationMutation) OldProcessDefinitionKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessDefinitionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldProcessDefinitionKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessDefinitionID requires an ID field in the mutation")
+		return v, errors.New("OldProcessDefinitionKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessDefinitionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldProcessDefinitionKey: %w", err)
 	}
-	return oldValue.ProcessDefinitionID, nil
+	return oldValue.ProcessDefinitionKey, nil
 }
 
-// AddProcessDefinitionID adds i to the "process_definition_id" field.
-func (m *HistoricProcessInstanceMutation) AddProcessDefinitionID(i int64) {
-	if m.addprocess_definition_id != nil {
-		*m.addprocess_definition_id += i
-	} else {
-		m.addprocess_definition_id = &i
-	}
+// ResetProcessDefinitionKey resets all changes to the "process_definition_key" field.
+func (m *AuthorizationMutation) ResetProcessDefinitionKey() {
+	m.process_definition_key = nil
 }
 
-// AddedProcessDefinitionID returns the value that was added to the "process_definition_id" field in this mutation.
-func (m *HistoricProcessInstanceMutation) AddedProcessDefinitionID() (r int64, exists bool) {
-	v := m.addprocess_definition_id
+// SetCategory sets the "category" field.
+func (m *AuthorizationMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *AuthorizationMutation) Category() (r string, exists bool) {
+	v := m.category
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetProcessDefinitionID resets all changes to the "process_definition_id" field.
-func (m *HistoricProcessInstanceMutation) ResetProcessDefinitionID() {
-	m.process_definition_id = nil
-	m.addprocess_definition_id = nil
+// OldCategory returns the old "category" field's value of the Authorization entity.
+// If the Authorization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthorizationMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
 }
 
-// SetProcessDefinitionKey sets the "process_definition_key" field.
-func (m *HistoricProcessInstanceMutation) SetProcessDefinitionKey(s string) {
-	m.process_definition_key = &s
+// ResetCategory resets all changes to the "category" field.
+func (m *AuthorizationMutation) ResetCategory() {
+	m.category = nil
 }
 
-// ProcessDefinitionKey returns the value of the "process_definition_key" field in the mutation.
-func (m *HistoricProcessInstanceMutation) ProcessDefinitionKey() (r string, exists bool) {
-	v := m.process_definition_key
+// SetTenantID sets the "tenant_id" field.
+func (m *AuthorizationMutation) SetTenantID(s string) {
+	m.tenant_id = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *AuthorizationMutation) TenantID() (r string, exists bool) {
+	v := m.tenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessDefinitionKey returns the old "process_definition_key" field's value of the HistoricProcessInstance entity.
-// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the Authorization entity.
+// If the Authorization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldProcessDefinitionKey(ctx context.Context) (v string, err error) {
+func (m *AuthorizationMutation) OldTenantID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessDefinitionKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessDefinitionKey requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessDefinitionKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.ProcessDefinitionKey, nil
+	return oldValue.TenantID, nil
 }
 
-// ResetProcessDefinitionKey resets all changes to the "process_definition_key" field.
-func (m *HistoricProcessInstanceMutation) ResetProcessDefinitionKey() {
-	m.process_definition_key = nil
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *AuthorizationMutation) ResetTenantID() {
+	m.tenant_id = nil
 }
 
-// SetProcessDefinitionName sets the "process_definition_name" field.
-func (m *HistoricProcessInstanceMutation) SetProcessDefinitionName(s string) {
-	m.process_definition_name = &s
+// SetActions sets the "actions" field.
+func (m *AuthorizationMutation) SetActions(s []string) {
+	m.actions = &s
+	m.appendactions = nil
 }
 
-// ProcessDefinitionName returns the value of the "process_definition_name" field in the mutation.
-func (m *HistoricProcessInstanceMutation) ProcessDefinitionName() (r string, exists bool) {
-	v := m.process_definition_name
+// Actions returns the value of the "actions" field in the mutation.
+func (m *AuthorizationMutation) Actions() (r []string, exists bool) {
+	v := m.actions
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessDefinitionName returns the old "process_definition_name" field's value of the HistoricProcessInstance entity.
-// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldActions returns the old "actions" field's value of the Authorization entity.
+// If the Authorization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldProcessDefinitionName(ctx context.Context) (v string, err error) {
+func (m *AuthorizationMutation) OldActions(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessDefinitionName is only allowed on UpdateOne operations")
+		return v, errors.New("OldActions is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessDefinitionName requires an ID field in the mutation")
+		return v, errors.New("OldActions requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessDefinitionName: %w", err)
+		return v, fmt.Errorf("querying old value for OldActions: %w", err)
 	}
-	return oldValue.ProcessDefinitionName, nil
+	return oldValue.Actions, nil
 }
 
-// ClearProcessDefinitionName clears the value of the "process_definition_name" field.
-func (m *HistoricProcessInstanceMutation) ClearProcessDefinitionName() {
-	m.process_definition_name = nil
-	m.clearedFields[historicprocessinstance.FieldProcessDefinitionName] = struct{}{}
+// AppendActions adds s to the "actions" field.
+func (m *AuthorizationMutation) AppendActions(s []string) {
+	m.appendactions = append(m.appendactions, s...)
 }
 
-// ProcessDefinitionNameCleared returns if the "process_definition_name" field was cleared in this mutation.
-func (m *HistoricProcessInstanceMutation) ProcessDefinitionNameCleared() bool {
-	_, ok := m.clearedFields[historicprocessinstance.FieldProcessDefinitionName]
-	return ok
+// AppendedActions returns the list of values that were appended to the "actions" field in this mutation.
+func (m *AuthorizationMutation) AppendedActions() ([]string, bool) {
+	if len(m.appendactions) == 0 {
+		return nil, false
+	}
+	return m.appendactions, true
 }
 
-// ResetProcessDefinitionName resets all changes to the "process_definition_name" field.
-func (m *HistoricProcessInstanceMutation) ResetProcessDefinitionName() {
-	m.process_definition_name = nil
-	delete(m.clearedFields, historicprocessinstance.FieldProcessDefinitionName)
+// ResetActions resets all changes to the "actions" field.
+func (m *AuthorizationMutation) ResetActions() {
+	m.actions = nil
+	m.appendactions = nil
 }
 
-// SetProcessDefinitionVersion sets the "process_definition_version" field.
-func (m *HistoricProcessInstanceMutation) SetProcessDefinitionVersion(i int32) {
-	m.process_definition_version = &i
-	m.addprocess_definition_version = nil
+// SetCreatedBy sets the "created_by" field.
+func (m *AuthorizationMutation) SetCreatedBy(s string) {
+	m.created_by = &s
 }
 
-// ProcessDefinitionVersion returns the value of the "process_definition_version" field in the mutation.
-func (m *HistoricProcessInstanceMutation) ProcessDefinitionVersion() (r int32, exists bool) {
-	v := m.process_definition_version
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *AuthorizationMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessDefinitionVersion returns the old "process_definition_version" field's value of the HistoricProcessInstance entity.
-// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedBy returns the old "created_by" field's value of the Authorization entity.
+// If the Authorization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldProcessDefinitionVersion(ctx context.Context) (v int32, err error) {
+func (m *AuthorizationMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessDefinitionVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessDefinitionVersion requires an ID field in the mutation")
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessDefinitionVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
 	}
-	return oldValue.ProcessDefinitionVersion, nil
+	return oldValue.CreatedBy, nil
 }
 
-// AddProcessDefinitionVersion adds i to the "process_definition_version" field.
-func (m *HistoricProcessInstanceMutation) AddProcessDefinitionVersion(i int32) {
-	if m.addprocess_definition_version != nil {
-		*m.addprocess_definition_version += i
-	} else {
-		m.addprocess_definition_version = &i
-	}
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *AuthorizationMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.clearedFields[authorization.FieldCreatedBy] = struct{}{}
 }
 
-// AddedProcessDefinitionVersion returns the value that was added to the "process_definition_version" field in this mutation.
-func (m *HistoricProcessInstanceMutation) AddedProcessDefinitionVersion() (r int32, exists bool) {
-	v := m.addprocess_definition_version
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *AuthorizationMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[authorization.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *AuthorizationMutation) ResetCreatedBy() {
+	m.created_by = nil
+	delete(m.clearedFields, authorization.FieldCreatedBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AuthorizationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuthorizationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetProcessDefinitionVersion resets all changes to the "process_definition_version" field.
-func (m *HistoricProcessInstanceMutation) ResetProcessDefinitionVersion() {
-	m.process_definition_version = nil
-	m.addprocess_definition_version = nil
-}
-
-// SetDeploymentID sets the "deployment_id" field.
-func (m *HistoricProcessInstanceMutation) SetDeploymentID(s string) {
-	m.deployment_id = &s
-}
-
-// DeploymentID returns the value of the "deployment_id" field in the mutation.
-func (m *HistoricProcessInstanceMutation) DeploymentID() (r string, exists bool) {
-	v := m.deployment_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDeploymentID returns the old "deployment_id" field's value of the HistoricProcessInstance entity.
-// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Authorization entity.
+// If the Authorization object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldDeploymentID(ctx context.Context) (v string, err error) {
+func (m *AuthorizationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeploymentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeploymentID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeploymentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.DeploymentID, nil
-}
-
-// ClearDeploymentID clears the value of the "deployment_id" field.
-func (m *HistoricProcessInstanceMutation) ClearDeploymentID() {
-	m.deployment_id = nil
-	m.clearedFields[historicprocessinstance.FieldDeploymentID] = struct{}{}
-}
-
-// DeploymentIDCleared returns if the "deployment_id" field was cleared in this mutation.
-func (m *HistoricProcessInstanceMutation) DeploymentIDCleared() bool {
-	_, ok := m.clearedFields[historicprocessinstance.FieldDeploymentID]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetDeploymentID resets all changes to the "deployment_id" field.
-func (m *HistoricProcessInstanceMutation) ResetDeploymentID() {
-	m.deployment_id = nil
-	delete(m.clearedFields, historicprocessinstance.FieldDeploymentID)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuthorizationMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetStartUserID sets the "start_user_id" field.
-func (m *HistoricProcessInstanceMutation) SetStartUserID(s string) {
-	m.start_user_id = &s
+// Where appends a list predicates to the AuthorizationMutation builder.
+func (m *AuthorizationMutation) Where(ps ...predicate.Authorization) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// StartUserID returns the value of the "start_user_id" field in the mutation.
-func (m *HistoricProcessInstanceMutation) StartUserID() (r string, exists bool) {
-	v := m.start_user_id
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the AuthorizationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuthorizationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Authorization, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldStartUserID returns the old "start_user_id" field's value of the HistoricProcessInstance entity.
-// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldStartUserID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartUserID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartUserID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartUserID: %w", err)
-	}
-	return oldValue.StartUserID, nil
+// Op returns the operation name.
+func (m *AuthorizationMutation) Op() Op {
+	return m.op
 }
 
-// ClearStartUserID clears the value of the "start_user_id" field.
-func (m *HistoricProcessInstanceMutation) ClearStartUserID() {
-	m.start_user_id = nil
-	m.clearedFields[historicprocessinstance.FieldStartUserID] = struct{}{}
+// SetOp allows setting the mutation operation.
+func (m *AuthorizationMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// StartUserIDCleared returns if the "start_user_id" field was cleared in this mutation.
-func (m *HistoricProcessInstanceMutation) StartUserIDCleared() bool {
-	_, ok := m.clearedFields[historicprocessinstance.FieldStartUserID]
-	return ok
+// Type returns the node type of this mutation (Authorization).
+func (m *AuthorizationMutation) Type() string {
+	return m.typ
 }
 
-// ResetStartUserID resets all changes to the "start_user_id" field.
-func (m *HistoricProcessInstanceMutation) ResetStartUserID() {
-	m.start_user_id = nil
-	delete(m.clearedFields, historicprocessinstance.FieldStartUserID)
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuthorizationMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.principal_type != nil {
+		fields = append(fields, authorization.FieldPrincipalType)
+	}
+	if m.principal_id != nil {
+		fields = append(fields, authorization.FieldPrincipalID)
+	}
+	if m.process_definition_key != nil {
+		fields = append(fields, authorization.FieldProcessDefinitionKey)
+	}
+	if m.category != nil {
+		fields = append(fields, authorization.FieldCategory)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, authorization.FieldTenantID)
+	}
+	if m.actions != nil {
+		fields = append(fields, authorization.FieldActions)
+	}
+	if m.created_by != nil {
+		fields = append(fields, authorization.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, authorization.FieldCreatedAt)
+	}
+	return fields
 }
 
-// SetStartTime sets the "start_time" field.
-func (m *HistoricProcessInstanceMutation) SetStartTime(t time.Time) {
-	m.start_time = &t
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuthorizationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case authorization.FieldPrincipalType:
+		return m.PrincipalType()
+	case authorization.FieldPrincipalID:
+		return m.PrincipalID()
+	case authorization.FieldProcessDefinitionKey:
+		return m.ProcessDefinitionKey()
+	case authorization.FieldCategory:
+		return m.Category()
+	case authorization.FieldTenantID:
+		return m.TenantID()
+	case authorization.FieldActions:
+		return m.Actions()
+	case authorization.FieldCreatedBy:
+		return m.CreatedBy()
+	case authorization.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
 }
 
-// StartTime returns the value of the "start_time" field in the mutation.
-func (m *HistoricProcessInstanceMutation) StartTime() (r time.Time, exists bool) {
-	v := m.start_time
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuthorizationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case authorization.FieldPrincipalType:
+		return m.OldPrincipalType(ctx)
+	case authorization.FieldPrincipalID:
+		return m.OldPrincipalID(ctx)
+	case authorization.FieldProcessDefinitionKey:
+		return m.OldProcessDefinitionKey(ctx)
+	case authorization.FieldCategory:
+		return m.OldCategory(ctx)
+	case authorization.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case authorization.FieldActions:
+		return m.OldActions(ctx)
+	case authorization.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case authorization.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown Authorization field %s", name)
 }
 
-// OldStartTime returns the old "start_time" field's value of the HistoricProcessInstance entity.
-// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuthorizationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case authorization.FieldPrincipalType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrincipalType(v)
+		return nil
+	case authorization.FieldPrincipalID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrincipalID(v)
+		return nil
+	case authorization.FieldProcessDefinitionKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessDefinitionKey(v)
+		return nil
+	case authorization.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case authorization.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case authorization.FieldActions:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActions(v)
+		return nil
+	case authorization.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case authorization.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
 	}
-	return oldValue.StartTime, nil
+	return fmt.Errorf("unknown Authorization field %s", name)
 }
 
-// ResetStartTime resets all changes to the "start_time" field.
-func (m *HistoricProcessInstanceMutation) ResetStartTime() {
-	m.start_time = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuthorizationMutation) AddedFields() []string {
+	return nil
 }
 
-// SetEndTime sets the "end_time" field.
-func (m *HistoricProcessInstanceMutation) SetEndTime(t time.Time) {
-	m.end_time = &t
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuthorizationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// EndTime returns the value of the "end_time" field in the mutation.
-func (m *HistoricProcessInstanceMutation) EndTime() (r time.Time, exists bool) {
-	v := m.end_time
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuthorizationMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown Authorization numeric field %s", name)
 }
 
-// OldEndTime returns the old "end_time" field's value of the HistoricProcessInstance entity.
-// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldEndTime(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuthorizationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(authorization.FieldCreatedBy) {
+		fields = append(fields, authorization.FieldCreatedBy)
 	}
-	return oldValue.EndTime, nil
+	return fields
 }
 
-// ClearEndTime clears the value of the "end_time" field.
-func (m *HistoricProcessInstanceMutation) ClearEndTime() {
-	m.end_time = nil
-	m.clearedFields[historicprocessinstance.FieldEndTime] = struct{}{}
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuthorizationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// EndTimeCleared returns if the "end_time" field was cleared in this mutation.
-func (m *HistoricProcessInstanceMutation) EndTimeCleared() bool {
-	_, ok := m.clearedFields[historicprocessinstance.FieldEndTime]
-	return ok
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuthorizationMutation) ClearField(name string) error {
+	switch name {
+	case authorization.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Authorization nullable field %s", name)
 }
 
-// ResetEndTime resets all changes to the "end_time" field.
-func (m *HistoricProcessInstanceMutation) ResetEndTime() {
-	m.end_time = nil
-	delete(m.clearedFields, historicprocessinstance.FieldEndTime)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuthorizationMutation) ResetField(name string) error {
+	switch name {
+	case authorization.FieldPrincipalType:
+		m.ResetPrincipalType()
+		return nil
+	case authorization.FieldPrincipalID:
+		m.ResetPrincipalID()
+		return nil
+	case authorization.FieldProcessDefinitionKey:
+		m.ResetProcessDefinitionKey()
+		return nil
+	case authorization.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case authorization.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case authorization.FieldActions:
+		m.ResetActions()
+		return nil
+	case authorization.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case authorization.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Authorization field %s", name)
 }
 
-// SetDuration sets the "duration" field.
-func (m *HistoricProcessInstanceMutation) SetDuration(i int64) {
-	m.duration = &i
-	m.addduration = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuthorizationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// Duration returns the value of the "duration" field in the mutation.
-func (m *HistoricProcessInstanceMutation) Duration() (r int64, exists bool) {
-	v := m.duration
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuthorizationMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldDuration returns the old "duration" field's value of the HistoricProcessInstance entity.
-// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldDuration(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDuration is only allowed on UpdateOne operations")
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuthorizationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuthorizationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuthorizationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuthorizationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuthorizationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Authorization unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuthorizationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Authorization edge %s", name)
+}
+
+// GroupMutation represents an operation that mutates the Group nodes in the graph.
+type GroupMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	name          *string
+	description   *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Group, error)
+	predicates    []predicate.Group
+}
+
+var _ ent.Mutation = (*GroupMutation)(nil)
+
+// groupOption allows management of the mutation configuration using functional options.
+type groupOption func(*GroupMutation)
+
+// newGroupMutation creates new mutation for the Group entity.
+func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
+	m := &GroupMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGroup,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDuration requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
+	return m
+}
+
+// withGroupID sets the ID field of the mutation.
+func withGroupID(id int64) groupOption {
+	return func(m *GroupMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Group
+		)
+		m.oldValue = func(ctx context.Context) (*Group, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Group.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.Duration, nil
 }
 
-// AddDuration adds i to the "duration" field.
-func (m *HistoricProcessInstanceMutation) AddDuration(i int64) {
-	if m.addduration != nil {
-		*m.addduration += i
-	} else {
-		m.addduration = &i
+// withGroup sets the old Group of the mutation.
+func withGroup(node *Group) groupOption {
+	return func(m *GroupMutation) {
+		m.oldValue = func(context.Context) (*Group, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
 }
 
-// AddedDuration returns the value that was added to the "duration" field in this mutation.
-func (m *HistoricProcessInstanceMutation) AddedDuration() (r int64, exists bool) {
-	v := m.addduration
-	if v == nil {
-		return
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GroupMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GroupMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return *v, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearDuration clears the value of the "duration" field.
-func (m *HistoricProcessInstanceMutation) ClearDuration() {
-	m.duration = nil
-	m.addduration = nil
-	m.clearedFields[historicprocessinstance.FieldDuration] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Group entities.
+func (m *GroupMutation) SetID(id int64) {
+	m.id = &id
 }
 
-// DurationCleared returns if the "duration" field was cleared in this mutation.
-func (m *HistoricProcessInstanceMutation) DurationCleared() bool {
-	_, ok := m.clearedFields[historicprocessinstance.FieldDuration]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GroupMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetDuration resets all changes to the "duration" field.
-func (m *HistoricProcessInstanceMutation) ResetDuration() {
-	m.duration = nil
-	m.addduration = nil
-	delete(m.clearedFields, historicprocessinstance.FieldDuration)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GroupMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Group.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetStartActivityID sets the "start_activity_id" field.
-func (m *HistoricProcessInstanceMutation) SetStartActivityID(s string) {
-	m.start_activity_id = &s
+// SetName sets the "name" field.
+func (m *GroupMutation) SetName(s string) {
+	m.name = &s
 }
 
-// StartActivityID returns the value of the "start_activity_id" field in the mutation.
-func (m *HistoricProcessInstanceMutation) StartActivityID() (r string, exists bool) {
-	v := m.start_activity_id
+// Name returns the value of the "name" field in the mutation.
+func (m *GroupMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartActivityID returns the old "start_activity_id" field's value of the HistoricProcessInstance entity.
-// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldStartActivityID(ctx context.Context) (v string, err error) {
+func (m *GroupMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartActivityID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartActivityID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartActivityID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.StartActivityID, nil
-}
-
-// ClearStartActivityID clears the value of the "start_activity_id" field.
-func (m *HistoricProcessInstanceMutation) ClearStartActivityID() {
-	m.start_activity_id = nil
-	m.clearedFields[historicprocessinstance.FieldStartActivityID] = struct{}{}
-}
-
-// StartActivityIDCleared returns if the "start_activity_id" field was cleared in this mutation.
-func (m *HistoricProcessInstanceMutation) StartActivityIDCleared() bool {
-	_, ok := m.clearedFields[historicprocessinstance.FieldStartActivityID]
-	return ok
+	return oldValue.Name, nil
 }
 
-// ResetStartActivityID resets all changes to the "start_activity_id" field.
-func (m *HistoricProcessInstanceMutation) ResetStartActivityID() {
-	m.start_activity_id = nil
-	delete(m.clearedFields, historicprocessinstance.FieldStartActivityID)
+// ResetName resets all changes to the "name" field.
+func (m *GroupMutation) ResetName() {
+	m.name = nil
 }
 
-// SetEndActivityID sets the "end_activity_id" field.
-func (m *HistoricProcessInstanceMutation) SetEndActivityID(s string) {
-	m.end_activity_id = &s
+// SetDescription sets the "description" field.
+func (m *GroupMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// EndActivityID returns the value of the "end_activity_id" field in the mutation.
-func (m *HistoricProcessInstanceMutation) EndActivityID() (r string, exists bool) {
-	v := m.end_activity_id
+// Description returns the value of the "description" field in the mutation.
+func (m *GroupMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEndActivityID returns the old "end_activity_id" field's value of the HistoricProcessInstance entity.
-// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldEndActivityID(ctx context.Context) (v string, err error) {
+func (m *GroupMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndActivityID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndActivityID requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndActivityID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.EndActivityID, nil
+	return oldValue.Description, nil
 }
 
-// ClearEndActivityID clears the value of the "end_activity_id" field.
-func (m *HistoricProcessInstanceMutation) ClearEndActivityID() {
-	m.end_activity_id = nil
-	m.clearedFields[historicprocessinstance.FieldEndActivityID] = struct{}{}
+// ClearDescription clears the value of the "description" field.
+func (m *GroupMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[group.FieldDescription] = struct{}{}
 }
 
-// EndActivityIDCleared returns if the "end_activity_id" field was cleared in this mutation.
-func (m *HistoricProcessInstanceMutation) EndActivityIDCleared() bool {
-	_, ok := m.clearedFields[historicprocessinstance.FieldEndActivityID]
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *GroupMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[group.FieldDescription]
 	return ok
 }
 
-// ResetEndActivityID resets all changes to the "end_activity_id" field.
-func (m *HistoricProcessInstanceMutation) ResetEndActivityID() {
-	m.end_activity_id = nil
-	delete(m.clearedFields, historicprocessinstance.FieldEndActivityID)
+// ResetDescription resets all changes to the "description" field.
+func (m *GroupMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, group.FieldDescription)
 }
 
-// SetSuperProcessInstanceID sets the "super_process_instance_id" field.
-func (m *HistoricProcessInstanceMutation) SetSuperProcessInstanceID(s string) {
-	m.super_process_instance_id = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *GroupMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// SuperProcessInstanceID returns the value of the "super_process_instance_id" field in the mutation.
-func (m *HistoricProcessInstanceMutation) SuperProcessInstanceID() (r string, exists bool) {
-	v := m.super_process_instance_id
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GroupMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSuperProcessInstanceID returns the old "super_process_instance_id" field's value of the HistoricProcessInstance entity.
-// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldSuperProcessInstanceID(ctx context.Context) (v string, err error) {
+func (m *GroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSuperProcessInstanceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSuperProcessInstanceID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSuperProcessInstanceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.SuperProcessInstanceID, nil
-}
-
-// ClearSuperProcessInstanceID clears the value of the "super_process_instance_id" field.
-func (m *HistoricProcessInstanceMutation) ClearSuperProcessInstanceID() {
-	m.super_process_instance_id = nil
-	m.clearedFields[historicprocessinstance.FieldSuperProcessInstanceID] = struct{}{}
-}
-
-// SuperProcessInstanceIDCleared returns if the "super_process_instance_id" field was cleared in this mutation.
-func (m *HistoricProcessInstanceMutation) SuperProcessInstanceIDCleared() bool {
-	_, ok := m.clearedFields[historicprocessinstance.FieldSuperProcessInstanceID]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetSuperProcessInstanceID resets all changes to the "super_process_instance_id" field.
-func (m *HistoricProcessInstanceMutation) ResetSuperProcessInstanceID() {
-	m.super_process_instance_id = nil
-	delete(m.clearedFields, historicprocessinstance.FieldSuperProcessInstanceID)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GroupMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetRootProcessInstanceID sets the "root_process_instance_id" field.
-func (m *HistoricProcessInstanceMutation) SetRootProcessInstanceID(s string) {
-	m.root_process_instance_id = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GroupMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// RootProcessInstanceID returns the value of the "root_process_instance_id" field in the mutation.
-func (m *HistoricProcessInstanceMutation) RootProcessInstanceID() (r string, exists bool) {
-	v := m.root_process_instance_id
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GroupMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRootProcessInstanceID returns the old "root_process_instance_id" field's value of the HistoricProcessInstance entity.
-// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldRootProcessInstanceID(ctx context.Context) (v string, err error) {
+func (m *GroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRootProcessInstanceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRootProcessInstanceID requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRootProcessInstanceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.RootProcessInstanceID, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearRootProcessInstanceID clears the value of the "root_process_instance_id" field.
-func (m *HistoricProcessInstanceMutation) ClearRootProcessInstanceID() {
-	m.root_process_instance_id = nil
-	m.clearedFields[historicprocessinstance.FieldRootProcessInstanceID] = struct{}{}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GroupMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// RootProcessInstanceIDCleared returns if the "root_process_instance_id" field was cleared in this mutation.
-func (m *HistoricProcessInstanceMutation) RootProcessInstanceIDCleared() bool {
-	_, ok := m.clearedFields[historicprocessinstance.FieldRootProcessInstanceID]
-	return ok
+// Where appends a list predicates to the GroupMutation builder.
+func (m *GroupMutation) Where(ps ...predicate.Group) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetRootProcessInstanceID resets all changes to the "root_process_instance_id" field.
-func (m *HistoricProcessInstanceMutation) ResetRootProcessInstanceID() {
-	m.root_process_instance_id = nil
-	delete(m.clearedFields, historicprocessinstance.FieldRootProcessInstanceID)
+// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Group, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetSuperCaseInstanceID sets the "super_case_instance_id" field.
-func (m *HistoricProcessInstanceMutation) SetSuperCaseInstanceID(s string) {
-	m.super_case_instance_id = &s
+// Op returns the operation name.
+func (m *GroupMutation) Op() Op {
+	return m.op
 }
 
-// SuperCaseInstanceID returns the value of the "super_case_instance_id" field in the mutation.
-func (m *HistoricProcessInstanceMutation) SuperCaseInstanceID() (r string, exists bool) {
-	v := m.super_case_instance_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetOp allows setting the mutation operation.
+func (m *GroupMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// OldSuperCaseInstanceID returns the old "super_case_instance_id" field's value of the HistoricProcessInstance entity.
-// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldSuperCaseInstanceID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSuperCaseInstanceID is only allowed on UpdateOne operations")
+// Type returns the node type of this mutation (Group).
+func (m *GroupMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GroupMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, group.FieldName)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSuperCaseInstanceID requires an ID field in the mutation")
+	if m.description != nil {
+		fields = append(fields, group.FieldDescription)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSuperCaseInstanceID: %w", err)
+	if m.created_at != nil {
+		fields = append(fields, group.FieldCreatedAt)
 	}
-	return oldValue.SuperCaseInstanceID, nil
+	if m.updated_at != nil {
+		fields = append(fields, group.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// ClearSuperCaseInstanceID clears the value of the "super_case_instance_id" field.
-func (m *HistoricProcessInstanceMutation) ClearSuperCaseInstanceID() {
-	m.super_case_instance_id = nil
-	m.clearedFields[historicprocessinstance.FieldSuperCaseInstanceID] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GroupMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case group.FieldName:
+		return m.Name()
+	case group.FieldDescription:
+		return m.Description()
+	case group.FieldCreatedAt:
+		return m.CreatedAt()
+	case group.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// SuperCaseInstanceIDCleared returns if the "super_case_instance_id" field was cleared in this mutation.
-func (m *HistoricProcessInstanceMutation) SuperCaseInstanceIDCleared() bool {
-	_, ok := m.clearedFields[historicprocessinstance.FieldSuperCaseInstanceID]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case group.FieldName:
+		return m.OldName(ctx)
+	case group.FieldDescription:
+		return m.OldDescription(ctx)
+	case group.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case group.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Group field %s", name)
 }
 
-// ResetSuperCaseInstanceID resets all changes to the "super_case_instance_id" field.
-func (m *HistoricProcessInstanceMutation) ResetSuperCaseInstanceID() {
-	m.super_case_instance_id = nil
-	delete(m.clearedFields, historicprocessinstance.FieldSuperCaseInstanceID)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case group.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case group.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case group.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case group.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Group field %s", name)
 }
 
-// SetCaseInstanceID sets the "case_instance_id" field.
-func (m *HistoricProcessInstanceMutation) SetCaseInstanceID(s string) {
-	m.case_instance_id = &s
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GroupMutation) AddedFields() []string {
+	return nil
 }
 
-// CaseInstanceID returns the value of the "case_instance_id" field in the mutation.
-func (m *HistoricProcessInstanceMutation) CaseInstanceID() (r string, exists bool) {
-	v := m.case_instance_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// OldCaseInstanceID returns the old "case_instance_id" field's value of the HistoricProcessInstance entity.
-// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldCaseInstanceID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCaseInstanceID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCaseInstanceID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCaseInstanceID: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return oldValue.CaseInstanceID, nil
+	return fmt.Errorf("unknown Group numeric field %s", name)
 }
 
-// ClearCaseInstanceID clears the value of the "case_instance_id" field.
-func (m *HistoricProcessInstanceMutation) ClearCaseInstanceID() {
-	m.case_instance_id = nil
-	m.clearedFields[historicprocessinstance.FieldCaseInstanceID] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GroupMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(group.FieldDescription) {
+		fields = append(fields, group.FieldDescription)
+	}
+	return fields
 }
 
-// CaseInstanceIDCleared returns if the "case_instance_id" field was cleared in this mutation.
-func (m *HistoricProcessInstanceMutation) CaseInstanceIDCleared() bool {
-	_, ok := m.clearedFields[historicprocessinstance.FieldCaseInstanceID]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GroupMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetCaseInstanceID resets all changes to the "case_instance_id" field.
-func (m *HistoricProcessInstanceMutation) ResetCaseInstanceID() {
-	m.case_instance_id = nil
-	delete(m.clearedFields, historicprocessinstance.FieldCaseInstanceID)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GroupMutation) ClearField(name string) error {
+	switch name {
+	case group.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Group nullable field %s", name)
 }
 
-// SetDeleteReason sets the "delete_reason" field.
-func (m *HistoricProcessInstanceMutation) SetDeleteReason(s string) {
-	m.delete_reason = &s
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GroupMutation) ResetField(name string) error {
+	switch name {
+	case group.FieldName:
+		m.ResetName()
+		return nil
+	case group.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case group.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case group.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Group field %s", name)
 }
 
-// DeleteReason returns the value of the "delete_reason" field in the mutation.
-func (m *HistoricProcessInstanceMutation) DeleteReason() (r string, exists bool) {
-	v := m.delete_reason
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldDeleteReason returns the old "delete_reason" field's value of the HistoricProcessInstance entity.
-// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldDeleteReason(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeleteReason is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeleteReason requires an ID field in the mutation")
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GroupMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GroupMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GroupMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Group unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GroupMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Group edge %s", name)
+}
+
+// HistoricProcessInstanceMutation represents an operation that mutates the HistoricProcessInstance nodes in the graph.
+type HistoricProcessInstanceMutation struct {
+	config
+	op                            Op
+	typ                           string
+	id                            *int64
+	process_instance_id           *string
+	business_key                  *string
+	process_definition_id         *int64
+	addprocess_definition_id      *int64
+	process_definition_key        *string
+	process_definition_name       *string
+	process_definition_version    *int32
+	addprocess_definition_version *int32
+	deployment_id                 *string
+	start_user_id                 *string
+	start_time                    *time.Time
+	end_time                      *time.Time
+	duration                      *int64
+	addduration                   *int64
+	start_activity_id             *string
+	end_activity_id               *string
+	super_process_instance_id     *string
+	root_process_instance_id      *string
+	super_case_instance_id        *string
+	case_instance_id              *string
+	delete_reason                 *string
+	tenant_id                     *string
+	state                         *string
+	removal_time                  *string
+	created_at                    *time.Time
+	updated_at                    *time.Time
+	clearedFields                 map[string]struct{}
+	done                          bool
+	oldValue                      func(context.Context) (*HistoricProcessInstance, error)
+	predicates                    []predicate.HistoricProcessInstance
+}
+
+var _ ent.Mutation = (*HistoricProcessInstanceMutation)(nil)
+
+// historicprocessinstanceOption allows management of the mutation configuration using functional options.
+type historicprocessinstanceOption func(*HistoricProcessInstanceMutation)
+
+// newHistoricProcessInstanceMutation creates new mutation for the HistoricProcessInstance entity.
+func newHistoricProcessInstanceMutation(c config, op Op, opts ...historicprocessinstanceOption) *HistoricProcessInstanceMutation {
+	m := &HistoricProcessInstanceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHistoricProcessInstance,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeleteReason: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.DeleteReason, nil
+	return m
 }
 
-// ClearDeleteReason clears the value of the "delete_reason" field.
-func (m *HistoricProcessInstanceMutation) ClearDeleteReason() {
-	m.delete_reason = nil
-	m.clearedFields[historicprocessinstance.FieldDeleteReason] = struct{}{}
+// withHistoricProcessInstanceID sets the ID field of the mutation.
+func withHistoricProcessInstanceID(id int64) historicprocessinstanceOption {
+	return func(m *HistoricProcessInstanceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *HistoricProcessInstance
+		)
+		m.oldValue = func(ctx context.Context) (*HistoricProcessInstance, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().HistoricProcessInstance.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// DeleteReasonCleared returns if the "delete_reason" field was cleared in this mutation.
-func (m *HistoricProcessInstanceMutation) DeleteReasonCleared() bool {
-	_, ok := m.clearedFields[historicprocessinstance.FieldDeleteReason]
-	return ok
+// withHistoricProcessInstance sets the old HistoricProcessInstance of the mutation.
+func withHistoricProcessInstance(node *HistoricProcessInstance) historicprocessinstanceOption {
+	return func(m *HistoricProcessInstanceMutation) {
+		m.oldValue = func(context.Context) (*HistoricProcessInstance, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ResetDeleteReason resets all changes to the "delete_reason" field.
-func (m *HistoricProcessInstanceMutation) ResetDeleteReason() {
-	m.delete_reason = nil
-	delete(m.clearedFields, historicprocessinstance.FieldDeleteReason)
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HistoricProcessInstanceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetTenantID sets the "tenant_id" field.
-func (m *HistoricProcessInstanceMutation) SetTenantID(s string) {
-	m.tenant_id = &s
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HistoricProcessInstanceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *HistoricProcessInstanceMutation) TenantID() (r string, exists bool) {
-	v := m.tenant_id
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of HistoricProcessInstance entities.
+func (m *HistoricProcessInstanceMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HistoricProcessInstanceMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HistoricProcessInstanceMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().HistoricProcessInstance.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (m *HistoricProcessInstanceMutation) SetProcessInstanceID(s string) {
+	m.process_instance_id = &s
+}
+
+// ProcessInstanceID returns the value of the "process_instance_id" field in the mutation.
+func (m *HistoricProcessInstanceMutation) ProcessInstanceID() (r string, exists bool) {
+	v := m.process_instance_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the HistoricProcessInstance entity.
+// OldProcessInstanceID returns the old "process_instance_id" field's value of the HistoricProcessInstance entity.
 // If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldTenantID(ctx context.Context) (v string, err error) {
+func (m *HistoricProcessInstanceMutation) OldProcessInstanceID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+		return v, errors.New("OldProcessInstanceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTenantID requires an ID field in the mutation")
+		return v, errors.New("OldProcessInstanceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+		return v, fmt.Errorf("querying old value for OldProcessInstanceID: %w", err)
 	}
-	return oldValue.TenantID, nil
+	return oldValue.ProcessInstanceID, nil
 }
 
-// ResetTenantID resets all changes to the "tenant_id" field.
-func (m *HistoricProcessInstanceMutation) ResetTenantID() {
-	m.tenant_id = nil
+// ResetProcessInstanceID resets all changes to the "process_instance_id" field.
+func (m *HistoricProcessInstanceMutation) ResetProcessInstanceID() {
+	m.process_instance_id = nil
 }
 
-// SetState sets the "state" field.
-func (m *HistoricProcessInstanceMutation) SetState(s string) {
-	m.state = &s
+// SetBusinessKey sets the "business_key" field.
+func (m *HistoricProcessInstanceMutation) SetBusinessKey(s string) {
+	m.business_key = &s
 }
 
-// State returns the value of the "state" field in the mutation.
-func (m *HistoricProcessInstanceMutation) State() (r string, exists bool) {
-	v := m.state
+// BusinessKey returns the value of the "business_key" field in the mutation.
+func (m *HistoricProcessInstanceMutation) BusinessKey() (r string, exists bool) {
+	v := m.business_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldState returns the old "state" field's value of the HistoricProcessInstance entity.
+// OldBusinessKey returns the old "business_key" field's value of the HistoricProcessInstance entity.
 // If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldState(ctx context.Context) (v string, err error) {
+func (m *HistoricProcessInstanceMutation) OldBusinessKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldState is only allowed on UpdateOne operations")
+		return v, errors.New("OldBusinessKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldState requires an ID field in the mutation")
+		return v, errors.New("OldBusinessKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldState: %w", err)
+		return v, fmt.Errorf("querying old value for OldBusinessKey: %w", err)
 	}
-	return oldValue.State, nil
+	return oldValue.BusinessKey, nil
 }
 
-// ClearState clears the value of the "state" field.
-func (m *HistoricProcessInstanceMutation) ClearState() {
-	m.state = nil
-	m.clearedFields[historicprocessinstance.FieldState] = struct{}{}
+// ClearBusinessKey clears the value of the "business_key" field.
+func (m *HistoricProcessInstanceMutation) ClearBusinessKey() {
+	m.business_key = nil
+	m.clearedFields[historicprocessinstance.FieldBusinessKey] = struct{}{}
 }
 
-// StateCleared returns if the "state" field was cleared in this mutation.
-func (m *HistoricProcessInstanceMutation) StateCleared() bool {
-	_, ok := m.clearedFields[historicprocessinstance.FieldState]
+// BusinessKeyCleared returns if the "business_key" field was cleared in this mutation.
+func (m *HistoricProcessInstanceMutation) BusinessKeyCleared() bool {
+	_, ok := m.clearedFields[historicprocessinstance.FieldBusinessKey]
 	return ok
 }
 
-// ResetState resets all changes to the "state" field.
-func (m *HistoricProcessInstanceMutation) ResetState() {
-	m.state = nil
-	delete(m.clearedFields, historicprocessinstance.FieldState)
-}
-
-// SetRemovalTime sets the "removal_time" field.
-func (m *HistoricProcessInstanceMutation) SetRemovalTime(s string) {
-	m.removal_time = &s
+// ResetBusinessKey resets all changes to the "business_key" field.
+func (m *HistoricProcessInstanceMutation) ResetBusinessKey() {
+	m.business_key = nil
+	delete(m.clearedFields, historicprocessinstance.FieldBusinessKey)
 }
 
-// RemovalTime returns the value of the "removal_time" field in the mutation.
-func (m *HistoricProcessInstanceMutation) RemovalTime() (r string, exists bool) {
-	v := m.removal_time
+// SetProcessDefinitionID sets the "process_definition_id" field.
+func (m *HistoricProcessInstanceMutation) SetProcessDefinitionID(i int64) {
+	m.process_definition_id = &i
+	m.addprocess_definition_id = nil
+}
+
+// ProcessDefinitionID returns the value of the "process_definition_id" field in the mutation.
+func (m *HistoricProcessInstanceMutation) ProcessDefinitionID() (r int64, exists bool) {
+	v := m.process_definition_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRemovalTime returns the old "removal_time" field's value of the HistoricProcessInstance entity.
+// OldProcessDefinitionID returns the old "process_definition_id" field's value of the HistoricProcessInstance entity.
 // If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldRemovalTime(ctx context.Context) (v string, err error) {
+func (m *HistoricProcessInstanceMutation) OldProcessDefinitionID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRemovalTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldProcessDefinitionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRemovalTime requires an ID field in the mutation")
+		return v, errors.New("OldProcessDefinitionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRemovalTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldProcessDefinitionID: %w", err)
 	}
-	return oldValue.RemovalTime, nil
+	return oldValue.ProcessDefinitionID, nil
 }
 
-// ClearRemovalTime clears the value of the "removal_time" field.
-func (m *HistoricProcessInstanceMutation) ClearRemovalTime() {
-	m.removal_time = nil
-	m.clearedFields[historicprocessinstance.FieldRemovalTime] = struct{}{}
+// AddProcessDefinitionID adds i to the "process_definition_id" field.
+func (m *HistoricProcessInstanceMutation) AddProcessDefinitionID(i int64) {
+	if m.addprocess_definition_id != nil {
+		*m.addprocess_definition_id += i
+	} else {
+		m.addprocess_definition_id = &i
+	}
 }
 
-// RemovalTimeCleared returns if the "removal_time" field was cleared in this mutation.
-func (m *HistoricProcessInstanceMutation) RemovalTimeCleared() bool {
-	_, ok := m.clearedFields[historicprocessinstance.FieldRemovalTime]
-	return ok
+// AddedProcessDefinitionID returns the value that was added to the "process_definition_id" field in this mutation.
+func (m *HistoricProcessInstanceMutation) AddedProcessDefinitionID() (r int64, exists bool) {
+	v := m.addprocess_definition_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetRemovalTime resets all changes to the "removal_time" field.
-func (m *HistoricProcessInstanceMutation) ResetRemovalTime() {
-	m.removal_time = nil
-	delete(m.clearedFields, historicprocessinstance.FieldRemovalTime)
+// ResetProcessDefinitionID resets all changes to the "process_definition_id" field.
+func (m *HistoricProcessInstanceMutation) ResetProcessDefinitionID() {
+	m.process_definition_id = nil
+	m.addprocess_definition_id = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *HistoricProcessInstanceMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetProcessDefinitionKey sets the "process_definition_key" field.
+func (m *HistoricProcessInstanceMutation) SetProcessDefinitionKey(s string) {
+	m.process_definition_key = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *HistoricProcessInstanceMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ProcessDefinitionKey returns the value of the "process_definition_key" field in the mutation.
+func (m *HistoricProcessInstanceMutation) ProcessDefinitionKey() (r string, exists bool) {
+	v := m.process_definition_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the HistoricProcessInstance entity.
+// OldProcessDefinitionKey returns the old "process_definition_key" field's value of the HistoricProcessInstance entity.
 // If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *HistoricProcessInstanceMutation) OldProcessDefinitionKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldProcessDefinitionKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldProcessDefinitionKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldProcessDefinitionKey: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.ProcessDefinitionKey, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *HistoricProcessInstanceMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetProcessDefinitionKey resets all changes to the "process_definition_key" field.
+func (m *HistoricProcessInstanceMutation) ResetProcessDefinitionKey() {
+	m.process_definition_key = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *HistoricProcessInstanceMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetProcessDefinitionName sets the "process_definition_name" field.
+func (m *HistoricProcessInstanceMutation) SetProcessDefinitionName(s string) {
+	m.process_definition_name = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *HistoricProcessInstanceMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ProcessDefinitionName returns the value of the "process_definition_name" field in the mutation.
+func (m *HistoricProcessInstanceMutation) ProcessDefinitionName() (r string, exists bool) {
+	v := m.process_definition_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the HistoricProcessInstance entity.
+// OldProcessDefinitionName returns the old "process_definition_name" field's value of the HistoricProcessInstance entity.
 // If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HistoricProcessInstanceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *HistoricProcessInstanceMutation) OldProcessDefinitionName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldProcessDefinitionName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldProcessDefinitionName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldProcessDefinitionName: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *HistoricProcessInstanceMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+	return oldValue.ProcessDefinitionName, nil
 }
 
-// Where appends a list predicates to the HistoricProcessInstanceMutation builder.
-func (m *HistoricProcessInstanceMutation) Where(ps ...predicate.HistoricProcessInstance) {
-	m.predicates = append(m.predicates, ps...)
+// ClearProcessDefinitionName clears the value of the "process_definition_name" field.
+func (m *HistoricProcessInstanceMutation) ClearProcessDefinitionName() {
+	m.process_definition_name = nil
+	m.clearedFields[historicprocessinstance.FieldProcessDefinitionName] = struct{}{}
 }
 
-// WhereP appends storage-level predicates to the HistoricProcessInstanceMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *HistoricProcessInstanceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.HistoricProcessInstance, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ProcessDefinitionNameCleared returns if the "process_definition_name" field was cleared in this mutation.
+func (m *HistoricProcessInstanceMutation) ProcessDefinitionNameCleared() bool {
+	_, ok := m.clearedFields[historicprocessinstance.FieldProcessDefinitionName]
+	return ok
 }
 
-// Op returns the operation name.
-func (m *HistoricProcessInstanceMutation) Op() Op {
-	return m.op
+// ResetProcessDefinitionName resets all changes to the "process_definition_name" field.
+func (m *HistoricProcessInstanceMutation) ResetProcessDefinitionName() {
+	m.process_definition_name = nil
+	delete(m.clearedFields, historicprocessinstance.FieldProcessDefinitionName)
 }
 
-// SetOp allows setting the mutation operation.
-func (m *HistoricProcessInstanceMutation) SetOp(op Op) {
-	m.op = op
+// SetProcessDefinitionVersion sets the "process_definition_version" field.
+func (m *HistoricProcessInstanceMutation) SetProcessDefinitionVersion(i int32) {
+	m.process_definition_version = &i
+	m.addprocess_definition_version = nil
 }
 
-// Type returns the node type of this mutation (HistoricProcessInstance).
-func (m *HistoricProcessInstanceMutation) Type() string {
-	return m.typ
+// ProcessDefinitionVersion returns the value of the "process_definition_version" field in the mutation.
+func (m *HistoricProcessInstanceMutation) ProcessDefinitionVersion() (r int32, exists bool) {
+	v := m.process_definition_version
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *HistoricProcessInstanceMutation) Fields() []string {
-	fields := make([]string, 0, 23)
-	if m.process_instance_id != nil {
-		fields = append(fields, historicprocessinstance.FieldProcessInstanceID)
-	}
-	if m.business_key != nil {
-		fields = append(fields, historicprocessinstance.FieldBusinessKey)
-	}
-	if m.process_definition_id != nil {
-		fields = append(fields, historicprocessinstance.FieldProcessDefinitionID)
-	}
-	if m.process_definition_key != nil {
-		fields = append(fields, historicprocessinstance.FieldProcessDefinitionKey)
+// OldProcessDefinitionVersion returns the old "process_definition_version" field's value of the HistoricProcessInstance entity.
+// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoricProcessInstanceMutation) OldProcessDefinitionVersion(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessDefinitionVersion is only allowed on UpdateOne operations")
 	}
-	if m.process_definition_name != nil {
-		fields = append(fields, historicprocessinstance.FieldProcessDefinitionName)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessDefinitionVersion requires an ID field in the mutation")
 	}
-	if m.process_definition_version != nil {
-		fields = append(fields, historicprocessinstance.FieldProcessDefinitionVersion)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessDefinitionVersion: %w", err)
 	}
-	if m.deployment_id != nil {
-		fields = append(fields, historicprocessinstance.FieldDeploymentID)
+	return oldValue.ProcessDefinitionVersion, nil
+}
+
+// AddProcessDefinitionVersion adds i to the "process_definition_version" field.
+func (m *HistoricProcessInstanceMutation) AddProcessDefinitionVersion(i int32) {
+	if m.addprocess_definition_version != nil {
+		*m.addprocess_definition_version += i
+	} else {
+		m.addprocess_definition_version = &i
 	}
-	if m.start_user_id != nil {
-		fields = append(fields, historicprocessinstance.FieldStartUserID)
+}
+
+// AddedProcessDefinitionVersion returns the value that was added to the "process_definition_version" field in this mutation.
+func (m *HistoricProcessInstanceMutation) AddedProcessDefinitionVersion() (r int32, exists bool) {
+	v := m.addprocess_definition_version
+	if v == nil {
+		return
 	}
-	if m.start_time != nil {
-		fields = append(fields, historicprocessinstance.FieldStartTime)
+	return *v, true
+}
+
+// ResetProcessDefinitionVersion resets all changes to the "process_definition_version" field.
+func (m *HistoricProcessInstanceMutation) ResetProcessDefinitionVersion() {
+	m.process_definition_version = nil
+	m.addprocess_definition_version = nil
+}
+
+// SetDeploymentID sets the "deployment_id" field.
+func (m *HistoricProcessInstanceMutation) SetDeploymentID(s string) {
+	m.deployment_id = &s
+}
+
+// DeploymentID returns the value of the "deployment_id" field in the mutation.
+func (m *HistoricProcessInstanceMutation) DeploymentID() (r string, exists bool) {
+	v := m.deployment_id
+	if v == nil {
+		return
 	}
-	if m.end_time != nil {
-		fields = append(fields, historicprocessinstance.FieldEndTime)
+	return *v, true
+}
+
+// OldDeploymentID returns the old "deployment_id" field's value of the HistoricProcessInstance entity.
+// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoricProcessInstanceMutation) OldDeploymentID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeploymentID is only allowed on UpdateOne operations")
 	}
-	if m.duration != nil {
-		fields = append(fields, historicprocessinstance.FieldDuration)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeploymentID requires an ID field in the mutation")
 	}
-	if m.start_activity_id != nil {
-		fields = append(fields, historicprocessinstance.FieldStartActivityID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeploymentID: %w", err)
 	}
-	if m.end_activity_id != nil {
-		fields = append(fields, historicprocessinstance.FieldEndActivityID)
-	}
-	if m.super_process_instance_id != nil {
-		fields = append(fields, historicprocessinstance.FieldSuperProcessInstanceID)
-	}
-	if m.root_process_instance_id != nil {
-		fields = append(fields, historicprocessinstance.FieldRootProcessInstanceID)
-	}
-	if m.super_case_instance_id != nil {
-		fields = append(fields, historicprocessinstance.FieldSuperCaseInstanceID)
+	return oldValue.DeploymentID, nil
+}
+
+// ClearDeploymentID clears the value of the "deployment_id" field.
+func (m *HistoricProcessInstanceMutation) ClearDeploymentID() {
+	m.deployment_id = nil
+	m.clearedFields[historicprocessinstance.FieldDeploymentID] = struct{}{}
+}
+
+// DeploymentIDCleared returns if the "deployment_id" field was cleared in this mutation.
+func (m *HistoricProcessInstanceMutation) DeploymentIDCleared() bool {
+	_, ok := m.clearedFields[historicprocessinstance.FieldDeploymentID]
+	return ok
+}
+
+// ResetDeploymentID resets all changes to the "deployment_id" field.
+func (m *HistoricProcessInstanceMutation) ResetDeploymentID() {
+	m.deployment_id = nil
+	delete(m.clearedFields, historicprocessinstance.FieldDeploymentID)
+}
+
+// SetStartUserID sets the "start_user_id" field.
+func (m *HistoricProcessInstanceMutation) SetStartUserID(s string) {
+	m.start_user_id = &s
+}
+
+// StartUserID returns the value of the "start_user_id" field in the mutation.
+func (m *HistoricProcessInstanceMutation) StartUserID() (r string, exists bool) {
+	v := m.start_user_id
+	if v == nil {
+		return
 	}
-	if m.case_instance_id != nil {
-		fields = append(fields, historicprocessinstance.FieldCaseInstanceID)
+	return *v, true
+}
+
+// OldStartUserID returns the old "start_user_id" field's value of the HistoricProcessInstance entity.
+// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoricProcessInstanceMutation) OldStartUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartUserID is only allowed on UpdateOne operations")
 	}
-	if m.delete_reason != nil {
-		fields = append(fields, historicprocessinstance.FieldDeleteReason)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartUserID requires an ID field in the mutation")
 	}
-	if m.tenant_id != nil {
-		fields = append(fields, historicprocessinstance.FieldTenantID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartUserID: %w", err)
 	}
-	if m.state != nil {
-		fields = append(fields, historicprocessinstance.FieldState)
+	return oldValue.StartUserID, nil
+}
+
+// ClearStartUserID clears the value of the "start_user_id" field.
+func (m *HistoricProcessInstanceMutation) ClearStartUserID() {
+	m.start_user_id = nil
+	m.clearedFields[historicprocessinstance.FieldStartUserID] = struct{}{}
+}
+
+// StartUserIDCleared returns if the "start_user_id" field was cleared in this mutation.
+func (m *HistoricProcessInstanceMutation) StartUserIDCleared() bool {
+	_, ok := m.clearedFields[historicprocessinstance.FieldStartUserID]
+	return ok
+}
+
+// ResetStartUserID resets all changes to the "start_user_id" field.
+func (m *HistoricProcessInstanceMutation) ResetStartUserID() {
+	m.start_user_id = nil
+	delete(m.clearedFields, historicprocessinstance.FieldStartUserID)
+}
+
+// SetStartTime sets the "start_time" field.
+func (m *HistoricProcessInstanceMutation) SetStartTime(t time.Time) {
+	m.start_time = &t
+}
+
+// StartTime returns the value of the "start_time" field in the mutation.
+func (m *HistoricProcessInstanceMutation) StartTime() (r time.Time, exists bool) {
+	v := m.start_time
+	if v == nil {
+		return
 	}
-	if m.removal_time != nil {
-		fields = append(fields, historicprocessinstance.FieldRemovalTime)
+	return *v, true
+}
+
+// OldStartTime returns the old "start_time" field's value of the HistoricProcessInstance entity.
+// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoricProcessInstanceMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
 	}
-	if m.created_at != nil {
-		fields = append(fields, historicprocessinstance.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, historicprocessinstance.FieldUpdatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
 	}
-	return fields
+	return oldValue.StartTime, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *HistoricProcessInstanceMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case historicprocessinstance.FieldProcessInstanceID:
-		return m.ProcessInstanceID()
-	case historicprocessinstance.FieldBusinessKey:
-		return m.BusinessKey()
-	case historicprocessinstance.FieldProcessDefinitionID:
-		return m.ProcessDefinitionID()
-	case historicprocessinstance.FieldProcessDefinitionKey:
-		return m.ProcessDefinitionKey()
-	case historicprocessinstance.FieldProcessDefinitionName:
-		return m.ProcessDefinitionName()
-	case historicprocessinstance.FieldProcessDefinitionVersion:
-		return m.ProcessDefinitionVersion()
-	case historicprocessinstance.FieldDeploymentID:
-		return m.DeploymentID()
-	case historicprocessinstance.FieldStartUserID:
-		return m.StartUserID()
-	case historicprocessinstance.FieldStartTime:
-		return m.StartTime()
-	case historicprocessinstance.FieldEndTime:
-		return m.EndTime()
-	case historicprocessinstance.FieldDuration:
-		return m.Duration()
-	case historicprocessinstance.FieldStartActivityID:
-		return m.StartActivityID()
-	case historicprocessinstance.FieldEndActivityID:
-		return m.EndActivityID()
-	case historicprocessinstance.FieldSuperProcessInstanceID:
-		return m.SuperProcessInstanceID()
-	case historicprocessinstance.FieldRootProcessInstanceID:
-		return m.RootProcessInstanceID()
-	case historicprocessinstance.FieldSuperCaseInstanceID:
-		return m.SuperCaseInstanceID()
-	case historicprocessinstance.FieldCaseInstanceID:
-		return m.CaseInstanceID()
-	case historicprocessinstance.FieldDeleteReason:
-		return m.DeleteReason()
-	case historicprocessinstance.FieldTenantID:
-		return m.TenantID()
-	case historicprocessinstance.FieldState:
-		return m.State()
-	case historicprocessinstance.FieldRemovalTime:
-		return m.RemovalTime()
-	case historicprocessinstance.FieldCreatedAt:
-		return m.CreatedAt()
-	case historicprocessinstance.FieldUpdatedAt:
-		return m.UpdatedAt()
+// ResetStartTime resets all changes to the "start_time" field.
+func (m *HistoricProcessInstanceMutation) ResetStartTime() {
+	m.start_time = nil
+}
+
+// SetEndTime sets the "end_time" field.
+func (m *HistoricProcessInstanceMutation) SetEndTime(t time.Time) {
+	m.end_time = &t
+}
+
+// EndTime returns the value of the "end_time" field in the mutation.
+func (m *HistoricProcessInstanceMutation) EndTime() (r time.Time, exists bool) {
+	v := m.end_time
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *HistoricProcessInstanceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case historicprocessinstance.FieldProcessInstanceID:
-		return m.OldProcessInstanceID(ctx)
-	case historicprocessinstance.FieldBusinessKey:
-		return m.OldBusinessKey(ctx)
-	case historicprocessinstance.FieldProcessDefinitionID:
-		return m.OldProcessDefinitionID(ctx)
-	case historicprocessinstance.FieldProcessDefinitionKey:
-		return m.OldProcessDefinitionKey(ctx)
-	case historicprocessinstance.FieldProcessDefinitionName:
-		return m.OldProcessDefinitionName(ctx)
-	case historicprocessinstance.FieldProcessDefinitionVersion:
-		return m.OldProcessDefinitionVersion(ctx)
-	case historicprocessinstance.FieldDeploymentID:
-		return m.OldDeploymentID(ctx)
-	case historicprocessinstance.FieldStartUserID:
-		return m.OldStartUserID(ctx)
-	case historicprocessinstance.FieldStartTime:
-		return m.OldStartTime(ctx)
-	case historicprocessinstance.FieldEndTime:
-		return m.OldEndTime(ctx)
-	case historicprocessinstance.FieldDuration:
-		return m.OldDuration(ctx)
-	case historicprocessinstance.FieldStartActivityID:
-		return m.OldStartActivityID(ctx)
-	case historicprocessinstance.FieldEndActivityID:
-		return m.OldEndActivityID(ctx)
-	case historicprocessinstance.FieldSuperProcessInstanceID:
-		return m.OldSuperProcessInstanceID(ctx)
-	case historicprocessinstance.FieldRootProcessInstanceID:
-		return m.OldRootProcessInstanceID(ctx)
-	case historicprocessinstance.FieldSuperCaseInstanceID:
-		return m.OldSuperCaseInstanceID(ctx)
-	case historicprocessinstance.FieldCaseInstanceID:
-		return m.OldCaseInstanceID(ctx)
-	case historicprocessinstance.FieldDeleteReason:
-		return m.OldDeleteReason(ctx)
-	case historicprocessinstance.FieldTenantID:
-		return m.OldTenantID(ctx)
-	case historicprocessinstance.FieldState:
-		return m.OldState(ctx)
-	case historicprocessinstance.FieldRemovalTime:
-		return m.OldRemovalTime(ctx)
-	case historicprocessinstance.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case historicprocessinstance.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// OldEndTime returns the old "end_time" field's value of the HistoricProcessInstance entity.
+// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoricProcessInstanceMutation) OldEndTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
 	}
-	return nil, fmt.Errorf("unknown HistoricProcessInstance field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+	}
+	return oldValue.EndTime, nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *HistoricProcessInstanceMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case historicprocessinstance.FieldProcessInstanceID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProcessInstanceID(v)
-		return nil
-	case historicprocessinstance.FieldBusinessKey:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBusinessKey(v)
-		return nil
-	case historicprocessinstance.FieldProcessDefinitionID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProcessDefinitionID(v)
-		return nil
-	case historicprocessinstance.FieldProcessDefinitionKey:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProcessDefinitionKey(v)
-		return nil
-	case historicprocessinstance.FieldProcessDefinitionName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProcessDefinitionName(v)
-		return nil
-	case historicprocessinstance.FieldProcessDefinitionVersion:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProcessDefinitionVersion(v)
-		return nil
-	case historicprocessinstance.FieldDeploymentID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeploymentID(v)
-		return nil
-	case historicprocessinstance.FieldStartUserID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartUserID(v)
-		return nil
-	case historicprocessinstance.FieldStartTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartTime(v)
-		return nil
-	case historicprocessinstance.FieldEndTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEndTime(v)
-		return nil
-	case historicprocessinstance.FieldDuration:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDuration(v)
-		return nil
-	case historicprocessinstance.FieldStartActivityID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartActivityID(v)
-		return nil
-	case historicprocessinstance.FieldEndActivityID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEndActivityID(v)
-		return nil
-	case historicprocessinstance.FieldSuperProcessInstanceID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSuperProcessInstanceID(v)
-		return nil
-	case historicprocessinstance.FieldRootProcessInstanceID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRootProcessInstanceID(v)
-		return nil
-	case historicprocessinstance.FieldSuperCaseInstanceID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSuperCaseInstanceID(v)
-		return nil
-	case historicprocessinstance.FieldCaseInstanceID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCaseInstanceID(v)
-		return nil
-	case historicprocessinstance.FieldDeleteReason:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeleteReason(v)
-		return nil
-	case historicprocessinstance.FieldTenantID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTenantID(v)
-		return nil
-	case historicprocessinstance.FieldState:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetState(v)
-		return nil
-	case historicprocessinstance.FieldRemovalTime:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRemovalTime(v)
-		return nil
-	case historicprocessinstance.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case historicprocessinstance.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
+// ClearEndTime clears the value of the "end_time" field.
+func (m *HistoricProcessInstanceMutation) ClearEndTime() {
+	m.end_time = nil
+	m.clearedFields[historicprocessinstance.FieldEndTime] = struct{}{}
+}
+
+// EndTimeCleared returns if the "end_time" field was cleared in this mutation.
+func (m *HistoricProcessInstanceMutation) EndTimeCleared() bool {
+	_, ok := m.clearedFields[historicprocessinstance.FieldEndTime]
+	return ok
+}
+
+// ResetEndTime resets all changes to the "end_time" field.
+func (m *HistoricProcessInstanceMutation) ResetEndTime() {
+	m.end_time = nil
+	delete(m.clearedFields, historicprocessinstance.FieldEndTime)
+}
+
+// SetDuration sets the "duration" field.
+func (m *HistoricProcessInstanceMutation) SetDuration(i int64) {
+	m.duration = &i
+	m.addduration = nil
+}
+
+// Duration returns the value of the "duration" field in the mutation.
+func (m *HistoricProcessInstanceMutation) Duration() (r int64, exists bool) {
+	v := m.duration
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown HistoricProcessInstance field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *HistoricProcessInstanceMutation) AddedFields() []string {
-	var fields []string
-	if m.addprocess_definition_id != nil {
-		fields = append(fields, historicprocessinstance.FieldProcessDefinitionID)
+// OldDuration returns the old "duration" field's value of the HistoricProcessInstance entity.
+// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoricProcessInstanceMutation) OldDuration(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDuration is only allowed on UpdateOne operations")
 	}
-	if m.addprocess_definition_version != nil {
-		fields = append(fields, historicprocessinstance.FieldProcessDefinitionVersion)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDuration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
 	}
+	return oldValue.Duration, nil
+}
+
+// AddDuration adds i to the "duration" field.
+func (m *HistoricProcessInstanceMutation) AddDuration(i int64) {
 	if m.addduration != nil {
-		fields = append(fields, historicprocessinstance.FieldDuration)
+		*m.addduration += i
+	} else {
+		m.addduration = &i
 	}
-	return fields
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *HistoricProcessInstanceMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case historicprocessinstance.FieldProcessDefinitionID:
-		return m.AddedProcessDefinitionID()
-	case historicprocessinstance.FieldProcessDefinitionVersion:
-		return m.AddedProcessDefinitionVersion()
-	case historicprocessinstance.FieldDuration:
-		return m.AddedDuration()
+// AddedDuration returns the value that was added to the "duration" field in this mutation.
+func (m *HistoricProcessInstanceMutation) AddedDuration() (r int64, exists bool) {
+	v := m.addduration
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *HistoricProcessInstanceMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case historicprocessinstance.FieldProcessDefinitionID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddProcessDefinitionID(v)
-		return nil
-	case historicprocessinstance.FieldProcessDefinitionVersion:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddProcessDefinitionVersion(v)
-		return nil
-	case historicprocessinstance.FieldDuration:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDuration(v)
-		return nil
+// ClearDuration clears the value of the "duration" field.
+func (m *HistoricProcessInstanceMutation) ClearDuration() {
+	m.duration = nil
+	m.addduration = nil
+	m.clearedFields[historicprocessinstance.FieldDuration] = struct{}{}
+}
+
+// DurationCleared returns if the "duration" field was cleared in this mutation.
+func (m *HistoricProcessInstanceMutation) DurationCleared() bool {
+	_, ok := m.clearedFields[historicprocessinstance.FieldDuration]
+	return ok
+}
+
+// ResetDuration resets all changes to the "duration" field.
+func (m *HistoricProcessInstanceMutation) ResetDuration() {
+	m.duration = nil
+	m.addduration = nil
+	delete(m.clearedFields, historicprocessinstance.FieldDuration)
+}
+
+// SetStartActivityID sets the "start_activity_id" field.
+func (m *HistoricProcessInstanceMutation) SetStartActivityID(s string) {
+	m.start_activity_id = &s
+}
+
+// StartActivityID returns the value of the "start_activity_id" field in the mutation.
+func (m *HistoricProcessInstanceMutation) StartActivityID() (r string, exists bool) {
+	v := m.start_activity_id
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown HistoricProcessInstance numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *HistoricProcessInstanceMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(historicprocessinstance.FieldBusinessKey) {
-		fields = append(fields, historicprocessinstance.FieldBusinessKey)
+// OldStartActivityID returns the old "start_activity_id" field's value of the HistoricProcessInstance entity.
+// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoricProcessInstanceMutation) OldStartActivityID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartActivityID is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(historicprocessinstance.FieldProcessDefinitionName) {
-		fields = append(fields, historicprocessinstance.FieldProcessDefinitionName)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartActivityID requires an ID field in the mutation")
 	}
-	if m.FieldCleared(historicprocessinstance.FieldDeploymentID) {
-		fields = append(fields, historicprocessinstance.FieldDeploymentID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartActivityID: %w", err)
 	}
-	if m.FieldCleared(historicprocessinstance.FieldStartUserID) {
-		fields = append(fields, historicprocessinstance.FieldStartUserID)
+	return oldValue.StartActivityID, nil
+}
+
+// ClearStartActivityID clears the value of the "start_activity_id" field.
+func (m *HistoricProcessInstanceMutation) ClearStartActivityID() {
+	m.start_activity_id = nil
+	m.clearedFields[historicprocessinstance.FieldStartActivityID] = struct{}{}
+}
+
+// StartActivityIDCleared returns if the "start_activity_id" field was cleared in this mutation.
+func (m *HistoricProcessInstanceMutation) StartActivityIDCleared() bool {
+	_, ok := m.clearedFields[historicprocessinstance.FieldStartActivityID]
+	return ok
+}
+
+// ResetStartActivityID resets all changes to the "start_activity_id" field.
+func (m *HistoricProcessInstanceMutation) ResetStartActivityID() {
+	m.start_activity_id = nil
+	delete(m.clearedFields, historicprocessinstance.FieldStartActivityID)
+}
+
+// SetEndActivityID sets the "end_activity_id" field.
+func (m *HistoricProcessInstanceMutation) SetEndActivityID(s string) {
+	m.end_activity_id = &s
+}
+
+// EndActivityID returns the value of the "end_activity_id" field in the mutation.
+func (m *HistoricProcessInstanceMutation) EndActivityID() (r string, exists bool) {
+	v := m.end_activity_id
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(historicprocessinstance.FieldEndTime) {
-		fields = append(fields, historicprocessinstance.FieldEndTime)
+	return *v, true
+}
+
+// OldEndActivityID returns the old "end_activity_id" field's value of the HistoricProcessInstance entity.
+// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoricProcessInstanceMutation) OldEndActivityID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndActivityID is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(historicprocessinstance.FieldDuration) {
-		fields = append(fields, historicprocessinstance.FieldDuration)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndActivityID requires an ID field in the mutation")
 	}
-	if m.FieldCleared(historicprocessinstance.FieldStartActivityID) {
-		fields = append(fields, historicprocessinstance.FieldStartActivityID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndActivityID: %w", err)
 	}
-	if m.FieldCleared(historicprocessinstance.FieldEndActivityID) {
-		fields = append(fields, historicprocessinstance.FieldEndActivityID)
+	return oldValue.EndActivityID, nil
+}
+
+// ClearEndActivityID clears the value of the "end_activity_id" field.
+func (m *HistoricProcessInstanceMutation) ClearEndActivityID() {
+	m.end_activity_id = nil
+	m.clearedFields[historicprocessinstance.FieldEndActivityID] = struct{}{}
+}
+
+// EndActivityIDCleared returns if the "end_activity_id" field was cleared in this mutation.
+func (m *HistoricProcessInstanceMutation) EndActivityIDCleared() bool {
+	_, ok := m.clearedFields[historicprocessinstance.FieldEndActivityID]
+	return ok
+}
+
+// ResetEndActivityID resets all changes to the "end_activity_id" field.
+func (m *HistoricProcessInstanceMutation) ResetEndActivityID() {
+	m.end_activity_id = nil
+	delete(m.clearedFields, historicprocessinstance.FieldEndActivityID)
+}
+
+// SetSuperProcessInstanceID sets the "super_process_instance_id" field.
+func (m *HistoricProcessInstanceMutation) SetSuperProcessInstanceID(s string) {
+	m.super_process_instance_id = &s
+}
+
+// SuperProcessInstanceID returns the value of the "super_process_instance_id" field in the mutation.
+func (m *HistoricProcessInstanceMutation) SuperProcessInstanceID() (r string, exists bool) {
+	v := m.super_process_instance_id
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(historicprocessinstance.FieldSuperProcessInstanceID) {
-		fields = append(fields, historicprocessinstance.FieldSuperProcessInstanceID)
+	return *v, true
+}
+
+// OldSuperProcessInstanceID returns the old "super_process_instance_id" field's value of the HistoricProcessInstance entity.
+// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoricProcessInstanceMutation) OldSuperProcessInstanceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuperProcessInstanceID is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(historicprocessinstance.FieldRootProcessInstanceID) {
-		fields = append(fields, historicprocessinstance.FieldRootProcessInstanceID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuperProcessInstanceID requires an ID field in the mutation")
 	}
-	if m.FieldCleared(historicprocessinstance.FieldSuperCaseInstanceID) {
-		fields = append(fields, historicprocessinstance.FieldSuperCaseInstanceID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuperProcessInstanceID: %w", err)
 	}
-	if m.FieldCleared(historicprocessinstance.FieldCaseInstanceID) {
-		fields = append(fields, historicprocessinstance.FieldCaseInstanceID)
+	return oldValue.SuperProcessInstanceID, nil
+}
+
+// ClearSuperProcessInstanceID clears the value of the "super_process_instance_id" field.
+func (m *HistoricProcessInstanceMutation) ClearSuperProcessInstanceID() {
+	m.super_process_instance_id = nil
+	m.clearedFields[historicprocessinstance.FieldSuperProcessInstanceID] = struct{}{}
+}
+
+// SuperProcessInstanceIDCleared returns if the "super_process_instance_id" field was cleared in this mutation.
+func (m *HistoricProcessInstanceMutation) SuperProcessInstanceIDCleared() bool {
+	_, ok := m.clearedFields[historicprocessinstance.FieldSuperProcessInstanceID]
+	return ok
+}
+
+// ResetSuperProcessInstanceID resets all changes to the "super_process_instance_id" field.
+func (m *HistoricProcessInstanceMutation) ResetSuperProcessInstanceID() {
+	m.super_process_instance_id = nil
+	delete(m.clearedFields, historicprocessinstance.FieldSuperProcessInstanceID)
+}
+
+// SetRootProcessInstanceID sets the "root_process_instance_id" field.
+func (m *HistoricProcessInstanceMutation) SetRootProcessInstanceID(s string) {
+	m.root_process_instance_id = &s
+}
+
+// RootProcessInstanceID returns the value of the "root_process_instance_id" field in the mutation.
+func (m *HistoricProcessInstanceMutation) RootProcessInstanceID() (r string, exists bool) {
+	v := m.root_process_instance_id
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(historicprocessinstance.FieldDeleteReason) {
-		fields = append(fields, historicprocessinstance.FieldDeleteReason)
+	return *v, true
+}
+
+// OldRootProcessInstanceID returns the old "root_process_instance_id" field's value of the HistoricProcessInstance entity.
+// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoricProcessInstanceMutation) OldRootProcessInstanceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRootProcessInstanceID is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(historicprocessinstance.FieldState) {
-		fields = append(fields, historicprocessinstance.FieldState)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRootProcessInstanceID requires an ID field in the mutation")
 	}
-	if m.FieldCleared(historicprocessinstance.FieldRemovalTime) {
-		fields = append(fields, historicprocessinstance.FieldRemovalTime)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRootProcessInstanceID: %w", err)
 	}
-	return fields
+	return oldValue.RootProcessInstanceID, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *HistoricProcessInstanceMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearRootProcessInstanceID clears the value of the "root_process_instance_id" field.
+func (m *HistoricProcessInstanceMutation) ClearRootProcessInstanceID() {
+	m.root_process_instance_id = nil
+	m.clearedFields[historicprocessinstance.FieldRootProcessInstanceID] = struct{}{}
+}
+
+// RootProcessInstanceIDCleared returns if the "root_process_instance_id" field was cleared in this mutation.
+func (m *HistoricProcessInstanceMutation) RootProcessInstanceIDCleared() bool {
+	_, ok := m.clearedFields[historicprocessinstance.FieldRootProcessInstanceID]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *HistoricProcessInstanceMutation) ClearField(name string) error {
-	switch name {
-	case historicprocessinstance.FieldBusinessKey:
-		m.ClearBusinessKey()
-		return nil
-	case historicprocessinstance.FieldProcessDefinitionName:
-		m.ClearProcessDefinitionName()
-		return nil
-	case historicprocessinstance.FieldDeploymentID:
-		m.ClearDeploymentID()
-		return nil
-	case historicprocessinstance.FieldStartUserID:
-		m.ClearStartUserID()
-		return nil
-	case historicprocessinstance.FieldEndTime:
-		m.ClearEndTime()
-		return nil
-	case historicprocessinstance.FieldDuration:
-		m.ClearDuration()
-		return nil
-	case historicprocessinstance.FieldStartActivityID:
-		m.ClearStartActivityID()
-		return nil
-	case historicprocessinstance.FieldEndActivityID:
-		m.ClearEndActivityID()
-		return nil
-	case historicprocessinstance.FieldSuperProcessInstanceID:
-		m.ClearSuperProcessInstanceID()
-		return nil
-	case historicprocessinstance.FieldRootProcessInstanceID:
-		m.ClearRootProcessInstanceID()
-		return nil
-	case historicprocessinstance.FieldSuperCaseInstanceID:
-		m.ClearSuperCaseInstanceID()
-		return nil
-	case historicprocessinstance.FieldCaseInstanceID:
-		m.ClearCaseInstanceID()
-		return nil
-	case historicprocessinstance.FieldDeleteReason:
-		m.ClearDeleteReason()
-		return nil
-	case historicprocessinstance.FieldState:
-		m.ClearState()
-		return nil
-	case historicprocessinstance.FieldRemovalTime:
-		m.ClearRemovalTime()
-		return nil
+// ResetRootProcessInstanceID resets all changes to the "root_process_instance_id" field.
+func (m *HistoricProcessInstanceMutation) ResetRootProcessInstanceID() {
+	m.root_process_instance_id = nil
+	delete(m.clearedFields, historicprocessinstance.FieldRootProcessInstanceID)
+}
+
+// SetSuperCaseInstanceID sets the "super_case_instance_id" field.
+func (m *HistoricProcessInstanceMutation) SetSuperCaseInstanceID(s string) {
+	m.super_case_instance_id = &s
+}
+
+// SuperCaseInstanceID returns the value of the "super_case_instance_id" field in the mutation.
+func (m *HistoricProcessInstanceMutation) SuperCaseInstanceID() (r string, exists bool) {
+	v := m.super_case_instance_id
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown HistoricProcessInstance nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *HistoricProcessInstanceMutation) ResetField(name string) error {
-	switch name {
-	case historicprocessinstance.FieldProcessInstanceID:
-		m.ResetProcessInstanceID()
-		return nil
-	case historicprocessinstance.FieldBusinessKey:
-		m.ResetBusinessKey()
-		return nil
-	case historicprocessinstance.FieldProcessDefinitionID:
-		m.ResetProcessDefinitionID()
-		return nil
-	case historicprocessinstance.FieldProcessDefinitionKey:
-		m.ResetProcessDefinitionKey()
-		return nil
-	case historicprocessinstance.FieldProcessDefinitionName:
-		m.ResetProcessDefinitionName()
-		return nil
-	case historicprocessinstance.FieldProcessDefinitionVersion:
-		m.ResetProcessDefinitionVersion()
-		return nil
-	case historicprocessinstance.FieldDeploymentID:
-		m.ResetDeploymentID()
-		return nil
-	case historicprocessinstance.FieldStartUserID:
-		m.ResetStartUserID()
-		return nil
-	case historicprocessinstance.FieldStartTime:
-		m.ResetStartTime()
-		return nil
-	case historicprocessinstance.FieldEndTime:
-		m.ResetEndTime()
-		return nil
-	case historicprocessinstance.FieldDuration:
-		m.ResetDuration()
-		return nil
-	case historicprocessinstance.FieldStartActivityID:
-		m.ResetStartActivityID()
-		return nil
-	case historicprocessinstance.FieldEndActivityID:
-		m.ResetEndActivityID()
-		return nil
-	case historicprocessinstance.FieldSuperProcessInstanceID:
-		m.ResetSuperProcessInstanceID()
-		return nil
-	case historicprocessinstance.FieldRootProcessInstanceID:
-		m.ResetRootProcessInstanceID()
-		return nil
-	case historicprocessinstance.FieldSuperCaseInstanceID:
-		m.ResetSuperCaseInstanceID()
-		return nil
-	case historicprocessinstance.FieldCaseInstanceID:
-		m.ResetCaseInstanceID()
-		return nil
-	case historicprocessinstance.FieldDeleteReason:
-		m.ResetDeleteReason()
-		return nil
-	case historicprocessinstance.FieldTenantID:
-		m.ResetTenantID()
-		return nil
-	case historicprocessinstance.FieldState:
-		m.ResetState()
-		return nil
-	case historicprocessinstance.FieldRemovalTime:
-		m.ResetRemovalTime()
-		return nil
-	case historicprocessinstance.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case historicprocessinstance.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
+// OldSuperCaseInstanceID returns the old "super_case_instance_id" field's value of the HistoricProcessInstance entity.
+// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoricProcessInstanceMutation) OldSuperCaseInstanceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuperCaseInstanceID is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown HistoricProcessInstance field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuperCaseInstanceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuperCaseInstanceID: %w", err)
+	}
+	return oldValue.SuperCaseInstanceID, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *HistoricProcessInstanceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ClearSuperCaseInstanceID clears the value of the "super_case_instance_id" field.
+func (m *HistoricProcessInstanceMutation) ClearSuperCaseInstanceID() {
+	m.super_case_instance_id = nil
+	m.clearedFields[historicprocessinstance.FieldSuperCaseInstanceID] = struct{}{}
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *HistoricProcessInstanceMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// SuperCaseInstanceIDCleared returns if the "super_case_instance_id" field was cleared in this mutation.
+func (m *HistoricProcessInstanceMutation) SuperCaseInstanceIDCleared() bool {
+	_, ok := m.clearedFields[historicprocessinstance.FieldSuperCaseInstanceID]
+	return ok
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *HistoricProcessInstanceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ResetSuperCaseInstanceID resets all changes to the "super_case_instance_id" field.
+func (m *HistoricProcessInstanceMutation) ResetSuperCaseInstanceID() {
+	m.super_case_instance_id = nil
+	delete(m.clearedFields, historicprocessinstance.FieldSuperCaseInstanceID)
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *HistoricProcessInstanceMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// SetCaseInstanceID sets the "case_instance_id" field.
+func (m *HistoricProcessInstanceMutation) SetCaseInstanceID(s string) {
+	m.case_instance_id = &s
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *HistoricProcessInstanceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// CaseInstanceID returns the value of the "case_instance_id" field in the mutation.
+func (m *HistoricProcessInstanceMutation) CaseInstanceID() (r string, exists bool) {
+	v := m.case_instance_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *HistoricProcessInstanceMutation) EdgeCleared(name string) bool {
-	return false
+// OldCaseInstanceID returns the old "case_instance_id" field's value of the HistoricProcessInstance entity.
+// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoricProcessInstanceMutation) OldCaseInstanceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCaseInstanceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCaseInstanceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCaseInstanceID: %w", err)
+	}
+	return oldValue.CaseInstanceID, nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *HistoricProcessInstanceMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown HistoricProcessInstance unique edge %s", name)
+// ClearCaseInstanceID clears the value of the "case_instance_id" field.
+func (m *HistoricProcessInstanceMutation) ClearCaseInstanceID() {
+	m.case_instance_id = nil
+	m.clearedFields[historicprocessinstance.FieldCaseInstanceID] = struct{}{}
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *HistoricProcessInstanceMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown HistoricProcessInstance edge %s", name)
+// CaseInstanceIDCleared returns if the "case_instance_id" field was cleared in this mutation.
+func (m *HistoricProcessInstanceMutation) CaseInstanceIDCleared() bool {
+	_, ok := m.clearedFields[historicprocessinstance.FieldCaseInstanceID]
+	return ok
 }
 
-// ProcessDefinitionMutation represents an operation that mutates the ProcessDefinition nodes in the graph.
-type ProcessDefinitionMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *int64
-	key            *string
-	name           *string
-	category       *string
-	version        *int32
-	addversion     *int32
-	description    *string
-	deploy_time    *time.Time
-	resource       *string
-	diagram_data   *map[string]interface{}
-	has_start_form *bool
-	suspended      *bool
-	tenant_id      *string
-	created_at     *time.Time
-	updated_at     *time.Time
-	clearedFields  map[string]struct{}
-	done           bool
-	oldValue       func(context.Context) (*ProcessDefinition, error)
-	predicates     []predicate.ProcessDefinition
+// ResetCaseInstanceID resets all changes to the "case_instance_id" field.
+func (m *HistoricProcessInstanceMutation) ResetCaseInstanceID() {
+	m.case_instance_id = nil
+	delete(m.clearedFields, historicprocessinstance.FieldCaseInstanceID)
 }
 
-var _ ent.Mutation = (*ProcessDefinitionMutation)(nil)
+// SetDeleteReason sets the "delete_reason" field.
+func (m *HistoricProcessInstanceMutation) SetDeleteReason(s string) {
+	m.delete_reason = &s
+}
 
-// processdefinitionOption allows management of the mutation configuration using functional options.
-type processdefinitionOption func(*ProcessDefinitionMutation)
+// DeleteReason returns the value of the "delete_reason" field in the mutation.
+func (m *HistoricProcessInstanceMutation) DeleteReason() (r string, exists bool) {
+	v := m.delete_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newProcessDefinitionMutation creates new mutation for the ProcessDefinition entity.
-func newProcessDefinitionMutation(c config, op Op, opts ...processdefinitionOption) *ProcessDefinitionMutation {
-	m := &ProcessDefinitionMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeProcessDefinition,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
-}
-
-// withProcessDefinitionID sets the ID field of the mutation.
-func withProcessDefinitionID(id int64) processdefinitionOption {
-	return func(m *ProcessDefinitionMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *ProcessDefinition
-		)
-		m.oldValue = func(ctx context.Context) (*ProcessDefinition, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().ProcessDefinition.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldDeleteReason returns the old "delete_reason" field's value of the HistoricProcessInstance entity.
+// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HistoricProcessInstanceMutation) OldDeleteReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteReason is only allowed on UpdateOne operations")
 	}
-}
-
-// withProcessDefinition sets the old ProcessDefinition of the mutation.
-func withProcessDefinition(node *ProcessDefinition) processdefinitionOption {
-	return func(m *ProcessDefinitionMutation) {
-		m.oldValue = func(context.Context) (*ProcessDefinition, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteReason requires an ID field in the mutation")
 	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProcessDefinitionMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ProcessDefinitionMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteReason: %w", err)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return oldValue.DeleteReason, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ProcessDefinition entities.
-func (m *ProcessDefinitionMutation) SetID(id int64) {
-	m.id = &id
+// ClearDeleteReason clears the value of the "delete_reason" field.
+func (m *HistoricProcessInstanceMutation) ClearDeleteReason() {
+	m.delete_reason = nil
+	m.clearedFields[historicprocessinstance.FieldDeleteReason] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ProcessDefinitionMutation) ID() (id int64, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// DeleteReasonCleared returns if the "delete_reason" field was cleared in this mutation.
+func (m *HistoricProcessInstanceMutation) DeleteReasonCleared() bool {
+	_, ok := m.clearedFields[historicprocessinstance.FieldDeleteReason]
+	return ok
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ProcessDefinitionMutation) IDs(ctx context.Context) ([]int64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ProcessDefinition.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetDeleteReason resets all changes to the "delete_reason" field.
+func (m *HistoricProcessInstanceMutation) ResetDeleteReason() {
+	m.delete_reason = nil
+	delete(m.clearedFields, historicprocessinstance.FieldDeleteReason)
 }
 
-// SetKey sets the "key" field.
-func (m *ProcessDefinitionMutation) SetKey(s string) {
-	m.key = &s
+// SetTenantID sets the "tenant_id" field.
+func (m *HistoricProcessInstanceMutation) SetTenantID(s string) {
+	m.tenant_id = &s
 }
 
-// Key returns the value of the "key" field in the mutation.
-func (m *ProcessDefinitionMutation) Key() (r string, exists bool) {
-	v := m.key
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *HistoricProcessInstanceMutation) TenantID() (r string, exists bool) {
+	v := m.tenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKey returns the old "key" field's value of the ProcessDefinition entity.
-// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the HistoricProcessInstance entity.
+// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessDefinitionMutation) OldKey(ctx context.Context) (v string, err error) {
+func (m *HistoricProcessInstanceMutation) OldTenantID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKey requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.Key, nil
+	return oldValue.TenantID, nil
 }
 
-// ResetKey resets all changes to the "key" field.
-func (m *ProcessDefinitionMutation) ResetKey() {
-	m.key = nil
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *HistoricProcessInstanceMutation) ResetTenantID() {
+	m.tenant_id = nil
 }
 
-// SetName sets the "name" field.
-func (m *ProcessDefinitionMutation) SetName(s string) {
-	m.name = &s
+// SetState sets the "state" field.
+func (m *HistoricProcessInstanceMutation) SetState(s string) {
+	m.state = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ProcessDefinitionMutation) Name() (r string, exists bool) {
-	v := m.name
+// State returns the value of the "state" field in the mutation.
+func (m *HistoricProcessInstanceMutation) State() (r string, exists bool) {
+	v := m.state
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the ProcessDefinition entity.
-// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
+// OldState returns the old "state" field's value of the HistoricProcessInstance entity.
+// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessDefinitionMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *HistoricProcessInstanceMutation) OldState(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldState requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.State, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ProcessDefinitionMutation) ResetName() {
-	m.name = nil
+// ClearState clears the value of the "state" field.
+func (m *HistoricProcessInstanceMutation) ClearState() {
+	m.state = nil
+	m.clearedFields[historicprocessinstance.FieldState] = struct{}{}
 }
 
-// SetCategory sets the "category" field.
-func (m *ProcessDefinitionMutation) SetCategory(s string) {
-	m.category = &s
+// StateCleared returns if the "state" field was cleared in this mutation.
+func (m *HistoricProcessInstanceMutation) StateCleared() bool {
+	_, ok := m.clearedFields[historicprocessinstance.FieldState]
+	return ok
 }
 
-// Category returns the value of the "category" field in the mutation.
-func (m *ProcessDefinitionMutation) Category() (r string, exists bool) {
-	v := m.category
+// ResetState resets all changes to the "state" field.
+func (m *HistoricProcessInstanceMutation) ResetState() {
+	m.state = nil
+	delete(m.clearedFields, historicprocessinstance.FieldState)
+}
+
+// SetRemovalTime sets the "removal_time" field.
+func (m *HistoricProcessInstanceMutation) SetRemovalTime(s string) {
+	m.removal_time = &s
+}
+
+// RemovalTime returns the value of the "removal_time" field in the mutation.
+func (m *HistoricProcessInstanceMutation) RemovalTime() (r string, exists bool) {
+	v := m.removal_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCategory returns the old "category" field's value of the ProcessDefinition entity.
-// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
+// OldRemovalTime returns the old "removal_time" field's value of the HistoricProcessInstance entity.
+// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessDefinitionMutation) OldCategory(ctx context.Context) (v string, err error) {
+func (m *HistoricProcessInstanceMutation) OldRemovalTime(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+		return v, errors.New("OldRemovalTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategory requires an ID field in the mutation")
+		return v, errors.New("OldRemovalTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+		return v, fmt.Errorf("querying old value for OldRemovalTime: %w", err)
 	}
-	return oldValue.Category, nil
+	return oldValue.RemovalTime, nil
 }
 
-// ClearCategory clears the value of the "category" field.
-func (m *ProcessDefinitionMutation) ClearCategory() {
-	m.category = nil
-	m.clearedFields[processdefinition.FieldCategory] = struct{}{}
+// ClearRemovalTime clears the value of the "removal_time" field.
+func (m *HistoricProcessInstanceMutation) ClearRemovalTime() {
+	m.removal_time = nil
+	m.clearedFields[historicprocessinstance.FieldRemovalTime] = struct{}{}
 }
 
-// CategoryCleared returns if the "category" field was cleared in this mutation.
-func (m *ProcessDefinitionMutation) CategoryCleared() bool {
-	_, ok := m.clearedFields[processdefinition.FieldCategory]
+// RemovalTimeCleared returns if the "removal_time" field was cleared in this mutation.
+func (m *HistoricProcessInstanceMutation) RemovalTimeCleared() bool {
+	_, ok := m.clearedFields[historicprocessinstance.FieldRemovalTime]
 	return ok
 }
 
-// ResetCategory resets all changes to the "category" field.
-func (m *ProcessDefinitionMutation) ResetCategory() {
-	m.category = nil
-	delete(m.clearedFields, processdefinition.FieldCategory)
+// ResetRemovalTime resets all changes to the "removal_time" field.
+func (m *HistoricProcessInstanceMutation) ResetRemovalTime() {
+	m.removal_time = nil
+	delete(m.clearedFields, historicprocessinstance.FieldRemovalTime)
 }
 
-// SetVersion sets the "version" field.
-func (m *ProcessDefinitionMutation) SetVersion(i int32) {
-	m.version = &i
-	m.addversion = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *HistoricProcessInstanceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Version returns the value of the "version" field in the mutation.
-func (m *ProcessDefinitionMutation) Version() (r int32, exists bool) {
-	v := m.version
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *HistoricProcessInstanceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVersion returns the old "version" field's value of the ProcessDefinition entity.
-// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the HistoricProcessInstance entity.
+// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessDefinitionMutation) OldVersion(ctx context.Context) (v int32, err error) {
+func (m *HistoricProcessInstanceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVersion requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
-	}
-	return oldValue.Version, nil
-}
-
-// AddVersion adds i to the "version" field.
-func (m *ProcessDefinitionMutation) AddVersion(i int32) {
-	if m.addversion != nil {
-		*m.addversion += i
-	} else {
-		m.addversion = &i
-	}
-}
-
-// AddedVersion returns the value that was added to the "version" field in this mutation.
-func (m *ProcessDefinitionMutation) AddedVersion() (r int32, exists bool) {
-	v := m.addversion
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return *v, true
+	return oldValue.CreatedAt, nil
 }
 
-// ResetVersion resets all changes to the "version" field.
-func (m *ProcessDefinitionMutation) ResetVersion() {
-	m.version = nil
-	m.addversion = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *HistoricProcessInstanceMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ProcessDefinitionMutation) SetDescription(s string) {
-	m.description = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *HistoricProcessInstanceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ProcessDefinitionMutation) Description() (r string, exists bool) {
-	v := m.description
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *HistoricProcessInstanceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the ProcessDefinition entity.
-// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the HistoricProcessInstance entity.
+// If the HistoricProcessInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessDefinitionMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *HistoricProcessInstanceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *ProcessDefinitionMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[processdefinition.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ProcessDefinitionMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[processdefinition.FieldDescription]
-	return ok
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ProcessDefinitionMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, processdefinition.FieldDescription)
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *HistoricProcessInstanceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetDeployTime sets the "deploy_time" field.
-func (m *ProcessDefinitionMutation) SetDeployTime(t time.Time) {
-	m.deploy_time = &t
+// Where appends a list predicates to the HistoricProcessInstanceMutation builder.
+func (m *HistoricProcessInstanceMutation) Where(ps ...predicate.HistoricProcessInstance) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// DeployTime returns the value of the "deploy_time" field in the mutation.
-func (m *ProcessDefinitionMutation) DeployTime() (r time.Time, exists bool) {
-	v := m.deploy_time
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the HistoricProcessInstanceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HistoricProcessInstanceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.HistoricProcessInstance, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldDeployTime returns the old "deploy_time" field's value of the ProcessDefinition entity.
-// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessDefinitionMutation) OldDeployTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeployTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeployTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeployTime: %w", err)
-	}
-	return oldValue.DeployTime, nil
+// Op returns the operation name.
+func (m *HistoricProcessInstanceMutation) Op() Op {
+	return m.op
 }
 
-// ResetDeployTime resets all changes to the "deploy_time" field.
-func (m *ProcessDefinitionMutation) ResetDeployTime() {
-	m.deploy_time = nil
+// SetOp allows setting the mutation operation.
+func (m *HistoricProcessInstanceMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetResource sets the "resource" field.
-func (m *ProcessDefinitionMutation) SetResource(s string) {
-	m.resource = &s
+// Type returns the node type of this mutation (HistoricProcessInstance).
+func (m *HistoricProcessInstanceMutation) Type() string {
+	return m.typ
 }
 
-// Resource returns the value of the "resource" field in the mutation.
-func (m *ProcessDefinitionMutation) Resource() (r string, exists bool) {
-	v := m.resource
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HistoricProcessInstanceMutation) Fields() []string {
+	fields := make([]string, 0, 23)
+	if m.process_instance_id != nil {
+		fields = append(fields, historicprocessinstance.FieldProcessInstanceID)
 	}
-	return *v, true
-}
-
-// OldResource returns the old "resource" field's value of the ProcessDefinition entity.
-// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessDefinitionMutation) OldResource(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResource is only allowed on UpdateOne operations")
+	if m.business_key != nil {
+		fields = append(fields, historicprocessinstance.FieldBusinessKey)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResource requires an ID field in the mutation")
+	if m.process_definition_id != nil {
+		fields = append(fields, historicprocessinstance.FieldProcessDefinitionID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResource: %w", err)
+	if m.process_definition_key != nil {
+		fields = append(fields, historicprocessinstance.FieldProcessDefinitionKey)
 	}
-	return oldValue.Resource, nil
-}
-
-// ClearResource clears the value of the "resource" field.
-func (m *ProcessDefinitionMutation) ClearResource() {
-	m.resource = nil
-	m.clearedFields[processdefinition.FieldResource] = struct{}{}
-}
-
-// ResourceCleared returns if the "resource" field was cleared in this mutation.
-func (m *ProcessDefinitionMutation) ResourceCleared() bool {
-	_, ok := m.clearedFields[processdefinition.FieldResource]
-	return ok
-}
-
-// ResetResource resets all changes to the "resource" field.
-func (m *ProcessDefinitionMutation) ResetResource() {
-	m.resource = nil
-	delete(m.clearedFields, processdefinition.FieldResource)
-}
-
-// SetDiagramData sets the "diagram_data" field.
-func (m *ProcessDefinitionMutation) SetDiagramData(value map[string]interface{}) {
-	m.diagram_data = &value
-}
-
-// DiagramData returns the value of the "diagram_data" field in the mutation.
-func (m *ProcessDefinitionMutation) DiagramData() (r map[string]interface{}, exists bool) {
-	v := m.diagram_data
-	if v == nil {
-		return
+	if m.process_definition_name != nil {
+		fields = append(fields, historicprocessinstance.FieldProcessDefinitionName)
 	}
-	return *v, true
-}
-
-// OldDiagramData returns the old "diagram_data" field's value of the ProcessDefinition entity.
-// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessDefinitionMutation) OldDiagramData(ctx context.Context) (v map[string]interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDiagramData is only allowed on UpdateOne operations")
+	if m.process_definition_version != nil {
+		fields = append(fields, historicprocessinstance.FieldProcessDefinitionVersion)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDiagramData requires an ID field in the mutation")
+	if m.deployment_id != nil {
+		fields = append(fields, historicprocessinstance.FieldDeploymentID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDiagramData: %w", err)
+	if m.start_user_id != nil {
+		fields = append(fields, historicprocessinstance.FieldStartUserID)
 	}
-	return oldValue.DiagramData, nil
-}
-
-// ClearDiagramData clears the value of the "diagram_data" field.
-func (m *ProcessDefinitionMutation) ClearDiagramData() {
-	m.diagram_data = nil
-	m.clearedFields[processdefinition.FieldDiagramData] = struct{}{}
-}
-
-// DiagramDataCleared returns if the "diagram_data" field was cleared in this mutation.
-func (m *ProcessDefinitionMutation) DiagramDataCleared() bool {
-	_, ok := m.clearedFields[processdefinition.FieldDiagramData]
-	return ok
-}
-
-// ResetDiagramData resets all changes to the "diagram_data" field.
-func (m *ProcessDefinitionMutation) ResetDiagramData() {
-	m.diagram_data = nil
-	delete(m.clearedFields, processdefinition.FieldDiagramData)
-}
-
-// SetHasStartForm sets the "has_start_form" field.
-func (m *ProcessDefinitionMutation) SetHasStartForm(b bool) {
-	m.has_start_form = &b
-}
-
-// HasStartForm returns the value of the "has_start_form" field in the mutation.
-func (m *ProcessDefinitionMutation) HasStartForm() (r bool, exists bool) {
-	v := m.has_start_form
-	if v == nil {
-		return
+	if m.start_time != nil {
+		fields = append(fields, historicprocessinstance.FieldStartTime)
 	}
-	return *v, true
-}
-
-// OldHasStartForm returns the old "has_start_form" field's value of the ProcessDefinition entity.
-// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessDefinitionMutation) OldHasStartForm(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHasStartForm is only allowed on UpdateOne operations")
+	if m.end_time != nil {
+		fields = append(fields, historicprocessinstance.FieldEndTime)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHasStartForm requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHasStartForm: %w", err)
-	}
-	return oldValue.HasStartForm, nil
-}
-
-// ResetHasStartForm resets all changes to the "has_start_form" field.
-func (m *ProcessDefinitionMutation) ResetHasStartForm() {
-	m.has_start_form = nil
-}
-
-// SetSuspended sets the "suspended" field.
-func (m *ProcessDefinitionMutation) SetSuspended(b bool) {
-	m.suspended = &b
-}
-
-// Suspended returns the value of the "suspended" field in the mutation.
-func (m *ProcessDefinitionMutation) Suspended() (r bool, exists bool) {
-	v := m.suspended
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSuspended returns the old "suspended" field's value of the ProcessDefinition entity.
-// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessDefinitionMutation) OldSuspended(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSuspended is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSuspended requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSuspended: %w", err)
-	}
-	return oldValue.Suspended, nil
-}
-
-// ResetSuspended resets all changes to the "suspended" field.
-func (m *ProcessDefinitionMutation) ResetSuspended() {
-	m.suspended = nil
-}
-
-// SetTenantID sets the "tenant_id" field.
-func (m *ProcessDefinitionMutation) SetTenantID(s string) {
-	m.tenant_id = &s
-}
-
-// TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *ProcessDefinitionMutation) TenantID() (r string, exists bool) {
-	v := m.tenant_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTenantID returns the old "tenant_id" field's value of the ProcessDefinition entity.
-// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessDefinitionMutation) OldTenantID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTenantID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
-	}
-	return oldValue.TenantID, nil
-}
-
-// ResetTenantID resets all changes to the "tenant_id" field.
-func (m *ProcessDefinitionMutation) ResetTenantID() {
-	m.tenant_id = nil
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *ProcessDefinitionMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ProcessDefinitionMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the ProcessDefinition entity.
-// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessDefinitionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ProcessDefinitionMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ProcessDefinitionMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ProcessDefinitionMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the ProcessDefinition entity.
-// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessDefinitionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ProcessDefinitionMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// Where appends a list predicates to the ProcessDefinitionMutation builder.
-func (m *ProcessDefinitionMutation) Where(ps ...predicate.ProcessDefinition) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the ProcessDefinitionMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProcessDefinitionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ProcessDefinition, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *ProcessDefinitionMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *ProcessDefinitionMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (ProcessDefinition).
-func (m *ProcessDefinitionMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ProcessDefinitionMutation) Fields() []string {
-	fields := make([]string, 0, 13)
-	if m.key != nil {
-		fields = append(fields, processdefinition.FieldKey)
+	if m.duration != nil {
+		fields = append(fields, historicprocessinstance.FieldDuration)
 	}
-	if m.name != nil {
-		fields = append(fields, processdefinition.FieldName)
+	if m.start_activity_id != nil {
+		fields = append(fields, historicprocessinstance.FieldStartActivityID)
 	}
-	if m.category != nil {
-		fields = append(fields, processdefinition.FieldCategory)
+	if m.end_activity_id != nil {
+		fields = append(fields, historicprocessinstance.FieldEndActivityID)
 	}
-	if m.version != nil {
-		fields = append(fields, processdefinition.FieldVersion)
+	if m.super_process_instance_id != nil {
+		fields = append(fields, historicprocessinstance.FieldSuperProcessInstanceID)
 	}
-	if m.description != nil {
-		fields = append(fields, processdefinition.FieldDescription)
+	if m.root_process_instance_id != nil {
+		fields = append(fields, historicprocessinstance.FieldRootProcessInstanceID)
 	}
-	if m.deploy_time != nil {
-		fields = append(fields, processdefinition.FieldDeployTime)
+	if m.super_case_instance_id != nil {
+		fields = append(fields, historicprocessinstance.FieldSuperCaseInstanceID)
 	}
-	if m.resource != nil {
-		fields = append(fields, processdefinition.FieldResource)
+	if m.case_instance_id != nil {
+		fields = append(fields, historicprocessinstance.FieldCaseInstanceID)
 	}
-	if m.diagram_data != nil {
-		fields = append(fields, processdefinition.FieldDiagramData)
+	if m.delete_reason != nil {
+		fields = append(fields, historicprocessinstance.FieldDeleteReason)
 	}
-	if m.has_start_form != nil {
-		fields = append(fields, processdefinition.FieldHasStartForm)
+	if m.tenant_id != nil {
+		fields = append(fields, historicprocessinstance.FieldTenantID)
 	}
-	if m.suspended != nil {
-		fields = append(fields, processdefinition.FieldSuspended)
+	if m.state != nil {
+		fields = append(fields, historicprocessinstance.FieldState)
 	}
-	if m.tenant_id != nil {
-		fields = append(fields, processdefinition.FieldTenantID)
+	if m.removal_time != nil {
+		fields = append(fields, historicprocessinstance.FieldRemovalTime)
 	}
 	if m.created_at != nil {
-		fields = append(fields, processdefinition.FieldCreatedAt)
+		fields = append(fields, historicprocessinstance.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, processdefinition.FieldUpdatedAt)
+		fields = append(fields, historicprocessinstance.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2698,33 +2653,53 @@ func (m *ProcessDefinitionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProcessDefinitionMutation) Field(name string) (ent.Value, bool) {
+func (m *HistoricProcessInstanceMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case processdefinition.FieldKey:
-		return m.Key()
-	case processdefinition.FieldName:
-		return m.Name()
-	case processdefinition.FieldCategory:
-		return m.Category()
-	case processdefinition.FieldVersion:
-		return m.Version()
-	case processdefinition.FieldDescription:
-		return m.Description()
-	case processdefinition.FieldDeployTime:
-		return m.DeployTime()
-	case processdefinition.FieldResource:
-		return m.Resource()
-	case processdefinition.FieldDiagramData:
-		return m.DiagramData()
-	case processdefinition.FieldHasStartForm:
-		return m.HasStartForm()
-	case processdefinition.FieldSuspended:
-		return m.Suspended()
-	case processdefinition.FieldTenantID:
+	case historicprocessinstance.FieldProcessInstanceID:
+		return m.ProcessInstanceID()
+	case historicprocessinstance.FieldBusinessKey:
+		return m.BusinessKey()
+	case historicprocessinstance.FieldProcessDefinitionID:
+		return m.ProcessDefinitionID()
+	case historicprocessinstance.FieldProcessDefinitionKey:
+		return m.ProcessDefinitionKey()
+	case historicprocessinstance.FieldProcessDefinitionName:
+		return m.ProcessDefinitionName()
+	case historicprocessinstance.FieldProcessDefinitionVersion:
+		return m.ProcessDefinitionVersion()
+	case historicprocessinstance.FieldDeploymentID:
+		return m.DeploymentID()
+	case historicprocessinstance.FieldStartUserID:
+		return m.StartUserID()
+	case historicprocessinstance.FieldStartTime:
+		return m.StartTime()
+	case historicprocessinstance.FieldEndTime:
+		return m.EndTime()
+	case historicprocessinstance.FieldDuration:
+		return m.Duration()
+	case historicprocessinstance.FieldStartActivityID:
+		return m.StartActivityID()
+	case historicprocessinstance.FieldEndActivityID:
+		return m.EndActivityID()
+	case historicprocessinstance.FieldSuperProcessInstanceID:
+		return m.SuperProcessInstanceID()
+	case historicprocessinstance.FieldRootProcessInstanceID:
+		return m.RootProcessInstanceID()
+	case historicprocessinstance.FieldSuperCaseInstanceID:
+		return m.SuperCaseInstanceID()
+	case historicprocessinstance.FieldCaseInstanceID:
+		return m.CaseInstanceID()
+	case historicprocessinstance.FieldDeleteReason:
+		return m.DeleteReason()
+	case historicprocessinstance.FieldTenantID:
 		return m.TenantID()
-	case processdefinition.FieldCreatedAt:
+	case historicprocessinstance.FieldState:
+		return m.State()
+	case historicprocessinstance.FieldRemovalTime:
+		return m.RemovalTime()
+	case historicprocessinstance.FieldCreatedAt:
 		return m.CreatedAt()
-	case processdefinition.FieldUpdatedAt:
+	case historicprocessinstance.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -2733,128 +2708,218 @@ func (m *ProcessDefinitionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProcessDefinitionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *HistoricProcessInstanceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case processdefinition.FieldKey:
-		return m.OldKey(ctx)
-	case processdefinition.FieldName:
-		return m.OldName(ctx)
-	case processdefinition.FieldCategory:
-		return m.OldCategory(ctx)
-	case processdefinition.FieldVersion:
-		return m.OldVersion(ctx)
-	case processdefinition.FieldDescription:
-		return m.OldDescription(ctx)
-	case processdefinition.FieldDeployTime:
-		return m.OldDeployTime(ctx)
-	case processdefinition.FieldResource:
-		return m.OldResource(ctx)
-	case processdefinition.FieldDiagramData:
-		return m.OldDiagramData(ctx)
-	case processdefinition.FieldHasStartForm:
-		return m.OldHasStartForm(ctx)
-	case processdefinition.FieldSuspended:
-		return m.OldSuspended(ctx)
-	case processdefinition.FieldTenantID:
+	case historicprocessinstance.FieldProcessInstanceID:
+		return m.OldProcessInstanceID(ctx)
+	case historicprocessinstance.FieldBusinessKey:
+		return m.OldBusinessKey(ctx)
+	case historicprocessinstance.FieldProcessDefinitionID:
+		return m.OldProcessDefinitionID(ctx)
+	case historicprocessinstance.FieldProcessDefinitionKey:
+		return m.OldProcessDefinitionKey(ctx)
+	case historicprocessinstance.FieldProcessDefinitionName:
+		return m.OldProcessDefinitionName(ctx)
+	case historicprocessinstance.FieldProcessDefinitionVersion:
+		return m.OldProcessDefinitionVersion(ctx)
+	case historicprocessinstance.FieldDeploymentID:
+		return m.OldDeploymentID(ctx)
+	case historicprocessinstance.FieldStartUserID:
+		return m.OldStartUserID(ctx)
+	case historicprocessinstance.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case historicprocessinstance.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case historicprocessinstance.FieldDuration:
+		return m.OldDuration(ctx)
+	case historicprocessinstance.FieldStartActivityID:
+		return m.OldStartActivityID(ctx)
+	case historicprocessinstance.FieldEndActivityID:
+		return m.OldEndActivityID(ctx)
+	case historicprocessinstance.FieldSuperProcessInstanceID:
+		return m.OldSuperProcessInstanceID(ctx)
+	case historicprocessinstance.FieldRootProcessInstanceID:
+		return m.OldRootProcessInstanceID(ctx)
+	case historicprocessinstance.FieldSuperCaseInstanceID:
+		return m.OldSuperCaseInstanceID(ctx)
+	case historicprocessinstance.FieldCaseInstanceID:
+		return m.OldCaseInstanceID(ctx)
+	case historicprocessinstance.FieldDeleteReason:
+		return m.OldDeleteReason(ctx)
+	case historicprocessinstance.FieldTenantID:
 		return m.OldTenantID(ctx)
-	case processdefinition.FieldCreatedAt:
+	case historicprocessinstance.FieldState:
+		return m.OldState(ctx)
+	case historicprocessinstance.FieldRemovalTime:
+		return m.OldRemovalTime(ctx)
+	case historicprocessinstance.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case processdefinition.FieldUpdatedAt:
+	case historicprocessinstance.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ProcessDefinition field %s", name)
+	return nil, fmt.Errorf("unknown HistoricProcessInstance field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProcessDefinitionMutation) SetField(name string, value ent.Value) error {
+func (m *HistoricProcessInstanceMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case processdefinition.FieldKey:
+	case historicprocessinstance.FieldProcessInstanceID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKey(v)
+		m.SetProcessInstanceID(v)
 		return nil
-	case processdefinition.FieldName:
+	case historicprocessinstance.FieldBusinessKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetBusinessKey(v)
 		return nil
-	case processdefinition.FieldCategory:
+	case historicprocessinstance.FieldProcessDefinitionID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessDefinitionID(v)
+		return nil
+	case historicprocessinstance.FieldProcessDefinitionKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCategory(v)
+		m.SetProcessDefinitionKey(v)
 		return nil
-	case processdefinition.FieldVersion:
+	case historicprocessinstance.FieldProcessDefinitionName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessDefinitionName(v)
+		return nil
+	case historicprocessinstance.FieldProcessDefinitionVersion:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVersion(v)
+		m.SetProcessDefinitionVersion(v)
 		return nil
-	case processdefinition.FieldDescription:
+	case historicprocessinstance.FieldDeploymentID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetDeploymentID(v)
 		return nil
-	case processdefinition.FieldDeployTime:
+	case historicprocessinstance.FieldStartUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartUserID(v)
+		return nil
+	case historicprocessinstance.FieldStartTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeployTime(v)
+		m.SetStartTime(v)
 		return nil
-	case processdefinition.FieldResource:
+	case historicprocessinstance.FieldEndTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case historicprocessinstance.FieldDuration:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDuration(v)
+		return nil
+	case historicprocessinstance.FieldStartActivityID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResource(v)
+		m.SetStartActivityID(v)
 		return nil
-	case processdefinition.FieldDiagramData:
-		v, ok := value.(map[string]interface{})
+	case historicprocessinstance.FieldEndActivityID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDiagramData(v)
+		m.SetEndActivityID(v)
 		return nil
-	case processdefinition.FieldHasStartForm:
-		v, ok := value.(bool)
+	case historicprocessinstance.FieldSuperProcessInstanceID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHasStartForm(v)
+		m.SetSuperProcessInstanceID(v)
 		return nil
-	case processdefinition.FieldSuspended:
-		v, ok := value.(bool)
+	case historicprocessinstance.FieldRootProcessInstanceID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSuspended(v)
+		m.SetRootProcessInstanceID(v)
 		return nil
-	case processdefinition.FieldTenantID:
+	case historicprocessinstance.FieldSuperCaseInstanceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuperCaseInstanceID(v)
+		return nil
+	case historicprocessinstance.FieldCaseInstanceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCaseInstanceID(v)
+		return nil
+	case historicprocessinstance.FieldDeleteReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteReason(v)
+		return nil
+	case historicprocessinstance.FieldTenantID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTenantID(v)
 		return nil
-	case processdefinition.FieldCreatedAt:
+	case historicprocessinstance.FieldState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case historicprocessinstance.FieldRemovalTime:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemovalTime(v)
+		return nil
+	case historicprocessinstance.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case processdefinition.FieldUpdatedAt:
+	case historicprocessinstance.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2862,26 +2927,36 @@ func (m *ProcessDefinitionMutation) SetField(name string, value ent.Value) error
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ProcessDefinition field %s", name)
+	return fmt.Errorf("unknown HistoricProcessInstance field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProcessDefinitionMutation) AddedFields() []string {
+func (m *HistoricProcessInstanceMutation) AddedFields() []string {
 	var fields []string
-	if m.addversion != nil {
-		fields = append(fields, processdefinition.FieldVersion)
+	if m.addprocess_definition_id != nil {
+		fields = append(fields, historicprocessinstance.FieldProcessDefinitionID)
 	}
-	return fields
+	if m.addprocess_definition_version != nil {
+		fields = append(fields, historicprocessinstance.FieldProcessDefinitionVersion)
+	}
+	if m.addduration != nil {
+		fields = append(fields, historicprocessinstance.FieldDuration)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProcessDefinitionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *HistoricProcessInstanceMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case processdefinition.FieldVersion:
-		return m.AddedVersion()
+	case historicprocessinstance.FieldProcessDefinitionID:
+		return m.AddedProcessDefinitionID()
+	case historicprocessinstance.FieldProcessDefinitionVersion:
+		return m.AddedProcessDefinitionVersion()
+	case historicprocessinstance.FieldDuration:
+		return m.AddedDuration()
 	}
 	return nil, false
 }
@@ -2889,209 +2964,307 @@ func (m *ProcessDefinitionMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProcessDefinitionMutation) AddField(name string, value ent.Value) error {
+func (m *HistoricProcessInstanceMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case processdefinition.FieldVersion:
+	case historicprocessinstance.FieldProcessDefinitionID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProcessDefinitionID(v)
+		return nil
+	case historicprocessinstance.FieldProcessDefinitionVersion:
 		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddVersion(v)
+		m.AddProcessDefinitionVersion(v)
+		return nil
+	case historicprocessinstance.FieldDuration:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDuration(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ProcessDefinition numeric field %s", name)
+	return fmt.Errorf("unknown HistoricProcessInstance numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProcessDefinitionMutation) ClearedFields() []string {
+func (m *HistoricProcessInstanceMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(processdefinition.FieldCategory) {
-		fields = append(fields, processdefinition.FieldCategory)
+	if m.FieldCleared(historicprocessinstance.FieldBusinessKey) {
+		fields = append(fields, historicprocessinstance.FieldBusinessKey)
 	}
-	if m.FieldCleared(processdefinition.FieldDescription) {
-		fields = append(fields, processdefinition.FieldDescription)
+	if m.FieldCleared(historicprocessinstance.FieldProcessDefinitionName) {
+		fields = append(fields, historicprocessinstance.FieldProcessDefinitionName)
 	}
-	if m.FieldCleared(processdefinition.FieldResource) {
-		fields = append(fields, processdefinition.FieldResource)
+	if m.FieldCleared(historicprocessinstance.FieldDeploymentID) {
+		fields = append(fields, historicprocessinstance.FieldDeploymentID)
 	}
-	if m.FieldCleared(processdefinition.FieldDiagramData) {
-		fields = append(fields, processdefinition.FieldDiagramData)
+	if m.FieldCleared(historicprocessinstance.FieldStartUserID) {
+		fields = append(fields, historicprocessinstance.FieldStartUserID)
+	}
+	if m.FieldCleared(historicprocessinstance.FieldEndTime) {
+		fields = append(fields, historicprocessinstance.FieldEndTime)
+	}
+	if m.FieldCleared(historicprocessinstance.FieldDuration) {
+		fields = append(fields, historicprocessinstance.FieldDuration)
+	}
+	if m.FieldCleared(historicprocessinstance.FieldStartActivityID) {
+		fields = append(fields, historicprocessinstance.FieldStartActivityID)
+	}
+	if m.FieldCleared(historicprocessinstance.FieldEndActivityID) {
+		fields = append(fields, historicprocessinstance.FieldEndActivityID)
+	}
+	if m.FieldCleared(historicprocessinstance.FieldSuperProcessInstanceID) {
+		fields = append(fields, historicprocessinstance.FieldSuperProcessInstanceID)
+	}
+	if m.FieldCleared(historicprocessinstance.FieldRootProcessInstanceID) {
+		fields = append(fields, historicprocessinstance.FieldRootProcessInstanceID)
+	}
+	if m.FieldCleared(historicprocessinstance.FieldSuperCaseInstanceID) {
+		fields = append(fields, historicprocessinstance.FieldSuperCaseInstanceID)
+	}
+	if m.FieldCleared(historicprocessinstance.FieldCaseInstanceID) {
+		fields = append(fields, historicprocessinstance.FieldCaseInstanceID)
+	}
+	if m.FieldCleared(historicprocessinstance.FieldDeleteReason) {
+		fields = append(fields, historicprocessinstance.FieldDeleteReason)
+	}
+	if m.FieldCleared(historicprocessinstance.FieldState) {
+		fields = append(fields, historicprocessinstance.FieldState)
+	}
+	if m.FieldCleared(historicprocessinstance.FieldRemovalTime) {
+		fields = append(fields, historicprocessinstance.FieldRemovalTime)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProcessDefinitionMutation) FieldCleared(name string) bool {
+func (m *HistoricProcessInstanceMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProcessDefinitionMutation) ClearField(name string) error {
+func (m *HistoricProcessInstanceMutation) ClearField(name string) error {
 	switch name {
-	case processdefinition.FieldCategory:
-		m.ClearCategory()
+	case historicprocessinstance.FieldBusinessKey:
+		m.ClearBusinessKey()
 		return nil
-	case processdefinition.FieldDescription:
-		m.ClearDescription()
+	case historicprocessinstance.FieldProcessDefinitionName:
+		m.ClearProcessDefinitionName()
 		return nil
-	case processdefinition.FieldResource:
-		m.ClearResource()
+	case historicprocessinstance.FieldDeploymentID:
+		m.ClearDeploymentID()
 		return nil
-	case processdefinition.FieldDiagramData:
-		m.ClearDiagramData()
+	case historicprocessinstance.FieldStartUserID:
+		m.ClearStartUserID()
+		return nil
+	case historicprocessinstance.FieldEndTime:
+		m.ClearEndTime()
+		return nil
+	case historicprocessinstance.FieldDuration:
+		m.ClearDuration()
+		return nil
+	case historicprocessinstance.FieldStartActivityID:
+		m.ClearStartActivityID()
+		return nil
+	case historicprocessinstance.FieldEndActivityID:
+		m.ClearEndActivityID()
+		return nil
+	case historicprocessinstance.FieldSuperProcessInstanceID:
+		m.ClearSuperProcessInstanceID()
+		return nil
+	case historicprocessinstance.FieldRootProcessInstanceID:
+		m.ClearRootProcessInstanceID()
+		return nil
+	case historicprocessinstance.FieldSuperCaseInstanceID:
+		m.ClearSuperCaseInstanceID()
+		return nil
+	case historicprocessinstance.FieldCaseInstanceID:
+		m.ClearCaseInstanceID()
+		return nil
+	case historicprocessinstance.FieldDeleteReason:
+		m.ClearDeleteReason()
+		return nil
+	case historicprocessinstance.FieldState:
+		m.ClearState()
+		return nil
+	case historicprocessinstance.FieldRemovalTime:
+		m.ClearRemovalTime()
 		return nil
 	}
-	return fmt.Errorf("unknown ProcessDefinition nullable field %s", name)
+	return fmt.Errorf("unknown HistoricProcessInstance nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProcessDefinitionMutation) ResetField(name string) error {
+func (m *HistoricProcessInstanceMutation) ResetField(name string) error {
 	switch name {
-	case processdefinition.FieldKey:
-		m.ResetKey()
+	case historicprocessinstance.FieldProcessInstanceID:
+		m.ResetProcessInstanceID()
 		return nil
-	case processdefinition.FieldName:
-		m.ResetName()
+	case historicprocessinstance.FieldBusinessKey:
+		m.ResetBusinessKey()
 		return nil
-	case processdefinition.FieldCategory:
-		m.ResetCategory()
+	case historicprocessinstance.FieldProcessDefinitionID:
+		m.ResetProcessDefinitionID()
 		return nil
-	case processdefinition.FieldVersion:
-		m.ResetVersion()
+	case historicprocessinstance.FieldProcessDefinitionKey:
+		m.ResetProcessDefinitionKey()
 		return nil
-	case processdefinition.FieldDescription:
-		m.ResetDescription()
+	case historicprocessinstance.FieldProcessDefinitionName:
+		m.ResetProcessDefinitionName()
 		return nil
-	case processdefinition.FieldDeployTime:
-		m.ResetDeployTime()
+	case historicprocessinstance.FieldProcessDefinitionVersion:
+		m.ResetProcessDefinitionVersion()
 		return nil
-	case processdefinition.FieldResource:
-		m.ResetResource()
+	case historicprocessinstance.FieldDeploymentID:
+		m.ResetDeploymentID()
 		return nil
-	case processdefinition.FieldDiagramData:
-		m.ResetDiagramData()
+	case historicprocessinstance.FieldStartUserID:
+		m.ResetStartUserID()
 		return nil
-	case processdefinition.FieldHasStartForm:
-		m.ResetHasStartForm()
+	case historicprocessinstance.FieldStartTime:
+		m.ResetStartTime()
 		return nil
-	case processdefinition.FieldSuspended:
-		m.ResetSuspended()
+	case historicprocessinstance.FieldEndTime:
+		m.ResetEndTime()
 		return nil
-	case processdefinition.FieldTenantID:
+	case historicprocessinstance.FieldDuration:
+		m.ResetDuration()
+		return nil
+	case historicprocessinstance.FieldStartActivityID:
+		m.ResetStartActivityID()
+		return nil
+	case historicprocessinstance.FieldEndActivityID:
+		m.ResetEndActivityID()
+		return nil
+	case historicprocessinstance.FieldSuperProcessInstanceID:
+		m.ResetSuperProcessInstanceID()
+		return nil
+	case historicprocessinstance.FieldRootProcessInstanceID:
+		m.ResetRootProcessInstanceID()
+		return nil
+	case historicprocessinstance.FieldSuperCaseInstanceID:
+		m.ResetSuperCaseInstanceID()
+		return nil
+	case historicprocessinstance.FieldCaseInstanceID:
+		m.ResetCaseInstanceID()
+		return nil
+	case historicprocessinstance.FieldDeleteReason:
+		m.ResetDeleteReason()
+		return nil
+	case historicprocessinstance.FieldTenantID:
 		m.ResetTenantID()
 		return nil
-	case processdefinition.FieldCreatedAt:
+	case historicprocessinstance.FieldState:
+		m.ResetState()
+		return nil
+	case historicprocessinstance.FieldRemovalTime:
+		m.ResetRemovalTime()
+		return nil
+	case historicprocessinstance.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case processdefinition.FieldUpdatedAt:
+	case historicprocessinstance.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ProcessDefinition field %s", name)
+	return fmt.Errorf("unknown HistoricProcessInstance field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProcessDefinitionMutation) AddedEdges() []string {
+func (m *HistoricProcessInstanceMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProcessDefinitionMutation) AddedIDs(name string) []ent.Value {
+func (m *HistoricProcessInstanceMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProcessDefinitionMutation) RemovedEdges() []string {
+func (m *HistoricProcessInstanceMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProcessDefinitionMutation) RemovedIDs(name string) []ent.Value {
+func (m *HistoricProcessInstanceMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProcessDefinitionMutation) ClearedEdges() []string {
+func (m *HistoricProcessInstanceMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProcessDefinitionMutation) EdgeCleared(name string) bool {
+func (m *HistoricProcessInstanceMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProcessDefinitionMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ProcessDefinition unique edge %s", name)
+func (m *HistoricProcessInstanceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown HistoricProcessInstance unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProcessDefinitionMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ProcessDefinition edge %s", name)
+func (m *HistoricProcessInstanceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown HistoricProcessInstance edge %s", name)
 }
 
-// ProcessEventMutation represents an operation that mutates the ProcessEvent nodes in the graph.
-type ProcessEventMutation struct {
+// ProcessDefinitionMutation represents an operation that mutates the ProcessDefinition nodes in the graph.
+type ProcessDefinitionMutation struct {
 	config
-	op                       Op
-	typ                      string
-	id                       *int64
-	event_type               *string
-	event_name               *string
-	execution_id             *string
-	process_instance_id      *int64
-	addprocess_instance_id   *int64
-	process_definition_id    *int64
-	addprocess_definition_id *int64
-	process_definition_key   *string
-	task_id                  *int64
-	addtask_id               *int64
-	activity_id              *string
-	activity_name            *string
-	activity_type            *string
-	user_id                  *string
-	timestamp                *time.Time
-	event_data               *map[string]interface{}
-	correlation_id           *string
-	message_name             *string
-	signal_name              *string
-	job_id                   *string
-	job_type                 *string
-	job_handler_type         *string
-	tenant_id                *string
-	deployment_id            *string
-	sequence_counter         *string
-	created_at               *time.Time
-	clearedFields            map[string]struct{}
-	done                     bool
-	oldValue                 func(context.Context) (*ProcessEvent, error)
-	predicates               []predicate.ProcessEvent
+	op             Op
+	typ            string
+	id             *int64
+	key            *string
+	name           *string
+	category       *string
+	version        *int32
+	addversion     *int32
+	description    *string
+	deploy_time    *time.Time
+	resource       *string
+	diagram_data   *map[string]interface{}
+	has_start_form *bool
+	suspended      *bool
+	tenant_id      *string
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*ProcessDefinition, error)
+	predicates     []predicate.ProcessDefinition
 }
 
-var _ ent.Mutation = (*ProcessEventMutation)(nil)
+var _ ent.Mutation = (*ProcessDefinitionMutation)(nil)
 
-// processeventOption allows management of the mutation configuration using functional options.
-type processeventOption func(*ProcessEventMutation)
+// processdefinitionOption allows management of the mutation configuration using functional options.
+type processdefinitionOption func(*ProcessDefinitionMutation)
 
-// newProcessEventMutation creates new mutation for the ProcessEvent entity.
-func newProcessEventMutation(c config, op Op, opts ...processeventOption) *ProcessEventMutation {
-	m := &ProcessEventMutation{
+// newProcessDefinitionMutation creates new mutation for the ProcessDefinition entity.
+func newProcessDefinitionMutation(c config, op Op, opts ...processdefinitionOption) *ProcessDefinitionMutation {
+	m := &ProcessDefinitionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProcessEvent,
+		typ:           TypeProcessDefinition,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3100,20 +3273,20 @@ func newProcessEventMutation(c config, op Op, opts ...processeventOption) *Proce
 	return m
 }
 
-// withProcessEventID sets the ID field of the mutation.
-func withProcessEventID(id int64) processeventOption {
-	return func(m *ProcessEventMutation) {
+// withProcessDefinitionID sets the ID field of the mutation.
+func withProcessDefinitionID(id int64) processdefinitionOption {
+	return func(m *ProcessDefinitionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ProcessEvent
+			value *ProcessDefinition
 		)
-		m.oldValue = func(ctx context.Context) (*ProcessEvent, error) {
+		m.oldValue = func(ctx context.Context) (*ProcessDefinition, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ProcessEvent.Get(ctx, id)
+					value, err = m.Client().ProcessDefinition.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3122,10 +3295,10 @@ func withProcessEventID(id int64) processeventOption {
 	}
 }
 
-// withProcessEvent sets the old ProcessEvent of the mutation.
-func withProcessEvent(node *ProcessEvent) processeventOption {
-	return func(m *ProcessEventMutation) {
-		m.oldValue = func(context.Context) (*ProcessEvent, error) {
+// withProcessDefinition sets the old ProcessDefinition of the mutation.
+func withProcessDefinition(node *ProcessDefinition) processdefinitionOption {
+	return func(m *ProcessDefinitionMutation) {
+		m.oldValue = func(context.Context) (*ProcessDefinition, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3134,7 +3307,7 @@ func withProcessEvent(node *ProcessEvent) processeventOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProcessEventMutation) Client() *Client {
+func (m ProcessDefinitionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3142,7 +3315,7 @@ func (m ProcessEventMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProcessEventMutation) Tx() (*Tx, error) {
+func (m ProcessDefinitionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3152,14 +3325,14 @@ func (m ProcessEventMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ProcessEvent entities.
-func (m *ProcessEventMutation) SetID(id int64) {
+// operation is only accepted on creation of ProcessDefinition entities.
+func (m *ProcessDefinitionMutation) SetID(id int64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProcessEventMutation) ID() (id int64, exists bool) {
+func (m *ProcessDefinitionMutation) ID() (id int64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3170,7 +3343,7 @@ func (m *ProcessEventMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProcessEventMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *ProcessDefinitionMutation) IDs(ctx context.Context) ([]int64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3179,2254 +3352,2130 @@ func (m *ProcessEventMutation) IDs(ctx context.Context) ([]int64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ProcessEvent.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ProcessDefinition.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEventType sets the "event_type" field.
-func (m *ProcessEventMutation) SetEventType(s string) {
-	m.event_type = &s
+// SetKey sets the "key" field.
+func (m *ProcessDefinitionMutation) SetKey(s string) {
+	m.key = &s
 }
 
-// EventType returns the value of the "event_type" field in the mutation.
-func (m *ProcessEventMutation) EventType() (r string, exists bool) {
-	v := m.event_type
+// Key returns the value of the "key" field in the mutation.
+func (m *ProcessDefinitionMutation) Key() (r string, exists bool) {
+	v := m.key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEventType returns the old "event_type" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldKey returns the old "key" field's value of the ProcessDefinition entity.
+// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldEventType(ctx context.Context) (v string, err error) {
+func (m *ProcessDefinitionMutation) OldKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEventType requires an ID field in the mutation")
+		return v, errors.New("OldKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
 	}
-	return oldValue.EventType, nil
+	return oldValue.Key, nil
 }
 
-// ResetEventType resets all changes to the "event_type" field.
-func (m *ProcessEventMutation) ResetEventType() {
-	m.event_type = nil
+// ResetKey resets all changes to the "key" field.
+func (m *ProcessDefinitionMutation) ResetKey() {
+	m.key = nil
 }
 
-// SetEventName sets the "event_name" field.
-func (m *ProcessEventMutation) SetEventName(s string) {
-	m.event_name = &s
+// SetName sets the "name" field.
+func (m *ProcessDefinitionMutation) SetName(s string) {
+	m.name = &s
 }
 
-// EventName returns the value of the "event_name" field in the mutation.
-func (m *ProcessEventMutation) EventName() (r string, exists bool) {
-	v := m.event_name
+// Name returns the value of the "name" field in the mutation.
+func (m *ProcessDefinitionMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEventName returns the old "event_name" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the ProcessDefinition entity.
+// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldEventName(ctx context.Context) (v string, err error) {
+func (m *ProcessDefinitionMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEventName is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEventName requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEventName: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.EventName, nil
-}
-
-// ClearEventName clears the value of the "event_name" field.
-func (m *ProcessEventMutation) ClearEventName() {
-	m.event_name = nil
-	m.clearedFields[processevent.FieldEventName] = struct{}{}
-}
-
-// EventNameCleared returns if the "event_name" field was cleared in this mutation.
-func (m *ProcessEventMutation) EventNameCleared() bool {
-	_, ok := m.clearedFields[processevent.FieldEventName]
-	return ok
+	return oldValue.Name, nil
 }
 
-// ResetEventName resets all changes to the "event_name" field.
-func (m *ProcessEventMutation) ResetEventName() {
-	m.event_name = nil
-	delete(m.clearedFields, processevent.FieldEventName)
+// ResetName resets all changes to the "name" field.
+func (m *ProcessDefinitionMutation) ResetName() {
+	m.name = nil
 }
 
-// SetExecutionID sets the "execution_id" field.
-func (m *ProcessEventMutation) SetExecutionID(s string) {
-	m.execution_id = &s
+// SetCategory sets the "category" field.
+func (m *ProcessDefinitionMutation) SetCategory(s string) {
+	m.category = &s
 }
 
-// ExecutionID returns the value of the "execution_id" field in the mutation.
-func (m *ProcessEventMutation) ExecutionID() (r string, exists bool) {
-	v := m.execution_id
+// Category returns the value of the "category" field in the mutation.
+func (m *ProcessDefinitionMutation) Category() (r string, exists bool) {
+	v := m.category
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExecutionID returns the old "execution_id" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldCategory returns the old "category" field's value of the ProcessDefinition entity.
+// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldExecutionID(ctx context.Context) (v string, err error) {
+func (m *ProcessDefinitionMutation) OldCategory(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExecutionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExecutionID requires an ID field in the mutation")
+		return v, errors.New("OldCategory requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExecutionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
 	}
-	return oldValue.ExecutionID, nil
+	return oldValue.Category, nil
 }
 
-// ClearExecutionID clears the value of the "execution_id" field.
-func (m *ProcessEventMutation) ClearExecutionID() {
-	m.execution_id = nil
-	m.clearedFields[processevent.FieldExecutionID] = struct{}{}
+// ClearCategory clears the value of the "category" field.
+func (m *ProcessDefinitionMutation) ClearCategory() {
+	m.category = nil
+	m.clearedFields[processdefinition.FieldCategory] = struct{}{}
 }
 
-// ExecutionIDCleared returns if the "execution_id" field was cleared in this mutation.
-func (m *ProcessEventMutation) ExecutionIDCleared() bool {
-	_, ok := m.clearedFields[processevent.FieldExecutionID]
+// CategoryCleared returns if the "category" field was cleared in this mutation.
+func (m *ProcessDefinitionMutation) CategoryCleared() bool {
+	_, ok := m.clearedFields[processdefinition.FieldCategory]
 	return ok
 }
 
-// ResetExecutionID resets all changes to the "execution_id" field.
-func (m *ProcessEventMutation) ResetExecutionID() {
-	m.execution_id = nil
-	delete(m.clearedFields, processevent.FieldExecutionID)
+// ResetCategory resets all changes to the "category" field.
+func (m *ProcessDefinitionMutation) ResetCategory() {
+	m.category = nil
+	delete(m.clearedFields, processdefinition.FieldCategory)
 }
 
-// SetProcessInstanceID sets the "process_instance_id" field.
-func (m *ProcessEventMutation) SetProcessInstanceID(i int64) {
-	m.process_instance_id = &i
-	m.addprocess_instance_id = nil
+// SetVersion sets the "version" field.
+func (m *ProcessDefinitionMutation) SetVersion(i int32) {
+	m.version = &i
+	m.addversion = nil
 }
 
-// ProcessInstanceID returns the value of the "process_instance_id" field in the mutation.
-func (m *ProcessEventMutation) ProcessInstanceID() (r int64, exists bool) {
-	v := m.process_instance_id
+// Version returns the value of the "version" field in the mutation.
+func (m *ProcessDefinitionMutation) Version() (r int32, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessInstanceID returns the old "process_instance_id" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the ProcessDefinition entity.
+// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldProcessInstanceID(ctx context.Context) (v int64, err error) {
+func (m *ProcessDefinitionMutation) OldVersion(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessInstanceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessInstanceID requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessInstanceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.ProcessInstanceID, nil
+	return oldValue.Version, nil
 }
 
-// AddProcessInstanceID adds i to the "process_instance_id" field.
-func (m *ProcessEventMutation) AddProcessInstanceID(i int64) {
-	if m.addprocess_instance_id != nil {
-		*m.addprocess_instance_id += i
+// AddVersion adds i to the "version" field.
+func (m *ProcessDefinitionMutation) AddVersion(i int32) {
+	if m.addversion != nil {
+		*m.addversion += i
 	} else {
-		m.addprocess_instance_id = &i
+		m.addversion = &i
 	}
 }
 
-// AddedProcessInstanceID returns the value that was added to the "process_instance_id" field in this mutation.
-func (m *ProcessEventMutation) AddedProcessInstanceID() (r int64, exists bool) {
-	v := m.addprocess_instance_id
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *ProcessDefinitionMutation) AddedVersion() (r int32, exists bool) {
+	v := m.addversion
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearProcessInstanceID clears the value of the "process_instance_id" field.
-func (m *ProcessEventMutation) ClearProcessInstanceID() {
-	m.process_instance_id = nil
-	m.addprocess_instance_id = nil
-	m.clearedFields[processevent.FieldProcessInstanceID] = struct{}{}
-}
-
-// ProcessInstanceIDCleared returns if the "process_instance_id" field was cleared in this mutation.
-func (m *ProcessEventMutation) ProcessInstanceIDCleared() bool {
-	_, ok := m.clearedFields[processevent.FieldProcessInstanceID]
-	return ok
-}
-
-// ResetProcessInstanceID resets all changes to the "process_instance_id" field.
-func (m *ProcessEventMutation) ResetProcessInstanceID() {
-	m.process_instance_id = nil
-	m.addprocess_instance_id = nil
-	delete(m.clearedFields, processevent.FieldProcessInstanceID)
+// ResetVersion resets all changes to the "version" field.
+func (m *ProcessDefinitionMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
 }
 
-// SetProcessDefinitionID sets the "process_definition_id" field.
-func (m *ProcessEventMutation) SetProcessDefinitionID(i int64) {
-	m.process_definition_id = &i
-	m.addprocess_definition_id = nil
+// SetDescription sets the "description" field.
+func (m *ProcessDefinitionMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// ProcessDefinitionID returns the value of the "process_definition_id" field in the mutation.
-func (m *ProcessEventMutation) ProcessDefinitionID() (r int64, exists bool) {
-	v := m.process_definition_id
+// Description returns the value of the "description" field in the mutation.
+func (m *ProcessDefinitionMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessDefinitionID returns the old "process_definition_id" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the ProcessDefinition entity.
+// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldProcessDefinitionID(ctx context.Context) (v int64, err error) {
+func (m *ProcessDefinitionMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessDefinitionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessDefinitionID requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessDefinitionID: %w", err)
-	}
-	return oldValue.ProcessDefinitionID, nil
-}
-
-// AddProcessDefinitionID adds i to the "process_definition_id" field.
-func (m *ProcessEventMutation) AddProcessDefinitionID(i int64) {
-	if m.addprocess_definition_id != nil {
-		*m.addprocess_definition_id += i
-	} else {
-		m.addprocess_definition_id = &i
-	}
-}
-
-// AddedProcessDefinitionID returns the value that was added to the "process_definition_id" field in this mutation.
-func (m *ProcessEventMutation) AddedProcessDefinitionID() (r int64, exists bool) {
-	v := m.addprocess_definition_id
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return *v, true
+	return oldValue.Description, nil
 }
 
-// ClearProcessDefinitionID clears the value of the "process_definition_id" field.
-func (m *ProcessEventMutation) ClearProcessDefinitionID() {
-	m.process_definition_id = nil
-	m.addprocess_definition_id = nil
-	m.clearedFields[processevent.FieldProcessDefinitionID] = struct{}{}
+// ClearDescription clears the value of the "description" field.
+func (m *ProcessDefinitionMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[processdefinition.FieldDescription] = struct{}{}
 }
 
-// ProcessDefinitionIDCleared returns if the "process_definition_id" field was cleared in this mutation.
-func (m *ProcessEventMutation) ProcessDefinitionIDCleared() bool {
-	_, ok := m.clearedFields[processevent.FieldProcessDefinitionID]
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ProcessDefinitionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[processdefinition.FieldDescription]
 	return ok
 }
 
-// ResetProcessDefinitionID resets all changes to the "process_definition_id" field.
-func (m *ProcessEventMutation) ResetProcessDefinitionID() {
-	m.process_definition_id = nil
-	m.addprocess_definition_id = nil
-	delete(m.clearedFields, processevent.FieldProcessDefinitionID)
+// ResetDescription resets all changes to the "description" field.
+func (m *ProcessDefinitionMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, processdefinition.FieldDescription)
 }
 
-// SetProcessDefinitionKey sets the "process_definition_key" field.
-func (m *ProcessEventMutation) SetProcessDefinitionKey(s string) {
-	m.process_definition_key = &s
+// SetDeployTime sets the "deploy_time" field.
+func (m *ProcessDefinitionMutation) SetDeployTime(t time.Time) {
+	m.deploy_time = &t
 }
 
-// ProcessDefinitionKey returns the value of the "process_definition_key" field in the mutation.
-func (m *ProcessEventMutation) ProcessDefinitionKey() (r string, exists bool) {
-	v := m.process_definition_key
+// DeployTime returns the value of the "deploy_time" field in the mutation.
+func (m *ProcessDefinitionMutation) DeployTime() (r time.Time, exists bool) {
+	v := m.deploy_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessDefinitionKey returns the old "process_definition_key" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldDeployTime returns the old "deploy_time" field's value of the ProcessDefinition entity.
+// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldProcessDefinitionKey(ctx context.Context) (v string, err error) {
+func (m *ProcessDefinitionMutation) OldDeployTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessDefinitionKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeployTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessDefinitionKey requires an ID field in the mutation")
+		return v, errors.New("OldDeployTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessDefinitionKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeployTime: %w", err)
 	}
-	return oldValue.ProcessDefinitionKey, nil
-}
-
-// ClearProcessDefinitionKey clears the value of the "process_definition_key" field.
-func (m *ProcessEventMutation) ClearProcessDefinitionKey() {
-	m.process_definition_key = nil
-	m.clearedFields[processevent.FieldProcessDefinitionKey] = struct{}{}
-}
-
-// ProcessDefinitionKeyCleared returns if the "process_definition_key" field was cleared in this mutation.
-func (m *ProcessEventMutation) ProcessDefinitionKeyCleared() bool {
-	_, ok := m.clearedFields[processevent.FieldProcessDefinitionKey]
-	return ok
+	return oldValue.DeployTime, nil
 }
 
-// ResetProcessDefinitionKey resets all changes to the "process_definition_key" field.
-func (m *ProcessEventMutation) ResetProcessDefinitionKey() {
-	m.process_definition_key = nil
-	delete(m.clearedFields, processevent.FieldProcessDefinitionKey)
+// ResetDeployTime resets all changes to the "deploy_time" field.
+func (m *ProcessDefinitionMutation) ResetDeployTime() {
+	m.deploy_time = nil
 }
 
-// SetTaskID sets the "task_id" field.
-func (m *ProcessEventMutation) SetTaskID(i int64) {
-	m.task_id = &i
-	m.addtask_id = nil
+// SetResource sets the "resource" field.
+func (m *ProcessDefinitionMutation) SetResource(s string) {
+	m.resource = &s
 }
 
-// TaskID returns the value of the "task_id" field in the mutation.
-func (m *ProcessEventMutation) TaskID() (r int64, exists bool) {
-	v := m.task_id
+// Resource returns the value of the "resource" field in the mutation.
+func (m *ProcessDefinitionMutation) Resource() (r string, exists bool) {
+	v := m.resource
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTaskID returns the old "task_id" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldResource returns the old "resource" field's value of the ProcessDefinition entity.
+// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldTaskID(ctx context.Context) (v int64, err error) {
+func (m *ProcessDefinitionMutation) OldResource(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+		return v, errors.New("OldResource is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTaskID requires an ID field in the mutation")
+		return v, errors.New("OldResource requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
-	}
-	return oldValue.TaskID, nil
-}
-
-// AddTaskID adds i to the "task_id" field.
-func (m *ProcessEventMutation) AddTaskID(i int64) {
-	if m.addtask_id != nil {
-		*m.addtask_id += i
-	} else {
-		m.addtask_id = &i
-	}
-}
-
-// AddedTaskID returns the value that was added to the "task_id" field in this mutation.
-func (m *ProcessEventMutation) AddedTaskID() (r int64, exists bool) {
-	v := m.addtask_id
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldResource: %w", err)
 	}
-	return *v, true
+	return oldValue.Resource, nil
 }
 
-// ClearTaskID clears the value of the "task_id" field.
-func (m *ProcessEventMutation) ClearTaskID() {
-	m.task_id = nil
-	m.addtask_id = nil
-	m.clearedFields[processevent.FieldTaskID] = struct{}{}
+// ClearResource clears the value of the "resource" field.
+func (m *ProcessDefinitionMutation) ClearResource() {
+	m.resource = nil
+	m.clearedFields[processdefinition.FieldResource] = struct{}{}
 }
 
-// TaskIDCleared returns if the "task_id" field was cleared in this mutation.
-func (m *ProcessEventMutation) TaskIDCleared() bool {
-	_, ok := m.clearedFields[processevent.FieldTaskID]
+// ResourceCleared returns if the "resource" field was cleared in this mutation.
+func (m *ProcessDefinitionMutation) ResourceCleared() bool {
+	_, ok := m.clearedFields[processdefinition.FieldResource]
 	return ok
 }
 
-// ResetTaskID resets all changes to the "task_id" field.
-func (m *ProcessEventMutation) ResetTaskID() {
-	m.task_id = nil
-	m.addtask_id = nil
-	delete(m.clearedFields, processevent.FieldTaskID)
+// ResetResource resets all changes to the "resource" field.
+func (m *ProcessDefinitionMutation) ResetResource() {
+	m.resource = nil
+	delete(m.clearedFields, processdefinition.FieldResource)
 }
 
-// SetActivityID sets the "activity_id" field.
-func (m *ProcessEventMutation) SetActivityID(s string) {
-	m.activity_id = &s
+// SetDiagramData sets the "diagram_data" field.
+func (m *ProcessDefinitionMutation) SetDiagramData(value map[string]interface{}) {
+	m.diagram_data = &value
 }
 
-// ActivityID returns the value of the "activity_id" field in the mutation.
-func (m *ProcessEventMutation) ActivityID() (r string, exists bool) {
-	v := m.activity_id
+// DiagramData returns the value of the "diagram_data" field in the mutation.
+func (m *ProcessDefinitionMutation) DiagramData() (r map[string]interface{}, exists bool) {
+	v := m.diagram_data
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActivityID returns the old "activity_id" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldDiagramData returns the old "diagram_data" field's value of the ProcessDefinition entity.
+// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldActivityID(ctx context.Context) (v string, err error) {
+func (m *ProcessDefinitionMutation) OldDiagramData(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActivityID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDiagramData is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActivityID requires an ID field in the mutation")
+		return v, errors.New("OldDiagramData requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActivityID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDiagramData: %w", err)
 	}
-	return oldValue.ActivityID, nil
+	return oldValue.DiagramData, nil
 }
 
-// ClearActivityID clears the value of the "activity_id" field.
-func (m *ProcessEventMutation) ClearActivityID() {
-	m.activity_id = nil
-	m.clearedFields[processevent.FieldActivityID] = struct{}{}
+// ClearDiagramData clears the value of the "diagram_data" field.
+func (m *ProcessDefinitionMutation) ClearDiagramData() {
+	m.diagram_data = nil
+	m.clearedFields[processdefinition.FieldDiagramData] = struct{}{}
 }
 
-// ActivityIDCleared returns if the "activity_id" field was cleared in this mutation.
-func (m *ProcessEventMutation) ActivityIDCleared() bool {
-	_, ok := m.clearedFields[processevent.FieldActivityID]
+// DiagramDataCleared returns if the "diagram_data" field was cleared in this mutation.
+func (m *ProcessDefinitionMutation) DiagramDataCleared() bool {
+	_, ok := m.clearedFields[processdefinition.FieldDiagramData]
 	return ok
 }
 
-// ResetActivityID resets all changes to the "activity_id" field.
-func (m *ProcessEventMutation) ResetActivityID() {
-	m.activity_id = nil
-	delete(m.clearedFields, processevent.FieldActivityID)
+// ResetDiagramData resets all changes to the "diagram_data" field.
+func (m *ProcessDefinitionMutation) ResetDiagramData() {
+	m.diagram_data = nil
+	delete(m.clearedFields, processdefinition.FieldDiagramData)
 }
 
-// SetActivityName sets the "activity_name" field.
-func (m *ProcessEventMutation) SetActivityName(s string) {
-	m.activity_name = &s
+// SetHasStartForm sets the "has_start_form" field.
+func (m *ProcessDefinitionMutation) SetHasStartForm(b bool) {
+	m.has_start_form = &b
 }
 
-// ActivityName returns the value of the "activity_name" field in the mutation.
-func (m *ProcessEventMutation) ActivityName() (r string, exists bool) {
-	v := m.activity_name
+// HasStartForm returns the value of the "has_start_form" field in the mutation.
+func (m *ProcessDefinitionMutation) HasStartForm() (r bool, exists bool) {
+	v := m.has_start_form
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActivityName returns the old "activity_name" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldHasStartForm returns the old "has_start_form" field's value of the ProcessDefinition entity.
+// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldActivityName(ctx context.Context) (v string, err error) {
+func (m *ProcessDefinitionMutation) OldHasStartForm(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActivityName is only allowed on UpdateOne operations")
+		return v, errors.New("OldHasStartForm is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActivityName requires an ID field in the mutation")
+		return v, errors.New("OldHasStartForm requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActivityName: %w", err)
+		return v, fmt.Errorf("querying old value for OldHasStartForm: %w", err)
 	}
-	return oldValue.ActivityName, nil
-}
-
-// ClearActivityName clears the value of the "activity_name" field.
-func (m *ProcessEventMutation) ClearActivityName() {
-	m.activity_name = nil
-	m.clearedFields[processevent.FieldActivityName] = struct{}{}
-}
-
-// ActivityNameCleared returns if the "activity_name" field was cleared in this mutation.
-func (m *ProcessEventMutation) ActivityNameCleared() bool {
-	_, ok := m.clearedFields[processevent.FieldActivityName]
-	return ok
+	return oldValue.HasStartForm, nil
 }
 
-// ResetActivityName resets all changes to the "activity_name" field.
-func (m *ProcessEventMutation) ResetActivityName() {
-	m.activity_name = nil
-	delete(m.clearedFields, processevent.FieldActivityName)
+// ResetHasStartForm resets all changes to the "has_start_form" field.
+func (m *ProcessDefinitionMutation) ResetHasStartForm() {
+	m.has_start_form = nil
 }
 
-// SetActivityType sets the "activity_type" field.
-func (m *ProcessEventMutation) SetActivityType(s string) {
-	m.activity_type = &s
+// SetSuspended sets the "suspended" field.
+func (m *ProcessDefinitionMutation) SetSuspended(b bool) {
+	m.suspended = &b
 }
 
-// ActivityType returns the value of the "activity_type" field in the mutation.
-func (m *ProcessEventMutation) ActivityType() (r string, exists bool) {
-	v := m.activity_type
+// Suspended returns the value of the "suspended" field in the mutation.
+func (m *ProcessDefinitionMutation) Suspended() (r bool, exists bool) {
+	v := m.suspended
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActivityType returns the old "activity_type" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldSuspended returns the old "suspended" field's value of the ProcessDefinition entity.
+// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldActivityType(ctx context.Context) (v string, err error) {
+func (m *ProcessDefinitionMutation) OldSuspended(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActivityType is only allowed on UpdateOne operations")
+		return v, errors.New("OldSuspended is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActivityType requires an ID field in the mutation")
+		return v, errors.New("OldSuspended requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActivityType: %w", err)
+		return v, fmt.Errorf("querying old value for OldSuspended: %w", err)
 	}
-	return oldValue.ActivityType, nil
-}
-
-// ClearActivityType clears the value of the "activity_type" field.
-func (m *ProcessEventMutation) ClearActivityType() {
-	m.activity_type = nil
-	m.clearedFields[processevent.FieldActivityType] = struct{}{}
-}
-
-// ActivityTypeCleared returns if the "activity_type" field was cleared in this mutation.
-func (m *ProcessEventMutation) ActivityTypeCleared() bool {
-	_, ok := m.clearedFields[processevent.FieldActivityType]
-	return ok
+	return oldValue.Suspended, nil
 }
 
-// ResetActivityType resets all changes to the "activity_type" field.
-func (m *ProcessEventMutation) ResetActivityType() {
-	m.activity_type = nil
-	delete(m.clearedFields, processevent.FieldActivityType)
+// ResetSuspended resets all changes to the "suspended" field.
+func (m *ProcessDefinitionMutation) ResetSuspended() {
+	m.suspended = nil
 }
 
-// SetUserID sets the "user_id" field.
-func (m *ProcessEventMutation) SetUserID(s string) {
-	m.user_id = &s
+// SetTenantID sets the "tenant_id" field.
+func (m *ProcessDefinitionMutation) SetTenantID(s string) {
+	m.tenant_id = &s
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *ProcessEventMutation) UserID() (r string, exists bool) {
-	v := m.user_id
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *ProcessDefinitionMutation) TenantID() (r string, exists bool) {
+	v := m.tenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the ProcessDefinition entity.
+// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldUserID(ctx context.Context) (v string, err error) {
+func (m *ProcessDefinitionMutation) OldTenantID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.UserID, nil
-}
-
-// ClearUserID clears the value of the "user_id" field.
-func (m *ProcessEventMutation) ClearUserID() {
-	m.user_id = nil
-	m.clearedFields[processevent.FieldUserID] = struct{}{}
-}
-
-// UserIDCleared returns if the "user_id" field was cleared in this mutation.
-func (m *ProcessEventMutation) UserIDCleared() bool {
-	_, ok := m.clearedFields[processevent.FieldUserID]
-	return ok
+	return oldValue.TenantID, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *ProcessEventMutation) ResetUserID() {
-	m.user_id = nil
-	delete(m.clearedFields, processevent.FieldUserID)
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *ProcessDefinitionMutation) ResetTenantID() {
+	m.tenant_id = nil
 }
 
-// SetTimestamp sets the "timestamp" field.
-func (m *ProcessEventMutation) SetTimestamp(t time.Time) {
-	m.timestamp = &t
+// SetCreatedAt sets the "created_at" field.
+func (m *ProcessDefinitionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Timestamp returns the value of the "timestamp" field in the mutation.
-func (m *ProcessEventMutation) Timestamp() (r time.Time, exists bool) {
-	v := m.timestamp
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProcessDefinitionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimestamp returns the old "timestamp" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ProcessDefinition entity.
+// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+func (m *ProcessDefinitionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Timestamp, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetTimestamp resets all changes to the "timestamp" field.
-func (m *ProcessEventMutation) ResetTimestamp() {
-	m.timestamp = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProcessDefinitionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetEventData sets the "event_data" field.
-func (m *ProcessEventMutation) SetEventData(value map[string]interface{}) {
-	m.event_data = &value
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProcessDefinitionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// EventData returns the value of the "event_data" field in the mutation.
-func (m *ProcessEventMutation) EventData() (r map[string]interface{}, exists bool) {
-	v := m.event_data
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProcessDefinitionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEventData returns the old "event_data" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ProcessDefinition entity.
+// If the ProcessDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldEventData(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *ProcessDefinitionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEventData is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEventData requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEventData: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.EventData, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearEventData clears the value of the "event_data" field.
-func (m *ProcessEventMutation) ClearEventData() {
-	m.event_data = nil
-	m.clearedFields[processevent.FieldEventData] = struct{}{}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProcessDefinitionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// EventDataCleared returns if the "event_data" field was cleared in this mutation.
-func (m *ProcessEventMutation) EventDataCleared() bool {
-	_, ok := m.clearedFields[processevent.FieldEventData]
-	return ok
+// Where appends a list predicates to the ProcessDefinitionMutation builder.
+func (m *ProcessDefinitionMutation) Where(ps ...predicate.ProcessDefinition) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetEventData resets all changes to the "event_data" field.
-func (m *ProcessEventMutation) ResetEventData() {
-	m.event_data = nil
-	delete(m.clearedFields, processevent.FieldEventData)
+// WhereP appends storage-level predicates to the ProcessDefinitionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProcessDefinitionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProcessDefinition, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetCorrelationID sets the "correlation_id" field.
-func (m *ProcessEventMutation) SetCorrelationID(s string) {
-	m.correlation_id = &s
+// Op returns the operation name.
+func (m *ProcessDefinitionMutation) Op() Op {
+	return m.op
 }
 
-// CorrelationID returns the value of the "correlation_id" field in the mutation.
-func (m *ProcessEventMutation) CorrelationID() (r string, exists bool) {
-	v := m.correlation_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetOp allows setting the mutation operation.
+func (m *ProcessDefinitionMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// OldCorrelationID returns the old "correlation_id" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldCorrelationID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCorrelationID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCorrelationID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCorrelationID: %w", err)
-	}
-	return oldValue.CorrelationID, nil
-}
-
-// ClearCorrelationID clears the value of the "correlation_id" field.
-func (m *ProcessEventMutation) ClearCorrelationID() {
-	m.correlation_id = nil
-	m.clearedFields[processevent.FieldCorrelationID] = struct{}{}
-}
-
-// CorrelationIDCleared returns if the "correlation_id" field was cleared in this mutation.
-func (m *ProcessEventMutation) CorrelationIDCleared() bool {
-	_, ok := m.clearedFields[processevent.FieldCorrelationID]
-	return ok
-}
-
-// ResetCorrelationID resets all changes to the "correlation_id" field.
-func (m *ProcessEventMutation) ResetCorrelationID() {
-	m.correlation_id = nil
-	delete(m.clearedFields, processevent.FieldCorrelationID)
-}
-
-// SetMessageName sets the "message_name" field.
-func (m *ProcessEventMutation) SetMessageName(s string) {
-	m.message_name = &s
+// Type returns the node type of this mutation (ProcessDefinition).
+func (m *ProcessDefinitionMutation) Type() string {
+	return m.typ
 }
 
-// MessageName returns the value of the "message_name" field in the mutation.
-func (m *ProcessEventMutation) MessageName() (r string, exists bool) {
-	v := m.message_name
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProcessDefinitionMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.key != nil {
+		fields = append(fields, processdefinition.FieldKey)
 	}
-	return *v, true
-}
-
-// OldMessageName returns the old "message_name" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldMessageName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessageName is only allowed on UpdateOne operations")
+	if m.name != nil {
+		fields = append(fields, processdefinition.FieldName)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessageName requires an ID field in the mutation")
+	if m.category != nil {
+		fields = append(fields, processdefinition.FieldCategory)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessageName: %w", err)
+	if m.version != nil {
+		fields = append(fields, processdefinition.FieldVersion)
 	}
-	return oldValue.MessageName, nil
-}
-
-// ClearMessageName clears the value of the "message_name" field.
-func (m *ProcessEventMutation) ClearMessageName() {
-	m.message_name = nil
-	m.clearedFields[processevent.FieldMessageName] = struct{}{}
-}
-
-// MessageNameCleared returns if the "message_name" field was cleared in this mutation.
-func (m *ProcessEventMutation) MessageNameCleared() bool {
-	_, ok := m.clearedFields[processevent.FieldMessageName]
-	return ok
-}
-
-// ResetMessageName resets all changes to the "message_name" field.
-func (m *ProcessEventMutation) ResetMessageName() {
-	m.message_name = nil
-	delete(m.clearedFields, processevent.FieldMessageName)
-}
-
-// SetSignalName sets the "signal_name" field.
-func (m *ProcessEventMutation) SetSignalName(s string) {
-	m.signal_name = &s
-}
-
-// SignalName returns the value of the "signal_name" field in the mutation.
-func (m *ProcessEventMutation) SignalName() (r string, exists bool) {
-	v := m.signal_name
-	if v == nil {
-		return
+	if m.description != nil {
+		fields = append(fields, processdefinition.FieldDescription)
 	}
-	return *v, true
-}
-
-// OldSignalName returns the old "signal_name" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldSignalName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSignalName is only allowed on UpdateOne operations")
+	if m.deploy_time != nil {
+		fields = append(fields, processdefinition.FieldDeployTime)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSignalName requires an ID field in the mutation")
+	if m.resource != nil {
+		fields = append(fields, processdefinition.FieldResource)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSignalName: %w", err)
+	if m.diagram_data != nil {
+		fields = append(fields, processdefinition.FieldDiagramData)
 	}
-	return oldValue.SignalName, nil
-}
-
-// ClearSignalName clears the value of the "signal_name" field.
-func (m *ProcessEventMutation) ClearSignalName() {
-	m.signal_name = nil
-	m.clearedFields[processevent.FieldSignalName] = struct{}{}
-}
-
-// SignalNameCleared returns if the "signal_name" field was cleared in this mutation.
-func (m *ProcessEventMutation) SignalNameCleared() bool {
-	_, ok := m.clearedFields[processevent.FieldSignalName]
-	return ok
-}
-
-// ResetSignalName resets all changes to the "signal_name" field.
-func (m *ProcessEventMutation) ResetSignalName() {
-	m.signal_name = nil
-	delete(m.clearedFields, processevent.FieldSignalName)
-}
-
-// SetJobID sets the "job_id" field.
-func (m *ProcessEventMutation) SetJobID(s string) {
-	m.job_id = &s
-}
-
-// JobID returns the value of the "job_id" field in the mutation.
-func (m *ProcessEventMutation) JobID() (r string, exists bool) {
-	v := m.job_id
-	if v == nil {
-		return
+	if m.has_start_form != nil {
+		fields = append(fields, processdefinition.FieldHasStartForm)
 	}
-	return *v, true
-}
-
-// OldJobID returns the old "job_id" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldJobID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
+	if m.suspended != nil {
+		fields = append(fields, processdefinition.FieldSuspended)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJobID requires an ID field in the mutation")
+	if m.tenant_id != nil {
+		fields = append(fields, processdefinition.FieldTenantID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
+	if m.created_at != nil {
+		fields = append(fields, processdefinition.FieldCreatedAt)
 	}
-	return oldValue.JobID, nil
-}
-
-// ClearJobID clears the value of the "job_id" field.
-func (m *ProcessEventMutation) ClearJobID() {
-	m.job_id = nil
-	m.clearedFields[processevent.FieldJobID] = struct{}{}
-}
-
-// JobIDCleared returns if the "job_id" field was cleared in this mutation.
-func (m *ProcessEventMutation) JobIDCleared() bool {
-	_, ok := m.clearedFields[processevent.FieldJobID]
-	return ok
-}
-
-// ResetJobID resets all changes to the "job_id" field.
-func (m *ProcessEventMutation) ResetJobID() {
-	m.job_id = nil
-	delete(m.clearedFields, processevent.FieldJobID)
-}
-
-// SetJobType sets the "job_type" field.
-func (m *ProcessEventMutation) SetJobType(s string) {
-	m.job_type = &s
-}
-
-// JobType returns the value of the "job_type" field in the mutation.
-func (m *ProcessEventMutation) JobType() (r string, exists bool) {
-	v := m.job_type
-	if v == nil {
-		return
+	if m.updated_at != nil {
+		fields = append(fields, processdefinition.FieldUpdatedAt)
 	}
-	return *v, true
+	return fields
 }
 
-// OldJobType returns the old "job_type" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldJobType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJobType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJobType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJobType: %w", err)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProcessDefinitionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case processdefinition.FieldKey:
+		return m.Key()
+	case processdefinition.FieldName:
+		return m.Name()
+	case processdefinition.FieldCategory:
+		return m.Category()
+	case processdefinition.FieldVersion:
+		return m.Version()
+	case processdefinition.FieldDescription:
+		return m.Description()
+	case processdefinition.FieldDeployTime:
+		return m.DeployTime()
+	case processdefinition.FieldResource:
+		return m.Resource()
+	case processdefinition.FieldDiagramData:
+		return m.DiagramData()
+	case processdefinition.FieldHasStartForm:
+		return m.HasStartForm()
+	case processdefinition.FieldSuspended:
+		return m.Suspended()
+	case processdefinition.FieldTenantID:
+		return m.TenantID()
+	case processdefinition.FieldCreatedAt:
+		return m.CreatedAt()
+	case processdefinition.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
-	return oldValue.JobType, nil
-}
-
-// ClearJobType clears the value of the "job_type" field.
-func (m *ProcessEventMutation) ClearJobType() {
-	m.job_type = nil
-	m.clearedFields[processevent.FieldJobType] = struct{}{}
-}
-
-// JobTypeCleared returns if the "job_type" field was cleared in this mutation.
-func (m *ProcessEventMutation) JobTypeCleared() bool {
-	_, ok := m.clearedFields[processevent.FieldJobType]
-	return ok
+	return nil, false
 }
 
-// ResetJobType resets all changes to the "job_type" field.
-func (m *ProcessEventMutation) ResetJobType() {
-	m.job_type = nil
-	delete(m.clearedFields, processevent.FieldJobType)
-}
-
-// SetJobHandlerType sets the "job_handler_type" field.
-func (m *ProcessEventMutation) SetJobHandlerType(s string) {
-	m.job_handler_type = &s
-}
-
-// JobHandlerType returns the value of the "job_handler_type" field in the mutation.
-func (m *ProcessEventMutation) JobHandlerType() (r string, exists bool) {
-	v := m.job_handler_type
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProcessDefinitionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case processdefinition.FieldKey:
+		return m.OldKey(ctx)
+	case processdefinition.FieldName:
+		return m.OldName(ctx)
+	case processdefinition.FieldCategory:
+		return m.OldCategory(ctx)
+	case processdefinition.FieldVersion:
+		return m.OldVersion(ctx)
+	case processdefinition.FieldDescription:
+		return m.OldDescription(ctx)
+	case processdefinition.FieldDeployTime:
+		return m.OldDeployTime(ctx)
+	case processdefinition.FieldResource:
+		return m.OldResource(ctx)
+	case processdefinition.FieldDiagramData:
+		return m.OldDiagramData(ctx)
+	case processdefinition.FieldHasStartForm:
+		return m.OldHasStartForm(ctx)
+	case processdefinition.FieldSuspended:
+		return m.OldSuspended(ctx)
+	case processdefinition.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case processdefinition.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case processdefinition.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown ProcessDefinition field %s", name)
 }
 
-// OldJobHandlerType returns the old "job_handler_type" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldJobHandlerType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJobHandlerType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJobHandlerType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJobHandlerType: %w", err)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProcessDefinitionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case processdefinition.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case processdefinition.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case processdefinition.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case processdefinition.FieldVersion:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case processdefinition.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case processdefinition.FieldDeployTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeployTime(v)
+		return nil
+	case processdefinition.FieldResource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResource(v)
+		return nil
+	case processdefinition.FieldDiagramData:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiagramData(v)
+		return nil
+	case processdefinition.FieldHasStartForm:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHasStartForm(v)
+		return nil
+	case processdefinition.FieldSuspended:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuspended(v)
+		return nil
+	case processdefinition.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case processdefinition.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case processdefinition.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return oldValue.JobHandlerType, nil
-}
-
-// ClearJobHandlerType clears the value of the "job_handler_type" field.
-func (m *ProcessEventMutation) ClearJobHandlerType() {
-	m.job_handler_type = nil
-	m.clearedFields[processevent.FieldJobHandlerType] = struct{}{}
-}
-
-// JobHandlerTypeCleared returns if the "job_handler_type" field was cleared in this mutation.
-func (m *ProcessEventMutation) JobHandlerTypeCleared() bool {
-	_, ok := m.clearedFields[processevent.FieldJobHandlerType]
-	return ok
+	return fmt.Errorf("unknown ProcessDefinition field %s", name)
 }
 
-// ResetJobHandlerType resets all changes to the "job_handler_type" field.
-func (m *ProcessEventMutation) ResetJobHandlerType() {
-	m.job_handler_type = nil
-	delete(m.clearedFields, processevent.FieldJobHandlerType)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProcessDefinitionMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, processdefinition.FieldVersion)
+	}
+	return fields
 }
 
-// SetTenantID sets the "tenant_id" field.
-func (m *ProcessEventMutation) SetTenantID(s string) {
-	m.tenant_id = &s
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProcessDefinitionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case processdefinition.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
 }
 
-// TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *ProcessEventMutation) TenantID() (r string, exists bool) {
-	v := m.tenant_id
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProcessDefinitionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case processdefinition.FieldVersion:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown ProcessDefinition numeric field %s", name)
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldTenantID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProcessDefinitionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(processdefinition.FieldCategory) {
+		fields = append(fields, processdefinition.FieldCategory)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	if m.FieldCleared(processdefinition.FieldDescription) {
+		fields = append(fields, processdefinition.FieldDescription)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	if m.FieldCleared(processdefinition.FieldResource) {
+		fields = append(fields, processdefinition.FieldResource)
 	}
-	return oldValue.TenantID, nil
-}
-
-// ResetTenantID resets all changes to the "tenant_id" field.
-func (m *ProcessEventMutation) ResetTenantID() {
-	m.tenant_id = nil
+	if m.FieldCleared(processdefinition.FieldDiagramData) {
+		fields = append(fields, processdefinition.FieldDiagramData)
+	}
+	return fields
 }
 
-// SetDeploymentID sets the "deployment_id" field.
-func (m *ProcessEventMutation) SetDeploymentID(s string) {
-	m.deployment_id = &s
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProcessDefinitionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// DeploymentID returns the value of the "deployment_id" field in the mutation.
-func (m *ProcessEventMutation) DeploymentID() (r string, exists bool) {
-	v := m.deployment_id
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProcessDefinitionMutation) ClearField(name string) error {
+	switch name {
+	case processdefinition.FieldCategory:
+		m.ClearCategory()
+		return nil
+	case processdefinition.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case processdefinition.FieldResource:
+		m.ClearResource()
+		return nil
+	case processdefinition.FieldDiagramData:
+		m.ClearDiagramData()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown ProcessDefinition nullable field %s", name)
 }
 
-// OldDeploymentID returns the old "deployment_id" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldDeploymentID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeploymentID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeploymentID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeploymentID: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProcessDefinitionMutation) ResetField(name string) error {
+	switch name {
+	case processdefinition.FieldKey:
+		m.ResetKey()
+		return nil
+	case processdefinition.FieldName:
+		m.ResetName()
+		return nil
+	case processdefinition.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case processdefinition.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case processdefinition.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case processdefinition.FieldDeployTime:
+		m.ResetDeployTime()
+		return nil
+	case processdefinition.FieldResource:
+		m.ResetResource()
+		return nil
+	case processdefinition.FieldDiagramData:
+		m.ResetDiagramData()
+		return nil
+	case processdefinition.FieldHasStartForm:
+		m.ResetHasStartForm()
+		return nil
+	case processdefinition.FieldSuspended:
+		m.ResetSuspended()
+		return nil
+	case processdefinition.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case processdefinition.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case processdefinition.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return oldValue.DeploymentID, nil
+	return fmt.Errorf("unknown ProcessDefinition field %s", name)
 }
 
-// ClearDeploymentID clears the value of the "deployment_id" field.
-func (m *ProcessEventMutation) ClearDeploymentID() {
-	m.deployment_id = nil
-	m.clearedFields[processevent.FieldDeploymentID] = struct{}{}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProcessDefinitionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// DeploymentIDCleared returns if the "deployment_id" field was cleared in this mutation.
-func (m *ProcessEventMutation) DeploymentIDCleared() bool {
-	_, ok := m.clearedFields[processevent.FieldDeploymentID]
-	return ok
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProcessDefinitionMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetDeploymentID resets all changes to the "deployment_id" field.
-func (m *ProcessEventMutation) ResetDeploymentID() {
-	m.deployment_id = nil
-	delete(m.clearedFields, processevent.FieldDeploymentID)
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProcessDefinitionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetSequenceCounter sets the "sequence_counter" field.
-func (m *ProcessEventMutation) SetSequenceCounter(s string) {
-	m.sequence_counter = &s
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProcessDefinitionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SequenceCounter returns the value of the "sequence_counter" field in the mutation.
-func (m *ProcessEventMutation) SequenceCounter() (r string, exists bool) {
-	v := m.sequence_counter
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProcessDefinitionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldSequenceCounter returns the old "sequence_counter" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldSequenceCounter(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSequenceCounter is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSequenceCounter requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSequenceCounter: %w", err)
-	}
-	return oldValue.SequenceCounter, nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProcessDefinitionMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// ClearSequenceCounter clears the value of the "sequence_counter" field.
-func (m *ProcessEventMutation) ClearSequenceCounter() {
-	m.sequence_counter = nil
-	m.clearedFields[processevent.FieldSequenceCounter] = struct{}{}
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProcessDefinitionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ProcessDefinition unique edge %s", name)
 }
 
-// SequenceCounterCleared returns if the "sequence_counter" field was cleared in this mutation.
-func (m *ProcessEventMutation) SequenceCounterCleared() bool {
-	_, ok := m.clearedFields[processevent.FieldSequenceCounter]
-	return ok
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProcessDefinitionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ProcessDefinition edge %s", name)
 }
 
-// ResetSequenceCounter resets all changes to the "sequence_counter" field.
-func (m *ProcessEventMutation) ResetSequenceCounter() {
-	m.sequence_counter = nil
-	delete(m.clearedFields, processevent.FieldSequenceCounter)
+// ProcessEventMutation represents an operation that mutates the ProcessEvent nodes in the graph.
+type ProcessEventMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *int64
+	event_type               *string
+	event_name               *string
+	execution_id             *string
+	process_instance_id      *int64
+	addprocess_instance_id   *int64
+	process_definition_id    *int64
+	addprocess_definition_id *int64
+	process_definition_key   *string
+	task_id                  *int64
+	addtask_id               *int64
+	activity_id              *string
+	activity_name            *string
+	activity_type            *string
+	user_id                  *string
+	timestamp                *time.Time
+	event_data               *map[string]interface{}
+	correlation_id           *string
+	message_name             *string
+	signal_name              *string
+	job_id                   *string
+	job_type                 *string
+	job_handler_type         *string
+	tenant_id                *string
+	deployment_id            *string
+	sequence_counter         *string
+	created_at               *time.Time
+	clearedFields            map[string]struct{}
+	done                     bool
+	oldValue                 func(context.Context) (*ProcessEvent, error)
+	predicates               []predicate.ProcessEvent
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ProcessEventMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
+var _ ent.Mutation = (*ProcessEventMutation)(nil)
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ProcessEventMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+// processeventOption allows management of the mutation configuration using functional options.
+type processeventOption func(*ProcessEventMutation)
 
-// OldCreatedAt returns the old "created_at" field's value of the ProcessEvent entity.
-// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+// newProcessEventMutation creates new mutation for the ProcessEvent entity.
+func newProcessEventMutation(c config, op Op, opts ...processeventOption) *ProcessEventMutation {
+	m := &ProcessEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProcessEvent,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	return m
+}
+
+// withProcessEventID sets the ID field of the mutation.
+func withProcessEventID(id int64) processeventOption {
+	return func(m *ProcessEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProcessEvent
+		)
+		m.oldValue = func(ctx context.Context) (*ProcessEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProcessEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.CreatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ProcessEventMutation) ResetCreatedAt() {
-	m.created_at = nil
+// withProcessEvent sets the old ProcessEvent of the mutation.
+func withProcessEvent(node *ProcessEvent) processeventOption {
+	return func(m *ProcessEventMutation) {
+		m.oldValue = func(context.Context) (*ProcessEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// Where appends a list predicates to the ProcessEventMutation builder.
-func (m *ProcessEventMutation) Where(ps ...predicate.ProcessEvent) {
-	m.predicates = append(m.predicates, ps...)
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProcessEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// WhereP appends storage-level predicates to the ProcessEventMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProcessEventMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ProcessEvent, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProcessEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	m.Where(p...)
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// Op returns the operation name.
-func (m *ProcessEventMutation) Op() Op {
-	return m.op
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ProcessEvent entities.
+func (m *ProcessEventMutation) SetID(id int64) {
+	m.id = &id
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ProcessEventMutation) SetOp(op Op) {
-	m.op = op
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProcessEventMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// Type returns the node type of this mutation (ProcessEvent).
-func (m *ProcessEventMutation) Type() string {
-	return m.typ
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProcessEventMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProcessEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ProcessEventMutation) Fields() []string {
-	fields := make([]string, 0, 23)
-	if m.event_type != nil {
-		fields = append(fields, processevent.FieldEventType)
+// SetEventType sets the "event_type" field.
+func (m *ProcessEventMutation) SetEventType(s string) {
+	m.event_type = &s
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *ProcessEventMutation) EventType() (r string, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
 	}
-	if m.event_name != nil {
-		fields = append(fields, processevent.FieldEventName)
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessEventMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
 	}
-	if m.execution_id != nil {
-		fields = append(fields, processevent.FieldExecutionID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
 	}
-	if m.process_instance_id != nil {
-		fields = append(fields, processevent.FieldProcessInstanceID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
 	}
-	if m.process_definition_id != nil {
-		fields = append(fields, processevent.FieldProcessDefinitionID)
+	return oldValue.EventType, nil
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *ProcessEventMutation) ResetEventType() {
+	m.event_type = nil
+}
+
+// SetEventName sets the "event_name" field.
+func (m *ProcessEventMutation) SetEventName(s string) {
+	m.event_name = &s
+}
+
+// EventName returns the value of the "event_name" field in the mutation.
+func (m *ProcessEventMutation) EventName() (r string, exists bool) {
+	v := m.event_name
+	if v == nil {
+		return
 	}
-	if m.process_definition_key != nil {
-		fields = append(fields, processevent.FieldProcessDefinitionKey)
+	return *v, true
+}
+
+// OldEventName returns the old "event_name" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessEventMutation) OldEventName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventName is only allowed on UpdateOne operations")
 	}
-	if m.task_id != nil {
-		fields = append(fields, processevent.FieldTaskID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventName requires an ID field in the mutation")
 	}
-	if m.activity_id != nil {
-		fields = append(fields, processevent.FieldActivityID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventName: %w", err)
 	}
-	if m.activity_name != nil {
-		fields = append(fields, processevent.FieldActivityName)
+	return oldValue.EventName, nil
+}
+
+// ClearEventName clears the value of the "event_name" field.
+func (m *ProcessEventMutation) ClearEventName() {
+	m.event_name = nil
+	m.clearedFields[processevent.FieldEventName] = struct{}{}
+}
+
+// EventNameCleared returns if the "event_name" field was cleared in this mutation.
+func (m *ProcessEventMutation) EventNameCleared() bool {
+	_, ok := m.clearedFields[processevent.FieldEventName]
+	return ok
+}
+
+// ResetEventName resets all changes to the "event_name" field.
+func (m *ProcessEventMutation) ResetEventName() {
+	m.event_name = nil
+	delete(m.clearedFields, processevent.FieldEventName)
+}
+
+// SetExecutionID sets the "execution_id" field.
+func (m *ProcessEventMutation) SetExecutionID(s string) {
+	m.execution_id = &s
+}
+
+// ExecutionID returns the value of the "execution_id" field in the mutation.
+func (m *ProcessEventMutation) ExecutionID() (r string, exists bool) {
+	v := m.execution_id
+	if v == nil {
+		return
 	}
-	if m.activity_type != nil {
-		fields = append(fields, processevent.FieldActivityType)
+	return *v, true
+}
+
+// OldExecutionID returns the old "execution_id" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessEventMutation) OldExecutionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExecutionID is only allowed on UpdateOne operations")
 	}
-	if m.user_id != nil {
-		fields = append(fields, processevent.FieldUserID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExecutionID requires an ID field in the mutation")
 	}
-	if m.timestamp != nil {
-		fields = append(fields, processevent.FieldTimestamp)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExecutionID: %w", err)
 	}
-	if m.event_data != nil {
-		fields = append(fields, processevent.FieldEventData)
+	return oldValue.ExecutionID, nil
+}
+
+// ClearExecutionID clears the value of the "execution_id" field.
+func (m *ProcessEventMutation) ClearExecutionID() {
+	m.execution_id = nil
+	m.clearedFields[processevent.FieldExecutionID] = struct{}{}
+}
+
+// ExecutionIDCleared returns if the "execution_id" field was cleared in this mutation.
+func (m *ProcessEventMutation) ExecutionIDCleared() bool {
+	_, ok := m.clearedFields[processevent.FieldExecutionID]
+	return ok
+}
+
+// ResetExecutionID resets all changes to the "execution_id" field.
+func (m *ProcessEventMutation) ResetExecutionID() {
+	m.execution_id = nil
+	delete(m.clearedFields, processevent.FieldExecutionID)
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (m *ProcessEventMutation) SetProcessInstanceID(i int64) {
+	m.process_instance_id = &i
+	m.addprocess_instance_id = nil
+}
+
+// ProcessInstanceID returns the value of the "process_instance_id" field in the mutation.
+func (m *ProcessEventMutation) ProcessInstanceID() (r int64, exists bool) {
+	v := m.process_instance_id
+	if v == nil {
+		return
 	}
-	if m.correlation_id != nil {
-		fields = append(fields, processevent.FieldCorrelationID)
+	return *v, true
+}
+
+// OldProcessInstanceID returns the old "process_instance_id" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessEventMutation) OldProcessInstanceID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessInstanceID is only allowed on UpdateOne operations")
 	}
-	if m.message_name != nil {
-		fields = append(fields, processevent.FieldMessageName)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessInstanceID requires an ID field in the mutation")
 	}
-	if m.signal_name != nil {
-		fields = append(fields, processevent.FieldSignalName)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessInstanceID: %w", err)
 	}
-	if m.job_id != nil {
-		fields = append(fields, processevent.FieldJobID)
+	return oldValue.ProcessInstanceID, nil
+}
+
+// AddProcessInstanceID adds i to the "process_instance_id" field.
+func (m *ProcessEventMutation) AddProcessInstanceID(i int64) {
+	if m.addprocess_instance_id != nil {
+		*m.addprocess_instance_id += i
+	} else {
+		m.addprocess_instance_id = &i
 	}
-	if m.job_type != nil {
-		fields = append(fields, processevent.FieldJobType)
+}
+
+// AddedProcessInstanceID returns the value that was added to the "process_instance_id" field in this mutation.
+func (m *ProcessEventMutation) AddedProcessInstanceID() (r int64, exists bool) {
+	v := m.addprocess_instance_id
+	if v == nil {
+		return
 	}
-	if m.job_handler_type != nil {
-		fields = append(fields, processevent.FieldJobHandlerType)
+	return *v, true
+}
+
+// ClearProcessInstanceID clears the value of the "process_instance_id" field.
+func (m *ProcessEventMutation) ClearProcessInstanceID() {
+	m.process_instance_id = nil
+	m.addprocess_instance_id = nil
+	m.clearedFields[processevent.FieldProcessInstanceID] = struct{}{}
+}
+
+// ProcessInstanceIDCleared returns if the "process_instance_id" field was cleared in this mutation.
+func (m *ProcessEventMutation) ProcessInstanceIDCleared() bool {
+	_, ok := m.clearedFields[processevent.FieldProcessInstanceID]
+	return ok
+}
+
+// ResetProcessInstanceID resets all changes to the "process_instance_id" field.
+func (m *ProcessEventMutation) ResetProcessInstanceID() {
+	m.process_instance_id = nil
+	m.addprocess_instance_id = nil
+	delete(m.clearedFields, processevent.FieldProcessInstanceID)
+}
+
+// SetProcessDefinitionID sets the "process_definition_id" field.
+func (m *ProcessEventMutation) SetProcessDefinitionID(i int64) {
+	m.process_definition_id = &i
+	m.addprocess_definition_id = nil
+}
+
+// ProcessDefinitionID returns the value of the "process_definition_id" field in the mutation.
+func (m *ProcessEventMutation) ProcessDefinitionID() (r int64, exists bool) {
+	v := m.process_definition_id
+	if v == nil {
+		return
 	}
-	if m.tenant_id != nil {
-		fields = append(fields, processevent.FieldTenantID)
+	return *v, true
+}
+
+// OldProcessDefinitionID returns the old "process_definition_id" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessEventMutation) OldProcessDefinitionID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessDefinitionID is only allowed on UpdateOne operations")
 	}
-	if m.deployment_id != nil {
-		fields = append(fields, processevent.FieldDeploymentID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessDefinitionID requires an ID field in the mutation")
 	}
-	if m.sequence_counter != nil {
-		fields = append(fields, processevent.FieldSequenceCounter)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessDefinitionID: %w", err)
 	}
-	if m.created_at != nil {
-		fields = append(fields, processevent.FieldCreatedAt)
+	return oldValue.ProcessDefinitionID, nil
+}
+
+// AddProcessDefinitionID adds i to the "process_definition_id" field.
+func (m *ProcessEventMutation) AddProcessDefinitionID(i int64) {
+	if m.addprocess_definition_id != nil {
+		*m.addprocess_definition_id += i
+	} else {
+		m.addprocess_definition_id = &i
 	}
-	return fields
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ProcessEventMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case processevent.FieldEventType:
-		return m.EventType()
-	case processevent.FieldEventName:
-		return m.EventName()
-	case processevent.FieldExecutionID:
-		return m.ExecutionID()
-	case processevent.FieldProcessInstanceID:
-		return m.ProcessInstanceID()
-	case processevent.FieldProcessDefinitionID:
-		return m.ProcessDefinitionID()
-	case processevent.FieldProcessDefinitionKey:
-		return m.ProcessDefinitionKey()
-	case processevent.FieldTaskID:
-		return m.TaskID()
-	case processevent.FieldActivityID:
-		return m.ActivityID()
-	case processevent.FieldActivityName:
-		return m.ActivityName()
-	case processevent.FieldActivityType:
-		return m.ActivityType()
-	case processevent.FieldUserID:
-		return m.UserID()
-	case processevent.FieldTimestamp:
-		return m.Timestamp()
-	case processevent.FieldEventData:
-		return m.EventData()
-	case processevent.FieldCorrelationID:
-		return m.CorrelationID()
-	case processevent.FieldMessageName:
-		return m.MessageName()
-	case processevent.FieldSignalName:
-		return m.SignalName()
-	case processevent.FieldJobID:
-		return m.JobID()
-	case processevent.FieldJobType:
-		return m.JobType()
-	case processevent.FieldJobHandlerType:
-		return m.JobHandlerType()
-	case processevent.FieldTenantID:
-		return m.TenantID()
-	case processevent.FieldDeploymentID:
-		return m.DeploymentID()
-	case processevent.FieldSequenceCounter:
-		return m.SequenceCounter()
-	case processevent.FieldCreatedAt:
-		return m.CreatedAt()
+// AddedProcessDefinitionID returns the value that was added to the "process_definition_id" field in this mutation.
+func (m *ProcessEventMutation) AddedProcessDefinitionID() (r int64, exists bool) {
+	v := m.addprocess_definition_id
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ProcessEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case processevent.FieldEventType:
-		return m.OldEventType(ctx)
-	case processevent.FieldEventName:
-		return m.OldEventName(ctx)
-	case processevent.FieldExecutionID:
-		return m.OldExecutionID(ctx)
-	case processevent.FieldProcessInstanceID:
-		return m.OldProcessInstanceID(ctx)
-	case processevent.FieldProcessDefinitionID:
-		return m.OldProcessDefinitionID(ctx)
-	case processevent.FieldProcessDefinitionKey:
-		return m.OldProcessDefinitionKey(ctx)
-	case processevent.FieldTaskID:
-		return m.OldTaskID(ctx)
-	case processevent.FieldActivityID:
-		return m.OldActivityID(ctx)
-	case processevent.FieldActivityName:
-		return m.OldActivityName(ctx)
-	case processevent.FieldActivityType:
-		return m.OldActivityType(ctx)
-	case processevent.FieldUserID:
-		return m.OldUserID(ctx)
-	case processevent.FieldTimestamp:
-		return m.OldTimestamp(ctx)
-	case processevent.FieldEventData:
-		return m.OldEventData(ctx)
-	case processevent.FieldCorrelationID:
-		return m.OldCorrelationID(ctx)
-	case processevent.FieldMessageName:
-		return m.OldMessageName(ctx)
-	case processevent.FieldSignalName:
-		return m.OldSignalName(ctx)
-	case processevent.FieldJobID:
-		return m.OldJobID(ctx)
-	case processevent.FieldJobType:
-		return m.OldJobType(ctx)
-	case processevent.FieldJobHandlerType:
-		return m.OldJobHandlerType(ctx)
-	case processevent.FieldTenantID:
-		return m.OldTenantID(ctx)
-	case processevent.FieldDeploymentID:
-		return m.OldDeploymentID(ctx)
-	case processevent.FieldSequenceCounter:
-		return m.OldSequenceCounter(ctx)
-	case processevent.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+// ClearProcessDefinitionID clears the value of the "process_definition_id" field.
+func (m *ProcessEventMutation) ClearProcessDefinitionID() {
+	m.process_definition_id = nil
+	m.addprocess_definition_id = nil
+	m.clearedFields[processevent.FieldProcessDefinitionID] = struct{}{}
+}
+
+// ProcessDefinitionIDCleared returns if the "process_definition_id" field was cleared in this mutation.
+func (m *ProcessEventMutation) ProcessDefinitionIDCleared() bool {
+	_, ok := m.clearedFields[processevent.FieldProcessDefinitionID]
+	return ok
+}
+
+// ResetProcessDefinitionID resets all changes to the "process_definition_id" field.
+func (m *ProcessEventMutation) ResetProcessDefinitionID() {
+	m.process_definition_id = nil
+	m.addprocess_definition_id = nil
+	delete(m.clearedFields, processevent.FieldProcessDefinitionID)
+}
+
+// SetProcessDefinitionKey sets the "process_definition_key" field.
+func (m *ProcessEventMutation) SetProcessDefinitionKey(s string) {
+	m.process_definition_key = &s
+}
+
+// ProcessDefinitionKey returns the value of the "process_definition_key" field in the mutation.
+func (m *ProcessEventMutation) ProcessDefinitionKey() (r string, exists bool) {
+	v := m.process_definition_key
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown ProcessEvent field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ProcessEventMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case processevent.FieldEventType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEventType(v)
-		return nil
-	case processevent.FieldEventName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEventName(v)
-		return nil
-	case processevent.FieldExecutionID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExecutionID(v)
-		return nil
-	case processevent.FieldProcessInstanceID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProcessInstanceID(v)
-		return nil
-	case processevent.FieldProcessDefinitionID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProcessDefinitionID(v)
-		return nil
-	case processevent.FieldProcessDefinitionKey:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProcessDefinitionKey(v)
-		return nil
-	case processevent.FieldTaskID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTaskID(v)
-		return nil
-	case processevent.FieldActivityID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetActivityID(v)
-		return nil
-	case processevent.FieldActivityName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetActivityName(v)
-		return nil
-	case processevent.FieldActivityType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetActivityType(v)
-		return nil
-	case processevent.FieldUserID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case processevent.FieldTimestamp:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTimestamp(v)
-		return nil
-	case processevent.FieldEventData:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEventData(v)
-		return nil
-	case processevent.FieldCorrelationID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCorrelationID(v)
-		return nil
-	case processevent.FieldMessageName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMessageName(v)
-		return nil
-	case processevent.FieldSignalName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSignalName(v)
-		return nil
-	case processevent.FieldJobID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetJobID(v)
-		return nil
-	case processevent.FieldJobType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetJobType(v)
-		return nil
-	case processevent.FieldJobHandlerType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetJobHandlerType(v)
-		return nil
-	case processevent.FieldTenantID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTenantID(v)
-		return nil
-	case processevent.FieldDeploymentID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeploymentID(v)
-		return nil
-	case processevent.FieldSequenceCounter:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSequenceCounter(v)
-		return nil
-	case processevent.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	}
-	return fmt.Errorf("unknown ProcessEvent field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ProcessEventMutation) AddedFields() []string {
-	var fields []string
-	if m.addprocess_instance_id != nil {
-		fields = append(fields, processevent.FieldProcessInstanceID)
+// OldProcessDefinitionKey returns the old "process_definition_key" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessEventMutation) OldProcessDefinitionKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessDefinitionKey is only allowed on UpdateOne operations")
 	}
-	if m.addprocess_definition_id != nil {
-		fields = append(fields, processevent.FieldProcessDefinitionID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessDefinitionKey requires an ID field in the mutation")
 	}
-	if m.addtask_id != nil {
-		fields = append(fields, processevent.FieldTaskID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessDefinitionKey: %w", err)
 	}
-	return fields
+	return oldValue.ProcessDefinitionKey, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ProcessEventMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case processevent.FieldProcessInstanceID:
-		return m.AddedProcessInstanceID()
-	case processevent.FieldProcessDefinitionID:
-		return m.AddedProcessDefinitionID()
-	case processevent.FieldTaskID:
-		return m.AddedTaskID()
-	}
-	return nil, false
+// ClearProcessDefinitionKey clears the value of the "process_definition_key" field.
+func (m *ProcessEventMutation) ClearProcessDefinitionKey() {
+	m.process_definition_key = nil
+	m.clearedFields[processevent.FieldProcessDefinitionKey] = struct{}{}
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ProcessEventMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case processevent.FieldProcessInstanceID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddProcessInstanceID(v)
-		return nil
-	case processevent.FieldProcessDefinitionID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddProcessDefinitionID(v)
-		return nil
-	case processevent.FieldTaskID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTaskID(v)
-		return nil
-	}
-	return fmt.Errorf("unknown ProcessEvent numeric field %s", name)
+// ProcessDefinitionKeyCleared returns if the "process_definition_key" field was cleared in this mutation.
+func (m *ProcessEventMutation) ProcessDefinitionKeyCleared() bool {
+	_, ok := m.clearedFields[processevent.FieldProcessDefinitionKey]
+	return ok
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ProcessEventMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(processevent.FieldEventName) {
-		fields = append(fields, processevent.FieldEventName)
+// ResetProcessDefinitionKey resets all changes to the "process_definition_key" field.
+func (m *ProcessEventMutation) ResetProcessDefinitionKey() {
+	m.process_definition_key = nil
+	delete(m.clearedFields, processevent.FieldProcessDefinitionKey)
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *ProcessEventMutation) SetTaskID(i int64) {
+	m.task_id = &i
+	m.addtask_id = nil
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *ProcessEventMutation) TaskID() (r int64, exists bool) {
+	v := m.task_id
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(processevent.FieldExecutionID) {
-		fields = append(fields, processevent.FieldExecutionID)
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessEventMutation) OldTaskID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(processevent.FieldProcessInstanceID) {
-		fields = append(fields, processevent.FieldProcessInstanceID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
 	}
-	if m.FieldCleared(processevent.FieldProcessDefinitionID) {
-		fields = append(fields, processevent.FieldProcessDefinitionID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
 	}
-	if m.FieldCleared(processevent.FieldProcessDefinitionKey) {
-		fields = append(fields, processevent.FieldProcessDefinitionKey)
+	return oldValue.TaskID, nil
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (m *ProcessEventMutation) AddTaskID(i int64) {
+	if m.addtask_id != nil {
+		*m.addtask_id += i
+	} else {
+		m.addtask_id = &i
 	}
-	if m.FieldCleared(processevent.FieldTaskID) {
-		fields = append(fields, processevent.FieldTaskID)
+}
+
+// AddedTaskID returns the value that was added to the "task_id" field in this mutation.
+func (m *ProcessEventMutation) AddedTaskID() (r int64, exists bool) {
+	v := m.addtask_id
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(processevent.FieldActivityID) {
-		fields = append(fields, processevent.FieldActivityID)
+	return *v, true
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (m *ProcessEventMutation) ClearTaskID() {
+	m.task_id = nil
+	m.addtask_id = nil
+	m.clearedFields[processevent.FieldTaskID] = struct{}{}
+}
+
+// TaskIDCleared returns if the "task_id" field was cleared in this mutation.
+func (m *ProcessEventMutation) TaskIDCleared() bool {
+	_, ok := m.clearedFields[processevent.FieldTaskID]
+	return ok
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *ProcessEventMutation) ResetTaskID() {
+	m.task_id = nil
+	m.addtask_id = nil
+	delete(m.clearedFields, processevent.FieldTaskID)
+}
+
+// SetActivityID sets the "activity_id" field.
+func (m *ProcessEventMutation) SetActivityID(s string) {
+	m.activity_id = &s
+}
+
+// ActivityID returns the value of the "activity_id" field in the mutation.
+func (m *ProcessEventMutation) ActivityID() (r string, exists bool) {
+	v := m.activity_id
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(processevent.FieldActivityName) {
-		fields = append(fields, processevent.FieldActivityName)
+	return *v, true
+}
+
+// OldActivityID returns the old "activity_id" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessEventMutation) OldActivityID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActivityID is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(processevent.FieldActivityType) {
-		fields = append(fields, processevent.FieldActivityType)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActivityID requires an ID field in the mutation")
 	}
-	if m.FieldCleared(processevent.FieldUserID) {
-		fields = append(fields, processevent.FieldUserID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActivityID: %w", err)
 	}
-	if m.FieldCleared(processevent.FieldEventData) {
-		fields = append(fields, processevent.FieldEventData)
+	return oldValue.ActivityID, nil
+}
+
+// ClearActivityID clears the value of the "activity_id" field.
+func (m *ProcessEventMutation) ClearActivityID() {
+	m.activity_id = nil
+	m.clearedFields[processevent.FieldActivityID] = struct{}{}
+}
+
+// ActivityIDCleared returns if the "activity_id" field was cleared in this mutation.
+func (m *ProcessEventMutation) ActivityIDCleared() bool {
+	_, ok := m.clearedFields[processevent.FieldActivityID]
+	return ok
+}
+
+// ResetActivityID resets all changes to the "activity_id" field.
+func (m *ProcessEventMutation) ResetActivityID() {
+	m.activity_id = nil
+	delete(m.clearedFields, processevent.FieldActivityID)
+}
+
+// SetActivityName sets the "activity_name" field.
+func (m *ProcessEventMutation) SetActivityName(s string) {
+	m.activity_name = &s
+}
+
+// ActivityName returns the value of the "activity_name" field in the mutation.
+func (m *ProcessEventMutation) ActivityName() (r string, exists bool) {
+	v := m.activity_name
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(processevent.FieldCorrelationID) {
-		fields = append(fields, processevent.FieldCorrelationID)
+	return *v, true
+}
+
+// OldActivityName returns the old "activity_name" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessEventMutation) OldActivityName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActivityName is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(processevent.FieldMessageName) {
-		fields = append(fields, processevent.FieldMessageName)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActivityName requires an ID field in the mutation")
 	}
-	if m.FieldCleared(processevent.FieldSignalName) {
-		fields = append(fields, processevent.FieldSignalName)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActivityName: %w", err)
 	}
-	if m.FieldCleared(processevent.FieldJobID) {
-		fields = append(fields, processevent.FieldJobID)
+	return oldValue.ActivityName, nil
+}
+
+// ClearActivityName clears the value of the "activity_name" field.
+func (m *ProcessEventMutation) ClearActivityName() {
+	m.activity_name = nil
+	m.clearedFields[processevent.FieldActivityName] = struct{}{}
+}
+
+// ActivityNameCleared returns if the "activity_name" field was cleared in this mutation.
+func (m *ProcessEventMutation) ActivityNameCleared() bool {
+	_, ok := m.clearedFields[processevent.FieldActivityName]
+	return ok
+}
+
+// ResetActivityName resets all changes to the "activity_name" field.
+func (m *ProcessEventMutation) ResetActivityName() {
+	m.activity_name = nil
+	delete(m.clearedFields, processevent.FieldActivityName)
+}
+
+// SetActivityType sets the "activity_type" field.
+func (m *ProcessEventMutation) SetActivityType(s string) {
+	m.activity_type = &s
+}
+
+// ActivityType returns the value of the "activity_type" field in the mutation.
+func (m *ProcessEventMutation) ActivityType() (r string, exists bool) {
+	v := m.activity_type
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(processevent.FieldJobType) {
-		fields = append(fields, processevent.FieldJobType)
+	return *v, true
+}
+
+// OldActivityType returns the old "activity_type" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessEventMutation) OldActivityType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActivityType is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(processevent.FieldJobHandlerType) {
-		fields = append(fields, processevent.FieldJobHandlerType)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActivityType requires an ID field in the mutation")
 	}
-	if m.FieldCleared(processevent.FieldDeploymentID) {
-		fields = append(fields, processevent.FieldDeploymentID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActivityType: %w", err)
 	}
-	if m.FieldCleared(processevent.FieldSequenceCounter) {
-		fields = append(fields, processevent.FieldSequenceCounter)
+	return oldValue.ActivityType, nil
+}
+
+// ClearActivityType clears the value of the "activity_type" field.
+func (m *ProcessEventMutation) ClearActivityType() {
+	m.activity_type = nil
+	m.clearedFields[processevent.FieldActivityType] = struct{}{}
+}
+
+// ActivityTypeCleared returns if the "activity_type" field was cleared in this mutation.
+func (m *ProcessEventMutation) ActivityTypeCleared() bool {
+	_, ok := m.clearedFields[processevent.FieldActivityType]
+	return ok
+}
+
+// ResetActivityType resets all changes to the "activity_type" field.
+func (m *ProcessEventMutation) ResetActivityType() {
+	m.activity_type = nil
+	delete(m.clearedFields, processevent.FieldActivityType)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ProcessEventMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ProcessEventMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ProcessEventMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// OldUserID returns the old "user_id" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessEventMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *ProcessEventMutation) ClearUserID() {
+	m.user_id = nil
+	m.clearedFields[processevent.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *ProcessEventMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[processevent.FieldUserID]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ProcessEventMutation) ClearField(name string) error {
-	switch name {
-	case processevent.FieldEventName:
-		m.ClearEventName()
-		return nil
-	case processevent.FieldExecutionID:
-		m.ClearExecutionID()
-		return nil
-	case processevent.FieldProcessInstanceID:
-		m.ClearProcessInstanceID()
-		return nil
-	case processevent.FieldProcessDefinitionID:
-		m.ClearProcessDefinitionID()
-		return nil
-	case processevent.FieldProcessDefinitionKey:
-		m.ClearProcessDefinitionKey()
-		return nil
-	case processevent.FieldTaskID:
-		m.ClearTaskID()
-		return nil
-	case processevent.FieldActivityID:
-		m.ClearActivityID()
-		return nil
-	case processevent.FieldActivityName:
-		m.ClearActivityName()
-		return nil
-	case processevent.FieldActivityType:
-		m.ClearActivityType()
-		return nil
-	case processevent.FieldUserID:
-		m.ClearUserID()
-		return nil
-	case processevent.FieldEventData:
-		m.ClearEventData()
-		return nil
-	case processevent.FieldCorrelationID:
-		m.ClearCorrelationID()
-		return nil
-	case processevent.FieldMessageName:
-		m.ClearMessageName()
-		return nil
-	case processevent.FieldSignalName:
-		m.ClearSignalName()
-		return nil
-	case processevent.FieldJobID:
-		m.ClearJobID()
-		return nil
-	case processevent.FieldJobType:
-		m.ClearJobType()
-		return nil
-	case processevent.FieldJobHandlerType:
-		m.ClearJobHandlerType()
-		return nil
-	case processevent.FieldDeploymentID:
-		m.ClearDeploymentID()
-		return nil
-	case processevent.FieldSequenceCounter:
-		m.ClearSequenceCounter()
-		return nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ProcessEventMutation) ResetUserID() {
+	m.user_id = nil
+	delete(m.clearedFields, processevent.FieldUserID)
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *ProcessEventMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *ProcessEventMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown ProcessEvent nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ProcessEventMutation) ResetField(name string) error {
-	switch name {
-	case processevent.FieldEventType:
-		m.ResetEventType()
-		return nil
-	case processevent.FieldEventName:
-		m.ResetEventName()
-		return nil
-	case processevent.FieldExecutionID:
-		m.ResetExecutionID()
-		return nil
-	case processevent.FieldProcessInstanceID:
-		m.ResetProcessInstanceID()
-		return nil
-	case processevent.FieldProcessDefinitionID:
-		m.ResetProcessDefinitionID()
-		return nil
-	case processevent.FieldProcessDefinitionKey:
-		m.ResetProcessDefinitionKey()
-		return nil
-	case processevent.FieldTaskID:
-		m.ResetTaskID()
-		return nil
-	case processevent.FieldActivityID:
-		m.ResetActivityID()
-		return nil
-	case processevent.FieldActivityName:
-		m.ResetActivityName()
-		return nil
-	case processevent.FieldActivityType:
-		m.ResetActivityType()
-		return nil
-	case processevent.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case processevent.FieldTimestamp:
-		m.ResetTimestamp()
-		return nil
-	case processevent.FieldEventData:
-		m.ResetEventData()
-		return nil
-	case processevent.FieldCorrelationID:
-		m.ResetCorrelationID()
-		return nil
-	case processevent.FieldMessageName:
-		m.ResetMessageName()
-		return nil
-	case processevent.FieldSignalName:
-		m.ResetSignalName()
-		return nil
-	case processevent.FieldJobID:
-		m.ResetJobID()
-		return nil
-	case processevent.FieldJobType:
-		m.ResetJobType()
-		return nil
-	case processevent.FieldJobHandlerType:
-		m.ResetJobHandlerType()
-		return nil
-	case processevent.FieldTenantID:
-		m.ResetTenantID()
-		return nil
-	case processevent.FieldDeploymentID:
-		m.ResetDeploymentID()
-		return nil
-	case processevent.FieldSequenceCounter:
-		m.ResetSequenceCounter()
-		return nil
-	case processevent.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
+// OldTimestamp returns the old "timestamp" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessEventMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown ProcessEvent field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProcessEventMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *ProcessEventMutation) ResetTimestamp() {
+	m.timestamp = nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ProcessEventMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// SetEventData sets the "event_data" field.
+func (m *ProcessEventMutation) SetEventData(value map[string]interface{}) {
+	m.event_data = &value
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProcessEventMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// EventData returns the value of the "event_data" field in the mutation.
+func (m *ProcessEventMutation) EventData() (r map[string]interface{}, exists bool) {
+	v := m.event_data
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ProcessEventMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// OldEventData returns the old "event_data" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessEventMutation) OldEventData(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventData: %w", err)
+	}
+	return oldValue.EventData, nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProcessEventMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ClearEventData clears the value of the "event_data" field.
+func (m *ProcessEventMutation) ClearEventData() {
+	m.event_data = nil
+	m.clearedFields[processevent.FieldEventData] = struct{}{}
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ProcessEventMutation) EdgeCleared(name string) bool {
-	return false
+// EventDataCleared returns if the "event_data" field was cleared in this mutation.
+func (m *ProcessEventMutation) EventDataCleared() bool {
+	_, ok := m.clearedFields[processevent.FieldEventData]
+	return ok
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ProcessEventMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ProcessEvent unique edge %s", name)
+// ResetEventData resets all changes to the "event_data" field.
+func (m *ProcessEventMutation) ResetEventData() {
+	m.event_data = nil
+	delete(m.clearedFields, processevent.FieldEventData)
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ProcessEventMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ProcessEvent edge %s", name)
+// SetCorrelationID sets the "correlation_id" field.
+func (m *ProcessEventMutation) SetCorrelationID(s string) {
+	m.correlation_id = &s
 }
 
-// ProcessInstanceMutation represents an operation that mutates the ProcessInstance nodes in the graph.
-type ProcessInstanceMutation struct {
-	config
-	op                            Op
-	typ                           string
-	id                            *int64
-	business_key                  *string
-	process_definition_id         *int64
-	addprocess_definition_id      *int64
-	process_definition_key        *string
-	process_definition_name       *string
-	process_definition_version    *int32
-	addprocess_definition_version *int32
-	deployment_id                 *string
-	start_user_id                 *string
-	start_time                    *time.Time
-	end_time                      *time.Time
-	duration                      *int64
-	addduration                   *int64
-	delete_reason                 *string
-	super_process_instance_id     *string
-	root_process_instance_id      *string
-	suspended                     *bool
-	tenant_id                     *string
-	name                          *string
-	description                   *string
-	callback_id                   *string
-	callback_type                 *string
-	reference_id                  *string
-	reference_type                *string
-	created_at                    *time.Time
-	updated_at                    *time.Time
-	clearedFields                 map[string]struct{}
-	done                          bool
-	oldValue                      func(context.Context) (*ProcessInstance, error)
-	predicates                    []predicate.ProcessInstance
-}
-
-var _ ent.Mutation = (*ProcessInstanceMutation)(nil)
-
-// processinstanceOption allows management of the mutation configuration using functional options.
-type processinstanceOption func(*ProcessInstanceMutation)
-
-// newProcessInstanceMutation creates new mutation for the ProcessInstance entity.
-func newProcessInstanceMutation(c config, op Op, opts ...processinstanceOption) *ProcessInstanceMutation {
-	m := &ProcessInstanceMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeProcessInstance,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// CorrelationID returns the value of the "correlation_id" field in the mutation.
+func (m *ProcessEventMutation) CorrelationID() (r string, exists bool) {
+	v := m.correlation_id
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withProcessInstanceID sets the ID field of the mutation.
-func withProcessInstanceID(id int64) processinstanceOption {
-	return func(m *ProcessInstanceMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *ProcessInstance
-		)
-		m.oldValue = func(ctx context.Context) (*ProcessInstance, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().ProcessInstance.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldCorrelationID returns the old "correlation_id" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessEventMutation) OldCorrelationID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCorrelationID is only allowed on UpdateOne operations")
 	}
-}
-
-// withProcessInstance sets the old ProcessInstance of the mutation.
-func withProcessInstance(node *ProcessInstance) processinstanceOption {
-	return func(m *ProcessInstanceMutation) {
-		m.oldValue = func(context.Context) (*ProcessInstance, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCorrelationID requires an ID field in the mutation")
 	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProcessInstanceMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ProcessInstanceMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCorrelationID: %w", err)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return oldValue.CorrelationID, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ProcessInstance entities.
-func (m *ProcessInstanceMutation) SetID(id int64) {
-	m.id = &id
+// ClearCorrelationID clears the value of the "correlation_id" field.
+func (m *ProcessEventMutation) ClearCorrelationID() {
+	m.correlation_id = nil
+	m.clearedFields[processevent.FieldCorrelationID] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ProcessInstanceMutation) ID() (id int64, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// CorrelationIDCleared returns if the "correlation_id" field was cleared in this mutation.
+func (m *ProcessEventMutation) CorrelationIDCleared() bool {
+	_, ok := m.clearedFields[processevent.FieldCorrelationID]
+	return ok
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ProcessInstanceMutation) IDs(ctx context.Context) ([]int64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ProcessInstance.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetCorrelationID resets all changes to the "correlation_id" field.
+func (m *ProcessEventMutation) ResetCorrelationID() {
+	m.correlation_id = nil
+	delete(m.clearedFields, processevent.FieldCorrelationID)
 }
 
-// SetBusinessKey sets the "business_key" field.
-func (m *ProcessInstanceMutation) SetBusinessKey(s string) {
-	m.business_key = &s
+// SetMessageName sets the "message_name" field.
+func (m *ProcessEventMutation) SetMessageName(s string) {
+	m.message_name = &s
 }
 
-// BusinessKey returns the value of the "business_key" field in the mutation.
-func (m *ProcessInstanceMutation) BusinessKey() (r string, exists bool) {
-	v := m.business_key
+// MessageName returns the value of the "message_name" field in the mutation.
+func (m *ProcessEventMutation) MessageName() (r string, exists bool) {
+	v := m.message_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBusinessKey returns the old "business_key" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldMessageName returns the old "message_name" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldBusinessKey(ctx context.Context) (v string, err error) {
+func (m *ProcessEventMutation) OldMessageName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBusinessKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessageName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBusinessKey requires an ID field in the mutation")
+		return v, errors.New("OldMessageName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBusinessKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessageName: %w", err)
 	}
-	return oldValue.BusinessKey, nil
+	return oldValue.MessageName, nil
 }
 
-// ClearBusinessKey clears the value of the "business_key" field.
-func (m *ProcessInstanceMutation) ClearBusinessKey() {
-	m.business_key = nil
-	m.clearedFields[processinstance.FieldBusinessKey] = struct{}{}
+// ClearMessageName clears the value of the "message_name" field.
+func (m *ProcessEventMutation) ClearMessageName() {
+	m.message_name = nil
+	m.clearedFields[processevent.FieldMessageName] = struct{}{}
 }
 
-// BusinessKeyCleared returns if the "business_key" field was cleared in this mutation.
-func (m *ProcessInstanceMutation) BusinessKeyCleared() bool {
-	_, ok := m.clearedFields[processinstance.FieldBusinessKey]
+// MessageNameCleared returns if the "message_name" field was cleared in this mutation.
+func (m *ProcessEventMutation) MessageNameCleared() bool {
+	_, ok := m.clearedFields[processevent.FieldMessageName]
 	return ok
 }
 
-// ResetBusinessKey resets all changes to the "business_key" field.
-func (m *ProcessInstanceMutation) ResetBusinessKey() {
-	m.business_key = nil
-	delete(m.clearedFields, processinstance.FieldBusinessKey)
+// ResetMessageName resets all changes to the "message_name" field.
+func (m *ProcessEventMutation) ResetMessageName() {
+	m.message_name = nil
+	delete(m.clearedFields, processevent.FieldMessageName)
 }
 
-// SetProcessDefinitionID sets the "process_definition_id" field.
-func (m *ProcessInstanceMutation) SetProcessDefinitionID(i int64) {
-	m.process_definition_id = &i
-	m.addprocess_definition_id = nil
+// SetSignalName sets the "signal_name" field.
+func (m *ProcessEventMutation) SetSignalName(s string) {
+	m.signal_name = &s
 }
 
-// ProcessDefinitionID returns the value of the "process_definition_id" field in the mutation.
-func (m *ProcessInstanceMutation) ProcessDefinitionID() (r int64, exists bool) {
-	v := m.process_definition_id
+// SignalName returns the value of the "signal_name" field in the mutation.
+func (m *ProcessEventMutation) SignalName() (r string, exists bool) {
+	v := m.signal_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessDefinitionID returns the old "process_definition_id" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldSignalName returns the old "signal_name" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldProcessDefinitionID(ctx context.Context) (v int64, err error) {
+func (m *ProcessEventMutation) OldSignalName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessDefinitionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSignalName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessDefinitionID requires an ID field in the mutation")
+		return v, errors.New("OldSignalName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessDefinitionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSignalName: %w", err)
 	}
-	return oldValue.ProcessDefinitionID, nil
+	return oldValue.SignalName, nil
 }
 
-// AddProcessDefinitionID adds i to the "process_definition_id" field.
-func (m *ProcessInstanceMutation) AddProcessDefinitionID(i int64) {
-	if m.addprocess_definition_id != nil {
-		*m.addprocess_definition_id += i
-	} else {
-		m.addprocess_definition_id = &i
-	}
+// ClearSignalName clears the value of the "signal_name" field.
+func (m *ProcessEventMutation) ClearSignalName() {
+	m.signal_name = nil
+	m.clearedFields[processevent.FieldSignalName] = struct{}{}
 }
 
-// AddedProcessDefinitionID returns the value that was added to the "process_definition_id" field in this mutation.
-func (m *ProcessInstanceMutation) AddedProcessDefinitionID() (r int64, exists bool) {
-	v := m.addprocess_definition_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// SignalNameCleared returns if the "signal_name" field was cleared in this mutation.
+func (m *ProcessEventMutation) SignalNameCleared() bool {
+	_, ok := m.clearedFields[processevent.FieldSignalName]
+	return ok
 }
 
-// ResetProcessDefinitionID resets all changes to the "process_definition_id" field.
-func (m *ProcessInstanceMutation) ResetProcessDefinitionID() {
-	m.process_definition_id = nil
-	m.addprocess_definition_id = nil
+// ResetSignalName resets all changes to the "signal_name" field.
+func (m *ProcessEventMutation) ResetSignalName() {
+	m.signal_name = nil
+	delete(m.clearedFields, processevent.FieldSignalName)
 }
 
-// SetProcessDefinitionKey sets the "process_definition_key" field.
-func (m *ProcessInstanceMutation) SetProcessDefinitionKey(s string) {
-	m.process_definition_key = &s
+// SetJobID sets the "job_id" field.
+func (m *ProcessEventMutation) SetJobID(s string) {
+	m.job_id = &s
 }
 
-// ProcessDefinitionKey returns the value of the "process_definition_key" field in the mutation.
-func (m *ProcessInstanceMutation) ProcessDefinitionKey() (r string, exists bool) {
-	v := m.process_definition_key
+// JobID returns the value of the "job_id" field in the mutation.
+func (m *ProcessEventMutation) JobID() (r string, exists bool) {
+	v := m.job_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessDefinitionKey returns the old "process_definition_key" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldJobID returns the old "job_id" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldProcessDefinitionKey(ctx context.Context) (v string, err error) {
+func (m *ProcessEventMutation) OldJobID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessDefinitionKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessDefinitionKey requires an ID field in the mutation")
+		return v, errors.New("OldJobID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessDefinitionKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
 	}
-	return oldValue.ProcessDefinitionKey, nil
+	return oldValue.JobID, nil
 }
 
-// ResetProcessDefinitionKey resets all changes to the "process_definition_key" field.
-func (m *ProcessInstanceMutation) ResetProcessDefinitionKey() {
-	m.process_definition_key = nil
+// ClearJobID clears the value of the "job_id" field.
+func (m *ProcessEventMutation) ClearJobID() {
+	m.job_id = nil
+	m.clearedFields[processevent.FieldJobID] = struct{}{}
 }
 
-// SetProcessDefinitionName sets the "process_definition_name" field.
-func (m *ProcessInstanceMutation) SetProcessDefinitionName(s string) {
-	m.process_definition_name = &s
+// JobIDCleared returns if the "job_id" field was cleared in this mutation.
+func (m *ProcessEventMutation) JobIDCleared() bool {
+	_, ok := m.clearedFields[processevent.FieldJobID]
+	return ok
 }
 
-// ProcessDefinitionName returns the value of the "process_definition_name" field in the mutation.
-func (m *ProcessInstanceMutation) ProcessDefinitionName() (r string, exists bool) {
-	v := m.process_definition_name
+// ResetJobID resets all changes to the "job_id" field.
+func (m *ProcessEventMutation) ResetJobID() {
+	m.job_id = nil
+	delete(m.clearedFields, processevent.FieldJobID)
+}
+
+// SetJobType sets the "job_type" field.
+func (m *ProcessEventMutation) SetJobType(s string) {
+	m.job_type = &s
+}
+
+// JobType returns the value of the "job_type" field in the mutation.
+func (m *ProcessEventMutation) JobType() (r string, exists bool) {
+	v := m.job_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessDefinitionName returns the old "process_definition_name" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldJobType returns the old "job_type" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldProcessDefinitionName(ctx context.Context) (v string, err error) {
+func (m *ProcessEventMutation) OldJobType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessDefinitionName is only allowed on UpdateOne operations")
+		return v, errors.New("OldJobType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessDefinitionName requires an ID field in the mutation")
+		return v, errors.New("OldJobType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessDefinitionName: %w", err)
+		return v, fmt.Errorf("querying old value for OldJobType: %w", err)
 	}
-	return oldValue.ProcessDefinitionName, nil
+	return oldValue.JobType, nil
 }
 
-// ClearProcessDefinitionName clears the value of the "process_definition_name" field.
-func (m *ProcessInstanceMutation) ClearProcessDefinitionName() {
-	m.process_definition_name = nil
-	m.clearedFields[processinstance.FieldProcessDefinitionName] = struct{}{}
+// ClearJobType clears the value of the "job_type" field.
+func (m *ProcessEventMutation) ClearJobType() {
+	m.job_type = nil
+	m.clearedFields[processevent.FieldJobType] = struct{}{}
 }
 
-// ProcessDefinitionNameCleared returns if the "process_definition_name" field was cleared in this mutation.
-func (m *ProcessInstanceMutation) ProcessDefinitionNameCleared() bool {
-	_, ok := m.clearedFields[processinstance.FieldProcessDefinitionName]
+// JobTypeCleared returns if the "job_type" field was cleared in this mutation.
+func (m *ProcessEventMutation) JobTypeCleared() bool {
+	_, ok := m.clearedFields[processevent.FieldJobType]
 	return ok
 }
 
-// ResetProcessDefinitionName resets all changes to the "process_definition_name" field.
-func (m *ProcessInstanceMutation) ResetProcessDefinitionName() {
-	m.process_definition_name = nil
-	delete(m.clearedFields, processinstance.FieldProcessDefinitionName)
+// ResetJobType resets all changes to the "job_type" field.
+func (m *ProcessEventMutation) ResetJobType() {
+	m.job_type = nil
+	delete(m.clearedFields, processevent.FieldJobType)
 }
 
-// SetProcessDefinitionVersion sets the "process_definition_version" field.
-func (m *ProcessInstanceMutation) SetProcessDefinitionVersion(i int32) {
-	m.process_definition_version = &i
-	m.addprocess_definition_version = nil
+// SetJobHandlerType sets the "job_handler_type" field.
+func (m *ProcessEventMutation) SetJobHandlerType(s string) {
+	m.job_handler_type = &s
 }
 
-// ProcessDefinitionVersion returns the value of the "process_definition_version" field in the mutation.
-func (m *ProcessInstanceMutation) ProcessDefinitionVersion() (r int32, exists bool) {
-	v := m.process_definition_version
+// JobHandlerType returns the value of the "job_handler_type" field in the mutation.
+func (m *ProcessEventMutation) JobHandlerType() (r string, exists bool) {
+	v := m.job_handler_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessDefinitionVersion returns the old "process_definition_version" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldJobHandlerType returns the old "job_handler_type" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldProcessDefinitionVersion(ctx context.Context) (v int32, err error) {
+func (m *ProcessEventMutation) OldJobHandlerType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessDefinitionVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldJobHandlerType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessDefinitionVersion requires an ID field in the mutation")
+		return v, errors.New("OldJobHandlerType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessDefinitionVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldJobHandlerType: %w", err)
 	}
-	return oldValue.ProcessDefinitionVersion, nil
+	return oldValue.JobHandlerType, nil
 }
 
-// AddProcessDefinitionVersion adds i to the "process_definition_version" field.
-func (m *ProcessInstanceMutation) AddProcessDefinitionVersion(i int32) {
-	if m.addprocess_definition_version != nil {
-		*m.addprocess_definition_version += i
-	} else {
-		m.addprocess_definition_version = &i
-	}
+// ClearJobHandlerType clears the value of the "job_handler_type" field.
+func (m *ProcessEventMutation) ClearJobHandlerType() {
+	m.job_handler_type = nil
+	m.clearedFields[processevent.FieldJobHandlerType] = struct{}{}
 }
 
-// AddedProcessDefinitionVersion returns the value that was added to the "process_definition_version" field in this mutation.
-func (m *ProcessInstanceMutation) AddedProcessDefinitionVersion() (r int32, exists bool) {
-	v := m.addprocess_definition_version
+// JobHandlerTypeCleared returns if the "job_handler_type" field was cleared in this mutation.
+func (m *ProcessEventMutation) JobHandlerTypeCleared() bool {
+	_, ok := m.clearedFields[processevent.FieldJobHandlerType]
+	return ok
+}
+
+// ResetJobHandlerType resets all changes to the "job_handler_type" field.
+func (m *ProcessEventMutation) ResetJobHandlerType() {
+	m.job_handler_type = nil
+	delete(m.clearedFields, processevent.FieldJobHandlerType)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *ProcessEventMutation) SetTenantID(s string) {
+	m.tenant_id = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *ProcessEventMutation) TenantID() (r string, exists bool) {
+	v := m.tenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetProcessDefinitionVersion resets all changes to the "process_definition_version" field.
-func (m *ProcessInstanceMutation) ResetProcessDefinitionVersion() {
-	m.process_definition_version = nil
-	m.addprocess_definition_version = nil
+// OldTenantID returns the old "tenant_id" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessEventMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *ProcessEventMutation) ResetTenantID() {
+	m.tenant_id = nil
 }
 
 // SetDeploymentID sets the "deployment_id" field.
-func (m *ProcessInstanceMutation) SetDeploymentID(s string) {
+func (m *ProcessEventMutation) SetDeploymentID(s string) {
 	m.deployment_id = &s
 }
 
 // DeploymentID returns the value of the "deployment_id" field in the mutation.
-func (m *ProcessInstanceMutation) DeploymentID() (r string, exists bool) {
+func (m *ProcessEventMutation) DeploymentID() (r string, exists bool) {
 	v := m.deployment_id
 	if v == nil {
 		return
@@ -5434,10 +5483,10 @@ func (m *ProcessInstanceMutation) DeploymentID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldDeploymentID returns the old "deployment_id" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldDeploymentID returns the old "deployment_id" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldDeploymentID(ctx context.Context) (v string, err error) {
+func (m *ProcessEventMutation) OldDeploymentID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeploymentID is only allowed on UpdateOne operations")
 	}
@@ -5452,970 +5501,7872 @@ func (m *ProcessInstanceMutation) OldDeploymentID(ctx context.Context) (v string
 }
 
 // ClearDeploymentID clears the value of the "deployment_id" field.
-func (m *ProcessInstanceMutation) ClearDeploymentID() {
+func (m *ProcessEventMutation) ClearDeploymentID() {
 	m.deployment_id = nil
-	m.clearedFields[processinstance.FieldDeploymentID] = struct{}{}
+	m.clearedFields[processevent.FieldDeploymentID] = struct{}{}
 }
 
 // DeploymentIDCleared returns if the "deployment_id" field was cleared in this mutation.
-func (m *ProcessInstanceMutation) DeploymentIDCleared() bool {
-	_, ok := m.clearedFields[processinstance.FieldDeploymentID]
+func (m *ProcessEventMutation) DeploymentIDCleared() bool {
+	_, ok := m.clearedFields[processevent.FieldDeploymentID]
 	return ok
 }
 
 // ResetDeploymentID resets all changes to the "deployment_id" field.
-func (m *ProcessInstanceMutation) ResetDeploymentID() {
+func (m *ProcessEventMutation) ResetDeploymentID() {
 	m.deployment_id = nil
-	delete(m.clearedFields, processinstance.FieldDeploymentID)
+	delete(m.clearedFields, processevent.FieldDeploymentID)
 }
 
-// SetStartUserID sets the "start_user_id" field.
-func (m *ProcessInstanceMutation) SetStartUserID(s string) {
-	m.start_user_id = &s
+// SetSequenceCounter sets the "sequence_counter" field.
+func (m *ProcessEventMutation) SetSequenceCounter(s string) {
+	m.sequence_counter = &s
 }
 
-// StartUserID returns the value of the "start_user_id" field in the mutation.
-func (m *ProcessInstanceMutation) StartUserID() (r string, exists bool) {
-	v := m.start_user_id
+// SequenceCounter returns the value of the "sequence_counter" field in the mutation.
+func (m *ProcessEventMutation) SequenceCounter() (r string, exists bool) {
+	v := m.sequence_counter
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartUserID returns the old "start_user_id" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldSequenceCounter returns the old "sequence_counter" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldStartUserID(ctx context.Context) (v string, err error) {
+func (m *ProcessEventMutation) OldSequenceCounter(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSequenceCounter is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartUserID requires an ID field in the mutation")
+		return v, errors.New("OldSequenceCounter requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSequenceCounter: %w", err)
 	}
-	return oldValue.StartUserID, nil
+	return oldValue.SequenceCounter, nil
 }
 
-// ClearStartUserID clears the value of the "start_user_id" field.
-func (m *ProcessInstanceMutation) ClearStartUserID() {
-	m.start_user_id = nil
-	m.clearedFields[processinstance.FieldStartUserID] = struct{}{}
+// ClearSequenceCounter clears the value of the "sequence_counter" field.
+func (m *ProcessEventMutation) ClearSequenceCounter() {
+	m.sequence_counter = nil
+	m.clearedFields[processevent.FieldSequenceCounter] = struct{}{}
 }
 
-// StartUserIDCleared returns if the "start_user_id" field was cleared in this mutation.
-func (m *ProcessInstanceMutation) StartUserIDCleared() bool {
-	_, ok := m.clearedFields[processinstance.FieldStartUserID]
+// SequenceCounterCleared returns if the "sequence_counter" field was cleared in this mutation.
+func (m *ProcessEventMutation) SequenceCounterCleared() bool {
+	_, ok := m.clearedFields[processevent.FieldSequenceCounter]
 	return ok
 }
 
-// ResetStartUserID resets all changes to the "start_user_id" field.
-func (m *ProcessInstanceMutation) ResetStartUserID() {
-	m.start_user_id = nil
-	delete(m.clearedFields, processinstance.FieldStartUserID)
+// ResetSequenceCounter resets all changes to the "sequence_counter" field.
+func (m *ProcessEventMutation) ResetSequenceCounter() {
+	m.sequence_counter = nil
+	delete(m.clearedFields, processevent.FieldSequenceCounter)
 }
 
-// SetStartTime sets the "start_time" field.
-func (m *ProcessInstanceMutation) SetStartTime(t time.Time) {
-	m.start_time = &t
+// SetCreatedAt sets the "created_at" field.
+func (m *ProcessEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// StartTime returns the value of the "start_time" field in the mutation.
-func (m *ProcessInstanceMutation) StartTime() (r time.Time, exists bool) {
-	v := m.start_time
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProcessEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartTime returns the old "start_time" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ProcessEvent entity.
+// If the ProcessEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
+func (m *ProcessEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartTime requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.StartTime, nil
-}
-
-// ResetStartTime resets all changes to the "start_time" field.
-func (m *ProcessInstanceMutation) ResetStartTime() {
-	m.start_time = nil
+	return oldValue.CreatedAt, nil
 }
 
-// SetEndTime sets the "end_time" field.
-func (m *ProcessInstanceMutation) SetEndTime(t time.Time) {
-	m.end_time = &t
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProcessEventMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// EndTime returns the value of the "end_time" field in the mutation.
-func (m *ProcessInstanceMutation) EndTime() (r time.Time, exists bool) {
-	v := m.end_time
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the ProcessEventMutation builder.
+func (m *ProcessEventMutation) Where(ps ...predicate.ProcessEvent) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldEndTime returns the old "end_time" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldEndTime(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+// WhereP appends storage-level predicates to the ProcessEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProcessEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProcessEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.EndTime, nil
-}
-
-// ClearEndTime clears the value of the "end_time" field.
-func (m *ProcessInstanceMutation) ClearEndTime() {
-	m.end_time = nil
-	m.clearedFields[processinstance.FieldEndTime] = struct{}{}
+	m.Where(p...)
 }
 
-// EndTimeCleared returns if the "end_time" field was cleared in this mutation.
-func (m *ProcessInstanceMutation) EndTimeCleared() bool {
-	_, ok := m.clearedFields[processinstance.FieldEndTime]
-	return ok
+// Op returns the operation name.
+func (m *ProcessEventMutation) Op() Op {
+	return m.op
 }
 
-// ResetEndTime resets all changes to the "end_time" field.
-func (m *ProcessInstanceMutation) ResetEndTime() {
-	m.end_time = nil
-	delete(m.clearedFields, processinstance.FieldEndTime)
+// SetOp allows setting the mutation operation.
+func (m *ProcessEventMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetDuration sets the "duration" field.
-func (m *ProcessInstanceMutation) SetDuration(i int64) {
-	m.duration = &i
-	m.addduration = nil
+// Type returns the node type of this mutation (ProcessEvent).
+func (m *ProcessEventMutation) Type() string {
+	return m.typ
 }
 
-// Duration returns the value of the "duration" field in the mutation.
-func (m *ProcessInstanceMutation) Duration() (r int64, exists bool) {
-	v := m.duration
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProcessEventMutation) Fields() []string {
+	fields := make([]string, 0, 23)
+	if m.event_type != nil {
+		fields = append(fields, processevent.FieldEventType)
 	}
-	return *v, true
-}
-
-// OldDuration returns the old "duration" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldDuration(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDuration is only allowed on UpdateOne operations")
+	if m.event_name != nil {
+		fields = append(fields, processevent.FieldEventName)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDuration requires an ID field in the mutation")
+	if m.execution_id != nil {
+		fields = append(fields, processevent.FieldExecutionID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
+	if m.process_instance_id != nil {
+		fields = append(fields, processevent.FieldProcessInstanceID)
 	}
-	return oldValue.Duration, nil
-}
-
-// AddDuration adds i to the "duration" field.
-func (m *ProcessInstanceMutation) AddDuration(i int64) {
-	if m.addduration != nil {
-		*m.addduration += i
-	} else {
-		m.addduration = &i
+	if m.process_definition_id != nil {
+		fields = append(fields, processevent.FieldProcessDefinitionID)
 	}
-}
-
-// AddedDuration returns the value that was added to the "duration" field in this mutation.
-func (m *ProcessInstanceMutation) AddedDuration() (r int64, exists bool) {
-	v := m.addduration
-	if v == nil {
-		return
+	if m.process_definition_key != nil {
+		fields = append(fields, processevent.FieldProcessDefinitionKey)
 	}
-	return *v, true
-}
-
-// ClearDuration clears the value of the "duration" field.
-func (m *ProcessInstanceMutation) ClearDuration() {
-	m.duration = nil
-	m.addduration = nil
-	m.clearedFields[processinstance.FieldDuration] = struct{}{}
-}
-
-// DurationCleared returns if the "duration" field was cleared in this mutation.
-func (m *ProcessInstanceMutation) DurationCleared() bool {
-	_, ok := m.clearedFields[processinstance.FieldDuration]
-	return ok
-}
-
-// ResetDuration resets all changes to the "duration" field.
-func (m *ProcessInstanceMutation) ResetDuration() {
-	m.duration = nil
-	m.addduration = nil
-	delete(m.clearedFields, processinstance.FieldDuration)
-}
-
-// SetDeleteReason sets the "delete_reason" field.
-func (m *ProcessInstanceMutation) SetDeleteReason(s string) {
-	m.delete_reason = &s
-}
-
-// DeleteReason returns the value of the "delete_reason" field in the mutation.
-func (m *ProcessInstanceMutation) DeleteReason() (r string, exists bool) {
-	v := m.delete_reason
-	if v == nil {
-		return
+	if m.task_id != nil {
+		fields = append(fields, processevent.FieldTaskID)
 	}
-	return *v, true
-}
-
-// OldDeleteReason returns the old "delete_reason" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldDeleteReason(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeleteReason is only allowed on UpdateOne operations")
+	if m.activity_id != nil {
+		fields = append(fields, processevent.FieldActivityID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeleteReason requires an ID field in the mutation")
+	if m.activity_name != nil {
+		fields = append(fields, processevent.FieldActivityName)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeleteReason: %w", err)
+	if m.activity_type != nil {
+		fields = append(fields, processevent.FieldActivityType)
 	}
-	return oldValue.DeleteReason, nil
-}
-
-// ClearDeleteReason clears the value of the "delete_reason" field.
-func (m *ProcessInstanceMutation) ClearDeleteReason() {
-	m.delete_reason = nil
-	m.clearedFields[processinstance.FieldDeleteReason] = struct{}{}
-}
-
-// DeleteReasonCleared returns if the "delete_reason" field was cleared in this mutation.
-func (m *ProcessInstanceMutation) DeleteReasonCleared() bool {
-	_, ok := m.clearedFields[processinstance.FieldDeleteReason]
-	return ok
-}
-
-// ResetDeleteReason resets all changes to the "delete_reason" field.
-func (m *ProcessInstanceMutation) ResetDeleteReason() {
-	m.delete_reason = nil
-	delete(m.clearedFields, processinstance.FieldDeleteReason)
-}
-
-// SetSuperProcessInstanceID sets the "super_process_instance_id" field.
-func (m *ProcessInstanceMutation) SetSuperProcessInstanceID(s string) {
-	m.super_process_instance_id = &s
-}
-
-// SuperProcessInstanceID returns the value of the "super_process_instance_id" field in the mutation.
-func (m *ProcessInstanceMutation) SuperProcessInstanceID() (r string, exists bool) {
-	v := m.super_process_instance_id
-	if v == nil {
-		return
+	if m.user_id != nil {
+		fields = append(fields, processevent.FieldUserID)
 	}
-	return *v, true
-}
-
-// OldSuperProcessInstanceID returns the old "super_process_instance_id" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldSuperProcessInstanceID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSuperProcessInstanceID is only allowed on UpdateOne operations")
+	if m.timestamp != nil {
+		fields = append(fields, processevent.FieldTimestamp)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSuperProcessInstanceID requires an ID field in the mutation")
+	if m.event_data != nil {
+		fields = append(fields, processevent.FieldEventData)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSuperProcessInstanceID: %w", err)
+	if m.correlation_id != nil {
+		fields = append(fields, processevent.FieldCorrelationID)
 	}
-	return oldValue.SuperProcessInstanceID, nil
-}
-
-// ClearSuperProcessInstanceID clears the value of the "super_process_instance_id" field.
-func (m *ProcessInstanceMutation) ClearSuperProcessInstanceID() {
-	m.super_process_instance_id = nil
-	m.clearedFields[processinstance.FieldSuperProcessInstanceID] = struct{}{}
-}
-
-// SuperProcessInstanceIDCleared returns if the "super_process_instance_id" field was cleared in this mutation.
-func (m *ProcessInstanceMutation) SuperProcessInstanceIDCleared() bool {
-	_, ok := m.clearedFields[processinstance.FieldSuperProcessInstanceID]
-	return ok
-}
-
-// ResetSuperProcessInstanceID resets all changes to the "super_process_instance_id" field.
-func (m *ProcessInstanceMutation) ResetSuperProcessInstanceID() {
-	m.super_process_instance_id = nil
-	delete(m.clearedFields, processinstance.FieldSuperProcessInstanceID)
-}
-
-// SetRootProcessInstanceID sets the "root_process_instance_id" field.
-func (m *ProcessInstanceMutation) SetRootProcessInstanceID(s string) {
-	m.root_process_instance_id = &s
-}
-
-// RootProcessInstanceID returns the value of the "root_process_instance_id" field in the mutation.
-func (m *ProcessInstanceMutation) RootProcessInstanceID() (r string, exists bool) {
-	v := m.root_process_instance_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldRootProcessInstanceID returns the old "root_process_instance_id" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldRootProcessInstanceID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRootProcessInstanceID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRootProcessInstanceID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRootProcessInstanceID: %w", err)
+	if m.message_name != nil {
+		fields = append(fields, processevent.FieldMessageName)
 	}
-	return oldValue.RootProcessInstanceID, nil
-}
-
-// ClearRootProcessInstanceID clears the value of the "root_process_instance_id" field.
-func (m *ProcessInstanceMutation) ClearRootProcessInstanceID() {
-	m.root_process_instance_id = nil
-	m.clearedFields[processinstance.FieldRootProcessInstanceID] = struct{}{}
-}
-
-// RootProcessInstanceIDCleared returns if the "root_process_instance_id" field was cleared in this mutation.
-func (m *ProcessInstanceMutation) RootProcessInstanceIDCleared() bool {
-	_, ok := m.clearedFields[processinstance.FieldRootProcessInstanceID]
-	return ok
-}
-
-// ResetRootProcessInstanceID resets all changes to the "root_process_instance_id" field.
-func (m *ProcessInstanceMutation) ResetRootProcessInstanceID() {
-	m.root_process_instance_id = nil
-	delete(m.clearedFields, processinstance.FieldRootProcessInstanceID)
-}
-
-// SetSuspended sets the "suspended" field.
-func (m *ProcessInstanceMutation) SetSuspended(b bool) {
-	m.suspended = &b
-}
-
-// Suspended returns the value of the "suspended" field in the mutation.
-func (m *ProcessInstanceMutation) Suspended() (r bool, exists bool) {
-	v := m.suspended
-	if v == nil {
-		return
+	if m.signal_name != nil {
+		fields = append(fields, processevent.FieldSignalName)
 	}
-	return *v, true
-}
-
-// OldSuspended returns the old "suspended" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldSuspended(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSuspended is only allowed on UpdateOne operations")
+	if m.job_id != nil {
+		fields = append(fields, processevent.FieldJobID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSuspended requires an ID field in the mutation")
+	if m.job_type != nil {
+		fields = append(fields, processevent.FieldJobType)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSuspended: %w", err)
+	if m.job_handler_type != nil {
+		fields = append(fields, processevent.FieldJobHandlerType)
 	}
-	return oldValue.Suspended, nil
-}
-
-// ResetSuspended resets all changes to the "suspended" field.
-func (m *ProcessInstanceMutation) ResetSuspended() {
-	m.suspended = nil
-}
-
-// SetTenantID sets the "tenant_id" field.
-func (m *ProcessInstanceMutation) SetTenantID(s string) {
-	m.tenant_id = &s
-}
-
-// TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *ProcessInstanceMutation) TenantID() (r string, exists bool) {
-	v := m.tenant_id
-	if v == nil {
-		return
+	if m.tenant_id != nil {
+		fields = append(fields, processevent.FieldTenantID)
 	}
-	return *v, true
-}
-
-// OldTenantID returns the old "tenant_id" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldTenantID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	if m.deployment_id != nil {
+		fields = append(fields, processevent.FieldDeploymentID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	if m.sequence_counter != nil {
+		fields = append(fields, processevent.FieldSequenceCounter)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	if m.created_at != nil {
+		fields = append(fields, processevent.FieldCreatedAt)
 	}
-	return oldValue.TenantID, nil
-}
-
-// ResetTenantID resets all changes to the "tenant_id" field.
-func (m *ProcessInstanceMutation) ResetTenantID() {
-	m.tenant_id = nil
-}
-
-// SetName sets the "name" field.
-func (m *ProcessInstanceMutation) SetName(s string) {
-	m.name = &s
+	return fields
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ProcessInstanceMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProcessEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case processevent.FieldEventType:
+		return m.EventType()
+	case processevent.FieldEventName:
+		return m.EventName()
+	case processevent.FieldExecutionID:
+		return m.ExecutionID()
+	case processevent.FieldProcessInstanceID:
+		return m.ProcessInstanceID()
+	case processevent.FieldProcessDefinitionID:
+		return m.ProcessDefinitionID()
+	case processevent.FieldProcessDefinitionKey:
+		return m.ProcessDefinitionKey()
+	case processevent.FieldTaskID:
+		return m.TaskID()
+	case processevent.FieldActivityID:
+		return m.ActivityID()
+	case processevent.FieldActivityName:
+		return m.ActivityName()
+	case processevent.FieldActivityType:
+		return m.ActivityType()
+	case processevent.FieldUserID:
+		return m.UserID()
+	case processevent.FieldTimestamp:
+		return m.Timestamp()
+	case processevent.FieldEventData:
+		return m.EventData()
+	case processevent.FieldCorrelationID:
+		return m.CorrelationID()
+	case processevent.FieldMessageName:
+		return m.MessageName()
+	case processevent.FieldSignalName:
+		return m.SignalName()
+	case processevent.FieldJobID:
+		return m.JobID()
+	case processevent.FieldJobType:
+		return m.JobType()
+	case processevent.FieldJobHandlerType:
+		return m.JobHandlerType()
+	case processevent.FieldTenantID:
+		return m.TenantID()
+	case processevent.FieldDeploymentID:
+		return m.DeploymentID()
+	case processevent.FieldSequenceCounter:
+		return m.SequenceCounter()
+	case processevent.FieldCreatedAt:
+		return m.CreatedAt()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldName returns the old "name" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProcessEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case processevent.FieldEventType:
+		return m.OldEventType(ctx)
+	case processevent.FieldEventName:
+		return m.OldEventName(ctx)
+	case processevent.FieldExecutionID:
+		return m.OldExecutionID(ctx)
+	case processevent.FieldProcessInstanceID:
+		return m.OldProcessInstanceID(ctx)
+	case processevent.FieldProcessDefinitionID:
+		return m.OldProcessDefinitionID(ctx)
+	case processevent.FieldProcessDefinitionKey:
+		return m.OldProcessDefinitionKey(ctx)
+	case processevent.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case processevent.FieldActivityID:
+		return m.OldActivityID(ctx)
+	case processevent.FieldActivityName:
+		return m.OldActivityName(ctx)
+	case processevent.FieldActivityType:
+		return m.OldActivityType(ctx)
+	case processevent.FieldUserID:
+		return m.OldUserID(ctx)
+	case processevent.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case processevent.FieldEventData:
+		return m.OldEventData(ctx)
+	case processevent.FieldCorrelationID:
+		return m.OldCorrelationID(ctx)
+	case processevent.FieldMessageName:
+		return m.OldMessageName(ctx)
+	case processevent.FieldSignalName:
+		return m.OldSignalName(ctx)
+	case processevent.FieldJobID:
+		return m.OldJobID(ctx)
+	case processevent.FieldJobType:
+		return m.OldJobType(ctx)
+	case processevent.FieldJobHandlerType:
+		return m.OldJobHandlerType(ctx)
+	case processevent.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case processevent.FieldDeploymentID:
+		return m.OldDeploymentID(ctx)
+	case processevent.FieldSequenceCounter:
+		return m.OldSequenceCounter(ctx)
+	case processevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return oldValue.Name, nil
+	return nil, fmt.Errorf("unknown ProcessEvent field %s", name)
 }
 
-// ClearName clears the value of the "name" field.
-func (m *ProcessInstanceMutation) ClearName() {
-	m.name = nil
-	m.clearedFields[processinstance.FieldName] = struct{}{}
-}
-
-// NameCleared returns if the "name" field was cleared in this mutation.
-func (m *ProcessInstanceMutation) NameCleared() bool {
-	_, ok := m.clearedFields[processinstance.FieldName]
-	return ok
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *ProcessInstanceMutation) ResetName() {
-	m.name = nil
-	delete(m.clearedFields, processinstance.FieldName)
-}
-
-// SetDescription sets the "description" field.
-func (m *ProcessInstanceMutation) SetDescription(s string) {
-	m.description = &s
-}
-
-// Description returns the value of the "description" field in the mutation.
-func (m *ProcessInstanceMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProcessEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case processevent.FieldEventType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventType(v)
+		return nil
+	case processevent.FieldEventName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventName(v)
+		return nil
+	case processevent.FieldExecutionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExecutionID(v)
+		return nil
+	case processevent.FieldProcessInstanceID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessInstanceID(v)
+		return nil
+	case processevent.FieldProcessDefinitionID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessDefinitionID(v)
+		return nil
+	case processevent.FieldProcessDefinitionKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessDefinitionKey(v)
+		return nil
+	case processevent.FieldTaskID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case processevent.FieldActivityID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActivityID(v)
+		return nil
+	case processevent.FieldActivityName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActivityName(v)
+		return nil
+	case processevent.FieldActivityType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActivityType(v)
+		return nil
+	case processevent.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case processevent.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	case processevent.FieldEventData:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventData(v)
+		return nil
+	case processevent.FieldCorrelationID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCorrelationID(v)
+		return nil
+	case processevent.FieldMessageName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageName(v)
+		return nil
+	case processevent.FieldSignalName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSignalName(v)
+		return nil
+	case processevent.FieldJobID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobID(v)
+		return nil
+	case processevent.FieldJobType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobType(v)
+		return nil
+	case processevent.FieldJobHandlerType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobHandlerType(v)
+		return nil
+	case processevent.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case processevent.FieldDeploymentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeploymentID(v)
+		return nil
+	case processevent.FieldSequenceCounter:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSequenceCounter(v)
+		return nil
+	case processevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown ProcessEvent field %s", name)
 }
 
-// OldDescription returns the old "description" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProcessEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addprocess_instance_id != nil {
+		fields = append(fields, processevent.FieldProcessInstanceID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+	if m.addprocess_definition_id != nil {
+		fields = append(fields, processevent.FieldProcessDefinitionID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	if m.addtask_id != nil {
+		fields = append(fields, processevent.FieldTaskID)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *ProcessInstanceMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[processinstance.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ProcessInstanceMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[processinstance.FieldDescription]
-	return ok
-}
-
-// ResetDescription resets all changes to the "description" field.
-func (m *ProcessInstanceMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, processinstance.FieldDescription)
+	return fields
 }
 
-// SetCallbackID sets the "callback_id" field.
-func (m *ProcessInstanceMutation) SetCallbackID(s string) {
-	m.callback_id = &s
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProcessEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case processevent.FieldProcessInstanceID:
+		return m.AddedProcessInstanceID()
+	case processevent.FieldProcessDefinitionID:
+		return m.AddedProcessDefinitionID()
+	case processevent.FieldTaskID:
+		return m.AddedTaskID()
+	}
+	return nil, false
 }
 
-// CallbackID returns the value of the "callback_id" field in the mutation.
-func (m *ProcessInstanceMutation) CallbackID() (r string, exists bool) {
-	v := m.callback_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProcessEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case processevent.FieldProcessInstanceID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProcessInstanceID(v)
+		return nil
+	case processevent.FieldProcessDefinitionID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProcessDefinitionID(v)
+		return nil
+	case processevent.FieldTaskID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTaskID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProcessEvent numeric field %s", name)
 }
 
-// OldCallbackID returns the old "callback_id" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldCallbackID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCallbackID is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProcessEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(processevent.FieldEventName) {
+		fields = append(fields, processevent.FieldEventName)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCallbackID requires an ID field in the mutation")
+	if m.FieldCleared(processevent.FieldExecutionID) {
+		fields = append(fields, processevent.FieldExecutionID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCallbackID: %w", err)
+	if m.FieldCleared(processevent.FieldProcessInstanceID) {
+		fields = append(fields, processevent.FieldProcessInstanceID)
 	}
-	return oldValue.CallbackID, nil
-}
-
-// ClearCallbackID clears the value of the "callback_id" field.
-func (m *ProcessInstanceMutation) ClearCallbackID() {
-	m.callback_id = nil
-	m.clearedFields[processinstance.FieldCallbackID] = struct{}{}
-}
-
-// CallbackIDCleared returns if the "callback_id" field was cleared in this mutation.
-func (m *ProcessInstanceMutation) CallbackIDCleared() bool {
-	_, ok := m.clearedFields[processinstance.FieldCallbackID]
-	return ok
-}
-
-// ResetCallbackID resets all changes to the "callback_id" field.
-func (m *ProcessInstanceMutation) ResetCallbackID() {
-	m.callback_id = nil
-	delete(m.clearedFields, processinstance.FieldCallbackID)
-}
-
-// SetCallbackType sets the "callback_type" field.
-func (m *ProcessInstanceMutation) SetCallbackType(s string) {
-	m.callback_type = &s
-}
-
-// CallbackType returns the value of the "callback_type" field in the mutation.
-func (m *ProcessInstanceMutation) CallbackType() (r string, exists bool) {
-	v := m.callback_type
-	if v == nil {
-		return
+	if m.FieldCleared(processevent.FieldProcessDefinitionID) {
+		fields = append(fields, processevent.FieldProcessDefinitionID)
 	}
-	return *v, true
-}
-
-// OldCallbackType returns the old "callback_type" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldCallbackType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCallbackType is only allowed on UpdateOne operations")
+	if m.FieldCleared(processevent.FieldProcessDefinitionKey) {
+		fields = append(fields, processevent.FieldProcessDefinitionKey)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCallbackType requires an ID field in the mutation")
+	if m.FieldCleared(processevent.FieldTaskID) {
+		fields = append(fields, processevent.FieldTaskID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCallbackType: %w", err)
+	if m.FieldCleared(processevent.FieldActivityID) {
+		fields = append(fields, processevent.FieldActivityID)
 	}
-	return oldValue.CallbackType, nil
-}
-
-// ClearCallbackType clears the value of the "callback_type" field.
-func (m *ProcessInstanceMutation) ClearCallbackType() {
-	m.callback_type = nil
-	m.clearedFields[processinstance.FieldCallbackType] = struct{}{}
-}
-
-// CallbackTypeCleared returns if the "callback_type" field was cleared in this mutation.
-func (m *ProcessInstanceMutation) CallbackTypeCleared() bool {
-	_, ok := m.clearedFields[processinstance.FieldCallbackType]
-	return ok
-}
-
-// ResetCallbackType resets all changes to the "callback_type" field.
-func (m *ProcessInstanceMutation) ResetCallbackType() {
-	m.callback_type = nil
-	delete(m.clearedFields, processinstance.FieldCallbackType)
-}
-
-// SetReferenceID sets the "reference_id" field.
-func (m *ProcessInstanceMutation) SetReferenceID(s string) {
-	m.reference_id = &s
-}
-
-// ReferenceID returns the value of the "reference_id" field in the mutation.
-func (m *ProcessInstanceMutation) ReferenceID() (r string, exists bool) {
-	v := m.reference_id
-	if v == nil {
-		return
+	if m.FieldCleared(processevent.FieldActivityName) {
+		fields = append(fields, processevent.FieldActivityName)
 	}
-	return *v, true
-}
-
-// OldReferenceID returns the old "reference_id" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldReferenceID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReferenceID is only allowed on UpdateOne operations")
+	if m.FieldCleared(processevent.FieldActivityType) {
+		fields = append(fields, processevent.FieldActivityType)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReferenceID requires an ID field in the mutation")
+	if m.FieldCleared(processevent.FieldUserID) {
+		fields = append(fields, processevent.FieldUserID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReferenceID: %w", err)
+	if m.FieldCleared(processevent.FieldEventData) {
+		fields = append(fields, processevent.FieldEventData)
 	}
-	return oldValue.ReferenceID, nil
-}
-
-// ClearReferenceID clears the value of the "reference_id" field.
-func (m *ProcessInstanceMutation) ClearReferenceID() {
-	m.reference_id = nil
-	m.clearedFields[processinstance.FieldReferenceID] = struct{}{}
-}
-
-// ReferenceIDCleared returns if the "reference_id" field was cleared in this mutation.
-func (m *ProcessInstanceMutation) ReferenceIDCleared() bool {
-	_, ok := m.clearedFields[processinstance.FieldReferenceID]
-	return ok
-}
-
-// ResetReferenceID resets all changes to the "reference_id" field.
-func (m *ProcessInstanceMutation) ResetReferenceID() {
-	m.reference_id = nil
-	delete(m.clearedFields, processinstance.FieldReferenceID)
-}
-
-// SetReferenceType sets the "reference_type" field.
-func (m *ProcessInstanceMutation) SetReferenceType(s string) {
-	m.reference_type = &s
-}
-
-// ReferenceType returns the value of the "reference_type" field in the mutation.
-func (m *ProcessInstanceMutation) ReferenceType() (r string, exists bool) {
-	v := m.reference_type
-	if v == nil {
-		return
+	if m.FieldCleared(processevent.FieldCorrelationID) {
+		fields = append(fields, processevent.FieldCorrelationID)
 	}
-	return *v, true
-}
-
-// OldReferenceType returns the old "reference_type" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldReferenceType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReferenceType is only allowed on UpdateOne operations")
+	if m.FieldCleared(processevent.FieldMessageName) {
+		fields = append(fields, processevent.FieldMessageName)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReferenceType requires an ID field in the mutation")
+	if m.FieldCleared(processevent.FieldSignalName) {
+		fields = append(fields, processevent.FieldSignalName)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReferenceType: %w", err)
+	if m.FieldCleared(processevent.FieldJobID) {
+		fields = append(fields, processevent.FieldJobID)
 	}
-	return oldValue.ReferenceType, nil
-}
-
-// ClearReferenceType clears the value of the "reference_type" field.
-func (m *ProcessInstanceMutation) ClearReferenceType() {
-	m.reference_type = nil
-	m.clearedFields[processinstance.FieldReferenceType] = struct{}{}
+	if m.FieldCleared(processevent.FieldJobType) {
+		fields = append(fields, processevent.FieldJobType)
+	}
+	if m.FieldCleared(processevent.FieldJobHandlerType) {
+		fields = append(fields, processevent.FieldJobHandlerType)
+	}
+	if m.FieldCleared(processevent.FieldDeploymentID) {
+		fields = append(fields, processevent.FieldDeploymentID)
+	}
+	if m.FieldCleared(processevent.FieldSequenceCounter) {
+		fields = append(fields, processevent.FieldSequenceCounter)
+	}
+	return fields
 }
 
-// ReferenceTypeCleared returns if the "reference_type" field was cleared in this mutation.
-func (m *ProcessInstanceMutation) ReferenceTypeCleared() bool {
-	_, ok := m.clearedFields[processinstance.FieldReferenceType]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProcessEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetReferenceType resets all changes to the "reference_type" field.
-func (m *ProcessInstanceMutation) ResetReferenceType() {
-	m.reference_type = nil
-	delete(m.clearedFields, processinstance.FieldReferenceType)
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *ProcessInstanceMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ProcessInstanceMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProcessEventMutation) ClearField(name string) error {
+	switch name {
+	case processevent.FieldEventName:
+		m.ClearEventName()
+		return nil
+	case processevent.FieldExecutionID:
+		m.ClearExecutionID()
+		return nil
+	case processevent.FieldProcessInstanceID:
+		m.ClearProcessInstanceID()
+		return nil
+	case processevent.FieldProcessDefinitionID:
+		m.ClearProcessDefinitionID()
+		return nil
+	case processevent.FieldProcessDefinitionKey:
+		m.ClearProcessDefinitionKey()
+		return nil
+	case processevent.FieldTaskID:
+		m.ClearTaskID()
+		return nil
+	case processevent.FieldActivityID:
+		m.ClearActivityID()
+		return nil
+	case processevent.FieldActivityName:
+		m.ClearActivityName()
+		return nil
+	case processevent.FieldActivityType:
+		m.ClearActivityType()
+		return nil
+	case processevent.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case processevent.FieldEventData:
+		m.ClearEventData()
+		return nil
+	case processevent.FieldCorrelationID:
+		m.ClearCorrelationID()
+		return nil
+	case processevent.FieldMessageName:
+		m.ClearMessageName()
+		return nil
+	case processevent.FieldSignalName:
+		m.ClearSignalName()
+		return nil
+	case processevent.FieldJobID:
+		m.ClearJobID()
+		return nil
+	case processevent.FieldJobType:
+		m.ClearJobType()
+		return nil
+	case processevent.FieldJobHandlerType:
+		m.ClearJobHandlerType()
+		return nil
+	case processevent.FieldDeploymentID:
+		m.ClearDeploymentID()
+		return nil
+	case processevent.FieldSequenceCounter:
+		m.ClearSequenceCounter()
+		return nil
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ProcessInstanceMutation) ResetCreatedAt() {
-	m.created_at = nil
+	return fmt.Errorf("unknown ProcessEvent nullable field %s", name)
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ProcessInstanceMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProcessEventMutation) ResetField(name string) error {
+	switch name {
+	case processevent.FieldEventType:
+		m.ResetEventType()
+		return nil
+	case processevent.FieldEventName:
+		m.ResetEventName()
+		return nil
+	case processevent.FieldExecutionID:
+		m.ResetExecutionID()
+		return nil
+	case processevent.FieldProcessInstanceID:
+		m.ResetProcessInstanceID()
+		return nil
+	case processevent.FieldProcessDefinitionID:
+		m.ResetProcessDefinitionID()
+		return nil
+	case processevent.FieldProcessDefinitionKey:
+		m.ResetProcessDefinitionKey()
+		return nil
+	case processevent.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case processevent.FieldActivityID:
+		m.ResetActivityID()
+		return nil
+	case processevent.FieldActivityName:
+		m.ResetActivityName()
+		return nil
+	case processevent.FieldActivityType:
+		m.ResetActivityType()
+		return nil
+	case processevent.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case processevent.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	case processevent.FieldEventData:
+		m.ResetEventData()
+		return nil
+	case processevent.FieldCorrelationID:
+		m.ResetCorrelationID()
+		return nil
+	case processevent.FieldMessageName:
+		m.ResetMessageName()
+		return nil
+	case processevent.FieldSignalName:
+		m.ResetSignalName()
+		return nil
+	case processevent.FieldJobID:
+		m.ResetJobID()
+		return nil
+	case processevent.FieldJobType:
+		m.ResetJobType()
+		return nil
+	case processevent.FieldJobHandlerType:
+		m.ResetJobHandlerType()
+		return nil
+	case processevent.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case processevent.FieldDeploymentID:
+		m.ResetDeploymentID()
+		return nil
+	case processevent.FieldSequenceCounter:
+		m.ResetSequenceCounter()
+		return nil
+	case processevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ProcessEvent field %s", name)
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ProcessInstanceMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProcessEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the ProcessInstance entity.
-// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessInstanceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProcessEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ProcessInstanceMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProcessEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// Where appends a list predicates to the ProcessInstanceMutation builder.
-func (m *ProcessInstanceMutation) Where(ps ...predicate.ProcessInstance) {
-	m.predicates = append(m.predicates, ps...)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProcessEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// WhereP appends storage-level predicates to the ProcessInstanceMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProcessInstanceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ProcessInstance, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProcessEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// Op returns the operation name.
-func (m *ProcessInstanceMutation) Op() Op {
-	return m.op
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProcessEventMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ProcessInstanceMutation) SetOp(op Op) {
-	m.op = op
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProcessEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ProcessEvent unique edge %s", name)
 }
 
-// Type returns the node type of this mutation (ProcessInstance).
-func (m *ProcessInstanceMutation) Type() string {
-	return m.typ
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProcessEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ProcessEvent edge %s", name)
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ProcessInstanceMutation) Fields() []string {
-	fields := make([]string, 0, 23)
-	if m.business_key != nil {
-		fields = append(fields, processinstance.FieldBusinessKey)
-	}
-	if m.process_definition_id != nil {
-		fields = append(fields, processinstance.FieldProcessDefinitionID)
-	}
-	if m.process_definition_key != nil {
-		fields = append(fields, processinstance.FieldProcessDefinitionKey)
-	}
-	if m.process_definition_name != nil {
-		fields = append(fields, processinstance.FieldProcessDefinitionName)
-	}
-	if m.process_definition_version != nil {
-		fields = append(fields, processinstance.FieldProcessDefinitionVersion)
-	}
-	if m.deployment_id != nil {
-		fields = append(fields, processinstance.FieldDeploymentID)
-	}
-	if m.start_user_id != nil {
-		fields = append(fields, processinstance.FieldStartUserID)
-	}
-	if m.start_time != nil {
-		fields = append(fields, processinstance.FieldStartTime)
+// ProcessInstanceMutation represents an operation that mutates the ProcessInstance nodes in the graph.
+type ProcessInstanceMutation struct {
+	config
+	op                            Op
+	typ                           string
+	id                            *int64
+	business_key                  *string
+	process_definition_id         *int64
+	addprocess_definition_id      *int64
+	process_definition_key        *string
+	process_definition_name       *string
+	process_definition_version    *int32
+	addprocess_definition_version *int32
+	deployment_id                 *string
+	start_user_id                 *string
+	start_time                    *time.Time
+	end_time                      *time.Time
+	duration                      *int64
+	addduration                   *int64
+	delete_reason                 *string
+	super_process_instance_id     *string
+	root_process_instance_id      *string
+	suspended                     *bool
+	tenant_id                     *string
+	name                          *string
+	description                   *string
+	callback_id                   *string
+	callback_type                 *string
+	reference_id                  *string
+	reference_type                *string
+	workflow_id                   *string
+	workflow_run_id               *string
+	created_at                    *time.Time
+	updated_at                    *time.Time
+	clearedFields                 map[string]struct{}
+	done                          bool
+	oldValue                      func(context.Context) (*ProcessInstance, error)
+	predicates                    []predicate.ProcessInstance
+}
+
+var _ ent.Mutation = (*ProcessInstanceMutation)(nil)
+
+// processinstanceOption allows management of the mutation configuration using functional options.
+type processinstanceOption func(*ProcessInstanceMutation)
+
+// newProcessInstanceMutation creates new mutation for the ProcessInstance entity.
+func newProcessInstanceMutation(c config, op Op, opts ...processinstanceOption) *ProcessInstanceMutation {
+	m := &ProcessInstanceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProcessInstance,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.end_time != nil {
-		fields = append(fields, processinstance.FieldEndTime)
+	for _, opt := range opts {
+		opt(m)
 	}
-	if m.duration != nil {
-		fields = append(fields, processinstance.FieldDuration)
+	return m
+}
+
+// withProcessInstanceID sets the ID field of the mutation.
+func withProcessInstanceID(id int64) processinstanceOption {
+	return func(m *ProcessInstanceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProcessInstance
+		)
+		m.oldValue = func(ctx context.Context) (*ProcessInstance, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProcessInstance.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	if m.delete_reason != nil {
-		fields = append(fields, processinstance.FieldDeleteReason)
+}
+
+// withProcessInstance sets the old ProcessInstance of the mutation.
+func withProcessInstance(node *ProcessInstance) processinstanceOption {
+	return func(m *ProcessInstanceMutation) {
+		m.oldValue = func(context.Context) (*ProcessInstance, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	if m.super_process_instance_id != nil {
-		fields = append(fields, processinstance.FieldSuperProcessInstanceID)
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProcessInstanceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProcessInstanceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	if m.root_process_instance_id != nil {
-		fields = append(fields, processinstance.FieldRootProcessInstanceID)
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ProcessInstance entities.
+func (m *ProcessInstanceMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProcessInstanceMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
 	}
-	if m.suspended != nil {
-		fields = append(fields, processinstance.FieldSuspended)
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProcessInstanceMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProcessInstance.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	if m.tenant_id != nil {
-		fields = append(fields, processinstance.FieldTenantID)
+}
+
+// SetBusinessKey sets the "business_key" field.
+func (m *ProcessInstanceMutation) SetBusinessKey(s string) {
+	m.business_key = &s
+}
+
+// BusinessKey returns the value of the "business_key" field in the mutation.
+func (m *ProcessInstanceMutation) BusinessKey() (r string, exists bool) {
+	v := m.business_key
+	if v == nil {
+		return
 	}
-	if m.name != nil {
-		fields = append(fields, processinstance.FieldName)
+	return *v, true
+}
+
+// OldBusinessKey returns the old "business_key" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldBusinessKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBusinessKey is only allowed on UpdateOne operations")
 	}
-	if m.description != nil {
-		fields = append(fields, processinstance.FieldDescription)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBusinessKey requires an ID field in the mutation")
 	}
-	if m.callback_id != nil {
-		fields = append(fields, processinstance.FieldCallbackID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBusinessKey: %w", err)
 	}
-	if m.callback_type != nil {
-		fields = append(fields, processinstance.FieldCallbackType)
+	return oldValue.BusinessKey, nil
+}
+
+// ClearBusinessKey clears the value of the "business_key" field.
+func (m *ProcessInstanceMutation) ClearBusinessKey() {
+	m.business_key = nil
+	m.clearedFields[processinstance.FieldBusinessKey] = struct{}{}
+}
+
+// BusinessKeyCleared returns if the "business_key" field was cleared in this mutation.
+func (m *ProcessInstanceMutation) BusinessKeyCleared() bool {
+	_, ok := m.clearedFields[processinstance.FieldBusinessKey]
+	return ok
+}
+
+// ResetBusinessKey resets all changes to the "business_key" field.
+func (m *ProcessInstanceMutation) ResetBusinessKey() {
+	m.business_key = nil
+	delete(m.clearedFields, processinstance.FieldBusinessKey)
+}
+
+// SetProcessDefinitionID sets the "process_definition_id" field.
+func (m *ProcessInstanceMutation) SetProcessDefinitionID(i int64) {
+	m.process_definition_id = &i
+	m.addprocess_definition_id = nil
+}
+
+// ProcessDefinitionID returns the value of the "process_definition_id" field in the mutation.
+func (m *ProcessInstanceMutation) ProcessDefinitionID() (r int64, exists bool) {
+	v := m.process_definition_id
+	if v == nil {
+		return
 	}
-	if m.reference_id != nil {
-		fields = append(fields, processinstance.FieldReferenceID)
+	return *v, true
+}
+
+// OldProcessDefinitionID returns the old "process_definition_id" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldProcessDefinitionID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessDefinitionID is only allowed on UpdateOne operations")
 	}
-	if m.reference_type != nil {
-		fields = append(fields, processinstance.FieldReferenceType)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessDefinitionID requires an ID field in the mutation")
 	}
-	if m.created_at != nil {
-		fields = append(fields, processinstance.FieldCreatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessDefinitionID: %w", err)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, processinstance.FieldUpdatedAt)
+	return oldValue.ProcessDefinitionID, nil
+}
+
+// AddProcessDefinitionID adds i to the "process_definition_id" field.
+func (m *ProcessInstanceMutation) AddProcessDefinitionID(i int64) {
+	if m.addprocess_definition_id != nil {
+		*m.addprocess_definition_id += i
+	} else {
+		m.addprocess_definition_id = &i
 	}
-	return fields
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
+// AddedProcessDefinitionID returns the value that was added to the "process_definition_id" field in this mutation.
+func (m *ProcessInstanceMutation) AddedProcessDefinitionID() (r int64, exists bool) {
+	v := m.addprocess_definition_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetProcessDefinitionID resets all changes to the "process_definition_id" field.
+func (m *ProcessInstanceMutation) ResetProcessDefinitionID() {
+	m.process_definition_id = nil
+	m.addprocess_definition_id = nil
+}
+
+// SetProcessDefinitionKey sets the "process_definition_key" field.
+func (m *ProcessInstanceMutation) SetProcessDefinitionKey(s string) {
+	m.process_definition_key = &s
+}
+
+// ProcessDefinitionKey returns the value of the "process_definition_key" field in the mutation.
+func (m *ProcessInstanceMutation) ProcessDefinitionKey() (r string, exists bool) {
+	v := m.process_definition_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessDefinitionKey returns the old "process_definition_key" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldProcessDefinitionKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessDefinitionKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessDefinitionKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessDefinitionKey: %w", err)
+	}
+	return oldValue.ProcessDefinitionKey, nil
+}
+
+// ResetProcessDefinitionKey resets all changes to the "process_definition_key" field.
+func (m *ProcessInstanceMutation) ResetProcessDefinitionKey() {
+	m.process_definition_key = nil
+}
+
+// SetProcessDefinitionName sets the "process_definition_name" field.
+func (m *ProcessInstanceMutation) SetProcessDefinitionName(s string) {
+	m.process_definition_name = &s
+}
+
+// ProcessDefinitionName returns the value of the "process_definition_name" field in the mutation.
+func (m *ProcessInstanceMutation) ProcessDefinitionName() (r string, exists bool) {
+	v := m.process_definition_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessDefinitionName returns the old "process_definition_name" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldProcessDefinitionName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessDefinitionName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessDefinitionName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessDefinitionName: %w", err)
+	}
+	return oldValue.ProcessDefinitionName, nil
+}
+
+// ClearProcessDefinitionName clears the value of the "process_definition_name" field.
+func (m *ProcessInstanceMutation) ClearProcessDefinitionName() {
+	m.process_definition_name = nil
+	m.clearedFields[processinstance.FieldProcessDefinitionName] = struct{}{}
+}
+
+// ProcessDefinitionNameCleared returns if the "process_definition_name" field was cleared in this mutation.
+func (m *ProcessInstanceMutation) ProcessDefinitionNameCleared() bool {
+	_, ok := m.clearedFields[processinstance.FieldProcessDefinitionName]
+	return ok
+}
+
+// ResetProcessDefinitionName resets all changes to the "process_definition_name" field.
+func (m *ProcessInstanceMutation) ResetProcessDefinitionName() {
+	m.process_definition_name = nil
+	delete(m.clearedFields, processinstance.FieldProcessDefinitionName)
+}
+
+// SetProcessDefinitionVersion sets the "process_definition_version" field.
+func (m *ProcessInstanceMutation) SetProcessDefinitionVersion(i int32) {
+	m.process_definition_version = &i
+	m.addprocess_definition_version = nil
+}
+
+// ProcessDefinitionVersion returns the value of the "process_definition_version" field in the mutation.
+func (m *ProcessInstanceMutation) ProcessDefinitionVersion() (r int32, exists bool) {
+	v := m.process_definition_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessDefinitionVersion returns the old "process_definition_version" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldProcessDefinitionVersion(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessDefinitionVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessDefinitionVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessDefinitionVersion: %w", err)
+	}
+	return oldValue.ProcessDefinitionVersion, nil
+}
+
+// AddProcessDefinitionVersion adds i to the "process_definition_version" field.
+func (m *ProcessInstanceMutation) AddProcessDefinitionVersion(i int32) {
+	if m.addprocess_definition_version != nil {
+		*m.addprocess_definition_version += i
+	} else {
+		m.addprocess_definition_version = &i
+	}
+}
+
+// AddedProcessDefinitionVersion returns the value that was added to the "process_definition_version" field in this mutation.
+func (m *ProcessInstanceMutation) AddedProcessDefinitionVersion() (r int32, exists bool) {
+	v := m.addprocess_definition_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetProcessDefinitionVersion resets all changes to the "process_definition_version" field.
+func (m *ProcessInstanceMutation) ResetProcessDefinitionVersion() {
+	m.process_definition_version = nil
+	m.addprocess_definition_version = nil
+}
+
+// SetDeploymentID sets the "deployment_id" field.
+func (m *ProcessInstanceMutation) SetDeploymentID(s string) {
+	m.deployment_id = &s
+}
+
+// DeploymentID returns the value of the "deployment_id" field in the mutation.
+func (m *ProcessInstanceMutation) DeploymentID() (r string, exists bool) {
+	v := m.deployment_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeploymentID returns the old "deployment_id" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldDeploymentID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeploymentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeploymentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeploymentID: %w", err)
+	}
+	return oldValue.DeploymentID, nil
+}
+
+// ClearDeploymentID clears the value of the "deployment_id" field.
+func (m *ProcessInstanceMutation) ClearDeploymentID() {
+	m.deployment_id = nil
+	m.clearedFields[processinstance.FieldDeploymentID] = struct{}{}
+}
+
+// DeploymentIDCleared returns if the "deployment_id" field was cleared in this mutation.
+func (m *ProcessInstanceMutation) DeploymentIDCleared() bool {
+	_, ok := m.clearedFields[processinstance.FieldDeploymentID]
+	return ok
+}
+
+// ResetDeploymentID resets all changes to the "deployment_id" field.
+func (m *ProcessInstanceMutation) ResetDeploymentID() {
+	m.deployment_id = nil
+	delete(m.clearedFields, processinstance.FieldDeploymentID)
+}
+
+// SetStartUserID sets the "start_user_id" field.
+func (m *ProcessInstanceMutation) SetStartUserID(s string) {
+	m.start_user_id = &s
+}
+
+// StartUserID returns the value of the "start_user_id" field in the mutation.
+func (m *ProcessInstanceMutation) StartUserID() (r string, exists bool) {
+	v := m.start_user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartUserID returns the old "start_user_id" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldStartUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartUserID: %w", err)
+	}
+	return oldValue.StartUserID, nil
+}
+
+// ClearStartUserID clears the value of the "start_user_id" field.
+func (m *ProcessInstanceMutation) ClearStartUserID() {
+	m.start_user_id = nil
+	m.clearedFields[processinstance.FieldStartUserID] = struct{}{}
+}
+
+// StartUserIDCleared returns if the "start_user_id" field was cleared in this mutation.
+func (m *ProcessInstanceMutation) StartUserIDCleared() bool {
+	_, ok := m.clearedFields[processinstance.FieldStartUserID]
+	return ok
+}
+
+// ResetStartUserID resets all changes to the "start_user_id" field.
+func (m *ProcessInstanceMutation) ResetStartUserID() {
+	m.start_user_id = nil
+	delete(m.clearedFields, processinstance.FieldStartUserID)
+}
+
+// SetStartTime sets the "start_time" field.
+func (m *ProcessInstanceMutation) SetStartTime(t time.Time) {
+	m.start_time = &t
+}
+
+// StartTime returns the value of the "start_time" field in the mutation.
+func (m *ProcessInstanceMutation) StartTime() (r time.Time, exists bool) {
+	v := m.start_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartTime returns the old "start_time" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+	}
+	return oldValue.StartTime, nil
+}
+
+// ResetStartTime resets all changes to the "start_time" field.
+func (m *ProcessInstanceMutation) ResetStartTime() {
+	m.start_time = nil
+}
+
+// SetEndTime sets the "end_time" field.
+func (m *ProcessInstanceMutation) SetEndTime(t time.Time) {
+	m.end_time = &t
+}
+
+// EndTime returns the value of the "end_time" field in the mutation.
+func (m *ProcessInstanceMutation) EndTime() (r time.Time, exists bool) {
+	v := m.end_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndTime returns the old "end_time" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldEndTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+	}
+	return oldValue.EndTime, nil
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (m *ProcessInstanceMutation) ClearEndTime() {
+	m.end_time = nil
+	m.clearedFields[processinstance.FieldEndTime] = struct{}{}
+}
+
+// EndTimeCleared returns if the "end_time" field was cleared in this mutation.
+func (m *ProcessInstanceMutation) EndTimeCleared() bool {
+	_, ok := m.clearedFields[processinstance.FieldEndTime]
+	return ok
+}
+
+// ResetEndTime resets all changes to the "end_time" field.
+func (m *ProcessInstanceMutation) ResetEndTime() {
+	m.end_time = nil
+	delete(m.clearedFields, processinstance.FieldEndTime)
+}
+
+// SetDuration sets the "duration" field.
+func (m *ProcessInstanceMutation) SetDuration(i int64) {
+	m.duration = &i
+	m.addduration = nil
+}
+
+// Duration returns the value of the "duration" field in the mutation.
+func (m *ProcessInstanceMutation) Duration() (r int64, exists bool) {
+	v := m.duration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDuration returns the old "duration" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldDuration(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDuration is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDuration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
+	}
+	return oldValue.Duration, nil
+}
+
+// AddDuration adds i to the "duration" field.
+func (m *ProcessInstanceMutation) AddDuration(i int64) {
+	if m.addduration != nil {
+		*m.addduration += i
+	} else {
+		m.addduration = &i
+	}
+}
+
+// AddedDuration returns the value that was added to the "duration" field in this mutation.
+func (m *ProcessInstanceMutation) AddedDuration() (r int64, exists bool) {
+	v := m.addduration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDuration clears the value of the "duration" field.
+func (m *ProcessInstanceMutation) ClearDuration() {
+	m.duration = nil
+	m.addduration = nil
+	m.clearedFields[processinstance.FieldDuration] = struct{}{}
+}
+
+// DurationCleared returns if the "duration" field was cleared in this mutation.
+func (m *ProcessInstanceMutation) DurationCleared() bool {
+	_, ok := m.clearedFields[processinstance.FieldDuration]
+	return ok
+}
+
+// ResetDuration resets all changes to the "duration" field.
+func (m *ProcessInstanceMutation) ResetDuration() {
+	m.duration = nil
+	m.addduration = nil
+	delete(m.clearedFields, processinstance.FieldDuration)
+}
+
+// SetDeleteReason sets the "delete_reason" field.
+func (m *ProcessInstanceMutation) SetDeleteReason(s string) {
+	m.delete_reason = &s
+}
+
+// DeleteReason returns the value of the "delete_reason" field in the mutation.
+func (m *ProcessInstanceMutation) DeleteReason() (r string, exists bool) {
+	v := m.delete_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteReason returns the old "delete_reason" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldDeleteReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteReason: %w", err)
+	}
+	return oldValue.DeleteReason, nil
+}
+
+// ClearDeleteReason clears the value of the "delete_reason" field.
+func (m *ProcessInstanceMutation) ClearDeleteReason() {
+	m.delete_reason = nil
+	m.clearedFields[processinstance.FieldDeleteReason] = struct{}{}
+}
+
+// DeleteReasonCleared returns if the "delete_reason" field was cleared in this mutation.
+func (m *ProcessInstanceMutation) DeleteReasonCleared() bool {
+	_, ok := m.clearedFields[processinstance.FieldDeleteReason]
+	return ok
+}
+
+// ResetDeleteReason resets all changes to the "delete_reason" field.
+func (m *ProcessInstanceMutation) ResetDeleteReason() {
+	m.delete_reason = nil
+	delete(m.clearedFields, processinstance.FieldDeleteReason)
+}
+
+// SetSuperProcessInstanceID sets the "super_process_instance_id" field.
+func (m *ProcessInstanceMutation) SetSuperProcessInstanceID(s string) {
+	m.super_process_instance_id = &s
+}
+
+// SuperProcessInstanceID returns the value of the "super_process_instance_id" field in the mutation.
+func (m *ProcessInstanceMutation) SuperProcessInstanceID() (r string, exists bool) {
+	v := m.super_process_instance_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuperProcessInstanceID returns the old "super_process_instance_id" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldSuperProcessInstanceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuperProcessInstanceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuperProcessInstanceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuperProcessInstanceID: %w", err)
+	}
+	return oldValue.SuperProcessInstanceID, nil
+}
+
+// ClearSuperProcessInstanceID clears the value of the "super_process_instance_id" field.
+func (m *ProcessInstanceMutation) ClearSuperProcessInstanceID() {
+	m.super_process_instance_id = nil
+	m.clearedFields[processinstance.FieldSuperProcessInstanceID] = struct{}{}
+}
+
+// SuperProcessInstanceIDCleared returns if the "super_process_instance_id" field was cleared in this mutation.
+func (m *ProcessInstanceMutation) SuperProcessInstanceIDCleared() bool {
+	_, ok := m.clearedFields[processinstance.FieldSuperProcessInstanceID]
+	return ok
+}
+
+// ResetSuperProcessInstanceID resets all changes to the "super_process_instance_id" field.
+func (m *ProcessInstanceMutation) ResetSuperProcessInstanceID() {
+	m.super_process_instance_id = nil
+	delete(m.clearedFields, processinstance.FieldSuperProcessInstanceID)
+}
+
+// SetRootProcessInstanceID sets the "root_process_instance_id" field.
+func (m *ProcessInstanceMutation) SetRootProcessInstanceID(s string) {
+	m.root_process_instance_id = &s
+}
+
+// RootProcessInstanceID returns the value of the "root_process_instance_id" field in the mutation.
+func (m *ProcessInstanceMutation) RootProcessInstanceID() (r string, exists bool) {
+	v := m.root_process_instance_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRootProcessInstanceID returns the old "root_process_instance_id" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldRootProcessInstanceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRootProcessInstanceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRootProcessInstanceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRootProcessInstanceID: %w", err)
+	}
+	return oldValue.RootProcessInstanceID, nil
+}
+
+// ClearRootProcessInstanceID clears the value of the "root_process_instance_id" field.
+func (m *ProcessInstanceMutation) ClearRootProcessInstanceID() {
+	m.root_process_instance_id = nil
+	m.clearedFields[processinstance.FieldRootProcessInstanceID] = struct{}{}
+}
+
+// RootProcessInstanceIDCleared returns if the "root_process_instance_id" field was cleared in this mutation.
+func (m *ProcessInstanceMutation) RootProcessInstanceIDCleared() bool {
+	_, ok := m.clearedFields[processinstance.FieldRootProcessInstanceID]
+	return ok
+}
+
+// ResetRootProcessInstanceID resets all changes to the "root_process_instance_id" field.
+func (m *ProcessInstanceMutation) ResetRootProcessInstanceID() {
+	m.root_process_instance_id = nil
+	delete(m.clearedFields, processinstance.FieldRootProcessInstanceID)
+}
+
+// SetSuspended sets the "suspended" field.
+func (m *ProcessInstanceMutation) SetSuspended(b bool) {
+	m.suspended = &b
+}
+
+// Suspended returns the value of the "suspended" field in the mutation.
+func (m *ProcessInstanceMutation) Suspended() (r bool, exists bool) {
+	v := m.suspended
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuspended returns the old "suspended" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldSuspended(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuspended is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuspended requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuspended: %w", err)
+	}
+	return oldValue.Suspended, nil
+}
+
+// ResetSuspended resets all changes to the "suspended" field.
+func (m *ProcessInstanceMutation) ResetSuspended() {
+	m.suspended = nil
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *ProcessInstanceMutation) SetTenantID(s string) {
+	m.tenant_id = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *ProcessInstanceMutation) TenantID() (r string, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *ProcessInstanceMutation) ResetTenantID() {
+	m.tenant_id = nil
+}
+
+// SetName sets the "name" field.
+func (m *ProcessInstanceMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ProcessInstanceMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *ProcessInstanceMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[processinstance.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *ProcessInstanceMutation) NameCleared() bool {
+	_, ok := m.clearedFields[processinstance.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ProcessInstanceMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, processinstance.FieldName)
+}
+
+// SetDescription sets the "description" field.
+func (m *ProcessInstanceMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ProcessInstanceMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ProcessInstanceMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[processinstance.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ProcessInstanceMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[processinstance.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ProcessInstanceMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, processinstance.FieldDescription)
+}
+
+// SetCallbackID sets the "callback_id" field.
+func (m *ProcessInstanceMutation) SetCallbackID(s string) {
+	m.callback_id = &s
+}
+
+// CallbackID returns the value of the "callback_id" field in the mutation.
+func (m *ProcessInstanceMutation) CallbackID() (r string, exists bool) {
+	v := m.callback_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCallbackID returns the old "callback_id" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldCallbackID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCallbackID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCallbackID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCallbackID: %w", err)
+	}
+	return oldValue.CallbackID, nil
+}
+
+// ClearCallbackID clears the value of the "callback_id" field.
+func (m *ProcessInstanceMutation) ClearCallbackID() {
+	m.callback_id = nil
+	m.clearedFields[processinstance.FieldCallbackID] = struct{}{}
+}
+
+// CallbackIDCleared returns if the "callback_id" field was cleared in this mutation.
+func (m *ProcessInstanceMutation) CallbackIDCleared() bool {
+	_, ok := m.clearedFields[processinstance.FieldCallbackID]
+	return ok
+}
+
+// ResetCallbackID resets all changes to the "callback_id" field.
+func (m *ProcessInstanceMutation) ResetCallbackID() {
+	m.callback_id = nil
+	delete(m.clearedFields, processinstance.FieldCallbackID)
+}
+
+// SetCallbackType sets the "callback_type" field.
+func (m *ProcessInstanceMutation) SetCallbackType(s string) {
+	m.callback_type = &s
+}
+
+// CallbackType returns the value of the "callback_type" field in the mutation.
+func (m *ProcessInstanceMutation) CallbackType() (r string, exists bool) {
+	v := m.callback_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCallbackType returns the old "callback_type" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldCallbackType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCallbackType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCallbackType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCallbackType: %w", err)
+	}
+	return oldValue.CallbackType, nil
+}
+
+// ClearCallbackType clears the value of the "callback_type" field.
+func (m *ProcessInstanceMutation) ClearCallbackType() {
+	m.callback_type = nil
+	m.clearedFields[processinstance.FieldCallbackType] = struct{}{}
+}
+
+// CallbackTypeCleared returns if the "callback_type" field was cleared in this mutation.
+func (m *ProcessInstanceMutation) CallbackTypeCleared() bool {
+	_, ok := m.clearedFields[processinstance.FieldCallbackType]
+	return ok
+}
+
+// ResetCallbackType resets all changes to the "callback_type" field.
+func (m *ProcessInstanceMutation) ResetCallbackType() {
+	m.callback_type = nil
+	delete(m.clearedFields, processinstance.FieldCallbackType)
+}
+
+// SetReferenceID sets the "reference_id" field.
+func (m *ProcessInstanceMutation) SetReferenceID(s string) {
+	m.reference_id = &s
+}
+
+// ReferenceID returns the value of the "reference_id" field in the mutation.
+func (m *ProcessInstanceMutation) ReferenceID() (r string, exists bool) {
+	v := m.reference_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReferenceID returns the old "reference_id" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldReferenceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReferenceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReferenceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReferenceID: %w", err)
+	}
+	return oldValue.ReferenceID, nil
+}
+
+// ClearReferenceID clears the value of the "reference_id" field.
+func (m *ProcessInstanceMutation) ClearReferenceID() {
+	m.reference_id = nil
+	m.clearedFields[processinstance.FieldReferenceID] = struct{}{}
+}
+
+// ReferenceIDCleared returns if the "reference_id" field was cleared in this mutation.
+func (m *ProcessInstanceMutation) ReferenceIDCleared() bool {
+	_, ok := m.clearedFields[processinstance.FieldReferenceID]
+	return ok
+}
+
+// ResetReferenceID resets all changes to the "reference_id" field.
+func (m *ProcessInstanceMutation) ResetReferenceID() {
+	m.reference_id = nil
+	delete(m.clearedFields, processinstance.FieldReferenceID)
+}
+
+// SetReferenceType sets the "reference_type" field.
+func (m *ProcessInstanceMutation) SetReferenceType(s string) {
+	m.reference_type = &s
+}
+
+// ReferenceType returns the value of the "reference_type" field in the mutation.
+func (m *ProcessInstanceMutation) ReferenceType() (r string, exists bool) {
+	v := m.reference_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReferenceType returns the old "reference_type" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldReferenceType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReferenceType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReferenceType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReferenceType: %w", err)
+	}
+	return oldValue.ReferenceType, nil
+}
+
+// ClearReferenceType clears the value of the "reference_type" field.
+func (m *ProcessInstanceMutation) ClearReferenceType() {
+	m.reference_type = nil
+	m.clearedFields[processinstance.FieldReferenceType] = struct{}{}
+}
+
+// ReferenceTypeCleared returns if the "reference_type" field was cleared in this mutation.
+func (m *ProcessInstanceMutation) ReferenceTypeCleared() bool {
+	_, ok := m.clearedFields[processinstance.FieldReferenceType]
+	return ok
+}
+
+// ResetReferenceType resets all changes to the "reference_type" field.
+func (m *ProcessInstanceMutation) ResetReferenceType() {
+	m.reference_type = nil
+	delete(m.clearedFields, processinstance.FieldReferenceType)
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (m *ProcessInstanceMutation) SetWorkflowID(s string) {
+	m.workflow_id = &s
+}
+
+// WorkflowID returns the value of the "workflow_id" field in the mutation.
+func (m *ProcessInstanceMutation) WorkflowID() (r string, exists bool) {
+	v := m.workflow_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkflowID returns the old "workflow_id" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldWorkflowID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkflowID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkflowID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkflowID: %w", err)
+	}
+	return oldValue.WorkflowID, nil
+}
+
+// ClearWorkflowID clears the value of the "workflow_id" field.
+func (m *ProcessInstanceMutation) ClearWorkflowID() {
+	m.workflow_id = nil
+	m.clearedFields[processinstance.FieldWorkflowID] = struct{}{}
+}
+
+// WorkflowIDCleared returns if the "workflow_id" field was cleared in this mutation.
+func (m *ProcessInstanceMutation) WorkflowIDCleared() bool {
+	_, ok := m.clearedFields[processinstance.FieldWorkflowID]
+	return ok
+}
+
+// ResetWorkflowID resets all changes to the "workflow_id" field.
+func (m *ProcessInstanceMutation) ResetWorkflowID() {
+	m.workflow_id = nil
+	delete(m.clearedFields, processinstance.FieldWorkflowID)
+}
+
+// SetWorkflowRunID sets the "workflow_run_id" field.
+func (m *ProcessInstanceMutation) SetWorkflowRunID(s string) {
+	m.workflow_run_id = &s
+}
+
+// WorkflowRunID returns the value of the "workflow_run_id" field in the mutation.
+func (m *ProcessInstanceMutation) WorkflowRunID() (r string, exists bool) {
+	v := m.workflow_run_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkflowRunID returns the old "workflow_run_id" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldWorkflowRunID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkflowRunID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkflowRunID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkflowRunID: %w", err)
+	}
+	return oldValue.WorkflowRunID, nil
+}
+
+// ClearWorkflowRunID clears the value of the "workflow_run_id" field.
+func (m *ProcessInstanceMutation) ClearWorkflowRunID() {
+	m.workflow_run_id = nil
+	m.clearedFields[processinstance.FieldWorkflowRunID] = struct{}{}
+}
+
+// WorkflowRunIDCleared returns if the "workflow_run_id" field was cleared in this mutation.
+func (m *ProcessInstanceMutation) WorkflowRunIDCleared() bool {
+	_, ok := m.clearedFields[processinstance.FieldWorkflowRunID]
+	return ok
+}
+
+// ResetWorkflowRunID resets all changes to the "workflow_run_id" field.
+func (m *ProcessInstanceMutation) ResetWorkflowRunID() {
+	m.workflow_run_id = nil
+	delete(m.clearedFields, processinstance.FieldWorkflowRunID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProcessInstanceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProcessInstanceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProcessInstanceMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProcessInstanceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProcessInstanceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ProcessInstance entity.
+// If the ProcessInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessInstanceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProcessInstanceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the ProcessInstanceMutation builder.
+func (m *ProcessInstanceMutation) Where(ps ...predicate.ProcessInstance) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProcessInstanceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProcessInstanceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProcessInstance, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProcessInstanceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProcessInstanceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProcessInstance).
+func (m *ProcessInstanceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProcessInstanceMutation) Fields() []string {
+	fields := make([]string, 0, 25)
+	if m.business_key != nil {
+		fields = append(fields, processinstance.FieldBusinessKey)
+	}
+	if m.process_definition_id != nil {
+		fields = append(fields, processinstance.FieldProcessDefinitionID)
+	}
+	if m.process_definition_key != nil {
+		fields = append(fields, processinstance.FieldProcessDefinitionKey)
+	}
+	if m.process_definition_name != nil {
+		fields = append(fields, processinstance.FieldProcessDefinitionName)
+	}
+	if m.process_definition_version != nil {
+		fields = append(fields, processinstance.FieldProcessDefinitionVersion)
+	}
+	if m.deployment_id != nil {
+		fields = append(fields, processinstance.FieldDeploymentID)
+	}
+	if m.start_user_id != nil {
+		fields = append(fields, processinstance.FieldStartUserID)
+	}
+	if m.start_time != nil {
+		fields = append(fields, processinstance.FieldStartTime)
+	}
+	if m.end_time != nil {
+		fields = append(fields, processinstance.FieldEndTime)
+	}
+	if m.duration != nil {
+		fields = append(fields, processinstance.FieldDuration)
+	}
+	if m.delete_reason != nil {
+		fields = append(fields, processinstance.FieldDeleteReason)
+	}
+	if m.super_process_instance_id != nil {
+		fields = append(fields, processinstance.FieldSuperProcessInstanceID)
+	}
+	if m.root_process_instance_id != nil {
+		fields = append(fields, processinstance.FieldRootProcessInstanceID)
+	}
+	if m.suspended != nil {
+		fields = append(fields, processinstance.FieldSuspended)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, processinstance.FieldTenantID)
+	}
+	if m.name != nil {
+		fields = append(fields, processinstance.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, processinstance.FieldDescription)
+	}
+	if m.callback_id != nil {
+		fields = append(fields, processinstance.FieldCallbackID)
+	}
+	if m.callback_type != nil {
+		fields = append(fields, processinstance.FieldCallbackType)
+	}
+	if m.reference_id != nil {
+		fields = append(fields, processinstance.FieldReferenceID)
+	}
+	if m.reference_type != nil {
+		fields = append(fields, processinstance.FieldReferenceType)
+	}
+	if m.workflow_id != nil {
+		fields = append(fields, processinstance.FieldWorkflowID)
+	}
+	if m.workflow_run_id != nil {
+		fields = append(fields, processinstance.FieldWorkflowRunID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, processinstance.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, processinstance.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
 func (m *ProcessInstanceMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case processinstance.FieldBusinessKey:
-		return m.BusinessKey()
-	case processinstance.FieldProcessDefinitionID:
-		return m.ProcessDefinitionID()
-	case processinstance.FieldProcessDefinitionKey:
-		return m.ProcessDefinitionKey()
-	case processinstance.FieldProcessDefinitionName:
-		return m.ProcessDefinitionName()
-	case processinstance.FieldProcessDefinitionVersion:
-		return m.ProcessDefinitionVersion()
-	case processinstance.FieldDeploymentID:
-		return m.DeploymentID()
-	case processinstance.FieldStartUserID:
-		return m.StartUserID()
-	case processinstance.FieldStartTime:
-		return m.StartTime()
-	case processinstance.FieldEndTime:
-		return m.EndTime()
-	case processinstance.FieldDuration:
-		return m.Duration()
-	case processinstance.FieldDeleteReason:
-		return m.DeleteReason()
-	case processinstance.FieldSuperProcessInstanceID:
-		return m.SuperProcessInstanceID()
-	case processinstance.FieldRootProcessInstanceID:
-		return m.RootProcessInstanceID()
-	case processinstance.FieldSuspended:
-		return m.Suspended()
-	case processinstance.FieldTenantID:
-		return m.TenantID()
-	case processinstance.FieldName:
-		return m.Name()
-	case processinstance.FieldDescription:
-		return m.Description()
-	case processinstance.FieldCallbackID:
-		return m.CallbackID()
-	case processinstance.FieldCallbackType:
-		return m.CallbackType()
-	case processinstance.FieldReferenceID:
-		return m.ReferenceID()
-	case processinstance.FieldReferenceType:
-		return m.ReferenceType()
-	case processinstance.FieldCreatedAt:
+	case processinstance.FieldBusinessKey:
+		return m.BusinessKey()
+	case processinstance.FieldProcessDefinitionID:
+		return m.ProcessDefinitionID()
+	case processinstance.FieldProcessDefinitionKey:
+		return m.ProcessDefinitionKey()
+	case processinstance.FieldProcessDefinitionName:
+		return m.ProcessDefinitionName()
+	case processinstance.FieldProcessDefinitionVersion:
+		return m.ProcessDefinitionVersion()
+	case processinstance.FieldDeploymentID:
+		return m.DeploymentID()
+	case processinstance.FieldStartUserID:
+		return m.StartUserID()
+	case processinstance.FieldStartTime:
+		return m.StartTime()
+	case processinstance.FieldEndTime:
+		return m.EndTime()
+	case processinstance.FieldDuration:
+		return m.Duration()
+	case processinstance.FieldDeleteReason:
+		return m.DeleteReason()
+	case processinstance.FieldSuperProcessInstanceID:
+		return m.SuperProcessInstanceID()
+	case processinstance.FieldRootProcessInstanceID:
+		return m.RootProcessInstanceID()
+	case processinstance.FieldSuspended:
+		return m.Suspended()
+	case processinstance.FieldTenantID:
+		return m.TenantID()
+	case processinstance.FieldName:
+		return m.Name()
+	case processinstance.FieldDescription:
+		return m.Description()
+	case processinstance.FieldCallbackID:
+		return m.CallbackID()
+	case processinstance.FieldCallbackType:
+		return m.CallbackType()
+	case processinstance.FieldReferenceID:
+		return m.ReferenceID()
+	case processinstance.FieldReferenceType:
+		return m.ReferenceType()
+	case processinstance.FieldWorkflowID:
+		return m.WorkflowID()
+	case processinstance.FieldWorkflowRunID:
+		return m.WorkflowRunID()
+	case processinstance.FieldCreatedAt:
+		return m.CreatedAt()
+	case processinstance.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProcessInstanceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case processinstance.FieldBusinessKey:
+		return m.OldBusinessKey(ctx)
+	case processinstance.FieldProcessDefinitionID:
+		return m.OldProcessDefinitionID(ctx)
+	case processinstance.FieldProcessDefinitionKey:
+		return m.OldProcessDefinitionKey(ctx)
+	case processinstance.FieldProcessDefinitionName:
+		return m.OldProcessDefinitionName(ctx)
+	case processinstance.FieldProcessDefinitionVersion:
+		return m.OldProcessDefinitionVersion(ctx)
+	case processinstance.FieldDeploymentID:
+		return m.OldDeploymentID(ctx)
+	case processinstance.FieldStartUserID:
+		return m.OldStartUserID(ctx)
+	case processinstance.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case processinstance.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case processinstance.FieldDuration:
+		return m.OldDuration(ctx)
+	case processinstance.FieldDeleteReason:
+		return m.OldDeleteReason(ctx)
+	case processinstance.FieldSuperProcessInstanceID:
+		return m.OldSuperProcessInstanceID(ctx)
+	case processinstance.FieldRootProcessInstanceID:
+		return m.OldRootProcessInstanceID(ctx)
+	case processinstance.FieldSuspended:
+		return m.OldSuspended(ctx)
+	case processinstance.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case processinstance.FieldName:
+		return m.OldName(ctx)
+	case processinstance.FieldDescription:
+		return m.OldDescription(ctx)
+	case processinstance.FieldCallbackID:
+		return m.OldCallbackID(ctx)
+	case processinstance.FieldCallbackType:
+		return m.OldCallbackType(ctx)
+	case processinstance.FieldReferenceID:
+		return m.OldReferenceID(ctx)
+	case processinstance.FieldReferenceType:
+		return m.OldReferenceType(ctx)
+	case processinstance.FieldWorkflowID:
+		return m.OldWorkflowID(ctx)
+	case processinstance.FieldWorkflowRunID:
+		return m.OldWorkflowRunID(ctx)
+	case processinstance.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case processinstance.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProcessInstance field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProcessInstanceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case processinstance.FieldBusinessKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBusinessKey(v)
+		return nil
+	case processinstance.FieldProcessDefinitionID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessDefinitionID(v)
+		return nil
+	case processinstance.FieldProcessDefinitionKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessDefinitionKey(v)
+		return nil
+	case processinstance.FieldProcessDefinitionName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessDefinitionName(v)
+		return nil
+	case processinstance.FieldProcessDefinitionVersion:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessDefinitionVersion(v)
+		return nil
+	case processinstance.FieldDeploymentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeploymentID(v)
+		return nil
+	case processinstance.FieldStartUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartUserID(v)
+		return nil
+	case processinstance.FieldStartTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartTime(v)
+		return nil
+	case processinstance.FieldEndTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case processinstance.FieldDuration:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDuration(v)
+		return nil
+	case processinstance.FieldDeleteReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteReason(v)
+		return nil
+	case processinstance.FieldSuperProcessInstanceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuperProcessInstanceID(v)
+		return nil
+	case processinstance.FieldRootProcessInstanceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRootProcessInstanceID(v)
+		return nil
+	case processinstance.FieldSuspended:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuspended(v)
+		return nil
+	case processinstance.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case processinstance.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case processinstance.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case processinstance.FieldCallbackID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCallbackID(v)
+		return nil
+	case processinstance.FieldCallbackType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCallbackType(v)
+		return nil
+	case processinstance.FieldReferenceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReferenceID(v)
+		return nil
+	case processinstance.FieldReferenceType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReferenceType(v)
+		return nil
+	case processinstance.FieldWorkflowID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkflowID(v)
+		return nil
+	case processinstance.FieldWorkflowRunID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkflowRunID(v)
+		return nil
+	case processinstance.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case processinstance.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProcessInstance field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProcessInstanceMutation) AddedFields() []string {
+	var fields []string
+	if m.addprocess_definition_id != nil {
+		fields = append(fields, processinstance.FieldProcessDefinitionID)
+	}
+	if m.addprocess_definition_version != nil {
+		fields = append(fields, processinstance.FieldProcessDefinitionVersion)
+	}
+	if m.addduration != nil {
+		fields = append(fields, processinstance.FieldDuration)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProcessInstanceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case processinstance.FieldProcessDefinitionID:
+		return m.AddedProcessDefinitionID()
+	case processinstance.FieldProcessDefinitionVersion:
+		return m.AddedProcessDefinitionVersion()
+	case processinstance.FieldDuration:
+		return m.AddedDuration()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProcessInstanceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case processinstance.FieldProcessDefinitionID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProcessDefinitionID(v)
+		return nil
+	case processinstance.FieldProcessDefinitionVersion:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProcessDefinitionVersion(v)
+		return nil
+	case processinstance.FieldDuration:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDuration(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProcessInstance numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProcessInstanceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(processinstance.FieldBusinessKey) {
+		fields = append(fields, processinstance.FieldBusinessKey)
+	}
+	if m.FieldCleared(processinstance.FieldProcessDefinitionName) {
+		fields = append(fields, processinstance.FieldProcessDefinitionName)
+	}
+	if m.FieldCleared(processinstance.FieldDeploymentID) {
+		fields = append(fields, processinstance.FieldDeploymentID)
+	}
+	if m.FieldCleared(processinstance.FieldStartUserID) {
+		fields = append(fields, processinstance.FieldStartUserID)
+	}
+	if m.FieldCleared(processinstance.FieldEndTime) {
+		fields = append(fields, processinstance.FieldEndTime)
+	}
+	if m.FieldCleared(processinstance.FieldDuration) {
+		fields = append(fields, processinstance.FieldDuration)
+	}
+	if m.FieldCleared(processinstance.FieldDeleteReason) {
+		fields = append(fields, processinstance.FieldDeleteReason)
+	}
+	if m.FieldCleared(processinstance.FieldSuperProcessInstanceID) {
+		fields = append(fields, processinstance.FieldSuperProcessInstanceID)
+	}
+	if m.FieldCleared(processinstance.FieldRootProcessInstanceID) {
+		fields = append(fields, processinstance.FieldRootProcessInstanceID)
+	}
+	if m.FieldCleared(processinstance.FieldName) {
+		fields = append(fields, processinstance.FieldName)
+	}
+	if m.FieldCleared(processinstance.FieldDescription) {
+		fields = append(fields, processinstance.FieldDescription)
+	}
+	if m.FieldCleared(processinstance.FieldCallbackID) {
+		fields = append(fields, processinstance.FieldCallbackID)
+	}
+	if m.FieldCleared(processinstance.FieldCallbackType) {
+		fields = append(fields, processinstance.FieldCallbackType)
+	}
+	if m.FieldCleared(processinstance.FieldReferenceID) {
+		fields = append(fields, processinstance.FieldReferenceID)
+	}
+	if m.FieldCleared(processinstance.FieldReferenceType) {
+		fields = append(fields, processinstance.FieldReferenceType)
+	}
+	if m.FieldCleared(processinstance.FieldWorkflowID) {
+		fields = append(fields, processinstance.FieldWorkflowID)
+	}
+	if m.FieldCleared(processinstance.FieldWorkflowRunID) {
+		fields = append(fields, processinstance.FieldWorkflowRunID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProcessInstanceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProcessInstanceMutation) ClearField(name string) error {
+	switch name {
+	case processinstance.FieldBusinessKey:
+		m.ClearBusinessKey()
+		return nil
+	case processinstance.FieldProcessDefinitionName:
+		m.ClearProcessDefinitionName()
+		return nil
+	case processinstance.FieldDeploymentID:
+		m.ClearDeploymentID()
+		return nil
+	case processinstance.FieldStartUserID:
+		m.ClearStartUserID()
+		return nil
+	case processinstance.FieldEndTime:
+		m.ClearEndTime()
+		return nil
+	case processinstance.FieldDuration:
+		m.ClearDuration()
+		return nil
+	case processinstance.FieldDeleteReason:
+		m.ClearDeleteReason()
+		return nil
+	case processinstance.FieldSuperProcessInstanceID:
+		m.ClearSuperProcessInstanceID()
+		return nil
+	case processinstance.FieldRootProcessInstanceID:
+		m.ClearRootProcessInstanceID()
+		return nil
+	case processinstance.FieldName:
+		m.ClearName()
+		return nil
+	case processinstance.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case processinstance.FieldCallbackID:
+		m.ClearCallbackID()
+		return nil
+	case processinstance.FieldCallbackType:
+		m.ClearCallbackType()
+		return nil
+	case processinstance.FieldReferenceID:
+		m.ClearReferenceID()
+		return nil
+	case processinstance.FieldReferenceType:
+		m.ClearReferenceType()
+		return nil
+	case processinstance.FieldWorkflowID:
+		m.ClearWorkflowID()
+		return nil
+	case processinstance.FieldWorkflowRunID:
+		m.ClearWorkflowRunID()
+		return nil
+	}
+	return fmt.Errorf("unknown ProcessInstance nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProcessInstanceMutation) ResetField(name string) error {
+	switch name {
+	case processinstance.FieldBusinessKey:
+		m.ResetBusinessKey()
+		return nil
+	case processinstance.FieldProcessDefinitionID:
+		m.ResetProcessDefinitionID()
+		return nil
+	case processinstance.FieldProcessDefinitionKey:
+		m.ResetProcessDefinitionKey()
+		return nil
+	case processinstance.FieldProcessDefinitionName:
+		m.ResetProcessDefinitionName()
+		return nil
+	case processinstance.FieldProcessDefinitionVersion:
+		m.ResetProcessDefinitionVersion()
+		return nil
+	case processinstance.FieldDeploymentID:
+		m.ResetDeploymentID()
+		return nil
+	case processinstance.FieldStartUserID:
+		m.ResetStartUserID()
+		return nil
+	case processinstance.FieldStartTime:
+		m.ResetStartTime()
+		return nil
+	case processinstance.FieldEndTime:
+		m.ResetEndTime()
+		return nil
+	case processinstance.FieldDuration:
+		m.ResetDuration()
+		return nil
+	case processinstance.FieldDeleteReason:
+		m.ResetDeleteReason()
+		return nil
+	case processinstance.FieldSuperProcessInstanceID:
+		m.ResetSuperProcessInstanceID()
+		return nil
+	case processinstance.FieldRootProcessInstanceID:
+		m.ResetRootProcessInstanceID()
+		return nil
+	case processinstance.FieldSuspended:
+		m.ResetSuspended()
+		return nil
+	case processinstance.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case processinstance.FieldName:
+		m.ResetName()
+		return nil
+	case processinstance.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case processinstance.FieldCallbackID:
+		m.ResetCallbackID()
+		return nil
+	case processinstance.FieldCallbackType:
+		m.ResetCallbackType()
+		return nil
+	case processinstance.FieldReferenceID:
+		m.ResetReferenceID()
+		return nil
+	case processinstance.FieldReferenceType:
+		m.ResetReferenceType()
+		return nil
+	case processinstance.FieldWorkflowID:
+		m.ResetWorkflowID()
+		return nil
+	case processinstance.FieldWorkflowRunID:
+		m.ResetWorkflowRunID()
+		return nil
+	case processinstance.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case processinstance.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ProcessInstance field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProcessInstanceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProcessInstanceMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProcessInstanceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProcessInstanceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProcessInstanceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProcessInstanceMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProcessInstanceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ProcessInstance unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProcessInstanceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ProcessInstance edge %s", name)
+}
+
+// ProcessVariableMutation represents an operation that mutates the ProcessVariable nodes in the graph.
+type ProcessVariableMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *int64
+	name                     *string
+	_type                    *string
+	text_value               *string
+	text_value2              *string
+	long_value               *int64
+	addlong_value            *int64
+	double_value             *float64
+	adddouble_value          *float64
+	byte_array_value         *[]byte
+	execution_id             *string
+	process_instance_id      *int64
+	addprocess_instance_id   *int64
+	process_definition_id    *int64
+	addprocess_definition_id *int64
+	case_execution_id        *string
+	case_instance_id         *string
+	task_id                  *int64
+	addtask_id               *int64
+	activity_instance_id     *string
+	tenant_id                *string
+	sequence_counter         *int32
+	addsequence_counter      *int32
+	concurrent_local         *bool
+	scope_id                 *string
+	scope_type               *string
+	created_at               *time.Time
+	updated_at               *time.Time
+	clearedFields            map[string]struct{}
+	done                     bool
+	oldValue                 func(context.Context) (*ProcessVariable, error)
+	predicates               []predicate.ProcessVariable
+}
+
+var _ ent.Mutation = (*ProcessVariableMutation)(nil)
+
+// processvariableOption allows management of the mutation configuration using functional options.
+type processvariableOption func(*ProcessVariableMutation)
+
+// newProcessVariableMutation creates new mutation for the ProcessVariable entity.
+func newProcessVariableMutation(c config, op Op, opts ...processvariableOption) *ProcessVariableMutation {
+	m := &ProcessVariableMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProcessVariable,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProcessVariableID sets the ID field of the mutation.
+func withProcessVariableID(id int64) processvariableOption {
+	return func(m *ProcessVariableMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProcessVariable
+		)
+		m.oldValue = func(ctx context.Context) (*ProcessVariable, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProcessVariable.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProcessVariable sets the old ProcessVariable of the mutation.
+func withProcessVariable(node *ProcessVariable) processvariableOption {
+	return func(m *ProcessVariableMutation) {
+		m.oldValue = func(context.Context) (*ProcessVariable, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProcessVariableMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProcessVariableMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ProcessVariable entities.
+func (m *ProcessVariableMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProcessVariableMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProcessVariableMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProcessVariable.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *ProcessVariableMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ProcessVariableMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ProcessVariable entity.
+// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessVariableMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ProcessVariableMutation) ResetName() {
+	m.name = nil
+}
+
+// SetType sets the "type" field.
+func (m *ProcessVariableMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *ProcessVariableMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the ProcessVariable entity.
+// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessVariableMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *ProcessVariableMutation) ResetType() {
+	m._type = nil
+}
+
+// SetTextValue sets the "text_value" field.
+func (m *ProcessVariableMutation) SetTextValue(s string) {
+	m.text_value = &s
+}
+
+// TextValue returns the value of the "text_value" field in the mutation.
+func (m *ProcessVariableMutation) TextValue() (r string, exists bool) {
+	v := m.text_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTextValue returns the old "text_value" field's value of the ProcessVariable entity.
+// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessVariableMutation) OldTextValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTextValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTextValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTextValue: %w", err)
+	}
+	return oldValue.TextValue, nil
+}
+
+// ClearTextValue clears the value of the "text_value" field.
+func (m *ProcessVariableMutation) ClearTextValue() {
+	m.text_value = nil
+	m.clearedFields[processvariable.FieldTextValue] = struct{}{}
+}
+
+// TextValueCleared returns if the "text_value" field was cleared in this mutation.
+func (m *ProcessVariableMutation) TextValueCleared() bool {
+	_, ok := m.clearedFields[processvariable.FieldTextValue]
+	return ok
+}
+
+// ResetTextValue resets all changes to the "text_value" field.
+func (m *ProcessVariableMutation) ResetTextValue() {
+	m.text_value = nil
+	delete(m.clearedFields, processvariable.FieldTextValue)
+}
+
+// SetTextValue2 sets the "text_value2" field.
+func (m *ProcessVariableMutation) SetTextValue2(s string) {
+	m.text_value2 = &s
+}
+
+// TextValue2 returns the value of the "text_value2" field in the mutation.
+func (m *ProcessVariableMutation) TextValue2() (r string, exists bool) {
+	v := m.text_value2
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTextValue2 returns the old "text_value2" field's value of the ProcessVariable entity.
+// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessVariableMutation) OldTextValue2(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTextValue2 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTextValue2 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTextValue2: %w", err)
+	}
+	return oldValue.TextValue2, nil
+}
+
+// ClearTextValue2 clears the value of the "text_value2" field.
+func (m *ProcessVariableMutation) ClearTextValue2() {
+	m.text_value2 = nil
+	m.clearedFields[processvariable.FieldTextValue2] = struct{}{}
+}
+
+// TextValue2Cleared returns if the "text_value2" field was cleared in this mutation.
+func (m *ProcessVariableMutation) TextValue2Cleared() bool {
+	_, ok := m.clearedFields[processvariable.FieldTextValue2]
+	return ok
+}
+
+// ResetTextValue2 resets all changes to the "text_value2" field.
+func (m *ProcessVariableMutation) ResetTextValue2() {
+	m.text_value2 = nil
+	delete(m.clearedFields, processvariable.FieldTextValue2)
+}
+
+// SetLongValue sets the "long_value" field.
+func (m *ProcessVariableMutation) SetLongValue(i int64) {
+	m.long_value = &i
+	m.addlong_value = nil
+}
+
+// LongValue returns the value of the "long_value" field in the mutation.
+func (m *ProcessVariableMutation) LongValue() (r int64, exists bool) {
+	v := m.long_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLongValue returns the old "long_value" field's value of the ProcessVariable entity.
+// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessVariableMutation) OldLongValue(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLongValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLongValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLongValue: %w", err)
+	}
+	return oldValue.LongValue, nil
+}
+
+// AddLongValue adds i to the "long_value" field.
+func (m *ProcessVariableMutation) AddLongValue(i int64) {
+	if m.addlong_value != nil {
+		*m.addlong_value += i
+	} else {
+		m.addlong_value = &i
+	}
+}
+
+// AddedLongValue returns the value that was added to the "long_value" field in this mutation.
+func (m *ProcessVariableMutation) AddedLongValue() (r int64, exists bool) {
+	v := m.addlong_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLongValue clears the value of the "long_value" field.
+func (m *ProcessVariableMutation) ClearLongValue() {
+	m.long_value = nil
+	m.addlong_value = nil
+	m.clearedFields[processvariable.FieldLongValue] = struct{}{}
+}
+
+// LongValueCleared returns if the "long_value" field was cleared in this mutation.
+func (m *ProcessVariableMutation) LongValueCleared() bool {
+	_, ok := m.clearedFields[processvariable.FieldLongValue]
+	return ok
+}
+
+// ResetLongValue resets all changes to the "long_value" field.
+func (m *ProcessVariableMutation) ResetLongValue() {
+	m.long_value = nil
+	m.addlong_value = nil
+	delete(m.clearedFields, processvariable.FieldLongValue)
+}
+
+// SetDoubleValue sets the "double_value" field.
+func (m *ProcessVariableMutation) SetDoubleValue(f float64) {
+	m.double_value = &f
+	m.adddouble_value = nil
+}
+
+// DoubleValue returns the value of the "double_value" field in the mutation.
+func (m *ProcessVariableMutation) DoubleValue() (r float64, exists bool) {
+	v := m.double_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDoubleValue returns the old "double_value" field's value of the ProcessVariable entity.
+// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessVariableMutation) OldDoubleValue(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDoubleValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDoubleValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDoubleValue: %w", err)
+	}
+	return oldValue.DoubleValue, nil
+}
+
+// AddDoubleValue adds f to the "double_value" field.
+func (m *ProcessVariableMutation) AddDoubleValue(f float64) {
+	if m.adddouble_value != nil {
+		*m.adddouble_value += f
+	} else {
+		m.adddouble_value = &f
+	}
+}
+
+// AddedDoubleValue returns the value that was added to the "double_value" field in this mutation.
+func (m *ProcessVariableMutation) AddedDoubleValue() (r float64, exists bool) {
+	v := m.adddouble_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDoubleValue clears the value of the "double_value" field.
+func (m *ProcessVariableMutation) ClearDoubleValue() {
+	m.double_value = nil
+	m.adddouble_value = nil
+	m.clearedFields[processvariable.FieldDoubleValue] = struct{}{}
+}
+
+// DoubleValueCleared returns if the "double_value" field was cleared in this mutation.
+func (m *ProcessVariableMutation) DoubleValueCleared() bool {
+	_, ok := m.clearedFields[processvariable.FieldDoubleValue]
+	return ok
+}
+
+// ResetDoubleValue resets all changes to the "double_value" field.
+func (m *ProcessVariableMutation) ResetDoubleValue() {
+	m.double_value = nil
+	m.adddouble_value = nil
+	delete(m.clearedFields, processvariable.FieldDoubleValue)
+}
+
+// SetByteArrayValue sets the "byte_array_value" field.
+func (m *ProcessVariableMutation) SetByteArrayValue(b []byte) {
+	m.byte_array_value = &b
+}
+
+// ByteArrayValue returns the value of the "byte_array_value" field in the mutation.
+func (m *ProcessVariableMutation) ByteArrayValue() (r []byte, exists bool) {
+	v := m.byte_array_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldByteArrayValue returns the old "byte_array_value" field's value of the ProcessVariable entity.
+// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessVariableMutation) OldByteArrayValue(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldByteArrayValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldByteArrayValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldByteArrayValue: %w", err)
+	}
+	return oldValue.ByteArrayValue, nil
+}
+
+// ClearByteArrayValue clears the value of the "byte_array_value" field.
+func (m *ProcessVariableMutation) ClearByteArrayValue() {
+	m.byte_array_value = nil
+	m.clearedFields[processvariable.FieldByteArrayValue] = struct{}{}
+}
+
+// ByteArrayValueCleared returns if the "byte_array_value" field was cleared in this mutation.
+func (m *ProcessVariableMutation) ByteArrayValueCleared() bool {
+	_, ok := m.clearedFields[processvariable.FieldByteArrayValue]
+	return ok
+}
+
+// ResetByteArrayValue resets all changes to the "byte_array_value" field.
+func (m *ProcessVariableMutation) ResetByteArrayValue() {
+	m.byte_array_value = nil
+	delete(m.clearedFields, processvariable.FieldByteArrayValue)
+}
+
+// SetExecutionID sets the "execution_id" field.
+func (m *ProcessVariableMutation) SetExecutionID(s string) {
+	m.execution_id = &s
+}
+
+// ExecutionID returns the value of the "execution_id" field in the mutation.
+func (m *ProcessVariableMutation) ExecutionID() (r string, exists bool) {
+	v := m.execution_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExecutionID returns the old "execution_id" field's value of the ProcessVariable entity.
+// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessVariableMutation) OldExecutionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExecutionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExecutionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExecutionID: %w", err)
+	}
+	return oldValue.ExecutionID, nil
+}
+
+// ClearExecutionID clears the value of the "execution_id" field.
+func (m *ProcessVariableMutation) ClearExecutionID() {
+	m.execution_id = nil
+	m.clearedFields[processvariable.FieldExecutionID] = struct{}{}
+}
+
+// ExecutionIDCleared returns if the "execution_id" field was cleared in this mutation.
+func (m *ProcessVariableMutation) ExecutionIDCleared() bool {
+	_, ok := m.clearedFields[processvariable.FieldExecutionID]
+	return ok
+}
+
+// ResetExecutionID resets all changes to the "execution_id" field.
+func (m *ProcessVariableMutation) ResetExecutionID() {
+	m.execution_id = nil
+	delete(m.clearedFields, processvariable.FieldExecutionID)
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (m *ProcessVariableMutation) SetProcessInstanceID(i int64) {
+	m.process_instance_id = &i
+	m.addprocess_instance_id = nil
+}
+
+// ProcessInstanceID returns the value of the "process_instance_id" field in the mutation.
+func (m *ProcessVariableMutation) ProcessInstanceID() (r int64, exists bool) {
+	v := m.process_instance_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessInstanceID returns the old "process_instance_id" field's value of the ProcessVariable entity.
+// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessVariableMutation) OldProcessInstanceID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessInstanceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessInstanceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessInstanceID: %w", err)
+	}
+	return oldValue.ProcessInstanceID, nil
+}
+
+// AddProcessInstanceID adds i to the "process_instance_id" field.
+func (m *ProcessVariableMutation) AddProcessInstanceID(i int64) {
+	if m.addprocess_instance_id != nil {
+		*m.addprocess_instance_id += i
+	} else {
+		m.addprocess_instance_id = &i
+	}
+}
+
+// AddedProcessInstanceID returns the value that was added to the "process_instance_id" field in this mutation.
+func (m *ProcessVariableMutation) AddedProcessInstanceID() (r int64, exists bool) {
+	v := m.addprocess_instance_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearProcessInstanceID clears the value of the "process_instance_id" field.
+func (m *ProcessVariableMutation) ClearProcessInstanceID() {
+	m.process_instance_id = nil
+	m.addprocess_instance_id = nil
+	m.clearedFields[processvariable.FieldProcessInstanceID] = struct{}{}
+}
+
+// ProcessInstanceIDCleared returns if the "process_instance_id" field was cleared in this mutation.
+func (m *ProcessVariableMutation) ProcessInstanceIDCleared() bool {
+	_, ok := m.clearedFields[processvariable.FieldProcessInstanceID]
+	return ok
+}
+
+// ResetProcessInstanceID resets all changes to the "process_instance_id" field.
+func (m *ProcessVariableMutation) ResetProcessInstanceID() {
+	m.process_instance_id = nil
+	m.addprocess_instance_id = nil
+	delete(m.clearedFields, processvariable.FieldProcessInstanceID)
+}
+
+// SetProcessDefinitionID sets the "process_definition_id" field.
+func (m *ProcessVariableMutation) SetProcessDefinitionID(i int64) {
+	m.process_definition_id = &i
+	m.addprocess_definition_id = nil
+}
+
+// ProcessDefinitionID returns the value of the "process_definition_id" field in the mutation.
+func (m *ProcessVariableMutation) ProcessDefinitionID() (r int64, exists bool) {
+	v := m.process_definition_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessDefinitionID returns the old "process_definition_id" field's value of the ProcessVariable entity.
+// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessVariableMutation) OldProcessDefinitionID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessDefinitionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessDefinitionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessDefinitionID: %w", err)
+	}
+	return oldValue.ProcessDefinitionID, nil
+}
+
+// AddProcessDefinitionID adds i to the "process_definition_id" field.
+func (m *ProcessVariableMutation) AddProcessDefinitionID(i int64) {
+	if m.addprocess_definition_id != nil {
+		*m.addprocess_definition_id += i
+	} else {
+		m.addprocess_definition_id = &i
+	}
+}
+
+// AddedProcessDefinitionID returns the value that was added to the "process_definition_id" field in this mutation.
+func (m *ProcessVariableMutation) AddedProcessDefinitionID() (r int64, exists bool) {
+	v := m.addprocess_definition_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearProcessDefinitionID clears the value of the "process_definition_id" field.
+func (m *ProcessVariableMutation) ClearProcessDefinitionID() {
+	m.process_definition_id = nil
+	m.addprocess_definition_id = nil
+	m.clearedFields[processvariable.FieldProcessDefinitionID] = struct{}{}
+}
+
+// ProcessDefinitionIDCleared returns if the "process_definition_id" field was cleared in this mutation.
+func (m *ProcessVariableMutation) ProcessDefinitionIDCleared() bool {
+	_, ok := m.clearedFields[processvariable.FieldProcessDefinitionID]
+	return ok
+}
+
+// ResetProcessDefinitionID resets all changes to the "process_definition_id" field.
+func (m *ProcessVariableMutation) ResetProcessDefinitionID() {
+	m.process_definition_id = nil
+	m.addprocess_definition_id = nil
+	delete(m.clearedFields, processvariable.FieldProcessDefinitionID)
+}
+
+// SetCaseExecutionID sets the "case_execution_id" field.
+func (m *ProcessVariableMutation) SetCaseExecutionID(s string) {
+	m.case_execution_id = &s
+}
+
+// CaseExecutionID returns the value of the "case_execution_id" field in the mutation.
+func (m *ProcessVariableMutation) CaseExecutionID() (r string, exists bool) {
+	v := m.case_execution_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCaseExecutionID returns the old "case_execution_id" field's value of the ProcessVariable entity.
+// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessVariableMutation) OldCaseExecutionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCaseExecutionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCaseExecutionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCaseExecutionID: %w", err)
+	}
+	return oldValue.CaseExecutionID, nil
+}
+
+// ClearCaseExecutionID clears the value of the "case_execution_id" field.
+func (m *ProcessVariableMutation) ClearCaseExecutionID() {
+	m.case_execution_id = nil
+	m.clearedFields[processvariable.FieldCaseExecutionID] = struct{}{}
+}
+
+// CaseExecutionIDCleared returns if the "case_execution_id" field was cleared in this mutation.
+func (m *ProcessVariableMutation) CaseExecutionIDCleared() bool {
+	_, ok := m.clearedFields[processvariable.FieldCaseExecutionID]
+	return ok
+}
+
+// ResetCaseExecutionID resets all changes to the "case_execution_id" field.
+func (m *ProcessVariableMutation) ResetCaseExecutionID() {
+	m.case_execution_id = nil
+	delete(m.clearedFields, processvariable.FieldCaseExecutionID)
+}
+
+// SetCaseInstanceID sets the "case_instance_id" field.
+func (m *ProcessVariableMutation) SetCaseInstanceID(s string) {
+	m.case_instance_id = &s
+}
+
+// CaseInstanceID returns the value of the "case_instance_id" field in the mutation.
+func (m *ProcessVariableMutation) CaseInstanceID() (r string, exists bool) {
+	v := m.case_instance_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCaseInstanceID returns the old "case_instance_id" field's value of the ProcessVariable entity.
+// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessVariableMutation) OldCaseInstanceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCaseInstanceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCaseInstanceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCaseInstanceID: %w", err)
+	}
+	return oldValue.CaseInstanceID, nil
+}
+
+// ClearCaseInstanceID clears the value of the "case_instance_id" field.
+func (m *ProcessVariableMutation) ClearCaseInstanceID() {
+	m.case_instance_id = nil
+	m.clearedFields[processvariable.FieldCaseInstanceID] = struct{}{}
+}
+
+// CaseInstanceIDCleared returns if the "case_instance_id" field was cleared in this mutation.
+func (m *ProcessVariableMutation) CaseInstanceIDCleared() bool {
+	_, ok := m.clearedFields[processvariable.FieldCaseInstanceID]
+	return ok
+}
+
+// ResetCaseInstanceID resets all changes to the "case_instance_id" field.
+func (m *ProcessVariableMutation) ResetCaseInstanceID() {
+	m.case_instance_id = nil
+	delete(m.clearedFields, processvariable.FieldCaseInstanceID)
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *ProcessVariableMutation) SetTaskID(i int64) {
+	m.task_id = &i
+	m.addtask_id = nil
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *ProcessVariableMutation) TaskID() (r int64, exists bool) {
+	v := m.task_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the ProcessVariable entity.
+// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessVariableMutation) OldTaskID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (m *ProcessVariableMutation) AddTaskID(i int64) {
+	if m.addtask_id != nil {
+		*m.addtask_id += i
+	} else {
+		m.addtask_id = &i
+	}
+}
+
+// AddedTaskID returns the value that was added to the "task_id" field in this mutation.
+func (m *ProcessVariableMutation) AddedTaskID() (r int64, exists bool) {
+	v := m.addtask_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (m *ProcessVariableMutation) ClearTaskID() {
+	m.task_id = nil
+	m.addtask_id = nil
+	m.clearedFields[processvariable.FieldTaskID] = struct{}{}
+}
+
+// TaskIDCleared returns if the "task_id" field was cleared in this mutation.
+func (m *ProcessVariableMutation) TaskIDCleared() bool {
+	_, ok := m.clearedFields[processvariable.FieldTaskID]
+	return ok
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *ProcessVariableMutation) ResetTaskID() {
+	m.task_id = nil
+	m.addtask_id = nil
+	delete(m.clearedFields, processvariable.FieldTaskID)
+}
+
+// SetActivityInstanceID sets the "activity_instance_id" field.
+func (m *ProcessVariableMutation) SetActivityInstanceID(s string) {
+	m.activity_instance_id = &s
+}
+
+// ActivityInstanceID returns the value of the "activity_instance_id" field in the mutation.
+func (m *ProcessVariableMutation) ActivityInstanceID() (r string, exists bool) {
+	v := m.activity_instance_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActivityInstanceID returns the old "activity_instance_id" field's value of the ProcessVariable entity.
+// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessVariableMutation) OldActivityInstanceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActivityInstanceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActivityInstanceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActivityInstanceID: %w", err)
+	}
+	return oldValue.ActivityInstanceID, nil
+}
+
+// ClearActivityInstanceID clears the value of the "activity_instance_id" field.
+func (m *ProcessVariableMutation) ClearActivityInstanceID() {
+	m.activity_instance_id = nil
+	m.clearedFields[processvariable.FieldActivityInstanceID] = struct{}{}
+}
+
+// ActivityInstanceIDCleared returns if the "activity_instance_id" field was cleared in this mutation.
+func (m *ProcessVariableMutation) ActivityInstanceIDCleared() bool {
+	_, ok := m.clearedFields[processvariable.FieldActivityInstanceID]
+	return ok
+}
+
+// ResetActivityInstanceID resets all changes to the "activity_instance_id" field.
+func (m *ProcessVariableMutation) ResetActivityInstanceID() {
+	m.activity_instance_id = nil
+	delete(m.clearedFields, processvariable.FieldActivityInstanceID)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *ProcessVariableMutation) SetTenantID(s string) {
+	m.tenant_id = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *ProcessVariableMutation) TenantID() (r string, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the ProcessVariable entity.
+// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessVariableMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *ProcessVariableMutation) ResetTenantID() {
+	m.tenant_id = nil
+}
+
+// SetSequenceCounter sets the "sequence_counter" field.
+func (m *ProcessVariableMutation) SetSequenceCounter(i int32) {
+	m.sequence_counter = &i
+	m.addsequence_counter = nil
+}
+
+// SequenceCounter returns the value of the "sequence_counter" field in the mutation.
+func (m *ProcessVariableMutation) SequenceCounter() (r int32, exists bool) {
+	v := m.sequence_counter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSequenceCounter returns the old "sequence_counter" field's value of the ProcessVariable entity.
+// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessVariableMutation) OldSequenceCounter(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSequenceCounter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSequenceCounter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSequenceCounter: %w", err)
+	}
+	return oldValue.SequenceCounter, nil
+}
+
+// AddSequenceCounter adds i to the "sequence_counter" field.
+func (m *ProcessVariableMutation) AddSequenceCounter(i int32) {
+	if m.addsequence_counter != nil {
+		*m.addsequence_counter += i
+	} else {
+		m.addsequence_counter = &i
+	}
+}
+
+// AddedSequenceCounter returns the value that was added to the "sequence_counter" field in this mutation.
+func (m *ProcessVariableMutation) AddedSequenceCounter() (r int32, exists bool) {
+	v := m.addsequence_counter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSequenceCounter resets all changes to the "sequence_counter" field.
+func (m *ProcessVariableMutation) ResetSequenceCounter() {
+	m.sequence_counter = nil
+	m.addsequence_counter = nil
+}
+
+// SetConcurrentLocal sets the "concurrent_local" field.
+func (m *ProcessVariableMutation) SetConcurrentLocal(b bool) {
+	m.concurrent_local = &b
+}
+
+// ConcurrentLocal returns the value of the "concurrent_local" field in the mutation.
+func (m *ProcessVariableMutation) ConcurrentLocal() (r bool, exists bool) {
+	v := m.concurrent_local
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConcurrentLocal returns the old "concurrent_local" field's value of the ProcessVariable entity.
+// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessVariableMutation) OldConcurrentLocal(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConcurrentLocal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConcurrentLocal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConcurrentLocal: %w", err)
+	}
+	return oldValue.ConcurrentLocal, nil
+}
+
+// ResetConcurrentLocal resets all changes to the "concurrent_local" field.
+func (m *ProcessVariableMutation) ResetConcurrentLocal() {
+	m.concurrent_local = nil
+}
+
+// SetScopeID sets the "scope_id" field.
+func (m *ProcessVariableMutation) SetScopeID(s string) {
+	m.scope_id = &s
+}
+
+// ScopeID returns the value of the "scope_id" field in the mutation.
+func (m *ProcessVariableMutation) ScopeID() (r string, exists bool) {
+	v := m.scope_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopeID returns the old "scope_id" field's value of the ProcessVariable entity.
+// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessVariableMutation) OldScopeID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopeID: %w", err)
+	}
+	return oldValue.ScopeID, nil
+}
+
+// ClearScopeID clears the value of the "scope_id" field.
+func (m *ProcessVariableMutation) ClearScopeID() {
+	m.scope_id = nil
+	m.clearedFields[processvariable.FieldScopeID] = struct{}{}
+}
+
+// ScopeIDCleared returns if the "scope_id" field was cleared in this mutation.
+func (m *ProcessVariableMutation) ScopeIDCleared() bool {
+	_, ok := m.clearedFields[processvariable.FieldScopeID]
+	return ok
+}
+
+// ResetScopeID resets all changes to the "scope_id" field.
+func (m *ProcessVariableMutation) ResetScopeID() {
+	m.scope_id = nil
+	delete(m.clearedFields, processvariable.FieldScopeID)
+}
+
+// SetScopeType sets the "scope_type" field.
+func (m *ProcessVariableMutation) SetScopeType(s string) {
+	m.scope_type = &s
+}
+
+// ScopeType returns the value of the "scope_type" field in the mutation.
+func (m *ProcessVariableMutation) ScopeType() (r string, exists bool) {
+	v := m.scope_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopeType returns the old "scope_type" field's value of the ProcessVariable entity.
+// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessVariableMutation) OldScopeType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopeType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopeType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopeType: %w", err)
+	}
+	return oldValue.ScopeType, nil
+}
+
+// ClearScopeType clears the value of the "scope_type" field.
+func (m *ProcessVariableMutation) ClearScopeType() {
+	m.scope_type = nil
+	m.clearedFields[processvariable.FieldScopeType] = struct{}{}
+}
+
+// ScopeTypeCleared returns if the "scope_type" field was cleared in this mutation.
+func (m *ProcessVariableMutation) ScopeTypeCleared() bool {
+	_, ok := m.clearedFields[processvariable.FieldScopeType]
+	return ok
+}
+
+// ResetScopeType resets all changes to the "scope_type" field.
+func (m *ProcessVariableMutation) ResetScopeType() {
+	m.scope_type = nil
+	delete(m.clearedFields, processvariable.FieldScopeType)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProcessVariableMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProcessVariableMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ProcessVariable entity.
+// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessVariableMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProcessVariableMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProcessVariableMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProcessVariableMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ProcessVariable entity.
+// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProcessVariableMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProcessVariableMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the ProcessVariableMutation builder.
+func (m *ProcessVariableMutation) Where(ps ...predicate.ProcessVariable) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProcessVariableMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProcessVariableMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProcessVariable, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProcessVariableMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProcessVariableMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProcessVariable).
+func (m *ProcessVariableMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProcessVariableMutation) Fields() []string {
+	fields := make([]string, 0, 21)
+	if m.name != nil {
+		fields = append(fields, processvariable.FieldName)
+	}
+	if m._type != nil {
+		fields = append(fields, processvariable.FieldType)
+	}
+	if m.text_value != nil {
+		fields = append(fields, processvariable.FieldTextValue)
+	}
+	if m.text_value2 != nil {
+		fields = append(fields, processvariable.FieldTextValue2)
+	}
+	if m.long_value != nil {
+		fields = append(fields, processvariable.FieldLongValue)
+	}
+	if m.double_value != nil {
+		fields = append(fields, processvariable.FieldDoubleValue)
+	}
+	if m.byte_array_value != nil {
+		fields = append(fields, processvariable.FieldByteArrayValue)
+	}
+	if m.execution_id != nil {
+		fields = append(fields, processvariable.FieldExecutionID)
+	}
+	if m.process_instance_id != nil {
+		fields = append(fields, processvariable.FieldProcessInstanceID)
+	}
+	if m.process_definition_id != nil {
+		fields = append(fields, processvariable.FieldProcessDefinitionID)
+	}
+	if m.case_execution_id != nil {
+		fields = append(fields, processvariable.FieldCaseExecutionID)
+	}
+	if m.case_instance_id != nil {
+		fields = append(fields, processvariable.FieldCaseInstanceID)
+	}
+	if m.task_id != nil {
+		fields = append(fields, processvariable.FieldTaskID)
+	}
+	if m.activity_instance_id != nil {
+		fields = append(fields, processvariable.FieldActivityInstanceID)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, processvariable.FieldTenantID)
+	}
+	if m.sequence_counter != nil {
+		fields = append(fields, processvariable.FieldSequenceCounter)
+	}
+	if m.concurrent_local != nil {
+		fields = append(fields, processvariable.FieldConcurrentLocal)
+	}
+	if m.scope_id != nil {
+		fields = append(fields, processvariable.FieldScopeID)
+	}
+	if m.scope_type != nil {
+		fields = append(fields, processvariable.FieldScopeType)
+	}
+	if m.created_at != nil {
+		fields = append(fields, processvariable.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, processvariable.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProcessVariableMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case processvariable.FieldName:
+		return m.Name()
+	case processvariable.FieldType:
+		return m.GetType()
+	case processvariable.FieldTextValue:
+		return m.TextValue()
+	case processvariable.FieldTextValue2:
+		return m.TextValue2()
+	case processvariable.FieldLongValue:
+		return m.LongValue()
+	case processvariable.FieldDoubleValue:
+		return m.DoubleValue()
+	case processvariable.FieldByteArrayValue:
+		return m.ByteArrayValue()
+	case processvariable.FieldExecutionID:
+		return m.ExecutionID()
+	case processvariable.FieldProcessInstanceID:
+		return m.ProcessInstanceID()
+	case processvariable.FieldProcessDefinitionID:
+		return m.ProcessDefinitionID()
+	case processvariable.FieldCaseExecutionID:
+		return m.CaseExecutionID()
+	case processvariable.FieldCaseInstanceID:
+		return m.CaseInstanceID()
+	case processvariable.FieldTaskID:
+		return m.TaskID()
+	case processvariable.FieldActivityInstanceID:
+		return m.ActivityInstanceID()
+	case processvariable.FieldTenantID:
+		return m.TenantID()
+	case processvariable.FieldSequenceCounter:
+		return m.SequenceCounter()
+	case processvariable.FieldConcurrentLocal:
+		return m.ConcurrentLocal()
+	case processvariable.FieldScopeID:
+		return m.ScopeID()
+	case processvariable.FieldScopeType:
+		return m.ScopeType()
+	case processvariable.FieldCreatedAt:
+		return m.CreatedAt()
+	case processvariable.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProcessVariableMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case processvariable.FieldName:
+		return m.OldName(ctx)
+	case processvariable.FieldType:
+		return m.OldType(ctx)
+	case processvariable.FieldTextValue:
+		return m.OldTextValue(ctx)
+	case processvariable.FieldTextValue2:
+		return m.OldTextValue2(ctx)
+	case processvariable.FieldLongValue:
+		return m.OldLongValue(ctx)
+	case processvariable.FieldDoubleValue:
+		return m.OldDoubleValue(ctx)
+	case processvariable.FieldByteArrayValue:
+		return m.OldByteArrayValue(ctx)
+	case processvariable.FieldExecutionID:
+		return m.OldExecutionID(ctx)
+	case processvariable.FieldProcessInstanceID:
+		return m.OldProcessInstanceID(ctx)
+	case processvariable.FieldProcessDefinitionID:
+		return m.OldProcessDefinitionID(ctx)
+	case processvariable.FieldCaseExecutionID:
+		return m.OldCaseExecutionID(ctx)
+	case processvariable.FieldCaseInstanceID:
+		return m.OldCaseInstanceID(ctx)
+	case processvariable.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case processvariable.FieldActivityInstanceID:
+		return m.OldActivityInstanceID(ctx)
+	case processvariable.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case processvariable.FieldSequenceCounter:
+		return m.OldSequenceCounter(ctx)
+	case processvariable.FieldConcurrentLocal:
+		return m.OldConcurrentLocal(ctx)
+	case processvariable.FieldScopeID:
+		return m.OldScopeID(ctx)
+	case processvariable.FieldScopeType:
+		return m.OldScopeType(ctx)
+	case processvariable.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case processvariable.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProcessVariable field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProcessVariableMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case processvariable.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case processvariable.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case processvariable.FieldTextValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTextValue(v)
+		return nil
+	case processvariable.FieldTextValue2:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTextValue2(v)
+		return nil
+	case processvariable.FieldLongValue:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLongValue(v)
+		return nil
+	case processvariable.FieldDoubleValue:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDoubleValue(v)
+		return nil
+	case processvariable.FieldByteArrayValue:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetByteArrayValue(v)
+		return nil
+	case processvariable.FieldExecutionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExecutionID(v)
+		return nil
+	case processvariable.FieldProcessInstanceID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessInstanceID(v)
+		return nil
+	case processvariable.FieldProcessDefinitionID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessDefinitionID(v)
+		return nil
+	case processvariable.FieldCaseExecutionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCaseExecutionID(v)
+		return nil
+	case processvariable.FieldCaseInstanceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCaseInstanceID(v)
+		return nil
+	case processvariable.FieldTaskID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case processvariable.FieldActivityInstanceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActivityInstanceID(v)
+		return nil
+	case processvariable.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case processvariable.FieldSequenceCounter:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSequenceCounter(v)
+		return nil
+	case processvariable.FieldConcurrentLocal:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConcurrentLocal(v)
+		return nil
+	case processvariable.FieldScopeID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopeID(v)
+		return nil
+	case processvariable.FieldScopeType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopeType(v)
+		return nil
+	case processvariable.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case processvariable.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProcessVariable field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProcessVariableMutation) AddedFields() []string {
+	var fields []string
+	if m.addlong_value != nil {
+		fields = append(fields, processvariable.FieldLongValue)
+	}
+	if m.adddouble_value != nil {
+		fields = append(fields, processvariable.FieldDoubleValue)
+	}
+	if m.addprocess_instance_id != nil {
+		fields = append(fields, processvariable.FieldProcessInstanceID)
+	}
+	if m.addprocess_definition_id != nil {
+		fields = append(fields, processvariable.FieldProcessDefinitionID)
+	}
+	if m.addtask_id != nil {
+		fields = append(fields, processvariable.FieldTaskID)
+	}
+	if m.addsequence_counter != nil {
+		fields = append(fields, processvariable.FieldSequenceCounter)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProcessVariableMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case processvariable.FieldLongValue:
+		return m.AddedLongValue()
+	case processvariable.FieldDoubleValue:
+		return m.AddedDoubleValue()
+	case processvariable.FieldProcessInstanceID:
+		return m.AddedProcessInstanceID()
+	case processvariable.FieldProcessDefinitionID:
+		return m.AddedProcessDefinitionID()
+	case processvariable.FieldTaskID:
+		return m.AddedTaskID()
+	case processvariable.FieldSequenceCounter:
+		return m.AddedSequenceCounter()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProcessVariableMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case processvariable.FieldLongValue:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLongValue(v)
+		return nil
+	case processvariable.FieldDoubleValue:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDoubleValue(v)
+		return nil
+	case processvariable.FieldProcessInstanceID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProcessInstanceID(v)
+		return nil
+	case processvariable.FieldProcessDefinitionID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProcessDefinitionID(v)
+		return nil
+	case processvariable.FieldTaskID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTaskID(v)
+		return nil
+	case processvariable.FieldSequenceCounter:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSequenceCounter(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProcessVariable numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProcessVariableMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(processvariable.FieldTextValue) {
+		fields = append(fields, processvariable.FieldTextValue)
+	}
+	if m.FieldCleared(processvariable.FieldTextValue2) {
+		fields = append(fields, processvariable.FieldTextValue2)
+	}
+	if m.FieldCleared(processvariable.FieldLongValue) {
+		fields = append(fields, processvariable.FieldLongValue)
+	}
+	if m.FieldCleared(processvariable.FieldDoubleValue) {
+		fields = append(fields, processvariable.FieldDoubleValue)
+	}
+	if m.FieldCleared(processvariable.FieldByteArrayValue) {
+		fields = append(fields, processvariable.FieldByteArrayValue)
+	}
+	if m.FieldCleared(processvariable.FieldExecutionID) {
+		fields = append(fields, processvariable.FieldExecutionID)
+	}
+	if m.FieldCleared(processvariable.FieldProcessInstanceID) {
+		fields = append(fields, processvariable.FieldProcessInstanceID)
+	}
+	if m.FieldCleared(processvariable.FieldProcessDefinitionID) {
+		fields = append(fields, processvariable.FieldProcessDefinitionID)
+	}
+	if m.FieldCleared(processvariable.FieldCaseExecutionID) {
+		fields = append(fields, processvariable.FieldCaseExecutionID)
+	}
+	if m.FieldCleared(processvariable.FieldCaseInstanceID) {
+		fields = append(fields, processvariable.FieldCaseInstanceID)
+	}
+	if m.FieldCleared(processvariable.FieldTaskID) {
+		fields = append(fields, processvariable.FieldTaskID)
+	}
+	if m.FieldCleared(processvariable.FieldActivityInstanceID) {
+		fields = append(fields, processvariable.FieldActivityInstanceID)
+	}
+	if m.FieldCleared(processvariable.FieldScopeID) {
+		fields = append(fields, processvariable.FieldScopeID)
+	}
+	if m.FieldCleared(processvariable.FieldScopeType) {
+		fields = append(fields, processvariable.FieldScopeType)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProcessVariableMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProcessVariableMutation) ClearField(name string) error {
+	switch name {
+	case processvariable.FieldTextValue:
+		m.ClearTextValue()
+		return nil
+	case processvariable.FieldTextValue2:
+		m.ClearTextValue2()
+		return nil
+	case processvariable.FieldLongValue:
+		m.ClearLongValue()
+		return nil
+	case processvariable.FieldDoubleValue:
+		m.ClearDoubleValue()
+		return nil
+	case processvariable.FieldByteArrayValue:
+		m.ClearByteArrayValue()
+		return nil
+	case processvariable.FieldExecutionID:
+		m.ClearExecutionID()
+		return nil
+	case processvariable.FieldProcessInstanceID:
+		m.ClearProcessInstanceID()
+		return nil
+	case processvariable.FieldProcessDefinitionID:
+		m.ClearProcessDefinitionID()
+		return nil
+	case processvariable.FieldCaseExecutionID:
+		m.ClearCaseExecutionID()
+		return nil
+	case processvariable.FieldCaseInstanceID:
+		m.ClearCaseInstanceID()
+		return nil
+	case processvariable.FieldTaskID:
+		m.ClearTaskID()
+		return nil
+	case processvariable.FieldActivityInstanceID:
+		m.ClearActivityInstanceID()
+		return nil
+	case processvariable.FieldScopeID:
+		m.ClearScopeID()
+		return nil
+	case processvariable.FieldScopeType:
+		m.ClearScopeType()
+		return nil
+	}
+	return fmt.Errorf("unknown ProcessVariable nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProcessVariableMutation) ResetField(name string) error {
+	switch name {
+	case processvariable.FieldName:
+		m.ResetName()
+		return nil
+	case processvariable.FieldType:
+		m.ResetType()
+		return nil
+	case processvariable.FieldTextValue:
+		m.ResetTextValue()
+		return nil
+	case processvariable.FieldTextValue2:
+		m.ResetTextValue2()
+		return nil
+	case processvariable.FieldLongValue:
+		m.ResetLongValue()
+		return nil
+	case processvariable.FieldDoubleValue:
+		m.ResetDoubleValue()
+		return nil
+	case processvariable.FieldByteArrayValue:
+		m.ResetByteArrayValue()
+		return nil
+	case processvariable.FieldExecutionID:
+		m.ResetExecutionID()
+		return nil
+	case processvariable.FieldProcessInstanceID:
+		m.ResetProcessInstanceID()
+		return nil
+	case processvariable.FieldProcessDefinitionID:
+		m.ResetProcessDefinitionID()
+		return nil
+	case processvariable.FieldCaseExecutionID:
+		m.ResetCaseExecutionID()
+		return nil
+	case processvariable.FieldCaseInstanceID:
+		m.ResetCaseInstanceID()
+		return nil
+	case processvariable.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case processvariable.FieldActivityInstanceID:
+		m.ResetActivityInstanceID()
+		return nil
+	case processvariable.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case processvariable.FieldSequenceCounter:
+		m.ResetSequenceCounter()
+		return nil
+	case processvariable.FieldConcurrentLocal:
+		m.ResetConcurrentLocal()
+		return nil
+	case processvariable.FieldScopeID:
+		m.ResetScopeID()
+		return nil
+	case processvariable.FieldScopeType:
+		m.ResetScopeType()
+		return nil
+	case processvariable.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case processvariable.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ProcessVariable field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProcessVariableMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProcessVariableMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProcessVariableMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProcessVariableMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProcessVariableMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProcessVariableMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProcessVariableMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ProcessVariable unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProcessVariableMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ProcessVariable edge %s", name)
+}
+
+// RefreshTokenMutation represents an operation that mutates the RefreshToken nodes in the graph.
+type RefreshTokenMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int64
+	token_id          *string
+	family_id         *string
+	user_id           *int64
+	adduser_id        *int64
+	parent_token_id   *string
+	access_token_id   *string
+	access_expires_at *time.Time
+	expires_at        *time.Time
+	used_at           *time.Time
+	revoked_at        *time.Time
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*RefreshToken, error)
+	predicates        []predicate.RefreshToken
+}
+
+var _ ent.Mutation = (*RefreshTokenMutation)(nil)
+
+// refreshtokenOption allows management of the mutation configuration using functional options.
+type refreshtokenOption func(*RefreshTokenMutation)
+
+// newRefreshTokenMutation creates new mutation for the RefreshToken entity.
+func newRefreshTokenMutation(c config, op Op, opts ...refreshtokenOption) *RefreshTokenMutation {
+	m := &RefreshTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRefreshToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRefreshTokenID sets the ID field of the mutation.
+func withRefreshTokenID(id int64) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RefreshToken
+		)
+		m.oldValue = func(ctx context.Context) (*RefreshToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RefreshToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRefreshToken sets the old RefreshToken of the mutation.
+func withRefreshToken(node *RefreshToken) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
+		m.oldValue = func(context.Context) (*RefreshToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RefreshTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RefreshTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RefreshToken entities.
+func (m *RefreshTokenMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RefreshTokenMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RefreshTokenMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RefreshToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTokenID sets the "token_id" field.
+func (m *RefreshTokenMutation) SetTokenID(s string) {
+	m.token_id = &s
+}
+
+// TokenID returns the value of the "token_id" field in the mutation.
+func (m *RefreshTokenMutation) TokenID() (r string, exists bool) {
+	v := m.token_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenID returns the old "token_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldTokenID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenID: %w", err)
+	}
+	return oldValue.TokenID, nil
+}
+
+// ResetTokenID resets all changes to the "token_id" field.
+func (m *RefreshTokenMutation) ResetTokenID() {
+	m.token_id = nil
+}
+
+// SetFamilyID sets the "family_id" field.
+func (m *RefreshTokenMutation) SetFamilyID(s string) {
+	m.family_id = &s
+}
+
+// FamilyID returns the value of the "family_id" field in the mutation.
+func (m *RefreshTokenMutation) FamilyID() (r string, exists bool) {
+	v := m.family_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFamilyID returns the old "family_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldFamilyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFamilyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFamilyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFamilyID: %w", err)
+	}
+	return oldValue.FamilyID, nil
+}
+
+// ResetFamilyID resets all changes to the "family_id" field.
+func (m *RefreshTokenMutation) ResetFamilyID() {
+	m.family_id = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RefreshTokenMutation) SetUserID(i int64) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RefreshTokenMutation) UserID() (r int64, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldUserID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *RefreshTokenMutation) AddUserID(i int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *RefreshTokenMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RefreshTokenMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetParentTokenID sets the "parent_token_id" field.
+func (m *RefreshTokenMutation) SetParentTokenID(s string) {
+	m.parent_token_id = &s
+}
+
+// ParentTokenID returns the value of the "parent_token_id" field in the mutation.
+func (m *RefreshTokenMutation) ParentTokenID() (r string, exists bool) {
+	v := m.parent_token_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentTokenID returns the old "parent_token_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldParentTokenID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentTokenID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentTokenID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentTokenID: %w", err)
+	}
+	return oldValue.ParentTokenID, nil
+}
+
+// ClearParentTokenID clears the value of the "parent_token_id" field.
+func (m *RefreshTokenMutation) ClearParentTokenID() {
+	m.parent_token_id = nil
+	m.clearedFields[refreshtoken.FieldParentTokenID] = struct{}{}
+}
+
+// ParentTokenIDCleared returns if the "parent_token_id" field was cleared in this mutation.
+func (m *RefreshTokenMutation) ParentTokenIDCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldParentTokenID]
+	return ok
+}
+
+// ResetParentTokenID resets all changes to the "parent_token_id" field.
+func (m *RefreshTokenMutation) ResetParentTokenID() {
+	m.parent_token_id = nil
+	delete(m.clearedFields, refreshtoken.FieldParentTokenID)
+}
+
+// SetAccessTokenID sets the "access_token_id" field.
+func (m *RefreshTokenMutation) SetAccessTokenID(s string) {
+	m.access_token_id = &s
+}
+
+// AccessTokenID returns the value of the "access_token_id" field in the mutation.
+func (m *RefreshTokenMutation) AccessTokenID() (r string, exists bool) {
+	v := m.access_token_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessTokenID returns the old "access_token_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldAccessTokenID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessTokenID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessTokenID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessTokenID: %w", err)
+	}
+	return oldValue.AccessTokenID, nil
+}
+
+// ClearAccessTokenID clears the value of the "access_token_id" field.
+func (m *RefreshTokenMutation) ClearAccessTokenID() {
+	m.access_token_id = nil
+	m.clearedFields[refreshtoken.FieldAccessTokenID] = struct{}{}
+}
+
+// AccessTokenIDCleared returns if the "access_token_id" field was cleared in this mutation.
+func (m *RefreshTokenMutation) AccessTokenIDCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldAccessTokenID]
+	return ok
+}
+
+// ResetAccessTokenID resets all changes to the "access_token_id" field.
+func (m *RefreshTokenMutation) ResetAccessTokenID() {
+	m.access_token_id = nil
+	delete(m.clearedFields, refreshtoken.FieldAccessTokenID)
+}
+
+// SetAccessExpiresAt sets the "access_expires_at" field.
+func (m *RefreshTokenMutation) SetAccessExpiresAt(t time.Time) {
+	m.access_expires_at = &t
+}
+
+// AccessExpiresAt returns the value of the "access_expires_at" field in the mutation.
+func (m *RefreshTokenMutation) AccessExpiresAt() (r time.Time, exists bool) {
+	v := m.access_expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessExpiresAt returns the old "access_expires_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldAccessExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessExpiresAt: %w", err)
+	}
+	return oldValue.AccessExpiresAt, nil
+}
+
+// ResetAccessExpiresAt resets all changes to the "access_expires_at" field.
+func (m *RefreshTokenMutation) ResetAccessExpiresAt() {
+	m.access_expires_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *RefreshTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *RefreshTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *RefreshTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetUsedAt sets the "used_at" field.
+func (m *RefreshTokenMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
+}
+
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *RefreshTokenMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsedAt returns the old "used_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
+	}
+	return oldValue.UsedAt, nil
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (m *RefreshTokenMutation) ClearUsedAt() {
+	m.used_at = nil
+	m.clearedFields[refreshtoken.FieldUsedAt] = struct{}{}
+}
+
+// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
+func (m *RefreshTokenMutation) UsedAtCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldUsedAt]
+	return ok
+}
+
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *RefreshTokenMutation) ResetUsedAt() {
+	m.used_at = nil
+	delete(m.clearedFields, refreshtoken.FieldUsedAt)
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *RefreshTokenMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *RefreshTokenMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *RefreshTokenMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[refreshtoken.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *RefreshTokenMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *RefreshTokenMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, refreshtoken.FieldRevokedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RefreshTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RefreshTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RefreshTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the RefreshTokenMutation builder.
+func (m *RefreshTokenMutation) Where(ps ...predicate.RefreshToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RefreshTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RefreshTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RefreshToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RefreshTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RefreshTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RefreshToken).
+func (m *RefreshTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RefreshTokenMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.token_id != nil {
+		fields = append(fields, refreshtoken.FieldTokenID)
+	}
+	if m.family_id != nil {
+		fields = append(fields, refreshtoken.FieldFamilyID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, refreshtoken.FieldUserID)
+	}
+	if m.parent_token_id != nil {
+		fields = append(fields, refreshtoken.FieldParentTokenID)
+	}
+	if m.access_token_id != nil {
+		fields = append(fields, refreshtoken.FieldAccessTokenID)
+	}
+	if m.access_expires_at != nil {
+		fields = append(fields, refreshtoken.FieldAccessExpiresAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, refreshtoken.FieldExpiresAt)
+	}
+	if m.used_at != nil {
+		fields = append(fields, refreshtoken.FieldUsedAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, refreshtoken.FieldRevokedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, refreshtoken.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RefreshTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case refreshtoken.FieldTokenID:
+		return m.TokenID()
+	case refreshtoken.FieldFamilyID:
+		return m.FamilyID()
+	case refreshtoken.FieldUserID:
+		return m.UserID()
+	case refreshtoken.FieldParentTokenID:
+		return m.ParentTokenID()
+	case refreshtoken.FieldAccessTokenID:
+		return m.AccessTokenID()
+	case refreshtoken.FieldAccessExpiresAt:
+		return m.AccessExpiresAt()
+	case refreshtoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case refreshtoken.FieldUsedAt:
+		return m.UsedAt()
+	case refreshtoken.FieldRevokedAt:
+		return m.RevokedAt()
+	case refreshtoken.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RefreshTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case refreshtoken.FieldTokenID:
+		return m.OldTokenID(ctx)
+	case refreshtoken.FieldFamilyID:
+		return m.OldFamilyID(ctx)
+	case refreshtoken.FieldUserID:
+		return m.OldUserID(ctx)
+	case refreshtoken.FieldParentTokenID:
+		return m.OldParentTokenID(ctx)
+	case refreshtoken.FieldAccessTokenID:
+		return m.OldAccessTokenID(ctx)
+	case refreshtoken.FieldAccessExpiresAt:
+		return m.OldAccessExpiresAt(ctx)
+	case refreshtoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case refreshtoken.FieldUsedAt:
+		return m.OldUsedAt(ctx)
+	case refreshtoken.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case refreshtoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefreshTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case refreshtoken.FieldTokenID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenID(v)
+		return nil
+	case refreshtoken.FieldFamilyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFamilyID(v)
+		return nil
+	case refreshtoken.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case refreshtoken.FieldParentTokenID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentTokenID(v)
+		return nil
+	case refreshtoken.FieldAccessTokenID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessTokenID(v)
+		return nil
+	case refreshtoken.FieldAccessExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessExpiresAt(v)
+		return nil
+	case refreshtoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case refreshtoken.FieldUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsedAt(v)
+		return nil
+	case refreshtoken.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case refreshtoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RefreshTokenMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, refreshtoken.FieldUserID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RefreshTokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case refreshtoken.FieldUserID:
+		return m.AddedUserID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefreshTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case refreshtoken.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RefreshTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(refreshtoken.FieldParentTokenID) {
+		fields = append(fields, refreshtoken.FieldParentTokenID)
+	}
+	if m.FieldCleared(refreshtoken.FieldAccessTokenID) {
+		fields = append(fields, refreshtoken.FieldAccessTokenID)
+	}
+	if m.FieldCleared(refreshtoken.FieldUsedAt) {
+		fields = append(fields, refreshtoken.FieldUsedAt)
+	}
+	if m.FieldCleared(refreshtoken.FieldRevokedAt) {
+		fields = append(fields, refreshtoken.FieldRevokedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RefreshTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RefreshTokenMutation) ClearField(name string) error {
+	switch name {
+	case refreshtoken.FieldParentTokenID:
+		m.ClearParentTokenID()
+		return nil
+	case refreshtoken.FieldAccessTokenID:
+		m.ClearAccessTokenID()
+		return nil
+	case refreshtoken.FieldUsedAt:
+		m.ClearUsedAt()
+		return nil
+	case refreshtoken.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RefreshTokenMutation) ResetField(name string) error {
+	switch name {
+	case refreshtoken.FieldTokenID:
+		m.ResetTokenID()
+		return nil
+	case refreshtoken.FieldFamilyID:
+		m.ResetFamilyID()
+		return nil
+	case refreshtoken.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case refreshtoken.FieldParentTokenID:
+		m.ResetParentTokenID()
+		return nil
+	case refreshtoken.FieldAccessTokenID:
+		m.ResetAccessTokenID()
+		return nil
+	case refreshtoken.FieldAccessExpiresAt:
+		m.ResetAccessExpiresAt()
+		return nil
+	case refreshtoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case refreshtoken.FieldUsedAt:
+		m.ResetUsedAt()
+		return nil
+	case refreshtoken.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case refreshtoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RefreshTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RefreshTokenMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RefreshTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RefreshTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RefreshTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RefreshTokenMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RefreshTokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RefreshToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RefreshTokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RefreshToken edge %s", name)
+}
+
+// RoleMutation represents an operation that mutates the Role nodes in the graph.
+type RoleMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int64
+	name              *string
+	description       *string
+	permissions       *[]string
+	appendpermissions []string
+	created_at        *time.Time
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*Role, error)
+	predicates        []predicate.Role
+}
+
+var _ ent.Mutation = (*RoleMutation)(nil)
+
+// roleOption allows management of the mutation configuration using functional options.
+type roleOption func(*RoleMutation)
+
+// newRoleMutation creates new mutation for the Role entity.
+func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
+	m := &RoleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRole,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoleID sets the ID field of the mutation.
+func withRoleID(id int64) roleOption {
+	return func(m *RoleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Role
+		)
+		m.oldValue = func(ctx context.Context) (*Role, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Role.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRole sets the old Role of the mutation.
+func withRole(node *Role) roleOption {
+	return func(m *RoleMutation) {
+		m.oldValue = func(context.Context) (*Role, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Role entities.
+func (m *RoleMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoleMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoleMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Role.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *RoleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RoleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RoleMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *RoleMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *RoleMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *RoleMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[role.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *RoleMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[role.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *RoleMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, role.FieldDescription)
+}
+
+// SetPermissions sets the "permissions" field.
+func (m *RoleMutation) SetPermissions(s []string) {
+	m.permissions = &s
+	m.appendpermissions = nil
+}
+
+// Permissions returns the value of the "permissions" field in the mutation.
+func (m *RoleMutation) Permissions() (r []string, exists bool) {
+	v := m.permissions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPermissions returns the old "permissions" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldPermissions(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPermissions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPermissions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPermissions: %w", err)
+	}
+	return oldValue.Permissions, nil
+}
+
+// AppendPermissions adds s to the "permissions" field.
+func (m *RoleMutation) AppendPermissions(s []string) {
+	m.appendpermissions = append(m.appendpermissions, s...)
+}
+
+// AppendedPermissions returns the list of values that were appended to the "permissions" field in this mutation.
+func (m *RoleMutation) AppendedPermissions() ([]string, bool) {
+	if len(m.appendpermissions) == 0 {
+		return nil, false
+	}
+	return m.appendpermissions, true
+}
+
+// ClearPermissions clears the value of the "permissions" field.
+func (m *RoleMutation) ClearPermissions() {
+	m.permissions = nil
+	m.appendpermissions = nil
+	m.clearedFields[role.FieldPermissions] = struct{}{}
+}
+
+// PermissionsCleared returns if the "permissions" field was cleared in this mutation.
+func (m *RoleMutation) PermissionsCleared() bool {
+	_, ok := m.clearedFields[role.FieldPermissions]
+	return ok
+}
+
+// ResetPermissions resets all changes to the "permissions" field.
+func (m *RoleMutation) ResetPermissions() {
+	m.permissions = nil
+	m.appendpermissions = nil
+	delete(m.clearedFields, role.FieldPermissions)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RoleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RoleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RoleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RoleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RoleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RoleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the RoleMutation builder.
+func (m *RoleMutation) Where(ps ...predicate.Role) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Role, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Role).
+func (m *RoleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoleMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, role.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, role.FieldDescription)
+	}
+	if m.permissions != nil {
+		fields = append(fields, role.FieldPermissions)
+	}
+	if m.created_at != nil {
+		fields = append(fields, role.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, role.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case role.FieldName:
+		return m.Name()
+	case role.FieldDescription:
+		return m.Description()
+	case role.FieldPermissions:
+		return m.Permissions()
+	case role.FieldCreatedAt:
+		return m.CreatedAt()
+	case role.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case role.FieldName:
+		return m.OldName(ctx)
+	case role.FieldDescription:
+		return m.OldDescription(ctx)
+	case role.FieldPermissions:
+		return m.OldPermissions(ctx)
+	case role.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case role.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Role field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case role.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case role.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case role.FieldPermissions:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermissions(v)
+		return nil
+	case role.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case role.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Role field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoleMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Role numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(role.FieldDescription) {
+		fields = append(fields, role.FieldDescription)
+	}
+	if m.FieldCleared(role.FieldPermissions) {
+		fields = append(fields, role.FieldPermissions)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoleMutation) ClearField(name string) error {
+	switch name {
+	case role.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case role.FieldPermissions:
+		m.ClearPermissions()
+		return nil
+	}
+	return fmt.Errorf("unknown Role nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoleMutation) ResetField(name string) error {
+	switch name {
+	case role.FieldName:
+		m.ResetName()
+		return nil
+	case role.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case role.FieldPermissions:
+		m.ResetPermissions()
+		return nil
+	case role.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case role.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Role field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoleMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoleMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Role unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Role edge %s", name)
+}
+
+// TaskAttachmentMutation represents an operation that mutates the TaskAttachment nodes in the graph.
+type TaskAttachmentMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int64
+	task_id                *int64
+	addtask_id             *int64
+	process_instance_id    *int64
+	addprocess_instance_id *int64
+	user_id                *string
+	name                   *string
+	description            *string
+	content_type           *string
+	size                   *int64
+	addsize                *int64
+	storage                *string
+	location               *string
+	content                *[]byte
+	created_at             *time.Time
+	clearedFields          map[string]struct{}
+	done                   bool
+	oldValue               func(context.Context) (*TaskAttachment, error)
+	predicates             []predicate.TaskAttachment
+}
+
+var _ ent.Mutation = (*TaskAttachmentMutation)(nil)
+
+// taskattachmentOption allows management of the mutation configuration using functional options.
+type taskattachmentOption func(*TaskAttachmentMutation)
+
+// newTaskAttachmentMutation creates new mutation for the TaskAttachment entity.
+func newTaskAttachmentMutation(c config, op Op, opts ...taskattachmentOption) *TaskAttachmentMutation {
+	m := &TaskAttachmentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTaskAttachment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTaskAttachmentID sets the ID field of the mutation.
+func withTaskAttachmentID(id int64) taskattachmentOption {
+	return func(m *TaskAttachmentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TaskAttachment
+		)
+		m.oldValue = func(ctx context.Context) (*TaskAttachment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TaskAttachment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTaskAttachment sets the old TaskAttachment of the mutation.
+func withTaskAttachment(node *TaskAttachment) taskattachmentOption {
+	return func(m *TaskAttachmentMutation) {
+		m.oldValue = func(context.Context) (*TaskAttachment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskAttachmentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskAttachmentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TaskAttachment entities.
+func (m *TaskAttachmentMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskAttachmentMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskAttachmentMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TaskAttachment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *TaskAttachmentMutation) SetTaskID(i int64) {
+	m.task_id = &i
+	m.addtask_id = nil
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *TaskAttachmentMutation) TaskID() (r int64, exists bool) {
+	v := m.task_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the TaskAttachment entity.
+// If the TaskAttachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskAttachmentMutation) OldTaskID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (m *TaskAttachmentMutation) AddTaskID(i int64) {
+	if m.addtask_id != nil {
+		*m.addtask_id += i
+	} else {
+		m.addtask_id = &i
+	}
+}
+
+// AddedTaskID returns the value that was added to the "task_id" field in this mutation.
+func (m *TaskAttachmentMutation) AddedTaskID() (r int64, exists bool) {
+	v := m.addtask_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *TaskAttachmentMutation) ResetTaskID() {
+	m.task_id = nil
+	m.addtask_id = nil
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (m *TaskAttachmentMutation) SetProcessInstanceID(i int64) {
+	m.process_instance_id = &i
+	m.addprocess_instance_id = nil
+}
+
+// ProcessInstanceID returns the value of the "process_instance_id" field in the mutation.
+func (m *TaskAttachmentMutation) ProcessInstanceID() (r int64, exists bool) {
+	v := m.process_instance_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessInstanceID returns the old "process_instance_id" field's value of the TaskAttachment entity.
+// If the TaskAttachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskAttachmentMutation) OldProcessInstanceID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessInstanceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessInstanceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessInstanceID: %w", err)
+	}
+	return oldValue.ProcessInstanceID, nil
+}
+
+// AddProcessInstanceID adds i to the "process_instance_id" field.
+func (m *TaskAttachmentMutation) AddProcessInstanceID(i int64) {
+	if m.addprocess_instance_id != nil {
+		*m.addprocess_instance_id += i
+	} else {
+		m.addprocess_instance_id = &i
+	}
+}
+
+// AddedProcessInstanceID returns the value that was added to the "process_instance_id" field in this mutation.
+func (m *TaskAttachmentMutation) AddedProcessInstanceID() (r int64, exists bool) {
+	v := m.addprocess_instance_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearProcessInstanceID clears the value of the "process_instance_id" field.
+func (m *TaskAttachmentMutation) ClearProcessInstanceID() {
+	m.process_instance_id = nil
+	m.addprocess_instance_id = nil
+	m.clearedFields[taskattachment.FieldProcessInstanceID] = struct{}{}
+}
+
+// ProcessInstanceIDCleared returns if the "process_instance_id" field was cleared in this mutation.
+func (m *TaskAttachmentMutation) ProcessInstanceIDCleared() bool {
+	_, ok := m.clearedFields[taskattachment.FieldProcessInstanceID]
+	return ok
+}
+
+// ResetProcessInstanceID resets all changes to the "process_instance_id" field.
+func (m *TaskAttachmentMutation) ResetProcessInstanceID() {
+	m.process_instance_id = nil
+	m.addprocess_instance_id = nil
+	delete(m.clearedFields, taskattachment.FieldProcessInstanceID)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *TaskAttachmentMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *TaskAttachmentMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the TaskAttachment entity.
+// If the TaskAttachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskAttachmentMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *TaskAttachmentMutation) ClearUserID() {
+	m.user_id = nil
+	m.clearedFields[taskattachment.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *TaskAttachmentMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[taskattachment.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *TaskAttachmentMutation) ResetUserID() {
+	m.user_id = nil
+	delete(m.clearedFields, taskattachment.FieldUserID)
+}
+
+// SetName sets the "name" field.
+func (m *TaskAttachmentMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TaskAttachmentMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the TaskAttachment entity.
+// If the TaskAttachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskAttachmentMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TaskAttachmentMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *TaskAttachmentMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *TaskAttachmentMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the TaskAttachment entity.
+// If the TaskAttachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskAttachmentMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *TaskAttachmentMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[taskattachment.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *TaskAttachmentMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[taskattachment.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *TaskAttachmentMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, taskattachment.FieldDescription)
+}
+
+// SetContentType sets the "content_type" field.
+func (m *TaskAttachmentMutation) SetContentType(s string) {
+	m.content_type = &s
+}
+
+// ContentType returns the value of the "content_type" field in the mutation.
+func (m *TaskAttachmentMutation) ContentType() (r string, exists bool) {
+	v := m.content_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentType returns the old "content_type" field's value of the TaskAttachment entity.
+// If the TaskAttachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskAttachmentMutation) OldContentType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
+	}
+	return oldValue.ContentType, nil
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (m *TaskAttachmentMutation) ClearContentType() {
+	m.content_type = nil
+	m.clearedFields[taskattachment.FieldContentType] = struct{}{}
+}
+
+// ContentTypeCleared returns if the "content_type" field was cleared in this mutation.
+func (m *TaskAttachmentMutation) ContentTypeCleared() bool {
+	_, ok := m.clearedFields[taskattachment.FieldContentType]
+	return ok
+}
+
+// ResetContentType resets all changes to the "content_type" field.
+func (m *TaskAttachmentMutation) ResetContentType() {
+	m.content_type = nil
+	delete(m.clearedFields, taskattachment.FieldContentType)
+}
+
+// SetSize sets the "size" field.
+func (m *TaskAttachmentMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *TaskAttachmentMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the TaskAttachment entity.
+// If the TaskAttachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskAttachmentMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *TaskAttachmentMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *TaskAttachmentMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *TaskAttachmentMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetStorage sets the "storage" field.
+func (m *TaskAttachmentMutation) SetStorage(s string) {
+	m.storage = &s
+}
+
+// Storage returns the value of the "storage" field in the mutation.
+func (m *TaskAttachmentMutation) Storage() (r string, exists bool) {
+	v := m.storage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStorage returns the old "storage" field's value of the TaskAttachment entity.
+// If the TaskAttachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskAttachmentMutation) OldStorage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorage: %w", err)
+	}
+	return oldValue.Storage, nil
+}
+
+// ResetStorage resets all changes to the "storage" field.
+func (m *TaskAttachmentMutation) ResetStorage() {
+	m.storage = nil
+}
+
+// SetLocation sets the "location" field.
+func (m *TaskAttachmentMutation) SetLocation(s string) {
+	m.location = &s
+}
+
+// Location returns the value of the "location" field in the mutation.
+func (m *TaskAttachmentMutation) Location() (r string, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocation returns the old "location" field's value of the TaskAttachment entity.
+// If the TaskAttachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskAttachmentMutation) OldLocation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+	}
+	return oldValue.Location, nil
+}
+
+// ClearLocation clears the value of the "location" field.
+func (m *TaskAttachmentMutation) ClearLocation() {
+	m.location = nil
+	m.clearedFields[taskattachment.FieldLocation] = struct{}{}
+}
+
+// LocationCleared returns if the "location" field was cleared in this mutation.
+func (m *TaskAttachmentMutation) LocationCleared() bool {
+	_, ok := m.clearedFields[taskattachment.FieldLocation]
+	return ok
+}
+
+// ResetLocation resets all changes to the "location" field.
+func (m *TaskAttachmentMutation) ResetLocation() {
+	m.location = nil
+	delete(m.clearedFields, taskattachment.FieldLocation)
+}
+
+// SetContent sets the "content" field.
+func (m *TaskAttachmentMutation) SetContent(b []byte) {
+	m.content = &b
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *TaskAttachmentMutation) Content() (r []byte, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the TaskAttachment entity.
+// If the TaskAttachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskAttachmentMutation) OldContent(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ClearContent clears the value of the "content" field.
+func (m *TaskAttachmentMutation) ClearContent() {
+	m.content = nil
+	m.clearedFields[taskattachment.FieldContent] = struct{}{}
+}
+
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *TaskAttachmentMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[taskattachment.FieldContent]
+	return ok
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *TaskAttachmentMutation) ResetContent() {
+	m.content = nil
+	delete(m.clearedFields, taskattachment.FieldContent)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TaskAttachmentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TaskAttachmentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TaskAttachment entity.
+// If the TaskAttachment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskAttachmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TaskAttachmentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the TaskAttachmentMutation builder.
+func (m *TaskAttachmentMutation) Where(ps ...predicate.TaskAttachment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TaskAttachmentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaskAttachmentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TaskAttachment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TaskAttachmentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TaskAttachmentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TaskAttachment).
+func (m *TaskAttachmentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TaskAttachmentMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.task_id != nil {
+		fields = append(fields, taskattachment.FieldTaskID)
+	}
+	if m.process_instance_id != nil {
+		fields = append(fields, taskattachment.FieldProcessInstanceID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, taskattachment.FieldUserID)
+	}
+	if m.name != nil {
+		fields = append(fields, taskattachment.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, taskattachment.FieldDescription)
+	}
+	if m.content_type != nil {
+		fields = append(fields, taskattachment.FieldContentType)
+	}
+	if m.size != nil {
+		fields = append(fields, taskattachment.FieldSize)
+	}
+	if m.storage != nil {
+		fields = append(fields, taskattachment.FieldStorage)
+	}
+	if m.location != nil {
+		fields = append(fields, taskattachment.FieldLocation)
+	}
+	if m.content != nil {
+		fields = append(fields, taskattachment.FieldContent)
+	}
+	if m.created_at != nil {
+		fields = append(fields, taskattachment.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TaskAttachmentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case taskattachment.FieldTaskID:
+		return m.TaskID()
+	case taskattachment.FieldProcessInstanceID:
+		return m.ProcessInstanceID()
+	case taskattachment.FieldUserID:
+		return m.UserID()
+	case taskattachment.FieldName:
+		return m.Name()
+	case taskattachment.FieldDescription:
+		return m.Description()
+	case taskattachment.FieldContentType:
+		return m.ContentType()
+	case taskattachment.FieldSize:
+		return m.Size()
+	case taskattachment.FieldStorage:
+		return m.Storage()
+	case taskattachment.FieldLocation:
+		return m.Location()
+	case taskattachment.FieldContent:
+		return m.Content()
+	case taskattachment.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TaskAttachmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case taskattachment.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case taskattachment.FieldProcessInstanceID:
+		return m.OldProcessInstanceID(ctx)
+	case taskattachment.FieldUserID:
+		return m.OldUserID(ctx)
+	case taskattachment.FieldName:
+		return m.OldName(ctx)
+	case taskattachment.FieldDescription:
+		return m.OldDescription(ctx)
+	case taskattachment.FieldContentType:
+		return m.OldContentType(ctx)
+	case taskattachment.FieldSize:
+		return m.OldSize(ctx)
+	case taskattachment.FieldStorage:
+		return m.OldStorage(ctx)
+	case taskattachment.FieldLocation:
+		return m.OldLocation(ctx)
+	case taskattachment.FieldContent:
+		return m.OldContent(ctx)
+	case taskattachment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TaskAttachment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskAttachmentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case taskattachment.FieldTaskID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case taskattachment.FieldProcessInstanceID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessInstanceID(v)
+		return nil
+	case taskattachment.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case taskattachment.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case taskattachment.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case taskattachment.FieldContentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentType(v)
+		return nil
+	case taskattachment.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case taskattachment.FieldStorage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorage(v)
+		return nil
+	case taskattachment.FieldLocation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocation(v)
+		return nil
+	case taskattachment.FieldContent:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case taskattachment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TaskAttachment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TaskAttachmentMutation) AddedFields() []string {
+	var fields []string
+	if m.addtask_id != nil {
+		fields = append(fields, taskattachment.FieldTaskID)
+	}
+	if m.addprocess_instance_id != nil {
+		fields = append(fields, taskattachment.FieldProcessInstanceID)
+	}
+	if m.addsize != nil {
+		fields = append(fields, taskattachment.FieldSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TaskAttachmentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case taskattachment.FieldTaskID:
+		return m.AddedTaskID()
+	case taskattachment.FieldProcessInstanceID:
+		return m.AddedProcessInstanceID()
+	case taskattachment.FieldSize:
+		return m.AddedSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskAttachmentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case taskattachment.FieldTaskID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTaskID(v)
+		return nil
+	case taskattachment.FieldProcessInstanceID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProcessInstanceID(v)
+		return nil
+	case taskattachment.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TaskAttachment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TaskAttachmentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(taskattachment.FieldProcessInstanceID) {
+		fields = append(fields, taskattachment.FieldProcessInstanceID)
+	}
+	if m.FieldCleared(taskattachment.FieldUserID) {
+		fields = append(fields, taskattachment.FieldUserID)
+	}
+	if m.FieldCleared(taskattachment.FieldDescription) {
+		fields = append(fields, taskattachment.FieldDescription)
+	}
+	if m.FieldCleared(taskattachment.FieldContentType) {
+		fields = append(fields, taskattachment.FieldContentType)
+	}
+	if m.FieldCleared(taskattachment.FieldLocation) {
+		fields = append(fields, taskattachment.FieldLocation)
+	}
+	if m.FieldCleared(taskattachment.FieldContent) {
+		fields = append(fields, taskattachment.FieldContent)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TaskAttachmentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TaskAttachmentMutation) ClearField(name string) error {
+	switch name {
+	case taskattachment.FieldProcessInstanceID:
+		m.ClearProcessInstanceID()
+		return nil
+	case taskattachment.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case taskattachment.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case taskattachment.FieldContentType:
+		m.ClearContentType()
+		return nil
+	case taskattachment.FieldLocation:
+		m.ClearLocation()
+		return nil
+	case taskattachment.FieldContent:
+		m.ClearContent()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskAttachment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TaskAttachmentMutation) ResetField(name string) error {
+	switch name {
+	case taskattachment.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case taskattachment.FieldProcessInstanceID:
+		m.ResetProcessInstanceID()
+		return nil
+	case taskattachment.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case taskattachment.FieldName:
+		m.ResetName()
+		return nil
+	case taskattachment.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case taskattachment.FieldContentType:
+		m.ResetContentType()
+		return nil
+	case taskattachment.FieldSize:
+		m.ResetSize()
+		return nil
+	case taskattachment.FieldStorage:
+		m.ResetStorage()
+		return nil
+	case taskattachment.FieldLocation:
+		m.ResetLocation()
+		return nil
+	case taskattachment.FieldContent:
+		m.ResetContent()
+		return nil
+	case taskattachment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TaskAttachment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TaskAttachmentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TaskAttachmentMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TaskAttachmentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TaskAttachmentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TaskAttachmentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TaskAttachmentMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TaskAttachmentMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TaskAttachment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TaskAttachmentMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TaskAttachment edge %s", name)
+}
+
+// TaskCommentMutation represents an operation that mutates the TaskComment nodes in the graph.
+type TaskCommentMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int64
+	task_id                *int64
+	addtask_id             *int64
+	process_instance_id    *int64
+	addprocess_instance_id *int64
+	user_id                *string
+	_type                  *string
+	message                *string
+	created_at             *time.Time
+	clearedFields          map[string]struct{}
+	done                   bool
+	oldValue               func(context.Context) (*TaskComment, error)
+	predicates             []predicate.TaskComment
+}
+
+var _ ent.Mutation = (*TaskCommentMutation)(nil)
+
+// taskcommentOption allows management of the mutation configuration using functional options.
+type taskcommentOption func(*TaskCommentMutation)
+
+// newTaskCommentMutation creates new mutation for the TaskComment entity.
+func newTaskCommentMutation(c config, op Op, opts ...taskcommentOption) *TaskCommentMutation {
+	m := &TaskCommentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTaskComment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTaskCommentID sets the ID field of the mutation.
+func withTaskCommentID(id int64) taskcommentOption {
+	return func(m *TaskCommentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TaskComment
+		)
+		m.oldValue = func(ctx context.Context) (*TaskComment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TaskComment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTaskComment sets the old TaskComment of the mutation.
+func withTaskComment(node *TaskComment) taskcommentOption {
+	return func(m *TaskCommentMutation) {
+		m.oldValue = func(context.Context) (*TaskComment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskCommentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskCommentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TaskComment entities.
+func (m *TaskCommentMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskCommentMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskCommentMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TaskComment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *TaskCommentMutation) SetTaskID(i int64) {
+	m.task_id = &i
+	m.addtask_id = nil
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *TaskCommentMutation) TaskID() (r int64, exists bool) {
+	v := m.task_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the TaskComment entity.
+// If the TaskComment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskCommentMutation) OldTaskID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (m *TaskCommentMutation) AddTaskID(i int64) {
+	if m.addtask_id != nil {
+		*m.addtask_id += i
+	} else {
+		m.addtask_id = &i
+	}
+}
+
+// AddedTaskID returns the value that was added to the "task_id" field in this mutation.
+func (m *TaskCommentMutation) AddedTaskID() (r int64, exists bool) {
+	v := m.addtask_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *TaskCommentMutation) ResetTaskID() {
+	m.task_id = nil
+	m.addtask_id = nil
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (m *TaskCommentMutation) SetProcessInstanceID(i int64) {
+	m.process_instance_id = &i
+	m.addprocess_instance_id = nil
+}
+
+// ProcessInstanceID returns the value of the "process_instance_id" field in the mutation.
+func (m *TaskCommentMutation) ProcessInstanceID() (r int64, exists bool) {
+	v := m.process_instance_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessInstanceID returns the old "process_instance_id" field's value of the TaskComment entity.
+// If the TaskComment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskCommentMutation) OldProcessInstanceID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessInstanceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessInstanceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessInstanceID: %w", err)
+	}
+	return oldValue.ProcessInstanceID, nil
+}
+
+// AddProcessInstanceID adds i to the "process_instance_id" field.
+func (m *TaskCommentMutation) AddProcessInstanceID(i int64) {
+	if m.addprocess_instance_id != nil {
+		*m.addprocess_instance_id += i
+	} else {
+		m.addprocess_instance_id = &i
+	}
+}
+
+// AddedProcessInstanceID returns the value that was added to the "process_instance_id" field in this mutation.
+func (m *TaskCommentMutation) AddedProcessInstanceID() (r int64, exists bool) {
+	v := m.addprocess_instance_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearProcessInstanceID clears the value of the "process_instance_id" field.
+func (m *TaskCommentMutation) ClearProcessInstanceID() {
+	m.process_instance_id = nil
+	m.addprocess_instance_id = nil
+	m.clearedFields[taskcomment.FieldProcessInstanceID] = struct{}{}
+}
+
+// ProcessInstanceIDCleared returns if the "process_instance_id" field was cleared in this mutation.
+func (m *TaskCommentMutation) ProcessInstanceIDCleared() bool {
+	_, ok := m.clearedFields[taskcomment.FieldProcessInstanceID]
+	return ok
+}
+
+// ResetProcessInstanceID resets all changes to the "process_instance_id" field.
+func (m *TaskCommentMutation) ResetProcessInstanceID() {
+	m.process_instance_id = nil
+	m.addprocess_instance_id = nil
+	delete(m.clearedFields, taskcomment.FieldProcessInstanceID)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *TaskCommentMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *TaskCommentMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the TaskComment entity.
+// If the TaskComment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskCommentMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *TaskCommentMutation) ClearUserID() {
+	m.user_id = nil
+	m.clearedFields[taskcomment.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *TaskCommentMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[taskcomment.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *TaskCommentMutation) ResetUserID() {
+	m.user_id = nil
+	delete(m.clearedFields, taskcomment.FieldUserID)
+}
+
+// SetType sets the "type" field.
+func (m *TaskCommentMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *TaskCommentMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the TaskComment entity.
+// If the TaskComment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskCommentMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *TaskCommentMutation) ResetType() {
+	m._type = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *TaskCommentMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *TaskCommentMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the TaskComment entity.
+// If the TaskComment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskCommentMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *TaskCommentMutation) ResetMessage() {
+	m.message = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TaskCommentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TaskCommentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TaskComment entity.
+// If the TaskComment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskCommentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TaskCommentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the TaskCommentMutation builder.
+func (m *TaskCommentMutation) Where(ps ...predicate.TaskComment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TaskCommentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaskCommentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TaskComment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TaskCommentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TaskCommentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TaskComment).
+func (m *TaskCommentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TaskCommentMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.task_id != nil {
+		fields = append(fields, taskcomment.FieldTaskID)
+	}
+	if m.process_instance_id != nil {
+		fields = append(fields, taskcomment.FieldProcessInstanceID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, taskcomment.FieldUserID)
+	}
+	if m._type != nil {
+		fields = append(fields, taskcomment.FieldType)
+	}
+	if m.message != nil {
+		fields = append(fields, taskcomment.FieldMessage)
+	}
+	if m.created_at != nil {
+		fields = append(fields, taskcomment.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TaskCommentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case taskcomment.FieldTaskID:
+		return m.TaskID()
+	case taskcomment.FieldProcessInstanceID:
+		return m.ProcessInstanceID()
+	case taskcomment.FieldUserID:
+		return m.UserID()
+	case taskcomment.FieldType:
+		return m.GetType()
+	case taskcomment.FieldMessage:
+		return m.Message()
+	case taskcomment.FieldCreatedAt:
 		return m.CreatedAt()
-	case processinstance.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -6423,240 +13374,84 @@ func (m *ProcessInstanceMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProcessInstanceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TaskCommentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case processinstance.FieldBusinessKey:
-		return m.OldBusinessKey(ctx)
-	case processinstance.FieldProcessDefinitionID:
-		return m.OldProcessDefinitionID(ctx)
-	case processinstance.FieldProcessDefinitionKey:
-		return m.OldProcessDefinitionKey(ctx)
-	case processinstance.FieldProcessDefinitionName:
-		return m.OldProcessDefinitionName(ctx)
-	case processinstance.FieldProcessDefinitionVersion:
-		return m.OldProcessDefinitionVersion(ctx)
-	case processinstance.FieldDeploymentID:
-		return m.OldDeploymentID(ctx)
-	case processinstance.FieldStartUserID:
-		return m.OldStartUserID(ctx)
-	case processinstance.FieldStartTime:
-		return m.OldStartTime(ctx)
-	case processinstance.FieldEndTime:
-		return m.OldEndTime(ctx)
-	case processinstance.FieldDuration:
-		return m.OldDuration(ctx)
-	case processinstance.FieldDeleteReason:
-		return m.OldDeleteReason(ctx)
-	case processinstance.FieldSuperProcessInstanceID:
-		return m.OldSuperProcessInstanceID(ctx)
-	case processinstance.FieldRootProcessInstanceID:
-		return m.OldRootProcessInstanceID(ctx)
-	case processinstance.FieldSuspended:
-		return m.OldSuspended(ctx)
-	case processinstance.FieldTenantID:
-		return m.OldTenantID(ctx)
-	case processinstance.FieldName:
-		return m.OldName(ctx)
-	case processinstance.FieldDescription:
-		return m.OldDescription(ctx)
-	case processinstance.FieldCallbackID:
-		return m.OldCallbackID(ctx)
-	case processinstance.FieldCallbackType:
-		return m.OldCallbackType(ctx)
-	case processinstance.FieldReferenceID:
-		return m.OldReferenceID(ctx)
-	case processinstance.FieldReferenceType:
-		return m.OldReferenceType(ctx)
-	case processinstance.FieldCreatedAt:
+	case taskcomment.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case taskcomment.FieldProcessInstanceID:
+		return m.OldProcessInstanceID(ctx)
+	case taskcomment.FieldUserID:
+		return m.OldUserID(ctx)
+	case taskcomment.FieldType:
+		return m.OldType(ctx)
+	case taskcomment.FieldMessage:
+		return m.OldMessage(ctx)
+	case taskcomment.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case processinstance.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ProcessInstance field %s", name)
+	return nil, fmt.Errorf("unknown TaskComment field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProcessInstanceMutation) SetField(name string, value ent.Value) error {
+func (m *TaskCommentMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case processinstance.FieldBusinessKey:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBusinessKey(v)
-		return nil
-	case processinstance.FieldProcessDefinitionID:
+	case taskcomment.FieldTaskID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProcessDefinitionID(v)
-		return nil
-	case processinstance.FieldProcessDefinitionKey:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProcessDefinitionKey(v)
-		return nil
-	case processinstance.FieldProcessDefinitionName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProcessDefinitionName(v)
-		return nil
-	case processinstance.FieldProcessDefinitionVersion:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProcessDefinitionVersion(v)
-		return nil
-	case processinstance.FieldDeploymentID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeploymentID(v)
-		return nil
-	case processinstance.FieldStartUserID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartUserID(v)
-		return nil
-	case processinstance.FieldStartTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartTime(v)
-		return nil
-	case processinstance.FieldEndTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEndTime(v)
+		m.SetTaskID(v)
 		return nil
-	case processinstance.FieldDuration:
+	case taskcomment.FieldProcessInstanceID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDuration(v)
-		return nil
-	case processinstance.FieldDeleteReason:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeleteReason(v)
-		return nil
-	case processinstance.FieldSuperProcessInstanceID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSuperProcessInstanceID(v)
-		return nil
-	case processinstance.FieldRootProcessInstanceID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRootProcessInstanceID(v)
-		return nil
-	case processinstance.FieldSuspended:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSuspended(v)
-		return nil
-	case processinstance.FieldTenantID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTenantID(v)
-		return nil
-	case processinstance.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case processinstance.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case processinstance.FieldCallbackID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCallbackID(v)
+		m.SetProcessInstanceID(v)
 		return nil
-	case processinstance.FieldCallbackType:
+	case taskcomment.FieldUserID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCallbackType(v)
+		m.SetUserID(v)
 		return nil
-	case processinstance.FieldReferenceID:
+	case taskcomment.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReferenceID(v)
+		m.SetType(v)
 		return nil
-	case processinstance.FieldReferenceType:
+	case taskcomment.FieldMessage:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReferenceType(v)
+		m.SetMessage(v)
 		return nil
-	case processinstance.FieldCreatedAt:
+	case taskcomment.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case processinstance.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown ProcessInstance field %s", name)
+	return fmt.Errorf("unknown TaskComment field %s", name)
 }
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ProcessInstanceMutation) AddedFields() []string {
-	var fields []string
-	if m.addprocess_definition_id != nil {
-		fields = append(fields, processinstance.FieldProcessDefinitionID)
-	}
-	if m.addprocess_definition_version != nil {
-		fields = append(fields, processinstance.FieldProcessDefinitionVersion)
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TaskCommentMutation) AddedFields() []string {
+	var fields []string
+	if m.addtask_id != nil {
+		fields = append(fields, taskcomment.FieldTaskID)
 	}
-	if m.addduration != nil {
-		fields = append(fields, processinstance.FieldDuration)
+	if m.addprocess_instance_id != nil {
+		fields = append(fields, taskcomment.FieldProcessInstanceID)
 	}
 	return fields
 }
@@ -6664,14 +13459,12 @@ func (m *ProcessInstanceMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProcessInstanceMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TaskCommentMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case processinstance.FieldProcessDefinitionID:
-		return m.AddedProcessDefinitionID()
-	case processinstance.FieldProcessDefinitionVersion:
-		return m.AddedProcessDefinitionVersion()
-	case processinstance.FieldDuration:
-		return m.AddedDuration()
+	case taskcomment.FieldTaskID:
+		return m.AddedTaskID()
+	case taskcomment.FieldProcessInstanceID:
+		return m.AddedProcessInstanceID()
 	}
 	return nil, false
 }
@@ -6679,3341 +13472,3839 @@ func (m *ProcessInstanceMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProcessInstanceMutation) AddField(name string, value ent.Value) error {
+func (m *TaskCommentMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case processinstance.FieldProcessDefinitionID:
+	case taskcomment.FieldTaskID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddProcessDefinitionID(v)
-		return nil
-	case processinstance.FieldProcessDefinitionVersion:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddProcessDefinitionVersion(v)
+		m.AddTaskID(v)
 		return nil
-	case processinstance.FieldDuration:
+	case taskcomment.FieldProcessInstanceID:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddDuration(v)
+		m.AddProcessInstanceID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ProcessInstance numeric field %s", name)
+	return fmt.Errorf("unknown TaskComment numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProcessInstanceMutation) ClearedFields() []string {
+func (m *TaskCommentMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(processinstance.FieldBusinessKey) {
-		fields = append(fields, processinstance.FieldBusinessKey)
-	}
-	if m.FieldCleared(processinstance.FieldProcessDefinitionName) {
-		fields = append(fields, processinstance.FieldProcessDefinitionName)
-	}
-	if m.FieldCleared(processinstance.FieldDeploymentID) {
-		fields = append(fields, processinstance.FieldDeploymentID)
-	}
-	if m.FieldCleared(processinstance.FieldStartUserID) {
-		fields = append(fields, processinstance.FieldStartUserID)
-	}
-	if m.FieldCleared(processinstance.FieldEndTime) {
-		fields = append(fields, processinstance.FieldEndTime)
-	}
-	if m.FieldCleared(processinstance.FieldDuration) {
-		fields = append(fields, processinstance.FieldDuration)
-	}
-	if m.FieldCleared(processinstance.FieldDeleteReason) {
-		fields = append(fields, processinstance.FieldDeleteReason)
-	}
-	if m.FieldCleared(processinstance.FieldSuperProcessInstanceID) {
-		fields = append(fields, processinstance.FieldSuperProcessInstanceID)
-	}
-	if m.FieldCleared(processinstance.FieldRootProcessInstanceID) {
-		fields = append(fields, processinstance.FieldRootProcessInstanceID)
-	}
-	if m.FieldCleared(processinstance.FieldName) {
-		fields = append(fields, processinstance.FieldName)
-	}
-	if m.FieldCleared(processinstance.FieldDescription) {
-		fields = append(fields, processinstance.FieldDescription)
-	}
-	if m.FieldCleared(processinstance.FieldCallbackID) {
-		fields = append(fields, processinstance.FieldCallbackID)
-	}
-	if m.FieldCleared(processinstance.FieldCallbackType) {
-		fields = append(fields, processinstance.FieldCallbackType)
-	}
-	if m.FieldCleared(processinstance.FieldReferenceID) {
-		fields = append(fields, processinstance.FieldReferenceID)
+	if m.FieldCleared(taskcomment.FieldProcessInstanceID) {
+		fields = append(fields, taskcomment.FieldProcessInstanceID)
 	}
-	if m.FieldCleared(processinstance.FieldReferenceType) {
-		fields = append(fields, processinstance.FieldReferenceType)
+	if m.FieldCleared(taskcomment.FieldUserID) {
+		fields = append(fields, taskcomment.FieldUserID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProcessInstanceMutation) FieldCleared(name string) bool {
+func (m *TaskCommentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProcessInstanceMutation) ClearField(name string) error {
+func (m *TaskCommentMutation) ClearField(name string) error {
 	switch name {
-	case processinstance.FieldBusinessKey:
-		m.ClearBusinessKey()
-		return nil
-	case processinstance.FieldProcessDefinitionName:
-		m.ClearProcessDefinitionName()
-		return nil
-	case processinstance.FieldDeploymentID:
-		m.ClearDeploymentID()
-		return nil
-	case processinstance.FieldStartUserID:
-		m.ClearStartUserID()
-		return nil
-	case processinstance.FieldEndTime:
-		m.ClearEndTime()
-		return nil
-	case processinstance.FieldDuration:
-		m.ClearDuration()
-		return nil
-	case processinstance.FieldDeleteReason:
-		m.ClearDeleteReason()
-		return nil
-	case processinstance.FieldSuperProcessInstanceID:
-		m.ClearSuperProcessInstanceID()
+	case taskcomment.FieldProcessInstanceID:
+		m.ClearProcessInstanceID()
 		return nil
-	case processinstance.FieldRootProcessInstanceID:
-		m.ClearRootProcessInstanceID()
+	case taskcomment.FieldUserID:
+		m.ClearUserID()
 		return nil
-	case processinstance.FieldName:
-		m.ClearName()
+	}
+	return fmt.Errorf("unknown TaskComment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TaskCommentMutation) ResetField(name string) error {
+	switch name {
+	case taskcomment.FieldTaskID:
+		m.ResetTaskID()
 		return nil
-	case processinstance.FieldDescription:
-		m.ClearDescription()
+	case taskcomment.FieldProcessInstanceID:
+		m.ResetProcessInstanceID()
 		return nil
-	case processinstance.FieldCallbackID:
-		m.ClearCallbackID()
+	case taskcomment.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case processinstance.FieldCallbackType:
-		m.ClearCallbackType()
+	case taskcomment.FieldType:
+		m.ResetType()
 		return nil
-	case processinstance.FieldReferenceID:
-		m.ClearReferenceID()
+	case taskcomment.FieldMessage:
+		m.ResetMessage()
 		return nil
-	case processinstance.FieldReferenceType:
-		m.ClearReferenceType()
+	case taskcomment.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ProcessInstance nullable field %s", name)
+	return fmt.Errorf("unknown TaskComment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TaskCommentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TaskCommentMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TaskCommentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TaskCommentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TaskCommentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TaskCommentMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TaskCommentMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TaskComment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TaskCommentMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TaskComment edge %s", name)
+}
+
+// TaskIdentityLinkMutation represents an operation that mutates the TaskIdentityLink nodes in the graph.
+type TaskIdentityLinkMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int64
+	task_id                *int64
+	addtask_id             *int64
+	process_instance_id    *int64
+	addprocess_instance_id *int64
+	_type                  *string
+	user_id                *string
+	group_id               *string
+	created_at             *time.Time
+	clearedFields          map[string]struct{}
+	done                   bool
+	oldValue               func(context.Context) (*TaskIdentityLink, error)
+	predicates             []predicate.TaskIdentityLink
+}
+
+var _ ent.Mutation = (*TaskIdentityLinkMutation)(nil)
+
+// taskidentitylinkOption allows management of the mutation configuration using functional options.
+type taskidentitylinkOption func(*TaskIdentityLinkMutation)
+
+// newTaskIdentityLinkMutation creates new mutation for the TaskIdentityLink entity.
+func newTaskIdentityLinkMutation(c config, op Op, opts ...taskidentitylinkOption) *TaskIdentityLinkMutation {
+	m := &TaskIdentityLinkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTaskIdentityLink,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTaskIdentityLinkID sets the ID field of the mutation.
+func withTaskIdentityLinkID(id int64) taskidentitylinkOption {
+	return func(m *TaskIdentityLinkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TaskIdentityLink
+		)
+		m.oldValue = func(ctx context.Context) (*TaskIdentityLink, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TaskIdentityLink.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTaskIdentityLink sets the old TaskIdentityLink of the mutation.
+func withTaskIdentityLink(node *TaskIdentityLink) taskidentitylinkOption {
+	return func(m *TaskIdentityLinkMutation) {
+		m.oldValue = func(context.Context) (*TaskIdentityLink, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskIdentityLinkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskIdentityLinkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TaskIdentityLink entities.
+func (m *TaskIdentityLinkMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskIdentityLinkMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskIdentityLinkMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TaskIdentityLink.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *TaskIdentityLinkMutation) SetTaskID(i int64) {
+	m.task_id = &i
+	m.addtask_id = nil
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *TaskIdentityLinkMutation) TaskID() (r int64, exists bool) {
+	v := m.task_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ProcessInstanceMutation) ResetField(name string) error {
-	switch name {
-	case processinstance.FieldBusinessKey:
-		m.ResetBusinessKey()
-		return nil
-	case processinstance.FieldProcessDefinitionID:
-		m.ResetProcessDefinitionID()
-		return nil
-	case processinstance.FieldProcessDefinitionKey:
-		m.ResetProcessDefinitionKey()
-		return nil
-	case processinstance.FieldProcessDefinitionName:
-		m.ResetProcessDefinitionName()
-		return nil
-	case processinstance.FieldProcessDefinitionVersion:
-		m.ResetProcessDefinitionVersion()
-		return nil
-	case processinstance.FieldDeploymentID:
-		m.ResetDeploymentID()
-		return nil
-	case processinstance.FieldStartUserID:
-		m.ResetStartUserID()
-		return nil
-	case processinstance.FieldStartTime:
-		m.ResetStartTime()
-		return nil
-	case processinstance.FieldEndTime:
-		m.ResetEndTime()
-		return nil
-	case processinstance.FieldDuration:
-		m.ResetDuration()
-		return nil
-	case processinstance.FieldDeleteReason:
-		m.ResetDeleteReason()
-		return nil
-	case processinstance.FieldSuperProcessInstanceID:
-		m.ResetSuperProcessInstanceID()
-		return nil
-	case processinstance.FieldRootProcessInstanceID:
-		m.ResetRootProcessInstanceID()
-		return nil
-	case processinstance.FieldSuspended:
-		m.ResetSuspended()
-		return nil
-	case processinstance.FieldTenantID:
-		m.ResetTenantID()
-		return nil
-	case processinstance.FieldName:
-		m.ResetName()
-		return nil
-	case processinstance.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case processinstance.FieldCallbackID:
-		m.ResetCallbackID()
-		return nil
-	case processinstance.FieldCallbackType:
-		m.ResetCallbackType()
-		return nil
-	case processinstance.FieldReferenceID:
-		m.ResetReferenceID()
-		return nil
-	case processinstance.FieldReferenceType:
-		m.ResetReferenceType()
-		return nil
-	case processinstance.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case processinstance.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
+// OldTaskID returns the old "task_id" field's value of the TaskIdentityLink entity.
+// If the TaskIdentityLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskIdentityLinkMutation) OldTaskID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown ProcessInstance field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProcessInstanceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// AddTaskID adds i to the "task_id" field.
+func (m *TaskIdentityLinkMutation) AddTaskID(i int64) {
+	if m.addtask_id != nil {
+		*m.addtask_id += i
+	} else {
+		m.addtask_id = &i
+	}
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ProcessInstanceMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// AddedTaskID returns the value that was added to the "task_id" field in this mutation.
+func (m *TaskIdentityLinkMutation) AddedTaskID() (r int64, exists bool) {
+	v := m.addtask_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProcessInstanceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *TaskIdentityLinkMutation) ResetTaskID() {
+	m.task_id = nil
+	m.addtask_id = nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ProcessInstanceMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (m *TaskIdentityLinkMutation) SetProcessInstanceID(i int64) {
+	m.process_instance_id = &i
+	m.addprocess_instance_id = nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProcessInstanceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ProcessInstanceID returns the value of the "process_instance_id" field in the mutation.
+func (m *TaskIdentityLinkMutation) ProcessInstanceID() (r int64, exists bool) {
+	v := m.process_instance_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ProcessInstanceMutation) EdgeCleared(name string) bool {
-	return false
+// OldProcessInstanceID returns the old "process_instance_id" field's value of the TaskIdentityLink entity.
+// If the TaskIdentityLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskIdentityLinkMutation) OldProcessInstanceID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessInstanceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessInstanceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessInstanceID: %w", err)
+	}
+	return oldValue.ProcessInstanceID, nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ProcessInstanceMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ProcessInstance unique edge %s", name)
+// AddProcessInstanceID adds i to the "process_instance_id" field.
+func (m *TaskIdentityLinkMutation) AddProcessInstanceID(i int64) {
+	if m.addprocess_instance_id != nil {
+		*m.addprocess_instance_id += i
+	} else {
+		m.addprocess_instance_id = &i
+	}
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ProcessInstanceMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ProcessInstance edge %s", name)
+// AddedProcessInstanceID returns the value that was added to the "process_instance_id" field in this mutation.
+func (m *TaskIdentityLinkMutation) AddedProcessInstanceID() (r int64, exists bool) {
+	v := m.addprocess_instance_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ProcessVariableMutation represents an operation that mutates the ProcessVariable nodes in the graph.
-type ProcessVariableMutation struct {
-	config
-	op                       Op
-	typ                      string
-	id                       *int64
-	name                     *string
-	_type                    *string
-	text_value               *string
-	text_value2              *string
-	long_value               *int64
-	addlong_value            *int64
-	double_value             *float64
-	adddouble_value          *float64
-	byte_array_value         *[]byte
-	execution_id             *string
-	process_instance_id      *int64
-	addprocess_instance_id   *int64
-	process_definition_id    *int64
-	addprocess_definition_id *int64
-	case_execution_id        *string
-	case_instance_id         *string
-	task_id                  *int64
-	addtask_id               *int64
-	activity_instance_id     *string
-	tenant_id                *string
-	sequence_counter         *int32
-	addsequence_counter      *int32
-	concurrent_local         *bool
-	scope_id                 *string
-	scope_type               *string
-	created_at               *time.Time
-	updated_at               *time.Time
-	clearedFields            map[string]struct{}
-	done                     bool
-	oldValue                 func(context.Context) (*ProcessVariable, error)
-	predicates               []predicate.ProcessVariable
+// ClearProcessInstanceID clears the value of the "process_instance_id" field.
+func (m *TaskIdentityLinkMutation) ClearProcessInstanceID() {
+	m.process_instance_id = nil
+	m.addprocess_instance_id = nil
+	m.clearedFields[taskidentitylink.FieldProcessInstanceID] = struct{}{}
 }
 
-var _ ent.Mutation = (*ProcessVariableMutation)(nil)
+// ProcessInstanceIDCleared returns if the "process_instance_id" field was cleared in this mutation.
+func (m *TaskIdentityLinkMutation) ProcessInstanceIDCleared() bool {
+	_, ok := m.clearedFields[taskidentitylink.FieldProcessInstanceID]
+	return ok
+}
 
-// processvariableOption allows management of the mutation configuration using functional options.
-type processvariableOption func(*ProcessVariableMutation)
+// ResetProcessInstanceID resets all changes to the "process_instance_id" field.
+func (m *TaskIdentityLinkMutation) ResetProcessInstanceID() {
+	m.process_instance_id = nil
+	m.addprocess_instance_id = nil
+	delete(m.clearedFields, taskidentitylink.FieldProcessInstanceID)
+}
 
-// newProcessVariableMutation creates new mutation for the ProcessVariable entity.
-func newProcessVariableMutation(c config, op Op, opts ...processvariableOption) *ProcessVariableMutation {
-	m := &ProcessVariableMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeProcessVariable,
-		clearedFields: make(map[string]struct{}),
+// SetType sets the "type" field.
+func (m *TaskIdentityLinkMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *TaskIdentityLinkMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
 	}
-	for _, opt := range opts {
-		opt(m)
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the TaskIdentityLink entity.
+// If the TaskIdentityLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskIdentityLinkMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
-	return m
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *TaskIdentityLinkMutation) ResetType() {
+	m._type = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *TaskIdentityLinkMutation) SetUserID(s string) {
+	m.user_id = &s
 }
 
-// withProcessVariableID sets the ID field of the mutation.
-func withProcessVariableID(id int64) processvariableOption {
-	return func(m *ProcessVariableMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *ProcessVariable
-		)
-		m.oldValue = func(ctx context.Context) (*ProcessVariable, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().ProcessVariable.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *TaskIdentityLinkMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withProcessVariable sets the old ProcessVariable of the mutation.
-func withProcessVariable(node *ProcessVariable) processvariableOption {
-	return func(m *ProcessVariableMutation) {
-		m.oldValue = func(context.Context) (*ProcessVariable, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldUserID returns the old "user_id" field's value of the TaskIdentityLink entity.
+// If the TaskIdentityLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskIdentityLinkMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
+	return oldValue.UserID, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProcessVariableMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ClearUserID clears the value of the "user_id" field.
+func (m *TaskIdentityLinkMutation) ClearUserID() {
+	m.user_id = nil
+	m.clearedFields[taskidentitylink.FieldUserID] = struct{}{}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ProcessVariableMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *TaskIdentityLinkMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[taskidentitylink.FieldUserID]
+	return ok
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ProcessVariable entities.
-func (m *ProcessVariableMutation) SetID(id int64) {
-	m.id = &id
+// ResetUserID resets all changes to the "user_id" field.
+func (m *TaskIdentityLinkMutation) ResetUserID() {
+	m.user_id = nil
+	delete(m.clearedFields, taskidentitylink.FieldUserID)
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ProcessVariableMutation) ID() (id int64, exists bool) {
-	if m.id == nil {
+// SetGroupID sets the "group_id" field.
+func (m *TaskIdentityLinkMutation) SetGroupID(s string) {
+	m.group_id = &s
+}
+
+// GroupID returns the value of the "group_id" field in the mutation.
+func (m *TaskIdentityLinkMutation) GroupID() (r string, exists bool) {
+	v := m.group_id
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ProcessVariableMutation) IDs(ctx context.Context) ([]int64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ProcessVariable.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldGroupID returns the old "group_id" field's value of the TaskIdentityLink entity.
+// If the TaskIdentityLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskIdentityLinkMutation) OldGroupID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroupID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
+	}
+	return oldValue.GroupID, nil
 }
 
-// SetName sets the "name" field.
-func (m *ProcessVariableMutation) SetName(s string) {
-	m.name = &s
+// ClearGroupID clears the value of the "group_id" field.
+func (m *TaskIdentityLinkMutation) ClearGroupID() {
+	m.group_id = nil
+	m.clearedFields[taskidentitylink.FieldGroupID] = struct{}{}
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ProcessVariableMutation) Name() (r string, exists bool) {
-	v := m.name
+// GroupIDCleared returns if the "group_id" field was cleared in this mutation.
+func (m *TaskIdentityLinkMutation) GroupIDCleared() bool {
+	_, ok := m.clearedFields[taskidentitylink.FieldGroupID]
+	return ok
+}
+
+// ResetGroupID resets all changes to the "group_id" field.
+func (m *TaskIdentityLinkMutation) ResetGroupID() {
+	m.group_id = nil
+	delete(m.clearedFields, taskidentitylink.FieldGroupID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TaskIdentityLinkMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TaskIdentityLinkMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the ProcessVariable entity.
-// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the TaskIdentityLink entity.
+// If the TaskIdentityLink object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessVariableMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TaskIdentityLinkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ProcessVariableMutation) ResetName() {
-	m.name = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TaskIdentityLinkMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetType sets the "type" field.
-func (m *ProcessVariableMutation) SetType(s string) {
-	m._type = &s
+// Where appends a list predicates to the TaskIdentityLinkMutation builder.
+func (m *TaskIdentityLinkMutation) Where(ps ...predicate.TaskIdentityLink) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TaskIdentityLinkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaskIdentityLinkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TaskIdentityLink, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TaskIdentityLinkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TaskIdentityLinkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TaskIdentityLink).
+func (m *TaskIdentityLinkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TaskIdentityLinkMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.task_id != nil {
+		fields = append(fields, taskidentitylink.FieldTaskID)
+	}
+	if m.process_instance_id != nil {
+		fields = append(fields, taskidentitylink.FieldProcessInstanceID)
+	}
+	if m._type != nil {
+		fields = append(fields, taskidentitylink.FieldType)
+	}
+	if m.user_id != nil {
+		fields = append(fields, taskidentitylink.FieldUserID)
+	}
+	if m.group_id != nil {
+		fields = append(fields, taskidentitylink.FieldGroupID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, taskidentitylink.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TaskIdentityLinkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case taskidentitylink.FieldTaskID:
+		return m.TaskID()
+	case taskidentitylink.FieldProcessInstanceID:
+		return m.ProcessInstanceID()
+	case taskidentitylink.FieldType:
+		return m.GetType()
+	case taskidentitylink.FieldUserID:
+		return m.UserID()
+	case taskidentitylink.FieldGroupID:
+		return m.GroupID()
+	case taskidentitylink.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TaskIdentityLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case taskidentitylink.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case taskidentitylink.FieldProcessInstanceID:
+		return m.OldProcessInstanceID(ctx)
+	case taskidentitylink.FieldType:
+		return m.OldType(ctx)
+	case taskidentitylink.FieldUserID:
+		return m.OldUserID(ctx)
+	case taskidentitylink.FieldGroupID:
+		return m.OldGroupID(ctx)
+	case taskidentitylink.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TaskIdentityLink field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskIdentityLinkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case taskidentitylink.FieldTaskID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case taskidentitylink.FieldProcessInstanceID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessInstanceID(v)
+		return nil
+	case taskidentitylink.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case taskidentitylink.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case taskidentitylink.FieldGroupID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupID(v)
+		return nil
+	case taskidentitylink.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TaskIdentityLink field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TaskIdentityLinkMutation) AddedFields() []string {
+	var fields []string
+	if m.addtask_id != nil {
+		fields = append(fields, taskidentitylink.FieldTaskID)
+	}
+	if m.addprocess_instance_id != nil {
+		fields = append(fields, taskidentitylink.FieldProcessInstanceID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TaskIdentityLinkMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case taskidentitylink.FieldTaskID:
+		return m.AddedTaskID()
+	case taskidentitylink.FieldProcessInstanceID:
+		return m.AddedProcessInstanceID()
+	}
+	return nil, false
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *ProcessVariableMutation) GetType() (r string, exists bool) {
-	v := m._type
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskIdentityLinkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case taskidentitylink.FieldTaskID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTaskID(v)
+		return nil
+	case taskidentitylink.FieldProcessInstanceID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProcessInstanceID(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown TaskIdentityLink numeric field %s", name)
 }
 
-// OldType returns the old "type" field's value of the ProcessVariable entity.
-// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessVariableMutation) OldType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TaskIdentityLinkMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(taskidentitylink.FieldProcessInstanceID) {
+		fields = append(fields, taskidentitylink.FieldProcessInstanceID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+	if m.FieldCleared(taskidentitylink.FieldUserID) {
+		fields = append(fields, taskidentitylink.FieldUserID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	if m.FieldCleared(taskidentitylink.FieldGroupID) {
+		fields = append(fields, taskidentitylink.FieldGroupID)
 	}
-	return oldValue.Type, nil
-}
-
-// ResetType resets all changes to the "type" field.
-func (m *ProcessVariableMutation) ResetType() {
-	m._type = nil
+	return fields
 }
 
-// SetTextValue sets the "text_value" field.
-func (m *ProcessVariableMutation) SetTextValue(s string) {
-	m.text_value = &s
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TaskIdentityLinkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// TextValue returns the value of the "text_value" field in the mutation.
-func (m *ProcessVariableMutation) TextValue() (r string, exists bool) {
-	v := m.text_value
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TaskIdentityLinkMutation) ClearField(name string) error {
+	switch name {
+	case taskidentitylink.FieldProcessInstanceID:
+		m.ClearProcessInstanceID()
+		return nil
+	case taskidentitylink.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case taskidentitylink.FieldGroupID:
+		m.ClearGroupID()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown TaskIdentityLink nullable field %s", name)
 }
 
-// OldTextValue returns the old "text_value" field's value of the ProcessVariable entity.
-// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessVariableMutation) OldTextValue(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTextValue is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTextValue requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTextValue: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TaskIdentityLinkMutation) ResetField(name string) error {
+	switch name {
+	case taskidentitylink.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case taskidentitylink.FieldProcessInstanceID:
+		m.ResetProcessInstanceID()
+		return nil
+	case taskidentitylink.FieldType:
+		m.ResetType()
+		return nil
+	case taskidentitylink.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case taskidentitylink.FieldGroupID:
+		m.ResetGroupID()
+		return nil
+	case taskidentitylink.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
 	}
-	return oldValue.TextValue, nil
+	return fmt.Errorf("unknown TaskIdentityLink field %s", name)
 }
 
-// ClearTextValue clears the value of the "text_value" field.
-func (m *ProcessVariableMutation) ClearTextValue() {
-	m.text_value = nil
-	m.clearedFields[processvariable.FieldTextValue] = struct{}{}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TaskIdentityLinkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// TextValueCleared returns if the "text_value" field was cleared in this mutation.
-func (m *ProcessVariableMutation) TextValueCleared() bool {
-	_, ok := m.clearedFields[processvariable.FieldTextValue]
-	return ok
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TaskIdentityLinkMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetTextValue resets all changes to the "text_value" field.
-func (m *ProcessVariableMutation) ResetTextValue() {
-	m.text_value = nil
-	delete(m.clearedFields, processvariable.FieldTextValue)
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TaskIdentityLinkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetTextValue2 sets the "text_value2" field.
-func (m *ProcessVariableMutation) SetTextValue2(s string) {
-	m.text_value2 = &s
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TaskIdentityLinkMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// TextValue2 returns the value of the "text_value2" field in the mutation.
-func (m *ProcessVariableMutation) TextValue2() (r string, exists bool) {
-	v := m.text_value2
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TaskIdentityLinkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldTextValue2 returns the old "text_value2" field's value of the ProcessVariable entity.
-// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessVariableMutation) OldTextValue2(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTextValue2 is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTextValue2 requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTextValue2: %w", err)
-	}
-	return oldValue.TextValue2, nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TaskIdentityLinkMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// ClearTextValue2 clears the value of the "text_value2" field.
-func (m *ProcessVariableMutation) ClearTextValue2() {
-	m.text_value2 = nil
-	m.clearedFields[processvariable.FieldTextValue2] = struct{}{}
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TaskIdentityLinkMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TaskIdentityLink unique edge %s", name)
 }
 
-// TextValue2Cleared returns if the "text_value2" field was cleared in this mutation.
-func (m *ProcessVariableMutation) TextValue2Cleared() bool {
-	_, ok := m.clearedFields[processvariable.FieldTextValue2]
-	return ok
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TaskIdentityLinkMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TaskIdentityLink edge %s", name)
 }
 
-// ResetTextValue2 resets all changes to the "text_value2" field.
-func (m *ProcessVariableMutation) ResetTextValue2() {
-	m.text_value2 = nil
-	delete(m.clearedFields, processvariable.FieldTextValue2)
+// TaskInstanceMutation represents an operation that mutates the TaskInstance nodes in the graph.
+type TaskInstanceMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *int64
+	name                     *string
+	description              *string
+	task_definition_key      *string
+	assignee                 *string
+	owner                    *string
+	delegation               *string
+	status                   *string
+	priority                 *int32
+	addpriority              *int32
+	create_time              *time.Time
+	claim_time               *time.Time
+	end_time                 *time.Time
+	duration                 *int64
+	addduration              *int64
+	delete_reason            *string
+	due_date                 *time.Time
+	follow_up_date           *time.Time
+	form_key                 *string
+	category                 *string
+	parent_task_id           *string
+	wait_for_subtasks        *bool
+	execution_id             *string
+	process_instance_id      *int64
+	addprocess_instance_id   *int64
+	process_definition_id    *int64
+	addprocess_definition_id *int64
+	process_definition_key   *string
+	case_execution_id        *string
+	case_instance_id         *string
+	case_definition_id       *string
+	suspended                *bool
+	tenant_id                *string
+	created_at               *time.Time
+	updated_at               *time.Time
+	clearedFields            map[string]struct{}
+	done                     bool
+	oldValue                 func(context.Context) (*TaskInstance, error)
+	predicates               []predicate.TaskInstance
 }
 
-// SetLongValue sets the "long_value" field.
-func (m *ProcessVariableMutation) SetLongValue(i int64) {
-	m.long_value = &i
-	m.addlong_value = nil
-}
+var _ ent.Mutation = (*TaskInstanceMutation)(nil)
 
-// LongValue returns the value of the "long_value" field in the mutation.
-func (m *ProcessVariableMutation) LongValue() (r int64, exists bool) {
-	v := m.long_value
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+// taskinstanceOption allows management of the mutation configuration using functional options.
+type taskinstanceOption func(*TaskInstanceMutation)
 
-// OldLongValue returns the old "long_value" field's value of the ProcessVariable entity.
-// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessVariableMutation) OldLongValue(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLongValue is only allowed on UpdateOne operations")
+// newTaskInstanceMutation creates new mutation for the TaskInstance entity.
+func newTaskInstanceMutation(c config, op Op, opts ...taskinstanceOption) *TaskInstanceMutation {
+	m := &TaskInstanceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTaskInstance,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLongValue requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLongValue: %w", err)
+	return m
+}
+
+// withTaskInstanceID sets the ID field of the mutation.
+func withTaskInstanceID(id int64) taskinstanceOption {
+	return func(m *TaskInstanceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TaskInstance
+		)
+		m.oldValue = func(ctx context.Context) (*TaskInstance, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TaskInstance.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.LongValue, nil
 }
 
-// AddLongValue adds i to the "long_value" field.
-func (m *ProcessVariableMutation) AddLongValue(i int64) {
-	if m.addlong_value != nil {
-		*m.addlong_value += i
-	} else {
-		m.addlong_value = &i
+// withTaskInstance sets the old TaskInstance of the mutation.
+func withTaskInstance(node *TaskInstance) taskinstanceOption {
+	return func(m *TaskInstanceMutation) {
+		m.oldValue = func(context.Context) (*TaskInstance, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
 }
 
-// AddedLongValue returns the value that was added to the "long_value" field in this mutation.
-func (m *ProcessVariableMutation) AddedLongValue() (r int64, exists bool) {
-	v := m.addlong_value
-	if v == nil {
-		return
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskInstanceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskInstanceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return *v, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearLongValue clears the value of the "long_value" field.
-func (m *ProcessVariableMutation) ClearLongValue() {
-	m.long_value = nil
-	m.addlong_value = nil
-	m.clearedFields[processvariable.FieldLongValue] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TaskInstance entities.
+func (m *TaskInstanceMutation) SetID(id int64) {
+	m.id = &id
 }
 
-// LongValueCleared returns if the "long_value" field was cleared in this mutation.
-func (m *ProcessVariableMutation) LongValueCleared() bool {
-	_, ok := m.clearedFields[processvariable.FieldLongValue]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskInstanceMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetLongValue resets all changes to the "long_value" field.
-func (m *ProcessVariableMutation) ResetLongValue() {
-	m.long_value = nil
-	m.addlong_value = nil
-	delete(m.clearedFields, processvariable.FieldLongValue)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskInstanceMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TaskInstance.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetDoubleValue sets the "double_value" field.
-func (m *ProcessVariableMutation) SetDoubleValue(f float64) {
-	m.double_value = &f
-	m.adddouble_value = nil
+// SetName sets the "name" field.
+func (m *TaskInstanceMutation) SetName(s string) {
+	m.name = &s
 }
 
-// DoubleValue returns the value of the "double_value" field in the mutation.
-func (m *ProcessVariableMutation) DoubleValue() (r float64, exists bool) {
-	v := m.double_value
+// Name returns the value of the "name" field in the mutation.
+func (m *TaskInstanceMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDoubleValue returns the old "double_value" field's value of the ProcessVariable entity.
-// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessVariableMutation) OldDoubleValue(ctx context.Context) (v float64, err error) {
+func (m *TaskInstanceMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDoubleValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDoubleValue requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDoubleValue: %w", err)
-	}
-	return oldValue.DoubleValue, nil
-}
-
-// AddDoubleValue adds f to the "double_value" field.
-func (m *ProcessVariableMutation) AddDoubleValue(f float64) {
-	if m.adddouble_value != nil {
-		*m.adddouble_value += f
-	} else {
-		m.adddouble_value = &f
-	}
-}
-
-// AddedDoubleValue returns the value that was added to the "double_value" field in this mutation.
-func (m *ProcessVariableMutation) AddedDoubleValue() (r float64, exists bool) {
-	v := m.adddouble_value
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return *v, true
+	return oldValue.Name, nil
 }
 
-// ClearDoubleValue clears the value of the "double_value" field.
-func (m *ProcessVariableMutation) ClearDoubleValue() {
-	m.double_value = nil
-	m.adddouble_value = nil
-	m.clearedFields[processvariable.FieldDoubleValue] = struct{}{}
+// ClearName clears the value of the "name" field.
+func (m *TaskInstanceMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[taskinstance.FieldName] = struct{}{}
 }
 
-// DoubleValueCleared returns if the "double_value" field was cleared in this mutation.
-func (m *ProcessVariableMutation) DoubleValueCleared() bool {
-	_, ok := m.clearedFields[processvariable.FieldDoubleValue]
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *TaskInstanceMutation) NameCleared() bool {
+	_, ok := m.clearedFields[taskinstance.FieldName]
 	return ok
 }
 
-// ResetDoubleValue resets all changes to the "double_value" field.
-func (m *ProcessVariableMutation) ResetDoubleValue() {
-	m.double_value = nil
-	m.adddouble_value = nil
-	delete(m.clearedFields, processvariable.FieldDoubleValue)
+// ResetName resets all changes to the "name" field.
+func (m *TaskInstanceMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, taskinstance.FieldName)
 }
 
-// SetByteArrayValue sets the "byte_array_value" field.
-func (m *ProcessVariableMutation) SetByteArrayValue(b []byte) {
-	m.byte_array_value = &b
+// SetDescription sets the "description" field.
+func (m *TaskInstanceMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// ByteArrayValue returns the value of the "byte_array_value" field in the mutation.
-func (m *ProcessVariableMutation) ByteArrayValue() (r []byte, exists bool) {
-	v := m.byte_array_value
+// Description returns the value of the "description" field in the mutation.
+func (m *TaskInstanceMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldByteArrayValue returns the old "byte_array_value" field's value of the ProcessVariable entity.
-// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessVariableMutation) OldByteArrayValue(ctx context.Context) (v []byte, err error) {
+func (m *TaskInstanceMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldByteArrayValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldByteArrayValue requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldByteArrayValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.ByteArrayValue, nil
+	return oldValue.Description, nil
 }
 
-// ClearByteArrayValue clears the value of the "byte_array_value" field.
-func (m *ProcessVariableMutation) ClearByteArrayValue() {
-	m.byte_array_value = nil
-	m.clearedFields[processvariable.FieldByteArrayValue] = struct{}{}
+// ClearDescription clears the value of the "description" field.
+func (m *TaskInstanceMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[taskinstance.FieldDescription] = struct{}{}
 }
 
-// ByteArrayValueCleared returns if the "byte_array_value" field was cleared in this mutation.
-func (m *ProcessVariableMutation) ByteArrayValueCleared() bool {
-	_, ok := m.clearedFields[processvariable.FieldByteArrayValue]
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *TaskInstanceMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[taskinstance.FieldDescription]
 	return ok
 }
 
-// ResetByteArrayValue resets all changes to the "byte_array_value" field.
-func (m *ProcessVariableMutation) ResetByteArrayValue() {
-	m.byte_array_value = nil
-	delete(m.clearedFields, processvariable.FieldByteArrayValue)
+// ResetDescription resets all changes to the "description" field.
+func (m *TaskInstanceMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, taskinstance.FieldDescription)
 }
 
-// SetExecutionID sets the "execution_id" field.
-func (m *ProcessVariableMutation) SetExecutionID(s string) {
-	m.execution_id = &s
+// SetTaskDefinitionKey sets the "task_definition_key" field.
+func (m *TaskInstanceMutation) SetTaskDefinitionKey(s string) {
+	m.task_definition_key = &s
 }
 
-// ExecutionID returns the value of the "execution_id" field in the mutation.
-func (m *ProcessVariableMutation) ExecutionID() (r string, exists bool) {
-	v := m.execution_id
+// TaskDefinitionKey returns the value of the "task_definition_key" field in the mutation.
+func (m *TaskInstanceMutation) TaskDefinitionKey() (r string, exists bool) {
+	v := m.task_definition_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExecutionID returns the old "execution_id" field's value of the ProcessVariable entity.
-// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// OldTaskDefinitionKey returns the old "task_definition_key" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessVariableMutation) OldExecutionID(ctx context.Context) (v string, err error) {
+func (m *TaskInstanceMutation) OldTaskDefinitionKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExecutionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaskDefinitionKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExecutionID requires an ID field in the mutation")
+		return v, errors.New("OldTaskDefinitionKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExecutionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaskDefinitionKey: %w", err)
 	}
-	return oldValue.ExecutionID, nil
-}
-
-// ClearExecutionID clears the value of the "execution_id" field.
-func (m *ProcessVariableMutation) ClearExecutionID() {
-	m.execution_id = nil
-	m.clearedFields[processvariable.FieldExecutionID] = struct{}{}
-}
-
-// ExecutionIDCleared returns if the "execution_id" field was cleared in this mutation.
-func (m *ProcessVariableMutation) ExecutionIDCleared() bool {
-	_, ok := m.clearedFields[processvariable.FieldExecutionID]
-	return ok
+	return oldValue.TaskDefinitionKey, nil
 }
 
-// ResetExecutionID resets all changes to the "execution_id" field.
-func (m *ProcessVariableMutation) ResetExecutionID() {
-	m.execution_id = nil
-	delete(m.clearedFields, processvariable.FieldExecutionID)
+// ResetTaskDefinitionKey resets all changes to the "task_definition_key" field.
+func (m *TaskInstanceMutation) ResetTaskDefinitionKey() {
+	m.task_definition_key = nil
 }
 
-// SetProcessInstanceID sets the "process_instance_id" field.
-func (m *ProcessVariableMutation) SetProcessInstanceID(i int64) {
-	m.process_instance_id = &i
-	m.addprocess_instance_id = nil
+// SetAssignee sets the "assignee" field.
+func (m *TaskInstanceMutation) SetAssignee(s string) {
+	m.assignee = &s
 }
 
-// ProcessInstanceID returns the value of the "process_instance_id" field in the mutation.
-func (m *ProcessVariableMutation) ProcessInstanceID() (r int64, exists bool) {
-	v := m.process_instance_id
+// Assignee returns the value of the "assignee" field in the mutation.
+func (m *TaskInstanceMutation) Assignee() (r string, exists bool) {
+	v := m.assignee
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessInstanceID returns the old "process_instance_id" field's value of the ProcessVariable entity.
-// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// OldAssignee returns the old "assignee" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessVariableMutation) OldProcessInstanceID(ctx context.Context) (v int64, err error) {
+func (m *TaskInstanceMutation) OldAssignee(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessInstanceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAssignee is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessInstanceID requires an ID field in the mutation")
+		return v, errors.New("OldAssignee requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessInstanceID: %w", err)
-	}
-	return oldValue.ProcessInstanceID, nil
-}
-
-// AddProcessInstanceID adds i to the "process_instance_id" field.
-func (m *ProcessVariableMutation) AddProcessInstanceID(i int64) {
-	if m.addprocess_instance_id != nil {
-		*m.addprocess_instance_id += i
-	} else {
-		m.addprocess_instance_id = &i
-	}
-}
-
-// AddedProcessInstanceID returns the value that was added to the "process_instance_id" field in this mutation.
-func (m *ProcessVariableMutation) AddedProcessInstanceID() (r int64, exists bool) {
-	v := m.addprocess_instance_id
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldAssignee: %w", err)
 	}
-	return *v, true
+	return oldValue.Assignee, nil
 }
 
-// ClearProcessInstanceID clears the value of the "process_instance_id" field.
-func (m *ProcessVariableMutation) ClearProcessInstanceID() {
-	m.process_instance_id = nil
-	m.addprocess_instance_id = nil
-	m.clearedFields[processvariable.FieldProcessInstanceID] = struct{}{}
+// ClearAssignee clears the value of the "assignee" field.
+func (m *TaskInstanceMutation) ClearAssignee() {
+	m.assignee = nil
+	m.clearedFields[taskinstance.FieldAssignee] = struct{}{}
 }
 
-// ProcessInstanceIDCleared returns if the "process_instance_id" field was cleared in this mutation.
-func (m *ProcessVariableMutation) ProcessInstanceIDCleared() bool {
-	_, ok := m.clearedFields[processvariable.FieldProcessInstanceID]
+// AssigneeCleared returns if the "assignee" field was cleared in this mutation.
+func (m *TaskInstanceMutation) AssigneeCleared() bool {
+	_, ok := m.clearedFields[taskinstance.FieldAssignee]
 	return ok
 }
 
-// ResetProcessInstanceID resets all changes to the "process_instance_id" field.
-func (m *ProcessVariableMutation) ResetProcessInstanceID() {
-	m.process_instance_id = nil
-	m.addprocess_instance_id = nil
-	delete(m.clearedFields, processvariable.FieldProcessInstanceID)
+// ResetAssignee resets all changes to the "assignee" field.
+func (m *TaskInstanceMutation) ResetAssignee() {
+	m.assignee = nil
+	delete(m.clearedFields, taskinstance.FieldAssignee)
 }
 
-// SetProcessDefinitionID sets the "process_definition_id" field.
-func (m *ProcessVariableMutation) SetProcessDefinitionID(i int64) {
-	m.process_definition_id = &i
-	m.addprocess_definition_id = nil
+// SetOwner sets the "owner" field.
+func (m *TaskInstanceMutation) SetOwner(s string) {
+	m.owner = &s
 }
 
-// ProcessDefinitionID returns the value of the "process_definition_id" field in the mutation.
-func (m *ProcessVariableMutation) ProcessDefinitionID() (r int64, exists bool) {
-	v := m.process_definition_id
+// Owner returns the value of the "owner" field in the mutation.
+func (m *TaskInstanceMutation) Owner() (r string, exists bool) {
+	v := m.owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessDefinitionID returns the old "process_definition_id" field's value of the ProcessVariable entity.
-// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// OldOwner returns the old "owner" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessVariableMutation) OldProcessDefinitionID(ctx context.Context) (v int64, err error) {
+func (m *TaskInstanceMutation) OldOwner(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessDefinitionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessDefinitionID requires an ID field in the mutation")
+		return v, errors.New("OldOwner requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessDefinitionID: %w", err)
-	}
-	return oldValue.ProcessDefinitionID, nil
-}
-
-// AddProcessDefinitionID adds i to the "process_definition_id" field.
-func (m *ProcessVariableMutation) AddProcessDefinitionID(i int64) {
-	if m.addprocess_definition_id != nil {
-		*m.addprocess_definition_id += i
-	} else {
-		m.addprocess_definition_id = &i
-	}
-}
-
-// AddedProcessDefinitionID returns the value that was added to the "process_definition_id" field in this mutation.
-func (m *ProcessVariableMutation) AddedProcessDefinitionID() (r int64, exists bool) {
-	v := m.addprocess_definition_id
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
 	}
-	return *v, true
+	return oldValue.Owner, nil
 }
 
-// ClearProcessDefinitionID clears the value of the "process_definition_id" field.
-func (m *ProcessVariableMutation) ClearProcessDefinitionID() {
-	m.process_definition_id = nil
-	m.addprocess_definition_id = nil
-	m.clearedFields[processvariable.FieldProcessDefinitionID] = struct{}{}
+// ClearOwner clears the value of the "owner" field.
+func (m *TaskInstanceMutation) ClearOwner() {
+	m.owner = nil
+	m.clearedFields[taskinstance.FieldOwner] = struct{}{}
 }
 
-// ProcessDefinitionIDCleared returns if the "process_definition_id" field was cleared in this mutation.
-func (m *ProcessVariableMutation) ProcessDefinitionIDCleared() bool {
-	_, ok := m.clearedFields[processvariable.FieldProcessDefinitionID]
+// OwnerCleared returns if the "owner" field was cleared in this mutation.
+func (m *TaskInstanceMutation) OwnerCleared() bool {
+	_, ok := m.clearedFields[taskinstance.FieldOwner]
 	return ok
 }
 
-// ResetProcessDefinitionID resets all changes to the "process_definition_id" field.
-func (m *ProcessVariableMutation) ResetProcessDefinitionID() {
-	m.process_definition_id = nil
-	m.addprocess_definition_id = nil
-	delete(m.clearedFields, processvariable.FieldProcessDefinitionID)
+// ResetOwner resets all changes to the "owner" field.
+func (m *TaskInstanceMutation) ResetOwner() {
+	m.owner = nil
+	delete(m.clearedFields, taskinstance.FieldOwner)
 }
 
-// SetCaseExecutionID sets the "case_execution_id" field.
-func (m *ProcessVariableMutation) SetCaseExecutionID(s string) {
-	m.case_execution_id = &s
+// SetDelegation sets the "delegation" field.
+func (m *TaskInstanceMutation) SetDelegation(s string) {
+	m.delegation = &s
 }
 
-// CaseExecutionID returns the value of the "case_execution_id" field in the mutation.
-func (m *ProcessVariableMutation) CaseExecutionID() (r string, exists bool) {
-	v := m.case_execution_id
+// Delegation returns the value of the "delegation" field in the mutation.
+func (m *TaskInstanceMutation) Delegation() (r string, exists bool) {
+	v := m.delegation
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCaseExecutionID returns the old "case_execution_id" field's value of the ProcessVariable entity.
-// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// OldDelegation returns the old "delegation" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessVariableMutation) OldCaseExecutionID(ctx context.Context) (v string, err error) {
+func (m *TaskInstanceMutation) OldDelegation(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCaseExecutionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDelegation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCaseExecutionID requires an ID field in the mutation")
+		return v, errors.New("OldDelegation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCaseExecutionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDelegation: %w", err)
 	}
-	return oldValue.CaseExecutionID, nil
+	return oldValue.Delegation, nil
 }
 
-// ClearCaseExecutionID clears the value of the "case_execution_id" field.
-func (m *ProcessVariableMutation) ClearCaseExecutionID() {
-	m.case_execution_id = nil
-	m.clearedFields[processvariable.FieldCaseExecutionID] = struct{}{}
+// ClearDelegation clears the value of the "delegation" field.
+func (m *TaskInstanceMutation) ClearDelegation() {
+	m.delegation = nil
+	m.clearedFields[taskinstance.FieldDelegation] = struct{}{}
 }
 
-// CaseExecutionIDCleared returns if the "case_execution_id" field was cleared in this mutation.
-func (m *ProcessVariableMutation) CaseExecutionIDCleared() bool {
-	_, ok := m.clearedFields[processvariable.FieldCaseExecutionID]
+// DelegationCleared returns if the "delegation" field was cleared in this mutation.
+func (m *TaskInstanceMutation) DelegationCleared() bool {
+	_, ok := m.clearedFields[taskinstance.FieldDelegation]
 	return ok
 }
 
-// ResetCaseExecutionID resets all changes to the "case_execution_id" field.
-func (m *ProcessVariableMutation) ResetCaseExecutionID() {
-	m.case_execution_id = nil
-	delete(m.clearedFields, processvariable.FieldCaseExecutionID)
+// ResetDelegation resets all changes to the "delegation" field.
+func (m *TaskInstanceMutation) ResetDelegation() {
+	m.delegation = nil
+	delete(m.clearedFields, taskinstance.FieldDelegation)
 }
 
-// SetCaseInstanceID sets the "case_instance_id" field.
-func (m *ProcessVariableMutation) SetCaseInstanceID(s string) {
-	m.case_instance_id = &s
+// SetStatus sets the "status" field.
+func (m *TaskInstanceMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// CaseInstanceID returns the value of the "case_instance_id" field in the mutation.
-func (m *ProcessVariableMutation) CaseInstanceID() (r string, exists bool) {
-	v := m.case_instance_id
+// Status returns the value of the "status" field in the mutation.
+func (m *TaskInstanceMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCaseInstanceID returns the old "case_instance_id" field's value of the ProcessVariable entity.
-// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessVariableMutation) OldCaseInstanceID(ctx context.Context) (v string, err error) {
+func (m *TaskInstanceMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCaseInstanceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCaseInstanceID requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCaseInstanceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.CaseInstanceID, nil
-}
-
-// ClearCaseInstanceID clears the value of the "case_instance_id" field.
-func (m *ProcessVariableMutation) ClearCaseInstanceID() {
-	m.case_instance_id = nil
-	m.clearedFields[processvariable.FieldCaseInstanceID] = struct{}{}
-}
-
-// CaseInstanceIDCleared returns if the "case_instance_id" field was cleared in this mutation.
-func (m *ProcessVariableMutation) CaseInstanceIDCleared() bool {
-	_, ok := m.clearedFields[processvariable.FieldCaseInstanceID]
-	return ok
+	return oldValue.Status, nil
 }
 
-// ResetCaseInstanceID resets all changes to the "case_instance_id" field.
-func (m *ProcessVariableMutation) ResetCaseInstanceID() {
-	m.case_instance_id = nil
-	delete(m.clearedFields, processvariable.FieldCaseInstanceID)
+// ResetStatus resets all changes to the "status" field.
+func (m *TaskInstanceMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetTaskID sets the "task_id" field.
-func (m *ProcessVariableMutation) SetTaskID(i int64) {
-	m.task_id = &i
-	m.addtask_id = nil
+// SetPriority sets the "priority" field.
+func (m *TaskInstanceMutation) SetPriority(i int32) {
+	m.priority = &i
+	m.addpriority = nil
 }
 
-// TaskID returns the value of the "task_id" field in the mutation.
-func (m *ProcessVariableMutation) TaskID() (r int64, exists bool) {
-	v := m.task_id
+// Priority returns the value of the "priority" field in the mutation.
+func (m *TaskInstanceMutation) Priority() (r int32, exists bool) {
+	v := m.priority
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTaskID returns the old "task_id" field's value of the ProcessVariable entity.
-// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// OldPriority returns the old "priority" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessVariableMutation) OldTaskID(ctx context.Context) (v int64, err error) {
+func (m *TaskInstanceMutation) OldPriority(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTaskID requires an ID field in the mutation")
+		return v, errors.New("OldPriority requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
 	}
-	return oldValue.TaskID, nil
+	return oldValue.Priority, nil
+}
+
+// AddPriority adds i to the "priority" field.
+func (m *TaskInstanceMutation) AddPriority(i int32) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
+}
+
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *TaskInstanceMutation) AddedPriority() (r int32, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *TaskInstanceMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
 }
 
-// AddTaskID adds i to the "task_id" field.
-func (m *ProcessVariableMutation) AddTaskID(i int64) {
-	if m.addtask_id != nil {
-		*m.addtask_id += i
-	} else {
-		m.addtask_id = &i
-	}
+// SetCreateTime sets the "create_time" field.
+func (m *TaskInstanceMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// AddedTaskID returns the value that was added to the "task_id" field in this mutation.
-func (m *ProcessVariableMutation) AddedTaskID() (r int64, exists bool) {
-	v := m.addtask_id
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TaskInstanceMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearTaskID clears the value of the "task_id" field.
-func (m *ProcessVariableMutation) ClearTaskID() {
-	m.task_id = nil
-	m.addtask_id = nil
-	m.clearedFields[processvariable.FieldTaskID] = struct{}{}
-}
-
-// TaskIDCleared returns if the "task_id" field was cleared in this mutation.
-func (m *ProcessVariableMutation) TaskIDCleared() bool {
-	_, ok := m.clearedFields[processvariable.FieldTaskID]
-	return ok
+// OldCreateTime returns the old "create_time" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskInstanceMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
 }
 
-// ResetTaskID resets all changes to the "task_id" field.
-func (m *ProcessVariableMutation) ResetTaskID() {
-	m.task_id = nil
-	m.addtask_id = nil
-	delete(m.clearedFields, processvariable.FieldTaskID)
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TaskInstanceMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// SetActivityInstanceID sets the "activity_instance_id" field.
-func (m *ProcessVariableMutation) SetActivityInstanceID(s string) {
-	m.activity_instance_id = &s
+// SetClaimTime sets the "claim_time" field.
+func (m *TaskInstanceMutation) SetClaimTime(t time.Time) {
+	m.claim_time = &t
 }
 
-// ActivityInstanceID returns the value of the "activity_instance_id" field in the mutation.
-func (m *ProcessVariableMutation) ActivityInstanceID() (r string, exists bool) {
-	v := m.activity_instance_id
+// ClaimTime returns the value of the "claim_time" field in the mutation.
+func (m *TaskInstanceMutation) ClaimTime() (r time.Time, exists bool) {
+	v := m.claim_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActivityInstanceID returns the old "activity_instance_id" field's value of the ProcessVariable entity.
-// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// OldClaimTime returns the old "claim_time" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessVariableMutation) OldActivityInstanceID(ctx context.Context) (v string, err error) {
+func (m *TaskInstanceMutation) OldClaimTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActivityInstanceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldClaimTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActivityInstanceID requires an ID field in the mutation")
+		return v, errors.New("OldClaimTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActivityInstanceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldClaimTime: %w", err)
 	}
-	return oldValue.ActivityInstanceID, nil
+	return oldValue.ClaimTime, nil
 }
 
-// ClearActivityInstanceID clears the value of the "activity_instance_id" field.
-func (m *ProcessVariableMutation) ClearActivityInstanceID() {
-	m.activity_instance_id = nil
-	m.clearedFields[processvariable.FieldActivityInstanceID] = struct{}{}
+// ClearClaimTime clears the value of the "claim_time" field.
+func (m *TaskInstanceMutation) ClearClaimTime() {
+	m.claim_time = nil
+	m.clearedFields[taskinstance.FieldClaimTime] = struct{}{}
 }
 
-// ActivityInstanceIDCleared returns if the "activity_instance_id" field was cleared in this mutation.
-func (m *ProcessVariableMutation) ActivityInstanceIDCleared() bool {
-	_, ok := m.clearedFields[processvariable.FieldActivityInstanceID]
+// ClaimTimeCleared returns if the "claim_time" field was cleared in this mutation.
+func (m *TaskInstanceMutation) ClaimTimeCleared() bool {
+	_, ok := m.clearedFields[taskinstance.FieldClaimTime]
 	return ok
 }
 
-// ResetActivityInstanceID resets all changes to the "activity_instance_id" field.
-func (m *ProcessVariableMutation) ResetActivityInstanceID() {
-	m.activity_instance_id = nil
-	delete(m.clearedFields, processvariable.FieldActivityInstanceID)
+// ResetClaimTime resets all changes to the "claim_time" field.
+func (m *TaskInstanceMutation) ResetClaimTime() {
+	m.claim_time = nil
+	delete(m.clearedFields, taskinstance.FieldClaimTime)
 }
 
-// SetTenantID sets the "tenant_id" field.
-func (m *ProcessVariableMutation) SetTenantID(s string) {
-	m.tenant_id = &s
+// SetEndTime sets the "end_time" field.
+func (m *TaskInstanceMutation) SetEndTime(t time.Time) {
+	m.end_time = &t
 }
 
-// TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *ProcessVariableMutation) TenantID() (r string, exists bool) {
-	v := m.tenant_id
+// EndTime returns the value of the "end_time" field in the mutation.
+func (m *TaskInstanceMutation) EndTime() (r time.Time, exists bool) {
+	v := m.end_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the ProcessVariable entity.
-// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// OldEndTime returns the old "end_time" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessVariableMutation) OldTenantID(ctx context.Context) (v string, err error) {
+func (m *TaskInstanceMutation) OldEndTime(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTenantID requires an ID field in the mutation")
+		return v, errors.New("OldEndTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
 	}
-	return oldValue.TenantID, nil
+	return oldValue.EndTime, nil
 }
 
-// ResetTenantID resets all changes to the "tenant_id" field.
-func (m *ProcessVariableMutation) ResetTenantID() {
-	m.tenant_id = nil
+// ClearEndTime clears the value of the "end_time" field.
+func (m *TaskInstanceMutation) ClearEndTime() {
+	m.end_time = nil
+	m.clearedFields[taskinstance.FieldEndTime] = struct{}{}
 }
 
-// SetSequenceCounter sets the "sequence_counter" field.
-func (m *ProcessVariableMutation) SetSequenceCounter(i int32) {
-	m.sequence_counter = &i
-	m.addsequence_counter = nil
+// EndTimeCleared returns if the "end_time" field was cleared in this mutation.
+func (m *TaskInstanceMutation) EndTimeCleared() bool {
+	_, ok := m.clearedFields[taskinstance.FieldEndTime]
+	return ok
 }
 
-// SequenceCounter returns the value of the "sequence_counter" field in the mutation.
-func (m *ProcessVariableMutation) SequenceCounter() (r int32, exists bool) {
-	v := m.sequence_counter
+// ResetEndTime resets all changes to the "end_time" field.
+func (m *TaskInstanceMutation) ResetEndTime() {
+	m.end_time = nil
+	delete(m.clearedFields, taskinstance.FieldEndTime)
+}
+
+// SetDuration sets the "duration" field.
+func (m *TaskInstanceMutation) SetDuration(i int64) {
+	m.duration = &i
+	m.addduration = nil
+}
+
+// Duration returns the value of the "duration" field in the mutation.
+func (m *TaskInstanceMutation) Duration() (r int64, exists bool) {
+	v := m.duration
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSequenceCounter returns the old "sequence_counter" field's value of the ProcessVariable entity.
-// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// OldDuration returns the old "duration" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessVariableMutation) OldSequenceCounter(ctx context.Context) (v int32, err error) {
+func (m *TaskInstanceMutation) OldDuration(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSequenceCounter is only allowed on UpdateOne operations")
+		return v, errors.New("OldDuration is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSequenceCounter requires an ID field in the mutation")
+		return v, errors.New("OldDuration requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSequenceCounter: %w", err)
+		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
 	}
-	return oldValue.SequenceCounter, nil
+	return oldValue.Duration, nil
 }
 
-// AddSequenceCounter adds i to the "sequence_counter" field.
-func (m *ProcessVariableMutation) AddSequenceCounter(i int32) {
-	if m.addsequence_counter != nil {
-		*m.addsequence_counter += i
+// AddDuration adds i to the "duration" field.
+func (m *TaskInstanceMutation) AddDuration(i int64) {
+	if m.addduration != nil {
+		*m.addduration += i
 	} else {
-		m.addsequence_counter = &i
+		m.addduration = &i
 	}
 }
 
-// AddedSequenceCounter returns the value that was added to the "sequence_counter" field in this mutation.
-func (m *ProcessVariableMutation) AddedSequenceCounter() (r int32, exists bool) {
-	v := m.addsequence_counter
+// AddedDuration returns the value that was added to the "duration" field in this mutation.
+func (m *TaskInstanceMutation) AddedDuration() (r int64, exists bool) {
+	v := m.addduration
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetSequenceCounter resets all changes to the "sequence_counter" field.
-func (m *ProcessVariableMutation) ResetSequenceCounter() {
-	m.sequence_counter = nil
-	m.addsequence_counter = nil
-}
-
-// SetConcurrentLocal sets the "concurrent_local" field.
-func (m *ProcessVariableMutation) SetConcurrentLocal(b bool) {
-	m.concurrent_local = &b
-}
-
-// ConcurrentLocal returns the value of the "concurrent_local" field in the mutation.
-func (m *ProcessVariableMutation) ConcurrentLocal() (r bool, exists bool) {
-	v := m.concurrent_local
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearDuration clears the value of the "duration" field.
+func (m *TaskInstanceMutation) ClearDuration() {
+	m.duration = nil
+	m.addduration = nil
+	m.clearedFields[taskinstance.FieldDuration] = struct{}{}
 }
 
-// OldConcurrentLocal returns the old "concurrent_local" field's value of the ProcessVariable entity.
-// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessVariableMutation) OldConcurrentLocal(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConcurrentLocal is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConcurrentLocal requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConcurrentLocal: %w", err)
-	}
-	return oldValue.ConcurrentLocal, nil
+// DurationCleared returns if the "duration" field was cleared in this mutation.
+func (m *TaskInstanceMutation) DurationCleared() bool {
+	_, ok := m.clearedFields[taskinstance.FieldDuration]
+	return ok
 }
 
-// ResetConcurrentLocal resets all changes to the "concurrent_local" field.
-func (m *ProcessVariableMutation) ResetConcurrentLocal() {
-	m.concurrent_local = nil
+// ResetDuration resets all changes to the "duration" field.
+func (m *TaskInstanceMutation) ResetDuration() {
+	m.duration = nil
+	m.addduration = nil
+	delete(m.clearedFields, taskinstance.FieldDuration)
 }
 
-// SetScopeID sets the "scope_id" field.
-func (m *ProcessVariableMutation) SetScopeID(s string) {
-	m.scope_id = &s
+// SetDeleteReason sets the "delete_reason" field.
+func (m *TaskInstanceMutation) SetDeleteReason(s string) {
+	m.delete_reason = &s
 }
 
-// ScopeID returns the value of the "scope_id" field in the mutation.
-func (m *ProcessVariableMutation) ScopeID() (r string, exists bool) {
-	v := m.scope_id
+// DeleteReason returns the value of the "delete_reason" field in the mutation.
+func (m *TaskInstanceMutation) DeleteReason() (r string, exists bool) {
+	v := m.delete_reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScopeID returns the old "scope_id" field's value of the ProcessVariable entity.
-// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// OldDeleteReason returns the old "delete_reason" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessVariableMutation) OldScopeID(ctx context.Context) (v string, err error) {
+func (m *TaskInstanceMutation) OldDeleteReason(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScopeID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeleteReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScopeID requires an ID field in the mutation")
+		return v, errors.New("OldDeleteReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScopeID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeleteReason: %w", err)
 	}
-	return oldValue.ScopeID, nil
+	return oldValue.DeleteReason, nil
 }
 
-// ClearScopeID clears the value of the "scope_id" field.
-func (m *ProcessVariableMutation) ClearScopeID() {
-	m.scope_id = nil
-	m.clearedFields[processvariable.FieldScopeID] = struct{}{}
+// ClearDeleteReason clears the value of the "delete_reason" field.
+func (m *TaskInstanceMutation) ClearDeleteReason() {
+	m.delete_reason = nil
+	m.clearedFields[taskinstance.FieldDeleteReason] = struct{}{}
 }
 
-// ScopeIDCleared returns if the "scope_id" field was cleared in this mutation.
-func (m *ProcessVariableMutation) ScopeIDCleared() bool {
-	_, ok := m.clearedFields[processvariable.FieldScopeID]
+// DeleteReasonCleared returns if the "delete_reason" field was cleared in this mutation.
+func (m *TaskInstanceMutation) DeleteReasonCleared() bool {
+	_, ok := m.clearedFields[taskinstance.FieldDeleteReason]
 	return ok
 }
 
-// ResetScopeID resets all changes to the "scope_id" field.
-func (m *ProcessVariableMutation) ResetScopeID() {
-	m.scope_id = nil
-	delete(m.clearedFields, processvariable.FieldScopeID)
+// ResetDeleteReason resets all changes to the "delete_reason" field.
+func (m *TaskInstanceMutation) ResetDeleteReason() {
+	m.delete_reason = nil
+	delete(m.clearedFields, taskinstance.FieldDeleteReason)
 }
 
-// SetScopeType sets the "scope_type" field.
-func (m *ProcessVariableMutation) SetScopeType(s string) {
-	m.scope_type = &s
+// SetDueDate sets the "due_date" field.
+func (m *TaskInstanceMutation) SetDueDate(t time.Time) {
+	m.due_date = &t
 }
 
-// ScopeType returns the value of the "scope_type" field in the mutation.
-func (m *ProcessVariableMutation) ScopeType() (r string, exists bool) {
-	v := m.scope_type
+// DueDate returns the value of the "due_date" field in the mutation.
+func (m *TaskInstanceMutation) DueDate() (r time.Time, exists bool) {
+	v := m.due_date
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScopeType returns the old "scope_type" field's value of the ProcessVariable entity.
-// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// OldDueDate returns the old "due_date" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessVariableMutation) OldScopeType(ctx context.Context) (v string, err error) {
+func (m *TaskInstanceMutation) OldDueDate(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScopeType is only allowed on UpdateOne operations")
+		return v, errors.New("OldDueDate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScopeType requires an ID field in the mutation")
+		return v, errors.New("OldDueDate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScopeType: %w", err)
+		return v, fmt.Errorf("querying old value for OldDueDate: %w", err)
 	}
-	return oldValue.ScopeType, nil
+	return oldValue.DueDate, nil
 }
 
-// ClearScopeType clears the value of the "scope_type" field.
-func (m *ProcessVariableMutation) ClearScopeType() {
-	m.scope_type = nil
-	m.clearedFields[processvariable.FieldScopeType] = struct{}{}
+// ClearDueDate clears the value of the "due_date" field.
+func (m *TaskInstanceMutation) ClearDueDate() {
+	m.due_date = nil
+	m.clearedFields[taskinstance.FieldDueDate] = struct{}{}
 }
 
-// ScopeTypeCleared returns if the "scope_type" field was cleared in this mutation.
-func (m *ProcessVariableMutation) ScopeTypeCleared() bool {
-	_, ok := m.clearedFields[processvariable.FieldScopeType]
+// DueDateCleared returns if the "due_date" field was cleared in this mutation.
+func (m *TaskInstanceMutation) DueDateCleared() bool {
+	_, ok := m.clearedFields[taskinstance.FieldDueDate]
 	return ok
 }
 
-// ResetScopeType resets all changes to the "scope_type" field.
-func (m *ProcessVariableMutation) ResetScopeType() {
-	m.scope_type = nil
-	delete(m.clearedFields, processvariable.FieldScopeType)
+// ResetDueDate resets all changes to the "due_date" field.
+func (m *TaskInstanceMutation) ResetDueDate() {
+	m.due_date = nil
+	delete(m.clearedFields, taskinstance.FieldDueDate)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ProcessVariableMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetFollowUpDate sets the "follow_up_date" field.
+func (m *TaskInstanceMutation) SetFollowUpDate(t time.Time) {
+	m.follow_up_date = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ProcessVariableMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// FollowUpDate returns the value of the "follow_up_date" field in the mutation.
+func (m *TaskInstanceMutation) FollowUpDate() (r time.Time, exists bool) {
+	v := m.follow_up_date
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ProcessVariable entity.
-// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// OldFollowUpDate returns the old "follow_up_date" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessVariableMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TaskInstanceMutation) OldFollowUpDate(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldFollowUpDate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldFollowUpDate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldFollowUpDate: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.FollowUpDate, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ProcessVariableMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearFollowUpDate clears the value of the "follow_up_date" field.
+func (m *TaskInstanceMutation) ClearFollowUpDate() {
+	m.follow_up_date = nil
+	m.clearedFields[taskinstance.FieldFollowUpDate] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ProcessVariableMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// FollowUpDateCleared returns if the "follow_up_date" field was cleared in this mutation.
+func (m *TaskInstanceMutation) FollowUpDateCleared() bool {
+	_, ok := m.clearedFields[taskinstance.FieldFollowUpDate]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ProcessVariableMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetFollowUpDate resets all changes to the "follow_up_date" field.
+func (m *TaskInstanceMutation) ResetFollowUpDate() {
+	m.follow_up_date = nil
+	delete(m.clearedFields, taskinstance.FieldFollowUpDate)
+}
+
+// SetFormKey sets the "form_key" field.
+func (m *TaskInstanceMutation) SetFormKey(s string) {
+	m.form_key = &s
+}
+
+// FormKey returns the value of the "form_key" field in the mutation.
+func (m *TaskInstanceMutation) FormKey() (r string, exists bool) {
+	v := m.form_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the ProcessVariable entity.
-// If the ProcessVariable object wasn't provided to the builder, the object is fetched from the database.
+// OldFormKey returns the old "form_key" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProcessVariableMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TaskInstanceMutation) OldFormKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldFormKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldFormKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ProcessVariableMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// Where appends a list predicates to the ProcessVariableMutation builder.
-func (m *ProcessVariableMutation) Where(ps ...predicate.ProcessVariable) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the ProcessVariableMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProcessVariableMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ProcessVariable, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+		return v, fmt.Errorf("querying old value for OldFormKey: %w", err)
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *ProcessVariableMutation) Op() Op {
-	return m.op
+	return oldValue.FormKey, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ProcessVariableMutation) SetOp(op Op) {
-	m.op = op
+// ClearFormKey clears the value of the "form_key" field.
+func (m *TaskInstanceMutation) ClearFormKey() {
+	m.form_key = nil
+	m.clearedFields[taskinstance.FieldFormKey] = struct{}{}
 }
 
-// Type returns the node type of this mutation (ProcessVariable).
-func (m *ProcessVariableMutation) Type() string {
-	return m.typ
+// FormKeyCleared returns if the "form_key" field was cleared in this mutation.
+func (m *TaskInstanceMutation) FormKeyCleared() bool {
+	_, ok := m.clearedFields[taskinstance.FieldFormKey]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ProcessVariableMutation) Fields() []string {
-	fields := make([]string, 0, 21)
-	if m.name != nil {
-		fields = append(fields, processvariable.FieldName)
-	}
-	if m._type != nil {
-		fields = append(fields, processvariable.FieldType)
-	}
-	if m.text_value != nil {
-		fields = append(fields, processvariable.FieldTextValue)
-	}
-	if m.text_value2 != nil {
-		fields = append(fields, processvariable.FieldTextValue2)
-	}
-	if m.long_value != nil {
-		fields = append(fields, processvariable.FieldLongValue)
-	}
-	if m.double_value != nil {
-		fields = append(fields, processvariable.FieldDoubleValue)
-	}
-	if m.byte_array_value != nil {
-		fields = append(fields, processvariable.FieldByteArrayValue)
-	}
-	if m.execution_id != nil {
-		fields = append(fields, processvariable.FieldExecutionID)
-	}
-	if m.process_instance_id != nil {
-		fields = append(fields, processvariable.FieldProcessInstanceID)
-	}
-	if m.process_definition_id != nil {
-		fields = append(fields, processvariable.FieldProcessDefinitionID)
-	}
-	if m.case_execution_id != nil {
-		fields = append(fields, processvariable.FieldCaseExecutionID)
-	}
-	if m.case_instance_id != nil {
-		fields = append(fields, processvariable.FieldCaseInstanceID)
-	}
-	if m.task_id != nil {
-		fields = append(fields, processvariable.FieldTaskID)
-	}
-	if m.activity_instance_id != nil {
-		fields = append(fields, processvariable.FieldActivityInstanceID)
-	}
-	if m.tenant_id != nil {
-		fields = append(fields, processvariable.FieldTenantID)
-	}
-	if m.sequence_counter != nil {
-		fields = append(fields, processvariable.FieldSequenceCounter)
-	}
-	if m.concurrent_local != nil {
-		fields = append(fields, processvariable.FieldConcurrentLocal)
-	}
-	if m.scope_id != nil {
-		fields = append(fields, processvariable.FieldScopeID)
-	}
-	if m.scope_type != nil {
-		fields = append(fields, processvariable.FieldScopeType)
-	}
-	if m.created_at != nil {
-		fields = append(fields, processvariable.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, processvariable.FieldUpdatedAt)
-	}
-	return fields
+// ResetFormKey resets all changes to the "form_key" field.
+func (m *TaskInstanceMutation) ResetFormKey() {
+	m.form_key = nil
+	delete(m.clearedFields, taskinstance.FieldFormKey)
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ProcessVariableMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case processvariable.FieldName:
-		return m.Name()
-	case processvariable.FieldType:
-		return m.GetType()
-	case processvariable.FieldTextValue:
-		return m.TextValue()
-	case processvariable.FieldTextValue2:
-		return m.TextValue2()
-	case processvariable.FieldLongValue:
-		return m.LongValue()
-	case processvariable.FieldDoubleValue:
-		return m.DoubleValue()
-	case processvariable.FieldByteArrayValue:
-		return m.ByteArrayValue()
-	case processvariable.FieldExecutionID:
-		return m.ExecutionID()
-	case processvariable.FieldProcessInstanceID:
-		return m.ProcessInstanceID()
-	case processvariable.FieldProcessDefinitionID:
-		return m.ProcessDefinitionID()
-	case processvariable.FieldCaseExecutionID:
-		return m.CaseExecutionID()
-	case processvariable.FieldCaseInstanceID:
-		return m.CaseInstanceID()
-	case processvariable.FieldTaskID:
-		return m.TaskID()
-	case processvariable.FieldActivityInstanceID:
-		return m.ActivityInstanceID()
-	case processvariable.FieldTenantID:
-		return m.TenantID()
-	case processvariable.FieldSequenceCounter:
-		return m.SequenceCounter()
-	case processvariable.FieldConcurrentLocal:
-		return m.ConcurrentLocal()
-	case processvariable.FieldScopeID:
-		return m.ScopeID()
-	case processvariable.FieldScopeType:
-		return m.ScopeType()
-	case processvariable.FieldCreatedAt:
-		return m.CreatedAt()
-	case processvariable.FieldUpdatedAt:
-		return m.UpdatedAt()
+// SetCategory sets the "category" field.
+func (m *TaskInstanceMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *TaskInstanceMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ProcessVariableMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case processvariable.FieldName:
-		return m.OldName(ctx)
-	case processvariable.FieldType:
-		return m.OldType(ctx)
-	case processvariable.FieldTextValue:
-		return m.OldTextValue(ctx)
-	case processvariable.FieldTextValue2:
-		return m.OldTextValue2(ctx)
-	case processvariable.FieldLongValue:
-		return m.OldLongValue(ctx)
-	case processvariable.FieldDoubleValue:
-		return m.OldDoubleValue(ctx)
-	case processvariable.FieldByteArrayValue:
-		return m.OldByteArrayValue(ctx)
-	case processvariable.FieldExecutionID:
-		return m.OldExecutionID(ctx)
-	case processvariable.FieldProcessInstanceID:
-		return m.OldProcessInstanceID(ctx)
-	case processvariable.FieldProcessDefinitionID:
-		return m.OldProcessDefinitionID(ctx)
-	case processvariable.FieldCaseExecutionID:
-		return m.OldCaseExecutionID(ctx)
-	case processvariable.FieldCaseInstanceID:
-		return m.OldCaseInstanceID(ctx)
-	case processvariable.FieldTaskID:
-		return m.OldTaskID(ctx)
-	case processvariable.FieldActivityInstanceID:
-		return m.OldActivityInstanceID(ctx)
-	case processvariable.FieldTenantID:
-		return m.OldTenantID(ctx)
-	case processvariable.FieldSequenceCounter:
-		return m.OldSequenceCounter(ctx)
-	case processvariable.FieldConcurrentLocal:
-		return m.OldConcurrentLocal(ctx)
-	case processvariable.FieldScopeID:
-		return m.OldScopeID(ctx)
-	case processvariable.FieldScopeType:
-		return m.OldScopeType(ctx)
-	case processvariable.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case processvariable.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// OldCategory returns the old "category" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskInstanceMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
 	}
-	return nil, fmt.Errorf("unknown ProcessVariable field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ProcessVariableMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case processvariable.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case processvariable.FieldType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case processvariable.FieldTextValue:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTextValue(v)
-		return nil
-	case processvariable.FieldTextValue2:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTextValue2(v)
-		return nil
-	case processvariable.FieldLongValue:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLongValue(v)
-		return nil
-	case processvariable.FieldDoubleValue:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDoubleValue(v)
-		return nil
-	case processvariable.FieldByteArrayValue:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetByteArrayValue(v)
-		return nil
-	case processvariable.FieldExecutionID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExecutionID(v)
-		return nil
-	case processvariable.FieldProcessInstanceID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProcessInstanceID(v)
-		return nil
-	case processvariable.FieldProcessDefinitionID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProcessDefinitionID(v)
-		return nil
-	case processvariable.FieldCaseExecutionID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCaseExecutionID(v)
-		return nil
-	case processvariable.FieldCaseInstanceID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCaseInstanceID(v)
-		return nil
-	case processvariable.FieldTaskID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTaskID(v)
-		return nil
-	case processvariable.FieldActivityInstanceID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetActivityInstanceID(v)
-		return nil
-	case processvariable.FieldTenantID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTenantID(v)
-		return nil
-	case processvariable.FieldSequenceCounter:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSequenceCounter(v)
-		return nil
-	case processvariable.FieldConcurrentLocal:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetConcurrentLocal(v)
-		return nil
-	case processvariable.FieldScopeID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetScopeID(v)
-		return nil
-	case processvariable.FieldScopeType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetScopeType(v)
-		return nil
-	case processvariable.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case processvariable.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
+// ClearCategory clears the value of the "category" field.
+func (m *TaskInstanceMutation) ClearCategory() {
+	m.category = nil
+	m.clearedFields[taskinstance.FieldCategory] = struct{}{}
+}
+
+// CategoryCleared returns if the "category" field was cleared in this mutation.
+func (m *TaskInstanceMutation) CategoryCleared() bool {
+	_, ok := m.clearedFields[taskinstance.FieldCategory]
+	return ok
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *TaskInstanceMutation) ResetCategory() {
+	m.category = nil
+	delete(m.clearedFields, taskinstance.FieldCategory)
+}
+
+// SetParentTaskID sets the "parent_task_id" field.
+func (m *TaskInstanceMutation) SetParentTaskID(s string) {
+	m.parent_task_id = &s
+}
+
+// ParentTaskID returns the value of the "parent_task_id" field in the mutation.
+func (m *TaskInstanceMutation) ParentTaskID() (r string, exists bool) {
+	v := m.parent_task_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentTaskID returns the old "parent_task_id" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskInstanceMutation) OldParentTaskID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentTaskID is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown ProcessVariable field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentTaskID: %w", err)
+	}
+	return oldValue.ParentTaskID, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ProcessVariableMutation) AddedFields() []string {
-	var fields []string
-	if m.addlong_value != nil {
-		fields = append(fields, processvariable.FieldLongValue)
+// ClearParentTaskID clears the value of the "parent_task_id" field.
+func (m *TaskInstanceMutation) ClearParentTaskID() {
+	m.parent_task_id = nil
+	m.clearedFields[taskinstance.FieldParentTaskID] = struct{}{}
+}
+
+// ParentTaskIDCleared returns if the "parent_task_id" field was cleared in this mutation.
+func (m *TaskInstanceMutation) ParentTaskIDCleared() bool {
+	_, ok := m.clearedFields[taskinstance.FieldParentTaskID]
+	return ok
+}
+
+// ResetParentTaskID resets all changes to the "parent_task_id" field.
+func (m *TaskInstanceMutation) ResetParentTaskID() {
+	m.parent_task_id = nil
+	delete(m.clearedFields, taskinstance.FieldParentTaskID)
+}
+
+// SetWaitForSubtasks sets the "wait_for_subtasks" field.
+func (m *TaskInstanceMutation) SetWaitForSubtasks(b bool) {
+	m.wait_for_subtasks = &b
+}
+
+// WaitForSubtasks returns the value of the "wait_for_subtasks" field in the mutation.
+func (m *TaskInstanceMutation) WaitForSubtasks() (r bool, exists bool) {
+	v := m.wait_for_subtasks
+	if v == nil {
+		return
 	}
-	if m.adddouble_value != nil {
-		fields = append(fields, processvariable.FieldDoubleValue)
+	return *v, true
+}
+
+// OldWaitForSubtasks returns the old "wait_for_subtasks" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskInstanceMutation) OldWaitForSubtasks(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWaitForSubtasks is only allowed on UpdateOne operations")
 	}
-	if m.addprocess_instance_id != nil {
-		fields = append(fields, processvariable.FieldProcessInstanceID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWaitForSubtasks requires an ID field in the mutation")
 	}
-	if m.addprocess_definition_id != nil {
-		fields = append(fields, processvariable.FieldProcessDefinitionID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWaitForSubtasks: %w", err)
 	}
-	if m.addtask_id != nil {
-		fields = append(fields, processvariable.FieldTaskID)
+	return oldValue.WaitForSubtasks, nil
+}
+
+// ResetWaitForSubtasks resets all changes to the "wait_for_subtasks" field.
+func (m *TaskInstanceMutation) ResetWaitForSubtasks() {
+	m.wait_for_subtasks = nil
+}
+
+// SetExecutionID sets the "execution_id" field.
+func (m *TaskInstanceMutation) SetExecutionID(s string) {
+	m.execution_id = &s
+}
+
+// ExecutionID returns the value of the "execution_id" field in the mutation.
+func (m *TaskInstanceMutation) ExecutionID() (r string, exists bool) {
+	v := m.execution_id
+	if v == nil {
+		return
 	}
-	if m.addsequence_counter != nil {
-		fields = append(fields, processvariable.FieldSequenceCounter)
+	return *v, true
+}
+
+// OldExecutionID returns the old "execution_id" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskInstanceMutation) OldExecutionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExecutionID is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExecutionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExecutionID: %w", err)
+	}
+	return oldValue.ExecutionID, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ProcessVariableMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case processvariable.FieldLongValue:
-		return m.AddedLongValue()
-	case processvariable.FieldDoubleValue:
-		return m.AddedDoubleValue()
-	case processvariable.FieldProcessInstanceID:
-		return m.AddedProcessInstanceID()
-	case processvariable.FieldProcessDefinitionID:
-		return m.AddedProcessDefinitionID()
-	case processvariable.FieldTaskID:
-		return m.AddedTaskID()
-	case processvariable.FieldSequenceCounter:
-		return m.AddedSequenceCounter()
+// ClearExecutionID clears the value of the "execution_id" field.
+func (m *TaskInstanceMutation) ClearExecutionID() {
+	m.execution_id = nil
+	m.clearedFields[taskinstance.FieldExecutionID] = struct{}{}
+}
+
+// ExecutionIDCleared returns if the "execution_id" field was cleared in this mutation.
+func (m *TaskInstanceMutation) ExecutionIDCleared() bool {
+	_, ok := m.clearedFields[taskinstance.FieldExecutionID]
+	return ok
+}
+
+// ResetExecutionID resets all changes to the "execution_id" field.
+func (m *TaskInstanceMutation) ResetExecutionID() {
+	m.execution_id = nil
+	delete(m.clearedFields, taskinstance.FieldExecutionID)
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (m *TaskInstanceMutation) SetProcessInstanceID(i int64) {
+	m.process_instance_id = &i
+	m.addprocess_instance_id = nil
+}
+
+// ProcessInstanceID returns the value of the "process_instance_id" field in the mutation.
+func (m *TaskInstanceMutation) ProcessInstanceID() (r int64, exists bool) {
+	v := m.process_instance_id
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ProcessVariableMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case processvariable.FieldLongValue:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddLongValue(v)
-		return nil
-	case processvariable.FieldDoubleValue:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDoubleValue(v)
-		return nil
-	case processvariable.FieldProcessInstanceID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddProcessInstanceID(v)
-		return nil
-	case processvariable.FieldProcessDefinitionID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddProcessDefinitionID(v)
-		return nil
-	case processvariable.FieldTaskID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTaskID(v)
-		return nil
-	case processvariable.FieldSequenceCounter:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSequenceCounter(v)
-		return nil
+// OldProcessInstanceID returns the old "process_instance_id" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskInstanceMutation) OldProcessInstanceID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessInstanceID is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown ProcessVariable numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessInstanceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessInstanceID: %w", err)
+	}
+	return oldValue.ProcessInstanceID, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ProcessVariableMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(processvariable.FieldTextValue) {
-		fields = append(fields, processvariable.FieldTextValue)
+// AddProcessInstanceID adds i to the "process_instance_id" field.
+func (m *TaskInstanceMutation) AddProcessInstanceID(i int64) {
+	if m.addprocess_instance_id != nil {
+		*m.addprocess_instance_id += i
+	} else {
+		m.addprocess_instance_id = &i
 	}
-	if m.FieldCleared(processvariable.FieldTextValue2) {
-		fields = append(fields, processvariable.FieldTextValue2)
+}
+
+// AddedProcessInstanceID returns the value that was added to the "process_instance_id" field in this mutation.
+func (m *TaskInstanceMutation) AddedProcessInstanceID() (r int64, exists bool) {
+	v := m.addprocess_instance_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetProcessInstanceID resets all changes to the "process_instance_id" field.
+func (m *TaskInstanceMutation) ResetProcessInstanceID() {
+	m.process_instance_id = nil
+	m.addprocess_instance_id = nil
+}
+
+// SetProcessDefinitionID sets the "process_definition_id" field.
+func (m *TaskInstanceMutation) SetProcessDefinitionID(i int64) {
+	m.process_definition_id = &i
+	m.addprocess_definition_id = nil
+}
+
+// ProcessDefinitionID returns the value of the "process_definition_id" field in the mutation.
+func (m *TaskInstanceMutation) ProcessDefinitionID() (r int64, exists bool) {
+	v := m.process_definition_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessDefinitionID returns the old "process_definition_id" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskInstanceMutation) OldProcessDefinitionID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessDefinitionID is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(processvariable.FieldLongValue) {
-		fields = append(fields, processvariable.FieldLongValue)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessDefinitionID requires an ID field in the mutation")
 	}
-	if m.FieldCleared(processvariable.FieldDoubleValue) {
-		fields = append(fields, processvariable.FieldDoubleValue)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessDefinitionID: %w", err)
 	}
-	if m.FieldCleared(processvariable.FieldByteArrayValue) {
-		fields = append(fields, processvariable.FieldByteArrayValue)
+	return oldValue.ProcessDefinitionID, nil
+}
+
+// AddProcessDefinitionID adds i to the "process_definition_id" field.
+func (m *TaskInstanceMutation) AddProcessDefinitionID(i int64) {
+	if m.addprocess_definition_id != nil {
+		*m.addprocess_definition_id += i
+	} else {
+		m.addprocess_definition_id = &i
 	}
-	if m.FieldCleared(processvariable.FieldExecutionID) {
-		fields = append(fields, processvariable.FieldExecutionID)
+}
+
+// AddedProcessDefinitionID returns the value that was added to the "process_definition_id" field in this mutation.
+func (m *TaskInstanceMutation) AddedProcessDefinitionID() (r int64, exists bool) {
+	v := m.addprocess_definition_id
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(processvariable.FieldProcessInstanceID) {
-		fields = append(fields, processvariable.FieldProcessInstanceID)
+	return *v, true
+}
+
+// ResetProcessDefinitionID resets all changes to the "process_definition_id" field.
+func (m *TaskInstanceMutation) ResetProcessDefinitionID() {
+	m.process_definition_id = nil
+	m.addprocess_definition_id = nil
+}
+
+// SetProcessDefinitionKey sets the "process_definition_key" field.
+func (m *TaskInstanceMutation) SetProcessDefinitionKey(s string) {
+	m.process_definition_key = &s
+}
+
+// ProcessDefinitionKey returns the value of the "process_definition_key" field in the mutation.
+func (m *TaskInstanceMutation) ProcessDefinitionKey() (r string, exists bool) {
+	v := m.process_definition_key
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(processvariable.FieldProcessDefinitionID) {
-		fields = append(fields, processvariable.FieldProcessDefinitionID)
+	return *v, true
+}
+
+// OldProcessDefinitionKey returns the old "process_definition_key" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskInstanceMutation) OldProcessDefinitionKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessDefinitionKey is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(processvariable.FieldCaseExecutionID) {
-		fields = append(fields, processvariable.FieldCaseExecutionID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessDefinitionKey requires an ID field in the mutation")
 	}
-	if m.FieldCleared(processvariable.FieldCaseInstanceID) {
-		fields = append(fields, processvariable.FieldCaseInstanceID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessDefinitionKey: %w", err)
 	}
-	if m.FieldCleared(processvariable.FieldTaskID) {
-		fields = append(fields, processvariable.FieldTaskID)
+	return oldValue.ProcessDefinitionKey, nil
+}
+
+// ResetProcessDefinitionKey resets all changes to the "process_definition_key" field.
+func (m *TaskInstanceMutation) ResetProcessDefinitionKey() {
+	m.process_definition_key = nil
+}
+
+// SetCaseExecutionID sets the "case_execution_id" field.
+func (m *TaskInstanceMutation) SetCaseExecutionID(s string) {
+	m.case_execution_id = &s
+}
+
+// CaseExecutionID returns the value of the "case_execution_id" field in the mutation.
+func (m *TaskInstanceMutation) CaseExecutionID() (r string, exists bool) {
+	v := m.case_execution_id
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(processvariable.FieldActivityInstanceID) {
-		fields = append(fields, processvariable.FieldActivityInstanceID)
+	return *v, true
+}
+
+// OldCaseExecutionID returns the old "case_execution_id" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskInstanceMutation) OldCaseExecutionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCaseExecutionID is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(processvariable.FieldScopeID) {
-		fields = append(fields, processvariable.FieldScopeID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCaseExecutionID requires an ID field in the mutation")
 	}
-	if m.FieldCleared(processvariable.FieldScopeType) {
-		fields = append(fields, processvariable.FieldScopeType)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCaseExecutionID: %w", err)
 	}
-	return fields
+	return oldValue.CaseExecutionID, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ProcessVariableMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearCaseExecutionID clears the value of the "case_execution_id" field.
+func (m *TaskInstanceMutation) ClearCaseExecutionID() {
+	m.case_execution_id = nil
+	m.clearedFields[taskinstance.FieldCaseExecutionID] = struct{}{}
+}
+
+// CaseExecutionIDCleared returns if the "case_execution_id" field was cleared in this mutation.
+func (m *TaskInstanceMutation) CaseExecutionIDCleared() bool {
+	_, ok := m.clearedFields[taskinstance.FieldCaseExecutionID]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ProcessVariableMutation) ClearField(name string) error {
-	switch name {
-	case processvariable.FieldTextValue:
-		m.ClearTextValue()
-		return nil
-	case processvariable.FieldTextValue2:
-		m.ClearTextValue2()
-		return nil
-	case processvariable.FieldLongValue:
-		m.ClearLongValue()
-		return nil
-	case processvariable.FieldDoubleValue:
-		m.ClearDoubleValue()
-		return nil
-	case processvariable.FieldByteArrayValue:
-		m.ClearByteArrayValue()
-		return nil
-	case processvariable.FieldExecutionID:
-		m.ClearExecutionID()
-		return nil
-	case processvariable.FieldProcessInstanceID:
-		m.ClearProcessInstanceID()
-		return nil
-	case processvariable.FieldProcessDefinitionID:
-		m.ClearProcessDefinitionID()
-		return nil
-	case processvariable.FieldCaseExecutionID:
-		m.ClearCaseExecutionID()
-		return nil
-	case processvariable.FieldCaseInstanceID:
-		m.ClearCaseInstanceID()
-		return nil
-	case processvariable.FieldTaskID:
-		m.ClearTaskID()
-		return nil
-	case processvariable.FieldActivityInstanceID:
-		m.ClearActivityInstanceID()
-		return nil
-	case processvariable.FieldScopeID:
-		m.ClearScopeID()
-		return nil
-	case processvariable.FieldScopeType:
-		m.ClearScopeType()
-		return nil
-	}
-	return fmt.Errorf("unknown ProcessVariable nullable field %s", name)
+// ResetCaseExecutionID resets all changes to the "case_execution_id" field.
+func (m *TaskInstanceMutation) ResetCaseExecutionID() {
+	m.case_execution_id = nil
+	delete(m.clearedFields, taskinstance.FieldCaseExecutionID)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ProcessVariableMutation) ResetField(name string) error {
-	switch name {
-	case processvariable.FieldName:
-		m.ResetName()
-		return nil
-	case processvariable.FieldType:
-		m.ResetType()
-		return nil
-	case processvariable.FieldTextValue:
-		m.ResetTextValue()
-		return nil
-	case processvariable.FieldTextValue2:
-		m.ResetTextValue2()
-		return nil
-	case processvariable.FieldLongValue:
-		m.ResetLongValue()
-		return nil
-	case processvariable.FieldDoubleValue:
-		m.ResetDoubleValue()
-		return nil
-	case processvariable.FieldByteArrayValue:
-		m.ResetByteArrayValue()
-		return nil
-	case processvariable.FieldExecutionID:
-		m.ResetExecutionID()
-		return nil
-	case processvariable.FieldProcessInstanceID:
-		m.ResetProcessInstanceID()
-		return nil
-	case processvariable.FieldProcessDefinitionID:
-		m.ResetProcessDefinitionID()
-		return nil
-	case processvariable.FieldCaseExecutionID:
-		m.ResetCaseExecutionID()
-		return nil
-	case processvariable.FieldCaseInstanceID:
-		m.ResetCaseInstanceID()
-		return nil
-	case processvariable.FieldTaskID:
-		m.ResetTaskID()
-		return nil
-	case processvariable.FieldActivityInstanceID:
-		m.ResetActivityInstanceID()
-		return nil
-	case processvariable.FieldTenantID:
-		m.ResetTenantID()
-		return nil
-	case processvariable.FieldSequenceCounter:
-		m.ResetSequenceCounter()
-		return nil
-	case processvariable.FieldConcurrentLocal:
-		m.ResetConcurrentLocal()
-		return nil
-	case processvariable.FieldScopeID:
-		m.ResetScopeID()
-		return nil
-	case processvariable.FieldScopeType:
-		m.ResetScopeType()
-		return nil
-	case processvariable.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case processvariable.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
+// SetCaseInstanceID sets the "case_instance_id" field.
+func (m *TaskInstanceMutation) SetCaseInstanceID(s string) {
+	m.case_instance_id = &s
+}
+
+// CaseInstanceID returns the value of the "case_instance_id" field in the mutation.
+func (m *TaskInstanceMutation) CaseInstanceID() (r string, exists bool) {
+	v := m.case_instance_id
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown ProcessVariable field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProcessVariableMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// OldCaseInstanceID returns the old "case_instance_id" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskInstanceMutation) OldCaseInstanceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCaseInstanceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCaseInstanceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCaseInstanceID: %w", err)
+	}
+	return oldValue.CaseInstanceID, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ProcessVariableMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// ClearCaseInstanceID clears the value of the "case_instance_id" field.
+func (m *TaskInstanceMutation) ClearCaseInstanceID() {
+	m.case_instance_id = nil
+	m.clearedFields[taskinstance.FieldCaseInstanceID] = struct{}{}
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProcessVariableMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// CaseInstanceIDCleared returns if the "case_instance_id" field was cleared in this mutation.
+func (m *TaskInstanceMutation) CaseInstanceIDCleared() bool {
+	_, ok := m.clearedFields[taskinstance.FieldCaseInstanceID]
+	return ok
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ProcessVariableMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// ResetCaseInstanceID resets all changes to the "case_instance_id" field.
+func (m *TaskInstanceMutation) ResetCaseInstanceID() {
+	m.case_instance_id = nil
+	delete(m.clearedFields, taskinstance.FieldCaseInstanceID)
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProcessVariableMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// SetCaseDefinitionID sets the "case_definition_id" field.
+func (m *TaskInstanceMutation) SetCaseDefinitionID(s string) {
+	m.case_definition_id = &s
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ProcessVariableMutation) EdgeCleared(name string) bool {
-	return false
+// CaseDefinitionID returns the value of the "case_definition_id" field in the mutation.
+func (m *TaskInstanceMutation) CaseDefinitionID() (r string, exists bool) {
+	v := m.case_definition_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ProcessVariableMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ProcessVariable unique edge %s", name)
+// OldCaseDefinitionID returns the old "case_definition_id" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskInstanceMutation) OldCaseDefinitionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCaseDefinitionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCaseDefinitionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCaseDefinitionID: %w", err)
+	}
+	return oldValue.CaseDefinitionID, nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ProcessVariableMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ProcessVariable edge %s", name)
+// ClearCaseDefinitionID clears the value of the "case_definition_id" field.
+func (m *TaskInstanceMutation) ClearCaseDefinitionID() {
+	m.case_definition_id = nil
+	m.clearedFields[taskinstance.FieldCaseDefinitionID] = struct{}{}
 }
 
-// TaskInstanceMutation represents an operation that mutates the TaskInstance nodes in the graph.
-type TaskInstanceMutation struct {
-	config
-	op                       Op
-	typ                      string
-	id                       *int64
-	name                     *string
-	description              *string
-	task_definition_key      *string
-	assignee                 *string
-	owner                    *string
-	delegation               *string
-	priority                 *int32
-	addpriority              *int32
-	create_time              *time.Time
-	due_date                 *time.Time
-	follow_up_date           *time.Time
-	form_key                 *string
-	category                 *string
-	parent_task_id           *string
-	execution_id             *string
-	process_instance_id      *int64
-	addprocess_instance_id   *int64
-	process_definition_id    *int64
-	addprocess_definition_id *int64
-	process_definition_key   *string
-	case_execution_id        *string
-	case_instance_id         *string
-	case_definition_id       *string
-	suspended                *bool
-	tenant_id                *string
-	created_at               *time.Time
-	updated_at               *time.Time
-	clearedFields            map[string]struct{}
-	done                     bool
-	oldValue                 func(context.Context) (*TaskInstance, error)
-	predicates               []predicate.TaskInstance
+// CaseDefinitionIDCleared returns if the "case_definition_id" field was cleared in this mutation.
+func (m *TaskInstanceMutation) CaseDefinitionIDCleared() bool {
+	_, ok := m.clearedFields[taskinstance.FieldCaseDefinitionID]
+	return ok
 }
 
-var _ ent.Mutation = (*TaskInstanceMutation)(nil)
-
-// taskinstanceOption allows management of the mutation configuration using functional options.
-type taskinstanceOption func(*TaskInstanceMutation)
-
-// newTaskInstanceMutation creates new mutation for the TaskInstance entity.
-func newTaskInstanceMutation(c config, op Op, opts ...taskinstanceOption) *TaskInstanceMutation {
-	m := &TaskInstanceMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeTaskInstance,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// ResetCaseDefinitionID resets all changes to the "case_definition_id" field.
+func (m *TaskInstanceMutation) ResetCaseDefinitionID() {
+	m.case_definition_id = nil
+	delete(m.clearedFields, taskinstance.FieldCaseDefinitionID)
 }
 
-// withTaskInstanceID sets the ID field of the mutation.
-func withTaskInstanceID(id int64) taskinstanceOption {
-	return func(m *TaskInstanceMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *TaskInstance
-		)
-		m.oldValue = func(ctx context.Context) (*TaskInstance, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().TaskInstance.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// SetSuspended sets the "suspended" field.
+func (m *TaskInstanceMutation) SetSuspended(b bool) {
+	m.suspended = &b
 }
 
-// withTaskInstance sets the old TaskInstance of the mutation.
-func withTaskInstance(node *TaskInstance) taskinstanceOption {
-	return func(m *TaskInstanceMutation) {
-		m.oldValue = func(context.Context) (*TaskInstance, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// Suspended returns the value of the "suspended" field in the mutation.
+func (m *TaskInstanceMutation) Suspended() (r bool, exists bool) {
+	v := m.suspended
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TaskInstanceMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// OldSuspended returns the old "suspended" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskInstanceMutation) OldSuspended(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuspended is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuspended requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuspended: %w", err)
+	}
+	return oldValue.Suspended, nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m TaskInstanceMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ResetSuspended resets all changes to the "suspended" field.
+func (m *TaskInstanceMutation) ResetSuspended() {
+	m.suspended = nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TaskInstance entities.
-func (m *TaskInstanceMutation) SetID(id int64) {
-	m.id = &id
+// SetTenantID sets the "tenant_id" field.
+func (m *TaskInstanceMutation) SetTenantID(s string) {
+	m.tenant_id = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *TaskInstanceMutation) ID() (id int64, exists bool) {
-	if m.id == nil {
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *TaskInstanceMutation) TenantID() (r string, exists bool) {
+	v := m.tenant_id
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *TaskInstanceMutation) IDs(ctx context.Context) ([]int64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TaskInstance.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldTenantID returns the old "tenant_id" field's value of the TaskInstance entity.
+// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskInstanceMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
+	return oldValue.TenantID, nil
 }
 
-// SetName sets the "name" field.
-func (m *TaskInstanceMutation) SetName(s string) {
-	m.name = &s
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *TaskInstanceMutation) ResetTenantID() {
+	m.tenant_id = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TaskInstanceMutation) Name() (r string, exists bool) {
-	v := m.name
+// SetCreatedAt sets the "created_at" field.
+func (m *TaskInstanceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TaskInstanceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the TaskInstance entity.
+// OldCreatedAt returns the old "created_at" field's value of the TaskInstance entity.
 // If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TaskInstanceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Name, nil
-}
-
-// ClearName clears the value of the "name" field.
-func (m *TaskInstanceMutation) ClearName() {
-	m.name = nil
-	m.clearedFields[taskinstance.FieldName] = struct{}{}
-}
-
-// NameCleared returns if the "name" field was cleared in this mutation.
-func (m *TaskInstanceMutation) NameCleared() bool {
-	_, ok := m.clearedFields[taskinstance.FieldName]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TaskInstanceMutation) ResetName() {
-	m.name = nil
-	delete(m.clearedFields, taskinstance.FieldName)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TaskInstanceMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *TaskInstanceMutation) SetDescription(s string) {
-	m.description = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TaskInstanceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *TaskInstanceMutation) Description() (r string, exists bool) {
-	v := m.description
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TaskInstanceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the TaskInstance entity.
+// OldUpdatedAt returns the old "updated_at" field's value of the TaskInstance entity.
 // If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *TaskInstanceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *TaskInstanceMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[taskinstance.FieldDescription] = struct{}{}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TaskInstanceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *TaskInstanceMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[taskinstance.FieldDescription]
-	return ok
+// Where appends a list predicates to the TaskInstanceMutation builder.
+func (m *TaskInstanceMutation) Where(ps ...predicate.TaskInstance) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *TaskInstanceMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, taskinstance.FieldDescription)
+// WhereP appends storage-level predicates to the TaskInstanceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaskInstanceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TaskInstance, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetTaskDefinitionKey sets the "task_definition_key" field.
-func (m *TaskInstanceMutation) SetTaskDefinitionKey(s string) {
-	m.task_definition_key = &s
+// Op returns the operation name.
+func (m *TaskInstanceMutation) Op() Op {
+	return m.op
 }
 
-// TaskDefinitionKey returns the value of the "task_definition_key" field in the mutation.
-func (m *TaskInstanceMutation) TaskDefinitionKey() (r string, exists bool) {
-	v := m.task_definition_key
-	if v == nil {
-		return
+// SetOp allows setting the mutation operation.
+func (m *TaskInstanceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TaskInstance).
+func (m *TaskInstanceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TaskInstanceMutation) Fields() []string {
+	fields := make([]string, 0, 30)
+	if m.name != nil {
+		fields = append(fields, taskinstance.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, taskinstance.FieldDescription)
+	}
+	if m.task_definition_key != nil {
+		fields = append(fields, taskinstance.FieldTaskDefinitionKey)
+	}
+	if m.assignee != nil {
+		fields = append(fields, taskinstance.FieldAssignee)
+	}
+	if m.owner != nil {
+		fields = append(fields, taskinstance.FieldOwner)
+	}
+	if m.delegation != nil {
+		fields = append(fields, taskinstance.FieldDelegation)
+	}
+	if m.status != nil {
+		fields = append(fields, taskinstance.FieldStatus)
+	}
+	if m.priority != nil {
+		fields = append(fields, taskinstance.FieldPriority)
+	}
+	if m.create_time != nil {
+		fields = append(fields, taskinstance.FieldCreateTime)
+	}
+	if m.claim_time != nil {
+		fields = append(fields, taskinstance.FieldClaimTime)
+	}
+	if m.end_time != nil {
+		fields = append(fields, taskinstance.FieldEndTime)
+	}
+	if m.duration != nil {
+		fields = append(fields, taskinstance.FieldDuration)
+	}
+	if m.delete_reason != nil {
+		fields = append(fields, taskinstance.FieldDeleteReason)
+	}
+	if m.due_date != nil {
+		fields = append(fields, taskinstance.FieldDueDate)
+	}
+	if m.follow_up_date != nil {
+		fields = append(fields, taskinstance.FieldFollowUpDate)
+	}
+	if m.form_key != nil {
+		fields = append(fields, taskinstance.FieldFormKey)
+	}
+	if m.category != nil {
+		fields = append(fields, taskinstance.FieldCategory)
+	}
+	if m.parent_task_id != nil {
+		fields = append(fields, taskinstance.FieldParentTaskID)
+	}
+	if m.wait_for_subtasks != nil {
+		fields = append(fields, taskinstance.FieldWaitForSubtasks)
+	}
+	if m.execution_id != nil {
+		fields = append(fields, taskinstance.FieldExecutionID)
+	}
+	if m.process_instance_id != nil {
+		fields = append(fields, taskinstance.FieldProcessInstanceID)
+	}
+	if m.process_definition_id != nil {
+		fields = append(fields, taskinstance.FieldProcessDefinitionID)
+	}
+	if m.process_definition_key != nil {
+		fields = append(fields, taskinstance.FieldProcessDefinitionKey)
+	}
+	if m.case_execution_id != nil {
+		fields = append(fields, taskinstance.FieldCaseExecutionID)
+	}
+	if m.case_instance_id != nil {
+		fields = append(fields, taskinstance.FieldCaseInstanceID)
+	}
+	if m.case_definition_id != nil {
+		fields = append(fields, taskinstance.FieldCaseDefinitionID)
+	}
+	if m.suspended != nil {
+		fields = append(fields, taskinstance.FieldSuspended)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, taskinstance.FieldTenantID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, taskinstance.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, taskinstance.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TaskInstanceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case taskinstance.FieldName:
+		return m.Name()
+	case taskinstance.FieldDescription:
+		return m.Description()
+	case taskinstance.FieldTaskDefinitionKey:
+		return m.TaskDefinitionKey()
+	case taskinstance.FieldAssignee:
+		return m.Assignee()
+	case taskinstance.FieldOwner:
+		return m.Owner()
+	case taskinstance.FieldDelegation:
+		return m.Delegation()
+	case taskinstance.FieldStatus:
+		return m.Status()
+	case taskinstance.FieldPriority:
+		return m.Priority()
+	case taskinstance.FieldCreateTime:
+		return m.CreateTime()
+	case taskinstance.FieldClaimTime:
+		return m.ClaimTime()
+	case taskinstance.FieldEndTime:
+		return m.EndTime()
+	case taskinstance.FieldDuration:
+		return m.Duration()
+	case taskinstance.FieldDeleteReason:
+		return m.DeleteReason()
+	case taskinstance.FieldDueDate:
+		return m.DueDate()
+	case taskinstance.FieldFollowUpDate:
+		return m.FollowUpDate()
+	case taskinstance.FieldFormKey:
+		return m.FormKey()
+	case taskinstance.FieldCategory:
+		return m.Category()
+	case taskinstance.FieldParentTaskID:
+		return m.ParentTaskID()
+	case taskinstance.FieldWaitForSubtasks:
+		return m.WaitForSubtasks()
+	case taskinstance.FieldExecutionID:
+		return m.ExecutionID()
+	case taskinstance.FieldProcessInstanceID:
+		return m.ProcessInstanceID()
+	case taskinstance.FieldProcessDefinitionID:
+		return m.ProcessDefinitionID()
+	case taskinstance.FieldProcessDefinitionKey:
+		return m.ProcessDefinitionKey()
+	case taskinstance.FieldCaseExecutionID:
+		return m.CaseExecutionID()
+	case taskinstance.FieldCaseInstanceID:
+		return m.CaseInstanceID()
+	case taskinstance.FieldCaseDefinitionID:
+		return m.CaseDefinitionID()
+	case taskinstance.FieldSuspended:
+		return m.Suspended()
+	case taskinstance.FieldTenantID:
+		return m.TenantID()
+	case taskinstance.FieldCreatedAt:
+		return m.CreatedAt()
+	case taskinstance.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldTaskDefinitionKey returns the old "task_definition_key" field's value of the TaskInstance entity.
-// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldTaskDefinitionKey(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTaskDefinitionKey is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTaskDefinitionKey requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTaskDefinitionKey: %w", err)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TaskInstanceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case taskinstance.FieldName:
+		return m.OldName(ctx)
+	case taskinstance.FieldDescription:
+		return m.OldDescription(ctx)
+	case taskinstance.FieldTaskDefinitionKey:
+		return m.OldTaskDefinitionKey(ctx)
+	case taskinstance.FieldAssignee:
+		return m.OldAssignee(ctx)
+	case taskinstance.FieldOwner:
+		return m.OldOwner(ctx)
+	case taskinstance.FieldDelegation:
+		return m.OldDelegation(ctx)
+	case taskinstance.FieldStatus:
+		return m.OldStatus(ctx)
+	case taskinstance.FieldPriority:
+		return m.OldPriority(ctx)
+	case taskinstance.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case taskinstance.FieldClaimTime:
+		return m.OldClaimTime(ctx)
+	case taskinstance.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case taskinstance.FieldDuration:
+		return m.OldDuration(ctx)
+	case taskinstance.FieldDeleteReason:
+		return m.OldDeleteReason(ctx)
+	case taskinstance.FieldDueDate:
+		return m.OldDueDate(ctx)
+	case taskinstance.FieldFollowUpDate:
+		return m.OldFollowUpDate(ctx)
+	case taskinstance.FieldFormKey:
+		return m.OldFormKey(ctx)
+	case taskinstance.FieldCategory:
+		return m.OldCategory(ctx)
+	case taskinstance.FieldParentTaskID:
+		return m.OldParentTaskID(ctx)
+	case taskinstance.FieldWaitForSubtasks:
+		return m.OldWaitForSubtasks(ctx)
+	case taskinstance.FieldExecutionID:
+		return m.OldExecutionID(ctx)
+	case taskinstance.FieldProcessInstanceID:
+		return m.OldProcessInstanceID(ctx)
+	case taskinstance.FieldProcessDefinitionID:
+		return m.OldProcessDefinitionID(ctx)
+	case taskinstance.FieldProcessDefinitionKey:
+		return m.OldProcessDefinitionKey(ctx)
+	case taskinstance.FieldCaseExecutionID:
+		return m.OldCaseExecutionID(ctx)
+	case taskinstance.FieldCaseInstanceID:
+		return m.OldCaseInstanceID(ctx)
+	case taskinstance.FieldCaseDefinitionID:
+		return m.OldCaseDefinitionID(ctx)
+	case taskinstance.FieldSuspended:
+		return m.OldSuspended(ctx)
+	case taskinstance.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case taskinstance.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case taskinstance.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return oldValue.TaskDefinitionKey, nil
-}
-
-// ResetTaskDefinitionKey resets all changes to the "task_definition_key" field.
-func (m *TaskInstanceMutation) ResetTaskDefinitionKey() {
-	m.task_definition_key = nil
-}
-
-// SetAssignee sets the "assignee" field.
-func (m *TaskInstanceMutation) SetAssignee(s string) {
-	m.assignee = &s
+	return nil, fmt.Errorf("unknown TaskInstance field %s", name)
 }
 
-// Assignee returns the value of the "assignee" field in the mutation.
-func (m *TaskInstanceMutation) Assignee() (r string, exists bool) {
-	v := m.assignee
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskInstanceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case taskinstance.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case taskinstance.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case taskinstance.FieldTaskDefinitionKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskDefinitionKey(v)
+		return nil
+	case taskinstance.FieldAssignee:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignee(v)
+		return nil
+	case taskinstance.FieldOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwner(v)
+		return nil
+	case taskinstance.FieldDelegation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDelegation(v)
+		return nil
+	case taskinstance.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case taskinstance.FieldPriority:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case taskinstance.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case taskinstance.FieldClaimTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimTime(v)
+		return nil
+	case taskinstance.FieldEndTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case taskinstance.FieldDuration:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDuration(v)
+		return nil
+	case taskinstance.FieldDeleteReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteReason(v)
+		return nil
+	case taskinstance.FieldDueDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDueDate(v)
+		return nil
+	case taskinstance.FieldFollowUpDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFollowUpDate(v)
+		return nil
+	case taskinstance.FieldFormKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormKey(v)
+		return nil
+	case taskinstance.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case taskinstance.FieldParentTaskID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentTaskID(v)
+		return nil
+	case taskinstance.FieldWaitForSubtasks:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWaitForSubtasks(v)
+		return nil
+	case taskinstance.FieldExecutionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExecutionID(v)
+		return nil
+	case taskinstance.FieldProcessInstanceID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessInstanceID(v)
+		return nil
+	case taskinstance.FieldProcessDefinitionID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessDefinitionID(v)
+		return nil
+	case taskinstance.FieldProcessDefinitionKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessDefinitionKey(v)
+		return nil
+	case taskinstance.FieldCaseExecutionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCaseExecutionID(v)
+		return nil
+	case taskinstance.FieldCaseInstanceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCaseInstanceID(v)
+		return nil
+	case taskinstance.FieldCaseDefinitionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCaseDefinitionID(v)
+		return nil
+	case taskinstance.FieldSuspended:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuspended(v)
+		return nil
+	case taskinstance.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case taskinstance.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case taskinstance.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown TaskInstance field %s", name)
 }
 
-// OldAssignee returns the old "assignee" field's value of the TaskInstance entity.
-// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldAssignee(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAssignee is only allowed on UpdateOne operations")
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TaskInstanceMutation) AddedFields() []string {
+	var fields []string
+	if m.addpriority != nil {
+		fields = append(fields, taskinstance.FieldPriority)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAssignee requires an ID field in the mutation")
+	if m.addduration != nil {
+		fields = append(fields, taskinstance.FieldDuration)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAssignee: %w", err)
+	if m.addprocess_instance_id != nil {
+		fields = append(fields, taskinstance.FieldProcessInstanceID)
 	}
-	return oldValue.Assignee, nil
-}
-
-// ClearAssignee clears the value of the "assignee" field.
-func (m *TaskInstanceMutation) ClearAssignee() {
-	m.assignee = nil
-	m.clearedFields[taskinstance.FieldAssignee] = struct{}{}
-}
-
-// AssigneeCleared returns if the "assignee" field was cleared in this mutation.
-func (m *TaskInstanceMutation) AssigneeCleared() bool {
-	_, ok := m.clearedFields[taskinstance.FieldAssignee]
-	return ok
-}
-
-// ResetAssignee resets all changes to the "assignee" field.
-func (m *TaskInstanceMutation) ResetAssignee() {
-	m.assignee = nil
-	delete(m.clearedFields, taskinstance.FieldAssignee)
-}
-
-// SetOwner sets the "owner" field.
-func (m *TaskInstanceMutation) SetOwner(s string) {
-	m.owner = &s
-}
-
-// Owner returns the value of the "owner" field in the mutation.
-func (m *TaskInstanceMutation) Owner() (r string, exists bool) {
-	v := m.owner
-	if v == nil {
-		return
+	if m.addprocess_definition_id != nil {
+		fields = append(fields, taskinstance.FieldProcessDefinitionID)
 	}
-	return *v, true
+	return fields
 }
 
-// OldOwner returns the old "owner" field's value of the TaskInstance entity.
-// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldOwner(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwner requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TaskInstanceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case taskinstance.FieldPriority:
+		return m.AddedPriority()
+	case taskinstance.FieldDuration:
+		return m.AddedDuration()
+	case taskinstance.FieldProcessInstanceID:
+		return m.AddedProcessInstanceID()
+	case taskinstance.FieldProcessDefinitionID:
+		return m.AddedProcessDefinitionID()
 	}
-	return oldValue.Owner, nil
-}
-
-// ClearOwner clears the value of the "owner" field.
-func (m *TaskInstanceMutation) ClearOwner() {
-	m.owner = nil
-	m.clearedFields[taskinstance.FieldOwner] = struct{}{}
-}
-
-// OwnerCleared returns if the "owner" field was cleared in this mutation.
-func (m *TaskInstanceMutation) OwnerCleared() bool {
-	_, ok := m.clearedFields[taskinstance.FieldOwner]
-	return ok
-}
-
-// ResetOwner resets all changes to the "owner" field.
-func (m *TaskInstanceMutation) ResetOwner() {
-	m.owner = nil
-	delete(m.clearedFields, taskinstance.FieldOwner)
-}
-
-// SetDelegation sets the "delegation" field.
-func (m *TaskInstanceMutation) SetDelegation(s string) {
-	m.delegation = &s
+	return nil, false
 }
 
-// Delegation returns the value of the "delegation" field in the mutation.
-func (m *TaskInstanceMutation) Delegation() (r string, exists bool) {
-	v := m.delegation
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaskInstanceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case taskinstance.FieldPriority:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	case taskinstance.FieldDuration:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDuration(v)
+		return nil
+	case taskinstance.FieldProcessInstanceID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProcessInstanceID(v)
+		return nil
+	case taskinstance.FieldProcessDefinitionID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProcessDefinitionID(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown TaskInstance numeric field %s", name)
 }
 
-// OldDelegation returns the old "delegation" field's value of the TaskInstance entity.
-// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldDelegation(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDelegation is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TaskInstanceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(taskinstance.FieldName) {
+		fields = append(fields, taskinstance.FieldName)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDelegation requires an ID field in the mutation")
+	if m.FieldCleared(taskinstance.FieldDescription) {
+		fields = append(fields, taskinstance.FieldDescription)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDelegation: %w", err)
+	if m.FieldCleared(taskinstance.FieldAssignee) {
+		fields = append(fields, taskinstance.FieldAssignee)
 	}
-	return oldValue.Delegation, nil
-}
-
-// ClearDelegation clears the value of the "delegation" field.
-func (m *TaskInstanceMutation) ClearDelegation() {
-	m.delegation = nil
-	m.clearedFields[taskinstance.FieldDelegation] = struct{}{}
-}
-
-// DelegationCleared returns if the "delegation" field was cleared in this mutation.
-func (m *TaskInstanceMutation) DelegationCleared() bool {
-	_, ok := m.clearedFields[taskinstance.FieldDelegation]
-	return ok
-}
-
-// ResetDelegation resets all changes to the "delegation" field.
-func (m *TaskInstanceMutation) ResetDelegation() {
-	m.delegation = nil
-	delete(m.clearedFields, taskinstance.FieldDelegation)
-}
-
-// SetPriority sets the "priority" field.
-func (m *TaskInstanceMutation) SetPriority(i int32) {
-	m.priority = &i
-	m.addpriority = nil
-}
-
-// Priority returns the value of the "priority" field in the mutation.
-func (m *TaskInstanceMutation) Priority() (r int32, exists bool) {
-	v := m.priority
-	if v == nil {
-		return
+	if m.FieldCleared(taskinstance.FieldOwner) {
+		fields = append(fields, taskinstance.FieldOwner)
 	}
-	return *v, true
-}
-
-// OldPriority returns the old "priority" field's value of the TaskInstance entity.
-// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldPriority(ctx context.Context) (v int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	if m.FieldCleared(taskinstance.FieldDelegation) {
+		fields = append(fields, taskinstance.FieldDelegation)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriority requires an ID field in the mutation")
+	if m.FieldCleared(taskinstance.FieldClaimTime) {
+		fields = append(fields, taskinstance.FieldClaimTime)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	if m.FieldCleared(taskinstance.FieldEndTime) {
+		fields = append(fields, taskinstance.FieldEndTime)
 	}
-	return oldValue.Priority, nil
-}
-
-// AddPriority adds i to the "priority" field.
-func (m *TaskInstanceMutation) AddPriority(i int32) {
-	if m.addpriority != nil {
-		*m.addpriority += i
-	} else {
-		m.addpriority = &i
+	if m.FieldCleared(taskinstance.FieldDuration) {
+		fields = append(fields, taskinstance.FieldDuration)
 	}
-}
-
-// AddedPriority returns the value that was added to the "priority" field in this mutation.
-func (m *TaskInstanceMutation) AddedPriority() (r int32, exists bool) {
-	v := m.addpriority
-	if v == nil {
-		return
+	if m.FieldCleared(taskinstance.FieldDeleteReason) {
+		fields = append(fields, taskinstance.FieldDeleteReason)
 	}
-	return *v, true
-}
-
-// ResetPriority resets all changes to the "priority" field.
-func (m *TaskInstanceMutation) ResetPriority() {
-	m.priority = nil
-	m.addpriority = nil
-}
-
-// SetCreateTime sets the "create_time" field.
-func (m *TaskInstanceMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
-}
-
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *TaskInstanceMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
-	if v == nil {
-		return
+	if m.FieldCleared(taskinstance.FieldDueDate) {
+		fields = append(fields, taskinstance.FieldDueDate)
 	}
-	return *v, true
-}
-
-// OldCreateTime returns the old "create_time" field's value of the TaskInstance entity.
-// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	if m.FieldCleared(taskinstance.FieldFollowUpDate) {
+		fields = append(fields, taskinstance.FieldFollowUpDate)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	if m.FieldCleared(taskinstance.FieldFormKey) {
+		fields = append(fields, taskinstance.FieldFormKey)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	if m.FieldCleared(taskinstance.FieldCategory) {
+		fields = append(fields, taskinstance.FieldCategory)
 	}
-	return oldValue.CreateTime, nil
-}
-
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *TaskInstanceMutation) ResetCreateTime() {
-	m.create_time = nil
+	if m.FieldCleared(taskinstance.FieldParentTaskID) {
+		fields = append(fields, taskinstance.FieldParentTaskID)
+	}
+	if m.FieldCleared(taskinstance.FieldExecutionID) {
+		fields = append(fields, taskinstance.FieldExecutionID)
+	}
+	if m.FieldCleared(taskinstance.FieldCaseExecutionID) {
+		fields = append(fields, taskinstance.FieldCaseExecutionID)
+	}
+	if m.FieldCleared(taskinstance.FieldCaseInstanceID) {
+		fields = append(fields, taskinstance.FieldCaseInstanceID)
+	}
+	if m.FieldCleared(taskinstance.FieldCaseDefinitionID) {
+		fields = append(fields, taskinstance.FieldCaseDefinitionID)
+	}
+	return fields
 }
 
-// SetDueDate sets the "due_date" field.
-func (m *TaskInstanceMutation) SetDueDate(t time.Time) {
-	m.due_date = &t
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TaskInstanceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// DueDate returns the value of the "due_date" field in the mutation.
-func (m *TaskInstanceMutation) DueDate() (r time.Time, exists bool) {
-	v := m.due_date
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TaskInstanceMutation) ClearField(name string) error {
+	switch name {
+	case taskinstance.FieldName:
+		m.ClearName()
+		return nil
+	case taskinstance.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case taskinstance.FieldAssignee:
+		m.ClearAssignee()
+		return nil
+	case taskinstance.FieldOwner:
+		m.ClearOwner()
+		return nil
+	case taskinstance.FieldDelegation:
+		m.ClearDelegation()
+		return nil
+	case taskinstance.FieldClaimTime:
+		m.ClearClaimTime()
+		return nil
+	case taskinstance.FieldEndTime:
+		m.ClearEndTime()
+		return nil
+	case taskinstance.FieldDuration:
+		m.ClearDuration()
+		return nil
+	case taskinstance.FieldDeleteReason:
+		m.ClearDeleteReason()
+		return nil
+	case taskinstance.FieldDueDate:
+		m.ClearDueDate()
+		return nil
+	case taskinstance.FieldFollowUpDate:
+		m.ClearFollowUpDate()
+		return nil
+	case taskinstance.FieldFormKey:
+		m.ClearFormKey()
+		return nil
+	case taskinstance.FieldCategory:
+		m.ClearCategory()
+		return nil
+	case taskinstance.FieldParentTaskID:
+		m.ClearParentTaskID()
+		return nil
+	case taskinstance.FieldExecutionID:
+		m.ClearExecutionID()
+		return nil
+	case taskinstance.FieldCaseExecutionID:
+		m.ClearCaseExecutionID()
+		return nil
+	case taskinstance.FieldCaseInstanceID:
+		m.ClearCaseInstanceID()
+		return nil
+	case taskinstance.FieldCaseDefinitionID:
+		m.ClearCaseDefinitionID()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown TaskInstance nullable field %s", name)
 }
 
-// OldDueDate returns the old "due_date" field's value of the TaskInstance entity.
-// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldDueDate(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDueDate is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDueDate requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDueDate: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TaskInstanceMutation) ResetField(name string) error {
+	switch name {
+	case taskinstance.FieldName:
+		m.ResetName()
+		return nil
+	case taskinstance.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case taskinstance.FieldTaskDefinitionKey:
+		m.ResetTaskDefinitionKey()
+		return nil
+	case taskinstance.FieldAssignee:
+		m.ResetAssignee()
+		return nil
+	case taskinstance.FieldOwner:
+		m.ResetOwner()
+		return nil
+	case taskinstance.FieldDelegation:
+		m.ResetDelegation()
+		return nil
+	case taskinstance.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case taskinstance.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case taskinstance.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case taskinstance.FieldClaimTime:
+		m.ResetClaimTime()
+		return nil
+	case taskinstance.FieldEndTime:
+		m.ResetEndTime()
+		return nil
+	case taskinstance.FieldDuration:
+		m.ResetDuration()
+		return nil
+	case taskinstance.FieldDeleteReason:
+		m.ResetDeleteReason()
+		return nil
+	case taskinstance.FieldDueDate:
+		m.ResetDueDate()
+		return nil
+	case taskinstance.FieldFollowUpDate:
+		m.ResetFollowUpDate()
+		return nil
+	case taskinstance.FieldFormKey:
+		m.ResetFormKey()
+		return nil
+	case taskinstance.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case taskinstance.FieldParentTaskID:
+		m.ResetParentTaskID()
+		return nil
+	case taskinstance.FieldWaitForSubtasks:
+		m.ResetWaitForSubtasks()
+		return nil
+	case taskinstance.FieldExecutionID:
+		m.ResetExecutionID()
+		return nil
+	case taskinstance.FieldProcessInstanceID:
+		m.ResetProcessInstanceID()
+		return nil
+	case taskinstance.FieldProcessDefinitionID:
+		m.ResetProcessDefinitionID()
+		return nil
+	case taskinstance.FieldProcessDefinitionKey:
+		m.ResetProcessDefinitionKey()
+		return nil
+	case taskinstance.FieldCaseExecutionID:
+		m.ResetCaseExecutionID()
+		return nil
+	case taskinstance.FieldCaseInstanceID:
+		m.ResetCaseInstanceID()
+		return nil
+	case taskinstance.FieldCaseDefinitionID:
+		m.ResetCaseDefinitionID()
+		return nil
+	case taskinstance.FieldSuspended:
+		m.ResetSuspended()
+		return nil
+	case taskinstance.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case taskinstance.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case taskinstance.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return oldValue.DueDate, nil
-}
-
-// ClearDueDate clears the value of the "due_date" field.
-func (m *TaskInstanceMutation) ClearDueDate() {
-	m.due_date = nil
-	m.clearedFields[taskinstance.FieldDueDate] = struct{}{}
-}
-
-// DueDateCleared returns if the "due_date" field was cleared in this mutation.
-func (m *TaskInstanceMutation) DueDateCleared() bool {
-	_, ok := m.clearedFields[taskinstance.FieldDueDate]
-	return ok
+	return fmt.Errorf("unknown TaskInstance field %s", name)
 }
 
-// ResetDueDate resets all changes to the "due_date" field.
-func (m *TaskInstanceMutation) ResetDueDate() {
-	m.due_date = nil
-	delete(m.clearedFields, taskinstance.FieldDueDate)
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TaskInstanceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetFollowUpDate sets the "follow_up_date" field.
-func (m *TaskInstanceMutation) SetFollowUpDate(t time.Time) {
-	m.follow_up_date = &t
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TaskInstanceMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// FollowUpDate returns the value of the "follow_up_date" field in the mutation.
-func (m *TaskInstanceMutation) FollowUpDate() (r time.Time, exists bool) {
-	v := m.follow_up_date
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TaskInstanceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldFollowUpDate returns the old "follow_up_date" field's value of the TaskInstance entity.
-// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldFollowUpDate(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFollowUpDate is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFollowUpDate requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFollowUpDate: %w", err)
-	}
-	return oldValue.FollowUpDate, nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TaskInstanceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ClearFollowUpDate clears the value of the "follow_up_date" field.
-func (m *TaskInstanceMutation) ClearFollowUpDate() {
-	m.follow_up_date = nil
-	m.clearedFields[taskinstance.FieldFollowUpDate] = struct{}{}
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TaskInstanceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// FollowUpDateCleared returns if the "follow_up_date" field was cleared in this mutation.
-func (m *TaskInstanceMutation) FollowUpDateCleared() bool {
-	_, ok := m.clearedFields[taskinstance.FieldFollowUpDate]
-	return ok
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TaskInstanceMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// ResetFollowUpDate resets all changes to the "follow_up_date" field.
-func (m *TaskInstanceMutation) ResetFollowUpDate() {
-	m.follow_up_date = nil
-	delete(m.clearedFields, taskinstance.FieldFollowUpDate)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TaskInstanceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TaskInstance unique edge %s", name)
 }
 
-// SetFormKey sets the "form_key" field.
-func (m *TaskInstanceMutation) SetFormKey(s string) {
-	m.form_key = &s
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TaskInstanceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TaskInstance edge %s", name)
 }
 
-// FormKey returns the value of the "form_key" field in the mutation.
-func (m *TaskInstanceMutation) FormKey() (r string, exists bool) {
-	v := m.form_key
-	if v == nil {
-		return
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int64
+	username            *string
+	email               *string
+	display_name        *string
+	password_hash       *string
+	status              *string
+	roles               *[]string
+	appendroles         []string
+	groups              *[]string
+	appendgroups        []string
+	tenant_id           *string
+	password_changed_at *time.Time
+	last_login_at       *time.Time
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*User, error)
+	predicates          []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldFormKey returns the old "form_key" field's value of the TaskInstance entity.
-// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldFormKey(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFormKey is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFormKey requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFormKey: %w", err)
+// withUserID sets the ID field of the mutation.
+func withUserID(id int64) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.FormKey, nil
 }
 
-// ClearFormKey clears the value of the "form_key" field.
-func (m *TaskInstanceMutation) ClearFormKey() {
-	m.form_key = nil
-	m.clearedFields[taskinstance.FieldFormKey] = struct{}{}
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// FormKeyCleared returns if the "form_key" field was cleared in this mutation.
-func (m *TaskInstanceMutation) FormKeyCleared() bool {
-	_, ok := m.clearedFields[taskinstance.FieldFormKey]
-	return ok
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ResetFormKey resets all changes to the "form_key" field.
-func (m *TaskInstanceMutation) ResetFormKey() {
-	m.form_key = nil
-	delete(m.clearedFields, taskinstance.FieldFormKey)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// SetCategory sets the "category" field.
-func (m *TaskInstanceMutation) SetCategory(s string) {
-	m.category = &s
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id int64) {
+	m.id = &id
 }
 
-// Category returns the value of the "category" field in the mutation.
-func (m *TaskInstanceMutation) Category() (r string, exists bool) {
-	v := m.category
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldCategory returns the old "category" field's value of the TaskInstance entity.
-// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldCategory(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategory requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.Category, nil
-}
-
-// ClearCategory clears the value of the "category" field.
-func (m *TaskInstanceMutation) ClearCategory() {
-	m.category = nil
-	m.clearedFields[taskinstance.FieldCategory] = struct{}{}
-}
-
-// CategoryCleared returns if the "category" field was cleared in this mutation.
-func (m *TaskInstanceMutation) CategoryCleared() bool {
-	_, ok := m.clearedFields[taskinstance.FieldCategory]
-	return ok
-}
-
-// ResetCategory resets all changes to the "category" field.
-func (m *TaskInstanceMutation) ResetCategory() {
-	m.category = nil
-	delete(m.clearedFields, taskinstance.FieldCategory)
 }
 
-// SetParentTaskID sets the "parent_task_id" field.
-func (m *TaskInstanceMutation) SetParentTaskID(s string) {
-	m.parent_task_id = &s
+// SetUsername sets the "username" field.
+func (m *UserMutation) SetUsername(s string) {
+	m.username = &s
 }
 
-// ParentTaskID returns the value of the "parent_task_id" field in the mutation.
-func (m *TaskInstanceMutation) ParentTaskID() (r string, exists bool) {
-	v := m.parent_task_id
+// Username returns the value of the "username" field in the mutation.
+func (m *UserMutation) Username() (r string, exists bool) {
+	v := m.username
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParentTaskID returns the old "parent_task_id" field's value of the TaskInstance entity.
-// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldUsername returns the old "username" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldParentTaskID(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentTaskID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentTaskID requires an ID field in the mutation")
+		return v, errors.New("OldUsername requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentTaskID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
 	}
-	return oldValue.ParentTaskID, nil
-}
-
-// ClearParentTaskID clears the value of the "parent_task_id" field.
-func (m *TaskInstanceMutation) ClearParentTaskID() {
-	m.parent_task_id = nil
-	m.clearedFields[taskinstance.FieldParentTaskID] = struct{}{}
-}
-
-// ParentTaskIDCleared returns if the "parent_task_id" field was cleared in this mutation.
-func (m *TaskInstanceMutation) ParentTaskIDCleared() bool {
-	_, ok := m.clearedFields[taskinstance.FieldParentTaskID]
-	return ok
-}
-
-// ResetParentTaskID resets all changes to the "parent_task_id" field.
-func (m *TaskInstanceMutation) ResetParentTaskID() {
-	m.parent_task_id = nil
-	delete(m.clearedFields, taskinstance.FieldParentTaskID)
+	return oldValue.Username, nil
 }
 
-// SetExecutionID sets the "execution_id" field.
-func (m *TaskInstanceMutation) SetExecutionID(s string) {
-	m.execution_id = &s
+// ResetUsername resets all changes to the "username" field.
+func (m *UserMutation) ResetUsername() {
+	m.username = nil
 }
 
-// ExecutionID returns the value of the "execution_id" field in the mutation.
-func (m *TaskInstanceMutation) ExecutionID() (r string, exists bool) {
-	v := m.execution_id
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExecutionID returns the old "execution_id" field's value of the TaskInstance entity.
-// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldExecutionID(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExecutionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExecutionID requires an ID field in the mutation")
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExecutionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return oldValue.ExecutionID, nil
+	return oldValue.Email, nil
 }
 
-// ClearExecutionID clears the value of the "execution_id" field.
-func (m *TaskInstanceMutation) ClearExecutionID() {
-	m.execution_id = nil
-	m.clearedFields[taskinstance.FieldExecutionID] = struct{}{}
+// ClearEmail clears the value of the "email" field.
+func (m *UserMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[user.FieldEmail] = struct{}{}
 }
 
-// ExecutionIDCleared returns if the "execution_id" field was cleared in this mutation.
-func (m *TaskInstanceMutation) ExecutionIDCleared() bool {
-	_, ok := m.clearedFields[taskinstance.FieldExecutionID]
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *UserMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[user.FieldEmail]
 	return ok
 }
 
-// ResetExecutionID resets all changes to the "execution_id" field.
-func (m *TaskInstanceMutation) ResetExecutionID() {
-	m.execution_id = nil
-	delete(m.clearedFields, taskinstance.FieldExecutionID)
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, user.FieldEmail)
 }
 
-// SetProcessInstanceID sets the "process_instance_id" field.
-func (m *TaskInstanceMutation) SetProcessInstanceID(i int64) {
-	m.process_instance_id = &i
-	m.addprocess_instance_id = nil
+// SetDisplayName sets the "display_name" field.
+func (m *UserMutation) SetDisplayName(s string) {
+	m.display_name = &s
 }
 
-// ProcessInstanceID returns the value of the "process_instance_id" field in the mutation.
-func (m *TaskInstanceMutation) ProcessInstanceID() (r int64, exists bool) {
-	v := m.process_instance_id
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *UserMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessInstanceID returns the old "process_instance_id" field's value of the TaskInstance entity.
-// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldDisplayName returns the old "display_name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldProcessInstanceID(ctx context.Context) (v int64, err error) {
+func (m *UserMutation) OldDisplayName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessInstanceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessInstanceID requires an ID field in the mutation")
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessInstanceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
 	}
-	return oldValue.ProcessInstanceID, nil
+	return oldValue.DisplayName, nil
 }
 
-// AddProcessInstanceID adds i to the "process_instance_id" field.
-func (m *TaskInstanceMutation) AddProcessInstanceID(i int64) {
-	if m.addprocess_instance_id != nil {
-		*m.addprocess_instance_id += i
-	} else {
-		m.addprocess_instance_id = &i
-	}
+// ClearDisplayName clears the value of the "display_name" field.
+func (m *UserMutation) ClearDisplayName() {
+	m.display_name = nil
+	m.clearedFields[user.FieldDisplayName] = struct{}{}
 }
 
-// AddedProcessInstanceID returns the value that was added to the "process_instance_id" field in this mutation.
-func (m *TaskInstanceMutation) AddedProcessInstanceID() (r int64, exists bool) {
-	v := m.addprocess_instance_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// DisplayNameCleared returns if the "display_name" field was cleared in this mutation.
+func (m *UserMutation) DisplayNameCleared() bool {
+	_, ok := m.clearedFields[user.FieldDisplayName]
+	return ok
 }
 
-// ResetProcessInstanceID resets all changes to the "process_instance_id" field.
-func (m *TaskInstanceMutation) ResetProcessInstanceID() {
-	m.process_instance_id = nil
-	m.addprocess_instance_id = nil
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *UserMutation) ResetDisplayName() {
+	m.display_name = nil
+	delete(m.clearedFields, user.FieldDisplayName)
 }
 
-// SetProcessDefinitionID sets the "process_definition_id" field.
-func (m *TaskInstanceMutation) SetProcessDefinitionID(i int64) {
-	m.process_definition_id = &i
-	m.addprocess_definition_id = nil
+// SetPasswordHash sets the "password_hash" field.
+func (m *UserMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
 }
 
-// ProcessDefinitionID returns the value of the "process_definition_id" field in the mutation.
-func (m *TaskInstanceMutation) ProcessDefinitionID() (r int64, exists bool) {
-	v := m.process_definition_id
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *UserMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessDefinitionID returns the old "process_definition_id" field's value of the TaskInstance entity.
-// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordHash returns the old "password_hash" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldProcessDefinitionID(ctx context.Context) (v int64, err error) {
+func (m *UserMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessDefinitionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessDefinitionID requires an ID field in the mutation")
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessDefinitionID: %w", err)
-	}
-	return oldValue.ProcessDefinitionID, nil
-}
-
-// AddProcessDefinitionID adds i to the "process_definition_id" field.
-func (m *TaskInstanceMutation) AddProcessDefinitionID(i int64) {
-	if m.addprocess_definition_id != nil {
-		*m.addprocess_definition_id += i
-	} else {
-		m.addprocess_definition_id = &i
-	}
-}
-
-// AddedProcessDefinitionID returns the value that was added to the "process_definition_id" field in this mutation.
-func (m *TaskInstanceMutation) AddedProcessDefinitionID() (r int64, exists bool) {
-	v := m.addprocess_definition_id
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
 	}
-	return *v, true
+	return oldValue.PasswordHash, nil
 }
 
-// ResetProcessDefinitionID resets all changes to the "process_definition_id" field.
-func (m *TaskInstanceMutation) ResetProcessDefinitionID() {
-	m.process_definition_id = nil
-	m.addprocess_definition_id = nil
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *UserMutation) ResetPasswordHash() {
+	m.password_hash = nil
 }
 
-// SetProcessDefinitionKey sets the "process_definition_key" field.
-func (m *TaskInstanceMutation) SetProcessDefinitionKey(s string) {
-	m.process_definition_key = &s
+// SetStatus sets the "status" field.
+func (m *UserMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// ProcessDefinitionKey returns the value of the "process_definition_key" field in the mutation.
-func (m *TaskInstanceMutation) ProcessDefinitionKey() (r string, exists bool) {
-	v := m.process_definition_key
+// Status returns the value of the "status" field in the mutation.
+func (m *UserMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessDefinitionKey returns the old "process_definition_key" field's value of the TaskInstance entity.
-// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldProcessDefinitionKey(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessDefinitionKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessDefinitionKey requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessDefinitionKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.ProcessDefinitionKey, nil
+	return oldValue.Status, nil
 }
 
-// ResetProcessDefinitionKey resets all changes to the "process_definition_key" field.
-func (m *TaskInstanceMutation) ResetProcessDefinitionKey() {
-	m.process_definition_key = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *UserMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetCaseExecutionID sets the "case_execution_id" field.
-func (m *TaskInstanceMutation) SetCaseExecutionID(s string) {
-	m.case_execution_id = &s
+// SetRoles sets the "roles" field.
+func (m *UserMutation) SetRoles(s []string) {
+	m.roles = &s
+	m.appendroles = nil
 }
 
-// CaseExecutionID returns the value of the "case_execution_id" field in the mutation.
-func (m *TaskInstanceMutation) CaseExecutionID() (r string, exists bool) {
-	v := m.case_execution_id
+// Roles returns the value of the "roles" field in the mutation.
+func (m *UserMutation) Roles() (r []string, exists bool) {
+	v := m.roles
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCaseExecutionID returns the old "case_execution_id" field's value of the TaskInstance entity.
-// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldRoles returns the old "roles" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldCaseExecutionID(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldRoles(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCaseExecutionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldRoles is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCaseExecutionID requires an ID field in the mutation")
+		return v, errors.New("OldRoles requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCaseExecutionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldRoles: %w", err)
 	}
-	return oldValue.CaseExecutionID, nil
+	return oldValue.Roles, nil
 }
 
-// ClearCaseExecutionID clears the value of the "case_execution_id" field.
-func (m *TaskInstanceMutation) ClearCaseExecutionID() {
-	m.case_execution_id = nil
-	m.clearedFields[taskinstance.FieldCaseExecutionID] = struct{}{}
+// AppendRoles adds s to the "roles" field.
+func (m *UserMutation) AppendRoles(s []string) {
+	m.appendroles = append(m.appendroles, s...)
 }
 
-// CaseExecutionIDCleared returns if the "case_execution_id" field was cleared in this mutation.
-func (m *TaskInstanceMutation) CaseExecutionIDCleared() bool {
-	_, ok := m.clearedFields[taskinstance.FieldCaseExecutionID]
+// AppendedRoles returns the list of values that were appended to the "roles" field in this mutation.
+func (m *UserMutation) AppendedRoles() ([]string, bool) {
+	if len(m.appendroles) == 0 {
+		return nil, false
+	}
+	return m.appendroles, true
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (m *UserMutation) ClearRoles() {
+	m.roles = nil
+	m.appendroles = nil
+	m.clearedFields[user.FieldRoles] = struct{}{}
+}
+
+// RolesCleared returns if the "roles" field was cleared in this mutation.
+func (m *UserMutation) RolesCleared() bool {
+	_, ok := m.clearedFields[user.FieldRoles]
 	return ok
 }
 
-// ResetCaseExecutionID resets all changes to the "case_execution_id" field.
-func (m *TaskInstanceMutation) ResetCaseExecutionID() {
-	m.case_execution_id = nil
-	delete(m.clearedFields, taskinstance.FieldCaseExecutionID)
+// ResetRoles resets all changes to the "roles" field.
+func (m *UserMutation) ResetRoles() {
+	m.roles = nil
+	m.appendroles = nil
+	delete(m.clearedFields, user.FieldRoles)
 }
 
-// SetCaseInstanceID sets the "case_instance_id" field.
-func (m *TaskInstanceMutation) SetCaseInstanceID(s string) {
-	m.case_instance_id = &s
+// SetGroups sets the "groups" field.
+func (m *UserMutation) SetGroups(s []string) {
+	m.groups = &s
+	m.appendgroups = nil
 }
 
-// CaseInstanceID returns the value of the "case_instance_id" field in the mutation.
-func (m *TaskInstanceMutation) CaseInstanceID() (r string, exists bool) {
-	v := m.case_instance_id
+// Groups returns the value of the "groups" field in the mutation.
+func (m *UserMutation) Groups() (r []string, exists bool) {
+	v := m.groups
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCaseInstanceID returns the old "case_instance_id" field's value of the TaskInstance entity.
-// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldGroups returns the old "groups" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldCaseInstanceID(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldGroups(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCaseInstanceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldGroups is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCaseInstanceID requires an ID field in the mutation")
+		return v, errors.New("OldGroups requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCaseInstanceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldGroups: %w", err)
 	}
-	return oldValue.CaseInstanceID, nil
+	return oldValue.Groups, nil
 }
 
-// ClearCaseInstanceID clears the value of the "case_instance_id" field.
-func (m *TaskInstanceMutation) ClearCaseInstanceID() {
-	m.case_instance_id = nil
-	m.clearedFields[taskinstance.FieldCaseInstanceID] = struct{}{}
+// AppendGroups adds s to the "groups" field.
+func (m *UserMutation) AppendGroups(s []string) {
+	m.appendgroups = append(m.appendgroups, s...)
 }
 
-// CaseInstanceIDCleared returns if the "case_instance_id" field was cleared in this mutation.
-func (m *TaskInstanceMutation) CaseInstanceIDCleared() bool {
-	_, ok := m.clearedFields[taskinstance.FieldCaseInstanceID]
+// AppendedGroups returns the list of values that were appended to the "groups" field in this mutation.
+func (m *UserMutation) AppendedGroups() ([]string, bool) {
+	if len(m.appendgroups) == 0 {
+		return nil, false
+	}
+	return m.appendgroups, true
+}
+
+// ClearGroups clears the value of the "groups" field.
+func (m *UserMutation) ClearGroups() {
+	m.groups = nil
+	m.appendgroups = nil
+	m.clearedFields[user.FieldGroups] = struct{}{}
+}
+
+// GroupsCleared returns if the "groups" field was cleared in this mutation.
+func (m *UserMutation) GroupsCleared() bool {
+	_, ok := m.clearedFields[user.FieldGroups]
 	return ok
 }
 
-// ResetCaseInstanceID resets all changes to the "case_instance_id" field.
-func (m *TaskInstanceMutation) ResetCaseInstanceID() {
-	m.case_instance_id = nil
-	delete(m.clearedFields, taskinstance.FieldCaseInstanceID)
+// ResetGroups resets all changes to the "groups" field.
+func (m *UserMutation) ResetGroups() {
+	m.groups = nil
+	m.appendgroups = nil
+	delete(m.clearedFields, user.FieldGroups)
 }
 
-// SetCaseDefinitionID sets the "case_definition_id" field.
-func (m *TaskInstanceMutation) SetCaseDefinitionID(s string) {
-	m.case_definition_id = &s
+// SetTenantID sets the "tenant_id" field.
+func (m *UserMutation) SetTenantID(s string) {
+	m.tenant_id = &s
 }
 
-// CaseDefinitionID returns the value of the "case_definition_id" field in the mutation.
-func (m *TaskInstanceMutation) CaseDefinitionID() (r string, exists bool) {
-	v := m.case_definition_id
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *UserMutation) TenantID() (r string, exists bool) {
+	v := m.tenant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCaseDefinitionID returns the old "case_definition_id" field's value of the TaskInstance entity.
-// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldTenantID returns the old "tenant_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldCaseDefinitionID(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldTenantID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCaseDefinitionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCaseDefinitionID requires an ID field in the mutation")
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCaseDefinitionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
 	}
-	return oldValue.CaseDefinitionID, nil
-}
-
-// ClearCaseDefinitionID clears the value of the "case_definition_id" field.
-func (m *TaskInstanceMutation) ClearCaseDefinitionID() {
-	m.case_definition_id = nil
-	m.clearedFields[taskinstance.FieldCaseDefinitionID] = struct{}{}
-}
-
-// CaseDefinitionIDCleared returns if the "case_definition_id" field was cleared in this mutation.
-func (m *TaskInstanceMutation) CaseDefinitionIDCleared() bool {
-	_, ok := m.clearedFields[taskinstance.FieldCaseDefinitionID]
-	return ok
+	return oldValue.TenantID, nil
 }
 
-// ResetCaseDefinitionID resets all changes to the "case_definition_id" field.
-func (m *TaskInstanceMutation) ResetCaseDefinitionID() {
-	m.case_definition_id = nil
-	delete(m.clearedFields, taskinstance.FieldCaseDefinitionID)
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *UserMutation) ResetTenantID() {
+	m.tenant_id = nil
 }
 
-// SetSuspended sets the "suspended" field.
-func (m *TaskInstanceMutation) SetSuspended(b bool) {
-	m.suspended = &b
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (m *UserMutation) SetPasswordChangedAt(t time.Time) {
+	m.password_changed_at = &t
 }
 
-// Suspended returns the value of the "suspended" field in the mutation.
-func (m *TaskInstanceMutation) Suspended() (r bool, exists bool) {
-	v := m.suspended
+// PasswordChangedAt returns the value of the "password_changed_at" field in the mutation.
+func (m *UserMutation) PasswordChangedAt() (r time.Time, exists bool) {
+	v := m.password_changed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSuspended returns the old "suspended" field's value of the TaskInstance entity.
-// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordChangedAt returns the old "password_changed_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldSuspended(ctx context.Context) (v bool, err error) {
+func (m *UserMutation) OldPasswordChangedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSuspended is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordChangedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSuspended requires an ID field in the mutation")
+		return v, errors.New("OldPasswordChangedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSuspended: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordChangedAt: %w", err)
 	}
-	return oldValue.Suspended, nil
+	return oldValue.PasswordChangedAt, nil
 }
 
-// ResetSuspended resets all changes to the "suspended" field.
-func (m *TaskInstanceMutation) ResetSuspended() {
-	m.suspended = nil
+// ResetPasswordChangedAt resets all changes to the "password_changed_at" field.
+func (m *UserMutation) ResetPasswordChangedAt() {
+	m.password_changed_at = nil
 }
 
-// SetTenantID sets the "tenant_id" field.
-func (m *TaskInstanceMutation) SetTenantID(s string) {
-	m.tenant_id = &s
+// SetLastLoginAt sets the "last_login_at" field.
+func (m *UserMutation) SetLastLoginAt(t time.Time) {
+	m.last_login_at = &t
 }
 
-// TenantID returns the value of the "tenant_id" field in the mutation.
-func (m *TaskInstanceMutation) TenantID() (r string, exists bool) {
-	v := m.tenant_id
+// LastLoginAt returns the value of the "last_login_at" field in the mutation.
+func (m *UserMutation) LastLoginAt() (r time.Time, exists bool) {
+	v := m.last_login_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTenantID returns the old "tenant_id" field's value of the TaskInstance entity.
-// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldLastLoginAt returns the old "last_login_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldTenantID(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldLastLoginAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastLoginAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTenantID requires an ID field in the mutation")
+		return v, errors.New("OldLastLoginAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastLoginAt: %w", err)
 	}
-	return oldValue.TenantID, nil
+	return oldValue.LastLoginAt, nil
 }
 
-// ResetTenantID resets all changes to the "tenant_id" field.
-func (m *TaskInstanceMutation) ResetTenantID() {
-	m.tenant_id = nil
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (m *UserMutation) ClearLastLoginAt() {
+	m.last_login_at = nil
+	m.clearedFields[user.FieldLastLoginAt] = struct{}{}
+}
+
+// LastLoginAtCleared returns if the "last_login_at" field was cleared in this mutation.
+func (m *UserMutation) LastLoginAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldLastLoginAt]
+	return ok
+}
+
+// ResetLastLoginAt resets all changes to the "last_login_at" field.
+func (m *UserMutation) ResetLastLoginAt() {
+	m.last_login_at = nil
+	delete(m.clearedFields, user.FieldLastLoginAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *TaskInstanceMutation) SetCreatedAt(t time.Time) {
+func (m *UserMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TaskInstanceMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -10021,10 +17312,10 @@ func (m *TaskInstanceMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the TaskInstance entity.
-// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -10039,28 +17330,28 @@ func (m *TaskInstanceMutation) OldCreatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TaskInstanceMutation) ResetCreatedAt() {
+func (m *UserMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *TaskInstanceMutation) SetUpdatedAt(t time.Time) {
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TaskInstanceMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
-
-// OldUpdatedAt returns the old "updated_at" field's value of the TaskInstance entity.
-// If the TaskInstance object wasn't provided to the builder, the object is fetched from the database.
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskInstanceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -10075,19 +17366,19 @@ func (m *TaskInstanceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TaskInstanceMutation) ResetUpdatedAt() {
+func (m *UserMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// Where appends a list predicates to the TaskInstanceMutation builder.
-func (m *TaskInstanceMutation) Where(ps ...predicate.TaskInstance) {
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TaskInstanceMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TaskInstanceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TaskInstance, len(ps))
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -10095,96 +17386,60 @@ func (m *TaskInstanceMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TaskInstanceMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TaskInstanceMutation) SetOp(op Op) {
+func (m *UserMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TaskInstance).
-func (m *TaskInstanceMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TaskInstanceMutation) Fields() []string {
-	fields := make([]string, 0, 24)
-	if m.name != nil {
-		fields = append(fields, taskinstance.FieldName)
-	}
-	if m.description != nil {
-		fields = append(fields, taskinstance.FieldDescription)
-	}
-	if m.task_definition_key != nil {
-		fields = append(fields, taskinstance.FieldTaskDefinitionKey)
-	}
-	if m.assignee != nil {
-		fields = append(fields, taskinstance.FieldAssignee)
-	}
-	if m.owner != nil {
-		fields = append(fields, taskinstance.FieldOwner)
-	}
-	if m.delegation != nil {
-		fields = append(fields, taskinstance.FieldDelegation)
-	}
-	if m.priority != nil {
-		fields = append(fields, taskinstance.FieldPriority)
-	}
-	if m.create_time != nil {
-		fields = append(fields, taskinstance.FieldCreateTime)
-	}
-	if m.due_date != nil {
-		fields = append(fields, taskinstance.FieldDueDate)
-	}
-	if m.follow_up_date != nil {
-		fields = append(fields, taskinstance.FieldFollowUpDate)
-	}
-	if m.form_key != nil {
-		fields = append(fields, taskinstance.FieldFormKey)
-	}
-	if m.category != nil {
-		fields = append(fields, taskinstance.FieldCategory)
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.username != nil {
+		fields = append(fields, user.FieldUsername)
 	}
-	if m.parent_task_id != nil {
-		fields = append(fields, taskinstance.FieldParentTaskID)
-	}
-	if m.execution_id != nil {
-		fields = append(fields, taskinstance.FieldExecutionID)
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
 	}
-	if m.process_instance_id != nil {
-		fields = append(fields, taskinstance.FieldProcessInstanceID)
+	if m.display_name != nil {
+		fields = append(fields, user.FieldDisplayName)
 	}
-	if m.process_definition_id != nil {
-		fields = append(fields, taskinstance.FieldProcessDefinitionID)
+	if m.password_hash != nil {
+		fields = append(fields, user.FieldPasswordHash)
 	}
-	if m.process_definition_key != nil {
-		fields = append(fields, taskinstance.FieldProcessDefinitionKey)
+	if m.status != nil {
+		fields = append(fields, user.FieldStatus)
 	}
-	if m.case_execution_id != nil {
-		fields = append(fields, taskinstance.FieldCaseExecutionID)
+	if m.roles != nil {
+		fields = append(fields, user.FieldRoles)
 	}
-	if m.case_instance_id != nil {
-		fields = append(fields, taskinstance.FieldCaseInstanceID)
+	if m.groups != nil {
+		fields = append(fields, user.FieldGroups)
 	}
-	if m.case_definition_id != nil {
-		fields = append(fields, taskinstance.FieldCaseDefinitionID)
+	if m.tenant_id != nil {
+		fields = append(fields, user.FieldTenantID)
 	}
-	if m.suspended != nil {
-		fields = append(fields, taskinstance.FieldSuspended)
+	if m.password_changed_at != nil {
+		fields = append(fields, user.FieldPasswordChangedAt)
 	}
-	if m.tenant_id != nil {
-		fields = append(fields, taskinstance.FieldTenantID)
+	if m.last_login_at != nil {
+		fields = append(fields, user.FieldLastLoginAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, taskinstance.FieldCreatedAt)
+		fields = append(fields, user.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, taskinstance.FieldUpdatedAt)
+		fields = append(fields, user.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -10192,284 +17447,152 @@ func (m *TaskInstanceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TaskInstanceMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case taskinstance.FieldName:
-		return m.Name()
-	case taskinstance.FieldDescription:
-		return m.Description()
-	case taskinstance.FieldTaskDefinitionKey:
-		return m.TaskDefinitionKey()
-	case taskinstance.FieldAssignee:
-		return m.Assignee()
-	case taskinstance.FieldOwner:
-		return m.Owner()
-	case taskinstance.FieldDelegation:
-		return m.Delegation()
-	case taskinstance.FieldPriority:
-		return m.Priority()
-	case taskinstance.FieldCreateTime:
-		return m.CreateTime()
-	case taskinstance.FieldDueDate:
-		return m.DueDate()
-	case taskinstance.FieldFollowUpDate:
-		return m.FollowUpDate()
-	case taskinstance.FieldFormKey:
-		return m.FormKey()
-	case taskinstance.FieldCategory:
-		return m.Category()
-	case taskinstance.FieldParentTaskID:
-		return m.ParentTaskID()
-	case taskinstance.FieldExecutionID:
-		return m.ExecutionID()
-	case taskinstance.FieldProcessInstanceID:
-		return m.ProcessInstanceID()
-	case taskinstance.FieldProcessDefinitionID:
-		return m.ProcessDefinitionID()
-	case taskinstance.FieldProcessDefinitionKey:
-		return m.ProcessDefinitionKey()
-	case taskinstance.FieldCaseExecutionID:
-		return m.CaseExecutionID()
-	case taskinstance.FieldCaseInstanceID:
-		return m.CaseInstanceID()
-	case taskinstance.FieldCaseDefinitionID:
-		return m.CaseDefinitionID()
-	case taskinstance.FieldSuspended:
-		return m.Suspended()
-	case taskinstance.FieldTenantID:
+	case user.FieldUsername:
+		return m.Username()
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldDisplayName:
+		return m.DisplayName()
+	case user.FieldPasswordHash:
+		return m.PasswordHash()
+	case user.FieldStatus:
+		return m.Status()
+	case user.FieldRoles:
+		return m.Roles()
+	case user.FieldGroups:
+		return m.Groups()
+	case user.FieldTenantID:
 		return m.TenantID()
-	case taskinstance.FieldCreatedAt:
+	case user.FieldPasswordChangedAt:
+		return m.PasswordChangedAt()
+	case user.FieldLastLoginAt:
+		return m.LastLoginAt()
+	case user.FieldCreatedAt:
 		return m.CreatedAt()
-	case taskinstance.FieldUpdatedAt:
-		return m.UpdatedAt()
-	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *TaskInstanceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case taskinstance.FieldName:
-		return m.OldName(ctx)
-	case taskinstance.FieldDescription:
-		return m.OldDescription(ctx)
-	case taskinstance.FieldTaskDefinitionKey:
-		return m.OldTaskDefinitionKey(ctx)
-	case taskinstance.FieldAssignee:
-		return m.OldAssignee(ctx)
-	case taskinstance.FieldOwner:
-		return m.OldOwner(ctx)
-	case taskinstance.FieldDelegation:
-		return m.OldDelegation(ctx)
-	case taskinstance.FieldPriority:
-		return m.OldPriority(ctx)
-	case taskinstance.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case taskinstance.FieldDueDate:
-		return m.OldDueDate(ctx)
-	case taskinstance.FieldFollowUpDate:
-		return m.OldFollowUpDate(ctx)
-	case taskinstance.FieldFormKey:
-		return m.OldFormKey(ctx)
-	case taskinstance.FieldCategory:
-		return m.OldCategory(ctx)
-	case taskinstance.FieldParentTaskID:
-		return m.OldParentTaskID(ctx)
-	case taskinstance.FieldExecutionID:
-		return m.OldExecutionID(ctx)
-	case taskinstance.FieldProcessInstanceID:
-		return m.OldProcessInstanceID(ctx)
-	case taskinstance.FieldProcessDefinitionID:
-		return m.OldProcessDefinitionID(ctx)
-	case taskinstance.FieldProcessDefinitionKey:
-		return m.OldProcessDefinitionKey(ctx)
-	case taskinstance.FieldCaseExecutionID:
-		return m.OldCaseExecutionID(ctx)
-	case taskinstance.FieldCaseInstanceID:
-		return m.OldCaseInstanceID(ctx)
-	case taskinstance.FieldCaseDefinitionID:
-		return m.OldCaseDefinitionID(ctx)
-	case taskinstance.FieldSuspended:
-		return m.OldSuspended(ctx)
-	case taskinstance.FieldTenantID:
-		return m.OldTenantID(ctx)
-	case taskinstance.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case taskinstance.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown TaskInstance field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TaskInstanceMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case taskinstance.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case taskinstance.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case taskinstance.FieldTaskDefinitionKey:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTaskDefinitionKey(v)
-		return nil
-	case taskinstance.FieldAssignee:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAssignee(v)
-		return nil
-	case taskinstance.FieldOwner:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOwner(v)
-		return nil
-	case taskinstance.FieldDelegation:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDelegation(v)
-		return nil
-	case taskinstance.FieldPriority:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPriority(v)
-		return nil
-	case taskinstance.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateTime(v)
-		return nil
-	case taskinstance.FieldDueDate:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDueDate(v)
-		return nil
-	case taskinstance.FieldFollowUpDate:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFollowUpDate(v)
-		return nil
-	case taskinstance.FieldFormKey:
+	case user.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldUsername:
+		return m.OldUsername(ctx)
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case user.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case user.FieldStatus:
+		return m.OldStatus(ctx)
+	case user.FieldRoles:
+		return m.OldRoles(ctx)
+	case user.FieldGroups:
+		return m.OldGroups(ctx)
+	case user.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case user.FieldPasswordChangedAt:
+		return m.OldPasswordChangedAt(ctx)
+	case user.FieldLastLoginAt:
+		return m.OldLastLoginAt(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldUsername:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFormKey(v)
+		m.SetUsername(v)
 		return nil
-	case taskinstance.FieldCategory:
+	case user.FieldEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCategory(v)
+		m.SetEmail(v)
 		return nil
-	case taskinstance.FieldParentTaskID:
+	case user.FieldDisplayName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetParentTaskID(v)
+		m.SetDisplayName(v)
 		return nil
-	case taskinstance.FieldExecutionID:
+	case user.FieldPasswordHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExecutionID(v)
-		return nil
-	case taskinstance.FieldProcessInstanceID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProcessInstanceID(v)
-		return nil
-	case taskinstance.FieldProcessDefinitionID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProcessDefinitionID(v)
+		m.SetPasswordHash(v)
 		return nil
-	case taskinstance.FieldProcessDefinitionKey:
+	case user.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProcessDefinitionKey(v)
+		m.SetStatus(v)
 		return nil
-	case taskinstance.FieldCaseExecutionID:
-		v, ok := value.(string)
+	case user.FieldRoles:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCaseExecutionID(v)
+		m.SetRoles(v)
 		return nil
-	case taskinstance.FieldCaseInstanceID:
-		v, ok := value.(string)
+	case user.FieldGroups:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCaseInstanceID(v)
+		m.SetGroups(v)
 		return nil
-	case taskinstance.FieldCaseDefinitionID:
+	case user.FieldTenantID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCaseDefinitionID(v)
+		m.SetTenantID(v)
 		return nil
-	case taskinstance.FieldSuspended:
-		v, ok := value.(bool)
+	case user.FieldPasswordChangedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSuspended(v)
+		m.SetPasswordChangedAt(v)
 		return nil
-	case taskinstance.FieldTenantID:
-		v, ok := value.(string)
+	case user.FieldLastLoginAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTenantID(v)
+		m.SetLastLoginAt(v)
 		return nil
-	case taskinstance.FieldCreatedAt:
+	case user.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case taskinstance.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -10477,300 +17600,171 @@ func (m *TaskInstanceMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TaskInstance field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TaskInstanceMutation) AddedFields() []string {
-	var fields []string
-	if m.addpriority != nil {
-		fields = append(fields, taskinstance.FieldPriority)
-	}
-	if m.addprocess_instance_id != nil {
-		fields = append(fields, taskinstance.FieldProcessInstanceID)
-	}
-	if m.addprocess_definition_id != nil {
-		fields = append(fields, taskinstance.FieldProcessDefinitionID)
-	}
-	return fields
+func (m *UserMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TaskInstanceMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case taskinstance.FieldPriority:
-		return m.AddedPriority()
-	case taskinstance.FieldProcessInstanceID:
-		return m.AddedProcessInstanceID()
-	case taskinstance.FieldProcessDefinitionID:
-		return m.AddedProcessDefinitionID()
-	}
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TaskInstanceMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case taskinstance.FieldPriority:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPriority(v)
-		return nil
-	case taskinstance.FieldProcessInstanceID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddProcessInstanceID(v)
-		return nil
-	case taskinstance.FieldProcessDefinitionID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddProcessDefinitionID(v)
-		return nil
 	}
-	return fmt.Errorf("unknown TaskInstance numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TaskInstanceMutation) ClearedFields() []string {
+func (m *UserMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(taskinstance.FieldName) {
-		fields = append(fields, taskinstance.FieldName)
-	}
-	if m.FieldCleared(taskinstance.FieldDescription) {
-		fields = append(fields, taskinstance.FieldDescription)
-	}
-	if m.FieldCleared(taskinstance.FieldAssignee) {
-		fields = append(fields, taskinstance.FieldAssignee)
-	}
-	if m.FieldCleared(taskinstance.FieldOwner) {
-		fields = append(fields, taskinstance.FieldOwner)
-	}
-	if m.FieldCleared(taskinstance.FieldDelegation) {
-		fields = append(fields, taskinstance.FieldDelegation)
-	}
-	if m.FieldCleared(taskinstance.FieldDueDate) {
-		fields = append(fields, taskinstance.FieldDueDate)
-	}
-	if m.FieldCleared(taskinstance.FieldFollowUpDate) {
-		fields = append(fields, taskinstance.FieldFollowUpDate)
+	if m.FieldCleared(user.FieldEmail) {
+		fields = append(fields, user.FieldEmail)
 	}
-	if m.FieldCleared(taskinstance.FieldFormKey) {
-		fields = append(fields, taskinstance.FieldFormKey)
-	}
-	if m.FieldCleared(taskinstance.FieldCategory) {
-		fields = append(fields, taskinstance.FieldCategory)
-	}
-	if m.FieldCleared(taskinstance.FieldParentTaskID) {
-		fields = append(fields, taskinstance.FieldParentTaskID)
+	if m.FieldCleared(user.FieldDisplayName) {
+		fields = append(fields, user.FieldDisplayName)
 	}
-	if m.FieldCleared(taskinstance.FieldExecutionID) {
-		fields = append(fields, taskinstance.FieldExecutionID)
-	}
-	if m.FieldCleared(taskinstance.FieldCaseExecutionID) {
-		fields = append(fields, taskinstance.FieldCaseExecutionID)
+	if m.FieldCleared(user.FieldRoles) {
+		fields = append(fields, user.FieldRoles)
 	}
-	if m.FieldCleared(taskinstance.FieldCaseInstanceID) {
-		fields = append(fields, taskinstance.FieldCaseInstanceID)
+	if m.FieldCleared(user.FieldGroups) {
+		fields = append(fields, user.FieldGroups)
 	}
-	if m.FieldCleared(taskinstance.FieldCaseDefinitionID) {
-		fields = append(fields, taskinstance.FieldCaseDefinitionID)
+	if m.FieldCleared(user.FieldLastLoginAt) {
+		fields = append(fields, user.FieldLastLoginAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TaskInstanceMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TaskInstanceMutation) ClearField(name string) error {
+func (m *UserMutation) ClearField(name string) error {
 	switch name {
-	case taskinstance.FieldName:
-		m.ClearName()
-		return nil
-	case taskinstance.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case taskinstance.FieldAssignee:
-		m.ClearAssignee()
-		return nil
-	case taskinstance.FieldOwner:
-		m.ClearOwner()
-		return nil
-	case taskinstance.FieldDelegation:
-		m.ClearDelegation()
-		return nil
-	case taskinstance.FieldDueDate:
-		m.ClearDueDate()
-		return nil
-	case taskinstance.FieldFollowUpDate:
-		m.ClearFollowUpDate()
-		return nil
-	case taskinstance.FieldFormKey:
-		m.ClearFormKey()
-		return nil
-	case taskinstance.FieldCategory:
-		m.ClearCategory()
-		return nil
-	case taskinstance.FieldParentTaskID:
-		m.ClearParentTaskID()
+	case user.FieldEmail:
+		m.ClearEmail()
 		return nil
-	case taskinstance.FieldExecutionID:
-		m.ClearExecutionID()
+	case user.FieldDisplayName:
+		m.ClearDisplayName()
 		return nil
-	case taskinstance.FieldCaseExecutionID:
-		m.ClearCaseExecutionID()
+	case user.FieldRoles:
+		m.ClearRoles()
 		return nil
-	case taskinstance.FieldCaseInstanceID:
-		m.ClearCaseInstanceID()
+	case user.FieldGroups:
+		m.ClearGroups()
 		return nil
-	case taskinstance.FieldCaseDefinitionID:
-		m.ClearCaseDefinitionID()
+	case user.FieldLastLoginAt:
+		m.ClearLastLoginAt()
 		return nil
 	}
-	return fmt.Errorf("unknown TaskInstance nullable field %s", name)
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TaskInstanceMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case taskinstance.FieldName:
-		m.ResetName()
-		return nil
-	case taskinstance.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case taskinstance.FieldTaskDefinitionKey:
-		m.ResetTaskDefinitionKey()
-		return nil
-	case taskinstance.FieldAssignee:
-		m.ResetAssignee()
-		return nil
-	case taskinstance.FieldOwner:
-		m.ResetOwner()
-		return nil
-	case taskinstance.FieldDelegation:
-		m.ResetDelegation()
-		return nil
-	case taskinstance.FieldPriority:
-		m.ResetPriority()
-		return nil
-	case taskinstance.FieldCreateTime:
-		m.ResetCreateTime()
-		return nil
-	case taskinstance.FieldDueDate:
-		m.ResetDueDate()
-		return nil
-	case taskinstance.FieldFollowUpDate:
-		m.ResetFollowUpDate()
-		return nil
-	case taskinstance.FieldFormKey:
-		m.ResetFormKey()
-		return nil
-	case taskinstance.FieldCategory:
-		m.ResetCategory()
-		return nil
-	case taskinstance.FieldParentTaskID:
-		m.ResetParentTaskID()
+	case user.FieldUsername:
+		m.ResetUsername()
 		return nil
-	case taskinstance.FieldExecutionID:
-		m.ResetExecutionID()
+	case user.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case taskinstance.FieldProcessInstanceID:
-		m.ResetProcessInstanceID()
+	case user.FieldDisplayName:
+		m.ResetDisplayName()
 		return nil
-	case taskinstance.FieldProcessDefinitionID:
-		m.ResetProcessDefinitionID()
+	case user.FieldPasswordHash:
+		m.ResetPasswordHash()
 		return nil
-	case taskinstance.FieldProcessDefinitionKey:
-		m.ResetProcessDefinitionKey()
+	case user.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case taskinstance.FieldCaseExecutionID:
-		m.ResetCaseExecutionID()
+	case user.FieldRoles:
+		m.ResetRoles()
 		return nil
-	case taskinstance.FieldCaseInstanceID:
-		m.ResetCaseInstanceID()
+	case user.FieldGroups:
+		m.ResetGroups()
 		return nil
-	case taskinstance.FieldCaseDefinitionID:
-		m.ResetCaseDefinitionID()
+	case user.FieldTenantID:
+		m.ResetTenantID()
 		return nil
-	case taskinstance.FieldSuspended:
-		m.ResetSuspended()
+	case user.FieldPasswordChangedAt:
+		m.ResetPasswordChangedAt()
 		return nil
-	case taskinstance.FieldTenantID:
-		m.ResetTenantID()
+	case user.FieldLastLoginAt:
+		m.ResetLastLoginAt()
 		return nil
-	case taskinstance.FieldCreatedAt:
+	case user.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case taskinstance.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown TaskInstance field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TaskInstanceMutation) AddedEdges() []string {
+func (m *UserMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TaskInstanceMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TaskInstanceMutation) RemovedEdges() []string {
+func (m *UserMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TaskInstanceMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TaskInstanceMutation) ClearedEdges() []string {
+func (m *UserMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TaskInstanceMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TaskInstanceMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown TaskInstance unique edge %s", name)
+func (m *UserMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TaskInstanceMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown TaskInstance edge %s", name)
+func (m *UserMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown User edge %s", name)
 }
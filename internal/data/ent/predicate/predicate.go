@@ -6,6 +6,12 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Authorization is the predicate function for authorization builders.
+type Authorization func(*sql.Selector)
+
+// Group is the predicate function for group builders.
+type Group func(*sql.Selector)
+
 // HistoricProcessInstance is the predicate function for historicprocessinstance builders.
 type HistoricProcessInstance func(*sql.Selector)
 
@@ -21,5 +27,23 @@ type ProcessInstance func(*sql.Selector)
 // ProcessVariable is the predicate function for processvariable builders.
 type ProcessVariable func(*sql.Selector)
 
+// RefreshToken is the predicate function for refreshtoken builders.
+type RefreshToken func(*sql.Selector)
+
+// Role is the predicate function for role builders.
+type Role func(*sql.Selector)
+
+// TaskAttachment is the predicate function for taskattachment builders.
+type TaskAttachment func(*sql.Selector)
+
+// TaskComment is the predicate function for taskcomment builders.
+type TaskComment func(*sql.Selector)
+
+// TaskIdentityLink is the predicate function for taskidentitylink builders.
+type TaskIdentityLink func(*sql.Selector)
+
 // TaskInstance is the predicate function for taskinstance builders.
 type TaskInstance func(*sql.Selector)
+
+// User is the predicate function for user builders.
+type User func(*sql.Selector)
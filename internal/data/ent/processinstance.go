@@ -60,6 +60,10 @@ type ProcessInstance struct {
 	ReferenceID string `json:"reference_id,omitempty"`
 	// 引用类型
 	ReferenceType string `json:"reference_type,omitempty"`
+	// Temporal工作流ID
+	WorkflowID string `json:"workflow_id,omitempty"`
+	// Temporal工作流运行ID
+	WorkflowRunID string `json:"workflow_run_id,omitempty"`
 	// 创建时间
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// 更新时间
@@ -76,7 +80,7 @@ func (*ProcessInstance) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case processinstance.FieldID, processinstance.FieldProcessDefinitionID, processinstance.FieldProcessDefinitionVersion, processinstance.FieldDuration:
 			values[i] = new(sql.NullInt64)
-		case processinstance.FieldBusinessKey, processinstance.FieldProcessDefinitionKey, processinstance.FieldProcessDefinitionName, processinstance.FieldDeploymentID, processinstance.FieldStartUserID, processinstance.FieldDeleteReason, processinstance.FieldSuperProcessInstanceID, processinstance.FieldRootProcessInstanceID, processinstance.FieldTenantID, processinstance.FieldName, processinstance.FieldDescription, processinstance.FieldCallbackID, processinstance.FieldCallbackType, processinstance.FieldReferenceID, processinstance.FieldReferenceType:
+		case processinstance.FieldBusinessKey, processinstance.FieldProcessDefinitionKey, processinstance.FieldProcessDefinitionName, processinstance.FieldDeploymentID, processinstance.FieldStartUserID, processinstance.FieldDeleteReason, processinstance.FieldSuperProcessInstanceID, processinstance.FieldRootProcessInstanceID, processinstance.FieldTenantID, processinstance.FieldName, processinstance.FieldDescription, processinstance.FieldCallbackID, processinstance.FieldCallbackType, processinstance.FieldReferenceID, processinstance.FieldReferenceType, processinstance.FieldWorkflowID, processinstance.FieldWorkflowRunID:
 			values[i] = new(sql.NullString)
 		case processinstance.FieldStartTime, processinstance.FieldEndTime, processinstance.FieldCreatedAt, processinstance.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -228,6 +232,18 @@ func (pi *ProcessInstance) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pi.ReferenceType = value.String
 			}
+		case processinstance.FieldWorkflowID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field workflow_id", values[i])
+			} else if value.Valid {
+				pi.WorkflowID = value.String
+			}
+		case processinstance.FieldWorkflowRunID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field workflow_run_id", values[i])
+			} else if value.Valid {
+				pi.WorkflowRunID = value.String
+			}
 		case processinstance.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -341,6 +357,12 @@ func (pi *ProcessInstance) String() string {
 	builder.WriteString("reference_type=")
 	builder.WriteString(pi.ReferenceType)
 	builder.WriteString(", ")
+	builder.WriteString("workflow_id=")
+	builder.WriteString(pi.WorkflowID)
+	builder.WriteString(", ")
+	builder.WriteString("workflow_run_id=")
+	builder.WriteString(pi.WorkflowRunID)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(pi.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -55,6 +55,10 @@ const (
 	FieldReferenceID = "reference_id"
 	// FieldReferenceType holds the string denoting the reference_type field in the database.
 	FieldReferenceType = "reference_type"
+	// FieldWorkflowID holds the string denoting the workflow_id field in the database.
+	FieldWorkflowID = "workflow_id"
+	// FieldWorkflowRunID holds the string denoting the workflow_run_id field in the database.
+	FieldWorkflowRunID = "workflow_run_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -87,6 +91,8 @@ var Columns = []string{
 	FieldCallbackType,
 	FieldReferenceID,
 	FieldReferenceType,
+	FieldWorkflowID,
+	FieldWorkflowRunID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -136,6 +142,10 @@ var (
 	ReferenceIDValidator func(string) error
 	// ReferenceTypeValidator is a validator for the "reference_type" field. It is called by the builders before save.
 	ReferenceTypeValidator func(string) error
+	// WorkflowIDValidator is a validator for the "workflow_id" field. It is called by the builders before save.
+	WorkflowIDValidator func(string) error
+	// WorkflowRunIDValidator is a validator for the "workflow_run_id" field. It is called by the builders before save.
+	WorkflowRunIDValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -257,6 +267,16 @@ func ByReferenceType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldReferenceType, opts...).ToFunc()
 }
 
+// ByWorkflowID orders the results by the workflow_id field.
+func ByWorkflowID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkflowID, opts...).ToFunc()
+}
+
+// ByWorkflowRunID orders the results by the workflow_run_id field.
+func ByWorkflowRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkflowRunID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
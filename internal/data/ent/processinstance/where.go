@@ -159,6 +159,16 @@ func ReferenceType(v string) predicate.ProcessInstance {
 	return predicate.ProcessInstance(sql.FieldEQ(FieldReferenceType, v))
 }
 
+// WorkflowID applies equality check predicate on the "workflow_id" field. It's identical to WorkflowIDEQ.
+func WorkflowID(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldEQ(FieldWorkflowID, v))
+}
+
+// WorkflowRunID applies equality check predicate on the "workflow_run_id" field. It's identical to WorkflowRunIDEQ.
+func WorkflowRunID(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldEQ(FieldWorkflowRunID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.ProcessInstance {
 	return predicate.ProcessInstance(sql.FieldEQ(FieldCreatedAt, v))
@@ -1504,6 +1514,156 @@ func ReferenceTypeContainsFold(v string) predicate.ProcessInstance {
 	return predicate.ProcessInstance(sql.FieldContainsFold(FieldReferenceType, v))
 }
 
+// WorkflowIDEQ applies the EQ predicate on the "workflow_id" field.
+func WorkflowIDEQ(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldEQ(FieldWorkflowID, v))
+}
+
+// WorkflowIDNEQ applies the NEQ predicate on the "workflow_id" field.
+func WorkflowIDNEQ(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldNEQ(FieldWorkflowID, v))
+}
+
+// WorkflowIDIn applies the In predicate on the "workflow_id" field.
+func WorkflowIDIn(vs ...string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldIn(FieldWorkflowID, vs...))
+}
+
+// WorkflowIDNotIn applies the NotIn predicate on the "workflow_id" field.
+func WorkflowIDNotIn(vs ...string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldNotIn(FieldWorkflowID, vs...))
+}
+
+// WorkflowIDGT applies the GT predicate on the "workflow_id" field.
+func WorkflowIDGT(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldGT(FieldWorkflowID, v))
+}
+
+// WorkflowIDGTE applies the GTE predicate on the "workflow_id" field.
+func WorkflowIDGTE(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldGTE(FieldWorkflowID, v))
+}
+
+// WorkflowIDLT applies the LT predicate on the "workflow_id" field.
+func WorkflowIDLT(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldLT(FieldWorkflowID, v))
+}
+
+// WorkflowIDLTE applies the LTE predicate on the "workflow_id" field.
+func WorkflowIDLTE(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldLTE(FieldWorkflowID, v))
+}
+
+// WorkflowIDContains applies the Contains predicate on the "workflow_id" field.
+func WorkflowIDContains(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldContains(FieldWorkflowID, v))
+}
+
+// WorkflowIDHasPrefix applies the HasPrefix predicate on the "workflow_id" field.
+func WorkflowIDHasPrefix(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldHasPrefix(FieldWorkflowID, v))
+}
+
+// WorkflowIDHasSuffix applies the HasSuffix predicate on the "workflow_id" field.
+func WorkflowIDHasSuffix(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldHasSuffix(FieldWorkflowID, v))
+}
+
+// WorkflowIDIsNil applies the IsNil predicate on the "workflow_id" field.
+func WorkflowIDIsNil() predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldIsNull(FieldWorkflowID))
+}
+
+// WorkflowIDNotNil applies the NotNil predicate on the "workflow_id" field.
+func WorkflowIDNotNil() predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldNotNull(FieldWorkflowID))
+}
+
+// WorkflowIDEqualFold applies the EqualFold predicate on the "workflow_id" field.
+func WorkflowIDEqualFold(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldEqualFold(FieldWorkflowID, v))
+}
+
+// WorkflowIDContainsFold applies the ContainsFold predicate on the "workflow_id" field.
+func WorkflowIDContainsFold(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldContainsFold(FieldWorkflowID, v))
+}
+
+// WorkflowRunIDEQ applies the EQ predicate on the "workflow_run_id" field.
+func WorkflowRunIDEQ(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldEQ(FieldWorkflowRunID, v))
+}
+
+// WorkflowRunIDNEQ applies the NEQ predicate on the "workflow_run_id" field.
+func WorkflowRunIDNEQ(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldNEQ(FieldWorkflowRunID, v))
+}
+
+// WorkflowRunIDIn applies the In predicate on the "workflow_run_id" field.
+func WorkflowRunIDIn(vs ...string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldIn(FieldWorkflowRunID, vs...))
+}
+
+// WorkflowRunIDNotIn applies the NotIn predicate on the "workflow_run_id" field.
+func WorkflowRunIDNotIn(vs ...string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldNotIn(FieldWorkflowRunID, vs...))
+}
+
+// WorkflowRunIDGT applies the GT predicate on the "workflow_run_id" field.
+func WorkflowRunIDGT(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldGT(FieldWorkflowRunID, v))
+}
+
+// WorkflowRunIDGTE applies the GTE predicate on the "workflow_run_id" field.
+func WorkflowRunIDGTE(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldGTE(FieldWorkflowRunID, v))
+}
+
+// WorkflowRunIDLT applies the LT predicate on the "workflow_run_id" field.
+func WorkflowRunIDLT(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldLT(FieldWorkflowRunID, v))
+}
+
+// WorkflowRunIDLTE applies the LTE predicate on the "workflow_run_id" field.
+func WorkflowRunIDLTE(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldLTE(FieldWorkflowRunID, v))
+}
+
+// WorkflowRunIDContains applies the Contains predicate on the "workflow_run_id" field.
+func WorkflowRunIDContains(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldContains(FieldWorkflowRunID, v))
+}
+
+// WorkflowRunIDHasPrefix applies the HasPrefix predicate on the "workflow_run_id" field.
+func WorkflowRunIDHasPrefix(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldHasPrefix(FieldWorkflowRunID, v))
+}
+
+// WorkflowRunIDHasSuffix applies the HasSuffix predicate on the "workflow_run_id" field.
+func WorkflowRunIDHasSuffix(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldHasSuffix(FieldWorkflowRunID, v))
+}
+
+// WorkflowRunIDIsNil applies the IsNil predicate on the "workflow_run_id" field.
+func WorkflowRunIDIsNil() predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldIsNull(FieldWorkflowRunID))
+}
+
+// WorkflowRunIDNotNil applies the NotNil predicate on the "workflow_run_id" field.
+func WorkflowRunIDNotNil() predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldNotNull(FieldWorkflowRunID))
+}
+
+// WorkflowRunIDEqualFold applies the EqualFold predicate on the "workflow_run_id" field.
+func WorkflowRunIDEqualFold(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldEqualFold(FieldWorkflowRunID, v))
+}
+
+// WorkflowRunIDContainsFold applies the ContainsFold predicate on the "workflow_run_id" field.
+func WorkflowRunIDContainsFold(v string) predicate.ProcessInstance {
+	return predicate.ProcessInstance(sql.FieldContainsFold(FieldWorkflowRunID, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.ProcessInstance {
 	return predicate.ProcessInstance(sql.FieldEQ(FieldCreatedAt, v))
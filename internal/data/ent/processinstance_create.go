@@ -290,6 +290,34 @@ func (pic *ProcessInstanceCreate) SetNillableReferenceType(s *string) *ProcessIn
 	return pic
 }
 
+// SetWorkflowID sets the "workflow_id" field.
+func (pic *ProcessInstanceCreate) SetWorkflowID(s string) *ProcessInstanceCreate {
+	pic.mutation.SetWorkflowID(s)
+	return pic
+}
+
+// SetNillableWorkflowID sets the "workflow_id" field if the given value is not nil.
+func (pic *ProcessInstanceCreate) SetNillableWorkflowID(s *string) *ProcessInstanceCreate {
+	if s != nil {
+		pic.SetWorkflowID(*s)
+	}
+	return pic
+}
+
+// SetWorkflowRunID sets the "workflow_run_id" field.
+func (pic *ProcessInstanceCreate) SetWorkflowRunID(s string) *ProcessInstanceCreate {
+	pic.mutation.SetWorkflowRunID(s)
+	return pic
+}
+
+// SetNillableWorkflowRunID sets the "workflow_run_id" field if the given value is not nil.
+func (pic *ProcessInstanceCreate) SetNillableWorkflowRunID(s *string) *ProcessInstanceCreate {
+	if s != nil {
+		pic.SetWorkflowRunID(*s)
+	}
+	return pic
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (pic *ProcessInstanceCreate) SetCreatedAt(t time.Time) *ProcessInstanceCreate {
 	pic.mutation.SetCreatedAt(t)
@@ -471,6 +499,16 @@ func (pic *ProcessInstanceCreate) check() error {
 			return &ValidationError{Name: "reference_type", err: fmt.Errorf(`ent: validator failed for field "ProcessInstance.reference_type": %w`, err)}
 		}
 	}
+	if v, ok := pic.mutation.WorkflowID(); ok {
+		if err := processinstance.WorkflowIDValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_id", err: fmt.Errorf(`ent: validator failed for field "ProcessInstance.workflow_id": %w`, err)}
+		}
+	}
+	if v, ok := pic.mutation.WorkflowRunID(); ok {
+		if err := processinstance.WorkflowRunIDValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_run_id", err: fmt.Errorf(`ent: validator failed for field "ProcessInstance.workflow_run_id": %w`, err)}
+		}
+	}
 	if _, ok := pic.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProcessInstance.created_at"`)}
 	}
@@ -593,6 +631,14 @@ func (pic *ProcessInstanceCreate) createSpec() (*ProcessInstance, *sqlgraph.Crea
 		_spec.SetField(processinstance.FieldReferenceType, field.TypeString, value)
 		_node.ReferenceType = value
 	}
+	if value, ok := pic.mutation.WorkflowID(); ok {
+		_spec.SetField(processinstance.FieldWorkflowID, field.TypeString, value)
+		_node.WorkflowID = value
+	}
+	if value, ok := pic.mutation.WorkflowRunID(); ok {
+		_spec.SetField(processinstance.FieldWorkflowRunID, field.TypeString, value)
+		_node.WorkflowRunID = value
+	}
 	if value, ok := pic.mutation.CreatedAt(); ok {
 		_spec.SetField(processinstance.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
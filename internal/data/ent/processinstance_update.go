@@ -433,6 +433,46 @@ func (piu *ProcessInstanceUpdate) ClearReferenceType() *ProcessInstanceUpdate {
 	return piu
 }
 
+// SetWorkflowID sets the "workflow_id" field.
+func (piu *ProcessInstanceUpdate) SetWorkflowID(s string) *ProcessInstanceUpdate {
+	piu.mutation.SetWorkflowID(s)
+	return piu
+}
+
+// SetNillableWorkflowID sets the "workflow_id" field if the given value is not nil.
+func (piu *ProcessInstanceUpdate) SetNillableWorkflowID(s *string) *ProcessInstanceUpdate {
+	if s != nil {
+		piu.SetWorkflowID(*s)
+	}
+	return piu
+}
+
+// ClearWorkflowID clears the value of the "workflow_id" field.
+func (piu *ProcessInstanceUpdate) ClearWorkflowID() *ProcessInstanceUpdate {
+	piu.mutation.ClearWorkflowID()
+	return piu
+}
+
+// SetWorkflowRunID sets the "workflow_run_id" field.
+func (piu *ProcessInstanceUpdate) SetWorkflowRunID(s string) *ProcessInstanceUpdate {
+	piu.mutation.SetWorkflowRunID(s)
+	return piu
+}
+
+// SetNillableWorkflowRunID sets the "workflow_run_id" field if the given value is not nil.
+func (piu *ProcessInstanceUpdate) SetNillableWorkflowRunID(s *string) *ProcessInstanceUpdate {
+	if s != nil {
+		piu.SetWorkflowRunID(*s)
+	}
+	return piu
+}
+
+// ClearWorkflowRunID clears the value of the "workflow_run_id" field.
+func (piu *ProcessInstanceUpdate) ClearWorkflowRunID() *ProcessInstanceUpdate {
+	piu.mutation.ClearWorkflowRunID()
+	return piu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (piu *ProcessInstanceUpdate) SetUpdatedAt(t time.Time) *ProcessInstanceUpdate {
 	piu.mutation.SetUpdatedAt(t)
@@ -552,6 +592,16 @@ func (piu *ProcessInstanceUpdate) check() error {
 			return &ValidationError{Name: "reference_type", err: fmt.Errorf(`ent: validator failed for field "ProcessInstance.reference_type": %w`, err)}
 		}
 	}
+	if v, ok := piu.mutation.WorkflowID(); ok {
+		if err := processinstance.WorkflowIDValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_id", err: fmt.Errorf(`ent: validator failed for field "ProcessInstance.workflow_id": %w`, err)}
+		}
+	}
+	if v, ok := piu.mutation.WorkflowRunID(); ok {
+		if err := processinstance.WorkflowRunIDValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_run_id", err: fmt.Errorf(`ent: validator failed for field "ProcessInstance.workflow_run_id": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -684,6 +734,18 @@ func (piu *ProcessInstanceUpdate) sqlSave(ctx context.Context) (n int, err error
 	if piu.mutation.ReferenceTypeCleared() {
 		_spec.ClearField(processinstance.FieldReferenceType, field.TypeString)
 	}
+	if value, ok := piu.mutation.WorkflowID(); ok {
+		_spec.SetField(processinstance.FieldWorkflowID, field.TypeString, value)
+	}
+	if piu.mutation.WorkflowIDCleared() {
+		_spec.ClearField(processinstance.FieldWorkflowID, field.TypeString)
+	}
+	if value, ok := piu.mutation.WorkflowRunID(); ok {
+		_spec.SetField(processinstance.FieldWorkflowRunID, field.TypeString, value)
+	}
+	if piu.mutation.WorkflowRunIDCleared() {
+		_spec.ClearField(processinstance.FieldWorkflowRunID, field.TypeString)
+	}
 	if value, ok := piu.mutation.UpdatedAt(); ok {
 		_spec.SetField(processinstance.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -1112,6 +1174,46 @@ func (piuo *ProcessInstanceUpdateOne) ClearReferenceType() *ProcessInstanceUpdat
 	return piuo
 }
 
+// SetWorkflowID sets the "workflow_id" field.
+func (piuo *ProcessInstanceUpdateOne) SetWorkflowID(s string) *ProcessInstanceUpdateOne {
+	piuo.mutation.SetWorkflowID(s)
+	return piuo
+}
+
+// SetNillableWorkflowID sets the "workflow_id" field if the given value is not nil.
+func (piuo *ProcessInstanceUpdateOne) SetNillableWorkflowID(s *string) *ProcessInstanceUpdateOne {
+	if s != nil {
+		piuo.SetWorkflowID(*s)
+	}
+	return piuo
+}
+
+// ClearWorkflowID clears the value of the "workflow_id" field.
+func (piuo *ProcessInstanceUpdateOne) ClearWorkflowID() *ProcessInstanceUpdateOne {
+	piuo.mutation.ClearWorkflowID()
+	return piuo
+}
+
+// SetWorkflowRunID sets the "workflow_run_id" field.
+func (piuo *ProcessInstanceUpdateOne) SetWorkflowRunID(s string) *ProcessInstanceUpdateOne {
+	piuo.mutation.SetWorkflowRunID(s)
+	return piuo
+}
+
+// SetNillableWorkflowRunID sets the "workflow_run_id" field if the given value is not nil.
+func (piuo *ProcessInstanceUpdateOne) SetNillableWorkflowRunID(s *string) *ProcessInstanceUpdateOne {
+	if s != nil {
+		piuo.SetWorkflowRunID(*s)
+	}
+	return piuo
+}
+
+// ClearWorkflowRunID clears the value of the "workflow_run_id" field.
+func (piuo *ProcessInstanceUpdateOne) ClearWorkflowRunID() *ProcessInstanceUpdateOne {
+	piuo.mutation.ClearWorkflowRunID()
+	return piuo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (piuo *ProcessInstanceUpdateOne) SetUpdatedAt(t time.Time) *ProcessInstanceUpdateOne {
 	piuo.mutation.SetUpdatedAt(t)
@@ -1244,6 +1346,16 @@ func (piuo *ProcessInstanceUpdateOne) check() error {
 			return &ValidationError{Name: "reference_type", err: fmt.Errorf(`ent: validator failed for field "ProcessInstance.reference_type": %w`, err)}
 		}
 	}
+	if v, ok := piuo.mutation.WorkflowID(); ok {
+		if err := processinstance.WorkflowIDValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_id", err: fmt.Errorf(`ent: validator failed for field "ProcessInstance.workflow_id": %w`, err)}
+		}
+	}
+	if v, ok := piuo.mutation.WorkflowRunID(); ok {
+		if err := processinstance.WorkflowRunIDValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_run_id", err: fmt.Errorf(`ent: validator failed for field "ProcessInstance.workflow_run_id": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1393,6 +1505,18 @@ func (piuo *ProcessInstanceUpdateOne) sqlSave(ctx context.Context) (_node *Proce
 	if piuo.mutation.ReferenceTypeCleared() {
 		_spec.ClearField(processinstance.FieldReferenceType, field.TypeString)
 	}
+	if value, ok := piuo.mutation.WorkflowID(); ok {
+		_spec.SetField(processinstance.FieldWorkflowID, field.TypeString, value)
+	}
+	if piuo.mutation.WorkflowIDCleared() {
+		_spec.ClearField(processinstance.FieldWorkflowID, field.TypeString)
+	}
+	if value, ok := piuo.mutation.WorkflowRunID(); ok {
+		_spec.SetField(processinstance.FieldWorkflowRunID, field.TypeString, value)
+	}
+	if piuo.mutation.WorkflowRunIDCleared() {
+		_spec.ClearField(processinstance.FieldWorkflowRunID, field.TypeString)
+	}
 	if value, ok := piuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(processinstance.FieldUpdatedAt, field.TypeTime, value)
 	}
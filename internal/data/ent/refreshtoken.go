@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/refreshtoken"
+)
+
+// RefreshToken is the model entity for the RefreshToken schema.
+type RefreshToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 记录ID
+	ID int64 `json:"id,omitempty"`
+	// 刷新令牌的 jti
+	TokenID string `json:"token_id,omitempty"`
+	// 令牌族ID，即登录会话ID，写入令牌的 sid
+	FamilyID string `json:"family_id,omitempty"`
+	// 用户ID
+	UserID int64 `json:"user_id,omitempty"`
+	// 轮换前的刷新令牌 jti，登录签发时为空
+	ParentTokenID string `json:"parent_token_id,omitempty"`
+	// 同时签发的访问令牌 jti
+	AccessTokenID string `json:"access_token_id,omitempty"`
+	// 访问令牌过期时间
+	AccessExpiresAt time.Time `json:"access_expires_at,omitempty"`
+	// 刷新令牌过期时间
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// 轮换时间，非空表示已使用
+	UsedAt *time.Time `json:"used_at,omitempty"`
+	// 吊销时间
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// 创建时间
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RefreshToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case refreshtoken.FieldID, refreshtoken.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case refreshtoken.FieldTokenID, refreshtoken.FieldFamilyID, refreshtoken.FieldParentTokenID, refreshtoken.FieldAccessTokenID:
+			values[i] = new(sql.NullString)
+		case refreshtoken.FieldAccessExpiresAt, refreshtoken.FieldExpiresAt, refreshtoken.FieldUsedAt, refreshtoken.FieldRevokedAt, refreshtoken.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RefreshToken fields.
+func (rt *RefreshToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case refreshtoken.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rt.ID = int64(value.Int64)
+		case refreshtoken.FieldTokenID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_id", values[i])
+			} else if value.Valid {
+				rt.TokenID = value.String
+			}
+		case refreshtoken.FieldFamilyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field family_id", values[i])
+			} else if value.Valid {
+				rt.FamilyID = value.String
+			}
+		case refreshtoken.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				rt.UserID = value.Int64
+			}
+		case refreshtoken.FieldParentTokenID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_token_id", values[i])
+			} else if value.Valid {
+				rt.ParentTokenID = value.String
+			}
+		case refreshtoken.FieldAccessTokenID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field access_token_id", values[i])
+			} else if value.Valid {
+				rt.AccessTokenID = value.String
+			}
+		case refreshtoken.FieldAccessExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field access_expires_at", values[i])
+			} else if value.Valid {
+				rt.AccessExpiresAt = value.Time
+			}
+		case refreshtoken.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				rt.ExpiresAt = value.Time
+			}
+		case refreshtoken.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				rt.UsedAt = new(time.Time)
+				*rt.UsedAt = value.Time
+			}
+		case refreshtoken.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				rt.RevokedAt = new(time.Time)
+				*rt.RevokedAt = value.Time
+			}
+		case refreshtoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rt.CreatedAt = value.Time
+			}
+		default:
+			rt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RefreshToken.
+// This includes values selected through modifiers, order, etc.
+func (rt *RefreshToken) Value(name string) (ent.Value, error) {
+	return rt.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RefreshToken.
+// Note that you need to call RefreshToken.Unwrap() before calling this method if this RefreshToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rt *RefreshToken) Update() *RefreshTokenUpdateOne {
+	return NewRefreshTokenClient(rt.config).UpdateOne(rt)
+}
+
+// Unwrap unwraps the RefreshToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rt *RefreshToken) Unwrap() *RefreshToken {
+	_tx, ok := rt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RefreshToken is not a transactional entity")
+	}
+	rt.config.driver = _tx.drv
+	return rt
+}
+
+// String implements the fmt.Stringer.
+func (rt *RefreshToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("RefreshToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rt.ID))
+	builder.WriteString("token_id=")
+	builder.WriteString(rt.TokenID)
+	builder.WriteString(", ")
+	builder.WriteString("family_id=")
+	builder.WriteString(rt.FamilyID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", rt.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("parent_token_id=")
+	builder.WriteString(rt.ParentTokenID)
+	builder.WriteString(", ")
+	builder.WriteString("access_token_id=")
+	builder.WriteString(rt.AccessTokenID)
+	builder.WriteString(", ")
+	builder.WriteString("access_expires_at=")
+	builder.WriteString(rt.AccessExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(rt.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := rt.UsedAt; v != nil {
+		builder.WriteString("used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := rt.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rt.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RefreshTokens is a parsable slice of RefreshToken.
+type RefreshTokens []*RefreshToken
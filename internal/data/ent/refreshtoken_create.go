@@ -0,0 +1,371 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/refreshtoken"
+)
+
+// RefreshTokenCreate is the builder for creating a RefreshToken entity.
+type RefreshTokenCreate struct {
+	config
+	mutation *RefreshTokenMutation
+	hooks    []Hook
+}
+
+// SetTokenID sets the "token_id" field.
+func (rtc *RefreshTokenCreate) SetTokenID(s string) *RefreshTokenCreate {
+	rtc.mutation.SetTokenID(s)
+	return rtc
+}
+
+// SetFamilyID sets the "family_id" field.
+func (rtc *RefreshTokenCreate) SetFamilyID(s string) *RefreshTokenCreate {
+	rtc.mutation.SetFamilyID(s)
+	return rtc
+}
+
+// SetUserID sets the "user_id" field.
+func (rtc *RefreshTokenCreate) SetUserID(i int64) *RefreshTokenCreate {
+	rtc.mutation.SetUserID(i)
+	return rtc
+}
+
+// SetParentTokenID sets the "parent_token_id" field.
+func (rtc *RefreshTokenCreate) SetParentTokenID(s string) *RefreshTokenCreate {
+	rtc.mutation.SetParentTokenID(s)
+	return rtc
+}
+
+// SetNillableParentTokenID sets the "parent_token_id" field if the given value is not nil.
+func (rtc *RefreshTokenCreate) SetNillableParentTokenID(s *string) *RefreshTokenCreate {
+	if s != nil {
+		rtc.SetParentTokenID(*s)
+	}
+	return rtc
+}
+
+// SetAccessTokenID sets the "access_token_id" field.
+func (rtc *RefreshTokenCreate) SetAccessTokenID(s string) *RefreshTokenCreate {
+	rtc.mutation.SetAccessTokenID(s)
+	return rtc
+}
+
+// SetNillableAccessTokenID sets the "access_token_id" field if the given value is not nil.
+func (rtc *RefreshTokenCreate) SetNillableAccessTokenID(s *string) *RefreshTokenCreate {
+	if s != nil {
+		rtc.SetAccessTokenID(*s)
+	}
+	return rtc
+}
+
+// SetAccessExpiresAt sets the "access_expires_at" field.
+func (rtc *RefreshTokenCreate) SetAccessExpiresAt(t time.Time) *RefreshTokenCreate {
+	rtc.mutation.SetAccessExpiresAt(t)
+	return rtc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rtc *RefreshTokenCreate) SetExpiresAt(t time.Time) *RefreshTokenCreate {
+	rtc.mutation.SetExpiresAt(t)
+	return rtc
+}
+
+// SetUsedAt sets the "used_at" field.
+func (rtc *RefreshTokenCreate) SetUsedAt(t time.Time) *RefreshTokenCreate {
+	rtc.mutation.SetUsedAt(t)
+	return rtc
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (rtc *RefreshTokenCreate) SetNillableUsedAt(t *time.Time) *RefreshTokenCreate {
+	if t != nil {
+		rtc.SetUsedAt(*t)
+	}
+	return rtc
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (rtc *RefreshTokenCreate) SetRevokedAt(t time.Time) *RefreshTokenCreate {
+	rtc.mutation.SetRevokedAt(t)
+	return rtc
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (rtc *RefreshTokenCreate) SetNillableRevokedAt(t *time.Time) *RefreshTokenCreate {
+	if t != nil {
+		rtc.SetRevokedAt(*t)
+	}
+	return rtc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rtc *RefreshTokenCreate) SetCreatedAt(t time.Time) *RefreshTokenCreate {
+	rtc.mutation.SetCreatedAt(t)
+	return rtc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rtc *RefreshTokenCreate) SetNillableCreatedAt(t *time.Time) *RefreshTokenCreate {
+	if t != nil {
+		rtc.SetCreatedAt(*t)
+	}
+	return rtc
+}
+
+// SetID sets the "id" field.
+func (rtc *RefreshTokenCreate) SetID(i int64) *RefreshTokenCreate {
+	rtc.mutation.SetID(i)
+	return rtc
+}
+
+// Mutation returns the RefreshTokenMutation object of the builder.
+func (rtc *RefreshTokenCreate) Mutation() *RefreshTokenMutation {
+	return rtc.mutation
+}
+
+// Save creates the RefreshToken in the database.
+func (rtc *RefreshTokenCreate) Save(ctx context.Context) (*RefreshToken, error) {
+	rtc.defaults()
+	return withHooks(ctx, rtc.sqlSave, rtc.mutation, rtc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rtc *RefreshTokenCreate) SaveX(ctx context.Context) *RefreshToken {
+	v, err := rtc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rtc *RefreshTokenCreate) Exec(ctx context.Context) error {
+	_, err := rtc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtc *RefreshTokenCreate) ExecX(ctx context.Context) {
+	if err := rtc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rtc *RefreshTokenCreate) defaults() {
+	if _, ok := rtc.mutation.CreatedAt(); !ok {
+		v := refreshtoken.DefaultCreatedAt()
+		rtc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rtc *RefreshTokenCreate) check() error {
+	if _, ok := rtc.mutation.TokenID(); !ok {
+		return &ValidationError{Name: "token_id", err: errors.New(`ent: missing required field "RefreshToken.token_id"`)}
+	}
+	if v, ok := rtc.mutation.TokenID(); ok {
+		if err := refreshtoken.TokenIDValidator(v); err != nil {
+			return &ValidationError{Name: "token_id", err: fmt.Errorf(`ent: validator failed for field "RefreshToken.token_id": %w`, err)}
+		}
+	}
+	if _, ok := rtc.mutation.FamilyID(); !ok {
+		return &ValidationError{Name: "family_id", err: errors.New(`ent: missing required field "RefreshToken.family_id"`)}
+	}
+	if v, ok := rtc.mutation.FamilyID(); ok {
+		if err := refreshtoken.FamilyIDValidator(v); err != nil {
+			return &ValidationError{Name: "family_id", err: fmt.Errorf(`ent: validator failed for field "RefreshToken.family_id": %w`, err)}
+		}
+	}
+	if _, ok := rtc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RefreshToken.user_id"`)}
+	}
+	if v, ok := rtc.mutation.ParentTokenID(); ok {
+		if err := refreshtoken.ParentTokenIDValidator(v); err != nil {
+			return &ValidationError{Name: "parent_token_id", err: fmt.Errorf(`ent: validator failed for field "RefreshToken.parent_token_id": %w`, err)}
+		}
+	}
+	if v, ok := rtc.mutation.AccessTokenID(); ok {
+		if err := refreshtoken.AccessTokenIDValidator(v); err != nil {
+			return &ValidationError{Name: "access_token_id", err: fmt.Errorf(`ent: validator failed for field "RefreshToken.access_token_id": %w`, err)}
+		}
+	}
+	if _, ok := rtc.mutation.AccessExpiresAt(); !ok {
+		return &ValidationError{Name: "access_expires_at", err: errors.New(`ent: missing required field "RefreshToken.access_expires_at"`)}
+	}
+	if _, ok := rtc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "RefreshToken.expires_at"`)}
+	}
+	if _, ok := rtc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RefreshToken.created_at"`)}
+	}
+	return nil
+}
+
+func (rtc *RefreshTokenCreate) sqlSave(ctx context.Context) (*RefreshToken, error) {
+	if err := rtc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rtc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rtc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	rtc.mutation.id = &_node.ID
+	rtc.mutation.done = true
+	return _node, nil
+}
+
+func (rtc *RefreshTokenCreate) createSpec() (*RefreshToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RefreshToken{config: rtc.config}
+		_spec = sqlgraph.NewCreateSpec(refreshtoken.Table, sqlgraph.NewFieldSpec(refreshtoken.FieldID, field.TypeInt64))
+	)
+	if id, ok := rtc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rtc.mutation.TokenID(); ok {
+		_spec.SetField(refreshtoken.FieldTokenID, field.TypeString, value)
+		_node.TokenID = value
+	}
+	if value, ok := rtc.mutation.FamilyID(); ok {
+		_spec.SetField(refreshtoken.FieldFamilyID, field.TypeString, value)
+		_node.FamilyID = value
+	}
+	if value, ok := rtc.mutation.UserID(); ok {
+		_spec.SetField(refreshtoken.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := rtc.mutation.ParentTokenID(); ok {
+		_spec.SetField(refreshtoken.FieldParentTokenID, field.TypeString, value)
+		_node.ParentTokenID = value
+	}
+	if value, ok := rtc.mutation.AccessTokenID(); ok {
+		_spec.SetField(refreshtoken.FieldAccessTokenID, field.TypeString, value)
+		_node.AccessTokenID = value
+	}
+	if value, ok := rtc.mutation.AccessExpiresAt(); ok {
+		_spec.SetField(refreshtoken.FieldAccessExpiresAt, field.TypeTime, value)
+		_node.AccessExpiresAt = value
+	}
+	if value, ok := rtc.mutation.ExpiresAt(); ok {
+		_spec.SetField(refreshtoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := rtc.mutation.UsedAt(); ok {
+		_spec.SetField(refreshtoken.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if value, ok := rtc.mutation.RevokedAt(); ok {
+		_spec.SetField(refreshtoken.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := rtc.mutation.CreatedAt(); ok {
+		_spec.SetField(refreshtoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// RefreshTokenCreateBulk is the builder for creating many RefreshToken entities in bulk.
+type RefreshTokenCreateBulk struct {
+	config
+	err      error
+	builders []*RefreshTokenCreate
+}
+
+// Save creates the RefreshToken entities in the database.
+func (rtcb *RefreshTokenCreateBulk) Save(ctx context.Context) ([]*RefreshToken, error) {
+	if rtcb.err != nil {
+		return nil, rtcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rtcb.builders))
+	nodes := make([]*RefreshToken, len(rtcb.builders))
+	mutators := make([]Mutator, len(rtcb.builders))
+	for i := range rtcb.builders {
+		func(i int, root context.Context) {
+			builder := rtcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RefreshTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rtcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rtcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rtcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtcb *RefreshTokenCreateBulk) SaveX(ctx context.Context) []*RefreshToken {
+	v, err := rtcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rtcb *RefreshTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := rtcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtcb *RefreshTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := rtcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,292 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/predicate"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/refreshtoken"
+)
+
+// RefreshTokenUpdate is the builder for updating RefreshToken entities.
+type RefreshTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RefreshTokenMutation
+}
+
+// Where appends a list predicates to the RefreshTokenUpdate builder.
+func (rtu *RefreshTokenUpdate) Where(ps ...predicate.RefreshToken) *RefreshTokenUpdate {
+	rtu.mutation.Where(ps...)
+	return rtu
+}
+
+// SetUsedAt sets the "used_at" field.
+func (rtu *RefreshTokenUpdate) SetUsedAt(t time.Time) *RefreshTokenUpdate {
+	rtu.mutation.SetUsedAt(t)
+	return rtu
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (rtu *RefreshTokenUpdate) SetNillableUsedAt(t *time.Time) *RefreshTokenUpdate {
+	if t != nil {
+		rtu.SetUsedAt(*t)
+	}
+	return rtu
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (rtu *RefreshTokenUpdate) ClearUsedAt() *RefreshTokenUpdate {
+	rtu.mutation.ClearUsedAt()
+	return rtu
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (rtu *RefreshTokenUpdate) SetRevokedAt(t time.Time) *RefreshTokenUpdate {
+	rtu.mutation.SetRevokedAt(t)
+	return rtu
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (rtu *RefreshTokenUpdate) SetNillableRevokedAt(t *time.Time) *RefreshTokenUpdate {
+	if t != nil {
+		rtu.SetRevokedAt(*t)
+	}
+	return rtu
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (rtu *RefreshTokenUpdate) ClearRevokedAt() *RefreshTokenUpdate {
+	rtu.mutation.ClearRevokedAt()
+	return rtu
+}
+
+// Mutation returns the RefreshTokenMutation object of the builder.
+func (rtu *RefreshTokenUpdate) Mutation() *RefreshTokenMutation {
+	return rtu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rtu *RefreshTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rtu.sqlSave, rtu.mutation, rtu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtu *RefreshTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := rtu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rtu *RefreshTokenUpdate) Exec(ctx context.Context) error {
+	_, err := rtu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtu *RefreshTokenUpdate) ExecX(ctx context.Context) {
+	if err := rtu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (rtu *RefreshTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(refreshtoken.Table, refreshtoken.Columns, sqlgraph.NewFieldSpec(refreshtoken.FieldID, field.TypeInt64))
+	if ps := rtu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if rtu.mutation.ParentTokenIDCleared() {
+		_spec.ClearField(refreshtoken.FieldParentTokenID, field.TypeString)
+	}
+	if rtu.mutation.AccessTokenIDCleared() {
+		_spec.ClearField(refreshtoken.FieldAccessTokenID, field.TypeString)
+	}
+	if value, ok := rtu.mutation.UsedAt(); ok {
+		_spec.SetField(refreshtoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if rtu.mutation.UsedAtCleared() {
+		_spec.ClearField(refreshtoken.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := rtu.mutation.RevokedAt(); ok {
+		_spec.SetField(refreshtoken.FieldRevokedAt, field.TypeTime, value)
+	}
+	if rtu.mutation.RevokedAtCleared() {
+		_spec.ClearField(refreshtoken.FieldRevokedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rtu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{refreshtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rtu.mutation.done = true
+	return n, nil
+}
+
+// RefreshTokenUpdateOne is the builder for updating a single RefreshToken entity.
+type RefreshTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RefreshTokenMutation
+}
+
+// SetUsedAt sets the "used_at" field.
+func (rtuo *RefreshTokenUpdateOne) SetUsedAt(t time.Time) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetUsedAt(t)
+	return rtuo
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (rtuo *RefreshTokenUpdateOne) SetNillableUsedAt(t *time.Time) *RefreshTokenUpdateOne {
+	if t != nil {
+		rtuo.SetUsedAt(*t)
+	}
+	return rtuo
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (rtuo *RefreshTokenUpdateOne) ClearUsedAt() *RefreshTokenUpdateOne {
+	rtuo.mutation.ClearUsedAt()
+	return rtuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (rtuo *RefreshTokenUpdateOne) SetRevokedAt(t time.Time) *RefreshTokenUpdateOne {
+	rtuo.mutation.SetRevokedAt(t)
+	return rtuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (rtuo *RefreshTokenUpdateOne) SetNillableRevokedAt(t *time.Time) *RefreshTokenUpdateOne {
+	if t != nil {
+		rtuo.SetRevokedAt(*t)
+	}
+	return rtuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (rtuo *RefreshTokenUpdateOne) ClearRevokedAt() *RefreshTokenUpdateOne {
+	rtuo.mutation.ClearRevokedAt()
+	return rtuo
+}
+
+// Mutation returns the RefreshTokenMutation object of the builder.
+func (rtuo *RefreshTokenUpdateOne) Mutation() *RefreshTokenMutation {
+	return rtuo.mutation
+}
+
+// Where appends a list predicates to the RefreshTokenUpdate builder.
+func (rtuo *RefreshTokenUpdateOne) Where(ps ...predicate.RefreshToken) *RefreshTokenUpdateOne {
+	rtuo.mutation.Where(ps...)
+	return rtuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rtuo *RefreshTokenUpdateOne) Select(field string, fields ...string) *RefreshTokenUpdateOne {
+	rtuo.fields = append([]string{field}, fields...)
+	return rtuo
+}
+
+// Save executes the query and returns the updated RefreshToken entity.
+func (rtuo *RefreshTokenUpdateOne) Save(ctx context.Context) (*RefreshToken, error) {
+	return withHooks(ctx, rtuo.sqlSave, rtuo.mutation, rtuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rtuo *RefreshTokenUpdateOne) SaveX(ctx context.Context) *RefreshToken {
+	node, err := rtuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rtuo *RefreshTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := rtuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rtuo *RefreshTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := rtuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (rtuo *RefreshTokenUpdateOne) sqlSave(ctx context.Context) (_node *RefreshToken, err error) {
+	_spec := sqlgraph.NewUpdateSpec(refreshtoken.Table, refreshtoken.Columns, sqlgraph.NewFieldSpec(refreshtoken.FieldID, field.TypeInt64))
+	id, ok := rtuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RefreshToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rtuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, refreshtoken.FieldID)
+		for _, f := range fields {
+			if !refreshtoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != refreshtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rtuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if rtuo.mutation.ParentTokenIDCleared() {
+		_spec.ClearField(refreshtoken.FieldParentTokenID, field.TypeString)
+	}
+	if rtuo.mutation.AccessTokenIDCleared() {
+		_spec.ClearField(refreshtoken.FieldAccessTokenID, field.TypeString)
+	}
+	if value, ok := rtuo.mutation.UsedAt(); ok {
+		_spec.SetField(refreshtoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if rtuo.mutation.UsedAtCleared() {
+		_spec.ClearField(refreshtoken.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := rtuo.mutation.RevokedAt(); ok {
+		_spec.SetField(refreshtoken.FieldRevokedAt, field.TypeTime, value)
+	}
+	if rtuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(refreshtoken.FieldRevokedAt, field.TypeTime)
+	}
+	_node = &RefreshToken{config: rtuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rtuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{refreshtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rtuo.mutation.done = true
+	return _node, nil
+}
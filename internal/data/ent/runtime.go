@@ -5,19 +5,121 @@ package ent
 import (
 	"time"
 
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/authorization"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/group"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/historicprocessinstance"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/processdefinition"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/processevent"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/processinstance"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/processvariable"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/refreshtoken"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/role"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/schema"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskattachment"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskcomment"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskidentitylink"
 	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskinstance"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/user"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	authorizationFields := schema.Authorization{}.Fields()
+	_ = authorizationFields
+	// authorizationDescPrincipalType is the schema descriptor for principal_type field.
+	authorizationDescPrincipalType := authorizationFields[1].Descriptor()
+	// authorization.PrincipalTypeValidator is a validator for the "principal_type" field. It is called by the builders before save.
+	authorization.PrincipalTypeValidator = func() func(string) error {
+		validators := authorizationDescPrincipalType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(principal_type string) error {
+			for _, fn := range fns {
+				if err := fn(principal_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// authorizationDescPrincipalID is the schema descriptor for principal_id field.
+	authorizationDescPrincipalID := authorizationFields[2].Descriptor()
+	// authorization.PrincipalIDValidator is a validator for the "principal_id" field. It is called by the builders before save.
+	authorization.PrincipalIDValidator = func() func(string) error {
+		validators := authorizationDescPrincipalID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(principal_id string) error {
+			for _, fn := range fns {
+				if err := fn(principal_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// authorizationDescProcessDefinitionKey is the schema descriptor for process_definition_key field.
+	authorizationDescProcessDefinitionKey := authorizationFields[3].Descriptor()
+	// authorization.DefaultProcessDefinitionKey holds the default value on creation for the process_definition_key field.
+	authorization.DefaultProcessDefinitionKey = authorizationDescProcessDefinitionKey.Default.(string)
+	// authorization.ProcessDefinitionKeyValidator is a validator for the "process_definition_key" field. It is called by the builders before save.
+	authorization.ProcessDefinitionKeyValidator = authorizationDescProcessDefinitionKey.Validators[0].(func(string) error)
+	// authorizationDescCategory is the schema descriptor for category field.
+	authorizationDescCategory := authorizationFields[4].Descriptor()
+	// authorization.DefaultCategory holds the default value on creation for the category field.
+	authorization.DefaultCategory = authorizationDescCategory.Default.(string)
+	// authorization.CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	authorization.CategoryValidator = authorizationDescCategory.Validators[0].(func(string) error)
+	// authorizationDescTenantID is the schema descriptor for tenant_id field.
+	authorizationDescTenantID := authorizationFields[5].Descriptor()
+	// authorization.DefaultTenantID holds the default value on creation for the tenant_id field.
+	authorization.DefaultTenantID = authorizationDescTenantID.Default.(string)
+	// authorization.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	authorization.TenantIDValidator = authorizationDescTenantID.Validators[0].(func(string) error)
+	// authorizationDescCreatedBy is the schema descriptor for created_by field.
+	authorizationDescCreatedBy := authorizationFields[7].Descriptor()
+	// authorization.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	authorization.CreatedByValidator = authorizationDescCreatedBy.Validators[0].(func(string) error)
+	// authorizationDescCreatedAt is the schema descriptor for created_at field.
+	authorizationDescCreatedAt := authorizationFields[8].Descriptor()
+	// authorization.DefaultCreatedAt holds the default value on creation for the created_at field.
+	authorization.DefaultCreatedAt = authorizationDescCreatedAt.Default.(func() time.Time)
+	groupFields := schema.Group{}.Fields()
+	_ = groupFields
+	// groupDescName is the schema descriptor for name field.
+	groupDescName := groupFields[1].Descriptor()
+	// group.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	group.NameValidator = func() func(string) error {
+		validators := groupDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// groupDescCreatedAt is the schema descriptor for created_at field.
+	groupDescCreatedAt := groupFields[3].Descriptor()
+	// group.DefaultCreatedAt holds the default value on creation for the created_at field.
+	group.DefaultCreatedAt = groupDescCreatedAt.Default.(func() time.Time)
+	// groupDescUpdatedAt is the schema descriptor for updated_at field.
+	groupDescUpdatedAt := groupFields[4].Descriptor()
+	// group.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	group.DefaultUpdatedAt = groupDescUpdatedAt.Default.(func() time.Time)
+	// group.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	group.UpdateDefaultUpdatedAt = groupDescUpdatedAt.UpdateDefault.(func() time.Time)
 	historicprocessinstanceFields := schema.HistoricProcessInstance{}.Fields()
 	_ = historicprocessinstanceFields
 	// historicprocessinstanceDescProcessInstanceID is the schema descriptor for process_instance_id field.
@@ -374,12 +476,20 @@ func init() {
 	processinstanceDescReferenceType := processinstanceFields[21].Descriptor()
 	// processinstance.ReferenceTypeValidator is a validator for the "reference_type" field. It is called by the builders before save.
 	processinstance.ReferenceTypeValidator = processinstanceDescReferenceType.Validators[0].(func(string) error)
+	// processinstanceDescWorkflowID is the schema descriptor for workflow_id field.
+	processinstanceDescWorkflowID := processinstanceFields[22].Descriptor()
+	// processinstance.WorkflowIDValidator is a validator for the "workflow_id" field. It is called by the builders before save.
+	processinstance.WorkflowIDValidator = processinstanceDescWorkflowID.Validators[0].(func(string) error)
+	// processinstanceDescWorkflowRunID is the schema descriptor for workflow_run_id field.
+	processinstanceDescWorkflowRunID := processinstanceFields[23].Descriptor()
+	// processinstance.WorkflowRunIDValidator is a validator for the "workflow_run_id" field. It is called by the builders before save.
+	processinstance.WorkflowRunIDValidator = processinstanceDescWorkflowRunID.Validators[0].(func(string) error)
 	// processinstanceDescCreatedAt is the schema descriptor for created_at field.
-	processinstanceDescCreatedAt := processinstanceFields[22].Descriptor()
+	processinstanceDescCreatedAt := processinstanceFields[24].Descriptor()
 	// processinstance.DefaultCreatedAt holds the default value on creation for the created_at field.
 	processinstance.DefaultCreatedAt = processinstanceDescCreatedAt.Default.(func() time.Time)
 	// processinstanceDescUpdatedAt is the schema descriptor for updated_at field.
-	processinstanceDescUpdatedAt := processinstanceFields[23].Descriptor()
+	processinstanceDescUpdatedAt := processinstanceFields[25].Descriptor()
 	// processinstance.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	processinstance.DefaultUpdatedAt = processinstanceDescUpdatedAt.Default.(func() time.Time)
 	// processinstance.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -470,6 +580,188 @@ func init() {
 	processvariable.DefaultUpdatedAt = processvariableDescUpdatedAt.Default.(func() time.Time)
 	// processvariable.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	processvariable.UpdateDefaultUpdatedAt = processvariableDescUpdatedAt.UpdateDefault.(func() time.Time)
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescTokenID is the schema descriptor for token_id field.
+	refreshtokenDescTokenID := refreshtokenFields[1].Descriptor()
+	// refreshtoken.TokenIDValidator is a validator for the "token_id" field. It is called by the builders before save.
+	refreshtoken.TokenIDValidator = func() func(string) error {
+		validators := refreshtokenDescTokenID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(token_id string) error {
+			for _, fn := range fns {
+				if err := fn(token_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// refreshtokenDescFamilyID is the schema descriptor for family_id field.
+	refreshtokenDescFamilyID := refreshtokenFields[2].Descriptor()
+	// refreshtoken.FamilyIDValidator is a validator for the "family_id" field. It is called by the builders before save.
+	refreshtoken.FamilyIDValidator = func() func(string) error {
+		validators := refreshtokenDescFamilyID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(family_id string) error {
+			for _, fn := range fns {
+				if err := fn(family_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// refreshtokenDescParentTokenID is the schema descriptor for parent_token_id field.
+	refreshtokenDescParentTokenID := refreshtokenFields[4].Descriptor()
+	// refreshtoken.ParentTokenIDValidator is a validator for the "parent_token_id" field. It is called by the builders before save.
+	refreshtoken.ParentTokenIDValidator = refreshtokenDescParentTokenID.Validators[0].(func(string) error)
+	// refreshtokenDescAccessTokenID is the schema descriptor for access_token_id field.
+	refreshtokenDescAccessTokenID := refreshtokenFields[5].Descriptor()
+	// refreshtoken.AccessTokenIDValidator is a validator for the "access_token_id" field. It is called by the builders before save.
+	refreshtoken.AccessTokenIDValidator = refreshtokenDescAccessTokenID.Validators[0].(func(string) error)
+	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	refreshtokenDescCreatedAt := refreshtokenFields[10].Descriptor()
+	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
+	roleFields := schema.Role{}.Fields()
+	_ = roleFields
+	// roleDescName is the schema descriptor for name field.
+	roleDescName := roleFields[1].Descriptor()
+	// role.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	role.NameValidator = func() func(string) error {
+		validators := roleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// roleDescCreatedAt is the schema descriptor for created_at field.
+	roleDescCreatedAt := roleFields[4].Descriptor()
+	// role.DefaultCreatedAt holds the default value on creation for the created_at field.
+	role.DefaultCreatedAt = roleDescCreatedAt.Default.(func() time.Time)
+	// roleDescUpdatedAt is the schema descriptor for updated_at field.
+	roleDescUpdatedAt := roleFields[5].Descriptor()
+	// role.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	role.DefaultUpdatedAt = roleDescUpdatedAt.Default.(func() time.Time)
+	// role.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	role.UpdateDefaultUpdatedAt = roleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	taskattachmentFields := schema.TaskAttachment{}.Fields()
+	_ = taskattachmentFields
+	// taskattachmentDescUserID is the schema descriptor for user_id field.
+	taskattachmentDescUserID := taskattachmentFields[3].Descriptor()
+	// taskattachment.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	taskattachment.UserIDValidator = taskattachmentDescUserID.Validators[0].(func(string) error)
+	// taskattachmentDescName is the schema descriptor for name field.
+	taskattachmentDescName := taskattachmentFields[4].Descriptor()
+	// taskattachment.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	taskattachment.NameValidator = func() func(string) error {
+		validators := taskattachmentDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// taskattachmentDescDescription is the schema descriptor for description field.
+	taskattachmentDescDescription := taskattachmentFields[5].Descriptor()
+	// taskattachment.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	taskattachment.DescriptionValidator = taskattachmentDescDescription.Validators[0].(func(string) error)
+	// taskattachmentDescContentType is the schema descriptor for content_type field.
+	taskattachmentDescContentType := taskattachmentFields[6].Descriptor()
+	// taskattachment.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	taskattachment.ContentTypeValidator = taskattachmentDescContentType.Validators[0].(func(string) error)
+	// taskattachmentDescSize is the schema descriptor for size field.
+	taskattachmentDescSize := taskattachmentFields[7].Descriptor()
+	// taskattachment.DefaultSize holds the default value on creation for the size field.
+	taskattachment.DefaultSize = taskattachmentDescSize.Default.(int64)
+	// taskattachmentDescStorage is the schema descriptor for storage field.
+	taskattachmentDescStorage := taskattachmentFields[8].Descriptor()
+	// taskattachment.DefaultStorage holds the default value on creation for the storage field.
+	taskattachment.DefaultStorage = taskattachmentDescStorage.Default.(string)
+	// taskattachment.StorageValidator is a validator for the "storage" field. It is called by the builders before save.
+	taskattachment.StorageValidator = taskattachmentDescStorage.Validators[0].(func(string) error)
+	// taskattachmentDescLocation is the schema descriptor for location field.
+	taskattachmentDescLocation := taskattachmentFields[9].Descriptor()
+	// taskattachment.LocationValidator is a validator for the "location" field. It is called by the builders before save.
+	taskattachment.LocationValidator = taskattachmentDescLocation.Validators[0].(func(string) error)
+	// taskattachmentDescCreatedAt is the schema descriptor for created_at field.
+	taskattachmentDescCreatedAt := taskattachmentFields[11].Descriptor()
+	// taskattachment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taskattachment.DefaultCreatedAt = taskattachmentDescCreatedAt.Default.(func() time.Time)
+	taskcommentFields := schema.TaskComment{}.Fields()
+	_ = taskcommentFields
+	// taskcommentDescUserID is the schema descriptor for user_id field.
+	taskcommentDescUserID := taskcommentFields[3].Descriptor()
+	// taskcomment.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	taskcomment.UserIDValidator = taskcommentDescUserID.Validators[0].(func(string) error)
+	// taskcommentDescType is the schema descriptor for type field.
+	taskcommentDescType := taskcommentFields[4].Descriptor()
+	// taskcomment.DefaultType holds the default value on creation for the type field.
+	taskcomment.DefaultType = taskcommentDescType.Default.(string)
+	// taskcomment.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	taskcomment.TypeValidator = taskcommentDescType.Validators[0].(func(string) error)
+	// taskcommentDescMessage is the schema descriptor for message field.
+	taskcommentDescMessage := taskcommentFields[5].Descriptor()
+	// taskcomment.MessageValidator is a validator for the "message" field. It is called by the builders before save.
+	taskcomment.MessageValidator = taskcommentDescMessage.Validators[0].(func(string) error)
+	// taskcommentDescCreatedAt is the schema descriptor for created_at field.
+	taskcommentDescCreatedAt := taskcommentFields[6].Descriptor()
+	// taskcomment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taskcomment.DefaultCreatedAt = taskcommentDescCreatedAt.Default.(func() time.Time)
+	taskidentitylinkFields := schema.TaskIdentityLink{}.Fields()
+	_ = taskidentitylinkFields
+	// taskidentitylinkDescType is the schema descriptor for type field.
+	taskidentitylinkDescType := taskidentitylinkFields[3].Descriptor()
+	// taskidentitylink.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	taskidentitylink.TypeValidator = func() func(string) error {
+		validators := taskidentitylinkDescType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(_type string) error {
+			for _, fn := range fns {
+				if err := fn(_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// taskidentitylinkDescUserID is the schema descriptor for user_id field.
+	taskidentitylinkDescUserID := taskidentitylinkFields[4].Descriptor()
+	// taskidentitylink.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	taskidentitylink.UserIDValidator = taskidentitylinkDescUserID.Validators[0].(func(string) error)
+	// taskidentitylinkDescGroupID is the schema descriptor for group_id field.
+	taskidentitylinkDescGroupID := taskidentitylinkFields[5].Descriptor()
+	// taskidentitylink.GroupIDValidator is a validator for the "group_id" field. It is called by the builders before save.
+	taskidentitylink.GroupIDValidator = taskidentitylinkDescGroupID.Validators[0].(func(string) error)
+	// taskidentitylinkDescCreatedAt is the schema descriptor for created_at field.
+	taskidentitylinkDescCreatedAt := taskidentitylinkFields[6].Descriptor()
+	// taskidentitylink.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taskidentitylink.DefaultCreatedAt = taskidentitylinkDescCreatedAt.Default.(func() time.Time)
 	taskinstanceFields := schema.TaskInstance{}.Fields()
 	_ = taskinstanceFields
 	// taskinstanceDescName is the schema descriptor for name field.
@@ -506,32 +798,46 @@ func init() {
 	taskinstanceDescDelegation := taskinstanceFields[6].Descriptor()
 	// taskinstance.DelegationValidator is a validator for the "delegation" field. It is called by the builders before save.
 	taskinstance.DelegationValidator = taskinstanceDescDelegation.Validators[0].(func(string) error)
+	// taskinstanceDescStatus is the schema descriptor for status field.
+	taskinstanceDescStatus := taskinstanceFields[7].Descriptor()
+	// taskinstance.DefaultStatus holds the default value on creation for the status field.
+	taskinstance.DefaultStatus = taskinstanceDescStatus.Default.(string)
+	// taskinstance.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	taskinstance.StatusValidator = taskinstanceDescStatus.Validators[0].(func(string) error)
 	// taskinstanceDescPriority is the schema descriptor for priority field.
-	taskinstanceDescPriority := taskinstanceFields[7].Descriptor()
+	taskinstanceDescPriority := taskinstanceFields[8].Descriptor()
 	// taskinstance.DefaultPriority holds the default value on creation for the priority field.
 	taskinstance.DefaultPriority = taskinstanceDescPriority.Default.(int32)
 	// taskinstanceDescCreateTime is the schema descriptor for create_time field.
-	taskinstanceDescCreateTime := taskinstanceFields[8].Descriptor()
+	taskinstanceDescCreateTime := taskinstanceFields[9].Descriptor()
 	// taskinstance.DefaultCreateTime holds the default value on creation for the create_time field.
 	taskinstance.DefaultCreateTime = taskinstanceDescCreateTime.Default.(func() time.Time)
+	// taskinstanceDescDeleteReason is the schema descriptor for delete_reason field.
+	taskinstanceDescDeleteReason := taskinstanceFields[13].Descriptor()
+	// taskinstance.DeleteReasonValidator is a validator for the "delete_reason" field. It is called by the builders before save.
+	taskinstance.DeleteReasonValidator = taskinstanceDescDeleteReason.Validators[0].(func(string) error)
 	// taskinstanceDescFormKey is the schema descriptor for form_key field.
-	taskinstanceDescFormKey := taskinstanceFields[11].Descriptor()
+	taskinstanceDescFormKey := taskinstanceFields[16].Descriptor()
 	// taskinstance.FormKeyValidator is a validator for the "form_key" field. It is called by the builders before save.
 	taskinstance.FormKeyValidator = taskinstanceDescFormKey.Validators[0].(func(string) error)
 	// taskinstanceDescCategory is the schema descriptor for category field.
-	taskinstanceDescCategory := taskinstanceFields[12].Descriptor()
+	taskinstanceDescCategory := taskinstanceFields[17].Descriptor()
 	// taskinstance.CategoryValidator is a validator for the "category" field. It is called by the builders before save.
 	taskinstance.CategoryValidator = taskinstanceDescCategory.Validators[0].(func(string) error)
 	// taskinstanceDescParentTaskID is the schema descriptor for parent_task_id field.
-	taskinstanceDescParentTaskID := taskinstanceFields[13].Descriptor()
+	taskinstanceDescParentTaskID := taskinstanceFields[18].Descriptor()
 	// taskinstance.ParentTaskIDValidator is a validator for the "parent_task_id" field. It is called by the builders before save.
 	taskinstance.ParentTaskIDValidator = taskinstanceDescParentTaskID.Validators[0].(func(string) error)
+	// taskinstanceDescWaitForSubtasks is the schema descriptor for wait_for_subtasks field.
+	taskinstanceDescWaitForSubtasks := taskinstanceFields[19].Descriptor()
+	// taskinstance.DefaultWaitForSubtasks holds the default value on creation for the wait_for_subtasks field.
+	taskinstance.DefaultWaitForSubtasks = taskinstanceDescWaitForSubtasks.Default.(bool)
 	// taskinstanceDescExecutionID is the schema descriptor for execution_id field.
-	taskinstanceDescExecutionID := taskinstanceFields[14].Descriptor()
+	taskinstanceDescExecutionID := taskinstanceFields[20].Descriptor()
 	// taskinstance.ExecutionIDValidator is a validator for the "execution_id" field. It is called by the builders before save.
 	taskinstance.ExecutionIDValidator = taskinstanceDescExecutionID.Validators[0].(func(string) error)
 	// taskinstanceDescProcessDefinitionKey is the schema descriptor for process_definition_key field.
-	taskinstanceDescProcessDefinitionKey := taskinstanceFields[17].Descriptor()
+	taskinstanceDescProcessDefinitionKey := taskinstanceFields[23].Descriptor()
 	// taskinstance.ProcessDefinitionKeyValidator is a validator for the "process_definition_key" field. It is called by the builders before save.
 	taskinstance.ProcessDefinitionKeyValidator = func() func(string) error {
 		validators := taskinstanceDescProcessDefinitionKey.Validators
@@ -549,35 +855,93 @@ func init() {
 		}
 	}()
 	// taskinstanceDescCaseExecutionID is the schema descriptor for case_execution_id field.
-	taskinstanceDescCaseExecutionID := taskinstanceFields[18].Descriptor()
+	taskinstanceDescCaseExecutionID := taskinstanceFields[24].Descriptor()
 	// taskinstance.CaseExecutionIDValidator is a validator for the "case_execution_id" field. It is called by the builders before save.
 	taskinstance.CaseExecutionIDValidator = taskinstanceDescCaseExecutionID.Validators[0].(func(string) error)
 	// taskinstanceDescCaseInstanceID is the schema descriptor for case_instance_id field.
-	taskinstanceDescCaseInstanceID := taskinstanceFields[19].Descriptor()
+	taskinstanceDescCaseInstanceID := taskinstanceFields[25].Descriptor()
 	// taskinstance.CaseInstanceIDValidator is a validator for the "case_instance_id" field. It is called by the builders before save.
 	taskinstance.CaseInstanceIDValidator = taskinstanceDescCaseInstanceID.Validators[0].(func(string) error)
 	// taskinstanceDescCaseDefinitionID is the schema descriptor for case_definition_id field.
-	taskinstanceDescCaseDefinitionID := taskinstanceFields[20].Descriptor()
+	taskinstanceDescCaseDefinitionID := taskinstanceFields[26].Descriptor()
 	// taskinstance.CaseDefinitionIDValidator is a validator for the "case_definition_id" field. It is called by the builders before save.
 	taskinstance.CaseDefinitionIDValidator = taskinstanceDescCaseDefinitionID.Validators[0].(func(string) error)
 	// taskinstanceDescSuspended is the schema descriptor for suspended field.
-	taskinstanceDescSuspended := taskinstanceFields[21].Descriptor()
+	taskinstanceDescSuspended := taskinstanceFields[27].Descriptor()
 	// taskinstance.DefaultSuspended holds the default value on creation for the suspended field.
 	taskinstance.DefaultSuspended = taskinstanceDescSuspended.Default.(bool)
 	// taskinstanceDescTenantID is the schema descriptor for tenant_id field.
-	taskinstanceDescTenantID := taskinstanceFields[22].Descriptor()
+	taskinstanceDescTenantID := taskinstanceFields[28].Descriptor()
 	// taskinstance.DefaultTenantID holds the default value on creation for the tenant_id field.
 	taskinstance.DefaultTenantID = taskinstanceDescTenantID.Default.(string)
 	// taskinstance.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
 	taskinstance.TenantIDValidator = taskinstanceDescTenantID.Validators[0].(func(string) error)
 	// taskinstanceDescCreatedAt is the schema descriptor for created_at field.
-	taskinstanceDescCreatedAt := taskinstanceFields[23].Descriptor()
+	taskinstanceDescCreatedAt := taskinstanceFields[29].Descriptor()
 	// taskinstance.DefaultCreatedAt holds the default value on creation for the created_at field.
 	taskinstance.DefaultCreatedAt = taskinstanceDescCreatedAt.Default.(func() time.Time)
 	// taskinstanceDescUpdatedAt is the schema descriptor for updated_at field.
-	taskinstanceDescUpdatedAt := taskinstanceFields[24].Descriptor()
+	taskinstanceDescUpdatedAt := taskinstanceFields[30].Descriptor()
 	// taskinstance.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	taskinstance.DefaultUpdatedAt = taskinstanceDescUpdatedAt.Default.(func() time.Time)
 	// taskinstance.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	taskinstance.UpdateDefaultUpdatedAt = taskinstanceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescUsername is the schema descriptor for username field.
+	userDescUsername := userFields[1].Descriptor()
+	// user.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	user.UsernameValidator = func() func(string) error {
+		validators := userDescUsername.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(username string) error {
+			for _, fn := range fns {
+				if err := fn(username); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[2].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescDisplayName is the schema descriptor for display_name field.
+	userDescDisplayName := userFields[3].Descriptor()
+	// user.DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	user.DisplayNameValidator = userDescDisplayName.Validators[0].(func(string) error)
+	// userDescPasswordHash is the schema descriptor for password_hash field.
+	userDescPasswordHash := userFields[4].Descriptor()
+	// user.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
+	user.PasswordHashValidator = userDescPasswordHash.Validators[0].(func(string) error)
+	// userDescStatus is the schema descriptor for status field.
+	userDescStatus := userFields[5].Descriptor()
+	// user.DefaultStatus holds the default value on creation for the status field.
+	user.DefaultStatus = userDescStatus.Default.(string)
+	// user.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	user.StatusValidator = userDescStatus.Validators[0].(func(string) error)
+	// userDescTenantID is the schema descriptor for tenant_id field.
+	userDescTenantID := userFields[8].Descriptor()
+	// user.DefaultTenantID holds the default value on creation for the tenant_id field.
+	user.DefaultTenantID = userDescTenantID.Default.(string)
+	// user.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	user.TenantIDValidator = userDescTenantID.Validators[0].(func(string) error)
+	// userDescPasswordChangedAt is the schema descriptor for password_changed_at field.
+	userDescPasswordChangedAt := userFields[9].Descriptor()
+	// user.DefaultPasswordChangedAt holds the default value on creation for the password_changed_at field.
+	user.DefaultPasswordChangedAt = userDescPasswordChangedAt.Default.(func() time.Time)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[11].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[12].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Authorization 资源授权表 - 按流程定义Key、分类与租户向用户或用户组授予流程操作权限
+type Authorization struct {
+	ent.Schema
+}
+
+// Fields 定义 Authorization 的字段
+func (Authorization) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Comment("授权ID"),
+		field.String("principal_type").
+			NotEmpty().
+			Immutable().
+			Comment("被授权主体类型：user, group").
+			MaxLen(20),
+		field.String("principal_id").
+			NotEmpty().
+			Immutable().
+			Comment("被授权主体：用户ID、用户名或用户组名称").
+			MaxLen(100),
+		field.String("process_definition_key").
+			Default("").
+			Immutable().
+			Comment("流程定义Key，为空表示不限").
+			MaxLen(255),
+		field.String("category").
+			Default("").
+			Immutable().
+			Comment("流程定义分类，为空表示不限").
+			MaxLen(100),
+		field.String("tenant_id").
+			Default("").
+			Immutable().
+			Comment("租户ID，为空表示不限").
+			MaxLen(255),
+		field.Strings("actions").
+			Comment("授予的操作：start, read, update, suspend, terminate, migrate，* 表示全部"),
+		field.String("created_by").
+			Optional().
+			Immutable().
+			Comment("授权人").
+			MaxLen(100),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+	}
+}
+
+// Edges 定义 Authorization 的边（关系）
+func (Authorization) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 关系将在所有 schema 定义完成后添加
+	}
+}
+
+// Indexes 定义 Authorization 的索引
+func (Authorization) Indexes() []ent.Index {
+	return []ent.Index{
+		// 主体索引，鉴权时按用户与用户组加载授权
+		index.Fields("principal_type", "principal_id"),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Group 用户组表 - 存储可作为任务候选组的用户组
+type Group struct {
+	ent.Schema
+}
+
+// Fields 定义 Group 的字段
+func (Group) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Comment("用户组ID"),
+		field.String("name").
+			NotEmpty().
+			Comment("用户组名称").
+			MaxLen(100),
+		field.Text("description").
+			Optional().
+			Comment("用户组描述"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+	}
+}
+
+// Edges 定义 Group 的边（关系）
+func (Group) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 关系将在所有 schema 定义完成后添加
+	}
+}
+
+// Indexes 定义 Group 的索引
+func (Group) Indexes() []ent.Index {
+	return []ent.Index{
+		// 用户组名称唯一索引
+		index.Fields("name").
+			Unique(),
+	}
+}
@@ -94,6 +94,14 @@ func (ProcessInstance) Fields() []ent.Field {
 			Optional().
 			Comment("引用类型").
 			MaxLen(100),
+		field.String("workflow_id").
+			Optional().
+			Comment("Temporal工作流ID").
+			MaxLen(255),
+		field.String("workflow_run_id").
+			Optional().
+			Comment("Temporal工作流运行ID").
+			MaxLen(255),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable().
@@ -138,5 +146,7 @@ func (ProcessInstance) Indexes() []ent.Index {
 		index.Fields("callback_id"),
 		// 引用索引
 		index.Fields("reference_id", "reference_type"),
+		// 工作流索引
+		index.Fields("workflow_id"),
 	}
 }
@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RefreshToken 刷新令牌表 - 记录登录会话中签发的每个刷新令牌，用于一次性轮换与重用检测
+type RefreshToken struct {
+	ent.Schema
+}
+
+// Fields 定义 RefreshToken 的字段
+func (RefreshToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Comment("记录ID"),
+		field.String("token_id").
+			NotEmpty().
+			Immutable().
+			Comment("刷新令牌的 jti").
+			MaxLen(64),
+		field.String("family_id").
+			NotEmpty().
+			Immutable().
+			Comment("令牌族ID，即登录会话ID，写入令牌的 sid").
+			MaxLen(64),
+		field.Int64("user_id").
+			Immutable().
+			Comment("用户ID"),
+		field.String("parent_token_id").
+			Optional().
+			Immutable().
+			Comment("轮换前的刷新令牌 jti，登录签发时为空").
+			MaxLen(64),
+		field.String("access_token_id").
+			Optional().
+			Immutable().
+			Comment("同时签发的访问令牌 jti").
+			MaxLen(64),
+		field.Time("access_expires_at").
+			Immutable().
+			Comment("访问令牌过期时间"),
+		field.Time("expires_at").
+			Immutable().
+			Comment("刷新令牌过期时间"),
+		field.Time("used_at").
+			Optional().
+			Nillable().
+			Comment("轮换时间，非空表示已使用"),
+		field.Time("revoked_at").
+			Optional().
+			Nillable().
+			Comment("吊销时间"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+	}
+}
+
+// Edges 定义 RefreshToken 的边（关系）
+func (RefreshToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 关系将在所有 schema 定义完成后添加
+	}
+}
+
+// Indexes 定义 RefreshToken 的索引
+func (RefreshToken) Indexes() []ent.Index {
+	return []ent.Index{
+		// 令牌唯一索引
+		index.Fields("token_id").
+			Unique(),
+		// 令牌族索引，重用检测时吊销整个令牌族
+		index.Fields("family_id"),
+		// 用户索引，修改密码时吊销用户的全部会话
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Role 角色表 - 存储角色及其授予的权限
+type Role struct {
+	ent.Schema
+}
+
+// Fields 定义 Role 的字段
+func (Role) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Comment("角色ID"),
+		field.String("name").
+			NotEmpty().
+			Comment("角色名称").
+			MaxLen(100),
+		field.Text("description").
+			Optional().
+			Comment("角色描述"),
+		field.Strings("permissions").
+			Optional().
+			Comment("权限列表，支持 process:* 形式的通配符"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+	}
+}
+
+// Edges 定义 Role 的边（关系）
+func (Role) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 关系将在所有 schema 定义完成后添加
+	}
+}
+
+// Indexes 定义 Role 的索引
+func (Role) Indexes() []ent.Index {
+	return []ent.Index{
+		// 角色名称唯一索引
+		index.Fields("name").
+			Unique(),
+	}
+}
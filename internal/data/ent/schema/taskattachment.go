@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TaskAttachment 任务附件表 - 存储任务附件的元数据，内容保存在本表或本地文件中
+type TaskAttachment struct {
+	ent.Schema
+}
+
+// Fields 定义 TaskAttachment 的字段
+func (TaskAttachment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Comment("附件ID"),
+		field.Int64("task_id").
+			Comment("任务实例ID"),
+		field.Int64("process_instance_id").
+			Optional().
+			Comment("流程实例ID"),
+		field.String("user_id").
+			Optional().
+			Comment("上传人").
+			MaxLen(255),
+		field.String("name").
+			NotEmpty().
+			Comment("文件名").
+			MaxLen(255),
+		field.String("description").
+			Optional().
+			Comment("附件说明").
+			MaxLen(1000),
+		field.String("content_type").
+			Optional().
+			Comment("内容类型").
+			MaxLen(255),
+		field.Int64("size").
+			Default(0).
+			Comment("文件大小(字节)"),
+		field.String("storage").
+			Default("db").
+			Comment("存储方式: db, local").
+			MaxLen(20),
+		field.String("location").
+			Optional().
+			Comment("本地存储时的文件路径，相对于附件目录").
+			MaxLen(1000),
+		field.Bytes("content").
+			Optional().
+			Comment("数据库存储时的文件内容"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+	}
+}
+
+// Edges 定义 TaskAttachment 的边（关系）
+func (TaskAttachment) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 关系将在所有 schema 定义完成后添加
+	}
+}
+
+// Indexes 定义 TaskAttachment 的索引
+func (TaskAttachment) Indexes() []ent.Index {
+	return []ent.Index{
+		// 任务索引
+		index.Fields("task_id"),
+		// 流程实例索引
+		index.Fields("process_instance_id"),
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TaskComment 任务评论表 - 存储任务的评论与办理意见
+type TaskComment struct {
+	ent.Schema
+}
+
+// Fields 定义 TaskComment 的字段
+func (TaskComment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Comment("评论ID"),
+		field.Int64("task_id").
+			Comment("任务实例ID"),
+		field.Int64("process_instance_id").
+			Optional().
+			Comment("流程实例ID"),
+		field.String("user_id").
+			Optional().
+			Comment("评论人").
+			MaxLen(255),
+		field.String("type").
+			Default("comment").
+			Comment("评论类型: comment, complete, delegate, resolve").
+			MaxLen(50),
+		field.Text("message").
+			NotEmpty().
+			Comment("评论内容"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+	}
+}
+
+// Edges 定义 TaskComment 的边（关系）
+func (TaskComment) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 关系将在所有 schema 定义完成后添加
+	}
+}
+
+// Indexes 定义 TaskComment 的索引
+func (TaskComment) Indexes() []ent.Index {
+	return []ent.Index{
+		// 任务索引
+		index.Fields("task_id"),
+		// 流程实例索引
+		index.Fields("process_instance_id"),
+	}
+}
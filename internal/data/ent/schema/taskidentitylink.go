@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TaskIdentityLink 任务身份关联表 - 存储任务的候选用户、候选组与参与者
+type TaskIdentityLink struct {
+	ent.Schema
+}
+
+// Fields 定义 TaskIdentityLink 的字段
+func (TaskIdentityLink) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Comment("身份关联ID"),
+		field.Int64("task_id").
+			Comment("任务实例ID"),
+		field.Int64("process_instance_id").
+			Optional().
+			Comment("流程实例ID"),
+		field.String("type").
+			NotEmpty().
+			Comment("关联类型: candidate, participant").
+			MaxLen(50),
+		field.String("user_id").
+			Optional().
+			Comment("用户ID").
+			MaxLen(255),
+		field.String("group_id").
+			Optional().
+			Comment("用户组ID").
+			MaxLen(255),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+	}
+}
+
+// Edges 定义 TaskIdentityLink 的边（关系）
+func (TaskIdentityLink) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 关系将在所有 schema 定义完成后添加
+	}
+}
+
+// Indexes 定义 TaskIdentityLink 的索引
+func (TaskIdentityLink) Indexes() []ent.Index {
+	return []ent.Index{
+		// 任务索引
+		index.Fields("task_id"),
+		// 流程实例索引
+		index.Fields("process_instance_id"),
+		// 复合索引：关联类型+用户，查询用户的候选任务
+		index.Fields("type", "user_id"),
+		// 复合索引：关联类型+用户组，查询用户组的候选任务
+		index.Fields("type", "group_id"),
+	}
+}
@@ -41,12 +41,31 @@ func (TaskInstance) Fields() []ent.Field {
 			Optional().
 			Comment("委派状态: PENDING, RESOLVED").
 			MaxLen(50),
+		field.String("status").
+			Default("created").
+			Comment("任务状态: created, claimed, delegated, resolved, completed, cancelled").
+			MaxLen(50),
 		field.Int32("priority").
 			Default(50).
 			Comment("任务优先级"),
 		field.Time("create_time").
 			Default(time.Now).
 			Comment("创建时间"),
+		field.Time("claim_time").
+			Optional().
+			Nillable().
+			Comment("认领时间"),
+		field.Time("end_time").
+			Optional().
+			Nillable().
+			Comment("结束时间"),
+		field.Int64("duration").
+			Optional().
+			Comment("持续时间(毫秒)"),
+		field.String("delete_reason").
+			Optional().
+			Comment("取消原因").
+			MaxLen(500),
 		field.Time("due_date").
 			Optional().
 			Nillable().
@@ -67,6 +86,9 @@ func (TaskInstance) Fields() []ent.Field {
 			Optional().
 			Comment("父任务ID").
 			MaxLen(255),
+		field.Bool("wait_for_subtasks").
+			Default(false).
+			Comment("子任务全部结束前不能完成任务"),
 		field.String("execution_id").
 			Optional().
 			Comment("执行ID").
@@ -142,6 +164,8 @@ func (TaskInstance) Indexes() []ent.Index {
 		index.Fields("tenant_id"),
 		// 委派状态索引
 		index.Fields("delegation"),
+		// 任务状态索引
+		index.Fields("status"),
 		// 父任务索引
 		index.Fields("parent_task_id"),
 		// 执行ID索引
@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// User 用户表 - 存储登录账号、密码哈希以及所属角色和用户组
+type User struct {
+	ent.Schema
+}
+
+// Fields 定义 User 的字段
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Comment("用户ID"),
+		field.String("username").
+			NotEmpty().
+			Comment("用户名").
+			MaxLen(255),
+		field.String("email").
+			Optional().
+			Comment("邮箱").
+			MaxLen(255),
+		field.String("display_name").
+			Optional().
+			Comment("显示名称").
+			MaxLen(255),
+		field.String("password_hash").
+			Sensitive().
+			Comment("密码哈希(PHC 格式)").
+			MaxLen(255),
+		field.String("status").
+			Default("active").
+			Comment("账号状态: active, disabled").
+			MaxLen(20),
+		field.Strings("roles").
+			Optional().
+			Comment("角色名称列表"),
+		field.Strings("groups").
+			Optional().
+			Comment("用户组名称列表"),
+		field.String("tenant_id").
+			Default("default").
+			Comment("租户ID").
+			MaxLen(100),
+		field.Time("password_changed_at").
+			Default(time.Now).
+			Comment("密码修改时间"),
+		field.Time("last_login_at").
+			Optional().
+			Nillable().
+			Comment("最后登录时间"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Comment("创建时间"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("更新时间"),
+	}
+}
+
+// Edges 定义 User 的边（关系）
+func (User) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 关系将在所有 schema 定义完成后添加
+	}
+}
+
+// Indexes 定义 User 的索引
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		// 用户名唯一索引
+		index.Fields("username").
+			Unique(),
+	}
+}
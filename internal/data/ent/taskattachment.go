@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskattachment"
+)
+
+// TaskAttachment is the model entity for the TaskAttachment schema.
+type TaskAttachment struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 附件ID
+	ID int64 `json:"id,omitempty"`
+	// 任务实例ID
+	TaskID int64 `json:"task_id,omitempty"`
+	// 流程实例ID
+	ProcessInstanceID int64 `json:"process_instance_id,omitempty"`
+	// 上传人
+	UserID string `json:"user_id,omitempty"`
+	// 文件名
+	Name string `json:"name,omitempty"`
+	// 附件说明
+	Description string `json:"description,omitempty"`
+	// 内容类型
+	ContentType string `json:"content_type,omitempty"`
+	// 文件大小(字节)
+	Size int64 `json:"size,omitempty"`
+	// 存储方式: db, local
+	Storage string `json:"storage,omitempty"`
+	// 本地存储时的文件路径，相对于附件目录
+	Location string `json:"location,omitempty"`
+	// 数据库存储时的文件内容
+	Content []byte `json:"content,omitempty"`
+	// 创建时间
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskAttachment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskattachment.FieldContent:
+			values[i] = new([]byte)
+		case taskattachment.FieldID, taskattachment.FieldTaskID, taskattachment.FieldProcessInstanceID, taskattachment.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case taskattachment.FieldUserID, taskattachment.FieldName, taskattachment.FieldDescription, taskattachment.FieldContentType, taskattachment.FieldStorage, taskattachment.FieldLocation:
+			values[i] = new(sql.NullString)
+		case taskattachment.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskAttachment fields.
+func (ta *TaskAttachment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskattachment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ta.ID = int64(value.Int64)
+		case taskattachment.FieldTaskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				ta.TaskID = value.Int64
+			}
+		case taskattachment.FieldProcessInstanceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field process_instance_id", values[i])
+			} else if value.Valid {
+				ta.ProcessInstanceID = value.Int64
+			}
+		case taskattachment.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ta.UserID = value.String
+			}
+		case taskattachment.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ta.Name = value.String
+			}
+		case taskattachment.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				ta.Description = value.String
+			}
+		case taskattachment.FieldContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_type", values[i])
+			} else if value.Valid {
+				ta.ContentType = value.String
+			}
+		case taskattachment.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				ta.Size = value.Int64
+			}
+		case taskattachment.FieldStorage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage", values[i])
+			} else if value.Valid {
+				ta.Storage = value.String
+			}
+		case taskattachment.FieldLocation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field location", values[i])
+			} else if value.Valid {
+				ta.Location = value.String
+			}
+		case taskattachment.FieldContent:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value != nil {
+				ta.Content = *value
+			}
+		case taskattachment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ta.CreatedAt = value.Time
+			}
+		default:
+			ta.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskAttachment.
+// This includes values selected through modifiers, order, etc.
+func (ta *TaskAttachment) Value(name string) (ent.Value, error) {
+	return ta.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TaskAttachment.
+// Note that you need to call TaskAttachment.Unwrap() before calling this method if this TaskAttachment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ta *TaskAttachment) Update() *TaskAttachmentUpdateOne {
+	return NewTaskAttachmentClient(ta.config).UpdateOne(ta)
+}
+
+// Unwrap unwraps the TaskAttachment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ta *TaskAttachment) Unwrap() *TaskAttachment {
+	_tx, ok := ta.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskAttachment is not a transactional entity")
+	}
+	ta.config.driver = _tx.drv
+	return ta
+}
+
+// String implements the fmt.Stringer.
+func (ta *TaskAttachment) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskAttachment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ta.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", ta.TaskID))
+	builder.WriteString(", ")
+	builder.WriteString("process_instance_id=")
+	builder.WriteString(fmt.Sprintf("%v", ta.ProcessInstanceID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(ta.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ta.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(ta.Description)
+	builder.WriteString(", ")
+	builder.WriteString("content_type=")
+	builder.WriteString(ta.ContentType)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", ta.Size))
+	builder.WriteString(", ")
+	builder.WriteString("storage=")
+	builder.WriteString(ta.Storage)
+	builder.WriteString(", ")
+	builder.WriteString("location=")
+	builder.WriteString(ta.Location)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(fmt.Sprintf("%v", ta.Content))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ta.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskAttachments is a parsable slice of TaskAttachment.
+type TaskAttachments []*TaskAttachment
@@ -0,0 +1,775 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taskattachment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLTE(FieldID, id))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldTaskID, v))
+}
+
+// ProcessInstanceID applies equality check predicate on the "process_instance_id" field. It's identical to ProcessInstanceIDEQ.
+func ProcessInstanceID(v int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldProcessInstanceID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldDescription, v))
+}
+
+// ContentType applies equality check predicate on the "content_type" field. It's identical to ContentTypeEQ.
+func ContentType(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldContentType, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldSize, v))
+}
+
+// Storage applies equality check predicate on the "storage" field. It's identical to StorageEQ.
+func Storage(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldStorage, v))
+}
+
+// Location applies equality check predicate on the "location" field. It's identical to LocationEQ.
+func Location(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldLocation, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v []byte) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldContent, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// TaskIDGT applies the GT predicate on the "task_id" field.
+func TaskIDGT(v int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGT(FieldTaskID, v))
+}
+
+// TaskIDGTE applies the GTE predicate on the "task_id" field.
+func TaskIDGTE(v int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGTE(FieldTaskID, v))
+}
+
+// TaskIDLT applies the LT predicate on the "task_id" field.
+func TaskIDLT(v int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLT(FieldTaskID, v))
+}
+
+// TaskIDLTE applies the LTE predicate on the "task_id" field.
+func TaskIDLTE(v int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLTE(FieldTaskID, v))
+}
+
+// ProcessInstanceIDEQ applies the EQ predicate on the "process_instance_id" field.
+func ProcessInstanceIDEQ(v int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDNEQ applies the NEQ predicate on the "process_instance_id" field.
+func ProcessInstanceIDNEQ(v int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNEQ(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDIn applies the In predicate on the "process_instance_id" field.
+func ProcessInstanceIDIn(vs ...int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldIn(FieldProcessInstanceID, vs...))
+}
+
+// ProcessInstanceIDNotIn applies the NotIn predicate on the "process_instance_id" field.
+func ProcessInstanceIDNotIn(vs ...int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNotIn(FieldProcessInstanceID, vs...))
+}
+
+// ProcessInstanceIDGT applies the GT predicate on the "process_instance_id" field.
+func ProcessInstanceIDGT(v int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGT(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDGTE applies the GTE predicate on the "process_instance_id" field.
+func ProcessInstanceIDGTE(v int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGTE(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDLT applies the LT predicate on the "process_instance_id" field.
+func ProcessInstanceIDLT(v int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLT(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDLTE applies the LTE predicate on the "process_instance_id" field.
+func ProcessInstanceIDLTE(v int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLTE(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDIsNil applies the IsNil predicate on the "process_instance_id" field.
+func ProcessInstanceIDIsNil() predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldIsNull(FieldProcessInstanceID))
+}
+
+// ProcessInstanceIDNotNil applies the NotNil predicate on the "process_instance_id" field.
+func ProcessInstanceIDNotNil() predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNotNull(FieldProcessInstanceID))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNotNull(FieldUserID))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// ContentTypeEQ applies the EQ predicate on the "content_type" field.
+func ContentTypeEQ(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldContentType, v))
+}
+
+// ContentTypeNEQ applies the NEQ predicate on the "content_type" field.
+func ContentTypeNEQ(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNEQ(FieldContentType, v))
+}
+
+// ContentTypeIn applies the In predicate on the "content_type" field.
+func ContentTypeIn(vs ...string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldIn(FieldContentType, vs...))
+}
+
+// ContentTypeNotIn applies the NotIn predicate on the "content_type" field.
+func ContentTypeNotIn(vs ...string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNotIn(FieldContentType, vs...))
+}
+
+// ContentTypeGT applies the GT predicate on the "content_type" field.
+func ContentTypeGT(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGT(FieldContentType, v))
+}
+
+// ContentTypeGTE applies the GTE predicate on the "content_type" field.
+func ContentTypeGTE(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGTE(FieldContentType, v))
+}
+
+// ContentTypeLT applies the LT predicate on the "content_type" field.
+func ContentTypeLT(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLT(FieldContentType, v))
+}
+
+// ContentTypeLTE applies the LTE predicate on the "content_type" field.
+func ContentTypeLTE(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLTE(FieldContentType, v))
+}
+
+// ContentTypeContains applies the Contains predicate on the "content_type" field.
+func ContentTypeContains(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldContains(FieldContentType, v))
+}
+
+// ContentTypeHasPrefix applies the HasPrefix predicate on the "content_type" field.
+func ContentTypeHasPrefix(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldHasPrefix(FieldContentType, v))
+}
+
+// ContentTypeHasSuffix applies the HasSuffix predicate on the "content_type" field.
+func ContentTypeHasSuffix(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldHasSuffix(FieldContentType, v))
+}
+
+// ContentTypeIsNil applies the IsNil predicate on the "content_type" field.
+func ContentTypeIsNil() predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldIsNull(FieldContentType))
+}
+
+// ContentTypeNotNil applies the NotNil predicate on the "content_type" field.
+func ContentTypeNotNil() predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNotNull(FieldContentType))
+}
+
+// ContentTypeEqualFold applies the EqualFold predicate on the "content_type" field.
+func ContentTypeEqualFold(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEqualFold(FieldContentType, v))
+}
+
+// ContentTypeContainsFold applies the ContainsFold predicate on the "content_type" field.
+func ContentTypeContainsFold(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldContainsFold(FieldContentType, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int64) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLTE(FieldSize, v))
+}
+
+// StorageEQ applies the EQ predicate on the "storage" field.
+func StorageEQ(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldStorage, v))
+}
+
+// StorageNEQ applies the NEQ predicate on the "storage" field.
+func StorageNEQ(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNEQ(FieldStorage, v))
+}
+
+// StorageIn applies the In predicate on the "storage" field.
+func StorageIn(vs ...string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldIn(FieldStorage, vs...))
+}
+
+// StorageNotIn applies the NotIn predicate on the "storage" field.
+func StorageNotIn(vs ...string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNotIn(FieldStorage, vs...))
+}
+
+// StorageGT applies the GT predicate on the "storage" field.
+func StorageGT(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGT(FieldStorage, v))
+}
+
+// StorageGTE applies the GTE predicate on the "storage" field.
+func StorageGTE(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGTE(FieldStorage, v))
+}
+
+// StorageLT applies the LT predicate on the "storage" field.
+func StorageLT(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLT(FieldStorage, v))
+}
+
+// StorageLTE applies the LTE predicate on the "storage" field.
+func StorageLTE(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLTE(FieldStorage, v))
+}
+
+// StorageContains applies the Contains predicate on the "storage" field.
+func StorageContains(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldContains(FieldStorage, v))
+}
+
+// StorageHasPrefix applies the HasPrefix predicate on the "storage" field.
+func StorageHasPrefix(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldHasPrefix(FieldStorage, v))
+}
+
+// StorageHasSuffix applies the HasSuffix predicate on the "storage" field.
+func StorageHasSuffix(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldHasSuffix(FieldStorage, v))
+}
+
+// StorageEqualFold applies the EqualFold predicate on the "storage" field.
+func StorageEqualFold(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEqualFold(FieldStorage, v))
+}
+
+// StorageContainsFold applies the ContainsFold predicate on the "storage" field.
+func StorageContainsFold(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldContainsFold(FieldStorage, v))
+}
+
+// LocationEQ applies the EQ predicate on the "location" field.
+func LocationEQ(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldLocation, v))
+}
+
+// LocationNEQ applies the NEQ predicate on the "location" field.
+func LocationNEQ(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNEQ(FieldLocation, v))
+}
+
+// LocationIn applies the In predicate on the "location" field.
+func LocationIn(vs ...string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldIn(FieldLocation, vs...))
+}
+
+// LocationNotIn applies the NotIn predicate on the "location" field.
+func LocationNotIn(vs ...string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNotIn(FieldLocation, vs...))
+}
+
+// LocationGT applies the GT predicate on the "location" field.
+func LocationGT(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGT(FieldLocation, v))
+}
+
+// LocationGTE applies the GTE predicate on the "location" field.
+func LocationGTE(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGTE(FieldLocation, v))
+}
+
+// LocationLT applies the LT predicate on the "location" field.
+func LocationLT(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLT(FieldLocation, v))
+}
+
+// LocationLTE applies the LTE predicate on the "location" field.
+func LocationLTE(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLTE(FieldLocation, v))
+}
+
+// LocationContains applies the Contains predicate on the "location" field.
+func LocationContains(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldContains(FieldLocation, v))
+}
+
+// LocationHasPrefix applies the HasPrefix predicate on the "location" field.
+func LocationHasPrefix(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldHasPrefix(FieldLocation, v))
+}
+
+// LocationHasSuffix applies the HasSuffix predicate on the "location" field.
+func LocationHasSuffix(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldHasSuffix(FieldLocation, v))
+}
+
+// LocationIsNil applies the IsNil predicate on the "location" field.
+func LocationIsNil() predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldIsNull(FieldLocation))
+}
+
+// LocationNotNil applies the NotNil predicate on the "location" field.
+func LocationNotNil() predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNotNull(FieldLocation))
+}
+
+// LocationEqualFold applies the EqualFold predicate on the "location" field.
+func LocationEqualFold(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEqualFold(FieldLocation, v))
+}
+
+// LocationContainsFold applies the ContainsFold predicate on the "location" field.
+func LocationContainsFold(v string) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldContainsFold(FieldLocation, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v []byte) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v []byte) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...[]byte) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...[]byte) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v []byte) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v []byte) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v []byte) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v []byte) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentIsNil applies the IsNil predicate on the "content" field.
+func ContentIsNil() predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldIsNull(FieldContent))
+}
+
+// ContentNotNil applies the NotNil predicate on the "content" field.
+func ContentNotNil() predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNotNull(FieldContent))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TaskAttachment) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TaskAttachment) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TaskAttachment) predicate.TaskAttachment {
+	return predicate.TaskAttachment(sql.NotPredicates(p))
+}
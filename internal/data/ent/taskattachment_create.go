@@ -0,0 +1,420 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskattachment"
+)
+
+// TaskAttachmentCreate is the builder for creating a TaskAttachment entity.
+type TaskAttachmentCreate struct {
+	config
+	mutation *TaskAttachmentMutation
+	hooks    []Hook
+}
+
+// SetTaskID sets the "task_id" field.
+func (tac *TaskAttachmentCreate) SetTaskID(i int64) *TaskAttachmentCreate {
+	tac.mutation.SetTaskID(i)
+	return tac
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (tac *TaskAttachmentCreate) SetProcessInstanceID(i int64) *TaskAttachmentCreate {
+	tac.mutation.SetProcessInstanceID(i)
+	return tac
+}
+
+// SetNillableProcessInstanceID sets the "process_instance_id" field if the given value is not nil.
+func (tac *TaskAttachmentCreate) SetNillableProcessInstanceID(i *int64) *TaskAttachmentCreate {
+	if i != nil {
+		tac.SetProcessInstanceID(*i)
+	}
+	return tac
+}
+
+// SetUserID sets the "user_id" field.
+func (tac *TaskAttachmentCreate) SetUserID(s string) *TaskAttachmentCreate {
+	tac.mutation.SetUserID(s)
+	return tac
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tac *TaskAttachmentCreate) SetNillableUserID(s *string) *TaskAttachmentCreate {
+	if s != nil {
+		tac.SetUserID(*s)
+	}
+	return tac
+}
+
+// SetName sets the "name" field.
+func (tac *TaskAttachmentCreate) SetName(s string) *TaskAttachmentCreate {
+	tac.mutation.SetName(s)
+	return tac
+}
+
+// SetDescription sets the "description" field.
+func (tac *TaskAttachmentCreate) SetDescription(s string) *TaskAttachmentCreate {
+	tac.mutation.SetDescription(s)
+	return tac
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (tac *TaskAttachmentCreate) SetNillableDescription(s *string) *TaskAttachmentCreate {
+	if s != nil {
+		tac.SetDescription(*s)
+	}
+	return tac
+}
+
+// SetContentType sets the "content_type" field.
+func (tac *TaskAttachmentCreate) SetContentType(s string) *TaskAttachmentCreate {
+	tac.mutation.SetContentType(s)
+	return tac
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (tac *TaskAttachmentCreate) SetNillableContentType(s *string) *TaskAttachmentCreate {
+	if s != nil {
+		tac.SetContentType(*s)
+	}
+	return tac
+}
+
+// SetSize sets the "size" field.
+func (tac *TaskAttachmentCreate) SetSize(i int64) *TaskAttachmentCreate {
+	tac.mutation.SetSize(i)
+	return tac
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (tac *TaskAttachmentCreate) SetNillableSize(i *int64) *TaskAttachmentCreate {
+	if i != nil {
+		tac.SetSize(*i)
+	}
+	return tac
+}
+
+// SetStorage sets the "storage" field.
+func (tac *TaskAttachmentCreate) SetStorage(s string) *TaskAttachmentCreate {
+	tac.mutation.SetStorage(s)
+	return tac
+}
+
+// SetNillableStorage sets the "storage" field if the given value is not nil.
+func (tac *TaskAttachmentCreate) SetNillableStorage(s *string) *TaskAttachmentCreate {
+	if s != nil {
+		tac.SetStorage(*s)
+	}
+	return tac
+}
+
+// SetLocation sets the "location" field.
+func (tac *TaskAttachmentCreate) SetLocation(s string) *TaskAttachmentCreate {
+	tac.mutation.SetLocation(s)
+	return tac
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (tac *TaskAttachmentCreate) SetNillableLocation(s *string) *TaskAttachmentCreate {
+	if s != nil {
+		tac.SetLocation(*s)
+	}
+	return tac
+}
+
+// SetContent sets the "content" field.
+func (tac *TaskAttachmentCreate) SetContent(b []byte) *TaskAttachmentCreate {
+	tac.mutation.SetContent(b)
+	return tac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tac *TaskAttachmentCreate) SetCreatedAt(t time.Time) *TaskAttachmentCreate {
+	tac.mutation.SetCreatedAt(t)
+	return tac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tac *TaskAttachmentCreate) SetNillableCreatedAt(t *time.Time) *TaskAttachmentCreate {
+	if t != nil {
+		tac.SetCreatedAt(*t)
+	}
+	return tac
+}
+
+// SetID sets the "id" field.
+func (tac *TaskAttachmentCreate) SetID(i int64) *TaskAttachmentCreate {
+	tac.mutation.SetID(i)
+	return tac
+}
+
+// Mutation returns the TaskAttachmentMutation object of the builder.
+func (tac *TaskAttachmentCreate) Mutation() *TaskAttachmentMutation {
+	return tac.mutation
+}
+
+// Save creates the TaskAttachment in the database.
+func (tac *TaskAttachmentCreate) Save(ctx context.Context) (*TaskAttachment, error) {
+	tac.defaults()
+	return withHooks(ctx, tac.sqlSave, tac.mutation, tac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tac *TaskAttachmentCreate) SaveX(ctx context.Context) *TaskAttachment {
+	v, err := tac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tac *TaskAttachmentCreate) Exec(ctx context.Context) error {
+	_, err := tac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tac *TaskAttachmentCreate) ExecX(ctx context.Context) {
+	if err := tac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tac *TaskAttachmentCreate) defaults() {
+	if _, ok := tac.mutation.Size(); !ok {
+		v := taskattachment.DefaultSize
+		tac.mutation.SetSize(v)
+	}
+	if _, ok := tac.mutation.Storage(); !ok {
+		v := taskattachment.DefaultStorage
+		tac.mutation.SetStorage(v)
+	}
+	if _, ok := tac.mutation.CreatedAt(); !ok {
+		v := taskattachment.DefaultCreatedAt()
+		tac.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tac *TaskAttachmentCreate) check() error {
+	if _, ok := tac.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "TaskAttachment.task_id"`)}
+	}
+	if v, ok := tac.mutation.UserID(); ok {
+		if err := taskattachment.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "TaskAttachment.user_id": %w`, err)}
+		}
+	}
+	if _, ok := tac.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "TaskAttachment.name"`)}
+	}
+	if v, ok := tac.mutation.Name(); ok {
+		if err := taskattachment.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TaskAttachment.name": %w`, err)}
+		}
+	}
+	if v, ok := tac.mutation.Description(); ok {
+		if err := taskattachment.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "TaskAttachment.description": %w`, err)}
+		}
+	}
+	if v, ok := tac.mutation.ContentType(); ok {
+		if err := taskattachment.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "TaskAttachment.content_type": %w`, err)}
+		}
+	}
+	if _, ok := tac.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "TaskAttachment.size"`)}
+	}
+	if _, ok := tac.mutation.Storage(); !ok {
+		return &ValidationError{Name: "storage", err: errors.New(`ent: missing required field "TaskAttachment.storage"`)}
+	}
+	if v, ok := tac.mutation.Storage(); ok {
+		if err := taskattachment.StorageValidator(v); err != nil {
+			return &ValidationError{Name: "storage", err: fmt.Errorf(`ent: validator failed for field "TaskAttachment.storage": %w`, err)}
+		}
+	}
+	if v, ok := tac.mutation.Location(); ok {
+		if err := taskattachment.LocationValidator(v); err != nil {
+			return &ValidationError{Name: "location", err: fmt.Errorf(`ent: validator failed for field "TaskAttachment.location": %w`, err)}
+		}
+	}
+	if _, ok := tac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskAttachment.created_at"`)}
+	}
+	return nil
+}
+
+func (tac *TaskAttachmentCreate) sqlSave(ctx context.Context) (*TaskAttachment, error) {
+	if err := tac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	tac.mutation.id = &_node.ID
+	tac.mutation.done = true
+	return _node, nil
+}
+
+func (tac *TaskAttachmentCreate) createSpec() (*TaskAttachment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskAttachment{config: tac.config}
+		_spec = sqlgraph.NewCreateSpec(taskattachment.Table, sqlgraph.NewFieldSpec(taskattachment.FieldID, field.TypeInt64))
+	)
+	if id, ok := tac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tac.mutation.TaskID(); ok {
+		_spec.SetField(taskattachment.FieldTaskID, field.TypeInt64, value)
+		_node.TaskID = value
+	}
+	if value, ok := tac.mutation.ProcessInstanceID(); ok {
+		_spec.SetField(taskattachment.FieldProcessInstanceID, field.TypeInt64, value)
+		_node.ProcessInstanceID = value
+	}
+	if value, ok := tac.mutation.UserID(); ok {
+		_spec.SetField(taskattachment.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := tac.mutation.Name(); ok {
+		_spec.SetField(taskattachment.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := tac.mutation.Description(); ok {
+		_spec.SetField(taskattachment.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := tac.mutation.ContentType(); ok {
+		_spec.SetField(taskattachment.FieldContentType, field.TypeString, value)
+		_node.ContentType = value
+	}
+	if value, ok := tac.mutation.Size(); ok {
+		_spec.SetField(taskattachment.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := tac.mutation.Storage(); ok {
+		_spec.SetField(taskattachment.FieldStorage, field.TypeString, value)
+		_node.Storage = value
+	}
+	if value, ok := tac.mutation.Location(); ok {
+		_spec.SetField(taskattachment.FieldLocation, field.TypeString, value)
+		_node.Location = value
+	}
+	if value, ok := tac.mutation.Content(); ok {
+		_spec.SetField(taskattachment.FieldContent, field.TypeBytes, value)
+		_node.Content = value
+	}
+	if value, ok := tac.mutation.CreatedAt(); ok {
+		_spec.SetField(taskattachment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// TaskAttachmentCreateBulk is the builder for creating many TaskAttachment entities in bulk.
+type TaskAttachmentCreateBulk struct {
+	config
+	err      error
+	builders []*TaskAttachmentCreate
+}
+
+// Save creates the TaskAttachment entities in the database.
+func (tacb *TaskAttachmentCreateBulk) Save(ctx context.Context) ([]*TaskAttachment, error) {
+	if tacb.err != nil {
+		return nil, tacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tacb.builders))
+	nodes := make([]*TaskAttachment, len(tacb.builders))
+	mutators := make([]Mutator, len(tacb.builders))
+	for i := range tacb.builders {
+		func(i int, root context.Context) {
+			builder := tacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskAttachmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tacb *TaskAttachmentCreateBulk) SaveX(ctx context.Context) []*TaskAttachment {
+	v, err := tacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tacb *TaskAttachmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := tacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tacb *TaskAttachmentCreateBulk) ExecX(ctx context.Context) {
+	if err := tacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
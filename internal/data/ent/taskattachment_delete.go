@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/predicate"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskattachment"
+)
+
+// TaskAttachmentDelete is the builder for deleting a TaskAttachment entity.
+type TaskAttachmentDelete struct {
+	config
+	hooks    []Hook
+	mutation *TaskAttachmentMutation
+}
+
+// Where appends a list predicates to the TaskAttachmentDelete builder.
+func (tad *TaskAttachmentDelete) Where(ps ...predicate.TaskAttachment) *TaskAttachmentDelete {
+	tad.mutation.Where(ps...)
+	return tad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tad *TaskAttachmentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tad.sqlExec, tad.mutation, tad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tad *TaskAttachmentDelete) ExecX(ctx context.Context) int {
+	n, err := tad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tad *TaskAttachmentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(taskattachment.Table, sqlgraph.NewFieldSpec(taskattachment.FieldID, field.TypeInt64))
+	if ps := tad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tad.mutation.done = true
+	return affected, err
+}
+
+// TaskAttachmentDeleteOne is the builder for deleting a single TaskAttachment entity.
+type TaskAttachmentDeleteOne struct {
+	tad *TaskAttachmentDelete
+}
+
+// Where appends a list predicates to the TaskAttachmentDelete builder.
+func (tado *TaskAttachmentDeleteOne) Where(ps ...predicate.TaskAttachment) *TaskAttachmentDeleteOne {
+	tado.tad.mutation.Where(ps...)
+	return tado
+}
+
+// Exec executes the deletion query.
+func (tado *TaskAttachmentDeleteOne) Exec(ctx context.Context) error {
+	n, err := tado.tad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{taskattachment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tado *TaskAttachmentDeleteOne) ExecX(ctx context.Context) {
+	if err := tado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,743 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/predicate"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskattachment"
+)
+
+// TaskAttachmentUpdate is the builder for updating TaskAttachment entities.
+type TaskAttachmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskAttachmentMutation
+}
+
+// Where appends a list predicates to the TaskAttachmentUpdate builder.
+func (tau *TaskAttachmentUpdate) Where(ps ...predicate.TaskAttachment) *TaskAttachmentUpdate {
+	tau.mutation.Where(ps...)
+	return tau
+}
+
+// SetTaskID sets the "task_id" field.
+func (tau *TaskAttachmentUpdate) SetTaskID(i int64) *TaskAttachmentUpdate {
+	tau.mutation.ResetTaskID()
+	tau.mutation.SetTaskID(i)
+	return tau
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (tau *TaskAttachmentUpdate) SetNillableTaskID(i *int64) *TaskAttachmentUpdate {
+	if i != nil {
+		tau.SetTaskID(*i)
+	}
+	return tau
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (tau *TaskAttachmentUpdate) AddTaskID(i int64) *TaskAttachmentUpdate {
+	tau.mutation.AddTaskID(i)
+	return tau
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (tau *TaskAttachmentUpdate) SetProcessInstanceID(i int64) *TaskAttachmentUpdate {
+	tau.mutation.ResetProcessInstanceID()
+	tau.mutation.SetProcessInstanceID(i)
+	return tau
+}
+
+// SetNillableProcessInstanceID sets the "process_instance_id" field if the given value is not nil.
+func (tau *TaskAttachmentUpdate) SetNillableProcessInstanceID(i *int64) *TaskAttachmentUpdate {
+	if i != nil {
+		tau.SetProcessInstanceID(*i)
+	}
+	return tau
+}
+
+// AddProcessInstanceID adds i to the "process_instance_id" field.
+func (tau *TaskAttachmentUpdate) AddProcessInstanceID(i int64) *TaskAttachmentUpdate {
+	tau.mutation.AddProcessInstanceID(i)
+	return tau
+}
+
+// ClearProcessInstanceID clears the value of the "process_instance_id" field.
+func (tau *TaskAttachmentUpdate) ClearProcessInstanceID() *TaskAttachmentUpdate {
+	tau.mutation.ClearProcessInstanceID()
+	return tau
+}
+
+// SetUserID sets the "user_id" field.
+func (tau *TaskAttachmentUpdate) SetUserID(s string) *TaskAttachmentUpdate {
+	tau.mutation.SetUserID(s)
+	return tau
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tau *TaskAttachmentUpdate) SetNillableUserID(s *string) *TaskAttachmentUpdate {
+	if s != nil {
+		tau.SetUserID(*s)
+	}
+	return tau
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (tau *TaskAttachmentUpdate) ClearUserID() *TaskAttachmentUpdate {
+	tau.mutation.ClearUserID()
+	return tau
+}
+
+// SetName sets the "name" field.
+func (tau *TaskAttachmentUpdate) SetName(s string) *TaskAttachmentUpdate {
+	tau.mutation.SetName(s)
+	return tau
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tau *TaskAttachmentUpdate) SetNillableName(s *string) *TaskAttachmentUpdate {
+	if s != nil {
+		tau.SetName(*s)
+	}
+	return tau
+}
+
+// SetDescription sets the "description" field.
+func (tau *TaskAttachmentUpdate) SetDescription(s string) *TaskAttachmentUpdate {
+	tau.mutation.SetDescription(s)
+	return tau
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (tau *TaskAttachmentUpdate) SetNillableDescription(s *string) *TaskAttachmentUpdate {
+	if s != nil {
+		tau.SetDescription(*s)
+	}
+	return tau
+}
+
+// ClearDescription clears the value of the "description" field.
+func (tau *TaskAttachmentUpdate) ClearDescription() *TaskAttachmentUpdate {
+	tau.mutation.ClearDescription()
+	return tau
+}
+
+// SetContentType sets the "content_type" field.
+func (tau *TaskAttachmentUpdate) SetContentType(s string) *TaskAttachmentUpdate {
+	tau.mutation.SetContentType(s)
+	return tau
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (tau *TaskAttachmentUpdate) SetNillableContentType(s *string) *TaskAttachmentUpdate {
+	if s != nil {
+		tau.SetContentType(*s)
+	}
+	return tau
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (tau *TaskAttachmentUpdate) ClearContentType() *TaskAttachmentUpdate {
+	tau.mutation.ClearContentType()
+	return tau
+}
+
+// SetSize sets the "size" field.
+func (tau *TaskAttachmentUpdate) SetSize(i int64) *TaskAttachmentUpdate {
+	tau.mutation.ResetSize()
+	tau.mutation.SetSize(i)
+	return tau
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (tau *TaskAttachmentUpdate) SetNillableSize(i *int64) *TaskAttachmentUpdate {
+	if i != nil {
+		tau.SetSize(*i)
+	}
+	return tau
+}
+
+// AddSize adds i to the "size" field.
+func (tau *TaskAttachmentUpdate) AddSize(i int64) *TaskAttachmentUpdate {
+	tau.mutation.AddSize(i)
+	return tau
+}
+
+// SetStorage sets the "storage" field.
+func (tau *TaskAttachmentUpdate) SetStorage(s string) *TaskAttachmentUpdate {
+	tau.mutation.SetStorage(s)
+	return tau
+}
+
+// SetNillableStorage sets the "storage" field if the given value is not nil.
+func (tau *TaskAttachmentUpdate) SetNillableStorage(s *string) *TaskAttachmentUpdate {
+	if s != nil {
+		tau.SetStorage(*s)
+	}
+	return tau
+}
+
+// SetLocation sets the "location" field.
+func (tau *TaskAttachmentUpdate) SetLocation(s string) *TaskAttachmentUpdate {
+	tau.mutation.SetLocation(s)
+	return tau
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (tau *TaskAttachmentUpdate) SetNillableLocation(s *string) *TaskAttachmentUpdate {
+	if s != nil {
+		tau.SetLocation(*s)
+	}
+	return tau
+}
+
+// ClearLocation clears the value of the "location" field.
+func (tau *TaskAttachmentUpdate) ClearLocation() *TaskAttachmentUpdate {
+	tau.mutation.ClearLocation()
+	return tau
+}
+
+// SetContent sets the "content" field.
+func (tau *TaskAttachmentUpdate) SetContent(b []byte) *TaskAttachmentUpdate {
+	tau.mutation.SetContent(b)
+	return tau
+}
+
+// ClearContent clears the value of the "content" field.
+func (tau *TaskAttachmentUpdate) ClearContent() *TaskAttachmentUpdate {
+	tau.mutation.ClearContent()
+	return tau
+}
+
+// Mutation returns the TaskAttachmentMutation object of the builder.
+func (tau *TaskAttachmentUpdate) Mutation() *TaskAttachmentMutation {
+	return tau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tau *TaskAttachmentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tau.sqlSave, tau.mutation, tau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tau *TaskAttachmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := tau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tau *TaskAttachmentUpdate) Exec(ctx context.Context) error {
+	_, err := tau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tau *TaskAttachmentUpdate) ExecX(ctx context.Context) {
+	if err := tau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tau *TaskAttachmentUpdate) check() error {
+	if v, ok := tau.mutation.UserID(); ok {
+		if err := taskattachment.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "TaskAttachment.user_id": %w`, err)}
+		}
+	}
+	if v, ok := tau.mutation.Name(); ok {
+		if err := taskattachment.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TaskAttachment.name": %w`, err)}
+		}
+	}
+	if v, ok := tau.mutation.Description(); ok {
+		if err := taskattachment.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "TaskAttachment.description": %w`, err)}
+		}
+	}
+	if v, ok := tau.mutation.ContentType(); ok {
+		if err := taskattachment.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "TaskAttachment.content_type": %w`, err)}
+		}
+	}
+	if v, ok := tau.mutation.Storage(); ok {
+		if err := taskattachment.StorageValidator(v); err != nil {
+			return &ValidationError{Name: "storage", err: fmt.Errorf(`ent: validator failed for field "TaskAttachment.storage": %w`, err)}
+		}
+	}
+	if v, ok := tau.mutation.Location(); ok {
+		if err := taskattachment.LocationValidator(v); err != nil {
+			return &ValidationError{Name: "location", err: fmt.Errorf(`ent: validator failed for field "TaskAttachment.location": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tau *TaskAttachmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskattachment.Table, taskattachment.Columns, sqlgraph.NewFieldSpec(taskattachment.FieldID, field.TypeInt64))
+	if ps := tau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tau.mutation.TaskID(); ok {
+		_spec.SetField(taskattachment.FieldTaskID, field.TypeInt64, value)
+	}
+	if value, ok := tau.mutation.AddedTaskID(); ok {
+		_spec.AddField(taskattachment.FieldTaskID, field.TypeInt64, value)
+	}
+	if value, ok := tau.mutation.ProcessInstanceID(); ok {
+		_spec.SetField(taskattachment.FieldProcessInstanceID, field.TypeInt64, value)
+	}
+	if value, ok := tau.mutation.AddedProcessInstanceID(); ok {
+		_spec.AddField(taskattachment.FieldProcessInstanceID, field.TypeInt64, value)
+	}
+	if tau.mutation.ProcessInstanceIDCleared() {
+		_spec.ClearField(taskattachment.FieldProcessInstanceID, field.TypeInt64)
+	}
+	if value, ok := tau.mutation.UserID(); ok {
+		_spec.SetField(taskattachment.FieldUserID, field.TypeString, value)
+	}
+	if tau.mutation.UserIDCleared() {
+		_spec.ClearField(taskattachment.FieldUserID, field.TypeString)
+	}
+	if value, ok := tau.mutation.Name(); ok {
+		_spec.SetField(taskattachment.FieldName, field.TypeString, value)
+	}
+	if value, ok := tau.mutation.Description(); ok {
+		_spec.SetField(taskattachment.FieldDescription, field.TypeString, value)
+	}
+	if tau.mutation.DescriptionCleared() {
+		_spec.ClearField(taskattachment.FieldDescription, field.TypeString)
+	}
+	if value, ok := tau.mutation.ContentType(); ok {
+		_spec.SetField(taskattachment.FieldContentType, field.TypeString, value)
+	}
+	if tau.mutation.ContentTypeCleared() {
+		_spec.ClearField(taskattachment.FieldContentType, field.TypeString)
+	}
+	if value, ok := tau.mutation.Size(); ok {
+		_spec.SetField(taskattachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := tau.mutation.AddedSize(); ok {
+		_spec.AddField(taskattachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := tau.mutation.Storage(); ok {
+		_spec.SetField(taskattachment.FieldStorage, field.TypeString, value)
+	}
+	if value, ok := tau.mutation.Location(); ok {
+		_spec.SetField(taskattachment.FieldLocation, field.TypeString, value)
+	}
+	if tau.mutation.LocationCleared() {
+		_spec.ClearField(taskattachment.FieldLocation, field.TypeString)
+	}
+	if value, ok := tau.mutation.Content(); ok {
+		_spec.SetField(taskattachment.FieldContent, field.TypeBytes, value)
+	}
+	if tau.mutation.ContentCleared() {
+		_spec.ClearField(taskattachment.FieldContent, field.TypeBytes)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskattachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tau.mutation.done = true
+	return n, nil
+}
+
+// TaskAttachmentUpdateOne is the builder for updating a single TaskAttachment entity.
+type TaskAttachmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskAttachmentMutation
+}
+
+// SetTaskID sets the "task_id" field.
+func (tauo *TaskAttachmentUpdateOne) SetTaskID(i int64) *TaskAttachmentUpdateOne {
+	tauo.mutation.ResetTaskID()
+	tauo.mutation.SetTaskID(i)
+	return tauo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (tauo *TaskAttachmentUpdateOne) SetNillableTaskID(i *int64) *TaskAttachmentUpdateOne {
+	if i != nil {
+		tauo.SetTaskID(*i)
+	}
+	return tauo
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (tauo *TaskAttachmentUpdateOne) AddTaskID(i int64) *TaskAttachmentUpdateOne {
+	tauo.mutation.AddTaskID(i)
+	return tauo
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (tauo *TaskAttachmentUpdateOne) SetProcessInstanceID(i int64) *TaskAttachmentUpdateOne {
+	tauo.mutation.ResetProcessInstanceID()
+	tauo.mutation.SetProcessInstanceID(i)
+	return tauo
+}
+
+// SetNillableProcessInstanceID sets the "process_instance_id" field if the given value is not nil.
+func (tauo *TaskAttachmentUpdateOne) SetNillableProcessInstanceID(i *int64) *TaskAttachmentUpdateOne {
+	if i != nil {
+		tauo.SetProcessInstanceID(*i)
+	}
+	return tauo
+}
+
+// AddProcessInstanceID adds i to the "process_instance_id" field.
+func (tauo *TaskAttachmentUpdateOne) AddProcessInstanceID(i int64) *TaskAttachmentUpdateOne {
+	tauo.mutation.AddProcessInstanceID(i)
+	return tauo
+}
+
+// ClearProcessInstanceID clears the value of the "process_instance_id" field.
+func (tauo *TaskAttachmentUpdateOne) ClearProcessInstanceID() *TaskAttachmentUpdateOne {
+	tauo.mutation.ClearProcessInstanceID()
+	return tauo
+}
+
+// SetUserID sets the "user_id" field.
+func (tauo *TaskAttachmentUpdateOne) SetUserID(s string) *TaskAttachmentUpdateOne {
+	tauo.mutation.SetUserID(s)
+	return tauo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tauo *TaskAttachmentUpdateOne) SetNillableUserID(s *string) *TaskAttachmentUpdateOne {
+	if s != nil {
+		tauo.SetUserID(*s)
+	}
+	return tauo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (tauo *TaskAttachmentUpdateOne) ClearUserID() *TaskAttachmentUpdateOne {
+	tauo.mutation.ClearUserID()
+	return tauo
+}
+
+// SetName sets the "name" field.
+func (tauo *TaskAttachmentUpdateOne) SetName(s string) *TaskAttachmentUpdateOne {
+	tauo.mutation.SetName(s)
+	return tauo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tauo *TaskAttachmentUpdateOne) SetNillableName(s *string) *TaskAttachmentUpdateOne {
+	if s != nil {
+		tauo.SetName(*s)
+	}
+	return tauo
+}
+
+// SetDescription sets the "description" field.
+func (tauo *TaskAttachmentUpdateOne) SetDescription(s string) *TaskAttachmentUpdateOne {
+	tauo.mutation.SetDescription(s)
+	return tauo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (tauo *TaskAttachmentUpdateOne) SetNillableDescription(s *string) *TaskAttachmentUpdateOne {
+	if s != nil {
+		tauo.SetDescription(*s)
+	}
+	return tauo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (tauo *TaskAttachmentUpdateOne) ClearDescription() *TaskAttachmentUpdateOne {
+	tauo.mutation.ClearDescription()
+	return tauo
+}
+
+// SetContentType sets the "content_type" field.
+func (tauo *TaskAttachmentUpdateOne) SetContentType(s string) *TaskAttachmentUpdateOne {
+	tauo.mutation.SetContentType(s)
+	return tauo
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (tauo *TaskAttachmentUpdateOne) SetNillableContentType(s *string) *TaskAttachmentUpdateOne {
+	if s != nil {
+		tauo.SetContentType(*s)
+	}
+	return tauo
+}
+
+// ClearContentType clears the value of the "content_type" field.
+func (tauo *TaskAttachmentUpdateOne) ClearContentType() *TaskAttachmentUpdateOne {
+	tauo.mutation.ClearContentType()
+	return tauo
+}
+
+// SetSize sets the "size" field.
+func (tauo *TaskAttachmentUpdateOne) SetSize(i int64) *TaskAttachmentUpdateOne {
+	tauo.mutation.ResetSize()
+	tauo.mutation.SetSize(i)
+	return tauo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (tauo *TaskAttachmentUpdateOne) SetNillableSize(i *int64) *TaskAttachmentUpdateOne {
+	if i != nil {
+		tauo.SetSize(*i)
+	}
+	return tauo
+}
+
+// AddSize adds i to the "size" field.
+func (tauo *TaskAttachmentUpdateOne) AddSize(i int64) *TaskAttachmentUpdateOne {
+	tauo.mutation.AddSize(i)
+	return tauo
+}
+
+// SetStorage sets the "storage" field.
+func (tauo *TaskAttachmentUpdateOne) SetStorage(s string) *TaskAttachmentUpdateOne {
+	tauo.mutation.SetStorage(s)
+	return tauo
+}
+
+// SetNillableStorage sets the "storage" field if the given value is not nil.
+func (tauo *TaskAttachmentUpdateOne) SetNillableStorage(s *string) *TaskAttachmentUpdateOne {
+	if s != nil {
+		tauo.SetStorage(*s)
+	}
+	return tauo
+}
+
+// SetLocation sets the "location" field.
+func (tauo *TaskAttachmentUpdateOne) SetLocation(s string) *TaskAttachmentUpdateOne {
+	tauo.mutation.SetLocation(s)
+	return tauo
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (tauo *TaskAttachmentUpdateOne) SetNillableLocation(s *string) *TaskAttachmentUpdateOne {
+	if s != nil {
+		tauo.SetLocation(*s)
+	}
+	return tauo
+}
+
+// ClearLocation clears the value of the "location" field.
+func (tauo *TaskAttachmentUpdateOne) ClearLocation() *TaskAttachmentUpdateOne {
+	tauo.mutation.ClearLocation()
+	return tauo
+}
+
+// SetContent sets the "content" field.
+func (tauo *TaskAttachmentUpdateOne) SetContent(b []byte) *TaskAttachmentUpdateOne {
+	tauo.mutation.SetContent(b)
+	return tauo
+}
+
+// ClearContent clears the value of the "content" field.
+func (tauo *TaskAttachmentUpdateOne) ClearContent() *TaskAttachmentUpdateOne {
+	tauo.mutation.ClearContent()
+	return tauo
+}
+
+// Mutation returns the TaskAttachmentMutation object of the builder.
+func (tauo *TaskAttachmentUpdateOne) Mutation() *TaskAttachmentMutation {
+	return tauo.mutation
+}
+
+// Where appends a list predicates to the TaskAttachmentUpdate builder.
+func (tauo *TaskAttachmentUpdateOne) Where(ps ...predicate.TaskAttachment) *TaskAttachmentUpdateOne {
+	tauo.mutation.Where(ps...)
+	return tauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tauo *TaskAttachmentUpdateOne) Select(field string, fields ...string) *TaskAttachmentUpdateOne {
+	tauo.fields = append([]string{field}, fields...)
+	return tauo
+}
+
+// Save executes the query and returns the updated TaskAttachment entity.
+func (tauo *TaskAttachmentUpdateOne) Save(ctx context.Context) (*TaskAttachment, error) {
+	return withHooks(ctx, tauo.sqlSave, tauo.mutation, tauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tauo *TaskAttachmentUpdateOne) SaveX(ctx context.Context) *TaskAttachment {
+	node, err := tauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tauo *TaskAttachmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := tauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tauo *TaskAttachmentUpdateOne) ExecX(ctx context.Context) {
+	if err := tauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tauo *TaskAttachmentUpdateOne) check() error {
+	if v, ok := tauo.mutation.UserID(); ok {
+		if err := taskattachment.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "TaskAttachment.user_id": %w`, err)}
+		}
+	}
+	if v, ok := tauo.mutation.Name(); ok {
+		if err := taskattachment.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TaskAttachment.name": %w`, err)}
+		}
+	}
+	if v, ok := tauo.mutation.Description(); ok {
+		if err := taskattachment.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "TaskAttachment.description": %w`, err)}
+		}
+	}
+	if v, ok := tauo.mutation.ContentType(); ok {
+		if err := taskattachment.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "TaskAttachment.content_type": %w`, err)}
+		}
+	}
+	if v, ok := tauo.mutation.Storage(); ok {
+		if err := taskattachment.StorageValidator(v); err != nil {
+			return &ValidationError{Name: "storage", err: fmt.Errorf(`ent: validator failed for field "TaskAttachment.storage": %w`, err)}
+		}
+	}
+	if v, ok := tauo.mutation.Location(); ok {
+		if err := taskattachment.LocationValidator(v); err != nil {
+			return &ValidationError{Name: "location", err: fmt.Errorf(`ent: validator failed for field "TaskAttachment.location": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tauo *TaskAttachmentUpdateOne) sqlSave(ctx context.Context) (_node *TaskAttachment, err error) {
+	if err := tauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskattachment.Table, taskattachment.Columns, sqlgraph.NewFieldSpec(taskattachment.FieldID, field.TypeInt64))
+	id, ok := tauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskAttachment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskattachment.FieldID)
+		for _, f := range fields {
+			if !taskattachment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskattachment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tauo.mutation.TaskID(); ok {
+		_spec.SetField(taskattachment.FieldTaskID, field.TypeInt64, value)
+	}
+	if value, ok := tauo.mutation.AddedTaskID(); ok {
+		_spec.AddField(taskattachment.FieldTaskID, field.TypeInt64, value)
+	}
+	if value, ok := tauo.mutation.ProcessInstanceID(); ok {
+		_spec.SetField(taskattachment.FieldProcessInstanceID, field.TypeInt64, value)
+	}
+	if value, ok := tauo.mutation.AddedProcessInstanceID(); ok {
+		_spec.AddField(taskattachment.FieldProcessInstanceID, field.TypeInt64, value)
+	}
+	if tauo.mutation.ProcessInstanceIDCleared() {
+		_spec.ClearField(taskattachment.FieldProcessInstanceID, field.TypeInt64)
+	}
+	if value, ok := tauo.mutation.UserID(); ok {
+		_spec.SetField(taskattachment.FieldUserID, field.TypeString, value)
+	}
+	if tauo.mutation.UserIDCleared() {
+		_spec.ClearField(taskattachment.FieldUserID, field.TypeString)
+	}
+	if value, ok := tauo.mutation.Name(); ok {
+		_spec.SetField(taskattachment.FieldName, field.TypeString, value)
+	}
+	if value, ok := tauo.mutation.Description(); ok {
+		_spec.SetField(taskattachment.FieldDescription, field.TypeString, value)
+	}
+	if tauo.mutation.DescriptionCleared() {
+		_spec.ClearField(taskattachment.FieldDescription, field.TypeString)
+	}
+	if value, ok := tauo.mutation.ContentType(); ok {
+		_spec.SetField(taskattachment.FieldContentType, field.TypeString, value)
+	}
+	if tauo.mutation.ContentTypeCleared() {
+		_spec.ClearField(taskattachment.FieldContentType, field.TypeString)
+	}
+	if value, ok := tauo.mutation.Size(); ok {
+		_spec.SetField(taskattachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := tauo.mutation.AddedSize(); ok {
+		_spec.AddField(taskattachment.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := tauo.mutation.Storage(); ok {
+		_spec.SetField(taskattachment.FieldStorage, field.TypeString, value)
+	}
+	if value, ok := tauo.mutation.Location(); ok {
+		_spec.SetField(taskattachment.FieldLocation, field.TypeString, value)
+	}
+	if tauo.mutation.LocationCleared() {
+		_spec.ClearField(taskattachment.FieldLocation, field.TypeString)
+	}
+	if value, ok := tauo.mutation.Content(); ok {
+		_spec.SetField(taskattachment.FieldContent, field.TypeBytes, value)
+	}
+	if tauo.mutation.ContentCleared() {
+		_spec.ClearField(taskattachment.FieldContent, field.TypeBytes)
+	}
+	_node = &TaskAttachment{config: tauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskattachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,162 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskcomment"
+)
+
+// TaskComment is the model entity for the TaskComment schema.
+type TaskComment struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 评论ID
+	ID int64 `json:"id,omitempty"`
+	// 任务实例ID
+	TaskID int64 `json:"task_id,omitempty"`
+	// 流程实例ID
+	ProcessInstanceID int64 `json:"process_instance_id,omitempty"`
+	// 评论人
+	UserID string `json:"user_id,omitempty"`
+	// 评论类型: comment, complete, delegate, resolve
+	Type string `json:"type,omitempty"`
+	// 评论内容
+	Message string `json:"message,omitempty"`
+	// 创建时间
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskComment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskcomment.FieldID, taskcomment.FieldTaskID, taskcomment.FieldProcessInstanceID:
+			values[i] = new(sql.NullInt64)
+		case taskcomment.FieldUserID, taskcomment.FieldType, taskcomment.FieldMessage:
+			values[i] = new(sql.NullString)
+		case taskcomment.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskComment fields.
+func (tc *TaskComment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskcomment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tc.ID = int64(value.Int64)
+		case taskcomment.FieldTaskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				tc.TaskID = value.Int64
+			}
+		case taskcomment.FieldProcessInstanceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field process_instance_id", values[i])
+			} else if value.Valid {
+				tc.ProcessInstanceID = value.Int64
+			}
+		case taskcomment.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				tc.UserID = value.String
+			}
+		case taskcomment.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				tc.Type = value.String
+			}
+		case taskcomment.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				tc.Message = value.String
+			}
+		case taskcomment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tc.CreatedAt = value.Time
+			}
+		default:
+			tc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskComment.
+// This includes values selected through modifiers, order, etc.
+func (tc *TaskComment) Value(name string) (ent.Value, error) {
+	return tc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TaskComment.
+// Note that you need to call TaskComment.Unwrap() before calling this method if this TaskComment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tc *TaskComment) Update() *TaskCommentUpdateOne {
+	return NewTaskCommentClient(tc.config).UpdateOne(tc)
+}
+
+// Unwrap unwraps the TaskComment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tc *TaskComment) Unwrap() *TaskComment {
+	_tx, ok := tc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskComment is not a transactional entity")
+	}
+	tc.config.driver = _tx.drv
+	return tc
+}
+
+// String implements the fmt.Stringer.
+func (tc *TaskComment) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskComment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tc.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", tc.TaskID))
+	builder.WriteString(", ")
+	builder.WriteString("process_instance_id=")
+	builder.WriteString(fmt.Sprintf("%v", tc.ProcessInstanceID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(tc.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(tc.Type)
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(tc.Message)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskComments is a parsable slice of TaskComment.
+type TaskComments []*TaskComment
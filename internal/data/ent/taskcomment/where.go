@@ -0,0 +1,435 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taskcomment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldLTE(FieldID, id))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldEQ(FieldTaskID, v))
+}
+
+// ProcessInstanceID applies equality check predicate on the "process_instance_id" field. It's identical to ProcessInstanceIDEQ.
+func ProcessInstanceID(v int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldEQ(FieldProcessInstanceID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldEQ(FieldUserID, v))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldEQ(FieldType, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldEQ(FieldMessage, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// TaskIDGT applies the GT predicate on the "task_id" field.
+func TaskIDGT(v int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldGT(FieldTaskID, v))
+}
+
+// TaskIDGTE applies the GTE predicate on the "task_id" field.
+func TaskIDGTE(v int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldGTE(FieldTaskID, v))
+}
+
+// TaskIDLT applies the LT predicate on the "task_id" field.
+func TaskIDLT(v int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldLT(FieldTaskID, v))
+}
+
+// TaskIDLTE applies the LTE predicate on the "task_id" field.
+func TaskIDLTE(v int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldLTE(FieldTaskID, v))
+}
+
+// ProcessInstanceIDEQ applies the EQ predicate on the "process_instance_id" field.
+func ProcessInstanceIDEQ(v int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldEQ(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDNEQ applies the NEQ predicate on the "process_instance_id" field.
+func ProcessInstanceIDNEQ(v int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldNEQ(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDIn applies the In predicate on the "process_instance_id" field.
+func ProcessInstanceIDIn(vs ...int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldIn(FieldProcessInstanceID, vs...))
+}
+
+// ProcessInstanceIDNotIn applies the NotIn predicate on the "process_instance_id" field.
+func ProcessInstanceIDNotIn(vs ...int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldNotIn(FieldProcessInstanceID, vs...))
+}
+
+// ProcessInstanceIDGT applies the GT predicate on the "process_instance_id" field.
+func ProcessInstanceIDGT(v int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldGT(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDGTE applies the GTE predicate on the "process_instance_id" field.
+func ProcessInstanceIDGTE(v int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldGTE(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDLT applies the LT predicate on the "process_instance_id" field.
+func ProcessInstanceIDLT(v int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldLT(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDLTE applies the LTE predicate on the "process_instance_id" field.
+func ProcessInstanceIDLTE(v int64) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldLTE(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDIsNil applies the IsNil predicate on the "process_instance_id" field.
+func ProcessInstanceIDIsNil() predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldIsNull(FieldProcessInstanceID))
+}
+
+// ProcessInstanceIDNotNil applies the NotNil predicate on the "process_instance_id" field.
+func ProcessInstanceIDNotNil() predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldNotNull(FieldProcessInstanceID))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldNotNull(FieldUserID))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldContainsFold(FieldType, v))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TaskComment {
+	return predicate.TaskComment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TaskComment) predicate.TaskComment {
+	return predicate.TaskComment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TaskComment) predicate.TaskComment {
+	return predicate.TaskComment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TaskComment) predicate.TaskComment {
+	return predicate.TaskComment(sql.NotPredicates(p))
+}
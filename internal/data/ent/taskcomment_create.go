@@ -0,0 +1,316 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskcomment"
+)
+
+// TaskCommentCreate is the builder for creating a TaskComment entity.
+type TaskCommentCreate struct {
+	config
+	mutation *TaskCommentMutation
+	hooks    []Hook
+}
+
+// SetTaskID sets the "task_id" field.
+func (tcc *TaskCommentCreate) SetTaskID(i int64) *TaskCommentCreate {
+	tcc.mutation.SetTaskID(i)
+	return tcc
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (tcc *TaskCommentCreate) SetProcessInstanceID(i int64) *TaskCommentCreate {
+	tcc.mutation.SetProcessInstanceID(i)
+	return tcc
+}
+
+// SetNillableProcessInstanceID sets the "process_instance_id" field if the given value is not nil.
+func (tcc *TaskCommentCreate) SetNillableProcessInstanceID(i *int64) *TaskCommentCreate {
+	if i != nil {
+		tcc.SetProcessInstanceID(*i)
+	}
+	return tcc
+}
+
+// SetUserID sets the "user_id" field.
+func (tcc *TaskCommentCreate) SetUserID(s string) *TaskCommentCreate {
+	tcc.mutation.SetUserID(s)
+	return tcc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tcc *TaskCommentCreate) SetNillableUserID(s *string) *TaskCommentCreate {
+	if s != nil {
+		tcc.SetUserID(*s)
+	}
+	return tcc
+}
+
+// SetType sets the "type" field.
+func (tcc *TaskCommentCreate) SetType(s string) *TaskCommentCreate {
+	tcc.mutation.SetType(s)
+	return tcc
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (tcc *TaskCommentCreate) SetNillableType(s *string) *TaskCommentCreate {
+	if s != nil {
+		tcc.SetType(*s)
+	}
+	return tcc
+}
+
+// SetMessage sets the "message" field.
+func (tcc *TaskCommentCreate) SetMessage(s string) *TaskCommentCreate {
+	tcc.mutation.SetMessage(s)
+	return tcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tcc *TaskCommentCreate) SetCreatedAt(t time.Time) *TaskCommentCreate {
+	tcc.mutation.SetCreatedAt(t)
+	return tcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tcc *TaskCommentCreate) SetNillableCreatedAt(t *time.Time) *TaskCommentCreate {
+	if t != nil {
+		tcc.SetCreatedAt(*t)
+	}
+	return tcc
+}
+
+// SetID sets the "id" field.
+func (tcc *TaskCommentCreate) SetID(i int64) *TaskCommentCreate {
+	tcc.mutation.SetID(i)
+	return tcc
+}
+
+// Mutation returns the TaskCommentMutation object of the builder.
+func (tcc *TaskCommentCreate) Mutation() *TaskCommentMutation {
+	return tcc.mutation
+}
+
+// Save creates the TaskComment in the database.
+func (tcc *TaskCommentCreate) Save(ctx context.Context) (*TaskComment, error) {
+	tcc.defaults()
+	return withHooks(ctx, tcc.sqlSave, tcc.mutation, tcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tcc *TaskCommentCreate) SaveX(ctx context.Context) *TaskComment {
+	v, err := tcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tcc *TaskCommentCreate) Exec(ctx context.Context) error {
+	_, err := tcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcc *TaskCommentCreate) ExecX(ctx context.Context) {
+	if err := tcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tcc *TaskCommentCreate) defaults() {
+	if _, ok := tcc.mutation.GetType(); !ok {
+		v := taskcomment.DefaultType
+		tcc.mutation.SetType(v)
+	}
+	if _, ok := tcc.mutation.CreatedAt(); !ok {
+		v := taskcomment.DefaultCreatedAt()
+		tcc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tcc *TaskCommentCreate) check() error {
+	if _, ok := tcc.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "TaskComment.task_id"`)}
+	}
+	if v, ok := tcc.mutation.UserID(); ok {
+		if err := taskcomment.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "TaskComment.user_id": %w`, err)}
+		}
+	}
+	if _, ok := tcc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "TaskComment.type"`)}
+	}
+	if v, ok := tcc.mutation.GetType(); ok {
+		if err := taskcomment.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TaskComment.type": %w`, err)}
+		}
+	}
+	if _, ok := tcc.mutation.Message(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required field "TaskComment.message"`)}
+	}
+	if v, ok := tcc.mutation.Message(); ok {
+		if err := taskcomment.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "TaskComment.message": %w`, err)}
+		}
+	}
+	if _, ok := tcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskComment.created_at"`)}
+	}
+	return nil
+}
+
+func (tcc *TaskCommentCreate) sqlSave(ctx context.Context) (*TaskComment, error) {
+	if err := tcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	tcc.mutation.id = &_node.ID
+	tcc.mutation.done = true
+	return _node, nil
+}
+
+func (tcc *TaskCommentCreate) createSpec() (*TaskComment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskComment{config: tcc.config}
+		_spec = sqlgraph.NewCreateSpec(taskcomment.Table, sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt64))
+	)
+	if id, ok := tcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tcc.mutation.TaskID(); ok {
+		_spec.SetField(taskcomment.FieldTaskID, field.TypeInt64, value)
+		_node.TaskID = value
+	}
+	if value, ok := tcc.mutation.ProcessInstanceID(); ok {
+		_spec.SetField(taskcomment.FieldProcessInstanceID, field.TypeInt64, value)
+		_node.ProcessInstanceID = value
+	}
+	if value, ok := tcc.mutation.UserID(); ok {
+		_spec.SetField(taskcomment.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := tcc.mutation.GetType(); ok {
+		_spec.SetField(taskcomment.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := tcc.mutation.Message(); ok {
+		_spec.SetField(taskcomment.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := tcc.mutation.CreatedAt(); ok {
+		_spec.SetField(taskcomment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// TaskCommentCreateBulk is the builder for creating many TaskComment entities in bulk.
+type TaskCommentCreateBulk struct {
+	config
+	err      error
+	builders []*TaskCommentCreate
+}
+
+// Save creates the TaskComment entities in the database.
+func (tccb *TaskCommentCreateBulk) Save(ctx context.Context) ([]*TaskComment, error) {
+	if tccb.err != nil {
+		return nil, tccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tccb.builders))
+	nodes := make([]*TaskComment, len(tccb.builders))
+	mutators := make([]Mutator, len(tccb.builders))
+	for i := range tccb.builders {
+		func(i int, root context.Context) {
+			builder := tccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskCommentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tccb *TaskCommentCreateBulk) SaveX(ctx context.Context) []*TaskComment {
+	v, err := tccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tccb *TaskCommentCreateBulk) Exec(ctx context.Context) error {
+	_, err := tccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tccb *TaskCommentCreateBulk) ExecX(ctx context.Context) {
+	if err := tccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
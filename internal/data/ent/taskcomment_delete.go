@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/predicate"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskcomment"
+)
+
+// TaskCommentDelete is the builder for deleting a TaskComment entity.
+type TaskCommentDelete struct {
+	config
+	hooks    []Hook
+	mutation *TaskCommentMutation
+}
+
+// Where appends a list predicates to the TaskCommentDelete builder.
+func (tcd *TaskCommentDelete) Where(ps ...predicate.TaskComment) *TaskCommentDelete {
+	tcd.mutation.Where(ps...)
+	return tcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tcd *TaskCommentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tcd.sqlExec, tcd.mutation, tcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcd *TaskCommentDelete) ExecX(ctx context.Context) int {
+	n, err := tcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tcd *TaskCommentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(taskcomment.Table, sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt64))
+	if ps := tcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tcd.mutation.done = true
+	return affected, err
+}
+
+// TaskCommentDeleteOne is the builder for deleting a single TaskComment entity.
+type TaskCommentDeleteOne struct {
+	tcd *TaskCommentDelete
+}
+
+// Where appends a list predicates to the TaskCommentDelete builder.
+func (tcdo *TaskCommentDeleteOne) Where(ps ...predicate.TaskComment) *TaskCommentDeleteOne {
+	tcdo.tcd.mutation.Where(ps...)
+	return tcdo
+}
+
+// Exec executes the deletion query.
+func (tcdo *TaskCommentDeleteOne) Exec(ctx context.Context) error {
+	n, err := tcdo.tcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{taskcomment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcdo *TaskCommentDeleteOne) ExecX(ctx context.Context) {
+	if err := tcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
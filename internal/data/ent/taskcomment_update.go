@@ -0,0 +1,467 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/predicate"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskcomment"
+)
+
+// TaskCommentUpdate is the builder for updating TaskComment entities.
+type TaskCommentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskCommentMutation
+}
+
+// Where appends a list predicates to the TaskCommentUpdate builder.
+func (tcu *TaskCommentUpdate) Where(ps ...predicate.TaskComment) *TaskCommentUpdate {
+	tcu.mutation.Where(ps...)
+	return tcu
+}
+
+// SetTaskID sets the "task_id" field.
+func (tcu *TaskCommentUpdate) SetTaskID(i int64) *TaskCommentUpdate {
+	tcu.mutation.ResetTaskID()
+	tcu.mutation.SetTaskID(i)
+	return tcu
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (tcu *TaskCommentUpdate) SetNillableTaskID(i *int64) *TaskCommentUpdate {
+	if i != nil {
+		tcu.SetTaskID(*i)
+	}
+	return tcu
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (tcu *TaskCommentUpdate) AddTaskID(i int64) *TaskCommentUpdate {
+	tcu.mutation.AddTaskID(i)
+	return tcu
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (tcu *TaskCommentUpdate) SetProcessInstanceID(i int64) *TaskCommentUpdate {
+	tcu.mutation.ResetProcessInstanceID()
+	tcu.mutation.SetProcessInstanceID(i)
+	return tcu
+}
+
+// SetNillableProcessInstanceID sets the "process_instance_id" field if the given value is not nil.
+func (tcu *TaskCommentUpdate) SetNillableProcessInstanceID(i *int64) *TaskCommentUpdate {
+	if i != nil {
+		tcu.SetProcessInstanceID(*i)
+	}
+	return tcu
+}
+
+// AddProcessInstanceID adds i to the "process_instance_id" field.
+func (tcu *TaskCommentUpdate) AddProcessInstanceID(i int64) *TaskCommentUpdate {
+	tcu.mutation.AddProcessInstanceID(i)
+	return tcu
+}
+
+// ClearProcessInstanceID clears the value of the "process_instance_id" field.
+func (tcu *TaskCommentUpdate) ClearProcessInstanceID() *TaskCommentUpdate {
+	tcu.mutation.ClearProcessInstanceID()
+	return tcu
+}
+
+// SetUserID sets the "user_id" field.
+func (tcu *TaskCommentUpdate) SetUserID(s string) *TaskCommentUpdate {
+	tcu.mutation.SetUserID(s)
+	return tcu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tcu *TaskCommentUpdate) SetNillableUserID(s *string) *TaskCommentUpdate {
+	if s != nil {
+		tcu.SetUserID(*s)
+	}
+	return tcu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (tcu *TaskCommentUpdate) ClearUserID() *TaskCommentUpdate {
+	tcu.mutation.ClearUserID()
+	return tcu
+}
+
+// SetType sets the "type" field.
+func (tcu *TaskCommentUpdate) SetType(s string) *TaskCommentUpdate {
+	tcu.mutation.SetType(s)
+	return tcu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (tcu *TaskCommentUpdate) SetNillableType(s *string) *TaskCommentUpdate {
+	if s != nil {
+		tcu.SetType(*s)
+	}
+	return tcu
+}
+
+// SetMessage sets the "message" field.
+func (tcu *TaskCommentUpdate) SetMessage(s string) *TaskCommentUpdate {
+	tcu.mutation.SetMessage(s)
+	return tcu
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (tcu *TaskCommentUpdate) SetNillableMessage(s *string) *TaskCommentUpdate {
+	if s != nil {
+		tcu.SetMessage(*s)
+	}
+	return tcu
+}
+
+// Mutation returns the TaskCommentMutation object of the builder.
+func (tcu *TaskCommentUpdate) Mutation() *TaskCommentMutation {
+	return tcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tcu *TaskCommentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tcu.sqlSave, tcu.mutation, tcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcu *TaskCommentUpdate) SaveX(ctx context.Context) int {
+	affected, err := tcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tcu *TaskCommentUpdate) Exec(ctx context.Context) error {
+	_, err := tcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcu *TaskCommentUpdate) ExecX(ctx context.Context) {
+	if err := tcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tcu *TaskCommentUpdate) check() error {
+	if v, ok := tcu.mutation.UserID(); ok {
+		if err := taskcomment.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "TaskComment.user_id": %w`, err)}
+		}
+	}
+	if v, ok := tcu.mutation.GetType(); ok {
+		if err := taskcomment.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TaskComment.type": %w`, err)}
+		}
+	}
+	if v, ok := tcu.mutation.Message(); ok {
+		if err := taskcomment.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "TaskComment.message": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tcu *TaskCommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskcomment.Table, taskcomment.Columns, sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt64))
+	if ps := tcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tcu.mutation.TaskID(); ok {
+		_spec.SetField(taskcomment.FieldTaskID, field.TypeInt64, value)
+	}
+	if value, ok := tcu.mutation.AddedTaskID(); ok {
+		_spec.AddField(taskcomment.FieldTaskID, field.TypeInt64, value)
+	}
+	if value, ok := tcu.mutation.ProcessInstanceID(); ok {
+		_spec.SetField(taskcomment.FieldProcessInstanceID, field.TypeInt64, value)
+	}
+	if value, ok := tcu.mutation.AddedProcessInstanceID(); ok {
+		_spec.AddField(taskcomment.FieldProcessInstanceID, field.TypeInt64, value)
+	}
+	if tcu.mutation.ProcessInstanceIDCleared() {
+		_spec.ClearField(taskcomment.FieldProcessInstanceID, field.TypeInt64)
+	}
+	if value, ok := tcu.mutation.UserID(); ok {
+		_spec.SetField(taskcomment.FieldUserID, field.TypeString, value)
+	}
+	if tcu.mutation.UserIDCleared() {
+		_spec.ClearField(taskcomment.FieldUserID, field.TypeString)
+	}
+	if value, ok := tcu.mutation.GetType(); ok {
+		_spec.SetField(taskcomment.FieldType, field.TypeString, value)
+	}
+	if value, ok := tcu.mutation.Message(); ok {
+		_spec.SetField(taskcomment.FieldMessage, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskcomment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tcu.mutation.done = true
+	return n, nil
+}
+
+// TaskCommentUpdateOne is the builder for updating a single TaskComment entity.
+type TaskCommentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskCommentMutation
+}
+
+// SetTaskID sets the "task_id" field.
+func (tcuo *TaskCommentUpdateOne) SetTaskID(i int64) *TaskCommentUpdateOne {
+	tcuo.mutation.ResetTaskID()
+	tcuo.mutation.SetTaskID(i)
+	return tcuo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (tcuo *TaskCommentUpdateOne) SetNillableTaskID(i *int64) *TaskCommentUpdateOne {
+	if i != nil {
+		tcuo.SetTaskID(*i)
+	}
+	return tcuo
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (tcuo *TaskCommentUpdateOne) AddTaskID(i int64) *TaskCommentUpdateOne {
+	tcuo.mutation.AddTaskID(i)
+	return tcuo
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (tcuo *TaskCommentUpdateOne) SetProcessInstanceID(i int64) *TaskCommentUpdateOne {
+	tcuo.mutation.ResetProcessInstanceID()
+	tcuo.mutation.SetProcessInstanceID(i)
+	return tcuo
+}
+
+// SetNillableProcessInstanceID sets the "process_instance_id" field if the given value is not nil.
+func (tcuo *TaskCommentUpdateOne) SetNillableProcessInstanceID(i *int64) *TaskCommentUpdateOne {
+	if i != nil {
+		tcuo.SetProcessInstanceID(*i)
+	}
+	return tcuo
+}
+
+// AddProcessInstanceID adds i to the "process_instance_id" field.
+func (tcuo *TaskCommentUpdateOne) AddProcessInstanceID(i int64) *TaskCommentUpdateOne {
+	tcuo.mutation.AddProcessInstanceID(i)
+	return tcuo
+}
+
+// ClearProcessInstanceID clears the value of the "process_instance_id" field.
+func (tcuo *TaskCommentUpdateOne) ClearProcessInstanceID() *TaskCommentUpdateOne {
+	tcuo.mutation.ClearProcessInstanceID()
+	return tcuo
+}
+
+// SetUserID sets the "user_id" field.
+func (tcuo *TaskCommentUpdateOne) SetUserID(s string) *TaskCommentUpdateOne {
+	tcuo.mutation.SetUserID(s)
+	return tcuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tcuo *TaskCommentUpdateOne) SetNillableUserID(s *string) *TaskCommentUpdateOne {
+	if s != nil {
+		tcuo.SetUserID(*s)
+	}
+	return tcuo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (tcuo *TaskCommentUpdateOne) ClearUserID() *TaskCommentUpdateOne {
+	tcuo.mutation.ClearUserID()
+	return tcuo
+}
+
+// SetType sets the "type" field.
+func (tcuo *TaskCommentUpdateOne) SetType(s string) *TaskCommentUpdateOne {
+	tcuo.mutation.SetType(s)
+	return tcuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (tcuo *TaskCommentUpdateOne) SetNillableType(s *string) *TaskCommentUpdateOne {
+	if s != nil {
+		tcuo.SetType(*s)
+	}
+	return tcuo
+}
+
+// SetMessage sets the "message" field.
+func (tcuo *TaskCommentUpdateOne) SetMessage(s string) *TaskCommentUpdateOne {
+	tcuo.mutation.SetMessage(s)
+	return tcuo
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (tcuo *TaskCommentUpdateOne) SetNillableMessage(s *string) *TaskCommentUpdateOne {
+	if s != nil {
+		tcuo.SetMessage(*s)
+	}
+	return tcuo
+}
+
+// Mutation returns the TaskCommentMutation object of the builder.
+func (tcuo *TaskCommentUpdateOne) Mutation() *TaskCommentMutation {
+	return tcuo.mutation
+}
+
+// Where appends a list predicates to the TaskCommentUpdate builder.
+func (tcuo *TaskCommentUpdateOne) Where(ps ...predicate.TaskComment) *TaskCommentUpdateOne {
+	tcuo.mutation.Where(ps...)
+	return tcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tcuo *TaskCommentUpdateOne) Select(field string, fields ...string) *TaskCommentUpdateOne {
+	tcuo.fields = append([]string{field}, fields...)
+	return tcuo
+}
+
+// Save executes the query and returns the updated TaskComment entity.
+func (tcuo *TaskCommentUpdateOne) Save(ctx context.Context) (*TaskComment, error) {
+	return withHooks(ctx, tcuo.sqlSave, tcuo.mutation, tcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcuo *TaskCommentUpdateOne) SaveX(ctx context.Context) *TaskComment {
+	node, err := tcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tcuo *TaskCommentUpdateOne) Exec(ctx context.Context) error {
+	_, err := tcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcuo *TaskCommentUpdateOne) ExecX(ctx context.Context) {
+	if err := tcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tcuo *TaskCommentUpdateOne) check() error {
+	if v, ok := tcuo.mutation.UserID(); ok {
+		if err := taskcomment.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "TaskComment.user_id": %w`, err)}
+		}
+	}
+	if v, ok := tcuo.mutation.GetType(); ok {
+		if err := taskcomment.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TaskComment.type": %w`, err)}
+		}
+	}
+	if v, ok := tcuo.mutation.Message(); ok {
+		if err := taskcomment.MessageValidator(v); err != nil {
+			return &ValidationError{Name: "message", err: fmt.Errorf(`ent: validator failed for field "TaskComment.message": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tcuo *TaskCommentUpdateOne) sqlSave(ctx context.Context) (_node *TaskComment, err error) {
+	if err := tcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskcomment.Table, taskcomment.Columns, sqlgraph.NewFieldSpec(taskcomment.FieldID, field.TypeInt64))
+	id, ok := tcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskComment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskcomment.FieldID)
+		for _, f := range fields {
+			if !taskcomment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskcomment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tcuo.mutation.TaskID(); ok {
+		_spec.SetField(taskcomment.FieldTaskID, field.TypeInt64, value)
+	}
+	if value, ok := tcuo.mutation.AddedTaskID(); ok {
+		_spec.AddField(taskcomment.FieldTaskID, field.TypeInt64, value)
+	}
+	if value, ok := tcuo.mutation.ProcessInstanceID(); ok {
+		_spec.SetField(taskcomment.FieldProcessInstanceID, field.TypeInt64, value)
+	}
+	if value, ok := tcuo.mutation.AddedProcessInstanceID(); ok {
+		_spec.AddField(taskcomment.FieldProcessInstanceID, field.TypeInt64, value)
+	}
+	if tcuo.mutation.ProcessInstanceIDCleared() {
+		_spec.ClearField(taskcomment.FieldProcessInstanceID, field.TypeInt64)
+	}
+	if value, ok := tcuo.mutation.UserID(); ok {
+		_spec.SetField(taskcomment.FieldUserID, field.TypeString, value)
+	}
+	if tcuo.mutation.UserIDCleared() {
+		_spec.ClearField(taskcomment.FieldUserID, field.TypeString)
+	}
+	if value, ok := tcuo.mutation.GetType(); ok {
+		_spec.SetField(taskcomment.FieldType, field.TypeString, value)
+	}
+	if value, ok := tcuo.mutation.Message(); ok {
+		_spec.SetField(taskcomment.FieldMessage, field.TypeString, value)
+	}
+	_node = &TaskComment{config: tcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskcomment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tcuo.mutation.done = true
+	return _node, nil
+}
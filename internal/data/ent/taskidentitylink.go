@@ -0,0 +1,162 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskidentitylink"
+)
+
+// TaskIdentityLink is the model entity for the TaskIdentityLink schema.
+type TaskIdentityLink struct {
+	config `json:"-"`
+	// ID of the ent.
+	// 身份关联ID
+	ID int64 `json:"id,omitempty"`
+	// 任务实例ID
+	TaskID int64 `json:"task_id,omitempty"`
+	// 流程实例ID
+	ProcessInstanceID int64 `json:"process_instance_id,omitempty"`
+	// 关联类型: candidate, participant
+	Type string `json:"type,omitempty"`
+	// 用户ID
+	UserID string `json:"user_id,omitempty"`
+	// 用户组ID
+	GroupID string `json:"group_id,omitempty"`
+	// 创建时间
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaskIdentityLink) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taskidentitylink.FieldID, taskidentitylink.FieldTaskID, taskidentitylink.FieldProcessInstanceID:
+			values[i] = new(sql.NullInt64)
+		case taskidentitylink.FieldType, taskidentitylink.FieldUserID, taskidentitylink.FieldGroupID:
+			values[i] = new(sql.NullString)
+		case taskidentitylink.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaskIdentityLink fields.
+func (til *TaskIdentityLink) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taskidentitylink.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			til.ID = int64(value.Int64)
+		case taskidentitylink.FieldTaskID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				til.TaskID = value.Int64
+			}
+		case taskidentitylink.FieldProcessInstanceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field process_instance_id", values[i])
+			} else if value.Valid {
+				til.ProcessInstanceID = value.Int64
+			}
+		case taskidentitylink.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				til.Type = value.String
+			}
+		case taskidentitylink.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				til.UserID = value.String
+			}
+		case taskidentitylink.FieldGroupID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value.Valid {
+				til.GroupID = value.String
+			}
+		case taskidentitylink.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				til.CreatedAt = value.Time
+			}
+		default:
+			til.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaskIdentityLink.
+// This includes values selected through modifiers, order, etc.
+func (til *TaskIdentityLink) Value(name string) (ent.Value, error) {
+	return til.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TaskIdentityLink.
+// Note that you need to call TaskIdentityLink.Unwrap() before calling this method if this TaskIdentityLink
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (til *TaskIdentityLink) Update() *TaskIdentityLinkUpdateOne {
+	return NewTaskIdentityLinkClient(til.config).UpdateOne(til)
+}
+
+// Unwrap unwraps the TaskIdentityLink entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (til *TaskIdentityLink) Unwrap() *TaskIdentityLink {
+	_tx, ok := til.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaskIdentityLink is not a transactional entity")
+	}
+	til.config.driver = _tx.drv
+	return til
+}
+
+// String implements the fmt.Stringer.
+func (til *TaskIdentityLink) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaskIdentityLink(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", til.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", til.TaskID))
+	builder.WriteString(", ")
+	builder.WriteString("process_instance_id=")
+	builder.WriteString(fmt.Sprintf("%v", til.ProcessInstanceID))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(til.Type)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(til.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("group_id=")
+	builder.WriteString(til.GroupID)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(til.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaskIdentityLinks is a parsable slice of TaskIdentityLink.
+type TaskIdentityLinks []*TaskIdentityLink
@@ -0,0 +1,445 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taskidentitylink
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldLTE(FieldID, id))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldEQ(FieldTaskID, v))
+}
+
+// ProcessInstanceID applies equality check predicate on the "process_instance_id" field. It's identical to ProcessInstanceIDEQ.
+func ProcessInstanceID(v int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldEQ(FieldProcessInstanceID, v))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldEQ(FieldType, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldEQ(FieldUserID, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldEQ(FieldGroupID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// TaskIDGT applies the GT predicate on the "task_id" field.
+func TaskIDGT(v int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldGT(FieldTaskID, v))
+}
+
+// TaskIDGTE applies the GTE predicate on the "task_id" field.
+func TaskIDGTE(v int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldGTE(FieldTaskID, v))
+}
+
+// TaskIDLT applies the LT predicate on the "task_id" field.
+func TaskIDLT(v int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldLT(FieldTaskID, v))
+}
+
+// TaskIDLTE applies the LTE predicate on the "task_id" field.
+func TaskIDLTE(v int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldLTE(FieldTaskID, v))
+}
+
+// ProcessInstanceIDEQ applies the EQ predicate on the "process_instance_id" field.
+func ProcessInstanceIDEQ(v int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldEQ(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDNEQ applies the NEQ predicate on the "process_instance_id" field.
+func ProcessInstanceIDNEQ(v int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldNEQ(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDIn applies the In predicate on the "process_instance_id" field.
+func ProcessInstanceIDIn(vs ...int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldIn(FieldProcessInstanceID, vs...))
+}
+
+// ProcessInstanceIDNotIn applies the NotIn predicate on the "process_instance_id" field.
+func ProcessInstanceIDNotIn(vs ...int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldNotIn(FieldProcessInstanceID, vs...))
+}
+
+// ProcessInstanceIDGT applies the GT predicate on the "process_instance_id" field.
+func ProcessInstanceIDGT(v int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldGT(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDGTE applies the GTE predicate on the "process_instance_id" field.
+func ProcessInstanceIDGTE(v int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldGTE(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDLT applies the LT predicate on the "process_instance_id" field.
+func ProcessInstanceIDLT(v int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldLT(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDLTE applies the LTE predicate on the "process_instance_id" field.
+func ProcessInstanceIDLTE(v int64) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldLTE(FieldProcessInstanceID, v))
+}
+
+// ProcessInstanceIDIsNil applies the IsNil predicate on the "process_instance_id" field.
+func ProcessInstanceIDIsNil() predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldIsNull(FieldProcessInstanceID))
+}
+
+// ProcessInstanceIDNotNil applies the NotNil predicate on the "process_instance_id" field.
+func ProcessInstanceIDNotNil() predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldNotNull(FieldProcessInstanceID))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldContainsFold(FieldType, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldNotNull(FieldUserID))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// GroupIDGT applies the GT predicate on the "group_id" field.
+func GroupIDGT(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldGT(FieldGroupID, v))
+}
+
+// GroupIDGTE applies the GTE predicate on the "group_id" field.
+func GroupIDGTE(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldGTE(FieldGroupID, v))
+}
+
+// GroupIDLT applies the LT predicate on the "group_id" field.
+func GroupIDLT(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldLT(FieldGroupID, v))
+}
+
+// GroupIDLTE applies the LTE predicate on the "group_id" field.
+func GroupIDLTE(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldLTE(FieldGroupID, v))
+}
+
+// GroupIDContains applies the Contains predicate on the "group_id" field.
+func GroupIDContains(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldContains(FieldGroupID, v))
+}
+
+// GroupIDHasPrefix applies the HasPrefix predicate on the "group_id" field.
+func GroupIDHasPrefix(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldHasPrefix(FieldGroupID, v))
+}
+
+// GroupIDHasSuffix applies the HasSuffix predicate on the "group_id" field.
+func GroupIDHasSuffix(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldHasSuffix(FieldGroupID, v))
+}
+
+// GroupIDIsNil applies the IsNil predicate on the "group_id" field.
+func GroupIDIsNil() predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldIsNull(FieldGroupID))
+}
+
+// GroupIDNotNil applies the NotNil predicate on the "group_id" field.
+func GroupIDNotNil() predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldNotNull(FieldGroupID))
+}
+
+// GroupIDEqualFold applies the EqualFold predicate on the "group_id" field.
+func GroupIDEqualFold(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldEqualFold(FieldGroupID, v))
+}
+
+// GroupIDContainsFold applies the ContainsFold predicate on the "group_id" field.
+func GroupIDContainsFold(v string) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldContainsFold(FieldGroupID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TaskIdentityLink) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TaskIdentityLink) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TaskIdentityLink) predicate.TaskIdentityLink {
+	return predicate.TaskIdentityLink(sql.NotPredicates(p))
+}
@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskidentitylink"
+)
+
+// TaskIdentityLinkCreate is the builder for creating a TaskIdentityLink entity.
+type TaskIdentityLinkCreate struct {
+	config
+	mutation *TaskIdentityLinkMutation
+	hooks    []Hook
+}
+
+// SetTaskID sets the "task_id" field.
+func (tilc *TaskIdentityLinkCreate) SetTaskID(i int64) *TaskIdentityLinkCreate {
+	tilc.mutation.SetTaskID(i)
+	return tilc
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (tilc *TaskIdentityLinkCreate) SetProcessInstanceID(i int64) *TaskIdentityLinkCreate {
+	tilc.mutation.SetProcessInstanceID(i)
+	return tilc
+}
+
+// SetNillableProcessInstanceID sets the "process_instance_id" field if the given value is not nil.
+func (tilc *TaskIdentityLinkCreate) SetNillableProcessInstanceID(i *int64) *TaskIdentityLinkCreate {
+	if i != nil {
+		tilc.SetProcessInstanceID(*i)
+	}
+	return tilc
+}
+
+// SetType sets the "type" field.
+func (tilc *TaskIdentityLinkCreate) SetType(s string) *TaskIdentityLinkCreate {
+	tilc.mutation.SetType(s)
+	return tilc
+}
+
+// SetUserID sets the "user_id" field.
+func (tilc *TaskIdentityLinkCreate) SetUserID(s string) *TaskIdentityLinkCreate {
+	tilc.mutation.SetUserID(s)
+	return tilc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tilc *TaskIdentityLinkCreate) SetNillableUserID(s *string) *TaskIdentityLinkCreate {
+	if s != nil {
+		tilc.SetUserID(*s)
+	}
+	return tilc
+}
+
+// SetGroupID sets the "group_id" field.
+func (tilc *TaskIdentityLinkCreate) SetGroupID(s string) *TaskIdentityLinkCreate {
+	tilc.mutation.SetGroupID(s)
+	return tilc
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (tilc *TaskIdentityLinkCreate) SetNillableGroupID(s *string) *TaskIdentityLinkCreate {
+	if s != nil {
+		tilc.SetGroupID(*s)
+	}
+	return tilc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tilc *TaskIdentityLinkCreate) SetCreatedAt(t time.Time) *TaskIdentityLinkCreate {
+	tilc.mutation.SetCreatedAt(t)
+	return tilc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tilc *TaskIdentityLinkCreate) SetNillableCreatedAt(t *time.Time) *TaskIdentityLinkCreate {
+	if t != nil {
+		tilc.SetCreatedAt(*t)
+	}
+	return tilc
+}
+
+// SetID sets the "id" field.
+func (tilc *TaskIdentityLinkCreate) SetID(i int64) *TaskIdentityLinkCreate {
+	tilc.mutation.SetID(i)
+	return tilc
+}
+
+// Mutation returns the TaskIdentityLinkMutation object of the builder.
+func (tilc *TaskIdentityLinkCreate) Mutation() *TaskIdentityLinkMutation {
+	return tilc.mutation
+}
+
+// Save creates the TaskIdentityLink in the database.
+func (tilc *TaskIdentityLinkCreate) Save(ctx context.Context) (*TaskIdentityLink, error) {
+	tilc.defaults()
+	return withHooks(ctx, tilc.sqlSave, tilc.mutation, tilc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tilc *TaskIdentityLinkCreate) SaveX(ctx context.Context) *TaskIdentityLink {
+	v, err := tilc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tilc *TaskIdentityLinkCreate) Exec(ctx context.Context) error {
+	_, err := tilc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tilc *TaskIdentityLinkCreate) ExecX(ctx context.Context) {
+	if err := tilc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tilc *TaskIdentityLinkCreate) defaults() {
+	if _, ok := tilc.mutation.CreatedAt(); !ok {
+		v := taskidentitylink.DefaultCreatedAt()
+		tilc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tilc *TaskIdentityLinkCreate) check() error {
+	if _, ok := tilc.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`ent: missing required field "TaskIdentityLink.task_id"`)}
+	}
+	if _, ok := tilc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "TaskIdentityLink.type"`)}
+	}
+	if v, ok := tilc.mutation.GetType(); ok {
+		if err := taskidentitylink.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TaskIdentityLink.type": %w`, err)}
+		}
+	}
+	if v, ok := tilc.mutation.UserID(); ok {
+		if err := taskidentitylink.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "TaskIdentityLink.user_id": %w`, err)}
+		}
+	}
+	if v, ok := tilc.mutation.GroupID(); ok {
+		if err := taskidentitylink.GroupIDValidator(v); err != nil {
+			return &ValidationError{Name: "group_id", err: fmt.Errorf(`ent: validator failed for field "TaskIdentityLink.group_id": %w`, err)}
+		}
+	}
+	if _, ok := tilc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TaskIdentityLink.created_at"`)}
+	}
+	return nil
+}
+
+func (tilc *TaskIdentityLinkCreate) sqlSave(ctx context.Context) (*TaskIdentityLink, error) {
+	if err := tilc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tilc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tilc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	tilc.mutation.id = &_node.ID
+	tilc.mutation.done = true
+	return _node, nil
+}
+
+func (tilc *TaskIdentityLinkCreate) createSpec() (*TaskIdentityLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskIdentityLink{config: tilc.config}
+		_spec = sqlgraph.NewCreateSpec(taskidentitylink.Table, sqlgraph.NewFieldSpec(taskidentitylink.FieldID, field.TypeInt64))
+	)
+	if id, ok := tilc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tilc.mutation.TaskID(); ok {
+		_spec.SetField(taskidentitylink.FieldTaskID, field.TypeInt64, value)
+		_node.TaskID = value
+	}
+	if value, ok := tilc.mutation.ProcessInstanceID(); ok {
+		_spec.SetField(taskidentitylink.FieldProcessInstanceID, field.TypeInt64, value)
+		_node.ProcessInstanceID = value
+	}
+	if value, ok := tilc.mutation.GetType(); ok {
+		_spec.SetField(taskidentitylink.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := tilc.mutation.UserID(); ok {
+		_spec.SetField(taskidentitylink.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := tilc.mutation.GroupID(); ok {
+		_spec.SetField(taskidentitylink.FieldGroupID, field.TypeString, value)
+		_node.GroupID = value
+	}
+	if value, ok := tilc.mutation.CreatedAt(); ok {
+		_spec.SetField(taskidentitylink.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// TaskIdentityLinkCreateBulk is the builder for creating many TaskIdentityLink entities in bulk.
+type TaskIdentityLinkCreateBulk struct {
+	config
+	err      error
+	builders []*TaskIdentityLinkCreate
+}
+
+// Save creates the TaskIdentityLink entities in the database.
+func (tilcb *TaskIdentityLinkCreateBulk) Save(ctx context.Context) ([]*TaskIdentityLink, error) {
+	if tilcb.err != nil {
+		return nil, tilcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tilcb.builders))
+	nodes := make([]*TaskIdentityLink, len(tilcb.builders))
+	mutators := make([]Mutator, len(tilcb.builders))
+	for i := range tilcb.builders {
+		func(i int, root context.Context) {
+			builder := tilcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskIdentityLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tilcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tilcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tilcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tilcb *TaskIdentityLinkCreateBulk) SaveX(ctx context.Context) []*TaskIdentityLink {
+	v, err := tilcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tilcb *TaskIdentityLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := tilcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tilcb *TaskIdentityLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := tilcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
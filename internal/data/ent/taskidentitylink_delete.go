@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/predicate"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskidentitylink"
+)
+
+// TaskIdentityLinkDelete is the builder for deleting a TaskIdentityLink entity.
+type TaskIdentityLinkDelete struct {
+	config
+	hooks    []Hook
+	mutation *TaskIdentityLinkMutation
+}
+
+// Where appends a list predicates to the TaskIdentityLinkDelete builder.
+func (tild *TaskIdentityLinkDelete) Where(ps ...predicate.TaskIdentityLink) *TaskIdentityLinkDelete {
+	tild.mutation.Where(ps...)
+	return tild
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tild *TaskIdentityLinkDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tild.sqlExec, tild.mutation, tild.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tild *TaskIdentityLinkDelete) ExecX(ctx context.Context) int {
+	n, err := tild.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tild *TaskIdentityLinkDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(taskidentitylink.Table, sqlgraph.NewFieldSpec(taskidentitylink.FieldID, field.TypeInt64))
+	if ps := tild.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tild.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tild.mutation.done = true
+	return affected, err
+}
+
+// TaskIdentityLinkDeleteOne is the builder for deleting a single TaskIdentityLink entity.
+type TaskIdentityLinkDeleteOne struct {
+	tild *TaskIdentityLinkDelete
+}
+
+// Where appends a list predicates to the TaskIdentityLinkDelete builder.
+func (tildo *TaskIdentityLinkDeleteOne) Where(ps ...predicate.TaskIdentityLink) *TaskIdentityLinkDeleteOne {
+	tildo.tild.mutation.Where(ps...)
+	return tildo
+}
+
+// Exec executes the deletion query.
+func (tildo *TaskIdentityLinkDeleteOne) Exec(ctx context.Context) error {
+	n, err := tildo.tild.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{taskidentitylink.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tildo *TaskIdentityLinkDeleteOne) ExecX(ctx context.Context) {
+	if err := tildo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
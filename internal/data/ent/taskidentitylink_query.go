@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/predicate"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskidentitylink"
+)
+
+// TaskIdentityLinkQuery is the builder for querying TaskIdentityLink entities.
+type TaskIdentityLinkQuery struct {
+	config
+	ctx        *QueryContext
+	order      []taskidentitylink.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TaskIdentityLink
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TaskIdentityLinkQuery builder.
+func (tilq *TaskIdentityLinkQuery) Where(ps ...predicate.TaskIdentityLink) *TaskIdentityLinkQuery {
+	tilq.predicates = append(tilq.predicates, ps...)
+	return tilq
+}
+
+// Limit the number of records to be returned by this query.
+func (tilq *TaskIdentityLinkQuery) Limit(limit int) *TaskIdentityLinkQuery {
+	tilq.ctx.Limit = &limit
+	return tilq
+}
+
+// Offset to start from.
+func (tilq *TaskIdentityLinkQuery) Offset(offset int) *TaskIdentityLinkQuery {
+	tilq.ctx.Offset = &offset
+	return tilq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tilq *TaskIdentityLinkQuery) Unique(unique bool) *TaskIdentityLinkQuery {
+	tilq.ctx.Unique = &unique
+	return tilq
+}
+
+// Order specifies how the records should be ordered.
+func (tilq *TaskIdentityLinkQuery) Order(o ...taskidentitylink.OrderOption) *TaskIdentityLinkQuery {
+	tilq.order = append(tilq.order, o...)
+	return tilq
+}
+
+// First returns the first TaskIdentityLink entity from the query.
+// Returns a *NotFoundError when no TaskIdentityLink was found.
+func (tilq *TaskIdentityLinkQuery) First(ctx context.Context) (*TaskIdentityLink, error) {
+	nodes, err := tilq.Limit(1).All(setContextOp(ctx, tilq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{taskidentitylink.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tilq *TaskIdentityLinkQuery) FirstX(ctx context.Context) *TaskIdentityLink {
+	node, err := tilq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TaskIdentityLink ID from the query.
+// Returns a *NotFoundError when no TaskIdentityLink ID was found.
+func (tilq *TaskIdentityLinkQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = tilq.Limit(1).IDs(setContextOp(ctx, tilq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{taskidentitylink.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tilq *TaskIdentityLinkQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := tilq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TaskIdentityLink entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TaskIdentityLink entity is found.
+// Returns a *NotFoundError when no TaskIdentityLink entities are found.
+func (tilq *TaskIdentityLinkQuery) Only(ctx context.Context) (*TaskIdentityLink, error) {
+	nodes, err := tilq.Limit(2).All(setContextOp(ctx, tilq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{taskidentitylink.Label}
+	default:
+		return nil, &NotSingularError{taskidentitylink.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tilq *TaskIdentityLinkQuery) OnlyX(ctx context.Context) *TaskIdentityLink {
+	node, err := tilq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TaskIdentityLink ID in the query.
+// Returns a *NotSingularError when more than one TaskIdentityLink ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tilq *TaskIdentityLinkQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = tilq.Limit(2).IDs(setContextOp(ctx, tilq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{taskidentitylink.Label}
+	default:
+		err = &NotSingularError{taskidentitylink.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tilq *TaskIdentityLinkQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := tilq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TaskIdentityLinks.
+func (tilq *TaskIdentityLinkQuery) All(ctx context.Context) ([]*TaskIdentityLink, error) {
+	ctx = setContextOp(ctx, tilq.ctx, ent.OpQueryAll)
+	if err := tilq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TaskIdentityLink, *TaskIdentityLinkQuery]()
+	return withInterceptors[[]*TaskIdentityLink](ctx, tilq, qr, tilq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tilq *TaskIdentityLinkQuery) AllX(ctx context.Context) []*TaskIdentityLink {
+	nodes, err := tilq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TaskIdentityLink IDs.
+func (tilq *TaskIdentityLinkQuery) IDs(ctx context.Context) (ids []int64, err error) {
+	if tilq.ctx.Unique == nil && tilq.path != nil {
+		tilq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tilq.ctx, ent.OpQueryIDs)
+	if err = tilq.Select(taskidentitylink.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tilq *TaskIdentityLinkQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := tilq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tilq *TaskIdentityLinkQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tilq.ctx, ent.OpQueryCount)
+	if err := tilq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tilq, querierCount[*TaskIdentityLinkQuery](), tilq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tilq *TaskIdentityLinkQuery) CountX(ctx context.Context) int {
+	count, err := tilq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tilq *TaskIdentityLinkQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tilq.ctx, ent.OpQueryExist)
+	switch _, err := tilq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tilq *TaskIdentityLinkQuery) ExistX(ctx context.Context) bool {
+	exist, err := tilq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TaskIdentityLinkQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tilq *TaskIdentityLinkQuery) Clone() *TaskIdentityLinkQuery {
+	if tilq == nil {
+		return nil
+	}
+	return &TaskIdentityLinkQuery{
+		config:     tilq.config,
+		ctx:        tilq.ctx.Clone(),
+		order:      append([]taskidentitylink.OrderOption{}, tilq.order...),
+		inters:     append([]Interceptor{}, tilq.inters...),
+		predicates: append([]predicate.TaskIdentityLink{}, tilq.predicates...),
+		// clone intermediate query.
+		sql:  tilq.sql.Clone(),
+		path: tilq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID int64 `json:"task_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TaskIdentityLink.Query().
+//		GroupBy(taskidentitylink.FieldTaskID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tilq *TaskIdentityLinkQuery) GroupBy(field string, fields ...string) *TaskIdentityLinkGroupBy {
+	tilq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TaskIdentityLinkGroupBy{build: tilq}
+	grbuild.flds = &tilq.ctx.Fields
+	grbuild.label = taskidentitylink.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID int64 `json:"task_id,omitempty"`
+//	}
+//
+//	client.TaskIdentityLink.Query().
+//		Select(taskidentitylink.FieldTaskID).
+//		Scan(ctx, &v)
+func (tilq *TaskIdentityLinkQuery) Select(fields ...string) *TaskIdentityLinkSelect {
+	tilq.ctx.Fields = append(tilq.ctx.Fields, fields...)
+	sbuild := &TaskIdentityLinkSelect{TaskIdentityLinkQuery: tilq}
+	sbuild.label = taskidentitylink.Label
+	sbuild.flds, sbuild.scan = &tilq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TaskIdentityLinkSelect configured with the given aggregations.
+func (tilq *TaskIdentityLinkQuery) Aggregate(fns ...AggregateFunc) *TaskIdentityLinkSelect {
+	return tilq.Select().Aggregate(fns...)
+}
+
+func (tilq *TaskIdentityLinkQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tilq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tilq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tilq.ctx.Fields {
+		if !taskidentitylink.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tilq.path != nil {
+		prev, err := tilq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tilq.sql = prev
+	}
+	return nil
+}
+
+func (tilq *TaskIdentityLinkQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TaskIdentityLink, error) {
+	var (
+		nodes = []*TaskIdentityLink{}
+		_spec = tilq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TaskIdentityLink).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TaskIdentityLink{config: tilq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tilq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (tilq *TaskIdentityLinkQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tilq.querySpec()
+	_spec.Node.Columns = tilq.ctx.Fields
+	if len(tilq.ctx.Fields) > 0 {
+		_spec.Unique = tilq.ctx.Unique != nil && *tilq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tilq.driver, _spec)
+}
+
+func (tilq *TaskIdentityLinkQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(taskidentitylink.Table, taskidentitylink.Columns, sqlgraph.NewFieldSpec(taskidentitylink.FieldID, field.TypeInt64))
+	_spec.From = tilq.sql
+	if unique := tilq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tilq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tilq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskidentitylink.FieldID)
+		for i := range fields {
+			if fields[i] != taskidentitylink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := tilq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tilq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tilq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tilq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tilq *TaskIdentityLinkQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tilq.driver.Dialect())
+	t1 := builder.Table(taskidentitylink.Table)
+	columns := tilq.ctx.Fields
+	if len(columns) == 0 {
+		columns = taskidentitylink.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tilq.sql != nil {
+		selector = tilq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tilq.ctx.Unique != nil && *tilq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range tilq.predicates {
+		p(selector)
+	}
+	for _, p := range tilq.order {
+		p(selector)
+	}
+	if offset := tilq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tilq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TaskIdentityLinkGroupBy is the group-by builder for TaskIdentityLink entities.
+type TaskIdentityLinkGroupBy struct {
+	selector
+	build *TaskIdentityLinkQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tilgb *TaskIdentityLinkGroupBy) Aggregate(fns ...AggregateFunc) *TaskIdentityLinkGroupBy {
+	tilgb.fns = append(tilgb.fns, fns...)
+	return tilgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tilgb *TaskIdentityLinkGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tilgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tilgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskIdentityLinkQuery, *TaskIdentityLinkGroupBy](ctx, tilgb.build, tilgb, tilgb.build.inters, v)
+}
+
+func (tilgb *TaskIdentityLinkGroupBy) sqlScan(ctx context.Context, root *TaskIdentityLinkQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tilgb.fns))
+	for _, fn := range tilgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tilgb.flds)+len(tilgb.fns))
+		for _, f := range *tilgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tilgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tilgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TaskIdentityLinkSelect is the builder for selecting fields of TaskIdentityLink entities.
+type TaskIdentityLinkSelect struct {
+	*TaskIdentityLinkQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tils *TaskIdentityLinkSelect) Aggregate(fns ...AggregateFunc) *TaskIdentityLinkSelect {
+	tils.fns = append(tils.fns, fns...)
+	return tils
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tils *TaskIdentityLinkSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tils.ctx, ent.OpQuerySelect)
+	if err := tils.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaskIdentityLinkQuery, *TaskIdentityLinkSelect](ctx, tils.TaskIdentityLinkQuery, tils, tils.inters, v)
+}
+
+func (tils *TaskIdentityLinkSelect) sqlScan(ctx context.Context, root *TaskIdentityLinkQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tils.fns))
+	for _, fn := range tils.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tils.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tils.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,485 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/predicate"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskidentitylink"
+)
+
+// TaskIdentityLinkUpdate is the builder for updating TaskIdentityLink entities.
+type TaskIdentityLinkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaskIdentityLinkMutation
+}
+
+// Where appends a list predicates to the TaskIdentityLinkUpdate builder.
+func (tilu *TaskIdentityLinkUpdate) Where(ps ...predicate.TaskIdentityLink) *TaskIdentityLinkUpdate {
+	tilu.mutation.Where(ps...)
+	return tilu
+}
+
+// SetTaskID sets the "task_id" field.
+func (tilu *TaskIdentityLinkUpdate) SetTaskID(i int64) *TaskIdentityLinkUpdate {
+	tilu.mutation.ResetTaskID()
+	tilu.mutation.SetTaskID(i)
+	return tilu
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (tilu *TaskIdentityLinkUpdate) SetNillableTaskID(i *int64) *TaskIdentityLinkUpdate {
+	if i != nil {
+		tilu.SetTaskID(*i)
+	}
+	return tilu
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (tilu *TaskIdentityLinkUpdate) AddTaskID(i int64) *TaskIdentityLinkUpdate {
+	tilu.mutation.AddTaskID(i)
+	return tilu
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (tilu *TaskIdentityLinkUpdate) SetProcessInstanceID(i int64) *TaskIdentityLinkUpdate {
+	tilu.mutation.ResetProcessInstanceID()
+	tilu.mutation.SetProcessInstanceID(i)
+	return tilu
+}
+
+// SetNillableProcessInstanceID sets the "process_instance_id" field if the given value is not nil.
+func (tilu *TaskIdentityLinkUpdate) SetNillableProcessInstanceID(i *int64) *TaskIdentityLinkUpdate {
+	if i != nil {
+		tilu.SetProcessInstanceID(*i)
+	}
+	return tilu
+}
+
+// AddProcessInstanceID adds i to the "process_instance_id" field.
+func (tilu *TaskIdentityLinkUpdate) AddProcessInstanceID(i int64) *TaskIdentityLinkUpdate {
+	tilu.mutation.AddProcessInstanceID(i)
+	return tilu
+}
+
+// ClearProcessInstanceID clears the value of the "process_instance_id" field.
+func (tilu *TaskIdentityLinkUpdate) ClearProcessInstanceID() *TaskIdentityLinkUpdate {
+	tilu.mutation.ClearProcessInstanceID()
+	return tilu
+}
+
+// SetType sets the "type" field.
+func (tilu *TaskIdentityLinkUpdate) SetType(s string) *TaskIdentityLinkUpdate {
+	tilu.mutation.SetType(s)
+	return tilu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (tilu *TaskIdentityLinkUpdate) SetNillableType(s *string) *TaskIdentityLinkUpdate {
+	if s != nil {
+		tilu.SetType(*s)
+	}
+	return tilu
+}
+
+// SetUserID sets the "user_id" field.
+func (tilu *TaskIdentityLinkUpdate) SetUserID(s string) *TaskIdentityLinkUpdate {
+	tilu.mutation.SetUserID(s)
+	return tilu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tilu *TaskIdentityLinkUpdate) SetNillableUserID(s *string) *TaskIdentityLinkUpdate {
+	if s != nil {
+		tilu.SetUserID(*s)
+	}
+	return tilu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (tilu *TaskIdentityLinkUpdate) ClearUserID() *TaskIdentityLinkUpdate {
+	tilu.mutation.ClearUserID()
+	return tilu
+}
+
+// SetGroupID sets the "group_id" field.
+func (tilu *TaskIdentityLinkUpdate) SetGroupID(s string) *TaskIdentityLinkUpdate {
+	tilu.mutation.SetGroupID(s)
+	return tilu
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (tilu *TaskIdentityLinkUpdate) SetNillableGroupID(s *string) *TaskIdentityLinkUpdate {
+	if s != nil {
+		tilu.SetGroupID(*s)
+	}
+	return tilu
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (tilu *TaskIdentityLinkUpdate) ClearGroupID() *TaskIdentityLinkUpdate {
+	tilu.mutation.ClearGroupID()
+	return tilu
+}
+
+// Mutation returns the TaskIdentityLinkMutation object of the builder.
+func (tilu *TaskIdentityLinkUpdate) Mutation() *TaskIdentityLinkMutation {
+	return tilu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tilu *TaskIdentityLinkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tilu.sqlSave, tilu.mutation, tilu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tilu *TaskIdentityLinkUpdate) SaveX(ctx context.Context) int {
+	affected, err := tilu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tilu *TaskIdentityLinkUpdate) Exec(ctx context.Context) error {
+	_, err := tilu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tilu *TaskIdentityLinkUpdate) ExecX(ctx context.Context) {
+	if err := tilu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tilu *TaskIdentityLinkUpdate) check() error {
+	if v, ok := tilu.mutation.GetType(); ok {
+		if err := taskidentitylink.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TaskIdentityLink.type": %w`, err)}
+		}
+	}
+	if v, ok := tilu.mutation.UserID(); ok {
+		if err := taskidentitylink.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "TaskIdentityLink.user_id": %w`, err)}
+		}
+	}
+	if v, ok := tilu.mutation.GroupID(); ok {
+		if err := taskidentitylink.GroupIDValidator(v); err != nil {
+			return &ValidationError{Name: "group_id", err: fmt.Errorf(`ent: validator failed for field "TaskIdentityLink.group_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tilu *TaskIdentityLinkUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tilu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskidentitylink.Table, taskidentitylink.Columns, sqlgraph.NewFieldSpec(taskidentitylink.FieldID, field.TypeInt64))
+	if ps := tilu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tilu.mutation.TaskID(); ok {
+		_spec.SetField(taskidentitylink.FieldTaskID, field.TypeInt64, value)
+	}
+	if value, ok := tilu.mutation.AddedTaskID(); ok {
+		_spec.AddField(taskidentitylink.FieldTaskID, field.TypeInt64, value)
+	}
+	if value, ok := tilu.mutation.ProcessInstanceID(); ok {
+		_spec.SetField(taskidentitylink.FieldProcessInstanceID, field.TypeInt64, value)
+	}
+	if value, ok := tilu.mutation.AddedProcessInstanceID(); ok {
+		_spec.AddField(taskidentitylink.FieldProcessInstanceID, field.TypeInt64, value)
+	}
+	if tilu.mutation.ProcessInstanceIDCleared() {
+		_spec.ClearField(taskidentitylink.FieldProcessInstanceID, field.TypeInt64)
+	}
+	if value, ok := tilu.mutation.GetType(); ok {
+		_spec.SetField(taskidentitylink.FieldType, field.TypeString, value)
+	}
+	if value, ok := tilu.mutation.UserID(); ok {
+		_spec.SetField(taskidentitylink.FieldUserID, field.TypeString, value)
+	}
+	if tilu.mutation.UserIDCleared() {
+		_spec.ClearField(taskidentitylink.FieldUserID, field.TypeString)
+	}
+	if value, ok := tilu.mutation.GroupID(); ok {
+		_spec.SetField(taskidentitylink.FieldGroupID, field.TypeString, value)
+	}
+	if tilu.mutation.GroupIDCleared() {
+		_spec.ClearField(taskidentitylink.FieldGroupID, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tilu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskidentitylink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tilu.mutation.done = true
+	return n, nil
+}
+
+// TaskIdentityLinkUpdateOne is the builder for updating a single TaskIdentityLink entity.
+type TaskIdentityLinkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaskIdentityLinkMutation
+}
+
+// SetTaskID sets the "task_id" field.
+func (tiluo *TaskIdentityLinkUpdateOne) SetTaskID(i int64) *TaskIdentityLinkUpdateOne {
+	tiluo.mutation.ResetTaskID()
+	tiluo.mutation.SetTaskID(i)
+	return tiluo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (tiluo *TaskIdentityLinkUpdateOne) SetNillableTaskID(i *int64) *TaskIdentityLinkUpdateOne {
+	if i != nil {
+		tiluo.SetTaskID(*i)
+	}
+	return tiluo
+}
+
+// AddTaskID adds i to the "task_id" field.
+func (tiluo *TaskIdentityLinkUpdateOne) AddTaskID(i int64) *TaskIdentityLinkUpdateOne {
+	tiluo.mutation.AddTaskID(i)
+	return tiluo
+}
+
+// SetProcessInstanceID sets the "process_instance_id" field.
+func (tiluo *TaskIdentityLinkUpdateOne) SetProcessInstanceID(i int64) *TaskIdentityLinkUpdateOne {
+	tiluo.mutation.ResetProcessInstanceID()
+	tiluo.mutation.SetProcessInstanceID(i)
+	return tiluo
+}
+
+// SetNillableProcessInstanceID sets the "process_instance_id" field if the given value is not nil.
+func (tiluo *TaskIdentityLinkUpdateOne) SetNillableProcessInstanceID(i *int64) *TaskIdentityLinkUpdateOne {
+	if i != nil {
+		tiluo.SetProcessInstanceID(*i)
+	}
+	return tiluo
+}
+
+// AddProcessInstanceID adds i to the "process_instance_id" field.
+func (tiluo *TaskIdentityLinkUpdateOne) AddProcessInstanceID(i int64) *TaskIdentityLinkUpdateOne {
+	tiluo.mutation.AddProcessInstanceID(i)
+	return tiluo
+}
+
+// ClearProcessInstanceID clears the value of the "process_instance_id" field.
+func (tiluo *TaskIdentityLinkUpdateOne) ClearProcessInstanceID() *TaskIdentityLinkUpdateOne {
+	tiluo.mutation.ClearProcessInstanceID()
+	return tiluo
+}
+
+// SetType sets the "type" field.
+func (tiluo *TaskIdentityLinkUpdateOne) SetType(s string) *TaskIdentityLinkUpdateOne {
+	tiluo.mutation.SetType(s)
+	return tiluo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (tiluo *TaskIdentityLinkUpdateOne) SetNillableType(s *string) *TaskIdentityLinkUpdateOne {
+	if s != nil {
+		tiluo.SetType(*s)
+	}
+	return tiluo
+}
+
+// SetUserID sets the "user_id" field.
+func (tiluo *TaskIdentityLinkUpdateOne) SetUserID(s string) *TaskIdentityLinkUpdateOne {
+	tiluo.mutation.SetUserID(s)
+	return tiluo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tiluo *TaskIdentityLinkUpdateOne) SetNillableUserID(s *string) *TaskIdentityLinkUpdateOne {
+	if s != nil {
+		tiluo.SetUserID(*s)
+	}
+	return tiluo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (tiluo *TaskIdentityLinkUpdateOne) ClearUserID() *TaskIdentityLinkUpdateOne {
+	tiluo.mutation.ClearUserID()
+	return tiluo
+}
+
+// SetGroupID sets the "group_id" field.
+func (tiluo *TaskIdentityLinkUpdateOne) SetGroupID(s string) *TaskIdentityLinkUpdateOne {
+	tiluo.mutation.SetGroupID(s)
+	return tiluo
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (tiluo *TaskIdentityLinkUpdateOne) SetNillableGroupID(s *string) *TaskIdentityLinkUpdateOne {
+	if s != nil {
+		tiluo.SetGroupID(*s)
+	}
+	return tiluo
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (tiluo *TaskIdentityLinkUpdateOne) ClearGroupID() *TaskIdentityLinkUpdateOne {
+	tiluo.mutation.ClearGroupID()
+	return tiluo
+}
+
+// Mutation returns the TaskIdentityLinkMutation object of the builder.
+func (tiluo *TaskIdentityLinkUpdateOne) Mutation() *TaskIdentityLinkMutation {
+	return tiluo.mutation
+}
+
+// Where appends a list predicates to the TaskIdentityLinkUpdate builder.
+func (tiluo *TaskIdentityLinkUpdateOne) Where(ps ...predicate.TaskIdentityLink) *TaskIdentityLinkUpdateOne {
+	tiluo.mutation.Where(ps...)
+	return tiluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tiluo *TaskIdentityLinkUpdateOne) Select(field string, fields ...string) *TaskIdentityLinkUpdateOne {
+	tiluo.fields = append([]string{field}, fields...)
+	return tiluo
+}
+
+// Save executes the query and returns the updated TaskIdentityLink entity.
+func (tiluo *TaskIdentityLinkUpdateOne) Save(ctx context.Context) (*TaskIdentityLink, error) {
+	return withHooks(ctx, tiluo.sqlSave, tiluo.mutation, tiluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tiluo *TaskIdentityLinkUpdateOne) SaveX(ctx context.Context) *TaskIdentityLink {
+	node, err := tiluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tiluo *TaskIdentityLinkUpdateOne) Exec(ctx context.Context) error {
+	_, err := tiluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tiluo *TaskIdentityLinkUpdateOne) ExecX(ctx context.Context) {
+	if err := tiluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tiluo *TaskIdentityLinkUpdateOne) check() error {
+	if v, ok := tiluo.mutation.GetType(); ok {
+		if err := taskidentitylink.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "TaskIdentityLink.type": %w`, err)}
+		}
+	}
+	if v, ok := tiluo.mutation.UserID(); ok {
+		if err := taskidentitylink.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "TaskIdentityLink.user_id": %w`, err)}
+		}
+	}
+	if v, ok := tiluo.mutation.GroupID(); ok {
+		if err := taskidentitylink.GroupIDValidator(v); err != nil {
+			return &ValidationError{Name: "group_id", err: fmt.Errorf(`ent: validator failed for field "TaskIdentityLink.group_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tiluo *TaskIdentityLinkUpdateOne) sqlSave(ctx context.Context) (_node *TaskIdentityLink, err error) {
+	if err := tiluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskidentitylink.Table, taskidentitylink.Columns, sqlgraph.NewFieldSpec(taskidentitylink.FieldID, field.TypeInt64))
+	id, ok := tiluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskIdentityLink.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tiluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskidentitylink.FieldID)
+		for _, f := range fields {
+			if !taskidentitylink.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskidentitylink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tiluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tiluo.mutation.TaskID(); ok {
+		_spec.SetField(taskidentitylink.FieldTaskID, field.TypeInt64, value)
+	}
+	if value, ok := tiluo.mutation.AddedTaskID(); ok {
+		_spec.AddField(taskidentitylink.FieldTaskID, field.TypeInt64, value)
+	}
+	if value, ok := tiluo.mutation.ProcessInstanceID(); ok {
+		_spec.SetField(taskidentitylink.FieldProcessInstanceID, field.TypeInt64, value)
+	}
+	if value, ok := tiluo.mutation.AddedProcessInstanceID(); ok {
+		_spec.AddField(taskidentitylink.FieldProcessInstanceID, field.TypeInt64, value)
+	}
+	if tiluo.mutation.ProcessInstanceIDCleared() {
+		_spec.ClearField(taskidentitylink.FieldProcessInstanceID, field.TypeInt64)
+	}
+	if value, ok := tiluo.mutation.GetType(); ok {
+		_spec.SetField(taskidentitylink.FieldType, field.TypeString, value)
+	}
+	if value, ok := tiluo.mutation.UserID(); ok {
+		_spec.SetField(taskidentitylink.FieldUserID, field.TypeString, value)
+	}
+	if tiluo.mutation.UserIDCleared() {
+		_spec.ClearField(taskidentitylink.FieldUserID, field.TypeString)
+	}
+	if value, ok := tiluo.mutation.GroupID(); ok {
+		_spec.SetField(taskidentitylink.FieldGroupID, field.TypeString, value)
+	}
+	if tiluo.mutation.GroupIDCleared() {
+		_spec.ClearField(taskidentitylink.FieldGroupID, field.TypeString)
+	}
+	_node = &TaskIdentityLink{config: tiluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tiluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskidentitylink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tiluo.mutation.done = true
+	return _node, nil
+}
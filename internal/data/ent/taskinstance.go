@@ -30,10 +30,20 @@ type TaskInstance struct {
 	Owner string `json:"owner,omitempty"`
 	// 委派状态: PENDING, RESOLVED
 	Delegation string `json:"delegation,omitempty"`
+	// 任务状态: created, claimed, delegated, resolved, completed, cancelled
+	Status string `json:"status,omitempty"`
 	// 任务优先级
 	Priority int32 `json:"priority,omitempty"`
 	// 创建时间
 	CreateTime time.Time `json:"create_time,omitempty"`
+	// 认领时间
+	ClaimTime *time.Time `json:"claim_time,omitempty"`
+	// 结束时间
+	EndTime *time.Time `json:"end_time,omitempty"`
+	// 持续时间(毫秒)
+	Duration int64 `json:"duration,omitempty"`
+	// 取消原因
+	DeleteReason string `json:"delete_reason,omitempty"`
 	// 到期时间
 	DueDate *time.Time `json:"due_date,omitempty"`
 	// 跟进时间
@@ -44,6 +54,8 @@ type TaskInstance struct {
 	Category string `json:"category,omitempty"`
 	// 父任务ID
 	ParentTaskID string `json:"parent_task_id,omitempty"`
+	// 子任务全部结束前不能完成任务
+	WaitForSubtasks bool `json:"wait_for_subtasks,omitempty"`
 	// 执行ID
 	ExecutionID string `json:"execution_id,omitempty"`
 	// 流程实例ID
@@ -74,13 +86,13 @@ func (*TaskInstance) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case taskinstance.FieldSuspended:
+		case taskinstance.FieldWaitForSubtasks, taskinstance.FieldSuspended:
 			values[i] = new(sql.NullBool)
-		case taskinstance.FieldID, taskinstance.FieldPriority, taskinstance.FieldProcessInstanceID, taskinstance.FieldProcessDefinitionID:
+		case taskinstance.FieldID, taskinstance.FieldPriority, taskinstance.FieldDuration, taskinstance.FieldProcessInstanceID, taskinstance.FieldProcessDefinitionID:
 			values[i] = new(sql.NullInt64)
-		case taskinstance.FieldName, taskinstance.FieldDescription, taskinstance.FieldTaskDefinitionKey, taskinstance.FieldAssignee, taskinstance.FieldOwner, taskinstance.FieldDelegation, taskinstance.FieldFormKey, taskinstance.FieldCategory, taskinstance.FieldParentTaskID, taskinstance.FieldExecutionID, taskinstance.FieldProcessDefinitionKey, taskinstance.FieldCaseExecutionID, taskinstance.FieldCaseInstanceID, taskinstance.FieldCaseDefinitionID, taskinstance.FieldTenantID:
+		case taskinstance.FieldName, taskinstance.FieldDescription, taskinstance.FieldTaskDefinitionKey, taskinstance.FieldAssignee, taskinstance.FieldOwner, taskinstance.FieldDelegation, taskinstance.FieldStatus, taskinstance.FieldDeleteReason, taskinstance.FieldFormKey, taskinstance.FieldCategory, taskinstance.FieldParentTaskID, taskinstance.FieldExecutionID, taskinstance.FieldProcessDefinitionKey, taskinstance.FieldCaseExecutionID, taskinstance.FieldCaseInstanceID, taskinstance.FieldCaseDefinitionID, taskinstance.FieldTenantID:
 			values[i] = new(sql.NullString)
-		case taskinstance.FieldCreateTime, taskinstance.FieldDueDate, taskinstance.FieldFollowUpDate, taskinstance.FieldCreatedAt, taskinstance.FieldUpdatedAt:
+		case taskinstance.FieldCreateTime, taskinstance.FieldClaimTime, taskinstance.FieldEndTime, taskinstance.FieldDueDate, taskinstance.FieldFollowUpDate, taskinstance.FieldCreatedAt, taskinstance.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -139,6 +151,12 @@ func (ti *TaskInstance) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ti.Delegation = value.String
 			}
+		case taskinstance.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ti.Status = value.String
+			}
 		case taskinstance.FieldPriority:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field priority", values[i])
@@ -151,6 +169,32 @@ func (ti *TaskInstance) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ti.CreateTime = value.Time
 			}
+		case taskinstance.FieldClaimTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field claim_time", values[i])
+			} else if value.Valid {
+				ti.ClaimTime = new(time.Time)
+				*ti.ClaimTime = value.Time
+			}
+		case taskinstance.FieldEndTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_time", values[i])
+			} else if value.Valid {
+				ti.EndTime = new(time.Time)
+				*ti.EndTime = value.Time
+			}
+		case taskinstance.FieldDuration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration", values[i])
+			} else if value.Valid {
+				ti.Duration = value.Int64
+			}
+		case taskinstance.FieldDeleteReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_reason", values[i])
+			} else if value.Valid {
+				ti.DeleteReason = value.String
+			}
 		case taskinstance.FieldDueDate:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field due_date", values[i])
@@ -183,6 +227,12 @@ func (ti *TaskInstance) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ti.ParentTaskID = value.String
 			}
+		case taskinstance.FieldWaitForSubtasks:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field wait_for_subtasks", values[i])
+			} else if value.Valid {
+				ti.WaitForSubtasks = value.Bool
+			}
 		case taskinstance.FieldExecutionID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field execution_id", values[i])
@@ -303,12 +353,31 @@ func (ti *TaskInstance) String() string {
 	builder.WriteString("delegation=")
 	builder.WriteString(ti.Delegation)
 	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(ti.Status)
+	builder.WriteString(", ")
 	builder.WriteString("priority=")
 	builder.WriteString(fmt.Sprintf("%v", ti.Priority))
 	builder.WriteString(", ")
 	builder.WriteString("create_time=")
 	builder.WriteString(ti.CreateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := ti.ClaimTime; v != nil {
+		builder.WriteString("claim_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ti.EndTime; v != nil {
+		builder.WriteString("end_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("duration=")
+	builder.WriteString(fmt.Sprintf("%v", ti.Duration))
+	builder.WriteString(", ")
+	builder.WriteString("delete_reason=")
+	builder.WriteString(ti.DeleteReason)
+	builder.WriteString(", ")
 	if v := ti.DueDate; v != nil {
 		builder.WriteString("due_date=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -328,6 +397,9 @@ func (ti *TaskInstance) String() string {
 	builder.WriteString("parent_task_id=")
 	builder.WriteString(ti.ParentTaskID)
 	builder.WriteString(", ")
+	builder.WriteString("wait_for_subtasks=")
+	builder.WriteString(fmt.Sprintf("%v", ti.WaitForSubtasks))
+	builder.WriteString(", ")
 	builder.WriteString("execution_id=")
 	builder.WriteString(ti.ExecutionID)
 	builder.WriteString(", ")
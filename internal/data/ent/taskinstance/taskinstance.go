@@ -25,10 +25,20 @@ const (
 	FieldOwner = "owner"
 	// FieldDelegation holds the string denoting the delegation field in the database.
 	FieldDelegation = "delegation"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
 	// FieldPriority holds the string denoting the priority field in the database.
 	FieldPriority = "priority"
 	// FieldCreateTime holds the string denoting the create_time field in the database.
 	FieldCreateTime = "create_time"
+	// FieldClaimTime holds the string denoting the claim_time field in the database.
+	FieldClaimTime = "claim_time"
+	// FieldEndTime holds the string denoting the end_time field in the database.
+	FieldEndTime = "end_time"
+	// FieldDuration holds the string denoting the duration field in the database.
+	FieldDuration = "duration"
+	// FieldDeleteReason holds the string denoting the delete_reason field in the database.
+	FieldDeleteReason = "delete_reason"
 	// FieldDueDate holds the string denoting the due_date field in the database.
 	FieldDueDate = "due_date"
 	// FieldFollowUpDate holds the string denoting the follow_up_date field in the database.
@@ -39,6 +49,8 @@ const (
 	FieldCategory = "category"
 	// FieldParentTaskID holds the string denoting the parent_task_id field in the database.
 	FieldParentTaskID = "parent_task_id"
+	// FieldWaitForSubtasks holds the string denoting the wait_for_subtasks field in the database.
+	FieldWaitForSubtasks = "wait_for_subtasks"
 	// FieldExecutionID holds the string denoting the execution_id field in the database.
 	FieldExecutionID = "execution_id"
 	// FieldProcessInstanceID holds the string denoting the process_instance_id field in the database.
@@ -74,13 +86,19 @@ var Columns = []string{
 	FieldAssignee,
 	FieldOwner,
 	FieldDelegation,
+	FieldStatus,
 	FieldPriority,
 	FieldCreateTime,
+	FieldClaimTime,
+	FieldEndTime,
+	FieldDuration,
+	FieldDeleteReason,
 	FieldDueDate,
 	FieldFollowUpDate,
 	FieldFormKey,
 	FieldCategory,
 	FieldParentTaskID,
+	FieldWaitForSubtasks,
 	FieldExecutionID,
 	FieldProcessInstanceID,
 	FieldProcessDefinitionID,
@@ -115,16 +133,24 @@ var (
 	OwnerValidator func(string) error
 	// DelegationValidator is a validator for the "delegation" field. It is called by the builders before save.
 	DelegationValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
 	// DefaultPriority holds the default value on creation for the "priority" field.
 	DefaultPriority int32
 	// DefaultCreateTime holds the default value on creation for the "create_time" field.
 	DefaultCreateTime func() time.Time
+	// DeleteReasonValidator is a validator for the "delete_reason" field. It is called by the builders before save.
+	DeleteReasonValidator func(string) error
 	// FormKeyValidator is a validator for the "form_key" field. It is called by the builders before save.
 	FormKeyValidator func(string) error
 	// CategoryValidator is a validator for the "category" field. It is called by the builders before save.
 	CategoryValidator func(string) error
 	// ParentTaskIDValidator is a validator for the "parent_task_id" field. It is called by the builders before save.
 	ParentTaskIDValidator func(string) error
+	// DefaultWaitForSubtasks holds the default value on creation for the "wait_for_subtasks" field.
+	DefaultWaitForSubtasks bool
 	// ExecutionIDValidator is a validator for the "execution_id" field. It is called by the builders before save.
 	ExecutionIDValidator func(string) error
 	// ProcessDefinitionKeyValidator is a validator for the "process_definition_key" field. It is called by the builders before save.
@@ -187,6 +213,11 @@ func ByDelegation(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDelegation, opts...).ToFunc()
 }
 
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
 // ByPriority orders the results by the priority field.
 func ByPriority(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPriority, opts...).ToFunc()
@@ -197,6 +228,26 @@ func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
 }
 
+// ByClaimTime orders the results by the claim_time field.
+func ByClaimTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClaimTime, opts...).ToFunc()
+}
+
+// ByEndTime orders the results by the end_time field.
+func ByEndTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndTime, opts...).ToFunc()
+}
+
+// ByDuration orders the results by the duration field.
+func ByDuration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDuration, opts...).ToFunc()
+}
+
+// ByDeleteReason orders the results by the delete_reason field.
+func ByDeleteReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteReason, opts...).ToFunc()
+}
+
 // ByDueDate orders the results by the due_date field.
 func ByDueDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDueDate, opts...).ToFunc()
@@ -222,6 +273,11 @@ func ByParentTaskID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldParentTaskID, opts...).ToFunc()
 }
 
+// ByWaitForSubtasks orders the results by the wait_for_subtasks field.
+func ByWaitForSubtasks(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWaitForSubtasks, opts...).ToFunc()
+}
+
 // ByExecutionID orders the results by the execution_id field.
 func ByExecutionID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExecutionID, opts...).ToFunc()
@@ -84,6 +84,11 @@ func Delegation(v string) predicate.TaskInstance {
 	return predicate.TaskInstance(sql.FieldEQ(FieldDelegation, v))
 }
 
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldEQ(FieldStatus, v))
+}
+
 // Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
 func Priority(v int32) predicate.TaskInstance {
 	return predicate.TaskInstance(sql.FieldEQ(FieldPriority, v))
@@ -94,6 +99,26 @@ func CreateTime(v time.Time) predicate.TaskInstance {
 	return predicate.TaskInstance(sql.FieldEQ(FieldCreateTime, v))
 }
 
+// ClaimTime applies equality check predicate on the "claim_time" field. It's identical to ClaimTimeEQ.
+func ClaimTime(v time.Time) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldEQ(FieldClaimTime, v))
+}
+
+// EndTime applies equality check predicate on the "end_time" field. It's identical to EndTimeEQ.
+func EndTime(v time.Time) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldEQ(FieldEndTime, v))
+}
+
+// Duration applies equality check predicate on the "duration" field. It's identical to DurationEQ.
+func Duration(v int64) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldEQ(FieldDuration, v))
+}
+
+// DeleteReason applies equality check predicate on the "delete_reason" field. It's identical to DeleteReasonEQ.
+func DeleteReason(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldEQ(FieldDeleteReason, v))
+}
+
 // DueDate applies equality check predicate on the "due_date" field. It's identical to DueDateEQ.
 func DueDate(v time.Time) predicate.TaskInstance {
 	return predicate.TaskInstance(sql.FieldEQ(FieldDueDate, v))
@@ -119,6 +144,11 @@ func ParentTaskID(v string) predicate.TaskInstance {
 	return predicate.TaskInstance(sql.FieldEQ(FieldParentTaskID, v))
 }
 
+// WaitForSubtasks applies equality check predicate on the "wait_for_subtasks" field. It's identical to WaitForSubtasksEQ.
+func WaitForSubtasks(v bool) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldEQ(FieldWaitForSubtasks, v))
+}
+
 // ExecutionID applies equality check predicate on the "execution_id" field. It's identical to ExecutionIDEQ.
 func ExecutionID(v string) predicate.TaskInstance {
 	return predicate.TaskInstance(sql.FieldEQ(FieldExecutionID, v))
@@ -614,6 +644,71 @@ func DelegationContainsFold(v string) predicate.TaskInstance {
 	return predicate.TaskInstance(sql.FieldContainsFold(FieldDelegation, v))
 }
 
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldContainsFold(FieldStatus, v))
+}
+
 // PriorityEQ applies the EQ predicate on the "priority" field.
 func PriorityEQ(v int32) predicate.TaskInstance {
 	return predicate.TaskInstance(sql.FieldEQ(FieldPriority, v))
@@ -694,6 +789,231 @@ func CreateTimeLTE(v time.Time) predicate.TaskInstance {
 	return predicate.TaskInstance(sql.FieldLTE(FieldCreateTime, v))
 }
 
+// ClaimTimeEQ applies the EQ predicate on the "claim_time" field.
+func ClaimTimeEQ(v time.Time) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldEQ(FieldClaimTime, v))
+}
+
+// ClaimTimeNEQ applies the NEQ predicate on the "claim_time" field.
+func ClaimTimeNEQ(v time.Time) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldNEQ(FieldClaimTime, v))
+}
+
+// ClaimTimeIn applies the In predicate on the "claim_time" field.
+func ClaimTimeIn(vs ...time.Time) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldIn(FieldClaimTime, vs...))
+}
+
+// ClaimTimeNotIn applies the NotIn predicate on the "claim_time" field.
+func ClaimTimeNotIn(vs ...time.Time) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldNotIn(FieldClaimTime, vs...))
+}
+
+// ClaimTimeGT applies the GT predicate on the "claim_time" field.
+func ClaimTimeGT(v time.Time) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldGT(FieldClaimTime, v))
+}
+
+// ClaimTimeGTE applies the GTE predicate on the "claim_time" field.
+func ClaimTimeGTE(v time.Time) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldGTE(FieldClaimTime, v))
+}
+
+// ClaimTimeLT applies the LT predicate on the "claim_time" field.
+func ClaimTimeLT(v time.Time) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldLT(FieldClaimTime, v))
+}
+
+// ClaimTimeLTE applies the LTE predicate on the "claim_time" field.
+func ClaimTimeLTE(v time.Time) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldLTE(FieldClaimTime, v))
+}
+
+// ClaimTimeIsNil applies the IsNil predicate on the "claim_time" field.
+func ClaimTimeIsNil() predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldIsNull(FieldClaimTime))
+}
+
+// ClaimTimeNotNil applies the NotNil predicate on the "claim_time" field.
+func ClaimTimeNotNil() predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldNotNull(FieldClaimTime))
+}
+
+// EndTimeEQ applies the EQ predicate on the "end_time" field.
+func EndTimeEQ(v time.Time) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldEQ(FieldEndTime, v))
+}
+
+// EndTimeNEQ applies the NEQ predicate on the "end_time" field.
+func EndTimeNEQ(v time.Time) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldNEQ(FieldEndTime, v))
+}
+
+// EndTimeIn applies the In predicate on the "end_time" field.
+func EndTimeIn(vs ...time.Time) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldIn(FieldEndTime, vs...))
+}
+
+// EndTimeNotIn applies the NotIn predicate on the "end_time" field.
+func EndTimeNotIn(vs ...time.Time) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldNotIn(FieldEndTime, vs...))
+}
+
+// EndTimeGT applies the GT predicate on the "end_time" field.
+func EndTimeGT(v time.Time) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldGT(FieldEndTime, v))
+}
+
+// EndTimeGTE applies the GTE predicate on the "end_time" field.
+func EndTimeGTE(v time.Time) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldGTE(FieldEndTime, v))
+}
+
+// EndTimeLT applies the LT predicate on the "end_time" field.
+func EndTimeLT(v time.Time) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldLT(FieldEndTime, v))
+}
+
+// EndTimeLTE applies the LTE predicate on the "end_time" field.
+func EndTimeLTE(v time.Time) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldLTE(FieldEndTime, v))
+}
+
+// EndTimeIsNil applies the IsNil predicate on the "end_time" field.
+func EndTimeIsNil() predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldIsNull(FieldEndTime))
+}
+
+// EndTimeNotNil applies the NotNil predicate on the "end_time" field.
+func EndTimeNotNil() predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldNotNull(FieldEndTime))
+}
+
+// DurationEQ applies the EQ predicate on the "duration" field.
+func DurationEQ(v int64) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldEQ(FieldDuration, v))
+}
+
+// DurationNEQ applies the NEQ predicate on the "duration" field.
+func DurationNEQ(v int64) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldNEQ(FieldDuration, v))
+}
+
+// DurationIn applies the In predicate on the "duration" field.
+func DurationIn(vs ...int64) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldIn(FieldDuration, vs...))
+}
+
+// DurationNotIn applies the NotIn predicate on the "duration" field.
+func DurationNotIn(vs ...int64) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldNotIn(FieldDuration, vs...))
+}
+
+// DurationGT applies the GT predicate on the "duration" field.
+func DurationGT(v int64) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldGT(FieldDuration, v))
+}
+
+// DurationGTE applies the GTE predicate on the "duration" field.
+func DurationGTE(v int64) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldGTE(FieldDuration, v))
+}
+
+// DurationLT applies the LT predicate on the "duration" field.
+func DurationLT(v int64) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldLT(FieldDuration, v))
+}
+
+// DurationLTE applies the LTE predicate on the "duration" field.
+func DurationLTE(v int64) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldLTE(FieldDuration, v))
+}
+
+// DurationIsNil applies the IsNil predicate on the "duration" field.
+func DurationIsNil() predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldIsNull(FieldDuration))
+}
+
+// DurationNotNil applies the NotNil predicate on the "duration" field.
+func DurationNotNil() predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldNotNull(FieldDuration))
+}
+
+// DeleteReasonEQ applies the EQ predicate on the "delete_reason" field.
+func DeleteReasonEQ(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldEQ(FieldDeleteReason, v))
+}
+
+// DeleteReasonNEQ applies the NEQ predicate on the "delete_reason" field.
+func DeleteReasonNEQ(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldNEQ(FieldDeleteReason, v))
+}
+
+// DeleteReasonIn applies the In predicate on the "delete_reason" field.
+func DeleteReasonIn(vs ...string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldIn(FieldDeleteReason, vs...))
+}
+
+// DeleteReasonNotIn applies the NotIn predicate on the "delete_reason" field.
+func DeleteReasonNotIn(vs ...string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldNotIn(FieldDeleteReason, vs...))
+}
+
+// DeleteReasonGT applies the GT predicate on the "delete_reason" field.
+func DeleteReasonGT(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldGT(FieldDeleteReason, v))
+}
+
+// DeleteReasonGTE applies the GTE predicate on the "delete_reason" field.
+func DeleteReasonGTE(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldGTE(FieldDeleteReason, v))
+}
+
+// DeleteReasonLT applies the LT predicate on the "delete_reason" field.
+func DeleteReasonLT(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldLT(FieldDeleteReason, v))
+}
+
+// DeleteReasonLTE applies the LTE predicate on the "delete_reason" field.
+func DeleteReasonLTE(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldLTE(FieldDeleteReason, v))
+}
+
+// DeleteReasonContains applies the Contains predicate on the "delete_reason" field.
+func DeleteReasonContains(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldContains(FieldDeleteReason, v))
+}
+
+// DeleteReasonHasPrefix applies the HasPrefix predicate on the "delete_reason" field.
+func DeleteReasonHasPrefix(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldHasPrefix(FieldDeleteReason, v))
+}
+
+// DeleteReasonHasSuffix applies the HasSuffix predicate on the "delete_reason" field.
+func DeleteReasonHasSuffix(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldHasSuffix(FieldDeleteReason, v))
+}
+
+// DeleteReasonIsNil applies the IsNil predicate on the "delete_reason" field.
+func DeleteReasonIsNil() predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldIsNull(FieldDeleteReason))
+}
+
+// DeleteReasonNotNil applies the NotNil predicate on the "delete_reason" field.
+func DeleteReasonNotNil() predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldNotNull(FieldDeleteReason))
+}
+
+// DeleteReasonEqualFold applies the EqualFold predicate on the "delete_reason" field.
+func DeleteReasonEqualFold(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldEqualFold(FieldDeleteReason, v))
+}
+
+// DeleteReasonContainsFold applies the ContainsFold predicate on the "delete_reason" field.
+func DeleteReasonContainsFold(v string) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldContainsFold(FieldDeleteReason, v))
+}
+
 // DueDateEQ applies the EQ predicate on the "due_date" field.
 func DueDateEQ(v time.Time) predicate.TaskInstance {
 	return predicate.TaskInstance(sql.FieldEQ(FieldDueDate, v))
@@ -1019,6 +1339,16 @@ func ParentTaskIDContainsFold(v string) predicate.TaskInstance {
 	return predicate.TaskInstance(sql.FieldContainsFold(FieldParentTaskID, v))
 }
 
+// WaitForSubtasksEQ applies the EQ predicate on the "wait_for_subtasks" field.
+func WaitForSubtasksEQ(v bool) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldEQ(FieldWaitForSubtasks, v))
+}
+
+// WaitForSubtasksNEQ applies the NEQ predicate on the "wait_for_subtasks" field.
+func WaitForSubtasksNEQ(v bool) predicate.TaskInstance {
+	return predicate.TaskInstance(sql.FieldNEQ(FieldWaitForSubtasks, v))
+}
+
 // ExecutionIDEQ applies the EQ predicate on the "execution_id" field.
 func ExecutionIDEQ(v string) predicate.TaskInstance {
 	return predicate.TaskInstance(sql.FieldEQ(FieldExecutionID, v))
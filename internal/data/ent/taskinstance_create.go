@@ -96,6 +96,20 @@ func (tic *TaskInstanceCreate) SetNillableDelegation(s *string) *TaskInstanceCre
 	return tic
 }
 
+// SetStatus sets the "status" field.
+func (tic *TaskInstanceCreate) SetStatus(s string) *TaskInstanceCreate {
+	tic.mutation.SetStatus(s)
+	return tic
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tic *TaskInstanceCreate) SetNillableStatus(s *string) *TaskInstanceCreate {
+	if s != nil {
+		tic.SetStatus(*s)
+	}
+	return tic
+}
+
 // SetPriority sets the "priority" field.
 func (tic *TaskInstanceCreate) SetPriority(i int32) *TaskInstanceCreate {
 	tic.mutation.SetPriority(i)
@@ -124,6 +138,62 @@ func (tic *TaskInstanceCreate) SetNillableCreateTime(t *time.Time) *TaskInstance
 	return tic
 }
 
+// SetClaimTime sets the "claim_time" field.
+func (tic *TaskInstanceCreate) SetClaimTime(t time.Time) *TaskInstanceCreate {
+	tic.mutation.SetClaimTime(t)
+	return tic
+}
+
+// SetNillableClaimTime sets the "claim_time" field if the given value is not nil.
+func (tic *TaskInstanceCreate) SetNillableClaimTime(t *time.Time) *TaskInstanceCreate {
+	if t != nil {
+		tic.SetClaimTime(*t)
+	}
+	return tic
+}
+
+// SetEndTime sets the "end_time" field.
+func (tic *TaskInstanceCreate) SetEndTime(t time.Time) *TaskInstanceCreate {
+	tic.mutation.SetEndTime(t)
+	return tic
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (tic *TaskInstanceCreate) SetNillableEndTime(t *time.Time) *TaskInstanceCreate {
+	if t != nil {
+		tic.SetEndTime(*t)
+	}
+	return tic
+}
+
+// SetDuration sets the "duration" field.
+func (tic *TaskInstanceCreate) SetDuration(i int64) *TaskInstanceCreate {
+	tic.mutation.SetDuration(i)
+	return tic
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (tic *TaskInstanceCreate) SetNillableDuration(i *int64) *TaskInstanceCreate {
+	if i != nil {
+		tic.SetDuration(*i)
+	}
+	return tic
+}
+
+// SetDeleteReason sets the "delete_reason" field.
+func (tic *TaskInstanceCreate) SetDeleteReason(s string) *TaskInstanceCreate {
+	tic.mutation.SetDeleteReason(s)
+	return tic
+}
+
+// SetNillableDeleteReason sets the "delete_reason" field if the given value is not nil.
+func (tic *TaskInstanceCreate) SetNillableDeleteReason(s *string) *TaskInstanceCreate {
+	if s != nil {
+		tic.SetDeleteReason(*s)
+	}
+	return tic
+}
+
 // SetDueDate sets the "due_date" field.
 func (tic *TaskInstanceCreate) SetDueDate(t time.Time) *TaskInstanceCreate {
 	tic.mutation.SetDueDate(t)
@@ -194,6 +264,20 @@ func (tic *TaskInstanceCreate) SetNillableParentTaskID(s *string) *TaskInstanceC
 	return tic
 }
 
+// SetWaitForSubtasks sets the "wait_for_subtasks" field.
+func (tic *TaskInstanceCreate) SetWaitForSubtasks(b bool) *TaskInstanceCreate {
+	tic.mutation.SetWaitForSubtasks(b)
+	return tic
+}
+
+// SetNillableWaitForSubtasks sets the "wait_for_subtasks" field if the given value is not nil.
+func (tic *TaskInstanceCreate) SetNillableWaitForSubtasks(b *bool) *TaskInstanceCreate {
+	if b != nil {
+		tic.SetWaitForSubtasks(*b)
+	}
+	return tic
+}
+
 // SetExecutionID sets the "execution_id" field.
 func (tic *TaskInstanceCreate) SetExecutionID(s string) *TaskInstanceCreate {
 	tic.mutation.SetExecutionID(s)
@@ -365,6 +449,10 @@ func (tic *TaskInstanceCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (tic *TaskInstanceCreate) defaults() {
+	if _, ok := tic.mutation.Status(); !ok {
+		v := taskinstance.DefaultStatus
+		tic.mutation.SetStatus(v)
+	}
 	if _, ok := tic.mutation.Priority(); !ok {
 		v := taskinstance.DefaultPriority
 		tic.mutation.SetPriority(v)
@@ -373,6 +461,10 @@ func (tic *TaskInstanceCreate) defaults() {
 		v := taskinstance.DefaultCreateTime()
 		tic.mutation.SetCreateTime(v)
 	}
+	if _, ok := tic.mutation.WaitForSubtasks(); !ok {
+		v := taskinstance.DefaultWaitForSubtasks
+		tic.mutation.SetWaitForSubtasks(v)
+	}
 	if _, ok := tic.mutation.Suspended(); !ok {
 		v := taskinstance.DefaultSuspended
 		tic.mutation.SetSuspended(v)
@@ -421,12 +513,25 @@ func (tic *TaskInstanceCreate) check() error {
 			return &ValidationError{Name: "delegation", err: fmt.Errorf(`ent: validator failed for field "TaskInstance.delegation": %w`, err)}
 		}
 	}
+	if _, ok := tic.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "TaskInstance.status"`)}
+	}
+	if v, ok := tic.mutation.Status(); ok {
+		if err := taskinstance.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskInstance.status": %w`, err)}
+		}
+	}
 	if _, ok := tic.mutation.Priority(); !ok {
 		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "TaskInstance.priority"`)}
 	}
 	if _, ok := tic.mutation.CreateTime(); !ok {
 		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "TaskInstance.create_time"`)}
 	}
+	if v, ok := tic.mutation.DeleteReason(); ok {
+		if err := taskinstance.DeleteReasonValidator(v); err != nil {
+			return &ValidationError{Name: "delete_reason", err: fmt.Errorf(`ent: validator failed for field "TaskInstance.delete_reason": %w`, err)}
+		}
+	}
 	if v, ok := tic.mutation.FormKey(); ok {
 		if err := taskinstance.FormKeyValidator(v); err != nil {
 			return &ValidationError{Name: "form_key", err: fmt.Errorf(`ent: validator failed for field "TaskInstance.form_key": %w`, err)}
@@ -442,6 +547,9 @@ func (tic *TaskInstanceCreate) check() error {
 			return &ValidationError{Name: "parent_task_id", err: fmt.Errorf(`ent: validator failed for field "TaskInstance.parent_task_id": %w`, err)}
 		}
 	}
+	if _, ok := tic.mutation.WaitForSubtasks(); !ok {
+		return &ValidationError{Name: "wait_for_subtasks", err: errors.New(`ent: missing required field "TaskInstance.wait_for_subtasks"`)}
+	}
 	if v, ok := tic.mutation.ExecutionID(); ok {
 		if err := taskinstance.ExecutionIDValidator(v); err != nil {
 			return &ValidationError{Name: "execution_id", err: fmt.Errorf(`ent: validator failed for field "TaskInstance.execution_id": %w`, err)}
@@ -549,6 +657,10 @@ func (tic *TaskInstanceCreate) createSpec() (*TaskInstance, *sqlgraph.CreateSpec
 		_spec.SetField(taskinstance.FieldDelegation, field.TypeString, value)
 		_node.Delegation = value
 	}
+	if value, ok := tic.mutation.Status(); ok {
+		_spec.SetField(taskinstance.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
 	if value, ok := tic.mutation.Priority(); ok {
 		_spec.SetField(taskinstance.FieldPriority, field.TypeInt32, value)
 		_node.Priority = value
@@ -557,6 +669,22 @@ func (tic *TaskInstanceCreate) createSpec() (*TaskInstance, *sqlgraph.CreateSpec
 		_spec.SetField(taskinstance.FieldCreateTime, field.TypeTime, value)
 		_node.CreateTime = value
 	}
+	if value, ok := tic.mutation.ClaimTime(); ok {
+		_spec.SetField(taskinstance.FieldClaimTime, field.TypeTime, value)
+		_node.ClaimTime = &value
+	}
+	if value, ok := tic.mutation.EndTime(); ok {
+		_spec.SetField(taskinstance.FieldEndTime, field.TypeTime, value)
+		_node.EndTime = &value
+	}
+	if value, ok := tic.mutation.Duration(); ok {
+		_spec.SetField(taskinstance.FieldDuration, field.TypeInt64, value)
+		_node.Duration = value
+	}
+	if value, ok := tic.mutation.DeleteReason(); ok {
+		_spec.SetField(taskinstance.FieldDeleteReason, field.TypeString, value)
+		_node.DeleteReason = value
+	}
 	if value, ok := tic.mutation.DueDate(); ok {
 		_spec.SetField(taskinstance.FieldDueDate, field.TypeTime, value)
 		_node.DueDate = &value
@@ -577,6 +705,10 @@ func (tic *TaskInstanceCreate) createSpec() (*TaskInstance, *sqlgraph.CreateSpec
 		_spec.SetField(taskinstance.FieldParentTaskID, field.TypeString, value)
 		_node.ParentTaskID = value
 	}
+	if value, ok := tic.mutation.WaitForSubtasks(); ok {
+		_spec.SetField(taskinstance.FieldWaitForSubtasks, field.TypeBool, value)
+		_node.WaitForSubtasks = value
+	}
 	if value, ok := tic.mutation.ExecutionID(); ok {
 		_spec.SetField(taskinstance.FieldExecutionID, field.TypeString, value)
 		_node.ExecutionID = value
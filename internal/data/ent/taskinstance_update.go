@@ -142,6 +142,20 @@ func (tiu *TaskInstanceUpdate) ClearDelegation() *TaskInstanceUpdate {
 	return tiu
 }
 
+// SetStatus sets the "status" field.
+func (tiu *TaskInstanceUpdate) SetStatus(s string) *TaskInstanceUpdate {
+	tiu.mutation.SetStatus(s)
+	return tiu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tiu *TaskInstanceUpdate) SetNillableStatus(s *string) *TaskInstanceUpdate {
+	if s != nil {
+		tiu.SetStatus(*s)
+	}
+	return tiu
+}
+
 // SetPriority sets the "priority" field.
 func (tiu *TaskInstanceUpdate) SetPriority(i int32) *TaskInstanceUpdate {
 	tiu.mutation.ResetPriority()
@@ -177,6 +191,93 @@ func (tiu *TaskInstanceUpdate) SetNillableCreateTime(t *time.Time) *TaskInstance
 	return tiu
 }
 
+// SetClaimTime sets the "claim_time" field.
+func (tiu *TaskInstanceUpdate) SetClaimTime(t time.Time) *TaskInstanceUpdate {
+	tiu.mutation.SetClaimTime(t)
+	return tiu
+}
+
+// SetNillableClaimTime sets the "claim_time" field if the given value is not nil.
+func (tiu *TaskInstanceUpdate) SetNillableClaimTime(t *time.Time) *TaskInstanceUpdate {
+	if t != nil {
+		tiu.SetClaimTime(*t)
+	}
+	return tiu
+}
+
+// ClearClaimTime clears the value of the "claim_time" field.
+func (tiu *TaskInstanceUpdate) ClearClaimTime() *TaskInstanceUpdate {
+	tiu.mutation.ClearClaimTime()
+	return tiu
+}
+
+// SetEndTime sets the "end_time" field.
+func (tiu *TaskInstanceUpdate) SetEndTime(t time.Time) *TaskInstanceUpdate {
+	tiu.mutation.SetEndTime(t)
+	return tiu
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (tiu *TaskInstanceUpdate) SetNillableEndTime(t *time.Time) *TaskInstanceUpdate {
+	if t != nil {
+		tiu.SetEndTime(*t)
+	}
+	return tiu
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (tiu *TaskInstanceUpdate) ClearEndTime() *TaskInstanceUpdate {
+	tiu.mutation.ClearEndTime()
+	return tiu
+}
+
+// SetDuration sets the "duration" field.
+func (tiu *TaskInstanceUpdate) SetDuration(i int64) *TaskInstanceUpdate {
+	tiu.mutation.ResetDuration()
+	tiu.mutation.SetDuration(i)
+	return tiu
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (tiu *TaskInstanceUpdate) SetNillableDuration(i *int64) *TaskInstanceUpdate {
+	if i != nil {
+		tiu.SetDuration(*i)
+	}
+	return tiu
+}
+
+// AddDuration adds i to the "duration" field.
+func (tiu *TaskInstanceUpdate) AddDuration(i int64) *TaskInstanceUpdate {
+	tiu.mutation.AddDuration(i)
+	return tiu
+}
+
+// ClearDuration clears the value of the "duration" field.
+func (tiu *TaskInstanceUpdate) ClearDuration() *TaskInstanceUpdate {
+	tiu.mutation.ClearDuration()
+	return tiu
+}
+
+// SetDeleteReason sets the "delete_reason" field.
+func (tiu *TaskInstanceUpdate) SetDeleteReason(s string) *TaskInstanceUpdate {
+	tiu.mutation.SetDeleteReason(s)
+	return tiu
+}
+
+// SetNillableDeleteReason sets the "delete_reason" field if the given value is not nil.
+func (tiu *TaskInstanceUpdate) SetNillableDeleteReason(s *string) *TaskInstanceUpdate {
+	if s != nil {
+		tiu.SetDeleteReason(*s)
+	}
+	return tiu
+}
+
+// ClearDeleteReason clears the value of the "delete_reason" field.
+func (tiu *TaskInstanceUpdate) ClearDeleteReason() *TaskInstanceUpdate {
+	tiu.mutation.ClearDeleteReason()
+	return tiu
+}
+
 // SetDueDate sets the "due_date" field.
 func (tiu *TaskInstanceUpdate) SetDueDate(t time.Time) *TaskInstanceUpdate {
 	tiu.mutation.SetDueDate(t)
@@ -277,6 +378,20 @@ func (tiu *TaskInstanceUpdate) ClearParentTaskID() *TaskInstanceUpdate {
 	return tiu
 }
 
+// SetWaitForSubtasks sets the "wait_for_subtasks" field.
+func (tiu *TaskInstanceUpdate) SetWaitForSubtasks(b bool) *TaskInstanceUpdate {
+	tiu.mutation.SetWaitForSubtasks(b)
+	return tiu
+}
+
+// SetNillableWaitForSubtasks sets the "wait_for_subtasks" field if the given value is not nil.
+func (tiu *TaskInstanceUpdate) SetNillableWaitForSubtasks(b *bool) *TaskInstanceUpdate {
+	if b != nil {
+		tiu.SetWaitForSubtasks(*b)
+	}
+	return tiu
+}
+
 // SetExecutionID sets the "execution_id" field.
 func (tiu *TaskInstanceUpdate) SetExecutionID(s string) *TaskInstanceUpdate {
 	tiu.mutation.SetExecutionID(s)
@@ -515,6 +630,16 @@ func (tiu *TaskInstanceUpdate) check() error {
 			return &ValidationError{Name: "delegation", err: fmt.Errorf(`ent: validator failed for field "TaskInstance.delegation": %w`, err)}
 		}
 	}
+	if v, ok := tiu.mutation.Status(); ok {
+		if err := taskinstance.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskInstance.status": %w`, err)}
+		}
+	}
+	if v, ok := tiu.mutation.DeleteReason(); ok {
+		if err := taskinstance.DeleteReasonValidator(v); err != nil {
+			return &ValidationError{Name: "delete_reason", err: fmt.Errorf(`ent: validator failed for field "TaskInstance.delete_reason": %w`, err)}
+		}
+	}
 	if v, ok := tiu.mutation.FormKey(); ok {
 		if err := taskinstance.FormKeyValidator(v); err != nil {
 			return &ValidationError{Name: "form_key", err: fmt.Errorf(`ent: validator failed for field "TaskInstance.form_key": %w`, err)}
@@ -608,6 +733,9 @@ func (tiu *TaskInstanceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tiu.mutation.DelegationCleared() {
 		_spec.ClearField(taskinstance.FieldDelegation, field.TypeString)
 	}
+	if value, ok := tiu.mutation.Status(); ok {
+		_spec.SetField(taskinstance.FieldStatus, field.TypeString, value)
+	}
 	if value, ok := tiu.mutation.Priority(); ok {
 		_spec.SetField(taskinstance.FieldPriority, field.TypeInt32, value)
 	}
@@ -617,6 +745,33 @@ func (tiu *TaskInstanceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := tiu.mutation.CreateTime(); ok {
 		_spec.SetField(taskinstance.FieldCreateTime, field.TypeTime, value)
 	}
+	if value, ok := tiu.mutation.ClaimTime(); ok {
+		_spec.SetField(taskinstance.FieldClaimTime, field.TypeTime, value)
+	}
+	if tiu.mutation.ClaimTimeCleared() {
+		_spec.ClearField(taskinstance.FieldClaimTime, field.TypeTime)
+	}
+	if value, ok := tiu.mutation.EndTime(); ok {
+		_spec.SetField(taskinstance.FieldEndTime, field.TypeTime, value)
+	}
+	if tiu.mutation.EndTimeCleared() {
+		_spec.ClearField(taskinstance.FieldEndTime, field.TypeTime)
+	}
+	if value, ok := tiu.mutation.Duration(); ok {
+		_spec.SetField(taskinstance.FieldDuration, field.TypeInt64, value)
+	}
+	if value, ok := tiu.mutation.AddedDuration(); ok {
+		_spec.AddField(taskinstance.FieldDuration, field.TypeInt64, value)
+	}
+	if tiu.mutation.DurationCleared() {
+		_spec.ClearField(taskinstance.FieldDuration, field.TypeInt64)
+	}
+	if value, ok := tiu.mutation.DeleteReason(); ok {
+		_spec.SetField(taskinstance.FieldDeleteReason, field.TypeString, value)
+	}
+	if tiu.mutation.DeleteReasonCleared() {
+		_spec.ClearField(taskinstance.FieldDeleteReason, field.TypeString)
+	}
 	if value, ok := tiu.mutation.DueDate(); ok {
 		_spec.SetField(taskinstance.FieldDueDate, field.TypeTime, value)
 	}
@@ -647,6 +802,9 @@ func (tiu *TaskInstanceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tiu.mutation.ParentTaskIDCleared() {
 		_spec.ClearField(taskinstance.FieldParentTaskID, field.TypeString)
 	}
+	if value, ok := tiu.mutation.WaitForSubtasks(); ok {
+		_spec.SetField(taskinstance.FieldWaitForSubtasks, field.TypeBool, value)
+	}
 	if value, ok := tiu.mutation.ExecutionID(); ok {
 		_spec.SetField(taskinstance.FieldExecutionID, field.TypeString, value)
 	}
@@ -829,6 +987,20 @@ func (tiuo *TaskInstanceUpdateOne) ClearDelegation() *TaskInstanceUpdateOne {
 	return tiuo
 }
 
+// SetStatus sets the "status" field.
+func (tiuo *TaskInstanceUpdateOne) SetStatus(s string) *TaskInstanceUpdateOne {
+	tiuo.mutation.SetStatus(s)
+	return tiuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tiuo *TaskInstanceUpdateOne) SetNillableStatus(s *string) *TaskInstanceUpdateOne {
+	if s != nil {
+		tiuo.SetStatus(*s)
+	}
+	return tiuo
+}
+
 // SetPriority sets the "priority" field.
 func (tiuo *TaskInstanceUpdateOne) SetPriority(i int32) *TaskInstanceUpdateOne {
 	tiuo.mutation.ResetPriority()
@@ -864,6 +1036,93 @@ func (tiuo *TaskInstanceUpdateOne) SetNillableCreateTime(t *time.Time) *TaskInst
 	return tiuo
 }
 
+// SetClaimTime sets the "claim_time" field.
+func (tiuo *TaskInstanceUpdateOne) SetClaimTime(t time.Time) *TaskInstanceUpdateOne {
+	tiuo.mutation.SetClaimTime(t)
+	return tiuo
+}
+
+// SetNillableClaimTime sets the "claim_time" field if the given value is not nil.
+func (tiuo *TaskInstanceUpdateOne) SetNillableClaimTime(t *time.Time) *TaskInstanceUpdateOne {
+	if t != nil {
+		tiuo.SetClaimTime(*t)
+	}
+	return tiuo
+}
+
+// ClearClaimTime clears the value of the "claim_time" field.
+func (tiuo *TaskInstanceUpdateOne) ClearClaimTime() *TaskInstanceUpdateOne {
+	tiuo.mutation.ClearClaimTime()
+	return tiuo
+}
+
+// SetEndTime sets the "end_time" field.
+func (tiuo *TaskInstanceUpdateOne) SetEndTime(t time.Time) *TaskInstanceUpdateOne {
+	tiuo.mutation.SetEndTime(t)
+	return tiuo
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (tiuo *TaskInstanceUpdateOne) SetNillableEndTime(t *time.Time) *TaskInstanceUpdateOne {
+	if t != nil {
+		tiuo.SetEndTime(*t)
+	}
+	return tiuo
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (tiuo *TaskInstanceUpdateOne) ClearEndTime() *TaskInstanceUpdateOne {
+	tiuo.mutation.ClearEndTime()
+	return tiuo
+}
+
+// SetDuration sets the "duration" field.
+func (tiuo *TaskInstanceUpdateOne) SetDuration(i int64) *TaskInstanceUpdateOne {
+	tiuo.mutation.ResetDuration()
+	tiuo.mutation.SetDuration(i)
+	return tiuo
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (tiuo *TaskInstanceUpdateOne) SetNillableDuration(i *int64) *TaskInstanceUpdateOne {
+	if i != nil {
+		tiuo.SetDuration(*i)
+	}
+	return tiuo
+}
+
+// AddDuration adds i to the "duration" field.
+func (tiuo *TaskInstanceUpdateOne) AddDuration(i int64) *TaskInstanceUpdateOne {
+	tiuo.mutation.AddDuration(i)
+	return tiuo
+}
+
+// ClearDuration clears the value of the "duration" field.
+func (tiuo *TaskInstanceUpdateOne) ClearDuration() *TaskInstanceUpdateOne {
+	tiuo.mutation.ClearDuration()
+	return tiuo
+}
+
+// SetDeleteReason sets the "delete_reason" field.
+func (tiuo *TaskInstanceUpdateOne) SetDeleteReason(s string) *TaskInstanceUpdateOne {
+	tiuo.mutation.SetDeleteReason(s)
+	return tiuo
+}
+
+// SetNillableDeleteReason sets the "delete_reason" field if the given value is not nil.
+func (tiuo *TaskInstanceUpdateOne) SetNillableDeleteReason(s *string) *TaskInstanceUpdateOne {
+	if s != nil {
+		tiuo.SetDeleteReason(*s)
+	}
+	return tiuo
+}
+
+// ClearDeleteReason clears the value of the "delete_reason" field.
+func (tiuo *TaskInstanceUpdateOne) ClearDeleteReason() *TaskInstanceUpdateOne {
+	tiuo.mutation.ClearDeleteReason()
+	return tiuo
+}
+
 // SetDueDate sets the "due_date" field.
 func (tiuo *TaskInstanceUpdateOne) SetDueDate(t time.Time) *TaskInstanceUpdateOne {
 	tiuo.mutation.SetDueDate(t)
@@ -964,6 +1223,20 @@ func (tiuo *TaskInstanceUpdateOne) ClearParentTaskID() *TaskInstanceUpdateOne {
 	return tiuo
 }
 
+// SetWaitForSubtasks sets the "wait_for_subtasks" field.
+func (tiuo *TaskInstanceUpdateOne) SetWaitForSubtasks(b bool) *TaskInstanceUpdateOne {
+	tiuo.mutation.SetWaitForSubtasks(b)
+	return tiuo
+}
+
+// SetNillableWaitForSubtasks sets the "wait_for_subtasks" field if the given value is not nil.
+func (tiuo *TaskInstanceUpdateOne) SetNillableWaitForSubtasks(b *bool) *TaskInstanceUpdateOne {
+	if b != nil {
+		tiuo.SetWaitForSubtasks(*b)
+	}
+	return tiuo
+}
+
 // SetExecutionID sets the "execution_id" field.
 func (tiuo *TaskInstanceUpdateOne) SetExecutionID(s string) *TaskInstanceUpdateOne {
 	tiuo.mutation.SetExecutionID(s)
@@ -1215,6 +1488,16 @@ func (tiuo *TaskInstanceUpdateOne) check() error {
 			return &ValidationError{Name: "delegation", err: fmt.Errorf(`ent: validator failed for field "TaskInstance.delegation": %w`, err)}
 		}
 	}
+	if v, ok := tiuo.mutation.Status(); ok {
+		if err := taskinstance.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskInstance.status": %w`, err)}
+		}
+	}
+	if v, ok := tiuo.mutation.DeleteReason(); ok {
+		if err := taskinstance.DeleteReasonValidator(v); err != nil {
+			return &ValidationError{Name: "delete_reason", err: fmt.Errorf(`ent: validator failed for field "TaskInstance.delete_reason": %w`, err)}
+		}
+	}
 	if v, ok := tiuo.mutation.FormKey(); ok {
 		if err := taskinstance.FormKeyValidator(v); err != nil {
 			return &ValidationError{Name: "form_key", err: fmt.Errorf(`ent: validator failed for field "TaskInstance.form_key": %w`, err)}
@@ -1325,6 +1608,9 @@ func (tiuo *TaskInstanceUpdateOne) sqlSave(ctx context.Context) (_node *TaskInst
 	if tiuo.mutation.DelegationCleared() {
 		_spec.ClearField(taskinstance.FieldDelegation, field.TypeString)
 	}
+	if value, ok := tiuo.mutation.Status(); ok {
+		_spec.SetField(taskinstance.FieldStatus, field.TypeString, value)
+	}
 	if value, ok := tiuo.mutation.Priority(); ok {
 		_spec.SetField(taskinstance.FieldPriority, field.TypeInt32, value)
 	}
@@ -1334,6 +1620,33 @@ func (tiuo *TaskInstanceUpdateOne) sqlSave(ctx context.Context) (_node *TaskInst
 	if value, ok := tiuo.mutation.CreateTime(); ok {
 		_spec.SetField(taskinstance.FieldCreateTime, field.TypeTime, value)
 	}
+	if value, ok := tiuo.mutation.ClaimTime(); ok {
+		_spec.SetField(taskinstance.FieldClaimTime, field.TypeTime, value)
+	}
+	if tiuo.mutation.ClaimTimeCleared() {
+		_spec.ClearField(taskinstance.FieldClaimTime, field.TypeTime)
+	}
+	if value, ok := tiuo.mutation.EndTime(); ok {
+		_spec.SetField(taskinstance.FieldEndTime, field.TypeTime, value)
+	}
+	if tiuo.mutation.EndTimeCleared() {
+		_spec.ClearField(taskinstance.FieldEndTime, field.TypeTime)
+	}
+	if value, ok := tiuo.mutation.Duration(); ok {
+		_spec.SetField(taskinstance.FieldDuration, field.TypeInt64, value)
+	}
+	if value, ok := tiuo.mutation.AddedDuration(); ok {
+		_spec.AddField(taskinstance.FieldDuration, field.TypeInt64, value)
+	}
+	if tiuo.mutation.DurationCleared() {
+		_spec.ClearField(taskinstance.FieldDuration, field.TypeInt64)
+	}
+	if value, ok := tiuo.mutation.DeleteReason(); ok {
+		_spec.SetField(taskinstance.FieldDeleteReason, field.TypeString, value)
+	}
+	if tiuo.mutation.DeleteReasonCleared() {
+		_spec.ClearField(taskinstance.FieldDeleteReason, field.TypeString)
+	}
 	if value, ok := tiuo.mutation.DueDate(); ok {
 		_spec.SetField(taskinstance.FieldDueDate, field.TypeTime, value)
 	}
@@ -1364,6 +1677,9 @@ func (tiuo *TaskInstanceUpdateOne) sqlSave(ctx context.Context) (_node *TaskInst
 	if tiuo.mutation.ParentTaskIDCleared() {
 		_spec.ClearField(taskinstance.FieldParentTaskID, field.TypeString)
 	}
+	if value, ok := tiuo.mutation.WaitForSubtasks(); ok {
+		_spec.SetField(taskinstance.FieldWaitForSubtasks, field.TypeBool, value)
+	}
 	if value, ok := tiuo.mutation.ExecutionID(); ok {
 		_spec.SetField(taskinstance.FieldExecutionID, field.TypeString, value)
 	}
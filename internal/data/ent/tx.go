@@ -12,6 +12,10 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Authorization is the client for interacting with the Authorization builders.
+	Authorization *AuthorizationClient
+	// Group is the client for interacting with the Group builders.
+	Group *GroupClient
 	// HistoricProcessInstance is the client for interacting with the HistoricProcessInstance builders.
 	HistoricProcessInstance *HistoricProcessInstanceClient
 	// ProcessDefinition is the client for interacting with the ProcessDefinition builders.
@@ -22,8 +26,20 @@ type Tx struct {
 	ProcessInstance *ProcessInstanceClient
 	// ProcessVariable is the client for interacting with the ProcessVariable builders.
 	ProcessVariable *ProcessVariableClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
+	// Role is the client for interacting with the Role builders.
+	Role *RoleClient
+	// TaskAttachment is the client for interacting with the TaskAttachment builders.
+	TaskAttachment *TaskAttachmentClient
+	// TaskComment is the client for interacting with the TaskComment builders.
+	TaskComment *TaskCommentClient
+	// TaskIdentityLink is the client for interacting with the TaskIdentityLink builders.
+	TaskIdentityLink *TaskIdentityLinkClient
 	// TaskInstance is the client for interacting with the TaskInstance builders.
 	TaskInstance *TaskInstanceClient
+	// User is the client for interacting with the User builders.
+	User *UserClient
 
 	// lazily loaded.
 	client     *Client
@@ -155,12 +171,20 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Authorization = NewAuthorizationClient(tx.config)
+	tx.Group = NewGroupClient(tx.config)
 	tx.HistoricProcessInstance = NewHistoricProcessInstanceClient(tx.config)
 	tx.ProcessDefinition = NewProcessDefinitionClient(tx.config)
 	tx.ProcessEvent = NewProcessEventClient(tx.config)
 	tx.ProcessInstance = NewProcessInstanceClient(tx.config)
 	tx.ProcessVariable = NewProcessVariableClient(tx.config)
+	tx.RefreshToken = NewRefreshTokenClient(tx.config)
+	tx.Role = NewRoleClient(tx.config)
+	tx.TaskAttachment = NewTaskAttachmentClient(tx.config)
+	tx.TaskComment = NewTaskCommentClient(tx.config)
+	tx.TaskIdentityLink = NewTaskIdentityLinkClient(tx.config)
 	tx.TaskInstance = NewTaskInstanceClient(tx.config)
+	tx.User = NewUserClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -170,7 +194,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: HistoricProcessInstance.QueryXXX(), the query will be executed
+// applies a query, for example: Authorization.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
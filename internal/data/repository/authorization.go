@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/authorization"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/predicate"
+
+	"go.uber.org/zap"
+)
+
+// authorizationRepo 资源授权仓储实现
+type authorizationRepo struct {
+	data   *ent.Client
+	logger *zap.Logger
+}
+
+// NewAuthorizationRepo 创建资源授权仓储实例
+func NewAuthorizationRepo(data *ent.Client, logger *zap.Logger) biz.AuthorizationRepo {
+	return &authorizationRepo{
+		data:   data,
+		logger: logger,
+	}
+}
+
+// Create 创建授权
+func (r *authorizationRepo) Create(ctx context.Context, a *ent.Authorization) (*ent.Authorization, error) {
+	r.logger.Info("创建授权",
+		zap.String("principal_type", a.PrincipalType),
+		zap.String("principal_id", a.PrincipalID))
+
+	result, err := entClient(ctx, r.data).Authorization.Create().
+		SetPrincipalType(a.PrincipalType).
+		SetPrincipalID(a.PrincipalID).
+		SetProcessDefinitionKey(a.ProcessDefinitionKey).
+		SetCategory(a.Category).
+		SetTenantID(a.TenantID).
+		SetActions(a.Actions).
+		SetCreatedBy(a.CreatedBy).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("创建授权失败", zap.String("principal_id", a.PrincipalID), zap.Error(err))
+		return nil, fmt.Errorf("创建授权失败: %w", err)
+	}
+	return result, nil
+}
+
+// Delete 删除授权
+func (r *authorizationRepo) Delete(ctx context.Context, id int64) error {
+	r.logger.Info("删除授权", zap.Int64("id", id))
+
+	if err := entClient(ctx, r.data).Authorization.DeleteOneID(id).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: %d", biz.ErrAuthorizationNotFound, id)
+		}
+		r.logger.Error("删除授权失败", zap.Int64("id", id), zap.Error(err))
+		return fmt.Errorf("删除授权失败: %w", err)
+	}
+	return nil
+}
+
+// List 按过滤条件查询授权，按创建时间倒序
+func (r *authorizationRepo) List(ctx context.Context, filter *biz.AuthorizationFilter) ([]*ent.Authorization, error) {
+	query := entClient(ctx, r.data).Authorization.Query()
+	if filter != nil {
+		if filter.PrincipalType != "" {
+			query = query.Where(authorization.PrincipalType(filter.PrincipalType))
+		}
+		if filter.PrincipalID != "" {
+			query = query.Where(authorization.PrincipalID(filter.PrincipalID))
+		}
+		if filter.ProcessDefinitionKey != "" {
+			query = query.Where(authorization.ProcessDefinitionKey(filter.ProcessDefinitionKey))
+		}
+	}
+
+	results, err := query.Order(ent.Desc(authorization.FieldCreatedAt), ent.Desc(authorization.FieldID)).All(ctx)
+	if err != nil {
+		r.logger.Error("查询授权失败", zap.Error(err))
+		return nil, fmt.Errorf("查询授权失败: %w", err)
+	}
+	return results, nil
+}
+
+// ListByPrincipals 查询授予给任一用户标识或任一用户组的授权
+func (r *authorizationRepo) ListByPrincipals(ctx context.Context, users []string, groups []string) ([]*ent.Authorization, error) {
+	var principals []predicate.Authorization
+	if len(users) > 0 {
+		principals = append(principals, authorization.And(
+			authorization.PrincipalType(biz.PrincipalUser),
+			authorization.PrincipalIDIn(users...),
+		))
+	}
+	if len(groups) > 0 {
+		principals = append(principals, authorization.And(
+			authorization.PrincipalType(biz.PrincipalGroup),
+			authorization.PrincipalIDIn(groups...),
+		))
+	}
+	if len(principals) == 0 {
+		return nil, nil
+	}
+
+	results, err := entClient(ctx, r.data).Authorization.Query().
+		Where(authorization.Or(principals...)).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("查询主体的授权失败", zap.Strings("users", users), zap.Strings("groups", groups), zap.Error(err))
+		return nil, fmt.Errorf("查询主体的授权失败: %w", err)
+	}
+	return results, nil
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// TestAuthorizationRepo 测试资源授权仓储
+func TestAuthorizationRepo(t *testing.T) {
+	ctx := context.Background()
+	repo := NewAuthorizationRepo(newTestClient(t), zap.NewNop())
+
+	seed := []*ent.Authorization{
+		{PrincipalType: biz.PrincipalUser, PrincipalID: "alice", ProcessDefinitionKey: "leave", Actions: []string{biz.ActionStart, biz.ActionRead}},
+		{PrincipalType: biz.PrincipalGroup, PrincipalID: "finance", Category: "finance", Actions: []string{biz.ActionAll}},
+		{PrincipalType: biz.PrincipalGroup, PrincipalID: "alice", TenantID: "t1", Actions: []string{biz.ActionRead}},
+	}
+	var ids []int64
+	for _, a := range seed {
+		created, err := repo.Create(ctx, a)
+		require.NoError(t, err, "创建授权不应该返回错误")
+		ids = append(ids, created.ID)
+	}
+
+	t.Run("按主体过滤授权", func(t *testing.T) {
+		results, err := repo.List(ctx, &biz.AuthorizationFilter{PrincipalType: biz.PrincipalGroup})
+		require.NoError(t, err, "查询授权不应该返回错误")
+		assert.Len(t, results, 2, "应该只返回用户组授权")
+
+		results, err = repo.List(ctx, &biz.AuthorizationFilter{ProcessDefinitionKey: "leave"})
+		require.NoError(t, err, "查询授权不应该返回错误")
+		require.Len(t, results, 1, "应该只返回指定流程定义的授权")
+		assert.Equal(t, []string{biz.ActionStart, biz.ActionRead}, results[0].Actions, "授予的操作应该匹配")
+	})
+
+	t.Run("按用户与用户组查询授权", func(t *testing.T) {
+		results, err := repo.ListByPrincipals(ctx, []string{"alice"}, []string{"finance"})
+		require.NoError(t, err, "查询主体的授权不应该返回错误")
+		assert.Len(t, results, 2, "同名用户组的授权不应该授予用户")
+
+		results, err = repo.ListByPrincipals(ctx, nil, nil)
+		require.NoError(t, err, "没有主体时不应该返回错误")
+		assert.Empty(t, results, "没有主体时不应该返回授权")
+	})
+
+	t.Run("删除授权", func(t *testing.T) {
+		require.NoError(t, repo.Delete(ctx, ids[0]), "删除授权不应该返回错误")
+		assert.ErrorIs(t, repo.Delete(ctx, ids[0]), biz.ErrAuthorizationNotFound, "重复删除应该返回授权不存在")
+	})
+}
+
+// TestAccessFilter 测试列表查询的授权过滤
+func TestAccessFilter(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	logger := zap.NewNop()
+	defRepo := NewProcessDefinitionRepo(client, logger)
+	instanceRepo := NewProcessInstanceRepo(client, logger)
+	taskRepo := NewTaskInstanceRepo(client, logger)
+
+	leaveDef, err := defRepo.Create(ctx, &ent.ProcessDefinition{Name: "请假", Key: "leave", Category: "hr", Version: 1, Resource: "{}"})
+	require.NoError(t, err, "创建流程定义不应该返回错误")
+	expenseDef, err := defRepo.Create(ctx, &ent.ProcessDefinition{Name: "报销", Key: "expense", Category: "finance", Version: 1, Resource: "{}"})
+	require.NoError(t, err, "创建流程定义不应该返回错误")
+
+	instances := []*ent.ProcessInstance{
+		{Name: "请假-张三", BusinessKey: "LEAVE-1", ProcessDefinitionID: leaveDef.ID, ProcessDefinitionKey: "leave", StartUserID: "zhangsan"},
+		{Name: "报销-李四", BusinessKey: "EXP-1", ProcessDefinitionID: expenseDef.ID, ProcessDefinitionKey: "expense", StartUserID: "lisi", TenantID: "t1"},
+		{Name: "报销-王五", BusinessKey: "EXP-2", ProcessDefinitionID: expenseDef.ID, ProcessDefinitionKey: "expense", StartUserID: "wangwu", TenantID: "t2"},
+	}
+	for _, pi := range instances {
+		_, err := instanceRepo.Create(ctx, pi)
+		require.NoError(t, err, "创建流程实例不应该返回错误")
+	}
+
+	businessKeys := func(access *biz.AccessFilter) []string {
+		results, _, err := instanceRepo.List(ctx, &biz.ProcessInstanceFilter{Access: access}, nil)
+		require.NoError(t, err, "按授权过滤查询不应该返回错误")
+		var keys []string
+		for _, result := range results {
+			keys = append(keys, result.BusinessKey)
+		}
+		return keys
+	}
+
+	t.Run("流程实例按授权范围过滤", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{"LEAVE-1"}, businessKeys(&biz.AccessFilter{Scopes: []biz.ResourceScope{{ProcessDefinitionKey: "leave"}}}), "应该只看到授权流程定义的实例")
+		assert.ElementsMatch(t, []string{"EXP-1", "EXP-2"}, businessKeys(&biz.AccessFilter{Scopes: []biz.ResourceScope{{Category: "finance"}}}), "应该按流程定义分类过滤")
+		assert.ElementsMatch(t, []string{"EXP-1"}, businessKeys(&biz.AccessFilter{Scopes: []biz.ResourceScope{{Category: "finance", TenantID: "t1"}}}), "同一授权范围内的条件应该同时满足")
+		assert.ElementsMatch(t, []string{"LEAVE-1", "EXP-2"}, businessKeys(&biz.AccessFilter{Scopes: []biz.ResourceScope{{ProcessDefinitionKey: "leave"}, {TenantID: "t2"}}}), "多个授权范围应该取并集")
+	})
+
+	t.Run("发起人可以看到自己的流程实例", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{"EXP-1"}, businessKeys(&biz.AccessFilter{User: "lisi"}), "没有授权时只能看到自己发起的实例")
+		assert.Empty(t, businessKeys(&biz.AccessFilter{User: "nobody"}), "没有授权也未参与时不应该看到实例")
+		assert.Len(t, businessKeys(&biz.AccessFilter{User: "nobody", Scopes: []biz.ResourceScope{{}}}), 3, "不限范围的授权应该看到全部实例")
+	})
+
+	t.Run("流程定义按授权范围过滤", func(t *testing.T) {
+		results, page, err := defRepo.List(ctx, &biz.ProcessDefinitionFilter{Access: &biz.AccessFilter{Scopes: []biz.ResourceScope{{Category: "hr"}}}}, nil)
+		require.NoError(t, err, "按授权过滤查询不应该返回错误")
+		assert.Equal(t, 1, page.Total, "总数应该只统计有权限的流程定义")
+		require.Len(t, results, 1, "应该只返回有权限的流程定义")
+		assert.Equal(t, "leave", results[0].Key, "流程定义Key应该匹配")
+	})
+
+	t.Run("任务按授权范围与参与人过滤", func(t *testing.T) {
+		assigned, err := taskRepo.Create(ctx, &ent.TaskInstance{Name: "审批", TaskDefinitionKey: "approve", ProcessInstanceID: 1, ProcessDefinitionID: leaveDef.ID, ProcessDefinitionKey: "leave", Assignee: "alice"})
+		require.NoError(t, err, "创建任务实例不应该返回错误")
+		owned, err := taskRepo.Create(ctx, &ent.TaskInstance{Name: "复核", TaskDefinitionKey: "review", ProcessInstanceID: 2, ProcessDefinitionID: expenseDef.ID, ProcessDefinitionKey: "expense", Owner: "alice"})
+		require.NoError(t, err, "创建任务实例不应该返回错误")
+		candidate, err := taskRepo.Create(ctx, &ent.TaskInstance{Name: "财务审核", TaskDefinitionKey: "audit", ProcessInstanceID: 3, ProcessDefinitionID: expenseDef.ID, ProcessDefinitionKey: "expense"})
+		require.NoError(t, err, "创建任务实例不应该返回错误")
+		require.NoError(t, taskRepo.AddCandidates(ctx, candidate.ID, nil, []string{"finance"}), "添加候选组不应该返回错误")
+
+		taskIDs := func(access *biz.AccessFilter) []string {
+			results, _, err := taskRepo.List(ctx, &biz.TaskInstanceFilter{Access: access}, nil)
+			require.NoError(t, err, "按授权过滤查询不应该返回错误")
+			var ids []string
+			for _, result := range results {
+				ids = append(ids, strconv.FormatInt(result.ID, 10))
+			}
+			return ids
+		}
+		id := func(ti *ent.TaskInstance) string { return strconv.FormatInt(ti.ID, 10) }
+
+		assert.ElementsMatch(t, []string{id(assigned), id(owned)}, taskIDs(&biz.AccessFilter{User: "alice"}), "办理人与所有人应该看到任务")
+		assert.ElementsMatch(t, []string{id(candidate)}, taskIDs(&biz.AccessFilter{User: "bob", Groups: []string{"finance"}}), "候选组成员应该看到任务")
+		assert.ElementsMatch(t, []string{id(assigned)}, taskIDs(&biz.AccessFilter{User: "bob", Scopes: []biz.ResourceScope{{Category: "hr"}}}), "应该按流程定义分类过滤任务")
+	})
+}
@@ -0,0 +1,446 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/historicprocessinstance"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/processvariable"
+
+	"go.uber.org/zap"
+)
+
+// 历史流程实例状态
+const (
+	HistoricStateActive               = "ACTIVE"
+	HistoricStateSuspended            = "SUSPENDED"
+	HistoricStateCompleted            = "COMPLETED"
+	HistoricStateExternallyTerminated = "EXTERNALLY_TERMINATED"
+	HistoricStateInternallyTerminated = "INTERNALLY_TERMINATED"
+)
+
+// historicProcessInstanceOrderFields 历史流程实例允许排序的字段
+var historicProcessInstanceOrderFields = map[string]string{
+	"start_time":             historicprocessinstance.FieldStartTime,
+	"end_time":               historicprocessinstance.FieldEndTime,
+	"duration":               historicprocessinstance.FieldDuration,
+	"business_key":           historicprocessinstance.FieldBusinessKey,
+	"process_definition_key": historicprocessinstance.FieldProcessDefinitionKey,
+	"created_at":             historicprocessinstance.FieldCreatedAt,
+}
+
+// historicProcessInstanceRepo 历史流程实例仓储实现
+type historicProcessInstanceRepo struct {
+	data   *ent.Client
+	logger *zap.Logger
+}
+
+// NewHistoricProcessInstanceRepo 创建历史流程实例仓储实例
+func NewHistoricProcessInstanceRepo(data *ent.Client, logger *zap.Logger) biz.HistoricProcessInstanceRepo {
+	return &historicProcessInstanceRepo{
+		data:   data,
+		logger: logger,
+	}
+}
+
+// Create 创建历史流程实例
+func (r *historicProcessInstanceRepo) Create(ctx context.Context, hpi *ent.HistoricProcessInstance) (*ent.HistoricProcessInstance, error) {
+	r.logger.Debug("创建历史流程实例",
+		zap.String("process_instance_id", hpi.ProcessInstanceID),
+		zap.String("process_definition_key", hpi.ProcessDefinitionKey))
+
+	startTime := hpi.StartTime
+	if startTime.IsZero() {
+		startTime = time.Now()
+	}
+	state := hpi.State
+	if state == "" {
+		state = HistoricStateActive
+	}
+
+	create := entClient(ctx, r.data).HistoricProcessInstance.Create().
+		SetProcessInstanceID(hpi.ProcessInstanceID).
+		SetBusinessKey(hpi.BusinessKey).
+		SetProcessDefinitionID(hpi.ProcessDefinitionID).
+		SetProcessDefinitionKey(hpi.ProcessDefinitionKey).
+		SetProcessDefinitionName(hpi.ProcessDefinitionName).
+		SetProcessDefinitionVersion(hpi.ProcessDefinitionVersion).
+		SetDeploymentID(hpi.DeploymentID).
+		SetStartUserID(hpi.StartUserID).
+		SetStartTime(startTime).
+		SetNillableEndTime(hpi.EndTime).
+		SetDuration(hpi.Duration).
+		SetStartActivityID(hpi.StartActivityID).
+		SetEndActivityID(hpi.EndActivityID).
+		SetSuperProcessInstanceID(hpi.SuperProcessInstanceID).
+		SetRootProcessInstanceID(hpi.RootProcessInstanceID).
+		SetDeleteReason(hpi.DeleteReason).
+		SetState(state)
+	if hpi.TenantID != "" {
+		create = create.SetTenantID(hpi.TenantID)
+	}
+
+	result, err := create.Save(ctx)
+	if err != nil {
+		r.logger.Error("创建历史流程实例失败", zap.Error(err))
+		return nil, fmt.Errorf("创建历史流程实例失败: %w", err)
+	}
+	return result, nil
+}
+
+// GetHistoricProcessInstance 根据ID获取历史流程实例
+func (r *historicProcessInstanceRepo) GetHistoricProcessInstance(ctx context.Context, id int64) (*ent.HistoricProcessInstance, error) {
+	result, err := entClient(ctx, r.data).HistoricProcessInstance.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			r.logger.Warn("历史流程实例不存在", zap.Int64("id", id))
+			return nil, fmt.Errorf("历史流程实例不存在: %d", id)
+		}
+		r.logger.Error("获取历史流程实例失败", zap.Int64("id", id), zap.Error(err))
+		return nil, fmt.Errorf("获取历史流程实例失败: %w", err)
+	}
+	return result, nil
+}
+
+// ListHistoricProcessInstances 分页查询历史流程实例
+func (r *historicProcessInstanceRepo) ListHistoricProcessInstances(ctx context.Context, filter *biz.HistoricProcessInstanceFilter) ([]*ent.HistoricProcessInstance, int, error) {
+	r.logger.Debug("分页查询历史流程实例", zap.Any("filter", filter))
+
+	query := r.filter(entClient(ctx, r.data).HistoricProcessInstance.Query(), filter)
+	opts := &biz.QueryOptions{}
+	if filter != nil {
+		opts.Page = filter.Page
+		opts.PageSize = filter.PageSize
+		opts.OrderBy = filter.OrderBy
+		opts.Order = filter.OrderDirection
+	}
+
+	results, page, err := r.page(ctx, query, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	return results, page.Total, nil
+}
+
+// Count 计数查询
+func (r *historicProcessInstanceRepo) Count(ctx context.Context, filter *biz.HistoricProcessInstanceFilter) (int, error) {
+	count, err := r.filter(entClient(ctx, r.data).HistoricProcessInstance.Query(), filter).Count(ctx)
+	if err != nil {
+		r.logger.Error("计数查询历史流程实例失败", zap.Error(err))
+		return 0, fmt.Errorf("计数查询历史流程实例失败: %w", err)
+	}
+	return count, nil
+}
+
+// ListByProcessDefinitionID 根据流程定义ID查询历史流程实例
+func (r *historicProcessInstanceRepo) ListByProcessDefinitionID(ctx context.Context, processDefinitionID string, opts *biz.QueryOptions) ([]*ent.HistoricProcessInstance, *biz.PaginationResult, error) {
+	definitionID, err := strconv.ParseInt(processDefinitionID, 10, 64)
+	if err != nil {
+		return nil, nil, fmt.Errorf("无效的流程定义ID: %s", processDefinitionID)
+	}
+
+	query := entClient(ctx, r.data).HistoricProcessInstance.Query().
+		Where(historicprocessinstance.ProcessDefinitionID(definitionID))
+	if opts != nil && opts.Search != "" {
+		query = query.Where(historicprocessinstance.Or(
+			historicprocessinstance.BusinessKeyContainsFold(opts.Search),
+			historicprocessinstance.ProcessDefinitionNameContainsFold(opts.Search),
+			historicprocessinstance.StartUserIDContainsFold(opts.Search),
+		))
+	}
+	return r.page(ctx, query, opts)
+}
+
+// DeleteHistoricProcessInstance 删除历史流程实例
+func (r *historicProcessInstanceRepo) DeleteHistoricProcessInstance(ctx context.Context, id int64) error {
+	if err := entClient(ctx, r.data).HistoricProcessInstance.DeleteOneID(id).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("历史流程实例不存在: %d", id)
+		}
+		r.logger.Error("删除历史流程实例失败", zap.Int64("id", id), zap.Error(err))
+		return fmt.Errorf("删除历史流程实例失败: %w", err)
+	}
+	return nil
+}
+
+// BatchDeleteHistoricProcessInstances 批量删除在指定时间之前结束的历史流程实例
+// 流程定义键为空时不限定流程定义；未结束的实例不会被删除
+func (r *historicProcessInstanceRepo) BatchDeleteHistoricProcessInstances(ctx context.Context, processDefinitionKey string, endTimeBefore time.Time) (int64, error) {
+	r.logger.Info("批量删除历史流程实例",
+		zap.String("process_definition_key", processDefinitionKey),
+		zap.Time("end_time_before", endTimeBefore))
+
+	del := entClient(ctx, r.data).HistoricProcessInstance.Delete().
+		Where(historicprocessinstance.EndTimeLT(endTimeBefore))
+	if processDefinitionKey != "" {
+		del = del.Where(historicprocessinstance.ProcessDefinitionKey(processDefinitionKey))
+	}
+
+	deleted, err := del.Exec(ctx)
+	if err != nil {
+		r.logger.Error("批量删除历史流程实例失败", zap.Error(err))
+		return 0, fmt.Errorf("批量删除历史流程实例失败: %w", err)
+	}
+	return int64(deleted), nil
+}
+
+// GetHistoricVariables 获取历史流程实例的流程级变量
+func (r *historicProcessInstanceRepo) GetHistoricVariables(ctx context.Context, processInstanceID int64) ([]*biz.HistoricVariableInstance, error) {
+	hpi, err := r.GetHistoricProcessInstance(ctx, processInstanceID)
+	if err != nil {
+		return nil, err
+	}
+	instanceID, err := strconv.ParseInt(hpi.ProcessInstanceID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("无效的流程实例ID: %s", hpi.ProcessInstanceID)
+	}
+
+	variables, err := entClient(ctx, r.data).ProcessVariable.Query().
+		Where(
+			processvariable.ProcessInstanceID(instanceID),
+			processVariableOfInstance(),
+		).
+		Order(ent.Asc(processvariable.FieldName), ent.Asc(processvariable.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("查询历史变量失败", zap.Int64("id", processInstanceID), zap.Error(err))
+		return nil, fmt.Errorf("查询历史变量失败: %w", err)
+	}
+
+	results := make([]*biz.HistoricVariableInstance, 0, len(variables))
+	for _, v := range variables {
+		item := &biz.HistoricVariableInstance{
+			ID:   strconv.FormatInt(v.ID, 10),
+			Name: v.Name,
+			Type: v.Type,
+		}
+		switch v.Type {
+		case "integer":
+			item.LongValue = &v.LongValue
+		case "double":
+			item.DoubleValue = &v.DoubleValue
+		default:
+			item.TextValue = &v.TextValue
+		}
+		results = append(results, item)
+	}
+	return results, nil
+}
+
+// GetProcessStatistics 统计时间范围内启动的流程实例
+// 持续时间只统计已完成的实例
+func (r *historicProcessInstanceRepo) GetProcessStatistics(ctx context.Context, processDefinitionKey string, startTime, endTime time.Time) (*biz.ProcessStatistics, error) {
+	query := entClient(ctx, r.data).HistoricProcessInstance.Query().
+		Where(
+			historicprocessinstance.StartTimeGTE(startTime),
+			historicprocessinstance.StartTimeLTE(endTime),
+		)
+	if processDefinitionKey != "" {
+		query = query.Where(historicprocessinstance.ProcessDefinitionKey(processDefinitionKey))
+	}
+
+	instances, err := query.
+		Select(
+			historicprocessinstance.FieldState,
+			historicprocessinstance.FieldStartTime,
+			historicprocessinstance.FieldEndTime,
+			historicprocessinstance.FieldDuration,
+		).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("查询流程统计数据失败", zap.Error(err))
+		return nil, fmt.Errorf("查询流程统计数据失败: %w", err)
+	}
+
+	stats := &biz.ProcessStatistics{TotalInstances: int64(len(instances))}
+	var total time.Duration
+	for _, instance := range instances {
+		switch instance.State {
+		case HistoricStateCompleted:
+			stats.CompletedInstances++
+			duration := time.Duration(instance.Duration) * time.Millisecond
+			if duration == 0 && instance.EndTime != nil {
+				duration = instance.EndTime.Sub(instance.StartTime)
+			}
+			total += duration
+			if stats.CompletedInstances == 1 || duration < stats.MinDuration {
+				stats.MinDuration = duration
+			}
+			if duration > stats.MaxDuration {
+				stats.MaxDuration = duration
+			}
+		case HistoricStateSuspended:
+			stats.SuspendedInstances++
+		case HistoricStateExternallyTerminated, HistoricStateInternallyTerminated:
+			stats.TerminatedInstances++
+		default:
+			stats.ActiveInstances++
+		}
+	}
+	if stats.CompletedInstances > 0 {
+		stats.AverageDuration = total / time.Duration(stats.CompletedInstances)
+	}
+	return stats, nil
+}
+
+// GetProcessTrend 按时间粒度统计流程趋势
+// 每个时间段统计该段内启动和完成的实例数，以及段结束时仍在运行的实例数
+func (r *historicProcessInstanceRepo) GetProcessTrend(ctx context.Context, processDefinitionKey string, startTime, endTime time.Time, granularity string) ([]*biz.ProcessTrendData, error) {
+	if _, err := nextBucket(startTime, granularity); err != nil {
+		return nil, err
+	}
+	if endTime.Before(startTime) {
+		return nil, fmt.Errorf("结束时间不能早于开始时间")
+	}
+
+	query := entClient(ctx, r.data).HistoricProcessInstance.Query().
+		Where(
+			historicprocessinstance.StartTimeLT(endTime),
+			historicprocessinstance.Or(
+				historicprocessinstance.EndTimeIsNil(),
+				historicprocessinstance.EndTimeGTE(startTime),
+			),
+		)
+	if processDefinitionKey != "" {
+		query = query.Where(historicprocessinstance.ProcessDefinitionKey(processDefinitionKey))
+	}
+
+	instances, err := query.
+		Select(
+			historicprocessinstance.FieldState,
+			historicprocessinstance.FieldStartTime,
+			historicprocessinstance.FieldEndTime,
+		).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("查询流程趋势数据失败", zap.Error(err))
+		return nil, fmt.Errorf("查询流程趋势数据失败: %w", err)
+	}
+
+	var trends []*biz.ProcessTrendData
+	for bucket := truncateBucket(startTime, granularity); bucket.Before(endTime); {
+		next, _ := nextBucket(bucket, granularity)
+		point := &biz.ProcessTrendData{Time: bucket}
+		for _, instance := range instances {
+			if !instance.StartTime.Before(bucket) && instance.StartTime.Before(next) {
+				point.StartedInstances++
+			}
+			if instance.EndTime != nil && instance.State == HistoricStateCompleted &&
+				!instance.EndTime.Before(bucket) && instance.EndTime.Before(next) {
+				point.CompletedInstances++
+			}
+			if instance.StartTime.Before(next) && (instance.EndTime == nil || !instance.EndTime.Before(next)) {
+				point.ActiveInstances++
+			}
+		}
+		trends = append(trends, point)
+		bucket = next
+	}
+	return trends, nil
+}
+
+// filter 应用历史流程实例过滤条件
+func (r *historicProcessInstanceRepo) filter(query *ent.HistoricProcessInstanceQuery, filter *biz.HistoricProcessInstanceFilter) *ent.HistoricProcessInstanceQuery {
+	if filter == nil {
+		return query
+	}
+	if filter.ProcessDefinitionID != "" {
+		if id, err := strconv.ParseInt(filter.ProcessDefinitionID, 10, 64); err == nil {
+			query = query.Where(historicprocessinstance.ProcessDefinitionID(id))
+		} else {
+			// 非数字的流程定义ID不可能匹配任何记录
+			query = query.Where(historicprocessinstance.IDEQ(-1))
+		}
+	}
+	if filter.ProcessDefinitionKey != "" {
+		query = query.Where(historicprocessinstance.ProcessDefinitionKey(filter.ProcessDefinitionKey))
+	}
+	if filter.BusinessKey != "" {
+		query = query.Where(historicprocessinstance.BusinessKey(filter.BusinessKey))
+	}
+	if filter.StartUserID != "" {
+		query = query.Where(historicprocessinstance.StartUserID(filter.StartUserID))
+	}
+	if filter.State != "" {
+		query = query.Where(historicprocessinstance.State(filter.State))
+	}
+	if filter.StartTimeAfter != nil {
+		query = query.Where(historicprocessinstance.StartTimeGTE(*filter.StartTimeAfter))
+	}
+	if filter.StartTimeBefore != nil {
+		query = query.Where(historicprocessinstance.StartTimeLTE(*filter.StartTimeBefore))
+	}
+	if filter.EndTimeAfter != nil {
+		query = query.Where(historicprocessinstance.EndTimeGTE(*filter.EndTimeAfter))
+	}
+	if filter.EndTimeBefore != nil {
+		query = query.Where(historicprocessinstance.EndTimeLTE(*filter.EndTimeBefore))
+	}
+	if filter.TenantID != "" {
+		query = query.Where(historicprocessinstance.TenantID(filter.TenantID))
+	}
+	if filter.Access != nil {
+		query = query.Where(accessPredicate(filter.Access, accessColumns{
+			key:          historicprocessinstance.FieldProcessDefinitionKey,
+			tenant:       historicprocessinstance.FieldTenantID,
+			definitionID: historicprocessinstance.FieldProcessDefinitionID,
+		}, userColumn(historicprocessinstance.FieldStartUserID, filter.Access.User)))
+	}
+	return query
+}
+
+// page 执行分页与排序查询
+func (r *historicProcessInstanceRepo) page(ctx context.Context, query *ent.HistoricProcessInstanceQuery, opts *biz.QueryOptions) ([]*ent.HistoricProcessInstance, *biz.PaginationResult, error) {
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.logger.Error("查询历史流程实例总数失败", zap.Error(err))
+		return nil, nil, fmt.Errorf("查询历史流程实例总数失败: %w", err)
+	}
+
+	query = query.Order(orderBy(opts, historicProcessInstanceOrderFields, historicprocessinstance.FieldStartTime), ent.Desc(historicprocessinstance.FieldID))
+	if opts != nil && opts.Page > 0 && opts.PageSize > 0 {
+		query = query.Offset((opts.Page - 1) * opts.PageSize).Limit(opts.PageSize)
+	}
+
+	results, err := query.All(ctx)
+	if err != nil {
+		r.logger.Error("查询历史流程实例失败", zap.Error(err))
+		return nil, nil, fmt.Errorf("查询历史流程实例失败: %w", err)
+	}
+	return results, pagination(total, opts), nil
+}
+
+// truncateBucket 将时间对齐到所属时间段的起点，周以周一为起点
+func truncateBucket(t time.Time, granularity string) time.Time {
+	switch granularity {
+	case "hour":
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+	case "week":
+		offset := (int(t.Weekday()) + 6) % 7
+		return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+	case "month":
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	}
+}
+
+// nextBucket 返回下一个时间段的起点
+func nextBucket(t time.Time, granularity string) (time.Time, error) {
+	switch granularity {
+	case "hour":
+		return t.Add(time.Hour), nil
+	case "day":
+		return t.AddDate(0, 0, 1), nil
+	case "week":
+		return t.AddDate(0, 0, 7), nil
+	case "month":
+		return t.AddDate(0, 1, 0), nil
+	default:
+		return time.Time{}, fmt.Errorf("不支持的时间粒度: %s", granularity)
+	}
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// TestHistoricProcessInstanceRepo 测试历史流程实例仓储
+func TestHistoricProcessInstanceRepo(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	repo := NewHistoricProcessInstanceRepo(client, zap.NewNop())
+
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	ended := func(d time.Duration) *time.Time {
+		end := day.Add(d)
+		return &end
+	}
+	seed := []*ent.HistoricProcessInstance{
+		{ProcessInstanceID: "1", ProcessDefinitionID: 1, ProcessDefinitionKey: "leave", BusinessKey: "LEAVE-001", StartUserID: "zhangsan",
+			StartTime: day.Add(9 * time.Hour), EndTime: ended(10 * time.Hour), Duration: time.Hour.Milliseconds(), State: HistoricStateCompleted},
+		{ProcessInstanceID: "2", ProcessDefinitionID: 1, ProcessDefinitionKey: "leave", BusinessKey: "LEAVE-002", StartUserID: "lisi",
+			StartTime: day.Add(10 * time.Hour), EndTime: ended(27 * time.Hour), Duration: (17 * time.Hour).Milliseconds(), State: HistoricStateCompleted},
+		{ProcessInstanceID: "3", ProcessDefinitionID: 1, ProcessDefinitionKey: "leave", BusinessKey: "LEAVE-003", StartUserID: "zhangsan",
+			StartTime: day.Add(30 * time.Hour), State: HistoricStateActive},
+		{ProcessInstanceID: "4", ProcessDefinitionID: 1, ProcessDefinitionKey: "leave", BusinessKey: "LEAVE-004", StartUserID: "wangwu",
+			StartTime: day.Add(31 * time.Hour), EndTime: ended(32 * time.Hour), State: HistoricStateExternallyTerminated},
+		{ProcessInstanceID: "5", ProcessDefinitionID: 2, ProcessDefinitionKey: "expense", BusinessKey: "EXP-001", StartUserID: "zhangsan",
+			StartTime: day.Add(11 * time.Hour), State: HistoricStateSuspended},
+	}
+	var ids []int64
+	for _, hpi := range seed {
+		created, err := repo.Create(ctx, hpi)
+		require.NoError(t, err, "创建历史流程实例不应该返回错误")
+		ids = append(ids, created.ID)
+	}
+
+	t.Run("按条件过滤并分页", func(t *testing.T) {
+		results, total, err := repo.ListHistoricProcessInstances(ctx, &biz.HistoricProcessInstanceFilter{
+			ProcessDefinitionKey: "leave",
+			StartUserID:          "zhangsan",
+			Page:                 1,
+			PageSize:             1,
+			OrderBy:              "start_time",
+			OrderDirection:       "asc",
+		})
+		require.NoError(t, err, "查询不应该返回错误")
+		assert.Equal(t, 2, total, "张三应该启动了两个请假流程")
+		require.Len(t, results, 1, "每页应该只返回一条")
+		assert.Equal(t, "LEAVE-001", results[0].BusinessKey, "升序时最早启动的实例在前")
+
+		count, err := repo.Count(ctx, &biz.HistoricProcessInstanceFilter{State: HistoricStateCompleted})
+		require.NoError(t, err, "计数查询不应该返回错误")
+		assert.Equal(t, 2, count, "应该有两个已完成的实例")
+	})
+
+	t.Run("流程统计", func(t *testing.T) {
+		stats, err := repo.GetProcessStatistics(ctx, "leave", day, day.Add(48*time.Hour))
+		require.NoError(t, err, "统计不应该返回错误")
+		assert.Equal(t, int64(4), stats.TotalInstances, "总实例数应该匹配")
+		assert.Equal(t, int64(2), stats.CompletedInstances, "完成实例数应该匹配")
+		assert.Equal(t, int64(1), stats.ActiveInstances, "活跃实例数应该匹配")
+		assert.Equal(t, int64(1), stats.TerminatedInstances, "终止实例数应该匹配")
+		assert.Equal(t, time.Hour, stats.MinDuration, "最短持续时间应该匹配")
+		assert.Equal(t, 17*time.Hour, stats.MaxDuration, "最长持续时间应该匹配")
+		assert.Equal(t, 9*time.Hour, stats.AverageDuration, "平均持续时间应该匹配")
+	})
+
+	t.Run("按天统计趋势", func(t *testing.T) {
+		trends, err := repo.GetProcessTrend(ctx, "leave", day.Add(6*time.Hour), day.Add(48*time.Hour), "day")
+		require.NoError(t, err, "趋势统计不应该返回错误")
+		require.Len(t, trends, 2, "应该有两个时间段")
+
+		assert.Equal(t, day, trends[0].Time, "时间段应该对齐到当天零点")
+		assert.Equal(t, int64(2), trends[0].StartedInstances, "第一天启动实例数应该匹配")
+		assert.Equal(t, int64(1), trends[0].CompletedInstances, "第一天完成实例数应该匹配")
+		assert.Equal(t, int64(1), trends[0].ActiveInstances, "第一天结束时仍有一个实例在运行")
+
+		assert.Equal(t, int64(2), trends[1].StartedInstances, "第二天启动实例数应该匹配")
+		assert.Equal(t, int64(1), trends[1].CompletedInstances, "第二天完成实例数应该匹配")
+		assert.Equal(t, int64(1), trends[1].ActiveInstances, "第二天结束时仍有一个实例在运行")
+
+		_, err = repo.GetProcessTrend(ctx, "leave", day, day.Add(time.Hour), "minute")
+		assert.Error(t, err, "不支持的时间粒度应该返回错误")
+	})
+
+	t.Run("获取历史变量", func(t *testing.T) {
+		variables := NewProcessVariableRepo(client, zap.NewNop())
+		require.NoError(t, variables.SetVariables(ctx, "1", map[string]interface{}{
+			"days":   2,
+			"reason": "年假",
+		}), "设置变量不应该返回错误")
+
+		results, err := repo.GetHistoricVariables(ctx, ids[0])
+		require.NoError(t, err, "获取历史变量不应该返回错误")
+		require.Len(t, results, 2, "应该有两个变量")
+		assert.Equal(t, "days", results[0].Name, "变量应该按名称排序")
+		require.NotNil(t, results[0].LongValue, "整数变量应该使用长整型值")
+		assert.Equal(t, int64(2), *results[0].LongValue, "整数变量值应该匹配")
+		require.NotNil(t, results[1].TextValue, "字符串变量应该使用文本值")
+		assert.Equal(t, "年假", *results[1].TextValue, "字符串变量值应该匹配")
+	})
+
+	t.Run("批量删除已结束的实例", func(t *testing.T) {
+		deleted, err := repo.BatchDeleteHistoricProcessInstances(ctx, "leave", day.Add(24*time.Hour))
+		require.NoError(t, err, "批量删除不应该返回错误")
+		assert.Equal(t, int64(1), deleted, "只应该删除截止时间之前结束的实例")
+
+		_, err = repo.GetHistoricProcessInstance(ctx, ids[0])
+		assert.Error(t, err, "被删除的实例应该查询不到")
+		_, err = repo.GetHistoricProcessInstance(ctx, ids[2])
+		assert.NoError(t, err, "未结束的实例不应该被删除")
+	})
+
+	t.Run("按流程定义ID查询", func(t *testing.T) {
+		results, page, err := repo.ListByProcessDefinitionID(ctx, "2", nil)
+		require.NoError(t, err, "查询不应该返回错误")
+		assert.Equal(t, 1, page.Total, "流程定义2应该只有一个实例")
+		assert.Equal(t, "EXP-001", results[0].BusinessKey, "业务键应该匹配")
+	})
+}
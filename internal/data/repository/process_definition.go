@@ -6,7 +6,6 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/workflow-engine/workflow-engine/internal/biz"
@@ -34,13 +33,14 @@ func NewProcessDefinitionRepo(data *ent.Client, logger *zap.Logger) biz.ProcessD
 func (r *processDefinitionRepo) Create(ctx context.Context, pd *ent.ProcessDefinition) (*ent.ProcessDefinition, error) {
 	r.logger.Info("创建流程定义", zap.String("name", pd.Name), zap.String("key", pd.Key))
 
-	result, err := r.data.ProcessDefinition.Create().
+	result, err := entClient(ctx, r.data).ProcessDefinition.Create().
 		SetName(pd.Name).
 		SetKey(pd.Key).
 		SetDescription(pd.Description).
 		SetCategory(pd.Category).
 		SetVersion(pd.Version).
 		SetResource(pd.Resource).
+		SetDiagramData(pd.DiagramData).
 		SetSuspended(pd.Suspended).
 		SetTenantID(pd.TenantID).
 		SetCreatedAt(time.Now()).
@@ -66,7 +66,7 @@ func (r *processDefinitionRepo) GetByID(ctx context.Context, id string) (*ent.Pr
 		return nil, fmt.Errorf("无效的流程定义ID: %s", id)
 	}
 
-	result, err := r.data.ProcessDefinition.
+	result, err := entClient(ctx, r.data).ProcessDefinition.
 		Query().
 		Where(processdefinition.ID(idInt)).
 		Only(ctx)
@@ -87,7 +87,7 @@ func (r *processDefinitionRepo) GetByID(ctx context.Context, id string) (*ent.Pr
 func (r *processDefinitionRepo) GetLatestByKey(ctx context.Context, key string) (*ent.ProcessDefinition, error) {
 	r.logger.Debug("根据Key获取最新版本流程定义", zap.String("key", key))
 
-	result, err := r.data.ProcessDefinition.
+	result, err := entClient(ctx, r.data).ProcessDefinition.
 		Query().
 		Where(processdefinition.Key(key)).
 		Order(ent.Desc(processdefinition.FieldVersion)).
@@ -111,7 +111,7 @@ func (r *processDefinitionRepo) GetByKeyAndVersion(ctx context.Context, key stri
 		zap.String("key", key),
 		zap.Int("version", version))
 
-	result, err := r.data.ProcessDefinition.
+	result, err := entClient(ctx, r.data).ProcessDefinition.
 		Query().
 		Where(
 			processdefinition.Key(key),
@@ -140,12 +140,13 @@ func (r *processDefinitionRepo) GetByKeyAndVersion(ctx context.Context, key stri
 func (r *processDefinitionRepo) Update(ctx context.Context, pd *ent.ProcessDefinition) (*ent.ProcessDefinition, error) {
 	r.logger.Info("更新流程定义", zap.String("id", strconv.FormatInt(pd.ID, 10)))
 
-	result, err := r.data.ProcessDefinition.
+	result, err := entClient(ctx, r.data).ProcessDefinition.
 		UpdateOneID(pd.ID).
 		SetName(pd.Name).
 		SetDescription(pd.Description).
 		SetCategory(pd.Category).
 		SetResource(pd.Resource).
+		SetDiagramData(pd.DiagramData).
 		SetSuspended(pd.Suspended).
 		SetTenantID(pd.TenantID).
 		SetUpdatedAt(time.Now()).
@@ -170,7 +171,7 @@ func (r *processDefinitionRepo) Delete(ctx context.Context, id string) error {
 		return fmt.Errorf("无效的流程定义ID: %s", id)
 	}
 
-	err = r.data.ProcessDefinition.
+	err = entClient(ctx, r.data).ProcessDefinition.
 		DeleteOneID(idInt).
 		Exec(ctx)
 
@@ -194,7 +195,7 @@ func (r *processDefinitionRepo) List(ctx context.Context, filter *biz.ProcessDef
 		zap.Any("options", opts))
 
 	// 构建查询条件
-	query := r.data.ProcessDefinition.Query()
+	query := entClient(ctx, r.data).ProcessDefinition.Query()
 
 	// 应用过滤条件
 	if filter != nil {
@@ -218,16 +219,23 @@ func (r *processDefinitionRepo) List(ctx context.Context, filter *biz.ProcessDef
 		if filter.CreatedTo != nil {
 			query = query.Where(processdefinition.CreatedAtLTE(*filter.CreatedTo))
 		}
+		if filter.Access != nil {
+			query = query.Where(accessPredicate(filter.Access, accessColumns{
+				key:      processdefinition.FieldKey,
+				category: processdefinition.FieldCategory,
+				tenant:   processdefinition.FieldTenantID,
+			}))
+		}
 	}
 
 	// 应用搜索条件
 	if opts != nil && opts.Search != "" {
-		searchTerm := "%" + strings.ToLower(opts.Search) + "%"
 		query = query.Where(
 			processdefinition.Or(
-				processdefinition.NameContains(searchTerm),
-				processdefinition.DescriptionContains(searchTerm),
-				processdefinition.CategoryContains(searchTerm),
+				processdefinition.KeyContainsFold(opts.Search),
+				processdefinition.NameContainsFold(opts.Search),
+				processdefinition.DescriptionContainsFold(opts.Search),
+				processdefinition.CategoryContainsFold(opts.Search),
 			),
 		)
 	}
@@ -298,7 +306,7 @@ func (r *processDefinitionRepo) List(ctx context.Context, filter *biz.ProcessDef
 func (r *processDefinitionRepo) Count(ctx context.Context, filter *biz.ProcessDefinitionFilter) (int, error) {
 	r.logger.Debug("计数查询流程定义", zap.Any("filter", filter))
 
-	query := r.data.ProcessDefinition.Query()
+	query := entClient(ctx, r.data).ProcessDefinition.Query()
 
 	// 应用过滤条件
 	if filter != nil {
@@ -322,6 +330,13 @@ func (r *processDefinitionRepo) Count(ctx context.Context, filter *biz.ProcessDe
 		if filter.CreatedTo != nil {
 			query = query.Where(processdefinition.CreatedAtLTE(*filter.CreatedTo))
 		}
+		if filter.Access != nil {
+			query = query.Where(accessPredicate(filter.Access, accessColumns{
+				key:      processdefinition.FieldKey,
+				category: processdefinition.FieldCategory,
+				tenant:   processdefinition.FieldTenantID,
+			}))
+		}
 	}
 
 	count, err := query.Count(ctx)
@@ -343,7 +358,7 @@ func (r *processDefinitionRepo) Deploy(ctx context.Context, id string) error {
 		return fmt.Errorf("无效的流程定义ID: %s", id)
 	}
 
-	_, err = r.data.ProcessDefinition.
+	_, err = entClient(ctx, r.data).ProcessDefinition.
 		UpdateOneID(idInt).
 		SetSuspended(false).
 		SetDeployTime(time.Now()).
@@ -373,7 +388,7 @@ func (r *processDefinitionRepo) Suspend(ctx context.Context, id string) error {
 		return fmt.Errorf("无效的流程定义ID: %s", id)
 	}
 
-	_, err = r.data.ProcessDefinition.
+	_, err = entClient(ctx, r.data).ProcessDefinition.
 		UpdateOneID(idInt).
 		SetSuspended(true).
 		SetUpdatedAt(time.Now()).
@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/processevent"
+
+	"go.uber.org/zap"
+)
+
+// processEventRepo 流程事件仓储实现
+type processEventRepo struct {
+	data   *ent.Client
+	logger *zap.Logger
+}
+
+// NewProcessEventRepo 创建流程事件仓储实例
+func NewProcessEventRepo(data *ent.Client, logger *zap.Logger) biz.ProcessEventRepo {
+	return &processEventRepo{
+		data:   data,
+		logger: logger,
+	}
+}
+
+// Create 创建流程事件
+func (r *processEventRepo) Create(ctx context.Context, pe *ent.ProcessEvent) (*ent.ProcessEvent, error) {
+	r.logger.Debug("创建流程事件",
+		zap.String("event_type", pe.EventType),
+		zap.Int64("process_instance_id", pe.ProcessInstanceID),
+		zap.String("execution_id", pe.ExecutionID))
+
+	timestamp := pe.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	create := entClient(ctx, r.data).ProcessEvent.Create().
+		SetEventType(pe.EventType).
+		SetEventName(pe.EventName).
+		SetExecutionID(pe.ExecutionID).
+		SetProcessInstanceID(pe.ProcessInstanceID).
+		SetProcessDefinitionID(pe.ProcessDefinitionID).
+		SetProcessDefinitionKey(pe.ProcessDefinitionKey).
+		SetTaskID(pe.TaskID).
+		SetActivityID(pe.ActivityID).
+		SetActivityName(pe.ActivityName).
+		SetActivityType(pe.ActivityType).
+		SetUserID(pe.UserID).
+		SetTimestamp(timestamp).
+		SetCorrelationID(pe.CorrelationID).
+		SetMessageName(pe.MessageName).
+		SetSignalName(pe.SignalName).
+		SetJobID(pe.JobID).
+		SetJobType(pe.JobType).
+		SetJobHandlerType(pe.JobHandlerType).
+		SetDeploymentID(pe.DeploymentID).
+		SetSequenceCounter(pe.SequenceCounter)
+	if pe.EventData != nil {
+		create = create.SetEventData(pe.EventData)
+	}
+	if pe.TenantID != "" {
+		create = create.SetTenantID(pe.TenantID)
+	}
+
+	result, err := create.Save(ctx)
+	if err != nil {
+		r.logger.Error("创建流程事件失败", zap.String("event_type", pe.EventType), zap.Error(err))
+		return nil, fmt.Errorf("创建流程事件失败: %w", err)
+	}
+	return result, nil
+}
+
+// GetByID 根据ID获取流程事件
+func (r *processEventRepo) GetByID(ctx context.Context, id string) (*ent.ProcessEvent, error) {
+	idInt, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("无效的流程事件ID: %s", id)
+	}
+
+	result, err := entClient(ctx, r.data).ProcessEvent.Get(ctx, idInt)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			r.logger.Warn("流程事件不存在", zap.String("id", id))
+			return nil, fmt.Errorf("流程事件不存在: %s", id)
+		}
+		r.logger.Error("获取流程事件失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("获取流程事件失败: %w", err)
+	}
+	return result, nil
+}
+
+// List 分页查询流程实例的事件
+func (r *processEventRepo) List(ctx context.Context, processInstanceID string, opts *biz.QueryOptions) ([]*ent.ProcessEvent, *biz.PaginationResult, error) {
+	instanceID, err := strconv.ParseInt(processInstanceID, 10, 64)
+	if err != nil {
+		return nil, nil, fmt.Errorf("无效的流程实例ID: %s", processInstanceID)
+	}
+	return r.page(ctx, entClient(ctx, r.data).ProcessEvent.Query().Where(processevent.ProcessInstanceID(instanceID)), opts)
+}
+
+// ListByProcessInstanceID 按发生顺序查询流程实例的全部事件
+func (r *processEventRepo) ListByProcessInstanceID(ctx context.Context, processInstanceID string) ([]*ent.ProcessEvent, error) {
+	instanceID, err := strconv.ParseInt(processInstanceID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("无效的流程实例ID: %s", processInstanceID)
+	}
+
+	results, err := entClient(ctx, r.data).ProcessEvent.Query().
+		Where(processevent.ProcessInstanceID(instanceID)).
+		Order(ent.Asc(processevent.FieldTimestamp), ent.Asc(processevent.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("查询流程事件失败", zap.String("process_instance_id", processInstanceID), zap.Error(err))
+		return nil, fmt.Errorf("查询流程事件失败: %w", err)
+	}
+	return results, nil
+}
+
+// ListByEventType 根据事件类型分页查询事件
+func (r *processEventRepo) ListByEventType(ctx context.Context, eventType string, opts *biz.QueryOptions) ([]*ent.ProcessEvent, *biz.PaginationResult, error) {
+	return r.page(ctx, entClient(ctx, r.data).ProcessEvent.Query().Where(processevent.EventType(eventType)), opts)
+}
+
+// ListByTaskID 根据任务ID分页查询事件
+func (r *processEventRepo) ListByTaskID(ctx context.Context, taskID int64, opts *biz.QueryOptions) ([]*ent.ProcessEvent, *biz.PaginationResult, error) {
+	return r.page(ctx, entClient(ctx, r.data).ProcessEvent.Query().Where(processevent.TaskID(taskID)), opts)
+}
+
+// Delete 删除流程事件
+func (r *processEventRepo) Delete(ctx context.Context, id string) error {
+	idInt, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return fmt.Errorf("无效的流程事件ID: %s", id)
+	}
+
+	if err := entClient(ctx, r.data).ProcessEvent.DeleteOneID(idInt).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("流程事件不存在: %s", id)
+		}
+		r.logger.Error("删除流程事件失败", zap.String("id", id), zap.Error(err))
+		return fmt.Errorf("删除流程事件失败: %w", err)
+	}
+	return nil
+}
+
+// DeleteByProcessInstanceID 删除流程实例的所有事件
+func (r *processEventRepo) DeleteByProcessInstanceID(ctx context.Context, processInstanceID string) error {
+	instanceID, err := strconv.ParseInt(processInstanceID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("无效的流程实例ID: %s", processInstanceID)
+	}
+
+	if _, err := entClient(ctx, r.data).ProcessEvent.Delete().
+		Where(processevent.ProcessInstanceID(instanceID)).
+		Exec(ctx); err != nil {
+		r.logger.Error("删除流程实例事件失败", zap.String("process_instance_id", processInstanceID), zap.Error(err))
+		return fmt.Errorf("删除流程实例事件失败: %w", err)
+	}
+	return nil
+}
+
+// page 按时间倒序分页查询
+func (r *processEventRepo) page(ctx context.Context, query *ent.ProcessEventQuery, opts *biz.QueryOptions) ([]*ent.ProcessEvent, *biz.PaginationResult, error) {
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.logger.Error("查询流程事件总数失败", zap.Error(err))
+		return nil, nil, fmt.Errorf("查询流程事件总数失败: %w", err)
+	}
+
+	if opts != nil && opts.Order == "asc" {
+		query = query.Order(ent.Asc(processevent.FieldTimestamp), ent.Asc(processevent.FieldID))
+	} else {
+		query = query.Order(ent.Desc(processevent.FieldTimestamp), ent.Desc(processevent.FieldID))
+	}
+	if opts != nil && opts.Page > 0 && opts.PageSize > 0 {
+		query = query.Offset((opts.Page - 1) * opts.PageSize).Limit(opts.PageSize)
+	}
+
+	results, err := query.All(ctx)
+	if err != nil {
+		r.logger.Error("查询流程事件失败", zap.Error(err))
+		return nil, nil, fmt.Errorf("查询流程事件失败: %w", err)
+	}
+
+	return results, pagination(total, opts), nil
+}
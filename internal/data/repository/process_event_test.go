@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// TestProcessEventRepo 测试流程事件仓储
+func TestProcessEventRepo(t *testing.T) {
+	ctx := context.Background()
+	repo := NewProcessEventRepo(newTestClient(t), zap.NewNop())
+
+	base := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	seed := []*ent.ProcessEvent{
+		{EventType: "PROCESS_STARTED", ProcessInstanceID: 1, ProcessDefinitionKey: "leave", Timestamp: base},
+		{EventType: "ACTIVITY_COMPLETED", ProcessInstanceID: 1, ProcessDefinitionKey: "leave", ActivityID: "approve", Timestamp: base.Add(time.Minute)},
+		{EventType: "PROCESS_COMPLETED", ProcessInstanceID: 1, ProcessDefinitionKey: "leave", Timestamp: base.Add(2 * time.Minute)},
+		{EventType: "PROCESS_STARTED", ProcessInstanceID: 2, ProcessDefinitionKey: "leave", Timestamp: base.Add(3 * time.Minute)},
+		{EventType: biz.TaskEventClaimed, ProcessInstanceID: 2, TaskID: 7, UserID: "manager", Timestamp: base.Add(4 * time.Minute)},
+		{EventType: biz.TaskEventDelegated, ProcessInstanceID: 2, TaskID: 7, UserID: "manager", Timestamp: base.Add(5 * time.Minute),
+			EventData: map[string]interface{}{"from": "manager", "to": "deputy"}},
+	}
+	for _, pe := range seed {
+		_, err := repo.Create(ctx, pe)
+		require.NoError(t, err, "创建流程事件不应该返回错误")
+	}
+
+	t.Run("按发生顺序查询实例事件", func(t *testing.T) {
+		results, err := repo.ListByProcessInstanceID(ctx, "1")
+		require.NoError(t, err, "查询不应该返回错误")
+		require.Len(t, results, 3, "流程实例1应该有三个事件")
+		assert.Equal(t, "PROCESS_STARTED", results[0].EventType, "第一个事件应该是流程启动")
+		assert.Equal(t, "PROCESS_COMPLETED", results[2].EventType, "最后一个事件应该是流程完成")
+	})
+
+	t.Run("分页查询默认按时间倒序", func(t *testing.T) {
+		results, page, err := repo.List(ctx, "1", &biz.QueryOptions{Page: 1, PageSize: 2})
+		require.NoError(t, err, "分页查询不应该返回错误")
+		assert.Equal(t, 3, page.Total, "总数应该匹配")
+		assert.Equal(t, 2, page.Pages, "总页数应该匹配")
+		require.Len(t, results, 2, "每页应该返回两条")
+		assert.Equal(t, "PROCESS_COMPLETED", results[0].EventType, "最新的事件在前")
+	})
+
+	t.Run("按事件类型查询", func(t *testing.T) {
+		_, page, err := repo.ListByEventType(ctx, "PROCESS_STARTED", nil)
+		require.NoError(t, err, "查询不应该返回错误")
+		assert.Equal(t, 2, page.Total, "应该有两个流程启动事件")
+	})
+
+	t.Run("按任务查询办理记录", func(t *testing.T) {
+		results, page, err := repo.ListByTaskID(ctx, 7, &biz.QueryOptions{Order: "asc"})
+		require.NoError(t, err, "查询不应该返回错误")
+		assert.Equal(t, 2, page.Total, "任务7应该有两条办理记录")
+		require.Len(t, results, 2, "应该返回全部办理记录")
+		assert.Equal(t, biz.TaskEventClaimed, results[0].EventType, "按发生顺序认领在前")
+		assert.Equal(t, "deputy", results[1].EventData["to"], "应该保留事件数据")
+	})
+
+	t.Run("删除实例事件", func(t *testing.T) {
+		require.NoError(t, repo.DeleteByProcessInstanceID(ctx, "1"), "删除不应该返回错误")
+
+		results, err := repo.ListByProcessInstanceID(ctx, "1")
+		require.NoError(t, err, "查询不应该返回错误")
+		assert.Empty(t, results, "流程实例1的事件应该被删除")
+
+		_, page, err := repo.ListByEventType(ctx, "PROCESS_STARTED", nil)
+		require.NoError(t, err, "查询不应该返回错误")
+		assert.Equal(t, 1, page.Total, "其他实例的事件不受影响")
+	})
+}
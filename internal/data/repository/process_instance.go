@@ -0,0 +1,384 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/processinstance"
+
+	"go.uber.org/zap"
+)
+
+// processInstanceOrderFields 流程实例允许排序的字段
+var processInstanceOrderFields = map[string]string{
+	"start_time":   processinstance.FieldStartTime,
+	"end_time":     processinstance.FieldEndTime,
+	"name":         processinstance.FieldName,
+	"business_key": processinstance.FieldBusinessKey,
+	"created_at":   processinstance.FieldCreatedAt,
+	"updated_at":   processinstance.FieldUpdatedAt,
+}
+
+// processInstanceRepo 流程实例仓储实现
+type processInstanceRepo struct {
+	data   *ent.Client
+	logger *zap.Logger
+}
+
+// NewProcessInstanceRepo 创建流程实例仓储实例
+func NewProcessInstanceRepo(data *ent.Client, logger *zap.Logger) biz.ProcessInstanceRepo {
+	return &processInstanceRepo{
+		data:   data,
+		logger: logger,
+	}
+}
+
+// Create 创建流程实例
+func (r *processInstanceRepo) Create(ctx context.Context, pi *ent.ProcessInstance) (*ent.ProcessInstance, error) {
+	r.logger.Info("创建流程实例",
+		zap.String("process_definition_key", pi.ProcessDefinitionKey),
+		zap.String("business_key", pi.BusinessKey))
+
+	startTime := pi.StartTime
+	if startTime.IsZero() {
+		startTime = time.Now()
+	}
+
+	create := entClient(ctx, r.data).ProcessInstance.Create().
+		SetBusinessKey(pi.BusinessKey).
+		SetProcessDefinitionID(pi.ProcessDefinitionID).
+		SetProcessDefinitionKey(pi.ProcessDefinitionKey).
+		SetProcessDefinitionName(pi.ProcessDefinitionName).
+		SetProcessDefinitionVersion(pi.ProcessDefinitionVersion).
+		SetDeploymentID(pi.DeploymentID).
+		SetStartUserID(pi.StartUserID).
+		SetStartTime(startTime).
+		SetNillableEndTime(pi.EndTime).
+		SetDuration(pi.Duration).
+		SetDeleteReason(pi.DeleteReason).
+		SetSuperProcessInstanceID(pi.SuperProcessInstanceID).
+		SetRootProcessInstanceID(pi.RootProcessInstanceID).
+		SetSuspended(pi.Suspended).
+		SetName(pi.Name).
+		SetDescription(pi.Description).
+		SetCallbackID(pi.CallbackID).
+		SetCallbackType(pi.CallbackType).
+		SetReferenceID(pi.ReferenceID).
+		SetReferenceType(pi.ReferenceType).
+		SetWorkflowID(pi.WorkflowID).
+		SetWorkflowRunID(pi.WorkflowRunID)
+	if pi.TenantID != "" {
+		create = create.SetTenantID(pi.TenantID)
+	}
+
+	result, err := create.Save(ctx)
+	if err != nil {
+		r.logger.Error("创建流程实例失败", zap.Error(err))
+		return nil, fmt.Errorf("创建流程实例失败: %w", err)
+	}
+
+	r.logger.Info("流程实例创建成功", zap.String("id", strconv.FormatInt(result.ID, 10)))
+	return result, nil
+}
+
+// GetByID 根据ID获取流程实例
+func (r *processInstanceRepo) GetByID(ctx context.Context, id string) (*ent.ProcessInstance, error) {
+	r.logger.Debug("根据ID获取流程实例", zap.String("id", id))
+
+	idInt, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("无效的流程实例ID: %s", id)
+	}
+
+	result, err := entClient(ctx, r.data).ProcessInstance.Get(ctx, idInt)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			r.logger.Warn("流程实例不存在", zap.String("id", id))
+			return nil, fmt.Errorf("流程实例不存在: %s", id)
+		}
+		r.logger.Error("获取流程实例失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("获取流程实例失败: %w", err)
+	}
+
+	return result, nil
+}
+
+// Update 更新流程实例
+func (r *processInstanceRepo) Update(ctx context.Context, pi *ent.ProcessInstance) (*ent.ProcessInstance, error) {
+	r.logger.Info("更新流程实例", zap.String("id", strconv.FormatInt(pi.ID, 10)))
+
+	update := entClient(ctx, r.data).ProcessInstance.
+		UpdateOneID(pi.ID).
+		SetBusinessKey(pi.BusinessKey).
+		SetStartUserID(pi.StartUserID).
+		SetDuration(pi.Duration).
+		SetDeleteReason(pi.DeleteReason).
+		SetSuspended(pi.Suspended).
+		SetName(pi.Name).
+		SetDescription(pi.Description).
+		SetWorkflowID(pi.WorkflowID).
+		SetWorkflowRunID(pi.WorkflowRunID)
+	if pi.EndTime != nil {
+		update = update.SetEndTime(*pi.EndTime)
+	} else {
+		update = update.ClearEndTime()
+	}
+
+	result, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("流程实例不存在: %d", pi.ID)
+		}
+		r.logger.Error("更新流程实例失败", zap.String("id", strconv.FormatInt(pi.ID, 10)), zap.Error(err))
+		return nil, fmt.Errorf("更新流程实例失败: %w", err)
+	}
+
+	r.logger.Info("流程实例更新成功", zap.String("id", strconv.FormatInt(result.ID, 10)))
+	return result, nil
+}
+
+// Delete 删除流程实例
+func (r *processInstanceRepo) Delete(ctx context.Context, id string) error {
+	r.logger.Info("删除流程实例", zap.String("id", id))
+
+	idInt, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return fmt.Errorf("无效的流程实例ID: %s", id)
+	}
+
+	if err := entClient(ctx, r.data).ProcessInstance.DeleteOneID(idInt).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			r.logger.Warn("流程实例不存在", zap.String("id", id))
+			return fmt.Errorf("流程实例不存在: %s", id)
+		}
+		r.logger.Error("删除流程实例失败", zap.String("id", id), zap.Error(err))
+		return fmt.Errorf("删除流程实例失败: %w", err)
+	}
+
+	r.logger.Info("流程实例删除成功", zap.String("id", id))
+	return nil
+}
+
+// List 分页查询流程实例
+func (r *processInstanceRepo) List(ctx context.Context, filter *biz.ProcessInstanceFilter, opts *biz.QueryOptions) ([]*ent.ProcessInstance, *biz.PaginationResult, error) {
+	r.logger.Debug("分页查询流程实例",
+		zap.Any("filter", filter),
+		zap.Any("options", opts))
+
+	query, err := r.filter(entClient(ctx, r.data).ProcessInstance.Query(), filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	return r.page(ctx, query, opts)
+}
+
+// Count 计数查询
+func (r *processInstanceRepo) Count(ctx context.Context, filter *biz.ProcessInstanceFilter) (int, error) {
+	r.logger.Debug("计数查询流程实例", zap.Any("filter", filter))
+
+	query, err := r.filter(entClient(ctx, r.data).ProcessInstance.Query(), filter)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		r.logger.Error("计数查询流程实例失败", zap.Error(err))
+		return 0, fmt.Errorf("计数查询流程实例失败: %w", err)
+	}
+	return count, nil
+}
+
+// ListByProcessDefinitionID 根据流程定义ID查询流程实例
+func (r *processInstanceRepo) ListByProcessDefinitionID(ctx context.Context, processDefinitionID string, opts *biz.QueryOptions) ([]*ent.ProcessInstance, *biz.PaginationResult, error) {
+	r.logger.Debug("根据流程定义ID查询流程实例", zap.String("process_definition_id", processDefinitionID))
+
+	return r.List(ctx, &biz.ProcessInstanceFilter{ProcessDefinitionID: processDefinitionID}, opts)
+}
+
+// Suspend 挂起流程实例
+func (r *processInstanceRepo) Suspend(ctx context.Context, id string) error {
+	r.logger.Info("挂起流程实例", zap.String("id", id))
+	return r.setSuspended(ctx, id, true)
+}
+
+// Activate 激活流程实例
+func (r *processInstanceRepo) Activate(ctx context.Context, id string) error {
+	r.logger.Info("激活流程实例", zap.String("id", id))
+	return r.setSuspended(ctx, id, false)
+}
+
+// Terminate 终止流程实例
+// 记录结束时间、持续时间与终止原因，已结束的流程实例不能再次终止
+func (r *processInstanceRepo) Terminate(ctx context.Context, id string, reason string) error {
+	r.logger.Info("终止流程实例", zap.String("id", id), zap.String("reason", reason))
+
+	instance, err := r.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if instance.EndTime != nil {
+		return fmt.Errorf("流程实例已结束: %s", id)
+	}
+
+	now := time.Now()
+	affected, err := entClient(ctx, r.data).ProcessInstance.Update().
+		Where(
+			processinstance.ID(instance.ID),
+			processinstance.EndTimeIsNil(),
+		).
+		SetEndTime(now).
+		SetDuration(now.Sub(instance.StartTime).Milliseconds()).
+		SetDeleteReason(reason).
+		SetSuspended(false).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("终止流程实例失败", zap.String("id", id), zap.Error(err))
+		return fmt.Errorf("终止流程实例失败: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("流程实例已结束: %s", id)
+	}
+
+	r.logger.Info("流程实例终止成功", zap.String("id", id))
+	return nil
+}
+
+// Migrate 将流程实例迁移到指定的流程定义版本，已结束的流程实例不能迁移
+func (r *processInstanceRepo) Migrate(ctx context.Context, id string, pd *ent.ProcessDefinition) error {
+	r.logger.Info("迁移流程实例", zap.String("id", id), zap.Int64("process_definition_id", pd.ID))
+
+	idInt, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return fmt.Errorf("无效的流程实例ID: %s", id)
+	}
+
+	affected, err := entClient(ctx, r.data).ProcessInstance.Update().
+		Where(
+			processinstance.ID(idInt),
+			processinstance.EndTimeIsNil(),
+		).
+		SetProcessDefinitionID(pd.ID).
+		SetProcessDefinitionName(pd.Name).
+		SetProcessDefinitionVersion(pd.Version).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("迁移流程实例失败", zap.String("id", id), zap.Error(err))
+		return fmt.Errorf("迁移流程实例失败: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("流程实例已结束: %s", id)
+	}
+
+	r.logger.Info("流程实例迁移成功", zap.String("id", id))
+	return nil
+}
+
+// setSuspended 设置流程实例的挂起状态，已结束的流程实例不能挂起或激活
+func (r *processInstanceRepo) setSuspended(ctx context.Context, id string, suspended bool) error {
+	idInt, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return fmt.Errorf("无效的流程实例ID: %s", id)
+	}
+
+	affected, err := entClient(ctx, r.data).ProcessInstance.Update().
+		Where(
+			processinstance.ID(idInt),
+			processinstance.EndTimeIsNil(),
+		).
+		SetSuspended(suspended).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("更新流程实例挂起状态失败", zap.String("id", id), zap.Error(err))
+		return fmt.Errorf("更新流程实例挂起状态失败: %w", err)
+	}
+	if affected == 0 {
+		exists, err := entClient(ctx, r.data).ProcessInstance.Query().Where(processinstance.ID(idInt)).Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("查询流程实例失败: %w", err)
+		}
+		if !exists {
+			return fmt.Errorf("流程实例不存在: %s", id)
+		}
+		return fmt.Errorf("流程实例已结束: %s", id)
+	}
+	return nil
+}
+
+// filter 应用流程实例过滤条件
+// 状态取值：active（运行中）、suspended（已挂起）、completed/ended（已结束）
+func (r *processInstanceRepo) filter(query *ent.ProcessInstanceQuery, filter *biz.ProcessInstanceFilter) (*ent.ProcessInstanceQuery, error) {
+	if filter == nil {
+		return query, nil
+	}
+	if filter.ProcessDefinitionID != "" {
+		definitionID, err := strconv.ParseInt(filter.ProcessDefinitionID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("无效的流程定义ID: %s", filter.ProcessDefinitionID)
+		}
+		query = query.Where(processinstance.ProcessDefinitionID(definitionID))
+	}
+	switch filter.Status {
+	case "":
+	case "active":
+		query = query.Where(processinstance.EndTimeIsNil(), processinstance.Suspended(false))
+	case "suspended":
+		query = query.Where(processinstance.EndTimeIsNil(), processinstance.Suspended(true))
+	case "completed", "ended":
+		query = query.Where(processinstance.EndTimeNotNil())
+	default:
+		return nil, fmt.Errorf("无效的流程实例状态: %s", filter.Status)
+	}
+	if filter.CreatedBy != "" {
+		query = query.Where(processinstance.StartUserID(filter.CreatedBy))
+	}
+	if filter.StartedFrom != nil {
+		query = query.Where(processinstance.StartTimeGTE(*filter.StartedFrom))
+	}
+	if filter.StartedTo != nil {
+		query = query.Where(processinstance.StartTimeLTE(*filter.StartedTo))
+	}
+	if filter.Access != nil {
+		query = query.Where(accessPredicate(filter.Access, accessColumns{
+			key:          processinstance.FieldProcessDefinitionKey,
+			tenant:       processinstance.FieldTenantID,
+			definitionID: processinstance.FieldProcessDefinitionID,
+		}, userColumn(processinstance.FieldStartUserID, filter.Access.User)))
+	}
+	return query, nil
+}
+
+// page 应用搜索、排序与分页
+func (r *processInstanceRepo) page(ctx context.Context, query *ent.ProcessInstanceQuery, opts *biz.QueryOptions) ([]*ent.ProcessInstance, *biz.PaginationResult, error) {
+	if opts != nil && opts.Search != "" {
+		query = query.Where(processinstance.Or(
+			processinstance.NameContainsFold(opts.Search),
+			processinstance.BusinessKeyContainsFold(opts.Search),
+			processinstance.ProcessDefinitionKeyContainsFold(opts.Search),
+			processinstance.ProcessDefinitionNameContainsFold(opts.Search),
+		))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.logger.Error("查询流程实例总数失败", zap.Error(err))
+		return nil, nil, fmt.Errorf("查询流程实例总数失败: %w", err)
+	}
+
+	query = query.Order(orderBy(opts, processInstanceOrderFields, processinstance.FieldStartTime), ent.Desc(processinstance.FieldID))
+	if opts != nil && opts.Page > 0 && opts.PageSize > 0 {
+		query = query.Offset((opts.Page - 1) * opts.PageSize).Limit(opts.PageSize)
+	}
+
+	results, err := query.All(ctx)
+	if err != nil {
+		r.logger.Error("查询流程实例失败", zap.Error(err))
+		return nil, nil, fmt.Errorf("查询流程实例失败: %w", err)
+	}
+
+	return results, pagination(total, opts), nil
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// TestProcessInstanceRepo 测试流程实例仓储
+func TestProcessInstanceRepo(t *testing.T) {
+	ctx := context.Background()
+	repo := NewProcessInstanceRepo(newTestClient(t), zap.NewNop())
+
+	base := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	seed := []*ent.ProcessInstance{
+		{Name: "请假申请-张三", BusinessKey: "LEAVE-001", ProcessDefinitionID: 1, ProcessDefinitionKey: "leave", StartUserID: "zhangsan", StartTime: base},
+		{Name: "请假申请-李四", BusinessKey: "LEAVE-002", ProcessDefinitionID: 1, ProcessDefinitionKey: "leave", StartUserID: "lisi", StartTime: base.Add(time.Hour)},
+		{Name: "报销申请-张三", BusinessKey: "EXP-001", ProcessDefinitionID: 2, ProcessDefinitionKey: "expense", StartUserID: "zhangsan", StartTime: base.Add(2 * time.Hour)},
+	}
+	var ids []string
+	for _, pi := range seed {
+		created, err := repo.Create(ctx, pi)
+		require.NoError(t, err, "创建流程实例不应该返回错误")
+		ids = append(ids, strconv.FormatInt(created.ID, 10))
+	}
+
+	t.Run("根据ID获取流程实例", func(t *testing.T) {
+		result, err := repo.GetByID(ctx, ids[0])
+		require.NoError(t, err, "获取流程实例不应该返回错误")
+		assert.Equal(t, "LEAVE-001", result.BusinessKey, "业务键应该匹配")
+
+		_, err = repo.GetByID(ctx, "999")
+		assert.Error(t, err, "不存在的流程实例应该返回错误")
+	})
+
+	t.Run("按流程定义过滤并分页排序", func(t *testing.T) {
+		results, page, err := repo.ListByProcessDefinitionID(ctx, "1", &biz.QueryOptions{
+			Page: 1, PageSize: 1, OrderBy: "start_time", Order: "asc",
+		})
+		require.NoError(t, err, "分页查询不应该返回错误")
+		assert.Equal(t, 2, page.Total, "总数应该只统计过滤后的实例")
+		assert.Equal(t, 2, page.Pages, "总页数应该匹配")
+		require.Len(t, results, 1, "每页应该只返回一条")
+		assert.Equal(t, "LEAVE-001", results[0].BusinessKey, "升序时最早启动的实例在前")
+	})
+
+	t.Run("关键字搜索不区分大小写", func(t *testing.T) {
+		results, page, err := repo.List(ctx, nil, &biz.QueryOptions{Search: "exp"})
+		require.NoError(t, err, "搜索不应该返回错误")
+		assert.Equal(t, 1, page.Total, "应该只匹配报销流程")
+		assert.Equal(t, "EXP-001", results[0].BusinessKey, "业务键应该匹配")
+	})
+
+	t.Run("按启动人和启动时间过滤", func(t *testing.T) {
+		from := base.Add(30 * time.Minute)
+		count, err := repo.Count(ctx, &biz.ProcessInstanceFilter{CreatedBy: "zhangsan", StartedFrom: &from})
+		require.NoError(t, err, "计数查询不应该返回错误")
+		assert.Equal(t, 1, count, "应该只统计时间范围内张三启动的实例")
+	})
+
+	t.Run("挂起与激活", func(t *testing.T) {
+		require.NoError(t, repo.Suspend(ctx, ids[1]), "挂起不应该返回错误")
+
+		count, err := repo.Count(ctx, &biz.ProcessInstanceFilter{Status: "suspended"})
+		require.NoError(t, err, "计数查询不应该返回错误")
+		assert.Equal(t, 1, count, "应该有一个挂起的实例")
+
+		require.NoError(t, repo.Activate(ctx, ids[1]), "激活不应该返回错误")
+		result, err := repo.GetByID(ctx, ids[1])
+		require.NoError(t, err, "获取流程实例不应该返回错误")
+		assert.False(t, result.Suspended, "激活后不应该处于挂起状态")
+	})
+
+	t.Run("终止后不能再挂起", func(t *testing.T) {
+		require.NoError(t, repo.Terminate(ctx, ids[2], "用户撤回"), "终止不应该返回错误")
+
+		result, err := repo.GetByID(ctx, ids[2])
+		require.NoError(t, err, "获取流程实例不应该返回错误")
+		assert.NotNil(t, result.EndTime, "终止后应该有结束时间")
+		assert.Equal(t, "用户撤回", result.DeleteReason, "终止原因应该匹配")
+
+		assert.Error(t, repo.Suspend(ctx, ids[2]), "已结束的实例不能挂起")
+		assert.Error(t, repo.Terminate(ctx, ids[2], "重复终止"), "已结束的实例不能重复终止")
+
+		count, err := repo.Count(ctx, &biz.ProcessInstanceFilter{Status: "completed"})
+		require.NoError(t, err, "计数查询不应该返回错误")
+		assert.Equal(t, 1, count, "应该有一个已结束的实例")
+	})
+
+	t.Run("不支持的状态过滤", func(t *testing.T) {
+		_, err := repo.Count(ctx, &biz.ProcessInstanceFilter{Status: "unknown"})
+		assert.Error(t, err, "未知状态应该返回错误")
+	})
+
+	t.Run("删除流程实例", func(t *testing.T) {
+		require.NoError(t, repo.Delete(ctx, ids[0]), "删除不应该返回错误")
+		_, err := repo.GetByID(ctx, ids[0])
+		assert.Error(t, err, "删除后应该查询不到")
+	})
+}
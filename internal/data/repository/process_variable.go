@@ -0,0 +1,284 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/processvariable"
+
+	"go.uber.org/zap"
+)
+
+// processVariableRepo 流程变量仓储实现
+type processVariableRepo struct {
+	data   *ent.Client
+	logger *zap.Logger
+}
+
+// NewProcessVariableRepo 创建流程变量仓储实例
+func NewProcessVariableRepo(data *ent.Client, logger *zap.Logger) biz.ProcessVariableRepo {
+	return &processVariableRepo{
+		data:   data,
+		logger: logger,
+	}
+}
+
+// Create 创建流程变量
+func (r *processVariableRepo) Create(ctx context.Context, pv *ent.ProcessVariable) (*ent.ProcessVariable, error) {
+	r.logger.Debug("创建流程变量",
+		zap.String("name", pv.Name),
+		zap.Int64("process_instance_id", pv.ProcessInstanceID))
+
+	create := entClient(ctx, r.data).ProcessVariable.Create().
+		SetName(pv.Name).
+		SetType(pv.Type).
+		SetTextValue(pv.TextValue).
+		SetTextValue2(pv.TextValue2).
+		SetLongValue(pv.LongValue).
+		SetDoubleValue(pv.DoubleValue).
+		SetExecutionID(pv.ExecutionID).
+		SetProcessInstanceID(pv.ProcessInstanceID).
+		SetProcessDefinitionID(pv.ProcessDefinitionID).
+		SetTaskID(pv.TaskID).
+		SetActivityInstanceID(pv.ActivityInstanceID).
+		SetConcurrentLocal(pv.ConcurrentLocal).
+		SetScopeID(pv.ScopeID).
+		SetScopeType(pv.ScopeType)
+	if pv.ByteArrayValue != nil {
+		create = create.SetByteArrayValue(pv.ByteArrayValue)
+	}
+	if pv.TenantID != "" {
+		create = create.SetTenantID(pv.TenantID)
+	}
+
+	result, err := create.Save(ctx)
+	if err != nil {
+		r.logger.Error("创建流程变量失败", zap.String("name", pv.Name), zap.Error(err))
+		return nil, fmt.Errorf("创建流程变量失败: %w", err)
+	}
+	return result, nil
+}
+
+// GetByID 根据ID获取流程变量
+func (r *processVariableRepo) GetByID(ctx context.Context, id string) (*ent.ProcessVariable, error) {
+	idInt, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("无效的流程变量ID: %s", id)
+	}
+
+	result, err := entClient(ctx, r.data).ProcessVariable.Get(ctx, idInt)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			r.logger.Warn("流程变量不存在", zap.String("id", id))
+			return nil, fmt.Errorf("流程变量不存在: %s", id)
+		}
+		r.logger.Error("获取流程变量失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("获取流程变量失败: %w", err)
+	}
+	return result, nil
+}
+
+// Update 更新流程变量的类型与取值，每次更新递增序列计数器
+func (r *processVariableRepo) Update(ctx context.Context, pv *ent.ProcessVariable) (*ent.ProcessVariable, error) {
+	r.logger.Debug("更新流程变量", zap.Int64("id", pv.ID), zap.String("name", pv.Name))
+
+	update := entClient(ctx, r.data).ProcessVariable.
+		UpdateOneID(pv.ID).
+		SetType(pv.Type).
+		SetTextValue(pv.TextValue).
+		SetTextValue2(pv.TextValue2).
+		SetLongValue(pv.LongValue).
+		SetDoubleValue(pv.DoubleValue).
+		AddSequenceCounter(1)
+	if pv.ByteArrayValue != nil {
+		update = update.SetByteArrayValue(pv.ByteArrayValue)
+	} else {
+		update = update.ClearByteArrayValue()
+	}
+
+	result, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("流程变量不存在: %d", pv.ID)
+		}
+		r.logger.Error("更新流程变量失败", zap.Int64("id", pv.ID), zap.Error(err))
+		return nil, fmt.Errorf("更新流程变量失败: %w", err)
+	}
+	return result, nil
+}
+
+// Delete 删除流程变量
+func (r *processVariableRepo) Delete(ctx context.Context, id string) error {
+	idInt, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return fmt.Errorf("无效的流程变量ID: %s", id)
+	}
+
+	if err := entClient(ctx, r.data).ProcessVariable.DeleteOneID(idInt).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("流程变量不存在: %s", id)
+		}
+		r.logger.Error("删除流程变量失败", zap.String("id", id), zap.Error(err))
+		return fmt.Errorf("删除流程变量失败: %w", err)
+	}
+	return nil
+}
+
+// GetByProcessInstanceIDAndName 根据流程实例ID和变量名获取流程级变量
+func (r *processVariableRepo) GetByProcessInstanceIDAndName(ctx context.Context, processInstanceID, name string) (*ent.ProcessVariable, error) {
+	instanceID, err := strconv.ParseInt(processInstanceID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("无效的流程实例ID: %s", processInstanceID)
+	}
+
+	result, err := entClient(ctx, r.data).ProcessVariable.Query().
+		Where(
+			processvariable.ProcessInstanceID(instanceID),
+			processvariable.Name(name),
+			processVariableOfInstance(),
+		).
+		Order(ent.Desc(processvariable.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("流程变量不存在: %s", name)
+		}
+		r.logger.Error("获取流程变量失败", zap.String("name", name), zap.Error(err))
+		return nil, fmt.Errorf("获取流程变量失败: %w", err)
+	}
+	return result, nil
+}
+
+// ListByProcessInstanceID 根据流程实例ID获取全部流程级变量，按变量名排序
+func (r *processVariableRepo) ListByProcessInstanceID(ctx context.Context, processInstanceID string) ([]*ent.ProcessVariable, error) {
+	instanceID, err := strconv.ParseInt(processInstanceID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("无效的流程实例ID: %s", processInstanceID)
+	}
+
+	results, err := entClient(ctx, r.data).ProcessVariable.Query().
+		Where(
+			processvariable.ProcessInstanceID(instanceID),
+			processVariableOfInstance(),
+		).
+		Order(ent.Asc(processvariable.FieldName), ent.Asc(processvariable.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("查询流程变量失败", zap.String("process_instance_id", processInstanceID), zap.Error(err))
+		return nil, fmt.Errorf("查询流程变量失败: %w", err)
+	}
+	return results, nil
+}
+
+// ListByTaskID 根据任务ID获取任务局部变量，按写入顺序排序
+func (r *processVariableRepo) ListByTaskID(ctx context.Context, taskID int64) ([]*ent.ProcessVariable, error) {
+	results, err := entClient(ctx, r.data).ProcessVariable.Query().
+		Where(processvariable.TaskID(taskID)).
+		Order(ent.Asc(processvariable.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("查询任务变量失败", zap.Int64("task_id", taskID), zap.Error(err))
+		return nil, fmt.Errorf("查询任务变量失败: %w", err)
+	}
+	return results, nil
+}
+
+// SetVariables 批量设置流程变量
+// 已存在的同名变量更新取值，不存在的新建；按变量名顺序写入以保证结果确定
+func (r *processVariableRepo) SetVariables(ctx context.Context, processInstanceID string, variables map[string]interface{}) error {
+	instanceID, err := strconv.ParseInt(processInstanceID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("无效的流程实例ID: %s", processInstanceID)
+	}
+
+	existing, err := r.ListByProcessInstanceID(ctx, processInstanceID)
+	if err != nil {
+		return err
+	}
+	byName := make(map[string]*ent.ProcessVariable, len(existing))
+	for _, v := range existing {
+		byName[v.Name] = v
+	}
+
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		pv := &ent.ProcessVariable{Name: name, ProcessInstanceID: instanceID}
+		if err := encodeVariable(pv, variables[name]); err != nil {
+			return fmt.Errorf("序列化流程变量 %s 失败: %w", name, err)
+		}
+		if current, ok := byName[name]; ok {
+			pv.ID = current.ID
+			_, err = r.Update(ctx, pv)
+		} else {
+			_, err = r.Create(ctx, pv)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteByProcessInstanceID 删除流程实例的所有变量
+func (r *processVariableRepo) DeleteByProcessInstanceID(ctx context.Context, processInstanceID string) error {
+	instanceID, err := strconv.ParseInt(processInstanceID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("无效的流程实例ID: %s", processInstanceID)
+	}
+
+	if _, err := entClient(ctx, r.data).ProcessVariable.Delete().
+		Where(processvariable.ProcessInstanceID(instanceID)).
+		Exec(ctx); err != nil {
+		r.logger.Error("删除流程实例变量失败", zap.String("process_instance_id", processInstanceID), zap.Error(err))
+		return fmt.Errorf("删除流程实例变量失败: %w", err)
+	}
+	return nil
+}
+
+// processVariableOfInstance 筛选流程级变量，排除任务局部变量
+func processVariableOfInstance() func(*sql.Selector) {
+	return processvariable.Or(processvariable.TaskIDIsNil(), processvariable.TaskID(0))
+}
+
+// encodeVariable 按取值类型写入变量的类型与值字段
+// 类型名称与业务层读取变量时的约定一致：string、integer、double、boolean、date、json
+func encodeVariable(pv *ent.ProcessVariable, value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		pv.Type, pv.TextValue = "string", v
+	case int:
+		pv.Type, pv.LongValue = "integer", int64(v)
+	case int32:
+		pv.Type, pv.LongValue = "integer", int64(v)
+	case int64:
+		pv.Type, pv.LongValue = "integer", v
+	case float32:
+		pv.Type, pv.DoubleValue = "double", float64(v)
+	case float64:
+		pv.Type, pv.DoubleValue = "double", v
+	case bool:
+		pv.Type, pv.TextValue = "boolean", strconv.FormatBool(v)
+	case time.Time:
+		pv.Type, pv.TextValue = "date", v.Format(time.RFC3339Nano)
+	default:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		pv.Type, pv.TextValue = "json", string(data)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// TestProcessVariableRepo 测试流程变量仓储
+func TestProcessVariableRepo(t *testing.T) {
+	ctx := context.Background()
+	repo := NewProcessVariableRepo(newTestClient(t), zap.NewNop())
+
+	t.Run("批量设置变量按类型编码", func(t *testing.T) {
+		err := repo.SetVariables(ctx, "1", map[string]interface{}{
+			"applicant": "张三",
+			"days":      3,
+			"amount":    1200.5,
+			"urgent":    false,
+			"items":     []string{"机票", "酒店"},
+		})
+		require.NoError(t, err, "设置变量不应该返回错误")
+
+		results, err := repo.ListByProcessInstanceID(ctx, "1")
+		require.NoError(t, err, "查询变量不应该返回错误")
+		require.Len(t, results, 5, "应该有五个变量")
+
+		byName := make(map[string]string)
+		for _, v := range results {
+			byName[v.Name] = v.Type
+		}
+		assert.Equal(t, "string", byName["applicant"], "字符串类型应该匹配")
+		assert.Equal(t, "integer", byName["days"], "整数类型应该匹配")
+		assert.Equal(t, "double", byName["amount"], "浮点类型应该匹配")
+		assert.Equal(t, "boolean", byName["urgent"], "布尔类型应该匹配")
+		assert.Equal(t, "json", byName["items"], "复杂类型应该序列化为JSON")
+	})
+
+	t.Run("同名变量更新而不是新增", func(t *testing.T) {
+		require.NoError(t, repo.SetVariables(ctx, "1", map[string]interface{}{"days": 5}), "更新变量不应该返回错误")
+
+		results, err := repo.ListByProcessInstanceID(ctx, "1")
+		require.NoError(t, err, "查询变量不应该返回错误")
+		assert.Len(t, results, 5, "变量数量不应该变化")
+
+		days, err := repo.GetByProcessInstanceIDAndName(ctx, "1", "days")
+		require.NoError(t, err, "获取变量不应该返回错误")
+		assert.Equal(t, int64(5), days.LongValue, "变量值应该被更新")
+		assert.Equal(t, int32(2), days.SequenceCounter, "更新应该递增序列计数器")
+	})
+
+	t.Run("删除流程实例变量", func(t *testing.T) {
+		require.NoError(t, repo.SetVariables(ctx, "2", map[string]interface{}{"other": "x"}), "设置变量不应该返回错误")
+		require.NoError(t, repo.DeleteByProcessInstanceID(ctx, "1"), "删除变量不应该返回错误")
+
+		results, err := repo.ListByProcessInstanceID(ctx, "1")
+		require.NoError(t, err, "查询变量不应该返回错误")
+		assert.Empty(t, results, "流程实例1的变量应该被删除")
+
+		results, err = repo.ListByProcessInstanceID(ctx, "2")
+		require.NoError(t, err, "查询变量不应该返回错误")
+		assert.Len(t, results, 1, "其他流程实例的变量不受影响")
+	})
+
+	t.Run("任务局部变量与流程级变量分开查询", func(t *testing.T) {
+		_, err := repo.Create(ctx, &ent.ProcessVariable{Name: "checked", Type: "boolean", TextValue: "true", ProcessInstanceID: 3, TaskID: 9})
+		require.NoError(t, err, "创建任务变量不应该返回错误")
+		require.NoError(t, repo.SetVariables(ctx, "3", map[string]interface{}{"checked": false}), "设置变量不应该返回错误")
+
+		results, err := repo.ListByTaskID(ctx, 9)
+		require.NoError(t, err, "查询任务变量不应该返回错误")
+		require.Len(t, results, 1, "任务应该只有一个局部变量")
+		assert.Equal(t, "true", results[0].TextValue, "任务变量不受流程级变量影响")
+
+		results, err = repo.ListByProcessInstanceID(ctx, "3")
+		require.NoError(t, err, "查询变量不应该返回错误")
+		require.Len(t, results, 1, "流程级变量不包含任务局部变量")
+		assert.Equal(t, "false", results[0].TextValue, "流程级变量值应该匹配")
+	})
+
+	t.Run("无效的流程实例ID", func(t *testing.T) {
+		assert.Error(t, repo.SetVariables(ctx, "abc", map[string]interface{}{"x": 1}), "非数字ID应该返回错误")
+	})
+}
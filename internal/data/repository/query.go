@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/processdefinition"
+)
+
+// orderBy 根据查询选项生成排序条件
+// 排序字段需在 fields 中声明，未声明或未指定时按 defaultField 倒序
+func orderBy(opts *biz.QueryOptions, fields map[string]string, defaultField string) func(*sql.Selector) {
+	if opts != nil {
+		if field, ok := fields[opts.OrderBy]; ok {
+			if opts.Order == "asc" {
+				return ent.Asc(field)
+			}
+			return ent.Desc(field)
+		}
+	}
+	return ent.Desc(defaultField)
+}
+
+// pagination 构建分页结果
+func pagination(total int, opts *biz.QueryOptions) *biz.PaginationResult {
+	result := &biz.PaginationResult{Total: total}
+	if opts != nil && opts.PageSize > 0 {
+		result.Page = opts.Page
+		result.PageSize = opts.PageSize
+		result.Pages = (total + opts.PageSize - 1) / opts.PageSize
+	}
+	return result
+}
+
+// accessColumns 流程资源表上参与授权过滤的列
+// category 为空时按 definitionID 列关联流程定义的分类
+type accessColumns struct {
+	key          string
+	category     string
+	tenant       string
+	definitionID string
+}
+
+// accessPredicate 根据授权过滤条件生成查询谓词
+// 记录属于任一授权范围，或满足任一 involved 条件（调用方直接参与）时可见；两者都没有时不返回任何记录
+func accessPredicate(access *biz.AccessFilter, cols accessColumns, involved ...func(*sql.Selector) *sql.Predicate) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		var matches []*sql.Predicate
+		for _, scope := range access.Scopes {
+			var conds []*sql.Predicate
+			if scope.ProcessDefinitionKey != "" {
+				conds = append(conds, sql.EQ(s.C(cols.key), scope.ProcessDefinitionKey))
+			}
+			if scope.Category != "" {
+				if cols.category != "" {
+					conds = append(conds, sql.EQ(s.C(cols.category), scope.Category))
+				} else {
+					t := sql.Table(processdefinition.Table)
+					conds = append(conds, sql.In(s.C(cols.definitionID),
+						sql.Select(t.C(processdefinition.FieldID)).
+							From(t).
+							Where(sql.EQ(t.C(processdefinition.FieldCategory), scope.Category)),
+					))
+				}
+			}
+			if scope.TenantID != "" {
+				conds = append(conds, sql.EQ(s.C(cols.tenant), scope.TenantID))
+			}
+			if len(conds) == 0 {
+				// 不限范围的授权
+				return
+			}
+			matches = append(matches, sql.And(conds...))
+		}
+		for _, p := range involved {
+			if pred := p(s); pred != nil {
+				matches = append(matches, pred)
+			}
+		}
+		if len(matches) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.Or(matches...))
+	}
+}
+
+// userColumn 匹配指定列为该用户的记录，例如发起人、办理人
+func userColumn(column, user string) func(*sql.Selector) *sql.Predicate {
+	return func(s *sql.Selector) *sql.Predicate {
+		if user == "" {
+			return nil
+		}
+		return sql.EQ(s.C(column), user)
+	}
+}
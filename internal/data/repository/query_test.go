@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestClient 创建基于内存SQLite的ent客户端，每个测试使用独立数据库
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name())
+	client := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// TestPagination 测试分页结果计算
+func TestPagination(t *testing.T) {
+	t.Run("按页大小计算总页数", func(t *testing.T) {
+		result := pagination(11, &biz.QueryOptions{Page: 2, PageSize: 5})
+		assert.Equal(t, 11, result.Total, "总数应该匹配")
+		assert.Equal(t, 2, result.Page, "页码应该匹配")
+		assert.Equal(t, 3, result.Pages, "总页数应该向上取整")
+	})
+
+	t.Run("未分页时只返回总数", func(t *testing.T) {
+		result := pagination(7, nil)
+		assert.Equal(t, 7, result.Total, "总数应该匹配")
+		assert.Equal(t, 0, result.Pages, "未分页时总页数应该为0")
+	})
+}
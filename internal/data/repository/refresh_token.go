@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/predicate"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/refreshtoken"
+
+	"go.uber.org/zap"
+)
+
+// refreshTokenRepo 刷新令牌仓储实现
+type refreshTokenRepo struct {
+	data   *ent.Client
+	logger *zap.Logger
+}
+
+// NewRefreshTokenRepo 创建刷新令牌仓储实例
+func NewRefreshTokenRepo(data *ent.Client, logger *zap.Logger) biz.RefreshTokenRepo {
+	return &refreshTokenRepo{
+		data:   data,
+		logger: logger,
+	}
+}
+
+// Create 记录签发的刷新令牌
+func (r *refreshTokenRepo) Create(ctx context.Context, rt *ent.RefreshToken) (*ent.RefreshToken, error) {
+	result, err := entClient(ctx, r.data).RefreshToken.Create().
+		SetTokenID(rt.TokenID).
+		SetFamilyID(rt.FamilyID).
+		SetUserID(rt.UserID).
+		SetParentTokenID(rt.ParentTokenID).
+		SetAccessTokenID(rt.AccessTokenID).
+		SetAccessExpiresAt(rt.AccessExpiresAt).
+		SetExpiresAt(rt.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("记录刷新令牌失败", zap.Int64("user_id", rt.UserID), zap.String("family_id", rt.FamilyID), zap.Error(err))
+		return nil, fmt.Errorf("记录刷新令牌失败: %w", err)
+	}
+	return result, nil
+}
+
+// GetByTokenID 根据 jti 获取刷新令牌记录
+func (r *refreshTokenRepo) GetByTokenID(ctx context.Context, tokenID string) (*ent.RefreshToken, error) {
+	result, err := entClient(ctx, r.data).RefreshToken.Query().
+		Where(refreshtoken.TokenID(tokenID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: 刷新令牌记录不存在", biz.ErrInvalidRefreshToken)
+		}
+		r.logger.Error("获取刷新令牌记录失败", zap.String("token_id", tokenID), zap.Error(err))
+		return nil, fmt.Errorf("获取刷新令牌记录失败: %w", err)
+	}
+	return result, nil
+}
+
+// MarkUsed 将未使用且未吊销的刷新令牌标记为已使用
+// 条件更新保证并发使用同一刷新令牌时只有一个请求成功
+func (r *refreshTokenRepo) MarkUsed(ctx context.Context, tokenID string, at time.Time) (bool, error) {
+	n, err := entClient(ctx, r.data).RefreshToken.Update().
+		Where(
+			refreshtoken.TokenID(tokenID),
+			refreshtoken.UsedAtIsNil(),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetUsedAt(at).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("标记刷新令牌已使用失败", zap.String("token_id", tokenID), zap.Error(err))
+		return false, fmt.Errorf("标记刷新令牌已使用失败: %w", err)
+	}
+	return n == 1, nil
+}
+
+// RevokeFamily 吊销令牌族中的全部刷新令牌
+func (r *refreshTokenRepo) RevokeFamily(ctx context.Context, familyID string, at time.Time) ([]*ent.RefreshToken, error) {
+	return r.revoke(ctx, refreshtoken.FamilyID(familyID), at)
+}
+
+// RevokeByUser 吊销用户的全部刷新令牌
+func (r *refreshTokenRepo) RevokeByUser(ctx context.Context, userID int64, at time.Time) ([]*ent.RefreshToken, error) {
+	return r.revoke(ctx, refreshtoken.UserID(userID), at)
+}
+
+// revoke 吊销满足条件的刷新令牌，返回访问令牌尚未过期的记录供调用方吊销访问令牌
+func (r *refreshTokenRepo) revoke(ctx context.Context, where predicate.RefreshToken, at time.Time) ([]*ent.RefreshToken, error) {
+	client := entClient(ctx, r.data)
+	if err := client.RefreshToken.Update().
+		Where(where, refreshtoken.RevokedAtIsNil()).
+		SetRevokedAt(at).
+		Exec(ctx); err != nil {
+		r.logger.Error("吊销刷新令牌失败", zap.Error(err))
+		return nil, fmt.Errorf("吊销刷新令牌失败: %w", err)
+	}
+
+	results, err := client.RefreshToken.Query().
+		Where(where, refreshtoken.AccessExpiresAtGT(at)).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("查询未过期的访问令牌失败", zap.Error(err))
+		return nil, fmt.Errorf("查询未过期的访问令牌失败: %w", err)
+	}
+	return results, nil
+}
+
+// DeleteExpired 删除刷新令牌在指定时间前已过期的记录
+func (r *refreshTokenRepo) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	n, err := entClient(ctx, r.data).RefreshToken.Delete().
+		Where(refreshtoken.ExpiresAtLT(before)).
+		Exec(ctx)
+	if err != nil {
+		r.logger.Error("删除过期刷新令牌失败", zap.Error(err))
+		return 0, fmt.Errorf("删除过期刷新令牌失败: %w", err)
+	}
+	return n, nil
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// TestRefreshTokenRepo 测试刷新令牌仓储
+func TestRefreshTokenRepo(t *testing.T) {
+	ctx := context.Background()
+	repo := NewRefreshTokenRepo(newTestClient(t), zap.NewNop())
+	now := time.Now()
+
+	create := func(t *testing.T, tokenID, familyID string, userID int64, accessExpiresAt time.Time) {
+		t.Helper()
+		_, err := repo.Create(ctx, &ent.RefreshToken{
+			TokenID:         tokenID,
+			FamilyID:        familyID,
+			UserID:          userID,
+			AccessTokenID:   "access-" + tokenID,
+			AccessExpiresAt: accessExpiresAt,
+			ExpiresAt:       now.Add(time.Hour),
+		})
+		require.NoError(t, err, "记录刷新令牌不应该返回错误")
+	}
+
+	t.Run("刷新令牌只能标记使用一次", func(t *testing.T) {
+		create(t, "rt-1", "family-1", 1, now.Add(time.Minute))
+
+		used, err := repo.MarkUsed(ctx, "rt-1", now)
+		require.NoError(t, err, "标记使用不应该返回错误")
+		assert.True(t, used, "首次使用应该标记成功")
+
+		used, err = repo.MarkUsed(ctx, "rt-1", now)
+		require.NoError(t, err)
+		assert.False(t, used, "重复使用应该标记失败")
+
+		record, err := repo.GetByTokenID(ctx, "rt-1")
+		require.NoError(t, err)
+		assert.NotNil(t, record.UsedAt, "应该记录使用时间")
+
+		_, err = repo.GetByTokenID(ctx, "missing")
+		assert.ErrorIs(t, err, biz.ErrInvalidRefreshToken, "记录不存在时应该返回刷新令牌无效")
+	})
+
+	t.Run("吊销令牌族返回访问令牌未过期的记录", func(t *testing.T) {
+		create(t, "rt-2", "family-2", 1, now.Add(-time.Minute))
+		create(t, "rt-3", "family-2", 1, now.Add(time.Minute))
+
+		records, err := repo.RevokeFamily(ctx, "family-2", now)
+		require.NoError(t, err, "吊销令牌族不应该返回错误")
+		require.Len(t, records, 1, "只返回访问令牌尚未过期的记录")
+		assert.Equal(t, "access-rt-3", records[0].AccessTokenID)
+
+		used, err := repo.MarkUsed(ctx, "rt-3", now)
+		require.NoError(t, err)
+		assert.False(t, used, "已吊销的刷新令牌不能使用")
+
+		record, err := repo.GetByTokenID(ctx, "rt-1")
+		require.NoError(t, err)
+		assert.Nil(t, record.RevokedAt, "其他令牌族不受影响")
+	})
+
+	t.Run("吊销用户的全部令牌", func(t *testing.T) {
+		create(t, "rt-4", "family-4", 2, now.Add(time.Minute))
+		create(t, "rt-5", "family-5", 2, now.Add(time.Minute))
+
+		records, err := repo.RevokeByUser(ctx, 2, now)
+		require.NoError(t, err, "吊销用户令牌不应该返回错误")
+		assert.Len(t, records, 2, "应该返回用户全部会话的记录")
+
+		for _, tokenID := range []string{"rt-4", "rt-5"} {
+			record, err := repo.GetByTokenID(ctx, tokenID)
+			require.NoError(t, err)
+			assert.NotNil(t, record.RevokedAt, "刷新令牌 %s 应该被吊销", tokenID)
+		}
+	})
+
+	t.Run("删除过期记录", func(t *testing.T) {
+		n, err := repo.DeleteExpired(ctx, now.Add(2*time.Hour))
+		require.NoError(t, err, "删除过期记录不应该返回错误")
+		assert.Equal(t, 5, n, "全部记录都已过期")
+	})
+}
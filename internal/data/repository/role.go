@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/group"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/role"
+
+	"go.uber.org/zap"
+)
+
+// roleRepo 角色仓储实现
+type roleRepo struct {
+	data   *ent.Client
+	logger *zap.Logger
+}
+
+// NewRoleRepo 创建角色仓储实例
+func NewRoleRepo(data *ent.Client, logger *zap.Logger) biz.RoleRepo {
+	return &roleRepo{
+		data:   data,
+		logger: logger,
+	}
+}
+
+// Create 创建角色
+func (r *roleRepo) Create(ctx context.Context, ro *ent.Role) (*ent.Role, error) {
+	r.logger.Info("创建角色", zap.String("name", ro.Name))
+
+	result, err := entClient(ctx, r.data).Role.Create().
+		SetName(ro.Name).
+		SetDescription(ro.Description).
+		SetPermissions(ro.Permissions).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("%w: %s", biz.ErrRoleExists, ro.Name)
+		}
+		r.logger.Error("创建角色失败", zap.String("name", ro.Name), zap.Error(err))
+		return nil, fmt.Errorf("创建角色失败: %w", err)
+	}
+	return result, nil
+}
+
+// GetByName 根据名称获取角色
+func (r *roleRepo) GetByName(ctx context.Context, name string) (*ent.Role, error) {
+	result, err := entClient(ctx, r.data).Role.Query().Where(role.Name(name)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", biz.ErrRoleNotFound, name)
+		}
+		r.logger.Error("获取角色失败", zap.String("name", name), zap.Error(err))
+		return nil, fmt.Errorf("获取角色失败: %w", err)
+	}
+	return result, nil
+}
+
+// Update 更新角色描述与权限
+func (r *roleRepo) Update(ctx context.Context, ro *ent.Role) (*ent.Role, error) {
+	r.logger.Info("更新角色", zap.String("name", ro.Name))
+
+	result, err := entClient(ctx, r.data).Role.UpdateOneID(ro.ID).
+		SetDescription(ro.Description).
+		SetPermissions(ro.Permissions).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", biz.ErrRoleNotFound, ro.Name)
+		}
+		r.logger.Error("更新角色失败", zap.String("name", ro.Name), zap.Error(err))
+		return nil, fmt.Errorf("更新角色失败: %w", err)
+	}
+	return result, nil
+}
+
+// List 查询全部角色，按名称排序
+func (r *roleRepo) List(ctx context.Context) ([]*ent.Role, error) {
+	results, err := entClient(ctx, r.data).Role.Query().Order(ent.Asc(role.FieldName)).All(ctx)
+	if err != nil {
+		r.logger.Error("查询角色失败", zap.Error(err))
+		return nil, fmt.Errorf("查询角色失败: %w", err)
+	}
+	return results, nil
+}
+
+// ListByNames 按名称批量查询角色
+func (r *roleRepo) ListByNames(ctx context.Context, names []string) ([]*ent.Role, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	results, err := entClient(ctx, r.data).Role.Query().
+		Where(role.NameIn(names...)).
+		Order(ent.Asc(role.FieldName)).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("查询角色失败", zap.Strings("names", names), zap.Error(err))
+		return nil, fmt.Errorf("查询角色失败: %w", err)
+	}
+	return results, nil
+}
+
+// groupRepo 用户组仓储实现
+type groupRepo struct {
+	data   *ent.Client
+	logger *zap.Logger
+}
+
+// NewGroupRepo 创建用户组仓储实例
+func NewGroupRepo(data *ent.Client, logger *zap.Logger) biz.GroupRepo {
+	return &groupRepo{
+		data:   data,
+		logger: logger,
+	}
+}
+
+// Create 创建用户组
+func (r *groupRepo) Create(ctx context.Context, g *ent.Group) (*ent.Group, error) {
+	r.logger.Info("创建用户组", zap.String("name", g.Name))
+
+	result, err := entClient(ctx, r.data).Group.Create().
+		SetName(g.Name).
+		SetDescription(g.Description).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("%w: %s", biz.ErrGroupExists, g.Name)
+		}
+		r.logger.Error("创建用户组失败", zap.String("name", g.Name), zap.Error(err))
+		return nil, fmt.Errorf("创建用户组失败: %w", err)
+	}
+	return result, nil
+}
+
+// List 查询全部用户组，按名称排序
+func (r *groupRepo) List(ctx context.Context) ([]*ent.Group, error) {
+	results, err := entClient(ctx, r.data).Group.Query().Order(ent.Asc(group.FieldName)).All(ctx)
+	if err != nil {
+		r.logger.Error("查询用户组失败", zap.Error(err))
+		return nil, fmt.Errorf("查询用户组失败: %w", err)
+	}
+	return results, nil
+}
+
+// ListByNames 按名称批量查询用户组
+func (r *groupRepo) ListByNames(ctx context.Context, names []string) ([]*ent.Group, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	results, err := entClient(ctx, r.data).Group.Query().
+		Where(group.NameIn(names...)).
+		Order(ent.Asc(group.FieldName)).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("查询用户组失败", zap.Strings("names", names), zap.Error(err))
+		return nil, fmt.Errorf("查询用户组失败: %w", err)
+	}
+	return results, nil
+}
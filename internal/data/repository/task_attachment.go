@@ -0,0 +1,243 @@
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskattachment"
+	"github.com/workflow-engine/workflow-engine/pkg/config"
+
+	"go.uber.org/zap"
+)
+
+// taskAttachmentMetaFields 附件元数据字段，查询附件列表与详情时不读取文件内容
+var taskAttachmentMetaFields = []string{
+	taskattachment.FieldID,
+	taskattachment.FieldTaskID,
+	taskattachment.FieldProcessInstanceID,
+	taskattachment.FieldUserID,
+	taskattachment.FieldName,
+	taskattachment.FieldDescription,
+	taskattachment.FieldContentType,
+	taskattachment.FieldSize,
+	taskattachment.FieldStorage,
+	taskattachment.FieldLocation,
+	taskattachment.FieldCreatedAt,
+}
+
+// taskAttachmentRepo 任务附件仓储实现
+// 新附件按配置的存储方式保存内容，读取时按附件记录的存储方式读取，切换配置不影响已有附件
+type taskAttachmentRepo struct {
+	data   *ent.Client
+	config config.AttachmentConfig
+	logger *zap.Logger
+}
+
+// NewTaskAttachmentRepo 创建任务附件仓储实例
+func NewTaskAttachmentRepo(data *ent.Client, cfg *config.Config, logger *zap.Logger) biz.TaskAttachmentRepo {
+	attachment := cfg.Data.Attachment
+	if attachment.Storage == "" {
+		attachment.Storage = config.AttachmentStorageDB
+	}
+	return &taskAttachmentRepo{
+		data:   data,
+		config: attachment,
+		logger: logger,
+	}
+}
+
+// Create 创建任务附件
+// 最多读取 MaxSize+1 字节以判断是否超过大小限制，不会把超限的文件整体读入内存；
+// 本地存储时先写入文件再保存记录，保存记录失败或所在事务回滚、提交失败时删除已写入的文件
+func (r *taskAttachmentRepo) Create(ctx context.Context, ta *ent.TaskAttachment, content io.Reader) (*ent.TaskAttachment, error) {
+	data, err := r.readContent(content)
+	if err != nil {
+		return nil, err
+	}
+
+	r.logger.Info("创建任务附件",
+		zap.Int64("task_id", ta.TaskID),
+		zap.String("name", ta.Name),
+		zap.Int("size", len(data)))
+
+	create := entClient(ctx, r.data).TaskAttachment.Create().
+		SetTaskID(ta.TaskID).
+		SetProcessInstanceID(ta.ProcessInstanceID).
+		SetUserID(ta.UserID).
+		SetName(ta.Name).
+		SetDescription(ta.Description).
+		SetContentType(ta.ContentType).
+		SetSize(int64(len(data))).
+		SetStorage(r.config.Storage)
+
+	var path string
+	switch r.config.Storage {
+	case config.AttachmentStorageLocal:
+		location, err := r.writeFile(ta.TaskID, ta.Name, data)
+		if err != nil {
+			r.logger.Error("保存附件文件失败", zap.Int64("task_id", ta.TaskID), zap.Error(err))
+			return nil, fmt.Errorf("保存附件文件失败: %w", err)
+		}
+		path = filepath.Join(r.config.Dir, location)
+		create = create.SetLocation(location)
+	default:
+		create = create.SetContent(data)
+	}
+
+	result, err := create.Save(ctx)
+	if err != nil {
+		if path != "" {
+			r.removeFile(path)
+		}
+		r.logger.Error("创建任务附件失败", zap.Int64("task_id", ta.TaskID), zap.Error(err))
+		return nil, fmt.Errorf("创建任务附件失败: %w", err)
+	}
+	if tx := ent.TxFromContext(ctx); tx != nil && path != "" {
+		r.removeOnTxFailure(tx, path)
+	}
+	result.Content = nil
+	return result, nil
+}
+
+// readContent 读取附件内容，超过大小限制时返回 ErrTaskAttachmentTooLarge
+func (r *taskAttachmentRepo) readContent(content io.Reader) ([]byte, error) {
+	if content == nil {
+		return nil, nil
+	}
+	if r.config.MaxSize > 0 {
+		content = io.LimitReader(content, r.config.MaxSize+1)
+	}
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return nil, fmt.Errorf("读取附件内容失败: %w", err)
+	}
+	if r.config.MaxSize > 0 && int64(len(data)) > r.config.MaxSize {
+		return nil, fmt.Errorf("%w: 最大 %d 字节", biz.ErrTaskAttachmentTooLarge, r.config.MaxSize)
+	}
+	return data, nil
+}
+
+// removeOnTxFailure 在事务回滚或提交失败时删除已写入的附件文件
+func (r *taskAttachmentRepo) removeOnTxFailure(tx *ent.Tx, path string) {
+	tx.OnRollback(func(next ent.Rollbacker) ent.Rollbacker {
+		return ent.RollbackFunc(func(ctx context.Context, tx *ent.Tx) error {
+			err := next.Rollback(ctx, tx)
+			r.removeFile(path)
+			return err
+		})
+	})
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			err := next.Commit(ctx, tx)
+			if err != nil {
+				r.removeFile(path)
+			}
+			return err
+		})
+	})
+}
+
+// removeFile 删除未保存成功的附件文件，删除失败只记录日志
+func (r *taskAttachmentRepo) removeFile(path string) {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		r.logger.Warn("删除附件文件失败", zap.String("path", path), zap.Error(err))
+	}
+}
+
+// GetByID 根据ID获取附件元数据
+func (r *taskAttachmentRepo) GetByID(ctx context.Context, id string) (*ent.TaskAttachment, error) {
+	idInt, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", biz.ErrTaskAttachmentNotFound, id)
+	}
+
+	result, err := entClient(ctx, r.data).TaskAttachment.Query().
+		Where(taskattachment.ID(idInt)).
+		Select(taskAttachmentMetaFields...).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", biz.ErrTaskAttachmentNotFound, id)
+		}
+		r.logger.Error("获取任务附件失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("获取任务附件失败: %w", err)
+	}
+	return result, nil
+}
+
+// GetContent 读取附件的文件内容
+func (r *taskAttachmentRepo) GetContent(ctx context.Context, ta *ent.TaskAttachment) ([]byte, error) {
+	if ta.Storage == config.AttachmentStorageLocal {
+		content, err := os.ReadFile(filepath.Join(r.config.Dir, ta.Location))
+		if err != nil {
+			r.logger.Error("读取附件文件失败", zap.Int64("id", ta.ID), zap.String("location", ta.Location), zap.Error(err))
+			return nil, fmt.Errorf("读取附件文件失败: %w", err)
+		}
+		return content, nil
+	}
+
+	result, err := entClient(ctx, r.data).TaskAttachment.Query().
+		Where(taskattachment.ID(ta.ID)).
+		Select(taskattachment.FieldContent).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %d", biz.ErrTaskAttachmentNotFound, ta.ID)
+		}
+		r.logger.Error("读取附件内容失败", zap.Int64("id", ta.ID), zap.Error(err))
+		return nil, fmt.Errorf("读取附件内容失败: %w", err)
+	}
+	return result.Content, nil
+}
+
+// ListByTaskID 根据任务ID分页查询附件元数据，按上传时间倒序
+func (r *taskAttachmentRepo) ListByTaskID(ctx context.Context, taskID int64, opts *biz.QueryOptions) ([]*ent.TaskAttachment, *biz.PaginationResult, error) {
+	query := entClient(ctx, r.data).TaskAttachment.Query().Where(taskattachment.TaskID(taskID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.logger.Error("查询任务附件总数失败", zap.Int64("task_id", taskID), zap.Error(err))
+		return nil, nil, fmt.Errorf("查询任务附件总数失败: %w", err)
+	}
+
+	query = query.Order(ent.Desc(taskattachment.FieldCreatedAt), ent.Desc(taskattachment.FieldID))
+	if opts != nil && opts.Page > 0 && opts.PageSize > 0 {
+		query = query.Offset((opts.Page - 1) * opts.PageSize).Limit(opts.PageSize)
+	}
+
+	results, err := query.Select(taskAttachmentMetaFields...).All(ctx)
+	if err != nil {
+		r.logger.Error("查询任务附件失败", zap.Int64("task_id", taskID), zap.Error(err))
+		return nil, nil, fmt.Errorf("查询任务附件失败: %w", err)
+	}
+
+	return results, pagination(total, opts), nil
+}
+
+// writeFile 将附件内容写入本地目录，返回相对于附件目录的路径
+// 文件按任务分目录保存，文件名加随机前缀避免同名附件互相覆盖
+func (r *taskAttachmentRepo) writeFile(taskID int64, name string, content []byte) (string, error) {
+	prefix := make([]byte, 8)
+	if _, err := rand.Read(prefix); err != nil {
+		return "", err
+	}
+
+	dir := strconv.FormatInt(taskID, 10)
+	if err := os.MkdirAll(filepath.Join(r.config.Dir, dir), 0o755); err != nil {
+		return "", err
+	}
+
+	location := filepath.Join(dir, hex.EncodeToString(prefix)+"-"+filepath.Base(filepath.Clean("/"+name)))
+	if err := os.WriteFile(filepath.Join(r.config.Dir, location), content, 0o644); err != nil {
+		return "", err
+	}
+	return location, nil
+}
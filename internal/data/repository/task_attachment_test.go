@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/pkg/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// TestTaskAttachmentRepo 测试任务附件仓储
+func TestTaskAttachmentRepo(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("数据库存储附件内容", func(t *testing.T) {
+		repo := NewTaskAttachmentRepo(newTestClient(t), &config.Config{}, zap.NewNop())
+
+		created, err := repo.Create(ctx, &ent.TaskAttachment{
+			TaskID: 7, ProcessInstanceID: 2, UserID: "manager", Name: "invoice.txt", ContentType: "text/plain",
+		}, strings.NewReader("invoice"))
+		require.NoError(t, err, "创建附件不应该返回错误")
+		assert.Equal(t, config.AttachmentStorageDB, created.Storage, "未配置时应该存入数据库")
+		assert.Equal(t, int64(7), created.Size, "应该记录文件大小")
+		assert.Nil(t, created.Content, "返回的附件不应该包含文件内容")
+
+		attachment, err := repo.GetByID(ctx, strconv.FormatInt(created.ID, 10))
+		require.NoError(t, err, "获取附件不应该返回错误")
+		assert.Nil(t, attachment.Content, "附件元数据不应该包含文件内容")
+
+		content, err := repo.GetContent(ctx, attachment)
+		require.NoError(t, err, "读取附件内容不应该返回错误")
+		assert.Equal(t, "invoice", string(content), "附件内容应该匹配")
+	})
+
+	t.Run("本地存储附件内容", func(t *testing.T) {
+		dir := t.TempDir()
+		cfg := &config.Config{Data: config.DataConfig{Attachment: config.AttachmentConfig{Storage: config.AttachmentStorageLocal, Dir: dir}}}
+		repo := NewTaskAttachmentRepo(newTestClient(t), cfg, zap.NewNop())
+
+		created, err := repo.Create(ctx, &ent.TaskAttachment{TaskID: 7, ProcessInstanceID: 2, Name: "../report.pdf"}, strings.NewReader("report"))
+		require.NoError(t, err, "创建附件不应该返回错误")
+		assert.Equal(t, config.AttachmentStorageLocal, created.Storage, "应该记录存储方式")
+		assert.Equal(t, "7", filepath.Dir(created.Location), "文件应该按任务分目录保存")
+
+		stored, err := os.ReadFile(filepath.Join(dir, created.Location))
+		require.NoError(t, err, "附件文件应该写入附件目录")
+		assert.Equal(t, "report", string(stored), "文件内容应该匹配")
+
+		content, err := repo.GetContent(ctx, created)
+		require.NoError(t, err, "读取附件内容不应该返回错误")
+		assert.Equal(t, "report", string(content), "附件内容应该匹配")
+	})
+
+	t.Run("超过大小限制", func(t *testing.T) {
+		cfg := &config.Config{Data: config.DataConfig{Attachment: config.AttachmentConfig{MaxSize: 4}}}
+		repo := NewTaskAttachmentRepo(newTestClient(t), cfg, zap.NewNop())
+
+		content := strings.NewReader("123456789")
+		_, err := repo.Create(ctx, &ent.TaskAttachment{TaskID: 7, Name: "big.bin"}, content)
+		assert.ErrorIs(t, err, biz.ErrTaskAttachmentTooLarge, "超过大小限制应该返回对应错误")
+		assert.Equal(t, 4, content.Len(), "最多只应该读取限制加一个字节")
+	})
+
+	t.Run("事务回滚时删除本地文件", func(t *testing.T) {
+		dir := t.TempDir()
+		cfg := &config.Config{Data: config.DataConfig{Attachment: config.AttachmentConfig{Storage: config.AttachmentStorageLocal, Dir: dir}}}
+		client := newTestClient(t)
+		repo := NewTaskAttachmentRepo(client, cfg, zap.NewNop())
+		txRepo := NewTransactionRepo(client, zap.NewNop())
+
+		var location string
+		failed := errors.New("记录办理记录失败")
+		err := txRepo.Transaction(ctx, func(ctx context.Context) error {
+			created, err := repo.Create(ctx, &ent.TaskAttachment{TaskID: 7, Name: "report.pdf"}, strings.NewReader("report"))
+			require.NoError(t, err, "创建附件不应该返回错误")
+			location = created.Location
+			_, err = os.Stat(filepath.Join(dir, location))
+			require.NoError(t, err, "提交前附件文件应该已写入")
+			return failed
+		})
+		require.ErrorIs(t, err, failed, "应该返回事务中的错误")
+
+		_, err = os.Stat(filepath.Join(dir, location))
+		assert.True(t, os.IsNotExist(err), "事务回滚后应该删除附件文件")
+	})
+
+	t.Run("按任务分页查询", func(t *testing.T) {
+		repo := NewTaskAttachmentRepo(newTestClient(t), &config.Config{}, zap.NewNop())
+		for _, name := range []string{"a.txt", "b.txt", "c.txt"} {
+			_, err := repo.Create(ctx, &ent.TaskAttachment{TaskID: 7, Name: name}, strings.NewReader(name))
+			require.NoError(t, err, "创建附件不应该返回错误")
+		}
+		_, err := repo.Create(ctx, &ent.TaskAttachment{TaskID: 8, Name: "other.txt"}, strings.NewReader(""))
+		require.NoError(t, err, "创建附件不应该返回错误")
+
+		results, page, err := repo.ListByTaskID(ctx, 7, &biz.QueryOptions{Page: 1, PageSize: 2})
+		require.NoError(t, err, "分页查询不应该返回错误")
+		assert.Equal(t, 3, page.Total, "总数应该匹配")
+		require.Len(t, results, 2, "每页应该返回两条")
+		assert.Equal(t, "c.txt", results[0].Name, "最新上传的在前")
+		assert.Nil(t, results[0].Content, "列表不应该包含文件内容")
+	})
+
+	t.Run("附件不存在", func(t *testing.T) {
+		repo := NewTaskAttachmentRepo(newTestClient(t), &config.Config{}, zap.NewNop())
+
+		_, err := repo.GetByID(ctx, "999")
+		assert.ErrorIs(t, err, biz.ErrTaskAttachmentNotFound, "不存在的附件应该返回对应错误")
+	})
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskcomment"
+
+	"go.uber.org/zap"
+)
+
+// taskCommentRepo 任务评论仓储实现
+type taskCommentRepo struct {
+	data   *ent.Client
+	logger *zap.Logger
+}
+
+// NewTaskCommentRepo 创建任务评论仓储实例
+func NewTaskCommentRepo(data *ent.Client, logger *zap.Logger) biz.TaskCommentRepo {
+	return &taskCommentRepo{
+		data:   data,
+		logger: logger,
+	}
+}
+
+// Create 创建任务评论
+func (r *taskCommentRepo) Create(ctx context.Context, tc *ent.TaskComment) (*ent.TaskComment, error) {
+	r.logger.Debug("创建任务评论", zap.Int64("task_id", tc.TaskID), zap.String("user_id", tc.UserID))
+
+	create := entClient(ctx, r.data).TaskComment.Create().
+		SetTaskID(tc.TaskID).
+		SetProcessInstanceID(tc.ProcessInstanceID).
+		SetUserID(tc.UserID).
+		SetMessage(tc.Message)
+	if tc.Type != "" {
+		create = create.SetType(tc.Type)
+	}
+
+	result, err := create.Save(ctx)
+	if err != nil {
+		r.logger.Error("创建任务评论失败", zap.Int64("task_id", tc.TaskID), zap.Error(err))
+		return nil, fmt.Errorf("创建任务评论失败: %w", err)
+	}
+	return result, nil
+}
+
+// ListByTaskID 根据任务ID分页查询评论，默认按时间倒序
+func (r *taskCommentRepo) ListByTaskID(ctx context.Context, taskID int64, opts *biz.QueryOptions) ([]*ent.TaskComment, *biz.PaginationResult, error) {
+	query := entClient(ctx, r.data).TaskComment.Query().Where(taskcomment.TaskID(taskID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.logger.Error("查询任务评论总数失败", zap.Int64("task_id", taskID), zap.Error(err))
+		return nil, nil, fmt.Errorf("查询任务评论总数失败: %w", err)
+	}
+
+	if opts != nil && opts.Order == "asc" {
+		query = query.Order(ent.Asc(taskcomment.FieldCreatedAt), ent.Asc(taskcomment.FieldID))
+	} else {
+		query = query.Order(ent.Desc(taskcomment.FieldCreatedAt), ent.Desc(taskcomment.FieldID))
+	}
+	if opts != nil && opts.Page > 0 && opts.PageSize > 0 {
+		query = query.Offset((opts.Page - 1) * opts.PageSize).Limit(opts.PageSize)
+	}
+
+	results, err := query.All(ctx)
+	if err != nil {
+		r.logger.Error("查询任务评论失败", zap.Int64("task_id", taskID), zap.Error(err))
+		return nil, nil, fmt.Errorf("查询任务评论失败: %w", err)
+	}
+
+	return results, pagination(total, opts), nil
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// TestTaskCommentRepo 测试任务评论仓储
+func TestTaskCommentRepo(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTaskCommentRepo(newTestClient(t), zap.NewNop())
+
+	seed := []*ent.TaskComment{
+		{TaskID: 7, ProcessInstanceID: 2, UserID: "manager", Type: biz.TaskCommentTypeDelegate, Message: "请核对金额"},
+		{TaskID: 7, ProcessInstanceID: 2, UserID: "deputy", Message: "金额无误"},
+		{TaskID: 7, ProcessInstanceID: 2, UserID: "manager", Type: biz.TaskCommentTypeComplete, Message: "同意"},
+		{TaskID: 8, ProcessInstanceID: 3, UserID: "manager", Message: "其他任务"},
+	}
+	for _, tc := range seed {
+		_, err := repo.Create(ctx, tc)
+		require.NoError(t, err, "创建任务评论不应该返回错误")
+	}
+
+	t.Run("未指定类型时记为普通评论", func(t *testing.T) {
+		results, _, err := repo.ListByTaskID(ctx, 7, &biz.QueryOptions{Order: "asc"})
+		require.NoError(t, err, "查询不应该返回错误")
+		require.Len(t, results, 3, "任务7应该有三条评论")
+		assert.Equal(t, biz.TaskCommentTypeDelegate, results[0].Type, "按发生顺序委派备注在前")
+		assert.Equal(t, biz.TaskCommentTypeComment, results[1].Type, "未指定类型应该为普通评论")
+		assert.Equal(t, int64(2), results[1].ProcessInstanceID, "应该关联流程实例")
+	})
+
+	t.Run("分页查询默认最新的在前", func(t *testing.T) {
+		results, page, err := repo.ListByTaskID(ctx, 7, &biz.QueryOptions{Page: 1, PageSize: 2})
+		require.NoError(t, err, "分页查询不应该返回错误")
+		assert.Equal(t, 3, page.Total, "总数应该匹配")
+		assert.Equal(t, 2, page.Pages, "总页数应该匹配")
+		require.Len(t, results, 2, "每页应该返回两条")
+		assert.Equal(t, "同意", results[0].Message, "最新的评论在前")
+	})
+
+	t.Run("评论内容不能为空", func(t *testing.T) {
+		_, err := repo.Create(ctx, &ent.TaskComment{TaskID: 7, UserID: "manager"})
+		assert.Error(t, err, "空评论应该创建失败")
+	})
+}
@@ -0,0 +1,922 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/predicate"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskidentitylink"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/taskinstance"
+
+	"go.uber.org/zap"
+)
+
+// taskInstanceOrderFields 任务实例允许排序的字段
+var taskInstanceOrderFields = map[string]string{
+	"create_time": taskinstance.FieldCreateTime,
+	"due_date":    taskinstance.FieldDueDate,
+	"priority":    taskinstance.FieldPriority,
+	"name":        taskinstance.FieldName,
+	"created_at":  taskinstance.FieldCreatedAt,
+	"updated_at":  taskinstance.FieldUpdatedAt,
+}
+
+// taskInstanceRepo 任务实例仓储实现
+type taskInstanceRepo struct {
+	data      *ent.Client
+	variables biz.ProcessVariableRepo
+	logger    *zap.Logger
+}
+
+// NewTaskInstanceRepo 创建任务实例仓储实例
+func NewTaskInstanceRepo(data *ent.Client, logger *zap.Logger) biz.TaskInstanceRepo {
+	return &taskInstanceRepo{
+		data:      data,
+		variables: NewProcessVariableRepo(data, logger),
+		logger:    logger,
+	}
+}
+
+// Create 创建任务实例
+func (r *taskInstanceRepo) Create(ctx context.Context, ti *ent.TaskInstance) (*ent.TaskInstance, error) {
+	r.logger.Info("创建任务实例",
+		zap.String("task_definition_key", ti.TaskDefinitionKey),
+		zap.Int64("process_instance_id", ti.ProcessInstanceID))
+
+	create := entClient(ctx, r.data).TaskInstance.Create().
+		SetName(ti.Name).
+		SetDescription(ti.Description).
+		SetTaskDefinitionKey(ti.TaskDefinitionKey).
+		SetAssignee(ti.Assignee).
+		SetOwner(ti.Owner).
+		SetDelegation(ti.Delegation).
+		SetNillableDueDate(ti.DueDate).
+		SetNillableFollowUpDate(ti.FollowUpDate).
+		SetFormKey(ti.FormKey).
+		SetCategory(ti.Category).
+		SetParentTaskID(ti.ParentTaskID).
+		SetWaitForSubtasks(ti.WaitForSubtasks).
+		SetNillableClaimTime(ti.ClaimTime).
+		SetExecutionID(ti.ExecutionID).
+		SetProcessInstanceID(ti.ProcessInstanceID).
+		SetProcessDefinitionID(ti.ProcessDefinitionID).
+		SetProcessDefinitionKey(ti.ProcessDefinitionKey).
+		SetSuspended(ti.Suspended)
+	if ti.Priority != 0 {
+		create = create.SetPriority(ti.Priority)
+	}
+	if !ti.CreateTime.IsZero() {
+		create = create.SetCreateTime(ti.CreateTime)
+	}
+	if ti.TenantID != "" {
+		create = create.SetTenantID(ti.TenantID)
+	}
+	if ti.Status != "" {
+		create = create.SetStatus(ti.Status)
+	}
+
+	result, err := create.Save(ctx)
+	if err != nil {
+		r.logger.Error("创建任务实例失败", zap.Error(err))
+		return nil, fmt.Errorf("创建任务实例失败: %w", err)
+	}
+
+	r.logger.Info("任务实例创建成功", zap.String("id", strconv.FormatInt(result.ID, 10)))
+	return result, nil
+}
+
+// GetByID 根据ID获取任务实例
+func (r *taskInstanceRepo) GetByID(ctx context.Context, id string) (*ent.TaskInstance, error) {
+	r.logger.Debug("根据ID获取任务实例", zap.String("id", id))
+
+	idInt, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("无效的任务实例ID: %s", id)
+	}
+
+	result, err := entClient(ctx, r.data).TaskInstance.Get(ctx, idInt)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			r.logger.Warn("任务实例不存在", zap.String("id", id))
+			return nil, fmt.Errorf("%w: %s", biz.ErrTaskNotFound, id)
+		}
+		r.logger.Error("获取任务实例失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("获取任务实例失败: %w", err)
+	}
+
+	return result, nil
+}
+
+// ListByExecution 按创建顺序查询流程执行在指定节点上的全部任务，包括已结束的任务
+// 执行每到达一次节点创建一个任务，供引擎创建用户任务时判断本次到达是否已经创建过
+func (r *taskInstanceRepo) ListByExecution(ctx context.Context, processInstanceID int64, executionID string, taskDefinitionKey string) ([]*ent.TaskInstance, error) {
+	r.logger.Debug("根据执行查询任务实例",
+		zap.Int64("process_instance_id", processInstanceID),
+		zap.String("execution_id", executionID),
+		zap.String("task_definition_key", taskDefinitionKey))
+
+	result, err := entClient(ctx, r.data).TaskInstance.Query().
+		Where(
+			taskinstance.ProcessInstanceID(processInstanceID),
+			taskinstance.ExecutionID(executionID),
+			taskinstance.TaskDefinitionKey(taskDefinitionKey),
+		).
+		Order(ent.Asc(taskinstance.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("根据执行查询任务实例失败", zap.Error(err))
+		return nil, fmt.Errorf("根据执行查询任务实例失败: %w", err)
+	}
+	return result, nil
+}
+
+// Update 更新任务实例
+func (r *taskInstanceRepo) Update(ctx context.Context, ti *ent.TaskInstance) (*ent.TaskInstance, error) {
+	r.logger.Info("更新任务实例", zap.String("id", strconv.FormatInt(ti.ID, 10)))
+
+	update := entClient(ctx, r.data).TaskInstance.
+		UpdateOneID(ti.ID).
+		SetName(ti.Name).
+		SetDescription(ti.Description).
+		SetAssignee(ti.Assignee).
+		SetOwner(ti.Owner).
+		SetDelegation(ti.Delegation).
+		SetPriority(ti.Priority).
+		SetFormKey(ti.FormKey).
+		SetCategory(ti.Category).
+		SetSuspended(ti.Suspended).
+		SetWaitForSubtasks(ti.WaitForSubtasks).
+		SetNillableClaimTime(ti.ClaimTime).
+		SetNillableEndTime(ti.EndTime).
+		SetDuration(ti.Duration).
+		SetDeleteReason(ti.DeleteReason)
+	if ti.Status != "" {
+		update = update.SetStatus(ti.Status)
+	}
+	if ti.DueDate != nil {
+		update = update.SetDueDate(*ti.DueDate)
+	} else {
+		update = update.ClearDueDate()
+	}
+	if ti.FollowUpDate != nil {
+		update = update.SetFollowUpDate(*ti.FollowUpDate)
+	} else {
+		update = update.ClearFollowUpDate()
+	}
+
+	result, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %d", biz.ErrTaskNotFound, ti.ID)
+		}
+		r.logger.Error("更新任务实例失败", zap.String("id", strconv.FormatInt(ti.ID, 10)), zap.Error(err))
+		return nil, fmt.Errorf("更新任务实例失败: %w", err)
+	}
+
+	r.logger.Info("任务实例更新成功", zap.String("id", strconv.FormatInt(result.ID, 10)))
+	return result, nil
+}
+
+// UpdateInfo 修改未结束任务的名称、描述、优先级、到期时间、分类以及是否等待子任务
+// 只写入请求中设置的字段，并按任务状态条件更新：办理人、状态等字段不会被覆盖，
+// 修改前任务已被完成或取消时返回任务状态错误
+func (r *taskInstanceRepo) UpdateInfo(ctx context.Context, id string, req *biz.UpdateTaskRequest) (*ent.TaskInstance, error) {
+	r.logger.Info("修改任务信息", zap.String("id", id))
+
+	idInt, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("无效的任务实例ID: %s", id)
+	}
+
+	update := entClient(ctx, r.data).TaskInstance.Update().
+		Where(taskinstance.ID(idInt), taskinstance.StatusIn(biz.OpenTaskStatuses...)).
+		SetNillableName(req.Name).
+		SetNillableDescription(req.Description).
+		SetNillablePriority(req.Priority).
+		SetNillableDueDate(req.DueDate).
+		SetNillableCategory(req.Category).
+		SetNillableWaitForSubtasks(req.WaitForSubtasks)
+	affected, err := update.Save(ctx)
+	if err != nil {
+		r.logger.Error("修改任务信息失败", zap.String("id", id), zap.Error(err))
+		return nil, fmt.Errorf("修改任务信息失败: %w", err)
+	}
+
+	task, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if affected == 0 {
+		switch task.Status {
+		case biz.TaskStatusCompleted:
+			return nil, fmt.Errorf("%w: %s", biz.ErrTaskCompleted, id)
+		case biz.TaskStatusCancelled:
+			return nil, fmt.Errorf("%w: %s", biz.ErrTaskCancelled, id)
+		}
+		return nil, fmt.Errorf("%w: 任务 %s 状态已变更", biz.ErrInvalidTaskTransition, id)
+	}
+	return task, nil
+}
+
+// Delete 删除任务实例
+func (r *taskInstanceRepo) Delete(ctx context.Context, id string) error {
+	r.logger.Info("删除任务实例", zap.String("id", id))
+
+	idInt, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return fmt.Errorf("无效的任务实例ID: %s", id)
+	}
+
+	if err := r.deleteIdentityLinks(ctx, idInt); err != nil {
+		return err
+	}
+	if err := entClient(ctx, r.data).TaskInstance.DeleteOneID(idInt).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			r.logger.Warn("任务实例不存在", zap.String("id", id))
+			return fmt.Errorf("%w: %s", biz.ErrTaskNotFound, id)
+		}
+		r.logger.Error("删除任务实例失败", zap.String("id", id), zap.Error(err))
+		return fmt.Errorf("删除任务实例失败: %w", err)
+	}
+
+	r.logger.Info("任务实例删除成功", zap.String("id", id))
+	return nil
+}
+
+// List 分页查询任务实例
+func (r *taskInstanceRepo) List(ctx context.Context, filter *biz.TaskInstanceFilter, opts *biz.QueryOptions) ([]*ent.TaskInstance, *biz.PaginationResult, error) {
+	r.logger.Debug("分页查询任务实例",
+		zap.Any("filter", filter),
+		zap.Any("options", opts))
+
+	query, err := r.filter(entClient(ctx, r.data).TaskInstance.Query(), filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	return r.page(ctx, query, opts)
+}
+
+// Count 计数查询
+func (r *taskInstanceRepo) Count(ctx context.Context, filter *biz.TaskInstanceFilter) (int, error) {
+	r.logger.Debug("计数查询任务实例", zap.Any("filter", filter))
+
+	query, err := r.filter(entClient(ctx, r.data).TaskInstance.Query(), filter)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := query.Count(ctx)
+	if err != nil {
+		r.logger.Error("计数查询任务实例失败", zap.Error(err))
+		return 0, fmt.Errorf("计数查询任务实例失败: %w", err)
+	}
+	return count, nil
+}
+
+// ListByProcessInstanceID 根据流程实例ID查询任务实例
+func (r *taskInstanceRepo) ListByProcessInstanceID(ctx context.Context, processInstanceID string, opts *biz.QueryOptions) ([]*ent.TaskInstance, *biz.PaginationResult, error) {
+	r.logger.Debug("根据流程实例ID查询任务实例", zap.String("process_instance_id", processInstanceID))
+
+	return r.List(ctx, &biz.TaskInstanceFilter{ProcessInstanceID: processInstanceID}, opts)
+}
+
+// ListByAssignee 根据执行人查询任务实例
+func (r *taskInstanceRepo) ListByAssignee(ctx context.Context, assigneeID string, opts *biz.QueryOptions) ([]*ent.TaskInstance, *biz.PaginationResult, error) {
+	r.logger.Debug("根据执行人查询任务实例", zap.String("assignee_id", assigneeID))
+
+	return r.List(ctx, &biz.TaskInstanceFilter{AssigneeID: assigneeID}, opts)
+}
+
+// ListSubtasks 查询多个父任务下的直接子任务，按创建顺序返回
+func (r *taskInstanceRepo) ListSubtasks(ctx context.Context, parentTaskIDs []string) ([]*ent.TaskInstance, error) {
+	if len(parentTaskIDs) == 0 {
+		return nil, nil
+	}
+
+	results, err := entClient(ctx, r.data).TaskInstance.Query().
+		Where(taskinstance.ParentTaskIDIn(parentTaskIDs...)).
+		Order(ent.Asc(taskinstance.FieldCreateTime), ent.Asc(taskinstance.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("查询子任务失败", zap.Strings("parent_task_ids", parentTaskIDs), zap.Error(err))
+		return nil, fmt.Errorf("查询子任务失败: %w", err)
+	}
+	return results, nil
+}
+
+// Claim 认领任务
+// 仅当任务处于可认领状态、且未分配或已分配给同一用户时认领成功，并发认领时只有一个用户能成功；
+// 同一用户重复认领保留首次认领时间
+func (r *taskInstanceRepo) Claim(ctx context.Context, id string, assigneeID string) error {
+	r.logger.Info("认领任务", zap.String("id", id), zap.String("assignee_id", assigneeID))
+
+	idInt, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return fmt.Errorf("无效的任务实例ID: %s", id)
+	}
+
+	client := entClient(ctx, r.data)
+	claimable := []predicate.TaskInstance{
+		taskinstance.ID(idInt),
+		taskinstance.StatusIn(biz.TaskStatusesBefore(biz.TaskStatusClaimed)...),
+		taskinstance.Or(
+			taskinstance.AssigneeIsNil(),
+			taskinstance.Assignee(""),
+			taskinstance.Assignee(assigneeID),
+		),
+	}
+	affected, err := client.TaskInstance.Update().
+		Where(claimable...).
+		SetAssignee(assigneeID).
+		SetStatus(biz.TaskStatusClaimed).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("认领任务失败", zap.String("id", id), zap.Error(err))
+		return fmt.Errorf("认领任务失败: %w", err)
+	}
+	if affected == 0 {
+		task, err := r.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := biz.CheckTaskTransition(task.Status, biz.TaskStatusClaimed); err != nil {
+			return err
+		}
+		return fmt.Errorf("%w: %s", biz.ErrTaskAlreadyClaimed, id)
+	}
+
+	if err := client.TaskInstance.Update().
+		Where(taskinstance.ID(idInt), taskinstance.ClaimTimeIsNil()).
+		SetClaimTime(time.Now()).
+		Exec(ctx); err != nil {
+		r.logger.Error("记录任务认领时间失败", zap.String("id", id), zap.Error(err))
+		return fmt.Errorf("记录任务认领时间失败: %w", err)
+	}
+	return nil
+}
+
+// Unclaim 取消认领任务
+// 任务退回待认领并清除办理人与认领时间，只有指定用户认领的、处于已认领状态的任务能被取消认领
+func (r *taskInstanceRepo) Unclaim(ctx context.Context, id string, assigneeID string) error {
+	r.logger.Info("取消认领任务", zap.String("id", id), zap.String("assignee_id", assigneeID))
+
+	idInt, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return fmt.Errorf("无效的任务实例ID: %s", id)
+	}
+
+	affected, err := entClient(ctx, r.data).TaskInstance.Update().
+		Where(
+			taskinstance.ID(idInt),
+			taskinstance.StatusIn(biz.TaskStatusesBefore(biz.TaskStatusCreated)...),
+			taskinstance.Assignee(assigneeID),
+		).
+		SetAssignee("").
+		ClearClaimTime().
+		SetStatus(biz.TaskStatusCreated).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("取消认领任务失败", zap.String("id", id), zap.Error(err))
+		return fmt.Errorf("取消认领任务失败: %w", err)
+	}
+	if affected == 0 {
+		task, err := r.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := biz.CheckTaskTransition(task.Status, biz.TaskStatusCreated); err != nil {
+			return err
+		}
+		return fmt.Errorf("%w: 任务不是由 %s 认领的", biz.ErrTaskNotAssignee, assigneeID)
+	}
+	return nil
+}
+
+// Complete 完成任务
+// 任务输出变量写入所属流程实例，任务记录结束时间与持续时间并变为已完成；
+// 只有处于可完成状态的任务能被完成，重复完成返回 biz.ErrTaskCompleted
+func (r *taskInstanceRepo) Complete(ctx context.Context, id string, variables map[string]interface{}) error {
+	r.logger.Info("完成任务", zap.String("id", id))
+
+	task, err := r.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if len(variables) > 0 {
+		processInstanceID := strconv.FormatInt(task.ProcessInstanceID, 10)
+		if err := r.variables.SetVariables(ctx, processInstanceID, variables); err != nil {
+			r.logger.Error("保存任务输出变量失败", zap.String("id", id), zap.Error(err))
+			return fmt.Errorf("保存任务输出变量失败: %w", err)
+		}
+	}
+
+	if err := r.finish(ctx, task, biz.TaskStatusCompleted, ""); err != nil {
+		return err
+	}
+
+	r.logger.Info("任务完成成功", zap.String("id", id))
+	return nil
+}
+
+// Delegate 委派任务
+// 原办理人成为任务拥有者，任务转交被委派人处理并进入委派中状态
+func (r *taskInstanceRepo) Delegate(ctx context.Context, id string, delegateID string) error {
+	r.logger.Info("委派任务", zap.String("id", id), zap.String("delegate_id", delegateID))
+
+	task, err := r.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	owner := task.Owner
+	if owner == "" {
+		owner = task.Assignee
+	}
+
+	affected, err := entClient(ctx, r.data).TaskInstance.Update().
+		Where(
+			taskinstance.ID(task.ID),
+			taskinstance.StatusIn(biz.TaskStatusesBefore(biz.TaskStatusDelegated)...),
+		).
+		SetOwner(owner).
+		SetAssignee(delegateID).
+		SetDelegation(biz.TaskDelegationPending).
+		SetStatus(biz.TaskStatusDelegated).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("委派任务失败", zap.String("id", id), zap.Error(err))
+		return fmt.Errorf("委派任务失败: %w", err)
+	}
+	if affected == 0 {
+		return r.transitionError(ctx, task.ID, biz.TaskStatusDelegated)
+	}
+	return nil
+}
+
+// Resolve 被委派人交还任务
+// 任务回到拥有者手中并进入已交还状态，只有委派中的任务能被交还
+func (r *taskInstanceRepo) Resolve(ctx context.Context, id string) error {
+	r.logger.Info("交还委派任务", zap.String("id", id))
+
+	task, err := r.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := entClient(ctx, r.data).TaskInstance.Update().
+		Where(
+			taskinstance.ID(task.ID),
+			taskinstance.StatusIn(biz.TaskStatusesBefore(biz.TaskStatusResolved)...),
+			taskinstance.Delegation(biz.TaskDelegationPending),
+		).
+		SetAssignee(task.Owner).
+		SetDelegation(biz.TaskDelegationResolved).
+		SetStatus(biz.TaskStatusResolved).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("交还委派任务失败", zap.String("id", id), zap.Error(err))
+		return fmt.Errorf("交还委派任务失败: %w", err)
+	}
+	if affected == 0 {
+		return r.transitionError(ctx, task.ID, biz.TaskStatusResolved)
+	}
+	return nil
+}
+
+// Reassign 转交任务
+// 任务交给新的办理人（为空时退回待认领）并追加候选组，清除拥有者与委派状态；只有未结束的任务能被转交
+func (r *taskInstanceRepo) Reassign(ctx context.Context, id string, assigneeID string, groupIDs []string) error {
+	r.logger.Info("转交任务",
+		zap.String("id", id),
+		zap.String("assignee_id", assigneeID),
+		zap.Strings("group_ids", groupIDs))
+
+	task, err := r.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	status := biz.TaskStatusCreated
+	update := entClient(ctx, r.data).TaskInstance.Update().
+		Where(
+			taskinstance.ID(task.ID),
+			taskinstance.StatusIn(biz.OpenTaskStatuses...),
+		).
+		SetAssignee(assigneeID).
+		SetOwner("").
+		SetDelegation("")
+	if assigneeID != "" {
+		status = biz.TaskStatusClaimed
+		update = update.SetClaimTime(time.Now())
+	} else {
+		update = update.ClearClaimTime()
+	}
+	affected, err := update.SetStatus(status).Save(ctx)
+	if err != nil {
+		r.logger.Error("转交任务失败", zap.String("id", id), zap.Error(err))
+		return fmt.Errorf("转交任务失败: %w", err)
+	}
+	if affected == 0 {
+		return r.transitionError(ctx, task.ID, status)
+	}
+
+	if err := r.AddCandidates(ctx, task.ID, nil, groupIDs); err != nil {
+		return err
+	}
+	if assigneeID != "" {
+		return r.AddParticipant(ctx, task.ID, assigneeID)
+	}
+	return nil
+}
+
+// RaisePriority 提高未结束任务的优先级，返回是否有变更
+// 任务当前优先级不低于目标优先级时不做修改
+func (r *taskInstanceRepo) RaisePriority(ctx context.Context, id string, priority int32) (bool, error) {
+	r.logger.Info("提高任务优先级", zap.String("id", id), zap.Int32("priority", priority))
+
+	idInt, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("无效的任务实例ID: %s", id)
+	}
+
+	affected, err := entClient(ctx, r.data).TaskInstance.Update().
+		Where(
+			taskinstance.ID(idInt),
+			taskinstance.StatusIn(biz.OpenTaskStatuses...),
+			taskinstance.PriorityLT(priority),
+		).
+		SetPriority(priority).
+		Save(ctx)
+	if err != nil {
+		r.logger.Error("提高任务优先级失败", zap.String("id", id), zap.Error(err))
+		return false, fmt.Errorf("提高任务优先级失败: %w", err)
+	}
+	return affected > 0, nil
+}
+
+// Cancel 取消未结束的任务，记录取消原因与结束时间
+func (r *taskInstanceRepo) Cancel(ctx context.Context, id string, reason string) error {
+	r.logger.Info("取消任务", zap.String("id", id), zap.String("reason", reason))
+
+	task, err := r.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	return r.finish(ctx, task, biz.TaskStatusCancelled, reason)
+}
+
+// CancelByProcessInstance 取消流程实例下未结束的任务，记录取消原因与结束时间
+func (r *taskInstanceRepo) CancelByProcessInstance(ctx context.Context, processInstanceID int64, reason string) (int, error) {
+	r.logger.Info("取消流程实例的任务",
+		zap.Int64("process_instance_id", processInstanceID),
+		zap.String("reason", reason))
+
+	tasks, err := entClient(ctx, r.data).TaskInstance.Query().
+		Where(
+			taskinstance.ProcessInstanceID(processInstanceID),
+			taskinstance.StatusIn(biz.OpenTaskStatuses...),
+		).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("查询流程实例的任务失败", zap.Int64("process_instance_id", processInstanceID), zap.Error(err))
+		return 0, fmt.Errorf("查询流程实例的任务失败: %w", err)
+	}
+
+	cancelled := 0
+	for _, task := range tasks {
+		if err := r.finish(ctx, task, biz.TaskStatusCancelled, reason); err != nil {
+			// 并发完成的任务不再取消
+			if errors.Is(err, biz.ErrTaskCompleted) || errors.Is(err, biz.ErrTaskCancelled) {
+				continue
+			}
+			return cancelled, err
+		}
+		cancelled++
+	}
+	return cancelled, nil
+}
+
+// MigrateByProcessInstance 将流程实例下未结束的任务迁移到指定的流程定义版本，返回迁移的任务
+func (r *taskInstanceRepo) MigrateByProcessInstance(ctx context.Context, processInstanceID int64, processDefinitionID int64) ([]*ent.TaskInstance, error) {
+	r.logger.Info("迁移流程实例的任务",
+		zap.Int64("process_instance_id", processInstanceID),
+		zap.Int64("process_definition_id", processDefinitionID))
+
+	open := []predicate.TaskInstance{
+		taskinstance.ProcessInstanceID(processInstanceID),
+		taskinstance.StatusIn(biz.OpenTaskStatuses...),
+	}
+	tasks, err := entClient(ctx, r.data).TaskInstance.Query().Where(open...).All(ctx)
+	if err != nil {
+		r.logger.Error("查询流程实例的任务失败", zap.Int64("process_instance_id", processInstanceID), zap.Error(err))
+		return nil, fmt.Errorf("查询流程实例的任务失败: %w", err)
+	}
+	if _, err := entClient(ctx, r.data).TaskInstance.Update().
+		Where(open...).
+		SetProcessDefinitionID(processDefinitionID).
+		Save(ctx); err != nil {
+		r.logger.Error("迁移流程实例的任务失败", zap.Int64("process_instance_id", processInstanceID), zap.Error(err))
+		return nil, fmt.Errorf("迁移流程实例的任务失败: %w", err)
+	}
+	return tasks, nil
+}
+
+// finish 结束任务：变更为已完成或已取消，记录结束时间、持续时间与取消原因
+// 任务已不处于可结束的状态时返回对应的任务业务错误
+func (r *taskInstanceRepo) finish(ctx context.Context, task *ent.TaskInstance, status string, reason string) error {
+	now := time.Now()
+	var duration int64
+	if task.CreateTime.Before(now) {
+		duration = now.Sub(task.CreateTime).Milliseconds()
+	}
+
+	update := entClient(ctx, r.data).TaskInstance.Update().
+		Where(
+			taskinstance.ID(task.ID),
+			taskinstance.StatusIn(biz.TaskStatusesBefore(status)...),
+		).
+		SetStatus(status).
+		SetEndTime(now).
+		SetDuration(duration)
+	if reason != "" {
+		update = update.SetDeleteReason(reason)
+	}
+
+	affected, err := update.Save(ctx)
+	if err != nil {
+		r.logger.Error("结束任务失败", zap.Int64("id", task.ID), zap.String("status", status), zap.Error(err))
+		return fmt.Errorf("结束任务失败: %w", err)
+	}
+	if affected == 0 {
+		return r.transitionError(ctx, task.ID, status)
+	}
+	return nil
+}
+
+// transitionError 按条件更新任务状态未生效时，根据任务当前状态返回对应的任务业务错误
+func (r *taskInstanceRepo) transitionError(ctx context.Context, id int64, to string) error {
+	task, err := r.GetByID(ctx, strconv.FormatInt(id, 10))
+	if err != nil {
+		return err
+	}
+	if err := biz.CheckTaskTransition(task.Status, to); err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: 任务 %d 状态已变更", biz.ErrInvalidTaskTransition, id)
+}
+
+// AddCandidates 添加任务候选用户与候选组
+// 已存在的关联不会重复添加，引擎重试创建用户任务时可以重复调用
+func (r *taskInstanceRepo) AddCandidates(ctx context.Context, taskID int64, userIDs []string, groupIDs []string) error {
+	r.logger.Info("添加任务候选人",
+		zap.Int64("task_id", taskID),
+		zap.Strings("user_ids", userIDs),
+		zap.Strings("group_ids", groupIDs))
+
+	task, err := r.GetByID(ctx, strconv.FormatInt(taskID, 10))
+	if err != nil {
+		return err
+	}
+	for _, userID := range userIDs {
+		if err := r.addIdentityLink(ctx, task, biz.IdentityLinkCandidate, userID, ""); err != nil {
+			return err
+		}
+	}
+	for _, groupID := range groupIDs {
+		if err := r.addIdentityLink(ctx, task, biz.IdentityLinkCandidate, "", groupID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddParticipant 添加任务参与者
+func (r *taskInstanceRepo) AddParticipant(ctx context.Context, taskID int64, userID string) error {
+	r.logger.Info("添加任务参与者", zap.Int64("task_id", taskID), zap.String("user_id", userID))
+
+	task, err := r.GetByID(ctx, strconv.FormatInt(taskID, 10))
+	if err != nil {
+		return err
+	}
+	return r.addIdentityLink(ctx, task, biz.IdentityLinkParticipant, userID, "")
+}
+
+// ListIdentityLinks 查询任务的身份关联
+func (r *taskInstanceRepo) ListIdentityLinks(ctx context.Context, taskID int64) ([]*ent.TaskIdentityLink, error) {
+	r.logger.Debug("查询任务身份关联", zap.Int64("task_id", taskID))
+
+	links, err := entClient(ctx, r.data).TaskIdentityLink.Query().
+		Where(taskidentitylink.TaskID(taskID)).
+		Order(ent.Asc(taskidentitylink.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.logger.Error("查询任务身份关联失败", zap.Int64("task_id", taskID), zap.Error(err))
+		return nil, fmt.Errorf("查询任务身份关联失败: %w", err)
+	}
+	return links, nil
+}
+
+// addIdentityLink 添加一条任务身份关联，用户与用户组只能设置其一，空值忽略
+func (r *taskInstanceRepo) addIdentityLink(ctx context.Context, task *ent.TaskInstance, linkType string, userID string, groupID string) error {
+	if userID == "" && groupID == "" {
+		return nil
+	}
+
+	client := entClient(ctx, r.data)
+	exists, err := client.TaskIdentityLink.Query().
+		Where(
+			taskidentitylink.TaskID(task.ID),
+			taskidentitylink.Type(linkType),
+			identityLinkUser(userID),
+			identityLinkGroup(groupID),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.logger.Error("查询任务身份关联失败", zap.Int64("task_id", task.ID), zap.Error(err))
+		return fmt.Errorf("查询任务身份关联失败: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	create := client.TaskIdentityLink.Create().
+		SetTaskID(task.ID).
+		SetProcessInstanceID(task.ProcessInstanceID).
+		SetType(linkType)
+	if userID != "" {
+		create = create.SetUserID(userID)
+	}
+	if groupID != "" {
+		create = create.SetGroupID(groupID)
+	}
+	if _, err := create.Save(ctx); err != nil {
+		r.logger.Error("添加任务身份关联失败", zap.Int64("task_id", task.ID), zap.Error(err))
+		return fmt.Errorf("添加任务身份关联失败: %w", err)
+	}
+	return nil
+}
+
+// deleteIdentityLinks 删除任务的全部身份关联
+func (r *taskInstanceRepo) deleteIdentityLinks(ctx context.Context, taskID int64) error {
+	if _, err := entClient(ctx, r.data).TaskIdentityLink.Delete().
+		Where(taskidentitylink.TaskID(taskID)).
+		Exec(ctx); err != nil {
+		r.logger.Error("删除任务身份关联失败", zap.Int64("task_id", taskID), zap.Error(err))
+		return fmt.Errorf("删除任务身份关联失败: %w", err)
+	}
+	return nil
+}
+
+// filter 应用任务实例过滤条件
+// 状态取值：assigned（已分配）、unassigned（未分配）、delegated（委派中）、suspended（已挂起）、active（未挂起）
+func (r *taskInstanceRepo) filter(query *ent.TaskInstanceQuery, filter *biz.TaskInstanceFilter) (*ent.TaskInstanceQuery, error) {
+	if filter == nil {
+		return query, nil
+	}
+	if filter.ProcessInstanceID != "" {
+		instanceID, err := strconv.ParseInt(filter.ProcessInstanceID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("无效的流程实例ID: %s", filter.ProcessInstanceID)
+		}
+		query = query.Where(taskinstance.ProcessInstanceID(instanceID))
+	}
+	if filter.AssigneeID != "" {
+		query = query.Where(taskinstance.Assignee(filter.AssigneeID))
+	}
+	if filter.ParentTaskID != "" {
+		query = query.Where(taskinstance.ParentTaskID(filter.ParentTaskID))
+	} else if filter.RootOnly {
+		query = query.Where(taskinstance.Or(taskinstance.ParentTaskIDIsNil(), taskinstance.ParentTaskID("")))
+	}
+	switch filter.Status {
+	case "":
+	case "assigned":
+		query = query.Where(taskinstance.AssigneeNotNil(), taskinstance.AssigneeNEQ(""))
+	case "unassigned":
+		query = query.Where(taskinstance.Or(taskinstance.AssigneeIsNil(), taskinstance.Assignee("")))
+	case "delegated":
+		query = query.Where(taskinstance.Delegation(biz.TaskDelegationPending))
+	case "suspended":
+		query = query.Where(taskinstance.Suspended(true))
+	case "active":
+		query = query.Where(taskinstance.Suspended(false))
+	default:
+		return nil, fmt.Errorf("无效的任务状态: %s", filter.Status)
+	}
+	if len(filter.States) > 0 {
+		query = query.Where(taskinstance.StatusIn(filter.States...))
+	}
+	if filter.CandidateUser != "" || len(filter.CandidateGroups) > 0 {
+		query = query.Where(taskInstanceOfCandidate(filter.CandidateUser, filter.CandidateGroups))
+	}
+	if filter.CreatedFrom != nil {
+		query = query.Where(taskinstance.CreateTimeGTE(*filter.CreatedFrom))
+	}
+	if filter.CreatedTo != nil {
+		query = query.Where(taskinstance.CreateTimeLTE(*filter.CreatedTo))
+	}
+	if filter.Access != nil {
+		access := filter.Access
+		query = query.Where(accessPredicate(access, accessColumns{
+			key:          taskinstance.FieldProcessDefinitionKey,
+			tenant:       taskinstance.FieldTenantID,
+			definitionID: taskinstance.FieldProcessDefinitionID,
+		},
+			userColumn(taskinstance.FieldAssignee, access.User),
+			userColumn(taskinstance.FieldOwner, access.User),
+			func(s *sql.Selector) *sql.Predicate {
+				return candidatePredicate(s, access.User, access.Groups)
+			},
+		))
+	}
+	return query, nil
+}
+
+// taskInstanceOfCandidate 筛选用户作为候选用户、或其所属用户组作为候选组的任务
+func taskInstanceOfCandidate(userID string, groupIDs []string) predicate.TaskInstance {
+	return func(s *sql.Selector) {
+		s.Where(candidatePredicate(s, userID, groupIDs))
+	}
+}
+
+// candidatePredicate 匹配用户作为候选用户、或其所属用户组作为候选组的任务，用户与用户组都为空时返回 nil
+func candidatePredicate(s *sql.Selector, userID string, groupIDs []string) *sql.Predicate {
+	t := sql.Table(taskidentitylink.Table)
+	var matches []*sql.Predicate
+	if userID != "" {
+		matches = append(matches, sql.EQ(t.C(taskidentitylink.FieldUserID), userID))
+	}
+	if len(groupIDs) > 0 {
+		groups := make([]interface{}, len(groupIDs))
+		for i, groupID := range groupIDs {
+			groups[i] = groupID
+		}
+		matches = append(matches, sql.In(t.C(taskidentitylink.FieldGroupID), groups...))
+	}
+	if len(matches) == 0 {
+		return nil
+	}
+	return sql.In(s.C(taskinstance.FieldID),
+		sql.Select(t.C(taskidentitylink.FieldTaskID)).
+			From(t).
+			Where(sql.And(
+				sql.EQ(t.C(taskidentitylink.FieldType), biz.IdentityLinkCandidate),
+				sql.Or(matches...),
+			)),
+	)
+}
+
+// identityLinkUser 按用户匹配身份关联，空用户匹配未设置用户的关联
+func identityLinkUser(userID string) predicate.TaskIdentityLink {
+	if userID == "" {
+		return taskidentitylink.Or(taskidentitylink.UserIDIsNil(), taskidentitylink.UserID(""))
+	}
+	return taskidentitylink.UserID(userID)
+}
+
+// identityLinkGroup 按用户组匹配身份关联，空用户组匹配未设置用户组的关联
+func identityLinkGroup(groupID string) predicate.TaskIdentityLink {
+	if groupID == "" {
+		return taskidentitylink.Or(taskidentitylink.GroupIDIsNil(), taskidentitylink.GroupID(""))
+	}
+	return taskidentitylink.GroupID(groupID)
+}
+
+// page 应用搜索、排序与分页
+func (r *taskInstanceRepo) page(ctx context.Context, query *ent.TaskInstanceQuery, opts *biz.QueryOptions) ([]*ent.TaskInstance, *biz.PaginationResult, error) {
+	if opts != nil && opts.Search != "" {
+		query = query.Where(taskinstance.Or(
+			taskinstance.NameContainsFold(opts.Search),
+			taskinstance.DescriptionContainsFold(opts.Search),
+			taskinstance.TaskDefinitionKeyContainsFold(opts.Search),
+			taskinstance.CategoryContainsFold(opts.Search),
+		))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.logger.Error("查询任务实例总数失败", zap.Error(err))
+		return nil, nil, fmt.Errorf("查询任务实例总数失败: %w", err)
+	}
+
+	query = query.Order(orderBy(opts, taskInstanceOrderFields, taskinstance.FieldCreateTime), ent.Desc(taskinstance.FieldID))
+	if opts != nil && opts.Page > 0 && opts.PageSize > 0 {
+		query = query.Offset((opts.Page - 1) * opts.PageSize).Limit(opts.PageSize)
+	}
+
+	results, err := query.All(ctx)
+	if err != nil {
+		r.logger.Error("查询任务实例失败", zap.Error(err))
+		return nil, nil, fmt.Errorf("查询任务实例失败: %w", err)
+	}
+
+	return results, pagination(total, opts), nil
+}
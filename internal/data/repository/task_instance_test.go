@@ -0,0 +1,331 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// TestTaskInstanceRepo 测试任务实例仓储
+func TestTaskInstanceRepo(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	repo := NewTaskInstanceRepo(client, zap.NewNop())
+	variables := NewProcessVariableRepo(client, zap.NewNop())
+
+	base := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	seed := []*ent.TaskInstance{
+		{Name: "部门审批", TaskDefinitionKey: "dept_approve", ProcessInstanceID: 10, ProcessDefinitionKey: "leave", Priority: 80, CreateTime: base},
+		{Name: "人事审批", TaskDefinitionKey: "hr_approve", ProcessInstanceID: 10, ProcessDefinitionKey: "leave", Assignee: "hr", Priority: 60, CreateTime: base.Add(time.Hour)},
+		{Name: "财务审批", TaskDefinitionKey: "finance_approve", ProcessInstanceID: 20, ProcessDefinitionKey: "expense", Assignee: "hr", Priority: 20, CreateTime: base.Add(2 * time.Hour)},
+	}
+	var ids []string
+	for _, ti := range seed {
+		created, err := repo.Create(ctx, ti)
+		require.NoError(t, err, "创建任务实例不应该返回错误")
+		ids = append(ids, strconv.FormatInt(created.ID, 10))
+	}
+
+	t.Run("按办理人分页并按优先级排序", func(t *testing.T) {
+		results, page, err := repo.ListByAssignee(ctx, "hr", &biz.QueryOptions{OrderBy: "priority", Order: "desc"})
+		require.NoError(t, err, "查询不应该返回错误")
+		assert.Equal(t, 2, page.Total, "办理人应该有两个任务")
+		assert.Equal(t, "hr_approve", results[0].TaskDefinitionKey, "优先级高的任务在前")
+	})
+
+	t.Run("按流程实例和状态过滤", func(t *testing.T) {
+		count, err := repo.Count(ctx, &biz.TaskInstanceFilter{ProcessInstanceID: "10", Status: "unassigned"})
+		require.NoError(t, err, "计数查询不应该返回错误")
+		assert.Equal(t, 1, count, "流程实例10应该只有一个未分配任务")
+
+		results, _, err := repo.ListByProcessInstanceID(ctx, "10", &biz.QueryOptions{Search: "人事"})
+		require.NoError(t, err, "搜索不应该返回错误")
+		require.Len(t, results, 1, "应该只匹配人事审批")
+		assert.Equal(t, "hr_approve", results[0].TaskDefinitionKey, "任务定义键应该匹配")
+	})
+
+	t.Run("认领任务", func(t *testing.T) {
+		require.NoError(t, repo.Claim(ctx, ids[0], "manager"), "认领未分配任务不应该返回错误")
+		claimed, err := repo.GetByID(ctx, ids[0])
+		require.NoError(t, err, "获取任务不应该返回错误")
+		assert.Equal(t, biz.TaskStatusClaimed, claimed.Status, "任务状态应该为已认领")
+		require.NotNil(t, claimed.ClaimTime, "应该记录认领时间")
+
+		require.NoError(t, repo.Claim(ctx, ids[0], "manager"), "重复认领自己的任务不应该返回错误")
+		reclaimed, err := repo.GetByID(ctx, ids[0])
+		require.NoError(t, err, "获取任务不应该返回错误")
+		assert.True(t, claimed.ClaimTime.Equal(*reclaimed.ClaimTime), "重复认领应该保留首次认领时间")
+
+		assert.ErrorIs(t, repo.Claim(ctx, ids[0], "other"), biz.ErrTaskAlreadyClaimed, "已被认领的任务不能再被他人认领")
+		assert.ErrorIs(t, repo.Claim(ctx, "999", "manager"), biz.ErrTaskNotFound, "不存在的任务应该返回未找到")
+	})
+
+	t.Run("委派任务", func(t *testing.T) {
+		require.NoError(t, repo.Delegate(ctx, ids[0], "deputy"), "委派不应该返回错误")
+
+		result, err := repo.GetByID(ctx, ids[0])
+		require.NoError(t, err, "获取任务不应该返回错误")
+		assert.Equal(t, "manager", result.Owner, "原办理人应该成为拥有者")
+		assert.Equal(t, "deputy", result.Assignee, "被委派人应该成为办理人")
+		assert.Equal(t, biz.TaskDelegationPending, result.Delegation, "委派状态应该为待处理")
+		assert.Equal(t, biz.TaskStatusDelegated, result.Status, "任务状态应该为委派中")
+		assert.ErrorIs(t, repo.Claim(ctx, ids[0], "deputy"), biz.ErrInvalidTaskTransition, "委派中的任务不能认领")
+
+		count, err := repo.Count(ctx, &biz.TaskInstanceFilter{Status: "delegated"})
+		require.NoError(t, err, "计数查询不应该返回错误")
+		assert.Equal(t, 1, count, "应该有一个委派中的任务")
+	})
+
+	t.Run("交还委派任务", func(t *testing.T) {
+		assert.ErrorIs(t, repo.Resolve(ctx, ids[2]), biz.ErrInvalidTaskTransition, "未委派的任务不能交还")
+
+		require.NoError(t, repo.Resolve(ctx, ids[0]), "交还委派任务不应该返回错误")
+		result, err := repo.GetByID(ctx, ids[0])
+		require.NoError(t, err, "获取任务不应该返回错误")
+		assert.Equal(t, "manager", result.Assignee, "任务应该回到拥有者手中")
+		assert.Equal(t, "manager", result.Owner, "拥有者不变")
+		assert.Equal(t, biz.TaskDelegationResolved, result.Delegation, "委派状态应该为已处理")
+		assert.Equal(t, biz.TaskStatusResolved, result.Status, "任务状态应该为已交还")
+		assert.ErrorIs(t, repo.Resolve(ctx, ids[0]), biz.ErrInvalidTaskTransition, "已交还的任务不能重复交还")
+
+		count, err := repo.Count(ctx, &biz.TaskInstanceFilter{Status: "delegated"})
+		require.NoError(t, err, "计数查询不应该返回错误")
+		assert.Equal(t, 0, count, "已交还的任务不再是委派中")
+	})
+
+	t.Run("完成任务写入流程变量", func(t *testing.T) {
+		require.NoError(t, repo.Complete(ctx, ids[1], map[string]interface{}{
+			"approved": true,
+			"days":     3,
+		}), "完成任务不应该返回错误")
+
+		completed, err := repo.GetByID(ctx, ids[1])
+		require.NoError(t, err, "完成后任务应该保留")
+		assert.Equal(t, biz.TaskStatusCompleted, completed.Status, "任务状态应该为已完成")
+		require.NotNil(t, completed.EndTime, "应该记录结束时间")
+		assert.Equal(t, completed.EndTime.Sub(completed.CreateTime).Milliseconds(), completed.Duration, "持续时间应该按创建与结束时间计算")
+		assert.ErrorIs(t, repo.Complete(ctx, ids[1], nil), biz.ErrTaskCompleted, "重复完成应该返回已完成错误")
+
+		approved, err := variables.GetByProcessInstanceIDAndName(ctx, "10", "approved")
+		require.NoError(t, err, "任务输出变量应该写入流程实例")
+		assert.Equal(t, "boolean", approved.Type, "布尔变量类型应该匹配")
+		assert.Equal(t, "true", approved.TextValue, "布尔变量值应该匹配")
+
+		days, err := variables.GetByProcessInstanceIDAndName(ctx, "10", "days")
+		require.NoError(t, err, "任务输出变量应该写入流程实例")
+		assert.Equal(t, int64(3), days.LongValue, "整数变量值应该匹配")
+	})
+
+	t.Run("按执行查询任务", func(t *testing.T) {
+		created, err := repo.Create(ctx, &ent.TaskInstance{
+			Name: "经理审批", TaskDefinitionKey: "approve", ProcessInstanceID: 30, ProcessDefinitionKey: "expense",
+			ExecutionID: "root.1",
+		})
+		require.NoError(t, err, "创建任务实例不应该返回错误")
+
+		results, err := repo.ListByExecution(ctx, 30, "root.1", "approve")
+		require.NoError(t, err, "查询执行上的任务不应该返回错误")
+		require.Len(t, results, 1, "应该返回执行上的任务")
+		assert.Equal(t, created.ID, results[0].ID, "应该返回执行上的任务")
+
+		results, err = repo.ListByExecution(ctx, 30, "root.2", "approve")
+		require.NoError(t, err, "查询执行上的任务不应该返回错误")
+		assert.Empty(t, results, "其他执行上没有任务")
+
+		require.NoError(t, repo.Claim(ctx, strconv.FormatInt(created.ID, 10), "manager"), "认领任务不应该返回错误")
+		require.NoError(t, repo.Complete(ctx, strconv.FormatInt(created.ID, 10), nil), "完成任务不应该返回错误")
+		again, err := repo.Create(ctx, &ent.TaskInstance{
+			Name: "经理审批", TaskDefinitionKey: "approve", ProcessInstanceID: 30, ProcessDefinitionKey: "expense",
+			ExecutionID: "root.1",
+		})
+		require.NoError(t, err, "创建任务实例不应该返回错误")
+		results, err = repo.ListByExecution(ctx, 30, "root.1", "approve")
+		require.NoError(t, err, "查询执行上的任务不应该返回错误")
+		require.Len(t, results, 2, "已完成的任务也应该返回")
+		assert.Equal(t, []int64{created.ID, again.ID}, []int64{results[0].ID, results[1].ID}, "任务应该按创建顺序返回")
+	})
+
+	t.Run("取消流程实例的任务", func(t *testing.T) {
+		open, err := repo.Create(ctx, &ent.TaskInstance{Name: "会签", TaskDefinitionKey: "sign", ProcessInstanceID: 50, ProcessDefinitionKey: "contract"})
+		require.NoError(t, err, "创建任务实例不应该返回错误")
+		done, err := repo.Create(ctx, &ent.TaskInstance{Name: "起草", TaskDefinitionKey: "draft", ProcessInstanceID: 50, ProcessDefinitionKey: "contract", Assignee: "manager"})
+		require.NoError(t, err, "创建任务实例不应该返回错误")
+		require.NoError(t, repo.Complete(ctx, strconv.FormatInt(done.ID, 10), nil), "完成任务不应该返回错误")
+
+		cancelled, err := repo.CancelByProcessInstance(ctx, 50, "用户撤回")
+		require.NoError(t, err, "取消任务不应该返回错误")
+		assert.Equal(t, 1, cancelled, "只应该取消未结束的任务")
+
+		result, err := repo.GetByID(ctx, strconv.FormatInt(open.ID, 10))
+		require.NoError(t, err, "获取任务不应该返回错误")
+		assert.Equal(t, biz.TaskStatusCancelled, result.Status, "任务状态应该为已取消")
+		assert.Equal(t, "用户撤回", result.DeleteReason, "应该记录取消原因")
+		assert.NotNil(t, result.EndTime, "应该记录结束时间")
+
+		result, err = repo.GetByID(ctx, strconv.FormatInt(done.ID, 10))
+		require.NoError(t, err, "获取任务不应该返回错误")
+		assert.Equal(t, biz.TaskStatusCompleted, result.Status, "已完成的任务不应该被取消")
+
+		count, err := repo.Count(ctx, &biz.TaskInstanceFilter{ProcessInstanceID: "50", States: biz.OpenTaskStatuses})
+		require.NoError(t, err, "计数查询不应该返回错误")
+		assert.Zero(t, count, "流程实例不应该还有未结束的任务")
+
+		assert.ErrorIs(t, repo.Cancel(ctx, strconv.FormatInt(done.ID, 10), "会签已通过"), biz.ErrTaskCompleted, "已完成的任务不能取消")
+		single, err := repo.Create(ctx, &ent.TaskInstance{Name: "会签", TaskDefinitionKey: "sign", ProcessInstanceID: 51, ProcessDefinitionKey: "contract"})
+		require.NoError(t, err, "创建任务实例不应该返回错误")
+		require.NoError(t, repo.Cancel(ctx, strconv.FormatInt(single.ID, 10), "会签已通过"), "取消任务不应该返回错误")
+		result, err = repo.GetByID(ctx, strconv.FormatInt(single.ID, 10))
+		require.NoError(t, err, "获取任务不应该返回错误")
+		assert.Equal(t, biz.TaskStatusCancelled, result.Status, "任务状态应该为已取消")
+		assert.Equal(t, "会签已通过", result.DeleteReason, "应该记录取消原因")
+	})
+
+	t.Run("候选人与参与者", func(t *testing.T) {
+		finance, err := repo.Create(ctx, &ent.TaskInstance{Name: "财务复核", TaskDefinitionKey: "review", ProcessInstanceID: 40, ProcessDefinitionKey: "expense"})
+		require.NoError(t, err, "创建任务实例不应该返回错误")
+		legal, err := repo.Create(ctx, &ent.TaskInstance{Name: "法务复核", TaskDefinitionKey: "review", ProcessInstanceID: 41, ProcessDefinitionKey: "contract"})
+		require.NoError(t, err, "创建任务实例不应该返回错误")
+
+		require.NoError(t, repo.AddCandidates(ctx, finance.ID, []string{"alice"}, []string{"finance"}), "添加候选人不应该返回错误")
+		require.NoError(t, repo.AddCandidates(ctx, finance.ID, []string{"alice"}, []string{"finance"}), "重复添加候选人不应该返回错误")
+		require.NoError(t, repo.AddCandidates(ctx, legal.ID, nil, []string{"legal"}), "添加候选组不应该返回错误")
+		assert.Error(t, repo.AddCandidates(ctx, 999, []string{"alice"}, nil), "不存在的任务应该返回错误")
+
+		links, err := repo.ListIdentityLinks(ctx, finance.ID)
+		require.NoError(t, err, "查询身份关联不应该返回错误")
+		assert.Len(t, links, 2, "重复添加的候选人不应该重复保存")
+
+		candidates := func(filter *biz.TaskInstanceFilter) []int64 {
+			results, _, err := repo.List(ctx, filter, nil)
+			require.NoError(t, err, "按候选人查询不应该返回错误")
+			var ids []int64
+			for _, result := range results {
+				ids = append(ids, result.ID)
+			}
+			return ids
+		}
+		assert.Equal(t, []int64{finance.ID}, candidates(&biz.TaskInstanceFilter{CandidateUser: "alice"}), "候选用户只能看到自己的任务")
+		assert.ElementsMatch(t, []int64{finance.ID, legal.ID}, candidates(&biz.TaskInstanceFilter{CandidateUser: "bob", CandidateGroups: []string{"finance", "legal"}}), "候选组成员应该看到所在组的任务")
+		assert.Empty(t, candidates(&biz.TaskInstanceFilter{CandidateUser: "bob", CandidateGroups: []string{"hr"}}), "非候选人不应该看到任务")
+
+		require.NoError(t, repo.Claim(ctx, strconv.FormatInt(finance.ID, 10), "alice"), "认领任务不应该返回错误")
+		require.NoError(t, repo.AddParticipant(ctx, finance.ID, "alice"), "添加参与者不应该返回错误")
+		assert.Empty(t, candidates(&biz.TaskInstanceFilter{CandidateUser: "alice", Status: "unassigned"}), "已认领的任务不再可认领")
+
+		links, err = repo.ListIdentityLinks(ctx, finance.ID)
+		require.NoError(t, err, "查询身份关联不应该返回错误")
+		require.Len(t, links, 3, "认领人应该记为参与者")
+		assert.Equal(t, biz.IdentityLinkParticipant, links[2].Type, "关联类型应该为参与者")
+
+		require.NoError(t, repo.Delete(ctx, strconv.FormatInt(finance.ID, 10)), "删除任务不应该返回错误")
+		links, err = repo.ListIdentityLinks(ctx, finance.ID)
+		require.NoError(t, err, "查询身份关联不应该返回错误")
+		assert.Empty(t, links, "删除任务后身份关联应该删除")
+	})
+	t.Run("子任务", func(t *testing.T) {
+		parent, err := repo.Create(ctx, &ent.TaskInstance{Name: "合同审批", TaskDefinitionKey: "approve", ProcessInstanceID: 60, ProcessDefinitionKey: "contract", CreateTime: base})
+		require.NoError(t, err, "创建任务实例不应该返回错误")
+		parentID := strconv.FormatInt(parent.ID, 10)
+		first, err := repo.Create(ctx, &ent.TaskInstance{Name: "核对金额", TaskDefinitionKey: "approve", ProcessInstanceID: 60, ProcessDefinitionKey: "contract", ParentTaskID: parentID, CreateTime: base.Add(time.Minute)})
+		require.NoError(t, err, "创建子任务不应该返回错误")
+		_, err = repo.Create(ctx, &ent.TaskInstance{Name: "核对条款", TaskDefinitionKey: "approve", ProcessInstanceID: 60, ProcessDefinitionKey: "contract", ParentTaskID: parentID, CreateTime: base.Add(2 * time.Minute)})
+		require.NoError(t, err, "创建子任务不应该返回错误")
+		require.NoError(t, repo.Complete(ctx, strconv.FormatInt(first.ID, 10), nil), "完成子任务不应该返回错误")
+
+		children, err := repo.ListSubtasks(ctx, []string{parentID})
+		require.NoError(t, err, "查询子任务不应该返回错误")
+		require.Len(t, children, 2, "应该返回两个子任务")
+		assert.Equal(t, first.ID, children[0].ID, "子任务按创建时间排序")
+
+		open, err := repo.Count(ctx, &biz.TaskInstanceFilter{ParentTaskID: parentID, States: biz.OpenTaskStatuses})
+		require.NoError(t, err, "计数查询不应该返回错误")
+		assert.Equal(t, 1, open, "应该只有一个未结束的子任务")
+
+		roots, _, err := repo.List(ctx, &biz.TaskInstanceFilter{ProcessInstanceID: "60", RootOnly: true}, nil)
+		require.NoError(t, err, "查询顶层任务不应该返回错误")
+		require.Len(t, roots, 1, "只应该返回顶层任务")
+		assert.Equal(t, parent.ID, roots[0].ID, "顶层任务应该匹配")
+	})
+	t.Run("转交任务与提高优先级", func(t *testing.T) {
+		task, err := repo.Create(ctx, &ent.TaskInstance{Name: "主管审批", TaskDefinitionKey: "approve", ProcessInstanceID: 70, ProcessDefinitionKey: "leave", Priority: 50})
+		require.NoError(t, err, "创建任务实例不应该返回错误")
+		id := strconv.FormatInt(task.ID, 10)
+		require.NoError(t, repo.Claim(ctx, id, "alice"), "认领任务不应该返回错误")
+		require.NoError(t, repo.Delegate(ctx, id, "bob"), "委派任务不应该返回错误")
+
+		require.NoError(t, repo.Reassign(ctx, id, "", []string{"managers"}), "转交任务不应该返回错误")
+		reassigned, err := repo.GetByID(ctx, id)
+		require.NoError(t, err, "获取任务不应该返回错误")
+		assert.Equal(t, biz.TaskStatusCreated, reassigned.Status, "转交给候选组后任务应该待认领")
+		assert.Empty(t, reassigned.Assignee, "转交给候选组后任务不应该有办理人")
+		assert.Empty(t, reassigned.Owner, "转交后应该清除拥有者")
+		assert.Nil(t, reassigned.ClaimTime, "转交给候选组后应该清除认领时间")
+		require.NoError(t, repo.Claim(ctx, id, "carol"), "候选组成员应该可以认领转交的任务")
+
+		raised, err := repo.RaisePriority(ctx, id, 90)
+		require.NoError(t, err, "提高优先级不应该返回错误")
+		assert.True(t, raised, "优先级应该提高")
+		raised, err = repo.RaisePriority(ctx, id, 60)
+		require.NoError(t, err, "提高优先级不应该返回错误")
+		assert.False(t, raised, "不应该降低优先级")
+
+		require.NoError(t, repo.Complete(ctx, id, nil), "完成任务不应该返回错误")
+		assert.ErrorIs(t, repo.Reassign(ctx, id, "dave", nil), biz.ErrTaskCompleted, "已完成的任务不能转交")
+	})
+
+	t.Run("取消认领任务", func(t *testing.T) {
+		task, err := repo.Create(ctx, &ent.TaskInstance{Name: "财务审批", TaskDefinitionKey: "finance", ProcessInstanceID: 71, ProcessDefinitionKey: "leave"})
+		require.NoError(t, err, "创建任务实例不应该返回错误")
+		id := strconv.FormatInt(task.ID, 10)
+		require.NoError(t, repo.Claim(ctx, id, "alice"), "认领任务不应该返回错误")
+
+		assert.ErrorIs(t, repo.Unclaim(ctx, id, "bob"), biz.ErrTaskNotAssignee, "不能取消他人认领的任务")
+		require.NoError(t, repo.Unclaim(ctx, id, "alice"), "取消认领不应该返回错误")
+		unclaimed, err := repo.GetByID(ctx, id)
+		require.NoError(t, err, "获取任务不应该返回错误")
+		assert.Equal(t, biz.TaskStatusCreated, unclaimed.Status, "取消认领后任务应该待认领")
+		assert.Empty(t, unclaimed.Assignee, "取消认领后任务不应该有办理人")
+		assert.Nil(t, unclaimed.ClaimTime, "取消认领后应该清除认领时间")
+
+		require.NoError(t, repo.Claim(ctx, id, "bob"), "取消认领后其他用户应该可以认领")
+		require.NoError(t, repo.Delegate(ctx, id, "carol"), "委派任务不应该返回错误")
+		assert.ErrorIs(t, repo.Unclaim(ctx, id, "carol"), biz.ErrInvalidTaskTransition, "委派中的任务不能取消认领")
+	})
+
+	t.Run("只修改请求中设置的字段", func(t *testing.T) {
+		task, err := repo.Create(ctx, &ent.TaskInstance{Name: "出纳审批", TaskDefinitionKey: "cashier", ProcessInstanceID: 72, ProcessDefinitionKey: "expense", Priority: 50})
+		require.NoError(t, err, "创建任务实例不应该返回错误")
+		id := strconv.FormatInt(task.ID, 10)
+
+		// 读取任务后另一个请求认领了任务，修改信息不应该覆盖办理人
+		stale, err := repo.GetByID(ctx, id)
+		require.NoError(t, err, "获取任务不应该返回错误")
+		require.NoError(t, repo.Claim(ctx, id, "alice"), "认领任务不应该返回错误")
+		priority := int32(80)
+		updated, err := repo.UpdateInfo(ctx, id, &biz.UpdateTaskRequest{Priority: &priority})
+		require.NoError(t, err, "修改任务信息不应该返回错误")
+		assert.Equal(t, priority, updated.Priority, "优先级应该更新")
+		assert.Equal(t, stale.Name, updated.Name, "未设置的字段应该保持不变")
+		assert.Equal(t, "alice", updated.Assignee, "修改任务信息不应该覆盖并发认领的办理人")
+		assert.Equal(t, biz.TaskStatusClaimed, updated.Status, "修改任务信息不应该覆盖任务状态")
+
+		require.NoError(t, repo.Complete(ctx, id, nil), "完成任务不应该返回错误")
+		name := "出纳复核"
+		_, err = repo.UpdateInfo(ctx, id, &biz.UpdateTaskRequest{Name: &name})
+		assert.ErrorIs(t, err, biz.ErrTaskCompleted, "已完成的任务不能修改")
+		completed, err := repo.GetByID(ctx, id)
+		require.NoError(t, err, "获取任务不应该返回错误")
+		assert.Equal(t, stale.Name, completed.Name, "已完成的任务名称不应该被修改")
+
+		_, err = repo.UpdateInfo(ctx, "999999", &biz.UpdateTaskRequest{Name: &name})
+		assert.ErrorIs(t, err, biz.ErrTaskNotFound, "不存在的任务应该返回未找到")
+	})
+}
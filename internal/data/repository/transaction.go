@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+
+	"go.uber.org/zap"
+)
+
+// ErrNoTransaction 上下文中没有进行中的事务
+var ErrNoTransaction = errors.New("当前上下文没有进行中的事务")
+
+// transactionRepo 事务仓储实现
+// 事务通过上下文传递，仓储方法从上下文中取出事务客户端，从而加入同一事务
+type transactionRepo struct {
+	data   *ent.Client
+	logger *zap.Logger
+}
+
+// NewTransactionRepo 创建事务仓储实例
+func NewTransactionRepo(data *ent.Client, logger *zap.Logger) biz.TransactionRepo {
+	return &transactionRepo{
+		data:   data,
+		logger: logger,
+	}
+}
+
+// Transaction 在事务中执行 fn
+// fn 返回错误或发生 panic 时回滚，否则提交；上下文中已有事务时直接加入该事务，由最外层负责提交
+func (r *transactionRepo) Transaction(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if ent.TxFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	txCtx, err := r.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			if rbErr := r.Rollback(txCtx); rbErr != nil {
+				r.logger.Error("事务回滚失败", zap.Error(rbErr))
+			}
+			panic(v)
+		}
+	}()
+
+	if err := fn(txCtx); err != nil {
+		if rbErr := r.Rollback(txCtx); rbErr != nil {
+			r.logger.Error("事务回滚失败", zap.Error(rbErr))
+			return fmt.Errorf("%w; 回滚事务失败: %v", err, rbErr)
+		}
+		return err
+	}
+	return r.Commit(txCtx)
+}
+
+// Begin 开启事务，返回携带事务的上下文
+func (r *transactionRepo) Begin(ctx context.Context) (context.Context, error) {
+	if ent.TxFromContext(ctx) != nil {
+		return nil, fmt.Errorf("上下文中已有进行中的事务")
+	}
+
+	tx, err := r.data.Tx(ctx)
+	if err != nil {
+		r.logger.Error("开启事务失败", zap.Error(err))
+		return nil, fmt.Errorf("开启事务失败: %w", err)
+	}
+	return ent.NewTxContext(ctx, tx), nil
+}
+
+// Commit 提交上下文中的事务
+func (r *transactionRepo) Commit(ctx context.Context) error {
+	tx := ent.TxFromContext(ctx)
+	if tx == nil {
+		return ErrNoTransaction
+	}
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("提交事务失败", zap.Error(err))
+		return fmt.Errorf("提交事务失败: %w", err)
+	}
+	return nil
+}
+
+// Rollback 回滚上下文中的事务
+func (r *transactionRepo) Rollback(ctx context.Context) error {
+	tx := ent.TxFromContext(ctx)
+	if tx == nil {
+		return ErrNoTransaction
+	}
+	if err := tx.Rollback(); err != nil {
+		return fmt.Errorf("回滚事务失败: %w", err)
+	}
+	return nil
+}
+
+// entClient 返回当前上下文应使用的ent客户端
+// 上下文中有事务时返回事务客户端，使仓储操作加入该事务
+func entClient(ctx context.Context, client *ent.Client) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+	return client
+}
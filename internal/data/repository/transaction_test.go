@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// TestTransactionRepo 测试事务仓储
+func TestTransactionRepo(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	txRepo := NewTransactionRepo(client, zap.NewNop())
+	instances := NewProcessInstanceRepo(client, zap.NewNop())
+	variables := NewProcessVariableRepo(client, zap.NewNop())
+
+	countInstances := func(t *testing.T) int {
+		count, err := instances.Count(ctx, nil)
+		require.NoError(t, err, "计数查询不应该返回错误")
+		return count
+	}
+
+	t.Run("成功时提交全部写入", func(t *testing.T) {
+		var instanceID string
+		err := txRepo.Transaction(ctx, func(ctx context.Context) error {
+			pi, err := instances.Create(ctx, &ent.ProcessInstance{ProcessDefinitionKey: "leave"})
+			if err != nil {
+				return err
+			}
+			instanceID = strconv.FormatInt(pi.ID, 10)
+			return variables.SetVariables(ctx, instanceID, map[string]interface{}{"days": 3})
+		})
+		require.NoError(t, err, "事务不应该返回错误")
+
+		assert.Equal(t, 1, countInstances(t), "流程实例应该被提交")
+		results, err := variables.ListByProcessInstanceID(ctx, instanceID)
+		require.NoError(t, err, "查询变量不应该返回错误")
+		assert.Len(t, results, 1, "流程变量应该被提交")
+	})
+
+	t.Run("返回错误时回滚全部写入", func(t *testing.T) {
+		before := countInstances(t)
+		err := txRepo.Transaction(ctx, func(ctx context.Context) error {
+			if _, err := instances.Create(ctx, &ent.ProcessInstance{ProcessDefinitionKey: "leave"}); err != nil {
+				return err
+			}
+			return errors.New("保存变量失败")
+		})
+
+		assert.EqualError(t, err, "保存变量失败", "应该返回事务函数的错误")
+		assert.Equal(t, before, countInstances(t), "流程实例应该被回滚")
+	})
+
+	t.Run("发生panic时回滚并继续抛出", func(t *testing.T) {
+		before := countInstances(t)
+		assert.Panics(t, func() {
+			_ = txRepo.Transaction(ctx, func(ctx context.Context) error {
+				if _, err := instances.Create(ctx, &ent.ProcessInstance{ProcessDefinitionKey: "leave"}); err != nil {
+					return err
+				}
+				panic("unexpected")
+			})
+		}, "panic应该继续向上抛出")
+		assert.Equal(t, before, countInstances(t), "流程实例应该被回滚")
+	})
+
+	t.Run("嵌套事务加入外层事务", func(t *testing.T) {
+		before := countInstances(t)
+		err := txRepo.Transaction(ctx, func(ctx context.Context) error {
+			inner := txRepo.Transaction(ctx, func(ctx context.Context) error {
+				_, err := instances.Create(ctx, &ent.ProcessInstance{ProcessDefinitionKey: "leave"})
+				return err
+			})
+			require.NoError(t, inner, "内层事务不应该返回错误")
+			return errors.New("外层失败")
+		})
+
+		assert.Error(t, err, "外层事务应该返回错误")
+		assert.Equal(t, before, countInstances(t), "内层写入应该随外层一起回滚")
+	})
+
+	t.Run("手动开启与提交事务", func(t *testing.T) {
+		before := countInstances(t)
+		txCtx, err := txRepo.Begin(ctx)
+		require.NoError(t, err, "开启事务不应该返回错误")
+
+		_, err = txRepo.Begin(txCtx)
+		assert.Error(t, err, "同一上下文不能重复开启事务")
+
+		_, err = instances.Create(txCtx, &ent.ProcessInstance{ProcessDefinitionKey: "leave"})
+		require.NoError(t, err, "事务内创建不应该返回错误")
+		require.NoError(t, txRepo.Commit(txCtx), "提交事务不应该返回错误")
+
+		assert.Equal(t, before+1, countInstances(t), "提交后应该可以查询到新实例")
+		assert.ErrorIs(t, txRepo.Commit(ctx), ErrNoTransaction, "没有事务时提交应该返回错误")
+		assert.ErrorIs(t, txRepo.Rollback(ctx), ErrNoTransaction, "没有事务时回滚应该返回错误")
+	})
+}
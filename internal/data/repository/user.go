@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/user"
+
+	"go.uber.org/zap"
+)
+
+// userRepo 用户仓储实现
+type userRepo struct {
+	data   *ent.Client
+	logger *zap.Logger
+}
+
+// NewUserRepo 创建用户仓储实例
+func NewUserRepo(data *ent.Client, logger *zap.Logger) biz.UserRepo {
+	return &userRepo{
+		data:   data,
+		logger: logger,
+	}
+}
+
+// Create 创建用户
+func (r *userRepo) Create(ctx context.Context, u *ent.User) (*ent.User, error) {
+	r.logger.Info("创建用户", zap.String("username", u.Username))
+
+	create := entClient(ctx, r.data).User.Create().
+		SetUsername(u.Username).
+		SetEmail(u.Email).
+		SetDisplayName(u.DisplayName).
+		SetPasswordHash(u.PasswordHash).
+		SetRoles(u.Roles).
+		SetGroups(u.Groups)
+	if u.Status != "" {
+		create = create.SetStatus(u.Status)
+	}
+	if u.TenantID != "" {
+		create = create.SetTenantID(u.TenantID)
+	}
+
+	result, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("%w: %s", biz.ErrUserExists, u.Username)
+		}
+		r.logger.Error("创建用户失败", zap.String("username", u.Username), zap.Error(err))
+		return nil, fmt.Errorf("创建用户失败: %w", err)
+	}
+	return result, nil
+}
+
+// GetByID 根据ID获取用户
+func (r *userRepo) GetByID(ctx context.Context, id int64) (*ent.User, error) {
+	result, err := entClient(ctx, r.data).User.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %d", biz.ErrUserNotFound, id)
+		}
+		r.logger.Error("获取用户失败", zap.Int64("id", id), zap.Error(err))
+		return nil, fmt.Errorf("获取用户失败: %w", err)
+	}
+	return result, nil
+}
+
+// GetByUsername 根据用户名获取用户
+func (r *userRepo) GetByUsername(ctx context.Context, username string) (*ent.User, error) {
+	result, err := entClient(ctx, r.data).User.Query().
+		Where(user.Username(username)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %s", biz.ErrUserNotFound, username)
+		}
+		r.logger.Error("获取用户失败", zap.String("username", username), zap.Error(err))
+		return nil, fmt.Errorf("获取用户失败: %w", err)
+	}
+	return result, nil
+}
+
+// Update 更新用户资料、角色、用户组与状态
+func (r *userRepo) Update(ctx context.Context, u *ent.User) (*ent.User, error) {
+	r.logger.Info("更新用户", zap.Int64("id", u.ID))
+
+	result, err := entClient(ctx, r.data).User.UpdateOneID(u.ID).
+		SetEmail(u.Email).
+		SetDisplayName(u.DisplayName).
+		SetRoles(u.Roles).
+		SetGroups(u.Groups).
+		SetStatus(u.Status).
+		SetTenantID(u.TenantID).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %d", biz.ErrUserNotFound, u.ID)
+		}
+		r.logger.Error("更新用户失败", zap.Int64("id", u.ID), zap.Error(err))
+		return nil, fmt.Errorf("更新用户失败: %w", err)
+	}
+	return result, nil
+}
+
+// UpdatePassword 更新密码哈希并记录修改时间
+func (r *userRepo) UpdatePassword(ctx context.Context, id int64, passwordHash string) error {
+	err := entClient(ctx, r.data).User.UpdateOneID(id).
+		SetPasswordHash(passwordHash).
+		SetPasswordChangedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("%w: %d", biz.ErrUserNotFound, id)
+		}
+		r.logger.Error("更新用户密码失败", zap.Int64("id", id), zap.Error(err))
+		return fmt.Errorf("更新用户密码失败: %w", err)
+	}
+	return nil
+}
+
+// RehashPassword 保存重新计算的密码哈希，不改变密码修改时间
+func (r *userRepo) RehashPassword(ctx context.Context, id int64, passwordHash string) error {
+	if err := entClient(ctx, r.data).User.UpdateOneID(id).SetPasswordHash(passwordHash).Exec(ctx); err != nil {
+		r.logger.Error("更新用户密码哈希失败", zap.Int64("id", id), zap.Error(err))
+		return fmt.Errorf("更新用户密码哈希失败: %w", err)
+	}
+	return nil
+}
+
+// RecordLogin 记录最后登录时间
+func (r *userRepo) RecordLogin(ctx context.Context, id int64, at time.Time) error {
+	if err := entClient(ctx, r.data).User.UpdateOneID(id).SetLastLoginAt(at).Exec(ctx); err != nil {
+		r.logger.Error("记录用户登录时间失败", zap.Int64("id", id), zap.Error(err))
+		return fmt.Errorf("记录用户登录时间失败: %w", err)
+	}
+	return nil
+}
+
+// List 分页查询用户
+func (r *userRepo) List(ctx context.Context, filter *biz.UserFilter, opts *biz.QueryOptions) ([]*ent.User, *biz.PaginationResult, error) {
+	query := entClient(ctx, r.data).User.Query()
+	if filter != nil {
+		if filter.Status != "" {
+			query = query.Where(user.Status(filter.Status))
+		}
+		if filter.Role != "" {
+			query = query.Where(func(s *sql.Selector) {
+				s.Where(sqljson.ValueContains(user.FieldRoles, filter.Role))
+			})
+		}
+		if filter.Group != "" {
+			query = query.Where(func(s *sql.Selector) {
+				s.Where(sqljson.ValueContains(user.FieldGroups, filter.Group))
+			})
+		}
+	}
+	if opts != nil && opts.Search != "" {
+		query = query.Where(user.Or(
+			user.UsernameContainsFold(opts.Search),
+			user.EmailContainsFold(opts.Search),
+			user.DisplayNameContainsFold(opts.Search),
+		))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		r.logger.Error("查询用户总数失败", zap.Error(err))
+		return nil, nil, fmt.Errorf("查询用户总数失败: %w", err)
+	}
+
+	query = query.Order(orderBy(opts, map[string]string{
+		"username":   user.FieldUsername,
+		"created_at": user.FieldCreatedAt,
+	}, user.FieldCreatedAt), ent.Asc(user.FieldID))
+	if opts != nil && opts.Page > 0 && opts.PageSize > 0 {
+		query = query.Offset((opts.Page - 1) * opts.PageSize).Limit(opts.PageSize)
+	}
+
+	results, err := query.All(ctx)
+	if err != nil {
+		r.logger.Error("查询用户失败", zap.Error(err))
+		return nil, nil, fmt.Errorf("查询用户失败: %w", err)
+	}
+	return results, pagination(total, opts), nil
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// TestUserRepo 测试用户仓储
+func TestUserRepo(t *testing.T) {
+	ctx := context.Background()
+	repo := NewUserRepo(newTestClient(t), zap.NewNop())
+
+	alice, err := repo.Create(ctx, &ent.User{
+		Username:     "alice",
+		Email:        "alice@example.com",
+		PasswordHash: "hash-1",
+		Roles:        []string{"reviewer", "auditor"},
+		Groups:       []string{"finance"},
+	})
+	require.NoError(t, err, "创建用户不应该返回错误")
+	_, err = repo.Create(ctx, &ent.User{
+		Username:     "bob",
+		DisplayName:  "Bob",
+		PasswordHash: "hash-2",
+		Status:       biz.UserStatusDisabled,
+		Roles:        []string{"reviewer"},
+	})
+	require.NoError(t, err, "创建用户不应该返回错误")
+
+	t.Run("创建时使用默认值", func(t *testing.T) {
+		assert.Equal(t, biz.UserStatusActive, alice.Status, "默认状态应该为 active")
+		assert.Equal(t, "default", alice.TenantID, "默认租户应该为 default")
+		assert.False(t, alice.PasswordChangedAt.IsZero(), "应该记录密码修改时间")
+	})
+
+	t.Run("用户名重复", func(t *testing.T) {
+		_, err := repo.Create(ctx, &ent.User{Username: "alice", PasswordHash: "hash"})
+		assert.ErrorIs(t, err, biz.ErrUserExists, "应该返回用户已存在")
+	})
+
+	t.Run("按ID与用户名查询", func(t *testing.T) {
+		u, err := repo.GetByUsername(ctx, "alice")
+		require.NoError(t, err, "查询用户不应该返回错误")
+		assert.Equal(t, alice.ID, u.ID, "用户ID应该匹配")
+
+		_, err = repo.GetByUsername(ctx, "nobody")
+		assert.ErrorIs(t, err, biz.ErrUserNotFound, "应该返回用户不存在")
+		_, err = repo.GetByID(ctx, 9999)
+		assert.ErrorIs(t, err, biz.ErrUserNotFound, "应该返回用户不存在")
+	})
+
+	t.Run("修改密码记录修改时间，重新计算哈希不改变修改时间", func(t *testing.T) {
+		before, err := repo.GetByID(ctx, alice.ID)
+		require.NoError(t, err)
+
+		require.NoError(t, repo.RehashPassword(ctx, alice.ID, "rehashed"), "重新计算哈希不应该返回错误")
+		u, err := repo.GetByID(ctx, alice.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "rehashed", u.PasswordHash, "哈希应该被更新")
+		assert.True(t, before.PasswordChangedAt.Equal(u.PasswordChangedAt), "密码修改时间不应该变化")
+
+		time.Sleep(5 * time.Millisecond)
+		require.NoError(t, repo.UpdatePassword(ctx, alice.ID, "hash-3"), "修改密码不应该返回错误")
+		u, err = repo.GetByID(ctx, alice.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "hash-3", u.PasswordHash, "哈希应该被更新")
+		assert.True(t, u.PasswordChangedAt.After(before.PasswordChangedAt), "密码修改时间应该更新")
+
+		assert.ErrorIs(t, repo.UpdatePassword(ctx, 9999, "hash"), biz.ErrUserNotFound, "应该返回用户不存在")
+	})
+
+	t.Run("更新资料与记录登录时间", func(t *testing.T) {
+		u, err := repo.GetByID(ctx, alice.ID)
+		require.NoError(t, err)
+		u.DisplayName = "Alice"
+		u.Groups = []string{"finance", "hr"}
+		updated, err := repo.Update(ctx, u)
+		require.NoError(t, err, "更新用户不应该返回错误")
+		assert.Equal(t, "Alice", updated.DisplayName, "显示名称应该被更新")
+		assert.Equal(t, "hash-3", updated.PasswordHash, "更新资料不应该修改密码")
+
+		at := time.Now()
+		require.NoError(t, repo.RecordLogin(ctx, alice.ID, at), "记录登录时间不应该返回错误")
+		u, err = repo.GetByID(ctx, alice.ID)
+		require.NoError(t, err)
+		require.NotNil(t, u.LastLoginAt, "应该记录登录时间")
+		assert.WithinDuration(t, at, *u.LastLoginAt, time.Millisecond, "登录时间应该匹配")
+	})
+
+	t.Run("按状态、角色、用户组过滤与搜索", func(t *testing.T) {
+		users, page, err := repo.List(ctx, &biz.UserFilter{Role: "reviewer"}, &biz.QueryOptions{Page: 1, PageSize: 10})
+		require.NoError(t, err, "查询用户不应该返回错误")
+		assert.Equal(t, 2, page.Total, "两个用户都有 reviewer 角色")
+		assert.Len(t, users, 2)
+
+		users, _, err = repo.List(ctx, &biz.UserFilter{Role: "review"}, &biz.QueryOptions{Page: 1, PageSize: 10})
+		require.NoError(t, err)
+		assert.Empty(t, users, "角色应该精确匹配")
+
+		users, _, err = repo.List(ctx, &biz.UserFilter{Group: "hr"}, &biz.QueryOptions{Page: 1, PageSize: 10})
+		require.NoError(t, err)
+		require.Len(t, users, 1)
+		assert.Equal(t, "alice", users[0].Username)
+
+		users, _, err = repo.List(ctx, &biz.UserFilter{Status: biz.UserStatusDisabled}, &biz.QueryOptions{Page: 1, PageSize: 10})
+		require.NoError(t, err)
+		require.Len(t, users, 1)
+		assert.Equal(t, "bob", users[0].Username)
+
+		users, _, err = repo.List(ctx, &biz.UserFilter{}, &biz.QueryOptions{Page: 1, PageSize: 10, Search: "EXAMPLE"})
+		require.NoError(t, err)
+		require.Len(t, users, 1, "应该按邮箱搜索且不区分大小写")
+		assert.Equal(t, "alice", users[0].Username)
+	})
+}
+
+// TestRoleRepo 测试角色与用户组仓储
+func TestRoleRepo(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	roles := NewRoleRepo(client, zap.NewNop())
+	groups := NewGroupRepo(client, zap.NewNop())
+
+	t.Run("角色", func(t *testing.T) {
+		_, err := roles.Create(ctx, &ent.Role{Name: "reviewer", Permissions: []string{"task:complete"}})
+		require.NoError(t, err, "创建角色不应该返回错误")
+		_, err = roles.Create(ctx, &ent.Role{Name: "auditor"})
+		require.NoError(t, err, "创建角色不应该返回错误")
+		_, err = roles.Create(ctx, &ent.Role{Name: "reviewer"})
+		assert.ErrorIs(t, err, biz.ErrRoleExists, "应该返回角色已存在")
+
+		role, err := roles.GetByName(ctx, "reviewer")
+		require.NoError(t, err)
+		role.Permissions = []string{"task:complete", "task:claim"}
+		updated, err := roles.Update(ctx, role)
+		require.NoError(t, err, "更新角色不应该返回错误")
+		assert.Equal(t, []string{"task:complete", "task:claim"}, updated.Permissions, "权限应该被更新")
+
+		_, err = roles.GetByName(ctx, "nobody")
+		assert.ErrorIs(t, err, biz.ErrRoleNotFound, "应该返回角色不存在")
+
+		found, err := roles.ListByNames(ctx, []string{"reviewer", "nobody"})
+		require.NoError(t, err)
+		require.Len(t, found, 1, "不存在的名称应该被忽略")
+		assert.Equal(t, "reviewer", found[0].Name)
+
+		all, err := roles.List(ctx)
+		require.NoError(t, err)
+		assert.Len(t, all, 2)
+	})
+
+	t.Run("用户组", func(t *testing.T) {
+		_, err := groups.Create(ctx, &ent.Group{Name: "finance"})
+		require.NoError(t, err, "创建用户组不应该返回错误")
+		_, err = groups.Create(ctx, &ent.Group{Name: "finance"})
+		assert.ErrorIs(t, err, biz.ErrGroupExists, "应该返回用户组已存在")
+
+		found, err := groups.ListByNames(ctx, []string{"finance", "hr"})
+		require.NoError(t, err)
+		assert.Len(t, found, 1, "不存在的名称应该被忽略")
+	})
+}
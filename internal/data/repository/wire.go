@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"github.com/google/wire"
+)
+
+// ProviderSet 数据仓储层的依赖注入提供器集合
+var ProviderSet = wire.NewSet(
+	NewProcessDefinitionRepo,
+	NewProcessInstanceRepo,
+	NewTaskInstanceRepo,
+	NewProcessVariableRepo,
+	NewProcessEventRepo,
+	NewTaskCommentRepo,
+	NewTaskAttachmentRepo,
+	NewHistoricProcessInstanceRepo,
+	NewUserRepo,
+	NewRoleRepo,
+	NewGroupRepo,
+	NewRefreshTokenRepo,
+	NewAuthorizationRepo,
+	NewTransactionRepo,
+	NewCacheRepo,
+)
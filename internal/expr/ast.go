@@ -0,0 +1,77 @@
+package expr
+
+// node 语法树节点
+type node interface {
+	position() int
+}
+
+// literalNode 字面量：float64、string、bool 或 nil
+type literalNode struct {
+	pos   int
+	value interface{}
+}
+
+// identNode 变量引用
+type identNode struct {
+	pos  int
+	name string
+}
+
+// memberNode 成员访问 a.b，optional 表示空值安全访问 a?.b
+type memberNode struct {
+	pos      int
+	object   node
+	name     string
+	optional bool
+}
+
+// indexNode 下标访问 a[i]，optional 表示空值安全访问 a?.[i]
+type indexNode struct {
+	pos      int
+	object   node
+	index    node
+	optional bool
+}
+
+// unaryNode 一元运算
+type unaryNode struct {
+	pos     int
+	op      string
+	operand node
+}
+
+// binaryNode 二元运算
+type binaryNode struct {
+	pos         int
+	op          string
+	left, right node
+}
+
+// conditionalNode 条件运算 cond ? a : b
+type conditionalNode struct {
+	pos             int
+	cond, then, els node
+}
+
+// listNode 列表字面量 [a, b]
+type listNode struct {
+	pos   int
+	items []node
+}
+
+// callNode 内置函数调用
+type callNode struct {
+	pos  int
+	name string
+	args []node
+}
+
+func (n *literalNode) position() int     { return n.pos }
+func (n *identNode) position() int       { return n.pos }
+func (n *memberNode) position() int      { return n.pos }
+func (n *indexNode) position() int       { return n.pos }
+func (n *unaryNode) position() int       { return n.pos }
+func (n *binaryNode) position() int      { return n.pos }
+func (n *conditionalNode) position() int { return n.pos }
+func (n *listNode) position() int        { return n.pos }
+func (n *callNode) position() int        { return n.pos }
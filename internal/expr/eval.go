@@ -0,0 +1,465 @@
+package expr
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"unicode/utf8"
+)
+
+// evaluator 表达式求值器
+// 每次求值都有独立的步数预算，超出后立即终止
+type evaluator struct {
+	vars  map[string]interface{}
+	steps int
+}
+
+// eval 计算节点的值
+func (e *evaluator) eval(n node) (interface{}, error) {
+	e.steps++
+	if e.steps > MaxSteps {
+		return nil, newError(n.position(), "表达式计算步数超过限制 %d", MaxSteps)
+	}
+
+	switch n := n.(type) {
+	case *literalNode:
+		return n.value, nil
+
+	case *identNode:
+		return e.vars[n.name], nil
+
+	case *memberNode:
+		obj, err := e.eval(n.object)
+		if err != nil {
+			return nil, err
+		}
+		if obj == nil {
+			if n.optional {
+				return nil, nil
+			}
+			return nil, newError(n.pos, "无法访问空值的成员 %s，可使用 ?. 进行空值安全访问", n.name)
+		}
+		m, ok := asMap(obj)
+		if !ok {
+			return nil, newError(n.pos, "%s 类型没有成员 %s", typeName(obj), n.name)
+		}
+		return m[n.name], nil
+
+	case *indexNode:
+		obj, err := e.eval(n.object)
+		if err != nil {
+			return nil, err
+		}
+		if obj == nil && n.optional {
+			return nil, nil
+		}
+		index, err := e.eval(n.index)
+		if err != nil {
+			return nil, err
+		}
+		return e.index(n, obj, index)
+
+	case *unaryNode:
+		v, err := e.eval(n.operand)
+		if err != nil {
+			return nil, err
+		}
+		if n.op == "!" {
+			b, err := asBool(n.pos, v)
+			if err != nil {
+				return nil, err
+			}
+			return !b, nil
+		}
+		f, ok := toNumber(v)
+		if !ok {
+			return nil, newError(n.pos, "运算符 - 需要 number，实际为 %s", typeName(v))
+		}
+		return -f, nil
+
+	case *binaryNode:
+		return e.binary(n)
+
+	case *conditionalNode:
+		cond, err := e.eval(n.cond)
+		if err != nil {
+			return nil, err
+		}
+		b, err := asBool(n.pos, cond)
+		if err != nil {
+			return nil, err
+		}
+		if b {
+			return e.eval(n.then)
+		}
+		return e.eval(n.els)
+
+	case *listNode:
+		list := make([]interface{}, len(n.items))
+		for i, item := range n.items {
+			v, err := e.eval(item)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = v
+		}
+		return list, nil
+
+	case *callNode:
+		fn, ok := functions[n.name]
+		if !ok {
+			return nil, newError(n.pos, "未知函数: %s", n.name)
+		}
+		args := make([]interface{}, len(n.args))
+		for i, arg := range n.args {
+			v, err := e.eval(arg)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = v
+		}
+		result, err := fn.call(args)
+		if err != nil {
+			return nil, newError(n.pos, "%s: %s", n.name, err.Error())
+		}
+		return result, nil
+	}
+	return nil, newError(n.position(), "无法识别的表达式")
+}
+
+// index 计算下标访问
+func (e *evaluator) index(n *indexNode, obj, index interface{}) (interface{}, error) {
+	if obj == nil {
+		return nil, newError(n.pos, "无法对空值进行下标访问，可使用 ?.[] 进行空值安全访问")
+	}
+	if s, ok := obj.(string); ok {
+		i, err := listIndex(n.pos, index, utf8.RuneCountInString(s))
+		if err != nil {
+			return nil, err
+		}
+		return string([]rune(s)[i]), nil
+	}
+	if list, ok := asList(obj); ok {
+		i, err := listIndex(n.pos, index, len(list))
+		if err != nil {
+			return nil, err
+		}
+		return list[i], nil
+	}
+	if m, ok := asMap(obj); ok {
+		key, ok := index.(string)
+		if !ok {
+			return nil, newError(n.pos, "对象下标必须是字符串，实际为 %s", typeName(index))
+		}
+		return m[key], nil
+	}
+	return nil, newError(n.pos, "%s 类型不支持下标访问", typeName(obj))
+}
+
+// listIndex 校验列表下标，负数表示从末尾倒数
+func listIndex(pos int, index interface{}, length int) (int, error) {
+	f, ok := toNumber(index)
+	if !ok || f != math.Trunc(f) {
+		return 0, newError(pos, "下标必须是整数，实际为 %s", toString(index))
+	}
+	i := int(f)
+	if i < 0 {
+		i += length
+	}
+	if i < 0 || i >= length {
+		return 0, newError(pos, "下标 %d 越界，长度为 %d", int(f), length)
+	}
+	return i, nil
+}
+
+// binary 计算二元运算
+func (e *evaluator) binary(n *binaryNode) (interface{}, error) {
+	left, err := e.eval(n.left)
+	if err != nil {
+		return nil, err
+	}
+
+	// 逻辑运算短路求值，空值视为 false
+	switch n.op {
+	case "&&", "||":
+		l, err := asBool(n.pos, left)
+		if err != nil {
+			return nil, err
+		}
+		if (n.op == "&&" && !l) || (n.op == "||" && l) {
+			return l, nil
+		}
+		right, err := e.eval(n.right)
+		if err != nil {
+			return nil, err
+		}
+		return asBool(n.pos, right)
+	}
+
+	right, err := e.eval(n.right)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "==":
+		return equal(left, right), nil
+	case "!=":
+		return !equal(left, right), nil
+	case "<", "<=", ">", ">=":
+		return compare(n, left, right)
+	case "+":
+		return add(n.pos, left, right)
+	}
+
+	l, lok := toNumber(left)
+	r, rok := toNumber(right)
+	if !lok || !rok {
+		return nil, newError(n.pos, "运算符 %s 需要 number，实际为 %s 与 %s", n.op, typeName(left), typeName(right))
+	}
+	switch n.op {
+	case "-":
+		return l - r, nil
+	case "*":
+		return l * r, nil
+	case "/":
+		if r == 0 {
+			return nil, newError(n.pos, "除数不能为零")
+		}
+		return l / r, nil
+	case "%":
+		if r == 0 {
+			return nil, newError(n.pos, "除数不能为零")
+		}
+		return math.Mod(l, r), nil
+	}
+	return nil, newError(n.pos, "不支持的运算符: %s", n.op)
+}
+
+// compare 计算大小比较，数字与字符串分别按数值和字典序比较
+func compare(n *binaryNode, left, right interface{}) (interface{}, error) {
+	var c int
+	if l, ok := toNumber(left); ok {
+		r, ok := toNumber(right)
+		if !ok {
+			return nil, newError(n.pos, "无法比较 %s 与 %s", typeName(left), typeName(right))
+		}
+		switch {
+		case l < r:
+			c = -1
+		case l > r:
+			c = 1
+		}
+	} else if l, ok := left.(string); ok {
+		r, ok := right.(string)
+		if !ok {
+			return nil, newError(n.pos, "无法比较 %s 与 %s", typeName(left), typeName(right))
+		}
+		switch {
+		case l < r:
+			c = -1
+		case l > r:
+			c = 1
+		}
+	} else {
+		return nil, newError(n.pos, "无法比较 %s 与 %s", typeName(left), typeName(right))
+	}
+
+	switch n.op {
+	case "<":
+		return c < 0, nil
+	case "<=":
+		return c <= 0, nil
+	case ">":
+		return c > 0, nil
+	default:
+		return c >= 0, nil
+	}
+}
+
+// add 计算加法：数字相加、字符串拼接或列表连接
+func add(pos int, left, right interface{}) (interface{}, error) {
+	_, lstr := left.(string)
+	_, rstr := right.(string)
+	if lstr || rstr {
+		s, err := checkString(toString(left) + toString(right))
+		if err != nil {
+			return nil, newError(pos, "%s", err.Error())
+		}
+		return s, nil
+	}
+	if l, ok := toNumber(left); ok {
+		if r, ok := toNumber(right); ok {
+			return l + r, nil
+		}
+	}
+	if l, ok := asList(left); ok {
+		if r, ok := asList(right); ok {
+			if len(l)+len(r) > MaxListLength {
+				return nil, newError(pos, "列表长度超过限制 %d", MaxListLength)
+			}
+			list := make([]interface{}, 0, len(l)+len(r))
+			return append(append(list, l...), r...), nil
+		}
+	}
+	return nil, newError(pos, "无法对 %s 与 %s 执行 +", typeName(left), typeName(right))
+}
+
+// asBool 取布尔值，空值视为 false
+func asBool(pos int, v interface{}) (bool, error) {
+	switch b := v.(type) {
+	case bool:
+		return b, nil
+	case nil:
+		return false, nil
+	}
+	return false, newError(pos, "需要 boolean，实际为 %s", typeName(v))
+}
+
+// equal 判断两个值是否相等，数字按数值比较
+func equal(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if x, ok := toNumber(a); ok {
+		y, ok := toNumber(b)
+		return ok && x == y
+	}
+	if x, ok := asList(a); ok {
+		y, ok := asList(b)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !equal(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	if x, ok := asMap(a); ok {
+		y, ok := asMap(b)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for k, v := range x {
+			w, exists := y[k]
+			if !exists || !equal(v, w) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// toNumber 将各种数字类型统一转换为 float64
+func toNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int8:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint8:
+		return float64(n), true
+	case uint16:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// toString 将值格式化为字符串，用于拼接与模板插值
+func toString(v interface{}) string {
+	switch s := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return s
+	case bool:
+		return strconv.FormatBool(s)
+	}
+	if f, ok := toNumber(v); ok {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// checkString 校验字符串长度
+func checkString(s string) (string, error) {
+	if len(s) > MaxStringLength {
+		return "", fmt.Errorf("字符串长度超过限制 %d", MaxStringLength)
+	}
+	return s, nil
+}
+
+// asList 将切片类型的值转换为 []interface{}
+func asList(v interface{}) ([]interface{}, bool) {
+	if list, ok := v.([]interface{}); ok {
+		return list, true
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, false
+	}
+	list := make([]interface{}, rv.Len())
+	for i := range list {
+		list[i] = rv.Index(i).Interface()
+	}
+	return list, true
+}
+
+// asMap 将字符串为键的映射转换为 map[string]interface{}
+func asMap(v interface{}) (map[string]interface{}, bool) {
+	if m, ok := v.(map[string]interface{}); ok {
+		return m, true
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
+		return nil, false
+	}
+	m := make(map[string]interface{}, rv.Len())
+	iter := rv.MapRange()
+	for iter.Next() {
+		m[iter.Key().String()] = iter.Value().Interface()
+	}
+	return m, true
+}
+
+// typeName 返回运行时值的类型名称
+func typeName(v interface{}) string {
+	if t := typeOf(v); t != TypeAny {
+		return t.String()
+	}
+	if _, ok := asList(v); ok {
+		return TypeList.String()
+	}
+	if _, ok := asMap(v); ok {
+		return TypeMap.String()
+	}
+	return reflect.TypeOf(v).String()
+}
@@ -0,0 +1,130 @@
+// Package expr 实现流程定义中使用的表达式语言
+//
+// 表达式用于网关条件、服务任务输入映射与用户任务办理人等配置，
+// 支持算术、比较、逻辑运算、字符串函数、空值安全成员访问（a?.b）与下标访问（a[0]）。
+// 表达式在部署流程定义时编译并做类型检查，运行时只在给定的流程变量上求值，
+// 不能调用登记之外的函数，也不能访问进程环境，且受长度、嵌套深度与计算步数限制。
+package expr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 沙箱限制
+const (
+	MaxExpressionLength = 4096    // 单个表达式的最大长度（字节）
+	MaxDepth            = 64      // 最大嵌套层数
+	MaxSteps            = 10000   // 单次求值的最大计算步数
+	MaxStringLength     = 1 << 20 // 计算过程中产生的字符串最大长度（字节）
+	MaxListLength       = 10000   // 计算过程中产生的列表最大长度
+)
+
+// Error 表达式编译或求值错误
+type Error struct {
+	Pos     int    // 出错位置在表达式中的字节偏移
+	Message string // 错误描述
+}
+
+// Error 实现 error 接口
+func (e *Error) Error() string {
+	return fmt.Sprintf("位置 %d: %s", e.Pos, e.Message)
+}
+
+// newError 创建表达式错误
+func newError(pos int, format string, args ...interface{}) *Error {
+	return &Error{Pos: pos, Message: fmt.Sprintf(format, args...)}
+}
+
+// Program 编译后的表达式
+type Program struct {
+	source     string
+	root       node
+	resultType Type
+}
+
+// Compile 编译表达式并按变量声明做类型检查
+func Compile(source string, env TypeEnv) (*Program, error) {
+	root, err := parse(source)
+	if err != nil {
+		return nil, err
+	}
+	c := &checker{env: env}
+	t, err := c.check(root)
+	if err != nil {
+		return nil, err
+	}
+	return &Program{source: source, root: root, resultType: t}, nil
+}
+
+// CompileCondition 编译条件表达式，结果类型必须是布尔
+// 条件可以写成 amount > 1000，也可以包在 ${} 中
+func CompileCondition(source string, env TypeEnv) (*Program, error) {
+	if inner, ok := Unwrap(source); ok {
+		source = inner
+	}
+	p, err := Compile(source, env)
+	if err != nil {
+		return nil, err
+	}
+	if !accepts(TypeBool, p.resultType) {
+		return nil, newError(0, "条件表达式结果需要 boolean，实际为 %s", p.resultType)
+	}
+	return p, nil
+}
+
+// Source 返回表达式原文
+func (p *Program) Source() string {
+	return p.source
+}
+
+// Type 返回表达式的静态结果类型
+func (p *Program) Type() Type {
+	return p.resultType
+}
+
+// Eval 在给定的变量上计算表达式
+func (p *Program) Eval(vars map[string]interface{}) (interface{}, error) {
+	e := &evaluator{vars: vars}
+	return e.eval(p.root)
+}
+
+// EvalBool 计算条件表达式，空值视为 false
+func (p *Program) EvalBool(vars map[string]interface{}) (bool, error) {
+	v, err := p.Eval(vars)
+	if err != nil {
+		return false, err
+	}
+	return asBool(0, v)
+}
+
+// Eval 编译并计算表达式，适用于只计算一次的场景
+func Eval(source string, vars map[string]interface{}) (interface{}, error) {
+	p, err := Compile(source, nil)
+	if err != nil {
+		return nil, err
+	}
+	return p.Eval(vars)
+}
+
+// EvalCondition 编译并计算条件表达式
+func EvalCondition(source string, vars map[string]interface{}) (bool, error) {
+	p, err := CompileCondition(source, nil)
+	if err != nil {
+		return false, err
+	}
+	return p.EvalBool(vars)
+}
+
+// Unwrap 如果字符串整体是一个 ${...} 占位符，返回其中的表达式
+func Unwrap(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "${") {
+		return "", false
+	}
+	end, err := placeholderEnd(s, 2)
+	if err != nil || end != len(s)-1 {
+		return "", false
+	}
+	return s[2:end], true
+}
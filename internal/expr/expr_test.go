@@ -0,0 +1,250 @@
+package expr
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testVariables() map[string]interface{} {
+	return map[string]interface{}{
+		"amount":   1500,
+		"price":    12.5,
+		"approved": true,
+		"name":     "Alice",
+		"tags":     []interface{}{"urgent", "finance"},
+		"scores":   []int{90, 75, 60},
+		"applicant": map[string]interface{}{
+			"name": "Bob",
+			"department": map[string]interface{}{
+				"code": "FIN",
+			},
+		},
+		"manager": nil,
+	}
+}
+
+func TestEval(t *testing.T) {
+	vars := testVariables()
+
+	tests := []struct {
+		name   string
+		source string
+		want   interface{}
+	}{
+		{"算术运算", "amount * 2 + price / 5 - 1", float64(3001.5)},
+		{"取模与一元负号", "-(amount % 7)", float64(-2)},
+		{"运算符优先级", "1 + 2 * 3 == 7", true},
+		{"数值比较", "amount > 1000 && price <= 12.5", true},
+		{"字符串比较", "name < 'Bob'", true},
+		{"逻辑运算与关键字", "not approved or amount >= 1500", true},
+		{"逻辑短路", "false && missing.field", false},
+		{"字符串拼接", "'Hello, ' + name + '!'", "Hello, Alice!"},
+		{"数字与字符串拼接", "name + amount", "Alice1500"},
+		{"成员访问", "applicant.department.code", "FIN"},
+		{"空值安全成员访问", "manager?.name", nil},
+		{"空值安全链式访问", "applicant?.leader?.name", nil},
+		{"下标访问", "tags[1]", "finance"},
+		{"负数下标", "scores[-1]", 60},
+		{"对象下标", "applicant['name']", "Bob"},
+		{"字符串下标", "name[0]", "A"},
+		{"条件运算", "amount > 1000 ? 'high' : 'low'", "high"},
+		{"缺失变量为空值", "missing == null", true},
+		{"数字相等忽略类型", "amount == 1500.0", true},
+		{"列表字面量相等", "tags == ['urgent', 'finance']", true},
+		{"列表成员判断", "contains(['FIN', 'HR'], applicant.department.code)", true},
+		{"字符串函数", "upper(substring(name, 0, 3)) + lower('X')", "ALIx"},
+		{"包含判断", "contains(tags, 'urgent') && contains(name, 'lic')", true},
+		{"前后缀判断", "startsWith(name, 'Al') && endsWith(name, 'ce')", true},
+		{"长度函数", "len(name) + len(tags) + len(applicant)", float64(9)},
+		{"去空白与替换", "replace(trim('  a-b  '), '-', '+')", "a+b"},
+		{"拆分与拼接", "join(split('a,b,c', ','), '|')", "a|b|c"},
+		{"数学函数", "max(1, amount, 3) + min(2, 1) + round(2.5) + abs(-1) + floor(1.9) + ceil(0.1)", float64(1507)},
+		{"类型转换", "number('42') + 1 == 43 && string(1.5) == '1.5'", true},
+		{"空值合并", "coalesce(manager, applicant.name)", "Bob"},
+		{"判空函数", "isEmpty(manager) && !isEmpty(tags)", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Eval(tt.source, vars)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	vars := testVariables()
+
+	tests := []struct {
+		name    string
+		source  string
+		wantErr string
+	}{
+		{"空值成员访问", "manager.name", "?."},
+		{"下标越界", "tags[5]", "越界"},
+		{"除零", "amount / 0", "除数不能为零"},
+		{"非布尔逻辑运算", "name && approved", "需要 boolean"},
+		{"类型不匹配比较", "name > amount", "无法比较"},
+		{"函数参数类型错误", "upper(amount)", "需要 string"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Eval(tt.source, vars)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestCompile(t *testing.T) {
+	env := TypeEnv{
+		"amount":   TypeNumber,
+		"name":     TypeString,
+		"approved": TypeBool,
+		"tags":     TypeList,
+		"form":     TypeMap,
+	}
+
+	t.Run("类型正确的表达式", func(t *testing.T) {
+		p, err := Compile("amount > 100 && startsWith(name, 'A')", env)
+		require.NoError(t, err)
+		assert.Equal(t, TypeBool, p.Type())
+	})
+
+	t.Run("未声明的变量推迟到运行时检查", func(t *testing.T) {
+		p, err := Compile("unknown.field + 1", env)
+		require.NoError(t, err)
+		assert.Equal(t, TypeAny, p.Type())
+	})
+
+	errorCases := []struct {
+		name    string
+		source  string
+		wantErr string
+	}{
+		{"语法错误", "amount >", "表达式不完整"},
+		{"括号不匹配", "(amount > 1", "缺少 )"},
+		{"多余内容", "amount 1", "多余的内容"},
+		{"字符串未结束", "name == 'abc", "缺少结束引号"},
+		{"非法字符", "amount # 1", "无法识别的字符"},
+		{"未知函数", "exec('rm')", "未知函数"},
+		{"参数个数错误", "upper(name, name)", "参数个数不正确"},
+		{"参数类型错误", "upper(amount)", "参数类型不匹配"},
+		{"字符串参与算术", "name * 2", "需要 number"},
+		{"数字与字符串比较", "amount > name", "无法比较"},
+		{"非布尔取反", "!amount", "需要 boolean"},
+		{"数字没有成员", "amount.value", "没有成员"},
+		{"列表字符串下标", "tags['a']", "列表下标必须是数字"},
+		{"布尔下标访问", "approved[0]", "不支持下标访问"},
+	}
+	for _, tt := range errorCases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile(tt.source, env)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+
+	t.Run("条件表达式结果必须是布尔", func(t *testing.T) {
+		_, err := CompileCondition("${amount + 1}", env)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "boolean")
+
+		p, err := CompileCondition("${amount > 1}", env)
+		require.NoError(t, err)
+		ok, err := p.EvalBool(map[string]interface{}{"amount": 2})
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("错误包含位置", func(t *testing.T) {
+		_, err := Compile("amount + name * 2", env)
+		var exprErr *Error
+		require.ErrorAs(t, err, &exprErr)
+		assert.Equal(t, 14, exprErr.Pos)
+	})
+}
+
+func TestSandboxLimits(t *testing.T) {
+	t.Run("表达式过长", func(t *testing.T) {
+		_, err := Compile(strings.Repeat("1+", MaxExpressionLength)+"1", nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "长度超过限制")
+	})
+
+	t.Run("嵌套过深", func(t *testing.T) {
+		source := strings.Repeat("(", MaxDepth+1) + "1" + strings.Repeat(")", MaxDepth+1)
+		_, err := Compile(source, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "嵌套层数超过限制")
+	})
+
+	t.Run("字符串膨胀", func(t *testing.T) {
+		vars := map[string]interface{}{"s": strings.Repeat("a", MaxStringLength/2+1)}
+		_, err := Eval("s + s", vars)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "字符串长度超过限制")
+
+		_, err = Eval("replace(s, 'a', 'aaaa')", vars)
+		require.Error(t, err)
+	})
+}
+
+func TestTemplate(t *testing.T) {
+	vars := testVariables()
+
+	t.Run("单个占位符保留原始类型", func(t *testing.T) {
+		got, err := ResolveValue("${amount}", vars)
+		require.NoError(t, err)
+		assert.Equal(t, 1500, got)
+	})
+
+	t.Run("混合文本拼接为字符串", func(t *testing.T) {
+		got, err := ResolveValue("${name} 提交了 ${amount * 2} 元 {ok}", vars)
+		require.NoError(t, err)
+		assert.Equal(t, "Alice 提交了 3000 元 {ok}", got)
+	})
+
+	t.Run("字符串字面量中的花括号", func(t *testing.T) {
+		got, err := ResolveValue("${name + '}'}", vars)
+		require.NoError(t, err)
+		assert.Equal(t, "Alice}", got)
+	})
+
+	t.Run("递归处理映射与列表", func(t *testing.T) {
+		got, err := ResolveValue(map[string]interface{}{
+			"to":    []interface{}{"${applicant.name}", "admin"},
+			"count": 3,
+		}, vars)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"to":    []interface{}{"Bob", "admin"},
+			"count": 3,
+		}, got)
+	})
+
+	t.Run("占位符未闭合", func(t *testing.T) {
+		_, err := CompileTemplate("hello ${name", nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "缺少结束的 }")
+	})
+
+	t.Run("编译错误位置相对整个模板", func(t *testing.T) {
+		_, err := CompileTemplate("ab${1 +}", nil)
+		var exprErr *Error
+		require.ErrorAs(t, err, &exprErr)
+		assert.Equal(t, 7, exprErr.Pos)
+	})
+
+	t.Run("整体占位符解包", func(t *testing.T) {
+		inner, ok := Unwrap(" ${amount > 1} ")
+		assert.True(t, ok)
+		assert.Equal(t, "amount > 1", inner)
+
+		_, ok = Unwrap("${a} and ${b}")
+		assert.False(t, ok)
+	})
+}
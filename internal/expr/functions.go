@@ -0,0 +1,315 @@
+package expr
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// function 内置函数签名与实现
+// 表达式只能调用此处登记的函数，不提供任何访问外部环境的能力
+type function struct {
+	minArgs int
+	maxArgs int    // -1 表示不限
+	args    []Type // 各参数类型，参数多于声明时沿用最后一个
+	result  Type
+	accept  func(i int, t Type) bool // 自定义参数类型检查，为空时按 args 检查
+	call    func(args []interface{}) (interface{}, error)
+}
+
+// argType 返回第 i 个参数的声明类型
+func (f *function) argType(i int) Type {
+	if len(f.args) == 0 {
+		return TypeAny
+	}
+	if i >= len(f.args) {
+		return f.args[len(f.args)-1]
+	}
+	return f.args[i]
+}
+
+// acceptsArg 判断第 i 个参数的类型是否满足签名
+func (f *function) acceptsArg(i int, t Type) bool {
+	if f.accept != nil {
+		return f.accept(i, t)
+	}
+	return accepts(f.argType(i), t)
+}
+
+// collectionType 判断类型是否可以取长度
+func collectionType(t Type) bool {
+	return t == TypeAny || t == TypeString || t == TypeList || t == TypeMap
+}
+
+// functions 内置函数表
+var functions map[string]*function
+
+func init() {
+	functions = map[string]*function{
+		"len": {minArgs: 1, maxArgs: 1, result: TypeNumber,
+			accept: func(_ int, t Type) bool { return collectionType(t) },
+			call: func(args []interface{}) (interface{}, error) {
+				switch v := args[0].(type) {
+				case string:
+					return float64(utf8.RuneCountInString(v)), nil
+				case nil:
+					return float64(0), nil
+				}
+				if list, ok := asList(args[0]); ok {
+					return float64(len(list)), nil
+				}
+				if m, ok := asMap(args[0]); ok {
+					return float64(len(m)), nil
+				}
+				return nil, fmt.Errorf("len 不支持 %s 类型", typeName(args[0]))
+			}},
+		"upper":      stringFunc(strings.ToUpper),
+		"lower":      stringFunc(strings.ToLower),
+		"trim":       stringFunc(strings.TrimSpace),
+		"startsWith": stringPredicate(strings.HasPrefix),
+		"endsWith":   stringPredicate(strings.HasSuffix),
+		"contains": {minArgs: 2, maxArgs: 2, result: TypeBool,
+			accept: func(i int, t Type) bool {
+				if i == 0 {
+					return t == TypeAny || t == TypeString || t == TypeList
+				}
+				return true
+			},
+			call: func(args []interface{}) (interface{}, error) {
+				if s, ok := args[0].(string); ok {
+					sub, err := stringArg("contains", args, 1)
+					if err != nil {
+						return nil, err
+					}
+					return strings.Contains(s, sub), nil
+				}
+				if list, ok := asList(args[0]); ok {
+					for _, item := range list {
+						if equal(item, args[1]) {
+							return true, nil
+						}
+					}
+					return false, nil
+				}
+				return nil, fmt.Errorf("contains 不支持 %s 类型", typeName(args[0]))
+			}},
+		"substring": {minArgs: 2, maxArgs: 3, args: []Type{TypeString, TypeNumber}, result: TypeString,
+			call: func(args []interface{}) (interface{}, error) {
+				s, err := stringArg("substring", args, 0)
+				if err != nil {
+					return nil, err
+				}
+				runes := []rune(s)
+				start, err := intArg("substring", args, 1)
+				if err != nil {
+					return nil, err
+				}
+				end := len(runes)
+				if len(args) == 3 {
+					if end, err = intArg("substring", args, 2); err != nil {
+						return nil, err
+					}
+				}
+				start = clamp(start, 0, len(runes))
+				end = clamp(end, start, len(runes))
+				return string(runes[start:end]), nil
+			}},
+		"replace": {minArgs: 3, maxArgs: 3, args: []Type{TypeString}, result: TypeString,
+			call: func(args []interface{}) (interface{}, error) {
+				var s [3]string
+				for i := range s {
+					v, err := stringArg("replace", args, i)
+					if err != nil {
+						return nil, err
+					}
+					s[i] = v
+				}
+				if s[1] != "" && strings.Count(s[0], s[1])*(len(s[2])-len(s[1])) > MaxStringLength-len(s[0]) {
+					return nil, fmt.Errorf("字符串长度超过限制 %d", MaxStringLength)
+				}
+				return strings.ReplaceAll(s[0], s[1], s[2]), nil
+			}},
+		"split": {minArgs: 2, maxArgs: 2, args: []Type{TypeString}, result: TypeList,
+			call: func(args []interface{}) (interface{}, error) {
+				s, err := stringArg("split", args, 0)
+				if err != nil {
+					return nil, err
+				}
+				sep, err := stringArg("split", args, 1)
+				if err != nil {
+					return nil, err
+				}
+				parts := strings.Split(s, sep)
+				list := make([]interface{}, len(parts))
+				for i, p := range parts {
+					list[i] = p
+				}
+				return list, nil
+			}},
+		"join": {minArgs: 2, maxArgs: 2, args: []Type{TypeList, TypeString}, result: TypeString,
+			call: func(args []interface{}) (interface{}, error) {
+				list, ok := asList(args[0])
+				if !ok {
+					return nil, fmt.Errorf("join 的第 1 个参数需要 list，实际为 %s", typeName(args[0]))
+				}
+				sep, err := stringArg("join", args, 1)
+				if err != nil {
+					return nil, err
+				}
+				parts := make([]string, len(list))
+				for i, item := range list {
+					parts[i] = toString(item)
+				}
+				return checkString(strings.Join(parts, sep))
+			}},
+		"string": {minArgs: 1, maxArgs: 1, result: TypeString,
+			call: func(args []interface{}) (interface{}, error) {
+				return toString(args[0]), nil
+			}},
+		"number": {minArgs: 1, maxArgs: 1, result: TypeNumber,
+			call: func(args []interface{}) (interface{}, error) {
+				if s, ok := args[0].(string); ok {
+					f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+					if err != nil {
+						return nil, fmt.Errorf("无法将 %q 转换为数字", s)
+					}
+					return f, nil
+				}
+				if b, ok := args[0].(bool); ok {
+					if b {
+						return float64(1), nil
+					}
+					return float64(0), nil
+				}
+				if f, ok := toNumber(args[0]); ok {
+					return f, nil
+				}
+				return nil, fmt.Errorf("无法将 %s 转换为数字", typeName(args[0]))
+			}},
+		"abs":   numberFunc(math.Abs),
+		"floor": numberFunc(math.Floor),
+		"ceil":  numberFunc(math.Ceil),
+		"round": numberFunc(math.Round),
+		"min":   aggregateFunc("min", math.Min),
+		"max":   aggregateFunc("max", math.Max),
+		"isEmpty": {minArgs: 1, maxArgs: 1, result: TypeBool,
+			call: func(args []interface{}) (interface{}, error) {
+				switch v := args[0].(type) {
+				case nil:
+					return true, nil
+				case string:
+					return v == "", nil
+				}
+				if list, ok := asList(args[0]); ok {
+					return len(list) == 0, nil
+				}
+				if m, ok := asMap(args[0]); ok {
+					return len(m) == 0, nil
+				}
+				return false, nil
+			}},
+		"coalesce": {minArgs: 1, maxArgs: -1, result: TypeAny,
+			call: func(args []interface{}) (interface{}, error) {
+				for _, arg := range args {
+					if arg != nil {
+						return arg, nil
+					}
+				}
+				return nil, nil
+			}},
+	}
+}
+
+// stringFunc 构造单个字符串参数的转换函数
+func stringFunc(fn func(string) string) *function {
+	return &function{minArgs: 1, maxArgs: 1, args: []Type{TypeString}, result: TypeString,
+		call: func(args []interface{}) (interface{}, error) {
+			s, err := stringArg("", args, 0)
+			if err != nil {
+				return nil, err
+			}
+			return fn(s), nil
+		}}
+}
+
+// stringPredicate 构造两个字符串参数的判断函数
+func stringPredicate(fn func(string, string) bool) *function {
+	return &function{minArgs: 2, maxArgs: 2, args: []Type{TypeString}, result: TypeBool,
+		call: func(args []interface{}) (interface{}, error) {
+			s, err := stringArg("", args, 0)
+			if err != nil {
+				return nil, err
+			}
+			t, err := stringArg("", args, 1)
+			if err != nil {
+				return nil, err
+			}
+			return fn(s, t), nil
+		}}
+}
+
+// numberFunc 构造单个数字参数的数学函数
+func numberFunc(fn func(float64) float64) *function {
+	return &function{minArgs: 1, maxArgs: 1, args: []Type{TypeNumber}, result: TypeNumber,
+		call: func(args []interface{}) (interface{}, error) {
+			f, ok := toNumber(args[0])
+			if !ok {
+				return nil, fmt.Errorf("参数需要 number，实际为 %s", typeName(args[0]))
+			}
+			return fn(f), nil
+		}}
+}
+
+// aggregateFunc 构造多个数字参数的聚合函数
+func aggregateFunc(name string, fn func(float64, float64) float64) *function {
+	return &function{minArgs: 1, maxArgs: -1, args: []Type{TypeNumber}, result: TypeNumber,
+		call: func(args []interface{}) (interface{}, error) {
+			var result float64
+			for i, arg := range args {
+				f, ok := toNumber(arg)
+				if !ok {
+					return nil, fmt.Errorf("%s 的第 %d 个参数需要 number，实际为 %s", name, i+1, typeName(arg))
+				}
+				if i == 0 {
+					result = f
+				} else {
+					result = fn(result, f)
+				}
+			}
+			return result, nil
+		}}
+}
+
+// stringArg 取字符串参数
+func stringArg(name string, args []interface{}, i int) (string, error) {
+	s, ok := args[i].(string)
+	if !ok {
+		if name == "" {
+			return "", fmt.Errorf("第 %d 个参数需要 string，实际为 %s", i+1, typeName(args[i]))
+		}
+		return "", fmt.Errorf("%s 的第 %d 个参数需要 string，实际为 %s", name, i+1, typeName(args[i]))
+	}
+	return s, nil
+}
+
+// intArg 取整数参数
+func intArg(name string, args []interface{}, i int) (int, error) {
+	f, ok := toNumber(args[i])
+	if !ok || f != math.Trunc(f) || math.Abs(f) > math.MaxInt32 {
+		return 0, fmt.Errorf("%s 的第 %d 个参数需要整数，实际为 %s", name, i+1, toString(args[i]))
+	}
+	return int(f), nil
+}
+
+// clamp 将 v 限制在 [lo, hi] 区间内
+func clamp(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
@@ -0,0 +1,157 @@
+package expr
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// tokenKind 词法单元类型
+type tokenKind int
+
+const (
+	tokEOF    tokenKind = iota // 输入结束
+	tokNumber                  // 数字字面量
+	tokString                  // 字符串字面量
+	tokIdent                   // 标识符
+	tokOp                      // 运算符与分隔符
+)
+
+// token 词法单元
+type token struct {
+	kind tokenKind
+	text string  // 原始文本，运算符为规范化后的形式
+	pos  int     // 在表达式中的字节偏移
+	num  float64 // 数字字面量的值
+	str  string  // 字符串字面量的值
+}
+
+// operators 支持的运算符，按长度降序匹配
+var operators = []string{
+	"?.", "==", "!=", "<=", ">=", "&&", "||",
+	"+", "-", "*", "/", "%", "<", ">", "!", "(", ")", "[", "]", ".", ",", "?", ":",
+}
+
+// wordOperators 关键字形式的逻辑运算符
+var wordOperators = map[string]string{
+	"and": "&&",
+	"or":  "||",
+	"not": "!",
+}
+
+// tokenize 将表达式切分为词法单元
+func tokenize(src string) ([]token, error) {
+	var tokens []token
+	i := 0
+	for i < len(src) {
+		r, size := utf8.DecodeRuneInString(src[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+
+		case r >= '0' && r <= '9':
+			start := i
+			for i < len(src) && (isDigit(src[i]) || src[i] == '.') {
+				// 数字后紧跟成员访问（如 1..）视为非法，由 ParseFloat 报告
+				i++
+			}
+			if i < len(src) && (src[i] == 'e' || src[i] == 'E') {
+				i++
+				if i < len(src) && (src[i] == '+' || src[i] == '-') {
+					i++
+				}
+				for i < len(src) && isDigit(src[i]) {
+					i++
+				}
+			}
+			num, err := strconv.ParseFloat(src[start:i], 64)
+			if err != nil {
+				return nil, newError(start, "无效的数字: %s", src[start:i])
+			}
+			tokens = append(tokens, token{kind: tokNumber, text: src[start:i], pos: start, num: num})
+
+		case r == '\'' || r == '"':
+			str, end, err := scanString(src, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokString, text: src[i:end], pos: i, str: str})
+			i = end
+
+		case r == '_' || r == '$' || unicode.IsLetter(r):
+			start := i
+			for i < len(src) {
+				r, size := utf8.DecodeRuneInString(src[i:])
+				if r != '_' && r != '$' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+					break
+				}
+				i += size
+			}
+			word := src[start:i]
+			if op, ok := wordOperators[word]; ok {
+				tokens = append(tokens, token{kind: tokOp, text: op, pos: start})
+			} else {
+				tokens = append(tokens, token{kind: tokIdent, text: word, pos: start})
+			}
+
+		default:
+			op := matchOperator(src[i:])
+			if op == "" {
+				return nil, newError(i, "无法识别的字符: %q", r)
+			}
+			tokens = append(tokens, token{kind: tokOp, text: op, pos: i})
+			i += len(op)
+		}
+	}
+	tokens = append(tokens, token{kind: tokEOF, pos: len(src)})
+	return tokens, nil
+}
+
+// matchOperator 匹配输入开头的运算符
+func matchOperator(s string) string {
+	for _, op := range operators {
+		if strings.HasPrefix(s, op) {
+			return op
+		}
+	}
+	return ""
+}
+
+// scanString 扫描以引号包围的字符串字面量，返回值与结束位置
+func scanString(src string, start int) (string, int, error) {
+	quote := src[start]
+	var sb strings.Builder
+	i := start + 1
+	for i < len(src) {
+		c := src[i]
+		switch {
+		case c == quote:
+			return sb.String(), i + 1, nil
+		case c == '\\':
+			if i+1 >= len(src) {
+				return "", 0, newError(i, "字符串转义不完整")
+			}
+			switch esc := src[i+1]; esc {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			case '\\', '\'', '"':
+				sb.WriteByte(esc)
+			default:
+				return "", 0, newError(i, "不支持的转义字符: \\%c", esc)
+			}
+			i += 2
+		default:
+			sb.WriteByte(c)
+			i++
+		}
+	}
+	return "", 0, newError(start, "字符串缺少结束引号")
+}
+
+// isDigit 判断是否为十进制数字
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,293 @@
+package expr
+
+// binaryPrecedence 二元运算符优先级，数值越大结合越紧
+var binaryPrecedence = map[string]int{
+	"||": 1,
+	"&&": 2,
+	"==": 3, "!=": 3,
+	"<": 4, "<=": 4, ">": 4, ">=": 4,
+	"+": 5, "-": 5,
+	"*": 6, "/": 6, "%": 6,
+}
+
+// parser 递归下降语法分析器
+type parser struct {
+	tokens []token
+	pos    int
+	depth  int
+}
+
+// parse 将表达式解析为语法树
+func parse(src string) (node, error) {
+	if len(src) > MaxExpressionLength {
+		return nil, newError(0, "表达式长度超过限制 %d", MaxExpressionLength)
+	}
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	if p.peek().kind == tokEOF {
+		return nil, newError(0, "表达式不能为空")
+	}
+	n, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokEOF {
+		return nil, newError(tok.pos, "多余的内容: %s", tok.text)
+	}
+	return n, nil
+}
+
+// peek 查看当前词法单元
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+// next 消费当前词法单元
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// isOp 判断当前词法单元是否为指定运算符
+func (p *parser) isOp(op string) bool {
+	tok := p.peek()
+	return tok.kind == tokOp && tok.text == op
+}
+
+// expect 消费指定运算符，不匹配时报错
+func (p *parser) expect(op string) error {
+	if !p.isOp(op) {
+		tok := p.peek()
+		if tok.kind == tokEOF {
+			return newError(tok.pos, "缺少 %s", op)
+		}
+		return newError(tok.pos, "期望 %s，实际为 %s", op, tok.text)
+	}
+	p.next()
+	return nil
+}
+
+// enter 增加嵌套深度，防止恶意构造的深层表达式耗尽栈空间
+func (p *parser) enter() error {
+	p.depth++
+	if p.depth > MaxDepth {
+		return newError(p.peek().pos, "表达式嵌套层数超过限制 %d", MaxDepth)
+	}
+	return nil
+}
+
+// parseExpression 解析完整表达式（含条件运算）
+func (p *parser) parseExpression() (node, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer func() { p.depth-- }()
+
+	cond, err := p.parseBinary(1)
+	if err != nil {
+		return nil, err
+	}
+	if !p.isOp("?") {
+		return cond, nil
+	}
+	pos := p.next().pos
+	then, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(":"); err != nil {
+		return nil, err
+	}
+	els, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	return &conditionalNode{pos: pos, cond: cond, then: then, els: els}, nil
+}
+
+// parseBinary 按优先级爬升解析二元运算
+func (p *parser) parseBinary(minPrec int) (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok := p.peek()
+		prec, ok := binaryPrecedence[tok.text]
+		if tok.kind != tokOp || !ok || prec < minPrec {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseBinary(prec + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{pos: tok.pos, op: tok.text, left: left, right: right}
+	}
+}
+
+// parseUnary 解析一元运算
+func (p *parser) parseUnary() (node, error) {
+	if p.isOp("!") || p.isOp("-") {
+		if err := p.enter(); err != nil {
+			return nil, err
+		}
+		defer func() { p.depth-- }()
+
+		tok := p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{pos: tok.pos, op: tok.text, operand: operand}, nil
+	}
+	return p.parsePostfix()
+}
+
+// parsePostfix 解析成员访问、下标访问等后缀运算
+func (p *parser) parsePostfix() (node, error) {
+	n, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok := p.peek()
+		switch {
+		case p.isOp("."), p.isOp("?."):
+			p.next()
+			optional := tok.text == "?."
+			if optional && p.isOp("[") {
+				index, err := p.parseIndex()
+				if err != nil {
+					return nil, err
+				}
+				n = &indexNode{pos: tok.pos, object: n, index: index, optional: true}
+				continue
+			}
+			name := p.next()
+			if name.kind != tokIdent {
+				return nil, newError(name.pos, "成员访问缺少属性名")
+			}
+			n = &memberNode{pos: tok.pos, object: n, name: name.text, optional: optional}
+		case p.isOp("["):
+			index, err := p.parseIndex()
+			if err != nil {
+				return nil, err
+			}
+			n = &indexNode{pos: tok.pos, object: n, index: index}
+		default:
+			return n, nil
+		}
+	}
+}
+
+// parseIndex 解析 [expr]
+func (p *parser) parseIndex() (node, error) {
+	if err := p.expect("["); err != nil {
+		return nil, err
+	}
+	index, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect("]"); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+// parsePrimary 解析字面量、变量、函数调用与括号表达式
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokNumber:
+		return &literalNode{pos: tok.pos, value: tok.num}, nil
+	case tokString:
+		return &literalNode{pos: tok.pos, value: tok.str}, nil
+	case tokIdent:
+		switch tok.text {
+		case "true":
+			return &literalNode{pos: tok.pos, value: true}, nil
+		case "false":
+			return &literalNode{pos: tok.pos, value: false}, nil
+		case "null", "nil":
+			return &literalNode{pos: tok.pos, value: nil}, nil
+		}
+		if p.isOp("(") {
+			return p.parseCall(tok)
+		}
+		return &identNode{pos: tok.pos, name: tok.text}, nil
+	case tokOp:
+		if tok.text == "(" {
+			n, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return n, nil
+		}
+		if tok.text == "[" {
+			return p.parseList(tok)
+		}
+		return nil, newError(tok.pos, "意外的运算符: %s", tok.text)
+	default:
+		return nil, newError(tok.pos, "表达式不完整")
+	}
+}
+
+// parseCall 解析函数调用参数列表
+func (p *parser) parseCall(name token) (node, error) {
+	p.next() // (
+	call := &callNode{pos: name.pos, name: name.text}
+	if p.isOp(")") {
+		p.next()
+		return call, nil
+	}
+	for {
+		arg, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		call.args = append(call.args, arg)
+		if p.isOp(",") {
+			p.next()
+			continue
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return call, nil
+	}
+}
+
+// parseList 解析列表字面量
+func (p *parser) parseList(open token) (node, error) {
+	list := &listNode{pos: open.pos}
+	if p.isOp("]") {
+		p.next()
+		return list, nil
+	}
+	for {
+		item, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		list.items = append(list.items, item)
+		if p.isOp(",") {
+			p.next()
+			continue
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+		return list, nil
+	}
+}
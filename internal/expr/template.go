@@ -0,0 +1,133 @@
+package expr
+
+import "strings"
+
+// Template 带 ${...} 占位符的字符串模板
+// 用于服务任务输入、用户任务办理人等从流程变量取值的配置项
+type Template struct {
+	literals []string   // 占位符之间的文本，比 programs 多一项
+	programs []*Program // 各占位符编译后的表达式
+}
+
+// IsTemplate 判断字符串是否包含占位符
+func IsTemplate(s string) bool {
+	return strings.Contains(s, "${")
+}
+
+// CompileTemplate 编译字符串模板，逐个编译并检查其中的占位符表达式
+func CompileTemplate(s string, env TypeEnv) (*Template, error) {
+	t := &Template{}
+	rest := s
+	offset := 0
+	for {
+		start := strings.Index(rest, "${")
+		if start < 0 {
+			t.literals = append(t.literals, rest)
+			return t, nil
+		}
+		end, perr := placeholderEnd(rest, start+2)
+		if perr != nil {
+			return nil, newError(offset+start, "%s", perr.Message)
+		}
+		p, err := Compile(rest[start+2:end], env)
+		if err != nil {
+			if e, ok := err.(*Error); ok {
+				return nil, newError(offset+start+2+e.Pos, "%s", e.Message)
+			}
+			return nil, err
+		}
+		t.literals = append(t.literals, rest[:start])
+		t.programs = append(t.programs, p)
+		offset += end + 1
+		rest = rest[end+1:]
+	}
+}
+
+// Eval 计算模板
+// 模板整体只有一个占位符时保留表达式结果的原始类型，否则拼接为字符串
+func (t *Template) Eval(vars map[string]interface{}) (interface{}, error) {
+	if len(t.programs) == 1 && t.literals[0] == "" && t.literals[1] == "" {
+		return t.programs[0].Eval(vars)
+	}
+	var sb strings.Builder
+	for i, p := range t.programs {
+		sb.WriteString(t.literals[i])
+		v, err := p.Eval(vars)
+		if err != nil {
+			return nil, err
+		}
+		sb.WriteString(toString(v))
+		if sb.Len() > MaxStringLength {
+			return nil, newError(0, "字符串长度超过限制 %d", MaxStringLength)
+		}
+	}
+	sb.WriteString(t.literals[len(t.literals)-1])
+	return sb.String(), nil
+}
+
+// EvalString 计算模板并格式化为字符串
+func (t *Template) EvalString(vars map[string]interface{}) (string, error) {
+	v, err := t.Eval(vars)
+	if err != nil {
+		return "", err
+	}
+	return toString(v), nil
+}
+
+// placeholderEnd 从表达式起始位置查找占位符的结束花括号，跳过字符串字面量中的内容
+func placeholderEnd(s string, start int) (int, *Error) {
+	var quote byte
+	for i := start; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '}':
+			return i, nil
+		}
+	}
+	return 0, newError(start-2, "占位符缺少结束的 }")
+}
+
+// ResolveValue 计算配置值中的模板
+// 字符串按模板计算，映射与列表逐项递归处理，其他值原样返回
+func ResolveValue(value interface{}, vars map[string]interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		if !IsTemplate(v) {
+			return v, nil
+		}
+		t, err := CompileTemplate(v, nil)
+		if err != nil {
+			return nil, err
+		}
+		return t.Eval(vars)
+	case map[string]interface{}:
+		resolved := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			r, err := ResolveValue(item, vars)
+			if err != nil {
+				return nil, err
+			}
+			resolved[k] = r
+		}
+		return resolved, nil
+	case []interface{}:
+		resolved := make([]interface{}, len(v))
+		for i, item := range v {
+			r, err := ResolveValue(item, vars)
+			if err != nil {
+				return nil, err
+			}
+			resolved[i] = r
+		}
+		return resolved, nil
+	}
+	return value, nil
+}
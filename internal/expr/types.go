@@ -0,0 +1,270 @@
+package expr
+
+import "strings"
+
+// Type 表达式静态类型
+type Type int
+
+const (
+	TypeAny    Type = iota // 类型未知，推迟到运行时检查
+	TypeNull               // 空值
+	TypeBool               // 布尔
+	TypeNumber             // 数字
+	TypeString             // 字符串
+	TypeList               // 列表
+	TypeMap                // 对象
+)
+
+// String 返回类型名称
+func (t Type) String() string {
+	switch t {
+	case TypeNull:
+		return "null"
+	case TypeBool:
+		return "boolean"
+	case TypeNumber:
+		return "number"
+	case TypeString:
+		return "string"
+	case TypeList:
+		return "list"
+	case TypeMap:
+		return "map"
+	default:
+		return "any"
+	}
+}
+
+// ParseType 解析流程变量声明中的类型名称
+// 同时兼容流程变量表使用的 integer、double、json 等名称
+func ParseType(name string) (Type, bool) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "any", "object", "date", "datetime":
+		return TypeAny, true
+	case "string", "text":
+		return TypeString, true
+	case "number", "integer", "int", "long", "double", "float", "decimal":
+		return TypeNumber, true
+	case "boolean", "bool":
+		return TypeBool, true
+	case "list", "array":
+		return TypeList, true
+	case "map", "json":
+		return TypeMap, true
+	default:
+		return TypeAny, false
+	}
+}
+
+// TypeEnv 变量名到声明类型的映射，未声明的变量按 TypeAny 处理
+type TypeEnv map[string]Type
+
+// accepts 判断期望类型是否接受实际类型
+func accepts(want, got Type) bool {
+	return want == TypeAny || got == TypeAny || want == got
+}
+
+// checker 静态类型检查器
+type checker struct {
+	env TypeEnv
+}
+
+// check 推导节点类型，发现必然失败的运算时报错
+func (c *checker) check(n node) (Type, error) {
+	switch n := n.(type) {
+	case *literalNode:
+		return typeOf(n.value), nil
+
+	case *identNode:
+		if t, ok := c.env[n.name]; ok {
+			return t, nil
+		}
+		return TypeAny, nil
+
+	case *memberNode:
+		t, err := c.check(n.object)
+		if err != nil {
+			return TypeAny, err
+		}
+		if t == TypeNull && n.optional {
+			return TypeNull, nil
+		}
+		if t != TypeAny && t != TypeMap {
+			return TypeAny, newError(n.pos, "%s 类型没有成员 %s", t, n.name)
+		}
+		return TypeAny, nil
+
+	case *indexNode:
+		obj, err := c.check(n.object)
+		if err != nil {
+			return TypeAny, err
+		}
+		idx, err := c.check(n.index)
+		if err != nil {
+			return TypeAny, err
+		}
+		switch obj {
+		case TypeAny:
+			return TypeAny, nil
+		case TypeNull:
+			if n.optional {
+				return TypeNull, nil
+			}
+		case TypeList:
+			if !accepts(TypeNumber, idx) {
+				return TypeAny, newError(n.pos, "列表下标必须是数字，实际为 %s", idx)
+			}
+			return TypeAny, nil
+		case TypeString:
+			if !accepts(TypeNumber, idx) {
+				return TypeAny, newError(n.pos, "字符串下标必须是数字，实际为 %s", idx)
+			}
+			return TypeString, nil
+		case TypeMap:
+			if !accepts(TypeString, idx) {
+				return TypeAny, newError(n.pos, "对象下标必须是字符串，实际为 %s", idx)
+			}
+			return TypeAny, nil
+		}
+		return TypeAny, newError(n.pos, "%s 类型不支持下标访问", obj)
+
+	case *unaryNode:
+		t, err := c.check(n.operand)
+		if err != nil {
+			return TypeAny, err
+		}
+		if n.op == "!" {
+			if !accepts(TypeBool, t) {
+				return TypeAny, newError(n.pos, "运算符 ! 需要 boolean，实际为 %s", t)
+			}
+			return TypeBool, nil
+		}
+		if !accepts(TypeNumber, t) {
+			return TypeAny, newError(n.pos, "运算符 - 需要 number，实际为 %s", t)
+		}
+		return TypeNumber, nil
+
+	case *binaryNode:
+		return c.checkBinary(n)
+
+	case *conditionalNode:
+		cond, err := c.check(n.cond)
+		if err != nil {
+			return TypeAny, err
+		}
+		if !accepts(TypeBool, cond) {
+			return TypeAny, newError(n.pos, "条件运算的判断部分需要 boolean，实际为 %s", cond)
+		}
+		then, err := c.check(n.then)
+		if err != nil {
+			return TypeAny, err
+		}
+		els, err := c.check(n.els)
+		if err != nil {
+			return TypeAny, err
+		}
+		if then == els {
+			return then, nil
+		}
+		return TypeAny, nil
+
+	case *listNode:
+		for _, item := range n.items {
+			if _, err := c.check(item); err != nil {
+				return TypeAny, err
+			}
+		}
+		return TypeList, nil
+
+	case *callNode:
+		fn, ok := functions[n.name]
+		if !ok {
+			return TypeAny, newError(n.pos, "未知函数: %s", n.name)
+		}
+		if len(n.args) < fn.minArgs || (fn.maxArgs >= 0 && len(n.args) > fn.maxArgs) {
+			return TypeAny, newError(n.pos, "函数 %s 的参数个数不正确", n.name)
+		}
+		for i, arg := range n.args {
+			t, err := c.check(arg)
+			if err != nil {
+				return TypeAny, err
+			}
+			if !fn.acceptsArg(i, t) {
+				return TypeAny, newError(arg.position(), "函数 %s 的第 %d 个参数类型不匹配: %s", n.name, i+1, t)
+			}
+		}
+		return fn.result, nil
+	}
+	return TypeAny, newError(n.position(), "无法识别的表达式")
+}
+
+// checkBinary 检查二元运算的操作数类型
+func (c *checker) checkBinary(n *binaryNode) (Type, error) {
+	left, err := c.check(n.left)
+	if err != nil {
+		return TypeAny, err
+	}
+	right, err := c.check(n.right)
+	if err != nil {
+		return TypeAny, err
+	}
+
+	switch n.op {
+	case "&&", "||":
+		if !accepts(TypeBool, left) || !accepts(TypeBool, right) {
+			return TypeAny, newError(n.pos, "运算符 %s 需要 boolean，实际为 %s 与 %s", n.op, left, right)
+		}
+		return TypeBool, nil
+
+	case "==", "!=":
+		return TypeBool, nil
+
+	case "<", "<=", ">", ">=":
+		if left == TypeAny || right == TypeAny {
+			return TypeBool, nil
+		}
+		if left != right || (left != TypeNumber && left != TypeString) {
+			return TypeAny, newError(n.pos, "无法比较 %s 与 %s", left, right)
+		}
+		return TypeBool, nil
+
+	case "+":
+		switch {
+		case left == TypeString || right == TypeString:
+			return TypeString, nil
+		case left == TypeAny || right == TypeAny:
+			return TypeAny, nil
+		case left == TypeNumber && right == TypeNumber:
+			return TypeNumber, nil
+		case left == TypeList && right == TypeList:
+			return TypeList, nil
+		}
+		return TypeAny, newError(n.pos, "无法对 %s 与 %s 执行 +", left, right)
+
+	default:
+		if !accepts(TypeNumber, left) || !accepts(TypeNumber, right) {
+			return TypeAny, newError(n.pos, "运算符 %s 需要 number，实际为 %s 与 %s", n.op, left, right)
+		}
+		return TypeNumber, nil
+	}
+}
+
+// typeOf 返回运行时值对应的静态类型
+func typeOf(v interface{}) Type {
+	switch v.(type) {
+	case nil:
+		return TypeNull
+	case bool:
+		return TypeBool
+	case string:
+		return TypeString
+	case []interface{}:
+		return TypeList
+	case map[string]interface{}:
+		return TypeMap
+	}
+	if _, ok := toNumber(v); ok {
+		return TypeNumber
+	}
+	return TypeAny
+}
@@ -24,12 +24,13 @@ type AuthMiddleware struct {
 func NewAuthMiddleware(jwtManager *auth.JWTManager, logger *zap.Logger) *AuthMiddleware {
 	// 默认跳过认证的路径
 	skipPaths := map[string]bool{
-		"/health":               true,
-		"/metrics":              true,
-		"/api/v1/auth/login":    true,
-		"/api/v1/auth/register": true,
-		"/api/v1/docs":          true,
-		"/swagger":              true,
+		"/health":                true,
+		"/metrics":               true,
+		"/api/v1/auth/login":     true,
+		"/api/v1/auth/refresh":   true,
+		"/api/v1/docs":           true,
+		"/swagger":               true,
+		"/.well-known/jwks.json": true,
 	}
 
 	return &AuthMiddleware{
@@ -39,6 +40,11 @@ func NewAuthMiddleware(jwtManager *auth.JWTManager, logger *zap.Logger) *AuthMid
 	}
 }
 
+// JWKS 返回用于验证本服务签发令牌的公钥集合
+func (m *AuthMiddleware) JWKS() *auth.JWKSet {
+	return m.jwtManager.JWKS()
+}
+
 // JWTAuth JWT认证中间件
 func (m *AuthMiddleware) JWTAuth() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -80,7 +86,7 @@ func (m *AuthMiddleware) JWTAuth() gin.HandlerFunc {
 		}
 
 		// 验证令牌
-		claims, err := m.jwtManager.ValidateToken(tokenString)
+		claims, err := m.jwtManager.ValidateAccessToken(c.Request.Context(), tokenString)
 		if err != nil {
 			m.logger.Warn("令牌验证失败",
 				zap.String("path", c.Request.URL.Path),
@@ -99,7 +105,9 @@ func (m *AuthMiddleware) JWTAuth() gin.HandlerFunc {
 		c.Set("user_id", claims.UserID)
 		c.Set("username", claims.Username)
 		c.Set("user_roles", claims.Roles)
+		c.Set("user_groups", claims.Groups)
 		c.Set("user_permissions", claims.Permissions)
+		c.Request = c.Request.WithContext(auth.NewContext(c.Request.Context(), auth.NewIdentity(claims)))
 
 		m.logger.Debug("用户认证成功",
 			zap.Int64("user_id", claims.UserID),
@@ -416,7 +424,7 @@ func (m *AuthMiddleware) OptionalAuth() gin.HandlerFunc {
 		}
 
 		// 验证令牌
-		claims, err := m.jwtManager.ValidateToken(tokenString)
+		claims, err := m.jwtManager.ValidateAccessToken(c.Request.Context(), tokenString)
 		if err != nil {
 			// 令牌无效，继续执行但不设置用户信息
 			c.Next()
@@ -428,7 +436,9 @@ func (m *AuthMiddleware) OptionalAuth() gin.HandlerFunc {
 		c.Set("user_id", claims.UserID)
 		c.Set("username", claims.Username)
 		c.Set("user_roles", claims.Roles)
+		c.Set("user_groups", claims.Groups)
 		c.Set("user_permissions", claims.Permissions)
+		c.Request = c.Request.WithContext(auth.NewContext(c.Request.Context(), auth.NewIdentity(claims)))
 
 		c.Next()
 	}
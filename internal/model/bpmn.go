@@ -0,0 +1,626 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// BPMN 2.0 相关的XML命名空间
+const (
+	BPMNNamespace   = "http://www.omg.org/spec/BPMN/20100524/MODEL" // BPMN 模型
+	BPMNDINamespace = "http://www.omg.org/spec/BPMN/20100524/DI"    // BPMN 图形交换
+	DCNamespace     = "http://www.omg.org/spec/DD/20100524/DC"      // 图形公共元素
+	DINamespace     = "http://www.omg.org/spec/DD/20100524/DI"      // 图形交换
+	XSINamespace    = "http://www.w3.org/2001/XMLSchema-instance"   // XML Schema 实例
+	// EngineNamespace 引擎扩展命名空间，承载BPMN标准无法表达的节点配置与变量声明
+	EngineNamespace = "http://workflow-engine.io/schema/bpmn"
+)
+
+// bpmnNodeTypes 支持导入的BPMN流程节点
+var bpmnNodeTypes = map[string]NodeType{
+	"startEvent":       NodeTypeStartEvent,
+	"endEvent":         NodeTypeEndEvent,
+	"serviceTask":      NodeTypeServiceTask,
+	"userTask":         NodeTypeUserTask,
+	"exclusiveGateway": NodeTypeExclusiveGateway,
+	"parallelGateway":  NodeTypeParallelGateway,
+	"inclusiveGateway": NodeTypeInclusiveGateway,
+}
+
+// bpmnIgnoredElements 不影响执行语义、导入时忽略的流程子元素
+var bpmnIgnoredElements = map[string]bool{
+	"laneSet":        true,
+	"textAnnotation": true,
+	"association":    true,
+	"group":          true,
+}
+
+// BPMNDocument BPMN导入结果
+type BPMNDocument struct {
+	Definition *Definition // 流程模型
+	Resource   []byte      // 等价的引擎JSON资源
+	Diagram    *Diagram    // 流程图布局，文件不含图形信息时为 nil
+}
+
+// IsBPMN 判断资源是否为XML格式的BPMN文件
+func IsBPMN(resource []byte) bool {
+	resource = bytes.TrimPrefix(resource, []byte("\xef\xbb\xbf"))
+	return bytes.HasPrefix(bytes.TrimSpace(resource), []byte("<"))
+}
+
+// ParseBPMN 解析 bpmn:definitions 文件并转换为流程模型
+// 不支持的元素与结构错误均以 ValidationErrors 返回，错误位置使用XPath描述
+func ParseBPMN(data []byte) (*BPMNDocument, error) {
+	var errs ValidationErrors
+
+	var root xmlNode
+	if err := xml.Unmarshal(data, &root); err != nil {
+		errs.add("/", ErrCodeInvalidXML, fmt.Sprintf("BPMN文件不是有效的XML格式: %v", err))
+		return nil, errs
+	}
+	if !root.is(BPMNNamespace, "definitions") {
+		errs.add("/", ErrCodeInvalidField, fmt.Sprintf("BPMN文件的根元素必须是 bpmn:definitions，实际为 %s", root.XMLName.Local))
+		return nil, errs
+	}
+
+	processes := root.children(BPMNNamespace, "process")
+	switch len(processes) {
+	case 0:
+		errs.add("/definitions", ErrCodeMissingField, "BPMN文件缺少 bpmn:process 元素")
+		return nil, errs
+	case 1:
+	default:
+		errs.add("/definitions/process[2]", ErrCodeUnsupportedElement, "仅支持包含单个流程的BPMN文件")
+		return nil, errs
+	}
+
+	imp := &bpmnImporter{
+		nodes:     make(map[string]*bpmnNode),
+		flowsByID: make(map[string]*bpmnFlow),
+		flowIDs:   make(map[string]string),
+		paths:     make(map[string]string),
+	}
+	imp.importProcess(processes[0])
+	diagram := imp.importDiagram(&root)
+	if len(imp.errs) > 0 {
+		return nil, imp.errs
+	}
+
+	resource, err := json.Marshal(imp.doc)
+	if err != nil {
+		return nil, fmt.Errorf("序列化流程定义失败: %w", err)
+	}
+	def, err := Parse(resource)
+	if err != nil {
+		var verrs ValidationErrors
+		if errors.As(err, &verrs) {
+			for _, e := range verrs {
+				e.Path = imp.xmlPath(e.Path)
+			}
+			return nil, verrs
+		}
+		return nil, err
+	}
+
+	return &BPMNDocument{Definition: def, Resource: resource, Diagram: diagram}, nil
+}
+
+// xmlNode 通用XML元素，用于逐个检查BPMN元素
+type xmlNode struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	Children []*xmlNode `xml:",any"`
+	Text     string     `xml:",chardata"`
+}
+
+// is 判断元素的命名空间与名称
+func (n *xmlNode) is(space, local string) bool {
+	return n.XMLName.Space == space && n.XMLName.Local == local
+}
+
+// attr 返回无命名空间的属性值
+func (n *xmlNode) attr(local string) string {
+	for _, a := range n.Attrs {
+		if a.Name.Space == "" && a.Name.Local == local {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// extensionAttr 返回扩展命名空间中的属性值，兼容其他建模工具的同名扩展属性
+func (n *xmlNode) extensionAttr(local string) (string, bool) {
+	for _, a := range n.Attrs {
+		if a.Name.Space != "" && a.Name.Space != "xmlns" && a.Name.Space != BPMNNamespace && a.Name.Local == local {
+			return a.Value, true
+		}
+	}
+	return "", false
+}
+
+// children 返回指定名称的子元素
+func (n *xmlNode) children(space, local string) []*xmlNode {
+	var result []*xmlNode
+	for _, c := range n.Children {
+		if c.is(space, local) {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// child 返回第一个指定名称的子元素
+func (n *xmlNode) child(space, local string) *xmlNode {
+	for _, c := range n.Children {
+		if c.is(space, local) {
+			return c
+		}
+	}
+	return nil
+}
+
+// resourceDocument 引擎JSON资源结构
+type resourceDocument struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description,omitempty"`
+	Variables   map[string]string  `json:"variables,omitempty"`
+	Elements    []*resourceElement `json:"elements"`
+}
+
+// resourceElement 引擎JSON资源中的节点
+type resourceElement struct {
+	ID     string          `json:"id"`
+	Name   string          `json:"name,omitempty"`
+	Type   NodeType        `json:"type"`
+	Next   []string        `json:"next,omitempty"`
+	Config json.RawMessage `json:"config,omitempty"`
+}
+
+// bpmnNode 导入中的流程节点
+type bpmnNode struct {
+	path        string
+	element     *resourceElement
+	defaultFlow string
+	outgoing    []*bpmnFlow
+}
+
+// bpmnFlow 导入中的顺序流
+type bpmnFlow struct {
+	id        string
+	source    string
+	target    string
+	condition string
+	path      string
+}
+
+// bpmnImporter BPMN导入器
+type bpmnImporter struct {
+	doc       resourceDocument
+	nodes     map[string]*bpmnNode
+	order     []*bpmnNode
+	flows     []*bpmnFlow
+	flowsByID map[string]*bpmnFlow
+	flowIDs   map[string]string // BPMN顺序流ID -> 引擎顺序流ID
+	paths     map[string]string // JSON路径 -> XPath，用于转换结构校验错误的位置
+	errs      ValidationErrors
+}
+
+// importProcess 导入流程元素及其全部子元素
+func (imp *bpmnImporter) importProcess(process *xmlNode) {
+	const processPath = "/definitions/process"
+	imp.paths["$"] = processPath
+
+	imp.doc.ID = process.attr("id")
+	imp.doc.Name = process.attr("name")
+	if imp.doc.Name == "" {
+		imp.doc.Name = imp.doc.ID
+	}
+
+	for i, c := range process.Children {
+		path := elementPath(processPath, c, i)
+		if c.XMLName.Space != BPMNNamespace {
+			continue
+		}
+		switch name := c.XMLName.Local; {
+		case name == "documentation":
+			imp.doc.Description = strings.TrimSpace(c.Text)
+		case name == "extensionElements":
+			imp.importVariables(c, path)
+		case name == "sequenceFlow":
+			imp.importFlow(c, path)
+		case bpmnNodeTypes[name] != "":
+			imp.importNode(c, bpmnNodeTypes[name], path)
+		case bpmnIgnoredElements[name]:
+		default:
+			imp.errs.add(path, ErrCodeUnsupportedElement, fmt.Sprintf("不支持的BPMN元素: bpmn:%s", name))
+		}
+	}
+
+	imp.connect()
+}
+
+// importVariables 导入 we:variable 扩展元素声明的流程变量
+func (imp *bpmnImporter) importVariables(ext *xmlNode, path string) {
+	imp.paths["$.variables"] = path
+	for _, v := range ext.children(EngineNamespace, "variable") {
+		if imp.doc.Variables == nil {
+			imp.doc.Variables = make(map[string]string)
+		}
+		imp.doc.Variables[v.attr("name")] = v.attr("type")
+	}
+}
+
+// importNode 导入流程节点
+func (imp *bpmnImporter) importNode(el *xmlNode, nodeType NodeType, path string) {
+	id := el.attr("id")
+	if id == "" {
+		imp.errs.add(path, ErrCodeMissingField, fmt.Sprintf("bpmn:%s 缺少id属性", el.XMLName.Local))
+		return
+	}
+	if _, exists := imp.nodes[id]; exists {
+		imp.errs.add(path, ErrCodeDuplicateID, fmt.Sprintf("节点ID重复: %s", id))
+		return
+	}
+
+	var config, loop *xmlNode
+	for i, c := range el.Children {
+		if c.XMLName.Space != BPMNNamespace {
+			continue
+		}
+		switch c.XMLName.Local {
+		case "incoming", "outgoing", "documentation":
+		case "extensionElements":
+			config = c.child(EngineNamespace, "config")
+		case "multiInstanceLoopCharacteristics":
+			if nodeType != NodeTypeServiceTask && nodeType != NodeTypeUserTask {
+				imp.errs.add(elementPath(path, c, i), ErrCodeUnsupportedElement,
+					fmt.Sprintf("bpmn:%s 不支持多实例", el.XMLName.Local))
+				continue
+			}
+			loop = c
+		default:
+			imp.errs.add(elementPath(path, c, i), ErrCodeUnsupportedElement,
+				fmt.Sprintf("bpmn:%s 不支持子元素 bpmn:%s", el.XMLName.Local, c.XMLName.Local))
+		}
+	}
+
+	node := &bpmnNode{
+		path:        path,
+		defaultFlow: el.attr("default"),
+		element: &resourceElement{
+			ID:   id,
+			Name: el.attr("name"),
+			Type: nodeType,
+		},
+	}
+	configPath := path + "/extensionElements/config"
+	var err error
+	switch nodeType {
+	case NodeTypeServiceTask:
+		node.element.Config, err = serviceTaskConfig(el, config, loop)
+	case NodeTypeUserTask:
+		node.element.Config, err = userTaskConfig(el, config, loop)
+	}
+	if err != nil {
+		imp.errs.add(configPath, ErrCodeInvalidField, fmt.Sprintf("节点配置格式无效: %v", err))
+	}
+
+	imp.nodes[id] = node
+	imp.order = append(imp.order, node)
+	imp.paths[fmt.Sprintf("$.elements[%d]", len(imp.order)-1)] = path
+}
+
+// serviceTaskConfig 合并 we:config、扩展属性与多实例标记生成服务任务配置
+func serviceTaskConfig(el, config, loop *xmlNode) (json.RawMessage, error) {
+	var cfg ServiceTaskConfig
+	if err := decodeExtensionConfig(config, &cfg); err != nil {
+		return nil, err
+	}
+	if loop != nil {
+		cfg.MultiInstance = multiInstanceConfig(loop, cfg.MultiInstance)
+	}
+	if v, ok := el.extensionAttr("serviceName"); ok {
+		cfg.ServiceName = v
+	}
+	if v, ok := el.extensionAttr("method"); ok {
+		cfg.Method = v
+	}
+	if v, ok := el.extensionAttr("timeout"); ok {
+		cfg.Timeout = v
+	}
+	return json.Marshal(&cfg)
+}
+
+// userTaskConfig 合并 we:config、扩展属性与多实例标记生成用户任务配置
+func userTaskConfig(el, config, loop *xmlNode) (json.RawMessage, error) {
+	var cfg UserTaskConfig
+	if err := decodeExtensionConfig(config, &cfg); err != nil {
+		return nil, err
+	}
+	set := config != nil
+	if loop != nil {
+		cfg.MultiInstance, set = multiInstanceConfig(loop, cfg.MultiInstance), true
+	}
+	if v, ok := el.extensionAttr("assignee"); ok {
+		cfg.Assignee, set = v, true
+	}
+	if v, ok := el.extensionAttr("candidateUsers"); ok {
+		cfg.CandidateUsers, set = splitList(v), true
+	}
+	if v, ok := el.extensionAttr("candidateGroups"); ok {
+		cfg.CandidateGroups, set = splitList(v), true
+	}
+	if v, ok := el.extensionAttr("formKey"); ok {
+		cfg.FormKey, set = v, true
+	}
+	if v, ok := el.extensionAttr("dueDate"); ok {
+		cfg.DueDate, set = v, true
+	}
+	if v, ok := el.extensionAttr("priority"); ok {
+		priority, err := strconv.ParseInt(strings.TrimSpace(v), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("priority 必须是整数: %s", v)
+		}
+		cfg.Priority, set = int32(priority), true
+	}
+	if !set {
+		return nil, nil
+	}
+	return json.Marshal(&cfg)
+}
+
+// multiInstanceConfig 将 bpmn:multiInstanceLoopCharacteristics 合并到 we:config 中的多实例配置
+// 集合与元素变量取自扩展属性 collection、elementVariable，基数与完成条件取自同名子元素
+func multiInstanceConfig(loop *xmlNode, base *MultiInstanceConfig) *MultiInstanceConfig {
+	mi := &MultiInstanceConfig{}
+	if base != nil {
+		*mi = *base
+	}
+	mi.Sequential = loop.attr("isSequential") == "true"
+	if v, ok := loop.extensionAttr("collection"); ok {
+		mi.Collection = v
+	}
+	if v, ok := loop.extensionAttr("elementVariable"); ok {
+		mi.ElementVariable = v
+	}
+	if c := loop.child(BPMNNamespace, "loopCardinality"); c != nil {
+		mi.Cardinality = strings.TrimSpace(c.Text)
+	}
+	if c := loop.child(BPMNNamespace, "completionCondition"); c != nil {
+		mi.CompletionCondition = strings.TrimSpace(c.Text)
+	}
+	return mi
+}
+
+// decodeExtensionConfig 解码 we:config 元素中的JSON配置
+func decodeExtensionConfig(config *xmlNode, dst interface{}) error {
+	if config == nil {
+		return nil
+	}
+	return decodeConfig(json.RawMessage(strings.TrimSpace(config.Text)), dst)
+}
+
+// splitList 拆分逗号分隔的列表
+func splitList(s string) []string {
+	var result []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// importFlow 导入顺序流
+func (imp *bpmnImporter) importFlow(el *xmlNode, path string) {
+	flow := &bpmnFlow{
+		id:     el.attr("id"),
+		source: el.attr("sourceRef"),
+		target: el.attr("targetRef"),
+		path:   path,
+	}
+	if flow.id == "" {
+		imp.errs.add(path, ErrCodeMissingField, "bpmn:sequenceFlow 缺少id属性")
+		return
+	}
+	if _, exists := imp.flowsByID[flow.id]; exists {
+		imp.errs.add(path, ErrCodeDuplicateID, fmt.Sprintf("顺序流ID重复: %s", flow.id))
+		return
+	}
+	for i, c := range el.Children {
+		if c.XMLName.Space != BPMNNamespace {
+			continue
+		}
+		switch c.XMLName.Local {
+		case "conditionExpression":
+			flow.condition = strings.TrimSpace(c.Text)
+		case "documentation", "extensionElements":
+		default:
+			imp.errs.add(elementPath(path, c, i), ErrCodeUnsupportedElement,
+				fmt.Sprintf("bpmn:sequenceFlow 不支持子元素 bpmn:%s", c.XMLName.Local))
+		}
+	}
+	imp.flows = append(imp.flows, flow)
+	imp.flowsByID[flow.id] = flow
+}
+
+// connect 将顺序流归入源节点，生成节点的 next 与网关条件
+// 顺序流按条件分支、默认分支、普通出口的顺序排列，与引擎解析资源时生成的顺序流一致
+func (imp *bpmnImporter) connect() {
+	for _, f := range imp.flows {
+		src, ok := imp.nodes[f.source]
+		if !ok {
+			imp.errs.add(f.path, ErrCodeDanglingTarget, fmt.Sprintf("顺序流 %s 的源节点不存在: %s", f.id, f.source))
+			continue
+		}
+		if _, ok := imp.nodes[f.target]; !ok {
+			imp.errs.add(f.path, ErrCodeDanglingTarget, fmt.Sprintf("顺序流 %s 的目标节点不存在: %s", f.id, f.target))
+			continue
+		}
+		src.outgoing = append(src.outgoing, f)
+	}
+
+	for i, n := range imp.order {
+		jsonPath := fmt.Sprintf("$.elements[%d]", i)
+		nodeType := n.element.Type
+
+		if n.defaultFlow != "" {
+			f, ok := imp.flowsByID[n.defaultFlow]
+			switch {
+			case !nodeType.IsConditional():
+				imp.errs.add(n.path, ErrCodeUnsupportedElement,
+					fmt.Sprintf("只有排他网关和包容网关可以设置默认顺序流: %s", n.element.ID))
+				continue
+			case !ok || f.source != n.element.ID:
+				imp.errs.add(n.path, ErrCodeDanglingTarget,
+					fmt.Sprintf("默认顺序流 %s 不是节点 %s 的出口", n.defaultFlow, n.element.ID))
+				continue
+			case f.condition != "":
+				imp.errs.add(f.path, ErrCodeInvalidField, fmt.Sprintf("默认顺序流不能设置条件: %s", f.id))
+				continue
+			}
+		}
+
+		var (
+			gateway     GatewayConfig
+			defaultFlow *bpmnFlow
+			plain       []*bpmnFlow
+		)
+		for _, f := range n.outgoing {
+			switch {
+			case f.id == n.defaultFlow:
+				defaultFlow = f
+			case f.condition != "":
+				if !nodeType.IsConditional() {
+					imp.errs.add(f.path, ErrCodeInvalidField,
+						fmt.Sprintf("只有排他网关和包容网关的出口顺序流可以设置条件: %s", f.id))
+					continue
+				}
+				imp.mapFlow(f, fmt.Sprintf("%s.config.conditions[%d]", jsonPath, len(gateway.Conditions)), len(gateway.Conditions))
+				gateway.Conditions = append(gateway.Conditions, &GatewayCondition{Expression: f.condition, Next: f.target})
+			default:
+				plain = append(plain, f)
+			}
+		}
+
+		index := len(gateway.Conditions)
+		if defaultFlow != nil {
+			imp.mapFlow(defaultFlow, jsonPath+".config.default", index)
+			gateway.Default = defaultFlow.target
+			index++
+		}
+		for k, f := range plain {
+			imp.mapFlow(f, fmt.Sprintf("%s.next[%d]", jsonPath, k), index+k)
+			n.element.Next = append(n.element.Next, f.target)
+		}
+
+		if len(gateway.Conditions) > 0 || gateway.Default != "" {
+			raw, err := json.Marshal(&gateway)
+			if err != nil {
+				imp.errs.add(n.path, ErrCodeInvalidField, fmt.Sprintf("网关配置格式无效: %v", err))
+				continue
+			}
+			n.element.Config = raw
+		}
+		imp.doc.Elements = append(imp.doc.Elements, n.element)
+	}
+}
+
+// mapFlow 记录BPMN顺序流对应的引擎顺序流ID与JSON路径
+func (imp *bpmnImporter) mapFlow(f *bpmnFlow, jsonPath string, index int) {
+	imp.flowIDs[f.id] = fmt.Sprintf("%s_flow_%d", f.source, index)
+	imp.paths[jsonPath] = f.path
+}
+
+// importDiagram 导入 bpmndi:BPMNDiagram 中的节点边界与连线折点
+func (imp *bpmnImporter) importDiagram(root *xmlNode) *Diagram {
+	diagram := &Diagram{
+		Shapes: make(map[string]*Bounds),
+		Edges:  make(map[string]*Edge),
+	}
+	for _, d := range root.children(BPMNDINamespace, "BPMNDiagram") {
+		for _, plane := range d.children(BPMNDINamespace, "BPMNPlane") {
+			for _, el := range plane.Children {
+				ref := el.attr("bpmnElement")
+				path := fmt.Sprintf("/definitions/BPMNDiagram/BPMNPlane/%s[@bpmnElement='%s']", el.XMLName.Local, ref)
+				switch {
+				case el.is(BPMNDINamespace, "BPMNShape"):
+					bounds := el.child(DCNamespace, "Bounds")
+					if _, ok := imp.nodes[ref]; !ok || bounds == nil {
+						continue
+					}
+					shape := &Bounds{}
+					imp.parseFloats(bounds, path+"/Bounds", map[string]*float64{
+						"x": &shape.X, "y": &shape.Y, "width": &shape.Width, "height": &shape.Height,
+					})
+					diagram.Shapes[ref] = shape
+				case el.is(BPMNDINamespace, "BPMNEdge"):
+					id, ok := imp.flowIDs[ref]
+					if !ok {
+						continue
+					}
+					flow := imp.flowsByID[ref]
+					edge := &Edge{Source: flow.source, Target: flow.target}
+					for _, wp := range el.children(DINamespace, "waypoint") {
+						var p Point
+						imp.parseFloats(wp, path+"/waypoint", map[string]*float64{"x": &p.X, "y": &p.Y})
+						edge.Waypoints = append(edge.Waypoints, p)
+					}
+					diagram.Edges[id] = edge
+				}
+			}
+		}
+	}
+	if len(diagram.Shapes) == 0 && len(diagram.Edges) == 0 {
+		return nil
+	}
+	return diagram
+}
+
+// parseFloats 解析图形元素的坐标属性
+func (imp *bpmnImporter) parseFloats(el *xmlNode, path string, dst map[string]*float64) {
+	names := make([]string, 0, len(dst))
+	for name := range dst {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		v, err := strconv.ParseFloat(el.attr(name), 64)
+		if err != nil {
+			imp.errs.add(path, ErrCodeInvalidField, fmt.Sprintf("坐标属性 %s 必须是数字: %q", name, el.attr(name)))
+			continue
+		}
+		*dst[name] = v
+	}
+}
+
+// xmlPath 将结构校验错误的JSON路径转换为BPMN元素的XPath
+// 取已记录路径中最长的前缀，无法对应时指向流程元素
+func (imp *bpmnImporter) xmlPath(jsonPath string) string {
+	best := ""
+	for prefix := range imp.paths {
+		if len(prefix) <= len(best) || !strings.HasPrefix(jsonPath, prefix) {
+			continue
+		}
+		if rest := jsonPath[len(prefix):]; rest != "" && rest[0] != '.' && rest[0] != '[' {
+			continue
+		}
+		best = prefix
+	}
+	return imp.paths[best]
+}
+
+// elementPath 生成子元素的XPath，带id属性时按id定位，否则按位置定位
+func elementPath(parent string, el *xmlNode, index int) string {
+	if id := el.attr("id"); id != "" {
+		return fmt.Sprintf("%s/%s[@id='%s']", parent, el.XMLName.Local, id)
+	}
+	return fmt.Sprintf("%s/%s[%d]", parent, el.XMLName.Local, index+1)
+}
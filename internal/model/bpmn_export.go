@@ -0,0 +1,344 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"sort"
+)
+
+// 自动布局参数，流程图缺少节点坐标时按层级排列
+const (
+	layoutOriginX = 80.0
+	layoutOriginY = 80.0
+	layoutStepX   = 160.0
+	layoutStepY   = 120.0
+)
+
+// bpmnDefinitionsXML 导出的 bpmn:definitions 元素
+type bpmnDefinitionsXML struct {
+	XMLName         xml.Name        `xml:"bpmn:definitions"`
+	XmlnsBPMN       string          `xml:"xmlns:bpmn,attr"`
+	XmlnsBPMNDI     string          `xml:"xmlns:bpmndi,attr"`
+	XmlnsDC         string          `xml:"xmlns:dc,attr"`
+	XmlnsDI         string          `xml:"xmlns:di,attr"`
+	XmlnsXSI        string          `xml:"xmlns:xsi,attr"`
+	XmlnsWE         string          `xml:"xmlns:we,attr"`
+	ID              string          `xml:"id,attr"`
+	TargetNamespace string          `xml:"targetNamespace,attr"`
+	Process         bpmnProcessXML  `xml:"bpmn:process"`
+	Diagram         *bpmnDiagramXML `xml:"bpmndi:BPMNDiagram"`
+}
+
+// bpmnProcessXML 导出的 bpmn:process 元素
+type bpmnProcessXML struct {
+	ID            string            `xml:"id,attr"`
+	Name          string            `xml:"name,attr,omitempty"`
+	IsExecutable  bool              `xml:"isExecutable,attr"`
+	Documentation string            `xml:"bpmn:documentation,omitempty"`
+	Extension     *bpmnExtensionXML `xml:"bpmn:extensionElements"`
+	Nodes         []*bpmnNodeXML
+	Flows         []*bpmnFlowXML `xml:"bpmn:sequenceFlow"`
+}
+
+// bpmnExtensionXML 导出的 bpmn:extensionElements 元素
+type bpmnExtensionXML struct {
+	Config    *bpmnConfigXML     `xml:"we:config"`
+	Variables []*bpmnVariableXML `xml:"we:variable"`
+}
+
+// bpmnConfigXML 导出的节点配置，JSON内容放在CDATA中保持可读
+type bpmnConfigXML struct {
+	Body string `xml:",cdata"`
+}
+
+// bpmnVariableXML 导出的变量声明
+type bpmnVariableXML struct {
+	Name string `xml:"name,attr"`
+	Type string `xml:"type,attr"`
+}
+
+// bpmnNodeXML 导出的流程节点，元素名由节点类型决定
+type bpmnNodeXML struct {
+	XMLName   xml.Name
+	ID        string            `xml:"id,attr"`
+	Name      string            `xml:"name,attr,omitempty"`
+	Default   string            `xml:"default,attr,omitempty"`
+	Extension *bpmnExtensionXML `xml:"bpmn:extensionElements"`
+	Incoming  []string          `xml:"bpmn:incoming"`
+	Outgoing  []string          `xml:"bpmn:outgoing"`
+	Loop      *bpmnLoopXML      `xml:"bpmn:multiInstanceLoopCharacteristics"`
+}
+
+// bpmnLoopXML 导出的多实例标记
+type bpmnLoopXML struct {
+	IsSequential        bool              `xml:"isSequential,attr"`
+	Collection          string            `xml:"we:collection,attr,omitempty"`
+	ElementVariable     string            `xml:"we:elementVariable,attr,omitempty"`
+	LoopCardinality     *bpmnConditionXML `xml:"bpmn:loopCardinality"`
+	CompletionCondition *bpmnConditionXML `xml:"bpmn:completionCondition"`
+}
+
+// bpmnFlowXML 导出的顺序流
+type bpmnFlowXML struct {
+	ID        string            `xml:"id,attr"`
+	SourceRef string            `xml:"sourceRef,attr"`
+	TargetRef string            `xml:"targetRef,attr"`
+	Condition *bpmnConditionXML `xml:"bpmn:conditionExpression"`
+}
+
+// bpmnConditionXML 导出的条件表达式
+type bpmnConditionXML struct {
+	Type string `xml:"xsi:type,attr"`
+	Body string `xml:",chardata"`
+}
+
+// bpmnDiagramXML 导出的 bpmndi:BPMNDiagram 元素
+type bpmnDiagramXML struct {
+	ID    string       `xml:"id,attr"`
+	Plane bpmnPlaneXML `xml:"bpmndi:BPMNPlane"`
+}
+
+// bpmnPlaneXML 导出的 bpmndi:BPMNPlane 元素
+type bpmnPlaneXML struct {
+	ID          string          `xml:"id,attr"`
+	BPMNElement string          `xml:"bpmnElement,attr"`
+	Shapes      []*bpmnShapeXML `xml:"bpmndi:BPMNShape"`
+	Edges       []*bpmnEdgeXML  `xml:"bpmndi:BPMNEdge"`
+}
+
+// bpmnShapeXML 导出的节点图形
+type bpmnShapeXML struct {
+	ID          string        `xml:"id,attr"`
+	BPMNElement string        `xml:"bpmnElement,attr"`
+	Bounds      bpmnBoundsXML `xml:"dc:Bounds"`
+}
+
+// bpmnBoundsXML 导出的图形边界
+type bpmnBoundsXML struct {
+	X      float64 `xml:"x,attr"`
+	Y      float64 `xml:"y,attr"`
+	Width  float64 `xml:"width,attr"`
+	Height float64 `xml:"height,attr"`
+}
+
+// bpmnEdgeXML 导出的连线
+type bpmnEdgeXML struct {
+	ID          string             `xml:"id,attr"`
+	BPMNElement string             `xml:"bpmnElement,attr"`
+	Waypoints   []*bpmnWaypointXML `xml:"di:waypoint"`
+}
+
+// bpmnWaypointXML 导出的连线折点
+type bpmnWaypointXML struct {
+	X float64 `xml:"x,attr"`
+	Y float64 `xml:"y,attr"`
+}
+
+// bpmnElementNames 节点类型对应的BPMN元素名
+var bpmnElementNames = map[NodeType]string{
+	NodeTypeStartEvent:       "startEvent",
+	NodeTypeEndEvent:         "endEvent",
+	NodeTypeServiceTask:      "serviceTask",
+	NodeTypeUserTask:         "userTask",
+	NodeTypeExclusiveGateway: "exclusiveGateway",
+	NodeTypeParallelGateway:  "parallelGateway",
+	NodeTypeInclusiveGateway: "inclusiveGateway",
+}
+
+// ExportBPMN 将流程模型导出为 bpmn:definitions 文件
+// 节点坐标与连线折点取自流程图布局，缺失的部分按层级自动布局；
+// 服务任务与用户任务的配置写入 we:config 扩展元素，多实例配置同时写入 bpmn:multiInstanceLoopCharacteristics，
+// 网关条件写入顺序流的条件表达式
+func ExportBPMN(def *Definition, diagram *Diagram) ([]byte, error) {
+	doc := &bpmnDefinitionsXML{
+		XmlnsBPMN:       BPMNNamespace,
+		XmlnsBPMNDI:     BPMNDINamespace,
+		XmlnsDC:         DCNamespace,
+		XmlnsDI:         DINamespace,
+		XmlnsXSI:        XSINamespace,
+		XmlnsWE:         EngineNamespace,
+		ID:              def.ID + "_definitions",
+		TargetNamespace: EngineNamespace,
+		Process: bpmnProcessXML{
+			ID:            def.ID,
+			Name:          def.Name,
+			IsExecutable:  true,
+			Documentation: def.Description,
+		},
+	}
+
+	if len(def.Variables) > 0 {
+		names := make([]string, 0, len(def.Variables))
+		for name := range def.Variables {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		ext := &bpmnExtensionXML{}
+		for _, name := range names {
+			ext.Variables = append(ext.Variables, &bpmnVariableXML{Name: name, Type: def.Variables[name]})
+		}
+		doc.Process.Extension = ext
+	}
+
+	for _, n := range def.Nodes {
+		el := &bpmnNodeXML{
+			XMLName: xml.Name{Local: "bpmn:" + bpmnElementNames[n.Type]},
+			ID:      n.ID,
+			Name:    n.Name,
+		}
+		if !n.Type.IsGateway() && len(n.Config) > 0 {
+			var config bytes.Buffer
+			if err := json.Compact(&config, n.Config); err != nil {
+				return nil, fmt.Errorf("节点 %s 的配置格式无效: %w", n.ID, err)
+			}
+			if config.String() != "null" {
+				el.Extension = &bpmnExtensionXML{Config: &bpmnConfigXML{Body: config.String()}}
+			}
+		}
+		if mi := n.MultiInstance(); mi != nil {
+			el.Loop = exportLoop(mi)
+		}
+		for _, f := range n.Incoming {
+			el.Incoming = append(el.Incoming, f.ID)
+		}
+		for _, f := range n.Outgoing {
+			el.Outgoing = append(el.Outgoing, f.ID)
+			if f.IsDefault {
+				el.Default = f.ID
+			}
+		}
+		doc.Process.Nodes = append(doc.Process.Nodes, el)
+	}
+
+	for _, f := range def.Flows {
+		flow := &bpmnFlowXML{ID: f.ID, SourceRef: f.SourceRef, TargetRef: f.TargetRef}
+		if f.Condition != "" {
+			flow.Condition = &bpmnConditionXML{Type: "bpmn:tFormalExpression", Body: f.Condition}
+		}
+		doc.Process.Flows = append(doc.Process.Flows, flow)
+	}
+
+	doc.Diagram = exportDiagram(def, diagram)
+
+	out, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("生成BPMN文件失败: %w", err)
+	}
+	return append([]byte(xml.Header), out...), nil
+}
+
+// exportLoop 生成多实例标记，便于建模工具显示多实例图标
+func exportLoop(mi *MultiInstanceConfig) *bpmnLoopXML {
+	loop := &bpmnLoopXML{
+		IsSequential:    mi.Sequential,
+		Collection:      mi.Collection,
+		ElementVariable: mi.ElementVariable,
+	}
+	if mi.Cardinality != "" {
+		loop.LoopCardinality = &bpmnConditionXML{Type: "bpmn:tFormalExpression", Body: mi.Cardinality}
+	}
+	if mi.CompletionCondition != "" {
+		loop.CompletionCondition = &bpmnConditionXML{Type: "bpmn:tFormalExpression", Body: mi.CompletionCondition}
+	}
+	return loop
+}
+
+// exportDiagram 生成流程图的图形交换元素
+func exportDiagram(def *Definition, diagram *Diagram) *bpmnDiagramXML {
+	if diagram == nil {
+		diagram = &Diagram{}
+	}
+	shapes := layout(def, diagram.Shapes)
+
+	d := &bpmnDiagramXML{
+		ID: def.ID + "_diagram",
+		Plane: bpmnPlaneXML{
+			ID:          def.ID + "_plane",
+			BPMNElement: def.ID,
+		},
+	}
+	for _, n := range def.Nodes {
+		b := shapes[n.ID]
+		d.Plane.Shapes = append(d.Plane.Shapes, &bpmnShapeXML{
+			ID:          n.ID + "_di",
+			BPMNElement: n.ID,
+			Bounds:      bpmnBoundsXML{X: b.X, Y: b.Y, Width: b.Width, Height: b.Height},
+		})
+	}
+	for _, f := range def.Flows {
+		edge := &bpmnEdgeXML{ID: f.ID + "_di", BPMNElement: f.ID}
+		if e, ok := diagram.Edges[f.ID]; ok && e.Source == f.SourceRef && e.Target == f.TargetRef && len(e.Waypoints) >= 2 {
+			for _, p := range e.Waypoints {
+				edge.Waypoints = append(edge.Waypoints, &bpmnWaypointXML{X: p.X, Y: p.Y})
+			}
+		} else if src, dst := shapes[f.SourceRef], shapes[f.TargetRef]; src != nil && dst != nil {
+			edge.Waypoints = []*bpmnWaypointXML{
+				{X: src.X + src.Width, Y: src.Y + src.Height/2},
+				{X: dst.X, Y: dst.Y + dst.Height/2},
+			}
+		}
+		d.Plane.Edges = append(d.Plane.Edges, edge)
+	}
+	return d
+}
+
+// layout 返回全部节点的图形边界
+// 已有坐标的节点保持不变，其余节点按从开始事件出发的层级自左向右排列
+func layout(def *Definition, existing map[string]*Bounds) map[string]*Bounds {
+	shapes := make(map[string]*Bounds, len(def.Nodes))
+	levels := make(map[string]int, len(def.Nodes))
+	var queue []*Node
+	for _, n := range def.StartNodes() {
+		levels[n.ID] = 0
+		queue = append(queue, n)
+	}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for _, f := range n.Outgoing {
+			if _, seen := levels[f.TargetRef]; seen {
+				continue
+			}
+			if next, ok := def.Node(f.TargetRef); ok {
+				levels[next.ID] = levels[n.ID] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	rows := make(map[int]int)
+	for _, n := range def.Nodes {
+		if b, ok := existing[n.ID]; ok && b != nil {
+			shapes[n.ID] = b
+			continue
+		}
+		level, ok := levels[n.ID]
+		if !ok {
+			level = len(def.Nodes)
+		}
+		row := rows[level]
+		rows[level]++
+
+		width, height := shapeSize(n.Type)
+		shapes[n.ID] = &Bounds{
+			X:      layoutOriginX + float64(level)*layoutStepX + (100-width)/2,
+			Y:      layoutOriginY + float64(row)*layoutStepY + (80-height)/2,
+			Width:  width,
+			Height: height,
+		}
+	}
+	return shapes
+}
+
+// shapeSize 返回节点类型的默认图形尺寸
+func shapeSize(t NodeType) (float64, float64) {
+	switch {
+	case t == NodeTypeStartEvent || t == NodeTypeEndEvent:
+		return 36, 36
+	case t.IsGateway():
+		return 50, 50
+	}
+	return 100, 80
+}
@@ -0,0 +1,249 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// leaveBPMN 建模工具导出的请假审批流程，使用扩展属性配置用户任务
+const leaveBPMN = `<?xml version="1.0" encoding="UTF-8"?>
+<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL"
+    xmlns:bpmndi="http://www.omg.org/spec/BPMN/20100524/DI"
+    xmlns:dc="http://www.omg.org/spec/DD/20100524/DC"
+    xmlns:di="http://www.omg.org/spec/DD/20100524/DI"
+    xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
+    xmlns:camunda="http://camunda.org/schema/1.0/bpmn"
+    xmlns:we="http://workflow-engine.io/schema/bpmn"
+    id="Definitions_1" targetNamespace="http://bpmn.io/schema/bpmn">
+  <bpmn:process id="leave" name="请假审批" isExecutable="true">
+    <bpmn:documentation>员工请假流程</bpmn:documentation>
+    <bpmn:extensionElements>
+      <we:variable name="days" type="number"/>
+    </bpmn:extensionElements>
+    <bpmn:laneSet id="lanes"/>
+    <bpmn:startEvent id="start"><bpmn:outgoing>f1</bpmn:outgoing></bpmn:startEvent>
+    <bpmn:exclusiveGateway id="check" default="f3"/>
+    <bpmn:userTask id="approve" name="经理审批" camunda:assignee="${manager}" camunda:candidateGroups="managers, hr" camunda:priority="50"/>
+    <bpmn:serviceTask id="notify">
+      <bpmn:extensionElements>
+        <we:config>{"service_name": "notification", "input": {"to": "${applicant}"}}</we:config>
+      </bpmn:extensionElements>
+    </bpmn:serviceTask>
+    <bpmn:endEvent id="end"/>
+    <bpmn:sequenceFlow id="f1" sourceRef="start" targetRef="check"/>
+    <bpmn:sequenceFlow id="f3" sourceRef="check" targetRef="notify"/>
+    <bpmn:sequenceFlow id="f2" sourceRef="check" targetRef="approve">
+      <bpmn:conditionExpression xsi:type="bpmn:tFormalExpression"><![CDATA[${days > 3}]]></bpmn:conditionExpression>
+    </bpmn:sequenceFlow>
+    <bpmn:sequenceFlow id="f4" sourceRef="approve" targetRef="notify"/>
+    <bpmn:sequenceFlow id="f5" sourceRef="notify" targetRef="end"/>
+  </bpmn:process>
+  <bpmndi:BPMNDiagram id="diagram">
+    <bpmndi:BPMNPlane id="plane" bpmnElement="leave">
+      <bpmndi:BPMNShape id="start_di" bpmnElement="start"><dc:Bounds x="152" y="102" width="36" height="36"/></bpmndi:BPMNShape>
+      <bpmndi:BPMNShape id="approve_di" bpmnElement="approve"><dc:Bounds x="370" y="80" width="100" height="80"/></bpmndi:BPMNShape>
+      <bpmndi:BPMNEdge id="f2_di" bpmnElement="f2"><di:waypoint x="295" y="120"/><di:waypoint x="370" y="120"/></bpmndi:BPMNEdge>
+    </bpmndi:BPMNPlane>
+  </bpmndi:BPMNDiagram>
+</bpmn:definitions>`
+
+// bpmnProcess 将流程元素包装为完整的BPMN文件
+func bpmnProcess(body string) []byte {
+	return []byte(`<bpmn:definitions xmlns:bpmn="http://www.omg.org/spec/BPMN/20100524/MODEL"
+    xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" id="defs">
+  <bpmn:process id="p" name="测试流程">` + body + `</bpmn:process>
+</bpmn:definitions>`)
+}
+
+// TestParseBPMN 测试BPMN文件导入
+func TestParseBPMN(t *testing.T) {
+	t.Run("映射支持的元素与图形信息", func(t *testing.T) {
+		require.True(t, IsBPMN([]byte(leaveBPMN)), "应该识别为BPMN文件")
+
+		doc, err := ParseBPMN([]byte(leaveBPMN))
+		require.NoError(t, err, "合法的BPMN文件不应该返回错误")
+
+		def := doc.Definition
+		assert.Equal(t, "leave", def.ID, "流程ID应该匹配")
+		assert.Equal(t, "员工请假流程", def.Description, "流程描述应该取自 documentation")
+		assert.Equal(t, map[string]string{"days": "number"}, def.Variables, "变量声明应该匹配")
+		assert.Len(t, def.Nodes, 5, "节点数量应该为5")
+
+		gateway, _ := def.Node("check")
+		require.Len(t, gateway.Outgoing, 2, "网关应该有两条出口顺序流")
+		assert.Equal(t, "${days > 3}", gateway.Outgoing[0].Condition, "条件顺序流应该排在前面")
+		assert.Equal(t, "approve", gateway.Outgoing[0].TargetRef, "条件分支目标应该匹配")
+		assert.True(t, gateway.Outgoing[1].IsDefault, "默认顺序流应该匹配")
+
+		approve, _ := def.Node("approve")
+		require.NotNil(t, approve.UserTask, "用户任务配置不应该为空")
+		assert.Equal(t, "${manager}", approve.UserTask.Assignee, "办理人应该取自扩展属性")
+		assert.Equal(t, []string{"managers", "hr"}, approve.UserTask.CandidateGroups, "候选组应该拆分")
+		assert.Equal(t, int32(50), approve.UserTask.Priority, "优先级应该匹配")
+
+		notify, _ := def.Node("notify")
+		require.NotNil(t, notify.ServiceTask, "服务任务配置不应该为空")
+		assert.Equal(t, "notification", notify.ServiceTask.ServiceName, "服务名称应该取自 we:config")
+		assert.Equal(t, "${applicant}", notify.ServiceTask.Input["to"], "输入参数应该匹配")
+
+		_, err = Parse(doc.Resource)
+		assert.NoError(t, err, "转换后的JSON资源应该可以直接解析")
+
+		require.NotNil(t, doc.Diagram, "应该导入图形信息")
+		assert.Equal(t, &Bounds{X: 370, Y: 80, Width: 100, Height: 80}, doc.Diagram.Shapes["approve"], "节点边界应该匹配")
+		edge := doc.Diagram.Edges["check_flow_0"]
+		require.NotNil(t, edge, "连线应该按引擎顺序流ID记录")
+		assert.Equal(t, "approve", edge.Target, "连线目标应该匹配")
+		assert.Equal(t, []Point{{X: 295, Y: 120}, {X: 370, Y: 120}}, edge.Waypoints, "折点应该匹配")
+	})
+
+	t.Run("拒绝不支持的元素", func(t *testing.T) {
+		_, err := ParseBPMN(bpmnProcess(`
+    <bpmn:startEvent id="start"><bpmn:timerEventDefinition/></bpmn:startEvent>
+    <bpmn:scriptTask id="script"/>
+    <bpmn:userTask id="review"><bpmn:standardLoopCharacteristics/></bpmn:userTask>
+    <bpmn:endEvent id="end"/>
+    <bpmn:sequenceFlow id="f1" sourceRef="start" targetRef="review"/>
+    <bpmn:sequenceFlow id="f2" sourceRef="review" targetRef="end">
+      <bpmn:conditionExpression>${ok}</bpmn:conditionExpression>
+    </bpmn:sequenceFlow>`))
+		require.Error(t, err, "包含不支持元素的文件应该返回错误")
+
+		paths := validationPaths(t, err)
+		assert.Equal(t, ErrCodeUnsupportedElement, paths["/definitions/process/startEvent[@id='start']/timerEventDefinition[1]"], "应该拒绝定时开始事件")
+		assert.Equal(t, ErrCodeUnsupportedElement, paths["/definitions/process/scriptTask[@id='script']"], "应该拒绝脚本任务")
+		assert.Equal(t, ErrCodeUnsupportedElement, paths["/definitions/process/userTask[@id='review']/standardLoopCharacteristics[1]"], "应该拒绝标准循环")
+		assert.Equal(t, ErrCodeInvalidField, paths["/definitions/process/sequenceFlow[@id='f2']"], "任务出口不能设置条件")
+	})
+
+	t.Run("多实例标记", func(t *testing.T) {
+		doc, err := ParseBPMN(bpmnProcess(`
+    <bpmn:startEvent id="start"/>
+    <bpmn:userTask id="review" xmlns:camunda="http://camunda.org/schema/1.0/bpmn" camunda:assignee="${reviewer}">
+      <bpmn:multiInstanceLoopCharacteristics camunda:collection="reviewers" camunda:elementVariable="reviewer">
+        <bpmn:completionCondition xsi:type="bpmn:tFormalExpression">${nrOfCompletedInstances/nrOfInstances >= 0.6}</bpmn:completionCondition>
+      </bpmn:multiInstanceLoopCharacteristics>
+    </bpmn:userTask>
+    <bpmn:serviceTask id="notify" xmlns:we="http://workflow-engine.io/schema/bpmn" we:serviceName="notification">
+      <bpmn:multiInstanceLoopCharacteristics isSequential="true"><bpmn:loopCardinality>3</bpmn:loopCardinality></bpmn:multiInstanceLoopCharacteristics>
+    </bpmn:serviceTask>
+    <bpmn:endEvent id="end"/>
+    <bpmn:sequenceFlow id="f1" sourceRef="start" targetRef="review"/>
+    <bpmn:sequenceFlow id="f2" sourceRef="review" targetRef="notify"/>
+    <bpmn:sequenceFlow id="f3" sourceRef="notify" targetRef="end"/>`))
+		require.NoError(t, err, "多实例任务应该可以导入")
+
+		review, _ := doc.Definition.Node("review")
+		assert.Equal(t, &MultiInstanceConfig{
+			Collection:          "reviewers",
+			ElementVariable:     "reviewer",
+			CompletionCondition: "${nrOfCompletedInstances/nrOfInstances >= 0.6}",
+		}, review.MultiInstance(), "用户任务的多实例配置应该匹配")
+		notify, _ := doc.Definition.Node("notify")
+		assert.Equal(t, &MultiInstanceConfig{Sequential: true, Cardinality: "3"}, notify.MultiInstance(), "服务任务的多实例配置应该匹配")
+
+		_, err = ParseBPMN(bpmnProcess(`
+    <bpmn:startEvent id="start"/>
+    <bpmn:exclusiveGateway id="check"><bpmn:multiInstanceLoopCharacteristics/></bpmn:exclusiveGateway>`))
+		assert.Equal(t, ErrCodeUnsupportedElement,
+			validationPaths(t, err)["/definitions/process/exclusiveGateway[@id='check']/multiInstanceLoopCharacteristics[1]"], "网关不支持多实例")
+	})
+
+	t.Run("结构错误定位到BPMN元素", func(t *testing.T) {
+		_, err := ParseBPMN(bpmnProcess(`
+    <bpmn:startEvent id="start"/>
+    <bpmn:exclusiveGateway id="check"/>
+    <bpmn:endEvent id="end"/>
+    <bpmn:sequenceFlow id="f1" sourceRef="start" targetRef="check"/>
+    <bpmn:sequenceFlow id="f2" sourceRef="check" targetRef="end">
+      <bpmn:conditionExpression>${amount &gt;}</bpmn:conditionExpression>
+    </bpmn:sequenceFlow>`))
+		require.Error(t, err, "非法表达式应该返回错误")
+
+		paths := validationPaths(t, err)
+		assert.Equal(t, ErrCodeInvalidExpression, paths["/definitions/process/sequenceFlow[@id='f2']"], "表达式错误应该定位到顺序流")
+	})
+
+	t.Run("无效文件", func(t *testing.T) {
+		_, err := ParseBPMN([]byte("<bpmn:definitions"))
+		assert.Equal(t, map[string]string{"/": ErrCodeInvalidXML}, validationPaths(t, err), "应该报告XML格式错误")
+
+		_, err = ParseBPMN([]byte(`<definitions xmlns="http://www.omg.org/spec/BPMN/20100524/MODEL"/>`))
+		assert.Equal(t, map[string]string{"/definitions": ErrCodeMissingField}, validationPaths(t, err), "应该报告缺少流程")
+	})
+}
+
+// TestExportBPMN 测试BPMN导出与往返转换
+func TestExportBPMN(t *testing.T) {
+	t.Run("往返转换保持语义与坐标", func(t *testing.T) {
+		def, err := Parse([]byte(approvalResource))
+		require.NoError(t, err)
+		diagram := &Diagram{
+			Shapes: map[string]*Bounds{"manager": {X: 400, Y: 60, Width: 100, Height: 80}},
+			Edges: map[string]*Edge{
+				"check_flow_0": {Source: "check", Target: "manager", Waypoints: []Point{{X: 325, Y: 100}, {X: 400, Y: 100}}},
+			},
+		}
+
+		xmlData, err := ExportBPMN(def, diagram)
+		require.NoError(t, err, "导出不应该返回错误")
+
+		doc, err := ParseBPMN(xmlData)
+		require.NoError(t, err, "导出的文件应该可以重新导入")
+		assertSameSemantics(t, def, doc.Definition)
+
+		require.NotNil(t, doc.Diagram, "导出的文件应该包含图形信息")
+		assert.Equal(t, diagram.Shapes["manager"], doc.Diagram.Shapes["manager"], "已有节点坐标应该保留")
+		assert.Equal(t, diagram.Edges["check_flow_0"], doc.Diagram.Edges["check_flow_0"], "已有连线折点应该保留")
+		assert.Len(t, doc.Diagram.Shapes, len(def.Nodes), "缺少坐标的节点应该自动布局")
+		assert.Len(t, doc.Diagram.Edges, len(def.Flows), "每条顺序流都应该有连线")
+	})
+
+	t.Run("并行与包容网关往返转换", func(t *testing.T) {
+		def, err := Parse([]byte(`{
+			"id": "review", "name": "会审", "variables": {"legal": "bool"},
+			"elements": [
+				{"id": "start", "type": "start_event", "next": "split"},
+				{"id": "split", "type": "inclusive_gateway",
+				 "config": {"conditions": [{"expression": "${legal}", "next": "law"}], "default": "finance"}, "next": "audit"},
+				{"id": "law", "type": "user_task", "next": "merge", "config": {"candidate_groups": ["legal"],
+				 "multi_instance": {"collection": "${lawyers}", "element_variable": "lawyer", "completion_condition": "${nrOfCompletedInstances >= 1}"}}},
+				{"id": "finance", "type": "user_task", "next": "merge"},
+				{"id": "audit", "type": "service_task", "next": "merge", "config": {"service_name": "audit"}},
+				{"id": "merge", "type": "inclusive_gateway", "next": "end"},
+				{"id": "end", "type": "end_event"}
+			]
+		}`))
+		require.NoError(t, err)
+
+		xmlData, err := ExportBPMN(def, nil)
+		require.NoError(t, err, "导出不应该返回错误")
+		doc, err := ParseBPMN(xmlData)
+		require.NoError(t, err, "导出的文件应该可以重新导入")
+		assertSameSemantics(t, def, doc.Definition)
+	})
+}
+
+// assertSameSemantics 断言两个流程模型的节点、配置与顺序流一致
+func assertSameSemantics(t *testing.T, want, got *Definition) {
+	t.Helper()
+	assert.Equal(t, want.ID, got.ID, "流程ID应该一致")
+	assert.Equal(t, want.Name, got.Name, "流程名称应该一致")
+	assert.Equal(t, want.Variables, got.Variables, "变量声明应该一致")
+	require.Len(t, got.Nodes, len(want.Nodes), "节点数量应该一致")
+	for i, w := range want.Nodes {
+		g := got.Nodes[i]
+		assert.Equal(t, w.ID, g.ID, "节点ID应该一致")
+		assert.Equal(t, w.Type, g.Type, "节点 %s 的类型应该一致", w.ID)
+		assert.Equal(t, w.ServiceTask, g.ServiceTask, "节点 %s 的服务任务配置应该一致", w.ID)
+		assert.Equal(t, w.UserTask, g.UserTask, "节点 %s 的用户任务配置应该一致", w.ID)
+	}
+	flows := func(d *Definition) string {
+		out, _ := json.Marshal(d.Flows)
+		return string(out)
+	}
+	assert.JSONEq(t, flows(want), flows(got), "顺序流应该一致")
+}
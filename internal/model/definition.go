@@ -0,0 +1,281 @@
+// Package model 提供流程模型功能
+// 将流程定义资源解析为强类型的节点与顺序流，并进行结构校验
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NodeType 节点类型
+type NodeType string
+
+// 节点类型常量
+const (
+	NodeTypeStartEvent       NodeType = "start_event"       // 开始事件
+	NodeTypeEndEvent         NodeType = "end_event"         // 结束事件
+	NodeTypeServiceTask      NodeType = "service_task"      // 服务任务
+	NodeTypeUserTask         NodeType = "user_task"         // 用户任务
+	NodeTypeExclusiveGateway NodeType = "exclusive_gateway" // 排他网关
+	NodeTypeParallelGateway  NodeType = "parallel_gateway"  // 并行网关
+	NodeTypeInclusiveGateway NodeType = "inclusive_gateway" // 包容网关
+)
+
+// nodeTypeAliases 节点类型别名，兼容 BPMN 风格的驼峰命名
+var nodeTypeAliases = map[string]NodeType{
+	"startEvent":       NodeTypeStartEvent,
+	"endEvent":         NodeTypeEndEvent,
+	"serviceTask":      NodeTypeServiceTask,
+	"userTask":         NodeTypeUserTask,
+	"exclusiveGateway": NodeTypeExclusiveGateway,
+	"parallelGateway":  NodeTypeParallelGateway,
+	"inclusiveGateway": NodeTypeInclusiveGateway,
+}
+
+// ParseNodeType 解析节点类型，支持规范名称与驼峰别名
+func ParseNodeType(s string) (NodeType, bool) {
+	switch t := NodeType(s); t {
+	case NodeTypeStartEvent, NodeTypeEndEvent, NodeTypeServiceTask,
+		NodeTypeUserTask, NodeTypeExclusiveGateway, NodeTypeParallelGateway, NodeTypeInclusiveGateway:
+		return t, true
+	}
+	if t, ok := nodeTypeAliases[s]; ok {
+		return t, true
+	}
+	return "", false
+}
+
+// IsGateway 判断是否为网关节点
+func (t NodeType) IsGateway() bool {
+	return t == NodeTypeExclusiveGateway || t == NodeTypeParallelGateway || t == NodeTypeInclusiveGateway
+}
+
+// IsConditional 判断网关是否按条件选择出口
+func (t NodeType) IsConditional() bool {
+	return t == NodeTypeExclusiveGateway || t == NodeTypeInclusiveGateway
+}
+
+// Definition 流程定义模型
+// 由流程定义资源解析而来，包含全部节点和顺序流
+type Definition struct {
+	ID          string            `json:"id"`                    // 流程ID
+	Name        string            `json:"name"`                  // 流程名称
+	Description string            `json:"description,omitempty"` // 流程描述
+	Variables   map[string]string `json:"variables,omitempty"`   // 变量声明（变量名 -> 类型）
+	Nodes       []*Node           `json:"-"`                     // 节点列表，保持资源中的顺序
+	Flows       []*SequenceFlow   `json:"-"`                     // 顺序流列表
+
+	nodes map[string]*Node
+	joins map[string]string // 分支节点ID -> 对应的汇聚网关ID
+}
+
+// Node 返回指定ID的节点
+func (d *Definition) Node(id string) (*Node, bool) {
+	n, ok := d.nodes[id]
+	return n, ok
+}
+
+// StartNodes 返回全部开始事件节点
+func (d *Definition) StartNodes() []*Node {
+	return d.nodesOfType(NodeTypeStartEvent)
+}
+
+// EndNodes 返回全部结束事件节点
+func (d *Definition) EndNodes() []*Node {
+	return d.nodesOfType(NodeTypeEndEvent)
+}
+
+// StartNode 返回唯一的开始事件节点，不存在时返回 nil
+func (d *Definition) StartNode() *Node {
+	starts := d.StartNodes()
+	if len(starts) == 0 {
+		return nil
+	}
+	return starts[0]
+}
+
+// nodesOfType 按类型筛选节点
+func (d *Definition) nodesOfType(t NodeType) []*Node {
+	var result []*Node
+	for _, n := range d.Nodes {
+		if n.Type == t {
+			result = append(result, n)
+		}
+	}
+	return result
+}
+
+// Node 流程节点
+type Node struct {
+	ID       string          `json:"id"`               // 节点ID
+	Name     string          `json:"name,omitempty"`   // 节点名称
+	Type     NodeType        `json:"type"`             // 节点类型
+	Config   json.RawMessage `json:"config,omitempty"` // 原始节点配置
+	Incoming []*SequenceFlow `json:"-"`                // 入口顺序流
+	Outgoing []*SequenceFlow `json:"-"`                // 出口顺序流
+
+	ServiceTask *ServiceTaskConfig `json:"-"` // 服务任务配置
+	UserTask    *UserTaskConfig    `json:"-"` // 用户任务配置
+	Gateway     *GatewayConfig     `json:"-"` // 网关配置
+
+	path string
+}
+
+// Path 返回节点在资源中的JSON路径
+func (n *Node) Path() string {
+	return n.path
+}
+
+// MultiInstance 返回任务节点的多实例配置，非多实例节点返回 nil
+func (n *Node) MultiInstance() *MultiInstanceConfig {
+	switch {
+	case n.ServiceTask != nil:
+		return n.ServiceTask.MultiInstance
+	case n.UserTask != nil:
+		return n.UserTask.MultiInstance
+	}
+	return nil
+}
+
+// SequenceFlow 顺序流，连接两个节点
+type SequenceFlow struct {
+	ID        string `json:"id"`                  // 顺序流ID
+	SourceRef string `json:"source_ref"`          // 源节点ID
+	TargetRef string `json:"target_ref"`          // 目标节点ID
+	Condition string `json:"condition,omitempty"` // 条件表达式
+	IsDefault bool   `json:"is_default"`          // 是否为网关默认流
+
+	path string
+}
+
+// Path 返回顺序流目标在资源中的JSON路径
+func (f *SequenceFlow) Path() string {
+	return f.path
+}
+
+// ServiceTaskConfig 服务任务配置
+type ServiceTaskConfig struct {
+	ServiceName   string                 `json:"service_name"`             // 服务名称
+	Method        string                 `json:"method,omitempty"`         // 调用方法
+	Input         map[string]interface{} `json:"input,omitempty"`          // 输入参数，支持 ${...} 引用流程变量
+	Timeout       string                 `json:"timeout,omitempty"`        // 执行超时，如 30s
+	RetryPolicy   *RetryPolicy           `json:"retry_policy,omitempty"`   // 重试策略
+	MultiInstance *MultiInstanceConfig   `json:"multi_instance,omitempty"` // 多实例配置
+}
+
+// RetryPolicy 服务任务重试策略
+type RetryPolicy struct {
+	MaxAttempts int    `json:"max_attempts,omitempty"` // 最大尝试次数
+	Backoff     string `json:"backoff,omitempty"`      // 退避策略：fixed、exponential
+}
+
+// UserTaskConfig 用户任务配置
+type UserTaskConfig struct {
+	Assignee        string                 `json:"assignee,omitempty"`         // 办理人
+	CandidateUsers  []string               `json:"candidate_users,omitempty"`  // 候选用户
+	CandidateGroups []string               `json:"candidate_groups,omitempty"` // 候选组
+	FormKey         string                 `json:"form_key,omitempty"`         // 表单键
+	Form            map[string]interface{} `json:"form,omitempty"`             // 内联表单定义
+	DueDate         string                 `json:"due_date,omitempty"`         // 到期时长，如 2h、3d
+	Priority        int32                  `json:"priority,omitempty"`         // 优先级
+	Escalations     []*EscalationRule      `json:"escalations,omitempty"`      // 到期升级规则，需要配置到期时长
+	MultiInstance   *MultiInstanceConfig   `json:"multi_instance,omitempty"`   // 多实例配置
+}
+
+// 多实例内置计数变量，在实例变量与完成条件中可用
+const (
+	VarNrOfInstances          = "nrOfInstances"          // 实例总数
+	VarNrOfActiveInstances    = "nrOfActiveInstances"    // 运行中的实例数
+	VarNrOfCompletedInstances = "nrOfCompletedInstances" // 已完成的实例数
+	VarLoopCounter            = "loopCounter"            // 当前实例的序号，从0开始
+)
+
+// MultiInstanceConfig 多实例配置
+// 按集合变量为每个元素创建一个实例，或按基数创建固定数量的实例；
+// 串行时逐个执行，并行时同时执行，完成条件成立后结束剩余实例
+type MultiInstanceConfig struct {
+	Sequential          bool   `json:"sequential,omitempty"`           // 是否串行执行
+	Collection          string `json:"collection,omitempty"`           // 集合变量名或 ${...} 表达式，结果必须是列表
+	ElementVariable     string `json:"element_variable,omitempty"`     // 当前元素在实例中的变量名
+	Cardinality         string `json:"cardinality,omitempty"`          // 实例数量，整数或 ${...} 表达式，未配置集合时使用
+	CompletionCondition string `json:"completion_condition,omitempty"` // 完成条件，每个实例完成后计算
+}
+
+// 用户任务升级动作
+const (
+	EscalationRemind        = "remind"         // 提醒办理人
+	EscalationReassign      = "reassign"       // 转交给其他用户或组
+	EscalationRaisePriority = "raise_priority" // 提高优先级
+	EscalationAutoComplete  = "auto_complete"  // 以默认变量自动完成
+)
+
+// EscalationRule 用户任务到期升级规则
+// 触发时间相对任务到期时间计算：before 为到期前的时长，after 为到期后的时长，都不配置时在到期时触发
+type EscalationRule struct {
+	Action          string                 `json:"action"`                     // 升级动作
+	Before          string                 `json:"before,omitempty"`           // 到期前触发的时长，如 1h
+	After           string                 `json:"after,omitempty"`            // 到期后触发的时长，如 1d
+	Recipients      []string               `json:"recipients,omitempty"`       // 提醒接收人，为空时提醒办理人或候选人
+	Assignee        string                 `json:"assignee,omitempty"`         // 转交的办理人
+	CandidateGroups []string               `json:"candidate_groups,omitempty"` // 转交的候选组
+	Priority        int32                  `json:"priority,omitempty"`         // 提高到的优先级
+	Variables       map[string]interface{} `json:"variables,omitempty"`        // 自动完成时提交的变量，支持 ${...} 引用流程变量
+}
+
+// Offset 返回触发时间相对到期时间的偏移，到期前为负数
+func (r *EscalationRule) Offset() (time.Duration, error) {
+	switch {
+	case r.Before != "" && r.After != "":
+		return 0, fmt.Errorf("before 与 after 不能同时配置")
+	case r.Before != "":
+		d, err := ParseDuration(r.Before)
+		return -d, err
+	case r.After != "":
+		return ParseDuration(r.After)
+	}
+	return 0, nil
+}
+
+// GatewayConfig 网关配置
+type GatewayConfig struct {
+	Conditions []*GatewayCondition `json:"conditions,omitempty"` // 条件分支
+	Default    string              `json:"default,omitempty"`    // 默认分支目标节点
+}
+
+// GatewayCondition 网关条件分支
+type GatewayCondition struct {
+	Expression string `json:"expression"` // 条件表达式
+	Next       string `json:"next"`       // 条件成立时的目标节点
+}
+
+// ParseDuration 解析时长字符串
+// 在 time.ParseDuration 的基础上支持以 d 表示天，如 3d、1d12h
+func ParseDuration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, fmt.Errorf("时长不能为空")
+	}
+	if idx := strings.Index(s, "d"); idx > 0 {
+		days, err := strconv.Atoi(s[:idx])
+		if err != nil {
+			return 0, fmt.Errorf("无效的时长格式: %s", s)
+		}
+		total := time.Duration(days) * 24 * time.Hour
+		if rest := s[idx+1:]; rest != "" {
+			d, err := time.ParseDuration(rest)
+			if err != nil {
+				return 0, fmt.Errorf("无效的时长格式: %s", s)
+			}
+			total += d
+		}
+		return total, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("无效的时长格式: %s", s)
+	}
+	return d, nil
+}
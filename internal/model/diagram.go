@@ -0,0 +1,66 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Diagram 流程图布局数据，对应流程定义的 diagram_data 字段
+// 节点按ID记录图形边界，连线按顺序流ID记录折点
+type Diagram struct {
+	Shapes map[string]*Bounds `json:"shapes,omitempty"` // 节点ID -> 图形边界
+	Edges  map[string]*Edge   `json:"edges,omitempty"`  // 顺序流ID -> 连线
+}
+
+// Bounds 图形边界
+type Bounds struct {
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+}
+
+// Edge 连线，记录源节点与目标节点以便在顺序流变化后识别失效的折点
+type Edge struct {
+	Source    string  `json:"source"`    // 源节点ID
+	Target    string  `json:"target"`    // 目标节点ID
+	Waypoints []Point `json:"waypoints"` // 折点
+}
+
+// Point 坐标点
+type Point struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+// DiagramFromMap 从 diagram_data 字段的值解析流程图布局
+func DiagramFromMap(data map[string]interface{}) (*Diagram, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("序列化流程图数据失败: %w", err)
+	}
+	var diagram Diagram
+	if err := json.Unmarshal(raw, &diagram); err != nil {
+		return nil, fmt.Errorf("流程图数据格式无效: %w", err)
+	}
+	return &diagram, nil
+}
+
+// Map 转换为 diagram_data 字段的值
+func (d *Diagram) Map() (map[string]interface{}, error) {
+	if d == nil {
+		return nil, nil
+	}
+	raw, err := json.Marshal(d)
+	if err != nil {
+		return nil, fmt.Errorf("序列化流程图数据失败: %w", err)
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("转换流程图数据失败: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,140 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/workflow-engine/workflow-engine/internal/expr"
+)
+
+// variableTypes 根据变量声明构建表达式类型环境
+func variableTypes(def *Definition, errs *ValidationErrors) expr.TypeEnv {
+	env := make(expr.TypeEnv, len(def.Variables))
+	names := make([]string, 0, len(def.Variables))
+	for name := range def.Variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		t, ok := expr.ParseType(def.Variables[name])
+		if !ok {
+			errs.add("$.variables."+name, ErrCodeInvalidField, fmt.Sprintf("不支持的变量类型: %s", def.Variables[name]))
+			continue
+		}
+		env[name] = t
+	}
+	return env
+}
+
+// validateExpressions 编译节点配置中的表达式并做类型检查
+// 网关条件、服务任务输入、用户任务办理人与升级规则在部署时即可发现语法与类型错误
+func validateExpressions(n *Node, env expr.TypeEnv, errs *ValidationErrors) {
+	configPath := n.path + ".config"
+	if mi := n.MultiInstance(); mi != nil {
+		env = validateMultiInstanceExpressions(mi, configPath+".multi_instance", env, errs)
+	}
+	switch n.Type {
+	case NodeTypeExclusiveGateway, NodeTypeInclusiveGateway:
+		for i, c := range n.Gateway.Conditions {
+			if c.Expression == "" {
+				continue
+			}
+			if _, err := expr.CompileCondition(c.Expression, env); err != nil {
+				addExpressionError(errs, fmt.Sprintf("%s.conditions[%d].expression", configPath, i), err)
+			}
+		}
+	case NodeTypeServiceTask:
+		keys := make([]string, 0, len(n.ServiceTask.Input))
+		for k := range n.ServiceTask.Input {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			validateTemplates(n.ServiceTask.Input[k], configPath+".input."+k, env, errs)
+		}
+	case NodeTypeUserTask:
+		validateTemplates(n.UserTask.Assignee, configPath+".assignee", env, errs)
+		for i, u := range n.UserTask.CandidateUsers {
+			validateTemplates(u, fmt.Sprintf("%s.candidate_users[%d]", configPath, i), env, errs)
+		}
+		for i, g := range n.UserTask.CandidateGroups {
+			validateTemplates(g, fmt.Sprintf("%s.candidate_groups[%d]", configPath, i), env, errs)
+		}
+		for i, rule := range n.UserTask.Escalations {
+			if rule == nil {
+				continue
+			}
+			rulePath := fmt.Sprintf("%s.escalations[%d]", configPath, i)
+			for j, u := range rule.Recipients {
+				validateTemplates(u, fmt.Sprintf("%s.recipients[%d]", rulePath, j), env, errs)
+			}
+			validateTemplates(rule.Assignee, rulePath+".assignee", env, errs)
+			for j, g := range rule.CandidateGroups {
+				validateTemplates(g, fmt.Sprintf("%s.candidate_groups[%d]", rulePath, j), env, errs)
+			}
+			validateTemplates(map[string]interface{}(rule.Variables), rulePath+".variables", env, errs)
+		}
+	}
+}
+
+// validateMultiInstanceExpressions 编译多实例的集合、基数与完成条件，
+// 返回加入内置计数变量与元素变量后的类型环境，供节点其他配置使用
+func validateMultiInstanceExpressions(mi *MultiInstanceConfig, path string, env expr.TypeEnv, errs *ValidationErrors) expr.TypeEnv {
+	validateTemplates(mi.Collection, path+".collection", env, errs)
+	validateTemplates(mi.Cardinality, path+".cardinality", env, errs)
+
+	scoped := make(expr.TypeEnv, len(env)+5)
+	for name, t := range env {
+		scoped[name] = t
+	}
+	for _, name := range []string{VarNrOfInstances, VarNrOfActiveInstances, VarNrOfCompletedInstances, VarLoopCounter} {
+		scoped[name] = expr.TypeNumber
+	}
+	if mi.ElementVariable != "" {
+		scoped[mi.ElementVariable] = expr.TypeAny
+	}
+
+	if mi.CompletionCondition != "" {
+		if _, err := expr.CompileCondition(mi.CompletionCondition, scoped); err != nil {
+			addExpressionError(errs, path+".completion_condition", err)
+		}
+	}
+	return scoped
+}
+
+// validateTemplates 递归编译配置值中的 ${...} 模板
+func validateTemplates(value interface{}, path string, env expr.TypeEnv, errs *ValidationErrors) {
+	switch v := value.(type) {
+	case string:
+		if !expr.IsTemplate(v) {
+			return
+		}
+		if _, err := expr.CompileTemplate(v, env); err != nil {
+			addExpressionError(errs, path, err)
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			validateTemplates(v[k], path+"."+k, env, errs)
+		}
+	case []interface{}:
+		for i, item := range v {
+			validateTemplates(item, fmt.Sprintf("%s[%d]", path, i), env, errs)
+		}
+	}
+}
+
+// addExpressionError 记录表达式错误
+func addExpressionError(errs *ValidationErrors, path string, err error) {
+	var exprErr *expr.Error
+	if errors.As(err, &exprErr) {
+		errs.add(path, ErrCodeInvalidExpression, fmt.Sprintf("表达式错误（位置 %d）: %s", exprErr.Pos, exprErr.Message))
+		return
+	}
+	errs.add(path, ErrCodeInvalidExpression, fmt.Sprintf("表达式错误: %s", err.Error()))
+}
@@ -0,0 +1,272 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// approvalResource 包含服务任务、用户任务与排他网关的合法流程定义
+const approvalResource = `{
+	"id": "expense-approval",
+	"name": "报销审批",
+	"elements": [
+		{"id": "start", "type": "start_event", "next": ["validate"]},
+		{"id": "validate", "type": "service_task", "next": ["check"],
+		 "config": {"service_name": "validation_service", "timeout": "30s", "retry_policy": {"max_attempts": 3, "backoff": "exponential"}}},
+		{"id": "check", "type": "exclusive_gateway",
+		 "config": {"conditions": [{"expression": "${amount > 1000}", "next": "manager"}], "default": "end"}},
+		{"id": "manager", "type": "user_task", "next": "end",
+		 "config": {"assignee": "${manager}", "candidate_groups": ["managers"], "due_date": "2d"}},
+		{"id": "end", "type": "end_event"}
+	]
+}`
+
+// validationPaths 提取校验错误的路径与错误码，便于断言
+func validationPaths(t *testing.T, err error) map[string]string {
+	t.Helper()
+	var verrs ValidationErrors
+	require.True(t, errors.As(err, &verrs), "错误应该是 ValidationErrors")
+	result := make(map[string]string, len(verrs))
+	for _, e := range verrs {
+		result[e.Path] = e.Code
+	}
+	return result
+}
+
+// TestParse 测试流程定义解析
+func TestParse(t *testing.T) {
+	t.Run("解析合法流程定义", func(t *testing.T) {
+		def, err := Parse([]byte(approvalResource))
+		require.NoError(t, err, "合法的流程定义不应该返回错误")
+
+		assert.Equal(t, "expense-approval", def.ID, "流程ID应该匹配")
+		assert.Len(t, def.Nodes, 5, "节点数量应该为5")
+		assert.Equal(t, "start", def.StartNode().ID, "开始节点应该匹配")
+
+		gateway, ok := def.Node("check")
+		require.True(t, ok, "网关节点应该存在")
+		assert.Equal(t, NodeTypeExclusiveGateway, gateway.Type, "节点类型应该为排他网关")
+		require.Len(t, gateway.Outgoing, 2, "网关应该有两条出口顺序流")
+		assert.Equal(t, "${amount > 1000}", gateway.Outgoing[0].Condition, "条件表达式应该匹配")
+		assert.True(t, gateway.Outgoing[1].IsDefault, "第二条顺序流应该是默认流")
+
+		task, _ := def.Node("manager")
+		require.NotNil(t, task.UserTask, "用户任务配置不应该为空")
+		assert.Equal(t, []string{"managers"}, task.UserTask.CandidateGroups, "候选组应该匹配")
+		assert.Len(t, task.Incoming, 1, "用户任务应该有一条入口顺序流")
+
+		end, _ := def.Node("end")
+		assert.Len(t, end.Incoming, 2, "结束事件应该有两条入口顺序流")
+	})
+
+	t.Run("兼容驼峰节点类型", func(t *testing.T) {
+		def, err := Parse([]byte(`{"id":"p","name":"p","elements":[` +
+			`{"id":"s","type":"startEvent","next":"e"},{"id":"e","type":"endEvent"}]}`))
+		require.NoError(t, err, "驼峰节点类型应该被接受")
+		assert.Equal(t, NodeTypeStartEvent, def.StartNode().Type, "开始节点类型应该被规范化")
+	})
+
+	t.Run("无效JSON", func(t *testing.T) {
+		_, err := Parse([]byte(`{"id":`))
+		paths := validationPaths(t, err)
+		assert.Equal(t, ErrCodeInvalidJSON, paths["$"], "应该报告JSON格式错误")
+	})
+
+	t.Run("缺少必要字段", func(t *testing.T) {
+		_, err := Parse([]byte(`{"name":"测试流程"}`))
+		paths := validationPaths(t, err)
+		assert.Equal(t, ErrCodeMissingField, paths["$.id"], "应该报告缺少id")
+		assert.Equal(t, ErrCodeMissingField, paths["$.elements"], "应该报告缺少elements")
+		assert.Contains(t, err.Error(), "流程定义缺少id字段", "错误信息应该包含缺少的字段")
+	})
+}
+
+// TestValidate 测试流程定义结构校验
+func TestValidate(t *testing.T) {
+	t.Run("报告全部结构错误", func(t *testing.T) {
+		_, err := Parse([]byte(`{"id":"p","name":"p","elements":[
+			{"id":"a","type":"service_task","next":["ghost"],"config":{"service_name":"svc"}},
+			{"id":"a","type":"user_task"},
+			{"id":"g","type":"parallel_gateway"},
+			{"id":"x","type":"unknown_type"}
+		]}`))
+		paths := validationPaths(t, err)
+
+		assert.Equal(t, ErrCodeDanglingTarget, paths["$.elements[0].next[0]"], "应该报告悬空的next目标")
+		assert.Equal(t, ErrCodeDuplicateID, paths["$.elements[1].id"], "应该报告重复的节点ID")
+		assert.Equal(t, ErrCodeGatewayWithoutOutgoing, paths["$.elements[2].next"], "应该报告没有出口的网关")
+		assert.Equal(t, ErrCodeUnknownNodeType, paths["$.elements[3].type"], "应该报告未知的节点类型")
+		assert.Contains(t, []string{ErrCodeMissingStartEvent, ErrCodeMissingEndEvent}, paths["$.elements"], "应该报告缺少开始或结束事件")
+	})
+
+	t.Run("缺少开始与结束事件", func(t *testing.T) {
+		_, err := Parse([]byte(`{"id":"p","name":"p","elements":[
+			{"id":"t","type":"user_task","next":"t2"},
+			{"id":"t2","type":"user_task","next":"t"}
+		]}`))
+		var verrs ValidationErrors
+		require.True(t, errors.As(err, &verrs), "错误应该是 ValidationErrors")
+
+		codes := make([]string, len(verrs))
+		for i, e := range verrs {
+			codes[i] = e.Code
+		}
+		assert.Contains(t, codes, ErrCodeMissingStartEvent, "应该报告缺少开始事件")
+		assert.Contains(t, codes, ErrCodeMissingEndEvent, "应该报告缺少结束事件")
+	})
+
+	t.Run("不可达节点与网关条件", func(t *testing.T) {
+		_, err := Parse([]byte(`{"id":"p","name":"p","elements":[
+			{"id":"s","type":"start_event","next":"gw"},
+			{"id":"gw","type":"exclusive_gateway","config":{"conditions":[{"expression":"","next":"e"}]}},
+			{"id":"island","type":"user_task","next":"e","config":{"due_date":"soon"}},
+			{"id":"e","type":"end_event"}
+		]}`))
+		paths := validationPaths(t, err)
+
+		assert.Equal(t, ErrCodeMissingField, paths["$.elements[1].config.conditions[0].expression"], "应该报告缺少条件表达式")
+		assert.Equal(t, ErrCodeUnreachableNode, paths["$.elements[2]"], "应该报告不可达节点")
+		assert.Equal(t, ErrCodeInvalidField, paths["$.elements[2].config.due_date"], "应该报告无效的到期时长")
+	})
+
+	t.Run("嵌套并行区域匹配汇聚网关", func(t *testing.T) {
+		def, err := Parse([]byte(`{"id":"p","name":"p","elements":[
+			{"id":"s","type":"start_event","next":"fork"},
+			{"id":"fork","type":"parallel_gateway","next":["a","inner"]},
+			{"id":"a","type":"user_task","next":"join"},
+			{"id":"inner","type":"inclusive_gateway","config":{"conditions":[{"expression":"${x > 1}","next":"b"}],"default":"c"}},
+			{"id":"b","type":"user_task","next":"inner_join"},
+			{"id":"c","type":"user_task","next":["d","e"]},
+			{"id":"d","type":"user_task","next":"cde_join"},
+			{"id":"e","type":"user_task","next":"cde_join"},
+			{"id":"cde_join","type":"parallel_gateway","next":"inner_join"},
+			{"id":"inner_join","type":"inclusive_gateway","next":"join"},
+			{"id":"join","type":"parallel_gateway","next":"end"},
+			{"id":"end","type":"end_event"}
+		]}`))
+		require.NoError(t, err, "结构正确的嵌套区域不应该报错")
+
+		assert.Equal(t, "join", def.JoinOf("fork"), "外层分支应该匹配外层汇聚")
+		assert.Equal(t, "inner_join", def.JoinOf("inner"), "包容分支应该匹配包容汇聚")
+		assert.Equal(t, "cde_join", def.JoinOf("c"), "隐式分支应该匹配最近的并行汇聚")
+		c, _ := def.Node("c")
+		assert.True(t, c.IsFork(), "多出口的任务节点应该是隐式分支")
+	})
+
+	t.Run("分支与汇聚不匹配", func(t *testing.T) {
+		_, err := Parse([]byte(`{"id":"p","name":"p","elements":[
+			{"id":"s","type":"start_event","next":"fork"},
+			{"id":"fork","type":"parallel_gateway","next":["a","b"]},
+			{"id":"a","type":"user_task","next":"merge"},
+			{"id":"b","type":"user_task","next":"merge"},
+			{"id":"merge","type":"inclusive_gateway","next":"x"},
+			{"id":"x","type":"exclusive_gateway","config":{"conditions":[{"expression":"${ok}","next":"c"}],"default":"d"}},
+			{"id":"c","type":"user_task","next":"join"},
+			{"id":"d","type":"user_task","next":"join"},
+			{"id":"join","type":"parallel_gateway","next":"end"},
+			{"id":"end","type":"end_event"}
+		]}`))
+		paths := validationPaths(t, err)
+
+		assert.Equal(t, ErrCodeUnbalancedGateway, paths["$.elements[1]"], "并行分支不能由包容网关汇聚")
+		assert.Equal(t, ErrCodeUnbalancedGateway, paths["$.elements[4]"], "包容汇聚网关没有对应的分支")
+		assert.Equal(t, ErrCodeUnbalancedGateway, paths["$.elements[8]"], "排他分支不能由并行网关汇聚")
+	})
+
+	t.Run("部署时编译并检查表达式", func(t *testing.T) {
+		_, err := Parse([]byte(`{"id":"p","name":"p",
+			"variables":{"amount":"number","applicant":"string","level":"decimal128"},
+			"elements":[
+			{"id":"s","type":"start_event","next":"notify"},
+			{"id":"notify","type":"service_task","next":"gw",
+			 "config":{"service_name":"notification","input":{"to":["${applicant}","${upper(amount)}"],"title":"${amount >}"}}},
+			{"id":"gw","type":"exclusive_gateway","config":{"conditions":[
+				{"expression":"${amount + 1}","next":"review"},
+				{"expression":"${applicant > 10}","next":"review"},
+				{"expression":"${amount > 1000 && startsWith(applicant, 'A')}","next":"e"}
+			],"default":"review"}},
+			{"id":"review","type":"user_task","next":"e","config":{"assignee":"${lookup(applicant)}"}},
+			{"id":"e","type":"end_event"}
+		]}`))
+		paths := validationPaths(t, err)
+
+		assert.Equal(t, ErrCodeInvalidField, paths["$.variables.level"], "应该报告不支持的变量类型")
+		assert.Equal(t, ErrCodeInvalidExpression, paths["$.elements[1].config.input.to[1]"], "应该报告函数参数类型错误")
+		assert.Equal(t, ErrCodeInvalidExpression, paths["$.elements[1].config.input.title"], "应该报告语法错误")
+		assert.Equal(t, ErrCodeInvalidExpression, paths["$.elements[2].config.conditions[0].expression"], "条件结果应该是布尔值")
+		assert.Equal(t, ErrCodeInvalidExpression, paths["$.elements[2].config.conditions[1].expression"], "应该报告比较类型不匹配")
+		assert.NotContains(t, paths, "$.elements[2].config.conditions[2].expression", "合法条件不应该报错")
+		assert.Equal(t, ErrCodeInvalidExpression, paths["$.elements[3].config.assignee"], "应该报告未知函数")
+		assert.NotContains(t, paths, "$.elements[1].config.input.to[0]", "合法模板不应该报错")
+	})
+
+	t.Run("升级规则", func(t *testing.T) {
+		_, err := Parse([]byte(`{"id":"p","name":"p","elements":[
+			{"id":"s","type":"start_event","next":["a"]},
+			{"id":"a","type":"user_task","next":["b"],"config":{"due_date":"1d","escalations":[
+				{"action":"remind","before":"2h"},
+				{"action":"reassign","before":"1h","after":"1h","candidate_groups":["managers"]},
+				{"action":"reassign"},
+				{"action":"raise_priority"},
+				{"action":"escalate"},
+				{"action":"auto_complete","after":"1d","variables":{"approved":"${amount >}"}}
+			]}},
+			{"id":"b","type":"user_task","next":["e"],"config":{"escalations":[{"action":"remind"}]}},
+			{"id":"e","type":"end_event"}
+		]}`))
+		paths := validationPaths(t, err)
+
+		assert.NotContains(t, paths, "$.elements[1].config.escalations[0]", "合法规则不应该报错")
+		assert.Equal(t, ErrCodeInvalidField, paths["$.elements[1].config.escalations[1]"], "before与after不能同时配置")
+		assert.Equal(t, ErrCodeMissingField, paths["$.elements[1].config.escalations[2]"], "转交规则需要配置转交目标")
+		assert.Equal(t, ErrCodeInvalidField, paths["$.elements[1].config.escalations[3].priority"], "提高优先级规则需要配置优先级")
+		assert.Equal(t, ErrCodeInvalidField, paths["$.elements[1].config.escalations[4].action"], "应该报告不支持的升级动作")
+		assert.Equal(t, ErrCodeInvalidExpression, paths["$.elements[1].config.escalations[5].variables.approved"], "应该编译自动完成变量中的表达式")
+		assert.Equal(t, ErrCodeMissingField, paths["$.elements[2].config.due_date"], "升级规则需要配置到期时长")
+	})
+
+	t.Run("多实例配置", func(t *testing.T) {
+		_, err := Parse([]byte(`{"id":"p","name":"p","variables":{"reviewers":"list"},"elements":[
+			{"id":"s","type":"start_event","next":"a"},
+			{"id":"a","type":"user_task","next":"b","config":{"assignee":"${reviewer}","multi_instance":{
+				"collection":"${reviewers}","element_variable":"reviewer",
+				"completion_condition":"${nrOfCompletedInstances/nrOfInstances >= 0.6}"}}},
+			{"id":"b","type":"service_task","next":"c","config":{"service_name":"audit","multi_instance":{"cardinality":"-1"}}},
+			{"id":"c","type":"user_task","next":"d","config":{"multi_instance":{"element_variable":"item"}}},
+			{"id":"d","type":"user_task","next":"e","config":{"multi_instance":{
+				"collection":"reviewers","element_variable":"loopCounter","completion_condition":"${nrOfInstances + 1}"}}},
+			{"id":"e","type":"end_event"}
+		]}`))
+		paths := validationPaths(t, err)
+
+		assert.NotContains(t, paths, "$.elements[1].config.multi_instance.completion_condition", "合法的完成条件不应该报错")
+		assert.NotContains(t, paths, "$.elements[1].config.assignee", "办理人可以引用元素变量")
+		assert.Equal(t, ErrCodeInvalidField, paths["$.elements[2].config.multi_instance.cardinality"], "基数不能为负数")
+		assert.Equal(t, ErrCodeMissingField, paths["$.elements[3].config.multi_instance"], "需要配置集合或基数")
+		assert.Equal(t, ErrCodeInvalidField, paths["$.elements[3].config.multi_instance.element_variable"], "元素变量需要配置集合")
+		assert.Equal(t, ErrCodeInvalidField, paths["$.elements[4].config.multi_instance.element_variable"], "元素变量不能使用内置变量名")
+		assert.Equal(t, ErrCodeInvalidExpression, paths["$.elements[4].config.multi_instance.completion_condition"], "完成条件结果应该是布尔值")
+	})
+}
+
+// TestParseDuration 测试时长解析
+func TestParseDuration(t *testing.T) {
+	cases := map[string]time.Duration{
+		"30s":   30 * time.Second,
+		"2h":    2 * time.Hour,
+		"3d":    72 * time.Hour,
+		"1d12h": 36 * time.Hour,
+	}
+	for input, expected := range cases {
+		d, err := ParseDuration(input)
+		require.NoError(t, err, "时长 %s 应该解析成功", input)
+		assert.Equal(t, expected, d, "时长 %s 解析结果应该匹配", input)
+	}
+
+	_, err := ParseDuration("abc")
+	assert.Error(t, err, "无效的时长应该返回错误")
+}
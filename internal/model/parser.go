@@ -0,0 +1,217 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// rawElement 资源中的原始节点结构
+type rawElement struct {
+	ID     string          `json:"id"`
+	Name   string          `json:"name"`
+	Type   string          `json:"type"`
+	Config json.RawMessage `json:"config"`
+	Next   stringList      `json:"next"`
+}
+
+// stringList 兼容单个字符串与字符串数组两种写法
+type stringList []string
+
+// UnmarshalJSON 实现 json.Unmarshaler 接口
+func (l *stringList) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*l = nil
+		return nil
+	}
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*l = stringList{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("next 必须是字符串或字符串数组")
+	}
+	*l = list
+	return nil
+}
+
+// Parse 解析流程定义资源并执行结构校验
+// 解析或校验失败时返回 ValidationErrors，包含全部错误及其JSON路径
+func Parse(resource []byte) (*Definition, error) {
+	def, errs := decode(resource)
+	if def != nil {
+		errs = append(errs, Validate(def)...)
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return def, nil
+}
+
+// decode 将资源解码为流程模型，只报告解码阶段的错误
+func decode(resource []byte) (*Definition, ValidationErrors) {
+	var errs ValidationErrors
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(resource, &fields); err != nil {
+		errs.add("$", ErrCodeInvalidJSON, fmt.Sprintf("流程定义不是有效的JSON格式: %v", err))
+		return nil, errs
+	}
+
+	def := &Definition{nodes: make(map[string]*Node)}
+	decodeString(fields, "id", &def.ID, &errs)
+	decodeString(fields, "name", &def.Name, &errs)
+	if raw, ok := fields["description"]; ok {
+		if err := json.Unmarshal(raw, &def.Description); err != nil {
+			errs.add("$.description", ErrCodeInvalidField, "description 必须是字符串")
+		}
+	}
+	if raw, ok := fields["variables"]; ok {
+		if err := json.Unmarshal(raw, &def.Variables); err != nil {
+			errs.add("$.variables", ErrCodeInvalidField, "variables 必须是变量名到类型的映射")
+		}
+	}
+
+	rawElements, ok := fields["elements"]
+	if !ok {
+		errs.add("$.elements", ErrCodeMissingField, "流程定义缺少elements字段")
+		return nil, errs
+	}
+	var elements []json.RawMessage
+	if err := json.Unmarshal(rawElements, &elements); err != nil {
+		errs.add("$.elements", ErrCodeInvalidField, "elements 必须是数组")
+		return nil, errs
+	}
+
+	for i, rawEl := range elements {
+		path := fmt.Sprintf("$.elements[%d]", i)
+		var el rawElement
+		if err := json.Unmarshal(rawEl, &el); err != nil {
+			errs.add(path, ErrCodeInvalidField, fmt.Sprintf("节点格式无效: %v", err))
+			continue
+		}
+		node := decodeNode(&el, path, &errs)
+		if node == nil {
+			continue
+		}
+		if _, exists := def.nodes[node.ID]; exists {
+			errs.add(path+".id", ErrCodeDuplicateID, fmt.Sprintf("节点ID重复: %s", node.ID))
+			continue
+		}
+		def.nodes[node.ID] = node
+		def.Nodes = append(def.Nodes, node)
+		def.Flows = append(def.Flows, decodeFlows(node, &el, path)...)
+	}
+
+	// 建立节点与顺序流的关联，悬空的目标留给结构校验报告
+	for _, f := range def.Flows {
+		if src, ok := def.nodes[f.SourceRef]; ok {
+			src.Outgoing = append(src.Outgoing, f)
+		}
+		if dst, ok := def.nodes[f.TargetRef]; ok {
+			dst.Incoming = append(dst.Incoming, f)
+		}
+	}
+
+	return def, errs
+}
+
+// decodeString 解码顶层必填字符串字段
+func decodeString(fields map[string]json.RawMessage, name string, dst *string, errs *ValidationErrors) {
+	path := "$." + name
+	raw, ok := fields[name]
+	if !ok {
+		errs.add(path, ErrCodeMissingField, fmt.Sprintf("流程定义缺少%s字段", name))
+		return
+	}
+	if err := json.Unmarshal(raw, dst); err != nil {
+		errs.add(path, ErrCodeInvalidField, fmt.Sprintf("%s 必须是字符串", name))
+		return
+	}
+	if *dst == "" {
+		errs.add(path, ErrCodeMissingField, fmt.Sprintf("流程定义%s字段不能为空", name))
+	}
+}
+
+// decodeNode 解码单个节点及其类型相关的配置
+func decodeNode(el *rawElement, path string, errs *ValidationErrors) *Node {
+	if el.ID == "" {
+		errs.add(path+".id", ErrCodeMissingField, "节点缺少id字段")
+		return nil
+	}
+	nodeType, ok := ParseNodeType(el.Type)
+	if !ok {
+		if el.Type == "" {
+			errs.add(path+".type", ErrCodeMissingField, "节点缺少type字段")
+		} else {
+			errs.add(path+".type", ErrCodeUnknownNodeType, fmt.Sprintf("未知的节点类型: %s", el.Type))
+		}
+		return nil
+	}
+
+	node := &Node{
+		ID:     el.ID,
+		Name:   el.Name,
+		Type:   nodeType,
+		Config: el.Config,
+		path:   path,
+	}
+
+	configPath := path + ".config"
+	var err error
+	switch nodeType {
+	case NodeTypeServiceTask:
+		node.ServiceTask = &ServiceTaskConfig{}
+		err = decodeConfig(el.Config, node.ServiceTask)
+	case NodeTypeUserTask:
+		node.UserTask = &UserTaskConfig{}
+		err = decodeConfig(el.Config, node.UserTask)
+	case NodeTypeExclusiveGateway, NodeTypeParallelGateway, NodeTypeInclusiveGateway:
+		node.Gateway = &GatewayConfig{}
+		err = decodeConfig(el.Config, node.Gateway)
+	}
+	if err != nil {
+		errs.add(configPath, ErrCodeInvalidField, fmt.Sprintf("节点配置格式无效: %v", err))
+	}
+
+	return node
+}
+
+// decodeConfig 解码节点配置，配置为空时保留零值
+func decodeConfig(raw json.RawMessage, dst interface{}) error {
+	if len(raw) == 0 || bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+		return nil
+	}
+	return json.Unmarshal(raw, dst)
+}
+
+// decodeFlows 根据节点的 next 与网关条件生成出口顺序流
+func decodeFlows(node *Node, el *rawElement, path string) []*SequenceFlow {
+	var flows []*SequenceFlow
+	newFlow := func(target, condition string, isDefault bool, flowPath string) {
+		flows = append(flows, &SequenceFlow{
+			ID:        fmt.Sprintf("%s_flow_%d", node.ID, len(flows)),
+			SourceRef: node.ID,
+			TargetRef: target,
+			Condition: condition,
+			IsDefault: isDefault,
+			path:      flowPath,
+		})
+	}
+
+	if node.Gateway != nil {
+		for i, c := range node.Gateway.Conditions {
+			newFlow(c.Next, c.Expression, false, fmt.Sprintf("%s.config.conditions[%d].next", path, i))
+		}
+		if node.Gateway.Default != "" {
+			newFlow(node.Gateway.Default, "", true, path+".config.default")
+		}
+	}
+	for i, target := range el.Next {
+		newFlow(target, "", false, fmt.Sprintf("%s.next[%d]", path, i))
+	}
+
+	return flows
+}
@@ -0,0 +1,157 @@
+package model
+
+import "fmt"
+
+// IsFork 判断节点是否会产生并发分支
+// 并行网关与包容网关有多个出口时分支；普通节点有多个出口时按隐式并行分支处理
+func (n *Node) IsFork() bool {
+	switch n.Type {
+	case NodeTypeExclusiveGateway, NodeTypeEndEvent:
+		return false
+	}
+	return len(n.Outgoing) > 1
+}
+
+// IsJoin 判断节点是否为汇聚网关
+func (n *Node) IsJoin() bool {
+	return (n.Type == NodeTypeParallelGateway || n.Type == NodeTypeInclusiveGateway) && len(n.Incoming) > 1
+}
+
+// JoinOf 返回分支节点对应的汇聚网关ID
+// 返回空字符串表示各分支不再汇聚，分别到达结束事件
+func (d *Definition) JoinOf(forkID string) string {
+	if d.joins == nil {
+		d.joins = d.matchJoins()
+	}
+	return d.joins[forkID]
+}
+
+// matchJoins 为每个分支节点匹配汇聚网关
+// 分支节点的直接后必经节点即为其汇聚点，嵌套的并行区域因此各自匹配到最近的汇聚网关
+func (d *Definition) matchJoins() map[string]string {
+	joins := make(map[string]string)
+	ipdom := d.immediatePostDominators()
+	for _, n := range d.Nodes {
+		if n.IsFork() {
+			joins[n.ID] = ipdom[n.ID]
+		}
+	}
+	return joins
+}
+
+// immediatePostDominators 计算各节点的直接后必经节点
+// 所有结束事件汇入一个虚拟出口，后必经于虚拟出口的节点记为空字符串
+func (d *Definition) immediatePostDominators() map[string]string {
+	count := len(d.Nodes)
+	exit := count
+	index := make(map[string]int, count)
+	for i, n := range d.Nodes {
+		index[n.ID] = i
+	}
+
+	succ := make([][]int, count)
+	for i, n := range d.Nodes {
+		for _, f := range n.Outgoing {
+			if j, ok := index[f.TargetRef]; ok {
+				succ[i] = append(succ[i], j)
+			}
+		}
+		if len(succ[i]) == 0 {
+			succ[i] = []int{exit}
+		}
+	}
+
+	// pdom[i][j] 表示节点 j 后必经于节点 i，迭代求不动点
+	pdom := make([][]bool, count+1)
+	for i := range pdom {
+		pdom[i] = make([]bool, count+1)
+		for j := range pdom[i] {
+			pdom[i][j] = i != exit || j == exit
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for i := count - 1; i >= 0; i-- {
+			for j := 0; j <= count; j++ {
+				v := j == i
+				if !v {
+					v = true
+					for _, s := range succ[i] {
+						if !pdom[s][j] {
+							v = false
+							break
+						}
+					}
+				}
+				if v != pdom[i][j] {
+					pdom[i][j] = v
+					changed = true
+				}
+			}
+		}
+	}
+
+	size := func(i int) int {
+		n := 0
+		for _, v := range pdom[i] {
+			if v {
+				n++
+			}
+		}
+		return n
+	}
+
+	result := make(map[string]string, count)
+	for i, n := range d.Nodes {
+		strict := size(i) - 1
+		for j := 0; j < count; j++ {
+			if j != i && pdom[i][j] && size(j) == strict {
+				result[n.ID] = d.Nodes[j].ID
+				break
+			}
+		}
+	}
+	return result
+}
+
+// validateRegions 校验分支与汇聚网关是否成对出现
+// 分支必须汇聚到同类网关（包容网关对应包容网关，其余对应并行网关），汇聚网关必须有对应的分支
+func validateRegions(def *Definition, errs *ValidationErrors) {
+	def.joins = def.matchJoins()
+
+	matched := make(map[string]bool)
+	for _, n := range def.Nodes {
+		if !n.IsFork() {
+			continue
+		}
+		joinID := def.joins[n.ID]
+		if joinID == "" {
+			continue
+		}
+		join := def.nodes[joinID]
+		want := NodeTypeParallelGateway
+		if n.Type == NodeTypeInclusiveGateway {
+			want = NodeTypeInclusiveGateway
+		}
+		if !join.IsJoin() || join.Type != want {
+			errs.add(n.path, ErrCodeUnbalancedGateway,
+				fmt.Sprintf("节点 %s 的分支在 %s 汇合，应该使用%s汇聚", n.ID, joinID, gatewayName(want)))
+			continue
+		}
+		matched[joinID] = true
+	}
+
+	for _, n := range def.Nodes {
+		if n.IsJoin() && !matched[n.ID] {
+			errs.add(n.path, ErrCodeUnbalancedGateway, fmt.Sprintf("汇聚网关没有对应的分支: %s", n.ID))
+		}
+	}
+}
+
+// gatewayName 返回网关类型的中文名称
+func gatewayName(t NodeType) string {
+	if t == NodeTypeInclusiveGateway {
+		return "包容网关"
+	}
+	return "并行网关"
+}
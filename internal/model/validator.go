@@ -0,0 +1,213 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/workflow-engine/workflow-engine/internal/expr"
+)
+
+// Validate 对流程模型进行结构校验，返回全部发现的错误
+func Validate(def *Definition) ValidationErrors {
+	var errs ValidationErrors
+
+	if len(def.Nodes) == 0 {
+		errs.add("$.elements", ErrCodeMissingField, "流程定义至少需要包含一个节点")
+		return errs
+	}
+
+	env := variableTypes(def, &errs)
+	validateEvents(def, &errs)
+	validateFlows(def, &errs)
+	for _, n := range def.Nodes {
+		validateNode(n, &errs)
+		validateExpressions(n, env, &errs)
+	}
+	validateReachability(def, &errs)
+	validateRegions(def, &errs)
+
+	return errs
+}
+
+// validateEvents 校验开始与结束事件
+func validateEvents(def *Definition, errs *ValidationErrors) {
+	starts := def.StartNodes()
+	switch {
+	case len(starts) == 0:
+		errs.add("$.elements", ErrCodeMissingStartEvent, "流程定义缺少开始事件")
+	case len(starts) > 1:
+		for _, n := range starts[1:] {
+			errs.add(n.path, ErrCodeMultipleStartEvents, fmt.Sprintf("流程定义只能有一个开始事件，多余的开始事件: %s", n.ID))
+		}
+	}
+	if len(def.EndNodes()) == 0 {
+		errs.add("$.elements", ErrCodeMissingEndEvent, "流程定义缺少结束事件")
+	}
+}
+
+// validateFlows 校验顺序流目标是否存在
+func validateFlows(def *Definition, errs *ValidationErrors) {
+	for _, f := range def.Flows {
+		if f.TargetRef == "" {
+			errs.add(f.path, ErrCodeMissingField, "顺序流目标节点不能为空")
+			continue
+		}
+		if _, ok := def.nodes[f.TargetRef]; !ok {
+			errs.add(f.path, ErrCodeDanglingTarget, fmt.Sprintf("顺序流指向不存在的节点: %s", f.TargetRef))
+		}
+	}
+}
+
+// validateNode 校验单个节点的连接关系与配置
+func validateNode(n *Node, errs *ValidationErrors) {
+	switch n.Type {
+	case NodeTypeStartEvent:
+		if len(n.Incoming) > 0 {
+			errs.add(n.path, ErrCodeStartEventIncoming, fmt.Sprintf("开始事件不能有入口顺序流: %s", n.ID))
+		}
+	case NodeTypeEndEvent:
+		if len(n.Outgoing) > 0 {
+			errs.add(n.path+".next", ErrCodeEndEventOutgoing, fmt.Sprintf("结束事件不能有出口顺序流: %s", n.ID))
+		}
+		return
+	}
+
+	if len(n.Outgoing) == 0 {
+		if n.Type.IsGateway() {
+			errs.add(n.path+".next", ErrCodeGatewayWithoutOutgoing, fmt.Sprintf("网关没有出口顺序流: %s", n.ID))
+		} else {
+			errs.add(n.path+".next", ErrCodeNodeWithoutOutgoing, fmt.Sprintf("节点没有出口顺序流: %s", n.ID))
+		}
+	}
+
+	configPath := n.path + ".config"
+	switch n.Type {
+	case NodeTypeServiceTask:
+		if n.ServiceTask.ServiceName == "" {
+			errs.add(configPath+".service_name", ErrCodeMissingField, "服务任务缺少service_name配置")
+		}
+		if n.ServiceTask.Timeout != "" {
+			if _, err := ParseDuration(n.ServiceTask.Timeout); err != nil {
+				errs.add(configPath+".timeout", ErrCodeInvalidField, err.Error())
+			}
+		}
+		if rp := n.ServiceTask.RetryPolicy; rp != nil {
+			if rp.MaxAttempts < 0 {
+				errs.add(configPath+".retry_policy.max_attempts", ErrCodeInvalidField, "最大尝试次数不能为负数")
+			}
+			if rp.Backoff != "" && rp.Backoff != "fixed" && rp.Backoff != "exponential" {
+				errs.add(configPath+".retry_policy.backoff", ErrCodeInvalidField, fmt.Sprintf("不支持的退避策略: %s", rp.Backoff))
+			}
+		}
+	case NodeTypeUserTask:
+		if n.UserTask.DueDate != "" {
+			if _, err := ParseDuration(n.UserTask.DueDate); err != nil {
+				errs.add(configPath+".due_date", ErrCodeInvalidField, err.Error())
+			}
+		}
+		if len(n.UserTask.Escalations) > 0 && n.UserTask.DueDate == "" {
+			errs.add(configPath+".due_date", ErrCodeMissingField, "配置升级规则的用户任务缺少due_date配置")
+		}
+		for i, rule := range n.UserTask.Escalations {
+			validateEscalation(rule, fmt.Sprintf("%s.escalations[%d]", configPath, i), errs)
+		}
+	case NodeTypeExclusiveGateway, NodeTypeInclusiveGateway:
+		for i, c := range n.Gateway.Conditions {
+			if c.Expression == "" {
+				errs.add(fmt.Sprintf("%s.conditions[%d].expression", configPath, i), ErrCodeMissingField, "网关条件缺少expression配置")
+			}
+		}
+	case NodeTypeParallelGateway:
+		if len(n.Gateway.Conditions) > 0 || n.Gateway.Default != "" {
+			errs.add(configPath, ErrCodeInvalidField, "并行网关不支持条件分支")
+		}
+	}
+	if mi := n.MultiInstance(); mi != nil {
+		validateMultiInstance(mi, configPath+".multi_instance", errs)
+	}
+}
+
+// validateMultiInstance 校验任务的多实例配置
+func validateMultiInstance(mi *MultiInstanceConfig, path string, errs *ValidationErrors) {
+	if mi.Collection == "" && mi.Cardinality == "" {
+		errs.add(path, ErrCodeMissingField, "多实例配置需要collection或cardinality")
+	}
+	if mi.ElementVariable != "" {
+		switch {
+		case mi.Collection == "":
+			errs.add(path+".element_variable", ErrCodeInvalidField, "element_variable需要与collection一起配置")
+		case isMultiInstanceCounter(mi.ElementVariable):
+			errs.add(path+".element_variable", ErrCodeInvalidField, fmt.Sprintf("element_variable不能使用内置变量名: %s", mi.ElementVariable))
+		}
+	}
+	if mi.Cardinality != "" && !expr.IsTemplate(mi.Cardinality) {
+		if n, err := strconv.Atoi(strings.TrimSpace(mi.Cardinality)); err != nil || n < 0 {
+			errs.add(path+".cardinality", ErrCodeInvalidField, fmt.Sprintf("cardinality必须是非负整数或表达式: %s", mi.Cardinality))
+		}
+	}
+}
+
+// isMultiInstanceCounter 判断变量名是否为多实例内置计数变量
+func isMultiInstanceCounter(name string) bool {
+	switch name {
+	case VarNrOfInstances, VarNrOfActiveInstances, VarNrOfCompletedInstances, VarLoopCounter:
+		return true
+	}
+	return false
+}
+
+// validateEscalation 校验用户任务升级规则
+func validateEscalation(rule *EscalationRule, path string, errs *ValidationErrors) {
+	if rule == nil {
+		errs.add(path, ErrCodeMissingField, "升级规则不能为空")
+		return
+	}
+	if _, err := rule.Offset(); err != nil {
+		errs.add(path, ErrCodeInvalidField, err.Error())
+	}
+	switch rule.Action {
+	case EscalationRemind, EscalationAutoComplete:
+	case EscalationReassign:
+		if rule.Assignee == "" && len(rule.CandidateGroups) == 0 {
+			errs.add(path, ErrCodeMissingField, "转交规则需要配置assignee或candidate_groups")
+		}
+	case EscalationRaisePriority:
+		if rule.Priority <= 0 {
+			errs.add(path+".priority", ErrCodeInvalidField, "提高优先级规则需要配置大于0的priority")
+		}
+	case "":
+		errs.add(path+".action", ErrCodeMissingField, "升级规则缺少action配置")
+	default:
+		errs.add(path+".action", ErrCodeInvalidField, fmt.Sprintf("不支持的升级动作: %s", rule.Action))
+	}
+}
+
+// validateReachability 从开始事件出发遍历，报告不可达的节点
+func validateReachability(def *Definition, errs *ValidationErrors) {
+	start := def.StartNode()
+	if start == nil {
+		return
+	}
+
+	visited := map[string]bool{start.ID: true}
+	queue := []*Node{start}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, f := range current.Outgoing {
+			next, ok := def.nodes[f.TargetRef]
+			if !ok || visited[next.ID] {
+				continue
+			}
+			visited[next.ID] = true
+			queue = append(queue, next)
+		}
+	}
+
+	for _, n := range def.Nodes {
+		if !visited[n.ID] && n.Type != NodeTypeStartEvent {
+			errs.add(n.path, ErrCodeUnreachableNode, fmt.Sprintf("节点从开始事件不可达: %s", n.ID))
+		}
+	}
+}
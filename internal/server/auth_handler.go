@@ -0,0 +1,75 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/middleware"
+	"github.com/workflow-engine/workflow-engine/internal/service"
+)
+
+// handleLogin 用户名密码登录
+func (r *Router) handleLogin(c *gin.Context) {
+	var req biz.LoginRequest
+	if !r.bindJSON(c, &req) {
+		return
+	}
+
+	result, err := r.authService.Login(c.Request.Context(), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleRefreshToken 使用刷新令牌换取新的令牌对
+func (r *Router) handleRefreshToken(c *gin.Context) {
+	var req biz.RefreshTokenRequest
+	if !r.bindJSON(c, &req) {
+		return
+	}
+
+	result, err := r.authService.Refresh(c.Request.Context(), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleLogout 登出当前会话
+func (r *Router) handleLogout(c *gin.Context) {
+	claims, err := middleware.GetCurrentUser(c)
+	if err != nil {
+		r.writeServiceError(c, service.WrapError(err, service.ErrCodeUnauthorized, "未登录"))
+		return
+	}
+
+	if err := r.authService.Logout(c.Request.Context(), claims); err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(nil))
+}
+
+// handleChangePassword 修改当前用户的密码，返回新会话的令牌对
+func (r *Router) handleChangePassword(c *gin.Context) {
+	var req biz.ChangePasswordRequest
+	if !r.bindJSON(c, &req) {
+		return
+	}
+
+	result, err := r.authService.ChangePassword(c.Request.Context(), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
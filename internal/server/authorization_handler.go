@@ -0,0 +1,51 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+)
+
+// handleListAuthorizations 查询资源授权
+func (r *Router) handleListAuthorizations(c *gin.Context) {
+	var req biz.ListAuthorizationsRequest
+	if !r.bindQuery(c, &req) {
+		return
+	}
+
+	result, err := r.authorizations.ListAuthorizations(c.Request.Context(), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleCreateAuthorization 创建资源授权
+func (r *Router) handleCreateAuthorization(c *gin.Context) {
+	var req biz.CreateAuthorizationRequest
+	if !r.bindJSON(c, &req) {
+		return
+	}
+
+	result, err := r.authorizations.CreateAuthorization(c.Request.Context(), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusCreated, r.successResponse(result))
+}
+
+// handleDeleteAuthorization 删除资源授权
+func (r *Router) handleDeleteAuthorization(c *gin.Context) {
+	if err := r.authorizations.DeleteAuthorization(c.Request.Context(), c.Param("id")); err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(nil))
+}
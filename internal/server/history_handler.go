@@ -0,0 +1,85 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+)
+
+// handleListHistoricProcessInstances 查询历史流程实例列表
+func (r *Router) handleListHistoricProcessInstances(c *gin.Context) {
+	var req biz.ListHistoricProcessInstancesRequest
+	if !r.bindQuery(c, &req) {
+		return
+	}
+
+	result, err := r.history.ListHistoricProcessInstances(c.Request.Context(), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleGetHistoricProcessInstance 获取历史流程实例
+func (r *Router) handleGetHistoricProcessInstance(c *gin.Context) {
+	result, err := r.history.GetHistoricProcessInstance(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleBatchDeleteHistoricProcessInstances 批量删除历史流程实例
+// 流程定义键和截止时间通过查询参数 process_definition_key、end_time_before 传递
+func (r *Router) handleBatchDeleteHistoricProcessInstances(c *gin.Context) {
+	var req biz.BatchDeleteHistoricProcessInstancesRequest
+	if !r.bindQuery(c, &req) {
+		return
+	}
+
+	result, err := r.history.BatchDeleteHistoricProcessInstances(c.Request.Context(), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleGetProcessStatistics 获取流程统计数据
+func (r *Router) handleGetProcessStatistics(c *gin.Context) {
+	var req biz.ProcessStatisticsRequest
+	if !r.bindQuery(c, &req) {
+		return
+	}
+
+	result, err := r.history.GetProcessStatistics(c.Request.Context(), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleGetProcessTrend 获取流程趋势数据
+func (r *Router) handleGetProcessTrend(c *gin.Context) {
+	var req biz.ProcessTrendRequest
+	if !r.bindQuery(c, &req) {
+		return
+	}
+
+	result, err := r.history.GetProcessTrend(c.Request.Context(), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
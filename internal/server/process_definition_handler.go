@@ -0,0 +1,120 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+)
+
+// handleListProcessDefinitions 查询流程定义列表
+func (r *Router) handleListProcessDefinitions(c *gin.Context) {
+	var req biz.ListProcessDefinitionsRequest
+	if !r.bindQuery(c, &req) {
+		return
+	}
+
+	result, err := r.processDefinitions.ListProcessDefinitions(c.Request.Context(), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleCreateProcessDefinition 创建流程定义
+func (r *Router) handleCreateProcessDefinition(c *gin.Context) {
+	var req biz.CreateProcessDefinitionRequest
+	if !r.bindJSON(c, &req) {
+		return
+	}
+
+	result, err := r.processDefinitions.CreateProcessDefinition(c.Request.Context(), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusCreated, r.successResponse(result))
+}
+
+// handleGetProcessDefinition 获取流程定义
+func (r *Router) handleGetProcessDefinition(c *gin.Context) {
+	result, err := r.processDefinitions.GetProcessDefinition(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleGetLatestProcessDefinition 根据Key获取最新版本流程定义
+func (r *Router) handleGetLatestProcessDefinition(c *gin.Context) {
+	result, err := r.processDefinitions.GetLatestProcessDefinition(c.Request.Context(), c.Param("key"))
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleUpdateProcessDefinition 更新流程定义
+func (r *Router) handleUpdateProcessDefinition(c *gin.Context) {
+	var req biz.UpdateProcessDefinitionRequest
+	if !r.bindJSON(c, &req) {
+		return
+	}
+
+	result, err := r.processDefinitions.UpdateProcessDefinition(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleDeleteProcessDefinition 删除流程定义
+func (r *Router) handleDeleteProcessDefinition(c *gin.Context) {
+	if err := r.processDefinitions.DeleteProcessDefinition(c.Request.Context(), c.Param("id")); err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(nil))
+}
+
+// handleDeployProcessDefinition 部署流程定义
+func (r *Router) handleDeployProcessDefinition(c *gin.Context) {
+	if err := r.processDefinitions.DeployProcessDefinition(c.Request.Context(), c.Param("id")); err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(nil))
+}
+
+// handleSuspendProcessDefinition 挂起流程定义
+func (r *Router) handleSuspendProcessDefinition(c *gin.Context) {
+	if err := r.processDefinitions.SuspendProcessDefinition(c.Request.Context(), c.Param("id")); err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(nil))
+}
+
+// handleExportProcessDefinitionBPMN 导出流程定义为BPMN 2.0 XML
+func (r *Router) handleExportProcessDefinitionBPMN(c *gin.Context) {
+	result, err := r.processDefinitions.ExportProcessDefinitionBPMN(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	c.Data(http.StatusOK, "application/xml; charset=utf-8", result)
+}
@@ -0,0 +1,179 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+)
+
+// reasonRequest 携带操作原因的请求体
+type reasonRequest struct {
+	Reason string `json:"reason"` // 操作原因
+}
+
+// setVariableRequest 设置单个流程变量请求体
+type setVariableRequest struct {
+	Value interface{} `json:"value"` // 变量值
+}
+
+// handleListProcessInstances 查询流程实例列表
+func (r *Router) handleListProcessInstances(c *gin.Context) {
+	var req biz.ListProcessInstancesRequest
+	if !r.bindQuery(c, &req) {
+		return
+	}
+
+	result, err := r.processInstances.ListProcessInstances(c.Request.Context(), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleStartProcessInstance 启动流程实例
+func (r *Router) handleStartProcessInstance(c *gin.Context) {
+	var req biz.StartProcessInstanceRequest
+	if !r.bindJSON(c, &req) {
+		return
+	}
+	req.Variables = normalizeVariables(req.Variables)
+
+	result, err := r.processInstances.StartProcessInstance(c.Request.Context(), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusCreated, r.successResponse(result))
+}
+
+// handleGetProcessInstance 获取流程实例
+func (r *Router) handleGetProcessInstance(c *gin.Context) {
+	result, err := r.processInstances.GetProcessInstance(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleDeleteProcessInstance 删除流程实例，删除原因通过查询参数 reason 传递
+func (r *Router) handleDeleteProcessInstance(c *gin.Context) {
+	if err := r.processInstances.DeleteProcessInstance(c.Request.Context(), c.Param("id"), c.Query("reason")); err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(nil))
+}
+
+// handleSuspendProcessInstance 挂起流程实例
+func (r *Router) handleSuspendProcessInstance(c *gin.Context) {
+	if err := r.processInstances.SuspendProcessInstance(c.Request.Context(), c.Param("id")); err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(nil))
+}
+
+// handleActivateProcessInstance 激活流程实例
+func (r *Router) handleActivateProcessInstance(c *gin.Context) {
+	if err := r.processInstances.ActivateProcessInstance(c.Request.Context(), c.Param("id")); err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(nil))
+}
+
+// handleTerminateProcessInstance 终止流程实例
+func (r *Router) handleTerminateProcessInstance(c *gin.Context) {
+	var req reasonRequest
+	if !r.bindOptionalJSON(c, &req) {
+		return
+	}
+
+	if err := r.processInstances.TerminateProcessInstance(c.Request.Context(), c.Param("id"), req.Reason); err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(nil))
+}
+
+// handleMigrateProcessInstance 将流程实例迁移到同一流程的其他版本
+func (r *Router) handleMigrateProcessInstance(c *gin.Context) {
+	var req biz.MigrateProcessInstanceRequest
+	if !r.bindJSON(c, &req) {
+		return
+	}
+
+	if err := r.processInstances.MigrateProcessInstance(c.Request.Context(), c.Param("id"), &req); err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(nil))
+}
+
+// handleGetProcessVariables 获取流程实例的全部变量
+func (r *Router) handleGetProcessVariables(c *gin.Context) {
+	result, err := r.processInstances.GetProcessVariables(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleSetProcessVariables 批量设置流程变量，请求体为变量名到变量值的映射
+func (r *Router) handleSetProcessVariables(c *gin.Context) {
+	var variables map[string]interface{}
+	if !r.bindJSON(c, &variables) {
+		return
+	}
+
+	if err := r.processInstances.SetProcessVariables(c.Request.Context(), c.Param("id"), normalizeVariables(variables)); err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(nil))
+}
+
+// handleGetProcessVariable 获取单个流程变量
+func (r *Router) handleGetProcessVariable(c *gin.Context) {
+	name := c.Param("name")
+	value, err := r.processInstances.GetProcessVariable(c.Request.Context(), c.Param("id"), name)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(map[string]interface{}{
+		"name":  name,
+		"value": value,
+	}))
+}
+
+// handleSetProcessVariable 设置单个流程变量
+func (r *Router) handleSetProcessVariable(c *gin.Context) {
+	var req setVariableRequest
+	if !r.bindJSON(c, &req) {
+		return
+	}
+
+	if err := r.processInstances.SetProcessVariable(c.Request.Context(), c.Param("id"), c.Param("name"), normalizeValue(req.Value)); err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(nil))
+}
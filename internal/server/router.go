@@ -1,27 +1,72 @@
 // Package server HTTP路由器
-// 提供RESTful API路由配置和基础处理器
+// 提供RESTful API路由配置，将HTTP请求绑定、校验后交给服务层处理
 package server
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"time"
 
-	"github.com/gorilla/mux"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"go.uber.org/zap"
+
+	"github.com/workflow-engine/workflow-engine/internal/middleware"
+	"github.com/workflow-engine/workflow-engine/internal/service"
 )
 
+// healthCheckTimeout 健康检查访问依赖的超时时间
+const healthCheckTimeout = 3 * time.Second
+
+// HealthChecker 依赖健康检查接口，由数据访问层实现
+type HealthChecker interface {
+	HealthCheck(ctx context.Context) error
+}
+
 // Router HTTP路由器
 type Router struct {
-	router *mux.Router
+	engine *gin.Engine
 	logger *zap.Logger
+	auth   *middleware.AuthMiddleware
+	health HealthChecker
+
+	processDefinitions *service.ProcessDefinitionService
+	processInstances   *service.ProcessInstanceService
+	tasks              *service.TaskInstanceService
+	history            *service.HistoricDataService
+	authService        *service.AuthService
+	users              *service.UserService
+	authorizations     *service.AuthorizationService
 }
 
 // NewRouter 创建新的HTTP路由器
-func NewRouter(logger *zap.Logger) *Router {
+func NewRouter(
+	processDefinitions *service.ProcessDefinitionService,
+	processInstances *service.ProcessInstanceService,
+	tasks *service.TaskInstanceService,
+	history *service.HistoricDataService,
+	authService *service.AuthService,
+	users *service.UserService,
+	authorizations *service.AuthorizationService,
+	auth *middleware.AuthMiddleware,
+	health HealthChecker,
+	logger *zap.Logger,
+) *Router {
 	r := &Router{
-		router: mux.NewRouter(),
-		logger: logger,
+		engine:             gin.New(),
+		logger:             logger,
+		auth:               auth,
+		health:             health,
+		processDefinitions: processDefinitions,
+		processInstances:   processInstances,
+		tasks:              tasks,
+		history:            history,
+		authService:        authService,
+		users:              users,
+		authorizations:     authorizations,
 	}
 
 	r.setupRoutes()
@@ -30,480 +75,385 @@ func NewRouter(logger *zap.Logger) *Router {
 
 // ServeHTTP 实现http.Handler接口
 func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
-	r.router.ServeHTTP(w, req)
+	r.engine.ServeHTTP(w, req)
 }
 
 // APIResponse 统一API响应格式
 type APIResponse struct {
-	Code      int         `json:"code"`            // 响应码
-	Message   string      `json:"message"`         // 响应消息
-	Data      interface{} `json:"data,omitempty"`  // 响应数据
-	Error     string      `json:"error,omitempty"` // 错误信息
-	Timestamp string      `json:"timestamp"`       // 时间戳
+	Code      int         `json:"code"`             // 响应码
+	Message   string      `json:"message"`          // 响应消息
+	Data      interface{} `json:"data,omitempty"`   // 响应数据
+	Error     string      `json:"error,omitempty"`  // 错误信息
+	Errors    interface{} `json:"errors,omitempty"` // 结构化错误列表
+	Timestamp string      `json:"timestamp"`        // 时间戳
 }
 
 // setupRoutes 设置路由
 func (r *Router) setupRoutes() {
-	// 中间件
-	r.router.Use(r.loggingMiddleware)
-	r.router.Use(r.corsMiddleware)
+	// 中间件，全局中间件同样作用于未匹配的路由，保证任意路径的预检请求都能通过
+	r.engine.Use(r.recoveryMiddleware, r.loggingMiddleware, r.corsMiddleware)
 
-	// API版本路由
-	api := r.router.PathPrefix("/api/v1").Subrouter()
+	// API版本路由，需要携带有效的访问令牌
+	api := r.engine.Group("/api/v1", r.auth.JWTAuth())
 
 	// 流程定义路由
-	processDefinitions := api.PathPrefix("/process-definitions").Subrouter()
-	processDefinitions.HandleFunc("", r.handleListProcessDefinitions).Methods("GET")
-	processDefinitions.HandleFunc("", r.handleCreateProcessDefinition).Methods("POST")
-	processDefinitions.HandleFunc("/{id}", r.handleGetProcessDefinition).Methods("GET")
-	processDefinitions.HandleFunc("/{id}", r.handleUpdateProcessDefinition).Methods("PUT")
-	processDefinitions.HandleFunc("/{id}", r.handleDeleteProcessDefinition).Methods("DELETE")
-	processDefinitions.HandleFunc("/{id}/deploy", r.handleDeployProcessDefinition).Methods("POST")
+	processDefinitions := api.Group("/process-definitions")
+	processDefinitions.GET("", r.handleListProcessDefinitions)
+	processDefinitions.POST("", r.handleCreateProcessDefinition)
+	processDefinitions.GET("/key/:key/latest", r.handleGetLatestProcessDefinition)
+	processDefinitions.GET("/:id", r.handleGetProcessDefinition)
+	processDefinitions.PUT("/:id", r.handleUpdateProcessDefinition)
+	processDefinitions.DELETE("/:id", r.handleDeleteProcessDefinition)
+	processDefinitions.POST("/:id/deploy", r.handleDeployProcessDefinition)
+	processDefinitions.POST("/:id/suspend", r.handleSuspendProcessDefinition)
+	processDefinitions.GET("/:id/bpmn", r.handleExportProcessDefinitionBPMN)
 
 	// 流程实例路由
-	processInstances := api.PathPrefix("/process-instances").Subrouter()
-	processInstances.HandleFunc("", r.handleListProcessInstances).Methods("GET")
-	processInstances.HandleFunc("", r.handleStartProcessInstance).Methods("POST")
-	processInstances.HandleFunc("/{id}", r.handleGetProcessInstance).Methods("GET")
-	processInstances.HandleFunc("/{id}/suspend", r.handleSuspendProcessInstance).Methods("POST")
-	processInstances.HandleFunc("/{id}/activate", r.handleActivateProcessInstance).Methods("POST")
-	processInstances.HandleFunc("/{id}/terminate", r.handleTerminateProcessInstance).Methods("POST")
+	processInstances := api.Group("/process-instances")
+	processInstances.GET("", r.handleListProcessInstances)
+	processInstances.POST("", r.handleStartProcessInstance)
+	processInstances.GET("/:id", r.handleGetProcessInstance)
+	processInstances.DELETE("/:id", r.handleDeleteProcessInstance)
+	processInstances.POST("/:id/suspend", r.handleSuspendProcessInstance)
+	processInstances.POST("/:id/activate", r.handleActivateProcessInstance)
+	processInstances.POST("/:id/terminate", r.handleTerminateProcessInstance)
+	processInstances.POST("/:id/migrate", r.handleMigrateProcessInstance)
+	processInstances.GET("/:id/variables", r.handleGetProcessVariables)
+	processInstances.PUT("/:id/variables", r.handleSetProcessVariables)
+	processInstances.GET("/:id/variables/:name", r.handleGetProcessVariable)
+	processInstances.PUT("/:id/variables/:name", r.handleSetProcessVariable)
 
 	// 任务路由
-	tasks := api.PathPrefix("/tasks").Subrouter()
-	tasks.HandleFunc("", r.handleListTasks).Methods("GET")
-	tasks.HandleFunc("/{id}", r.handleGetTask).Methods("GET")
-	tasks.HandleFunc("/{id}/claim", r.handleClaimTask).Methods("POST")
-	tasks.HandleFunc("/{id}/complete", r.handleCompleteTask).Methods("POST")
-	tasks.HandleFunc("/{id}/delegate", r.handleDelegateTask).Methods("POST")
+	tasks := api.Group("/tasks")
+	tasks.GET("", r.handleListTasks)
+	tasks.GET("/my", r.handleGetMyTasks)
+	tasks.GET("/available", r.handleGetAvailableTasks)
+	tasks.POST("/bulk", r.handleBulkTasks)
+	tasks.GET("/:id", r.handleGetTask)
+	tasks.POST("/:id/claim", r.handleClaimTask)
+	tasks.POST("/:id/complete", r.handleCompleteTask)
+	tasks.POST("/:id/delegate", r.handleDelegateTask)
+	tasks.POST("/:id/resolve", r.handleResolveTask)
+	tasks.PUT("/:id", r.handleUpdateTask)
+	tasks.GET("/:id/history", r.handleListTaskEvents)
+	tasks.GET("/:id/subtasks", r.handleListSubtasks)
+	tasks.POST("/:id/subtasks", r.handleCreateSubtask)
+	tasks.GET("/:id/comments", r.handleListTaskComments)
+	tasks.POST("/:id/comments", r.handleAddTaskComment)
+	tasks.GET("/:id/attachments", r.handleListTaskAttachments)
+	tasks.POST("/:id/attachments", r.handleAddTaskAttachment)
+	tasks.GET("/:id/attachments/:attachmentId/content", r.handleGetTaskAttachmentContent)
 
 	// 历史数据路由
-	history := api.PathPrefix("/history").Subrouter()
-	history.HandleFunc("/process-instances", r.handleListHistoricProcessInstances).Methods("GET")
-	history.HandleFunc("/process-instances/{id}", r.handleGetHistoricProcessInstance).Methods("GET")
+	history := api.Group("/history")
+	history.GET("/process-instances", r.handleListHistoricProcessInstances)
+	history.DELETE("/process-instances", r.handleBatchDeleteHistoricProcessInstances)
+	history.GET("/process-instances/:id", r.handleGetHistoricProcessInstance)
+	history.GET("/statistics", r.handleGetProcessStatistics)
+	history.GET("/trend", r.handleGetProcessTrend)
+
+	// 认证路由，登录和刷新令牌无需携带访问令牌
+	authGroup := api.Group("/auth")
+	authGroup.POST("/login", r.handleLogin)
+	authGroup.POST("/refresh", r.handleRefreshToken)
+	authGroup.POST("/logout", r.handleLogout)
+	authGroup.POST("/password", r.handleChangePassword)
+
+	// 用户、角色与用户组管理路由，仅管理员可访问
+	users := api.Group("/users", r.auth.AdminOnly())
+	users.GET("", r.handleListUsers)
+	users.POST("", r.handleCreateUser)
+	users.GET("/:id", r.handleGetUser)
+	users.PUT("/:id", r.handleUpdateUser)
+
+	roles := api.Group("/roles", r.auth.AdminOnly())
+	roles.GET("", r.handleListRoles)
+	roles.POST("", r.handleCreateRole)
+	roles.PUT("/:name", r.handleUpdateRole)
+
+	groups := api.Group("/groups", r.auth.AdminOnly())
+	groups.GET("", r.handleListGroups)
+	groups.POST("", r.handleCreateGroup)
+
+	// 流程资源授权管理路由，仅管理员可访问
+	authorizations := api.Group("/authorizations", r.auth.AdminOnly())
+	authorizations.GET("", r.handleListAuthorizations)
+	authorizations.POST("", r.handleCreateAuthorization)
+	authorizations.DELETE("/:id", r.handleDeleteAuthorization)
 
 	// 健康检查路由
-	r.router.HandleFunc("/health", r.handleHealthCheck).Methods("GET")
-	r.router.HandleFunc("/ready", r.handleReadinessCheck).Methods("GET")
+	r.engine.GET("/health", r.handleHealthCheck)
+	r.engine.GET("/ready", r.handleReadinessCheck)
+
+	// 令牌签名公钥，供其他服务验证本服务签发的令牌
+	r.engine.GET("/.well-known/jwks.json", r.handleJWKS)
 }
 
 // ====================
 // 中间件
 // ====================
 
-// loggingMiddleware 日志中间件
-func (r *Router) loggingMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
-		start := time.Now()
-
-		r.logger.Info("HTTP请求开始",
-			zap.String("method", req.Method),
-			zap.String("path", req.URL.Path),
-			zap.String("remote_addr", req.RemoteAddr))
-
-		next.ServeHTTP(w, req)
-
-		r.logger.Info("HTTP请求完成",
-			zap.String("method", req.Method),
-			zap.String("path", req.URL.Path),
-			zap.Duration("duration", time.Since(start)))
-	})
+// recoveryMiddleware 异常恢复中间件
+func (r *Router) recoveryMiddleware(c *gin.Context) {
+	defer func() {
+		if v := recover(); v != nil {
+			r.logger.Error("HTTP请求处理发生panic",
+				zap.Any("panic", v),
+				zap.String("method", c.Request.Method),
+				zap.String("path", c.Request.URL.Path))
+			r.writeJSONResponse(c, http.StatusInternalServerError,
+				r.errorResponse(service.ErrCodeInternalError, service.GetErrorMessage(service.ErrCodeInternalError)))
+			c.Abort()
+		}
+	}()
+	c.Next()
 }
 
-// corsMiddleware CORS中间件
-func (r *Router) corsMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
-
-		if req.Method == "OPTIONS" {
-			w.WriteHeader(http.StatusOK)
-			return
-		}
+// loggingMiddleware 日志中间件
+func (r *Router) loggingMiddleware(c *gin.Context) {
+	start := time.Now()
 
-		next.ServeHTTP(w, req)
-	})
-}
+	r.logger.Info("HTTP请求开始",
+		zap.String("method", c.Request.Method),
+		zap.String("path", c.Request.URL.Path),
+		zap.String("remote_addr", c.Request.RemoteAddr))
 
-// ====================
-// 响应辅助函数
-// ====================
+	c.Next()
 
-// writeJSONResponse 写入JSON响应
-func (r *Router) writeJSONResponse(w http.ResponseWriter, statusCode int, response *APIResponse) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
-	response.Timestamp = time.Now().Format(time.RFC3339)
-	json.NewEncoder(w).Encode(response)
+	r.logger.Info("HTTP请求完成",
+		zap.String("method", c.Request.Method),
+		zap.String("path", c.Request.URL.Path),
+		zap.Int("status", c.Writer.Status()),
+		zap.Duration("duration", time.Since(start)))
 }
 
-// successResponse 创建成功响应
-func (r *Router) successResponse(data interface{}) *APIResponse {
-	return &APIResponse{
-		Code:    200,
-		Message: "成功",
-		Data:    data,
+// corsMiddleware CORS中间件
+func (r *Router) corsMiddleware(c *gin.Context) {
+	c.Header("Access-Control-Allow-Origin", "*")
+	c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+	c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+	if c.Request.Method == http.MethodOptions {
+		c.AbortWithStatus(http.StatusOK)
+		return
 	}
-}
 
-// errorResponse 创建错误响应
-func (r *Router) errorResponse(code int, message string) *APIResponse {
-	return &APIResponse{
-		Code:    code,
-		Message: message,
-	}
+	c.Next()
 }
 
 // ====================
-// 基础处理器实现
+// 请求绑定
 // ====================
 
-// handleListProcessDefinitions 查询流程定义列表
-func (r *Router) handleListProcessDefinitions(w http.ResponseWriter, req *http.Request) {
-	r.logger.Info("处理查询流程定义列表请求")
-
-	// 模拟数据
-	data := map[string]interface{}{
-		"items": []map[string]interface{}{
-			{
-				"id":      "1",
-				"key":     "sample-process",
-				"name":    "示例流程",
-				"version": 1,
-			},
-		},
-		"total":     1,
-		"page":      1,
-		"page_size": 20,
-	}
-
-	r.writeJSONResponse(w, http.StatusOK, r.successResponse(data))
-}
-
-// handleCreateProcessDefinition 创建流程定义
-func (r *Router) handleCreateProcessDefinition(w http.ResponseWriter, req *http.Request) {
-	r.logger.Info("处理创建流程定义请求")
-
-	// 模拟创建成功
-	data := map[string]interface{}{
-		"id":      "2",
-		"key":     "new-process",
-		"name":    "新流程",
-		"version": 1,
-		"status":  "created",
-	}
-
-	r.writeJSONResponse(w, http.StatusCreated, r.successResponse(data))
-}
-
-// handleGetProcessDefinition 获取流程定义
-func (r *Router) handleGetProcessDefinition(w http.ResponseWriter, req *http.Request) {
-	vars := mux.Vars(req)
-	id := vars["id"]
-
-	r.logger.Info("处理获取流程定义请求", zap.String("id", id))
-
-	data := map[string]interface{}{
-		"id":      id,
-		"key":     "sample-process",
-		"name":    "示例流程",
-		"version": 1,
+// bindJSON 绑定并校验JSON请求体
+// 数字按原样解码后再转换为整数或浮点数，避免整数变量被当作浮点数保存
+func (r *Router) bindJSON(c *gin.Context, obj interface{}) bool {
+	decoder := json.NewDecoder(c.Request.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(obj); err != nil {
+		r.writeBindError(c, err)
+		return false
 	}
-
-	r.writeJSONResponse(w, http.StatusOK, r.successResponse(data))
+	return r.validate(c, obj)
 }
 
-// handleUpdateProcessDefinition 更新流程定义
-func (r *Router) handleUpdateProcessDefinition(w http.ResponseWriter, req *http.Request) {
-	vars := mux.Vars(req)
-	id := vars["id"]
-
-	r.logger.Info("处理更新流程定义请求", zap.String("id", id))
-
-	data := map[string]interface{}{
-		"id":      id,
-		"status":  "updated",
-		"message": "流程定义更新成功",
+// bindOptionalJSON 绑定可选的JSON请求体，请求体为空时保留零值
+func (r *Router) bindOptionalJSON(c *gin.Context, obj interface{}) bool {
+	decoder := json.NewDecoder(c.Request.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(obj); err != nil && !errors.Is(err, io.EOF) {
+		r.writeBindError(c, err)
+		return false
 	}
-
-	r.writeJSONResponse(w, http.StatusOK, r.successResponse(data))
+	return r.validate(c, obj)
 }
 
-// handleDeleteProcessDefinition 删除流程定义
-func (r *Router) handleDeleteProcessDefinition(w http.ResponseWriter, req *http.Request) {
-	vars := mux.Vars(req)
-	id := vars["id"]
-
-	r.logger.Info("处理删除流程定义请求", zap.String("id", id))
-
-	data := map[string]interface{}{
-		"id":      id,
-		"message": "流程定义删除成功",
+// bindQuery 绑定并校验查询参数，时间参数使用RFC3339格式
+func (r *Router) bindQuery(c *gin.Context, obj interface{}) bool {
+	if err := c.ShouldBindQuery(obj); err != nil {
+		r.writeBindError(c, err)
+		return false
 	}
-
-	r.writeJSONResponse(w, http.StatusOK, r.successResponse(data))
+	return true
 }
 
-// handleDeployProcessDefinition 部署流程定义
-func (r *Router) handleDeployProcessDefinition(w http.ResponseWriter, req *http.Request) {
-	vars := mux.Vars(req)
-	id := vars["id"]
-
-	r.logger.Info("处理部署流程定义请求", zap.String("id", id))
-
-	data := map[string]interface{}{
-		"id":      id,
-		"status":  "deployed",
-		"message": "流程定义部署成功",
+// validate 按 binding 标签校验请求对象
+func (r *Router) validate(c *gin.Context, obj interface{}) bool {
+	if err := binding.Validator.ValidateStruct(obj); err != nil {
+		r.writeBindError(c, err)
+		return false
 	}
-
-	r.writeJSONResponse(w, http.StatusOK, r.successResponse(data))
+	return true
 }
 
-// handleStartProcessInstance 启动流程实例
-func (r *Router) handleStartProcessInstance(w http.ResponseWriter, req *http.Request) {
-	r.logger.Info("处理启动流程实例请求")
+// writeBindError 写入请求绑定失败响应
+func (r *Router) writeBindError(c *gin.Context, err error) {
+	r.logger.Warn("请求参数绑定失败",
+		zap.String("method", c.Request.Method),
+		zap.String("path", c.Request.URL.Path),
+		zap.Error(err))
 
-	data := map[string]interface{}{
-		"id":                    "inst-1",
-		"process_definition_id": "1",
-		"business_key":          "business-001",
-		"status":                "running",
-	}
-
-	r.writeJSONResponse(w, http.StatusCreated, r.successResponse(data))
+	response := r.errorResponse(service.ErrCodeBadRequest, service.GetErrorMessage(service.ErrCodeBadRequest))
+	response.Error = err.Error()
+	r.writeJSONResponse(c, http.StatusBadRequest, response)
 }
 
-// handleGetProcessInstance 获取流程实例
-func (r *Router) handleGetProcessInstance(w http.ResponseWriter, req *http.Request) {
-	vars := mux.Vars(req)
-	id := vars["id"]
-
-	r.logger.Info("处理获取流程实例请求", zap.String("id", id))
-
-	data := map[string]interface{}{
-		"id":                    id,
-		"process_definition_id": "1",
-		"status":                "running",
+// normalizeValue 将 json.Number 转换为 int64 或 float64，并递归处理嵌套结构
+func normalizeValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = normalizeValue(item)
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = normalizeValue(item)
+		}
+		return v
+	default:
+		return value
 	}
-
-	r.writeJSONResponse(w, http.StatusOK, r.successResponse(data))
 }
 
-// handleListProcessInstances 查询流程实例列表
-func (r *Router) handleListProcessInstances(w http.ResponseWriter, req *http.Request) {
-	r.logger.Info("处理查询流程实例列表请求")
-
-	data := map[string]interface{}{
-		"items": []map[string]interface{}{
-			{
-				"id":                    "inst-1",
-				"process_definition_id": "1",
-				"status":                "running",
-			},
-		},
-		"total":     1,
-		"page":      1,
-		"page_size": 20,
+// normalizeVariables 规范化流程变量中的数字类型
+func normalizeVariables(variables map[string]interface{}) map[string]interface{} {
+	for name, value := range variables {
+		variables[name] = normalizeValue(value)
 	}
-
-	r.writeJSONResponse(w, http.StatusOK, r.successResponse(data))
+	return variables
 }
 
-// handleSuspendProcessInstance 挂起流程实例
-func (r *Router) handleSuspendProcessInstance(w http.ResponseWriter, req *http.Request) {
-	vars := mux.Vars(req)
-	id := vars["id"]
-
-	r.logger.Info("处理挂起流程实例请求", zap.String("id", id))
-
-	data := map[string]interface{}{
-		"id":      id,
-		"status":  "suspended",
-		"message": "流程实例挂起成功",
-	}
+// ====================
+// 响应辅助函数
+// ====================
 
-	r.writeJSONResponse(w, http.StatusOK, r.successResponse(data))
+// writeJSONResponse 写入JSON响应
+func (r *Router) writeJSONResponse(c *gin.Context, statusCode int, response *APIResponse) {
+	response.Timestamp = time.Now().Format(time.RFC3339)
+	c.JSON(statusCode, response)
 }
 
-// handleActivateProcessInstance 激活流程实例
-func (r *Router) handleActivateProcessInstance(w http.ResponseWriter, req *http.Request) {
-	vars := mux.Vars(req)
-	id := vars["id"]
-
-	r.logger.Info("处理激活流程实例请求", zap.String("id", id))
-
-	data := map[string]interface{}{
-		"id":      id,
-		"status":  "active",
-		"message": "流程实例激活成功",
+// successResponse 创建成功响应
+func (r *Router) successResponse(data interface{}) *APIResponse {
+	return &APIResponse{
+		Code:    200,
+		Message: "成功",
+		Data:    data,
 	}
-
-	r.writeJSONResponse(w, http.StatusOK, r.successResponse(data))
 }
 
-// handleTerminateProcessInstance 终止流程实例
-func (r *Router) handleTerminateProcessInstance(w http.ResponseWriter, req *http.Request) {
-	vars := mux.Vars(req)
-	id := vars["id"]
-
-	r.logger.Info("处理终止流程实例请求", zap.String("id", id))
-
-	data := map[string]interface{}{
-		"id":      id,
-		"status":  "terminated",
-		"message": "流程实例终止成功",
+// errorResponse 创建错误响应
+func (r *Router) errorResponse(code int, message string) *APIResponse {
+	return &APIResponse{
+		Code:    code,
+		Message: message,
 	}
-
-	r.writeJSONResponse(w, http.StatusOK, r.successResponse(data))
 }
 
-// handleListTasks 查询任务列表
-func (r *Router) handleListTasks(w http.ResponseWriter, req *http.Request) {
-	r.logger.Info("处理查询任务列表请求")
-
-	data := map[string]interface{}{
-		"items": []map[string]interface{}{
-			{
-				"id":          "task-1",
-				"name":        "审批任务",
-				"assignee":    "user1",
-				"status":      "pending",
-				"create_time": time.Now().Format(time.RFC3339),
-			},
-		},
-		"total":     1,
-		"page":      1,
-		"page_size": 20,
+// writeServiceError 将服务层错误转换为HTTP响应
+// 非 ServiceError 视为内部错误，错误详情只记录日志不返回给调用方
+func (r *Router) writeServiceError(c *gin.Context, err error) {
+	var serviceErr *service.ServiceError
+	if !errors.As(err, &serviceErr) {
+		r.logger.Error("未预期的服务层错误",
+			zap.String("method", c.Request.Method),
+			zap.String("path", c.Request.URL.Path),
+			zap.Error(err))
+		r.writeJSONResponse(c, http.StatusInternalServerError,
+			r.errorResponse(service.ErrCodeInternalError, service.GetErrorMessage(service.ErrCodeInternalError)))
+		return
 	}
 
-	r.writeJSONResponse(w, http.StatusOK, r.successResponse(data))
+	response := r.errorResponse(serviceErr.Code, serviceErr.Message)
+	response.Error = serviceErr.Details
+	response.Errors = serviceErr.Errors
+	r.writeJSONResponse(c, httpStatus(serviceErr.Code), response)
 }
 
-// handleGetTask 获取任务
-func (r *Router) handleGetTask(w http.ResponseWriter, req *http.Request) {
-	vars := mux.Vars(req)
-	id := vars["id"]
-
-	r.logger.Info("处理获取任务请求", zap.String("id", id))
-
-	data := map[string]interface{}{
-		"id":          id,
-		"name":        "审批任务",
-		"assignee":    "user1",
-		"status":      "pending",
-		"create_time": time.Now().Format(time.RFC3339),
+// httpStatus 将服务层错误码映射为HTTP状态码
+func httpStatus(code int) int {
+	switch {
+	case service.IsClientError(code), service.IsServerError(code):
+		if http.StatusText(code) != "" {
+			return code
+		}
+		if service.IsClientError(code) {
+			return http.StatusBadRequest
+		}
+		return http.StatusInternalServerError
 	}
 
-	r.writeJSONResponse(w, http.StatusOK, r.successResponse(data))
-}
-
-// handleClaimTask 认领任务
-func (r *Router) handleClaimTask(w http.ResponseWriter, req *http.Request) {
-	vars := mux.Vars(req)
-	id := vars["id"]
-
-	r.logger.Info("处理认领任务请求", zap.String("id", id))
-
-	data := map[string]interface{}{
-		"id":      id,
-		"status":  "claimed",
-		"message": "任务认领成功",
+	switch code {
+	case service.ErrCodeWorkflowNotFound, service.ErrCodeTaskNotFound:
+		return http.StatusNotFound
+	case service.ErrCodeWorkflowSuspended, service.ErrCodeTaskAlreadyClaimed,
+		service.ErrCodeTaskNotAssigned, service.ErrCodeProcessNotStarted, service.ErrCodeProcessAlreadyEnded,
+		service.ErrCodeTaskAlreadyCompleted, service.ErrCodeTaskCancelled, service.ErrCodeInvalidTaskState,
+		service.ErrCodeTaskHasOpenSubtasks:
+		return http.StatusConflict
+	case service.ErrCodeTaskNotCandidate, service.ErrCodeTaskNotAssignee:
+		return http.StatusForbidden
+	case service.ErrCodeInvalidVariable, service.ErrCodeInvalidConfiguration:
+		return http.StatusUnprocessableEntity
 	}
 
-	r.writeJSONResponse(w, http.StatusOK, r.successResponse(data))
-}
-
-// handleCompleteTask 完成任务
-func (r *Router) handleCompleteTask(w http.ResponseWriter, req *http.Request) {
-	vars := mux.Vars(req)
-	id := vars["id"]
-
-	r.logger.Info("处理完成任务请求", zap.String("id", id))
-
-	data := map[string]interface{}{
-		"id":      id,
-		"status":  "completed",
-		"message": "任务完成成功",
+	if service.IsBusinessError(code) {
+		return http.StatusBadRequest
 	}
-
-	r.writeJSONResponse(w, http.StatusOK, r.successResponse(data))
+	return http.StatusInternalServerError
 }
 
-// handleDelegateTask 委派任务
-func (r *Router) handleDelegateTask(w http.ResponseWriter, req *http.Request) {
-	vars := mux.Vars(req)
-	id := vars["id"]
-
-	r.logger.Info("处理委派任务请求", zap.String("id", id))
-
-	data := map[string]interface{}{
-		"id":      id,
-		"status":  "delegated",
-		"message": "任务委派成功",
-	}
+// ====================
+// 健康检查
+// ====================
 
-	r.writeJSONResponse(w, http.StatusOK, r.successResponse(data))
+// handleHealthCheck 健康检查
+func (r *Router) handleHealthCheck(c *gin.Context) {
+	r.writeHealthResponse(c, "healthy", "unhealthy")
 }
 
-// handleListHistoricProcessInstances 查询历史流程实例列表
-func (r *Router) handleListHistoricProcessInstances(w http.ResponseWriter, req *http.Request) {
-	r.logger.Info("处理查询历史流程实例列表请求")
-
-	data := map[string]interface{}{
-		"items": []map[string]interface{}{
-			{
-				"id":         "hist-1",
-				"start_time": time.Now().AddDate(0, 0, -1).Format(time.RFC3339),
-				"end_time":   time.Now().Format(time.RFC3339),
-				"status":     "completed",
-			},
-		},
-		"total":     1,
-		"page":      1,
-		"page_size": 20,
-	}
-
-	r.writeJSONResponse(w, http.StatusOK, r.successResponse(data))
+// handleReadinessCheck 就绪检查
+func (r *Router) handleReadinessCheck(c *gin.Context) {
+	r.writeHealthResponse(c, "ready", "not_ready")
 }
 
-// handleGetHistoricProcessInstance 获取历史流程实例
-func (r *Router) handleGetHistoricProcessInstance(w http.ResponseWriter, req *http.Request) {
-	vars := mux.Vars(req)
-	id := vars["id"]
-
-	r.logger.Info("处理获取历史流程实例请求", zap.String("id", id))
-
-	data := map[string]interface{}{
-		"id":         id,
-		"start_time": time.Now().AddDate(0, 0, -1).Format(time.RFC3339),
-		"end_time":   time.Now().Format(time.RFC3339),
-		"status":     "completed",
-		"duration":   "1h30m",
-	}
-
-	r.writeJSONResponse(w, http.StatusOK, r.successResponse(data))
-}
+// writeHealthResponse 检查依赖状态并写入响应，依赖不可用时返回503
+func (r *Router) writeHealthResponse(c *gin.Context, okStatus, failedStatus string) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), healthCheckTimeout)
+	defer cancel()
 
-// handleHealthCheck 健康检查
-func (r *Router) handleHealthCheck(w http.ResponseWriter, req *http.Request) {
 	data := map[string]interface{}{
-		"status":  "healthy",
+		"status":  okStatus,
 		"service": "workflow-engine",
 		"version": "1.0.0",
 	}
 
-	r.writeJSONResponse(w, http.StatusOK, r.successResponse(data))
-}
+	if err := r.health.HealthCheck(ctx); err != nil {
+		r.logger.Warn("依赖健康检查失败", zap.Error(err))
+		data["status"] = failedStatus
 
-// handleReadinessCheck 就绪检查
-func (r *Router) handleReadinessCheck(w http.ResponseWriter, req *http.Request) {
-	data := map[string]interface{}{
-		"status":  "ready",
-		"service": "workflow-engine",
-		"version": "1.0.0",
+		response := r.errorResponse(service.ErrCodeServiceUnavailable, service.GetErrorMessage(service.ErrCodeServiceUnavailable))
+		response.Data = data
+		response.Error = err.Error()
+		r.writeJSONResponse(c, http.StatusServiceUnavailable, response)
+		return
 	}
 
-	r.writeJSONResponse(w, http.StatusOK, r.successResponse(data))
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(data))
+}
+
+// handleJWKS 发布令牌签名公钥
+// 按 RFC 7517 直接返回 JWK Set，不使用统一响应格式，便于标准 JWT 库直接读取
+func (r *Router) handleJWKS(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, r.auth.JWKS())
 }
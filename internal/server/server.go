@@ -0,0 +1,45 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/wire"
+
+	"github.com/workflow-engine/workflow-engine/pkg/config"
+)
+
+// ProviderSet HTTP服务层的依赖注入提供器集合
+var ProviderSet = wire.NewSet(NewRouter, NewHTTPServer)
+
+// timeoutResponse 请求处理超时时返回的响应体
+const timeoutResponse = `{"code":504,"message":"请求处理超时"}`
+
+// NewHTTPServer 创建HTTP服务器
+// 配置了 Server.HTTP.Timeout 时，每个请求的上下文在超时后取消并返回504
+func NewHTTPServer(cfg *config.Config, router *Router) *http.Server {
+	var handler http.Handler = router
+	if timeout := cfg.Server.HTTP.Timeout; timeout > 0 {
+		handler = &timeoutHandler{next: http.TimeoutHandler(router, timeout, timeoutResponse)}
+	}
+
+	return &http.Server{
+		Addr:         cfg.Server.HTTP.Addr,
+		Handler:      handler,
+		ReadTimeout:  30 * time.Second,
+		WriteTimeout: 30 * time.Second,
+		IdleTimeout:  60 * time.Second,
+	}
+}
+
+// timeoutHandler 为超时响应设置JSON内容类型
+// http.TimeoutHandler 超时时不会覆盖内容类型，这里预先设置，正常响应时由路由器覆盖
+type timeoutHandler struct {
+	next http.Handler
+}
+
+// ServeHTTP 实现http.Handler接口
+func (h *timeoutHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	h.next.ServeHTTP(w, req)
+}
@@ -0,0 +1,371 @@
+package server
+
+import (
+	"bufio"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/workflow-engine/workflow-engine/internal/auth"
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+)
+
+// sniffLen http.DetectContentType 识别文件类型最多使用的字节数
+const sniffLen = 512
+
+// claimTaskRequest 认领任务请求体
+type claimTaskRequest struct {
+	UserID string `json:"user_id" binding:"required"` // 认领用户ID
+}
+
+// completeTaskRequest 完成任务请求体
+type completeTaskRequest struct {
+	Variables map[string]interface{} `json:"variables"` // 任务输出变量
+	Comment   string                 `json:"comment"`   // 审批意见
+}
+
+// delegateTaskRequest 委派任务请求体
+type delegateTaskRequest struct {
+	DelegateID string `json:"delegate_id" binding:"required"` // 被委派人用户ID
+	Comment    string `json:"comment"`                        // 委派说明
+}
+
+// resolveTaskRequest 交还委派任务请求体
+type resolveTaskRequest struct {
+	Variables map[string]interface{} `json:"variables"` // 被委派人填写的任务变量
+	Comment   string                 `json:"comment"`   // 处理说明
+}
+
+// addCommentRequest 添加任务评论请求体
+type addCommentRequest struct {
+	Message string `json:"message" binding:"required"` // 评论内容
+}
+
+// handleListTasks 查询任务列表
+func (r *Router) handleListTasks(c *gin.Context) {
+	var req biz.ListTaskInstancesRequest
+	if !r.bindQuery(c, &req) {
+		return
+	}
+
+	result, err := r.tasks.ListTaskInstances(c.Request.Context(), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleGetMyTasks 查询当前用户的任务列表
+func (r *Router) handleGetMyTasks(c *gin.Context) {
+	var req biz.ListTaskInstancesRequest
+	if !r.bindQuery(c, &req) {
+		return
+	}
+
+	result, err := r.tasks.GetMyTasks(c.Request.Context(), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleGetAvailableTasks 查询可认领的任务列表
+// 只返回当前用户或其角色、用户组是候选人的任务
+func (r *Router) handleGetAvailableTasks(c *gin.Context) {
+	var req biz.ListTaskInstancesRequest
+	if !r.bindQuery(c, &req) {
+		return
+	}
+	req.CandidateUser, req.CandidateGroups = callerIdentity(c)
+
+	result, err := r.tasks.GetAvailableTasks(c.Request.Context(), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleGetTask 获取任务
+func (r *Router) handleGetTask(c *gin.Context) {
+	result, err := r.tasks.GetTaskInstance(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleClaimTask 认领任务
+// 当前用户为自己认领时按其角色、用户组校验候选组；为他人认领需要 task:assign 权限，且只校验候选用户
+func (r *Router) handleClaimTask(c *gin.Context) {
+	var req claimTaskRequest
+	if !r.bindJSON(c, &req) {
+		return
+	}
+	var groups []string
+	if userID, callerGroups := callerIdentity(c); userID == req.UserID {
+		groups = callerGroups
+	}
+
+	if err := r.tasks.ClaimTask(c.Request.Context(), c.Param("id"), req.UserID, groups); err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(nil))
+}
+
+// handleCompleteTask 完成任务
+func (r *Router) handleCompleteTask(c *gin.Context) {
+	var req completeTaskRequest
+	if !r.bindOptionalJSON(c, &req) {
+		return
+	}
+
+	if err := r.tasks.CompleteTask(c.Request.Context(), c.Param("id"), normalizeVariables(req.Variables), req.Comment); err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(nil))
+}
+
+// handleDelegateTask 委派任务
+func (r *Router) handleDelegateTask(c *gin.Context) {
+	var req delegateTaskRequest
+	if !r.bindJSON(c, &req) {
+		return
+	}
+
+	if err := r.tasks.DelegateTask(c.Request.Context(), c.Param("id"), req.DelegateID, req.Comment); err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(nil))
+}
+
+// handleResolveTask 被委派人交还任务
+func (r *Router) handleResolveTask(c *gin.Context) {
+	var req resolveTaskRequest
+	if !r.bindOptionalJSON(c, &req) {
+		return
+	}
+
+	if err := r.tasks.ResolveTask(c.Request.Context(), c.Param("id"), normalizeVariables(req.Variables), req.Comment); err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(nil))
+}
+
+// handleBulkTasks 批量操作任务
+// 认领时未指定认领人则为当前用户认领；为自己认领时按其角色、用户组校验候选组，为他人认领需要 task:assign 权限，与认领单个任务一致
+func (r *Router) handleBulkTasks(c *gin.Context) {
+	var req biz.BulkTaskRequest
+	if !r.bindJSON(c, &req) {
+		return
+	}
+
+	userID, callerGroups := callerIdentity(c)
+	if req.Action == biz.BulkTaskClaim && req.AssigneeID == "" {
+		req.AssigneeID = userID
+	}
+	if req.AssigneeID == userID {
+		req.CandidateGroups = callerGroups
+	}
+	req.Variables = normalizeVariables(req.Variables)
+
+	result, err := r.tasks.BulkTasks(c.Request.Context(), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleListTaskEvents 查询任务办理记录
+func (r *Router) handleListTaskEvents(c *gin.Context) {
+	var req biz.ListTaskEventsRequest
+	if !r.bindQuery(c, &req) {
+		return
+	}
+
+	result, err := r.tasks.ListTaskEvents(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleCreateSubtask 创建子任务
+func (r *Router) handleCreateSubtask(c *gin.Context) {
+	var req biz.CreateSubtaskRequest
+	if !r.bindJSON(c, &req) {
+		return
+	}
+
+	result, err := r.tasks.CreateSubtask(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusCreated, r.successResponse(result))
+}
+
+// handleListSubtasks 查询任务的子任务树
+func (r *Router) handleListSubtasks(c *gin.Context) {
+	result, err := r.tasks.ListSubtasks(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleUpdateTask 修改任务信息
+func (r *Router) handleUpdateTask(c *gin.Context) {
+	var req biz.UpdateTaskRequest
+	if !r.bindJSON(c, &req) {
+		return
+	}
+
+	result, err := r.tasks.UpdateTask(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleAddTaskComment 添加任务评论
+func (r *Router) handleAddTaskComment(c *gin.Context) {
+	var req addCommentRequest
+	if !r.bindJSON(c, &req) {
+		return
+	}
+
+	result, err := r.tasks.AddComment(c.Request.Context(), c.Param("id"), req.Message)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusCreated, r.successResponse(result))
+}
+
+// handleListTaskComments 查询任务评论
+func (r *Router) handleListTaskComments(c *gin.Context) {
+	var req biz.ListTaskCommentsRequest
+	if !r.bindQuery(c, &req) {
+		return
+	}
+
+	result, err := r.tasks.ListComments(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleAddTaskAttachment 上传任务附件
+// 请求体为 multipart/form-data，file 字段为附件文件，description 字段为附件说明
+func (r *Router) handleAddTaskAttachment(c *gin.Context) {
+	header, err := c.FormFile("file")
+	if err != nil {
+		r.writeBindError(c, err)
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		r.writeBindError(c, err)
+		return
+	}
+	defer file.Close()
+
+	// 文件内容由仓储按大小限制读取，这里只预读识别文件类型所需的前512字节
+	content := bufio.NewReaderSize(file, sniffLen)
+	contentType := header.Header.Get("Content-Type")
+	if contentType == "" {
+		head, err := content.Peek(sniffLen)
+		if err != nil && err != io.EOF {
+			r.writeBindError(c, err)
+			return
+		}
+		contentType = http.DetectContentType(head)
+	}
+
+	result, err := r.tasks.AddAttachment(c.Request.Context(), c.Param("id"), &biz.AddTaskAttachmentRequest{
+		Name:        header.Filename,
+		Description: c.PostForm("description"),
+		ContentType: contentType,
+		Content:     content,
+	})
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusCreated, r.successResponse(result))
+}
+
+// handleListTaskAttachments 查询任务附件
+func (r *Router) handleListTaskAttachments(c *gin.Context) {
+	var req biz.ListTaskAttachmentsRequest
+	if !r.bindQuery(c, &req) {
+		return
+	}
+
+	result, err := r.tasks.ListAttachments(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleGetTaskAttachmentContent 下载任务附件
+func (r *Router) handleGetTaskAttachmentContent(c *gin.Context) {
+	result, err := r.tasks.GetAttachmentContent(c.Request.Context(), c.Param("id"), c.Param("attachmentId"))
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	contentType := result.Attachment.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": result.Attachment.Name}))
+	c.Data(http.StatusOK, contentType, result.Content)
+}
+
+// callerIdentity 返回当前用户的用户标识与所属用户组
+// 取认证中间件放入请求上下文的调用方身份，用户组包含令牌中的角色与用户组
+func callerIdentity(c *gin.Context) (string, []string) {
+	identity, ok := auth.FromContext(c.Request.Context())
+	if !ok {
+		return "", nil
+	}
+	return identity.Principal(), identity.CandidateGroups()
+}
@@ -0,0 +1,138 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+)
+
+// handleListUsers 查询用户列表
+func (r *Router) handleListUsers(c *gin.Context) {
+	var req biz.ListUsersRequest
+	if !r.bindQuery(c, &req) {
+		return
+	}
+
+	result, err := r.users.ListUsers(c.Request.Context(), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleCreateUser 创建用户
+func (r *Router) handleCreateUser(c *gin.Context) {
+	var req biz.CreateUserRequest
+	if !r.bindJSON(c, &req) {
+		return
+	}
+
+	result, err := r.users.CreateUser(c.Request.Context(), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusCreated, r.successResponse(result))
+}
+
+// handleGetUser 获取用户
+func (r *Router) handleGetUser(c *gin.Context) {
+	result, err := r.users.GetUser(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleUpdateUser 更新用户资料、角色、用户组、状态或重置密码
+func (r *Router) handleUpdateUser(c *gin.Context) {
+	var req biz.UpdateUserRequest
+	if !r.bindJSON(c, &req) {
+		return
+	}
+
+	result, err := r.users.UpdateUser(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleListRoles 查询全部角色
+func (r *Router) handleListRoles(c *gin.Context) {
+	result, err := r.users.ListRoles(c.Request.Context())
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleCreateRole 创建角色
+func (r *Router) handleCreateRole(c *gin.Context) {
+	var req biz.CreateRoleRequest
+	if !r.bindJSON(c, &req) {
+		return
+	}
+
+	result, err := r.users.CreateRole(c.Request.Context(), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusCreated, r.successResponse(result))
+}
+
+// handleUpdateRole 更新角色描述与权限
+func (r *Router) handleUpdateRole(c *gin.Context) {
+	var req biz.UpdateRoleRequest
+	if !r.bindJSON(c, &req) {
+		return
+	}
+
+	result, err := r.users.UpdateRole(c.Request.Context(), c.Param("name"), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleListGroups 查询全部用户组
+func (r *Router) handleListGroups(c *gin.Context) {
+	result, err := r.users.ListGroups(c.Request.Context())
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusOK, r.successResponse(result))
+}
+
+// handleCreateGroup 创建用户组
+func (r *Router) handleCreateGroup(c *gin.Context) {
+	var req biz.CreateGroupRequest
+	if !r.bindJSON(c, &req) {
+		return
+	}
+
+	result, err := r.users.CreateGroup(c.Request.Context(), &req)
+	if err != nil {
+		r.writeServiceError(c, err)
+		return
+	}
+
+	r.writeJSONResponse(c, http.StatusCreated, r.successResponse(result))
+}
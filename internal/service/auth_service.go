@@ -0,0 +1,108 @@
+// Package service 认证服务实现
+// 处理登录、刷新令牌、登出和修改密码相关的API请求并调用业务逻辑
+package service
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/workflow-engine/workflow-engine/internal/auth"
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+)
+
+// AuthService 认证服务
+// 负责处理认证相关的API请求，连接HTTP层与业务逻辑层
+type AuthService struct {
+	uc     *biz.AuthUseCase
+	logger *zap.Logger
+}
+
+// NewAuthService 创建认证服务
+func NewAuthService(uc *biz.AuthUseCase, logger *zap.Logger) *AuthService {
+	return &AuthService{
+		uc:     uc,
+		logger: logger,
+	}
+}
+
+// Login 用户名密码登录
+func (s *AuthService) Login(ctx context.Context, req *biz.LoginRequest) (*auth.TokenPair, error) {
+	if req.Username == "" || req.Password == "" {
+		return nil, NewServiceError(ErrCodeBadRequest, "用户名和密码不能为空")
+	}
+
+	pair, err := s.uc.Login(ctx, req)
+	if err != nil {
+		return nil, wrapAuthError(err, ErrCodeInternalError, "登录失败")
+	}
+	return pair, nil
+}
+
+// Refresh 使用刷新令牌换取新的令牌对
+func (s *AuthService) Refresh(ctx context.Context, req *biz.RefreshTokenRequest) (*auth.TokenPair, error) {
+	if req.RefreshToken == "" {
+		return nil, NewServiceError(ErrCodeBadRequest, "刷新令牌不能为空")
+	}
+
+	pair, err := s.uc.Refresh(ctx, req.RefreshToken)
+	if err != nil {
+		return nil, wrapAuthError(err, ErrCodeInternalError, "刷新令牌失败")
+	}
+	return pair, nil
+}
+
+// Logout 登出当前会话
+func (s *AuthService) Logout(ctx context.Context, claims *auth.UserClaims) error {
+	if claims == nil {
+		return NewServiceError(ErrCodeUnauthorized, "未登录")
+	}
+
+	if err := s.uc.Logout(ctx, claims); err != nil {
+		s.logger.Error("登出失败", zap.Int64("user_id", claims.UserID), zap.Error(err))
+		return wrapAuthError(err, ErrCodeInternalError, "登出失败")
+	}
+	return nil
+}
+
+// ChangePassword 修改当前用户的密码
+func (s *AuthService) ChangePassword(ctx context.Context, req *biz.ChangePasswordRequest) (*auth.TokenPair, error) {
+	if req.OldPassword == "" || req.NewPassword == "" {
+		return nil, NewServiceError(ErrCodeBadRequest, "原密码和新密码不能为空")
+	}
+
+	pair, err := s.uc.ChangePassword(ctx, req)
+	if err != nil {
+		return nil, wrapAuthError(err, ErrCodeInternalError, "修改密码失败")
+	}
+	return pair, nil
+}
+
+// authErrorCodes 认证与用户管理业务错误对应的错误码
+var authErrorCodes = []struct {
+	err  error
+	code int
+}{
+	{biz.ErrInvalidCredentials, ErrCodeUnauthorized},
+	{biz.ErrInvalidRefreshToken, ErrCodeUnauthorized},
+	{biz.ErrRefreshTokenReused, ErrCodeUnauthorized},
+	{biz.ErrUserDisabled, ErrCodeForbidden},
+	{biz.ErrWeakPassword, ErrCodeValidationError},
+	{biz.ErrUserNotFound, ErrCodeNotFound},
+	{biz.ErrRoleNotFound, ErrCodeNotFound},
+	{biz.ErrGroupNotFound, ErrCodeNotFound},
+	{biz.ErrUserExists, ErrCodeConflict},
+	{biz.ErrRoleExists, ErrCodeConflict},
+	{biz.ErrGroupExists, ErrCodeConflict},
+}
+
+// wrapAuthError 将认证与用户管理业务错误包装为对应错误码的服务层错误，其他错误使用给定的错误码
+func wrapAuthError(err error, code int, message string) *ServiceError {
+	for _, c := range authErrorCodes {
+		if errors.Is(err, c.err) {
+			return WrapError(err, c.code, c.err.Error())
+		}
+	}
+	return WrapError(err, code, message)
+}
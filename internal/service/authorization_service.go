@@ -0,0 +1,86 @@
+// Package service 资源授权服务实现
+// 处理流程资源授权管理相关的API请求并调用业务逻辑
+package service
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+)
+
+// AuthorizationService 资源授权服务
+// 负责管理按流程定义Key、分类与租户授予用户或用户组的流程操作权限
+type AuthorizationService struct {
+	uc     *biz.AuthorizationUseCase
+	logger *zap.Logger
+}
+
+// NewAuthorizationService 创建资源授权服务
+func NewAuthorizationService(uc *biz.AuthorizationUseCase, logger *zap.Logger) *AuthorizationService {
+	return &AuthorizationService{
+		uc:     uc,
+		logger: logger,
+	}
+}
+
+// CreateAuthorization 创建授权
+func (s *AuthorizationService) CreateAuthorization(ctx context.Context, req *biz.CreateAuthorizationRequest) (*biz.AuthorizationResponse, error) {
+	if req.PrincipalType == "" || req.PrincipalID == "" {
+		return nil, NewServiceError(ErrCodeBadRequest, "被授权主体不能为空")
+	}
+	if len(req.Actions) == 0 {
+		return nil, NewServiceError(ErrCodeBadRequest, "授予的操作不能为空")
+	}
+
+	resp, err := s.uc.CreateGrant(ctx, req)
+	if err != nil {
+		s.logger.Error("创建授权失败", zap.String("principal_id", req.PrincipalID), zap.Error(err))
+		return nil, wrapAccessError(err, ErrCodeInternalError, "创建授权失败")
+	}
+	return resp, nil
+}
+
+// ListAuthorizations 查询授权
+func (s *AuthorizationService) ListAuthorizations(ctx context.Context, req *biz.ListAuthorizationsRequest) ([]*biz.AuthorizationResponse, error) {
+	resp, err := s.uc.ListGrants(ctx, req)
+	if err != nil {
+		return nil, wrapAccessError(err, ErrCodeInternalError, "查询授权列表失败")
+	}
+	return resp, nil
+}
+
+// DeleteAuthorization 删除授权
+func (s *AuthorizationService) DeleteAuthorization(ctx context.Context, id string) error {
+	if id == "" {
+		return NewServiceError(ErrCodeBadRequest, "授权ID不能为空")
+	}
+
+	if err := s.uc.DeleteGrant(ctx, id); err != nil {
+		s.logger.Error("删除授权失败", zap.String("id", id), zap.Error(err))
+		return wrapAccessError(err, ErrCodeInternalError, "删除授权失败")
+	}
+	return nil
+}
+
+// accessErrorCodes 资源授权业务错误对应的错误码
+var accessErrorCodes = []struct {
+	err  error
+	code int
+}{
+	{biz.ErrAccessDenied, ErrCodeForbidden},
+	{biz.ErrInvalidAuthorization, ErrCodeValidationError},
+	{biz.ErrAuthorizationNotFound, ErrCodeNotFound},
+}
+
+// wrapAccessError 将资源授权业务错误包装为对应错误码的服务层错误，其他错误使用给定的错误码
+func wrapAccessError(err error, code int, message string) *ServiceError {
+	for _, c := range accessErrorCodes {
+		if errors.Is(err, c.err) {
+			return WrapError(err, c.code, c.err.Error())
+		}
+	}
+	return WrapError(err, code, message)
+}
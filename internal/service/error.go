@@ -9,9 +9,10 @@ import (
 // ServiceError 服务层错误类型
 // 统一的服务层错误结构，包含错误码和错误信息
 type ServiceError struct {
-	Code    int    `json:"code"`    // 错误码
-	Message string `json:"message"` // 错误信息
-	Details string `json:"details"` // 错误详情
+	Code    int         `json:"code"`             // 错误码
+	Message string      `json:"message"`          // 错误信息
+	Details string      `json:"details"`          // 错误详情
+	Errors  interface{} `json:"errors,omitempty"` // 结构化错误列表
 }
 
 // Error 实现 error 接口
@@ -39,6 +40,15 @@ func NewServiceErrorWithDetails(code int, message, details string) *ServiceError
 	}
 }
 
+// NewServiceErrorWithErrors 创建带结构化错误列表的服务层错误
+func NewServiceErrorWithErrors(code int, message string, errs interface{}) *ServiceError {
+	return &ServiceError{
+		Code:    code,
+		Message: message,
+		Errors:  errs,
+	}
+}
+
 // 错误码常量定义
 const (
 	// 成功响应
@@ -72,6 +82,12 @@ const (
 	ErrCodeProcessAlreadyEnded  = 607 // 流程已结束
 	ErrCodeInvalidVariable      = 608 // 无效的变量
 	ErrCodeInvalidConfiguration = 609 // 无效的配置
+	ErrCodeTaskAlreadyCompleted = 610 // 任务已完成
+	ErrCodeTaskCancelled        = 611 // 任务已取消
+	ErrCodeInvalidTaskState     = 612 // 任务状态不允许该操作
+	ErrCodeTaskNotCandidate     = 613 // 不是任务候选人
+	ErrCodeTaskNotAssignee      = 614 // 不是任务办理人
+	ErrCodeTaskHasOpenSubtasks  = 615 // 任务存在未结束的子任务
 )
 
 // 错误信息映射
@@ -100,6 +116,12 @@ var ErrorMessages = map[int]string{
 	ErrCodeProcessAlreadyEnded:  "流程已结束",
 	ErrCodeInvalidVariable:      "无效的变量",
 	ErrCodeInvalidConfiguration: "无效的配置",
+	ErrCodeTaskAlreadyCompleted: "任务已完成",
+	ErrCodeTaskCancelled:        "任务已取消",
+	ErrCodeInvalidTaskState:     "任务状态不允许该操作",
+	ErrCodeTaskNotCandidate:     "不是任务候选人",
+	ErrCodeTaskNotAssignee:      "不是任务办理人",
+	ErrCodeTaskHasOpenSubtasks:  "任务存在未结束的子任务",
 }
 
 // GetErrorMessage 根据错误码获取错误信息
@@ -162,4 +184,9 @@ var (
 	ErrProcessAlreadyEnded  = NewServiceError(ErrCodeProcessAlreadyEnded, "流程已结束")
 	ErrInvalidVariable      = NewServiceError(ErrCodeInvalidVariable, "无效的变量")
 	ErrInvalidConfiguration = NewServiceError(ErrCodeInvalidConfiguration, "无效的配置")
+	ErrTaskAlreadyCompleted = NewServiceError(ErrCodeTaskAlreadyCompleted, "任务已完成")
+	ErrTaskCancelled        = NewServiceError(ErrCodeTaskCancelled, "任务已取消")
+	ErrInvalidTaskState     = NewServiceError(ErrCodeInvalidTaskState, "任务状态不允许该操作")
+	ErrTaskNotCandidate     = NewServiceError(ErrCodeTaskNotCandidate, "不是任务候选人")
+	ErrTaskNotAssignee      = NewServiceError(ErrCodeTaskNotAssignee, "不是任务办理人")
 )
@@ -49,7 +49,7 @@ func (s *HistoricDataService) GetHistoricProcessInstance(ctx context.Context, id
 	result, err := s.uc.GetHistoricProcessInstance(ctx, instanceID)
 	if err != nil {
 		s.logger.Error("获取历史流程实例失败", zap.String("id", id), zap.Error(err))
-		return nil, WrapError(err, ErrCodeNotFound, "历史流程实例不存在")
+		return nil, wrapAccessError(err, ErrCodeNotFound, "历史流程实例不存在")
 	}
 
 	return result, nil
@@ -74,7 +74,7 @@ func (s *HistoricDataService) ListHistoricProcessInstances(ctx context.Context,
 	result, err := s.uc.ListHistoricProcessInstances(ctx, req)
 	if err != nil {
 		s.logger.Error("查询历史流程实例列表失败", zap.Error(err))
-		return nil, WrapError(err, ErrCodeInternalError, "查询历史流程实例列表失败")
+		return nil, wrapAccessError(err, ErrCodeInternalError, "查询历史流程实例列表失败")
 	}
 
 	s.logger.Info("服务层: 查询历史流程实例列表成功",
@@ -100,7 +100,7 @@ func (s *HistoricDataService) GetProcessStatistics(ctx context.Context, req *biz
 		s.logger.Error("获取流程统计数据失败",
 			zap.String("process_definition_key", req.ProcessDefinitionKey),
 			zap.Error(err))
-		return nil, WrapError(err, ErrCodeInternalError, "获取流程统计数据失败")
+		return nil, wrapAccessError(err, ErrCodeInternalError, "获取流程统计数据失败")
 	}
 
 	s.logger.Info("服务层: 获取流程统计数据成功",
@@ -126,7 +126,7 @@ func (s *HistoricDataService) GetProcessTrend(ctx context.Context, req *biz.Proc
 		s.logger.Error("获取流程趋势数据失败",
 			zap.String("process_definition_key", req.ProcessDefinitionKey),
 			zap.Error(err))
-		return nil, WrapError(err, ErrCodeInternalError, "获取流程趋势数据失败")
+		return nil, wrapAccessError(err, ErrCodeInternalError, "获取流程趋势数据失败")
 	}
 
 	s.logger.Info("服务层: 获取流程趋势数据成功",
@@ -152,7 +152,7 @@ func (s *HistoricDataService) BatchDeleteHistoricProcessInstances(ctx context.Co
 		s.logger.Error("批量删除历史流程实例失败",
 			zap.String("process_definition_key", req.ProcessDefinitionKey),
 			zap.Error(err))
-		return nil, WrapError(err, ErrCodeInternalError, "批量删除历史流程实例失败")
+		return nil, wrapAccessError(err, ErrCodeInternalError, "批量删除历史流程实例失败")
 	}
 
 	s.logger.Info("服务层: 批量删除历史流程实例成功",
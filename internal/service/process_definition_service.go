@@ -4,10 +4,12 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"go.uber.org/zap"
 
 	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/model"
 )
 
 // ProcessDefinitionService 流程定义服务
@@ -45,7 +47,7 @@ func (s *ProcessDefinitionService) CreateProcessDefinition(ctx context.Context,
 	result, err := s.uc.CreateProcessDefinition(ctx, req)
 	if err != nil {
 		s.logger.Error("创建流程定义失败", zap.Error(err))
-		return nil, err
+		return nil, wrapDefinitionError(err)
 	}
 
 	s.logger.Info("服务层: 创建流程定义成功", zap.String("id", result.ID))
@@ -68,7 +70,7 @@ func (s *ProcessDefinitionService) GetProcessDefinition(ctx context.Context, id
 	result, err := s.uc.GetProcessDefinition(ctx, id)
 	if err != nil {
 		s.logger.Error("获取流程定义失败", zap.String("id", id), zap.Error(err))
-		return nil, err
+		return nil, wrapAccessError(err, ErrCodeNotFound, "流程定义不存在")
 	}
 
 	return result, nil
@@ -90,7 +92,7 @@ func (s *ProcessDefinitionService) GetLatestProcessDefinition(ctx context.Contex
 	result, err := s.uc.GetLatestProcessDefinition(ctx, key)
 	if err != nil {
 		s.logger.Error("获取最新版本流程定义失败", zap.String("key", key), zap.Error(err))
-		return nil, err
+		return nil, wrapAccessError(err, ErrCodeNotFound, "流程定义不存在")
 	}
 
 	return result, nil
@@ -112,7 +114,7 @@ func (s *ProcessDefinitionService) UpdateProcessDefinition(ctx context.Context,
 	result, err := s.uc.UpdateProcessDefinition(ctx, id, req)
 	if err != nil {
 		s.logger.Error("更新流程定义失败", zap.String("id", id), zap.Error(err))
-		return nil, err
+		return nil, wrapDefinitionError(err)
 	}
 
 	s.logger.Info("服务层: 更新流程定义成功", zap.String("id", id))
@@ -135,7 +137,7 @@ func (s *ProcessDefinitionService) DeleteProcessDefinition(ctx context.Context,
 	err := s.uc.DeleteProcessDefinition(ctx, id)
 	if err != nil {
 		s.logger.Error("删除流程定义失败", zap.String("id", id), zap.Error(err))
-		return err
+		return wrapDefinitionError(err)
 	}
 
 	s.logger.Info("服务层: 删除流程定义成功", zap.String("id", id))
@@ -161,7 +163,7 @@ func (s *ProcessDefinitionService) ListProcessDefinitions(ctx context.Context, r
 	result, err := s.uc.ListProcessDefinitions(ctx, req)
 	if err != nil {
 		s.logger.Error("查询流程定义列表失败", zap.Error(err))
-		return nil, err
+		return nil, wrapAccessError(err, ErrCodeInternalError, "查询流程定义列表失败")
 	}
 
 	s.logger.Info("服务层: 查询流程定义列表成功",
@@ -186,7 +188,7 @@ func (s *ProcessDefinitionService) DeployProcessDefinition(ctx context.Context,
 	err := s.uc.DeployProcessDefinition(ctx, id)
 	if err != nil {
 		s.logger.Error("部署流程定义失败", zap.String("id", id), zap.Error(err))
-		return err
+		return wrapDefinitionError(err)
 	}
 
 	s.logger.Info("服务层: 部署流程定义成功", zap.String("id", id))
@@ -209,13 +211,35 @@ func (s *ProcessDefinitionService) SuspendProcessDefinition(ctx context.Context,
 	err := s.uc.SuspendProcessDefinition(ctx, id)
 	if err != nil {
 		s.logger.Error("挂起流程定义失败", zap.String("id", id), zap.Error(err))
-		return err
+		return wrapAccessError(err, ErrCodeInternalError, "挂起流程定义失败")
 	}
 
 	s.logger.Info("服务层: 挂起流程定义成功", zap.String("id", id))
 	return nil
 }
 
+// ExportProcessDefinitionBPMN 导出流程定义
+// 将流程定义导出为BPMN 2.0 XML，供建模工具打开
+func (s *ProcessDefinitionService) ExportProcessDefinitionBPMN(ctx context.Context, id string) ([]byte, error) {
+	s.logger.Debug("服务层: 导出流程定义BPMN", zap.String("id", id))
+
+	if id == "" {
+		s.logger.Error("流程定义ID不能为空")
+		return nil, &ServiceError{
+			Code:    ErrCodeBadRequest,
+			Message: "流程定义ID不能为空",
+		}
+	}
+
+	result, err := s.uc.ExportProcessDefinitionBPMN(ctx, id)
+	if err != nil {
+		s.logger.Error("导出流程定义BPMN失败", zap.String("id", id), zap.Error(err))
+		return nil, wrapAccessError(err, ErrCodeInternalError, "导出流程定义BPMN失败")
+	}
+
+	return result, nil
+}
+
 // validateCreateRequest 验证创建请求参数
 func (s *ProcessDefinitionService) validateCreateRequest(req *biz.CreateProcessDefinitionRequest) error {
 	if req.Key == "" {
@@ -238,3 +262,15 @@ func (s *ProcessDefinitionService) validateCreateRequest(req *biz.CreateProcessD
 	}
 	return nil
 }
+
+// wrapDefinitionError 将流程定义校验错误转换为带结构化错误列表的服务层错误，无权操作流程定义时返回禁止访问
+func wrapDefinitionError(err error) error {
+	var verrs model.ValidationErrors
+	if errors.As(err, &verrs) {
+		return NewServiceErrorWithErrors(ErrCodeValidationError, "流程定义内容验证失败", verrs)
+	}
+	if errors.Is(err, biz.ErrAccessDenied) {
+		return wrapAccessError(err, ErrCodeForbidden, biz.ErrAccessDenied.Error())
+	}
+	return err
+}
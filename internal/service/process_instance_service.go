@@ -4,6 +4,7 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"go.uber.org/zap"
 
@@ -46,7 +47,7 @@ func (s *ProcessInstanceService) StartProcessInstance(ctx context.Context, req *
 	result, err := s.uc.StartProcessInstance(ctx, req)
 	if err != nil {
 		s.logger.Error("启动流程实例失败", zap.Error(err))
-		return nil, WrapError(err, ErrCodeInternalError, "启动流程实例失败")
+		return nil, wrapAccessError(err, ErrCodeInternalError, "启动流程实例失败")
 	}
 
 	s.logger.Info("服务层: 启动流程实例成功", zap.String("instance_id", result.ID))
@@ -66,7 +67,7 @@ func (s *ProcessInstanceService) GetProcessInstance(ctx context.Context, id stri
 	result, err := s.uc.GetProcessInstance(ctx, id)
 	if err != nil {
 		s.logger.Error("获取流程实例失败", zap.String("id", id), zap.Error(err))
-		return nil, WrapError(err, ErrCodeNotFound, "流程实例不存在")
+		return nil, wrapAccessError(err, ErrCodeNotFound, "流程实例不存在")
 	}
 
 	return result, nil
@@ -91,7 +92,7 @@ func (s *ProcessInstanceService) ListProcessInstances(ctx context.Context, req *
 	result, err := s.uc.ListProcessInstances(ctx, req)
 	if err != nil {
 		s.logger.Error("查询流程实例列表失败", zap.Error(err))
-		return nil, WrapError(err, ErrCodeInternalError, "查询流程实例列表失败")
+		return nil, wrapAccessError(err, ErrCodeInternalError, "查询流程实例列表失败")
 	}
 
 	s.logger.Info("服务层: 查询流程实例列表成功",
@@ -113,7 +114,7 @@ func (s *ProcessInstanceService) SuspendProcessInstance(ctx context.Context, id
 	err := s.uc.SuspendProcessInstance(ctx, id)
 	if err != nil {
 		s.logger.Error("挂起流程实例失败", zap.String("id", id), zap.Error(err))
-		return WrapError(err, ErrCodeInternalError, "挂起流程实例失败")
+		return wrapAccessError(err, ErrCodeInternalError, "挂起流程实例失败")
 	}
 
 	s.logger.Info("服务层: 挂起流程实例成功", zap.String("id", id))
@@ -133,7 +134,7 @@ func (s *ProcessInstanceService) ActivateProcessInstance(ctx context.Context, id
 	err := s.uc.ActivateProcessInstance(ctx, id)
 	if err != nil {
 		s.logger.Error("激活流程实例失败", zap.String("id", id), zap.Error(err))
-		return WrapError(err, ErrCodeInternalError, "激活流程实例失败")
+		return wrapAccessError(err, ErrCodeInternalError, "激活流程实例失败")
 	}
 
 	s.logger.Info("服务层: 激活流程实例成功", zap.String("id", id))
@@ -155,13 +156,41 @@ func (s *ProcessInstanceService) TerminateProcessInstance(ctx context.Context, i
 	err := s.uc.TerminateProcessInstance(ctx, id, reason)
 	if err != nil {
 		s.logger.Error("终止流程实例失败", zap.String("id", id), zap.Error(err))
-		return WrapError(err, ErrCodeInternalError, "终止流程实例失败")
+		return wrapAccessError(err, ErrCodeInternalError, "终止流程实例失败")
 	}
 
 	s.logger.Info("服务层: 终止流程实例成功", zap.String("id", id))
 	return nil
 }
 
+// MigrateProcessInstance 迁移流程实例
+// 将运行中的流程实例迁移到同一流程的其他版本
+func (s *ProcessInstanceService) MigrateProcessInstance(ctx context.Context, id string, req *biz.MigrateProcessInstanceRequest) error {
+	s.logger.Info("服务层: 迁移流程实例",
+		zap.String("id", id),
+		zap.String("process_definition_id", req.ProcessDefinitionID))
+
+	if id == "" {
+		s.logger.Error("流程实例ID不能为空")
+		return NewServiceError(ErrCodeBadRequest, "流程实例ID不能为空")
+	}
+	if req.ProcessDefinitionID == "" {
+		return NewServiceError(ErrCodeBadRequest, "目标流程定义ID不能为空")
+	}
+
+	err := s.uc.MigrateProcessInstance(ctx, id, req)
+	if err != nil {
+		s.logger.Error("迁移流程实例失败", zap.String("id", id), zap.Error(err))
+		if errors.Is(err, biz.ErrInvalidMigration) {
+			return WrapError(err, ErrCodeBadRequest, err.Error())
+		}
+		return wrapAccessError(err, ErrCodeInternalError, "迁移流程实例失败")
+	}
+
+	s.logger.Info("服务层: 迁移流程实例成功", zap.String("id", id))
+	return nil
+}
+
 // DeleteProcessInstance 删除流程实例
 // 删除指定的流程实例
 func (s *ProcessInstanceService) DeleteProcessInstance(ctx context.Context, id string, reason string) error {
@@ -177,7 +206,7 @@ func (s *ProcessInstanceService) DeleteProcessInstance(ctx context.Context, id s
 	err := s.uc.DeleteProcessInstance(ctx, id, reason)
 	if err != nil {
 		s.logger.Error("删除流程实例失败", zap.String("id", id), zap.Error(err))
-		return WrapError(err, ErrCodeInternalError, "删除流程实例失败")
+		return wrapAccessError(err, ErrCodeInternalError, "删除流程实例失败")
 	}
 
 	s.logger.Info("服务层: 删除流程实例成功", zap.String("id", id))
@@ -206,7 +235,7 @@ func (s *ProcessInstanceService) GetProcessVariable(ctx context.Context, instanc
 			zap.String("instance_id", instanceID),
 			zap.String("variable_name", variableName),
 			zap.Error(err))
-		return nil, WrapError(err, ErrCodeNotFound, "流程变量不存在")
+		return nil, wrapAccessError(err, ErrCodeNotFound, "流程变量不存在")
 	}
 
 	return result, nil
@@ -234,7 +263,7 @@ func (s *ProcessInstanceService) SetProcessVariable(ctx context.Context, instanc
 			zap.String("instance_id", instanceID),
 			zap.String("variable_name", variableName),
 			zap.Error(err))
-		return WrapError(err, ErrCodeInternalError, "设置流程变量失败")
+		return wrapAccessError(err, ErrCodeInternalError, "设置流程变量失败")
 	}
 
 	s.logger.Info("服务层: 设置流程变量成功",
@@ -258,7 +287,7 @@ func (s *ProcessInstanceService) GetProcessVariables(ctx context.Context, instan
 		s.logger.Error("获取流程变量列表失败",
 			zap.String("instance_id", instanceID),
 			zap.Error(err))
-		return nil, WrapError(err, ErrCodeInternalError, "获取流程变量列表失败")
+		return nil, wrapAccessError(err, ErrCodeInternalError, "获取流程变量列表失败")
 	}
 
 	s.logger.Debug("服务层: 获取流程变量列表成功",
@@ -288,7 +317,7 @@ func (s *ProcessInstanceService) SetProcessVariables(ctx context.Context, instan
 		s.logger.Error("批量设置流程变量失败",
 			zap.String("instance_id", instanceID),
 			zap.Error(err))
-		return WrapError(err, ErrCodeInternalError, "批量设置流程变量失败")
+		return wrapAccessError(err, ErrCodeInternalError, "批量设置流程变量失败")
 	}
 
 	s.logger.Info("服务层: 批量设置流程变量成功",
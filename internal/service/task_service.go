@@ -4,6 +4,7 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"go.uber.org/zap"
 
@@ -41,7 +42,7 @@ func (s *TaskInstanceService) GetTaskInstance(ctx context.Context, id string) (*
 	result, err := s.uc.GetTaskInstance(ctx, id)
 	if err != nil {
 		s.logger.Error("获取任务实例失败", zap.String("id", id), zap.Error(err))
-		return nil, WrapError(err, ErrCodeNotFound, "任务实例不存在")
+		return nil, wrapAccessError(err, ErrCodeNotFound, "任务实例不存在")
 	}
 
 	return result, nil
@@ -62,11 +63,14 @@ func (s *TaskInstanceService) ListTaskInstances(ctx context.Context, req *biz.Li
 	if req.PageSize > 100 {
 		req.PageSize = 100 // 限制最大页面大小
 	}
+	if req.Status != "" && !biz.IsTaskStatus(req.Status) {
+		return nil, NewServiceError(ErrCodeBadRequest, "无效的任务状态")
+	}
 
 	result, err := s.uc.ListTaskInstances(ctx, req)
 	if err != nil {
 		s.logger.Error("查询任务实例列表失败", zap.Error(err))
-		return nil, WrapError(err, ErrCodeInternalError, "查询任务实例列表失败")
+		return nil, wrapAccessError(err, ErrCodeInternalError, "查询任务实例列表失败")
 	}
 
 	s.logger.Info("服务层: 查询任务实例列表成功",
@@ -76,8 +80,8 @@ func (s *TaskInstanceService) ListTaskInstances(ctx context.Context, req *biz.Li
 }
 
 // ClaimTask 认领任务
-// 用户认领指定的任务
-func (s *TaskInstanceService) ClaimTask(ctx context.Context, taskID string, userID string) error {
+// 用户认领指定的任务，groups 为认领用户所属的用户组，用于校验候选组
+func (s *TaskInstanceService) ClaimTask(ctx context.Context, taskID string, userID string, groups []string) error {
 	s.logger.Info("服务层: 认领任务",
 		zap.String("task_id", taskID),
 		zap.String("user_id", userID))
@@ -92,7 +96,8 @@ func (s *TaskInstanceService) ClaimTask(ctx context.Context, taskID string, user
 	}
 
 	req := &biz.ClaimTaskRequest{
-		AssigneeID: userID,
+		AssigneeID:      userID,
+		CandidateGroups: groups,
 	}
 
 	err := s.uc.ClaimTask(ctx, taskID, req)
@@ -101,7 +106,7 @@ func (s *TaskInstanceService) ClaimTask(ctx context.Context, taskID string, user
 			zap.String("task_id", taskID),
 			zap.String("user_id", userID),
 			zap.Error(err))
-		return WrapError(err, ErrCodeInternalError, "认领任务失败")
+		return wrapTaskError(err, ErrCodeInternalError, "认领任务失败")
 	}
 
 	s.logger.Info("服务层: 认领任务成功",
@@ -131,7 +136,7 @@ func (s *TaskInstanceService) CompleteTask(ctx context.Context, taskID string, v
 		s.logger.Error("完成任务失败",
 			zap.String("task_id", taskID),
 			zap.Error(err))
-		return WrapError(err, ErrCodeInternalError, "完成任务失败")
+		return wrapTaskError(err, ErrCodeInternalError, "完成任务失败")
 	}
 
 	s.logger.Info("服务层: 完成任务成功", zap.String("task_id", taskID))
@@ -165,7 +170,7 @@ func (s *TaskInstanceService) DelegateTask(ctx context.Context, taskID string, d
 			zap.String("task_id", taskID),
 			zap.String("delegate_id", delegateID),
 			zap.Error(err))
-		return WrapError(err, ErrCodeInternalError, "委派任务失败")
+		return wrapTaskError(err, ErrCodeInternalError, "委派任务失败")
 	}
 
 	s.logger.Info("服务层: 委派任务成功",
@@ -174,6 +179,304 @@ func (s *TaskInstanceService) DelegateTask(ctx context.Context, taskID string, d
 	return nil
 }
 
+// ResolveTask 交还委派任务
+// 被委派人处理完成后将任务交还拥有者，variables 为被委派人填写的任务变量
+func (s *TaskInstanceService) ResolveTask(ctx context.Context, taskID string, variables map[string]interface{}, comment string) error {
+	s.logger.Info("服务层: 交还委派任务",
+		zap.String("task_id", taskID))
+
+	if taskID == "" {
+		s.logger.Error("任务ID不能为空")
+		return NewServiceError(ErrCodeBadRequest, "任务ID不能为空")
+	}
+
+	req := &biz.ResolveTaskRequest{
+		Variables: variables,
+		Comment:   comment,
+	}
+
+	err := s.uc.ResolveTask(ctx, taskID, req)
+	if err != nil {
+		s.logger.Error("交还委派任务失败",
+			zap.String("task_id", taskID),
+			zap.Error(err))
+		return wrapTaskError(err, ErrCodeInternalError, "交还委派任务失败")
+	}
+
+	s.logger.Info("服务层: 交还委派任务成功", zap.String("task_id", taskID))
+	return nil
+}
+
+// ListTaskEvents 查询任务办理记录
+// 默认按发生顺序返回认领、委派、交还与完成记录
+func (s *TaskInstanceService) ListTaskEvents(ctx context.Context, taskID string, req *biz.ListTaskEventsRequest) (*biz.ListTaskEventsResponse, error) {
+	s.logger.Debug("服务层: 查询任务办理记录", zap.String("task_id", taskID))
+
+	if taskID == "" {
+		s.logger.Error("任务ID不能为空")
+		return nil, NewServiceError(ErrCodeBadRequest, "任务ID不能为空")
+	}
+
+	// 参数验证和默认值设置
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+	if req.Order == "" {
+		req.Order = "asc"
+	}
+
+	result, err := s.uc.ListTaskEvents(ctx, taskID, req)
+	if err != nil {
+		s.logger.Error("查询任务办理记录失败", zap.String("task_id", taskID), zap.Error(err))
+		return nil, wrapTaskError(err, ErrCodeInternalError, "查询任务办理记录失败")
+	}
+
+	return result, nil
+}
+
+// CreateSubtask 创建子任务
+func (s *TaskInstanceService) CreateSubtask(ctx context.Context, parentID string, req *biz.CreateSubtaskRequest) (*biz.TaskInstanceResponse, error) {
+	s.logger.Info("服务层: 创建子任务", zap.String("parent_id", parentID), zap.String("name", req.Name))
+
+	if parentID == "" {
+		s.logger.Error("任务ID不能为空")
+		return nil, NewServiceError(ErrCodeBadRequest, "任务ID不能为空")
+	}
+	if req.Name == "" {
+		return nil, NewServiceError(ErrCodeValidationError, "子任务名称不能为空")
+	}
+
+	result, err := s.uc.CreateSubtask(ctx, parentID, req)
+	if err != nil {
+		s.logger.Error("创建子任务失败", zap.String("parent_id", parentID), zap.Error(err))
+		return nil, wrapTaskError(err, ErrCodeInternalError, "创建子任务失败")
+	}
+
+	s.logger.Info("服务层: 创建子任务成功", zap.String("parent_id", parentID), zap.String("id", result.ID))
+	return result, nil
+}
+
+// ListSubtasks 查询任务的子任务树
+func (s *TaskInstanceService) ListSubtasks(ctx context.Context, taskID string) ([]*biz.TaskInstanceResponse, error) {
+	s.logger.Debug("服务层: 查询子任务", zap.String("task_id", taskID))
+
+	if taskID == "" {
+		s.logger.Error("任务ID不能为空")
+		return nil, NewServiceError(ErrCodeBadRequest, "任务ID不能为空")
+	}
+
+	result, err := s.uc.ListSubtasks(ctx, taskID)
+	if err != nil {
+		s.logger.Error("查询子任务失败", zap.String("task_id", taskID), zap.Error(err))
+		return nil, wrapTaskError(err, ErrCodeInternalError, "查询子任务失败")
+	}
+
+	return result, nil
+}
+
+// UpdateTask 修改任务信息
+// 只修改请求中设置的名称、描述、优先级、到期时间与分类
+func (s *TaskInstanceService) UpdateTask(ctx context.Context, taskID string, req *biz.UpdateTaskRequest) (*biz.TaskInstanceResponse, error) {
+	s.logger.Info("服务层: 修改任务", zap.String("task_id", taskID))
+
+	if taskID == "" {
+		s.logger.Error("任务ID不能为空")
+		return nil, NewServiceError(ErrCodeBadRequest, "任务ID不能为空")
+	}
+	if req.Name != nil && *req.Name == "" {
+		return nil, NewServiceError(ErrCodeValidationError, "任务名称不能为空")
+	}
+
+	result, err := s.uc.UpdateTask(ctx, taskID, req)
+	if err != nil {
+		s.logger.Error("修改任务失败", zap.String("task_id", taskID), zap.Error(err))
+		return nil, wrapTaskError(err, ErrCodeInternalError, "修改任务失败")
+	}
+
+	s.logger.Info("服务层: 修改任务成功", zap.String("task_id", taskID))
+	return result, nil
+}
+
+// AddComment 添加任务评论
+func (s *TaskInstanceService) AddComment(ctx context.Context, taskID string, message string) (*biz.TaskCommentResponse, error) {
+	s.logger.Info("服务层: 添加任务评论", zap.String("task_id", taskID))
+
+	if taskID == "" {
+		s.logger.Error("任务ID不能为空")
+		return nil, NewServiceError(ErrCodeBadRequest, "任务ID不能为空")
+	}
+	if message == "" {
+		return nil, NewServiceError(ErrCodeValidationError, "评论内容不能为空")
+	}
+
+	result, err := s.uc.AddComment(ctx, taskID, &biz.AddTaskCommentRequest{Message: message})
+	if err != nil {
+		s.logger.Error("添加任务评论失败", zap.String("task_id", taskID), zap.Error(err))
+		return nil, wrapTaskError(err, ErrCodeInternalError, "添加任务评论失败")
+	}
+
+	return result, nil
+}
+
+// ListComments 查询任务评论
+// 默认最新的评论在前，包含完成、委派与交还任务时填写的备注
+func (s *TaskInstanceService) ListComments(ctx context.Context, taskID string, req *biz.ListTaskCommentsRequest) (*biz.ListTaskCommentsResponse, error) {
+	s.logger.Debug("服务层: 查询任务评论", zap.String("task_id", taskID))
+
+	if taskID == "" {
+		s.logger.Error("任务ID不能为空")
+		return nil, NewServiceError(ErrCodeBadRequest, "任务ID不能为空")
+	}
+
+	// 参数验证和默认值设置
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	result, err := s.uc.ListComments(ctx, taskID, req)
+	if err != nil {
+		s.logger.Error("查询任务评论失败", zap.String("task_id", taskID), zap.Error(err))
+		return nil, wrapTaskError(err, ErrCodeInternalError, "查询任务评论失败")
+	}
+
+	return result, nil
+}
+
+// AddAttachment 上传任务附件
+func (s *TaskInstanceService) AddAttachment(ctx context.Context, taskID string, req *biz.AddTaskAttachmentRequest) (*biz.TaskAttachmentResponse, error) {
+	s.logger.Info("服务层: 上传任务附件", zap.String("task_id", taskID), zap.String("name", req.Name))
+
+	if taskID == "" {
+		s.logger.Error("任务ID不能为空")
+		return nil, NewServiceError(ErrCodeBadRequest, "任务ID不能为空")
+	}
+	if req.Name == "" {
+		return nil, NewServiceError(ErrCodeValidationError, "附件文件名不能为空")
+	}
+
+	result, err := s.uc.AddAttachment(ctx, taskID, req)
+	if err != nil {
+		s.logger.Error("上传任务附件失败", zap.String("task_id", taskID), zap.Error(err))
+		return nil, wrapTaskError(err, ErrCodeInternalError, "上传任务附件失败")
+	}
+
+	s.logger.Info("服务层: 上传任务附件成功", zap.String("task_id", taskID), zap.String("attachment_id", result.ID))
+	return result, nil
+}
+
+// ListAttachments 查询任务附件
+// 默认最新上传的附件在前，不返回文件内容
+func (s *TaskInstanceService) ListAttachments(ctx context.Context, taskID string, req *biz.ListTaskAttachmentsRequest) (*biz.ListTaskAttachmentsResponse, error) {
+	s.logger.Debug("服务层: 查询任务附件", zap.String("task_id", taskID))
+
+	if taskID == "" {
+		s.logger.Error("任务ID不能为空")
+		return nil, NewServiceError(ErrCodeBadRequest, "任务ID不能为空")
+	}
+
+	// 参数验证和默认值设置
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+
+	result, err := s.uc.ListAttachments(ctx, taskID, req)
+	if err != nil {
+		s.logger.Error("查询任务附件失败", zap.String("task_id", taskID), zap.Error(err))
+		return nil, wrapTaskError(err, ErrCodeInternalError, "查询任务附件失败")
+	}
+
+	return result, nil
+}
+
+// GetAttachmentContent 下载任务附件
+func (s *TaskInstanceService) GetAttachmentContent(ctx context.Context, taskID string, attachmentID string) (*biz.TaskAttachmentContent, error) {
+	s.logger.Debug("服务层: 下载任务附件", zap.String("task_id", taskID), zap.String("attachment_id", attachmentID))
+
+	if taskID == "" || attachmentID == "" {
+		s.logger.Error("任务ID与附件ID不能为空")
+		return nil, NewServiceError(ErrCodeBadRequest, "任务ID与附件ID不能为空")
+	}
+
+	result, err := s.uc.GetAttachmentContent(ctx, taskID, attachmentID)
+	if err != nil {
+		s.logger.Error("下载任务附件失败",
+			zap.String("task_id", taskID),
+			zap.String("attachment_id", attachmentID),
+			zap.Error(err))
+		return nil, wrapTaskError(err, ErrCodeInternalError, "下载任务附件失败")
+	}
+
+	return result, nil
+}
+
+// BulkTasks 批量操作任务
+// 请求参数错误时整个请求失败；单个任务处理失败时在该任务的结果中返回错误码，其他任务照常处理
+func (s *TaskInstanceService) BulkTasks(ctx context.Context, req *biz.BulkTaskRequest) (*biz.BulkTaskResponse, error) {
+	s.logger.Info("服务层: 批量操作任务",
+		zap.String("action", req.Action),
+		zap.Int("task_ids", len(req.TaskIDs)))
+
+	switch req.Action {
+	case biz.BulkTaskClaim, biz.BulkTaskUnclaim, biz.BulkTaskComplete:
+	case biz.BulkTaskReassign:
+		if req.AssigneeID == "" {
+			return nil, NewServiceError(ErrCodeValidationError, "转交任务时办理人不能为空")
+		}
+	case biz.BulkTaskSetPriority:
+		if req.Priority == nil {
+			return nil, NewServiceError(ErrCodeValidationError, "修改优先级时优先级不能为空")
+		}
+	case biz.BulkTaskSetDueDate:
+		if req.DueDate == nil {
+			return nil, NewServiceError(ErrCodeValidationError, "修改到期时间时到期时间不能为空")
+		}
+	default:
+		return nil, NewServiceError(ErrCodeValidationError, "不支持的批量操作")
+	}
+	if len(req.TaskIDs) == 0 && req.Filter == nil {
+		return nil, NewServiceError(ErrCodeValidationError, "请指定任务ID列表或过滤条件")
+	}
+	if req.Filter != nil && req.Filter.Status != "" && !biz.IsTaskStatus(req.Filter.Status) {
+		return nil, NewServiceError(ErrCodeBadRequest, "无效的任务状态")
+	}
+
+	result, err := s.uc.BulkTasks(ctx, req)
+	if err != nil {
+		s.logger.Error("批量操作任务失败", zap.String("action", req.Action), zap.Error(err))
+		return nil, wrapTaskError(err, ErrCodeInternalError, "批量操作任务失败")
+	}
+
+	for _, item := range result.Items {
+		if item.Err != nil {
+			item.Code = taskErrorCode(item.Err, ErrCodeInternalError)
+		}
+	}
+
+	s.logger.Info("服务层: 批量操作任务完成",
+		zap.String("action", req.Action),
+		zap.Int("succeeded", result.Succeeded),
+		zap.Int("failed", result.Failed))
+	return result, nil
+}
+
 // GetMyTasks 获取我的任务列表
 // 获取当前用户的任务列表
 func (s *TaskInstanceService) GetMyTasks(ctx context.Context, req *biz.ListTaskInstancesRequest) (*biz.ListTaskInstancesResponse, error) {
@@ -189,6 +492,9 @@ func (s *TaskInstanceService) GetMyTasks(ctx context.Context, req *biz.ListTaskI
 	if req.PageSize > 100 {
 		req.PageSize = 100
 	}
+	if req.Status != "" && !biz.IsTaskStatus(req.Status) {
+		return nil, NewServiceError(ErrCodeBadRequest, "无效的任务状态")
+	}
 
 	result, err := s.uc.GetMyTasks(ctx, req)
 	if err != nil {
@@ -227,3 +533,43 @@ func (s *TaskInstanceService) GetAvailableTasks(ctx context.Context, req *biz.Li
 		zap.Int("total", result.Pagination.Total))
 	return result, nil
 }
+
+// taskErrorCodes 任务业务错误对应的错误码
+var taskErrorCodes = []struct {
+	err  error
+	code int
+}{
+	{biz.ErrTaskNotFound, ErrCodeTaskNotFound},
+	{biz.ErrTaskAlreadyClaimed, ErrCodeTaskAlreadyClaimed},
+	{biz.ErrTaskNotAssigned, ErrCodeTaskNotAssigned},
+	{biz.ErrTaskNotCandidate, ErrCodeTaskNotCandidate},
+	{biz.ErrTaskNotAssignee, ErrCodeTaskNotAssignee},
+	{biz.ErrTaskCompleted, ErrCodeTaskAlreadyCompleted},
+	{biz.ErrTaskCancelled, ErrCodeTaskCancelled},
+	{biz.ErrInvalidTaskTransition, ErrCodeInvalidTaskState},
+	{biz.ErrTaskHasOpenSubtasks, ErrCodeTaskHasOpenSubtasks},
+	{biz.ErrTaskAttachmentNotFound, ErrCodeNotFound},
+	{biz.ErrTaskAttachmentTooLarge, ErrCodeValidationError},
+	{biz.ErrBulkTaskLimitExceeded, ErrCodeValidationError},
+	{biz.ErrAccessDenied, ErrCodeForbidden},
+}
+
+// wrapTaskError 将任务业务错误包装为对应错误码的服务层错误，其他错误使用给定的错误码
+func wrapTaskError(err error, code int, message string) *ServiceError {
+	for _, c := range taskErrorCodes {
+		if errors.Is(err, c.err) {
+			return WrapError(err, c.code, GetErrorMessage(c.code))
+		}
+	}
+	return WrapError(err, code, message)
+}
+
+// taskErrorCode 返回任务业务错误对应的错误码，其他错误返回给定的错误码
+func taskErrorCode(err error, code int) int {
+	for _, c := range taskErrorCodes {
+		if errors.Is(err, c.err) {
+			return c.code
+		}
+	}
+	return code
+}
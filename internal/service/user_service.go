@@ -0,0 +1,152 @@
+// Package service 用户管理服务实现
+// 处理用户、角色与用户组管理相关的API请求并调用业务逻辑
+package service
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+)
+
+// UserService 用户管理服务
+// 负责处理用户、角色与用户组管理的API请求，连接HTTP层与业务逻辑层
+type UserService struct {
+	uc     *biz.UserUseCase
+	logger *zap.Logger
+}
+
+// NewUserService 创建用户管理服务
+func NewUserService(uc *biz.UserUseCase, logger *zap.Logger) *UserService {
+	return &UserService{
+		uc:     uc,
+		logger: logger,
+	}
+}
+
+// CreateUser 创建用户
+func (s *UserService) CreateUser(ctx context.Context, req *biz.CreateUserRequest) (*biz.UserResponse, error) {
+	if req.Username == "" {
+		return nil, NewServiceError(ErrCodeBadRequest, "用户名不能为空")
+	}
+	if req.Password == "" {
+		return nil, NewServiceError(ErrCodeBadRequest, "初始密码不能为空")
+	}
+
+	resp, err := s.uc.CreateUser(ctx, req)
+	if err != nil {
+		s.logger.Error("创建用户失败", zap.String("username", req.Username), zap.Error(err))
+		return nil, wrapAuthError(err, ErrCodeInternalError, "创建用户失败")
+	}
+	return resp, nil
+}
+
+// GetUser 获取用户
+func (s *UserService) GetUser(ctx context.Context, id string) (*biz.UserResponse, error) {
+	if id == "" {
+		return nil, NewServiceError(ErrCodeBadRequest, "用户ID不能为空")
+	}
+
+	resp, err := s.uc.GetUser(ctx, id)
+	if err != nil {
+		return nil, wrapAuthError(err, ErrCodeInternalError, "获取用户失败")
+	}
+	return resp, nil
+}
+
+// ListUsers 分页查询用户
+func (s *UserService) ListUsers(ctx context.Context, req *biz.ListUsersRequest) (*biz.ListUsersResponse, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 20
+	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
+	if req.Status != "" && req.Status != biz.UserStatusActive && req.Status != biz.UserStatusDisabled {
+		return nil, NewServiceErrorWithDetails(ErrCodeBadRequest, "无效的账号状态", req.Status)
+	}
+
+	resp, err := s.uc.ListUsers(ctx, req)
+	if err != nil {
+		return nil, wrapAuthError(err, ErrCodeInternalError, "查询用户列表失败")
+	}
+	return resp, nil
+}
+
+// UpdateUser 更新用户
+func (s *UserService) UpdateUser(ctx context.Context, id string, req *biz.UpdateUserRequest) (*biz.UserResponse, error) {
+	if id == "" {
+		return nil, NewServiceError(ErrCodeBadRequest, "用户ID不能为空")
+	}
+	if req.Status != nil && *req.Status != biz.UserStatusActive && *req.Status != biz.UserStatusDisabled {
+		return nil, NewServiceErrorWithDetails(ErrCodeBadRequest, "无效的账号状态", *req.Status)
+	}
+
+	resp, err := s.uc.UpdateUser(ctx, id, req)
+	if err != nil {
+		s.logger.Error("更新用户失败", zap.String("id", id), zap.Error(err))
+		return nil, wrapAuthError(err, ErrCodeInternalError, "更新用户失败")
+	}
+	return resp, nil
+}
+
+// CreateRole 创建角色
+func (s *UserService) CreateRole(ctx context.Context, req *biz.CreateRoleRequest) (*biz.RoleResponse, error) {
+	if req.Name == "" {
+		return nil, NewServiceError(ErrCodeBadRequest, "角色名称不能为空")
+	}
+
+	resp, err := s.uc.CreateRole(ctx, req)
+	if err != nil {
+		return nil, wrapAuthError(err, ErrCodeInternalError, "创建角色失败")
+	}
+	return resp, nil
+}
+
+// UpdateRole 更新角色
+func (s *UserService) UpdateRole(ctx context.Context, name string, req *biz.UpdateRoleRequest) (*biz.RoleResponse, error) {
+	if name == "" {
+		return nil, NewServiceError(ErrCodeBadRequest, "角色名称不能为空")
+	}
+
+	resp, err := s.uc.UpdateRole(ctx, name, req)
+	if err != nil {
+		return nil, wrapAuthError(err, ErrCodeInternalError, "更新角色失败")
+	}
+	return resp, nil
+}
+
+// ListRoles 查询全部角色
+func (s *UserService) ListRoles(ctx context.Context) ([]*biz.RoleResponse, error) {
+	resp, err := s.uc.ListRoles(ctx)
+	if err != nil {
+		return nil, wrapAuthError(err, ErrCodeInternalError, "查询角色列表失败")
+	}
+	return resp, nil
+}
+
+// CreateGroup 创建用户组
+func (s *UserService) CreateGroup(ctx context.Context, req *biz.CreateGroupRequest) (*biz.GroupResponse, error) {
+	if req.Name == "" {
+		return nil, NewServiceError(ErrCodeBadRequest, "用户组名称不能为空")
+	}
+
+	resp, err := s.uc.CreateGroup(ctx, req)
+	if err != nil {
+		return nil, wrapAuthError(err, ErrCodeInternalError, "创建用户组失败")
+	}
+	return resp, nil
+}
+
+// ListGroups 查询全部用户组
+func (s *UserService) ListGroups(ctx context.Context) ([]*biz.GroupResponse, error) {
+	resp, err := s.uc.ListGroups(ctx)
+	if err != nil {
+		return nil, wrapAuthError(err, ErrCodeInternalError, "查询用户组列表失败")
+	}
+	return resp, nil
+}
@@ -26,12 +26,6 @@ type SendNotificationInput struct {
 	Data      map[string]interface{} `json:"data"`
 }
 
-// UpdateStatusInput 更新状态活动输入
-type UpdateStatusInput struct {
-	ProcessInstanceID int64  `json:"process_instance_id"`
-	Status            string `json:"status"`
-}
-
 // ApprovalInput 审批活动输入
 type ApprovalInput struct {
 	RequestID string                 `json:"request_id"`
@@ -111,18 +105,6 @@ func SendNotificationActivity(ctx context.Context, input SendNotificationInput)
 	return nil
 }
 
-// UpdateStatusActivity 更新状态活动
-func UpdateStatusActivity(ctx context.Context, input UpdateStatusInput) error {
-	log.Printf("更新流程实例状态，ID: %d，状态: %s", input.ProcessInstanceID, input.Status)
-
-	// 模拟更新数据库状态
-	// 实际实现中应该调用数据库服务更新流程实例状态
-	// 例如：processInstanceRepo.UpdateStatus(input.ProcessInstanceID, input.Status)
-
-	log.Printf("状态更新成功")
-	return nil
-}
-
 // ApprovalActivity 审批活动
 func ApprovalActivity(ctx context.Context, input ApprovalInput) (*ApprovalResult, error) {
 	log.Printf("执行审批活动，请求ID: %s，审批人: %s", input.RequestID, input.Approver)
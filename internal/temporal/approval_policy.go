@@ -0,0 +1,356 @@
+package temporal
+
+import (
+	"fmt"
+	"time"
+)
+
+// 审批工作流信号、更新与查询名称
+const (
+	SignalApproval     = "approval"       // 审批投票信号，决议后到达的投票被记录为迟到投票
+	UpdateApprovalVote = "approval_vote"  // 审批投票更新，重复、越权或决议后的投票被拒绝
+	QueryApprovalState = "approval_state" // 审批状态查询
+)
+
+// 审批投票策略
+const (
+	ApprovalPolicyUnanimous      = "unanimous"       // 全部同意才通过，任一拒绝即驳回
+	ApprovalPolicyAny            = "any"             // 任一同意即通过，全部拒绝才驳回
+	ApprovalPolicyMajority       = "majority"        // 同意人数超过审批人半数通过
+	ApprovalPolicyWeighted       = "weighted"        // 同意权重占总权重达到阈值通过
+	ApprovalPolicyQuorum         = "quorum"          // 投票人数达到法定人数后按已投票多数决定
+	ApprovalPolicyFirstRejection = "first_rejection" // 首个拒绝即驳回，截止时无人拒绝视为通过
+)
+
+// 审批状态
+const (
+	ApprovalStatusPending  = "pending"  // 等待投票
+	ApprovalStatusApproved = "approved" // 已通过
+	ApprovalStatusRejected = "rejected" // 已驳回
+	ApprovalStatusTimeout  = "timeout"  // 截止时仍未形成决议
+)
+
+// defaultApprovalThreshold 加权投票默认的通过阈值
+const defaultApprovalThreshold = 0.5
+
+// ApprovalVote 一次审批投票
+type ApprovalVote struct {
+	Approver string    `json:"approver"`
+	Approved bool      `json:"approved"`
+	Comments string    `json:"comments"`
+	Weight   float64   `json:"weight"`
+	Time     time.Time `json:"time"`
+}
+
+// ApprovalState 审批运行状态，供查询处理器返回
+type ApprovalState struct {
+	RequestID      string         `json:"request_id"`
+	Policy         string         `json:"policy"`
+	Status         string         `json:"status"`
+	Approved       bool           `json:"approved"`
+	Approvers      []string       `json:"approvers"`
+	Pending        []string       `json:"pending"` // 尚未投票的审批人
+	Votes          []ApprovalVote `json:"votes"`   // 按到达顺序记录的有效投票
+	LateVotes      []ApprovalVote `json:"late_votes"`
+	Approvals      int            `json:"approvals"`
+	Rejections     int            `json:"rejections"`
+	ApproveWeight  float64        `json:"approve_weight"`
+	RejectWeight   float64        `json:"reject_weight"`
+	TotalWeight    float64        `json:"total_weight"`
+	DecidedAt      *time.Time     `json:"decided_at,omitempty"`
+	QuorumRequired int            `json:"quorum_required,omitempty"`
+}
+
+// approvalPolicy 审批投票策略
+// decide 在每次投票后调用，返回是否已形成决议及是否通过；
+// expire 在截止时间到达且仍未形成决议时调用，返回最终状态及是否通过
+type approvalPolicy interface {
+	decide(s *ApprovalState) (decided, approved bool)
+	expire(s *ApprovalState) (status string, approved bool)
+}
+
+// newApprovalPolicy 按输入创建投票策略
+// 未指定策略时沿用 RequireAll：为 true 时全体一致，否则任一同意
+func newApprovalPolicy(input ApprovalWorkflowInput) (string, approvalPolicy, error) {
+	name := input.Policy
+	if name == "" {
+		if input.RequireAll {
+			name = ApprovalPolicyUnanimous
+		} else {
+			name = ApprovalPolicyAny
+		}
+	}
+
+	switch name {
+	case ApprovalPolicyUnanimous:
+		return name, unanimousPolicy{}, nil
+	case ApprovalPolicyAny:
+		return name, anyPolicy{}, nil
+	case ApprovalPolicyMajority:
+		return name, majorityPolicy{}, nil
+	case ApprovalPolicyWeighted:
+		threshold := input.Threshold
+		if threshold == 0 {
+			threshold = defaultApprovalThreshold
+		}
+		if threshold < 0 || threshold > 1 {
+			return "", nil, fmt.Errorf("加权投票阈值必须在 0 到 1 之间: %v", input.Threshold)
+		}
+		for approver, weight := range input.Weights {
+			if weight < 0 {
+				return "", nil, fmt.Errorf("审批人 %s 的投票权重不能为负数", approver)
+			}
+		}
+		return name, weightedPolicy{threshold: threshold}, nil
+	case ApprovalPolicyQuorum:
+		if input.Quorum <= 0 || input.Quorum > len(input.Approvers) {
+			return "", nil, fmt.Errorf("法定人数必须在 1 到审批人数 %d 之间: %d", len(input.Approvers), input.Quorum)
+		}
+		return name, quorumPolicy{quorum: input.Quorum}, nil
+	case ApprovalPolicyFirstRejection:
+		return name, firstRejectionPolicy{}, nil
+	default:
+		return "", nil, fmt.Errorf("不支持的审批策略: %s", name)
+	}
+}
+
+// unanimousPolicy 全体一致
+type unanimousPolicy struct{}
+
+func (unanimousPolicy) decide(s *ApprovalState) (bool, bool) {
+	if s.Rejections > 0 {
+		return true, false
+	}
+	return s.Approvals == len(s.Approvers), true
+}
+
+func (unanimousPolicy) expire(s *ApprovalState) (string, bool) {
+	return ApprovalStatusTimeout, false
+}
+
+// anyPolicy 任一同意
+type anyPolicy struct{}
+
+func (anyPolicy) decide(s *ApprovalState) (bool, bool) {
+	if s.Approvals > 0 {
+		return true, true
+	}
+	return s.Rejections == len(s.Approvers), false
+}
+
+func (anyPolicy) expire(s *ApprovalState) (string, bool) {
+	return ApprovalStatusTimeout, false
+}
+
+// majorityPolicy 过半数同意
+// 同意人数超过半数时通过，剩余审批人全部同意也无法过半时驳回
+type majorityPolicy struct{}
+
+func (majorityPolicy) decide(s *ApprovalState) (bool, bool) {
+	half := len(s.Approvers) / 2
+	if s.Approvals > half {
+		return true, true
+	}
+	return s.Approvals+len(s.Pending) <= half, false
+}
+
+func (majorityPolicy) expire(s *ApprovalState) (string, bool) {
+	return ApprovalStatusTimeout, false
+}
+
+// weightedPolicy 加权投票
+// 同意权重达到总权重乘以阈值时通过，剩余权重全部同意也无法达到时驳回
+type weightedPolicy struct {
+	threshold float64
+}
+
+func (p weightedPolicy) decide(s *ApprovalState) (bool, bool) {
+	required := s.TotalWeight * p.threshold
+	if s.ApproveWeight >= required && s.ApproveWeight > 0 {
+		return true, true
+	}
+	remaining := s.TotalWeight - s.ApproveWeight - s.RejectWeight
+	return s.ApproveWeight+remaining < required || len(s.Pending) == 0, false
+}
+
+func (weightedPolicy) expire(s *ApprovalState) (string, bool) {
+	return ApprovalStatusTimeout, false
+}
+
+// quorumPolicy 法定人数
+// 投票人数达到法定人数后按已投票的多数决定，剩余投票无法改变结果时提前决议
+type quorumPolicy struct {
+	quorum int
+}
+
+func (p quorumPolicy) decide(s *ApprovalState) (bool, bool) {
+	if len(s.Votes) < p.quorum {
+		return false, false
+	}
+	remaining := len(s.Pending)
+	if s.Approvals > s.Rejections+remaining {
+		return true, true
+	}
+	if s.Rejections >= s.Approvals+remaining {
+		return true, false
+	}
+	return false, false
+}
+
+func (p quorumPolicy) expire(s *ApprovalState) (string, bool) {
+	if len(s.Votes) < p.quorum {
+		return ApprovalStatusTimeout, false
+	}
+	if s.Approvals > s.Rejections {
+		return ApprovalStatusApproved, true
+	}
+	return ApprovalStatusRejected, false
+}
+
+// firstRejectionPolicy 首个拒绝即驳回
+// 全部同意时提前通过，截止时无人拒绝视为默认同意
+type firstRejectionPolicy struct{}
+
+func (firstRejectionPolicy) decide(s *ApprovalState) (bool, bool) {
+	if s.Rejections > 0 {
+		return true, false
+	}
+	return len(s.Pending) == 0, true
+}
+
+func (firstRejectionPolicy) expire(s *ApprovalState) (string, bool) {
+	return ApprovalStatusApproved, true
+}
+
+// approvalTracker 记录审批投票并按策略判断决议
+type approvalTracker struct {
+	state   *ApprovalState
+	policy  approvalPolicy
+	weights map[string]float64
+}
+
+// newApprovalTracker 创建审批投票记录，审批人去重并按输入顺序保留
+func newApprovalTracker(input ApprovalWorkflowInput, name string, policy approvalPolicy) *approvalTracker {
+	t := &approvalTracker{
+		policy:  policy,
+		weights: make(map[string]float64, len(input.Approvers)),
+		state: &ApprovalState{
+			RequestID: input.RequestID,
+			Policy:    name,
+			Status:    ApprovalStatusPending,
+			Votes:     []ApprovalVote{},
+			LateVotes: []ApprovalVote{},
+		},
+	}
+	if name == ApprovalPolicyQuorum {
+		t.state.QuorumRequired = input.Quorum
+	}
+	for _, approver := range input.Approvers {
+		if _, ok := t.weights[approver]; ok {
+			continue
+		}
+		weight := 1.0
+		if w, ok := input.Weights[approver]; ok && name == ApprovalPolicyWeighted {
+			weight = w
+		}
+		t.weights[approver] = weight
+		t.state.Approvers = append(t.state.Approvers, approver)
+		t.state.TotalWeight += weight
+	}
+	t.state.Pending = append([]string(nil), t.state.Approvers...)
+	return t
+}
+
+// decided 是否已形成决议
+func (t *approvalTracker) decided() bool {
+	return t.state.Status != ApprovalStatusPending
+}
+
+// validate 校验投票，决议后的投票、非审批人投票与重复投票返回错误
+func (t *approvalTracker) validate(vote ApprovalData) error {
+	if t.decided() {
+		return fmt.Errorf("审批已结束，状态为 %s", t.state.Status)
+	}
+	if _, ok := t.weights[vote.Approver]; !ok {
+		return fmt.Errorf("%s 不是该审批的审批人", vote.Approver)
+	}
+	for _, v := range t.state.Votes {
+		if v.Approver == vote.Approver {
+			return fmt.Errorf("%s 已经投过票", vote.Approver)
+		}
+	}
+	return nil
+}
+
+// record 记录一次有效投票并重新计算决议，调用前须通过 validate 校验
+func (t *approvalTracker) record(vote ApprovalData, now time.Time) {
+	weight := t.weights[vote.Approver]
+	s := t.state
+	s.Votes = append(s.Votes, ApprovalVote{
+		Approver: vote.Approver,
+		Approved: vote.Approved,
+		Comments: vote.Comments,
+		Weight:   weight,
+		Time:     now,
+	})
+	if vote.Approved {
+		s.Approvals++
+		s.ApproveWeight += weight
+	} else {
+		s.Rejections++
+		s.RejectWeight += weight
+	}
+	for i, approver := range s.Pending {
+		if approver == vote.Approver {
+			s.Pending = append(s.Pending[:i], s.Pending[i+1:]...)
+			break
+		}
+	}
+
+	if decided, approved := t.policy.decide(s); decided {
+		t.finish(approvalStatus(approved), approved, now)
+	}
+}
+
+// late 记录决议后到达的投票，不参与计票
+func (t *approvalTracker) late(vote ApprovalData, now time.Time) {
+	t.state.LateVotes = append(t.state.LateVotes, ApprovalVote{
+		Approver: vote.Approver,
+		Approved: vote.Approved,
+		Comments: vote.Comments,
+		Weight:   t.weights[vote.Approver],
+		Time:     now,
+	})
+}
+
+// expire 截止时间到达时按策略给出最终结果
+func (t *approvalTracker) expire(now time.Time) {
+	status, approved := t.policy.expire(t.state)
+	t.finish(status, approved, now)
+}
+
+// finish 记录决议
+func (t *approvalTracker) finish(status string, approved bool, now time.Time) {
+	t.state.Status = status
+	t.state.Approved = approved
+	t.state.DecidedAt = &now
+}
+
+// comments 按投票顺序返回有效投票的评论
+func (t *approvalTracker) comments() []string {
+	var comments []string
+	for _, v := range t.state.Votes {
+		if v.Comments != "" {
+			comments = append(comments, v.Comments)
+		}
+	}
+	return comments
+}
+
+// approvalStatus 返回决议对应的审批状态
+func approvalStatus(approved bool) string {
+	if approved {
+		return ApprovalStatusApproved
+	}
+	return ApprovalStatusRejected
+}
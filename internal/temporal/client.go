@@ -2,9 +2,11 @@ package temporal
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/worker"
 
@@ -38,7 +40,8 @@ func NewClient(cfg config.TemporalConfig) (*Client, error) {
 }
 
 // StartWorker 启动Worker
-func (c *Client) StartWorker(ctx context.Context) error {
+// activities 为流程解释器使用的活动集合，包含加载流程定义、执行服务任务与更新流程实例状态等活动
+func (c *Client) StartWorker(ctx context.Context, activities *ProcessActivities) error {
 	// 创建Worker
 	w := worker.New(c.Client, c.config.TaskQueue, worker.Options{})
 
@@ -50,8 +53,8 @@ func (c *Client) StartWorker(ctx context.Context) error {
 	// 注册活动
 	w.RegisterActivity(ValidateDataActivity)
 	w.RegisterActivity(SendNotificationActivity)
-	w.RegisterActivity(UpdateStatusActivity)
 	w.RegisterActivity(ApprovalActivity)
+	w.RegisterActivity(activities)
 
 	log.Printf("启动Temporal Worker, 任务队列: %s", c.config.TaskQueue)
 
@@ -82,6 +85,32 @@ func (c *Client) ExecuteWorkflow(ctx context.Context, workflowID string, workflo
 	return c.Client.ExecuteWorkflow(ctx, options, workflowFunc, input)
 }
 
+// ProcessWorkflowID 根据流程实例ID生成确定性的工作流ID
+func ProcessWorkflowID(processInstanceID int64) string {
+	return fmt.Sprintf("process-instance-%d", processInstanceID)
+}
+
+// StartProcessWorkflow 启动流程工作流并返回运行ID
+// 工作流ID由流程实例ID确定，重复启动同一实例时返回已有运行的ID而不会创建新的执行
+func (c *Client) StartProcessWorkflow(ctx context.Context, input ProcessWorkflowInput) (string, error) {
+	options := client.StartWorkflowOptions{
+		ID:        ProcessWorkflowID(input.ProcessInstanceID),
+		TaskQueue: c.config.TaskQueue,
+	}
+
+	run, err := c.Client.ExecuteWorkflow(ctx, options, ProcessWorkflow, input)
+	if err != nil {
+		return "", fmt.Errorf("启动流程工作流失败: %w", err)
+	}
+	return run.GetRunID(), nil
+}
+
+// IsWorkflowNotFound 判断错误是否表示工作流不存在或已结束
+func IsWorkflowNotFound(err error) bool {
+	var notFound *serviceerror.NotFound
+	return errors.As(err, &notFound)
+}
+
 // GetWorkflowResult 获取工作流执行结果
 func (c *Client) GetWorkflowResult(ctx context.Context, workflowID string, runID string) (interface{}, error) {
 	workflowRun := c.Client.GetWorkflow(ctx, workflowID, runID)
@@ -0,0 +1,375 @@
+package temporal
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/workflow-engine/workflow-engine/internal/expr"
+	"github.com/workflow-engine/workflow-engine/internal/model"
+)
+
+// 多实例事件类型，写入 ProcessEvent 表
+const (
+	EventMultiInstanceStarted   = "MULTI_INSTANCE_STARTED"   // 多实例任务开始，记录实例数
+	EventMultiInstanceCompleted = "MULTI_INSTANCE_COMPLETED" // 多实例任务结束，记录计数与取消的任务
+)
+
+// maxMultiInstances 单个多实例任务最多创建的实例数
+const maxMultiInstances = 1000
+
+// multiInstanceCancelReason 完成条件成立后取消剩余用户任务时记录的原因
+const multiInstanceCancelReason = "多实例完成条件已满足"
+
+// multiInstance 多实例任务的运行状态
+type multiInstance struct {
+	node      *model.Node
+	cfg       *model.MultiInstanceConfig
+	items     []interface{} // 集合元素，按基数创建实例时为 nil
+	total     int           // 实例总数
+	active    int           // 运行中的实例数
+	completed int           // 已完成的实例数
+}
+
+// counters 返回内置计数变量
+func (m *multiInstance) counters() map[string]interface{} {
+	return map[string]interface{}{
+		model.VarNrOfInstances:          m.total,
+		model.VarNrOfActiveInstances:    m.active,
+		model.VarNrOfCompletedInstances: m.completed,
+	}
+}
+
+// runMultiInstance 按集合或基数执行多实例任务
+// 串行时逐个执行实例，并行时同时启动全部实例；每个实例完成后计算完成条件，
+// 条件成立时不再启动后续实例，并行中尚未完成的用户任务被取消、服务任务活动被取消。
+// 实例提交的变量与服务任务返回值合并到流程变量，计数变量与元素变量只在实例内可见
+func (e *processExecutor) runMultiInstance(ctx workflow.Context, x *execution, node *model.Node, cfg *model.MultiInstanceConfig) error {
+	items, total, err := e.multiInstanceItems(node, cfg)
+	if err != nil {
+		return err
+	}
+	m := &multiInstance{node: node, cfg: cfg, items: items, total: total}
+
+	e.logger.Info("多实例任务开始", "node_id", node.ID, "execution_id", x.id, "instances", total, "sequential", cfg.Sequential)
+	e.recordEvent(ctx, EventMultiInstanceStarted, x, node, map[string]interface{}{
+		"instances":  total,
+		"sequential": cfg.Sequential,
+	})
+
+	var cancelled []interface{}
+	var reached bool
+	switch {
+	case total == 0:
+	case cfg.Sequential:
+		reached, err = e.runSequential(ctx, x, m)
+	case node.Type == model.NodeTypeUserTask:
+		reached, cancelled, err = e.runParallelUserTasks(ctx, x, m)
+	default:
+		reached, err = e.runParallelServiceTasks(ctx, x, m)
+	}
+	if err != nil {
+		return err
+	}
+
+	data := m.counters()
+	data["completion_condition_met"] = reached
+	if len(cancelled) > 0 {
+		data["cancelled_tasks"] = cancelled
+	}
+	e.logger.Info("多实例任务结束", "node_id", node.ID, "execution_id", x.id, "completed", m.completed, "instances", total)
+	e.recordEvent(ctx, EventMultiInstanceCompleted, x, node, data)
+	return nil
+}
+
+// runSequential 逐个执行实例，完成条件成立时返回 true
+func (e *processExecutor) runSequential(ctx workflow.Context, x *execution, m *multiInstance) (bool, error) {
+	for i := 0; i < m.total; i++ {
+		if err := e.awaitActive(ctx); err != nil {
+			return false, fmt.Errorf("等待流程恢复失败: %w", err)
+		}
+		m.active = 1
+		child := e.instanceExecution(x, m.node)
+		err := e.runTask(ctx, child, m.node, e.instanceVariables(m, i))
+		delete(e.state.Executions, child.id)
+		if err != nil {
+			return false, err
+		}
+		m.active, m.completed = 0, m.completed+1
+
+		reached, err := e.completionReached(m)
+		if err != nil || reached {
+			return reached, err
+		}
+	}
+	return false, nil
+}
+
+// runParallelUserTasks 同时创建全部实例的用户任务并逐个等待完成
+// 完成条件成立时取消剩余任务，返回条件是否成立与取消的任务ID
+func (e *processExecutor) runParallelUserTasks(ctx workflow.Context, x *execution, m *multiInstance) (bool, []interface{}, error) {
+	m.active = m.total
+	taskIDs := make([]int64, 0, m.total)
+	children := make(map[int64]string, m.total)
+	defer func() {
+		for _, taskID := range taskIDs {
+			e.finishUserTask(taskID)
+			delete(e.state.Executions, children[taskID])
+		}
+	}()
+
+	for i := 0; i < m.total; i++ {
+		child := e.instanceExecution(x, m.node)
+		taskID, cancel, err := e.openUserTask(ctx, child, m.node, e.instanceVariables(m, i))
+		if err != nil {
+			return false, nil, err
+		}
+		defer cancel()
+		taskIDs = append(taskIDs, taskID)
+		children[taskID] = child.id
+	}
+
+	open := taskIDs
+	for len(open) > 0 {
+		var done int64
+		if err := workflow.Await(ctx, func() bool {
+			for _, taskID := range open {
+				if _, ok := e.pending[taskID]; ok {
+					done = taskID
+					return true
+				}
+			}
+			return false
+		}); err != nil {
+			return false, nil, fmt.Errorf("等待用户任务 %s 失败: %w", m.node.ID, err)
+		}
+		e.userTaskCompleted(m.node, done)
+		delete(e.state.Executions, children[done])
+		open = removeTask(open, done)
+		m.active, m.completed = m.active-1, m.completed+1
+
+		reached, err := e.completionReached(m)
+		if err != nil {
+			return false, nil, err
+		}
+		if reached {
+			cancelled, err := e.cancelUserTasks(ctx, m.node, open)
+			m.active = 0
+			return true, cancelled, err
+		}
+	}
+	return false, nil, nil
+}
+
+// runParallelServiceTasks 同时启动全部实例的服务任务活动并按完成顺序处理返回值
+// 完成条件成立时取消尚未完成的活动
+func (e *processExecutor) runParallelServiceTasks(ctx workflow.Context, x *execution, m *multiInstance) (bool, error) {
+	ctx, cancel := workflow.WithCancel(ctx)
+	defer cancel()
+
+	m.active = m.total
+	selector := workflow.NewSelector(ctx)
+	var done workflow.Future
+	for i := 0; i < m.total; i++ {
+		child := e.instanceExecution(x, m.node)
+		defer delete(e.state.Executions, child.id)
+		future, err := e.startServiceTask(ctx, m.node, e.instanceVariables(m, i))
+		if err != nil {
+			return false, err
+		}
+		selector.AddFuture(future, func(f workflow.Future) { done = f })
+	}
+
+	for m.completed < m.total {
+		selector.Select(ctx)
+		if err := e.serviceTaskCompleted(ctx, m.node, done); err != nil {
+			return false, err
+		}
+		m.active, m.completed = m.active-1, m.completed+1
+
+		reached, err := e.completionReached(m)
+		if err != nil {
+			return false, err
+		}
+		if reached {
+			m.active = 0
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// cancelUserTasks 通过活动取消剩余的用户任务，返回取消的任务ID
+// 取消时已被完成的任务不计入结果，其提交的变量不再合并到流程变量
+func (e *processExecutor) cancelUserTasks(ctx workflow.Context, node *model.Node, taskIDs []int64) ([]interface{}, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Second * 30,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+		},
+	})
+
+	var a *ProcessActivities
+	futures := make([]workflow.Future, len(taskIDs))
+	for i, taskID := range taskIDs {
+		futures[i] = workflow.ExecuteActivity(ctx, a.CancelUserTaskActivity, CancelUserTaskInput{
+			ProcessInstanceID: e.input.ProcessInstanceID,
+			NodeID:            node.ID,
+			TaskID:            taskID,
+			Reason:            multiInstanceCancelReason,
+		})
+	}
+
+	var cancelled []interface{}
+	for i, future := range futures {
+		var ok bool
+		if err := future.Get(ctx, &ok); err != nil {
+			return nil, fmt.Errorf("取消用户任务 %s 失败: %w", node.ID, err)
+		}
+		if ok {
+			cancelled = append(cancelled, taskIDs[i])
+		}
+	}
+	return cancelled, nil
+}
+
+// instanceExecution 为多实例任务的一个实例派生子执行
+// 每个实例使用独立的执行ID，创建用户任务时按执行ID去重不会把不同实例当成同一任务
+func (e *processExecutor) instanceExecution(x *execution, node *model.Node) *execution {
+	e.forks[x.id]++
+	child := &execution{
+		id:     fmt.Sprintf("%s.%d", x.id, e.forks[x.id]),
+		scopes: x.scopes,
+	}
+	e.state.Executions[child.id] = node.ID
+	return child
+}
+
+// instanceVariables 返回第 index 个实例可见的变量：流程变量、计数变量、loopCounter 与元素变量
+func (e *processExecutor) instanceVariables(m *multiInstance, index int) map[string]interface{} {
+	vars := make(map[string]interface{}, len(e.variables)+5)
+	for k, v := range e.variables {
+		vars[k] = v
+	}
+	for k, v := range m.counters() {
+		vars[k] = v
+	}
+	vars[model.VarLoopCounter] = index
+	if m.cfg.ElementVariable != "" && m.items != nil {
+		vars[m.cfg.ElementVariable] = m.items[index]
+	}
+	return vars
+}
+
+// completionReached 在流程变量与计数变量上计算完成条件，未配置时返回 false
+func (e *processExecutor) completionReached(m *multiInstance) (bool, error) {
+	if m.cfg.CompletionCondition == "" {
+		return false, nil
+	}
+	vars := make(map[string]interface{}, len(e.variables)+3)
+	for k, v := range e.variables {
+		vars[k] = v
+	}
+	for k, v := range m.counters() {
+		vars[k] = v
+	}
+	ok, err := expr.EvalCondition(m.cfg.CompletionCondition, vars)
+	if err != nil {
+		return false, fmt.Errorf("计算多实例任务 %s 的完成条件失败: %w", m.node.ID, err)
+	}
+	return ok, nil
+}
+
+// multiInstanceItems 计算多实例的集合元素与实例数
+// 配置了集合时每个元素一个实例，集合为空值时没有实例；否则按基数创建实例
+func (e *processExecutor) multiInstanceItems(node *model.Node, cfg *model.MultiInstanceConfig) ([]interface{}, int, error) {
+	if cfg.Collection != "" {
+		var value interface{}
+		if expr.IsTemplate(cfg.Collection) {
+			resolved, err := expr.ResolveValue(cfg.Collection, e.variables)
+			if err != nil {
+				return nil, 0, fmt.Errorf("计算多实例任务 %s 的集合失败: %w", node.ID, err)
+			}
+			value = resolved
+		} else {
+			value = e.variables[strings.TrimSpace(cfg.Collection)]
+		}
+
+		var items []interface{}
+		switch v := value.(type) {
+		case nil:
+			items = []interface{}{}
+		case []interface{}:
+			items = v
+		default:
+			return nil, 0, fmt.Errorf("多实例任务 %s 的集合必须是列表，实际为 %T", node.ID, value)
+		}
+		if len(items) > maxMultiInstances {
+			return nil, 0, fmt.Errorf("多实例任务 %s 的实例数 %d 超过上限 %d", node.ID, len(items), maxMultiInstances)
+		}
+		return items, len(items), nil
+	}
+
+	resolved, err := expr.ResolveValue(cfg.Cardinality, e.variables)
+	if err != nil {
+		return nil, 0, fmt.Errorf("计算多实例任务 %s 的基数失败: %w", node.ID, err)
+	}
+	n, err := cardinality(resolved)
+	if err != nil {
+		return nil, 0, fmt.Errorf("多实例任务 %s 的基数无效: %w", node.ID, err)
+	}
+	if n > maxMultiInstances {
+		return nil, 0, fmt.Errorf("多实例任务 %s 的实例数 %d 超过上限 %d", node.ID, n, maxMultiInstances)
+	}
+	return nil, n, nil
+}
+
+// cardinality 将基数的计算结果转换为非负整数
+func cardinality(value interface{}) (int, error) {
+	var f float64
+	switch v := value.(type) {
+	case string:
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return 0, fmt.Errorf("基数必须是整数: %s", v)
+		}
+		f = float64(n)
+	case float64:
+		f = v
+	case int:
+		f = float64(v)
+	case int64:
+		f = float64(v)
+	case json.Number:
+		n, err := v.Float64()
+		if err != nil {
+			return 0, fmt.Errorf("基数必须是整数: %s", v)
+		}
+		f = n
+	default:
+		return 0, fmt.Errorf("基数必须是整数，实际为 %T", value)
+	}
+	if f < 0 || f != math.Trunc(f) {
+		return 0, fmt.Errorf("基数必须是非负整数: %v", value)
+	}
+	return int(f), nil
+}
+
+// removeTask 从任务ID列表中移除指定任务
+func removeTask(taskIDs []int64, taskID int64) []int64 {
+	result := make([]int64, 0, len(taskIDs))
+	for _, id := range taskIDs {
+		if id != taskID {
+			result = append(result, id)
+		}
+	}
+	return result
+}
@@ -0,0 +1,151 @@
+package temporal
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.temporal.io/sdk/temporal"
+	"go.uber.org/zap"
+
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+)
+
+// ProcessDefinitionStore 流程定义读取接口
+// 与 biz.ProcessDefinitionRepo 的同名方法签名一致，由数据层仓储直接实现
+type ProcessDefinitionStore interface {
+	GetByID(ctx context.Context, id string) (*ent.ProcessDefinition, error)
+}
+
+// ServiceTaskInput 服务任务活动输入
+type ServiceTaskInput struct {
+	ProcessInstanceID int64                  `json:"process_instance_id"`
+	NodeID            string                 `json:"node_id"`
+	ServiceName       string                 `json:"service_name"`
+	Method            string                 `json:"method"`
+	Input             map[string]interface{} `json:"input"`
+}
+
+// ServiceHandler 服务任务处理函数，返回值会合并到流程变量中
+type ServiceHandler func(ctx context.Context, input ServiceTaskInput) (map[string]interface{}, error)
+
+// ServiceRegistry 服务任务注册表
+// 服务任务按 service_name（或 service_name.method）查找处理函数
+type ServiceRegistry struct {
+	handlers map[string]ServiceHandler
+}
+
+// NewServiceRegistry 创建服务任务注册表，默认注册 notification 服务
+func NewServiceRegistry() *ServiceRegistry {
+	r := &ServiceRegistry{handlers: make(map[string]ServiceHandler)}
+	r.Register("notification", notificationService)
+	return r
+}
+
+// Register 注册服务任务处理函数
+// name 可以是服务名称，也可以是 "服务名称.方法" 以区分同一服务的不同方法
+func (r *ServiceRegistry) Register(name string, handler ServiceHandler) {
+	r.handlers[name] = handler
+}
+
+// lookup 按 "服务名称.方法"、"服务名称" 的顺序查找处理函数
+func (r *ServiceRegistry) lookup(serviceName, method string) (ServiceHandler, bool) {
+	if handler, ok := r.handlers[serviceName+"."+method]; ok {
+		return handler, true
+	}
+	handler, ok := r.handlers[serviceName]
+	return handler, ok
+}
+
+// ProcessActivities 流程解释器使用的活动集合
+// 持有活动执行所需的仓储与服务注册表，通过 worker.RegisterActivity 整体注册
+type ProcessActivities struct {
+	definitions ProcessDefinitionStore
+	instances   ProcessInstanceStore
+	history     HistoricProcessStore
+	tx          Transactor
+	events      ProcessEventStore
+	tasks       UserTaskStore
+	cache       TaskCache
+	services    *ServiceRegistry
+	logger      *zap.Logger
+}
+
+// NewProcessActivities 创建流程活动集合
+// cache 可以为 nil，此时升级规则修改任务、流程结束后不清除服务端缓存
+func NewProcessActivities(
+	definitions ProcessDefinitionStore,
+	instances ProcessInstanceStore,
+	history HistoricProcessStore,
+	tx Transactor,
+	events ProcessEventStore,
+	tasks UserTaskStore,
+	cache TaskCache,
+	services *ServiceRegistry,
+	logger *zap.Logger,
+) *ProcessActivities {
+	return &ProcessActivities{
+		definitions: definitions,
+		instances:   instances,
+		history:     history,
+		tx:          tx,
+		events:      events,
+		tasks:       tasks,
+		cache:       cache,
+		services:    services,
+		logger:      logger,
+	}
+}
+
+// LoadProcessDefinitionActivity 加载流程定义资源
+func (a *ProcessActivities) LoadProcessDefinitionActivity(ctx context.Context, processDefinitionID int64) (string, error) {
+	pd, err := a.definitions.GetByID(ctx, strconv.FormatInt(processDefinitionID, 10))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", temporal.NewNonRetryableApplicationError(
+				fmt.Sprintf("流程定义不存在: %d", processDefinitionID), "ProcessDefinitionNotFound", err)
+		}
+		return "", fmt.Errorf("加载流程定义失败: %w", err)
+	}
+	if pd.Suspended {
+		return "", temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("流程定义已挂起: %d", processDefinitionID), "ProcessDefinitionSuspended", nil)
+	}
+	return pd.Resource, nil
+}
+
+// ServiceTaskActivity 执行服务任务
+func (a *ProcessActivities) ServiceTaskActivity(ctx context.Context, input ServiceTaskInput) (map[string]interface{}, error) {
+	a.logger.Info("执行服务任务",
+		zap.Int64("process_instance_id", input.ProcessInstanceID),
+		zap.String("node_id", input.NodeID),
+		zap.String("service_name", input.ServiceName),
+		zap.String("method", input.Method))
+
+	handler, ok := a.services.lookup(input.ServiceName, input.Method)
+	if !ok {
+		return nil, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("未注册的服务: %s", input.ServiceName), "ServiceNotFound", nil)
+	}
+
+	output, err := handler(ctx, input)
+	if err != nil {
+		a.logger.Error("服务任务执行失败", zap.String("node_id", input.NodeID), zap.Error(err))
+		return nil, err
+	}
+	return output, nil
+}
+
+// notificationService 内置通知服务，复用 SendNotificationActivity 发送通知
+func notificationService(ctx context.Context, input ServiceTaskInput) (map[string]interface{}, error) {
+	notificationType, _ := input.Input["type"].(string)
+	recipient, _ := input.Input["recipient"].(string)
+	if err := SendNotificationActivity(ctx, SendNotificationInput{
+		Type:      notificationType,
+		Recipient: recipient,
+		Data:      input.Input,
+	}); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
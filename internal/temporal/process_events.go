@@ -0,0 +1,75 @@
+package temporal
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+)
+
+// 流程执行事件类型，写入 ProcessEvent 表
+const (
+	EventActivityStarted   = "ACTIVITY_STARTED"   // 进入节点
+	EventActivityCompleted = "ACTIVITY_COMPLETED" // 离开节点
+	EventExecutionForked   = "EXECUTION_FORKED"   // 分支网关产生并发分支
+	EventExecutionJoined   = "EXECUTION_JOINED"   // 分支到达汇聚网关
+)
+
+// ProcessEventStore 流程事件写入接口
+// 与 biz.ProcessEventRepo 的同名方法签名一致，由数据层仓储直接实现
+type ProcessEventStore interface {
+	Create(ctx context.Context, pe *ent.ProcessEvent) (*ent.ProcessEvent, error)
+}
+
+// ProcessEventInput 流程事件活动输入
+type ProcessEventInput struct {
+	EventType            string                 `json:"event_type"`
+	ExecutionID          string                 `json:"execution_id"`
+	ProcessInstanceID    int64                  `json:"process_instance_id"`
+	TaskID               int64                  `json:"task_id,omitempty"`
+	ProcessDefinitionID  int64                  `json:"process_definition_id"`
+	ProcessDefinitionKey string                 `json:"process_definition_key"`
+	ActivityID           string                 `json:"activity_id"`
+	ActivityName         string                 `json:"activity_name"`
+	ActivityType         string                 `json:"activity_type"`
+	Sequence             int64                  `json:"sequence"`
+	Timestamp            time.Time              `json:"timestamp"`
+	Data                 map[string]interface{} `json:"data,omitempty"`
+}
+
+// RecordEventActivity 记录流程执行事件
+// 时间戳与序号由工作流生成，重放与重试不会改变事件内容
+func (a *ProcessActivities) RecordEventActivity(ctx context.Context, input ProcessEventInput) error {
+	if a.events == nil {
+		return nil
+	}
+
+	_, err := a.events.Create(ctx, &ent.ProcessEvent{
+		EventType:            input.EventType,
+		EventName:            input.ActivityName,
+		ExecutionID:          input.ExecutionID,
+		ProcessInstanceID:    input.ProcessInstanceID,
+		TaskID:               input.TaskID,
+		ProcessDefinitionID:  input.ProcessDefinitionID,
+		ProcessDefinitionKey: input.ProcessDefinitionKey,
+		ActivityID:           input.ActivityID,
+		ActivityName:         input.ActivityName,
+		ActivityType:         input.ActivityType,
+		Timestamp:            input.Timestamp,
+		EventData:            input.Data,
+		SequenceCounter:      strconv.FormatInt(input.Sequence, 10),
+	})
+	if err != nil {
+		a.logger.Error("记录流程事件失败",
+			zap.Int64("process_instance_id", input.ProcessInstanceID),
+			zap.String("event_type", input.EventType),
+			zap.String("execution_id", input.ExecutionID),
+			zap.Error(err))
+		return fmt.Errorf("记录流程事件失败: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,764 @@
+package temporal
+
+import (
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/workflow-engine/workflow-engine/internal/expr"
+	"github.com/workflow-engine/workflow-engine/internal/model"
+)
+
+// 流程工作流信号与查询名称
+const (
+	SignalUserTaskCompleted = "user_task_completed" // 用户任务完成信号
+	SignalSuspend           = "suspend"             // 挂起信号
+	SignalResume            = "resume"              // 恢复信号
+	SignalMigrate           = "migrate"             // 迁移到其他流程定义版本的信号
+	QueryProcessState       = "process_state"       // 流程运行状态查询
+)
+
+// 流程工作流状态
+const (
+	ProcessStatusRunning   = "running"   // 运行中
+	ProcessStatusSuspended = "suspended" // 已挂起
+	ProcessStatusCompleted = "completed" // 已完成
+	ProcessStatusFailed    = "failed"    // 执行失败
+)
+
+// UserTaskCompletedSignal 用户任务完成信号数据
+type UserTaskCompletedSignal struct {
+	NodeID      string                 `json:"node_id"`
+	TaskID      int64                  `json:"task_id"`
+	Variables   map[string]interface{} `json:"variables"`
+	CompletedBy string                 `json:"completed_by"`
+}
+
+// MigrateSignal 流程实例迁移信号数据
+type MigrateSignal struct {
+	ProcessDefinitionID int64 `json:"process_definition_id"` // 目标流程定义ID，与原流程定义键相同
+}
+
+// ProcessState 流程运行状态，供查询处理器返回
+type ProcessState struct {
+	Status         string                 `json:"status"`
+	ActiveNodes    []string               `json:"active_nodes"`
+	CompletedNodes []string               `json:"completed_nodes"`
+	Executions     map[string]string      `json:"executions"` // 活跃执行ID -> 当前节点ID
+	Variables      map[string]interface{} `json:"variables"`
+}
+
+// rootExecutionID 根执行ID，分支执行的ID在父执行ID后追加序号，如 root.1、root.1.2
+const rootExecutionID = "root"
+
+// execution 流程执行令牌
+// 分支网关为每条出口派生子执行，子执行全部到达汇聚网关后恢复父执行继续前进
+type execution struct {
+	id     string
+	scopes []*forkScope // 所在的分支区域，由外到内
+}
+
+// forkScope 一次分支产生的并行区域
+type forkScope struct {
+	forkID   string     // 分支节点ID
+	joinID   string     // 汇聚网关ID，为空表示各分支分别结束
+	parent   *execution // 分支前的执行，汇聚后恢复
+	expected int        // 需要到达汇聚网关的分支数
+	arrived  int        // 已到达汇聚网关的分支数
+}
+
+// innermost 返回最内层的分支区域
+func (x *execution) innermost() *forkScope {
+	if len(x.scopes) == 0 {
+		return nil
+	}
+	return x.scopes[len(x.scopes)-1]
+}
+
+// processExecutor 流程图解释器
+// 在工作流上下文中从开始事件出发，以执行令牌沿顺序流推进；
+// 每个执行运行在独立的工作流协程中，协程调度由 Temporal 保证确定性，重放时得到相同的执行顺序
+type processExecutor struct {
+	def       *model.Definition
+	input     ProcessWorkflowInput
+	variables map[string]interface{}
+	state     *ProcessState
+	waiting   map[int64]string                   // 正在等待完成的用户任务ID -> 节点ID
+	pending   map[int64]*UserTaskCompletedSignal // 已收到但尚未处理的完成信号，按任务ID索引，可能早于任务登记为等待
+	finished  map[int64]bool                     // 已结束等待的用户任务ID，之后到达的完成信号被忽略
+	suspended bool                               // 是否已挂起，挂起期间不推进任何节点
+	active    int                                // 运行中的执行数
+	forks     map[string]int                     // 各执行已派生的子执行数，用于生成子执行ID
+	visits    map[string]int                     // 各执行到达各用户任务节点的次数，用于区分循环中的多次到达
+	sequence  int64                              // 事件序号
+	err       error                              // 首个失败的执行返回的错误
+	logger    log.Logger
+}
+
+// newProcessExecutor 创建流程图解释器
+func newProcessExecutor(ctx workflow.Context, def *model.Definition, input ProcessWorkflowInput) *processExecutor {
+	variables := make(map[string]interface{}, len(input.Variables))
+	for k, v := range input.Variables {
+		variables[k] = v
+	}
+	return &processExecutor{
+		def:       def,
+		input:     input,
+		variables: variables,
+		state: &ProcessState{
+			Status:     ProcessStatusRunning,
+			Executions: make(map[string]string),
+			Variables:  variables,
+		},
+		waiting:  make(map[int64]string),
+		pending:  make(map[int64]*UserTaskCompletedSignal),
+		finished: make(map[int64]bool),
+		forks:    make(map[string]int),
+		visits:   make(map[string]int),
+		logger:   workflow.GetLogger(ctx),
+	}
+}
+
+// listen 注册查询处理器，并在后台协程中接收用户任务完成、挂起、恢复与迁移信号
+func (e *processExecutor) listen(ctx workflow.Context) error {
+	if err := workflow.SetQueryHandler(ctx, QueryProcessState, func() (*ProcessState, error) {
+		return e.state, nil
+	}); err != nil {
+		return fmt.Errorf("注册流程状态查询失败: %w", err)
+	}
+
+	ch := workflow.GetSignalChannel(ctx, SignalUserTaskCompleted)
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		for {
+			var signal UserTaskCompletedSignal
+			ch.Receive(ctx, &signal)
+			// 已结束等待的任务来自已完成或已取消的旧任务，例如重试的完成请求
+			if e.finished[signal.TaskID] {
+				e.logger.Warn("忽略已结束任务的完成信号", "node_id", signal.NodeID, "task_id", signal.TaskID)
+				continue
+			}
+			// 任务可能在创建任务的活动结果处理之前就被完成，尚未登记为等待的任务同样保留信号，登记时再校验节点
+			if nodeID, ok := e.waiting[signal.TaskID]; ok && signal.NodeID != nodeID {
+				e.logger.Warn("忽略节点不匹配的完成信号", "node_id", signal.NodeID, "task_id", signal.TaskID, "expected_node_id", nodeID)
+				continue
+			}
+			if _, exists := e.pending[signal.TaskID]; exists {
+				e.logger.Warn("忽略重复的完成信号", "node_id", signal.NodeID, "task_id", signal.TaskID)
+				continue
+			}
+			e.pending[signal.TaskID] = &signal
+		}
+	})
+
+	suspendCh := workflow.GetSignalChannel(ctx, SignalSuspend)
+	resumeCh := workflow.GetSignalChannel(ctx, SignalResume)
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		selector := workflow.NewSelector(ctx)
+		selector.AddReceive(suspendCh, func(c workflow.ReceiveChannel, more bool) {
+			c.Receive(ctx, nil)
+			e.setSuspended(true)
+		})
+		selector.AddReceive(resumeCh, func(c workflow.ReceiveChannel, more bool) {
+			c.Receive(ctx, nil)
+			e.setSuspended(false)
+		})
+		for {
+			selector.Select(ctx)
+		}
+	})
+
+	migrateCh := workflow.GetSignalChannel(ctx, SignalMigrate)
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		for {
+			var signal MigrateSignal
+			migrateCh.Receive(ctx, &signal)
+			e.migrate(ctx, signal)
+		}
+	})
+	return nil
+}
+
+// migrate 加载目标版本的流程定义并替换流程图，正在等待的节点结束后按新版本的出口继续
+// 目标版本缺少正在执行的节点时不迁移，流程按原版本继续
+func (e *processExecutor) migrate(ctx workflow.Context, signal MigrateSignal) {
+	var a *ProcessActivities
+	var resource string
+	if err := workflow.ExecuteActivity(ctx, a.LoadProcessDefinitionActivity, signal.ProcessDefinitionID).Get(ctx, &resource); err != nil {
+		e.logger.Error("加载迁移目标流程定义失败", "process_definition_id", signal.ProcessDefinitionID, "error", err)
+		return
+	}
+	def, err := model.Parse([]byte(resource))
+	if err != nil {
+		e.logger.Error("解析迁移目标流程定义失败", "process_definition_id", signal.ProcessDefinitionID, "error", err)
+		return
+	}
+	for _, nodeID := range e.state.Executions {
+		if _, ok := def.Node(nodeID); !ok {
+			e.logger.Error("迁移目标流程定义缺少正在执行的节点", "process_definition_id", signal.ProcessDefinitionID, "node_id", nodeID)
+			return
+		}
+	}
+
+	e.def = def
+	e.input.ProcessDefinitionID = signal.ProcessDefinitionID
+	e.logger.Info("流程实例已迁移", "process_definition_id", signal.ProcessDefinitionID)
+}
+
+// current 返回节点在当前流程图中的定义，流程实例迁移后节点的出口以新版本为准
+func (e *processExecutor) current(node *model.Node) *model.Node {
+	if n, ok := e.def.Node(node.ID); ok {
+		return n
+	}
+	return node
+}
+
+// setSuspended 切换挂起状态
+func (e *processExecutor) setSuspended(suspended bool) {
+	if e.suspended == suspended {
+		return
+	}
+	e.suspended = suspended
+	if suspended {
+		e.state.Status = ProcessStatusSuspended
+	} else {
+		e.state.Status = ProcessStatusRunning
+	}
+	e.logger.Info("流程挂起状态变更", "suspended", suspended)
+}
+
+// awaitActive 挂起期间阻塞，直到收到恢复信号
+func (e *processExecutor) awaitActive(ctx workflow.Context) error {
+	return workflow.Await(ctx, func() bool { return !e.suspended })
+}
+
+// run 从开始事件出发执行流程，直到所有执行结束或任一执行失败
+func (e *processExecutor) run(ctx workflow.Context) error {
+	ctx, cancel := workflow.WithCancel(ctx)
+	defer cancel()
+
+	e.spawn(ctx, &execution{id: rootExecutionID}, e.def.StartNode())
+	if err := workflow.Await(ctx, func() bool { return e.active == 0 || e.err != nil }); err != nil {
+		return fmt.Errorf("等待流程执行失败: %w", err)
+	}
+	return e.err
+}
+
+// spawn 在新的工作流协程中运行执行
+func (e *processExecutor) spawn(ctx workflow.Context, x *execution, node *model.Node) {
+	e.active++
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		defer func() { e.active-- }()
+		if err := e.walk(ctx, x, node); err != nil && e.err == nil {
+			e.logger.Error("流程执行失败", "execution_id", x.id, "error", err)
+			e.err = err
+		}
+	})
+}
+
+// walk 沿顺序流推进单个执行，直到到达结束事件、分支或在汇聚网关等待其他分支
+func (e *processExecutor) walk(ctx workflow.Context, x *execution, node *model.Node) error {
+	for node != nil {
+		if err := e.awaitActive(ctx); err != nil {
+			return fmt.Errorf("等待流程恢复失败: %w", err)
+		}
+		if e.err != nil {
+			return nil
+		}
+
+		if node.IsJoin() {
+			resumed, err := e.arrive(ctx, x, node)
+			if err != nil {
+				return err
+			}
+			if resumed == nil {
+				return nil
+			}
+			x = resumed
+		}
+
+		flows, err := e.execute(ctx, x, node)
+		if err != nil {
+			return err
+		}
+		node = e.current(node)
+		if node.IsFork() {
+			return e.fork(ctx, x, node, flows)
+		}
+		switch {
+		case node.Type == model.NodeTypeEndEvent:
+			return nil
+		case len(flows) != 1:
+			return fmt.Errorf("节点 %s 没有可以继续的出口顺序流", node.ID)
+		}
+		if node, err = e.target(flows[0]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fork 为选中的每条出口派生子执行，父执行在汇聚后恢复
+func (e *processExecutor) fork(ctx workflow.Context, x *execution, node *model.Node, flows []*model.SequenceFlow) error {
+	scope := &forkScope{
+		forkID:   node.ID,
+		joinID:   e.def.JoinOf(node.ID),
+		parent:   x,
+		expected: len(flows),
+	}
+
+	children := make([]*execution, len(flows))
+	targets := make([]*model.Node, len(flows))
+	ids := make([]interface{}, len(flows))
+	for i, flow := range flows {
+		target, err := e.target(flow)
+		if err != nil {
+			return err
+		}
+		e.forks[x.id]++
+		scopes := make([]*forkScope, len(x.scopes), len(x.scopes)+1)
+		copy(scopes, x.scopes)
+		children[i] = &execution{
+			id:     fmt.Sprintf("%s.%d", x.id, e.forks[x.id]),
+			scopes: append(scopes, scope),
+		}
+		targets[i] = target
+		ids[i] = children[i].id
+	}
+
+	e.logger.Info("流程分支", "node_id", node.ID, "execution_id", x.id, "branches", len(flows), "join", scope.joinID)
+	e.recordEvent(ctx, EventExecutionForked, x, node, map[string]interface{}{
+		"executions": ids,
+		"join":       scope.joinID,
+	})
+	for i := range children {
+		e.spawn(ctx, children[i], targets[i])
+	}
+	return nil
+}
+
+// arrive 处理执行到达汇聚网关
+// 所在区域的分支全部到达后返回恢复的父执行；仍需等待其他分支时返回 nil，当前执行就此结束。
+// 多个分支区域汇聚到同一网关时逐层恢复
+func (e *processExecutor) arrive(ctx workflow.Context, x *execution, node *model.Node) (*execution, error) {
+	joined := false
+	for {
+		scope := x.innermost()
+		if scope == nil || scope.joinID != node.ID {
+			if joined {
+				return x, nil
+			}
+			return nil, fmt.Errorf("汇聚网关 %s 收到不属于任何分支区域的执行: %s", node.ID, x.id)
+		}
+
+		// 先计数再记录事件，记录事件期间其他分支可能同时到达
+		scope.arrived++
+		arrived := scope.arrived
+		e.recordEvent(ctx, EventExecutionJoined, x, node, map[string]interface{}{
+			"fork":     scope.forkID,
+			"arrived":  arrived,
+			"expected": scope.expected,
+		})
+		if arrived < scope.expected {
+			e.logger.Info("分支到达汇聚网关，等待其他分支",
+				"node_id", node.ID, "execution_id", x.id, "arrived", arrived, "expected", scope.expected)
+			return nil, nil
+		}
+		x = scope.parent
+		joined = true
+	}
+}
+
+// execute 执行单个节点，返回需要继续的出口顺序流
+func (e *processExecutor) execute(ctx workflow.Context, x *execution, node *model.Node) ([]*model.SequenceFlow, error) {
+	e.logger.Info("进入流程节点", "node_id", node.ID, "type", string(node.Type), "execution_id", x.id)
+	e.enter(x, node)
+	e.recordEvent(ctx, EventActivityStarted, x, node, nil)
+	defer e.leave(x, node)
+
+	var flows []*model.SequenceFlow
+	var err error
+	switch node.Type {
+	case model.NodeTypeStartEvent, model.NodeTypeParallelGateway:
+		flows = node.Outgoing
+	case model.NodeTypeEndEvent:
+	case model.NodeTypeServiceTask, model.NodeTypeUserTask:
+		if mi := node.MultiInstance(); mi != nil {
+			err = e.runMultiInstance(ctx, x, node, mi)
+		} else {
+			err = e.runTask(ctx, x, node, e.variables)
+		}
+		// 等待期间流程实例可能已迁移到其他版本
+		flows = e.current(node).Outgoing
+	case model.NodeTypeExclusiveGateway:
+		var flow *model.SequenceFlow
+		if flow, err = e.chooseExclusive(node); err == nil {
+			flows = []*model.SequenceFlow{flow}
+		}
+	case model.NodeTypeInclusiveGateway:
+		flows, err = e.chooseInclusive(node)
+	default:
+		err = fmt.Errorf("暂不支持的节点类型: %s (%s)", node.Type, node.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	e.recordEvent(ctx, EventActivityCompleted, x, node, nil)
+	return flows, nil
+}
+
+// enter 记录执行进入节点
+func (e *processExecutor) enter(x *execution, node *model.Node) {
+	e.state.ActiveNodes = append(e.state.ActiveNodes, node.ID)
+	e.state.Executions[x.id] = node.ID
+}
+
+// leave 记录执行离开节点
+func (e *processExecutor) leave(x *execution, node *model.Node) {
+	for i, id := range e.state.ActiveNodes {
+		if id == node.ID {
+			e.state.ActiveNodes = append(e.state.ActiveNodes[:i], e.state.ActiveNodes[i+1:]...)
+			break
+		}
+	}
+	delete(e.state.Executions, x.id)
+	e.state.CompletedNodes = append(e.state.CompletedNodes, node.ID)
+}
+
+// target 返回顺序流的目标节点
+func (e *processExecutor) target(flow *model.SequenceFlow) (*model.Node, error) {
+	next, ok := e.def.Node(flow.TargetRef)
+	if !ok {
+		return nil, fmt.Errorf("顺序流指向不存在的节点: %s", flow.TargetRef)
+	}
+	return next, nil
+}
+
+// recordEvent 通过活动记录流程事件
+// 事件只用于审计与监控，记录失败不影响流程推进
+func (e *processExecutor) recordEvent(ctx workflow.Context, eventType string, x *execution, node *model.Node, data map[string]interface{}) {
+	e.recordTaskEvent(ctx, eventType, x, node, 0, data)
+}
+
+// recordTaskEvent 记录关联用户任务的流程事件，taskID 为 0 表示不关联任务
+func (e *processExecutor) recordTaskEvent(ctx workflow.Context, eventType string, x *execution, node *model.Node, taskID int64, data map[string]interface{}) {
+	e.sequence++
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Second * 30,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Second * 30,
+			MaximumAttempts:    5,
+		},
+	})
+
+	var a *ProcessActivities
+	err := workflow.ExecuteActivity(ctx, a.RecordEventActivity, ProcessEventInput{
+		EventType:            eventType,
+		ExecutionID:          x.id,
+		ProcessInstanceID:    e.input.ProcessInstanceID,
+		TaskID:               taskID,
+		ProcessDefinitionID:  e.input.ProcessDefinitionID,
+		ProcessDefinitionKey: e.def.ID,
+		ActivityID:           node.ID,
+		ActivityName:         node.Name,
+		ActivityType:         string(node.Type),
+		Sequence:             e.sequence,
+		Timestamp:            workflow.Now(ctx),
+		Data:                 data,
+	}).Get(ctx, nil)
+	if err != nil {
+		e.logger.Warn("记录流程事件失败", "event_type", eventType, "execution_id", x.id, "error", err)
+	}
+}
+
+// runTask 在给定的变量上执行服务任务或用户任务
+// vars 为流程变量，多实例任务的每个实例在流程变量之上叠加计数变量与元素变量
+func (e *processExecutor) runTask(ctx workflow.Context, x *execution, node *model.Node, vars map[string]interface{}) error {
+	if node.Type == model.NodeTypeUserTask {
+		return e.waitUserTask(ctx, x, node, vars)
+	}
+	return e.runServiceTask(ctx, node, vars)
+}
+
+// runServiceTask 以活动方式执行服务任务，并将返回值合并到流程变量
+func (e *processExecutor) runServiceTask(ctx workflow.Context, node *model.Node, vars map[string]interface{}) error {
+	future, err := e.startServiceTask(ctx, node, vars)
+	if err != nil {
+		return err
+	}
+	return e.serviceTaskCompleted(ctx, node, future)
+}
+
+// startServiceTask 计算服务任务的输入参数并启动服务任务活动
+func (e *processExecutor) startServiceTask(ctx workflow.Context, node *model.Node, vars map[string]interface{}) (workflow.Future, error) {
+	cfg := node.ServiceTask
+	ctx = workflow.WithActivityOptions(ctx, serviceTaskActivityOptions(cfg))
+
+	input := make(map[string]interface{}, len(cfg.Input))
+	for k, v := range cfg.Input {
+		resolved, err := expr.ResolveValue(v, vars)
+		if err != nil {
+			return nil, fmt.Errorf("计算服务任务 %s 的输入参数 %s 失败: %w", node.ID, k, err)
+		}
+		input[k] = resolved
+	}
+
+	var a *ProcessActivities
+	return workflow.ExecuteActivity(ctx, a.ServiceTaskActivity, ServiceTaskInput{
+		ProcessInstanceID: e.input.ProcessInstanceID,
+		NodeID:            node.ID,
+		ServiceName:       cfg.ServiceName,
+		Method:            cfg.Method,
+		Input:             input,
+	}), nil
+}
+
+// serviceTaskCompleted 等待服务任务活动结束，并将返回值合并到流程变量
+func (e *processExecutor) serviceTaskCompleted(ctx workflow.Context, node *model.Node, future workflow.Future) error {
+	var output map[string]interface{}
+	if err := future.Get(ctx, &output); err != nil {
+		return fmt.Errorf("服务任务 %s 执行失败: %w", node.ID, err)
+	}
+	for k, v := range output {
+		e.variables[k] = v
+	}
+	return nil
+}
+
+// serviceTaskActivityOptions 根据服务任务配置生成活动选项
+func serviceTaskActivityOptions(cfg *model.ServiceTaskConfig) workflow.ActivityOptions {
+	options := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute * 10,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	}
+	if cfg.Timeout != "" {
+		if d, err := model.ParseDuration(cfg.Timeout); err == nil {
+			options.StartToCloseTimeout = d
+		}
+	}
+	if rp := cfg.RetryPolicy; rp != nil {
+		if rp.MaxAttempts > 0 {
+			options.RetryPolicy.MaximumAttempts = int32(rp.MaxAttempts)
+		}
+		if rp.Backoff == "fixed" {
+			options.RetryPolicy.BackoffCoefficient = 1.0
+		}
+	}
+	return options
+}
+
+// waitUserTask 创建用户任务并等待其完成信号，将提交的变量合并到流程变量
+// 只接受携带本次创建的任务ID的信号，同一任务的重复完成不会让流程推进两次
+func (e *processExecutor) waitUserTask(ctx workflow.Context, x *execution, node *model.Node, vars map[string]interface{}) error {
+	taskID, cancel, err := e.openUserTask(ctx, x, node, vars)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	defer e.finishUserTask(taskID)
+
+	if err := workflow.Await(ctx, func() bool {
+		_, ok := e.pending[taskID]
+		return ok
+	}); err != nil {
+		return fmt.Errorf("等待用户任务 %s 失败: %w", node.ID, err)
+	}
+	e.userTaskCompleted(node, taskID)
+	return nil
+}
+
+// openUserTask 创建用户任务并登记为等待完成，返回任务ID与停止升级定时器的函数
+// 配置了升级规则时，等待期间按到期时间触发提醒、转交、提高优先级或自动完成
+func (e *processExecutor) openUserTask(ctx workflow.Context, x *execution, node *model.Node, vars map[string]interface{}) (int64, workflow.CancelFunc, error) {
+	taskID, dueDate, err := e.createUserTask(ctx, x, node, vars)
+	if err != nil {
+		return 0, nil, err
+	}
+	e.waiting[taskID] = node.ID
+	if signal, ok := e.pending[taskID]; ok && signal.NodeID != node.ID {
+		e.logger.Warn("忽略节点不匹配的完成信号", "node_id", signal.NodeID, "task_id", taskID, "expected_node_id", node.ID)
+		delete(e.pending, taskID)
+	}
+
+	escalationCtx, cancel := workflow.WithCancel(ctx)
+	if dueDate != nil && len(node.UserTask.Escalations) > 0 {
+		e.scheduleEscalations(escalationCtx, x, node, taskID, *dueDate, vars)
+	}
+	return taskID, cancel, nil
+}
+
+// userTaskCompleted 取出用户任务的完成信号，将提交的变量合并到流程变量
+func (e *processExecutor) userTaskCompleted(node *model.Node, taskID int64) *UserTaskCompletedSignal {
+	signal := e.pending[taskID]
+	e.finishUserTask(taskID)
+	for k, v := range signal.Variables {
+		e.variables[k] = v
+	}
+	e.logger.Info("用户任务已完成", "node_id", node.ID, "task_id", signal.TaskID, "completed_by", signal.CompletedBy)
+	return signal
+}
+
+// finishUserTask 结束对用户任务的等待，丢弃尚未处理的完成信号并忽略之后到达的信号
+func (e *processExecutor) finishUserTask(taskID int64) {
+	delete(e.waiting, taskID)
+	delete(e.pending, taskID)
+	e.finished[taskID] = true
+}
+
+// createUserTask 按用户任务配置计算办理人、候选人与到期时间，并通过活动创建运行时任务，返回任务ID与到期时间
+func (e *processExecutor) createUserTask(ctx workflow.Context, x *execution, node *model.Node, vars map[string]interface{}) (int64, *time.Time, error) {
+	visitKey := x.id + "/" + node.ID
+	e.visits[visitKey]++
+	input := CreateUserTaskInput{
+		ProcessInstanceID:    e.input.ProcessInstanceID,
+		ProcessDefinitionID:  e.input.ProcessDefinitionID,
+		ProcessDefinitionKey: e.def.ID,
+		TenantID:             e.input.TenantID,
+		ExecutionID:          x.id,
+		NodeID:               node.ID,
+		Visit:                e.visits[visitKey],
+		Name:                 node.Name,
+		CreateTime:           workflow.Now(ctx),
+	}
+
+	if cfg := node.UserTask; cfg != nil {
+		assignee, err := resolveUsers(vars, cfg.Assignee)
+		if err != nil {
+			return 0, nil, fmt.Errorf("计算用户任务 %s 的办理人失败: %w", node.ID, err)
+		}
+		if len(assignee) > 0 {
+			input.Assignee = assignee[0]
+		}
+		if input.CandidateUsers, err = resolveUsers(vars, cfg.CandidateUsers...); err != nil {
+			return 0, nil, fmt.Errorf("计算用户任务 %s 的候选用户失败: %w", node.ID, err)
+		}
+		if input.CandidateGroups, err = resolveUsers(vars, cfg.CandidateGroups...); err != nil {
+			return 0, nil, fmt.Errorf("计算用户任务 %s 的候选组失败: %w", node.ID, err)
+		}
+		if cfg.DueDate != "" {
+			d, err := model.ParseDuration(cfg.DueDate)
+			if err != nil {
+				return 0, nil, fmt.Errorf("用户任务 %s 的到期时长无效: %w", node.ID, err)
+			}
+			dueDate := input.CreateTime.Add(d)
+			input.DueDate = &dueDate
+		}
+		input.FormKey = cfg.FormKey
+		input.Priority = cfg.Priority
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Second * 30,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+		},
+	})
+
+	var a *ProcessActivities
+	var taskID int64
+	if err := workflow.ExecuteActivity(ctx, a.CreateUserTaskActivity, input).Get(ctx, &taskID); err != nil {
+		return 0, nil, fmt.Errorf("创建用户任务 %s 失败: %w", node.ID, err)
+	}
+	e.logger.Info("等待用户任务完成", "node_id", node.ID, "task_id", taskID, "execution_id", x.id)
+	return taskID, input.DueDate, nil
+}
+
+// resolveUsers 在给定的变量上计算用户或组配置中的表达式
+// 表达式结果为列表时逐项展开，空值被忽略
+func resolveUsers(vars map[string]interface{}, values ...string) ([]string, error) {
+	var users []string
+	for _, value := range values {
+		resolved, err := expr.ResolveValue(value, vars)
+		if err != nil {
+			return nil, err
+		}
+		switch v := resolved.(type) {
+		case nil:
+		case []interface{}:
+			for _, item := range v {
+				if item != nil && fmt.Sprint(item) != "" {
+					users = append(users, fmt.Sprint(item))
+				}
+			}
+		default:
+			if s := fmt.Sprint(v); s != "" {
+				users = append(users, s)
+			}
+		}
+	}
+	return users, nil
+}
+
+// chooseExclusive 计算排他网关的出口
+// 按定义顺序选择第一条条件成立的顺序流，都不成立时走默认流
+func (e *processExecutor) chooseExclusive(node *model.Node) (*model.SequenceFlow, error) {
+	var defaultFlow *model.SequenceFlow
+	for _, flow := range node.Outgoing {
+		if flow.IsDefault {
+			defaultFlow = flow
+			continue
+		}
+		ok, err := e.evaluate(node, flow)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return flow, nil
+		}
+	}
+	if defaultFlow != nil {
+		return defaultFlow, nil
+	}
+	return nil, fmt.Errorf("排他网关 %s 没有满足条件的出口", node.ID)
+}
+
+// chooseInclusive 计算包容网关的出口
+// 选择全部条件成立的顺序流，都不成立时走默认流
+func (e *processExecutor) chooseInclusive(node *model.Node) ([]*model.SequenceFlow, error) {
+	var flows []*model.SequenceFlow
+	var defaultFlow *model.SequenceFlow
+	for _, flow := range node.Outgoing {
+		if flow.IsDefault {
+			defaultFlow = flow
+			continue
+		}
+		ok, err := e.evaluate(node, flow)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			flows = append(flows, flow)
+		}
+	}
+	if len(flows) == 0 && defaultFlow != nil {
+		flows = append(flows, defaultFlow)
+	}
+	if len(flows) == 0 {
+		return nil, fmt.Errorf("包容网关 %s 没有满足条件的出口", node.ID)
+	}
+	return flows, nil
+}
+
+// evaluate 计算顺序流条件，没有条件的顺序流视为成立
+func (e *processExecutor) evaluate(node *model.Node, flow *model.SequenceFlow) (bool, error) {
+	if flow.Condition == "" {
+		return true, nil
+	}
+	ok, err := expr.EvalCondition(flow.Condition, e.variables)
+	if err != nil {
+		return false, fmt.Errorf("计算网关 %s 条件失败: %w", node.ID, err)
+	}
+	return ok, nil
+}
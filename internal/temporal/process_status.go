@@ -0,0 +1,147 @@
+package temporal
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+)
+
+// 与数据层历史流程实例状态一致的最终状态，temporal 包不能依赖数据层
+const (
+	historicStateCompleted            = "COMPLETED"
+	historicStateInternallyTerminated = "INTERNALLY_TERMINATED"
+)
+
+// maxEndReasonLen 结束原因的最大字节数，与流程实例 delete_reason 字段长度一致
+const maxEndReasonLen = 500
+
+// ProcessInstanceStore 流程实例读写接口
+// 与 biz.ProcessInstanceRepo 的同名方法签名一致，由数据层仓储直接实现
+type ProcessInstanceStore interface {
+	GetByID(ctx context.Context, id string) (*ent.ProcessInstance, error)
+	Update(ctx context.Context, pi *ent.ProcessInstance) (*ent.ProcessInstance, error)
+}
+
+// HistoricProcessStore 历史流程实例写入接口
+// 与 biz.HistoricProcessInstanceRepo 的同名方法签名一致，由数据层仓储直接实现
+type HistoricProcessStore interface {
+	Create(ctx context.Context, hpi *ent.HistoricProcessInstance) (*ent.HistoricProcessInstance, error)
+}
+
+// Transactor 事务执行接口，与 biz.TransactionRepo 的同名方法签名一致
+type Transactor interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// UpdateStatusInput 更新状态活动输入
+type UpdateStatusInput struct {
+	ProcessInstanceID int64     `json:"process_instance_id"`
+	Status            string    `json:"status"`
+	Reason            string    `json:"reason,omitempty"`   // 执行失败的原因，记录为流程实例的结束原因
+	EndTime           time.Time `json:"end_time,omitempty"` // 结束时间，由工作流生成
+}
+
+// UpdateStatusActivity 更新流程实例状态
+// 流程完成或失败时在同一事务中记录流程实例的结束时间与原因并写入历史流程实例；
+// 流程实例已结束（例如已被终止或活动重试）时不做处理。运行中状态由启动流程实例时写入，无需更新
+func (a *ProcessActivities) UpdateStatusActivity(ctx context.Context, input UpdateStatusInput) error {
+	if input.Status != ProcessStatusCompleted && input.Status != ProcessStatusFailed {
+		return nil
+	}
+	if a.instances == nil || a.history == nil || a.tx == nil {
+		return fmt.Errorf("未配置流程实例存储")
+	}
+
+	id := strconv.FormatInt(input.ProcessInstanceID, 10)
+	reason := truncateReason(input.Reason)
+	ended := false
+	err := a.tx.Transaction(ctx, func(ctx context.Context) error {
+		instance, err := a.instances.GetByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("获取流程实例失败: %w", err)
+		}
+		if instance.EndTime != nil {
+			ended = true
+			return nil
+		}
+
+		endTime := input.EndTime
+		if endTime.IsZero() {
+			endTime = time.Now()
+		}
+		instance.EndTime = &endTime
+		instance.Duration = endTime.Sub(instance.StartTime).Milliseconds()
+		instance.DeleteReason = reason
+		instance.Suspended = false
+		if _, err := a.instances.Update(ctx, instance); err != nil {
+			return err
+		}
+
+		state := historicStateCompleted
+		if input.Status == ProcessStatusFailed {
+			state = historicStateInternallyTerminated
+		}
+		_, err = a.history.Create(ctx, &ent.HistoricProcessInstance{
+			ProcessInstanceID:        id,
+			BusinessKey:              instance.BusinessKey,
+			ProcessDefinitionID:      instance.ProcessDefinitionID,
+			ProcessDefinitionKey:     instance.ProcessDefinitionKey,
+			ProcessDefinitionName:    instance.ProcessDefinitionName,
+			ProcessDefinitionVersion: instance.ProcessDefinitionVersion,
+			DeploymentID:             instance.DeploymentID,
+			StartUserID:              instance.StartUserID,
+			StartTime:                instance.StartTime,
+			EndTime:                  &endTime,
+			Duration:                 instance.Duration,
+			SuperProcessInstanceID:   instance.SuperProcessInstanceID,
+			RootProcessInstanceID:    instance.RootProcessInstanceID,
+			DeleteReason:             reason,
+			TenantID:                 instance.TenantID,
+			State:                    state,
+		})
+		return err
+	})
+	if err != nil {
+		a.logger.Error("更新流程实例状态失败",
+			zap.Int64("process_instance_id", input.ProcessInstanceID),
+			zap.String("status", input.Status),
+			zap.Error(err))
+		return fmt.Errorf("更新流程实例状态失败: %w", err)
+	}
+	if ended {
+		a.logger.Info("流程实例已结束，跳过状态更新",
+			zap.Int64("process_instance_id", input.ProcessInstanceID),
+			zap.String("status", input.Status))
+		return nil
+	}
+
+	if a.cache != nil {
+		if err := a.cache.Delete(ctx, fmt.Sprintf("process_instance:%s", id)); err != nil {
+			a.logger.Warn("清除流程实例缓存失败", zap.Int64("process_instance_id", input.ProcessInstanceID), zap.Error(err))
+		}
+	}
+	a.logger.Info("流程实例状态更新成功",
+		zap.Int64("process_instance_id", input.ProcessInstanceID),
+		zap.String("status", input.Status))
+	return nil
+}
+
+// truncateReason 按字段长度截断结束原因，不截断多字节字符
+func truncateReason(reason string) string {
+	if len(reason) <= maxEndReasonLen {
+		return reason
+	}
+	end := 0
+	for i := range reason {
+		if i > maxEndReasonLen {
+			break
+		}
+		end = i
+	}
+	return reason[:end]
+}
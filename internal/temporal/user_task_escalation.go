@@ -0,0 +1,289 @@
+package temporal
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/expr"
+	"github.com/workflow-engine/workflow-engine/internal/model"
+)
+
+// EventTaskEscalated 用户任务到期升级事件类型，写入 ProcessEvent 表并关联任务
+const EventTaskEscalated = "TASK_ESCALATED"
+
+// escalationUser 升级规则自动执行操作时记录的操作人
+const escalationUser = "system"
+
+// TaskCache 任务缓存接口
+// 升级规则修改任务后清除服务端缓存的任务详情，与 biz.CacheRepo 的同名方法签名一致
+type TaskCache interface {
+	Delete(ctx context.Context, key string) error
+}
+
+// EscalateUserTaskInput 用户任务升级活动输入
+// 接收人、转交目标与自动完成变量中的表达式已由工作流按流程变量计算完成
+type EscalateUserTaskInput struct {
+	ProcessInstanceID int64                  `json:"process_instance_id"`
+	NodeID            string                 `json:"node_id"`
+	TaskID            int64                  `json:"task_id"`
+	Action            string                 `json:"action"`
+	Recipients        []string               `json:"recipients,omitempty"`
+	Assignee          string                 `json:"assignee,omitempty"`
+	CandidateGroups   []string               `json:"candidate_groups,omitempty"`
+	Priority          int32                  `json:"priority,omitempty"`
+	Variables         map[string]interface{} `json:"variables,omitempty"`
+	DueDate           time.Time              `json:"due_date"`
+}
+
+// EscalateUserTaskResult 用户任务升级活动结果
+type EscalateUserTaskResult struct {
+	Applied    bool     `json:"applied"`              // 是否执行了升级动作
+	Reason     string   `json:"reason,omitempty"`     // 未执行的原因
+	Recipients []string `json:"recipients,omitempty"` // 实际提醒的接收人
+}
+
+// EscalateUserTaskActivity 执行用户任务升级动作
+// 任务已结束时不执行；每个动作都可以重复执行，活动重试不会重复转交或完成任务
+func (a *ProcessActivities) EscalateUserTaskActivity(ctx context.Context, input EscalateUserTaskInput) (*EscalateUserTaskResult, error) {
+	if a.tasks == nil {
+		return nil, fmt.Errorf("未配置用户任务存储")
+	}
+	a.logger.Info("执行用户任务升级",
+		zap.Int64("process_instance_id", input.ProcessInstanceID),
+		zap.String("node_id", input.NodeID),
+		zap.Int64("task_id", input.TaskID),
+		zap.String("action", input.Action))
+
+	id := strconv.FormatInt(input.TaskID, 10)
+	task, err := a.tasks.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("获取用户任务失败: %w", err)
+	}
+	if task.Status == taskStatusCompleted || task.Status == taskStatusCancelled {
+		return &EscalateUserTaskResult{Reason: "任务已结束"}, nil
+	}
+
+	result := &EscalateUserTaskResult{Applied: true}
+	switch input.Action {
+	case model.EscalationRemind:
+		recipients := input.Recipients
+		if len(recipients) == 0 {
+			if recipients, err = a.taskRecipients(ctx, task); err != nil {
+				return nil, err
+			}
+		}
+		if len(recipients) == 0 {
+			return &EscalateUserTaskResult{Reason: "任务没有办理人或候选人"}, nil
+		}
+		for _, recipient := range recipients {
+			if err := SendNotificationActivity(ctx, SendNotificationInput{
+				Type:      "task_reminder",
+				Recipient: recipient,
+				Data: map[string]interface{}{
+					"process_instance_id": input.ProcessInstanceID,
+					"task_id":             input.TaskID,
+					"task_name":           task.Name,
+					"due_date":            input.DueDate,
+				},
+			}); err != nil {
+				return nil, fmt.Errorf("发送任务提醒失败: %w", err)
+			}
+		}
+		result.Recipients = recipients
+	case model.EscalationReassign:
+		if err := a.tasks.Reassign(ctx, id, input.Assignee, input.CandidateGroups); err != nil {
+			return nil, fmt.Errorf("转交用户任务失败: %w", err)
+		}
+	case model.EscalationRaisePriority:
+		raised, err := a.tasks.RaisePriority(ctx, id, input.Priority)
+		if err != nil {
+			return nil, fmt.Errorf("提高用户任务优先级失败: %w", err)
+		}
+		if !raised {
+			return &EscalateUserTaskResult{Reason: "任务优先级已不低于目标优先级"}, nil
+		}
+	case model.EscalationAutoComplete:
+		if err := a.tasks.Complete(ctx, id, input.Variables); err != nil {
+			return nil, fmt.Errorf("自动完成用户任务失败: %w", err)
+		}
+	default:
+		return nil, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("不支持的升级动作: %s", input.Action), "UnsupportedEscalation", nil)
+	}
+
+	if a.cache != nil && input.Action != model.EscalationRemind {
+		if err := a.cache.Delete(ctx, fmt.Sprintf("task_instance:%s", id)); err != nil {
+			a.logger.Warn("清除任务实例缓存失败", zap.Int64("task_id", input.TaskID), zap.Error(err))
+		}
+	}
+	return result, nil
+}
+
+// taskRecipients 返回任务提醒的默认接收人：有办理人时为办理人，否则为全部候选用户与候选组
+func (a *ProcessActivities) taskRecipients(ctx context.Context, task *ent.TaskInstance) ([]string, error) {
+	if task.Assignee != "" {
+		return []string{task.Assignee}, nil
+	}
+	links, err := a.tasks.ListIdentityLinks(ctx, task.ID)
+	if err != nil {
+		return nil, fmt.Errorf("查询用户任务候选人失败: %w", err)
+	}
+	var recipients []string
+	for _, link := range links {
+		if link.Type != identityLinkCandidate {
+			continue
+		}
+		if link.UserID != "" {
+			recipients = append(recipients, link.UserID)
+		} else if link.GroupID != "" {
+			recipients = append(recipients, link.GroupID)
+		}
+	}
+	return recipients, nil
+}
+
+// scheduleEscalations 为等待中的用户任务按升级规则启动定时器
+// 定时器由 Temporal 持久化，工作流重启后继续计时；任务完成后 ctx 被取消，尚未触发的规则不再执行。
+// 规则中的表达式在 vars 上计算，多实例任务可以引用实例的元素变量
+func (e *processExecutor) scheduleEscalations(ctx workflow.Context, x *execution, node *model.Node, taskID int64, dueDate time.Time, vars map[string]interface{}) {
+	for i, rule := range node.UserTask.Escalations {
+		offset, err := rule.Offset()
+		if err != nil {
+			e.logger.Warn("忽略无效的升级规则", "node_id", node.ID, "rule", i, "error", err)
+			continue
+		}
+		index, rule := i, rule
+		fireAt := dueDate.Add(offset)
+		workflow.Go(ctx, func(ctx workflow.Context) {
+			if delay := fireAt.Sub(workflow.Now(ctx)); delay > 0 {
+				if err := workflow.NewTimer(ctx, delay).Get(ctx, nil); err != nil {
+					return
+				}
+			}
+			if err := e.awaitActive(ctx); err != nil {
+				return
+			}
+			if !e.awaitingTask(taskID) {
+				return
+			}
+			e.escalate(ctx, x, node, taskID, index, rule, dueDate, vars)
+		})
+	}
+}
+
+// awaitingTask 判断流程是否仍在等待指定任务完成
+func (e *processExecutor) awaitingTask(taskID int64) bool {
+	if _, ok := e.waiting[taskID]; !ok {
+		return false
+	}
+	_, completed := e.pending[taskID]
+	return !completed
+}
+
+// escalate 执行一条升级规则并记录升级事件
+// 升级失败只记录事件，不影响流程推进；自动完成成功后按完成信号推进流程
+func (e *processExecutor) escalate(ctx workflow.Context, x *execution, node *model.Node, taskID int64, index int, rule *model.EscalationRule, dueDate time.Time, vars map[string]interface{}) {
+	data := map[string]interface{}{
+		"action":   rule.Action,
+		"rule":     index,
+		"due_date": dueDate,
+	}
+	input, err := e.escalationInput(node, taskID, rule, dueDate, vars)
+	if err != nil {
+		e.logger.Warn("计算升级规则失败", "node_id", node.ID, "rule", index, "error", err)
+		data["error"] = err.Error()
+		e.recordTaskEvent(ctx, EventTaskEscalated, x, node, taskID, data)
+		return
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Second * 30,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    5,
+		},
+	})
+
+	var a *ProcessActivities
+	var result EscalateUserTaskResult
+	if err := workflow.ExecuteActivity(ctx, a.EscalateUserTaskActivity, input).Get(ctx, &result); err != nil {
+		e.logger.Warn("执行升级规则失败", "node_id", node.ID, "task_id", taskID, "rule", index, "error", err)
+		data["error"] = err.Error()
+		e.recordTaskEvent(ctx, EventTaskEscalated, x, node, taskID, data)
+		return
+	}
+
+	data["applied"] = result.Applied
+	if result.Reason != "" {
+		data["reason"] = result.Reason
+	}
+	switch rule.Action {
+	case model.EscalationRemind:
+		data["recipients"] = result.Recipients
+	case model.EscalationReassign:
+		data["assignee"] = input.Assignee
+		data["candidate_groups"] = input.CandidateGroups
+	case model.EscalationRaisePriority:
+		data["priority"] = input.Priority
+	case model.EscalationAutoComplete:
+		data["variables"] = input.Variables
+	}
+	e.logger.Info("用户任务升级", "node_id", node.ID, "task_id", taskID, "action", rule.Action, "applied", result.Applied)
+	e.recordTaskEvent(ctx, EventTaskEscalated, x, node, taskID, data)
+
+	if rule.Action == model.EscalationAutoComplete && result.Applied && e.awaitingTask(taskID) {
+		e.pending[taskID] = &UserTaskCompletedSignal{
+			NodeID:      node.ID,
+			TaskID:      taskID,
+			Variables:   input.Variables,
+			CompletedBy: escalationUser,
+		}
+	}
+}
+
+// escalationInput 按流程变量计算升级规则中的表达式，生成升级活动输入
+func (e *processExecutor) escalationInput(node *model.Node, taskID int64, rule *model.EscalationRule, dueDate time.Time, vars map[string]interface{}) (EscalateUserTaskInput, error) {
+	input := EscalateUserTaskInput{
+		ProcessInstanceID: e.input.ProcessInstanceID,
+		NodeID:            node.ID,
+		TaskID:            taskID,
+		Action:            rule.Action,
+		Priority:          rule.Priority,
+		DueDate:           dueDate,
+	}
+
+	var err error
+	if input.Recipients, err = resolveUsers(vars, rule.Recipients...); err != nil {
+		return input, fmt.Errorf("计算提醒接收人失败: %w", err)
+	}
+	assignee, err := resolveUsers(vars, rule.Assignee)
+	if err != nil {
+		return input, fmt.Errorf("计算转交办理人失败: %w", err)
+	}
+	if len(assignee) > 0 {
+		input.Assignee = assignee[0]
+	}
+	if input.CandidateGroups, err = resolveUsers(vars, rule.CandidateGroups...); err != nil {
+		return input, fmt.Errorf("计算转交候选组失败: %w", err)
+	}
+	if len(rule.Variables) > 0 {
+		input.Variables = make(map[string]interface{}, len(rule.Variables))
+		for k, v := range rule.Variables {
+			resolved, err := expr.ResolveValue(v, vars)
+			if err != nil {
+				return input, fmt.Errorf("计算自动完成变量 %s 失败: %w", k, err)
+			}
+			input.Variables[k] = resolved
+		}
+	}
+	return input, nil
+}
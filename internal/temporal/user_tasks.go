@@ -0,0 +1,167 @@
+package temporal
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+)
+
+// 与 biz 中同名常量一致的任务状态与身份关联类型，temporal 包不能依赖 biz 包
+const (
+	taskStatusCompleted   = "completed" // 已完成
+	taskStatusCancelled   = "cancelled" // 已取消
+	identityLinkCandidate = "candidate" // 候选人
+)
+
+// UserTaskStore 用户任务读写接口
+// 与 biz.TaskInstanceRepo 的同名方法签名一致，由数据层仓储直接实现
+type UserTaskStore interface {
+	Create(ctx context.Context, ti *ent.TaskInstance) (*ent.TaskInstance, error)
+	GetByID(ctx context.Context, id string) (*ent.TaskInstance, error)
+	ListByExecution(ctx context.Context, processInstanceID int64, executionID string, taskDefinitionKey string) ([]*ent.TaskInstance, error)
+	AddCandidates(ctx context.Context, taskID int64, userIDs []string, groupIDs []string) error
+	ListIdentityLinks(ctx context.Context, taskID int64) ([]*ent.TaskIdentityLink, error)
+	Complete(ctx context.Context, id string, variables map[string]interface{}) error
+	Reassign(ctx context.Context, id string, assigneeID string, groupIDs []string) error
+	RaisePriority(ctx context.Context, id string, priority int32) (bool, error)
+	Cancel(ctx context.Context, id string, reason string) error
+}
+
+// CreateUserTaskInput 创建用户任务活动输入
+// 办理人与候选人中的表达式已由工作流按流程变量计算完成
+type CreateUserTaskInput struct {
+	ProcessInstanceID    int64      `json:"process_instance_id"`
+	ProcessDefinitionID  int64      `json:"process_definition_id"`
+	ProcessDefinitionKey string     `json:"process_definition_key"`
+	TenantID             string     `json:"tenant_id"`
+	ExecutionID          string     `json:"execution_id"`
+	NodeID               string     `json:"node_id"`
+	Visit                int        `json:"visit"` // 执行第几次到达该节点，从 1 开始
+	Name                 string     `json:"name"`
+	Assignee             string     `json:"assignee"`
+	CandidateUsers       []string   `json:"candidate_users"`
+	CandidateGroups      []string   `json:"candidate_groups"`
+	FormKey              string     `json:"form_key"`
+	Priority             int32      `json:"priority"`
+	DueDate              *time.Time `json:"due_date"`
+	CreateTime           time.Time  `json:"create_time"`
+}
+
+// CreateUserTaskActivity 为到达用户任务的执行创建运行时任务并登记候选人，返回任务ID
+// 执行每到达一次节点只创建一个任务：本次到达的任务已存在时无论是否已结束都沿用该任务，
+// 活动重试不会重复创建任务或候选人，任务在重试前已被完成时也不会再创建新任务
+func (a *ProcessActivities) CreateUserTaskActivity(ctx context.Context, input CreateUserTaskInput) (int64, error) {
+	if a.tasks == nil {
+		return 0, fmt.Errorf("未配置用户任务存储")
+	}
+
+	existing, err := a.tasks.ListByExecution(ctx, input.ProcessInstanceID, input.ExecutionID, input.NodeID)
+	if err != nil {
+		return 0, fmt.Errorf("查询用户任务失败: %w", err)
+	}
+	visit := input.Visit
+	if visit < 1 {
+		visit = 1
+	}
+
+	var task *ent.TaskInstance
+	if len(existing) >= visit {
+		task = existing[visit-1]
+		a.logger.Info("用户任务已存在",
+			zap.Int64("process_instance_id", input.ProcessInstanceID),
+			zap.String("node_id", input.NodeID),
+			zap.Int64("task_id", task.ID),
+			zap.String("status", task.Status))
+	} else if task, err = a.createUserTask(ctx, input); err != nil {
+		return 0, err
+	}
+
+	if len(input.CandidateUsers) > 0 || len(input.CandidateGroups) > 0 {
+		if err := a.tasks.AddCandidates(ctx, task.ID, input.CandidateUsers, input.CandidateGroups); err != nil {
+			a.logger.Error("登记用户任务候选人失败",
+				zap.Int64("task_id", task.ID),
+				zap.Error(err))
+			return 0, fmt.Errorf("登记用户任务候选人失败: %w", err)
+		}
+	}
+	return task.ID, nil
+}
+
+// createUserTask 创建运行时任务
+func (a *ProcessActivities) createUserTask(ctx context.Context, input CreateUserTaskInput) (*ent.TaskInstance, error) {
+
+	task, err := a.tasks.Create(ctx, &ent.TaskInstance{
+		Name:                 input.Name,
+		TaskDefinitionKey:    input.NodeID,
+		Assignee:             input.Assignee,
+		FormKey:              input.FormKey,
+		Priority:             input.Priority,
+		DueDate:              input.DueDate,
+		CreateTime:           input.CreateTime,
+		ExecutionID:          input.ExecutionID,
+		ProcessInstanceID:    input.ProcessInstanceID,
+		ProcessDefinitionID:  input.ProcessDefinitionID,
+		ProcessDefinitionKey: input.ProcessDefinitionKey,
+		TenantID:             input.TenantID,
+	})
+	if err != nil {
+		a.logger.Error("创建用户任务失败",
+			zap.Int64("process_instance_id", input.ProcessInstanceID),
+			zap.String("node_id", input.NodeID),
+			zap.Error(err))
+		return nil, fmt.Errorf("创建用户任务失败: %w", err)
+	}
+
+	a.logger.Info("用户任务创建成功",
+		zap.Int64("process_instance_id", input.ProcessInstanceID),
+		zap.String("node_id", input.NodeID),
+		zap.Int64("task_id", task.ID),
+		zap.String("assignee", task.Assignee))
+	return task, nil
+}
+
+// CancelUserTaskInput 取消用户任务活动输入
+type CancelUserTaskInput struct {
+	ProcessInstanceID int64  `json:"process_instance_id"`
+	NodeID            string `json:"node_id"`
+	TaskID            int64  `json:"task_id"`
+	Reason            string `json:"reason"`
+}
+
+// CancelUserTaskActivity 取消不再需要的用户任务，例如多实例完成条件成立后剩余的任务
+// 任务已结束时不做处理，活动重试不会重复取消；返回任务是否由本次调用取消
+func (a *ProcessActivities) CancelUserTaskActivity(ctx context.Context, input CancelUserTaskInput) (bool, error) {
+	if a.tasks == nil {
+		return false, fmt.Errorf("未配置用户任务存储")
+	}
+
+	id := strconv.FormatInt(input.TaskID, 10)
+	task, err := a.tasks.GetByID(ctx, id)
+	if err != nil {
+		return false, fmt.Errorf("获取用户任务失败: %w", err)
+	}
+	if task.Status == taskStatusCompleted || task.Status == taskStatusCancelled {
+		return false, nil
+	}
+	// 并发完成导致取消失败时由重试重新检查任务状态
+	if err := a.tasks.Cancel(ctx, id, input.Reason); err != nil {
+		return false, fmt.Errorf("取消用户任务失败: %w", err)
+	}
+
+	if a.cache != nil {
+		if err := a.cache.Delete(ctx, fmt.Sprintf("task_instance:%s", id)); err != nil {
+			a.logger.Warn("清除任务实例缓存失败", zap.Int64("task_id", input.TaskID), zap.Error(err))
+		}
+	}
+	a.logger.Info("用户任务已取消",
+		zap.Int64("process_instance_id", input.ProcessInstanceID),
+		zap.String("node_id", input.NodeID),
+		zap.Int64("task_id", input.TaskID),
+		zap.String("reason", input.Reason))
+	return true, nil
+}
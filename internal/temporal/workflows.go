@@ -1,18 +1,23 @@
 package temporal
 
 import (
+	"fmt"
 	"time"
 
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
+
+	"github.com/workflow-engine/workflow-engine/internal/model"
 )
 
 // ProcessWorkflowInput 流程工作流输入参数
 type ProcessWorkflowInput struct {
+	ProcessInstanceID   int64                  `json:"process_instance_id"`
 	ProcessDefinitionID int64                  `json:"process_definition_id"`
 	BusinessKey         string                 `json:"business_key"`
 	Variables           map[string]interface{} `json:"variables"`
 	Initiator           string                 `json:"initiator"`
+	TenantID            string                 `json:"tenant_id"` // 流程实例所属租户，引擎创建的任务归属同一租户
 }
 
 // ProcessWorkflowResult 流程工作流结果
@@ -45,22 +50,33 @@ type ApprovalWorkflowInput struct {
 	Approvers  []string               `json:"approvers"`
 	Content    map[string]interface{} `json:"content"`
 	Deadline   time.Duration          `json:"deadline"`
-	RequireAll bool                   `json:"require_all"`
+	RequireAll bool                   `json:"require_all"` // 未指定投票策略时使用，true 为全体一致，false 为任一同意
+	Policy     string                 `json:"policy"`      // 投票策略，见 ApprovalPolicy 常量
+	Weights    map[string]float64     `json:"weights"`     // 加权投票中各审批人的权重，未配置的审批人权重为 1
+	Threshold  float64                `json:"threshold"`   // 加权投票的通过阈值，占总权重的比例，默认 0.5
+	Quorum     int                    `json:"quorum"`      // 法定人数策略要求的最少投票人数
 }
 
 // ApprovalWorkflowResult 审批工作流结果
 type ApprovalWorkflowResult struct {
-	RequestID string    `json:"request_id"`
-	Status    string    `json:"status"`
-	Approved  bool      `json:"approved"`
-	Comments  string    `json:"comments"`
-	EndTime   time.Time `json:"end_time"`
+	RequestID string         `json:"request_id"`
+	Status    string         `json:"status"`
+	Approved  bool           `json:"approved"`
+	Comments  string         `json:"comments"`
+	Policy    string         `json:"policy"`
+	Votes     []ApprovalVote `json:"votes"`
+	EndTime   time.Time      `json:"end_time"`
 }
 
 // ProcessWorkflow 流程工作流
+// 加载流程定义并解析为流程模型，然后由解释器沿流程图逐个节点执行：
+// 服务任务作为活动执行，用户任务作为等待状态，网关计算出口，到达结束事件时完成
 func ProcessWorkflow(ctx workflow.Context, input ProcessWorkflowInput) (*ProcessWorkflowResult, error) {
 	logger := workflow.GetLogger(ctx)
-	logger.Info("开始执行流程工作流", "process_definition_id", input.ProcessDefinitionID, "business_key", input.BusinessKey)
+	logger.Info("开始执行流程工作流",
+		"process_instance_id", input.ProcessInstanceID,
+		"process_definition_id", input.ProcessDefinitionID,
+		"business_key", input.BusinessKey)
 
 	// 设置工作流选项
 	options := workflow.ActivityOptions{
@@ -74,115 +90,91 @@ func ProcessWorkflow(ctx workflow.Context, input ProcessWorkflowInput) (*Process
 	}
 	ctx = workflow.WithActivityOptions(ctx, options)
 
-	// 1. 验证数据
-	var validateResult ValidateDataResult
-	err := workflow.ExecuteActivity(ctx, ValidateDataActivity, ValidateDataInput{
-		ProcessDefinitionID: input.ProcessDefinitionID,
-		Variables:           input.Variables,
-	}).Get(ctx, &validateResult)
-	if err != nil {
-		logger.Error("数据验证失败", "error", err)
-		return &ProcessWorkflowResult{
-			Status:  "failed",
-			Result:  map[string]interface{}{"error": err.Error()},
-			EndTime: workflow.Now(ctx),
-		}, nil
+	failed := func(err error) (*ProcessWorkflowResult, error) {
+		logger.Error("流程工作流执行失败", "error", err)
+		result := &ProcessWorkflowResult{
+			ProcessInstanceID: input.ProcessInstanceID,
+			Status:            ProcessStatusFailed,
+			Result:            map[string]interface{}{"error": err.Error()},
+			EndTime:           workflow.Now(ctx),
+		}
+		finishProcess(ctx, input, result)
+		return result, nil
 	}
 
-	if !validateResult.Valid {
-		logger.Warn("数据验证未通过", "errors", validateResult.Errors)
-		return &ProcessWorkflowResult{
-			Status:  "failed",
-			Result:  map[string]interface{}{"validation_errors": validateResult.Errors},
-			EndTime: workflow.Now(ctx),
-		}, nil
+	// 1. 加载并解析流程定义
+	var a *ProcessActivities
+	var resource string
+	if err := workflow.ExecuteActivity(ctx, a.LoadProcessDefinitionActivity, input.ProcessDefinitionID).Get(ctx, &resource); err != nil {
+		return failed(fmt.Errorf("加载流程定义失败: %w", err))
 	}
-
-	// 2. 更新状态为运行中
-	err = workflow.ExecuteActivity(ctx, UpdateStatusActivity, UpdateStatusInput{
-		ProcessInstanceID: validateResult.ProcessInstanceID,
-		Status:            "running",
-	}).Get(ctx, nil)
+	def, err := model.Parse([]byte(resource))
 	if err != nil {
-		logger.Error("更新状态失败", "error", err)
-		return &ProcessWorkflowResult{
-			Status:  "failed",
-			Result:  map[string]interface{}{"error": err.Error()},
-			EndTime: workflow.Now(ctx),
-		}, nil
+		return failed(fmt.Errorf("解析流程定义失败: %w", err))
 	}
 
-	// 3. 发送开始通知
-	err = workflow.ExecuteActivity(ctx, SendNotificationActivity, SendNotificationInput{
-		Type:      "process_started",
-		Recipient: input.Initiator,
-		Data: map[string]interface{}{
-			"process_instance_id": validateResult.ProcessInstanceID,
-			"business_key":        input.BusinessKey,
-		},
+	// 2. 更新状态为运行中
+	err = workflow.ExecuteActivity(ctx, a.UpdateStatusActivity, UpdateStatusInput{
+		ProcessInstanceID: input.ProcessInstanceID,
+		Status:            ProcessStatusRunning,
 	}).Get(ctx, nil)
 	if err != nil {
-		logger.Warn("发送通知失败", "error", err)
-		// 通知失败不影响流程继续
+		return failed(fmt.Errorf("更新状态失败: %w", err))
 	}
 
-	// 4. 等待完成信号或超时
-	selector := workflow.NewSelector(ctx)
-	var result ProcessWorkflowResult
-
-	// 设置超时
-	timerFuture := workflow.NewTimer(ctx, time.Hour*24) // 24小时超时
-	selector.AddFuture(timerFuture, func(f workflow.Future) {
-		result = ProcessWorkflowResult{
-			ProcessInstanceID: validateResult.ProcessInstanceID,
-			Status:            "timeout",
-			Result:            map[string]interface{}{"message": "工作流执行超时"},
-			EndTime:           workflow.Now(ctx),
-		}
-	})
+	// 3. 沿流程图执行节点
+	executor := newProcessExecutor(ctx, def, input)
+	if err := executor.listen(ctx); err != nil {
+		return failed(err)
+	}
+	if err := executor.run(ctx); err != nil {
+		executor.state.Status = ProcessStatusFailed
+		return failed(err)
+	}
+	executor.state.Status = ProcessStatusCompleted
 
-	// 等待完成信号
-	completeSignal := workflow.GetSignalChannel(ctx, "complete")
-	selector.AddReceive(completeSignal, func(c workflow.ReceiveChannel, more bool) {
-		var signalData map[string]interface{}
-		c.Receive(ctx, &signalData)
+	result := &ProcessWorkflowResult{
+		ProcessInstanceID: input.ProcessInstanceID,
+		Status:            ProcessStatusCompleted,
+		Result:            executor.variables,
+		EndTime:           workflow.Now(ctx),
+	}
+	finishProcess(ctx, input, result)
 
-		result = ProcessWorkflowResult{
-			ProcessInstanceID: validateResult.ProcessInstanceID,
-			Status:            "completed",
-			Result:            signalData,
-			EndTime:           workflow.Now(ctx),
-		}
-	})
+	logger.Info("流程工作流执行完成", "status", result.Status)
+	return result, nil
+}
 
-	// 等待其中一个条件满足
-	selector.Select(ctx)
+// finishProcess 更新流程最终状态并发送完成通知，失败只记录日志
+func finishProcess(ctx workflow.Context, input ProcessWorkflowInput, result *ProcessWorkflowResult) {
+	logger := workflow.GetLogger(ctx)
 
-	// 5. 更新最终状态
-	err = workflow.ExecuteActivity(ctx, UpdateStatusActivity, UpdateStatusInput{
+	var a *ProcessActivities
+	var reason string
+	if result.Status == ProcessStatusFailed {
+		reason, _ = result.Result["error"].(string)
+	}
+	err := workflow.ExecuteActivity(ctx, a.UpdateStatusActivity, UpdateStatusInput{
 		ProcessInstanceID: result.ProcessInstanceID,
 		Status:            result.Status,
+		Reason:            reason,
+		EndTime:           result.EndTime,
 	}).Get(ctx, nil)
 	if err != nil {
 		logger.Error("更新最终状态失败", "error", err)
 	}
 
-	// 6. 发送完成通知
 	err = workflow.ExecuteActivity(ctx, SendNotificationActivity, SendNotificationInput{
 		Type:      "process_completed",
 		Recipient: input.Initiator,
 		Data: map[string]interface{}{
 			"process_instance_id": result.ProcessInstanceID,
 			"status":              result.Status,
-			"result":              result.Result,
 		},
 	}).Get(ctx, nil)
 	if err != nil {
 		logger.Warn("发送完成通知失败", "error", err)
 	}
-
-	logger.Info("流程工作流执行完成", "status", result.Status)
-	return &result, nil
 }
 
 // TaskWorkflow 任务工作流
@@ -240,9 +232,58 @@ func TaskWorkflow(ctx workflow.Context, input TaskWorkflowInput) (*TaskWorkflowR
 }
 
 // ApprovalWorkflow 审批工作流
+// 按投票策略收集审批人的投票，每次投票后重新计算决议，形成决议或截止时间到达时结束；
+// 投票可通过信号或更新提交，更新会拒绝重复、越权与决议后的投票，决议后到达的信号记录为迟到投票。
+// 运行期间可通过 QueryApprovalState 查询当前计票
 func ApprovalWorkflow(ctx workflow.Context, input ApprovalWorkflowInput) (*ApprovalWorkflowResult, error) {
 	logger := workflow.GetLogger(ctx)
-	logger.Info("开始执行审批工作流", "request_id", input.RequestID, "approvers", input.Approvers)
+	logger.Info("开始执行审批工作流", "request_id", input.RequestID, "approvers", input.Approvers, "policy", input.Policy)
+
+	if len(input.Approvers) == 0 {
+		return nil, fmt.Errorf("审批人不能为空")
+	}
+	name, policy, err := newApprovalPolicy(input)
+	if err != nil {
+		return nil, err
+	}
+	tracker := newApprovalTracker(input, name, policy)
+
+	if err := workflow.SetQueryHandler(ctx, QueryApprovalState, func() (*ApprovalState, error) {
+		return tracker.state, nil
+	}); err != nil {
+		return nil, fmt.Errorf("注册审批状态查询失败: %w", err)
+	}
+	if err := workflow.SetUpdateHandlerWithOptions(ctx, UpdateApprovalVote,
+		func(ctx workflow.Context, vote ApprovalData) (*ApprovalState, error) {
+			tracker.record(vote, workflow.Now(ctx))
+			return tracker.state, nil
+		},
+		workflow.UpdateHandlerOptions{
+			Validator: func(ctx workflow.Context, vote ApprovalData) error {
+				return tracker.validate(vote)
+			},
+		},
+	); err != nil {
+		return nil, fmt.Errorf("注册审批投票更新失败: %w", err)
+	}
+
+	approvalSignal := workflow.GetSignalChannel(ctx, SignalApproval)
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		for {
+			var vote ApprovalData
+			approvalSignal.Receive(ctx, &vote)
+			if tracker.decided() {
+				logger.Warn("审批已结束，记录迟到投票", "request_id", input.RequestID, "approver", vote.Approver)
+				tracker.late(vote, workflow.Now(ctx))
+				continue
+			}
+			if err := tracker.validate(vote); err != nil {
+				logger.Warn("忽略无效的审批投票", "request_id", input.RequestID, "approver", vote.Approver, "error", err)
+				continue
+			}
+			tracker.record(vote, workflow.Now(ctx))
+		}
+	})
 
 	// 设置活动选项
 	options := workflow.ActivityOptions{
@@ -257,7 +298,7 @@ func ApprovalWorkflow(ctx workflow.Context, input ApprovalWorkflowInput) (*Appro
 	ctx = workflow.WithActivityOptions(ctx, options)
 
 	// 发送审批请求
-	for _, approver := range input.Approvers {
+	for _, approver := range tracker.state.Approvers {
 		err := workflow.ExecuteActivity(ctx, SendNotificationActivity, SendNotificationInput{
 			Type:      "approval_request",
 			Recipient: approver,
@@ -273,93 +314,31 @@ func ApprovalWorkflow(ctx workflow.Context, input ApprovalWorkflowInput) (*Appro
 		}
 	}
 
-	// 收集审批结果
-	approvalSignal := workflow.GetSignalChannel(ctx, "approval")
-	selector := workflow.NewSelector(ctx)
-
-	approvals := make(map[string]bool)
-	var comments []string
-
-	// 设置截止时间
-	deadline := workflow.NewTimer(ctx, input.Deadline)
-	selector.AddFuture(deadline, func(f workflow.Future) {
-		// 超时处理
-	})
-
-	// 接收审批信号
-	selector.AddReceive(approvalSignal, func(c workflow.ReceiveChannel, more bool) {
-		var approval ApprovalData
-		c.Receive(ctx, &approval)
-
-		approvals[approval.Approver] = approval.Approved
-		if approval.Comments != "" {
-			comments = append(comments, approval.Comments)
-		}
-	})
-
-	// 等待所有审批或超时
-	for {
-		selector.Select(ctx)
-
-		// 检查是否所有人都审批了
-		if len(approvals) >= len(input.Approvers) {
-			break
-		}
-
-		// 检查是否超时
-		if deadline.IsReady() {
-			break
-		}
-
-		// 如果不需要所有人同意，有一个人拒绝就可以结束
-		if !input.RequireAll {
-			for _, approved := range approvals {
-				if !approved {
-					break
-				}
-			}
-		}
-	}
-
-	// 计算最终结果
-	var finalApproved bool
-	if input.RequireAll {
-		// 需要所有人同意
-		finalApproved = len(approvals) == len(input.Approvers)
-		for _, approved := range approvals {
-			if !approved {
-				finalApproved = false
-				break
-			}
+	// 等待形成决议或截止时间到达，未设置截止时间时一直等待
+	if input.Deadline > 0 {
+		ok, err := workflow.AwaitWithTimeout(ctx, input.Deadline, tracker.decided)
+		if err != nil {
+			return nil, fmt.Errorf("等待审批投票失败: %w", err)
 		}
-	} else {
-		// 只需要一个人同意
-		for _, approved := range approvals {
-			if approved {
-				finalApproved = true
-				break
-			}
+		if !ok {
+			tracker.expire(workflow.Now(ctx))
 		}
+	} else if err := workflow.Await(ctx, tracker.decided); err != nil {
+		return nil, fmt.Errorf("等待审批投票失败: %w", err)
 	}
 
-	var status string
-	if len(approvals) < len(input.Approvers) && deadline.IsReady() {
-		status = "timeout"
-	} else if finalApproved {
-		status = "approved"
-	} else {
-		status = "rejected"
-	}
-
+	state := tracker.state
 	result := &ApprovalWorkflowResult{
 		RequestID: input.RequestID,
-		Status:    status,
-		Approved:  finalApproved,
-		Comments:  joinComments(comments),
+		Status:    state.Status,
+		Approved:  state.Approved,
+		Comments:  joinComments(tracker.comments()),
+		Policy:    state.Policy,
+		Votes:     state.Votes,
 		EndTime:   workflow.Now(ctx),
 	}
 
-	logger.Info("审批工作流执行完成", "request_id", input.RequestID, "status", status, "approved", finalApproved)
+	logger.Info("审批工作流执行完成", "request_id", input.RequestID, "status", state.Status, "approved", state.Approved)
 	return result, nil
 }
 
@@ -0,0 +1,1152 @@
+package temporal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/testsuite"
+	"go.uber.org/zap"
+
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/model"
+)
+
+// fakeDefinitionStore 内存中的流程定义存储
+type fakeDefinitionStore map[string]string
+
+func (s fakeDefinitionStore) GetByID(ctx context.Context, id string) (*ent.ProcessDefinition, error) {
+	resource, ok := s[id]
+	if !ok {
+		return nil, &ent.NotFoundError{}
+	}
+	return &ent.ProcessDefinition{Resource: resource}, nil
+}
+
+// fakeEventStore 内存中的流程事件存储
+type fakeEventStore struct {
+	mu     sync.Mutex
+	events []*ent.ProcessEvent
+}
+
+func (s *fakeEventStore) Create(ctx context.Context, pe *ent.ProcessEvent) (*ent.ProcessEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, pe)
+	return pe, nil
+}
+
+// executions 返回指定节点指定类型事件的执行ID
+func (s *fakeEventStore) executions(eventType, activityID string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ids []string
+	for _, e := range s.events {
+		if e.EventType == eventType && e.ActivityID == activityID {
+			ids = append(ids, e.ExecutionID)
+		}
+	}
+	return ids
+}
+
+// eventsOfType 返回指定类型的事件
+func (s *fakeEventStore) eventsOfType(eventType string) []*ent.ProcessEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var events []*ent.ProcessEvent
+	for _, e := range s.events {
+		if e.EventType == eventType {
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+// fakeTaskStore 内存中的用户任务存储
+type fakeTaskStore struct {
+	mu     sync.Mutex
+	tasks  []*ent.TaskInstance
+	users  map[int64][]string // 任务候选用户
+	groups map[int64][]string // 任务候选组
+}
+
+func (s *fakeTaskStore) Create(ctx context.Context, ti *ent.TaskInstance) (*ent.TaskInstance, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	task := *ti
+	task.ID = int64(len(s.tasks) + 1)
+	s.tasks = append(s.tasks, &task)
+	return &task, nil
+}
+
+func (s *fakeTaskStore) ListByExecution(ctx context.Context, processInstanceID int64, executionID string, taskDefinitionKey string) ([]*ent.TaskInstance, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var tasks []*ent.TaskInstance
+	for _, task := range s.tasks {
+		if task.ProcessInstanceID == processInstanceID && task.ExecutionID == executionID && task.TaskDefinitionKey == taskDefinitionKey {
+			tasks = append(tasks, task)
+		}
+	}
+	return tasks, nil
+}
+
+func (s *fakeTaskStore) AddCandidates(ctx context.Context, taskID int64, userIDs []string, groupIDs []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.users == nil {
+		s.users, s.groups = make(map[int64][]string), make(map[int64][]string)
+	}
+	s.users[taskID] = appendMissing(s.users[taskID], userIDs...)
+	s.groups[taskID] = appendMissing(s.groups[taskID], groupIDs...)
+	return nil
+}
+
+func (s *fakeTaskStore) GetByID(ctx context.Context, id string) (*ent.TaskInstance, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, task := range s.tasks {
+		if strconv.FormatInt(task.ID, 10) == id {
+			copied := *task
+			return &copied, nil
+		}
+	}
+	return nil, &ent.NotFoundError{}
+}
+
+func (s *fakeTaskStore) ListIdentityLinks(ctx context.Context, taskID int64) ([]*ent.TaskIdentityLink, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var links []*ent.TaskIdentityLink
+	for _, userID := range s.users[taskID] {
+		links = append(links, &ent.TaskIdentityLink{TaskID: taskID, Type: identityLinkCandidate, UserID: userID})
+	}
+	for _, groupID := range s.groups[taskID] {
+		links = append(links, &ent.TaskIdentityLink{TaskID: taskID, Type: identityLinkCandidate, GroupID: groupID})
+	}
+	return links, nil
+}
+
+func (s *fakeTaskStore) Complete(ctx context.Context, id string, variables map[string]interface{}) error {
+	return s.update(id, func(task *ent.TaskInstance) { task.Status = taskStatusCompleted })
+}
+
+func (s *fakeTaskStore) Reassign(ctx context.Context, id string, assigneeID string, groupIDs []string) error {
+	if err := s.update(id, func(task *ent.TaskInstance) { task.Assignee = assigneeID }); err != nil {
+		return err
+	}
+	taskID, _ := strconv.ParseInt(id, 10, 64)
+	return s.AddCandidates(ctx, taskID, nil, groupIDs)
+}
+
+func (s *fakeTaskStore) RaisePriority(ctx context.Context, id string, priority int32) (bool, error) {
+	raised := false
+	err := s.update(id, func(task *ent.TaskInstance) {
+		if task.Priority < priority {
+			task.Priority, raised = priority, true
+		}
+	})
+	return raised, err
+}
+
+func (s *fakeTaskStore) Cancel(ctx context.Context, id string, reason string) error {
+	return s.update(id, func(task *ent.TaskInstance) {
+		task.Status, task.DeleteReason = taskStatusCancelled, reason
+	})
+}
+
+// update 修改指定ID的任务
+func (s *fakeTaskStore) update(id string, fn func(task *ent.TaskInstance)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, task := range s.tasks {
+		if strconv.FormatInt(task.ID, 10) == id {
+			fn(task)
+			return nil
+		}
+	}
+	return &ent.NotFoundError{}
+}
+
+// candidateGroups 返回任务的候选组
+func (s *fakeTaskStore) candidateGroups(taskID int64) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.groups[taskID]
+}
+
+// appendMissing 追加列表中尚不存在的值
+func appendMissing(list []string, values ...string) []string {
+	for _, value := range values {
+		if !slices.Contains(list, value) {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
+// task 返回指定节点最近创建的任务
+func (s *fakeTaskStore) task(nodeID string) *ent.TaskInstance {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := len(s.tasks) - 1; i >= 0; i-- {
+		if s.tasks[i].TaskDefinitionKey == nodeID {
+			return s.tasks[i]
+		}
+	}
+	return nil
+}
+
+// tasksOf 返回指定节点按创建顺序的全部任务
+func (s *fakeTaskStore) tasksOf(nodeID string) []*ent.TaskInstance {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var tasks []*ent.TaskInstance
+	for _, task := range s.tasks {
+		if task.TaskDefinitionKey == nodeID {
+			tasks = append(tasks, task)
+		}
+	}
+	return tasks
+}
+
+// id 返回指定节点最近创建的任务ID
+func (s *fakeTaskStore) id(nodeID string) int64 {
+	if task := s.task(nodeID); task != nil {
+		return task.ID
+	}
+	return 0
+}
+
+// expenseResource 报销流程：风控检查后按金额决定是否需要经理审批
+const expenseResource = `{
+	"id": "expense",
+	"name": "报销流程",
+	"elements": [
+		{"id": "start", "type": "start_event", "next": "risk"},
+		{"id": "risk", "type": "service_task", "next": "check",
+		 "config": {"service_name": "risk", "input": {"amount": "${amount}"}}},
+		{"id": "check", "type": "exclusive_gateway",
+		 "config": {"conditions": [{"expression": "${amount > 1000}", "next": "approve"}], "default": "end"}},
+		{"id": "approve", "name": "经理审批", "type": "user_task", "next": "end",
+		 "config": {"assignee": "${manager}", "candidate_groups": ["finance"], "form_key": "expense-approve", "due_date": "2d", "priority": 80}},
+		{"id": "end", "type": "end_event"}
+	]
+}`
+
+// expenseV2Resource 报销流程新版本：经理审批后增加归档
+const expenseV2Resource = `{
+	"id": "expense",
+	"name": "报销流程",
+	"elements": [
+		{"id": "start", "type": "start_event", "next": "risk"},
+		{"id": "risk", "type": "service_task", "next": "check",
+		 "config": {"service_name": "risk", "input": {"amount": "${amount}"}}},
+		{"id": "check", "type": "exclusive_gateway",
+		 "config": {"conditions": [{"expression": "${amount > 1000}", "next": "approve"}], "default": "end"}},
+		{"id": "approve", "name": "经理审批", "type": "user_task", "next": "archive"},
+		{"id": "archive", "type": "service_task", "next": "end", "config": {"service_name": "audit"}},
+		{"id": "end", "type": "end_event"}
+	]
+}`
+
+// expenseV3Resource 报销流程不含经理审批的版本，等待审批的实例不能迁移到该版本
+const expenseV3Resource = `{
+	"id": "expense",
+	"name": "报销流程",
+	"elements": [
+		{"id": "start", "type": "start_event", "next": "archive"},
+		{"id": "archive", "type": "service_task", "next": "end", "config": {"service_name": "audit"}},
+		{"id": "end", "type": "end_event"}
+	]
+}`
+
+// parallelResource 合同审批：法务与财务并行，财务分支内再并行两项审计
+const parallelResource = `{
+	"id": "contract",
+	"name": "合同审批",
+	"elements": [
+		{"id": "start", "type": "start_event", "next": "split"},
+		{"id": "split", "type": "parallel_gateway", "next": ["legal", "finance"]},
+		{"id": "legal", "type": "user_task", "next": "outer_join"},
+		{"id": "finance", "type": "parallelGateway", "next": ["audit_a", "audit_b"]},
+		{"id": "audit_a", "type": "service_task", "next": "inner_join", "config": {"service_name": "audit"}},
+		{"id": "audit_b", "type": "service_task", "next": "inner_join", "config": {"service_name": "audit"}},
+		{"id": "inner_join", "type": "parallel_gateway", "next": "outer_join"},
+		{"id": "outer_join", "type": "parallel_gateway", "next": "end"},
+		{"id": "end", "type": "end_event"}
+	]
+}`
+
+// inclusiveResource 采购流程：按条件激活评审与通知分支，都不满足时自动通过
+const inclusiveResource = `{
+	"id": "purchase",
+	"name": "采购流程",
+	"elements": [
+		{"id": "start", "type": "start_event", "next": "route"},
+		{"id": "route", "type": "inclusive_gateway", "config": {
+			"conditions": [
+				{"expression": "${amount > 1000}", "next": "review"},
+				{"expression": "${urgent}", "next": "notify"}
+			],
+			"default": "auto"}},
+		{"id": "review", "type": "user_task", "next": "merge"},
+		{"id": "notify", "type": "service_task", "next": "merge", "config": {"service_name": "audit"}},
+		{"id": "auto", "type": "service_task", "next": "merge", "config": {"service_name": "audit"}},
+		{"id": "merge", "type": "inclusive_gateway", "next": "end"},
+		{"id": "end", "type": "end_event"}
+	]
+}`
+
+// escalationResource 请假审批：到期前提醒，到期转交经理组并提高优先级，逾期一天自动通过
+const escalationResource = `{
+	"id": "leave",
+	"name": "请假流程",
+	"elements": [
+		{"id": "start", "type": "start_event", "next": "approve"},
+		{"id": "approve", "name": "主管审批", "type": "user_task", "next": "end",
+		 "config": {"assignee": "${leader}", "due_date": "1d", "priority": 50, "escalations": [
+			{"action": "remind", "before": "2h"},
+			{"action": "reassign", "candidate_groups": ["${leader}-managers"]},
+			{"action": "raise_priority", "priority": 90},
+			{"action": "auto_complete", "after": "1d", "variables": {"approved": true, "approved_by": "${leader}"}}
+		 ]}},
+		{"id": "end", "type": "end_event"}
+	]
+}`
+
+// countersignResource 合同会签：每位评审人一个并行任务，六成通过即结束会签
+const countersignResource = `{
+	"id": "countersign",
+	"name": "合同会签",
+	"elements": [
+		{"id": "start", "type": "start_event", "next": "sign"},
+		{"id": "sign", "name": "会签", "type": "user_task", "next": "end",
+		 "config": {"assignee": "${reviewer}", "multi_instance": {
+			"collection": "reviewers", "element_variable": "reviewer",
+			"completion_condition": "${nrOfCompletedInstances/nrOfInstances >= 0.6}"}}},
+		{"id": "end", "type": "end_event"}
+	]
+}`
+
+// sequentialResource 逐级审批：评审人依次审批，任一人驳回即结束
+const sequentialResource = `{
+	"id": "chain",
+	"name": "逐级审批",
+	"elements": [
+		{"id": "start", "type": "start_event", "next": "approve"},
+		{"id": "approve", "type": "user_task", "next": "end",
+		 "config": {"assignee": "${approver}", "candidate_groups": ["level-${loopCounter}"], "multi_instance": {
+			"sequential": true, "collection": "${approvers}", "element_variable": "approver",
+			"completion_condition": "${rejected == true}"}}},
+		{"id": "end", "type": "end_event"}
+	]
+}`
+
+// batchResource 批量审计：按集合并行调用审计服务
+const batchResource = `{
+	"id": "batch",
+	"name": "批量审计",
+	"elements": [
+		{"id": "start", "type": "start_event", "next": "check"},
+		{"id": "check", "type": "service_task", "next": "end",
+		 "config": {"service_name": "check", "input": {"index": "${loopCounter}", "item": "${item}", "total": "${nrOfInstances}"},
+		 "multi_instance": {"collection": "items", "element_variable": "item"}}},
+		{"id": "end", "type": "end_event"}
+	]
+}`
+
+// ProcessWorkflowTestSuite 流程工作流测试套件
+type ProcessWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env        *testsuite.TestWorkflowEnvironment
+	activities *ProcessActivities
+	events     *fakeEventStore
+	tasks      *fakeTaskStore
+}
+
+func (s *ProcessWorkflowTestSuite) SetupTest() {
+	s.env = s.NewTestWorkflowEnvironment()
+	services := NewServiceRegistry()
+	services.Register("risk", func(ctx context.Context, input ServiceTaskInput) (map[string]interface{}, error) {
+		return map[string]interface{}{"risk_checked": true, "risk_amount": input.Input["amount"]}, nil
+	})
+	services.Register("audit", func(ctx context.Context, input ServiceTaskInput) (map[string]interface{}, error) {
+		return map[string]interface{}{input.NodeID + "_done": true}, nil
+	})
+	services.Register("check", func(ctx context.Context, input ServiceTaskInput) (map[string]interface{}, error) {
+		return map[string]interface{}{
+			fmt.Sprintf("checked_%v", input.Input["index"]): input.Input["item"],
+			"check_total": input.Input["total"],
+		}, nil
+	})
+	s.events = &fakeEventStore{}
+	s.tasks = &fakeTaskStore{}
+	definitions := fakeDefinitionStore{
+		"1": expenseResource,
+		"2": parallelResource,
+		"3": inclusiveResource,
+		"4": escalationResource,
+		"5": countersignResource,
+		"6": sequentialResource,
+		"7": batchResource,
+		"8": expenseV2Resource,
+		"9": expenseV3Resource,
+	}
+	s.activities = NewProcessActivities(definitions, nil, nil, nil, s.events, s.tasks, nil, services, zap.NewNop())
+	s.env.RegisterActivity(s.activities)
+	s.env.RegisterActivity(SendNotificationActivity)
+}
+
+func (s *ProcessWorkflowTestSuite) AfterTest(suiteName, testName string) {
+	s.env.AssertExpectations(s.T())
+}
+
+// TestSmallAmountSkipsApproval 小额报销走默认分支直接结束
+func (s *ProcessWorkflowTestSuite) TestSmallAmountSkipsApproval() {
+	s.env.ExecuteWorkflow(ProcessWorkflow, ProcessWorkflowInput{
+		ProcessInstanceID:   100,
+		ProcessDefinitionID: 1,
+		Variables:           map[string]interface{}{"amount": 200},
+	})
+
+	s.True(s.env.IsWorkflowCompleted(), "工作流应该已完成")
+	s.NoError(s.env.GetWorkflowError())
+
+	var result ProcessWorkflowResult
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal(ProcessStatusCompleted, result.Status, "流程应该正常完成")
+	s.Equal(int64(100), result.ProcessInstanceID, "流程实例ID应该匹配")
+	s.Equal(true, result.Result["risk_checked"], "服务任务输出应该合并到流程变量")
+	s.EqualValues(200, result.Result["risk_amount"], "服务任务输入应该解析流程变量")
+}
+
+// TestLargeAmountWaitsForApproval 大额报销需要等待用户任务完成
+func (s *ProcessWorkflowTestSuite) TestLargeAmountWaitsForApproval() {
+	s.env.RegisterDelayedCallback(func() {
+		var state ProcessState
+		value, err := s.env.QueryWorkflow(QueryProcessState)
+		s.NoError(err)
+		s.NoError(value.Get(&state))
+		s.Equal([]string{"approve"}, state.ActiveNodes, "流程应该停留在审批节点")
+
+		// 到达用户任务时应该按节点配置创建任务
+		task := s.tasks.task("approve")
+		s.Require().NotNil(task, "应该创建审批任务")
+		s.Equal("经理审批", task.Name, "任务名称应该取节点名称")
+		s.Equal("alice", task.Assignee, "办理人表达式应该按流程变量计算")
+		s.Equal([]string{"finance"}, s.tasks.candidateGroups(task.ID), "候选组应该取节点配置")
+		s.Equal("expense-approve", task.FormKey, "表单键应该取节点配置")
+		s.Equal(int32(80), task.Priority, "优先级应该取节点配置")
+		s.Equal(int64(101), task.ProcessInstanceID, "任务应该关联流程实例")
+		s.Equal("expense", task.ProcessDefinitionKey, "任务应该关联流程定义键")
+		s.Equal(rootExecutionID, task.ExecutionID, "任务应该关联所在执行")
+		s.Equal("tenant-a", task.TenantID, "任务应该属于流程实例所在的租户")
+		s.Require().NotNil(task.DueDate, "应该计算到期时间")
+		s.Equal(48*time.Hour, task.DueDate.Sub(task.CreateTime), "到期时间应该按到期时长计算")
+
+		// 非等待节点的信号应该被忽略
+		s.env.SignalWorkflow(SignalUserTaskCompleted, UserTaskCompletedSignal{NodeID: "risk"})
+		// 任务ID不匹配的信号应该被忽略
+		s.env.SignalWorkflow(SignalUserTaskCompleted, UserTaskCompletedSignal{
+			NodeID:    "approve",
+			TaskID:    task.ID + 100,
+			Variables: map[string]interface{}{"approved": false},
+		})
+		s.env.SignalWorkflow(SignalUserTaskCompleted, UserTaskCompletedSignal{
+			NodeID:      "approve",
+			TaskID:      task.ID,
+			Variables:   map[string]interface{}{"approved": true},
+			CompletedBy: "manager",
+		})
+	}, time.Hour)
+
+	s.env.ExecuteWorkflow(ProcessWorkflow, ProcessWorkflowInput{
+		ProcessInstanceID:   101,
+		ProcessDefinitionID: 1,
+		Variables:           map[string]interface{}{"amount": 5000, "manager": "alice"},
+		TenantID:            "tenant-a",
+	})
+
+	s.True(s.env.IsWorkflowCompleted(), "工作流应该已完成")
+	var result ProcessWorkflowResult
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal(ProcessStatusCompleted, result.Status, "流程应该正常完成")
+	s.Equal(true, result.Result["approved"], "用户任务提交的变量应该合并到流程变量")
+}
+
+// TestDuplicateCompletionAdvancesOnce 同一任务的重复完成信号只推进一次流程
+func (s *ProcessWorkflowTestSuite) TestDuplicateCompletionAdvancesOnce() {
+	s.env.RegisterDelayedCallback(func() {
+		signal := UserTaskCompletedSignal{
+			NodeID:    "approve",
+			TaskID:    s.tasks.id("approve"),
+			Variables: map[string]interface{}{"approved": true},
+		}
+		s.env.SignalWorkflow(SignalUserTaskCompleted, signal)
+		s.env.SignalWorkflow(SignalUserTaskCompleted, signal)
+	}, time.Hour)
+
+	s.env.ExecuteWorkflow(ProcessWorkflow, ProcessWorkflowInput{
+		ProcessInstanceID:   105,
+		ProcessDefinitionID: 1,
+		Variables:           map[string]interface{}{"amount": 5000, "manager": "alice"},
+	})
+
+	var result ProcessWorkflowResult
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal(ProcessStatusCompleted, result.Status, "流程应该正常完成")
+	s.Len(s.tasks.tasks, 1, "审批节点只应该创建一个任务")
+	s.Len(s.events.executions(EventActivityCompleted, "approve"), 1, "审批节点只应该完成一次")
+	s.Len(s.events.executions(EventActivityCompleted, "end"), 1, "结束事件只应该执行一次")
+}
+
+// TestCompletionSignalWithTaskCreation 完成信号与创建任务的活动结果同时到达时流程仍然推进
+func (s *ProcessWorkflowTestSuite) TestCompletionSignalWithTaskCreation() {
+	s.env.OnActivity(s.activities.CreateUserTaskActivity, mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, input CreateUserTaskInput) (int64, error) {
+			taskID, err := s.activities.CreateUserTaskActivity(ctx, input)
+			if err != nil {
+				return 0, err
+			}
+			// 任务创建后立即被完成，信号与活动结果在同一个工作流任务中处理
+			s.env.SignalWorkflow(SignalUserTaskCompleted, UserTaskCompletedSignal{
+				NodeID:    input.NodeID,
+				TaskID:    taskID,
+				Variables: map[string]interface{}{"approved": true},
+			})
+			return taskID, nil
+		})
+
+	s.env.ExecuteWorkflow(ProcessWorkflow, ProcessWorkflowInput{
+		ProcessInstanceID:   106,
+		ProcessDefinitionID: 1,
+		Variables:           map[string]interface{}{"amount": 5000, "manager": "alice"},
+	})
+
+	s.True(s.env.IsWorkflowCompleted(), "工作流应该已完成")
+	var result ProcessWorkflowResult
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal(ProcessStatusCompleted, result.Status, "提前到达的完成信号不应该丢失")
+	s.Equal(true, result.Result["approved"], "用户任务提交的变量应该合并到流程变量")
+}
+
+// TestMultiInstanceSignalWithTaskCreation 并行会签的任务创建后立即完成时流程仍然推进
+func (s *ProcessWorkflowTestSuite) TestMultiInstanceSignalWithTaskCreation() {
+	s.env.OnActivity(s.activities.CreateUserTaskActivity, mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, input CreateUserTaskInput) (int64, error) {
+			taskID, err := s.activities.CreateUserTaskActivity(ctx, input)
+			if err != nil {
+				return 0, err
+			}
+			s.env.SignalWorkflow(SignalUserTaskCompleted, UserTaskCompletedSignal{
+				NodeID:    input.NodeID,
+				TaskID:    taskID,
+				Variables: map[string]interface{}{"signed_by_" + input.Assignee: true},
+			})
+			return taskID, nil
+		})
+
+	s.env.ExecuteWorkflow(ProcessWorkflow, ProcessWorkflowInput{
+		ProcessInstanceID:   501,
+		ProcessDefinitionID: 5,
+		Variables:           map[string]interface{}{"reviewers": []interface{}{"a", "b"}},
+	})
+
+	var result ProcessWorkflowResult
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal(ProcessStatusCompleted, result.Status, "提前到达的完成信号不应该丢失")
+	s.Equal(true, result.Result["signed_by_a"], "实例提交的变量应该合并到流程变量")
+	s.Equal(true, result.Result["signed_by_b"], "实例提交的变量应该合并到流程变量")
+}
+
+// TestMigrateWhileWaiting 等待用户任务期间迁移到新版本，任务完成后按新版本继续
+func (s *ProcessWorkflowTestSuite) TestMigrateWhileWaiting() {
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalMigrate, MigrateSignal{ProcessDefinitionID: 8})
+	}, time.Hour)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalUserTaskCompleted, UserTaskCompletedSignal{NodeID: "approve", TaskID: s.tasks.id("approve")})
+	}, 2*time.Hour)
+
+	s.env.ExecuteWorkflow(ProcessWorkflow, ProcessWorkflowInput{
+		ProcessInstanceID:   111,
+		ProcessDefinitionID: 1,
+		Variables:           map[string]interface{}{"amount": 5000, "manager": "alice"},
+	})
+
+	var result ProcessWorkflowResult
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal(ProcessStatusCompleted, result.Status, "迁移后流程应该正常完成")
+	s.Equal(true, result.Result["archive_done"], "审批完成后应该按新版本执行归档")
+}
+
+// TestMigrateRejectsMissingNode 目标版本缺少正在等待的节点时不迁移
+func (s *ProcessWorkflowTestSuite) TestMigrateRejectsMissingNode() {
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalMigrate, MigrateSignal{ProcessDefinitionID: 9})
+	}, time.Hour)
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalUserTaskCompleted, UserTaskCompletedSignal{NodeID: "approve", TaskID: s.tasks.id("approve")})
+	}, 2*time.Hour)
+
+	s.env.ExecuteWorkflow(ProcessWorkflow, ProcessWorkflowInput{
+		ProcessInstanceID:   112,
+		ProcessDefinitionID: 1,
+		Variables:           map[string]interface{}{"amount": 5000, "manager": "alice"},
+	})
+
+	var result ProcessWorkflowResult
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal(ProcessStatusCompleted, result.Status, "流程应该按原版本完成")
+	s.NotContains(result.Result, "archive_done", "未迁移时不应该执行新版本的节点")
+}
+
+// TestSuspendBlocksProgress 挂起期间流程不推进，恢复后继续执行
+func (s *ProcessWorkflowTestSuite) TestSuspendBlocksProgress() {
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalSuspend, nil)
+		s.env.SignalWorkflow(SignalUserTaskCompleted, UserTaskCompletedSignal{NodeID: "approve", TaskID: s.tasks.id("approve")})
+	}, time.Hour)
+	s.env.RegisterDelayedCallback(func() {
+		var state ProcessState
+		value, err := s.env.QueryWorkflow(QueryProcessState)
+		s.NoError(err)
+		s.NoError(value.Get(&state))
+		s.Equal(ProcessStatusSuspended, state.Status, "流程应该处于挂起状态")
+		s.NotContains(state.CompletedNodes, "end", "挂起期间不应该到达结束事件")
+
+		s.env.SignalWorkflow(SignalResume, nil)
+	}, 2*time.Hour)
+
+	s.env.ExecuteWorkflow(ProcessWorkflow, ProcessWorkflowInput{
+		ProcessInstanceID:   104,
+		ProcessDefinitionID: 1,
+		Variables:           map[string]interface{}{"amount": 5000, "manager": "alice"},
+	})
+
+	var result ProcessWorkflowResult
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal(ProcessStatusCompleted, result.Status, "恢复后流程应该正常完成")
+}
+
+// TestServiceTaskFailure 服务任务失败时流程以失败状态结束
+func (s *ProcessWorkflowTestSuite) TestServiceTaskFailure() {
+	s.env.OnActivity(s.activities.ServiceTaskActivity, mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("风控服务不可用"))
+
+	s.env.ExecuteWorkflow(ProcessWorkflow, ProcessWorkflowInput{
+		ProcessInstanceID:   102,
+		ProcessDefinitionID: 1,
+		Variables:           map[string]interface{}{"amount": 1},
+	})
+
+	var result ProcessWorkflowResult
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal(ProcessStatusFailed, result.Status, "流程应该以失败状态结束")
+	s.Contains(result.Result["error"], "risk", "错误信息应该包含失败的节点")
+}
+
+// TestMissingDefinition 流程定义不存在时流程以失败状态结束
+func (s *ProcessWorkflowTestSuite) TestMissingDefinition() {
+	s.env.ExecuteWorkflow(ProcessWorkflow, ProcessWorkflowInput{
+		ProcessInstanceID:   103,
+		ProcessDefinitionID: 404,
+	})
+
+	var result ProcessWorkflowResult
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal(ProcessStatusFailed, result.Status, "流程应该以失败状态结束")
+}
+
+// TestNestedParallelRegions 嵌套并行区域全部分支完成后才汇聚
+func (s *ProcessWorkflowTestSuite) TestNestedParallelRegions() {
+	s.env.RegisterDelayedCallback(func() {
+		var state ProcessState
+		value, err := s.env.QueryWorkflow(QueryProcessState)
+		s.NoError(err)
+		s.NoError(value.Get(&state))
+		s.Equal(map[string]string{"root.1": "legal"}, state.Executions, "只有法务分支仍在等待")
+		s.Contains(state.CompletedNodes, "inner_join", "内层区域应该已经汇聚")
+		s.NotContains(state.CompletedNodes, "outer_join", "法务分支完成前外层区域不应该汇聚")
+
+		s.env.SignalWorkflow(SignalUserTaskCompleted, UserTaskCompletedSignal{
+			NodeID:    "legal",
+			TaskID:    s.tasks.id("legal"),
+			Variables: map[string]interface{}{"legal_approved": true},
+		})
+	}, time.Hour)
+
+	s.env.ExecuteWorkflow(ProcessWorkflow, ProcessWorkflowInput{
+		ProcessInstanceID:   200,
+		ProcessDefinitionID: 2,
+	})
+
+	var result ProcessWorkflowResult
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal(ProcessStatusCompleted, result.Status, "流程应该正常完成")
+	s.Equal(true, result.Result["legal_approved"], "法务分支的变量应该合并")
+	s.Equal(true, result.Result["audit_a_done"], "内层分支A的变量应该合并")
+	s.Equal(true, result.Result["audit_b_done"], "内层分支B的变量应该合并")
+
+	s.Equal([]string{"root.1"}, s.events.executions(EventActivityCompleted, "legal"), "法务分支应该有独立的执行ID")
+	s.Equal([]string{"root.2"}, s.events.executions(EventActivityCompleted, "finance"), "财务分支应该有独立的执行ID")
+	s.Equal([]string{"root.2.1"}, s.events.executions(EventActivityCompleted, "audit_a"), "嵌套分支应该派生子执行ID")
+	s.Equal([]string{"root.2.2"}, s.events.executions(EventActivityCompleted, "audit_b"), "嵌套分支应该派生子执行ID")
+	s.ElementsMatch([]string{"root.2.1", "root.2.2"}, s.events.executions(EventExecutionJoined, "inner_join"), "内层汇聚应该记录两个分支到达")
+	s.Equal([]string{"root.2"}, s.events.executions(EventActivityCompleted, "inner_join"), "内层汇聚后恢复父执行")
+	s.Equal([]string{"root"}, s.events.executions(EventActivityCompleted, "end"), "外层汇聚后恢复根执行")
+	s.Len(s.events.executions(EventActivityCompleted, "end"), 1, "结束事件只应该执行一次")
+}
+
+// TestInclusiveGatewayJoinsActivatedBranches 包容网关只等待条件成立的分支
+func (s *ProcessWorkflowTestSuite) TestInclusiveGatewayJoinsActivatedBranches() {
+	cases := []struct {
+		name      string
+		variables map[string]interface{}
+		branches  []string
+	}{
+		{"单个分支", map[string]interface{}{"amount": 10, "urgent": true}, []string{"notify"}},
+		{"多个分支", map[string]interface{}{"amount": 5000, "urgent": true}, []string{"review", "notify"}},
+		{"默认分支", map[string]interface{}{"amount": 10, "urgent": false}, []string{"auto"}},
+	}
+	for i, tc := range cases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.env.RegisterDelayedCallback(func() {
+				s.env.SignalWorkflow(SignalUserTaskCompleted, UserTaskCompletedSignal{NodeID: "review", TaskID: s.tasks.id("review")})
+			}, time.Hour)
+
+			s.env.ExecuteWorkflow(ProcessWorkflow, ProcessWorkflowInput{
+				ProcessInstanceID:   int64(300 + i),
+				ProcessDefinitionID: 3,
+				Variables:           tc.variables,
+			})
+
+			var result ProcessWorkflowResult
+			s.NoError(s.env.GetWorkflowResult(&result))
+			s.Equal(ProcessStatusCompleted, result.Status, "流程应该正常完成")
+			for _, branch := range []string{"review", "notify", "auto"} {
+				completed := s.events.executions(EventActivityCompleted, branch)
+				if contains(tc.branches, branch) {
+					s.Len(completed, 1, "分支 %s 应该执行", branch)
+				} else {
+					s.Empty(completed, "分支 %s 不应该执行", branch)
+				}
+			}
+			s.Len(s.events.executions(EventExecutionJoined, "merge"), len(tc.branches), "汇聚网关应该只等待被激活的分支")
+			s.Len(s.events.executions(EventActivityCompleted, "end"), 1, "结束事件只应该执行一次")
+		})
+	}
+}
+
+// TestParallelBranchFailure 任一分支失败时流程以失败状态结束
+func (s *ProcessWorkflowTestSuite) TestParallelBranchFailure() {
+	s.env.OnActivity(s.activities.ServiceTaskActivity, mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("审计服务不可用"))
+
+	s.env.ExecuteWorkflow(ProcessWorkflow, ProcessWorkflowInput{
+		ProcessInstanceID:   201,
+		ProcessDefinitionID: 2,
+	})
+
+	var result ProcessWorkflowResult
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal(ProcessStatusFailed, result.Status, "流程应该以失败状态结束")
+	s.Empty(s.events.executions(EventActivityStarted, "end"), "失败后不应该到达结束事件")
+}
+
+// TestEscalationRules 用户任务逾期后按升级规则提醒、转交、提高优先级并自动完成
+func (s *ProcessWorkflowTestSuite) TestEscalationRules() {
+	s.env.RegisterDelayedCallback(func() {
+		escalated := s.events.eventsOfType(EventTaskEscalated)
+		s.Require().Len(escalated, 1, "到期前两小时应该只触发提醒")
+		s.Equal([]interface{}{"alice"}, escalated[0].EventData["recipients"], "默认提醒办理人")
+	}, 23*time.Hour)
+	s.env.RegisterDelayedCallback(func() {
+		task := s.tasks.task("approve")
+		s.Equal([]string{"alice-managers"}, s.tasks.candidateGroups(task.ID), "到期后应该转交经理组")
+		s.Equal(int32(90), task.Priority, "到期后应该提高优先级")
+		s.Len(s.events.eventsOfType(EventTaskEscalated), 3, "自动完成前应该记录三次升级")
+	}, 25*time.Hour)
+
+	s.env.ExecuteWorkflow(ProcessWorkflow, ProcessWorkflowInput{
+		ProcessInstanceID:   400,
+		ProcessDefinitionID: 4,
+		Variables:           map[string]interface{}{"leader": "alice"},
+	})
+
+	var result ProcessWorkflowResult
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal(ProcessStatusCompleted, result.Status, "自动完成后流程应该正常结束")
+	s.Equal(true, result.Result["approved"], "自动完成的变量应该合并到流程变量")
+	s.Equal("alice", result.Result["approved_by"], "自动完成的变量应该按流程变量计算")
+	s.Equal(taskStatusCompleted, s.tasks.task("approve").Status, "任务应该被自动完成")
+
+	escalated := s.events.eventsOfType(EventTaskEscalated)
+	s.Require().Len(escalated, 4, "每条升级规则都应该记录事件")
+	var actions []interface{}
+	for _, e := range escalated {
+		actions = append(actions, e.EventData["action"])
+		s.Equal(s.tasks.id("approve"), e.TaskID, "升级事件应该关联任务")
+		s.Equal(true, e.EventData["applied"], "升级动作应该已执行")
+	}
+	s.Equal([]interface{}{"remind", "reassign", "raise_priority", "auto_complete"}, actions, "升级事件应该按触发时间记录")
+}
+
+// TestEscalationCancelledOnCompletion 到期前完成的任务不再触发升级
+func (s *ProcessWorkflowTestSuite) TestEscalationCancelledOnCompletion() {
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalUserTaskCompleted, UserTaskCompletedSignal{
+			NodeID:    "approve",
+			TaskID:    s.tasks.id("approve"),
+			Variables: map[string]interface{}{"approved": false},
+		})
+	}, time.Hour)
+
+	s.env.ExecuteWorkflow(ProcessWorkflow, ProcessWorkflowInput{
+		ProcessInstanceID:   401,
+		ProcessDefinitionID: 4,
+		Variables:           map[string]interface{}{"leader": "alice"},
+	})
+
+	var result ProcessWorkflowResult
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal(ProcessStatusCompleted, result.Status, "流程应该正常完成")
+	s.Equal(false, result.Result["approved"], "应该使用办理人提交的变量")
+	s.Empty(s.events.eventsOfType(EventTaskEscalated), "完成后不应该再触发升级")
+}
+
+// TestParallelMultiInstanceCompletionCondition 并行会签在完成条件成立后取消剩余任务
+func (s *ProcessWorkflowTestSuite) TestParallelMultiInstanceCompletionCondition() {
+	s.env.RegisterDelayedCallback(func() {
+		tasks := s.tasks.tasksOf("sign")
+		s.Require().Len(tasks, 5, "每位评审人应该有一个任务")
+		var assignees, executions []string
+		for _, task := range tasks {
+			assignees = append(assignees, task.Assignee)
+			executions = append(executions, task.ExecutionID)
+		}
+		s.Equal([]string{"a", "b", "c", "d", "e"}, assignees, "办理人应该按元素变量计算")
+		s.Equal([]string{"root.1", "root.2", "root.3", "root.4", "root.5"}, executions, "每个实例应该有独立的执行ID")
+
+		for _, task := range tasks[:3] {
+			s.env.SignalWorkflow(SignalUserTaskCompleted, UserTaskCompletedSignal{
+				NodeID:    "sign",
+				TaskID:    task.ID,
+				Variables: map[string]interface{}{"signed_by_" + task.Assignee: true},
+			})
+		}
+	}, time.Hour)
+
+	s.env.ExecuteWorkflow(ProcessWorkflow, ProcessWorkflowInput{
+		ProcessInstanceID:   500,
+		ProcessDefinitionID: 5,
+		Variables:           map[string]interface{}{"reviewers": []interface{}{"a", "b", "c", "d", "e"}},
+	})
+
+	var result ProcessWorkflowResult
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal(ProcessStatusCompleted, result.Status, "三人完成后流程应该结束")
+	s.Equal(true, result.Result["signed_by_c"], "实例提交的变量应该合并到流程变量")
+	s.NotContains(result.Result, "reviewer", "元素变量不应该写入流程变量")
+
+	tasks := s.tasks.tasksOf("sign")
+	s.Equal(taskStatusCancelled, tasks[3].Status, "剩余任务应该被取消")
+	s.Equal(taskStatusCancelled, tasks[4].Status, "剩余任务应该被取消")
+	s.Equal(multiInstanceCancelReason, tasks[4].DeleteReason, "应该记录取消原因")
+
+	completed := s.events.eventsOfType(EventMultiInstanceCompleted)
+	s.Require().Len(completed, 1, "应该记录多实例结束事件")
+	s.EqualValues(3, completed[0].EventData[model.VarNrOfCompletedInstances], "应该记录完成的实例数")
+	s.Equal(true, completed[0].EventData["completion_condition_met"], "应该记录完成条件成立")
+	s.Len(completed[0].EventData["cancelled_tasks"], 2, "应该记录取消的任务")
+}
+
+// TestSequentialMultiInstance 串行多实例逐个创建任务，完成条件成立后不再创建后续任务
+func (s *ProcessWorkflowTestSuite) TestSequentialMultiInstance() {
+	s.env.RegisterDelayedCallback(func() {
+		tasks := s.tasks.tasksOf("approve")
+		s.Require().Len(tasks, 1, "串行时只应该创建第一个任务")
+		s.Equal("a", tasks[0].Assignee, "第一个任务的办理人应该是第一个元素")
+		s.Equal([]string{"level-0"}, s.tasks.candidateGroups(tasks[0].ID), "loopCounter 应该从0开始")
+		s.env.SignalWorkflow(SignalUserTaskCompleted, UserTaskCompletedSignal{NodeID: "approve", TaskID: tasks[0].ID})
+	}, time.Hour)
+	s.env.RegisterDelayedCallback(func() {
+		tasks := s.tasks.tasksOf("approve")
+		s.Require().Len(tasks, 2, "上一个实例完成后应该创建下一个任务")
+		s.Equal("b", tasks[1].Assignee, "第二个任务的办理人应该是第二个元素")
+		s.Equal([]string{"level-1"}, s.tasks.candidateGroups(tasks[1].ID), "loopCounter 应该递增")
+		s.env.SignalWorkflow(SignalUserTaskCompleted, UserTaskCompletedSignal{
+			NodeID:    "approve",
+			TaskID:    tasks[1].ID,
+			Variables: map[string]interface{}{"rejected": true},
+		})
+	}, 2*time.Hour)
+
+	s.env.ExecuteWorkflow(ProcessWorkflow, ProcessWorkflowInput{
+		ProcessInstanceID:   600,
+		ProcessDefinitionID: 6,
+		Variables:           map[string]interface{}{"approvers": []interface{}{"a", "b", "c"}},
+	})
+
+	var result ProcessWorkflowResult
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal(ProcessStatusCompleted, result.Status, "驳回后流程应该结束")
+	s.Len(s.tasks.tasksOf("approve"), 2, "完成条件成立后不应该创建后续任务")
+}
+
+// TestParallelServiceMultiInstance 并行多实例服务任务为每个元素调用一次服务
+func (s *ProcessWorkflowTestSuite) TestParallelServiceMultiInstance() {
+	s.env.ExecuteWorkflow(ProcessWorkflow, ProcessWorkflowInput{
+		ProcessInstanceID:   700,
+		ProcessDefinitionID: 7,
+		Variables:           map[string]interface{}{"items": []interface{}{"x", "y", "z"}},
+	})
+
+	var result ProcessWorkflowResult
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal(ProcessStatusCompleted, result.Status, "流程应该正常完成")
+	s.Equal("x", result.Result["checked_0"], "每个实例应该收到对应的元素")
+	s.Equal("y", result.Result["checked_1"], "每个实例应该收到对应的元素")
+	s.Equal("z", result.Result["checked_2"], "每个实例应该收到对应的元素")
+	s.EqualValues(3, result.Result["check_total"], "实例应该可以读取实例总数")
+	s.Equal([]string{"root"}, s.events.executions(EventMultiInstanceCompleted, "check"), "应该记录多实例结束事件")
+}
+
+// TestEmptyMultiInstanceCollection 集合为空时多实例任务直接完成
+func (s *ProcessWorkflowTestSuite) TestEmptyMultiInstanceCollection() {
+	s.env.ExecuteWorkflow(ProcessWorkflow, ProcessWorkflowInput{
+		ProcessInstanceID:   701,
+		ProcessDefinitionID: 7,
+		Variables:           map[string]interface{}{"items": []interface{}{}},
+	})
+
+	var result ProcessWorkflowResult
+	s.NoError(s.env.GetWorkflowResult(&result))
+	s.Equal(ProcessStatusCompleted, result.Status, "空集合时流程应该正常完成")
+	s.NotContains(result.Result, "check_total", "空集合时不应该调用服务")
+	s.Len(s.events.executions(EventActivityCompleted, "end"), 1, "流程应该到达结束事件")
+}
+
+func contains(list []string, v string) bool {
+	for _, item := range list {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
+
+func TestProcessWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(ProcessWorkflowTestSuite))
+}
+
+// TestCreateUserTaskActivityIsIdempotent 活动重试时返回已创建的任务
+func TestCreateUserTaskActivityIsIdempotent(t *testing.T) {
+	tasks := &fakeTaskStore{}
+	activities := NewProcessActivities(fakeDefinitionStore{}, nil, nil, nil, nil, tasks, nil, NewServiceRegistry(), zap.NewNop())
+	input := CreateUserTaskInput{
+		ProcessInstanceID:    1,
+		ProcessDefinitionKey: "expense",
+		ExecutionID:          rootExecutionID,
+		NodeID:               "approve",
+		CandidateGroups:      []string{"finance"},
+	}
+
+	first, err := activities.CreateUserTaskActivity(context.Background(), input)
+	require.NoError(t, err, "创建用户任务失败")
+	second, err := activities.CreateUserTaskActivity(context.Background(), input)
+	require.NoError(t, err, "重试创建用户任务失败")
+	require.Equal(t, first, second, "重试应该返回已创建的任务")
+	require.Len(t, tasks.tasks, 1, "重试不应该重复创建任务")
+	require.Equal(t, []string{"finance"}, tasks.candidateGroups(first), "重试不应该重复登记候选组")
+
+	// 任务在活动重试前已被完成，重试不应该再创建一个新任务
+	require.NoError(t, tasks.Complete(context.Background(), strconv.FormatInt(first, 10), nil), "完成用户任务失败")
+	retried, err := activities.CreateUserTaskActivity(context.Background(), input)
+	require.NoError(t, err, "重试创建用户任务失败")
+	require.Equal(t, first, retried, "重试应该返回已完成的任务")
+	require.Len(t, tasks.tasks, 1, "任务已完成时重试不应该重复创建任务")
+
+	// 执行沿循环再次到达节点时创建新的任务
+	input.Visit = 2
+	next, err := activities.CreateUserTaskActivity(context.Background(), input)
+	require.NoError(t, err, "再次到达节点时创建用户任务失败")
+	require.NotEqual(t, first, next, "再次到达节点应该创建新的任务")
+	require.Len(t, tasks.tasks, 2, "每次到达节点应该只创建一个任务")
+}
+
+// TestEscalateUserTaskActivitySkipsFinishedTask 任务已结束时升级活动不执行动作
+func TestEscalateUserTaskActivitySkipsFinishedTask(t *testing.T) {
+	tasks := &fakeTaskStore{}
+	activities := NewProcessActivities(fakeDefinitionStore{}, nil, nil, nil, nil, tasks, nil, NewServiceRegistry(), zap.NewNop())
+	task, err := tasks.Create(context.Background(), &ent.TaskInstance{TaskDefinitionKey: "approve", Status: taskStatusCompleted, Priority: 10})
+	require.NoError(t, err, "创建用户任务失败")
+
+	result, err := activities.EscalateUserTaskActivity(context.Background(), EscalateUserTaskInput{
+		TaskID:   task.ID,
+		Action:   "raise_priority",
+		Priority: 90,
+	})
+	require.NoError(t, err, "升级已结束的任务不应该返回错误")
+	require.False(t, result.Applied, "已结束的任务不应该执行升级动作")
+	require.Equal(t, int32(10), tasks.task("approve").Priority, "已结束的任务优先级不应该变化")
+}
+
+// TestApprovalPolicies 各投票策略在投票过程中与截止时给出的决议
+func TestApprovalPolicies(t *testing.T) {
+	approvers := []string{"a", "b", "c", "d"}
+	type vote struct {
+		approver string
+		approved bool
+	}
+	cases := []struct {
+		name     string
+		input    ApprovalWorkflowInput
+		votes    []vote
+		status   string // 投票后的状态，pending 时继续检查截止结果
+		approved bool
+		expired  string // 截止时的状态
+	}{
+		{name: "全体一致首个拒绝即驳回", input: ApprovalWorkflowInput{RequireAll: true},
+			votes: []vote{{"a", true}, {"b", false}}, status: ApprovalStatusRejected},
+		{name: "全体一致需要全部同意", input: ApprovalWorkflowInput{Policy: ApprovalPolicyUnanimous},
+			votes: []vote{{"a", true}, {"b", true}, {"c", true}}, status: ApprovalStatusPending, expired: ApprovalStatusTimeout},
+		{name: "任一同意即通过", input: ApprovalWorkflowInput{},
+			votes: []vote{{"a", false}, {"b", true}}, status: ApprovalStatusApproved, approved: true},
+		{name: "任一同意在一次拒绝后继续等待", input: ApprovalWorkflowInput{},
+			votes: []vote{{"a", false}}, status: ApprovalStatusPending, expired: ApprovalStatusTimeout},
+		{name: "过半数同意通过", input: ApprovalWorkflowInput{Policy: ApprovalPolicyMajority},
+			votes: []vote{{"a", true}, {"b", false}, {"c", true}}, status: ApprovalStatusPending, expired: ApprovalStatusTimeout},
+		{name: "过半数已无法达到时驳回", input: ApprovalWorkflowInput{Policy: ApprovalPolicyMajority},
+			votes: []vote{{"a", false}, {"b", false}}, status: ApprovalStatusRejected},
+		{name: "过半数三票同意通过", input: ApprovalWorkflowInput{Policy: ApprovalPolicyMajority},
+			votes: []vote{{"a", true}, {"b", true}, {"c", true}}, status: ApprovalStatusApproved, approved: true},
+		{name: "加权投票达到阈值通过", input: ApprovalWorkflowInput{Policy: ApprovalPolicyWeighted, Weights: map[string]float64{"a": 5}, Threshold: 0.6},
+			votes: []vote{{"a", true}}, status: ApprovalStatusApproved, approved: true},
+		{name: "加权投票剩余权重不足时驳回", input: ApprovalWorkflowInput{Policy: ApprovalPolicyWeighted, Weights: map[string]float64{"a": 5}, Threshold: 0.6},
+			votes: []vote{{"a", false}}, status: ApprovalStatusRejected},
+		{name: "法定人数未达到时不决议", input: ApprovalWorkflowInput{Policy: ApprovalPolicyQuorum, Quorum: 3},
+			votes: []vote{{"a", true}, {"b", true}}, status: ApprovalStatusPending, expired: ApprovalStatusTimeout},
+		{name: "法定人数达到后截止按多数决定", input: ApprovalWorkflowInput{Policy: ApprovalPolicyQuorum, Quorum: 3},
+			votes: []vote{{"a", true}, {"b", false}, {"c", true}}, status: ApprovalStatusPending, expired: ApprovalStatusApproved, approved: true},
+		{name: "法定人数达到且结果不可逆时提前决议", input: ApprovalWorkflowInput{Policy: ApprovalPolicyQuorum, Quorum: 3},
+			votes: []vote{{"a", false}, {"b", false}, {"c", true}}, status: ApprovalStatusRejected},
+		{name: "首个拒绝即驳回", input: ApprovalWorkflowInput{Policy: ApprovalPolicyFirstRejection},
+			votes: []vote{{"a", true}, {"b", false}}, status: ApprovalStatusRejected},
+		{name: "截止时无人拒绝视为通过", input: ApprovalWorkflowInput{Policy: ApprovalPolicyFirstRejection},
+			votes: []vote{{"a", true}}, status: ApprovalStatusPending, expired: ApprovalStatusApproved, approved: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.input.Approvers = approvers
+			name, policy, err := newApprovalPolicy(tc.input)
+			require.NoError(t, err, "创建投票策略失败")
+			tracker := newApprovalTracker(tc.input, name, policy)
+			for _, v := range tc.votes {
+				require.NoError(t, tracker.validate(ApprovalData{Approver: v.approver}), "投票应该有效")
+				tracker.record(ApprovalData{Approver: v.approver, Approved: v.approved}, time.Time{})
+			}
+			require.Equal(t, tc.status, tracker.state.Status, "投票后的审批状态不符")
+			if tc.status == ApprovalStatusPending {
+				tracker.expire(time.Time{})
+				require.Equal(t, tc.expired, tracker.state.Status, "截止时的审批状态不符")
+			}
+			require.Equal(t, tc.approved, tracker.state.Approved, "审批结果不符")
+		})
+	}
+}
+
+// TestApprovalPolicyValidation 策略配置不合法时返回错误
+func TestApprovalPolicyValidation(t *testing.T) {
+	approvers := []string{"a", "b"}
+	for _, input := range []ApprovalWorkflowInput{
+		{Approvers: approvers, Policy: "veto"},
+		{Approvers: approvers, Policy: ApprovalPolicyQuorum},
+		{Approvers: approvers, Policy: ApprovalPolicyQuorum, Quorum: 3},
+		{Approvers: approvers, Policy: ApprovalPolicyWeighted, Threshold: 1.5},
+		{Approvers: approvers, Policy: ApprovalPolicyWeighted, Weights: map[string]float64{"a": -1}},
+	} {
+		_, _, err := newApprovalPolicy(input)
+		require.Error(t, err, "策略 %s 的非法配置应该返回错误", input.Policy)
+	}
+}
+
+// TestApprovalWorkflowRejectsLateVotes 决议后提前结束，迟到的投票更新被拒绝
+func TestApprovalWorkflowRejectsLateVotes(t *testing.T) {
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterActivity(SendNotificationActivity)
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(SignalApproval, ApprovalData{Approver: "a", Approved: true, Comments: "同意"})
+	}, time.Minute)
+	env.RegisterDelayedCallback(func() {
+		var state ApprovalState
+		value, err := env.QueryWorkflow(QueryApprovalState)
+		require.NoError(t, err, "查询审批状态失败")
+		require.NoError(t, value.Get(&state))
+		require.Equal(t, ApprovalStatusPending, state.Status, "一票同意时不应该形成决议")
+		require.Equal(t, []string{"b", "c"}, state.Pending, "应该返回尚未投票的审批人")
+
+		env.UpdateWorkflow(UpdateApprovalVote, "dup", &testsuite.TestUpdateCallback{
+			OnAccept:   func() { t.Error("重复投票应该被拒绝") },
+			OnReject:   func(err error) {},
+			OnComplete: func(interface{}, error) {},
+		}, ApprovalData{Approver: "a", Approved: false})
+		env.UpdateWorkflowNoRejection(UpdateApprovalVote, "b", t, ApprovalData{Approver: "b", Approved: true, Comments: "通过"})
+	}, 2*time.Minute)
+
+	env.ExecuteWorkflow(ApprovalWorkflow, ApprovalWorkflowInput{
+		RequestID: "req-1",
+		Approvers: []string{"a", "b", "c"},
+		Deadline:  time.Hour,
+		Policy:    ApprovalPolicyMajority,
+	})
+
+	require.True(t, env.IsWorkflowCompleted(), "过半数同意后工作流应该结束")
+	require.NoError(t, env.GetWorkflowError())
+	var result ApprovalWorkflowResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	require.Equal(t, ApprovalStatusApproved, result.Status, "过半数同意应该通过")
+	require.True(t, result.Approved)
+	require.Equal(t, "同意; 通过", result.Comments, "评论应该按投票顺序合并")
+	require.Len(t, result.Votes, 2, "重复投票不应该计票")
+}
+
+// TestApprovalWorkflowRejectionEndsEarly 任一同意策略下全部拒绝时提前结束，不等待截止时间
+func TestApprovalWorkflowRejectionEndsEarly(t *testing.T) {
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterActivity(SendNotificationActivity)
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(SignalApproval, ApprovalData{Approver: "a", Approved: false})
+		env.SignalWorkflow(SignalApproval, ApprovalData{Approver: "b", Approved: false})
+	}, time.Minute)
+
+	start := env.Now()
+	env.ExecuteWorkflow(ApprovalWorkflow, ApprovalWorkflowInput{
+		RequestID: "req-2",
+		Approvers: []string{"a", "b"},
+		Deadline:  24 * time.Hour,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	var result ApprovalWorkflowResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	require.Equal(t, ApprovalStatusRejected, result.Status, "全部拒绝应该驳回")
+	require.Equal(t, time.Minute, result.EndTime.Sub(start), "应该在最后一票拒绝时结束")
+}
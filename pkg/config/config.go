@@ -40,8 +40,9 @@ type GRPCConfig struct {
 
 // DataConfig 数据存储配置
 type DataConfig struct {
-	Database DatabaseConfig `yaml:"database"` // 数据库配置
-	Redis    RedisConfig    `yaml:"redis"`    // Redis 配置
+	Database   DatabaseConfig   `yaml:"database"`   // 数据库配置
+	Redis      RedisConfig      `yaml:"redis"`      // Redis 配置
+	Attachment AttachmentConfig `yaml:"attachment"` // 任务附件存储配置
 }
 
 // DatabaseConfig 数据库配置
@@ -62,6 +63,19 @@ type RedisConfig struct {
 	WriteTimeout time.Duration `yaml:"write_timeout"` // 写入超时
 }
 
+// 任务附件存储方式
+const (
+	AttachmentStorageDB    = "db"    // 文件内容保存在数据库中
+	AttachmentStorageLocal = "local" // 文件内容保存在本地目录中
+)
+
+// AttachmentConfig 任务附件存储配置
+type AttachmentConfig struct {
+	Storage string `yaml:"storage"`  // 存储方式: db, local，默认 db
+	Dir     string `yaml:"dir"`      // 本地存储目录
+	MaxSize int64  `yaml:"max_size"` // 单个附件最大字节数，0 表示不限制
+}
+
 // TemporalConfig Temporal 配置
 type TemporalConfig struct {
 	HostPort  string        `yaml:"host_port"`  // Temporal 服务地址
@@ -85,8 +99,42 @@ type LogConfig struct {
 
 // AuthConfig 认证配置
 type AuthConfig struct {
-	Secret  string        `yaml:"secret"`  // JWT 密钥
-	Expires time.Duration `yaml:"expires"` // Token 过期时间
+	Secret         string           `yaml:"secret"`          // JWT HS256 共享密钥，配置签名密钥时可省略
+	Expires        time.Duration    `yaml:"expires"`         // Token 过期时间
+	RefreshExpires time.Duration    `yaml:"refresh_expires"` // 刷新令牌过期时间，默认 7 天
+	Algorithm      string           `yaml:"algorithm"`       // 签名密钥的默认算法: RS256, ES256, EdDSA，为空时按密钥类型推断
+	Keys           []AuthKeyConfig  `yaml:"keys"`            // 非对称签名密钥，轮换时同时配置新旧密钥
+	JWKS           []AuthJWKSConfig `yaml:"jwks"`            // 信任的外部身份提供方
+	PasswordHash   string           `yaml:"password_hash"`   // 新密码使用的哈希算法: argon2id, bcrypt，默认 argon2id
+	Admin          AuthAdminConfig  `yaml:"admin"`           // 初始管理员账号
+}
+
+// AuthAdminConfig 初始管理员账号配置
+// 启动时账号不存在则创建并授予 admin 角色，已存在时不做修改；未配置密码时跳过
+type AuthAdminConfig struct {
+	Username string `yaml:"username"` // 用户名
+	Password string `yaml:"password"` // 初始密码，建议通过环境变量 AUTH_ADMIN_PASSWORD 设置
+}
+
+// AuthKeyConfig 非对称签名密钥配置
+type AuthKeyConfig struct {
+	ID             string    `yaml:"id"`               // 密钥ID，写入令牌头的 kid
+	Algorithm      string    `yaml:"algorithm"`        // 签名算法，为空时使用 auth.algorithm
+	PrivateKeyFile string    `yaml:"private_key_file"` // PEM 私钥文件，未配置时密钥只用于验证
+	PublicKeyFile  string    `yaml:"public_key_file"`  // PEM 公钥文件
+	NotBefore      time.Time `yaml:"not_before"`       // 开始用于签名的时间
+	NotAfter       time.Time `yaml:"not_after"`        // 停止验证的时间
+}
+
+// AuthJWKSConfig 外部身份提供方配置，文件与地址二选一
+type AuthJWKSConfig struct {
+	Issuer          string            `yaml:"issuer"`           // 令牌签发者，必填
+	Audience        string            `yaml:"audience"`         // 令牌受众，必填
+	File            string            `yaml:"file"`             // JWKS 文件
+	URL             string            `yaml:"url"`              // JWKS 地址
+	RefreshInterval time.Duration     `yaml:"refresh_interval"` // 刷新间隔
+	Roles           map[string]string `yaml:"roles"`            // 外部角色到本地角色的映射
+	Permissions     []string          `yaml:"permissions"`      // 接受的外部权限
 }
 
 // EngineConfig 工作流引擎配置
@@ -159,6 +207,9 @@ func loadFromEnv(config *Config) error {
 	if authSecret := os.Getenv("AUTH_SECRET"); authSecret != "" {
 		config.Auth.Secret = authSecret
 	}
+	if adminPassword := os.Getenv("AUTH_ADMIN_PASSWORD"); adminPassword != "" {
+		config.Auth.Admin.Password = adminPassword
+	}
 
 	return nil
 }
@@ -181,6 +232,17 @@ func validate(config *Config) error {
 		return fmt.Errorf("数据库连接字符串不能为空")
 	}
 
+	// 验证附件存储配置
+	switch config.Data.Attachment.Storage {
+	case "", AttachmentStorageDB:
+	case AttachmentStorageLocal:
+		if config.Data.Attachment.Dir == "" {
+			return fmt.Errorf("本地附件存储目录不能为空")
+		}
+	default:
+		return fmt.Errorf("不支持的附件存储方式: %s", config.Data.Attachment.Storage)
+	}
+
 	// 验证 Temporal 配置
 	if config.Temporal.HostPort == "" {
 		return fmt.Errorf("Temporal 服务地址不能为空")
@@ -190,9 +252,27 @@ func validate(config *Config) error {
 	}
 
 	// 验证认证配置
-	if config.Auth.Secret == "" {
+	if config.Auth.Secret == "" && len(config.Auth.Keys) == 0 {
 		return fmt.Errorf("认证密钥不能为空")
 	}
+	for _, key := range config.Auth.Keys {
+		if key.ID == "" {
+			return fmt.Errorf("认证签名密钥ID不能为空")
+		}
+		if key.PrivateKeyFile == "" && key.PublicKeyFile == "" {
+			return fmt.Errorf("认证签名密钥 %s 未配置密钥文件", key.ID)
+		}
+	}
+	for _, jwks := range config.Auth.JWKS {
+		if (jwks.File == "") == (jwks.URL == "") {
+			return fmt.Errorf("外部身份提供方 %s 必须配置 JWKS 文件或地址其中之一", jwks.Issuer)
+		}
+	}
+	switch config.Auth.PasswordHash {
+	case "", "argon2id", "bcrypt":
+	default:
+		return fmt.Errorf("不支持的密码哈希算法: %s", config.Auth.PasswordHash)
+	}
 
 	return nil
 }
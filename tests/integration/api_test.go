@@ -4,25 +4,54 @@ package integration
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/gin-gonic/gin"
+	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
 
+	"github.com/workflow-engine/workflow-engine/internal/auth"
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent/enttest"
+	"github.com/workflow-engine/workflow-engine/internal/data/repository"
+	"github.com/workflow-engine/workflow-engine/internal/middleware"
 	"github.com/workflow-engine/workflow-engine/internal/server"
+	"github.com/workflow-engine/workflow-engine/internal/service"
+	"github.com/workflow-engine/workflow-engine/internal/temporal"
+	"github.com/workflow-engine/workflow-engine/pkg/config"
 )
 
+// testerUserID 测试用访问令牌的用户ID
+const testerUserID = 10000
+
 // APITestSuite HTTP API集成测试套件
+// 路由器基于真实的服务层与仓储层，数据库使用内存SQLite，工作流引擎使用记录调用的替身
 type APITestSuite struct {
 	suite.Suite
 	server *httptest.Server
 	router *server.Router
 	logger *zap.Logger
+
+	client       *ent.Client
+	engine       *fakeWorkflowEngine
+	health       *fakeHealthChecker
+	token        string
+	taskRepo     biz.TaskInstanceRepo
+	historicRepo biz.HistoricProcessInstanceRepo
+	users        *biz.UserUseCase
 }
 
 // SetupSuite 设置测试套件，在所有测试前执行一次
@@ -32,8 +61,56 @@ func (suite *APITestSuite) SetupSuite() {
 	suite.Require().NoError(err, "初始化日志器失败")
 	suite.logger = logger
 
+	// 创建内存数据库与依赖替身
+	suite.client = enttest.Open(suite.T(), "sqlite3", "file:api?mode=memory&cache=shared&_fk=1")
+	suite.engine = &fakeWorkflowEngine{}
+	suite.health = &fakeHealthChecker{}
+	cache := newMemoryCache()
+
+	// 创建JWT管理器并签发测试用访问令牌
+	jwtManager, err := auth.NewJWTManager(&auth.JWTConfig{
+		SecretKey:       "test-secret",
+		Issuer:          "workflow-engine",
+		EnableRefresh:   true,
+		EnableBlacklist: true,
+	}, nil, logger)
+	suite.Require().NoError(err, "创建JWT管理器失败")
+	// 令牌的用户不在用户表中，用户ID避开测试中创建的用户，强制下线这些用户时不受影响
+	tokenPair, err := jwtManager.GenerateTokenPair(testerUserID, "tester", "tester@example.com", []string{"admin"}, nil)
+	suite.Require().NoError(err, "签发访问令牌失败")
+	suite.token = tokenPair.AccessToken
+
+	processDefinitionRepo := repository.NewProcessDefinitionRepo(suite.client, logger)
+	processInstanceRepo := repository.NewProcessInstanceRepo(suite.client, logger)
+	variableRepo := repository.NewProcessVariableRepo(suite.client, logger)
+	eventRepo := repository.NewProcessEventRepo(suite.client, logger)
+	commentRepo := repository.NewTaskCommentRepo(suite.client, logger)
+	attachmentRepo := repository.NewTaskAttachmentRepo(suite.client, &config.Config{}, logger)
+	txRepo := repository.NewTransactionRepo(suite.client, logger)
+	suite.taskRepo = repository.NewTaskInstanceRepo(suite.client, logger)
+	suite.historicRepo = repository.NewHistoricProcessInstanceRepo(suite.client, logger)
+	passwords, err := auth.NewPasswordHasher(auth.PasswordConfig{})
+	suite.Require().NoError(err, "创建密码哈希器失败")
+	userRepo := repository.NewUserRepo(suite.client, logger)
+	roleRepo := repository.NewRoleRepo(suite.client, logger)
+	authUseCase := biz.NewAuthUseCase(userRepo, roleRepo, repository.NewRefreshTokenRepo(suite.client, logger), txRepo, jwtManager, passwords, logger)
+	suite.users = biz.NewUserUseCase(userRepo, roleRepo, repository.NewGroupRepo(suite.client, logger), authUseCase, passwords, logger)
+	authz := biz.NewAuthorizationUseCase(repository.NewAuthorizationRepo(suite.client, logger), processDefinitionRepo, logger)
+
 	// 创建HTTP路由器
-	suite.router = server.NewRouter(logger)
+	gin.SetMode(gin.TestMode)
+	suite.router = server.NewRouter(
+		service.NewProcessDefinitionService(biz.NewProcessDefinitionUseCase(processDefinitionRepo, cache, authz, logger), logger),
+		service.NewProcessInstanceService(biz.NewProcessInstanceUseCase(processInstanceRepo, processDefinitionRepo, suite.taskRepo, variableRepo, txRepo, cache, suite.engine, authz, logger), logger),
+		service.NewTaskInstanceService(biz.NewTaskInstanceUseCase(suite.taskRepo, processInstanceRepo, variableRepo, eventRepo, commentRepo, attachmentRepo, txRepo, cache, suite.engine, authz, logger), logger),
+		service.NewHistoricDataService(biz.NewHistoricDataUseCase(suite.historicRepo, cache, authz, logger), logger),
+		service.NewAuthService(authUseCase, logger),
+		service.NewUserService(suite.users, logger),
+		service.NewAuthorizationService(authz, logger),
+		middleware.NewAuthMiddleware(jwtManager, logger),
+		suite.health,
+		logger,
+	)
 
 	// 创建测试服务器
 	suite.server = httptest.NewServer(suite.router)
@@ -47,6 +124,9 @@ func (suite *APITestSuite) TearDownSuite() {
 		suite.server.Close()
 		suite.logger.Info("API集成测试服务器关闭")
 	}
+	if suite.client != nil {
+		suite.client.Close()
+	}
 	if suite.logger != nil {
 		suite.logger.Sync()
 	}
@@ -56,8 +136,12 @@ func (suite *APITestSuite) TearDownSuite() {
 func (suite *APITestSuite) makeRequest(method, path string, body interface{}) (*http.Response, []byte) {
 	var reqBody bytes.Buffer
 	if body != nil {
-		err := json.NewEncoder(&reqBody).Encode(body)
-		suite.Require().NoError(err, "编码请求体失败")
+		if raw, ok := body.(string); ok {
+			reqBody.WriteString(raw)
+		} else {
+			err := json.NewEncoder(&reqBody).Encode(body)
+			suite.Require().NoError(err, "编码请求体失败")
+		}
 	}
 
 	req, err := http.NewRequest(method, suite.server.URL+path, &reqBody)
@@ -66,6 +150,7 @@ func (suite *APITestSuite) makeRequest(method, path string, body interface{}) (*
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
+	req.Header.Set("Authorization", "Bearer "+suite.token)
 
 	client := &http.Client{Timeout: 10 * time.Second}
 	resp, err := client.Do(req)
@@ -91,6 +176,58 @@ func (suite *APITestSuite) parseResponse(respBody []byte) map[string]interface{}
 	return result
 }
 
+// expectData 断言请求成功并返回响应中的data对象
+func (suite *APITestSuite) expectData(resp *http.Response, body []byte, status int) map[string]interface{} {
+	suite.Require().Equal(status, resp.StatusCode, "HTTP状态码不匹配，响应: %s", body)
+
+	result := suite.parseResponse(body)
+	suite.Equal(float64(200), result["code"], "响应码应为200")
+	data, _ := result["data"].(map[string]interface{})
+	return data
+}
+
+// expectError 断言请求失败并返回响应对象
+func (suite *APITestSuite) expectError(resp *http.Response, body []byte, status int, code int) map[string]interface{} {
+	suite.Require().Equal(status, resp.StatusCode, "HTTP状态码不匹配，响应: %s", body)
+
+	result := suite.parseResponse(body)
+	suite.Equal(float64(code), result["code"], "错误码不匹配")
+	suite.NotEmpty(result["message"], "错误消息不应为空")
+	return result
+}
+
+// processResource 生成只包含开始和结束节点的最小流程资源
+func processResource(key string) string {
+	return fmt.Sprintf(`{"id":%q,"name":"测试流程","elements":[`+
+		`{"id":"start","type":"startEvent","next":["end"]},`+
+		`{"id":"end","type":"endEvent"}]}`, key)
+}
+
+// createProcessDefinition 通过API创建流程定义并返回ID
+func (suite *APITestSuite) createProcessDefinition(key string) string {
+	resp, body := suite.makeRequest("POST", "/api/v1/process-definitions", map[string]interface{}{
+		"key":      key,
+		"name":     "测试流程",
+		"resource": processResource(key),
+		"category": "测试分类",
+	})
+	data := suite.expectData(resp, body, http.StatusCreated)
+	suite.Require().NotEmpty(data["id"], "创建的流程定义应有ID")
+	return data["id"].(string)
+}
+
+// startProcessInstance 通过API启动流程实例并返回ID
+func (suite *APITestSuite) startProcessInstance(definitionID string, variables map[string]interface{}) string {
+	resp, body := suite.makeRequest("POST", "/api/v1/process-instances", map[string]interface{}{
+		"process_definition_id": definitionID,
+		"business_key":          "test-business-001",
+		"variables":             variables,
+	})
+	data := suite.expectData(resp, body, http.StatusCreated)
+	suite.Require().NotEmpty(data["id"], "流程实例应有ID")
+	return data["id"].(string)
+}
+
 // ====================
 // 健康检查API测试
 // ====================
@@ -142,6 +279,233 @@ func (suite *APITestSuite) TestReadinessCheck() {
 	suite.logger.Info("就绪检查API测试通过")
 }
 
+// TestDependencyUnavailable 测试依赖不可用时健康与就绪检查返回503
+func (suite *APITestSuite) TestDependencyUnavailable() {
+	suite.health.setError(fmt.Errorf("数据库健康检查失败: connection refused"))
+	defer suite.health.setError(nil)
+
+	suite.Run("健康检查", func() {
+		resp, body := suite.makeRequest("GET", "/health", nil)
+		result := suite.expectError(resp, body, http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+		assert.Contains(suite.T(), result["error"], "connection refused", "应返回依赖错误信息")
+
+		data, ok := result["data"].(map[string]interface{})
+		suite.Require().True(ok, "data字段应为对象")
+		assert.Equal(suite.T(), "unhealthy", data["status"], "健康状态应为unhealthy")
+	})
+
+	suite.Run("就绪检查", func() {
+		resp, body := suite.makeRequest("GET", "/ready", nil)
+		result := suite.expectError(resp, body, http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+
+		data, ok := result["data"].(map[string]interface{})
+		suite.Require().True(ok, "data字段应为对象")
+		assert.Equal(suite.T(), "not_ready", data["status"], "就绪状态应为not_ready")
+	})
+}
+
+// TestAuthentication 测试API需要携带有效的访问令牌
+func (suite *APITestSuite) TestAuthentication() {
+	token := suite.token
+	defer func() { suite.token = token }()
+
+	suite.Run("缺少令牌", func() {
+		suite.token = ""
+		resp, _ := suite.makeRequest("GET", "/api/v1/process-definitions", nil)
+		assert.Equal(suite.T(), http.StatusUnauthorized, resp.StatusCode, "缺少令牌应返回401状态码")
+	})
+
+	suite.Run("无效令牌", func() {
+		suite.token = "invalid"
+		resp, _ := suite.makeRequest("GET", "/api/v1/process-definitions", nil)
+		assert.Equal(suite.T(), http.StatusUnauthorized, resp.StatusCode, "无效令牌应返回401状态码")
+	})
+
+	suite.Run("健康检查无需令牌", func() {
+		suite.token = ""
+		resp, _ := suite.makeRequest("GET", "/health", nil)
+		assert.Equal(suite.T(), http.StatusOK, resp.StatusCode, "健康检查应返回200状态码")
+	})
+}
+
+// TestAuthAPI 测试登录、刷新令牌、登出、修改密码与用户管理API
+func (suite *APITestSuite) TestAuthAPI() {
+	adminToken := suite.token
+	defer func() { suite.token = adminToken }()
+
+	// login 使用用户名密码登录，返回访问令牌和刷新令牌
+	login := func(password string) (string, string) {
+		suite.token = ""
+		resp, body := suite.makeRequest("POST", "/api/v1/auth/login", map[string]interface{}{
+			"username": "alice",
+			"password": password,
+		})
+		data := suite.expectData(resp, body, http.StatusOK)
+		suite.Require().NotEmpty(data["access_token"], "登录应返回访问令牌")
+		suite.Require().NotEmpty(data["refresh_token"], "登录应返回刷新令牌")
+		return data["access_token"].(string), data["refresh_token"].(string)
+	}
+	// refresh 使用刷新令牌换取新的令牌对
+	refresh := func(refreshToken string) (*http.Response, []byte) {
+		suite.token = ""
+		return suite.makeRequest("POST", "/api/v1/auth/refresh", map[string]interface{}{"refresh_token": refreshToken})
+	}
+	// canAccess 判断访问令牌能否访问受保护的接口
+	canAccess := func(accessToken string) bool {
+		suite.token = accessToken
+		resp, _ := suite.makeRequest("GET", "/api/v1/process-definitions", nil)
+		return resp.StatusCode == http.StatusOK
+	}
+
+	var userID string
+	suite.Run("管理员创建角色和用户", func() {
+		suite.token = adminToken
+		resp, body := suite.makeRequest("POST", "/api/v1/roles", map[string]interface{}{
+			"name":        "reviewer",
+			"permissions": []string{"task:complete"},
+		})
+		suite.expectData(resp, body, http.StatusCreated)
+
+		resp, body = suite.makeRequest("POST", "/api/v1/groups", map[string]interface{}{"name": "finance"})
+		suite.expectData(resp, body, http.StatusCreated)
+
+		resp, body = suite.makeRequest("POST", "/api/v1/users", map[string]interface{}{
+			"username": "alice",
+			"password": "short",
+			"roles":    []string{"reviewer"},
+		})
+		suite.expectError(resp, body, http.StatusUnprocessableEntity, http.StatusUnprocessableEntity)
+
+		resp, body = suite.makeRequest("POST", "/api/v1/users", map[string]interface{}{
+			"username": "alice",
+			"password": "correct-horse-1",
+			"roles":    []string{"unknown"},
+		})
+		suite.expectError(resp, body, http.StatusNotFound, http.StatusNotFound)
+
+		resp, body = suite.makeRequest("POST", "/api/v1/users", map[string]interface{}{
+			"username": "alice",
+			"password": "correct-horse-1",
+			"email":    "alice@example.com",
+			"roles":    []string{"reviewer"},
+			"groups":   []string{"finance"},
+		})
+		data := suite.expectData(resp, body, http.StatusCreated)
+		suite.Require().NotEmpty(data["id"], "创建的用户应有ID")
+		suite.NotContains(string(body), "password_hash", "用户响应不应包含密码哈希")
+		userID = data["id"].(string)
+
+		resp, body = suite.makeRequest("POST", "/api/v1/users", map[string]interface{}{
+			"username": "alice",
+			"password": "correct-horse-1",
+		})
+		suite.expectError(resp, body, http.StatusConflict, http.StatusConflict)
+	})
+
+	suite.Run("登录", func() {
+		suite.token = ""
+		resp, body := suite.makeRequest("POST", "/api/v1/auth/login", map[string]interface{}{
+			"username": "alice",
+			"password": "wrong-password",
+		})
+		suite.expectError(resp, body, http.StatusUnauthorized, http.StatusUnauthorized)
+
+		resp, body = suite.makeRequest("POST", "/api/v1/auth/login", map[string]interface{}{
+			"username": "nobody",
+			"password": "wrong-password",
+		})
+		suite.expectError(resp, body, http.StatusUnauthorized, http.StatusUnauthorized)
+
+		accessToken, refreshToken := login("correct-horse-1")
+		suite.True(canAccess(accessToken), "登录后的访问令牌应可访问接口")
+		suite.False(canAccess(refreshToken), "刷新令牌不能用于访问接口")
+
+		suite.token = accessToken
+		resp, _ = suite.makeRequest("GET", "/api/v1/users", nil)
+		suite.Equal(http.StatusForbidden, resp.StatusCode, "非管理员不能管理用户")
+	})
+
+	suite.Run("刷新令牌轮换与重用检测", func() {
+		accessToken, refreshToken := login("correct-horse-1")
+
+		resp, body := refresh(refreshToken)
+		data := suite.expectData(resp, body, http.StatusOK)
+		newAccessToken := data["access_token"].(string)
+		newRefreshToken := data["refresh_token"].(string)
+		suite.NotEqual(refreshToken, newRefreshToken, "刷新后应签发新的刷新令牌")
+		suite.True(canAccess(newAccessToken), "刷新后的访问令牌应可访问接口")
+
+		// 重用已轮换的刷新令牌吊销整个会话
+		resp, body = refresh(refreshToken)
+		suite.expectError(resp, body, http.StatusUnauthorized, http.StatusUnauthorized)
+		resp, body = refresh(newRefreshToken)
+		suite.expectError(resp, body, http.StatusUnauthorized, http.StatusUnauthorized)
+		suite.False(canAccess(accessToken), "会话吊销后原访问令牌应失效")
+		suite.False(canAccess(newAccessToken), "会话吊销后新访问令牌应失效")
+	})
+
+	suite.Run("登出", func() {
+		accessToken, refreshToken := login("correct-horse-1")
+		otherAccessToken, _ := login("correct-horse-1")
+
+		suite.token = accessToken
+		resp, body := suite.makeRequest("POST", "/api/v1/auth/logout", nil)
+		suite.expectData(resp, body, http.StatusOK)
+
+		suite.False(canAccess(accessToken), "登出后访问令牌应失效")
+		resp, body = refresh(refreshToken)
+		suite.expectError(resp, body, http.StatusUnauthorized, http.StatusUnauthorized)
+		suite.True(canAccess(otherAccessToken), "登出不影响其他会话")
+	})
+
+	suite.Run("修改密码", func() {
+		accessToken, refreshToken := login("correct-horse-1")
+
+		suite.token = accessToken
+		resp, body := suite.makeRequest("POST", "/api/v1/auth/password", map[string]interface{}{
+			"old_password": "wrong-password",
+			"new_password": "battery-staple-2",
+		})
+		suite.expectError(resp, body, http.StatusUnauthorized, http.StatusUnauthorized)
+
+		resp, body = suite.makeRequest("POST", "/api/v1/auth/password", map[string]interface{}{
+			"old_password": "correct-horse-1",
+			"new_password": "battery-staple-2",
+		})
+		data := suite.expectData(resp, body, http.StatusOK)
+		suite.True(canAccess(data["access_token"].(string)), "修改密码后返回的访问令牌应可访问接口")
+
+		suite.False(canAccess(accessToken), "修改密码后原访问令牌应失效")
+		resp, body = refresh(refreshToken)
+		suite.expectError(resp, body, http.StatusUnauthorized, http.StatusUnauthorized)
+
+		login("battery-staple-2")
+	})
+
+	suite.Run("停用用户", func() {
+		accessToken, _ := login("battery-staple-2")
+
+		suite.token = adminToken
+		resp, body := suite.makeRequest("PUT", "/api/v1/users/"+userID, map[string]interface{}{"status": "disabled"})
+		data := suite.expectData(resp, body, http.StatusOK)
+		suite.Equal("disabled", data["status"], "用户应已停用")
+
+		suite.False(canAccess(accessToken), "停用后访问令牌应失效")
+		suite.token = ""
+		resp, body = suite.makeRequest("POST", "/api/v1/auth/login", map[string]interface{}{
+			"username": "alice",
+			"password": "battery-staple-2",
+		})
+		suite.expectError(resp, body, http.StatusForbidden, http.StatusForbidden)
+
+		suite.token = adminToken
+		resp, body = suite.makeRequest("GET", "/api/v1/users?status=disabled&role=reviewer", nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		items, _ := data["items"].([]interface{})
+		suite.Len(items, 1, "应查询到一个已停用的用户")
+	})
+}
+
 // ====================
 // 流程定义API测试
 // ====================
@@ -150,105 +514,109 @@ func (suite *APITestSuite) TestReadinessCheck() {
 func (suite *APITestSuite) TestProcessDefinitionsAPI() {
 	suite.logger.Info("开始测试流程定义API")
 
-	// 测试查询流程定义列表
-	suite.Run("查询流程定义列表", func() {
-		resp, body := suite.makeRequest("GET", "/api/v1/process-definitions", nil)
-
-		assert.Equal(suite.T(), http.StatusOK, resp.StatusCode, "查询列表应返回200状态码")
-
-		result := suite.parseResponse(body)
-		assert.Equal(suite.T(), float64(200), result["code"], "响应码应为200")
-
-		// 验证分页数据结构
-		data, ok := result["data"].(map[string]interface{})
-		assert.True(suite.T(), ok, "data字段应为对象")
-		assert.NotNil(suite.T(), data["items"], "items字段不应为空")
-		assert.NotNil(suite.T(), data["total"], "total字段不应为空")
-		assert.NotNil(suite.T(), data["page"], "page字段不应为空")
-		assert.NotNil(suite.T(), data["page_size"], "page_size字段不应为空")
-	})
+	var definitionID string
 
 	// 测试创建流程定义
 	suite.Run("创建流程定义", func() {
-		createReq := map[string]interface{}{
-			"key":         "test-process",
-			"name":        "测试流程",
-			"description": "这是一个测试流程定义",
-			"resource":    `{"version": "1.0", "steps": []}`,
-			"category":    "测试分类",
-		}
+		definitionID = suite.createProcessDefinition("definition-api")
 
-		resp, body := suite.makeRequest("POST", "/api/v1/process-definitions", createReq)
-
-		assert.Equal(suite.T(), http.StatusCreated, resp.StatusCode, "创建流程定义应返回201状态码")
-
-		result := suite.parseResponse(body)
-		assert.Equal(suite.T(), float64(200), result["code"], "响应码应为200")
-
-		// 验证创建结果
-		data, ok := result["data"].(map[string]interface{})
-		assert.True(suite.T(), ok, "data字段应为对象")
-		assert.NotEmpty(suite.T(), data["id"], "创建的流程定义应有ID")
-		assert.Equal(suite.T(), "created", data["status"], "状态应为created")
+		resp, body := suite.makeRequest("GET", "/api/v1/process-definitions/"+definitionID, nil)
+		data := suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), "definition-api", data["key"], "流程定义键应匹配")
+		assert.Equal(suite.T(), float64(1), data["version"], "首个版本号应为1")
+		assert.Equal(suite.T(), false, data["suspended"], "新建的流程定义不应挂起")
 	})
 
-	// 测试获取流程定义详情
-	suite.Run("获取流程定义详情", func() {
-		resp, body := suite.makeRequest("GET", "/api/v1/process-definitions/1", nil)
+	// 测试请求体绑定与校验
+	suite.Run("创建流程定义参数错误", func() {
+		resp, body := suite.makeRequest("POST", "/api/v1/process-definitions", `{"key":`)
+		suite.expectError(resp, body, http.StatusBadRequest, service.ErrCodeBadRequest)
+
+		resp, body = suite.makeRequest("POST", "/api/v1/process-definitions", map[string]interface{}{
+			"name":     "缺少键的流程",
+			"resource": processResource("missing-key"),
+		})
+		suite.expectError(resp, body, http.StatusBadRequest, service.ErrCodeBadRequest)
+
+		resp, body = suite.makeRequest("POST", "/api/v1/process-definitions", map[string]interface{}{
+			"key":      "invalid-resource",
+			"name":     "结构错误的流程",
+			"resource": `{"id":"invalid-resource","elements":[{"id":"start","type":"startEvent"}]}`,
+		})
+		result := suite.expectError(resp, body, http.StatusUnprocessableEntity, service.ErrCodeValidationError)
+		assert.NotEmpty(suite.T(), result["errors"], "应返回结构化的校验错误")
+	})
 
-		assert.Equal(suite.T(), http.StatusOK, resp.StatusCode, "获取详情应返回200状态码")
+	// 测试查询流程定义列表
+	suite.Run("查询流程定义列表", func() {
+		resp, body := suite.makeRequest("GET", "/api/v1/process-definitions?page=1&page_size=10&search=definition-api", nil)
+		data := suite.expectData(resp, body, http.StatusOK)
+
+		items, ok := data["items"].([]interface{})
+		assert.True(suite.T(), ok, "items字段应为数组")
+		assert.Len(suite.T(), items, 1, "应查询到刚创建的流程定义")
+		pagination, ok := data["pagination"].(map[string]interface{})
+		assert.True(suite.T(), ok, "pagination字段应为对象")
+		assert.Equal(suite.T(), float64(1), pagination["total"], "总数应为1")
+
+		resp, body = suite.makeRequest("GET", "/api/v1/process-definitions?page=abc", nil)
+		suite.expectError(resp, body, http.StatusBadRequest, service.ErrCodeBadRequest)
+	})
 
-		result := suite.parseResponse(body)
-		assert.Equal(suite.T(), float64(200), result["code"], "响应码应为200")
+	// 测试获取不存在的流程定义
+	suite.Run("获取不存在的流程定义", func() {
+		resp, body := suite.makeRequest("GET", "/api/v1/process-definitions/999999", nil)
+		suite.expectError(resp, body, http.StatusNotFound, service.ErrCodeNotFound)
+	})
 
-		// 验证详情数据
-		data, ok := result["data"].(map[string]interface{})
-		assert.True(suite.T(), ok, "data字段应为对象")
-		assert.Equal(suite.T(), "1", data["id"], "ID应匹配")
-		assert.NotEmpty(suite.T(), data["name"], "名称不应为空")
+	// 测试根据Key获取最新版本
+	suite.Run("获取最新版本流程定义", func() {
+		resp, body := suite.makeRequest("GET", "/api/v1/process-definitions/key/definition-api/latest", nil)
+		data := suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), definitionID, data["id"], "最新版本应为刚创建的流程定义")
 	})
 
 	// 测试更新流程定义
 	suite.Run("更新流程定义", func() {
-		updateReq := map[string]interface{}{
+		resp, body := suite.makeRequest("PUT", "/api/v1/process-definitions/"+definitionID, map[string]interface{}{
 			"name":        "更新后的流程名称",
 			"description": "更新后的描述",
-		}
-
-		resp, body := suite.makeRequest("PUT", "/api/v1/process-definitions/1", updateReq)
-
-		assert.Equal(suite.T(), http.StatusOK, resp.StatusCode, "更新流程定义应返回200状态码")
-
-		result := suite.parseResponse(body)
-		assert.Equal(suite.T(), float64(200), result["code"], "响应码应为200")
-
-		data, ok := result["data"].(map[string]interface{})
-		assert.True(suite.T(), ok, "data字段应为对象")
-		assert.Equal(suite.T(), "updated", data["status"], "状态应为updated")
+		})
+		data := suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), "更新后的流程名称", data["name"], "名称应已更新")
 	})
 
 	// 测试部署流程定义
 	suite.Run("部署流程定义", func() {
-		resp, body := suite.makeRequest("POST", "/api/v1/process-definitions/1/deploy", nil)
+		resp, body := suite.makeRequest("POST", "/api/v1/process-definitions/"+definitionID+"/deploy", nil)
+		suite.expectData(resp, body, http.StatusOK)
+	})
 
-		assert.Equal(suite.T(), http.StatusOK, resp.StatusCode, "部署流程定义应返回200状态码")
+	// 测试导出BPMN
+	suite.Run("导出BPMN", func() {
+		resp, body := suite.makeRequest("GET", "/api/v1/process-definitions/"+definitionID+"/bpmn", nil)
+		suite.Require().Equal(http.StatusOK, resp.StatusCode, "导出BPMN应返回200状态码，响应: %s", body)
+		assert.Contains(suite.T(), resp.Header.Get("Content-Type"), "application/xml", "应返回XML内容")
+		assert.Contains(suite.T(), string(body), "definitions", "应包含BPMN根元素")
+	})
 
-		result := suite.parseResponse(body)
-		assert.Equal(suite.T(), float64(200), result["code"], "响应码应为200")
+	// 测试挂起流程定义
+	suite.Run("挂起流程定义", func() {
+		resp, body := suite.makeRequest("POST", "/api/v1/process-definitions/"+definitionID+"/suspend", nil)
+		suite.expectData(resp, body, http.StatusOK)
 
-		data, ok := result["data"].(map[string]interface{})
-		assert.True(suite.T(), ok, "data字段应为对象")
-		assert.Equal(suite.T(), "deployed", data["status"], "状态应为deployed")
+		resp, body = suite.makeRequest("GET", "/api/v1/process-definitions/"+definitionID, nil)
+		data := suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), true, data["suspended"], "流程定义应已挂起")
 	})
 
 	// 测试删除流程定义 (放在最后)
 	suite.Run("删除流程定义", func() {
-		resp, body := suite.makeRequest("DELETE", "/api/v1/process-definitions/999", nil)
-
-		assert.Equal(suite.T(), http.StatusOK, resp.StatusCode, "删除流程定义应返回200状态码")
+		resp, body := suite.makeRequest("DELETE", "/api/v1/process-definitions/"+definitionID, nil)
+		suite.expectData(resp, body, http.StatusOK)
 
-		result := suite.parseResponse(body)
-		assert.Equal(suite.T(), float64(200), result["code"], "响应码应为200")
+		resp, body = suite.makeRequest("GET", "/api/v1/process-definitions/"+definitionID, nil)
+		suite.expectError(resp, body, http.StatusNotFound, service.ErrCodeNotFound)
 	})
 
 	suite.logger.Info("流程定义API测试完成")
@@ -262,105 +630,117 @@ func (suite *APITestSuite) TestProcessDefinitionsAPI() {
 func (suite *APITestSuite) TestProcessInstancesAPI() {
 	suite.logger.Info("开始测试流程实例API")
 
+	definitionID := suite.createProcessDefinition("instance-api")
+	var instanceID string
+
 	// 测试启动流程实例
 	suite.Run("启动流程实例", func() {
-		startReq := map[string]interface{}{
-			"process_definition_id": "1",
-			"business_key":          "test-business-001",
-			"variables": map[string]interface{}{
-				"applicant": "张三",
-				"amount":    10000,
-			},
-			"start_user_id": "user-1",
-		}
-
-		resp, body := suite.makeRequest("POST", "/api/v1/process-instances", startReq)
-
-		assert.Equal(suite.T(), http.StatusCreated, resp.StatusCode, "启动流程实例应返回201状态码")
-
-		result := suite.parseResponse(body)
-		assert.Equal(suite.T(), float64(200), result["code"], "响应码应为200")
-
-		// 验证启动结果
-		data, ok := result["data"].(map[string]interface{})
-		assert.True(suite.T(), ok, "data字段应为对象")
-		assert.NotEmpty(suite.T(), data["id"], "流程实例应有ID")
-		assert.Equal(suite.T(), "running", data["status"], "状态应为running")
+		instanceID = suite.startProcessInstance(definitionID, map[string]interface{}{
+			"applicant": "张三",
+			"amount":    10000,
+		})
+
+		resp, body := suite.makeRequest("GET", "/api/v1/process-instances/"+instanceID, nil)
+		data := suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), definitionID, data["process_definition_id"], "流程定义ID应匹配")
+		assert.Equal(suite.T(), true, data["is_active"], "新启动的实例应处于激活状态")
+		assert.NotEmpty(suite.T(), data["workflow_id"], "应记录工作流ID")
 	})
 
-	// 测试查询流程实例列表
-	suite.Run("查询流程实例列表", func() {
-		resp, body := suite.makeRequest("GET", "/api/v1/process-instances", nil)
+	// 测试启动参数校验
+	suite.Run("启动流程实例参数错误", func() {
+		resp, body := suite.makeRequest("POST", "/api/v1/process-instances", map[string]interface{}{
+			"business_key": "missing-definition",
+		})
+		suite.expectError(resp, body, http.StatusBadRequest, service.ErrCodeBadRequest)
+	})
 
-		assert.Equal(suite.T(), http.StatusOK, resp.StatusCode, "查询列表应返回200状态码")
+	// 测试流程变量
+	suite.Run("读写流程变量", func() {
+		resp, body := suite.makeRequest("GET", "/api/v1/process-instances/"+instanceID+"/variables", nil)
+		data := suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), "张三", data["applicant"], "字符串变量应匹配")
+		assert.Equal(suite.T(), float64(10000), data["amount"], "数值变量应匹配")
+
+		variable, err := suite.client.ProcessVariable.Query().All(context.Background())
+		suite.Require().NoError(err, "查询流程变量失败")
+		for _, v := range variable {
+			if v.Name == "amount" {
+				assert.Equal(suite.T(), "integer", v.Type, "JSON整数应保存为整数变量")
+			}
+		}
 
-		result := suite.parseResponse(body)
-		assert.Equal(suite.T(), float64(200), result["code"], "响应码应为200")
+		resp, body = suite.makeRequest("PUT", "/api/v1/process-instances/"+instanceID+"/variables/approved",
+			map[string]interface{}{"value": true})
+		suite.expectData(resp, body, http.StatusOK)
 
-		// 验证分页数据结构
-		data, ok := result["data"].(map[string]interface{})
-		assert.True(suite.T(), ok, "data字段应为对象")
-		assert.NotNil(suite.T(), data["items"], "items字段不应为空")
-	})
+		resp, body = suite.makeRequest("PUT", "/api/v1/process-instances/"+instanceID+"/variables",
+			map[string]interface{}{"amount": 12000, "comment": "追加预算"})
+		suite.expectData(resp, body, http.StatusOK)
 
-	// 测试获取流程实例详情
-	suite.Run("获取流程实例详情", func() {
-		resp, body := suite.makeRequest("GET", "/api/v1/process-instances/inst-1", nil)
+		resp, body = suite.makeRequest("GET", "/api/v1/process-instances/"+instanceID+"/variables/amount", nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), float64(12000), data["value"], "变量应已更新")
 
-		assert.Equal(suite.T(), http.StatusOK, resp.StatusCode, "获取详情应返回200状态码")
+		resp, body = suite.makeRequest("GET", "/api/v1/process-instances/"+instanceID+"/variables/approved", nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), true, data["value"], "布尔变量应匹配")
 
-		result := suite.parseResponse(body)
-		assert.Equal(suite.T(), float64(200), result["code"], "响应码应为200")
+		resp, body = suite.makeRequest("GET", "/api/v1/process-instances/"+instanceID+"/variables/missing", nil)
+		suite.expectError(resp, body, http.StatusNotFound, service.ErrCodeNotFound)
 
-		data, ok := result["data"].(map[string]interface{})
-		assert.True(suite.T(), ok, "data字段应为对象")
-		assert.Equal(suite.T(), "inst-1", data["id"], "ID应匹配")
+		resp, body = suite.makeRequest("PUT", "/api/v1/process-instances/"+instanceID+"/variables", map[string]interface{}{})
+		suite.expectError(resp, body, http.StatusBadRequest, service.ErrCodeBadRequest)
 	})
 
-	// 测试挂起流程实例
-	suite.Run("挂起流程实例", func() {
-		resp, body := suite.makeRequest("POST", "/api/v1/process-instances/inst-1/suspend", nil)
-
-		assert.Equal(suite.T(), http.StatusOK, resp.StatusCode, "挂起流程实例应返回200状态码")
-
-		result := suite.parseResponse(body)
-		assert.Equal(suite.T(), float64(200), result["code"], "响应码应为200")
+	// 测试查询流程实例列表
+	suite.Run("查询流程实例列表", func() {
+		resp, body := suite.makeRequest("GET", "/api/v1/process-instances?process_definition_id="+definitionID, nil)
+		data := suite.expectData(resp, body, http.StatusOK)
 
-		data, ok := result["data"].(map[string]interface{})
-		assert.True(suite.T(), ok, "data字段应为对象")
-		assert.Equal(suite.T(), "suspended", data["status"], "状态应为suspended")
+		items, ok := data["items"].([]interface{})
+		assert.True(suite.T(), ok, "items字段应为数组")
+		assert.Len(suite.T(), items, 1, "应查询到一个流程实例")
 	})
 
-	// 测试激活流程实例
-	suite.Run("激活流程实例", func() {
-		resp, body := suite.makeRequest("POST", "/api/v1/process-instances/inst-1/activate", nil)
+	// 测试挂起与激活流程实例
+	suite.Run("挂起与激活流程实例", func() {
+		resp, body := suite.makeRequest("POST", "/api/v1/process-instances/"+instanceID+"/suspend", nil)
+		suite.expectData(resp, body, http.StatusOK)
 
-		assert.Equal(suite.T(), http.StatusOK, resp.StatusCode, "激活流程实例应返回200状态码")
+		resp, body = suite.makeRequest("GET", "/api/v1/process-instances/"+instanceID, nil)
+		data := suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), true, data["is_suspended"], "实例应已挂起")
 
-		result := suite.parseResponse(body)
-		assert.Equal(suite.T(), float64(200), result["code"], "响应码应为200")
+		resp, body = suite.makeRequest("POST", "/api/v1/process-instances/"+instanceID+"/activate", nil)
+		suite.expectData(resp, body, http.StatusOK)
 
-		data, ok := result["data"].(map[string]interface{})
-		assert.True(suite.T(), ok, "data字段应为对象")
-		assert.Equal(suite.T(), "active", data["status"], "状态应为active")
+		resp, body = suite.makeRequest("GET", "/api/v1/process-instances/"+instanceID, nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), false, data["is_suspended"], "实例应已激活")
 	})
 
 	// 测试终止流程实例
 	suite.Run("终止流程实例", func() {
-		terminateReq := map[string]interface{}{
+		resp, body := suite.makeRequest("POST", "/api/v1/process-instances/"+instanceID+"/terminate", map[string]interface{}{
 			"reason": "测试终止流程",
-		}
+		})
+		suite.expectData(resp, body, http.StatusOK)
 
-		resp, body := suite.makeRequest("POST", "/api/v1/process-instances/inst-1/terminate", terminateReq)
+		resp, body = suite.makeRequest("GET", "/api/v1/process-instances/"+instanceID, nil)
+		data := suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), true, data["is_ended"], "实例应已结束")
+	})
 
-		assert.Equal(suite.T(), http.StatusOK, resp.StatusCode, "终止流程实例应返回200状态码")
+	// 测试删除流程实例
+	suite.Run("删除流程实例", func() {
+		otherID := suite.startProcessInstance(definitionID, nil)
 
-		result := suite.parseResponse(body)
-		assert.Equal(suite.T(), float64(200), result["code"], "响应码应为200")
+		resp, body := suite.makeRequest("DELETE", "/api/v1/process-instances/"+otherID+"?reason="+url.QueryEscape("测试删除"), nil)
+		suite.expectData(resp, body, http.StatusOK)
 
-		data, ok := result["data"].(map[string]interface{})
-		assert.True(suite.T(), ok, "data字段应为对象")
-		assert.Equal(suite.T(), "terminated", data["status"], "状态应为terminated")
+		resp, body = suite.makeRequest("GET", "/api/v1/process-instances/"+otherID, nil)
+		suite.expectError(resp, body, http.StatusNotFound, service.ErrCodeNotFound)
 	})
 
 	suite.logger.Info("流程实例API测试完成")
@@ -374,75 +754,374 @@ func (suite *APITestSuite) TestProcessInstancesAPI() {
 func (suite *APITestSuite) TestTasksAPI() {
 	suite.logger.Info("开始测试任务API")
 
+	instanceID := suite.startProcessInstance(suite.createProcessDefinition("task-api"), nil)
+	processInstanceID, err := strconv.ParseInt(instanceID, 10, 64)
+	suite.Require().NoError(err, "流程实例ID应为数字")
+
+	// 任务通常由引擎在到达用户任务时创建，这里直接写入；当前用户为访问令牌中的 tester
+	createTask := func(name, key string, candidateUsers []string, candidateGroups []string) string {
+		task, err := suite.taskRepo.Create(context.Background(), &ent.TaskInstance{
+			Name:                 name,
+			TaskDefinitionKey:    key,
+			ProcessInstanceID:    processInstanceID,
+			ProcessDefinitionKey: "task-api",
+			Priority:             50,
+			CreateTime:           time.Now(),
+		})
+		suite.Require().NoError(err, "创建任务失败")
+		suite.Require().NoError(suite.taskRepo.AddCandidates(context.Background(), task.ID, candidateUsers, candidateGroups), "添加任务候选人失败")
+		return strconv.FormatInt(task.ID, 10)
+	}
+	taskID := createTask("部门审批", "dept_approve", []string{"tester"}, []string{"admin"})
+	delegatedTaskID := createTask("人事审批", "hr_approve", []string{"tester"}, nil)
+	financeTaskID := createTask("财务审批", "finance_approve", nil, []string{"finance"})
+
 	// 测试查询任务列表
 	suite.Run("查询任务列表", func() {
-		resp, body := suite.makeRequest("GET", "/api/v1/tasks", nil)
-
-		assert.Equal(suite.T(), http.StatusOK, resp.StatusCode, "查询任务列表应返回200状态码")
-
-		result := suite.parseResponse(body)
-		assert.Equal(suite.T(), float64(200), result["code"], "响应码应为200")
-
-		data, ok := result["data"].(map[string]interface{})
-		assert.True(suite.T(), ok, "data字段应为对象")
-		assert.NotNil(suite.T(), data["items"], "items字段不应为空")
+		resp, body := suite.makeRequest("GET", "/api/v1/tasks?process_instance_id="+instanceID, nil)
+		data := suite.expectData(resp, body, http.StatusOK)
+
+		items, ok := data["items"].([]interface{})
+		assert.True(suite.T(), ok, "items字段应为数组")
+		assert.Len(suite.T(), items, 3, "应查询到三个任务")
+
+		// 当前用户 tester 只能看到自己是候选用户、或角色 admin 是候选组的任务
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/available?process_instance_id="+instanceID, nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		items, _ = data["items"].([]interface{})
+		suite.Require().Len(items, 2, "只应查询到当前用户是候选人的任务")
+		available := make(map[interface{}]map[string]interface{}, len(items))
+		for _, item := range items {
+			task, _ := item.(map[string]interface{})
+			available[task["id"]] = task
+		}
+		assert.NotContains(suite.T(), available, financeTaskID, "不应查询到其他组的任务")
+		task := available[taskID]
+		suite.Require().NotNil(task, "可认领任务应包含候选组为 admin 的任务")
+		assert.Equal(suite.T(), []interface{}{"tester"}, task["candidate_users"], "应返回候选用户")
+		assert.Equal(suite.T(), []interface{}{"admin"}, task["candidate_groups"], "应返回候选组")
 	})
 
 	// 测试获取任务详情
 	suite.Run("获取任务详情", func() {
-		resp, body := suite.makeRequest("GET", "/api/v1/tasks/task-1", nil)
-
-		assert.Equal(suite.T(), http.StatusOK, resp.StatusCode, "获取任务详情应返回200状态码")
+		resp, body := suite.makeRequest("GET", "/api/v1/tasks/"+taskID, nil)
+		data := suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), taskID, data["id"], "任务ID应匹配")
+		assert.Equal(suite.T(), "部门审批", data["name"], "任务名称应匹配")
 
-		result := suite.parseResponse(body)
-		assert.Equal(suite.T(), float64(200), result["code"], "响应码应为200")
-
-		data, ok := result["data"].(map[string]interface{})
-		assert.True(suite.T(), ok, "data字段应为对象")
-		assert.Equal(suite.T(), "task-1", data["id"], "任务ID应匹配")
-		assert.NotEmpty(suite.T(), data["name"], "任务名称不应为空")
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/999999", nil)
+		suite.expectError(resp, body, http.StatusNotFound, service.ErrCodeNotFound)
 	})
 
 	// 测试认领任务
 	suite.Run("认领任务", func() {
-		claimReq := map[string]interface{}{
-			"user_id": "user-1",
+		resp, body := suite.makeRequest("POST", "/api/v1/tasks/"+taskID+"/claim", map[string]interface{}{})
+		suite.expectError(resp, body, http.StatusBadRequest, service.ErrCodeBadRequest)
+
+		// 非候选人不能认领任务
+		for _, userID := range []string{"tester", "system"} {
+			resp, body = suite.makeRequest("POST", "/api/v1/tasks/"+financeTaskID+"/claim", map[string]interface{}{
+				"user_id": userID,
+			})
+			suite.expectError(resp, body, http.StatusForbidden, service.ErrCodeTaskNotCandidate)
 		}
 
-		resp, body := suite.makeRequest("POST", "/api/v1/tasks/task-1/claim", claimReq)
-
-		assert.Equal(suite.T(), http.StatusOK, resp.StatusCode, "认领任务应返回200状态码")
-
-		result := suite.parseResponse(body)
-		assert.Equal(suite.T(), float64(200), result["code"], "响应码应为200")
+		for _, id := range []string{taskID, delegatedTaskID} {
+			resp, body = suite.makeRequest("POST", "/api/v1/tasks/"+id+"/claim", map[string]interface{}{
+				"user_id": "tester",
+			})
+			suite.expectData(resp, body, http.StatusOK)
+		}
 
-		data, ok := result["data"].(map[string]interface{})
-		assert.True(suite.T(), ok, "data字段应为对象")
-		assert.Equal(suite.T(), "claimed", data["status"], "状态应为claimed")
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/"+taskID, nil)
+		data := suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), "tester", data["assignee"], "任务应已被认领")
+		assert.Equal(suite.T(), biz.TaskStatusClaimed, data["status"], "任务状态应为已认领")
+		assert.NotNil(suite.T(), data["claim_time"], "应记录认领时间")
+
+		// 已被认领的任务不能被他人认领
+		resp, body = suite.makeRequest("POST", "/api/v1/tasks/"+taskID+"/claim", map[string]interface{}{
+			"user_id": "system",
+		})
+		suite.expectError(resp, body, http.StatusConflict, service.ErrCodeTaskAlreadyClaimed)
+
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/my", nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		items, _ := data["items"].([]interface{})
+		assert.Len(suite.T(), items, 2, "我的任务应包含已认领的任务")
 	})
 
 	// 测试委派任务
 	suite.Run("委派任务", func() {
-		delegateReq := map[string]interface{}{
+		resp, body := suite.makeRequest("POST", "/api/v1/tasks/"+delegatedTaskID+"/delegate", map[string]interface{}{
+			"comment": "缺少被委派人",
+		})
+		suite.expectError(resp, body, http.StatusBadRequest, service.ErrCodeBadRequest)
+
+		resp, body = suite.makeRequest("POST", "/api/v1/tasks/"+delegatedTaskID+"/delegate", map[string]interface{}{
 			"delegate_id": "user-2",
 			"comment":     "委派给其他人处理",
-		}
+		})
+		suite.expectData(resp, body, http.StatusOK)
+
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/"+delegatedTaskID, nil)
+		data := suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), "user-2", data["assignee"], "任务应已委派给user-2")
+		assert.Equal(suite.T(), "tester", data["owner"], "原办理人应成为拥有者")
+		assert.Equal(suite.T(), biz.TaskStatusDelegated, data["status"], "任务状态应为委派中")
+
+		// 委派中的任务不能由他人认领或再次委派
+		resp, body = suite.makeRequest("POST", "/api/v1/tasks/"+delegatedTaskID+"/claim", map[string]interface{}{
+			"user_id": "tester",
+		})
+		suite.expectError(resp, body, http.StatusConflict, service.ErrCodeInvalidTaskState)
+
+		// 只有被委派人才能交还任务
+		resp, body = suite.makeRequest("POST", "/api/v1/tasks/"+delegatedTaskID+"/resolve", nil)
+		suite.expectError(resp, body, http.StatusForbidden, service.ErrCodeTaskNotAssignee)
+
+		// 认领与委派都记入任务的办理记录
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/"+delegatedTaskID+"/history", nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		items, _ := data["items"].([]interface{})
+		suite.Require().Len(items, 2, "应有认领与委派两条办理记录")
+		claimed, _ := items[0].(map[string]interface{})
+		assert.Equal(suite.T(), biz.TaskEventClaimed, claimed["event_type"], "第一条应为认领记录")
+		delegated, _ := items[1].(map[string]interface{})
+		assert.Equal(suite.T(), biz.TaskEventDelegated, delegated["event_type"], "第二条应为委派记录")
+		delegation, _ := delegated["data"].(map[string]interface{})
+		assert.Equal(suite.T(), "tester", delegation["from"], "委派记录应包含原办理人")
+		assert.Equal(suite.T(), "user-2", delegation["to"], "委派记录应包含被委派人")
+	})
 
-		resp, body := suite.makeRequest("POST", "/api/v1/tasks/task-1/delegate", delegateReq)
+	// 测试交还委派任务
+	suite.Run("交还委派任务", func() {
+		// 由 manager 认领并委派给当前用户 tester
+		resolvedTaskID := createTask("法务审批", "legal_approve", []string{"manager"}, nil)
+		suite.Require().NoError(suite.taskRepo.Claim(context.Background(), resolvedTaskID, "manager"), "认领任务失败")
+		suite.Require().NoError(suite.taskRepo.Delegate(context.Background(), resolvedTaskID, "tester"), "委派任务失败")
+
+		resp, body := suite.makeRequest("POST", "/api/v1/tasks/"+resolvedTaskID+"/complete", nil)
+		suite.expectError(resp, body, http.StatusConflict, service.ErrCodeInvalidTaskState)
+
+		resp, body = suite.makeRequest("POST", "/api/v1/tasks/"+resolvedTaskID+"/resolve", map[string]interface{}{
+			"variables": map[string]interface{}{"checked": true},
+			"comment":   "已核对",
+		})
+		suite.expectData(resp, body, http.StatusOK)
+
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/"+resolvedTaskID, nil)
+		data := suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), "manager", data["assignee"], "任务应回到拥有者手中")
+		assert.Equal(suite.T(), biz.TaskDelegationResolved, data["delegation"], "委派状态应为已处理")
+		assert.Equal(suite.T(), biz.TaskStatusResolved, data["status"], "任务状态应为已交还")
+		variables, _ := data["variables"].(map[string]interface{})
+		assert.Equal(suite.T(), true, variables["checked"], "任务应携带被委派人填写的变量")
+
+		// 交还后只有拥有者能完成任务，重复交还被拒绝
+		resp, body = suite.makeRequest("POST", "/api/v1/tasks/"+resolvedTaskID+"/complete", nil)
+		suite.expectError(resp, body, http.StatusForbidden, service.ErrCodeTaskNotAssignee)
+		resp, body = suite.makeRequest("POST", "/api/v1/tasks/"+resolvedTaskID+"/resolve", nil)
+		suite.expectError(resp, body, http.StatusConflict, service.ErrCodeInvalidTaskState)
+
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/"+resolvedTaskID+"/history?order=desc", nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		items, _ := data["items"].([]interface{})
+		suite.Require().NotEmpty(items, "应有交还记录")
+		resolved, _ := items[0].(map[string]interface{})
+		assert.Equal(suite.T(), biz.TaskEventResolved, resolved["event_type"], "最新的记录应为交还记录")
+		assert.Equal(suite.T(), "tester", resolved["user_id"], "交还记录应包含被委派人")
+
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/999999/history", nil)
+		suite.expectError(resp, body, http.StatusNotFound, service.ErrCodeTaskNotFound)
+	})
 
-		assert.Equal(suite.T(), http.StatusOK, resp.StatusCode, "委派任务应返回200状态码")
+	// 测试修改任务、评论与附件
+	suite.Run("修改任务、评论与附件", func() {
+		resp, body := suite.makeRequest("PUT", "/api/v1/tasks/"+taskID, map[string]interface{}{
+			"priority": 80,
+			"category": "finance",
+		})
+		data := suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), float64(80), data["priority"], "优先级应已修改")
+		assert.Equal(suite.T(), "finance", data["category"], "分类应已修改")
+
+		resp, body = suite.makeRequest("PUT", "/api/v1/tasks/"+taskID, map[string]interface{}{"name": ""})
+		suite.expectError(resp, body, http.StatusUnprocessableEntity, service.ErrCodeValidationError)
+
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/"+taskID+"/history?order=desc", nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		items, _ := data["items"].([]interface{})
+		suite.Require().NotEmpty(items, "应有修改记录")
+		updated, _ := items[0].(map[string]interface{})
+		assert.Equal(suite.T(), biz.TaskEventUpdated, updated["event_type"], "最新的记录应为修改记录")
+
+		// 评论
+		resp, body = suite.makeRequest("POST", "/api/v1/tasks/"+taskID+"/comments", map[string]interface{}{})
+		suite.expectError(resp, body, http.StatusBadRequest, service.ErrCodeBadRequest)
+		resp, body = suite.makeRequest("POST", "/api/v1/tasks/"+taskID+"/comments", map[string]interface{}{
+			"message": "请补充发票",
+		})
+		data = suite.expectData(resp, body, http.StatusCreated)
+		assert.Equal(suite.T(), biz.TaskCommentTypeComment, data["type"], "应为普通评论")
+		assert.Equal(suite.T(), instanceID, data["process_instance_id"], "评论应关联流程实例")
+
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/"+taskID+"/comments", nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		items, _ = data["items"].([]interface{})
+		suite.Require().Len(items, 1, "应查询到一条评论")
+
+		// 委派时填写的备注保存为任务评论
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/"+delegatedTaskID+"/comments", nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		items, _ = data["items"].([]interface{})
+		suite.Require().Len(items, 1, "委派备注应保存为评论")
+		comment, _ := items[0].(map[string]interface{})
+		assert.Equal(suite.T(), biz.TaskCommentTypeDelegate, comment["type"], "评论类型应为委派")
+		assert.Equal(suite.T(), "委派给其他人处理", comment["message"], "评论内容应为委派备注")
+
+		// 附件
+		var form bytes.Buffer
+		writer := multipart.NewWriter(&form)
+		part, err := writer.CreateFormFile("file", "invoice.txt")
+		suite.Require().NoError(err, "创建上传文件失败")
+		_, err = part.Write([]byte("invoice-001"))
+		suite.Require().NoError(err, "写入上传文件失败")
+		suite.Require().NoError(writer.WriteField("description", "发票"), "写入附件说明失败")
+		suite.Require().NoError(writer.Close(), "结束上传表单失败")
+
+		req, err := http.NewRequest("POST", suite.server.URL+"/api/v1/tasks/"+taskID+"/attachments", &form)
+		suite.Require().NoError(err, "创建请求失败")
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		req.Header.Set("Authorization", "Bearer "+suite.token)
+		resp, err = http.DefaultClient.Do(req)
+		suite.Require().NoError(err, "发送请求失败")
+		body, err = io.ReadAll(resp.Body)
+		resp.Body.Close()
+		suite.Require().NoError(err, "读取响应体失败")
+		data = suite.expectData(resp, body, http.StatusCreated)
+		attachmentID, _ := data["id"].(string)
+		assert.Equal(suite.T(), "invoice.txt", data["name"], "应记录文件名")
+		assert.Equal(suite.T(), float64(11), data["size"], "应记录文件大小")
+
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/"+taskID+"/attachments", nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		items, _ = data["items"].([]interface{})
+		suite.Require().Len(items, 1, "应查询到一个附件")
+
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/"+taskID+"/attachments/"+attachmentID+"/content", nil)
+		assert.Equal(suite.T(), http.StatusOK, resp.StatusCode, "下载附件应成功")
+		assert.Equal(suite.T(), "invoice-001", string(body), "附件内容应匹配")
+		assert.Contains(suite.T(), resp.Header.Get("Content-Disposition"), "invoice.txt", "应返回附件文件名")
+
+		// 附件不属于该任务时视为不存在
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/"+delegatedTaskID+"/attachments/"+attachmentID+"/content", nil)
+		suite.expectError(resp, body, http.StatusNotFound, service.ErrCodeNotFound)
+	})
 
-		result := suite.parseResponse(body)
-		assert.Equal(suite.T(), float64(200), result["code"], "响应码应为200")
+	// 测试子任务
+	suite.Run("子任务", func() {
+		resp, body := suite.makeRequest("POST", "/api/v1/tasks/"+taskID+"/subtasks", map[string]interface{}{})
+		suite.expectError(resp, body, http.StatusUnprocessableEntity, service.ErrCodeValidationError)
+
+		resp, body = suite.makeRequest("POST", "/api/v1/tasks/"+taskID+"/subtasks", map[string]interface{}{
+			"name":         "核对发票",
+			"block_parent": true,
+		})
+		data := suite.expectData(resp, body, http.StatusCreated)
+		subtaskID, _ := data["id"].(string)
+		assert.Equal(suite.T(), taskID, data["parent_task_id"], "子任务应关联父任务")
+		assert.Equal(suite.T(), "tester", data["assignee"], "未指定办理人时由创建人办理")
+		assert.Equal(suite.T(), biz.TaskStatusClaimed, data["status"], "子任务创建后即已认领")
+
+		// 子任务未结束时父任务不能完成
+		resp, body = suite.makeRequest("POST", "/api/v1/tasks/"+taskID+"/complete", nil)
+		suite.expectError(resp, body, http.StatusConflict, service.ErrCodeTaskHasOpenSubtasks)
+
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/"+taskID, nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), true, data["wait_for_subtasks"], "父任务应等待子任务")
+		subtasks, _ := data["subtasks"].([]interface{})
+		suite.Require().Len(subtasks, 1, "任务详情应包含子任务")
+
+		// 子任务不在顶层任务列表中，按需返回子任务树
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks?with_subtasks=true&process_instance_id="+instanceID, nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		items, _ := data["items"].([]interface{})
+		for _, item := range items {
+			task, _ := item.(map[string]interface{})
+			assert.NotEqual(suite.T(), subtaskID, task["id"], "子任务不应出现在顶层任务中")
+		}
 
-		data, ok := result["data"].(map[string]interface{})
-		assert.True(suite.T(), ok, "data字段应为对象")
-		assert.Equal(suite.T(), "delegated", data["status"], "状态应为delegated")
+		// 完成子任务不通知工作流
+		resp, body = suite.makeRequest("POST", "/api/v1/tasks/"+subtaskID+"/complete", map[string]interface{}{
+			"comment": "发票无误",
+		})
+		suite.expectData(resp, body, http.StatusOK)
+		assert.Empty(suite.T(), suite.engine.taskCompletions(), "完成子任务不应发送任务完成信号")
+
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/"+taskID+"/subtasks", nil)
+		suite.Require().Equal(http.StatusOK, resp.StatusCode, "查询子任务应成功，响应: %s", body)
+		items, _ = suite.parseResponse(body)["data"].([]interface{})
+		suite.Require().Len(items, 1, "应查询到一个子任务")
+		subtask, _ := items[0].(map[string]interface{})
+		assert.Equal(suite.T(), biz.TaskStatusCompleted, subtask["status"], "子任务应已完成")
+	})
+
+	// 测试批量操作任务
+	suite.Run("批量操作任务", func() {
+		resp, body := suite.makeRequest("POST", "/api/v1/tasks/bulk", map[string]interface{}{
+			"action":   biz.BulkTaskReassign,
+			"task_ids": []string{taskID},
+		})
+		suite.expectError(resp, body, http.StatusUnprocessableEntity, service.ErrCodeValidationError)
+
+		// 每个任务分别返回处理结果
+		resp, body = suite.makeRequest("POST", "/api/v1/tasks/bulk", map[string]interface{}{
+			"action":   biz.BulkTaskUnclaim,
+			"task_ids": []string{taskID, financeTaskID},
+		})
+		data := suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), float64(1), data["succeeded"], "已认领的任务应取消认领成功")
+		assert.Equal(suite.T(), float64(1), data["failed"], "未认领的任务应取消认领失败")
+		items, _ := data["items"].([]interface{})
+		suite.Require().Len(items, 2, "应返回每个任务的处理结果")
+		failed, _ := items[1].(map[string]interface{})
+		assert.Equal(suite.T(), financeTaskID, failed["task_id"], "失败结果应对应任务")
+		assert.Equal(suite.T(), float64(service.ErrCodeInvalidTaskState), failed["code"], "失败结果应包含错误码")
+
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/"+taskID, nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), biz.TaskStatusCreated, data["status"], "取消认领后任务应待认领")
+
+		resp, body = suite.makeRequest("POST", "/api/v1/tasks/bulk", map[string]interface{}{
+			"action":      biz.BulkTaskClaim,
+			"task_ids":    []string{taskID},
+			"assignee_id": "tester",
+		})
+		data = suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), float64(1), data["succeeded"], "候选用户应认领成功")
+
+		resp, body = suite.makeRequest("POST", "/api/v1/tasks/bulk", map[string]interface{}{
+			"action":   biz.BulkTaskSetPriority,
+			"task_ids": []string{taskID, "999999"},
+			"priority": 90,
+		})
+		data = suite.expectData(resp, body, http.StatusOK)
+		items, _ = data["items"].([]interface{})
+		suite.Require().Len(items, 2, "应返回每个任务的处理结果")
+		missing, _ := items[1].(map[string]interface{})
+		assert.Equal(suite.T(), float64(service.ErrCodeTaskNotFound), missing["code"], "不存在的任务应返回任务不存在")
+
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/"+taskID, nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), "tester", data["assignee"], "任务应已重新认领")
+		assert.Equal(suite.T(), float64(90), data["priority"], "优先级应已修改")
 	})
 
 	// 测试完成任务
 	suite.Run("完成任务", func() {
-		completeReq := map[string]interface{}{
+		request := map[string]interface{}{
 			"variables": map[string]interface{}{
 				"approved": true,
 				"comment":  "审批通过",
@@ -450,16 +1129,76 @@ func (suite *APITestSuite) TestTasksAPI() {
 			"comment": "任务完成",
 		}
 
-		resp, body := suite.makeRequest("POST", "/api/v1/tasks/task-1/complete", completeReq)
-
-		assert.Equal(suite.T(), http.StatusOK, resp.StatusCode, "完成任务应返回200状态码")
-
-		result := suite.parseResponse(body)
-		assert.Equal(suite.T(), float64(200), result["code"], "响应码应为200")
-
-		data, ok := result["data"].(map[string]interface{})
-		assert.True(suite.T(), ok, "data字段应为对象")
-		assert.Equal(suite.T(), "completed", data["status"], "状态应为completed")
+		// 通知流程引擎失败时任务保持未完成，可以重试
+		suite.engine.setSignalError(fmt.Errorf("temporal unavailable"))
+		resp, body := suite.makeRequest("POST", "/api/v1/tasks/"+taskID+"/complete", request)
+		suite.expectError(resp, body, http.StatusInternalServerError, service.ErrCodeInternalError)
+		suite.engine.setSignalError(nil)
+
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/"+taskID, nil)
+		suite.expectData(resp, body, http.StatusOK)
+
+		resp, body = suite.makeRequest("POST", "/api/v1/tasks/"+taskID+"/complete", request)
+		suite.expectData(resp, body, http.StatusOK)
+
+		resp, body = suite.makeRequest("GET", "/api/v1/process-instances/"+instanceID+"/variables/approved", nil)
+		data := suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), true, data["value"], "任务输出变量应写入流程实例")
+
+		// 完成任务应通知所属工作流，并携带任务ID与输出变量
+		completions := suite.engine.taskCompletions()
+		suite.Require().Len(completions, 1, "应发送一次任务完成信号")
+		assert.Equal(suite.T(), "dept_approve", completions[0].NodeID, "信号应携带任务节点")
+		assert.Equal(suite.T(), taskID, strconv.FormatInt(completions[0].TaskID, 10), "信号应携带任务ID")
+		assert.Equal(suite.T(), true, completions[0].Variables["approved"], "信号应携带任务输出变量")
+		assert.Equal(suite.T(), "tester", completions[0].CompletedBy, "信号应携带完成人")
+
+		// 已完成的任务保留结束时间与持续时间
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/"+taskID, nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), biz.TaskStatusCompleted, data["status"], "任务状态应为已完成")
+		assert.NotNil(suite.T(), data["end_time"], "应记录结束时间")
+
+		// 完成备注保存为任务评论，已完成的任务仍可查询评论
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/"+taskID+"/comments", nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		comments, _ := data["items"].([]interface{})
+		suite.Require().NotEmpty(comments, "应有完成备注")
+		comment, _ := comments[0].(map[string]interface{})
+		assert.Equal(suite.T(), biz.TaskCommentTypeComplete, comment["type"], "最新的评论应为完成备注")
+		assert.Equal(suite.T(), "任务完成", comment["message"], "评论内容应为完成备注")
+
+		// 重试的完成请求被拒绝，不会再次推进流程
+		resp, body = suite.makeRequest("POST", "/api/v1/tasks/"+taskID+"/complete", request)
+		suite.expectError(resp, body, http.StatusConflict, service.ErrCodeTaskAlreadyCompleted)
+		assert.Len(suite.T(), suite.engine.taskCompletions(), 1, "重试不应再次发送完成信号")
+
+		resp, body = suite.makeRequest("POST", "/api/v1/tasks/"+taskID+"/claim", map[string]interface{}{
+			"user_id": "tester",
+		})
+		suite.expectError(resp, body, http.StatusConflict, service.ErrCodeTaskAlreadyCompleted)
+
+		// 我的任务只包含未结束的任务，已完成的任务可以按状态查询
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks/my", nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		items, _ := data["items"].([]interface{})
+		assert.Len(suite.T(), items, 0, "已完成与已委派的任务不在我的任务中")
+
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks?status=completed&process_instance_id="+instanceID, nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		items, _ = data["items"].([]interface{})
+		assert.Len(suite.T(), items, 2, "应按状态查询到已完成的任务与子任务")
+
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks?with_subtasks=true&status=completed&process_instance_id="+instanceID, nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		items, _ = data["items"].([]interface{})
+		suite.Require().Len(items, 1, "返回子任务树时只列出顶层任务")
+		completed, _ := items[0].(map[string]interface{})
+		subtasks, _ := completed["subtasks"].([]interface{})
+		assert.Len(suite.T(), subtasks, 1, "顶层任务应包含子任务")
+
+		resp, body = suite.makeRequest("GET", "/api/v1/tasks?status=unknown", nil)
+		suite.expectError(resp, body, http.StatusBadRequest, service.ErrCodeBadRequest)
 	})
 
 	suite.logger.Info("任务API测试完成")
@@ -473,38 +1212,282 @@ func (suite *APITestSuite) TestTasksAPI() {
 func (suite *APITestSuite) TestHistoryAPI() {
 	suite.logger.Info("开始测试历史数据API")
 
-	// 测试查询历史流程实例列表
-	suite.Run("查询历史流程实例列表", func() {
-		resp, body := suite.makeRequest("GET", "/api/v1/history/process-instances", nil)
+	ctx := context.Background()
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	endTime := day.Add(10 * time.Hour)
+	completed, err := suite.historicRepo.Create(ctx, &ent.HistoricProcessInstance{
+		ProcessInstanceID: "9001", ProcessDefinitionID: 1, ProcessDefinitionKey: "history-api", BusinessKey: "HIS-001",
+		StartUserID: "zhangsan", StartTime: day.Add(9 * time.Hour), EndTime: &endTime,
+		Duration: time.Hour.Milliseconds(), State: repository.HistoricStateCompleted,
+	})
+	suite.Require().NoError(err, "创建历史流程实例失败")
+	_, err = suite.historicRepo.Create(ctx, &ent.HistoricProcessInstance{
+		ProcessInstanceID: "9002", ProcessDefinitionID: 1, ProcessDefinitionKey: "history-api", BusinessKey: "HIS-002",
+		StartUserID: "lisi", StartTime: day.Add(11 * time.Hour), State: repository.HistoricStateActive,
+	})
+	suite.Require().NoError(err, "创建历史流程实例失败")
+	completedID := strconv.FormatInt(completed.ID, 10)
 
-		assert.Equal(suite.T(), http.StatusOK, resp.StatusCode, "查询历史列表应返回200状态码")
+	rangeQuery := "process_definition_key=history-api" +
+		"&start_time=" + url.QueryEscape(day.Format(time.RFC3339)) +
+		"&end_time=" + url.QueryEscape(day.Add(24*time.Hour).Format(time.RFC3339))
 
-		result := suite.parseResponse(body)
-		assert.Equal(suite.T(), float64(200), result["code"], "响应码应为200")
+	// 测试查询历史流程实例列表
+	suite.Run("查询历史流程实例列表", func() {
+		resp, body := suite.makeRequest("GET", "/api/v1/history/process-instances?process_definition_key=history-api", nil)
+		data := suite.expectData(resp, body, http.StatusOK)
 
-		data, ok := result["data"].(map[string]interface{})
-		assert.True(suite.T(), ok, "data字段应为对象")
-		assert.NotNil(suite.T(), data["items"], "items字段不应为空")
+		items, ok := data["items"].([]interface{})
+		assert.True(suite.T(), ok, "items字段应为数组")
+		assert.Len(suite.T(), items, 2, "应查询到两个历史实例")
+		assert.Equal(suite.T(), float64(2), data["total"], "总数应为2")
 	})
 
 	// 测试获取历史流程实例详情
 	suite.Run("获取历史流程实例详情", func() {
-		resp, body := suite.makeRequest("GET", "/api/v1/history/process-instances/hist-1", nil)
+		resp, body := suite.makeRequest("GET", "/api/v1/history/process-instances/"+completedID, nil)
+		data := suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), completedID, data["id"], "历史ID应匹配")
+		assert.Equal(suite.T(), float64(time.Hour.Milliseconds()), data["duration"], "持续时间应匹配")
 
-		assert.Equal(suite.T(), http.StatusOK, resp.StatusCode, "获取历史详情应返回200状态码")
+		resp, body = suite.makeRequest("GET", "/api/v1/history/process-instances/hist-1", nil)
+		suite.expectError(resp, body, http.StatusBadRequest, service.ErrCodeBadRequest)
+	})
 
-		result := suite.parseResponse(body)
-		assert.Equal(suite.T(), float64(200), result["code"], "响应码应为200")
+	// 测试流程统计
+	suite.Run("流程统计", func() {
+		resp, body := suite.makeRequest("GET", "/api/v1/history/statistics?"+rangeQuery, nil)
+		data := suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), float64(2), data["total_instances"], "总实例数应匹配")
+		assert.Equal(suite.T(), float64(1), data["completed_instances"], "完成实例数应匹配")
 
-		data, ok := result["data"].(map[string]interface{})
-		assert.True(suite.T(), ok, "data字段应为对象")
-		assert.Equal(suite.T(), "hist-1", data["id"], "历史ID应匹配")
-		assert.NotEmpty(suite.T(), data["duration"], "持续时间不应为空")
+		resp, body = suite.makeRequest("GET", "/api/v1/history/statistics?process_definition_key=history-api&start_time=yesterday", nil)
+		suite.expectError(resp, body, http.StatusBadRequest, service.ErrCodeBadRequest)
+
+		resp, body = suite.makeRequest("GET", "/api/v1/history/statistics?process_definition_key=history-api", nil)
+		suite.expectError(resp, body, http.StatusBadRequest, service.ErrCodeBadRequest)
+	})
+
+	// 测试流程趋势
+	suite.Run("流程趋势", func() {
+		resp, body := suite.makeRequest("GET", "/api/v1/history/trend?granularity=day&"+rangeQuery, nil)
+		suite.expectData(resp, body, http.StatusOK)
+
+		resp, body = suite.makeRequest("GET", "/api/v1/history/trend?granularity=minute&"+rangeQuery, nil)
+		suite.expectError(resp, body, http.StatusBadRequest, service.ErrCodeBadRequest)
+	})
+
+	// 测试批量删除历史流程实例
+	suite.Run("批量删除历史流程实例", func() {
+		resp, body := suite.makeRequest("DELETE", "/api/v1/history/process-instances?process_definition_key=history-api"+
+			"&end_time_before="+url.QueryEscape(day.Add(24*time.Hour).Format(time.RFC3339)), nil)
+		data := suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), float64(1), data["deleted_count"], "只应删除已结束的实例")
+
+		resp, body = suite.makeRequest("GET", "/api/v1/history/process-instances?process_definition_key=history-api", nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		assert.Equal(suite.T(), float64(1), data["total"], "未结束的实例应保留")
 	})
 
 	suite.logger.Info("历史数据API测试完成")
 }
 
+// ====================
+// 资源授权API测试
+// ====================
+
+// TestAuthorizationAPI 测试流程资源授权
+func (suite *APITestSuite) TestAuthorizationAPI() {
+	adminToken := suite.token
+	defer func() { suite.token = adminToken }()
+
+	grantedID := suite.createProcessDefinition("authz-granted")
+	otherID := suite.createProcessDefinition("authz-other")
+	otherInstanceID := suite.startProcessInstance(otherID, nil)
+
+	var userToken, grantID, ownInstanceID string
+	suite.Run("普通用户不能管理授权", func() {
+		resp, body := suite.makeRequest("POST", "/api/v1/roles", map[string]interface{}{
+			"name":        "clerk",
+			"permissions": []string{"task:claim"},
+		})
+		suite.expectData(resp, body, http.StatusCreated)
+		resp, body = suite.makeRequest("POST", "/api/v1/users", map[string]interface{}{
+			"username": "bob",
+			"password": "correct-horse-2",
+			"roles":    []string{"clerk"},
+		})
+		suite.expectData(resp, body, http.StatusCreated)
+
+		suite.token = ""
+		resp, body = suite.makeRequest("POST", "/api/v1/auth/login", map[string]interface{}{
+			"username": "bob",
+			"password": "correct-horse-2",
+		})
+		userToken = suite.expectData(resp, body, http.StatusOK)["access_token"].(string)
+
+		suite.token = userToken
+		resp, _ = suite.makeRequest("GET", "/api/v1/authorizations", nil)
+		suite.Equal(http.StatusForbidden, resp.StatusCode, "非管理员不能管理授权")
+
+		resp, body = suite.makeRequest("POST", "/api/v1/process-instances", map[string]interface{}{"process_definition_id": grantedID})
+		suite.expectError(resp, body, http.StatusForbidden, service.ErrCodeForbidden)
+	})
+
+	suite.Run("普通用户不能为他人认领任务", func() {
+		instanceID, err := strconv.ParseInt(otherInstanceID, 10, 64)
+		suite.Require().NoError(err, "流程实例ID应为数字")
+		task, err := suite.taskRepo.Create(context.Background(), &ent.TaskInstance{
+			Name:                 "候选审批",
+			TaskDefinitionKey:    "candidate_approve",
+			ProcessInstanceID:    instanceID,
+			ProcessDefinitionKey: "authz-other",
+			CreateTime:           time.Now(),
+		})
+		suite.Require().NoError(err, "创建任务失败")
+		suite.Require().NoError(suite.taskRepo.AddCandidates(context.Background(), task.ID, []string{"bob", "tester"}, nil), "添加任务候选人失败")
+		taskID := strconv.FormatInt(task.ID, 10)
+
+		suite.token = userToken
+		resp, body := suite.makeRequest("POST", "/api/v1/tasks/"+taskID+"/claim", map[string]interface{}{"user_id": "tester"})
+		suite.expectError(resp, body, http.StatusForbidden, service.ErrCodeForbidden)
+		resp, body = suite.makeRequest("POST", "/api/v1/tasks/bulk", map[string]interface{}{
+			"action":      biz.BulkTaskClaim,
+			"task_ids":    []string{taskID},
+			"assignee_id": "tester",
+		})
+		suite.expectError(resp, body, http.StatusForbidden, service.ErrCodeForbidden)
+
+		resp, body = suite.makeRequest("POST", "/api/v1/tasks/999999/claim", map[string]interface{}{"user_id": "bob"})
+		suite.expectError(resp, body, http.StatusNotFound, service.ErrCodeTaskNotFound)
+	})
+
+	suite.Run("管理员授予启动与查看权限", func() {
+		suite.token = adminToken
+		resp, body := suite.makeRequest("POST", "/api/v1/authorizations", map[string]interface{}{
+			"principal_type": "user",
+			"principal_id":   "bob",
+			"actions":        []string{"delete"},
+		})
+		suite.expectError(resp, body, http.StatusUnprocessableEntity, service.ErrCodeValidationError)
+
+		resp, body = suite.makeRequest("POST", "/api/v1/authorizations", map[string]interface{}{
+			"principal_type":         "user",
+			"principal_id":           "bob",
+			"process_definition_key": "authz-granted",
+			"actions":                []string{"start", "read"},
+		})
+		data := suite.expectData(resp, body, http.StatusCreated)
+		suite.Require().NotEmpty(data["id"], "创建的授权应有ID")
+		grantID = data["id"].(string)
+
+		resp, body = suite.makeRequest("GET", "/api/v1/authorizations?principal_id=bob", nil)
+		suite.Require().Equal(http.StatusOK, resp.StatusCode, "查询授权应成功")
+		grants, _ := suite.parseResponse(body)["data"].([]interface{})
+		suite.Len(grants, 1, "应查询到一条授权")
+	})
+
+	suite.Run("按授权启动和查看流程实例", func() {
+		suite.token = userToken
+		resp, body := suite.makeRequest("POST", "/api/v1/process-instances", map[string]interface{}{"process_definition_id": grantedID})
+		data := suite.expectData(resp, body, http.StatusCreated)
+		ownInstanceID = data["id"].(string)
+
+		resp, body = suite.makeRequest("POST", "/api/v1/process-instances", map[string]interface{}{"process_definition_id": otherID})
+		suite.expectError(resp, body, http.StatusForbidden, service.ErrCodeForbidden)
+
+		resp, body = suite.makeRequest("GET", "/api/v1/process-instances", nil)
+		data = suite.expectData(resp, body, http.StatusOK)
+		items, _ := data["items"].([]interface{})
+		suite.Require().Len(items, 1, "只应查询到有权限的流程实例")
+		suite.Equal(ownInstanceID, items[0].(map[string]interface{})["id"], "流程实例ID应匹配")
+
+		resp, body = suite.makeRequest("GET", "/api/v1/process-instances/"+otherInstanceID, nil)
+		suite.expectError(resp, body, http.StatusForbidden, service.ErrCodeForbidden)
+
+		resp, body = suite.makeRequest("POST", "/api/v1/process-instances/"+ownInstanceID+"/terminate", map[string]interface{}{"reason": "测试"})
+		suite.expectError(resp, body, http.StatusForbidden, service.ErrCodeForbidden)
+	})
+
+	suite.Run("只有查看与启动授权时不能管理流程定义", func() {
+		suite.token = userToken
+		resp, body := suite.makeRequest("POST", "/api/v1/process-definitions", map[string]interface{}{
+			"key":      "authz-granted",
+			"name":     "测试流程",
+			"resource": processResource("authz-granted"),
+		})
+		suite.expectError(resp, body, http.StatusForbidden, service.ErrCodeForbidden)
+
+		resp, body = suite.makeRequest("PUT", "/api/v1/process-definitions/"+grantedID, map[string]interface{}{"name": "改名"})
+		suite.expectError(resp, body, http.StatusForbidden, service.ErrCodeForbidden)
+		resp, body = suite.makeRequest("POST", "/api/v1/process-definitions/"+grantedID+"/deploy", nil)
+		suite.expectError(resp, body, http.StatusForbidden, service.ErrCodeForbidden)
+		resp, body = suite.makeRequest("DELETE", "/api/v1/process-definitions/"+grantedID, nil)
+		suite.expectError(resp, body, http.StatusForbidden, service.ErrCodeForbidden)
+	})
+
+	suite.Run("删除授权后立即生效", func() {
+		suite.token = adminToken
+		resp, body := suite.makeRequest("DELETE", "/api/v1/authorizations/"+grantID, nil)
+		suite.expectData(resp, body, http.StatusOK)
+		resp, body = suite.makeRequest("DELETE", "/api/v1/authorizations/"+grantID, nil)
+		suite.expectError(resp, body, http.StatusNotFound, service.ErrCodeNotFound)
+
+		suite.token = userToken
+		resp, body = suite.makeRequest("POST", "/api/v1/process-instances", map[string]interface{}{"process_definition_id": grantedID})
+		suite.expectError(resp, body, http.StatusForbidden, service.ErrCodeForbidden)
+
+		resp, body = suite.makeRequest("GET", "/api/v1/process-instances/"+ownInstanceID, nil)
+		suite.expectData(resp, body, http.StatusOK)
+	})
+
+	suite.Run("按租户授权可以查看引擎创建的任务", func() {
+		suite.token = adminToken
+		resp, body := suite.makeRequest("POST", "/api/v1/process-instances", map[string]interface{}{
+			"process_definition_id": otherID,
+			"tenant_id":             "tenant-a",
+		})
+		instanceID, err := strconv.ParseInt(suite.expectData(resp, body, http.StatusCreated)["id"].(string), 10, 64)
+		suite.Require().NoError(err, "流程实例ID应为数字")
+		started := suite.engine.lastStarted()
+		suite.Equal("tenant-a", started.TenantID, "工作流输入应携带流程实例的租户")
+
+		// 按工作流输入由引擎活动创建用户任务
+		activities := temporal.NewProcessActivities(nil, nil, nil, nil, nil, suite.taskRepo, nil, temporal.NewServiceRegistry(), suite.logger)
+		taskID, err := activities.CreateUserTaskActivity(context.Background(), temporal.CreateUserTaskInput{
+			ProcessInstanceID:    instanceID,
+			ProcessDefinitionID:  started.ProcessDefinitionID,
+			ProcessDefinitionKey: "authz-other",
+			TenantID:             started.TenantID,
+			ExecutionID:          "root",
+			NodeID:               "tenant_approve",
+			Visit:                1,
+			Name:                 "租户审批",
+			CreateTime:           time.Now(),
+		})
+		suite.Require().NoError(err, "创建用户任务失败")
+		path := "/api/v1/tasks/" + strconv.FormatInt(taskID, 10)
+
+		suite.token = userToken
+		resp, body = suite.makeRequest("GET", path, nil)
+		suite.expectError(resp, body, http.StatusForbidden, service.ErrCodeForbidden)
+
+		suite.token = adminToken
+		resp, body = suite.makeRequest("POST", "/api/v1/authorizations", map[string]interface{}{
+			"principal_type": "user",
+			"principal_id":   "bob",
+			"tenant_id":      "tenant-a",
+			"actions":        []string{"read"},
+		})
+		suite.expectData(resp, body, http.StatusCreated)
+
+		suite.token = userToken
+		resp, body = suite.makeRequest("GET", path, nil)
+		data := suite.expectData(resp, body, http.StatusOK)
+		suite.Equal("tenant-a", data["tenant_id"], "任务应属于流程实例所在的租户")
+	})
+}
+
 // ====================
 // CORS和中间件测试
 // ====================
@@ -531,6 +1514,11 @@ func (suite *APITestSuite) TestCORSMiddleware() {
 	assert.Equal(suite.T(), "*", resp.Header.Get("Access-Control-Allow-Origin"), "应允许所有来源")
 	assert.Contains(suite.T(), resp.Header.Get("Access-Control-Allow-Methods"), "POST", "应允许POST方法")
 
+	// 只注册了POST方法的路由同样应该响应预检请求
+	resp, _ = suite.makeRequest("OPTIONS", "/api/v1/tasks/1/claim", nil)
+	assert.Equal(suite.T(), http.StatusOK, resp.StatusCode, "OPTIONS请求应返回200状态码")
+	assert.Equal(suite.T(), "*", resp.Header.Get("Access-Control-Allow-Origin"), "应允许所有来源")
+
 	suite.logger.Info("CORS中间件测试通过")
 }
 
@@ -0,0 +1,211 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/workflow-engine/workflow-engine/internal/temporal"
+)
+
+// memoryCache 基于内存的缓存仓储，序列化规则与Redis缓存仓储保持一致
+type memoryCache struct {
+	mu     sync.Mutex
+	values map[string]string
+	hashes map[string]map[string]string
+}
+
+// newMemoryCache 创建内存缓存
+func newMemoryCache() *memoryCache {
+	return &memoryCache{
+		values: make(map[string]string),
+		hashes: make(map[string]map[string]string),
+	}
+}
+
+func (m *memoryCache) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	data, err := encodeCacheValue(value)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.values[key] = data
+	return nil
+}
+
+func (m *memoryCache) Get(ctx context.Context, key string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	value, ok := m.values[key]
+	if !ok {
+		return "", fmt.Errorf("缓存不存在: %s", key)
+	}
+	return value, nil
+}
+
+func (m *memoryCache) Delete(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.values, key)
+	delete(m.hashes, key)
+	return nil
+}
+
+func (m *memoryCache) Exists(ctx context.Context, key string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.values[key]
+	return ok, nil
+}
+
+func (m *memoryCache) Expire(ctx context.Context, key string, expiration time.Duration) error {
+	return nil
+}
+
+func (m *memoryCache) HGet(ctx context.Context, key, field string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	value, ok := m.hashes[key][field]
+	if !ok {
+		return "", fmt.Errorf("缓存不存在: %s.%s", key, field)
+	}
+	return value, nil
+}
+
+func (m *memoryCache) HSet(ctx context.Context, key, field string, value interface{}) error {
+	data, err := encodeCacheValue(value)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.hashes[key] == nil {
+		m.hashes[key] = make(map[string]string)
+	}
+	m.hashes[key][field] = data
+	return nil
+}
+
+func (m *memoryCache) HDel(ctx context.Context, key string, fields ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, field := range fields {
+		delete(m.hashes[key], field)
+	}
+	return nil
+}
+
+func (m *memoryCache) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := make(map[string]string, len(m.hashes[key]))
+	for field, value := range m.hashes[key] {
+		result[field] = value
+	}
+	return result, nil
+}
+
+// encodeCacheValue 序列化缓存值
+func encodeCacheValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	default:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return "", fmt.Errorf("序列化缓存值失败: %w", err)
+		}
+		return string(data), nil
+	}
+}
+
+// fakeWorkflowEngine 记录调用的工作流引擎，不连接Temporal
+type fakeWorkflowEngine struct {
+	mu         sync.Mutex
+	started    []temporal.ProcessWorkflowInput
+	terminated []string
+	signals    []fakeSignal
+	signalErr  error // 不为空时发送信号失败
+}
+
+// fakeSignal 记录的工作流信号
+type fakeSignal struct {
+	workflowID string
+	name       string
+	arg        interface{}
+}
+
+func (f *fakeWorkflowEngine) StartProcessWorkflow(ctx context.Context, input temporal.ProcessWorkflowInput) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.started = append(f.started, input)
+	return fmt.Sprintf("run-%d", len(f.started)), nil
+}
+
+func (f *fakeWorkflowEngine) SignalWorkflow(ctx context.Context, workflowID string, runID string, signalName string, arg interface{}) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.signalErr != nil {
+		return f.signalErr
+	}
+	f.signals = append(f.signals, fakeSignal{workflowID: workflowID, name: signalName, arg: arg})
+	return nil
+}
+
+// setSignalError 设置发送信号返回的错误，nil表示发送成功
+func (f *fakeWorkflowEngine) setSignalError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.signalErr = err
+}
+
+// lastStarted 返回最近一次启动流程工作流的输入
+func (f *fakeWorkflowEngine) lastStarted() temporal.ProcessWorkflowInput {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.started[len(f.started)-1]
+}
+
+// taskCompletions 返回已发送的用户任务完成信号
+func (f *fakeWorkflowEngine) taskCompletions() []temporal.UserTaskCompletedSignal {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var completions []temporal.UserTaskCompletedSignal
+	for _, signal := range f.signals {
+		if completion, ok := signal.arg.(temporal.UserTaskCompletedSignal); ok && signal.name == temporal.SignalUserTaskCompleted {
+			completions = append(completions, completion)
+		}
+	}
+	return completions
+}
+
+func (f *fakeWorkflowEngine) TerminateWorkflow(ctx context.Context, workflowID string, runID string, reason string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.terminated = append(f.terminated, workflowID)
+	return nil
+}
+
+// fakeHealthChecker 可设置检查结果的依赖健康检查替身
+type fakeHealthChecker struct {
+	mu  sync.Mutex
+	err error
+}
+
+func (f *fakeHealthChecker) HealthCheck(ctx context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.err
+}
+
+// setError 设置健康检查返回的错误，nil表示依赖可用
+func (f *fakeHealthChecker) setError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.err = err
+}
@@ -4,6 +4,8 @@ package performance
 
 import (
 	"bytes"
+	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -12,10 +14,22 @@ import (
 	"testing"
 	"time"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 
+	"github.com/workflow-engine/workflow-engine/internal/auth"
+	"github.com/workflow-engine/workflow-engine/internal/biz"
+	"github.com/workflow-engine/workflow-engine/internal/data/ent"
+	"github.com/workflow-engine/workflow-engine/internal/data/repository"
+	"github.com/workflow-engine/workflow-engine/internal/middleware"
 	"github.com/workflow-engine/workflow-engine/internal/server"
+	"github.com/workflow-engine/workflow-engine/internal/service"
+	"github.com/workflow-engine/workflow-engine/internal/temporal"
+	"github.com/workflow-engine/workflow-engine/pkg/config"
 )
 
 // LoadTestConfig 负载测试配置
@@ -39,22 +53,77 @@ type LoadTestResult struct {
 	ErrorRate         float64       // 错误率
 }
 
+// testProcessResource 只包含开始和结束节点的最小流程资源
+const testProcessResource = `{"id":"perf-process","name":"性能测试流程","elements":[` +
+	`{"id":"start","type":"startEvent","next":["end"]},` +
+	`{"id":"end","type":"endEvent"}]}`
+
 // PerformanceTestSuite 性能测试套件
 type PerformanceTestSuite struct {
 	server *httptest.Server
 	router *server.Router
+	client *ent.Client
+	token  string
 	logger *zap.Logger
 }
 
 // NewPerformanceTestSuite 创建性能测试套件
+// 服务层连接内存SQLite数据库，缓存与工作流引擎使用空实现，只衡量HTTP与数据访问开销
 func NewPerformanceTestSuite() *PerformanceTestSuite {
 	logger, _ := zap.NewDevelopment()
-	router := server.NewRouter(logger)
+
+	// SQLite内存库只允许单个连接写入，避免并发请求出现锁冲突
+	db, err := sql.Open("sqlite3", fmt.Sprintf("file:perf-%d?mode=memory&cache=shared&_fk=1", time.Now().UnixNano()))
+	if err != nil {
+		panic(fmt.Sprintf("打开测试数据库失败: %v", err))
+	}
+	db.SetMaxOpenConns(1)
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	if err := client.Schema.Create(context.Background()); err != nil {
+		panic(fmt.Sprintf("创建测试数据库结构失败: %v", err))
+	}
+
+	cache := noopCache{}
+	processDefinitionRepo := repository.NewProcessDefinitionRepo(client, logger)
+	processInstanceRepo := repository.NewProcessInstanceRepo(client, logger)
+	taskRepo := repository.NewTaskInstanceRepo(client, logger)
+	variableRepo := repository.NewProcessVariableRepo(client, logger)
+	eventRepo := repository.NewProcessEventRepo(client, logger)
+	commentRepo := repository.NewTaskCommentRepo(client, logger)
+	attachmentRepo := repository.NewTaskAttachmentRepo(client, &config.Config{}, logger)
+	txRepo := repository.NewTransactionRepo(client, logger)
+
+	jwtManager, err := auth.NewJWTManager(&auth.JWTConfig{SecretKey: "perf-secret", Issuer: "workflow-engine"}, nil, logger)
+	if err != nil {
+		panic(fmt.Sprintf("创建JWT管理器失败: %v", err))
+	}
+	tokenPair, err := jwtManager.GenerateTokenPair(1, "perf", "perf@example.com", []string{"admin"}, nil)
+	if err != nil {
+		panic(fmt.Sprintf("签发访问令牌失败: %v", err))
+	}
+
+	authz := biz.NewAuthorizationUseCase(repository.NewAuthorizationRepo(client, logger), processDefinitionRepo, logger)
+
+	gin.SetMode(gin.ReleaseMode)
+	router := server.NewRouter(
+		service.NewProcessDefinitionService(biz.NewProcessDefinitionUseCase(processDefinitionRepo, cache, authz, logger), logger),
+		service.NewProcessInstanceService(biz.NewProcessInstanceUseCase(processInstanceRepo, processDefinitionRepo, taskRepo, variableRepo, txRepo, cache, noopEngine{}, authz, logger), logger),
+		service.NewTaskInstanceService(biz.NewTaskInstanceUseCase(taskRepo, processInstanceRepo, variableRepo, eventRepo, commentRepo, attachmentRepo, txRepo, cache, noopEngine{}, authz, logger), logger),
+		service.NewHistoricDataService(biz.NewHistoricDataUseCase(repository.NewHistoricProcessInstanceRepo(client, logger), cache, authz, logger), logger),
+		nil,
+		nil,
+		nil,
+		middleware.NewAuthMiddleware(jwtManager, logger),
+		noopHealthChecker{},
+		logger,
+	)
 	server := httptest.NewServer(router)
 
 	return &PerformanceTestSuite{
 		server: server,
 		router: router,
+		client: client,
+		token:  tokenPair.AccessToken,
 		logger: logger,
 	}
 }
@@ -64,6 +133,9 @@ func (pts *PerformanceTestSuite) Close() {
 	if pts.server != nil {
 		pts.server.Close()
 	}
+	if pts.client != nil {
+		pts.client.Close()
+	}
 	if pts.logger != nil {
 		pts.logger.Sync()
 	}
@@ -88,6 +160,7 @@ func (pts *PerformanceTestSuite) makeHTTPRequest(method, path string, body inter
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
+	req.Header.Set("Authorization", "Bearer "+pts.token)
 
 	client := &http.Client{Timeout: 10 * time.Second}
 	resp, err := client.Do(req)
@@ -276,7 +349,7 @@ func TestCreateProcessDefinitionPerformance(t *testing.T) {
 			"key":         fmt.Sprintf("test-process-%d", requestID),
 			"name":        fmt.Sprintf("测试流程-%d", requestID),
 			"description": "性能测试流程定义",
-			"resource":    `{"version": "1.0", "steps": []}`,
+			"resource":    testProcessResource,
 			"category":    "性能测试",
 		}
 		return pts.makeHTTPRequest("POST", "/api/v1/process-definitions", createReq)
@@ -428,3 +501,48 @@ func BenchmarkProcessDefinitionsList(b *testing.B) {
 		}
 	})
 }
+
+// noopCache 不保存任何内容的缓存，所有读取都视为未命中
+type noopCache struct{}
+
+func (noopCache) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
+	return nil
+}
+func (noopCache) Get(ctx context.Context, key string) (string, error) {
+	return "", fmt.Errorf("缓存不存在: %s", key)
+}
+func (noopCache) Delete(ctx context.Context, key string) error { return nil }
+func (noopCache) Exists(ctx context.Context, key string) (bool, error) {
+	return false, nil
+}
+func (noopCache) Expire(ctx context.Context, key string, expiration time.Duration) error {
+	return nil
+}
+func (noopCache) HGet(ctx context.Context, key, field string) (string, error) {
+	return "", fmt.Errorf("缓存不存在: %s.%s", key, field)
+}
+func (noopCache) HSet(ctx context.Context, key, field string, value interface{}) error {
+	return nil
+}
+func (noopCache) HDel(ctx context.Context, key string, fields ...string) error { return nil }
+func (noopCache) HGetAll(ctx context.Context, key string) (map[string]string, error) {
+	return map[string]string{}, nil
+}
+
+// noopEngine 不连接Temporal的工作流引擎
+type noopEngine struct{}
+
+func (noopEngine) StartProcessWorkflow(ctx context.Context, input temporal.ProcessWorkflowInput) (string, error) {
+	return "perf-run", nil
+}
+func (noopEngine) SignalWorkflow(ctx context.Context, workflowID string, runID string, signalName string, arg interface{}) error {
+	return nil
+}
+func (noopEngine) TerminateWorkflow(ctx context.Context, workflowID string, runID string, reason string) error {
+	return nil
+}
+
+// noopHealthChecker 始终报告依赖可用的健康检查
+type noopHealthChecker struct{}
+
+func (noopHealthChecker) HealthCheck(ctx context.Context) error { return nil }